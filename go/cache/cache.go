@@ -16,11 +16,24 @@ limitations under the License.
 
 package cache
 
+import (
+	"context"
+	"time"
+)
+
 // Cache is a generic interface type for a data structure that keeps recently used
 // objects in memory and evicts them when it becomes full.
 type Cache interface {
 	Get(key string) (interface{}, bool)
 	Set(key string, val interface{}) bool
+
+	// GetOrCompute returns the value of key. If the key is missing, compute
+	// is called to create its value, which is stored in the cache if compute
+	// returns true. The concurrent GetOrCompute calls for the same key wait, until
+	// their ctx is done, for the running compute and share its value, instead of
+	// computing it again; if the value was not stored, or compute failed, they call
+	// compute themselves.
+	GetOrCompute(ctx context.Context, key string, compute func() (interface{}, bool, error)) (interface{}, error)
 	ForEach(callback func(interface{}) bool)
 
 	Delete(key string)
@@ -33,6 +46,8 @@ type Cache interface {
 
 	Len() int
 	Evictions() int64
+	Hits() int64
+	Misses() int64
 	UsedCapacity() int64
 	MaxCapacity() int64
 	SetCapacity(int64)
@@ -59,12 +74,24 @@ func NewDefaultCacheImpl(cfg *Config) Cache {
 		})
 
 	default:
-		if cfg.MaxEntries == 0 {
-			return &nullCache{}
+		var lru *LRUCache
+		if cfg.LRUMemoryBased {
+			if cfg.MaxMemoryUsage == 0 {
+				return &nullCache{}
+			}
+			lru = NewLRUCache(cfg.MaxMemoryUsage, func(val interface{}) int64 {
+				return val.(cachedObject).CachedSize(true)
+			})
+		} else {
+			if cfg.MaxEntries == 0 {
+				return &nullCache{}
+			}
+			lru = NewLRUCache(cfg.MaxEntries, func(_ interface{}) int64 {
+				return 1
+			})
 		}
-		return NewLRUCache(cfg.MaxEntries, func(_ interface{}) int64 {
-			return 1
-		})
+		lru.SetTTL(cfg.TTL)
+		return lru
 	}
 }
 
@@ -76,6 +103,12 @@ type Config struct {
 	MaxMemoryUsage int64
 	// LFU toggles whether to use a new cache implementation with a TinyLFU admission policy
 	LFU bool
+	// LRUMemoryBased makes the LRU cache hold up to MaxMemoryUsage bytes of
+	// entries, instead of MaxEntries entries. It is ignored if LFU is set.
+	LRUMemoryBased bool
+	// TTL is the maximum time an entry stays in the LRU cache after it was
+	// set. Zero means the entries don't expire. It is ignored if LFU is set.
+	TTL time.Duration
 }
 
 // DefaultConfig is the default configuration for a cache instance in Vitess
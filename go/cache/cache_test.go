@@ -37,9 +37,11 @@ func TestNewDefaultCacheImpl(t *testing.T) {
 		{&Config{MaxEntries: 100, MaxMemoryUsage: 0, LFU: true}, assertNullCache},
 		{&Config{MaxEntries: 100, MaxMemoryUsage: 1000, LFU: true}, assertLFUCache},
 		{&Config{MaxEntries: 0, MaxMemoryUsage: 1000, LFU: true}, assertNullCache},
+		{&Config{MaxEntries: 100, MaxMemoryUsage: 0, LRUMemoryBased: true}, assertNullCache},
+		{&Config{MaxEntries: 0, MaxMemoryUsage: 1000, LRUMemoryBased: true}, assertLRUCache},
 	}
 	for _, tt := range tests {
-		t.Run(fmt.Sprintf("%d.%d.%v", tt.cfg.MaxEntries, tt.cfg.MaxMemoryUsage, tt.cfg.LFU), func(t *testing.T) {
+		t.Run(fmt.Sprintf("%d.%d.%v.%v", tt.cfg.MaxEntries, tt.cfg.MaxMemoryUsage, tt.cfg.LFU, tt.cfg.LRUMemoryBased), func(t *testing.T) {
 			cache := NewDefaultCacheImpl(tt.cfg)
 			tt.verify(t, cache)
 		})
@@ -25,6 +25,7 @@ package cache
 
 import (
 	"container/list"
+	"context"
 	"sync"
 	"time"
 )
@@ -34,7 +35,8 @@ var _ Cache = &LRUCache{}
 // LRUCache is a typical LRU cache implementation.  If the cache
 // reaches the capacity, the least recently used item is deleted from
 // the cache. Note the capacity is not the number of items, but the
-// total sum of the CachedSize() of each item.
+// total sum of the CachedSize() of each item. If a TTL is set, the items
+// also expire after it.
 type LRUCache struct {
 	mu sync.Mutex
 
@@ -45,7 +47,13 @@ type LRUCache struct {
 
 	size      int64
 	capacity  int64
+	ttl       time.Duration
 	evictions int64
+	hits      int64
+	misses    int64
+
+	// flights are the running computes of GetOrCompute.
+	flights flights
 }
 
 // Item is what is stored in the cache
@@ -59,6 +67,7 @@ type entry struct {
 	value        interface{}
 	size         int64
 	timeAccessed time.Time
+	timeSet      time.Time
 }
 
 // NewLRUCache creates a new empty cache with the given capacity.
@@ -77,14 +86,68 @@ func (lru *LRUCache) Get(key string) (v interface{}, ok bool) {
 	lru.mu.Lock()
 	defer lru.mu.Unlock()
 
-	element := lru.table[key]
+	element := lru.lookup(key)
 	if element == nil {
+		lru.misses++
 		return nil, false
 	}
+	lru.hits++
 	lru.moveToFront(element)
 	return element.Value.(*entry).value, true
 }
 
+// GetOrCompute returns a value from the cache, or computes it if it's
+// missing. See Cache.GetOrCompute.
+func (lru *LRUCache) GetOrCompute(ctx context.Context, key string, compute func() (interface{}, bool, error)) (interface{}, error) {
+	if value, ok := lru.Get(key); ok {
+		return value, nil
+	}
+	return lru.flights.do(ctx, key, func() (interface{}, bool, error) {
+		// The key may have been set by a flight which finished after our Get.
+		if value, ok := lru.peek(key); ok {
+			return value, true, nil
+		}
+		value, store, err := compute()
+		if err != nil || !store {
+			return value, false, err
+		}
+		lru.Set(key, value)
+		return value, true, nil
+	})
+}
+
+// peek returns a value from the cache, without marking it as used or
+// counting a hit or a miss.
+func (lru *LRUCache) peek(key string) (interface{}, bool) {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+
+	element := lru.lookup(key)
+	if element == nil {
+		return nil, false
+	}
+	return element.Value.(*entry).value, true
+}
+
+// lookup returns the element of key, or nil if it is missing. An expired
+// element is removed.
+func (lru *LRUCache) lookup(key string) *list.Element {
+	element := lru.table[key]
+	if element == nil {
+		return nil
+	}
+	if lru.expired(element.Value.(*entry)) {
+		lru.remove(element)
+		lru.evictions++
+		return nil
+	}
+	return element
+}
+
+func (lru *LRUCache) expired(e *entry) bool {
+	return lru.ttl > 0 && time.Since(e.timeSet) > lru.ttl
+}
+
 // Set sets a value in the cache.
 func (lru *LRUCache) Set(key string, value interface{}) bool {
 	lru.mu.Lock()
@@ -109,9 +172,7 @@ func (lru *LRUCache) delete(key string) bool {
 		return false
 	}
 
-	lru.list.Remove(element)
-	delete(lru.table, key)
-	lru.size -= element.Value.(*entry).size
+	lru.remove(element)
 	return true
 }
 
@@ -148,6 +209,15 @@ func (lru *LRUCache) SetCapacity(capacity int64) {
 	lru.checkCapacity()
 }
 
+// SetTTL sets the maximum time the entries stay in the cache after they
+// were set. Zero means the entries don't expire. The expired entries are
+// removed when they are accessed, or when they reach the end of the list.
+func (lru *LRUCache) SetTTL(ttl time.Duration) {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	lru.ttl = ttl
+}
+
 // Wait is a no-op in the LRU cache
 func (lru *LRUCache) Wait() {}
 
@@ -170,6 +240,20 @@ func (lru *LRUCache) Evictions() int64 {
 	return lru.evictions
 }
 
+// Hits returns the number of Gets which found their key.
+func (lru *LRUCache) Hits() int64 {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	return lru.hits
+}
+
+// Misses returns the number of Gets which didn't find their key.
+func (lru *LRUCache) Misses() int64 {
+	lru.mu.Lock()
+	defer lru.mu.Unlock()
+	return lru.misses
+}
+
 // ForEach yields all the values for the cache, ordered from most recently
 // used to least recently used.
 func (lru *LRUCache) ForEach(callback func(value interface{}) bool) {
@@ -178,6 +262,9 @@ func (lru *LRUCache) ForEach(callback func(value interface{}) bool) {
 
 	for e := lru.list.Front(); e != nil; e = e.Next() {
 		v := e.Value.(*entry)
+		if lru.expired(v) {
+			continue
+		}
 		if !callback(v.value) {
 			break
 		}
@@ -193,6 +280,9 @@ func (lru *LRUCache) Items() []Item {
 	items := make([]Item, 0, lru.list.Len())
 	for e := lru.list.Front(); e != nil; e = e.Next() {
 		v := e.Value.(*entry)
+		if lru.expired(v) {
+			continue
+		}
 		items = append(items, Item{Key: v.key, Value: v.value})
 	}
 	return items
@@ -203,6 +293,7 @@ func (lru *LRUCache) updateInplace(element *list.Element, value interface{}) {
 	sizeDiff := valueSize - element.Value.(*entry).size
 	element.Value.(*entry).value = value
 	element.Value.(*entry).size = valueSize
+	element.Value.(*entry).timeSet = time.Now()
 	lru.size += sizeDiff
 	lru.moveToFront(element)
 	lru.checkCapacity()
@@ -214,7 +305,8 @@ func (lru *LRUCache) moveToFront(element *list.Element) {
 }
 
 func (lru *LRUCache) addNew(key string, value interface{}) {
-	newEntry := &entry{key, value, lru.cost(value), time.Now()}
+	now := time.Now()
+	newEntry := &entry{key, value, lru.cost(value), now, now}
 	element := lru.list.PushFront(newEntry)
 	lru.table[key] = element
 	lru.size += newEntry.size
@@ -222,13 +314,15 @@ func (lru *LRUCache) addNew(key string, value interface{}) {
 }
 
 func (lru *LRUCache) checkCapacity() {
-	// Partially duplicated from Delete
 	for lru.size > lru.capacity {
-		delElem := lru.list.Back()
-		delValue := delElem.Value.(*entry)
-		lru.list.Remove(delElem)
-		delete(lru.table, delValue.key)
-		lru.size -= delValue.size
+		lru.remove(lru.list.Back())
 		lru.evictions++
 	}
 }
+
+func (lru *LRUCache) remove(element *list.Element) {
+	e := element.Value.(*entry)
+	lru.list.Remove(element)
+	delete(lru.table, e.key)
+	lru.size -= e.size
+}
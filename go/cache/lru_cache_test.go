@@ -17,7 +17,12 @@ limitations under the License.
 package cache
 
 import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 )
 
 type CacheValue struct {
@@ -228,3 +233,120 @@ func TestLRUIsEvicted(t *testing.T) {
 		t.Errorf("evictions: %d, want: %d", e, want)
 	}
 }
+
+func TestLRUHitsAndMisses(t *testing.T) {
+	cache := NewLRUCache(100, cacheValueSize)
+	cache.Set("key1", &CacheValue{1})
+
+	cache.Get("key1")
+	cache.Get("key1")
+	cache.Get("key2")
+	if h, want := cache.Hits(), int64(2); h != want {
+		t.Errorf("hits: %d, want: %d", h, want)
+	}
+	if m, want := cache.Misses(), int64(1); m != want {
+		t.Errorf("misses: %d, want: %d", m, want)
+	}
+}
+
+func TestLRUTTL(t *testing.T) {
+	cache := NewLRUCache(100, cacheValueSize)
+	cache.SetTTL(10 * time.Millisecond)
+	cache.Set("key1", &CacheValue{1})
+
+	if _, ok := cache.Get("key1"); !ok {
+		t.Error("key1 expired too soon")
+	}
+	time.Sleep(20 * time.Millisecond)
+	if items := cache.Items(); len(items) != 0 {
+		t.Errorf("Items() returned expired items: %v", items)
+	}
+	if _, ok := cache.Get("key1"); ok {
+		t.Error("key1 did not expire")
+	}
+	if l := cache.Len(); l != 0 {
+		t.Errorf("cache.Len() returned bad length: %v", l)
+	}
+	if s := cache.UsedCapacity(); s != 0 {
+		t.Errorf("cache.UsedCapacity() returned bad size: %v", s)
+	}
+	if e, want := cache.Evictions(), int64(1); e != want {
+		t.Errorf("evictions: %d, want: %d", e, want)
+	}
+}
+
+func TestLRUGetOrCompute(t *testing.T) {
+	cache := NewLRUCache(100, cacheValueSize)
+
+	var computes int64
+	release := make(chan struct{})
+	compute := func() (interface{}, bool, error) {
+		atomic.AddInt64(&computes, 1)
+		<-release
+		return &CacheValue{1}, true, nil
+	}
+
+	var wg sync.WaitGroup
+	values := make([]interface{}, 10)
+	for i := range values {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			value, err := cache.GetOrCompute(context.Background(), "key", compute)
+			if err != nil {
+				t.Errorf("GetOrCompute failed: %v", err)
+			}
+			values[i] = value
+		}(i)
+	}
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if c := atomic.LoadInt64(&computes); c != 1 {
+		t.Errorf("compute was called %d times, want 1", c)
+	}
+	for _, value := range values {
+		if value != values[0] {
+			t.Errorf("GetOrCompute returned different values: %v, %v", value, values[0])
+		}
+	}
+	if v, ok := cache.Get("key"); !ok || v != values[0] {
+		t.Errorf("the computed value was not cached: %v", v)
+	}
+
+	// A value which is not stored, or an error, is not shared.
+	value, err := cache.GetOrCompute(context.Background(), "other", func() (interface{}, bool, error) {
+		return &CacheValue{1}, false, nil
+	})
+	if err != nil || value == nil {
+		t.Errorf("GetOrCompute returned %v, %v", value, err)
+	}
+	if _, ok := cache.Get("other"); ok {
+		t.Error("a value which must not be stored was cached")
+	}
+	wantErr := errors.New("compute failed")
+	if _, err := cache.GetOrCompute(context.Background(), "other", func() (interface{}, bool, error) {
+		return nil, true, wantErr
+	}); err != wantErr {
+		t.Errorf("GetOrCompute returned error %v, want %v", err, wantErr)
+	}
+
+	// A caller waiting for a compute stops when its context is done.
+	release = make(chan struct{})
+	started := make(chan struct{})
+	go func() {
+		_, _ = cache.GetOrCompute(context.Background(), "slow", func() (interface{}, bool, error) {
+			close(started)
+			<-release
+			return &CacheValue{1}, true, nil
+		})
+	}()
+	<-started
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := cache.GetOrCompute(ctx, "slow", compute); err != context.DeadlineExceeded {
+		t.Errorf("GetOrCompute returned error %v, want %v", err, context.DeadlineExceeded)
+	}
+	close(release)
+}
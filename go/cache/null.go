@@ -16,6 +16,10 @@ limitations under the License.
 
 package cache
 
+import (
+	"context"
+)
+
 // nullCache is a no-op cache that does not store items
 type nullCache struct{}
 
@@ -29,6 +33,12 @@ func (n *nullCache) Set(_ string, _ interface{}) bool {
 	return false
 }
 
+// GetOrCompute always computes the value in the nullCache
+func (n *nullCache) GetOrCompute(_ context.Context, _ string, compute func() (interface{}, bool, error)) (interface{}, error) {
+	value, _, err := compute()
+	return value, err
+}
+
 // ForEach iterates the nullCache, which is always empty
 func (n *nullCache) ForEach(_ func(interface{}) bool) {}
 
@@ -61,3 +71,13 @@ func (n *nullCache) SetCapacity(_ int64) {}
 func (n *nullCache) Evictions() int64 {
 	return 0
 }
+
+// Hits returns the number of hits of the nullCache, which is always 0
+func (n *nullCache) Hits() int64 {
+	return 0
+}
+
+// Misses returns the number of misses of the nullCache, which is always 0
+func (n *nullCache) Misses() int64 {
+	return 0
+}
@@ -22,6 +22,7 @@ package ristretto
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"sync"
@@ -84,6 +85,8 @@ type Cache struct {
 	// Metrics contains a running log of important statistics like hits, misses,
 	// and dropped items.
 	Metrics *Metrics
+	// flights are the running computes of GetOrCompute.
+	flights flights
 }
 
 // Config is passed to NewCache for creating new Cache instances.
@@ -238,6 +241,25 @@ func (c *Cache) Get(key string) (interface{}, bool) {
 	return value, ok
 }
 
+// GetOrCompute returns the value of key. If the key is missing, compute is
+// called to create its value, which is set in the cache if compute returns
+// true. The concurrent GetOrCompute calls for the same key wait, until their
+// ctx is done, for the running compute and share its value; if it was not
+// set, or compute failed, they call compute themselves.
+func (c *Cache) GetOrCompute(ctx context.Context, key string, compute func() (interface{}, bool, error)) (interface{}, error) {
+	if value, ok := c.Get(key); ok {
+		return value, nil
+	}
+	return c.flights.do(ctx, key, func() (interface{}, bool, error) {
+		value, store, err := compute()
+		if err != nil || !store {
+			return value, false, err
+		}
+		c.Set(key, value)
+		return value, true, nil
+	})
+}
+
 // Set attempts to add the key-value item to the cache. If it returns false,
 // then the Set was dropped and the key-value item isn't added to the cache. If
 // it returns true, there's still a chance it could be dropped by the policy if
@@ -403,6 +425,22 @@ func (c *Cache) Evictions() int64 {
 	return int64(c.Metrics.KeysEvicted())
 }
 
+// Hits returns the number of Gets which found their key
+func (c *Cache) Hits() int64 {
+	if c == nil || c.Metrics == nil {
+		return 0
+	}
+	return int64(c.Metrics.Hits())
+}
+
+// Misses returns the number of Gets which didn't find their key
+func (c *Cache) Misses() int64 {
+	if c == nil || c.Metrics == nil {
+		return 0
+	}
+	return int64(c.Metrics.Misses())
+}
+
 // ForEach yields all the values currently stored in the cache to the given callback.
 // The callback may return `false` to stop the iteration early.
 func (c *Cache) ForEach(forEach func(interface{}) bool) {
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ristretto
+
+import (
+	"context"
+	"sync"
+)
+
+// flights runs a single compute per key at a time, and lets the other
+// callers for the key wait for its value, until their context is done.
+type flights struct {
+	mu    sync.Mutex
+	calls map[string]*flight
+}
+
+type flight struct {
+	done   chan struct{}
+	value  interface{}
+	shared bool
+}
+
+// do calls compute for key, unless a compute for key is already running,
+// in which case it waits for it and returns its value if it was shared.
+// If it was not shared, compute is called for this caller too. compute
+// runs in the caller's goroutine, so the caller's state it touches is
+// never used after do returns.
+func (f *flights) do(ctx context.Context, key string, compute func() (interface{}, bool, error)) (interface{}, error) {
+	f.mu.Lock()
+	if call, ok := f.calls[key]; ok {
+		f.mu.Unlock()
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if call.shared {
+			return call.value, nil
+		}
+		value, _, err := compute()
+		return value, err
+	}
+	if f.calls == nil {
+		f.calls = make(map[string]*flight)
+	}
+	call := &flight{done: make(chan struct{})}
+	f.calls[key] = call
+	f.mu.Unlock()
+
+	defer func() {
+		f.mu.Lock()
+		delete(f.calls, key)
+		f.mu.Unlock()
+		close(call.done)
+	}()
+	value, shared, err := compute()
+	if err == nil && shared {
+		call.value, call.shared = value, true
+	}
+	return value, err
+}
@@ -17,16 +17,27 @@ limitations under the License.
 package command
 
 import (
+	"errors"
 	"fmt"
+	"io/ioutil"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"vitess.io/vitess/go/cmd/vtctldclient/cli"
+	"vitess.io/vitess/go/json2"
 
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 )
 
 var (
+	// ApplyVSchema makes an ApplyVSchema gRPC call to a vtctld.
+	ApplyVSchema = &cobra.Command{
+		Use:  "ApplyVSchema {--vschema=<vschema> || --vschema-file=<vschema file> || --sql=<sql> || --sql-file=<sql file>} [--cells=c1,c2,...] [--skip-rebuild] [--skip-validation] [--plan-queries-file=<queries file>] [--dry-run] <keyspace>",
+		Args: cobra.ExactArgs(1),
+		RunE: commandApplyVSchema,
+	}
 	// GetVSchema makes a GetVSchema gRPC call to a vtctld.
 	GetVSchema = &cobra.Command{
 		Use:  "GetVSchema keyspace",
@@ -35,6 +46,94 @@ var (
 	}
 )
 
+var applyVSchemaOptions = struct {
+	VSchema         string
+	VSchemaFile     string
+	SQL             string
+	SQLFile         string
+	PlanQueriesFile string
+	DryRun          bool
+	SkipRebuild     bool
+	SkipValidation  bool
+	Cells           []string
+}{}
+
+func commandApplyVSchema(cmd *cobra.Command, args []string) error {
+	sqlMode := (applyVSchemaOptions.SQL != "") != (applyVSchemaOptions.SQLFile != "")
+	jsonMode := (applyVSchemaOptions.VSchema != "") != (applyVSchemaOptions.VSchemaFile != "")
+
+	if sqlMode && jsonMode {
+		return errors.New("only one of the sql, sql-file, vschema, or vschema-file flags may be specified when calling the ApplyVSchema command")
+	}
+
+	if !sqlMode && !jsonMode {
+		return errors.New("one of the sql, sql-file, vschema, or vschema-file flags must be specified when calling the ApplyVSchema command")
+	}
+
+	req := &vtctldatapb.ApplyVSchemaRequest{
+		Keyspace:       cmd.Flags().Arg(0),
+		SkipRebuild:    applyVSchemaOptions.SkipRebuild,
+		DryRun:         applyVSchemaOptions.DryRun,
+		Cells:          applyVSchemaOptions.Cells,
+		SkipValidation: applyVSchemaOptions.SkipValidation,
+	}
+
+	if sqlMode {
+		if applyVSchemaOptions.SQLFile != "" {
+			sqlBytes, err := ioutil.ReadFile(applyVSchemaOptions.SQLFile)
+			if err != nil {
+				return err
+			}
+			req.Sql = string(sqlBytes)
+		} else {
+			req.Sql = applyVSchemaOptions.SQL
+		}
+	} else {
+		schema := []byte(applyVSchemaOptions.VSchema)
+		if applyVSchemaOptions.VSchemaFile != "" {
+			var err error
+			schema, err = ioutil.ReadFile(applyVSchemaOptions.VSchemaFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		req.VSchema = &vschemapb.Keyspace{}
+		if err := json2.Unmarshal(schema, req.VSchema); err != nil {
+			return err
+		}
+	}
+
+	if applyVSchemaOptions.PlanQueriesFile != "" {
+		data, err := ioutil.ReadFile(applyVSchemaOptions.PlanQueriesFile)
+		if err != nil {
+			return err
+		}
+
+		for _, query := range strings.Split(string(data), "\n") {
+			if query = strings.TrimSpace(query); query != "" {
+				req.PlanQueries = append(req.PlanQueries, query)
+			}
+		}
+	}
+
+	cli.FinishedParsing(cmd)
+
+	resp, err := client.ApplyVSchema(commandCtx, req)
+	if err != nil {
+		return err
+	}
+
+	data, err := cli.MarshalJSON(resp)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("%s\n", data)
+
+	return nil
+}
+
 func commandGetVSchema(cmd *cobra.Command, args []string) error {
 	cli.FinishedParsing(cmd)
 
@@ -58,5 +157,16 @@ func commandGetVSchema(cmd *cobra.Command, args []string) error {
 }
 
 func init() {
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchema, "vschema", "", "VSchema")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.VSchemaFile, "vschema-file", "", "Path to a file containing the vschema")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.SQL, "sql", "", "A VSchema DDL SQL statement, e.g. `alter table t add vindex hash(id)`")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.SQLFile, "sql-file", "", "Path to a file containing a VSchema DDL SQL statement")
+	ApplyVSchema.Flags().StringVar(&applyVSchemaOptions.PlanQueriesFile, "plan-queries-file", "", "Path to a file containing a sample of the queries of the keyspace, one per line, whose plans the vschema would change are reported")
+	ApplyVSchema.Flags().BoolVar(&applyVSchemaOptions.DryRun, "dry-run", false, "If set, validates and plans the vschema without saving it")
+	ApplyVSchema.Flags().BoolVar(&applyVSchemaOptions.SkipRebuild, "skip-rebuild", false, "Skip rebuilding the SrvSchema objects")
+	ApplyVSchema.Flags().BoolVar(&applyVSchemaOptions.SkipValidation, "skip-validation", false, "Skip validating the vschema against the schema of the primary tablets of the keyspace")
+	ApplyVSchema.Flags().StringSliceVar(&applyVSchemaOptions.Cells, "cells", nil, "Limits the rebuild to the specified cells, after application. Ignored if --skip-rebuild is set")
+	Root.AddCommand(ApplyVSchema)
+
 	Root.AddCommand(GetVSchema)
 }
@@ -38,11 +38,12 @@ var (
 	ksShardMapFlag     = flag.String("ks-shard-map", "", "JSON map of keyspace name -> shard name -> ShardReference object. The inner map is the same as the output of FindAllShardsInKeyspace")
 	ksShardMapFileFlag = flag.String("ks-shard-map-file", "", "File containing json blob of keyspace name -> shard name -> ShardReference object")
 	numShards          = flag.Int("shards", 2, "Number of shards per keyspace. Passing -ks-shard-map/-ks-shard-map-file causes this flag to be ignored.")
-	executionMode      = flag.String("execution-mode", "multi", "The execution mode to simulate -- must be set to multi, legacy-autocommit, or twopc")
+	executionMode      = flag.String("execution-mode", "multi", "The execution mode to simulate -- must be set to multi, single, legacy-autocommit, or twopc")
 	replicationMode    = flag.String("replication-mode", "ROW", "The replication mode to simulate -- must be set to either ROW or STATEMENT")
 	normalize          = flag.Bool("normalize", false, "Whether to enable vtgate normalization")
 	outputMode         = flag.String("output-mode", "text", "Output in human-friendly text or json")
 	dbName             = flag.String("dbname", "", "Optional database target to override normal routing")
+	explainTxs         = flag.Bool("explain-transactions", false, "Whether to show the shards on which each statement begins, commits or rolls back transactions and takes locks, and flag cross-shard transactions")
 
 	// vtexplainFlags lists all the flags that should show in usage
 	vtexplainFlags = []string{
@@ -59,6 +60,8 @@ var (
 		"ks-shard-map",
 		"ks-shard-map-file",
 		"dbname",
+		"execution-mode",
+		"explain-transactions",
 		"queryserver-config-passthrough-dmls",
 	}
 )
@@ -166,11 +169,12 @@ func parseAndRun() error {
 	}
 
 	opts := &vtexplain.Options{
-		ExecutionMode:   *executionMode,
-		ReplicationMode: *replicationMode,
-		NumShards:       *numShards,
-		Normalize:       *normalize,
-		Target:          *dbName,
+		ExecutionMode:       *executionMode,
+		ReplicationMode:     *replicationMode,
+		NumShards:           *numShards,
+		Normalize:           *normalize,
+		Target:              *dbName,
+		ExplainTransactions: *explainTxs,
 	}
 
 	log.V(100).Infof("sql %s\n", sql)
@@ -48,6 +48,7 @@ var (
 	enforceTableACLConfig        = flag.Bool("enforce-tableacl-config", false, "if this flag is true, vttablet will fail to start if a valid tableacl config does not exist")
 	tableACLConfig               = flag.String("table-acl-config", "", "path to table access checker config file; send SIGHUP to reload this file")
 	tableACLConfigReloadInterval = flag.Duration("table-acl-config-reload-interval", 0, "Ticker to reload ACLs. Duration flag, format e.g.: 30s. Default: do not reload")
+	tableACLConfigWatch          = flag.Bool("table-acl-config-watch", false, "reload the table acl config file when it changes")
 	tabletPath                   = flag.String("tablet-path", "", "tablet alias")
 	tabletConfig                 = flag.String("tablet_config", "", "YAML file config for tablet")
 
@@ -210,5 +211,10 @@ func createTabletServer(config *tabletenv.TabletConfig, ts *topo.Server, tabletA
 	})
 	servenv.OnClose(qsc.StopService)
 	qsc.InitACL(*tableACLConfig, *enforceTableACLConfig, *tableACLConfigReloadInterval)
+	if *tableACLConfigWatch {
+		if err := qsc.WatchACL(*tableACLConfig); err != nil {
+			log.Exitf("cannot watch the table acl config file: %v", err)
+		}
+	}
 	return qsc
 }
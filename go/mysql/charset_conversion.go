@@ -0,0 +1,313 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// characterSetNames maps the collation ids sent in the handshakes to the
+// names of their character sets. The default collations of the character
+// sets come from CharacterSetMap, and the utf8 and utf8mb4 collations from
+// the collations package.
+var characterSetNames = map[uint8]string{
+	5:  "latin1", // latin1_german1_ci
+	15: "latin1", // latin1_danish_ci
+	31: "latin1", // latin1_german2_ci
+	47: "latin1", // latin1_bin
+	48: "latin1", // latin1_general_ci
+	49: "latin1", // latin1_general_cs
+	94: "latin1", // latin1_spanish_ci
+	65: "ascii",  // ascii_bin
+	// The UCA collations are not in the collations package.
+	192: "utf8",    // utf8_unicode_ci
+	224: "utf8mb4", // utf8mb4_unicode_ci
+	255: "utf8mb4", // utf8mb4_0900_ai_ci
+}
+
+func init() {
+	for name, id := range CharacterSetMap {
+		characterSetNames[id] = name
+	}
+}
+
+// CharacterSetName returns the name of the character set of a collation
+// id, as sent in the handshakes, or "" if it is unknown.
+func CharacterSetName(id uint8) string {
+	if name, ok := characterSetNames[id]; ok {
+		return name
+	}
+	if coll := collations.LookupByID(collations.ID(id)); coll != nil {
+		return coll.Charset()
+	}
+	return ""
+}
+
+// singleByteCharsets maps the names of the single byte character sets
+// that the queries and the results can be converted from and to, to their
+// characters. The character sets missing from the map, but utf8, are not
+// converted.
+var singleByteCharsets = map[string]*charmap.Charmap{
+	"latin1":   charmap.Windows1252,
+	"latin2":   charmap.ISO8859_2,
+	"latin5":   charmap.ISO8859_9,
+	"latin7":   charmap.ISO8859_13,
+	"greek":    charmap.ISO8859_7,
+	"hebrew":   charmap.ISO8859_8,
+	"cp1250":   charmap.Windows1250,
+	"cp1251":   charmap.Windows1251,
+	"cp1256":   charmap.Windows1256,
+	"cp1257":   charmap.Windows1257,
+	"cp850":    charmap.CodePage850,
+	"cp852":    charmap.CodePage852,
+	"cp866":    charmap.CodePage866,
+	"koi8r":    charmap.KOI8R,
+	"koi8u":    charmap.KOI8U,
+	"macroman": charmap.Macintosh,
+	"tis620":   charmap.Windows874,
+}
+
+// IsConvertibleCharset returns true if the queries and the results can be
+// converted from and to a character set, or do not need to be: utf8mb4,
+// utf8, binary, ascii and the single byte character sets like latin1.
+func IsConvertibleCharset(charset string) bool {
+	switch charset {
+	case "utf8mb4", "utf8", "utf8mb3", "binary", "ascii":
+		return true
+	}
+	_, ok := singleByteCharsets[charset]
+	return ok
+}
+
+// charsetConverter converts the strings of the backends, which are
+// utf8mb4, to the character set of a client, and the strings of the
+// client back to utf8mb4. Like in MySQL, the characters that do not exist
+// in the character set they are converted to are replaced with '?'.
+type charsetConverter struct {
+	// collation is the collation id sent to the client for the converted
+	// columns.
+	collation uint8
+	// maxLen is the maximum length in bytes of a character.
+	maxLen uint32
+	// encode encodes a character in a single byte character set, and
+	// decode decodes it. They are nil for utf8 (utf8mb3), which only
+	// misses the characters outside of the BMP.
+	encode func(r rune) (byte, bool)
+	decode func(b byte) rune
+}
+
+// newCharsetConverter returns the converter to the character set of a
+// collation id, or nil if the strings do not need to, or can not, be
+// converted.
+func newCharsetConverter(collation uint8) *charsetConverter {
+	charset := CharacterSetName(collation)
+	switch charset {
+	case "utf8", "utf8mb3":
+		return &charsetConverter{collation: collation, maxLen: 3}
+	case "ascii":
+		return &charsetConverter{
+			collation: collation,
+			maxLen:    1,
+			encode: func(r rune) (byte, bool) {
+				return byte(r), r < utf8.RuneSelf
+			},
+			decode: func(b byte) rune {
+				if b >= utf8.RuneSelf {
+					return '?'
+				}
+				return rune(b)
+			},
+		}
+	}
+	if cm, ok := singleByteCharsets[charset]; ok {
+		return &charsetConverter{collation: collation, maxLen: 1, encode: cm.EncodeRune, decode: cm.DecodeByte}
+	}
+	return nil
+}
+
+// newCharsetConverterByName is like newCharsetConverter, for the name of
+// a character set, as set with SET NAMES.
+func newCharsetConverterByName(charset string) *charsetConverter {
+	if charset == "utf8mb3" {
+		charset = "utf8"
+	}
+	collation, ok := CharacterSetMap[charset]
+	if !ok {
+		return nil
+	}
+	return newCharsetConverter(collation)
+}
+
+// convert converts a utf8mb4 string. The invalid utf8 sequences are
+// copied as is, since they are more likely binary data than text. It
+// returns false if in did not need to be converted.
+func (cc *charsetConverter) convert(in []byte) ([]byte, bool) {
+	i := asciiPrefixLen(in)
+	if i == len(in) {
+		// All the character sets are ascii compatible.
+		return in, false
+	}
+	out := make([]byte, i, len(in))
+	copy(out, in[:i])
+	for i < len(in) {
+		r, size := utf8.DecodeRune(in[i:])
+		switch {
+		case r == utf8.RuneError && size <= 1:
+			out = append(out, in[i])
+		case cc.encode == nil:
+			if r > 0xFFFF {
+				out = append(out, '?')
+			} else {
+				out = append(out, in[i:i+size]...)
+			}
+		default:
+			b, ok := cc.encode(r)
+			if !ok {
+				b = '?'
+			}
+			out = append(out, b)
+		}
+		i += size
+	}
+	return out, true
+}
+
+// convertString is like convert, for a string.
+func (cc *charsetConverter) convertString(in string) string {
+	out, ok := cc.convert([]byte(in))
+	if !ok {
+		return in
+	}
+	return string(out)
+}
+
+// decodeString converts a string of the client to utf8mb4. The strings
+// of utf8 clients are valid utf8mb4 already.
+func (cc *charsetConverter) decodeString(in string) string {
+	out, ok := cc.decodeBytes([]byte(in))
+	if !ok {
+		return in
+	}
+	return string(out)
+}
+
+// decodeBytes is like decodeString, for bytes. It returns false if in did
+// not need to be converted.
+func (cc *charsetConverter) decodeBytes(in []byte) ([]byte, bool) {
+	if cc.decode == nil {
+		return in, false
+	}
+	i := asciiPrefixLen(in)
+	if i == len(in) {
+		return in, false
+	}
+	out := make([]byte, i, len(in)+len(in)/2)
+	copy(out, in[:i])
+	var buf [utf8.UTFMax]byte
+	for _, b := range in[i:] {
+		if b < utf8.RuneSelf {
+			out = append(out, b)
+			continue
+		}
+		n := utf8.EncodeRune(buf[:], cc.decode(b))
+		out = append(out, buf[:n]...)
+	}
+	return out, true
+}
+
+func asciiPrefixLen(in []byte) int {
+	i := 0
+	for i < len(in) && in[i] < utf8.RuneSelf {
+		i++
+	}
+	return i
+}
+
+// utf8MaxLen returns the maximum length in bytes of the characters of the
+// values of a column, if they are utf8mb4 or utf8, or 0 if they are not.
+// Only those values are converted.
+func utf8MaxLen(field *querypb.Field) uint32 {
+	if !sqltypes.IsText(field.Type) || field.Charset > 255 {
+		return 0
+	}
+	switch CharacterSetName(uint8(field.Charset)) {
+	case "utf8mb4":
+		return 4
+	case "utf8", "utf8mb3":
+		return 3
+	}
+	return 0
+}
+
+// convertRow returns row with the values of the converted columns of the
+// last result set converted.
+func (cc *charsetConverter) convertRow(converted []bool, row []sqltypes.Value) []sqltypes.Value {
+	var out []sqltypes.Value
+	for i, val := range row {
+		if i >= len(converted) || !converted[i] || val.IsNull() {
+			continue
+		}
+		raw, ok := cc.convert(val.Raw())
+		if !ok {
+			continue
+		}
+		if out == nil {
+			out = append([]sqltypes.Value(nil), row...)
+		}
+		out[i] = sqltypes.MakeTrusted(val.Type(), raw)
+	}
+	if out == nil {
+		return row
+	}
+	return out
+}
+
+// CharsetName returns the name of the character set negotiated with the
+// other side of the connection, or "" if it is unknown.
+func (c *Conn) CharsetName() string {
+	return CharacterSetName(c.CharacterSet)
+}
+
+// SetCharacterSets changes the character sets of the queries sent by the
+// client and of the results sent to it, like SET NAMES and SET
+// character_set_results do. An empty name restores the character set of
+// the handshake. It does nothing if the Listener does not convert them.
+func (c *Conn) SetCharacterSets(client, results string) {
+	if c.listener == nil || !c.listener.ConvertCharset {
+		return
+	}
+	if client == c.clientCharset && results == c.resultsCharset {
+		return
+	}
+	c.clientCharset, c.resultsCharset = client, results
+	c.queryConverter = c.charsetConverterFor(client)
+	c.resultsConverter = c.charsetConverterFor(results)
+}
+
+func (c *Conn) charsetConverterFor(charset string) *charsetConverter {
+	if charset == "" {
+		return newCharsetConverter(c.CharacterSet)
+	}
+	return newCharsetConverterByName(charset)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestCharacterSetName(t *testing.T) {
+	assert.Equal(t, "latin1", CharacterSetName(8))
+	assert.Equal(t, "latin1", CharacterSetName(47))
+	assert.Equal(t, "utf8", CharacterSetName(CharacterSetUtf8))
+	assert.Equal(t, "utf8mb4", CharacterSetName(45))
+	assert.Equal(t, "utf8mb4", CharacterSetName(46))
+	assert.Equal(t, "binary", CharacterSetName(CharacterSetBinary))
+	assert.Equal(t, "", CharacterSetName(250))
+}
+
+func TestCharsetConverter(t *testing.T) {
+	assert.Nil(t, newCharsetConverter(45), "utf8mb4 is not converted")
+	assert.Nil(t, newCharsetConverter(CharacterSetMap["gbk"]), "gbk is not supported")
+
+	testcases := []struct {
+		charset string
+		in, out string
+	}{
+		{"latin1", "abc", "abc"},
+		{"latin1", "café €", "caf\xe9 \x80"},
+		{"latin1", "日本", "??"},
+		{"latin1", "a\xffb", "a\xffb"},
+		{"latin2", "zażółć", "za\xbf\xf3\xb3\xe6"},
+		{"cp1251", "привет", "\xef\xf0\xe8\xe2\xe5\xf2"},
+		{"ascii", "café", "caf?"},
+		{"utf8", "café 😀", "café ?"},
+	}
+	for _, tcase := range testcases {
+		t.Run(tcase.charset+"/"+tcase.in, func(t *testing.T) {
+			cc := newCharsetConverter(CharacterSetMap[tcase.charset])
+			require.NotNil(t, cc)
+			assert.Equal(t, tcase.out, cc.convertString(tcase.in))
+		})
+	}
+}
+
+func TestCharsetConverterDecode(t *testing.T) {
+	testcases := []struct {
+		charset string
+		in, out string
+	}{
+		{"latin1", "abc", "abc"},
+		{"latin1", "caf\xe9 \x80", "café €"},
+		{"cp1251", "\xef\xf0\xe8\xe2\xe5\xf2", "привет"},
+		{"ascii", "caf\xe9", "caf?"},
+		{"utf8", "café", "café"},
+	}
+	for _, tcase := range testcases {
+		t.Run(tcase.charset+"/"+tcase.in, func(t *testing.T) {
+			cc := newCharsetConverterByName(tcase.charset)
+			require.NotNil(t, cc)
+			assert.Equal(t, tcase.out, cc.decodeString(tcase.in))
+		})
+	}
+}
+
+func TestIsConvertibleCharset(t *testing.T) {
+	for _, charset := range []string{"utf8mb4", "utf8", "utf8mb3", "binary", "ascii", "latin1", "cp1251"} {
+		assert.True(t, IsConvertibleCharset(charset), charset)
+	}
+	for _, charset := range []string{"gbk", "utf16", "abcd"} {
+		assert.False(t, IsConvertibleCharset(charset), charset)
+	}
+}
+
+func TestSetCharacterSets(t *testing.T) {
+	c := &Conn{listener: &Listener{ConvertCharset: true}, CharacterSet: CharacterSetMap["latin1"]}
+	c.SetCharacterSets("cp1251", "")
+	require.NotNil(t, c.queryConverter)
+	assert.EqualValues(t, CharacterSetMap["cp1251"], c.queryConverter.collation)
+	require.NotNil(t, c.resultsConverter)
+	assert.EqualValues(t, CharacterSetMap["latin1"], c.resultsConverter.collation)
+
+	// NULL, or binary, disables the conversion of the results.
+	c.SetCharacterSets("cp1251", "binary")
+	assert.NotNil(t, c.queryConverter)
+	assert.Nil(t, c.resultsConverter)
+
+	c.SetCharacterSets("utf8mb4", "utf8mb4")
+	assert.Nil(t, c.queryConverter)
+	assert.Nil(t, c.resultsConverter)
+
+	// The connections of the listeners that do not convert the character
+	// sets are not changed.
+	c = &Conn{listener: &Listener{}, CharacterSet: CharacterSetMap["latin1"]}
+	c.SetCharacterSets("cp1251", "cp1251")
+	assert.Nil(t, c.queryConverter)
+	assert.Nil(t, c.resultsConverter)
+}
+
+func TestCharsetConverterRow(t *testing.T) {
+	cc := newCharsetConverter(CharacterSetMap["latin1"])
+	row := []sqltypes.Value{
+		sqltypes.NewVarChar("café"),
+		sqltypes.NewVarBinary("café"),
+		sqltypes.NULL,
+		sqltypes.NewVarChar("abc"),
+	}
+	converted := cc.convertRow([]bool{true, false, true, true}, row)
+	assert.Equal(t, []sqltypes.Value{
+		sqltypes.NewVarChar("caf\xe9"),
+		sqltypes.NewVarBinary("café"),
+		sqltypes.NULL,
+		sqltypes.NewVarChar("abc"),
+	}, converted)
+	assert.Equal(t, "café", row[0].ToString(), "the row must not be modified")
+
+	ascii := []sqltypes.Value{sqltypes.NewVarChar("abc")}
+	assert.Equal(t, &ascii[0], &cc.convertRow([]bool{true}, ascii)[0], "the rows that do not change are not copied")
+}
+
+func TestServerCharsetConversion(t *testing.T) {
+	th := &testHandler{
+		result: &sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Name: "name", Type: querypb.Type_VARCHAR, Charset: 45, ColumnLength: 400},
+				{Name: "data", Type: querypb.Type_VARBINARY, Charset: CharacterSetBinary},
+				{Name: "id", Type: querypb.Type_INT64, Charset: CharacterSetBinary},
+				{Name: "legacy", Type: querypb.Type_VARCHAR, Charset: 8, ColumnLength: 100},
+			},
+			Rows: [][]sqltypes.Value{{
+				sqltypes.NewVarChar("café"),
+				sqltypes.NewVarBinary("café"),
+				sqltypes.NewInt64(1),
+				sqltypes.NewVarChar("caf\xe9"),
+			}},
+		},
+	}
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err)
+	l.ConvertCharset = true
+	defer l.Close()
+	go l.Accept()
+	host, port := getHostPort(t, l.Addr())
+
+	ctx := context.Background()
+	for _, tcase := range []struct {
+		charset string
+		name    string
+		want    string
+		collID  uint32
+		length  uint32
+	}{
+		{charset: "latin1", name: "latin1", want: "caf\xe9", collID: 8, length: 100},
+		{charset: "47", name: "latin1", want: "caf\xe9", collID: 47, length: 100},
+		{charset: "utf8", name: "utf8", want: "café", collID: CharacterSetUtf8, length: 300},
+		{charset: "utf8mb4", name: "utf8mb4", want: "café", collID: 45, length: 400},
+	} {
+		t.Run(tcase.charset, func(t *testing.T) {
+			conn, err := Connect(ctx, &ConnParams{Host: host, Port: port, Uname: "user1", Pass: "password1", Charset: tcase.charset})
+			require.NoError(t, err)
+			defer conn.Close()
+			assert.Equal(t, tcase.name, conn.CharsetName())
+			assert.Equal(t, tcase.name, th.LastConn().CharsetName())
+
+			qr, err := conn.ExecuteFetch("select", 10, true)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.want, qr.Rows[0][0].ToString())
+			assert.Equal(t, "café", qr.Rows[0][1].ToString())
+			assert.Equal(t, "1", qr.Rows[0][2].ToString())
+			assert.Equal(t, tcase.collID, qr.Fields[0].Charset)
+			assert.Equal(t, tcase.length, qr.Fields[0].ColumnLength)
+			assert.EqualValues(t, CharacterSetBinary, qr.Fields[1].Charset)
+			// Only the utf8 columns are converted.
+			assert.Equal(t, "caf\xe9", qr.Rows[0][3].ToString())
+			assert.EqualValues(t, 8, qr.Fields[3].Charset)
+			assert.EqualValues(t, 100, qr.Fields[3].ColumnLength)
+		})
+	}
+
+	// The queries are converted from the character set of the client.
+	th.result = nil
+	conn, err := Connect(ctx, &ConnParams{Host: host, Port: port, Uname: "user1", Pass: "password1", Charset: "latin1"})
+	require.NoError(t, err)
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch(benchmarkQueryPrefix+"caf\xe9", 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, benchmarkQueryPrefix+"café", qr.Rows[0][0].ToString())
+
+	// The errors are converted too.
+	th.SetErr(NewSQLError(ERUnknownError, SSUnknownSQLState, "café"))
+	_, err = conn.ExecuteFetch("error", 10, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "caf\xe9")
+}
+
+func TestComStmtExecuteCharsetConversion(t *testing.T) {
+	c := &Conn{queryConverter: newCharsetConverterByName("latin1")}
+	prepareData := map[uint32]*PrepareData{
+		18: {
+			StatementID: 18,
+			ParamsCount: 2,
+			ParamsType:  make([]int32, 2),
+			BindVars:    make(map[string]*querypb.BindVariable),
+		},
+	}
+	// The strings are converted from latin1, but not the blobs.
+	data := []byte{
+		ComStmtExecute, 18, 0, 0, 0, 0, 1, 0, 0, 0,
+		0,                 // NULL bitmap
+		1, 253, 0, 252, 0, // types: VAR_STRING, BLOB
+		4, 'c', 'a', 'f', 0xe9,
+		4, 'c', 'a', 'f', 0xe9,
+	}
+	_, _, err := c.parseComStmtExecute(prepareData, data)
+	require.NoError(t, err)
+	assert.Equal(t, "café", string(prepareData[18].BindVars["v1"].Value))
+	assert.Equal(t, "caf\xe9", string(prepareData[18].BindVars["v2"].Value))
+}
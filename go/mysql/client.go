@@ -216,7 +216,7 @@ func parseCharacterSet(cs string) (uint8, error) {
 	}
 
 	// As a fallback, try to parse a number. So we support more values.
-	if i, err := strconv.ParseInt(cs, 10, 8); err == nil {
+	if i, err := strconv.ParseUint(cs, 10, 8); err == nil {
 		return uint8(i), nil
 	}
 
@@ -317,6 +317,9 @@ func (c *Conn) clientHandshake(characterSet uint8, params *ConnParams) error {
 	if err := c.writeHandshakeResponse41(capabilities, scrambledPassword, characterSet, params); err != nil {
 		return err
 	}
+	// The server uses the character set we asked for, not the one of its
+	// initial handshake.
+	c.CharacterSet = characterSet
 
 	// Read the server response.
 	if err := c.handleAuthResponse(params); err != nil {
@@ -447,7 +450,15 @@ func (c *Conn) parseInitialHandshakePacket(data []byte) (uint32, []byte, error)
 		}
 	}
 
-	// 10 reserved 0 bytes.
+	// 10 reserved 0 bytes, but for the MariaDB extended capability flags
+	// in the last 4 bytes.
+	if capabilities&CapabilityClientLongPassword == 0 {
+		mariaDBFlags, _, ok := readUint32(data, pos+6)
+		if !ok {
+			return 0, nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "parseInitialHandshakePacket: packet has no MariaDB capability flags")
+		}
+		c.MariaDBCapabilities = mariaDBFlags & MariaDBCapabilityClientExtendedMetadata
+	}
 	pos += 10
 
 	if capabilities&CapabilityClientSecureConnection != 0 {
@@ -506,6 +517,12 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 		capabilityFlags |= CapabilityClientConnectWithDB
 	}
 
+	// MariaDB only reads the extended capability flags if
+	// CapabilityClientLongPassword is not set.
+	if c.MariaDBCapabilities != 0 {
+		capabilityFlags &^= CapabilityClientLongPassword
+	}
+
 	data, pos := c.startEphemeralPacketWithHeader(length)
 
 	// Client capability flags.
@@ -515,7 +532,11 @@ func (c *Conn) writeSSLRequest(capabilities uint32, characterSet uint8, params *
 	pos = writeZeroes(data, pos, 4)
 
 	// Character set.
-	_ = writeByte(data, pos, characterSet)
+	pos = writeByte(data, pos, characterSet)
+
+	// 23 reserved bytes, all 0, but for the MariaDB extended
+	// capability flags.
+	_ = c.writeHandshakeReserved(data, pos)
 
 	// And send it as is.
 	if err := c.writeEphemeralPacket(); err != nil {
@@ -578,6 +599,10 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 		length++
 	}
 
+	if c.MariaDBCapabilities != 0 {
+		capabilityFlags &^= CapabilityClientLongPassword
+	}
+
 	data, pos := c.startEphemeralPacketWithHeader(length)
 
 	// Client capability flags.
@@ -589,8 +614,9 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 	// Character set.
 	pos = writeByte(data, pos, characterSet)
 
-	// 23 reserved bytes, all 0.
-	pos = writeZeroes(data, pos, 23)
+	// 23 reserved bytes, all 0, but for the MariaDB extended
+	// capability flags.
+	pos = c.writeHandshakeReserved(data, pos)
 
 	// Username
 	pos = writeNullString(data, pos, params.Uname)
@@ -625,6 +651,17 @@ func (c *Conn) writeHandshakeResponse41(capabilities uint32, scrambledPassword [
 	return nil
 }
 
+// writeHandshakeReserved writes the 23 reserved bytes of the handshake
+// response. They are all 0, but for the MariaDB extended capability flags
+// in the last 4 bytes.
+func (c *Conn) writeHandshakeReserved(data []byte, pos int) int {
+	if c.MariaDBCapabilities == 0 {
+		return writeZeroes(data, pos, 23)
+	}
+	pos = writeZeroes(data, pos, 19)
+	return writeUint32(data, pos, c.MariaDBCapabilities)
+}
+
 // handleAuthResponse parses server's response after client sends the password for authentication
 // and handles next steps for AuthSwitchRequestPacket and AuthMoreDataPacket.
 func (c *Conn) handleAuthResponse(params *ConnParams) error {
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"unicode/utf8"
+)
+
+// binaryCollation compares the bytes of the strings, like the binary
+// collation and the NO PAD utf8mb4_0900_bin.
+type binaryCollation struct {
+	id      ID
+	name    string
+	charset string
+}
+
+func (c *binaryCollation) ID() ID          { return c.id }
+func (c *binaryCollation) Name() string    { return c.name }
+func (c *binaryCollation) Charset() string { return c.charset }
+func (c *binaryCollation) IsBinary() bool  { return true }
+
+func (c *binaryCollation) Collate(left, right []byte) int {
+	return bytes.Compare(left, right)
+}
+
+func (c *binaryCollation) WeightString(dst, src []byte, numCodepoints int) []byte {
+	if numCodepoints <= 0 {
+		return append(dst, src...)
+	}
+	if c.charset == "binary" {
+		// BINARY(n) values are padded with zero bytes.
+		if len(src) >= numCodepoints {
+			return append(dst, src[:numCodepoints]...)
+		}
+		dst = append(dst, src...)
+		return append(dst, make([]byte, numCodepoints-len(src))...)
+	}
+	return append(dst, truncateCodepoints(src, numCodepoints)...)
+}
+
+// truncateCodepoints returns the first n characters of the UTF-8 string s.
+func truncateCodepoints(s []byte, n int) []byte {
+	for i := range s {
+		if !utf8.RuneStart(s[i]) {
+			continue
+		}
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// runeCollation compares strings one character at a time, using a weight
+// per character. Trailing spaces are ignored, like in all the PAD SPACE
+// collations of MySQL.
+type runeCollation struct {
+	id      ID
+	name    string
+	charset string
+	// weight returns the weight of a character.
+	weight func(r rune) uint32
+	// width is the number of bytes of each weight in the weight string.
+	width int
+}
+
+func (c *runeCollation) ID() ID          { return c.id }
+func (c *runeCollation) Name() string    { return c.name }
+func (c *runeCollation) Charset() string { return c.charset }
+func (c *runeCollation) IsBinary() bool  { return false }
+
+func (c *runeCollation) Collate(left, right []byte) int {
+	left, right = bytes.TrimRight(left, " "), bytes.TrimRight(right, " ")
+	for len(left) > 0 && len(right) > 0 {
+		lr, lsize := utf8.DecodeRune(left)
+		rr, rsize := utf8.DecodeRune(right)
+		if lw, rw := c.weight(lr), c.weight(rr); lw != rw {
+			return compareWeights(lw, rw)
+		}
+		left, right = left[lsize:], right[rsize:]
+	}
+	// The shortest string is padded with spaces.
+	switch {
+	case len(left) > 0:
+		return c.compareToSpaces(left)
+	case len(right) > 0:
+		return -c.compareToSpaces(right)
+	}
+	return 0
+}
+
+func (c *runeCollation) compareToSpaces(s []byte) int {
+	space := c.weight(' ')
+	for len(s) > 0 {
+		r, size := utf8.DecodeRune(s)
+		if w := c.weight(r); w != space {
+			return compareWeights(w, space)
+		}
+		s = s[size:]
+	}
+	return 0
+}
+
+func compareWeights(a, b uint32) int {
+	if a < b {
+		return -1
+	}
+	return 1
+}
+
+func (c *runeCollation) WeightString(dst, src []byte, numCodepoints int) []byte {
+	if numCodepoints <= 0 {
+		src = bytes.TrimRight(src, " ")
+	}
+	count := 0
+	for len(src) > 0 && (numCodepoints <= 0 || count < numCodepoints) {
+		r, size := utf8.DecodeRune(src)
+		dst = c.appendWeight(dst, c.weight(r))
+		src = src[size:]
+		count++
+	}
+	for ; count < numCodepoints; count++ {
+		dst = c.appendWeight(dst, c.weight(' '))
+	}
+	return dst
+}
+
+func (c *runeCollation) appendWeight(dst []byte, w uint32) []byte {
+	for shift := 8 * (c.width - 1); shift >= 0; shift -= 8 {
+		dst = append(dst, byte(w>>shift))
+	}
+	return dst
+}
+
+// codepointWeight is the weight of the _bin collations: the code point of the character.
+func codepointWeight(r rune) uint32 {
+	return uint32(r)
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package collations implements the comparison and weight string generation
+// of the binary, _bin and _general_ci MySQL 8.0 collations of the utf8mb4 (and
+// utf8mb3) character sets, so that strings can be compared in vtgate exactly
+// like MySQL does. The UCA collations, such as utf8mb4_0900_ai_ci, are not
+// implemented: their weights cannot be derived from another implementation of
+// the UCA, so their strings must be compared by MySQL.
+package collations
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ID is the numeric identifier of a collation, as used in the MySQL protocol
+// and in information_schema.COLLATIONS.
+type ID uint16
+
+// Unknown is the ID of an unspecified collation.
+const Unknown ID = 0
+
+// Collation implements a MySQL collation.
+type Collation interface {
+	// ID returns the MySQL identifier of the collation.
+	ID() ID
+	// Name returns the name of the collation, e.g. utf8mb4_0900_ai_ci.
+	Name() string
+	// Charset returns the name of the character set of the collation.
+	Charset() string
+	// IsBinary returns true if the collation compares the bytes of the strings.
+	IsBinary() bool
+
+	// Collate compares left and right. It returns a negative number if
+	// left sorts before right, a positive number if it sorts after it, and
+	// 0 if the two strings are equal in the collation.
+	Collate(left, right []byte) int
+
+	// WeightString appends the weight string of src to dst and returns
+	// the result. Two strings are equal in the collation if and only if
+	// their weight strings are equal, and weight strings sort byte-wise
+	// like the strings they were generated from.
+	//
+	// If numCodepoints is greater than 0, src is truncated to that many
+	// characters and, for collations that pad with spaces, padded up to
+	// it, like WEIGHT_STRING(src AS CHAR(numCodepoints)). Otherwise, the
+	// trailing spaces of src are ignored by the collations that pad with
+	// spaces, so only strings without characters sorting before the space
+	// are guaranteed to sort like their weight strings.
+	WeightString(dst, src []byte, numCodepoints int) []byte
+}
+
+var (
+	collationsByID   = map[ID]Collation{}
+	collationsByName = map[string]Collation{}
+)
+
+func register(c Collation, aliases ...string) {
+	if _, ok := collationsByID[c.ID()]; ok {
+		panic(fmt.Sprintf("collation %d (%s) registered twice", c.ID(), c.Name()))
+	}
+	collationsByID[c.ID()] = c
+	collationsByName[c.Name()] = c
+	for _, alias := range aliases {
+		collationsByName[alias] = c
+	}
+}
+
+// LookupByID returns the collation with the given ID, or nil if it is not supported.
+func LookupByID(id ID) Collation {
+	return collationsByID[id]
+}
+
+// LookupByName returns the collation with the given name, or nil if it is not supported.
+// The lookup is case insensitive, and accepts the utf8mb3 aliases of the utf8 collations.
+func LookupByName(name string) Collation {
+	return collationsByName[strings.ToLower(name)]
+}
+
+// All returns all the supported collations.
+func All() []Collation {
+	all := make([]Collation, 0, len(collationsByID))
+	for _, c := range collationsByID {
+		all = append(all, c)
+	}
+	return all
+}
+
+// String returns the name of the collation, if it is supported.
+func (id ID) String() string {
+	if c := LookupByID(id); c != nil {
+		return c.Name()
+	}
+	return fmt.Sprintf("collation(%d)", uint16(id))
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	c := LookupByName("UTF8MB4_GENERAL_CI")
+	require.NotNil(t, c)
+	assert.Equal(t, ID(45), c.ID())
+	assert.Equal(t, "utf8mb4", c.Charset())
+	assert.Equal(t, c, LookupByID(45))
+
+	assert.Equal(t, LookupByID(33), LookupByName("utf8mb3_general_ci"))
+	assert.Nil(t, LookupByName("latin7_estonian_cs"))
+	assert.Nil(t, LookupByName("utf8mb4_0900_ai_ci"))
+	assert.Nil(t, LookupByID(Unknown))
+	assert.Equal(t, "utf8mb4_bin", ID(46).String())
+	assert.Equal(t, "collation(1000)", ID(1000).String())
+
+	for _, c := range All() {
+		assert.Equal(t, c, LookupByName(c.Name()))
+	}
+}
+
+func sign(i int) int {
+	switch {
+	case i < 0:
+		return -1
+	case i > 0:
+		return 1
+	}
+	return 0
+}
+
+func TestCollate(t *testing.T) {
+	testcases := []struct {
+		collation   string
+		left, right string
+		want        int
+	}{
+		{"binary", "abc", "ABC", 1},
+		{"binary", "abc", "abc ", -1},
+		{"utf8mb4_0900_bin", "a", "a ", -1},
+		{"utf8mb4_bin", "abc", "abc  ", 0},
+		{"utf8mb4_bin", "abc", "ABC", 1},
+		{"utf8mb4_bin", "a\t", "a", -1},
+		{"utf8mb4_general_ci", "abc", "ABC ", 0},
+		{"utf8mb4_general_ci", "café", "CAFE", 0},
+		{"utf8mb4_general_ci", "straße", "STRASE", 0},
+		{"utf8mb4_general_ci", "a", "B", -1},
+		{"utf8mb4_general_ci", "😀", "😺", 0},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.collation+"/"+tc.left+"/"+tc.right, func(t *testing.T) {
+			c := LookupByName(tc.collation)
+			require.NotNil(t, c)
+			assert.Equal(t, tc.want, sign(c.Collate([]byte(tc.left), []byte(tc.right))))
+			assert.Equal(t, -tc.want, sign(c.Collate([]byte(tc.right), []byte(tc.left))))
+		})
+	}
+}
+
+func TestWeightStringOrdering(t *testing.T) {
+	inputs := []string{"", "a", "A", "á", "ab", "abc", "abc ", "ABC", "b", "B ", "é", "straße", "strasse", "z", "ö", "ñ", "日本", "😀", "a b"}
+	for _, c := range All() {
+		t.Run(c.Name(), func(t *testing.T) {
+			for _, left := range inputs {
+				for _, right := range inputs {
+					lw := c.WeightString(nil, []byte(left), 0)
+					rw := c.WeightString(nil, []byte(right), 0)
+					assert.Equal(t, sign(c.Collate([]byte(left), []byte(right))), bytes.Compare(lw, rw), "%q <=> %q", left, right)
+				}
+			}
+		})
+	}
+}
+
+func TestWeightStringPadding(t *testing.T) {
+	c := LookupByName("utf8mb4_bin")
+	assert.Equal(t, []byte{0, 0, 'a', 0, 0, ' ', 0, 0, ' '}, c.WeightString(nil, []byte("a"), 3))
+	assert.Equal(t, []byte{0, 0, 'a'}, c.WeightString(nil, []byte("abc"), 1))
+	assert.Equal(t, []byte{0, 0, 'a'}, c.WeightString(nil, []byte("a  "), 0))
+
+	c = LookupByName("utf8mb4_general_ci")
+	assert.Equal(t, []byte{0, 'A', 0, 'B'}, c.WeightString(nil, []byte("ab"), 0))
+	assert.Equal(t, []byte{0xFF, 0xFD}, c.WeightString(nil, []byte("😀"), 0))
+
+	c = LookupByName("binary")
+	assert.Equal(t, []byte{'a', 0, 0}, c.WeightString(nil, []byte("a"), 3))
+
+	// The weight string is appended to dst.
+	c = LookupByName("utf8mb4_general_ci")
+	assert.Equal(t, append([]byte("x"), c.WeightString(nil, []byte("abc"), 0)...), c.WeightString([]byte("x"), []byte("abc"), 0))
+	assert.Equal(t, c.WeightString(nil, []byte("ab"), 0), c.WeightString(nil, []byte("abc"), 2))
+}
+
+// TestWeightStringMySQL checks the weight strings against the ones returned by
+// MySQL 8.0 for HEX(WEIGHT_STRING(...)).
+func TestWeightStringMySQL(t *testing.T) {
+	testcases := []struct {
+		collation string
+		input     string
+		length    int
+		want      string
+	}{
+		{"utf8mb4_bin", "AB", 0, "000041000042"},
+		{"utf8mb4_bin", "ab", 0, "000061000062"},
+		{"utf8mb4_general_ci", "ab", 0, "00410042"},
+		{"utf8mb4_general_ci", "AB", 0, "00410042"},
+		{"binary", "AB", 0, "4142"},
+		{"binary", "AB", 4, "41420000"},
+	}
+	for _, tc := range testcases {
+		c := LookupByName(tc.collation)
+		require.NotNil(t, c)
+		assert.Equal(t, tc.want, fmt.Sprintf("%X", c.WeightString(nil, []byte(tc.input), tc.length)), "%s %q", tc.collation, tc.input)
+	}
+}
+
+func BenchmarkCollate(b *testing.B) {
+	left, right := []byte("The quick brown fox jumps over the lazy dog"), []byte("THE QUICK BROWN FOX JUMPS OVER THE LAZY DOG")
+	for _, name := range []string{"utf8mb4_bin", "utf8mb4_general_ci"} {
+		c := LookupByName(name)
+		b.Run(name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				c.Collate(left, right)
+			}
+		})
+	}
+}
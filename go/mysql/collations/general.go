@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+import (
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	generalWeightsOnce sync.Once
+	generalWeights     []uint16
+)
+
+// generalWeight is the weight of a character in utf8mb4_general_ci: the
+// upper case of its base letter. It does not implement any expansion or
+// contraction, and all the characters outside of the BMP are equal.
+func generalWeight(r rune) uint32 {
+	if r > 0xFFFF {
+		return 0xFFFD
+	}
+	generalWeightsOnce.Do(buildGeneralWeights)
+	return uint32(generalWeights[r])
+}
+
+func buildGeneralWeights() {
+	generalWeights = make([]uint16, 0x10000)
+	var buf [utf8.UTFMax]byte
+	for r := rune(0); r <= 0xFFFF; r++ {
+		base := r
+		// Accented latin letters sort like their base letter.
+		if r >= 0xC0 && r < 0x250 {
+			n := utf8.EncodeRune(buf[:], r)
+			if decomposed := norm.NFD.Bytes(buf[:n]); len(decomposed) > 0 {
+				if first, _ := utf8.DecodeRune(decomposed); first < 0x80 {
+					base = first
+				}
+			}
+		}
+		switch base {
+		case 'ß':
+			base = 'S'
+		default:
+			if upper := unicode.ToUpper(base); upper <= 0xFFFF {
+				base = upper
+			}
+		}
+		generalWeights[r] = uint16(base)
+	}
+}
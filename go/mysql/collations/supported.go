@@ -0,0 +1,27 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package collations
+
+func init() {
+	register(&binaryCollation{id: 63, name: "binary", charset: "binary"})
+	register(&binaryCollation{id: 309, name: "utf8mb4_0900_bin", charset: "utf8mb4"})
+
+	register(&runeCollation{id: 46, name: "utf8mb4_bin", charset: "utf8mb4", weight: codepointWeight, width: 3})
+	register(&runeCollation{id: 83, name: "utf8_bin", charset: "utf8", weight: codepointWeight, width: 3}, "utf8mb3_bin")
+	register(&runeCollation{id: 45, name: "utf8mb4_general_ci", charset: "utf8mb4", weight: generalWeight, width: 2})
+	register(&runeCollation{id: 33, name: "utf8_general_ci", charset: "utf8", weight: generalWeight, width: 2}, "utf8mb3_general_ci")
+}
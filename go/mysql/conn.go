@@ -86,6 +86,9 @@ type Conn struct {
 	// fields, this is set to an empty array (but not nil).
 	fields []*querypb.Field
 
+	// streamRows allocates the rows returned by FetchNext.
+	streamRows sqltypes.RowAllocator
+
 	// salt is sent by the server during initial handshake to be used for authentication
 	salt []byte
 
@@ -160,6 +163,13 @@ type Conn struct {
 	// and CapabilityClientFoundRows.
 	Capabilities uint32
 
+	// MariaDBCapabilities is the set of MariaDB extended capabilities
+	// this connection is using. It is set during the initial handshake
+	// with MariaDB clients and servers.
+	//
+	// It is only used for MariaDBCapabilityClientExtendedMetadata.
+	MariaDBCapabilities uint32
+
 	// closed is set to true when Close() is called on the connection.
 	closed sync2.AtomicBool
 
@@ -185,6 +195,21 @@ type Conn struct {
 	// See the values in constants.go.
 	CharacterSet uint8
 
+	// queryConverter converts the queries and the bind variables sent by
+	// the client from its character set, and resultsConverter the results
+	// and the errors sent to it to the character set it expects. They are
+	// only set on the server side, if the Listener converts them and the
+	// character sets are not utf8mb4.
+	queryConverter   *charsetConverter
+	resultsConverter *charsetConverter
+	// clientCharset and resultsCharset are the names of the character
+	// sets set with SetCharacterSets, "" for the one of the handshake.
+	clientCharset  string
+	resultsCharset string
+	// convertedColumns are the columns of the current result set whose
+	// values are converted by resultsConverter.
+	convertedColumns []bool
+
 	// Packet encoding variables.
 	sequence uint8
 }
@@ -809,6 +834,9 @@ func (c *Conn) writeErrorPacketFromErrorAndLog(err error) bool {
 // This method returns a generic error, not a SQLError.
 func (c *Conn) writeErrorPacket(errorCode uint16, sqlState string, format string, args ...interface{}) error {
 	errorMessage := fmt.Sprintf(format, args...)
+	if c.resultsConverter != nil {
+		errorMessage = c.resultsConverter.convertString(errorMessage)
+	}
 	length := 1 + 2 + 1 + 5 + len(errorMessage)
 	data, pos := c.startEphemeralPacketWithHeader(length)
 	pos = writeByte(data, pos, ErrPacket)
@@ -1015,6 +1043,15 @@ func (c *Conn) handleComStmtExecute(handler Handler, data []byte) (kontinue bool
 			return io.EOF
 		}
 
+		// See execQuery.
+		if qr.IsMoreResultsExists() {
+			if fieldSent {
+				// Failsafe: Unreachable if server is well-behaved.
+				return io.EOF
+			}
+			return c.writeMoreResults(qr, handler.WarningCount(c), true)
+		}
+
 		if !fieldSent {
 			fieldSent = true
 
@@ -1082,6 +1119,9 @@ func (c *Conn) handleComPrepare(handler Handler, data []byte) (kontinue bool) {
 
 	query := c.parseComPrepare(data)
 	c.recycleReadPacket()
+	if c.queryConverter != nil {
+		query = c.queryConverter.decodeString(query)
+	}
 
 	var queries []string
 	if c.Capabilities&CapabilityClientMultiStatements != 0 {
@@ -1210,6 +1250,9 @@ func (c *Conn) handleComQuery(handler Handler, data []byte) (kontinue bool) {
 	queryStart := time.Now()
 	query := c.parseComQuery(data)
 	c.recycleReadPacket()
+	if c.queryConverter != nil {
+		query = c.queryConverter.decodeString(query)
+	}
 
 	var queries []string
 	var err error
@@ -1257,6 +1300,18 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) execResult {
 			return io.EOF
 		}
 
+		// A result with SERVER_MORE_RESULTS_EXISTS is followed by another
+		// result of the same statement, like the result sets of a CALL
+		// followed by its final OK packet. It is sent complete, and the
+		// next callback starts the next result.
+		if qr.IsMoreResultsExists() {
+			if callbackCalled {
+				// Failsafe: Unreachable if server is well-behaved.
+				return io.EOF
+			}
+			return c.writeMoreResults(qr, handler.WarningCount(c), false)
+		}
+
 		if !callbackCalled {
 			callbackCalled = true
 
@@ -1318,6 +1373,35 @@ func (c *Conn) execQuery(query string, handler Handler, more bool) execResult {
 	return execSuccess
 }
 
+// writeMoreResults writes a complete result followed by more results of
+// the same statement: an OK packet if it has no fields, or else its
+// fields, rows and end packet. The status flags of the result, like
+// SERVER_MORE_RESULTS_EXISTS and SERVER_PS_OUT_PARAMS, are sent with it.
+// The rows are written in the binary protocol if binary is set.
+func (c *Conn) writeMoreResults(qr *sqltypes.Result, warnings uint16, binary bool) error {
+	flags := c.StatusFlags | qr.StatusFlags
+	if len(qr.Fields) == 0 {
+		return c.writeOKPacket(&PacketOK{
+			affectedRows:     qr.RowsAffected,
+			lastInsertID:     qr.InsertID,
+			statusFlags:      flags,
+			warnings:         warnings,
+			sessionStateData: qr.SessionStateChanges,
+		})
+	}
+	if err := c.writeFields(qr); err != nil {
+		return err
+	}
+	writeRows := c.writeRows
+	if binary {
+		writeRows = c.writeBinaryRows
+	}
+	if err := writeRows(qr); err != nil {
+		return err
+	}
+	return c.writeEndResultFlags(flags, 0, 0, warnings)
+}
+
 //
 // Packet parsing methods, for generic packets.
 //
@@ -1468,19 +1552,23 @@ func ParseErrorPacket(data []byte) error {
 		return NewSQLError(CRUnknownError, SSUnknownSQLState, "invalid error packet code: %v", data)
 	}
 
-	// '#' marker of the SQL state is 1 byte. Ignored.
-	pos++
-
-	// SQL state is 5 bytes
-	sqlState, pos, ok := readBytesCopy(data, pos, 5)
-	if !ok {
-		return NewSQLError(CRUnknownError, SSUnknownSQLState, "invalid error packet sqlState: %v", data)
+	// '#' marker of the SQL state is 1 byte, followed by the 5 bytes of
+	// the SQL state. MariaDB does not send them for the errors that have
+	// no SQL state, e.g. the ones raised before the handshake.
+	sqlState := SSUnknownSQLState
+	if pos < len(data) && data[pos] == '#' {
+		var state []byte
+		state, pos, ok = readBytesCopy(data, pos+1, 5)
+		if !ok {
+			return NewSQLError(CRUnknownError, SSUnknownSQLState, "invalid error packet sqlState: %v", data)
+		}
+		sqlState = string(state)
 	}
 
 	// Human readable error message is the rest.
 	msg := string(data[pos:])
 
-	return NewSQLError(int(code), string(sqlState), "%v", msg)
+	return NewSQLError(int(code), sqlState, "%v", msg)
 }
 
 // GetTLSClientCerts gets TLS certificates.
@@ -318,6 +318,10 @@ func TestBasicPackets(t *testing.T) {
 	err = ParseErrorPacket(data)
 	utils.MustMatch(t, err, NewSQLError(ERAccessDeniedError, SSAccessDeniedError, "access denied"), "")
 
+	// MariaDB error packets can have no SQL state.
+	err = ParseErrorPacket([]byte{ErrPacket, 0x10, 0x04, 'T', 'o', 'o', ' ', 'm', 'a', 'n', 'y'})
+	utils.MustMatch(t, err, NewSQLError(ERConCount, SSUnknownSQLState, "Too many"), "")
+
 	// Write EOF packet, read it, compare first byte. Payload is always ignored.
 	err = sConn.writeEOFPacket(0x8912, 0xabba)
 	require.NoError(err)
@@ -141,6 +141,35 @@ const (
 	CapabilityClientDeprecateEOF = 1 << 24
 )
 
+// MariaDB extended capability flags. They are the upper 32 bits of the
+// 64 bits MariaDB capability flags. MariaDB servers send them in the last
+// 4 bytes of the reserved bytes of the initial handshake, and clients in the
+// last 4 bytes of the reserved bytes of their handshake response, if
+// CapabilityClientLongPassword (CLIENT_MYSQL for MariaDB) is not set.
+// Originally found in include/mysql_com.h of MariaDB.
+const (
+	// MARIADB_CLIENT_PROGRESS 1 << 32
+	// Progress reports in error packets. Not supported.
+
+	// MARIADB_CLIENT_COM_MULTI 1 << 33
+	// Not supported.
+
+	// MARIADB_CLIENT_STMT_BULK_OPERATIONS 1 << 34
+	// Not supported.
+
+	// MariaDBCapabilityClientExtendedMetadata is MARIADB_CLIENT_EXTENDED_METADATA (1 << 35).
+	// The column definitions carry the type name and the format of the
+	// columns, e.g. the geometry type of a point column or the json
+	// format of a json column.
+	MariaDBCapabilityClientExtendedMetadata = 1 << 3
+)
+
+// Types of the MariaDB extended metadata of a column definition.
+const (
+	mariaDBMetadataTypeName = 0
+	mariaDBMetadataFormat   = 1
+)
+
 // Status flags. They are returned by the server in a few cases.
 // Originally found in include/mysql/mysql_com.h
 // See http://dev.mysql.com/doc/internals/en/status-flags.html
@@ -516,6 +545,7 @@ const (
 	ERDataTooLong                  = 1406
 	ERForbidSchemaChange           = 1450
 	ERDataOutOfRange               = 1690
+	ERQueryTimeout                 = 3024
 )
 
 // Sql states for errors.
@@ -36,6 +36,7 @@ import (
 
 	"vitess.io/vitess/go/vt/dbconfigs"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/sqlparser"
 )
 
 const appendEntry = -1
@@ -113,12 +114,41 @@ type DB struct {
 	// should respond with the last entry from the list.
 	infinite bool
 
+	// This next set of fields is used when ExpectQueriesInOrder() was called.
+	// Results are still taken from AddQuery() and AddQueryPattern(), but the
+	// queries must arrive in the given sequence.
+
+	// expectedSequence is the list of queries expected in order.
+	expectedSequence []string
+	// expectedSequenceIndex is the index of the next query in expectedSequence.
+	expectedSequenceIndex int
+
+	// faults maps tolower(query) to the faults injected for it.
+	// It applies to both the ordered and the unordered mode.
+	faults map[string]*queryFault
+
+	// openTransactions tracks which connections have an open transaction,
+	// based on the BEGIN, COMMIT and ROLLBACK statements they executed.
+	// The key for the map is the value of mysql.Conn.ConnectionID.
+	openTransactions map[uint32]bool
+	// requireTransactionForDML, if set, makes DMLs fail when they are not
+	// executed inside a transaction.
+	requireTransactionForDML bool
+
 	// connections tracks all open connections.
 	// The key for the map is the value of mysql.Conn.ConnectionID.
 	connections map[uint32]*mysql.Conn
 
-	// queryPatternUserCallback stores optional callbacks when a query with a pattern is called
-	queryPatternUserCallback map[*regexp.Regexp]func(string)
+	// procedures maps tolower(name) to the stored procedures answering
+	// the CALL statements. See AddProcedure().
+	procedures map[string]*Procedure
+	// userVars tracks the user variables assigned by the OUT and INOUT
+	// parameters of the procedures, by connection and tolower(name).
+	userVars map[uint32]map[string]sqltypes.Value
+	// preparedCalls tracks the CALL statements being executed as prepared
+	// statements, by connection, to return their OUT and INOUT parameters
+	// bound to placeholders.
+	preparedCalls map[uint32]*sqlparser.CallProc
 }
 
 // QueryHandler is the interface used by the DB to simulate executed queries
@@ -137,6 +167,56 @@ type exprResult struct {
 	expr   *regexp.Regexp
 	result *sqltypes.Result
 	err    string
+	// callback is an optional user callback invoked with the matched query.
+	callback func(string)
+}
+
+// queryFault describes the faults injected for a query.
+// See SetQueryDelay(), SetQueryFailEvery() and SetQueryDropAfterRows().
+type queryFault struct {
+	// delay is slept before the query is answered.
+	delay time.Duration
+	// failEvery makes every Nth execution return failErr, if > 0.
+	failEvery int
+	failErr   error
+	// dropAfterRows, if >= 0, makes the server send that many rows and then
+	// abort the stream, which the client sees as a lost connection.
+	dropAfterRows int
+	// executions counts how many times the query was seen since the
+	// last call to SetQueryFailEvery().
+	executions int
+}
+
+// Procedure is the answer of the fake to the CALL statements of a stored
+// procedure. Like MySQL, the fake answers a CALL with the result sets of
+// the procedure followed by its final result, in as many results sent with
+// the SERVER_MORE_RESULTS_EXISTS flag.
+type Procedure struct {
+	// ResultSets are the result sets returned by the procedure, in order.
+	ResultSets []*sqltypes.Result
+	// Result is the final result of the CALL, with the rows affected by
+	// the last statement of the procedure. An empty result is sent if nil.
+	Result *sqltypes.Result
+	// OutParams are the OUT and INOUT parameters of the procedure, with
+	// the values it assigns to them.
+	OutParams []*OutParam
+}
+
+// OutParam is an OUT or INOUT parameter of a Procedure.
+//
+// The argument passed for it to a CALL must be a user variable, e.g.
+// "call p(1, @out)", which is assigned the value and can then be read with
+// "select @out". If the CALL is a prepared statement, the argument can also
+// be a placeholder, e.g. "call p(1, ?)": like MySQL, the fake then returns
+// the values of those parameters in a result set of their own, sent with
+// the SERVER_PS_OUT_PARAMS flag before the final result.
+type OutParam struct {
+	// Index is the position of the parameter, starting at 0.
+	Index int
+	// Name is the name of the parameter, used as the column name of the
+	// result set of a prepared statement.
+	Name  string
+	Value sqltypes.Value
 }
 
 // ExpectedExecuteFetch defines for an expected query the to be faked output.
@@ -161,14 +241,18 @@ func New(t testing.TB) *DB {
 
 	// Create our DB.
 	db := &DB{
-		t:                        t,
-		socketFile:               socketFile,
-		name:                     "fakesqldb",
-		data:                     make(map[string]*ExpectedResult),
-		rejectedData:             make(map[string]error),
-		queryCalled:              make(map[string]int),
-		connections:              make(map[uint32]*mysql.Conn),
-		queryPatternUserCallback: make(map[*regexp.Regexp]func(string)),
+		t:                t,
+		socketFile:       socketFile,
+		name:             "fakesqldb",
+		data:             make(map[string]*ExpectedResult),
+		rejectedData:     make(map[string]error),
+		queryCalled:      make(map[string]int),
+		faults:           make(map[string]*queryFault),
+		openTransactions: make(map[uint32]bool),
+		connections:      make(map[uint32]*mysql.Conn),
+		procedures:       make(map[string]*Procedure),
+		userVars:         make(map[uint32]map[string]sqltypes.Value),
+		preparedCalls:    make(map[uint32]*sqlparser.CallProc),
 	}
 
 	db.Handler = db
@@ -318,9 +402,15 @@ func (db *DB) ConnectionClosed(c *mysql.Conn) {
 		panic(fmt.Errorf("BUG: Cannot delete connection from list of open connections because it is not registered. ID: %v Conn: %v", c.ConnectionID, c))
 	}
 	delete(db.connections, c.ConnectionID)
+	// MySQL rolls back the open transaction of a closed connection.
+	delete(db.openTransactions, c.ConnectionID)
+	delete(db.userVars, c.ConnectionID)
 }
 
 // ComQuery is part of the mysql.Handler interface.
+// The statements of a multi-statement query are passed one at a time, and
+// each of them is answered with its own result, or several for the CALL
+// statements of the procedures added with AddProcedure().
 func (db *DB) ComQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
 	return db.Handler.HandleQuery(c, query, callback)
 }
@@ -336,6 +426,30 @@ func (db *DB) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.R
 		return callback(&sqltypes.Result{})
 	}
 
+	delay, err, dropAfterRows := db.nextFault(query)
+	if delay > 0 {
+		time.Sleep(delay)
+	}
+	if err != nil {
+		return err
+	}
+	if dropAfterRows >= 0 {
+		callback = dropAfterRowsCallback(callback, dropAfterRows)
+	}
+
+	stmtType := sqlparser.Preview(query)
+	if err := db.checkTransactionState(c, stmtType); err != nil {
+		return err
+	}
+	if err := db.handleQuery(c, query, callback); err != nil {
+		return err
+	}
+	db.updateTransactionState(c, stmtType)
+	return nil
+}
+
+// handleQuery looks up the result for query and sends it with callback.
+func (db *DB) handleQuery(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) error {
 	if db.orderMatters {
 		result, err := db.comQueryOrdered(query)
 		if err != nil {
@@ -371,6 +485,8 @@ func (db *DB) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.R
 		return nil
 	}
 
+	db.checkSequenceLocked(query)
+
 	// check if we should reject it.
 	if err, ok := db.rejectedData[key]; ok {
 		return err
@@ -385,12 +501,17 @@ func (db *DB) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.R
 		return callback(result.Result)
 	}
 
+	// Check the procedures from AddProcedure(), and the user variables
+	// they assigned.
+	if handled, err := db.handleProcedureLocked(c, query, callback); handled {
+		return err
+	}
+
 	// Check query patterns from AddQueryPattern().
 	for _, pat := range db.patternData {
 		if pat.expr.MatchString(query) {
-			userCallback, ok := db.queryPatternUserCallback[pat.expr]
-			if ok {
-				userCallback(query)
+			if pat.callback != nil {
+				pat.callback(query)
 			}
 			if pat.err != "" {
 				return fmt.Errorf(pat.err)
@@ -400,9 +521,256 @@ func (db *DB) HandleQuery(c *mysql.Conn, query string, callback func(*sqltypes.R
 	}
 
 	// Nothing matched.
+	if db.expectedSequence != nil {
+		db.t.Errorf("%v: got unmatched query: %v", db.name, query)
+	}
 	return fmt.Errorf("query: '%s' is not supported on %v", query, db.name)
 }
 
+// checkSequenceLocked verifies that query is the next one expected by
+// ExpectQueriesInOrder(). It is a no-op if no sequence was set.
+// db.mu must be held.
+func (db *DB) checkSequenceLocked(query string) {
+	if db.expectedSequence == nil {
+		return
+	}
+	index := db.expectedSequenceIndex
+	if index >= len(db.expectedSequence) {
+		db.t.Errorf("%v: got unexpected query after the expected sequence (index=%v): %v", db.name, index, query)
+		return
+	}
+	db.expectedSequenceIndex++
+	if !queryMatches(db.expectedSequence[index], query) {
+		db.t.Errorf("%v: got query out of order (index=%v): %v != %v", db.name, index, query, db.expectedSequence[index])
+	}
+}
+
+// queryMatches returns true if query matches expected. A trailing '*' in
+// expected matches any suffix. The comparison is case-insensitive.
+func queryMatches(expected, query string) bool {
+	expected = strings.ToLower(expected)
+	query = strings.ToLower(query)
+	if strings.HasSuffix(expected, "*") {
+		return strings.HasPrefix(query, expected[0:len(expected)-1])
+	}
+	return query == expected
+}
+
+// checkTransactionState returns an error if a DML is executed outside of a
+// transaction while RequireTransactionForDML() is on.
+func (db *DB) checkTransactionState(c *mysql.Conn, stmtType sqlparser.StatementType) error {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if !db.requireTransactionForDML || !isDML(stmtType) {
+		return nil
+	}
+	if !db.openTransactions[c.ConnectionID] {
+		return mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "%v: DML executed outside of a transaction", db.name)
+	}
+	return nil
+}
+
+// updateTransactionState records the transaction boundaries of a
+// successfully executed statement.
+func (db *DB) updateTransactionState(c *mysql.Conn, stmtType sqlparser.StatementType) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	switch stmtType {
+	case sqlparser.StmtBegin:
+		db.openTransactions[c.ConnectionID] = true
+	case sqlparser.StmtCommit, sqlparser.StmtRollback:
+		delete(db.openTransactions, c.ConnectionID)
+	}
+}
+
+func isDML(stmtType sqlparser.StatementType) bool {
+	switch stmtType {
+	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+		return true
+	}
+	return false
+}
+
+// nextFault records an execution of query and returns the faults to inject
+// for it. dropAfterRows is -1 if the stream should not be aborted.
+func (db *DB) nextFault(query string) (delay time.Duration, err error, dropAfterRows int) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	fault, ok := db.faults[strings.ToLower(query)]
+	if !ok {
+		return 0, nil, -1
+	}
+	fault.executions++
+	if fault.failEvery > 0 && fault.executions%fault.failEvery == 0 {
+		return fault.delay, fault.failErr, -1
+	}
+	return fault.delay, nil, fault.dropAfterRows
+}
+
+// dropAfterRowsCallback returns a callback which only sends the fields and
+// the first n rows of a result, and then fails so that the stream is
+// aborted mid-way.
+func dropAfterRowsCallback(callback func(*sqltypes.Result) error, n int) func(*sqltypes.Result) error {
+	return func(qr *sqltypes.Result) error {
+		if len(qr.Fields) == 0 {
+			return errors.New("simulated connection drop")
+		}
+		partial := *qr
+		if n < len(partial.Rows) {
+			partial.Rows = partial.Rows[:n]
+		}
+		if err := callback(&partial); err != nil {
+			return err
+		}
+		return errors.New("simulated connection drop in the middle of a result set")
+	}
+}
+
+// isCall returns whether query is a CALL statement.
+func isCall(query string) bool {
+	fields := strings.Fields(sqlparser.StripLeadingComments(query))
+	return len(fields) > 0 && strings.EqualFold(fields[0], "call")
+}
+
+// isSelectUserVars returns whether query may be a SELECT of user variables.
+func isSelectUserVars(query string) bool {
+	fields := strings.Fields(sqlparser.StripLeadingComments(query))
+	return len(fields) > 1 && strings.EqualFold(fields[0], "select") && strings.HasPrefix(fields[1], "@") && !strings.HasPrefix(fields[1], "@@")
+}
+
+// handleProcedureLocked answers query if it is the CALL of a procedure
+// added with AddProcedure(), or a SELECT of the user variables assigned by
+// the procedures. handled is false if query is neither.
+// db.mu must be held.
+func (db *DB) handleProcedureLocked(c *mysql.Conn, query string, callback func(*sqltypes.Result) error) (handled bool, err error) {
+	switch {
+	case isCall(query):
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return false, nil
+		}
+		call := stmt.(*sqlparser.CallProc)
+		proc, ok := db.procedures[strings.ToLower(sqlparser.String(call.Name))]
+		if !ok {
+			return false, nil
+		}
+		return true, db.callProcedureLocked(c, call, proc, callback)
+	case isSelectUserVars(query):
+		stmt, err := sqlparser.Parse(query)
+		if err != nil {
+			return false, nil
+		}
+		result, ok := db.selectUserVarsLocked(c, stmt)
+		if !ok {
+			return false, nil
+		}
+		return true, callback(result)
+	}
+	return false, nil
+}
+
+// callProcedureLocked answers the CALL of a procedure, assigning its OUT
+// and INOUT parameters.
+// db.mu must be held.
+func (db *DB) callProcedureLocked(c *mysql.Conn, call *sqlparser.CallProc, proc *Procedure, callback func(*sqltypes.Result) error) error {
+	// The placeholders of a prepared CALL, which the arguments of call
+	// were expanded from.
+	var placeholders sqlparser.Exprs
+	if prepared, ok := db.preparedCalls[c.ConnectionID]; ok && len(prepared.Params) == len(call.Params) {
+		placeholders = prepared.Params
+	}
+
+	vars := make(map[string]sqltypes.Value)
+	outParams := &sqltypes.Result{}
+	for _, param := range proc.OutParams {
+		if param.Index >= len(call.Params) {
+			return mysql.NewSQLError(mysql.ERWrongParamCountToProcedure, mysql.SSUnknownSQLState, "Incorrect parameter count to procedure '%s'", sqlparser.String(call.Name))
+		}
+		if placeholders != nil {
+			if _, ok := placeholders[param.Index].(sqlparser.Argument); ok {
+				outParams.Fields = append(outParams.Fields, &querypb.Field{Name: param.Name, Type: param.Value.Type()})
+				continue
+			}
+		}
+		col, ok := call.Params[param.Index].(*sqlparser.ColName)
+		if !ok || !col.Qualifier.IsEmpty() || col.Name.AtCount() != sqlparser.SingleAt {
+			return mysql.NewSQLError(mysql.ErSPNotVarArg, mysql.SSUnknownSQLState, "OUT or INOUT argument %d for routine %s is not a variable or NEW pseudo-variable in BEFORE trigger", param.Index+1, sqlparser.String(call.Name))
+		}
+		vars[col.Name.Lowered()] = param.Value
+	}
+	if len(outParams.Fields) > 0 {
+		row := make([]sqltypes.Value, 0, len(outParams.Fields))
+		for _, param := range proc.OutParams {
+			if _, ok := placeholders[param.Index].(sqlparser.Argument); ok {
+				row = append(row, param.Value)
+			}
+		}
+		outParams.Rows = [][]sqltypes.Value{row}
+		outParams.StatusFlags = mysql.ServerMoreResultsExists | mysql.ServerPsOutParams
+	}
+
+	for _, rs := range proc.ResultSets {
+		result := *rs
+		result.StatusFlags |= mysql.ServerMoreResultsExists
+		if err := callback(&result); err != nil {
+			return err
+		}
+	}
+	if len(outParams.Fields) > 0 {
+		if err := callback(outParams); err != nil {
+			return err
+		}
+	}
+	for name, value := range vars {
+		if db.userVars[c.ConnectionID] == nil {
+			db.userVars[c.ConnectionID] = make(map[string]sqltypes.Value)
+		}
+		db.userVars[c.ConnectionID][name] = value
+	}
+	if proc.Result == nil {
+		return callback(&sqltypes.Result{})
+	}
+	return callback(proc.Result)
+}
+
+// selectUserVarsLocked answers a SELECT of user variables from dual, if
+// all of them were assigned by the procedures.
+// db.mu must be held.
+func (db *DB) selectUserVarsLocked(c *mysql.Conn, stmt sqlparser.Statement) (*sqltypes.Result, bool) {
+	sel, ok := stmt.(*sqlparser.Select)
+	if !ok || sel.Where != nil || len(sel.From) != 1 {
+		return nil, false
+	}
+	if from, ok := sel.From[0].(*sqlparser.AliasedTableExpr); !ok || sqlparser.String(from) != "dual" {
+		return nil, false
+	}
+	result := &sqltypes.Result{Rows: [][]sqltypes.Value{nil}}
+	for _, selectExpr := range sel.SelectExprs {
+		expr, ok := selectExpr.(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, false
+		}
+		col, ok := expr.Expr.(*sqlparser.ColName)
+		if !ok || !col.Qualifier.IsEmpty() || col.Name.AtCount() != sqlparser.SingleAt {
+			return nil, false
+		}
+		value, ok := db.userVars[c.ConnectionID][col.Name.Lowered()]
+		if !ok {
+			return nil, false
+		}
+		name := col.Name.String()
+		if !expr.As.IsEmpty() {
+			name = expr.As.String()
+		}
+		result.Fields = append(result.Fields, &querypb.Field{Name: name, Type: value.Type()})
+		result.Rows[0] = append(result.Rows[0], value)
+	}
+	return result, true
+}
+
 func (db *DB) comQueryOrdered(query string) (*sqltypes.Result, error) {
 	db.mu.Lock()
 	defer db.mu.Unlock()
@@ -449,18 +817,75 @@ func (db *DB) comQueryOrdered(query string) (*sqltypes.Result, error) {
 }
 
 // ComPrepare is part of the mysql.Handler interface.
+// It returns the fields of the result registered for the query, if any.
+// The query is not counted as executed.
 func (db *DB) ComPrepare(c *mysql.Conn, query string, bindVars map[string]*querypb.BindVariable) ([]*querypb.Field, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.orderMatters {
+		if db.expectedExecuteFetchIndex < len(db.expectedExecuteFetch) {
+			if qr := db.expectedExecuteFetch[db.expectedExecuteFetchIndex].QueryResult; qr != nil {
+				return qr.Fields, nil
+			}
+		}
+		return nil, nil
+	}
+	if result, ok := db.data[strings.ToLower(query)]; ok {
+		return result.Fields, nil
+	}
+	for _, pat := range db.patternData {
+		if pat.expr.MatchString(query) && pat.result != nil {
+			return pat.result.Fields, nil
+		}
+	}
 	return nil, nil
 }
 
 // ComStmtExecute is part of the mysql.Handler interface.
+// The prepared statement is expanded with its bind variables, and the
+// resulting query is handled like a regular query. Register the expanded
+// query in the format of sqlparser.String(), e.g.
+// "select id, `name` from t1 where id = 1", to answer it.
+// The OUT and INOUT parameters of a prepared CALL bound to placeholders are
+// returned in a result set, see OutParam.
 func (db *DB) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareData, callback func(*sqltypes.Result) error) error {
-	return nil
+	query, err := expandPreparedStatement(prepare)
+	if err != nil {
+		return err
+	}
+	if isCall(prepare.PrepareStmt) {
+		if stmt, err := sqlparser.Parse(prepare.PrepareStmt); err == nil {
+			db.mu.Lock()
+			db.preparedCalls[c.ConnectionID] = stmt.(*sqlparser.CallProc)
+			db.mu.Unlock()
+			defer func() {
+				db.mu.Lock()
+				delete(db.preparedCalls, c.ConnectionID)
+				db.mu.Unlock()
+			}()
+		}
+	}
+	return db.Handler.HandleQuery(c, query, callback)
+}
+
+// expandPreparedStatement substitutes the bind variables of a prepared
+// statement into its query.
+func expandPreparedStatement(prepare *mysql.PrepareData) (string, error) {
+	stmt, err := sqlparser.Parse(prepare.PrepareStmt)
+	if err != nil {
+		return "", err
+	}
+	return sqlparser.NewParsedQuery(stmt).GenerateQuery(prepare.BindVars, nil)
 }
 
 // ComResetConnection is part of the mysql.Handler interface.
+// Like MySQL, it clears the user variables of the connection.
 func (db *DB) ComResetConnection(c *mysql.Conn) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
 
+	delete(db.userVars, c.ConnectionID)
 }
 
 //
@@ -483,6 +908,15 @@ func (db *DB) AddQuery(query string, expectedResult *sqltypes.Result) *ExpectedR
 	return r
 }
 
+// AddProcedure adds a stored procedure answering the CALL statements of
+// name. The procedures are only used when the query order doesn't matter,
+// and after the queries added with AddQuery().
+func (db *DB) AddProcedure(name string, proc *Procedure) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.procedures[strings.ToLower(name)] = proc
+}
+
 // SetBeforeFunc sets the BeforeFunc field for the previously registered "query".
 func (db *DB) SetBeforeFunc(query string, f func()) {
 	db.mu.Lock()
@@ -521,14 +955,22 @@ func (db *DB) RejectQueryPattern(queryPattern, error string) {
 
 // ClearQueryPattern removes all query patterns set up
 func (db *DB) ClearQueryPattern() {
+	db.mu.Lock()
+	defer db.mu.Unlock()
 	db.patternData = nil
 }
 
 // AddQueryPatternWithCallback is similar to AddQueryPattern: in addition it calls the provided callback function
 // The callback can be used to set user counters/variables for testing specific usecases
 func (db *DB) AddQueryPatternWithCallback(queryPattern string, expectedResult *sqltypes.Result, callback func(string)) {
-	db.AddQueryPattern(queryPattern, expectedResult)
-	db.queryPatternUserCallback[db.patternData[len(db.patternData)-1].expr] = callback
+	if len(expectedResult.Rows) > 0 && len(expectedResult.Fields) == 0 {
+		panic(fmt.Errorf("please add Fields to this Result so it's valid: %v", queryPattern))
+	}
+	expr := regexp.MustCompile("(?is)^" + queryPattern + "$")
+	result := *expectedResult
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.patternData = append(db.patternData, exprResult{expr: expr, result: &result, callback: callback})
 }
 
 // DeleteQuery deletes query from the fake DB.
@@ -603,6 +1045,86 @@ func (db *DB) EnableShouldClose() {
 	db.shouldClose = true
 }
 
+//
+// Methods to simulate and inspect the transaction state.
+//
+
+// RequireTransactionForDML makes DMLs fail unless they are executed in a
+// transaction opened with BEGIN on the same connection.
+func (db *DB) RequireTransactionForDML(require bool) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.requireTransactionForDML = require
+}
+
+// OpenTransactionCount returns the number of connections which have an
+// open transaction.
+func (db *DB) OpenTransactionCount() int {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return len(db.openTransactions)
+}
+
+// InTransaction returns true if the connection with the given id has an
+// open transaction.
+func (db *DB) InTransaction(connectionID uint32) bool {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	return db.openTransactions[connectionID]
+}
+
+//
+// Methods to inject faults for specific queries.
+//
+
+// faultLocked returns the queryFault for query, creating it if needed.
+// db.mu must be held.
+func (db *DB) faultLocked(query string) *queryFault {
+	key := strings.ToLower(query)
+	fault, ok := db.faults[key]
+	if !ok {
+		fault = &queryFault{dropAfterRows: -1}
+		db.faults[key] = fault
+	}
+	return fault
+}
+
+// SetQueryDelay makes the DB wait for the given duration before answering
+// the query. The DB is not locked while waiting.
+func (db *DB) SetQueryDelay(query string, delay time.Duration) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.faultLocked(query).delay = delay
+}
+
+// SetQueryFailEvery makes every nth execution of the query fail with err.
+// The executions are counted from this call.
+func (db *DB) SetQueryFailEvery(query string, n int, err error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	fault := db.faultLocked(query)
+	fault.failEvery = n
+	fault.failErr = err
+	fault.executions = 0
+}
+
+// SetQueryDropAfterRows makes the DB send the fields and the first n rows
+// for the query, and then abort the stream. The client will see a
+// CRServerLost(2013) error. If the result has no fields, the query fails
+// before anything is sent.
+func (db *DB) SetQueryDropAfterRows(query string, n int) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	db.faultLocked(query).dropAfterRows = n
+}
+
+// ClearQueryFaults removes all faults injected for the query.
+func (db *DB) ClearQueryFaults(query string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	delete(db.faults, strings.ToLower(query))
+}
+
 //
 // The following methods are used for ordered expected queries.
 //
@@ -711,4 +1233,37 @@ func (db *DB) VerifyAllExecutedOrFail() {
 	if db.expectedExecuteFetchIndex != len(db.expectedExecuteFetch) {
 		db.t.Errorf("%v: not all expected queries were executed. leftovers: %v", db.name, db.expectedExecuteFetch[db.expectedExecuteFetchIndex:])
 	}
+	if db.expectedSequenceIndex < len(db.expectedSequence) {
+		db.t.Errorf("%v: not all queries of the expected sequence were executed. leftovers: %v", db.name, db.expectedSequence[db.expectedSequenceIndex:])
+	}
+}
+
+//
+// The following methods are used for sequence-based expectations on top of
+// the unordered query results.
+//
+
+// ExpectQueriesInOrder makes the DB fail the test if queries do not arrive in
+// the given order, or if a query does not match any registered result.
+// Results are still served by AddQuery() and AddQueryPattern(). A trailing
+// '*' in an expected query matches any suffix.
+// Calling it again appends to the current sequence.
+func (db *DB) ExpectQueriesInOrder(queries ...string) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	if db.expectedSequence == nil {
+		db.expectedSequence = make([]string, 0, len(queries))
+	}
+	db.expectedSequence = append(db.expectedSequence, queries...)
+}
+
+// ResetExpectedQueryOrder turns off the sequence checks set by
+// ExpectQueriesInOrder().
+func (db *DB) ResetExpectedQueryOrder() {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+
+	db.expectedSequence = nil
+	db.expectedSequenceIndex = 0
 }
@@ -0,0 +1,347 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakesqldb
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// recordingTB wraps a testing.TB and records Errorf calls instead of
+// failing the test, so we can assert on expectation failures.
+type recordingTB struct {
+	testing.TB
+
+	mu     sync.Mutex
+	errors []string
+}
+
+func (r *recordingTB) Errorf(format string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *recordingTB) errorCount() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.errors)
+}
+
+func connect(t *testing.T, db *DB) *mysql.Conn {
+	t.Helper()
+	conn, err := db.ConnParams().Connect(context.Background())
+	require.NoError(t, err)
+	return conn
+}
+
+func TestAddQueryPatternWithCallback(t *testing.T) {
+	db := New(t)
+	defer db.Close()
+
+	var got []string
+	db.AddQueryPatternWithCallback("select .* from t1", &sqltypes.Result{}, func(query string) {
+		got = append(got, query)
+	})
+	conn := connect(t, db)
+	defer conn.Close()
+
+	_, err := conn.ExecuteFetch("select a from t1", 10, false)
+	require.NoError(t, err)
+	_, err = conn.ExecuteFetch("select b from t2", 10, false)
+	require.Error(t, err)
+	assert.Equal(t, []string{"select a from t1"}, got)
+}
+
+func TestExpectQueriesInOrder(t *testing.T) {
+	rtb := &recordingTB{TB: t}
+	db := New(rtb)
+	defer db.Close()
+
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQueryPattern("update t1 .*", &sqltypes.Result{})
+	db.ExpectQueriesInOrder("begin", "update t1 set*", "commit")
+	conn := connect(t, db)
+	defer conn.Close()
+
+	for _, query := range []string{"begin", "update t1 set a = 1", "commit"} {
+		_, err := conn.ExecuteFetch(query, 10, false)
+		require.NoError(t, err)
+	}
+	db.VerifyAllExecutedOrFail()
+	assert.Equal(t, 0, rtb.errorCount())
+
+	// Out of order and unmatched queries fail the test.
+	db.ResetExpectedQueryOrder()
+	db.ExpectQueriesInOrder("begin", "commit")
+	_, err := conn.ExecuteFetch("commit", 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rtb.errorCount())
+	_, err = conn.ExecuteFetch("select 1", 10, false)
+	require.Error(t, err)
+	assert.Equal(t, 3, rtb.errorCount(), rtb.errors)
+
+	// Leftover expectations are reported.
+	db.ResetExpectedQueryOrder()
+	db.ExpectQueriesInOrder("begin")
+	db.VerifyAllExecutedOrFail()
+	assert.Equal(t, 4, rtb.errorCount())
+}
+
+func TestQueryFaults(t *testing.T) {
+	db := New(t)
+	defer db.Close()
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id", "int64"), "1", "2", "3")
+	db.AddQuery("select id from t1", result)
+	db.AddQuery("select 1", &sqltypes.Result{})
+	conn := connect(t, db)
+	defer conn.Close()
+
+	// Fail every 2nd execution.
+	db.SetQueryFailEvery("select 1", 2, fmt.Errorf("injected"))
+	for i := 1; i <= 4; i++ {
+		_, err := conn.ExecuteFetch("select 1", 10, false)
+		if i%2 == 0 {
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "injected")
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	// The executions are counted again from a new call.
+	db.SetQueryFailEvery("select 1", 3, fmt.Errorf("injected"))
+	for i := 1; i <= 3; i++ {
+		_, err := conn.ExecuteFetch("select 1", 10, false)
+		if i == 3 {
+			require.Error(t, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	db.ClearQueryFaults("select 1")
+	_, err := conn.ExecuteFetch("select 1", 10, false)
+	require.NoError(t, err)
+
+	// Delay.
+	db.SetQueryDelay("select 1", 50*time.Millisecond)
+	start := time.Now()
+	_, err = conn.ExecuteFetch("select 1", 10, false)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(50*time.Millisecond))
+
+	// Drop in the middle of a result set.
+	db.SetQueryDropAfterRows("select id from t1", 1)
+	_, err = conn.ExecuteFetch("select id from t1", 10, false)
+	require.Error(t, err)
+	_, err = conn.ExecuteFetch("select 1", 10, false)
+	require.Error(t, err, "connection should have been dropped")
+}
+
+func TestTransactionState(t *testing.T) {
+	db := New(t)
+	defer db.Close()
+
+	db.AddQuery("begin", &sqltypes.Result{})
+	db.AddQuery("commit", &sqltypes.Result{})
+	db.AddQuery("rollback", &sqltypes.Result{})
+	db.AddQuery("update t1 set a = 1", &sqltypes.Result{})
+	db.RequireTransactionForDML(true)
+	conn1 := connect(t, db)
+	defer conn1.Close()
+	conn2 := connect(t, db)
+	defer conn2.Close()
+
+	_, err := conn1.ExecuteFetch("update t1 set a = 1", 10, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DML executed outside of a transaction")
+
+	_, err = conn1.ExecuteFetch("begin", 10, false)
+	require.NoError(t, err)
+	_, err = conn2.ExecuteFetch("begin", 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, db.OpenTransactionCount())
+	assert.True(t, db.InTransaction(uint32(conn1.ID())))
+
+	_, err = conn1.ExecuteFetch("update t1 set a = 1", 10, false)
+	require.NoError(t, err)
+	_, err = conn1.ExecuteFetch("commit", 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.OpenTransactionCount())
+	assert.False(t, db.InTransaction(uint32(conn1.ID())))
+
+	// Closing the connection discards its transaction.
+	conn2.Close()
+	for start := time.Now(); db.OpenTransactionCount() != 0; time.Sleep(time.Millisecond) {
+		require.Less(t, int64(time.Since(start)), int64(5*time.Second), "transaction of closed connection was not discarded")
+	}
+}
+
+func TestPreparedStatements(t *testing.T) {
+	db := New(t)
+	defer db.Close()
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|a")
+	db.AddQuery("select id, `name` from t1 where id = 1", result)
+
+	c := &mysql.Conn{ConnectionID: 1}
+	fields, err := db.ComPrepare(c, "select id, `name` from t1 where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, result.Fields, fields)
+	assert.Equal(t, 0, db.GetQueryCalledNum("select id, `name` from t1 where id = 1"))
+
+	prepare := &mysql.PrepareData{
+		PrepareStmt: "select id, name from t1 where id = ?",
+		ParamsCount: 1,
+		BindVars: map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(1),
+		},
+	}
+	var got *sqltypes.Result
+	err = db.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
+		got = qr
+		return nil
+	})
+	require.NoError(t, err)
+	assert.True(t, got.Equal(result), "got: %v, want: %v", got, result)
+	assert.Equal(t, 1, db.GetQueryCalledNum("select id, `name` from t1 where id = 1"))
+}
+
+func TestMultiStatement(t *testing.T) {
+	db := New(t)
+	defer db.Close()
+
+	result1 := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "int64"), "1")
+	result2 := sqltypes.MakeTestResult(sqltypes.MakeTestFields("b", "int64"), "2")
+	db.AddQuery("select 1 as a", result1)
+	db.AddQuery("select 2 as b", result2)
+	conn := connect(t, db)
+	defer conn.Close()
+
+	got, more, err := conn.ExecuteFetchMulti("select 1 as a;select 2 as b", 10, true)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.True(t, got.Equal(result1), "got: %v, want: %v", got, result1)
+	got, more, _, err = conn.ReadQueryResult(10, true)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.True(t, got.Equal(result2), "got: %v, want: %v", got, result2)
+}
+
+func TestCallProcedure(t *testing.T) {
+	db := New(t)
+	defer db.Close()
+
+	result1 := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "int64"), "1", "2")
+	result2 := sqltypes.MakeTestResult(sqltypes.MakeTestFields("b|c", "varchar|int64"), "x|3")
+	db.AddProcedure("p", &Procedure{
+		ResultSets: []*sqltypes.Result{result1, result2},
+		Result:     &sqltypes.Result{RowsAffected: 1},
+		OutParams:  []*OutParam{{Index: 1, Name: "total", Value: sqltypes.NewInt64(3)}},
+	})
+	db.AddQuery("call noresults()", &sqltypes.Result{RowsAffected: 2})
+	conn := connect(t, db)
+	defer conn.Close()
+
+	got, more, err := conn.ExecuteFetchMulti("call p(1, @total)", 10, true)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.True(t, got.Equal(result1), "got: %v, want: %v", got, result1)
+	got, more, _, err = conn.ReadQueryResult(10, true)
+	require.NoError(t, err)
+	assert.True(t, more)
+	assert.True(t, got.Equal(result2), "got: %v, want: %v", got, result2)
+	got, more, _, err = conn.ReadQueryResult(10, true)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.EqualValues(t, 1, got.RowsAffected)
+
+	got, err = conn.ExecuteFetch("select @total", 10, true)
+	require.NoError(t, err)
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("@total", "int64"), "3")
+	assert.True(t, got.Equal(want), "got: %v, want: %v", got, want)
+
+	_, err = conn.ExecuteFetch("call p(1, 2)", 10, false)
+	require.Error(t, err)
+	assert.Equal(t, mysql.ErSPNotVarArg, err.(*mysql.SQLError).Number())
+	_, err = conn.ExecuteFetch("call p(1)", 10, false)
+	require.Error(t, err)
+	assert.Equal(t, mysql.ERWrongParamCountToProcedure, err.(*mysql.SQLError).Number())
+
+	// A CALL without result sets is answered with a single OK packet.
+	got, more, err = conn.ExecuteFetchMulti("call noresults()", 10, false)
+	require.NoError(t, err)
+	assert.False(t, more)
+	assert.EqualValues(t, 2, got.RowsAffected)
+}
+
+func TestCallProcedurePrepared(t *testing.T) {
+	db := New(t)
+	defer db.Close()
+
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "int64"), "1")
+	db.AddProcedure("p", &Procedure{
+		ResultSets: []*sqltypes.Result{result},
+		OutParams: []*OutParam{
+			{Index: 1, Name: "total", Value: sqltypes.NewInt64(3)},
+			{Index: 2, Name: "name", Value: sqltypes.NewVarChar("x")},
+		},
+	})
+
+	c := &mysql.Conn{ConnectionID: 1}
+	prepare := &mysql.PrepareData{
+		PrepareStmt: "call p(?, ?, @name)",
+		ParamsCount: 2,
+		BindVars: map[string]*querypb.BindVariable{
+			"v1": sqltypes.Int64BindVariable(1),
+			"v2": sqltypes.NullBindVariable,
+		},
+	}
+	var got []*sqltypes.Result
+	err := db.ComStmtExecute(c, prepare, func(qr *sqltypes.Result) error {
+		got = append(got, qr)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, got, 3)
+	assert.True(t, got[0].Equal(result), "got: %v, want: %v", got[0], result)
+	assert.Equal(t, mysql.ServerMoreResultsExists, got[0].StatusFlags)
+	outParams := sqltypes.MakeTestResult(sqltypes.MakeTestFields("total", "int64"), "3")
+	assert.True(t, got[1].Equal(outParams), "got: %v, want: %v", got[1], outParams)
+	assert.Equal(t, mysql.ServerMoreResultsExists|mysql.ServerPsOutParams, got[1].StatusFlags)
+	assert.Equal(t, &sqltypes.Result{}, got[2])
+
+	// The parameters bound to user variables are assigned.
+	err = db.HandleQuery(c, "select @name", func(qr *sqltypes.Result) error {
+		want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("@name", "varchar"), "x")
+		assert.True(t, qr.Equal(want), "got: %v, want: %v", qr, want)
+		return nil
+	})
+	require.NoError(t, err)
+}
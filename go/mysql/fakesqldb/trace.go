@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakesqldb
+
+import (
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// TraceEntry is a query and the response a MySQL server sent for it.
+// A list of TraceEntry is captured by a Recorder, and can be replayed by
+// a DB.
+type TraceEntry struct {
+	Query  string               `json:"query"`
+	Result *querypb.QueryResult `json:"result,omitempty"`
+
+	// Error is the error message if the query failed.
+	// ErrNum and SQLState are only set for MySQL errors.
+	Error    string `json:"error,omitempty"`
+	ErrNum   int    `json:"errno,omitempty"`
+	SQLState string `json:"sqlstate,omitempty"`
+}
+
+// err returns the recorded error, or nil.
+func (te *TraceEntry) err() error {
+	if te.Error == "" {
+		return nil
+	}
+	if te.ErrNum == 0 {
+		return mysql.NewSQLError(mysql.ERUnknownError, mysql.SSUnknownSQLState, "%s", te.Error)
+	}
+	return mysql.NewSQLError(te.ErrNum, te.SQLState, "%s", te.Error)
+}
+
+// result returns the recorded result, or an empty one.
+func (te *TraceEntry) result() *sqltypes.Result {
+	if te.Result == nil {
+		return &sqltypes.Result{}
+	}
+	return sqltypes.Proto3ToResult(te.Result)
+}
+
+// Recorder wraps a connection to a real MySQL server and records all
+// queries executed through it along with their responses.
+type Recorder struct {
+	conn *mysql.Conn
+
+	mu      sync.Mutex
+	entries []TraceEntry
+}
+
+// NewRecorder creates a Recorder for the connection.
+func NewRecorder(conn *mysql.Conn) *Recorder {
+	return &Recorder{conn: conn}
+}
+
+// ExecuteFetch executes the query on the underlying connection and records
+// the outcome.
+func (r *Recorder) ExecuteFetch(query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
+	qr, err := r.conn.ExecuteFetch(query, maxrows, wantfields)
+
+	entry := TraceEntry{Query: query}
+	if err != nil {
+		entry.Error = err.Error()
+		if sqlErr, ok := err.(*mysql.SQLError); ok {
+			entry.Error = sqlErr.Message
+			entry.ErrNum = sqlErr.Number()
+			entry.SQLState = sqlErr.SQLState()
+		}
+	} else {
+		entry.Result = sqltypes.ResultToProto3(qr)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.entries = append(r.entries, entry)
+	return qr, err
+}
+
+// Entries returns a copy of the recorded entries.
+func (r *Recorder) Entries() []TraceEntry {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]TraceEntry(nil), r.entries...)
+}
+
+// WriteTrace writes the recorded entries as JSON.
+func (r *Recorder) WriteTrace(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r.Entries())
+}
+
+// WriteTraceFile writes the recorded entries as JSON to a file.
+func (r *Recorder) WriteTraceFile(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := r.WriteTrace(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadTrace reads entries written by Recorder.WriteTrace.
+func ReadTrace(r io.Reader) ([]TraceEntry, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	var entries []TraceEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil, err
+	}
+	return entries, nil
+}
+
+// Replay registers the entries of a trace as expected queries.
+// If ordered is true, the entries are added as ordered expectations and
+// OrderMatters() is turned on. Otherwise, they are added with AddQuery()
+// and AddRejectedQuery(), and the last entry wins for duplicate queries.
+func (db *DB) Replay(entries []TraceEntry, ordered bool) {
+	if ordered {
+		db.OrderMatters()
+	}
+	for i := range entries {
+		entry := &entries[i]
+		switch {
+		case ordered:
+			db.AddExpectedExecuteFetch(ExpectedExecuteFetch{
+				Query:       entry.Query,
+				QueryResult: entry.result(),
+				Error:       entry.err(),
+			})
+		case entry.Error != "":
+			db.DeleteQuery(entry.Query)
+			db.AddRejectedQuery(entry.Query, entry.err())
+		default:
+			db.DeleteRejectedQuery(entry.Query)
+			db.AddQuery(entry.Query, entry.result())
+		}
+	}
+}
+
+// ReplayFile reads a trace file and replays it. See Replay().
+func (db *DB) ReplayFile(filename string, ordered bool) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	entries, err := ReadTrace(f)
+	if err != nil {
+		return err
+	}
+	db.Replay(entries, ordered)
+	return nil
+}
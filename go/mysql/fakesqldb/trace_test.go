@@ -0,0 +1,71 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fakesqldb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestRecordAndReplay(t *testing.T) {
+	// The source DB plays the role of the real MySQL server.
+	source := New(t)
+	defer source.Close()
+	want := sqltypes.MakeTestResult(sqltypes.MakeTestFields("id|name", "int64|varchar"), "1|a", "2|null")
+	source.AddQuery("select id, name from t1", want)
+	source.AddRejectedQuery("select * from missing", mysql.NewSQLError(mysql.ERNoSuchTable, mysql.SSUnknownTable, "Table 'missing' doesn't exist"))
+
+	conn := connect(t, source)
+	defer conn.Close()
+	recorder := NewRecorder(conn)
+	_, err := recorder.ExecuteFetch("select id, name from t1", 10, true)
+	require.NoError(t, err)
+	_, err = recorder.ExecuteFetch("select * from missing", 10, true)
+	require.Error(t, err)
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, recorder.WriteTrace(buf))
+	entries, err := ReadTrace(buf)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+
+	for _, ordered := range []bool{false, true} {
+		replay := New(t)
+		replay.Replay(entries, ordered)
+		rconn := connect(t, replay)
+
+		got, err := rconn.ExecuteFetch("select id, name from t1", 10, true)
+		require.NoError(t, err)
+		assert.True(t, got.Equal(want), "got: %v, want: %v", got, want)
+
+		_, err = rconn.ExecuteFetch("select * from missing", 10, true)
+		require.Error(t, err)
+		sqlErr, ok := err.(*mysql.SQLError)
+		require.True(t, ok, "%T", err)
+		assert.Equal(t, mysql.ERNoSuchTable, sqlErr.Number())
+
+		replay.VerifyAllExecutedOrFail()
+		rconn.Close()
+		replay.Close()
+	}
+}
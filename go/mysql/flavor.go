@@ -160,6 +160,12 @@ func (c *Conn) fillFlavor(params *ConnParams) {
 	}
 }
 
+// isMariaDBVersion returns true if serverVersion is the version of a
+// MariaDB server.
+func isMariaDBVersion(serverVersion string) bool {
+	return strings.Contains(serverVersion, mariaDBVersionString)
+}
+
 //
 // The following methods are dependent on the flavor.
 // Only valid for client connections (will panic for server connections).
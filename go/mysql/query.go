@@ -116,6 +116,15 @@ func (c *Conn) readColumnDefinition(field *querypb.Field, index int) error {
 		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extracting col %v org_name failed", index)
 	}
 
+	// MariaDB extended metadata.
+	var typeName, format string
+	if c.MariaDBCapabilities&MariaDBCapabilityClientExtendedMetadata != 0 {
+		typeName, format, pos, ok = readMariaDBExtendedMetadata(colDef, pos)
+		if !ok {
+			return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extracting col %v extended metadata failed", index)
+		}
+	}
+
 	// Skip length of fixed-length fields.
 	pos++
 
@@ -149,6 +158,12 @@ func (c *Conn) readColumnDefinition(field *querypb.Field, index int) error {
 	if err != nil {
 		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "MySQLToType(%v,%v) failed for column %v: %v", t, flags, index, err)
 	}
+	if format == mariaDBJSONFormat {
+		field.Type = sqltypes.TypeJSON
+	}
+	if field.Type == sqltypes.Geometry && field.ColumnType == "" {
+		field.ColumnType = typeName
+	}
 	// Decimals is a byte.
 	decimals, _, ok := readByte(colDef, pos)
 	if !ok {
@@ -213,6 +228,15 @@ func (c *Conn) readColumnDefinitionType(field *querypb.Field, index int) error {
 		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "skipping col %v org_name failed", index)
 	}
 
+	// MariaDB extended metadata, only the format is used.
+	var format string
+	if c.MariaDBCapabilities&MariaDBCapabilityClientExtendedMetadata != 0 {
+		_, format, pos, ok = readMariaDBExtendedMetadata(colDef, pos)
+		if !ok {
+			return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "extracting col %v extended metadata failed", index)
+		}
+	}
+
 	// Skip length of fixed-length fields.
 	pos++
 
@@ -245,17 +269,22 @@ func (c *Conn) readColumnDefinitionType(field *querypb.Field, index int) error {
 	if err != nil {
 		return NewSQLError(CRMalformedPacket, SSUnknownSQLState, "MySQLToType(%v,%v) failed for column %v: %v", t, flags, index, err)
 	}
+	if format == mariaDBJSONFormat {
+		field.Type = sqltypes.TypeJSON
+	}
 
 	// skip decimals
 
 	return nil
 }
 
-// parseRow parses an individual row.
+// parseRow parses an individual row. The row is carved from alloc.
+// If ephemeral is set, the values are copied since data will be recycled,
+// otherwise they reference data, which must be owned by the caller.
 // Returns a SQLError.
-func (c *Conn) parseRow(data []byte, fields []*querypb.Field) ([]sqltypes.Value, error) {
+func (c *Conn) parseRow(data []byte, fields []*querypb.Field, alloc *sqltypes.RowAllocator, ephemeral bool) ([]sqltypes.Value, error) {
 	colNumber := len(fields)
-	result := make([]sqltypes.Value, colNumber)
+	result := alloc.Row(colNumber)
 	pos := 0
 	for i := 0; i < colNumber; i++ {
 		if data[pos] == NullValue {
@@ -264,10 +293,13 @@ func (c *Conn) parseRow(data []byte, fields []*querypb.Field) ([]sqltypes.Value,
 		}
 		var s []byte
 		var ok bool
-		s, pos, ok = readLenEncStringAsBytesCopy(data, pos)
+		s, pos, ok = readLenEncStringAsBytes(data, pos)
 		if !ok {
 			return nil, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "decoding string failed")
 		}
+		if ephemeral {
+			s = alloc.Copy(s)
+		}
 		result[i] = sqltypes.MakeTrusted(fields[i].Type, s)
 	}
 	return result, nil
@@ -409,6 +441,7 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (*sqltypes.Result,
 	}
 
 	// read each row until EOF or OK packet.
+	var alloc sqltypes.RowAllocator
 	for {
 		data, err := c.readEphemeralPacket()
 		if err != nil {
@@ -464,7 +497,7 @@ func (c *Conn) ReadQueryResult(maxrows int, wantfields bool) (*sqltypes.Result,
 		}
 
 		// Regular row.
-		row, err := c.parseRow(data, result.Fields)
+		row, err := c.parseRow(data, result.Fields, &alloc, true)
 		if err != nil {
 			c.recycleReadPacket()
 			return nil, false, 0, err
@@ -618,6 +651,16 @@ func (c *Conn) parseComStmtExecute(prepareData map[uint32]*PrepareData, data []b
 		if !ok {
 			return stmtID, 0, NewSQLError(CRMalformedPacket, SSUnknownSQLState, "decoding parameter value failed: %v", prepare.ParamsType[i])
 		}
+		// The strings are sent in the character set of the client, but
+		// not the blobs.
+		if cc := c.queryConverter; cc != nil && !val.IsNull() {
+			switch querypb.Type(prepare.ParamsType[i]) {
+			case sqltypes.VarChar, sqltypes.Char:
+				if raw, converted := cc.decodeBytes(val.Raw()); converted {
+					val = sqltypes.MakeTrusted(val.Type(), raw)
+				}
+			}
+		}
 
 		prepare.BindVars[parameterID] = sqltypes.ValueBindVariable(val)
 	}
@@ -890,11 +933,6 @@ func (c *Conn) sendColumnCount(count uint64) error {
 
 func (c *Conn) writeColumnDefinition(field *querypb.Field) error {
 	length := 4 + // lenEncStringSize("def")
-		lenEncStringSize(field.Database) +
-		lenEncStringSize(field.Table) +
-		lenEncStringSize(field.OrgTable) +
-		lenEncStringSize(field.Name) +
-		lenEncStringSize(field.OrgName) +
 		1 + // length of fixed length fields
 		2 + // character set
 		4 + // column length
@@ -911,17 +949,52 @@ func (c *Conn) writeColumnDefinition(field *querypb.Field) error {
 		flags = int64(field.Flags)
 	}
 
+	// The names, and the values of the utf8 text columns, are sent in the
+	// character set of the client. The length of the columns is in bytes,
+	// so it changes with the maximum length of their characters.
+	charset, columnLength := uint16(field.Charset), field.ColumnLength
+	database, table, orgTable, name, orgName := field.Database, field.Table, field.OrgTable, field.Name, field.OrgName
+	if cc := c.resultsConverter; cc != nil {
+		if maxLen := utf8MaxLen(field); maxLen != 0 {
+			charset = uint16(cc.collation)
+			columnLength = columnLength / maxLen * cc.maxLen
+		}
+		database, table, orgTable = cc.convertString(database), cc.convertString(table), cc.convertString(orgTable)
+		name, orgName = cc.convertString(name), cc.convertString(orgName)
+	}
+
+	length += lenEncStringSize(database) +
+		lenEncStringSize(table) +
+		lenEncStringSize(orgTable) +
+		lenEncStringSize(name) +
+		lenEncStringSize(orgName)
+
+	extendedMetadata := c.MariaDBCapabilities&MariaDBCapabilityClientExtendedMetadata != 0
+	var metadata string
+	if extendedMetadata {
+		metadata = mariaDBExtendedMetadata(field)
+		length += lenEncStringSize(metadata)
+		// MariaDB has no json type, json columns are longtext
+		// columns with the json format.
+		if field.Type == sqltypes.TypeJSON {
+			typ = mariaDBJSONType
+		}
+	}
+
 	data, pos := c.startEphemeralPacketWithHeader(length)
 
 	pos = writeLenEncString(data, pos, "def") // Always the same.
-	pos = writeLenEncString(data, pos, field.Database)
-	pos = writeLenEncString(data, pos, field.Table)
-	pos = writeLenEncString(data, pos, field.OrgTable)
-	pos = writeLenEncString(data, pos, field.Name)
-	pos = writeLenEncString(data, pos, field.OrgName)
+	pos = writeLenEncString(data, pos, database)
+	pos = writeLenEncString(data, pos, table)
+	pos = writeLenEncString(data, pos, orgTable)
+	pos = writeLenEncString(data, pos, name)
+	pos = writeLenEncString(data, pos, orgName)
+	if extendedMetadata {
+		pos = writeLenEncString(data, pos, metadata)
+	}
 	pos = writeByte(data, pos, 0x0c)
-	pos = writeUint16(data, pos, uint16(field.Charset))
-	pos = writeUint32(data, pos, field.ColumnLength)
+	pos = writeUint16(data, pos, charset)
+	pos = writeUint32(data, pos, columnLength)
 	pos = writeByte(data, pos, byte(typ))
 	pos = writeUint16(data, pos, uint16(flags))
 	pos = writeByte(data, pos, byte(field.Decimals))
@@ -934,6 +1007,66 @@ func (c *Conn) writeColumnDefinition(field *querypb.Field) error {
 	return c.writeEphemeralPacket()
 }
 
+const (
+	// mariaDBJSONFormat is the MariaDB extended metadata format of
+	// the json columns.
+	mariaDBJSONFormat = "json"
+	// mariaDBJSONType is the MySQL type MariaDB sends for the json
+	// columns: MYSQL_TYPE_BLOB, as they are longtext columns.
+	mariaDBJSONType = 252
+)
+
+// mariaDBExtendedMetadata returns the MariaDB extended metadata of a
+// column: the type name of the geometry columns, and the format of the
+// json columns.
+func mariaDBExtendedMetadata(field *querypb.Field) string {
+	var metadataType byte
+	var value string
+	switch field.Type {
+	case sqltypes.Geometry:
+		// The column type can have attributes, e.g. "point /*!80003 SRID 4326 */".
+		value = strings.ToLower(strings.Fields(field.ColumnType + " geometry")[0])
+		metadataType = mariaDBMetadataTypeName
+	case sqltypes.TypeJSON:
+		value = mariaDBJSONFormat
+		metadataType = mariaDBMetadataFormat
+	default:
+		return ""
+	}
+	data := make([]byte, 1+lenEncStringSize(value))
+	pos := writeByte(data, 0, metadataType)
+	writeLenEncString(data, pos, value)
+	return string(data)
+}
+
+// readMariaDBExtendedMetadata reads the MariaDB extended metadata of a
+// column definition. It returns the type name and the format of the column.
+func readMariaDBExtendedMetadata(data []byte, pos int) (string, string, int, bool) {
+	metadata, pos, ok := readLenEncStringAsBytes(data, pos)
+	if !ok {
+		return "", "", 0, false
+	}
+	var typeName, format string
+	d := &coder{data: metadata}
+	for d.pos < len(metadata) {
+		metadataType, ok := d.readByte()
+		if !ok {
+			return "", "", 0, false
+		}
+		value, ok := d.readLenEncString()
+		if !ok {
+			return "", "", 0, false
+		}
+		switch metadataType {
+		case mariaDBMetadataTypeName:
+			typeName = value
+		case mariaDBMetadataFormat:
+			format = value
+		}
+	}
+	return typeName, format, pos, true
+}
+
 func (c *Conn) writeRow(row []sqltypes.Value) error {
 	length := 0
 	for _, val := range row {
@@ -968,10 +1101,14 @@ func (c *Conn) writeFields(result *sqltypes.Result) error {
 	}
 
 	// Now send each Field.
+	c.convertedColumns = c.convertedColumns[:0]
 	for _, field := range result.Fields {
 		if err := c.writeColumnDefinition(field); err != nil {
 			return err
 		}
+		if c.resultsConverter != nil {
+			c.convertedColumns = append(c.convertedColumns, utf8MaxLen(field) != 0)
+		}
 	}
 
 	// Now send an EOF packet.
@@ -987,6 +1124,9 @@ func (c *Conn) writeFields(result *sqltypes.Result) error {
 // writeRows sends the rows of a Result.
 func (c *Conn) writeRows(result *sqltypes.Result) error {
 	for _, row := range result.Rows {
+		if c.resultsConverter != nil {
+			row = c.resultsConverter.convertRow(c.convertedColumns, row)
+		}
 		if err := c.writeRow(row); err != nil {
 			return err
 		}
@@ -997,12 +1137,17 @@ func (c *Conn) writeRows(result *sqltypes.Result) error {
 // writeEndResult concludes the sending of a Result.
 // if more is set to true, then it means there are more results afterwords
 func (c *Conn) writeEndResult(more bool, affectedRows, lastInsertID uint64, warnings uint16) error {
-	// Send either an EOF, or an OK packet.
-	// See doc.go.
 	flags := c.StatusFlags
 	if more {
 		flags |= ServerMoreResultsExists
 	}
+	return c.writeEndResultFlags(flags, affectedRows, lastInsertID, warnings)
+}
+
+// writeEndResultFlags is like writeEndResult, with the status flags to send.
+func (c *Conn) writeEndResultFlags(flags uint16, affectedRows, lastInsertID uint64, warnings uint16) error {
+	// Send either an EOF, or an OK packet.
+	// See doc.go.
 	if c.Capabilities&CapabilityClientDeprecateEOF == 0 {
 		if err := c.writeEOFPacket(flags, warnings); err != nil {
 			return err
@@ -1146,6 +1291,9 @@ func (c *Conn) writeBinaryRow(fields []*querypb.Field, row []sqltypes.Value) err
 // writeBinaryRows sends the rows of a Result with binary form.
 func (c *Conn) writeBinaryRows(result *sqltypes.Result) error {
 	for _, row := range result.Rows {
+		if c.resultsConverter != nil {
+			row = c.resultsConverter.convertRow(c.convertedColumns, row)
+		}
 		if err := c.writeBinaryRow(result.Fields, row); err != nil {
 			return err
 		}
@@ -18,12 +18,17 @@ package mysql
 
 import (
 	"flag"
+	"fmt"
 	"math/rand"
 	"net"
 	"strings"
 	"testing"
 
 	"context"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
 var testReadConnBufferSize = connBufferSize
@@ -117,3 +122,36 @@ func BenchmarkParallelMediumQueries(b *testing.B) {
 func BenchmarkParallelRandomQueries(b *testing.B) {
 	benchmarkQuery(b, 10, "")
 }
+
+// BenchmarkParseRow measures the decoding of the rows of a large result,
+// read either from recycled packets (ExecuteFetch) or from packets owned
+// by the connection (FetchNext).
+func BenchmarkParseRow(b *testing.B) {
+	fields := make([]*querypb.Field, 10)
+	values := make([]string, len(fields))
+	size := 0
+	for i := range fields {
+		fields[i] = &querypb.Field{Type: querypb.Type_VARCHAR}
+		values[i] = strings.Repeat("x", 10*i)
+		size += lenEncStringSize(values[i])
+	}
+	data := make([]byte, size)
+	pos := 0
+	for _, value := range values {
+		pos = writeLenEncString(data, pos, value)
+	}
+
+	c := &Conn{}
+	for _, ephemeral := range []bool{true, false} {
+		b.Run(fmt.Sprintf("ephemeral=%v", ephemeral), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(data)))
+			var alloc sqltypes.RowAllocator
+			for i := 0; i < b.N; i++ {
+				if _, err := c.parseRow(data, fields, &alloc, ephemeral); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -417,6 +417,25 @@ func TestQueries(t *testing.T) {
 		},
 	})
 
+	// MariaDB extended metadata carries the geometry types and the
+	// json columns.
+	sConn.MariaDBCapabilities = MariaDBCapabilityClientExtendedMetadata
+	cConn.MariaDBCapabilities = MariaDBCapabilityClientExtendedMetadata
+	checkQuery(t, "mariadb extended metadata", sConn, cConn, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Name: "id", Type: querypb.Type_INT32},
+			{Name: "location", Type: querypb.Type_GEOMETRY, ColumnType: "point"},
+			{Name: "doc", Type: querypb.Type_JSON},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.MakeTrusted(querypb.Type_INT32, []byte("10")),
+			sqltypes.MakeTrusted(querypb.Type_GEOMETRY, []byte("geometry")),
+			sqltypes.MakeTrusted(querypb.Type_JSON, []byte(`{"a": 1}`)),
+		}},
+	})
+	sConn.MariaDBCapabilities = 0
+	cConn.MariaDBCapabilities = 0
+
 	// Typical Select with TYPE_AND_NAME.
 	// All types are represented.
 	// One row has all NULL values.
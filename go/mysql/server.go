@@ -121,6 +121,25 @@ type Handler interface {
 	ComResetConnection(c *Conn)
 }
 
+// ConnectionAuthorizer is an optional interface a Handler can implement
+// to refuse connections once their user is authenticated, e.g. to limit
+// the number of connections of each user.
+type ConnectionAuthorizer interface {
+	// AuthorizeConnection is called once the user of the connection
+	// is authenticated. If it returns an error, the error is sent to
+	// the client and the connection is closed.
+	AuthorizeConnection(c *Conn) error
+}
+
+// AuthFailureObserver is an optional interface a Handler can implement
+// to be told about the connections whose user could not be
+// authenticated, e.g. to audit them.
+type AuthFailureObserver interface {
+	// AuthFailed is called when the authentication of user fails,
+	// before the error is sent to the client.
+	AuthFailed(c *Conn, user string, err error)
+}
+
 // Listener is the MySQL server protocol listener.
 type Listener struct {
 	// Construction parameters, set by NewListener.
@@ -175,6 +194,14 @@ type Listener struct {
 	// RequireSecureTransport configures the server to reject connections from insecure clients
 	RequireSecureTransport bool
 
+	// ConvertCharset makes the server convert the queries and the bind
+	// variables to utf8mb4, the character set of the backends, from the
+	// character set the clients request in their handshake, and the
+	// results and the errors back to it. The handler can change them with
+	// Conn.SetCharacterSets. The single byte character sets, like latin1,
+	// and utf8 (utf8mb3) are supported.
+	ConvertCharset bool
+
 	// PreHandleFunc is called for each incoming connection, immediately after
 	// accepting a new connection. By default it's no-op. Useful for custom
 	// connection inspection or TLS termination. The returned connection is
@@ -378,6 +405,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 	// See what auth method the AuthServer wants to use for that user.
 	authServerMethod, err := l.authServer.AuthMethod(user)
 	if err != nil {
+		l.authFailed(c, user, err)
 		c.writeErrorPacketFromError(err)
 		return
 	}
@@ -391,6 +419,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		userData, err := l.authServer.ValidateHash(salt, user, authResponse, conn.RemoteAddr())
 		if err != nil {
 			log.Warningf("Error authenticating user using MySQL native password: %v", err)
+			l.authFailed(c, user, err)
 			c.writeErrorPacketFromError(err)
 			return
 		}
@@ -422,6 +451,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		userData, err := l.authServer.ValidateHash(salt, user, response, conn.RemoteAddr())
 		if err != nil {
 			log.Warningf("Error authenticating user using MySQL native password: %v", err)
+			l.authFailed(c, user, err)
 			c.writeErrorPacketFromError(err)
 			return
 		}
@@ -452,6 +482,7 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		// auth server.
 		userData, err := l.authServer.Negotiate(c, user, conn.RemoteAddr())
 		if err != nil {
+			l.authFailed(c, user, err)
 			c.writeErrorPacketFromError(err)
 			return
 		}
@@ -459,6 +490,14 @@ func (l *Listener) handle(conn net.Conn, connectionID uint32, acceptTime time.Ti
 		c.UserData = userData
 	}
 
+	if authorizer, ok := l.handler.(ConnectionAuthorizer); ok {
+		if err := authorizer.AuthorizeConnection(c); err != nil {
+			log.Warningf("Connection of user %v refused for %s: %v", c.User, c, err)
+			c.writeErrorPacketFromError(err)
+			return
+		}
+	}
+
 	if c.User != "" {
 		connCountPerUser.Add(c.User, 1)
 		defer connCountPerUser.Add(c.User, -1)
@@ -516,6 +555,14 @@ func (l *Listener) isShutdown() bool {
 	return l.shutdown.Get()
 }
 
+// authFailed tells the handler about a failed authentication, if it
+// implements AuthFailureObserver.
+func (l *Listener) authFailed(c *Conn, user string, err error) {
+	if observer, ok := l.handler.(AuthFailureObserver); ok {
+		observer.AuthFailed(c, user, err)
+	}
+}
+
 // writeHandshakeV10 writes the Initial Handshake Packet, server side.
 // It returns the salt data.
 func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, enableTLS bool) ([]byte, error) {
@@ -535,6 +582,13 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 	if enableTLS {
 		capabilities |= CapabilityClientSSL
 	}
+	// When we advertise a MariaDB version, we also advertise the MariaDB
+	// extended capabilities, which MariaDB clients only read if
+	// CapabilityClientLongPassword is not set.
+	mariaDB := isMariaDBVersion(serverVersion)
+	if mariaDB {
+		capabilities &^= CapabilityClientLongPassword
+	}
 
 	length :=
 		1 + // protocol version
@@ -589,8 +643,14 @@ func (c *Conn) writeHandshakeV10(serverVersion string, authServer AuthServer, en
 	// Always 21 (8 + 13).
 	pos = writeByte(data, pos, 21)
 
-	// Reserved 10 bytes: all 0
-	pos = writeZeroes(data, pos, 10)
+	// Reserved 10 bytes: all 0, but for the MariaDB extended capability
+	// flags in the last 4 bytes.
+	if mariaDB {
+		pos = writeZeroes(data, pos, 6)
+		pos = writeUint32(data, pos, MariaDBCapabilityClientExtendedMetadata)
+	} else {
+		pos = writeZeroes(data, pos, 10)
+	}
 
 	// Second part of auth plugin data.
 	pos += copy(data[pos:], salt[8:])
@@ -658,8 +718,20 @@ func (l *Listener) parseClientHandshakePacket(c *Conn, firstTime bool, data []by
 		return "", "", nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read characterSet")
 	}
 	c.CharacterSet = characterSet
+	if l.ConvertCharset {
+		c.queryConverter = newCharsetConverter(characterSet)
+		c.resultsConverter = c.queryConverter
+	}
 
-	// 23x reserved zero bytes.
+	// 23x reserved zero bytes, but for the MariaDB extended capability
+	// flags in the last 4 bytes.
+	if clientFlags&CapabilityClientLongPassword == 0 && isMariaDBVersion(l.ServerVersion) {
+		mariaDBFlags, _, ok := readUint32(data, pos+19)
+		if !ok {
+			return "", "", nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "parseClientHandshakePacket: can't read MariaDB capability flags")
+		}
+		c.MariaDBCapabilities = mariaDBFlags & MariaDBCapabilityClientExtendedMetadata
+	}
 	pos += 23
 
 	// Check for SSL.
@@ -283,6 +283,96 @@ func TestConnectionFromListener(t *testing.T) {
 	c.Close()
 }
 
+// refusingHandler is a testHandler that refuses all the connections
+// once they are authenticated.
+type refusingHandler struct {
+	testHandler
+}
+
+func (rh *refusingHandler) AuthorizeConnection(c *Conn) error {
+	return NewSQLError(ERTooManyUserConnections, SSClientError, "too many connections for user %v", c.User)
+}
+
+func TestConnectionAuthorizer(t *testing.T) {
+	th := &refusingHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err, "NewListener failed")
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+
+	// Setup the right parameters.
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+
+	_, err = Connect(context.Background(), params)
+	require.Error(t, err)
+	serr, ok := err.(*SQLError)
+	require.True(t, ok, "not a SQLError: %v", err)
+	assert.Equal(t, ERTooManyUserConnections, serr.Number())
+	assert.Contains(t, serr.Error(), "too many connections for user user1")
+}
+
+// authFailureHandler is a testHandler that records the users whose
+// authentication failed.
+type authFailureHandler struct {
+	testHandler
+	failedUsers []string
+}
+
+func (ah *authFailureHandler) AuthFailed(c *Conn, user string, err error) {
+	ah.mu.Lock()
+	defer ah.mu.Unlock()
+	ah.failedUsers = append(ah.failedUsers, user)
+}
+
+func TestAuthFailureObserver(t *testing.T) {
+	th := &authFailureHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+		UserData: "userData1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err, "NewListener failed")
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "wrong",
+	}
+	_, err = Connect(context.Background(), params)
+	require.Error(t, err)
+
+	params.Pass = "password1"
+	c, err := Connect(context.Background(), params)
+	require.NoError(t, err)
+	c.Close()
+
+	th.mu.Lock()
+	defer th.mu.Unlock()
+	assert.Equal(t, []string{"user1"}, th.failedUsers)
+}
+
 func TestConnectionWithoutSourceHost(t *testing.T) {
 	th := &testHandler{}
 
@@ -454,6 +544,42 @@ func TestClientFoundRows(t *testing.T) {
 	c.Close()
 }
 
+func TestMariaDBCapabilities(t *testing.T) {
+	th := &testHandler{}
+
+	authServer := NewAuthServerStatic("", "", 0)
+	authServer.entries["user1"] = []*AuthServerStaticEntry{{
+		Password: "password1",
+	}}
+	defer authServer.close()
+	l, err := NewListener("tcp", ":0", authServer, th, 0, 0, false)
+	require.NoError(t, err, "NewListener failed")
+	defer l.Close()
+	go l.Accept()
+
+	host, port := getHostPort(t, l.Addr())
+	params := &ConnParams{
+		Host:  host,
+		Port:  port,
+		Uname: "user1",
+		Pass:  "password1",
+	}
+
+	// The MariaDB capabilities are not advertised with a MySQL version.
+	c, err := Connect(context.Background(), params)
+	require.NoError(t, err, "Connect failed")
+	assert.Zero(t, c.MariaDBCapabilities)
+	assert.Zero(t, th.LastConn().MariaDBCapabilities)
+	c.Close()
+
+	l.ServerVersion = "5.5.5-10.5.9-MariaDB"
+	c, err = Connect(context.Background(), params)
+	require.NoError(t, err, "Connect failed")
+	assert.EqualValues(t, MariaDBCapabilityClientExtendedMetadata, c.MariaDBCapabilities)
+	assert.EqualValues(t, MariaDBCapabilityClientExtendedMetadata, th.LastConn().MariaDBCapabilities)
+	c.Close()
+}
+
 func TestConnCounts(t *testing.T) {
 	th := &testHandler{}
 
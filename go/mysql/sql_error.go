@@ -97,6 +97,16 @@ func NewSQLErrorFromError(err error) error {
 	}
 
 	msg := err.Error()
+	if num, ss, ok := vterrors.SQLErrorDetails(err); ok {
+		if ss == "" {
+			ss = SSUnknownSQLState
+		}
+		return &SQLError{
+			Num:     num,
+			State:   ss,
+			Message: msg,
+		}
+	}
 	match := errExtract.FindStringSubmatch(msg)
 	if len(match) < 2 {
 		// Map vitess error codes into the mysql equivalent
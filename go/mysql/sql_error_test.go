@@ -150,6 +150,14 @@ func TestNewSQLErrorFromError(t *testing.T) {
 			num: ERNoDb,
 			ss:  SSNoDB,
 		},
+		{
+			err: vterrors.WithDetails(vterrors.Errorf(vtrpc.Code_ALREADY_EXISTS, "duplicate entry"), map[string]string{
+				vterrors.DetailErrno:    "1062",
+				vterrors.DetailSQLState: "23000",
+			}),
+			num: ERDupEntry,
+			ss:  SSConstraintViolation,
+		},
 	}
 
 	for _, tc := range tCases {
@@ -132,8 +132,8 @@ func (c *Conn) FetchNext() ([]sqltypes.Value, error) {
 		return nil, ParseErrorPacket(data)
 	}
 
-	// Regular row.
-	return c.parseRow(data, c.fields)
+	// Regular row. The packet is owned by us, so the values can reference it.
+	return c.parseRow(data, c.fields, &c.streamRows, false)
 }
 
 // CloseResult can be used to terminate a streaming query
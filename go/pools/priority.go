@@ -0,0 +1,67 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pools
+
+import (
+	"context"
+)
+
+// Priority is the priority class of a ResourcePool Get. When the pool is
+// exhausted, the waiters of a higher priority are served first.
+type Priority int
+
+const (
+	// PriorityBackground is for background work, like maintenance jobs.
+	PriorityBackground Priority = iota
+	// PriorityOLAP is for analytical queries.
+	PriorityOLAP
+	// PriorityOLTP is for transactional queries. It's the default.
+	PriorityOLTP
+
+	numPriorities
+)
+
+var priorityNames = [numPriorities]string{
+	PriorityBackground: "Background",
+	PriorityOLAP:       "OLAP",
+	PriorityOLTP:       "OLTP",
+}
+
+// String returns the name of the priority.
+func (p Priority) String() string {
+	if p < 0 || p >= numPriorities {
+		return "Unknown"
+	}
+	return priorityNames[p]
+}
+
+type priorityKey struct{}
+
+// WithPriority returns a context which makes ResourcePool Get calls use
+// the given priority.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityKey{}, priority)
+}
+
+// PriorityFromContext returns the priority set by WithPriority, or
+// PriorityOLTP if none was set.
+func PriorityFromContext(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityKey{}).(Priority); ok && priority >= 0 && priority < numPriorities {
+		return priority
+	}
+	return PriorityOLTP
+}
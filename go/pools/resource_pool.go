@@ -19,8 +19,10 @@ limitations under the License.
 package pools
 
 import (
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"hash/fnv"
 	"sync"
 	"time"
 
@@ -44,9 +46,16 @@ var (
 	// ErrCtxTimeout is returned if a ctx is already expired by the time the resource pool is used
 	ErrCtxTimeout = vterrors.New(vtrpcpb.Code_DEADLINE_EXCEEDED, "resource pool context already expired")
 
+	// ErrShed is returned if a resource get waited for higher priority gets longer than the shed timeout.
+	ErrShed = vterrors.New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "resource pool shed a low priority request")
+
 	prefillTimeout = 30 * time.Second
 )
 
+// maxLifetimeJitter is the fraction of the max lifetime by which the
+// lifetime of resources is shortened, to spread their reopening.
+const maxLifetimeJitter = 10
+
 // Factory is a function that can be used to create a resource.
 type Factory func(context.Context) (Resource, error)
 
@@ -57,6 +66,14 @@ type Resource interface {
 	Close()
 }
 
+// ExpirableResource is a Resource which knows its age. The max lifetime
+// and Drain only apply to the resources which implement it.
+type ExpirableResource interface {
+	Resource
+	// CreateTime returns the time the resource was created at.
+	CreateTime() time.Time
+}
+
 // ResourcePool allows you to use a pool of resources.
 type ResourcePool struct {
 	// stats. Atomic fields must remain at the top in order to prevent panics on certain architectures.
@@ -67,9 +84,27 @@ type ResourcePool struct {
 	waitTime   sync2.AtomicDuration
 	idleClosed sync2.AtomicInt64
 	exhausted  sync2.AtomicInt64
+	// lifetimeClosed counts the resources closed because they outlived
+	// the max lifetime or were drained.
+	lifetimeClosed sync2.AtomicInt64
 
 	capacity    sync2.AtomicInt64
 	idleTimeout sync2.AtomicDuration
+	shedTimeout sync2.AtomicDuration
+	maxLifetime sync2.AtomicDuration
+	// drainTime is the time of the last Drain in nanoseconds, or 0.
+	drainTime sync2.AtomicInt64
+
+	priorityWaitCount [numPriorities]sync2.AtomicInt64
+	priorityWaitTime  [numPriorities]sync2.AtomicDuration
+	priorityShed      [numPriorities]sync2.AtomicInt64
+
+	// waiters is the number of waiters of each priority. priorityChanged
+	// is closed, and replaced, when the waiters of a higher priority than
+	// its index appear or disappear.
+	waitersMu       sync.Mutex
+	waiters         [numPriorities]int
+	priorityChanged [numPriorities]chan struct{}
 
 	resources chan resourceWrapper
 	factory   Factory
@@ -105,6 +140,9 @@ func NewResourcePool(factory Factory, capacity, maxCap int, idleTimeout time.Dur
 		idleTimeout: sync2.NewAtomicDuration(idleTimeout),
 		logWait:     logWait,
 	}
+	for i := range rp.priorityChanged {
+		rp.priorityChanged[i] = make(chan struct{})
+	}
 	for i := 0; i < capacity; i++ {
 		rp.resources <- resourceWrapper{}
 	}
@@ -182,6 +220,10 @@ func (rp *ResourcePool) closeIdleResources() {
 				wrapper.resource.Close()
 				rp.idleClosed.Add(1)
 				rp.reopenResource(&wrapper)
+			} else if wrapper.resource != nil && rp.stale(wrapper.resource) {
+				wrapper.resource.Close()
+				rp.lifetimeClosed.Add(1)
+				rp.reopenResource(&wrapper)
 			}
 		}()
 
@@ -192,6 +234,9 @@ func (rp *ResourcePool) closeIdleResources() {
 // has not been reached, it will create a new one using the factory. Otherwise,
 // it will wait till the next resource becomes available or a timeout.
 // A timeout of 0 is an indefinite wait.
+// The waiters of the priority set in ctx by WithPriority wait until there
+// are no waiters of a higher priority. If a shed timeout is set, they give
+// up with ErrShed after waiting that long for higher priority waiters.
 func (rp *ResourcePool) Get(ctx context.Context) (resource Resource, err error) {
 	span, ctx := trace.NewSpan(ctx, "ResourcePool.Get")
 	span.Annotate("capacity", rp.capacity.Get())
@@ -216,18 +261,29 @@ func (rp *ResourcePool) get(ctx context.Context) (resource Resource, err error)
 	select {
 	case wrapper, ok = <-rp.resources:
 	default:
+		priority := PriorityFromContext(ctx)
+		span, _ := trace.NewSpan(ctx, "ResourcePool.wait")
+		span.Annotate("priority", priority.String())
 		startTime := time.Now()
-		select {
-		case wrapper, ok = <-rp.resources:
-		case <-ctx.Done():
-			return nil, ErrTimeout
+		wrapper, ok, err = rp.wait(ctx, priority)
+		span.Finish()
+		if err != nil {
+			return nil, err
 		}
-		rp.recordWait(startTime)
+		rp.recordWait(startTime, priority)
 	}
 	if !ok {
 		return nil, ErrClosed
 	}
 
+	// Replace the resource if it outlived its lifetime.
+	if wrapper.resource != nil && rp.stale(wrapper.resource) {
+		wrapper.resource.Close()
+		wrapper.resource = nil
+		rp.active.Add(-1)
+		rp.lifetimeClosed.Add(1)
+	}
+
 	// Unwrap
 	if wrapper.resource == nil {
 		span, _ := trace.NewSpan(ctx, "ResourcePool.factory")
@@ -246,13 +302,98 @@ func (rp *ResourcePool) get(ctx context.Context) (resource Resource, err error)
 	return wrapper.resource, err
 }
 
+// wait waits for a resource, after the waiters of a higher priority.
+func (rp *ResourcePool) wait(ctx context.Context, priority Priority) (resourceWrapper, bool, error) {
+	rp.updateWaiters(priority, 1)
+	defer rp.updateWaiters(priority, -1)
+
+	var shedTimer *time.Timer
+	defer func() {
+		if shedTimer != nil {
+			shedTimer.Stop()
+		}
+	}()
+	for {
+		blocked, changed := rp.priorityState(priority)
+		if !blocked {
+			select {
+			case wrapper, ok := <-rp.resources:
+				// A higher priority waiter may have come while we
+				// were waiting: hand the resource over to it.
+				if ok && priority < numPriorities-1 {
+					if blocked, _ := rp.priorityState(priority); blocked {
+						rp.resources <- wrapper
+						continue
+					}
+				}
+				return wrapper, ok, nil
+			case <-changed:
+				continue
+			case <-ctx.Done():
+				return resourceWrapper{}, false, ErrTimeout
+			}
+		}
+
+		var shed <-chan time.Time
+		if shedTimeout := rp.shedTimeout.Get(); shedTimeout > 0 {
+			if shedTimer == nil {
+				shedTimer = time.NewTimer(shedTimeout)
+			}
+			shed = shedTimer.C
+		}
+		select {
+		case <-changed:
+		case <-shed:
+			rp.priorityShed[priority].Add(1)
+			return resourceWrapper{}, false, ErrShed
+		case <-ctx.Done():
+			return resourceWrapper{}, false, ErrTimeout
+		}
+	}
+}
+
+// updateWaiters adds delta to the waiters of a priority, and notifies the
+// lower priorities if there are now some, or no more, waiters.
+func (rp *ResourcePool) updateWaiters(priority Priority, delta int) {
+	rp.waitersMu.Lock()
+	defer rp.waitersMu.Unlock()
+	before := rp.waiters[priority]
+	rp.waiters[priority] += delta
+	if (before == 0) == (rp.waiters[priority] == 0) {
+		return
+	}
+	for p := Priority(0); p < priority; p++ {
+		close(rp.priorityChanged[p])
+		rp.priorityChanged[p] = make(chan struct{})
+	}
+}
+
+// priorityState returns whether there are waiters of a higher priority,
+// and the channel which is closed when that changes.
+func (rp *ResourcePool) priorityState(priority Priority) (bool, chan struct{}) {
+	rp.waitersMu.Lock()
+	defer rp.waitersMu.Unlock()
+	for p := priority + 1; p < numPriorities; p++ {
+		if rp.waiters[p] > 0 {
+			return true, rp.priorityChanged[priority]
+		}
+	}
+	return false, rp.priorityChanged[priority]
+}
+
 // Put will return a resource to the pool. For every successful Get,
 // a corresponding Put is required. If you no longer need a resource,
 // you will need to call Put(nil) instead of returning the closed resource.
 // This will cause a new resource to be created in its place.
+// A resource which outlived its lifetime is closed, and a new one is
+// created in its place at the next Get.
 func (rp *ResourcePool) Put(resource Resource) {
 	var wrapper resourceWrapper
-	if resource != nil {
+	if resource != nil && rp.stale(resource) {
+		resource.Close()
+		rp.active.Add(-1)
+		rp.lifetimeClosed.Add(1)
+	} else if resource != nil {
 		wrapper = resourceWrapper{
 			resource: resource,
 			timeUsed: time.Now(),
@@ -269,6 +410,59 @@ func (rp *ResourcePool) Put(resource Resource) {
 	rp.available.Add(1)
 }
 
+// stale returns true if a resource was created before the last Drain, or
+// if it outlived the max lifetime, shortened by its jitter.
+func (rp *ResourcePool) stale(r Resource) bool {
+	resource, ok := r.(ExpirableResource)
+	if !ok {
+		return false
+	}
+	created := resource.CreateTime()
+	if drainTime := rp.drainTime.Get(); drainTime != 0 && created.UnixNano() < drainTime {
+		return true
+	}
+	maxLifetime := rp.maxLifetime.Get()
+	if maxLifetime <= 0 {
+		return false
+	}
+	return time.Since(created) > maxLifetime-lifetimeJitter(created, maxLifetime)
+}
+
+// lifetimeJitter returns the duration by which the lifetime of the
+// resource created at the given time is shortened. It's a hash of the
+// creation time, so that a resource gets the same jitter at every check
+// while the resources created together get different ones.
+func lifetimeJitter(created time.Time, maxLifetime time.Duration) time.Duration {
+	var b [8]byte
+	binary.LittleEndian.PutUint64(b[:], uint64(created.UnixNano()))
+	h := fnv.New64a()
+	h.Write(b[:])
+	return time.Duration(h.Sum64() % uint64(maxLifetime/maxLifetimeJitter+1))
+}
+
+// Drain replaces all the ExpirableResource resources: the idle ones are
+// closed and reopened now, the ones in use are closed when they're
+// returned to the pool.
+// It can be used to make the resources use new credentials, for instance.
+func (rp *ResourcePool) Drain() {
+	rp.drainTime.Set(time.Now().UnixNano())
+	available := int(rp.Available())
+	for i := 0; i < available; i++ {
+		var wrapper resourceWrapper
+		select {
+		case wrapper = <-rp.resources:
+		default:
+			return
+		}
+		if wrapper.resource != nil && rp.stale(wrapper.resource) {
+			wrapper.resource.Close()
+			rp.lifetimeClosed.Add(1)
+			rp.reopenResource(&wrapper)
+		}
+		rp.resources <- wrapper
+	}
+}
+
 func (rp *ResourcePool) reopenResource(wrapper *resourceWrapper) {
 	if r, err := rp.factory(context.TODO()); err == nil {
 		wrapper.resource = r
@@ -327,9 +521,12 @@ func (rp *ResourcePool) SetCapacity(capacity int) error {
 	return nil
 }
 
-func (rp *ResourcePool) recordWait(start time.Time) {
+func (rp *ResourcePool) recordWait(start time.Time, priority Priority) {
+	waitTime := time.Since(start)
 	rp.waitCount.Add(1)
-	rp.waitTime.Add(time.Since(start))
+	rp.waitTime.Add(waitTime)
+	rp.priorityWaitCount[priority].Add(1)
+	rp.priorityWaitTime[priority].Add(waitTime)
 	if rp.logWait != nil {
 		rp.logWait(start)
 	}
@@ -346,6 +543,23 @@ func (rp *ResourcePool) SetIdleTimeout(idleTimeout time.Duration) {
 	rp.idleTimer.SetInterval(idleTimeout / 10)
 }
 
+// SetShedTimeout sets how long the waiters of a priority wait for the
+// waiters of a higher priority before giving up with ErrShed.
+// A shed timeout of 0, the default, means they wait until their context
+// is done.
+func (rp *ResourcePool) SetShedTimeout(shedTimeout time.Duration) {
+	rp.shedTimeout.Set(shedTimeout)
+}
+
+// SetMaxLifetime sets how long resources are used before they're closed
+// and replaced. The lifetime of every resource is randomly shortened by
+// up to a tenth, so that they aren't all replaced at the same time.
+// A max lifetime of 0, the default, means resources are used as long as
+// they're not idle.
+func (rp *ResourcePool) SetMaxLifetime(maxLifetime time.Duration) {
+	rp.maxLifetime.Set(maxLifetime)
+}
+
 // StatsJSON returns the stats in JSON format.
 func (rp *ResourcePool) StatsJSON() string {
 	return fmt.Sprintf(`{"Capacity": %v, "Available": %v, "Active": %v, "InUse": %v, "MaxCapacity": %v, "WaitCount": %v, "WaitTime": %v, "IdleTimeout": %v, "IdleClosed": %v, "Exhausted": %v}`,
@@ -412,3 +626,43 @@ func (rp *ResourcePool) IdleClosed() int64 {
 func (rp *ResourcePool) Exhausted() int64 {
 	return rp.exhausted.Get()
 }
+
+// WaitCountByPriority returns the total number of waits of each priority.
+func (rp *ResourcePool) WaitCountByPriority() map[string]int64 {
+	result := make(map[string]int64, numPriorities)
+	for p := Priority(0); p < numPriorities; p++ {
+		result[p.String()] = rp.priorityWaitCount[p].Get()
+	}
+	return result
+}
+
+// WaitTimeByPriority returns the total wait time of each priority, in
+// nanoseconds.
+func (rp *ResourcePool) WaitTimeByPriority() map[string]int64 {
+	result := make(map[string]int64, numPriorities)
+	for p := Priority(0); p < numPriorities; p++ {
+		result[p.String()] = rp.priorityWaitTime[p].Get().Nanoseconds()
+	}
+	return result
+}
+
+// ShedByPriority returns the number of waiters of each priority that gave
+// up with ErrShed.
+func (rp *ResourcePool) ShedByPriority() map[string]int64 {
+	result := make(map[string]int64, numPriorities)
+	for p := Priority(0); p < numPriorities; p++ {
+		result[p.String()] = rp.priorityShed[p].Get()
+	}
+	return result
+}
+
+// MaxLifetime returns the max lifetime of the resources.
+func (rp *ResourcePool) MaxLifetime() time.Duration {
+	return rp.maxLifetime.Get()
+}
+
+// LifetimeClosed returns the number of resources closed because they
+// outlived the max lifetime or were drained.
+func (rp *ResourcePool) LifetimeClosed() int64 {
+	return rp.lifetimeClosed.Get()
+}
@@ -30,8 +30,9 @@ var lastID, count sync2.AtomicInt64
 var waitStarts []time.Time
 
 type TestResource struct {
-	num    int64
-	closed bool
+	num     int64
+	closed  bool
+	created time.Time
 }
 
 func (tr *TestResource) Close() {
@@ -41,13 +42,17 @@ func (tr *TestResource) Close() {
 	}
 }
 
+func (tr *TestResource) CreateTime() time.Time {
+	return tr.created
+}
+
 func logWait(start time.Time) {
 	waitStarts = append(waitStarts, start)
 }
 
 func PoolFactory(ctx context.Context) (Resource, error) {
 	count.Add(1)
-	return &TestResource{lastID.Add(1), false}, nil
+	return &TestResource{lastID.Add(1), false, time.Now()}, nil
 }
 
 func FailFactory(ctx context.Context) (Resource, error) {
@@ -669,3 +674,196 @@ func TestExpired(t *testing.T) {
 		t.Errorf("got %v, want %s", err, want)
 	}
 }
+
+// countedResource is a TestResource which counts the open resources of
+// its own test.
+type countedResource struct {
+	TestResource
+	count *sync2.AtomicInt64
+}
+
+func (cr *countedResource) Close() {
+	if !cr.closed {
+		cr.count.Add(-1)
+		cr.closed = true
+	}
+}
+
+func TestMaxLifetime(t *testing.T) {
+	ctx := context.Background()
+	var lastID, count sync2.AtomicInt64
+	factory := func(ctx context.Context) (Resource, error) {
+		count.Add(1)
+		return &countedResource{TestResource{lastID.Add(1), false, time.Now()}, &count}, nil
+	}
+	p := NewResourcePool(factory, 1, 1, time.Second, 0, logWait)
+	defer p.Close()
+	p.SetMaxLifetime(10 * time.Millisecond)
+
+	r, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Put(r)
+	r, err = p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num := r.(*countedResource).num; num != 1 {
+		t.Errorf("got resource %d, want 1", num)
+	}
+
+	// Returned after its lifetime, the resource is closed.
+	time.Sleep(20 * time.Millisecond)
+	p.Put(r)
+	if !r.(*countedResource).closed {
+		t.Errorf("expired resource was not closed")
+	}
+	if p.Active() != 0 || p.LifetimeClosed() != 1 {
+		t.Errorf("got active %d, lifetime closed %d, want 0, 1", p.Active(), p.LifetimeClosed())
+	}
+
+	// Idle after its lifetime, the resource is replaced by Get.
+	r, err = p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num := r.(*countedResource).num; num != 2 {
+		t.Errorf("got resource %d, want 2", num)
+	}
+	p.Put(r)
+	time.Sleep(20 * time.Millisecond)
+	r, err = p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num := r.(*countedResource).num; num != 3 {
+		t.Errorf("got resource %d, want 3", num)
+	}
+	p.Put(r)
+	if p.Active() != 1 || p.LifetimeClosed() != 2 || count.Get() != 1 {
+		t.Errorf("got active %d, lifetime closed %d, count %d, want 1, 2, 1", p.Active(), p.LifetimeClosed(), count.Get())
+	}
+}
+
+// closeOnlyResource is a Resource which doesn't know its age.
+type closeOnlyResource struct {
+	closed bool
+}
+
+func (cr *closeOnlyResource) Close() {
+	cr.closed = true
+}
+
+func TestMaxLifetimeNotExpirable(t *testing.T) {
+	ctx := context.Background()
+	factory := func(ctx context.Context) (Resource, error) {
+		return &closeOnlyResource{}, nil
+	}
+	p := NewResourcePool(factory, 1, 1, time.Second, 0, logWait)
+	defer p.Close()
+	p.SetMaxLifetime(time.Millisecond)
+
+	r, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The max lifetime and Drain don't apply to the resources which
+	// don't implement ExpirableResource.
+	time.Sleep(5 * time.Millisecond)
+	p.Drain()
+	p.Put(r)
+	got, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Put(got)
+	if got != r || r.(*closeOnlyResource).closed || p.LifetimeClosed() != 0 {
+		t.Errorf("resource was replaced: closed %v, lifetime closed %d", r.(*closeOnlyResource).closed, p.LifetimeClosed())
+	}
+}
+
+func TestDrain(t *testing.T) {
+	ctx := context.Background()
+	var lastID, count sync2.AtomicInt64
+	factory := func(ctx context.Context) (Resource, error) {
+		count.Add(1)
+		return &countedResource{TestResource{lastID.Add(1), false, time.Now()}, &count}, nil
+	}
+	p := NewResourcePool(factory, 2, 2, time.Second, 0, logWait)
+	defer p.Close()
+
+	idle, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inUse, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Put(idle)
+
+	time.Sleep(time.Millisecond)
+	p.Drain()
+	if !idle.(*countedResource).closed {
+		t.Errorf("idle resource was not closed by Drain")
+	}
+	if inUse.(*countedResource).closed {
+		t.Errorf("resource in use was closed by Drain")
+	}
+	if p.Active() != 2 || p.LifetimeClosed() != 1 {
+		t.Errorf("got active %d, lifetime closed %d, want 2, 1", p.Active(), p.LifetimeClosed())
+	}
+
+	p.Put(inUse)
+	if !inUse.(*countedResource).closed {
+		t.Errorf("drained resource was not closed by Put")
+	}
+	if p.Active() != 1 || p.LifetimeClosed() != 2 || count.Get() != 1 {
+		t.Errorf("got active %d, lifetime closed %d, count %d, want 1, 2, 1", p.Active(), p.LifetimeClosed(), count.Get())
+	}
+
+	// The resources created after the drain are kept.
+	r, err := p.Get(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num := r.(*countedResource).num; num != 3 {
+		t.Errorf("got resource %d, want 3", num)
+	}
+	p.Put(r)
+	if r.(*countedResource).closed || p.LifetimeClosed() != 2 {
+		t.Errorf("resource created after Drain was closed")
+	}
+}
+
+func TestMaxLifetimeJitter(t *testing.T) {
+	p := NewResourcePool(PoolFactory, 1, 1, time.Second, 0, logWait)
+	defer p.Close()
+	maxLifetime := time.Hour
+	p.SetMaxLifetime(maxLifetime)
+
+	// A resource in the jitter window is stale either at every check or
+	// at none.
+	r := &TestResource{created: time.Now().Add(-maxLifetime + maxLifetime/maxLifetimeJitter/2)}
+	want := p.stale(r)
+	for i := 0; i < 100; i++ {
+		if got := p.stale(r); got != want {
+			t.Fatalf("check %d: got stale %v, want %v", i, got, want)
+		}
+	}
+
+	// The resources created together get different jitters.
+	now := time.Now()
+	jitters := make(map[time.Duration]bool)
+	for i := 0; i < 10; i++ {
+		jitter := lifetimeJitter(now.Add(time.Duration(i)), maxLifetime)
+		if jitter < 0 || jitter > maxLifetime/maxLifetimeJitter {
+			t.Errorf("got jitter %v, want at most %v", jitter, maxLifetime/maxLifetimeJitter)
+		}
+		jitters[jitter] = true
+	}
+	if len(jitters) < 2 {
+		t.Errorf("got the same jitter for all resources: %v", jitters)
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pools
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// waitForWaiters waits until the pool has n waiters of a priority.
+func waitForWaiters(t *testing.T, p *ResourcePool, priority Priority, n int) {
+	t.Helper()
+	for i := 0; i < 500; i++ {
+		p.waitersMu.Lock()
+		waiters := p.waiters[priority]
+		p.waitersMu.Unlock()
+		if waiters == n {
+			return
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d %v waiters", n, priority)
+}
+
+func TestPriorityFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, PriorityOLTP, PriorityFromContext(ctx))
+	assert.Equal(t, PriorityOLAP, PriorityFromContext(WithPriority(ctx, PriorityOLAP)))
+	assert.Equal(t, PriorityBackground, PriorityFromContext(WithPriority(ctx, PriorityBackground)))
+	assert.Equal(t, PriorityOLTP, PriorityFromContext(WithPriority(ctx, Priority(42))))
+	assert.Equal(t, "OLAP", PriorityOLAP.String())
+}
+
+func TestPriorityOrder(t *testing.T) {
+	ctx := context.Background()
+	p := NewResourcePool(PoolFactory, 1, 1, time.Second, 0, logWait)
+	defer p.Close()
+	r, err := p.Get(ctx)
+	require.NoError(t, err)
+
+	got := make(chan Priority, 3)
+	get := func(priority Priority) {
+		r, err := p.Get(WithPriority(ctx, priority))
+		if err != nil {
+			t.Error(err)
+			got <- -1
+			return
+		}
+		got <- priority
+		p.Put(r)
+	}
+	go get(PriorityBackground)
+	waitForWaiters(t, p, PriorityBackground, 1)
+	go get(PriorityOLAP)
+	waitForWaiters(t, p, PriorityOLAP, 1)
+	go get(PriorityOLTP)
+	waitForWaiters(t, p, PriorityOLTP, 1)
+
+	p.Put(r)
+	assert.Equal(t, PriorityOLTP, <-got)
+	assert.Equal(t, PriorityOLAP, <-got)
+	assert.Equal(t, PriorityBackground, <-got)
+
+	assert.EqualValues(t, 1, p.WaitCountByPriority()["OLTP"])
+	assert.EqualValues(t, 1, p.WaitCountByPriority()["OLAP"])
+	assert.EqualValues(t, 1, p.WaitCountByPriority()["Background"])
+	assert.EqualValues(t, 3, p.WaitCount())
+	assert.Greater(t, p.WaitTimeByPriority()["Background"], p.WaitTimeByPriority()["OLTP"])
+}
+
+func TestPriorityShed(t *testing.T) {
+	ctx := context.Background()
+	p := NewResourcePool(PoolFactory, 1, 1, time.Second, 0, logWait)
+	defer p.Close()
+	p.SetShedTimeout(10 * time.Millisecond)
+	r, err := p.Get(ctx)
+	require.NoError(t, err)
+
+	// Without higher priority waiters, a low priority waiter isn't shed.
+	olapCtx, cancel := context.WithTimeout(WithPriority(ctx, PriorityOLAP), 50*time.Millisecond)
+	_, err = p.Get(olapCtx)
+	cancel()
+	assert.Equal(t, ErrTimeout, err)
+
+	oltpDone := make(chan error)
+	go func() {
+		r, err := p.Get(ctx)
+		if err == nil {
+			p.Put(r)
+		}
+		oltpDone <- err
+	}()
+	waitForWaiters(t, p, PriorityOLTP, 1)
+
+	_, err = p.Get(WithPriority(ctx, PriorityOLAP))
+	assert.Equal(t, ErrShed, err)
+	assert.EqualValues(t, 1, p.ShedByPriority()["OLAP"])
+	assert.EqualValues(t, 0, p.ShedByPriority()["OLTP"])
+
+	p.Put(r)
+	require.NoError(t, <-oltpDone)
+}
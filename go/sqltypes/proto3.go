@@ -51,21 +51,46 @@ func RowToProto3(row []Value) *querypb.Row {
 	return result
 }
 
-// RowsToProto3 converts [][]Value to proto3.
+// RowsToProto3 converts [][]Value to proto3. The lengths and values
+// of all the rows are stored in two shared buffers, to avoid allocating
+// them for every row.
 func RowsToProto3(rows [][]Value) []*querypb.Row {
 	if len(rows) == 0 {
 		return nil
 	}
 
+	totalCols, totalBytes := 0, 0
+	for _, r := range rows {
+		totalCols += len(r)
+		for _, c := range r {
+			totalBytes += c.Len()
+		}
+	}
+	lengths := make([]int64, 0, totalCols)
+	values := make([]byte, 0, totalBytes)
+	rowStructs := make([]querypb.Row, len(rows))
+
 	result := make([]*querypb.Row, len(rows))
 	for i, r := range rows {
-		result[i] = RowToProto3(r)
+		lengthsStart, valuesStart := len(lengths), len(values)
+		for _, c := range r {
+			if c.IsNull() {
+				lengths = append(lengths, -1)
+				continue
+			}
+			lengths = append(lengths, int64(c.Len()))
+			values = append(values, c.Raw()...)
+		}
+		rowStructs[i].Lengths = lengths[lengthsStart:len(lengths):len(lengths)]
+		rowStructs[i].Values = values[valuesStart:len(values):len(values)]
+		result[i] = &rowStructs[i]
 	}
 	return result
 }
 
 // proto3ToRows converts a proto3 rows to [][]Value. The function is private
-// because it uses the trusted API.
+// because it uses the trusted API. The values reference the proto3 rows,
+// and all the rows are carved out of a single slab of Values.
 func proto3ToRows(fields []*querypb.Field, rows []*querypb.Row) [][]Value {
 	if len(rows) == 0 {
 		// TODO(sougou): This is needed for backward compatibility.
@@ -73,9 +98,18 @@ func proto3ToRows(fields []*querypb.Field, rows []*querypb.Row) [][]Value {
 		return [][]Value{}
 	}
 
+	totalCols := 0
+	for _, r := range rows {
+		totalCols += len(r.Lengths)
+	}
+	slab := make([]Value, totalCols)
+
 	result := make([][]Value, len(rows))
 	for i, r := range rows {
-		result[i] = MakeRowTrusted(fields, r)
+		cols := len(r.Lengths)
+		result[i] = slab[:cols:cols]
+		slab = slab[cols:]
+		fillRowTrusted(fields, r, result[i])
 	}
 	return result
 }
@@ -90,6 +124,7 @@ func ResultToProto3(qr *Result) *querypb.QueryResult {
 		RowsAffected: qr.RowsAffected,
 		InsertId:     qr.InsertID,
 		Rows:         RowsToProto3(qr.Rows),
+		Warnings:     qr.Warnings,
 	}
 }
 
@@ -104,6 +139,7 @@ func Proto3ToResult(qr *querypb.QueryResult) *Result {
 		RowsAffected: qr.RowsAffected,
 		InsertID:     qr.InsertId,
 		Rows:         proto3ToRows(qr.Fields, qr.Rows),
+		Warnings:     qr.Warnings,
 	}
 }
 
@@ -119,6 +155,7 @@ func CustomProto3ToResult(fields []*querypb.Field, qr *querypb.QueryResult) *Res
 		RowsAffected: qr.RowsAffected,
 		InsertID:     qr.InsertId,
 		Rows:         proto3ToRows(fields, qr.Rows),
+		Warnings:     qr.Warnings,
 	}
 }
 
@@ -321,3 +321,37 @@ func TestProto3ValuesEqual(t *testing.T) {
 		require.Equal(t, tc.expected, Proto3ValuesEqual(tc.v1, tc.v2))
 	}
 }
+
+func benchmarkRows(rowCount int) ([]*querypb.Field, [][]Value) {
+	fields := MakeTestFields("id|name|price|created", "int64|varchar|float64|datetime")
+	rows := make([][]Value, rowCount)
+	for i := range rows {
+		rows[i] = []Value{
+			NewInt64(int64(i)),
+			NewVarChar("a name that is somewhat long"),
+			NewFloat64(float64(i) / 3),
+			NULL,
+		}
+	}
+	return fields, rows
+}
+
+func BenchmarkResultToProto3(b *testing.B) {
+	fields, rows := benchmarkRows(10000)
+	qr := &Result{Fields: fields, Rows: rows}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		ResultToProto3(qr)
+	}
+}
+
+func BenchmarkProto3ToResult(b *testing.B) {
+	fields, rows := benchmarkRows(10000)
+	p3 := ResultToProto3(&Result{Fields: fields, Rows: rows})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Proto3ToResult(p3)
+	}
+}
@@ -32,6 +32,8 @@ type Result struct {
 	Rows                [][]Value        `json:"rows"`
 	SessionStateChanges string           `json:"session_state_changes"`
 	StatusFlags         uint16           `json:"status_flags"`
+	// Warnings are added to the warnings of the vtgate session.
+	Warnings []*querypb.QueryWarning `json:"warnings,omitempty"`
 }
 
 //goland:noinspection GoUnusedConst
@@ -78,6 +80,7 @@ func (result *Result) Copy() *Result {
 	out := &Result{
 		InsertID:     result.InsertID,
 		RowsAffected: result.RowsAffected,
+		Warnings:     result.Warnings,
 	}
 	if result.Fields != nil {
 		fieldsp := make([]*querypb.Field, len(result.Fields))
@@ -181,6 +184,13 @@ func ResultsEqual(r1, r2 []Result) bool {
 // why it's justified.
 func MakeRowTrusted(fields []*querypb.Field, row *querypb.Row) []Value {
 	sqlRow := make([]Value, len(row.Lengths))
+	fillRowTrusted(fields, row, sqlRow)
+	return sqlRow
+}
+
+// fillRowTrusted is like MakeRowTrusted, but it stores the values in sqlRow,
+// which must have one NULL Value for every column of row.
+func fillRowTrusted(fields []*querypb.Field, row *querypb.Row, sqlRow []Value) {
 	var offset int64
 	for i, length := range row.Lengths {
 		if length < 0 {
@@ -189,7 +199,6 @@ func MakeRowTrusted(fields []*querypb.Field, row *querypb.Row) []Value {
 		sqlRow[i] = MakeTrusted(fields[i].Type, row.Values[offset:offset+length])
 		offset += length
 	}
-	return sqlRow
 }
 
 // IncludeFieldsOrDefault normalizes the passed Execution Options.
@@ -0,0 +1,66 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqltypes
+
+const (
+	// rowsPerSlab is the number of rows a RowAllocator carves out of each
+	// slab of Values.
+	rowsPerSlab = 128
+
+	// bytesPerSlab is the size of the slabs a RowAllocator copies values into.
+	// Values larger than a quarter of a slab get their own buffer, so a single
+	// large value does not waste the rest of the slab.
+	bytesPerSlab = 16 * 1024
+)
+
+// RowAllocator hands out rows and value buffers carved from larger slabs,
+// so building a result with many rows does not need one allocation per row
+// and per value. A slab is garbage collected once none of the rows and
+// values carved from it are referenced anymore.
+//
+// The zero value is ready to use. A RowAllocator is not safe for concurrent use.
+type RowAllocator struct {
+	values []Value
+	bytes  []byte
+}
+
+// Row returns a row of cols NULL values. Appending to the row never
+// overwrites the rows carved after it.
+func (a *RowAllocator) Row(cols int) []Value {
+	if cols > len(a.values) {
+		a.values = make([]Value, cols*rowsPerSlab)
+	}
+	row := a.values[:cols:cols]
+	a.values = a.values[cols:]
+	return row
+}
+
+// Copy returns a copy of b.
+func (a *RowAllocator) Copy(b []byte) []byte {
+	switch {
+	case len(b) == 0:
+		return []byte{}
+	case len(b) > bytesPerSlab/4:
+		return append([]byte(nil), b...)
+	case len(b) > len(a.bytes):
+		a.bytes = make([]byte, bytesPerSlab)
+	}
+	out := a.bytes[:len(b):len(b)]
+	copy(out, b)
+	a.bytes = a.bytes[len(b):]
+	return out
+}
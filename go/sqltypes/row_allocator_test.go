@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqltypes
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRowAllocator(t *testing.T) {
+	var alloc RowAllocator
+
+	row1 := alloc.Row(3)
+	row2 := alloc.Row(2)
+	assert.Equal(t, []Value{NULL, NULL, NULL}, row1)
+	assert.Equal(t, 3, cap(row1))
+
+	// Appending to a row must not overwrite the next one.
+	row2[0] = NewInt64(1)
+	row1 = append(row1, NewInt64(2))
+	assert.Equal(t, NewInt64(1), row2[0])
+	assert.Equal(t, NewInt64(2), row1[3])
+
+	// Rows larger than a slab still work.
+	assert.Len(t, alloc.Row(rowsPerSlab*2), rowsPerSlab*2)
+
+	src := []byte("abc")
+	copied := alloc.Copy(src)
+	src[0] = 'x'
+	assert.Equal(t, []byte("abc"), copied)
+	assert.Equal(t, 3, cap(copied))
+
+	assert.Equal(t, []byte{}, alloc.Copy(nil))
+
+	large := bytes.Repeat([]byte("x"), bytesPerSlab)
+	assert.Equal(t, large, alloc.Copy(large))
+}
@@ -122,6 +122,37 @@ var pushBackends = make(map[string]PushBackend)
 var pushBackendsLock sync.Mutex
 var once sync.Once
 
+var (
+	resourceAttributesMu sync.Mutex
+	resourceAttributes   = make(map[string]string)
+)
+
+// SetResourceAttribute sets an attribute of the process which exports the
+// stats, e.g. the cell of a tablet. The push backends which support it
+// attach the attributes to all the stats. An empty value removes the
+// attribute.
+func SetResourceAttribute(key, value string) {
+	resourceAttributesMu.Lock()
+	defer resourceAttributesMu.Unlock()
+	if value == "" {
+		delete(resourceAttributes, key)
+		return
+	}
+	resourceAttributes[key] = value
+}
+
+// ResourceAttributes returns a copy of the attributes set by
+// SetResourceAttribute.
+func ResourceAttributes() map[string]string {
+	resourceAttributesMu.Lock()
+	defer resourceAttributesMu.Unlock()
+	attributes := make(map[string]string, len(resourceAttributes))
+	for key, value := range resourceAttributes {
+		attributes[key] = value
+	}
+	return attributes
+}
+
 // RegisterPushBackend allows modules to register PushBackend implementations.
 // Should be called on init().
 func RegisterPushBackend(name string, backend PushBackend) {
@@ -18,11 +18,25 @@ package stats
 
 import (
 	"bytes"
+	"encoding/json"
+	"flag"
 	"fmt"
+	"sync"
+	"time"
 
 	"vitess.io/vitess/go/sync2"
 )
 
+var exemplarInterval = flag.Duration("stats_exemplar_interval", 0, "If set, the histograms which support it keep an exemplar per bucket, e.g. the digest of a query for the query timings, replaced at most once per interval. The exemplars are exported to /debug/vars, and to Prometheus on the buckets of the histograms when it scrapes the OpenMetrics format.")
+
+// Exemplar is a sample of the values added to a histogram bucket, with a
+// label identifying where it comes from, e.g. the digest of a query.
+type Exemplar struct {
+	Value int64
+	Label string
+	Time  time.Time
+}
+
 // Histogram tracks counts and totals while
 // splitting the counts under different buckets
 // using specified cutoffs.
@@ -37,6 +51,11 @@ type Histogram struct {
 
 	buckets []sync2.AtomicInt64
 	total   sync2.AtomicInt64
+
+	// exemplarsMu protects exemplars, which is allocated by the first
+	// AddWithExemplar.
+	exemplarsMu sync.Mutex
+	exemplars   []*Exemplar
 }
 
 // NewHistogram creates a histogram with auto-generated labels
@@ -77,19 +96,55 @@ func NewGenericHistogram(name, help string, cutoffs []int64, labels []string, co
 
 // Add adds a new measurement to the Histogram.
 func (h *Histogram) Add(value int64) {
-	for i := range h.labels {
-		if i == len(h.labels)-1 || value <= h.cutoffs[i] {
-			h.buckets[i].Add(1)
-			h.total.Add(value)
-			break
-		}
+	h.add(value)
+}
+
+// AddWithExemplar adds a new measurement to the Histogram, and keeps it as
+// the exemplar of its bucket if the exemplars are enabled and the previous
+// one is older than -stats_exemplar_interval. exemplar returns the label of
+// the exemplar: it's only called if the measurement is kept.
+func (h *Histogram) AddWithExemplar(value int64, exemplar func() string) {
+	i := h.add(value)
+	interval := *exemplarInterval
+	if interval <= 0 {
+		return
+	}
+
+	h.exemplarsMu.Lock()
+	defer h.exemplarsMu.Unlock()
+	if h.exemplars == nil {
+		h.exemplars = make([]*Exemplar, len(h.labels))
 	}
+	now := time.Now()
+	if previous := h.exemplars[i]; previous != nil && now.Sub(previous.Time) < interval {
+		return
+	}
+	h.exemplars[i] = &Exemplar{Value: value, Label: exemplar(), Time: now}
+}
+
+// add adds a new measurement to the Histogram, and returns the index of
+// its bucket.
+func (h *Histogram) add(value int64) int {
+	i := h.bucket(value)
+	h.buckets[i].Add(1)
+	h.total.Add(value)
 	if h.hook != nil {
 		h.hook(value)
 	}
 	if defaultStatsdHook.histogramHook != nil && h.name != "" {
 		defaultStatsdHook.histogramHook(h.name, value)
 	}
+	return i
+}
+
+// bucket returns the index of the bucket of value.
+func (h *Histogram) bucket(value int64) int {
+	for i, cutoff := range h.cutoffs {
+		if value <= cutoff {
+			return i
+		}
+	}
+	return len(h.labels) - 1
 }
 
 // String returns a string representation of the Histogram.
@@ -114,6 +169,19 @@ func (h *Histogram) MarshalJSON() ([]byte, error) {
 	}
 	fmt.Fprintf(b, "\"%s\": %v, ", h.countLabel, totalCount)
 	fmt.Fprintf(b, "\"%s\": %v", h.totalLabel, h.total.Get())
+	if exemplars := h.Exemplars(); exemplars != nil {
+		byLabel := make(map[string]*Exemplar)
+		for i, exemplar := range exemplars {
+			if exemplar != nil {
+				byLabel[h.labels[i]] = exemplar
+			}
+		}
+		data, err := json.Marshal(byLabel)
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(b, ", \"Exemplars\": %s", data)
+	}
 	fmt.Fprintf(b, "}")
 	return b.Bytes(), nil
 }
@@ -169,6 +237,24 @@ func (h *Histogram) Buckets() []int64 {
 	return buckets
 }
 
+// Exemplars returns a snapshot of the exemplars of the buckets, or nil if
+// no exemplar was captured. The buckets without exemplar have a nil entry.
+func (h *Histogram) Exemplars() []*Exemplar {
+	h.exemplarsMu.Lock()
+	defer h.exemplarsMu.Unlock()
+	if h.exemplars == nil {
+		return nil
+	}
+	exemplars := make([]*Exemplar, len(h.exemplars))
+	for i, exemplar := range h.exemplars {
+		if exemplar != nil {
+			e := *exemplar
+			exemplars[i] = &e
+		}
+	}
+	return exemplars
+}
+
 // Help returns the help string.
 func (h *Histogram) Help() string {
 	return h.help
@@ -18,7 +18,9 @@ package stats
 
 import (
 	"expvar"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestHistogram(t *testing.T) {
@@ -87,3 +89,38 @@ func TestHistogramHook(t *testing.T) {
 		t.Errorf("got %#v, want %#v", gotv, v)
 	}
 }
+
+func TestHistogramExemplars(t *testing.T) {
+	clear()
+	h := NewHistogram("hist_exemplars", "help", []int64{1, 5})
+
+	// Exemplars are disabled by default.
+	h.AddWithExemplar(3, func() string { return "first" })
+	if got := h.Exemplars(); got != nil {
+		t.Errorf("got exemplars %v, want none", got)
+	}
+
+	*exemplarInterval = time.Hour
+	defer func() { *exemplarInterval = 0 }()
+	h.AddWithExemplar(3, func() string { return "second" })
+	h.AddWithExemplar(4, func() string { return "third" })
+	h.AddWithExemplar(10, func() string { return "fourth" })
+	h.Add(1)
+
+	exemplars := h.Exemplars()
+	if len(exemplars) != 3 {
+		t.Fatalf("got %d exemplars, want 3", len(exemplars))
+	}
+	if exemplars[0] != nil {
+		t.Errorf("got exemplar %v for bucket 1, want none", exemplars[0])
+	}
+	if e := exemplars[1]; e == nil || e.Label != "second" || e.Value != 3 {
+		t.Errorf("got exemplar %v for bucket 5, want second", e)
+	}
+	if e := exemplars[2]; e == nil || e.Label != "fourth" || e.Value != 10 {
+		t.Errorf("got exemplar %v for bucket inf, want fourth", e)
+	}
+	if got, want := h.String(), `{"1": 1, "5": 3, "inf": 1, "Count": 5, "Total": 21, "Exemplars": {"5":{"Value":3,"Label":"second"`; !strings.HasPrefix(got, want) {
+		t.Errorf("got %v, want prefix %v", got, want)
+	}
+}
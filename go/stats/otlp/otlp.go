@@ -0,0 +1,452 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package otlp adds support for pushing stats to an OpenTelemetry
+// collector, with the OTLP/HTTP protocol and its JSON encoding.
+package otlp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"expvar"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+)
+
+var (
+	otlpMetricsEndpoint = flag.String("otlp_metrics_endpoint", "", "URL of the OTLP/HTTP metrics endpoint of an OpenTelemetry collector, e.g. http://localhost:4318/v1/metrics. The stats are pushed to it when -stats_backend is otlp.")
+	otlpMetricsHeaders  = flag.String("otlp_metrics_headers", "", "Comma separated list of <name>=<value> HTTP headers sent with the stats pushed to the OTLP endpoint, e.g. for authentication.")
+	otlpMetricsTimeout  = flag.Duration("otlp_metrics_timeout", 10*time.Second, "Timeout of the pushes of the stats to the OTLP endpoint.")
+)
+
+const (
+	// aggregationTemporalityCumulative means the values of the sums and
+	// histograms are totals since the start time.
+	aggregationTemporalityCumulative = 2
+
+	scopeName = "vitess.io/vitess/go/stats"
+)
+
+// The types below are the JSON encoding of an OTLP
+// ExportMetricsServiceRequest. 64-bit integers are strings, as in the
+// protobuf JSON mapping.
+
+type exportMetricsServiceRequest struct {
+	ResourceMetrics []resourceMetrics `json:"resourceMetrics"`
+}
+
+type resourceMetrics struct {
+	Resource     resource       `json:"resource"`
+	ScopeMetrics []scopeMetrics `json:"scopeMetrics"`
+}
+
+type resource struct {
+	Attributes []keyValue `json:"attributes"`
+}
+
+type scopeMetrics struct {
+	Scope   scope    `json:"scope"`
+	Metrics []metric `json:"metrics"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+type keyValue struct {
+	Key   string   `json:"key"`
+	Value anyValue `json:"value"`
+}
+
+type anyValue struct {
+	StringValue string `json:"stringValue"`
+}
+
+type metric struct {
+	Name        string     `json:"name"`
+	Description string     `json:"description,omitempty"`
+	Unit        string     `json:"unit,omitempty"`
+	Sum         *sum       `json:"sum,omitempty"`
+	Gauge       *gauge     `json:"gauge,omitempty"`
+	Histogram   *histogram `json:"histogram,omitempty"`
+}
+
+type sum struct {
+	DataPoints             []numberDataPoint `json:"dataPoints"`
+	AggregationTemporality int               `json:"aggregationTemporality"`
+	IsMonotonic            bool              `json:"isMonotonic"`
+}
+
+type gauge struct {
+	DataPoints []numberDataPoint `json:"dataPoints"`
+}
+
+type histogram struct {
+	DataPoints             []histogramDataPoint `json:"dataPoints"`
+	AggregationTemporality int                  `json:"aggregationTemporality"`
+}
+
+type numberDataPoint struct {
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano,omitempty"`
+	TimeUnixNano      string     `json:"timeUnixNano"`
+	AsInt             *string    `json:"asInt,omitempty"`
+	AsDouble          *float64   `json:"asDouble,omitempty"`
+}
+
+type histogramDataPoint struct {
+	Attributes        []keyValue `json:"attributes,omitempty"`
+	StartTimeUnixNano string     `json:"startTimeUnixNano"`
+	TimeUnixNano      string     `json:"timeUnixNano"`
+	Count             string     `json:"count"`
+	Sum               float64    `json:"sum"`
+	BucketCounts      []string   `json:"bucketCounts"`
+	ExplicitBounds    []float64  `json:"explicitBounds"`
+	Exemplars         []exemplar `json:"exemplars,omitempty"`
+}
+
+type exemplar struct {
+	FilteredAttributes []keyValue `json:"filteredAttributes"`
+	TimeUnixNano       string     `json:"timeUnixNano"`
+	AsDouble           float64    `json:"asDouble"`
+}
+
+// otlpBackend implements stats.PushBackend.
+type otlpBackend struct {
+	endpoint string
+	headers  map[string]string
+	client   *http.Client
+	// serviceName is the name of the binary, e.g. vttablet.
+	serviceName string
+	// startTime is the start time of the cumulative values.
+	startTime time.Time
+}
+
+// Init registers the OTLP push backend if -otlp_metrics_endpoint is set.
+// serviceName is the name of the binary, which is sent as the service.name
+// resource attribute along with the attributes set by
+// stats.SetResourceAttribute.
+func Init(serviceName string) {
+	// Needs to happen in servenv.OnRun() instead of init because it requires flag parsing and logging
+	servenv.OnRun(func() {
+		if *otlpMetricsEndpoint == "" {
+			return
+		}
+		backend, err := newBackend(serviceName, *otlpMetricsEndpoint, *otlpMetricsHeaders, *otlpMetricsTimeout)
+		if err != nil {
+			log.Exitf("otlp: %v", err)
+		}
+		stats.RegisterPushBackend("otlp", backend)
+
+		http.HandleFunc("/debug/otlp_metrics", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json; charset=utf-8")
+			if b, err := json.MarshalIndent(backend.getRequest(time.Now()), "", "  "); err != nil {
+				w.Write([]byte(err.Error()))
+			} else {
+				w.Write(b)
+			}
+		})
+	})
+}
+
+func newBackend(serviceName, endpoint, headers string, timeout time.Duration) (*otlpBackend, error) {
+	backend := &otlpBackend{
+		endpoint:    endpoint,
+		headers:     make(map[string]string),
+		client:      &http.Client{Timeout: timeout},
+		serviceName: serviceName,
+		startTime:   time.Now(),
+	}
+	for _, header := range strings.Split(headers, ",") {
+		if strings.TrimSpace(header) == "" {
+			continue
+		}
+		parts := strings.SplitN(header, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid -otlp_metrics_headers entry %q, expected <name>=<value>", header)
+		}
+		backend.headers[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	return backend, nil
+}
+
+// PushAll pushes all stats to the OTLP endpoint.
+func (backend *otlpBackend) PushAll() error {
+	data, err := json.Marshal(backend.getRequest(time.Now()))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), backend.client.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, backend.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for name, value := range backend.headers {
+		req.Header.Set(name, value)
+	}
+	resp, err := backend.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("OTLP endpoint %s returned %s: %s", backend.endpoint, resp.Status, strings.TrimSpace(string(body)))
+	}
+	return nil
+}
+
+// getRequest returns the export request of all the stats.
+// This is separated from PushAll() so it can be reused for the /debug/otlp_metrics handler.
+func (backend *otlpBackend) getRequest(now time.Time) *exportMetricsServiceRequest {
+	collector := &dataCollector{
+		startTime: strconv.FormatInt(backend.startTime.UnixNano(), 10),
+		time:      strconv.FormatInt(now.UnixNano(), 10),
+	}
+	expvar.Do(collector.addExpVar)
+
+	return &exportMetricsServiceRequest{
+		ResourceMetrics: []resourceMetrics{{
+			Resource: resource{Attributes: backend.resourceAttributes()},
+			ScopeMetrics: []scopeMetrics{{
+				Scope:   scope{Name: scopeName},
+				Metrics: collector.metrics,
+			}},
+		}},
+	}
+}
+
+// resourceAttributes returns the attributes of the process.
+func (backend *otlpBackend) resourceAttributes() []keyValue {
+	attributes := stats.ResourceAttributes()
+	attributes["service.name"] = backend.serviceName
+	if hostname, err := os.Hostname(); err == nil {
+		attributes["host.name"] = hostname
+	}
+	return makeAttributes(attributes)
+}
+
+// dataCollector tracks state for a single pass of stats collection.
+type dataCollector struct {
+	startTime string
+	time      string
+	metrics   []metric
+}
+
+// addExpVar adds the metric of an expvar. The well-known stats types are
+// converted, the other expvars are skipped.
+func (dc *dataCollector) addExpVar(kv expvar.KeyValue) {
+	k := kv.Key
+	switch v := kv.Value.(type) {
+	case stats.FloatFunc:
+		dc.addGauge(k, v.Help(), "", []numberDataPoint{dc.doublePoint(v(), nil)})
+	case *stats.Counter:
+		dc.addSum(k, v.Help(), "", []numberDataPoint{dc.intPoint(v.Get(), nil)})
+	case *stats.CounterFunc:
+		dc.addSum(k, v.Help(), "", []numberDataPoint{dc.intPoint(v.F(), nil)})
+	case *stats.Gauge:
+		dc.addGauge(k, v.Help(), "", []numberDataPoint{dc.intPoint(v.Get(), nil)})
+	case *stats.GaugeFunc:
+		dc.addGauge(k, v.Help(), "", []numberDataPoint{dc.intPoint(v.F(), nil)})
+	case *stats.CounterDuration:
+		dc.addSum(k, v.Help(), "s", []numberDataPoint{dc.doublePoint(v.Get().Seconds(), nil)})
+	case *stats.CounterDurationFunc:
+		dc.addSum(k, v.Help(), "s", []numberDataPoint{dc.doublePoint(v.F().Seconds(), nil)})
+	case *stats.GaugeDuration:
+		dc.addGauge(k, v.Help(), "s", []numberDataPoint{dc.doublePoint(v.Get().Seconds(), nil)})
+	case *stats.GaugeDurationFunc:
+		dc.addGauge(k, v.Help(), "s", []numberDataPoint{dc.doublePoint(v.F().Seconds(), nil)})
+	case *stats.CountersWithSingleLabel:
+		dc.addSum(k, v.Help(), "", dc.intPoints([]string{v.Label()}, v.Counts()))
+	case *stats.CountersWithMultiLabels:
+		dc.addSum(k, v.Help(), "", dc.intPoints(v.Labels(), v.Counts()))
+	case *stats.CountersFuncWithMultiLabels:
+		dc.addSum(k, v.Help(), "", dc.intPoints(v.Labels(), v.Counts()))
+	case *stats.GaugesWithSingleLabel:
+		dc.addGauge(k, v.Help(), "", dc.intPoints([]string{v.Label()}, v.Counts()))
+	case *stats.GaugesWithMultiLabels:
+		dc.addGauge(k, v.Help(), "", dc.intPoints(v.Labels(), v.Counts()))
+	case *stats.GaugesFuncWithMultiLabels:
+		dc.addGauge(k, v.Help(), "", dc.intPoints(v.Labels(), v.Counts()))
+	case *stats.MultiTimings:
+		dc.addTimings(k, v.Labels(), &v.Timings)
+	case *stats.Timings:
+		dc.addTimings(k, []string{v.Label()}, v)
+	case *stats.Histogram:
+		dc.addHistogram(k, v.Help(), "", []histogramDataPoint{dc.histogramPoint(v, 1, nil)})
+	}
+}
+
+func (dc *dataCollector) addSum(name, help, unit string, dataPoints []numberDataPoint) {
+	dc.metrics = append(dc.metrics, metric{
+		Name:        name,
+		Description: help,
+		Unit:        unit,
+		Sum: &sum{
+			DataPoints:             dataPoints,
+			AggregationTemporality: aggregationTemporalityCumulative,
+			IsMonotonic:            true,
+		},
+	})
+}
+
+func (dc *dataCollector) addGauge(name, help, unit string, dataPoints []numberDataPoint) {
+	dc.metrics = append(dc.metrics, metric{
+		Name:        name,
+		Description: help,
+		Unit:        unit,
+		Gauge:       &gauge{DataPoints: dataPoints},
+	})
+}
+
+func (dc *dataCollector) addHistogram(name, help, unit string, dataPoints []histogramDataPoint) {
+	dc.metrics = append(dc.metrics, metric{
+		Name:        name,
+		Description: help,
+		Unit:        unit,
+		Histogram: &histogram{
+			DataPoints:             dataPoints,
+			AggregationTemporality: aggregationTemporalityCumulative,
+		},
+	})
+}
+
+// addTimings converts a vitess Timings stat to a histogram in seconds.
+func (dc *dataCollector) addTimings(name string, labels []string, timings *stats.Timings) {
+	histograms := timings.Histograms()
+	keys := make([]string, 0, len(histograms))
+	for key := range histograms {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	dataPoints := make([]histogramDataPoint, 0, len(keys))
+	for _, key := range keys {
+		dataPoints = append(dataPoints, dc.histogramPoint(histograms[key], 1e9, makeLabels(labels, key)))
+	}
+	dc.addHistogram(name, timings.Help(), "s", dataPoints)
+}
+
+func (dc *dataCollector) intPoint(value int64, attributes []keyValue) numberDataPoint {
+	s := strconv.FormatInt(value, 10)
+	return numberDataPoint{
+		Attributes:        attributes,
+		StartTimeUnixNano: dc.startTime,
+		TimeUnixNano:      dc.time,
+		AsInt:             &s,
+	}
+}
+
+func (dc *dataCollector) doublePoint(value float64, attributes []keyValue) numberDataPoint {
+	return numberDataPoint{
+		Attributes:        attributes,
+		StartTimeUnixNano: dc.startTime,
+		TimeUnixNano:      dc.time,
+		AsDouble:          &value,
+	}
+}
+
+// intPoints returns the data points of a multi-dimensional stat, sorted
+// by label values.
+func (dc *dataCollector) intPoints(labels []string, counts map[string]int64) []numberDataPoint {
+	keys := make([]string, 0, len(counts))
+	for key := range counts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	dataPoints := make([]numberDataPoint, 0, len(keys))
+	for _, key := range keys {
+		dataPoints = append(dataPoints, dc.intPoint(counts[key], makeLabels(labels, key)))
+	}
+	return dataPoints
+}
+
+// histogramPoint returns the data point of a histogram, whose values are
+// divided by divideBy.
+func (dc *dataCollector) histogramPoint(h *stats.Histogram, divideBy float64, attributes []keyValue) histogramDataPoint {
+	buckets := h.Buckets()
+	bucketCounts := make([]string, len(buckets))
+	var count int64
+	for i, bucket := range buckets {
+		bucketCounts[i] = strconv.FormatInt(bucket, 10)
+		count += bucket
+	}
+	cutoffs := h.Cutoffs()
+	bounds := make([]float64, len(cutoffs))
+	for i, cutoff := range cutoffs {
+		bounds[i] = float64(cutoff) / divideBy
+	}
+	dataPoint := histogramDataPoint{
+		Attributes:        attributes,
+		StartTimeUnixNano: dc.startTime,
+		TimeUnixNano:      dc.time,
+		Count:             strconv.FormatInt(count, 10),
+		Sum:               float64(h.Total()) / divideBy,
+		BucketCounts:      bucketCounts,
+		ExplicitBounds:    bounds,
+	}
+	for _, e := range h.Exemplars() {
+		if e == nil {
+			continue
+		}
+		dataPoint.Exemplars = append(dataPoint.Exemplars, exemplar{
+			FilteredAttributes: makeAttributes(map[string]string{"exemplar": e.Label}),
+			TimeUnixNano:       strconv.FormatInt(e.Time.UnixNano(), 10),
+			AsDouble:           float64(e.Value) / divideBy,
+		})
+	}
+	return dataPoint
+}
+
+// makeLabels takes the vitess stat representation of label values ("."-separated list) and breaks it
+// apart into attributes.
+func makeLabels(labelNames []string, labelValsCombined string) []keyValue {
+	attributes := make(map[string]string, len(labelNames))
+	labelVals := strings.Split(labelValsCombined, ".")
+	for i, v := range labelVals {
+		if i < len(labelNames) {
+			attributes[labelNames[i]] = v
+		}
+	}
+	return makeAttributes(attributes)
+}
+
+// makeAttributes returns the attributes of a map, sorted by key.
+func makeAttributes(attributes map[string]string) []keyValue {
+	keys := make([]string, 0, len(attributes))
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	kvs := make([]keyValue, 0, len(keys))
+	for _, key := range keys {
+		kvs = append(kvs, keyValue{Key: key, Value: anyValue{StringValue: attributes[key]}})
+	}
+	return kvs
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package otlp
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/stats"
+)
+
+// findMetric returns the metric with the given name.
+func findMetric(t *testing.T, req *exportMetricsServiceRequest, name string) metric {
+	t.Helper()
+	require.Len(t, req.ResourceMetrics, 1)
+	require.Len(t, req.ResourceMetrics[0].ScopeMetrics, 1)
+	for _, m := range req.ResourceMetrics[0].ScopeMetrics[0].Metrics {
+		if m.Name == name {
+			return m
+		}
+	}
+	require.FailNow(t, "metric not found", name)
+	return metric{}
+}
+
+func TestOTLPCounter(t *testing.T) {
+	name := "otlp_counter"
+	c := stats.NewCounter(name, "counter description")
+	c.Add(3)
+
+	backend, err := newBackend("vtgate", "", "", time.Second)
+	require.NoError(t, err)
+	m := findMetric(t, backend.getRequest(time.Unix(0, 1234)), name)
+	assert.Equal(t, "counter description", m.Description)
+	require.NotNil(t, m.Sum)
+	assert.True(t, m.Sum.IsMonotonic)
+	assert.Equal(t, aggregationTemporalityCumulative, m.Sum.AggregationTemporality)
+	require.Len(t, m.Sum.DataPoints, 1)
+	assert.Equal(t, "3", *m.Sum.DataPoints[0].AsInt)
+	assert.Equal(t, "1234", m.Sum.DataPoints[0].TimeUnixNano)
+}
+
+func TestOTLPGaugesWithMultiLabels(t *testing.T) {
+	name := "otlp_gauges_with_multi_labels"
+	gauges := stats.NewGaugesWithMultiLabels(name, "help", []string{"flavor", "texture"})
+	gauges.Add([]string{"sour", "brittle"}, 3)
+
+	backend, err := newBackend("vtgate", "", "", time.Second)
+	require.NoError(t, err)
+	m := findMetric(t, backend.getRequest(time.Now()), name)
+	require.NotNil(t, m.Gauge)
+	require.Len(t, m.Gauge.DataPoints, 1)
+	dp := m.Gauge.DataPoints[0]
+	assert.Equal(t, "3", *dp.AsInt)
+	assert.Equal(t, []keyValue{
+		{Key: "flavor", Value: anyValue{StringValue: "sour"}},
+		{Key: "texture", Value: anyValue{StringValue: "brittle"}},
+	}, dp.Attributes)
+}
+
+func TestOTLPTimings(t *testing.T) {
+	name := "otlp_timings"
+	timings := stats.NewTimings(name, "help", "category")
+	timings.Add("foo", 2*time.Millisecond)
+	timings.Add("foo", 200*time.Millisecond)
+
+	backend, err := newBackend("vtgate", "", "", time.Second)
+	require.NoError(t, err)
+	m := findMetric(t, backend.getRequest(time.Now()), name)
+	assert.Equal(t, "s", m.Unit)
+	require.NotNil(t, m.Histogram)
+	require.Len(t, m.Histogram.DataPoints, 1)
+	dp := m.Histogram.DataPoints[0]
+	assert.Equal(t, "2", dp.Count)
+	assert.InDelta(t, 0.202, dp.Sum, 1e-9)
+	assert.Len(t, dp.BucketCounts, len(dp.ExplicitBounds)+1)
+	assert.Equal(t, []keyValue{{Key: "category", Value: anyValue{StringValue: "foo"}}}, dp.Attributes)
+}
+
+func TestOTLPPushAll(t *testing.T) {
+	stats.NewGauge("otlp_push_gauge", "help").Set(7)
+	stats.SetResourceAttribute("keyspace", "ks")
+	defer stats.SetResourceAttribute("keyspace", "")
+
+	var body []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	backend, err := newBackend("vttablet", server.URL, "Authorization=Bearer token, X-Scope=vitess", time.Second)
+	require.NoError(t, err)
+	require.NoError(t, backend.PushAll())
+
+	assert.Equal(t, "application/json", header.Get("Content-Type"))
+	assert.Equal(t, "Bearer token", header.Get("Authorization"))
+	assert.Equal(t, "vitess", header.Get("X-Scope"))
+
+	var req exportMetricsServiceRequest
+	require.NoError(t, json.Unmarshal(body, &req))
+	attributes := map[string]string{}
+	for _, kv := range req.ResourceMetrics[0].Resource.Attributes {
+		attributes[kv.Key] = kv.Value.StringValue
+	}
+	assert.Equal(t, "vttablet", attributes["service.name"])
+	assert.Equal(t, "ks", attributes["keyspace"])
+	m := findMetric(t, &req, "otlp_push_gauge")
+	require.NotNil(t, m.Gauge)
+	assert.Equal(t, "7", *m.Gauge.DataPoints[0].AsInt)
+}
+
+func TestOTLPPushAllError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	backend, err := newBackend("vttablet", server.URL, "", time.Second)
+	require.NoError(t, err)
+	err = backend.PushAll()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "503")
+}
+
+func TestOTLPInvalidHeaders(t *testing.T) {
+	_, err := newBackend("vttablet", "http://localhost", "novalue", time.Second)
+	require.Error(t, err)
+}
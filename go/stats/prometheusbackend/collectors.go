@@ -17,9 +17,13 @@ limitations under the License.
 package prometheusbackend
 
 import (
+	"math"
 	"strings"
 
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
 
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/log"
@@ -249,20 +253,13 @@ func (c *metricsFuncWithMultiLabelsCollector) Collect(ch chan<- prometheus.Metri
 }
 
 type timingsCollector struct {
-	t       *stats.Timings
-	cutoffs []float64
-	desc    *prometheus.Desc
+	t    *stats.Timings
+	desc *prometheus.Desc
 }
 
 func newTimingsCollector(t *stats.Timings, name string) {
-	cutoffs := make([]float64, len(t.Cutoffs()))
-	for i, val := range t.Cutoffs() {
-		cutoffs[i] = float64(val) / 1000000000
-	}
-
 	collector := &timingsCollector{
-		t:       t,
-		cutoffs: cutoffs,
+		t: t,
 		desc: prometheus.NewDesc(
 			name,
 			t.Help(),
@@ -281,15 +278,16 @@ func (c *timingsCollector) Describe(ch chan<- *prometheus.Desc) {
 // Collect implements Collector.
 func (c *timingsCollector) Collect(ch chan<- prometheus.Metric) {
 	for cat, his := range c.t.Histograms() {
+		cutoffs := scaleCutoffs(his.Cutoffs(), 1000000000)
 		metric, err := prometheus.NewConstHistogram(c.desc,
 			uint64(his.Count()),
 			float64(his.Total())/1000000000,
-			makeCumulativeBuckets(c.cutoffs,
+			makeCumulativeBuckets(cutoffs,
 				his.Buckets()), cat)
 		if err != nil {
 			log.Errorf("Error adding metric: %s", c.desc)
 		} else {
-			ch <- metric
+			ch <- withExemplars(metric, his, cutoffs, 1000000000)
 		}
 	}
 }
@@ -305,21 +303,88 @@ func makeCumulativeBuckets(cutoffs []float64, buckets []int64) map[float64]uint6
 	return output
 }
 
-type multiTimingsCollector struct {
-	mt      *stats.MultiTimings
+// scaleCutoffs returns the cutoffs of a histogram divided by scale, e.g.
+// in seconds for timings.
+func scaleCutoffs(cutoffs []int64, scale float64) []float64 {
+	scaled := make([]float64, len(cutoffs))
+	for i, val := range cutoffs {
+		scaled[i] = float64(val) / scale
+	}
+	return scaled
+}
+
+// histogramWithExemplars is a histogram metric which exports the exemplars
+// of its buckets. They're only exposed in the OpenMetrics format, which
+// Prometheus negotiates when its exemplar storage is enabled.
+type histogramWithExemplars struct {
+	prometheus.Metric
+	exemplars []*stats.Exemplar
+	// cutoffs are the upper bounds of the buckets of the exemplars,
+	// except the last one which is +Inf.
 	cutoffs []float64
-	desc    *prometheus.Desc
+	scale   float64
 }
 
-func newMultiTimingsCollector(mt *stats.MultiTimings, name string) {
-	cutoffs := make([]float64, len(mt.Cutoffs()))
-	for i, val := range mt.Cutoffs() {
-		cutoffs[i] = float64(val) / 1000000000
+// withExemplars returns metric, a histogram built from his with the given
+// cutoffs, with the exemplars of his divided by scale.
+func withExemplars(metric prometheus.Metric, his *stats.Histogram, cutoffs []float64, scale float64) prometheus.Metric {
+	exemplars := his.Exemplars()
+	if exemplars == nil {
+		return metric
 	}
+	return &histogramWithExemplars{Metric: metric, exemplars: exemplars, cutoffs: cutoffs, scale: scale}
+}
 
+// Write implements Metric.
+func (m *histogramWithExemplars) Write(out *dto.Metric) error {
+	if err := m.Metric.Write(out); err != nil {
+		return err
+	}
+	buckets := make(map[float64]*dto.Bucket, len(out.Histogram.Bucket))
+	for _, b := range out.Histogram.Bucket {
+		buckets[b.GetUpperBound()] = b
+	}
+	for i, exemplar := range m.exemplars {
+		if exemplar == nil {
+			continue
+		}
+		upperBound := math.Inf(+1)
+		if i < len(m.cutoffs) {
+			upperBound = m.cutoffs[i]
+		}
+		b, ok := buckets[upperBound]
+		if !ok {
+			if !math.IsInf(upperBound, +1) {
+				continue
+			}
+			// The +Inf bucket is implicit: add it to hold its exemplar.
+			b = &dto.Bucket{
+				CumulativeCount: proto.Uint64(out.Histogram.GetSampleCount()),
+				UpperBound:      proto.Float64(upperBound),
+			}
+			out.Histogram.Bucket = append(out.Histogram.Bucket, b)
+		}
+		timestamp, err := ptypes.TimestampProto(exemplar.Time)
+		if err != nil {
+			return err
+		}
+		b.Exemplar = &dto.Exemplar{
+			Label:     []*dto.LabelPair{{Name: proto.String("exemplar"), Value: proto.String(exemplar.Label)}},
+			Value:     proto.Float64(float64(exemplar.Value) / m.scale),
+			Timestamp: timestamp,
+		}
+	}
+	return nil
+}
+
+type multiTimingsCollector struct {
+	mt   *stats.MultiTimings
+	desc *prometheus.Desc
+}
+
+func newMultiTimingsCollector(mt *stats.MultiTimings, name string) {
 	collector := &multiTimingsCollector{
-		mt:      mt,
-		cutoffs: cutoffs,
+		mt: mt,
 		desc: prometheus.NewDesc(
 			name,
 			mt.Help(),
@@ -339,16 +404,17 @@ func (c *multiTimingsCollector) Describe(ch chan<- *prometheus.Desc) {
 func (c *multiTimingsCollector) Collect(ch chan<- prometheus.Metric) {
 	for cat, his := range c.mt.Timings.Histograms() {
 		labelValues := strings.Split(cat, ".")
+		cutoffs := scaleCutoffs(his.Cutoffs(), 1000000000)
 		metric, err := prometheus.NewConstHistogram(
 			c.desc,
 			uint64(his.Count()),
 			float64(his.Total())/1000000000,
-			makeCumulativeBuckets(c.cutoffs, his.Buckets()),
+			makeCumulativeBuckets(cutoffs, his.Buckets()),
 			labelValues...)
 		if err != nil {
 			log.Errorf("Error adding metric: %s", c.desc)
 		} else {
-			ch <- metric
+			ch <- withExemplars(metric, his, cutoffs, 1000000000)
 		}
 	}
 }
@@ -360,14 +426,9 @@ type histogramCollector struct {
 }
 
 func newHistogramCollector(h *stats.Histogram, name string) {
-	cutoffs := make([]float64, len(h.Cutoffs()))
-	for i, val := range h.Cutoffs() {
-		cutoffs[i] = float64(val)
-	}
-
 	collector := &histogramCollector{
 		h:       h,
-		cutoffs: cutoffs,
+		cutoffs: scaleCutoffs(h.Cutoffs(), 1),
 		desc: prometheus.NewDesc(
 			name,
 			h.Help(),
@@ -392,6 +453,6 @@ func (c *histogramCollector) Collect(ch chan<- prometheus.Metric) {
 	if err != nil {
 		log.Errorf("Error adding metric: %s", c.desc)
 	} else {
-		ch <- metric
+		ch <- withExemplars(metric, c.h, c.cutoffs, 1)
 	}
 }
@@ -39,7 +39,11 @@ var (
 
 // Init initializes the Prometheus be with the given namespace.
 func Init(namespace string) {
-	http.Handle("/metrics", promhttp.Handler())
+	// OpenMetrics is the only format which exports the exemplars of the
+	// histograms.
+	http.Handle("/metrics", promhttp.InstrumentMetricHandler(
+		prometheus.DefaultRegisterer,
+		promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{EnableOpenMetrics: true})))
 	be.namespace = namespace
 	stats.Register(be.publishPrometheusMetric)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package prometheusbackend
 
 import (
+	"flag"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -306,6 +307,64 @@ func TestPrometheusMultiTimings(t *testing.T) {
 	}
 }
 
+func TestPrometheusTimingsExemplars(t *testing.T) {
+	flag.Set("stats_exemplar_interval", "1h")
+	defer flag.Set("stats_exemplar_interval", "0")
+
+	name := "blah_timings_exemplars"
+	timing := stats.NewTimings(name, "help", "category")
+	timing.AddWithExemplar("cat1", 30*time.Millisecond, func() string { return "digest1" })
+	timing.AddWithExemplar("cat1", 20*time.Second, func() string { return "digest2" })
+
+	// The exemplars are exported on their buckets in the OpenMetrics
+	// format, including the implicit +Inf bucket.
+	req, _ := http.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=0.0.1")
+	response := httptest.NewRecorder()
+	http.DefaultServeMux.ServeHTTP(response, req)
+	for _, line := range []string{
+		fmt.Sprintf("%s_%s_bucket{category=\"cat1\",le=\"0.05\"} 1 # {exemplar=\"digest1\"} 0.03 ", namespace, name),
+		fmt.Sprintf("%s_%s_bucket{category=\"cat1\",le=\"+Inf\"} 2 # {exemplar=\"digest2\"} 20.0 ", namespace, name),
+		fmt.Sprintf("%s_%s_count{category=\"cat1\"} 2", namespace, name),
+	} {
+		if !strings.Contains(response.Body.String(), line) {
+			t.Fatalf("Expected result to contain %s, got %s", line, response.Body.String())
+		}
+	}
+
+	// The text format has no exemplars, but keeps the buckets.
+	response = testMetricsHandler(t)
+	if strings.Contains(response.Body.String(), "digest1") {
+		t.Fatalf("Expected result not to contain the exemplars, got %s", response.Body.String())
+	}
+	for _, line := range []string{
+		fmt.Sprintf("%s_%s_bucket{category=\"cat1\",le=\"+Inf\"} 2", namespace, name),
+		fmt.Sprintf("%s_%s_count{category=\"cat1\"} 2", namespace, name),
+	} {
+		if !strings.Contains(response.Body.String(), line) {
+			t.Fatalf("Expected result to contain %s, got %s", line, response.Body.String())
+		}
+	}
+}
+
+func TestPrometheusHistogramExemplars(t *testing.T) {
+	flag.Set("stats_exemplar_interval", "1h")
+	defer flag.Set("stats_exemplar_interval", "0")
+
+	name := "blah_histogram_exemplars"
+	hist := stats.NewHistogram(name, "help", []int64{1, 5, 10})
+	hist.AddWithExemplar(2, func() string { return "digest1" })
+
+	req, _ := http.NewRequest("GET", "/metrics", nil)
+	req.Header.Set("Accept", "application/openmetrics-text; version=0.0.1")
+	response := httptest.NewRecorder()
+	http.DefaultServeMux.ServeHTTP(response, req)
+	line := fmt.Sprintf("%s_%s_bucket{le=\"5.0\"} 1 # {exemplar=\"digest1\"} 2.0 ", namespace, name)
+	if !strings.Contains(response.Body.String(), line) {
+		t.Fatalf("Expected result to contain %s, got %s", line, response.Body.String())
+	}
+}
+
 func TestPrometheusMultiTimings_PanicWrongLength(t *testing.T) {
 	defer func() {
 		if r := recover(); r == nil {
@@ -18,13 +18,19 @@ package stats
 
 import (
 	"encoding/json"
+	"flag"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
 
 	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/log"
 )
 
+var timingsBucketsFlag = flag.String("stats_timings_buckets", "", "Semicolon separated list of <timings name>:<comma separated durations>, the cutoffs of the histogram buckets of these timings, e.g. 'Queries:1ms,5ms,10ms,50ms,100ms;Transactions:10ms,100ms,1s'. The name * sets the cutoffs of all the other timings.")
+
 // Timings is meant to tracks timing data
 // by named categories as well as histograms.
 type Timings struct {
@@ -33,6 +39,11 @@ type Timings struct {
 
 	mu         sync.RWMutex
 	histograms map[string]*Histogram
+	// cutoffs and labels are the buckets of the histograms. They're
+	// resolved from -stats_timings_buckets once the flags are parsed.
+	cutoffs         []int64
+	labels          []string
+	bucketsResolved sync2.AtomicBool
 
 	name          string
 	help          string
@@ -52,8 +63,9 @@ func NewTimings(name, help, label string, categories ...string) *Timings {
 		label:         label,
 		labelCombined: IsDimensionCombined(label),
 	}
+	t.resolveBuckets()
 	for _, cat := range categories {
-		t.histograms[cat] = NewGenericHistogram("", "", bucketCutoffs, bucketLabels, "Count", "Time")
+		t.histograms[cat] = t.newHistogram()
 	}
 	if name != "" {
 		publish(name, t)
@@ -71,30 +83,48 @@ func (t *Timings) Reset() {
 
 // Add will add a new value to the named histogram.
 func (t *Timings) Add(name string, elapsed time.Duration) {
+	t.add(name, elapsed, nil)
+}
+
+// AddWithExemplar will add a new value to the named histogram, with an
+// exemplar if the histogram keeps it. See Histogram.AddWithExemplar.
+func (t *Timings) AddWithExemplar(name string, elapsed time.Duration, exemplar func() string) {
+	t.add(name, elapsed, exemplar)
+}
+
+func (t *Timings) add(name string, elapsed time.Duration, exemplar func() string) {
 	if t.labelCombined {
 		name = StatsAllStr
 	}
-	// Get existing Histogram.
+	if !t.bucketsResolved.Get() {
+		t.resolveBuckets()
+	}
+	if defaultStatsdHook.timerHook != nil && t.name != "" {
+		defaultStatsdHook.timerHook(t.name, name, elapsed.Milliseconds(), t)
+	}
+
+	// The value is added under the read lock, so that resolveBuckets
+	// doesn't replace the histogram in the meantime.
 	t.mu.RLock()
 	hist, ok := t.histograms[name]
-	t.mu.RUnlock()
-
-	// Create Histogram if it does not exist.
-	if !ok {
+	for !ok {
+		// Create Histogram if it does not exist.
+		t.mu.RUnlock()
 		t.mu.Lock()
-		hist, ok = t.histograms[name]
-		if !ok {
-			hist = NewGenericHistogram("", "", bucketCutoffs, bucketLabels, "Count", "Time")
-			t.histograms[name] = hist
+		if _, ok := t.histograms[name]; !ok {
+			t.histograms[name] = t.newHistogram()
 		}
 		t.mu.Unlock()
+		t.mu.RLock()
+		hist, ok = t.histograms[name]
 	}
-	if defaultStatsdHook.timerHook != nil && t.name != "" {
-		defaultStatsdHook.timerHook(t.name, name, elapsed.Milliseconds(), t)
-	}
-
 	elapsedNs := int64(elapsed)
-	hist.Add(elapsedNs)
+	if exemplar != nil {
+		hist.AddWithExemplar(elapsedNs, exemplar)
+	} else {
+		hist.Add(elapsedNs)
+	}
+	t.mu.RUnlock()
 	t.totalCount.Add(1)
 	t.totalTime.Add(elapsedNs)
 }
@@ -108,8 +138,59 @@ func (t *Timings) Record(name string, startTime time.Time) {
 	t.Add(name, time.Since(startTime))
 }
 
+// newHistogram returns a histogram with the buckets of t.
+// The mutex must be held.
+func (t *Timings) newHistogram() *Histogram {
+	return NewGenericHistogram("", "", t.cutoffs, t.labels, "Count", "Time")
+}
+
+// resolveBuckets sets the buckets of the histograms from
+// -stats_timings_buckets. Until the flags are parsed, the default buckets
+// are used, and the histograms are recreated with the configured buckets
+// once they are. See rebucket for what happens to their measurements.
+func (t *Timings) resolveBuckets() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.bucketsResolved.Get() {
+		return
+	}
+	t.cutoffs, t.labels = timingsBuckets(t.name)
+	if !flag.Parsed() {
+		return
+	}
+	for name, hist := range t.histograms {
+		if !int64sEqual(hist.Cutoffs(), t.cutoffs) {
+			t.histograms[name] = t.rebucket(hist)
+		}
+	}
+	t.bucketsResolved.Set(true)
+}
+
+// rebucket returns a histogram with the buckets of t and the measurements
+// of hist. As their exact values are not known anymore, the measurements
+// are counted in the bucket of the upper bound of their bucket in hist.
+// The mutex must be held.
+func (t *Timings) rebucket(hist *Histogram) *Histogram {
+	rebucketed := t.newHistogram()
+	for i, count := range hist.Buckets() {
+		if count == 0 {
+			continue
+		}
+		bucket := len(rebucketed.labels) - 1
+		if i < len(hist.cutoffs) {
+			bucket = rebucketed.bucket(hist.cutoffs[i])
+		}
+		rebucketed.buckets[bucket].Add(count)
+	}
+	rebucketed.total.Add(hist.Total())
+	return rebucketed
+}
+
 // String is for expvar.
 func (t *Timings) String() string {
+	if !t.bucketsResolved.Get() {
+		t.resolveBuckets()
+	}
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 
@@ -132,6 +213,9 @@ func (t *Timings) String() string {
 
 // Histograms returns a map pointing at the histograms.
 func (t *Timings) Histograms() (h map[string]*Histogram) {
+	if !t.bucketsResolved.Get() {
+		t.resolveBuckets()
+	}
 	t.mu.RLock()
 	defer t.mu.RUnlock()
 	h = make(map[string]*Histogram, len(t.histograms))
@@ -167,7 +251,12 @@ func (t *Timings) Counts() map[string]int64 {
 // Cutoffs returns the cutoffs used in the component histograms.
 // Do not change the returned slice.
 func (t *Timings) Cutoffs() []int64 {
-	return bucketCutoffs
+	if !t.bucketsResolved.Get() {
+		t.resolveBuckets()
+	}
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.cutoffs
 }
 
 // Help returns the help string.
@@ -185,11 +274,95 @@ var bucketCutoffs = []int64{5e5, 1e6, 5e6, 1e7, 5e7, 1e8, 5e8, 1e9, 5e9, 1e10}
 var bucketLabels []string
 
 func init() {
-	bucketLabels = make([]string, len(bucketCutoffs)+1)
-	for i, v := range bucketCutoffs {
-		bucketLabels[i] = fmt.Sprintf("%d", v)
+	bucketLabels = cutoffLabels(bucketCutoffs)
+}
+
+// cutoffLabels returns the labels of the buckets of cutoffs.
+func cutoffLabels(cutoffs []int64) []string {
+	labels := make([]string, len(cutoffs)+1)
+	for i, v := range cutoffs {
+		labels[i] = fmt.Sprintf("%d", v)
+	}
+	labels[len(labels)-1] = "inf"
+	return labels
+}
+
+var (
+	timingsBucketsMu sync.Mutex
+	// timingsBucketsConfig has the cutoffs of -stats_timings_buckets by
+	// timings name. It's parsed at the first use after the flags are.
+	timingsBucketsConfig map[string][]int64
+)
+
+// timingsBuckets returns the cutoffs and labels of the histograms of the
+// timings of the given name.
+func timingsBuckets(name string) ([]int64, []string) {
+	timingsBucketsMu.Lock()
+	defer timingsBucketsMu.Unlock()
+
+	if timingsBucketsConfig == nil {
+		if !flag.Parsed() {
+			return bucketCutoffs, bucketLabels
+		}
+		config, err := parseTimingsBuckets(*timingsBucketsFlag)
+		if err != nil {
+			log.Errorf("Ignoring -stats_timings_buckets: %v", err)
+		}
+		timingsBucketsConfig = config
+	}
+	cutoffs, ok := timingsBucketsConfig[name]
+	if !ok || name == "" {
+		cutoffs, ok = timingsBucketsConfig["*"]
+	}
+	if !ok {
+		return bucketCutoffs, bucketLabels
 	}
-	bucketLabels[len(bucketLabels)-1] = "inf"
+	return cutoffs, cutoffLabels(cutoffs)
+}
+
+// parseTimingsBuckets parses the value of -stats_timings_buckets. The
+// cutoffs are sorted and deduplicated.
+func parseTimingsBuckets(value string) (map[string][]int64, error) {
+	config := make(map[string][]int64)
+	for _, entry := range strings.Split(value, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return map[string][]int64{}, fmt.Errorf("invalid entry %q, expected <name>:<durations>", entry)
+		}
+		var cutoffs []int64
+		for _, token := range strings.Split(parts[1], ",") {
+			d, err := time.ParseDuration(strings.TrimSpace(token))
+			if err != nil || d <= 0 {
+				return map[string][]int64{}, fmt.Errorf("invalid duration %q in entry %q", token, entry)
+			}
+			cutoffs = append(cutoffs, int64(d))
+		}
+		sort.Slice(cutoffs, func(i, j int) bool { return cutoffs[i] < cutoffs[j] })
+		deduped := cutoffs[:1]
+		for _, cutoff := range cutoffs[1:] {
+			if cutoff != deduped[len(deduped)-1] {
+				deduped = append(deduped, cutoff)
+			}
+		}
+		config[strings.TrimSpace(parts[0])] = deduped
+	}
+	return config, nil
+}
+
+func int64sEqual(a, b []int64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
 }
 
 // MultiTimings is meant to tracks timing data by categories as well
@@ -217,6 +390,7 @@ func NewMultiTimings(name string, help string, labels []string) *MultiTimings {
 		labels:         labels,
 		combinedLabels: combinedLabels,
 	}
+	t.resolveBuckets()
 	if name != "" {
 		publish(name, t)
 	}
@@ -237,6 +411,15 @@ func (mt *MultiTimings) Add(names []string, elapsed time.Duration) {
 	mt.Timings.Add(safeJoinLabels(names, mt.combinedLabels), elapsed)
 }
 
+// AddWithExemplar will add a new value to the named histogram, with an
+// exemplar if the histogram keeps it. See Histogram.AddWithExemplar.
+func (mt *MultiTimings) AddWithExemplar(names []string, elapsed time.Duration, exemplar func() string) {
+	if len(names) != len(mt.labels) {
+		panic("MultiTimings: wrong number of values in AddWithExemplar")
+	}
+	mt.Timings.AddWithExemplar(safeJoinLabels(names, mt.combinedLabels), elapsed, exemplar)
+}
+
 // Record is a convenience function that records completion
 // timing data based on the provided start time of an event.
 func (mt *MultiTimings) Record(names []string, startTime time.Time) {
@@ -249,5 +432,5 @@ func (mt *MultiTimings) Record(names []string, startTime time.Time) {
 // Cutoffs returns the cutoffs used in the component histograms.
 // Do not change the returned slice.
 func (mt *MultiTimings) Cutoffs() []int64 {
-	return bucketCutoffs
+	return mt.Timings.Cutoffs()
 }
@@ -99,3 +99,55 @@ func TestTimingsCombineDimension(t *testing.T) {
 	want = `{"TotalCount":1,"TotalTime":1,"Histograms":{"all.c2.all":{"500000":1,"1000000":0,"5000000":0,"10000000":0,"50000000":0,"100000000":0,"500000000":0,"1000000000":0,"5000000000":0,"10000000000":0,"inf":0,"Count":1,"Time":1}}}`
 	assert.Equal(t, want, t3.String())
 }
+
+func TestTimingsBuckets(t *testing.T) {
+	clear()
+	defer func() {
+		*timingsBucketsFlag = ""
+		timingsBucketsConfig = nil
+	}()
+	*timingsBucketsFlag = "timing_buckets1:10ms,1ms,10ms; *:1s"
+	timingsBucketsConfig = nil
+
+	t1 := NewTimings("timing_buckets1", "help", "label", "t1")
+	assert.Equal(t, []int64{1e6, 1e7}, t1.Cutoffs())
+	t1.Add("t1", 5*time.Millisecond)
+	t1.Add("t2", 50*time.Millisecond)
+	want := `{"TotalCount":2,"TotalTime":55000000,"Histograms":{"t1":{"1000000":0,"10000000":1,"inf":0,"Count":1,"Time":5000000},"t2":{"1000000":0,"10000000":0,"inf":1,"Count":1,"Time":50000000}}}`
+	assert.Equal(t, want, t1.String())
+
+	t2 := NewMultiTimings("timing_buckets2", "help", []string{"a", "b"})
+	assert.Equal(t, []int64{1e9}, t2.Cutoffs())
+
+	// The measurements made with the default buckets, before the flags
+	// are parsed, are kept when the buckets are resolved.
+	t3 := &Timings{histograms: make(map[string]*Histogram), cutoffs: bucketCutoffs, labels: bucketLabels}
+	hist := t3.newHistogram()
+	hist.Add(int64(5 * time.Millisecond))
+	hist.Add(int64(20 * time.Second))
+	t3.histograms["t3"] = hist
+	t3.resolveBuckets()
+	assert.Equal(t, []int64{1e9}, t3.Histograms()["t3"].Cutoffs())
+	assert.Equal(t, map[string]int64{"1000000000": 1, "inf": 1}, t3.Histograms()["t3"].Counts())
+	assert.EqualValues(t, 5*time.Millisecond+20*time.Second, t3.Histograms()["t3"].Total())
+
+	_, err := parseTimingsBuckets("timing_buckets1:10")
+	assert.EqualError(t, err, `invalid duration "10" in entry "timing_buckets1:10"`)
+	_, err = parseTimingsBuckets("10ms")
+	assert.EqualError(t, err, `invalid entry "10ms", expected <name>:<durations>`)
+}
+
+func TestTimingsExemplars(t *testing.T) {
+	clear()
+	*exemplarInterval = time.Hour
+	defer func() { *exemplarInterval = 0 }()
+
+	mtm := NewMultiTimings("timing_exemplars", "help", []string{"a", "b"})
+	mtm.AddWithExemplar([]string{"a1", "b1"}, 2*time.Millisecond, func() string { return "digest" })
+	exemplars := mtm.Histograms()["a1.b1"].Exemplars()
+	if assert.Len(t, exemplars, len(bucketLabels)) && assert.NotNil(t, exemplars[2]) {
+		assert.Equal(t, "digest", exemplars[2].Label)
+		assert.EqualValues(t, 2*time.Millisecond, exemplars[2].Value)
+	}
+	assert.EqualValues(t, 1, mtm.Count())
+}
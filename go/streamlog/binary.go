@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+
+	"github.com/golang/protobuf/proto"
+)
+
+// maxBinaryRecordSize is the size beyond which a record of a log in the
+// binary format is considered corrupted.
+const maxBinaryRecordSize = 64 * 1024 * 1024
+
+// ProtoFormatter is implemented by the messages which can be logged in the
+// binary format.
+type ProtoFormatter interface {
+	// LogProto returns the record of the message, or nil if the message
+	// must not be logged.
+	LogProto() proto.Message
+}
+
+// GetBinaryFormatter returns a formatter function writing the records of
+// objects conforming to the ProtoFormatter interface in the binary
+// format: each record is a protobuf message, preceded by its size as a
+// varint.
+func GetBinaryFormatter(logger *StreamLogger) LogFormatter {
+	return func(w io.Writer, _ url.Values, val interface{}) error {
+		fmter, ok := val.(ProtoFormatter)
+		if !ok {
+			return fmt.Errorf("unexpected value of type %T in %s", val, logger.Name())
+		}
+		msg := fmter.LogProto()
+		if msg == nil {
+			return nil
+		}
+		return writeBinaryRecord(w, msg)
+	}
+}
+
+// writeBinaryRecord writes a record in a single call to w, so that it is
+// never split across rotated files.
+func writeBinaryRecord(w io.Writer, msg proto.Message) error {
+	data, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	buf := make([]byte, binary.MaxVarintLen64, binary.MaxVarintLen64+len(data))
+	n := binary.PutUvarint(buf, uint64(len(data)))
+	buf = append(buf[:n], data...)
+	_, err = w.Write(buf)
+	return err
+}
+
+// BinaryLogReader reads the records of a log in the binary format.
+type BinaryLogReader struct {
+	r       *bufio.Reader
+	closers []io.Closer
+	buf     []byte
+}
+
+// NewBinaryLogReader returns a reader of the records of a log in the
+// binary format.
+func NewBinaryLogReader(r io.Reader) *BinaryLogReader {
+	return &BinaryLogReader{r: bufio.NewReader(r)}
+}
+
+// OpenBinaryLog opens a log file in the binary format, decompressing it
+// if it was compressed when it was rotated.
+func OpenBinaryLog(path string) (*BinaryLogReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(f)
+	magic, err := br.Peek(2)
+	if err != nil && err != io.EOF {
+		f.Close()
+		return nil, err
+	}
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			f.Close()
+			return nil, err
+		}
+		return &BinaryLogReader{r: bufio.NewReader(zr), closers: []io.Closer{zr, f}}, nil
+	}
+	return &BinaryLogReader{r: br, closers: []io.Closer{f}}, nil
+}
+
+// Next reads the next record of the log into msg. It returns io.EOF at
+// the end of the log, and io.ErrUnexpectedEOF if the last record is
+// truncated.
+func (r *BinaryLogReader) Next(msg proto.Message) error {
+	size, err := binary.ReadUvarint(r.r)
+	if err != nil {
+		return err
+	}
+	if size > maxBinaryRecordSize {
+		return fmt.Errorf("record of %d bytes is bigger than the maximum of %d bytes", size, maxBinaryRecordSize)
+	}
+	if uint64(cap(r.buf)) < size {
+		r.buf = make([]byte, size)
+	}
+	buf := r.buf[:size]
+	if _, err := io.ReadFull(r.r, buf); err != nil {
+		if err == io.EOF {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+	return proto.Unmarshal(buf, msg)
+}
+
+// Close closes the log file opened by OpenBinaryLog.
+func (r *BinaryLogReader) Close() error {
+	var firstErr error
+	for _, c := range r.closers {
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
+)
+
+type protoMessage struct {
+	record *querylogpb.Transaction
+}
+
+func (m *protoMessage) LogProto() proto.Message {
+	if m.record == nil {
+		return nil
+	}
+	return m.record
+}
+
+func TestBinaryFormatter(t *testing.T) {
+	logger := New("logger", 1)
+	logf := GetBinaryFormatter(logger)
+	records := []*querylogpb.Transaction{
+		{ConnId: 1, Queries: []string{"begin", "insert into t values (1)", "commit"}},
+		{},
+		{ConnId: 3, Conclusion: "rollback"},
+	}
+
+	var buf bytes.Buffer
+	for _, record := range records {
+		require.NoError(t, logf(&buf, nil, &protoMessage{record: record}))
+	}
+	// Messages without records are skipped.
+	require.NoError(t, logf(&buf, nil, &protoMessage{}))
+	assert.Error(t, logf(&buf, nil, "not a ProtoFormatter"))
+
+	reader := NewBinaryLogReader(bytes.NewReader(buf.Bytes()))
+	for _, want := range records {
+		got := &querylogpb.Transaction{}
+		require.NoError(t, reader.Next(got))
+		assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+	}
+	assert.Equal(t, io.EOF, reader.Next(&querylogpb.Transaction{}))
+
+	// A truncated record is reported.
+	reader = NewBinaryLogReader(bytes.NewReader(buf.Bytes()[:buf.Len()-1]))
+	for range records[:2] {
+		require.NoError(t, reader.Next(&querylogpb.Transaction{}))
+	}
+	assert.Equal(t, io.ErrUnexpectedEOF, reader.Next(&querylogpb.Transaction{}))
+}
+
+func TestOpenBinaryLog(t *testing.T) {
+	dir, err := ioutil.TempDir("", "streamlog_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	want := &querylogpb.GateQuery{Sql: "select 1 from dual", ShardQueries: 1}
+	var buf bytes.Buffer
+	require.NoError(t, writeBinaryRecord(&buf, want))
+	logPath := path.Join(dir, "test.log")
+	require.NoError(t, ioutil.WriteFile(logPath, buf.Bytes(), 0644))
+
+	for _, compress := range []bool{false, true} {
+		name := logPath
+		if compress {
+			require.NoError(t, compressFile(logPath))
+			name += ".gz"
+		}
+		reader, err := OpenBinaryLog(name)
+		require.NoError(t, err)
+		got := &querylogpb.GateQuery{}
+		require.NoError(t, reader.Next(got))
+		assert.True(t, proto.Equal(want, got), "got %v, want %v", got, want)
+		assert.Equal(t, io.EOF, reader.Next(got))
+		require.NoError(t, reader.Close())
+	}
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// backupTimeFormat is the format of the time of the rotation in the
+// names of the rotated files. It sorts in the order of the rotations.
+const backupTimeFormat = "20060102-150405.000000"
+
+// rotationOptions controls when a log file is rotated, and what happens
+// to the rotated files.
+type rotationOptions struct {
+	// maxSize is the size in bytes beyond which the file is rotated,
+	// 0 if it isn't rotated by size.
+	maxSize int64
+	// maxAge is the age at which the file is rotated, 0 if it isn't
+	// rotated by age.
+	maxAge time.Duration
+	// maxBackups is the number of rotated files kept, 0 to keep all
+	// of them.
+	maxBackups int
+	// compress controls whether the rotated files are compressed with
+	// gzip.
+	compress bool
+}
+
+// rotatingFile is a log file which is renamed after the time of its
+// rotation when it grows beyond a size or an age, and replaced with a
+// new file. Records are never split across files, as long as each of
+// them is written by a single call to Write.
+type rotatingFile struct {
+	path string
+	opts rotationOptions
+	now  func() time.Time
+
+	file   *os.File
+	size   int64
+	opened time.Time
+
+	// background serializes the compression and the pruning of the
+	// rotated files, and tracks them until the file is closed.
+	background sync.WaitGroup
+	backupMu   sync.Mutex
+}
+
+func openRotatingFile(path string, opts rotationOptions) (*rotatingFile, error) {
+	rf := &rotatingFile{
+		path: path,
+		opts: opts,
+		now:  time.Now,
+	}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	return rf, nil
+}
+
+func (rf *rotatingFile) open() error {
+	f, err := os.OpenFile(rf.path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return err
+	}
+	rf.file = f
+	rf.size = fi.Size()
+	rf.opened = rf.now()
+	return nil
+}
+
+// Write writes a record to the file, after rotating it if the record
+// would make it grow beyond its maximum size or if it is too old.
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	if rf.shouldRotate(len(p)) {
+		if err := rf.rotate(); err != nil {
+			log.Errorf("Error rotating log file %s: %v", rf.path, err)
+		}
+	}
+	if rf.file == nil {
+		if err := rf.open(); err != nil {
+			return 0, err
+		}
+	}
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+// shouldRotate returns whether the file must be rotated before writing
+// n bytes to it. An empty file is never rotated, so that a record bigger
+// than the maximum size gets a file of its own.
+func (rf *rotatingFile) shouldRotate(n int) bool {
+	if rf.file == nil || rf.size == 0 {
+		return false
+	}
+	if rf.opts.maxSize > 0 && rf.size+int64(n) > rf.opts.maxSize {
+		return true
+	}
+	return rf.opts.maxAge > 0 && rf.now().Sub(rf.opened) >= rf.opts.maxAge
+}
+
+// rotate renames the file after the time of the rotation and opens a new
+// one in its place. The rotated file is compressed and the old rotated
+// files are pruned in the background.
+func (rf *rotatingFile) rotate() error {
+	rf.file.Close()
+	rf.file = nil
+
+	backup := rf.path + "." + rf.now().UTC().Format(backupTimeFormat)
+	if err := os.Rename(rf.path, backup); err != nil {
+		return err
+	}
+	rf.background.Add(1)
+	go func() {
+		defer rf.background.Done()
+		rf.backupMu.Lock()
+		defer rf.backupMu.Unlock()
+
+		// The rotated file may already have been pruned, if the files
+		// of later rotations were processed first.
+		if rf.opts.compress {
+			if err := compressFile(backup); err != nil && !os.IsNotExist(err) {
+				log.Errorf("Error compressing rotated log file %s: %v", backup, err)
+			}
+		}
+		if err := rf.pruneBackups(); err != nil {
+			log.Errorf("Error pruning rotated log files of %s: %v", rf.path, err)
+		}
+	}()
+	return rf.open()
+}
+
+// Reopen closes and reopens the file, for it to be rotated by an external
+// tool.
+func (rf *rotatingFile) Reopen() error {
+	if rf.file != nil {
+		rf.file.Close()
+		rf.file = nil
+	}
+	return rf.open()
+}
+
+// Close closes the file, after waiting for the rotated files to be
+// compressed and pruned.
+func (rf *rotatingFile) Close() error {
+	rf.background.Wait()
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
+
+// backups returns the rotated files, from the oldest to the newest.
+func (rf *rotatingFile) backups() ([]string, error) {
+	matches, err := filepath.Glob(rf.path + ".*")
+	if err != nil {
+		return nil, err
+	}
+	var backups []string
+	for _, match := range matches {
+		suffix := strings.TrimSuffix(strings.TrimPrefix(match, rf.path+"."), ".gz")
+		if _, err := time.Parse(backupTimeFormat, suffix); err != nil {
+			continue
+		}
+		backups = append(backups, match)
+	}
+	sort.Strings(backups)
+	return backups, nil
+}
+
+// pruneBackups removes the oldest rotated files beyond the maximum
+// number of backups.
+func (rf *rotatingFile) pruneBackups() error {
+	if rf.opts.maxBackups <= 0 {
+		return nil
+	}
+	backups, err := rf.backups()
+	if err != nil {
+		return err
+	}
+	for len(backups) > rf.opts.maxBackups {
+		if err := os.Remove(backups[0]); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		backups = backups[1:]
+	}
+	return nil
+}
+
+// compressFile replaces a file with its gzip compressed version.
+func compressFile(path string) error {
+	in, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(path+".gz", os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		out.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		out.Close()
+		os.Remove(path + ".gz")
+		return err
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(path + ".gz")
+		return err
+	}
+	return os.Remove(path)
+}
@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package streamlog
+
+import (
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRotatingFile(t *testing.T, opts rotationOptions) (*rotatingFile, *time.Time) {
+	dir, err := ioutil.TempDir("", "streamlog_test")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+
+	now := time.Date(2021, 4, 1, 12, 0, 0, 0, time.UTC)
+	rf := &rotatingFile{
+		path: path.Join(dir, "test.log"),
+		opts: opts,
+		now:  func() time.Time { return now },
+	}
+	require.NoError(t, rf.open())
+	t.Cleanup(func() { rf.Close() })
+	return rf, &now
+}
+
+func readFile(t *testing.T, name string) string {
+	data, err := ioutil.ReadFile(name)
+	require.NoError(t, err)
+	return string(data)
+}
+
+func TestRotatingFileBySize(t *testing.T) {
+	rf, now := newTestRotatingFile(t, rotationOptions{maxSize: 10})
+
+	for _, record := range []string{"aaaa\n", "bbbb\n", "cccc\n", "a record bigger than the maximum\n", "dddd\n"} {
+		_, err := rf.Write([]byte(record))
+		require.NoError(t, err)
+		*now = now.Add(time.Second)
+	}
+	require.NoError(t, rf.Close())
+
+	backups, err := rf.backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 3)
+	assert.Equal(t, rf.path+".20210401-120002.000000", backups[0])
+	assert.Equal(t, "aaaa\nbbbb\n", readFile(t, backups[0]))
+	assert.Equal(t, "cccc\n", readFile(t, backups[1]))
+	assert.Equal(t, "a record bigger than the maximum\n", readFile(t, backups[2]))
+	assert.Equal(t, "dddd\n", readFile(t, rf.path))
+}
+
+func TestRotatingFileByAge(t *testing.T) {
+	rf, now := newTestRotatingFile(t, rotationOptions{maxAge: time.Minute})
+
+	for _, record := range []string{"aaaa\n", "bbbb\n", "cccc\n"} {
+		_, err := rf.Write([]byte(record))
+		require.NoError(t, err)
+		*now = now.Add(40 * time.Second)
+	}
+	require.NoError(t, rf.Close())
+
+	backups, err := rf.backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 1)
+	assert.Equal(t, "aaaa\nbbbb\n", readFile(t, backups[0]))
+	assert.Equal(t, "cccc\n", readFile(t, rf.path))
+}
+
+func TestRotatingFileCompressAndPrune(t *testing.T) {
+	rf, now := newTestRotatingFile(t, rotationOptions{maxSize: 5, maxBackups: 2, compress: true})
+	// Files which don't look like rotated files are left alone.
+	require.NoError(t, ioutil.WriteFile(rf.path+".old", []byte("old"), 0644))
+
+	for _, record := range []string{"aaaa\n", "bbbb\n", "cccc\n", "dddd\n"} {
+		_, err := rf.Write([]byte(record))
+		require.NoError(t, err)
+		*now = now.Add(time.Second)
+	}
+	require.NoError(t, rf.Close())
+
+	backups, err := rf.backups()
+	require.NoError(t, err)
+	require.Len(t, backups, 2)
+	for i, want := range []string{"bbbb\n", "cccc\n"} {
+		assert.Equal(t, ".gz", path.Ext(backups[i]))
+		f, err := os.Open(backups[i])
+		require.NoError(t, err)
+		zr, err := gzip.NewReader(f)
+		require.NoError(t, err)
+		data, err := ioutil.ReadAll(zr)
+		require.NoError(t, err)
+		f.Close()
+		assert.Equal(t, want, string(data))
+	}
+	assert.Equal(t, "dddd\n", readFile(t, rf.path))
+	assert.Equal(t, "old", readFile(t, rf.path+".old"))
+}
@@ -18,6 +18,7 @@ limitations under the License.
 package streamlog
 
 import (
+	"bytes"
 	"flag"
 	"fmt"
 	"io"
@@ -47,6 +48,21 @@ var (
 	// QueryLogRowThreshold only log queries returning or affecting this many rows
 	QueryLogRowThreshold = flag.Uint64("querylog-row-threshold", 0, "Number of rows a query has to return or affect before being logged; not useful for streaming queries. 0 means all queries will be logged.")
 
+	// QueryLogFileFormat controls the format of the logs written to files (either the format of querylog-format or binary)
+	QueryLogFileFormat = flag.String("querylog-file-format", "", "format for logs written to files (\"binary\", or empty for the format of -querylog-format)")
+
+	// QueryLogFileMaxSize controls the size beyond which the log files are rotated
+	QueryLogFileMaxSize = flag.Int64("querylog-file-max-size", 0, "size in bytes beyond which log files are rotated. 0 means they are not rotated by size.")
+
+	// QueryLogFileMaxAge controls the age at which the log files are rotated
+	QueryLogFileMaxAge = flag.Duration("querylog-file-max-age", 0, "age at which log files are rotated. 0 means they are not rotated by age.")
+
+	// QueryLogFileMaxBackups controls the number of rotated log files which are kept
+	QueryLogFileMaxBackups = flag.Int("querylog-file-max-backups", 0, "number of rotated log files to keep. 0 means all of them are kept.")
+
+	// QueryLogFileCompress controls whether the rotated log files are compressed
+	QueryLogFileCompress = flag.Bool("querylog-file-compress", false, "compress rotated log files with gzip")
+
 	sendCount      = stats.NewCountersWithSingleLabel("StreamlogSend", "stream log send count", "logger_names")
 	deliveredCount = stats.NewCountersWithMultiLabels(
 		"StreamlogDelivered",
@@ -64,6 +80,9 @@ const (
 
 	// QueryLogFormatJSON is the format specifier for json querylog output
 	QueryLogFormatJSON = "json"
+
+	// QueryLogFormatBinary is the format specifier for binary log files
+	QueryLogFormatBinary = "binary"
 )
 
 // StreamLogger is a non-blocking broadcaster of messages.
@@ -159,30 +178,54 @@ func (logger *StreamLogger) ServeLogs(url string, logf LogFormatter) {
 }
 
 // LogToFile starts logging to the specified file path and will reopen the
-// file in response to SIGUSR2.
+// file in response to SIGUSR2. The file is rotated and written in the
+// format controlled by the querylog-file-* flags: in the binary format,
+// the messages must conform to the ProtoFormatter interface, and logf is
+// not used.
 //
 // Returns the channel used for the subscription which can be used to close
 // it.
 func (logger *StreamLogger) LogToFile(path string, logf LogFormatter) (chan interface{}, error) {
+	switch *QueryLogFileFormat {
+	case "":
+	case QueryLogFormatBinary:
+		logf = GetBinaryFormatter(logger)
+	default:
+		return nil, fmt.Errorf("invalid querylog-file-format value %v: must be either empty or binary", *QueryLogFileFormat)
+	}
+
+	f, err := openRotatingFile(path, rotationOptions{
+		maxSize:    *QueryLogFileMaxSize,
+		maxAge:     *QueryLogFileMaxAge,
+		maxBackups: *QueryLogFileMaxBackups,
+		compress:   *QueryLogFileCompress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	rotateChan := make(chan os.Signal, 1)
 	signal.Notify(rotateChan, syscall.SIGUSR2)
 
 	logChan := logger.Subscribe("FileLog")
 	formatParams := map[string][]string{"full": {}}
 
-	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
-	if err != nil {
-		return nil, err
-	}
-
 	go func() {
+		// Each record is formatted in a buffer, to be written with a
+		// single call and never be split by a rotation.
+		var buf bytes.Buffer
 		for {
 			select {
 			case record := <-logChan:
-				logf(f, formatParams, record)
+				buf.Reset()
+				logf(&buf, formatParams, record)
+				if buf.Len() > 0 {
+					f.Write(buf.Bytes())
+				}
 			case <-rotateChan:
-				f.Close()
-				f, _ = os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0644)
+				if err := f.Reopen(); err != nil {
+					log.Errorf("Error reopening log file %s: %v", path, err)
+				}
 			}
 		}
 	}()
@@ -503,9 +503,19 @@ func testRevertMigration(t *testing.T, revertUUID string) (uuid string) {
 	fmt.Printf("<%s>\n", uuid)
 
 	time.Sleep(time.Second * 20)
+	checkRevertedUUID(t, uuid, revertUUID)
 	return uuid
 }
 
+// checkRevertedUUID verifies a revert migration records the UUID of the migration it reverted
+func checkRevertedUUID(t *testing.T, uuid string, expectRevertedUUID string) {
+	showQuery := fmt.Sprintf("show vitess_migrations like '%s'", uuid)
+	r := onlineddl.VtgateExecQuery(t, &vtParams, showQuery, "")
+	for _, row := range r.Named().Rows {
+		assert.Equal(t, expectRevertedUUID, row["reverted_uuid"].ToString())
+	}
+}
+
 // checkTable checks the number of tables in the first two shards.
 func checkTable(t *testing.T, showTableName string, expectExists bool) bool {
 	expectCount := 0
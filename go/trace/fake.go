@@ -30,8 +30,11 @@ func (noopTracingServer) New(Span, string) Span { return NoopSpan{} }
 func (noopTracingServer) NewClientSpan(parent Span, serviceName, label string) Span {
 	return NoopSpan{}
 }
-func (noopTracingServer) FromContext(context.Context) (Span, bool)                  { return nil, false }
-func (noopTracingServer) NewFromString(parent, label string) (Span, error)          { return NoopSpan{}, nil }
+func (noopTracingServer) FromContext(context.Context) (Span, bool)         { return nil, false }
+func (noopTracingServer) NewFromString(parent, label string) (Span, error) { return NoopSpan{}, nil }
+func (noopTracingServer) NewFromTraceparent(Traceparent, string) (Span, error) {
+	return NoopSpan{}, nil
+}
 func (noopTracingServer) NewContext(parent context.Context, _ Span) context.Context { return parent }
 func (noopTracingServer) AddGrpcServerOptions(addInterceptors func(s grpc.StreamServerInterceptor, u grpc.UnaryServerInterceptor)) {
 }
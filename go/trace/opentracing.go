@@ -26,6 +26,7 @@ import (
 	"github.com/opentracing/opentracing-go"
 	"google.golang.org/grpc"
 
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 )
 
@@ -51,6 +52,14 @@ type tracer interface {
 	GetOpenTracingTracer() opentracing.Tracer
 }
 
+// traceparentTracer is implemented by the tracers which can continue the
+// traces of a W3C traceparent.
+type traceparentTracer interface {
+	// TraceparentCarrier returns the span context of the traceparent in the
+	// TextMap format of the tracer.
+	TraceparentCarrier(parent Traceparent) opentracing.TextMapCarrier
+}
+
 type openTracingService struct {
 	Tracer tracer
 }
@@ -115,6 +124,20 @@ func (jf openTracingService) NewFromString(parent, label string) (Span, error) {
 	return openTracingSpan{otSpan: innerSpan}, nil
 }
 
+// NewFromTraceparent is part of an interface implementation
+func (jf openTracingService) NewFromTraceparent(parent Traceparent, label string) (Span, error) {
+	tpTracer, ok := jf.Tracer.(traceparentTracer)
+	if !ok {
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "the tracer does not support traceparent")
+	}
+	spanContext, err := jf.Tracer.GetOpenTracingTracer().Extract(opentracing.TextMap, tpTracer.TraceparentCarrier(parent))
+	if err != nil {
+		return nil, vterrors.Wrap(err, "failed to deserialize traceparent")
+	}
+	innerSpan := jf.Tracer.GetOpenTracingTracer().StartSpan(label, opentracing.ChildOf(spanContext))
+	return openTracingSpan{otSpan: innerSpan}, nil
+}
+
 // FromContext is part of an interface implementation
 func (jf openTracingService) FromContext(ctx context.Context) (Span, bool) {
 	innerSpan := opentracing.SpanFromContext(ctx)
@@ -4,6 +4,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"strconv"
 
 	"github.com/opentracing/opentracing-go"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/opentracer"
@@ -54,3 +55,19 @@ type datadogTracer struct {
 func (dt *datadogTracer) GetOpenTracingTracer() opentracing.Tracer {
 	return dt.actual
 }
+
+var _ traceparentTracer = (*datadogTracer)(nil)
+
+// TraceparentCarrier is part of the traceparentTracer interface. Datadog
+// trace ids have 64 bits, which are the low 64 bits of the W3C trace id.
+func (dt *datadogTracer) TraceparentCarrier(parent Traceparent) opentracing.TextMapCarrier {
+	priority := "0"
+	if parent.Sampled {
+		priority = "1"
+	}
+	return opentracing.TextMapCarrier{
+		ddtracer.DefaultTraceIDHeader:  strconv.FormatUint(parent.TraceIDLow, 10),
+		ddtracer.DefaultParentIDHeader: strconv.FormatUint(parent.ParentID, 10),
+		ddtracer.DefaultPriorityHeader: priority,
+	}
+}
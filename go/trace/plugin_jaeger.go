@@ -18,6 +18,7 @@ package trace
 
 import (
 	"flag"
+	"fmt"
 	"io"
 
 	"github.com/opentracing/opentracing-go"
@@ -100,3 +101,18 @@ type jaegerTracer struct {
 func (jt *jaegerTracer) GetOpenTracingTracer() opentracing.Tracer {
 	return jt.actual
 }
+
+var _ traceparentTracer = (*jaegerTracer)(nil)
+
+// TraceparentCarrier is part of the traceparentTracer interface
+func (jt *jaegerTracer) TraceparentCarrier(parent Traceparent) opentracing.TextMapCarrier {
+	var flags byte
+	if parent.Sampled {
+		flags = 1
+	}
+	// The format is {trace-id}:{span-id}:{parent-span-id}:{flags}, with
+	// the traceparent span as the span.
+	return opentracing.TextMapCarrier{
+		jaeger.TraceContextHeaderName: fmt.Sprintf("%016x%016x:%016x:0:%x", parent.TraceIDHigh, parent.TraceIDLow, parent.ParentID, flags),
+	}
+}
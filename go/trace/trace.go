@@ -55,9 +55,15 @@ func NewSpan(inCtx context.Context, label string) (Span, context.Context) {
 }
 
 // NewFromString creates a new Span with the currently installed tracing plugin, extracting the span context from
-// the provided string.
+// the provided string. The string is either a span context encoded by the plugin, or a W3C traceparent.
 func NewFromString(inCtx context.Context, parent, label string) (Span, context.Context, error) {
-	span, err := currentTracer.NewFromString(parent, label)
+	var span Span
+	var err error
+	if traceparent, tpErr := ParseTraceparent(parent); tpErr == nil {
+		span, err = currentTracer.NewFromTraceparent(traceparent, label)
+	} else {
+		span, err = currentTracer.NewFromString(parent, label)
+	}
 	if err != nil {
 		return nil, nil, err
 	}
@@ -109,6 +115,9 @@ type tracingService interface {
 	// NewFromString creates a new span and uses the provided string to reconstitute the parent span
 	NewFromString(parent, label string) (Span, error)
 
+	// NewFromTraceparent creates a new span whose parent is the span of a W3C traceparent
+	NewFromTraceparent(parent Traceparent, label string) (Span, error)
+
 	// FromContext extracts a span from a context, making it possible to annotate the span with additional information
 	FromContext(ctx context.Context) (Span, bool)
 
@@ -98,6 +98,10 @@ func (f *fakeTracer) NewFromString(parent, label string) (Span, error) {
 	panic("implement me")
 }
 
+func (f *fakeTracer) NewFromTraceparent(parent Traceparent, label string) (Span, error) {
+	panic("implement me")
+}
+
 func (f *fakeTracer) New(parent Span, label string) Span {
 	f.log = append(f.log, "span started")
 
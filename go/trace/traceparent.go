@@ -0,0 +1,93 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Traceparent is a parent span context in the format of the W3C Trace
+// Context traceparent header, which lets the traces of the applications
+// continue in Vitess.
+type Traceparent struct {
+	// TraceIDHigh and TraceIDLow are the high and low 64 bits of the
+	// 128-bit trace id.
+	TraceIDHigh uint64
+	TraceIDLow  uint64
+	// ParentID is the id of the parent span.
+	ParentID uint64
+	// Sampled is true if the parent span is recorded.
+	Sampled bool
+}
+
+// ParseTraceparent parses a W3C traceparent, e.g.
+// 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01.
+func ParseTraceparent(s string) (Traceparent, error) {
+	parts := strings.Split(s, "-")
+	if len(parts) < 4 {
+		return Traceparent{}, fmt.Errorf("invalid traceparent %q", s)
+	}
+	version, traceID, parentID, flags := parts[0], parts[1], parts[2], parts[3]
+	// The future versions may add fields, but the version 00 has exactly four.
+	if len(version) != 2 || version == "ff" || (version == "00" && len(parts) != 4) {
+		return Traceparent{}, fmt.Errorf("invalid traceparent version in %q", s)
+	}
+	if len(traceID) != 32 || len(parentID) != 16 || len(flags) != 2 {
+		return Traceparent{}, fmt.Errorf("invalid traceparent %q", s)
+	}
+
+	var tp Traceparent
+	var err error
+	if tp.TraceIDHigh, err = parseHex(traceID[:16]); err != nil {
+		return Traceparent{}, fmt.Errorf("invalid traceparent trace id in %q", s)
+	}
+	if tp.TraceIDLow, err = parseHex(traceID[16:]); err != nil {
+		return Traceparent{}, fmt.Errorf("invalid traceparent trace id in %q", s)
+	}
+	if tp.ParentID, err = parseHex(parentID); err != nil {
+		return Traceparent{}, fmt.Errorf("invalid traceparent parent id in %q", s)
+	}
+	traceFlags, err := parseHex(flags)
+	if err != nil {
+		return Traceparent{}, fmt.Errorf("invalid traceparent flags in %q", s)
+	}
+	if tp.TraceIDHigh == 0 && tp.TraceIDLow == 0 || tp.ParentID == 0 {
+		return Traceparent{}, fmt.Errorf("invalid traceparent %q: the ids must not be zero", s)
+	}
+	tp.Sampled = traceFlags&1 == 1
+	return tp, nil
+}
+
+// parseHex parses a lowercase hexadecimal number, as the traceparent
+// fields are.
+func parseHex(s string) (uint64, error) {
+	if strings.ToLower(s) != s {
+		return 0, fmt.Errorf("%q is not lowercase", s)
+	}
+	return strconv.ParseUint(s, 16, 64)
+}
+
+// String returns the traceparent in the W3C format.
+func (tp Traceparent) String() string {
+	flags := 0
+	if tp.Sampled {
+		flags = 1
+	}
+	return fmt.Sprintf("00-%016x%016x-%016x-%02x", tp.TraceIDHigh, tp.TraceIDLow, tp.ParentID, flags)
+}
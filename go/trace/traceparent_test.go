@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trace
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uber/jaeger-client-go"
+)
+
+func TestParseTraceparent(t *testing.T) {
+	tp, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	assert.Equal(t, Traceparent{
+		TraceIDHigh: 0x4bf92f3577b34da6,
+		TraceIDLow:  0xa3ce929d0e0e4736,
+		ParentID:    0x00f067aa0ba902b7,
+		Sampled:     true,
+	}, tp)
+	assert.Equal(t, "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01", tp.String())
+
+	tp, err = ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00")
+	require.NoError(t, err)
+	assert.False(t, tp.Sampled)
+
+	// A future version can have more fields.
+	_, err = ParseTraceparent("01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra")
+	require.NoError(t, err)
+
+	for _, invalid := range []string{
+		"",
+		"VT_SPAN_CONTEXT",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"00-4BF92F3577B34DA6A3CE929D0E0E4736-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-0000000000000000-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902bz-01",
+	} {
+		_, err := ParseTraceparent(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestJaegerNewFromTraceparent(t *testing.T) {
+	tracer, closer := jaeger.NewTracer("vtgate", jaeger.NewConstSampler(true), jaeger.NewNullReporter())
+	defer closer.Close()
+	service := openTracingService{Tracer: &jaegerTracer{actual: tracer}}
+
+	tp, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	require.NoError(t, err)
+	span, err := service.NewFromTraceparent(tp, "label")
+	require.NoError(t, err)
+	defer span.Finish()
+
+	spanContext := span.(openTracingSpan).otSpan.Context().(jaeger.SpanContext)
+	assert.Equal(t, jaeger.TraceID{High: tp.TraceIDHigh, Low: tp.TraceIDLow}, spanContext.TraceID())
+	assert.Equal(t, jaeger.SpanID(tp.ParentID), spanContext.ParentID())
+}
@@ -94,6 +94,9 @@ type Stats struct {
 	CopyRowCount  *stats.Counter
 	CopyLoopCount *stats.Counter
 	ErrorCounts   *stats.CountersWithMultiLabels
+	// ThrottledTimings is the time spent waiting for
+	// the throttler, per phase.
+	ThrottledTimings *stats.Timings
 }
 
 // RecordHeartbeat updates the time the last heartbeat from vstreamer was seen
@@ -149,6 +152,7 @@ func NewStats() *Stats {
 	bps.CopyRowCount = stats.NewCounter("", "")
 	bps.CopyLoopCount = stats.NewCounter("", "")
 	bps.ErrorCounts = stats.NewCountersWithMultiLabels("", "", []string{"type"})
+	bps.ThrottledTimings = stats.NewTimings("", "", "Phase")
 	return bps
 }
 
@@ -18,7 +18,7 @@ package dbconnpool
 
 import (
 	"context"
-	"fmt"
+	"time"
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
@@ -30,6 +30,7 @@ import (
 // by itself. (Recycle needs to know about the Pool).
 type DBConnection struct {
 	*mysql.Conn
+	createTime time.Time
 }
 
 // NewDBConnection returns a new DBConnection based on the ConnParams
@@ -39,7 +40,12 @@ func NewDBConnection(ctx context.Context, info dbconfigs.Connector) (*DBConnecti
 	if err != nil {
 		return nil, err
 	}
-	return &DBConnection{Conn: c}, nil
+	return &DBConnection{Conn: c, createTime: time.Now()}, nil
+}
+
+// CreateTime returns the time the connection was created at.
+func (dbc *DBConnection) CreateTime() time.Time {
+	return dbc.createTime
 }
 
 // ExecuteFetch overwrites mysql.Conn.ExecuteFetch.
@@ -67,9 +73,11 @@ func (dbc *DBConnection) ExecuteStreamFetch(query string, callback func(*sqltype
 	if err != nil {
 		return err
 	}
+	// The callback errors are returned as is, so that callers can still
+	// tell what failed, e.g. a *mysql.SQLError raised by the callback.
 	err = callback(&sqltypes.Result{Fields: flds})
 	if err != nil {
-		return fmt.Errorf("stream send error: %v", err)
+		return err
 	}
 
 	// then get all the rows, sending them as we reach a decent packet size
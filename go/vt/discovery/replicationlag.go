@@ -34,13 +34,23 @@ var (
 // IsReplicationLagHigh verifies that the given LegacytabletHealth refers to a tablet with high
 // replication lag, i.e. higher than the configured discovery_low_replication_lag flag.
 func IsReplicationLagHigh(tabletHealth *TabletHealth) bool {
-	return float64(tabletHealth.Stats.SecondsBehindMaster) > lowReplicationLag.Seconds()
+	return ReplicationLag(tabletHealth) > *lowReplicationLag
 }
 
 // IsReplicationLagVeryHigh verifies that the given LegacytabletHealth refers to a tablet with very high
 // replication lag, i.e. higher than the configured discovery_high_replication_lag_minimum_serving flag.
 func IsReplicationLagVeryHigh(tabletHealth *TabletHealth) bool {
-	return float64(tabletHealth.Stats.SecondsBehindMaster) > highReplicationLagMinServing.Seconds()
+	return ReplicationLag(tabletHealth) > *highReplicationLagMinServing
+}
+
+// ReplicationLag returns the replication lag reported by the tablet. It uses
+// the millisecond lag if the tablet reports it, which is sub-second precise
+// when the tablet runs heartbeats, and falls back to seconds_behind_master.
+func ReplicationLag(tabletHealth *TabletHealth) time.Duration {
+	if tabletHealth.Stats.ReplicationLagMs > 0 {
+		return time.Duration(tabletHealth.Stats.ReplicationLagMs) * time.Millisecond
+	}
+	return time.Duration(tabletHealth.Stats.SecondsBehindMaster) * time.Second
 }
 
 // FilterStatsByReplicationLag filters the list of TabletHealth by TabletHealth.Stats.SecondsBehindMaster.
@@ -92,7 +102,7 @@ func filterStatsByLag(tabletHealthList []*TabletHealth) []*TabletHealth {
 		// Pull the current replication lag for a stable sort later.
 		list = append(list, tabletLagSnapshot{
 			ts:     ts,
-			replag: ts.Stats.SecondsBehindMaster})
+			replag: ReplicationLag(ts)})
 	}
 
 	// Sort by replication lag.
@@ -153,7 +163,7 @@ func filterStatsByLagWithLegacyAlgorithm(tabletHealthList []*TabletHealth) []*Ta
 		if !IsReplicationLagVeryHigh(ts) {
 			snapshots = append(snapshots, tabletLagSnapshot{
 				ts:     ts,
-				replag: ts.Stats.SecondsBehindMaster})
+				replag: ReplicationLag(ts)})
 		}
 	}
 	if len(snapshots) == 0 {
@@ -167,7 +177,7 @@ func filterStatsByLagWithLegacyAlgorithm(tabletHealthList []*TabletHealth) []*Ta
 		for _, ts := range list {
 			snapshots = append(snapshots, tabletLagSnapshot{
 				ts:     ts,
-				replag: ts.Stats.SecondsBehindMaster})
+				replag: ReplicationLag(ts)})
 		}
 	}
 
@@ -190,7 +200,7 @@ func (a byReplag) Less(i, j int) bool { return a[i].replag < a[j].replag }
 
 type tabletLagSnapshot struct {
 	ts     *TabletHealth
-	replag uint32
+	replag time.Duration
 }
 type tabletLagSnapshotList []tabletLagSnapshot
 
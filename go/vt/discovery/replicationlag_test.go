@@ -19,6 +19,9 @@ package discovery
 import (
 	"fmt"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
 
 	"vitess.io/vitess/go/test/utils"
 
@@ -215,3 +218,30 @@ func TestFilterStatsByReplicationLagOneTabletMin(t *testing.T) {
 	// Reset to the default
 	testSetMinNumTablets(2)
 }
+
+func TestFilterStatsByReplicationLagMilliseconds(t *testing.T) {
+	testSetMinNumTablets(1)
+	*legacyReplicationLagAlgorithm = false
+	defer func() { *legacyReplicationLagAlgorithm = true }()
+	oldLowReplicationLag := *lowReplicationLag
+	*lowReplicationLag = 500 * time.Millisecond
+	defer func() { *lowReplicationLag = oldLowReplicationLag }()
+
+	// lags of (1.2s, 200ms) both round down to 1s or 0s, but only the
+	// sub-second lag is below the threshold.
+	ts1 := &TabletHealth{
+		Tablet:  topo.NewTablet(1, "cell", "host1"),
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{SecondsBehindMaster: 1, ReplicationLagMs: 1200},
+	}
+	ts2 := &TabletHealth{
+		Tablet:  topo.NewTablet(2, "cell", "host2"),
+		Serving: true,
+		Stats:   &querypb.RealtimeStats{ReplicationLagMs: 200},
+	}
+	got := FilterStatsByReplicationLag([]*TabletHealth{ts1, ts2})
+	want := []*TabletHealth{ts2}
+	mustMatch(t, want, got, "FilterStatsByReplicationLag")
+	assert.True(t, IsReplicationLagHigh(ts1))
+	assert.False(t, IsReplicationLagHigh(ts2))
+}
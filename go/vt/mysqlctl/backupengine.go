@@ -38,7 +38,7 @@ import (
 
 var (
 	// BackupEngineImplementation is the implementation to use for BackupEngine
-	backupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "Specifies which implementation to use for creating new backups (builtin or xtrabackup). Restores will always be done with whichever engine created a given backup.")
+	backupEngineImplementation = flag.String("backup_engine_implementation", builtinBackupEngineName, "Specifies which implementation to use for creating new backups (builtin, xtrabackup or mysqlshell). Restores will always be done with whichever engine created a given backup.")
 )
 
 // BackupEngine is the interface to take a backup with a given engine.
@@ -92,6 +92,10 @@ type RestoreParams struct {
 	// StartTime: if non-zero, look for a backup that was taken at or before this time
 	// Otherwise, find the most recent backup
 	StartTime time.Time
+	// RestoreToPos: if non-empty, look for the most recent backup whose
+	// position is contained in this position, so binlogs can be applied on top
+	// of it up to RestoreToPos.
+	RestoreToPos mysql.Position
 }
 
 // RestoreEngine is the interface to restore a backup with a given engine.
@@ -217,6 +221,10 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 				continue
 			}
 		}
+		if !params.RestoreToPos.IsZero() && !params.RestoreToPos.AtLeast(bm.Position) {
+			params.Logger.Infof("Restore: skipping backup %v/%v at position %v, which is past the requested position %v", backupDir, bh.Name(), bm.Position, params.RestoreToPos)
+			continue
+		}
 		if !checkBackupTime /* not snapshot */ || backupTime.Equal(params.StartTime) || backupTime.Before(params.StartTime) {
 			params.Logger.Infof("Restore: found backup %v %v to restore", bh.Directory(), bh.Name())
 			break
@@ -226,6 +234,9 @@ func FindBackupToRestore(ctx context.Context, params RestoreParams, bhs []backup
 		if checkBackupTime {
 			params.Logger.Errorf("No valid backup found before time %v", params.StartTime.Format(BackupTimestampFormat))
 		}
+		if !params.RestoreToPos.IsZero() {
+			params.Logger.Errorf("No valid backup found before position %v", params.RestoreToPos)
+		}
 		// There is at least one attempted backup, but none could be read.
 		// This implies there is data we ought to have, so it's not safe to start
 		// up empty.
@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+)
+
+// manifestBackupHandle is a read-only BackupHandle serving only a MANIFEST.
+type manifestBackupHandle struct {
+	backupstorage.BackupHandle
+	name     string
+	manifest string
+}
+
+func (bh *manifestBackupHandle) Directory() string { return "ks/0" }
+func (bh *manifestBackupHandle) Name() string      { return bh.name }
+func (bh *manifestBackupHandle) ReadFile(ctx context.Context, filename string) (io.ReadCloser, error) {
+	return ioutil.NopCloser(strings.NewReader(bh.manifest)), nil
+}
+
+func newManifestBackupHandle(t *testing.T, name, pos string) backupstorage.BackupHandle {
+	position, err := mysql.DecodePosition(pos)
+	require.NoError(t, err)
+	data, err := json.Marshal(&BackupManifest{BackupMethod: builtinBackupEngineName, Position: position})
+	require.NoError(t, err)
+	return &manifestBackupHandle{name: name, manifest: string(data)}
+}
+
+func TestFindBackupToRestoreToPos(t *testing.T) {
+	bhs := []backupstorage.BackupHandle{
+		newManifestBackupHandle(t, "b1", "MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-100"),
+		newManifestBackupHandle(t, "b2", "MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-200"),
+		newManifestBackupHandle(t, "b3", "MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-300"),
+	}
+	params := RestoreParams{Logger: logutil.NewMemoryLogger(), Keyspace: "ks", Shard: "0"}
+
+	bh, err := FindBackupToRestore(context.Background(), params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, "b3", bh.Name())
+
+	params.RestoreToPos, err = mysql.DecodePosition("MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-250")
+	require.NoError(t, err)
+	bh, err = FindBackupToRestore(context.Background(), params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, "b2", bh.Name())
+
+	params.RestoreToPos, err = mysql.DecodePosition("MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-200")
+	require.NoError(t, err)
+	bh, err = FindBackupToRestore(context.Background(), params, bhs)
+	require.NoError(t, err)
+	assert.Equal(t, "b2", bh.Name())
+
+	params.RestoreToPos, err = mysql.DecodePosition("MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-50")
+	require.NoError(t, err)
+	_, err = FindBackupToRestore(context.Background(), params, bhs)
+	assert.Equal(t, ErrNoCompleteBackup, err)
+}
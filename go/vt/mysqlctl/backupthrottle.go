@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"io"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/logutil"
+)
+
+var (
+	// backupBandwidthLimit caps the rate at which a backup writes to the
+	// backup storage, shared between all the files of the backup.
+	backupBandwidthLimit = flag.Int("backup_bandwidth_limit", 0, "if greater than 0, the maximum number of bytes per second written to the backup storage while taking a backup, across all files")
+
+	// restoreBandwidthLimit caps the rate at which a restore reads from the
+	// backup storage, shared between all the files of the restore.
+	restoreBandwidthLimit = flag.Int("restore_bandwidth_limit", 0, "if greater than 0, the maximum number of bytes per second read from the backup storage while restoring a backup, across all files")
+
+	backupProgressInterval = flag.Duration("backup_progress_interval", 30*time.Second, "how often to log the progress of a running backup or restore")
+
+	backupBytes            = stats.NewCounter("backup_bytes", "Number of bytes read by backups, before compression")
+	restoreBytes           = stats.NewCounter("restore_bytes", "Number of bytes written by restores, after decompression")
+	backupProgressPercent  = stats.NewGauge("backup_progress_percent", "Progress of the running or last backup, when its total size is known")
+	restoreProgressPercent = stats.NewGauge("restore_progress_percent", "Progress of the running or last restore, when its total size is known")
+	backupThrottledTime    = stats.NewCounterDuration("backup_throttled_time", "Time backups spent waiting on backup_bandwidth_limit")
+	restoreThrottledTime   = stats.NewCounterDuration("restore_throttled_time", "Time restores spent waiting on restore_bandwidth_limit")
+)
+
+// bandwidthLimiter throttles the bytes going through any number of
+// readers and writers. A nil *bandwidthLimiter does not throttle.
+type bandwidthLimiter struct {
+	limiter   *rate.Limiter
+	throttled *stats.CounterDuration
+}
+
+// newBandwidthLimiter returns a limiter for the given number of bytes per
+// second, or nil if bytesPerSecond is not positive.
+func newBandwidthLimiter(bytesPerSecond int, throttled *stats.CounterDuration) *bandwidthLimiter {
+	if bytesPerSecond <= 0 {
+		return nil
+	}
+	return &bandwidthLimiter{
+		limiter:   rate.NewLimiter(rate.Limit(bytesPerSecond), bytesPerSecond),
+		throttled: throttled,
+	}
+}
+
+// wait blocks until n more bytes may go through.
+func (bl *bandwidthLimiter) wait(ctx context.Context, n int) error {
+	if bl == nil {
+		return nil
+	}
+	start := time.Now()
+	defer func() { bl.throttled.Add(time.Since(start)) }()
+	// WaitN does not accept more than the burst size at once.
+	for n > 0 {
+		chunk := n
+		if burst := bl.limiter.Burst(); chunk > burst {
+			chunk = burst
+		}
+		if err := bl.limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+// writer returns w throttled by the limiter.
+func (bl *bandwidthLimiter) writer(ctx context.Context, w io.Writer) io.Writer {
+	if bl == nil {
+		return w
+	}
+	return &throttledWriter{ctx: ctx, w: w, limiter: bl}
+}
+
+// reader returns r throttled by the limiter.
+func (bl *bandwidthLimiter) reader(ctx context.Context, r io.Reader) io.Reader {
+	if bl == nil {
+		return r
+	}
+	return &throttledReader{ctx: ctx, r: r, limiter: bl}
+}
+
+type throttledWriter struct {
+	ctx     context.Context
+	w       io.Writer
+	limiter *bandwidthLimiter
+}
+
+// Write is part of the io.Writer interface.
+func (tw *throttledWriter) Write(p []byte) (int, error) {
+	if err := tw.limiter.wait(tw.ctx, len(p)); err != nil {
+		return 0, err
+	}
+	return tw.w.Write(p)
+}
+
+type throttledReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *bandwidthLimiter
+}
+
+// Read is part of the io.Reader interface.
+func (tr *throttledReader) Read(p []byte) (int, error) {
+	n, err := tr.r.Read(p)
+	if n > 0 {
+		if werr := tr.limiter.wait(tr.ctx, n); werr != nil && err == nil {
+			err = werr
+		}
+	}
+	return n, err
+}
+
+// backupProgress tracks the bytes processed by a backup or restore,
+// exports them as stats, and logs them periodically.
+type backupProgress struct {
+	operation string
+	total     int64
+	done      sync2.AtomicInt64
+	start     time.Time
+	bytes     *stats.Counter
+	percent   *stats.Gauge
+	logger    logutil.Logger
+	stop      chan struct{}
+}
+
+// newBackupProgress starts tracking an operation ("Backup" or "Restore")
+// of total bytes. A total of 0 means the size is not known up front.
+// close() must be called once the operation is over.
+func newBackupProgress(operation string, total int64, bytes *stats.Counter, percent *stats.Gauge, logger logutil.Logger) *backupProgress {
+	bp := &backupProgress{
+		operation: operation,
+		total:     total,
+		start:     time.Now(),
+		bytes:     bytes,
+		percent:   percent,
+		logger:    logger,
+		stop:      make(chan struct{}),
+	}
+	percent.Set(0)
+	if *backupProgressInterval > 0 {
+		go bp.run(*backupProgressInterval)
+	}
+	return bp
+}
+
+func (bp *backupProgress) run(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-bp.stop:
+			return
+		case <-ticker.C:
+			bp.log()
+		}
+	}
+}
+
+func (bp *backupProgress) log() {
+	done := bp.done.Get()
+	elapsed := time.Since(bp.start)
+	rate := float64(done) / elapsed.Seconds()
+	if bp.total > 0 {
+		bp.logger.Infof("%v progress: %v of %v bytes (%v%%) in %v, %.0f bytes/sec", bp.operation, done, bp.total, done*100/bp.total, elapsed.Round(time.Second), rate)
+		return
+	}
+	bp.logger.Infof("%v progress: %v bytes in %v, %.0f bytes/sec", bp.operation, done, elapsed.Round(time.Second), rate)
+}
+
+func (bp *backupProgress) add(n int) {
+	done := bp.done.Add(int64(n))
+	bp.bytes.Add(int64(n))
+	if bp.total > 0 {
+		pct := done * 100 / bp.total
+		if pct > 100 {
+			pct = 100
+		}
+		bp.percent.Set(pct)
+	}
+}
+
+// close stops the periodic logging and logs the final tally.
+func (bp *backupProgress) close() {
+	close(bp.stop)
+	bp.log()
+}
+
+// reader returns r, counting the bytes read from it.
+func (bp *backupProgress) reader(r io.Reader) io.Reader {
+	return &progressReader{r: r, progress: bp}
+}
+
+// writer returns w, counting the bytes written to it.
+func (bp *backupProgress) writer(w io.Writer) io.Writer {
+	return &progressWriter{w: w, progress: bp}
+}
+
+type progressReader struct {
+	r        io.Reader
+	progress *backupProgress
+}
+
+// Read is part of the io.Reader interface.
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.r.Read(p)
+	pr.progress.add(n)
+	return n, err
+}
+
+type progressWriter struct {
+	w        io.Writer
+	progress *backupProgress
+}
+
+// Write is part of the io.Writer interface.
+func (pw *progressWriter) Write(p []byte) (int, error) {
+	n, err := pw.w.Write(p)
+	pw.progress.add(n)
+	return n, err
+}
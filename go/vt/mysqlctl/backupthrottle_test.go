@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/logutil"
+)
+
+func TestBandwidthLimiter(t *testing.T) {
+	ctx := context.Background()
+	throttled := stats.NewCounterDuration("", "")
+
+	// No limit: readers and writers are returned as is.
+	var limiter *bandwidthLimiter = newBandwidthLimiter(0, throttled)
+	if limiter != nil {
+		t.Fatalf("expected no limiter")
+	}
+	buf := &bytes.Buffer{}
+	if w := limiter.writer(ctx, buf); w != io.Writer(buf) {
+		t.Errorf("expected the writer to not be wrapped")
+	}
+
+	// 1000 bytes/sec, with a full initial burst: writing 2500 bytes takes
+	// about 1.5 seconds.
+	limiter = newBandwidthLimiter(1000, throttled)
+	start := time.Now()
+	w := limiter.writer(ctx, buf)
+	if _, err := w.Write(make([]byte, 2500)); err != nil {
+		t.Fatal(err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("write took %v, expected it to be throttled", elapsed)
+	}
+	if throttled.Get() < time.Second {
+		t.Errorf("throttled time %v, expected at least a second", throttled.Get())
+	}
+
+	// A cancelled context interrupts the wait.
+	cancelledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	r := limiter.reader(cancelledCtx, bytes.NewReader(make([]byte, 5000)))
+	if _, err := ioutil.ReadAll(r); err == nil {
+		t.Errorf("expected an error reading with a cancelled context")
+	}
+}
+
+func TestBackupProgress(t *testing.T) {
+	bytesCounter := stats.NewCounter("", "")
+	percent := stats.NewGauge("", "")
+	logger := logutil.NewMemoryLogger()
+
+	progress := newBackupProgress("Backup", 200, bytesCounter, percent, logger)
+	if _, err := io.Copy(ioutil.Discard, progress.reader(bytes.NewReader(make([]byte, 50)))); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := progress.writer(ioutil.Discard).Write(make([]byte, 100)); err != nil {
+		t.Fatal(err)
+	}
+	progress.close()
+
+	if got := bytesCounter.Get(); got != 150 {
+		t.Errorf("bytes = %v, want 150", got)
+	}
+	if got := percent.Get(); got != 75 {
+		t.Errorf("percent = %v, want 75", got)
+	}
+	if got := logger.String(); !bytes.Contains([]byte(got), []byte("Backup progress: 150 of 200 bytes (75%)")) {
+		t.Errorf("unexpected progress log: %v", got)
+	}
+}
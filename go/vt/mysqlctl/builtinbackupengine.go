@@ -28,9 +28,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/concurrency"
@@ -82,6 +79,14 @@ type builtinBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine the files were compressed with.
+	// Backups created before the field existed used gzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command to decompress the files with,
+	// if CompressionEngine is external.
+	ExternalDecompressor string
 }
 
 // FileEntry is one file to backup
@@ -138,7 +143,12 @@ func (fe *FileEntry) open(cnf *Mycnf, readOnly bool) (*os.File, error) {
 // and an overall error.
 func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (bool, error) {
 
-	params.Logger.Infof("Hook: %v, Compress: %v", *backupStorageHook, *backupStorageCompress)
+	params.Logger.Infof("Hook: %v, Compress: %v, Compression engine: %v", *backupStorageHook, *backupStorageCompress, *backupCompressionEngine)
+	if *backupStorageCompress {
+		if err := validateCompressionEngine(*backupCompressionEngine); err != nil {
+			return false, err
+		}
+	}
 
 	// Save initial state so we can restore.
 	replicaStartRequired := false
@@ -279,12 +289,16 @@ func (be *BuiltinBackupEngine) ExecuteBackup(ctx context.Context, params BackupP
 func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, replicationPosition mysql.Position) (finalErr error) {
 
 	// Get the files to backup.
-	// We don't care about totalSize because we add each file separately.
-	fes, _, err := findFilesToBackup(params.Cnf)
+	fes, totalSize, err := findFilesToBackup(params.Cnf)
 	if err != nil {
 		return vterrors.Wrap(err, "can't find files to backup")
 	}
-	params.Logger.Infof("found %v files to backup", len(fes))
+	params.Logger.Infof("found %v files to backup, %v bytes", len(fes), totalSize)
+
+	// The bandwidth limit and progress are shared by all files.
+	limiter := newBandwidthLimiter(*backupBandwidthLimit, backupThrottledTime)
+	progress := newBackupProgress("Backup", totalSize, backupBytes, backupProgressPercent, params.Logger)
+	defer progress.close()
 
 	// Backup with the provided concurrency.
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
@@ -304,7 +318,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 
 			// Backup the individual file.
 			name := fmt.Sprintf("%v", i)
-			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], name))
+			bh.RecordError(be.backupFile(ctx, params, bh, &fes[i], name, limiter, progress))
 		}(i)
 	}
 
@@ -348,6 +362,12 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 		TransformHook: *backupStorageHook,
 		SkipCompress:  !*backupStorageCompress,
 	}
+	if *backupStorageCompress {
+		bm.CompressionEngine = *backupCompressionEngine
+		if bm.CompressionEngine == ExternalCompressor {
+			bm.ExternalDecompressor = *backupExternalDecompressor
+		}
+	}
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
 		return vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
@@ -360,7 +380,7 @@ func (be *BuiltinBackupEngine) backupFiles(ctx context.Context, params BackupPar
 }
 
 // backupFile backs up an individual file.
-func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle, fe *FileEntry, name string, limiter *bandwidthLimiter, progress *backupProgress) (finalErr error) {
 	// Open the source file for reading.
 	source, err := fe.open(params.Cnf, true)
 	if err != nil {
@@ -389,7 +409,7 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 			}
 		}
 	}(name, fe.Name)
-	dst := bufio.NewWriterSize(wc, writerBufferSize)
+	dst := bufio.NewWriterSize(limiter.writer(ctx, wc), writerBufferSize)
 
 	// Create the hasher and the tee on top.
 	hasher := newHasher()
@@ -408,27 +428,27 @@ func (be *BuiltinBackupEngine) backupFile(ctx context.Context, params BackupPara
 		writer = pipe
 	}
 
-	// Create the gzip compression pipe, if necessary.
-	var gzip *pargzip.Writer
+	// Create the compression pipe, if necessary.
+	var compressor io.WriteCloser
 	if *backupStorageCompress {
-		gzip = pargzip.NewWriter(writer)
-		gzip.ChunkSize = *backupCompressBlockSize
-		gzip.Parallel = *backupCompressBlocks
-		gzip.CompressionLevel = pargzip.BestSpeed
-		writer = gzip
+		compressor, err = newCompressor(ctx, *backupCompressionEngine, writer, params.Logger)
+		if err != nil {
+			return vterrors.Wrap(err, "cannot create compressor")
+		}
+		writer = compressor
 	}
 
-	// Copy from the source file to writer (optional gzip,
+	// Copy from the source file to writer (optional compressor,
 	// optional pipe, tee, output file and hasher).
-	_, err = io.Copy(writer, source)
+	_, err = io.Copy(writer, progress.reader(source))
 	if err != nil {
 		return vterrors.Wrap(err, "cannot copy data")
 	}
 
-	// Close gzip to flush it, after that all data is sent to writer.
-	if gzip != nil {
-		if err = gzip.Close(); err != nil {
-			return vterrors.Wrap(err, "cannot close gzip")
+	// Close the compressor to flush it, after that all data is sent to writer.
+	if compressor != nil {
+		if err = compressor.Close(); err != nil {
+			return vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -491,6 +511,9 @@ func (be *BuiltinBackupEngine) ExecuteRestore(ctx context.Context, params Restor
 // right place.
 func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, bm builtinBackupManifest) error {
 	fes := bm.FileEntries
+	limiter := newBandwidthLimiter(*restoreBandwidthLimit, restoreThrottledTime)
+	progress := newBackupProgress("Restore", 0, restoreBytes, restoreProgressPercent, params.Logger)
+	defer progress.close()
 	sema := sync2.NewSemaphore(params.Concurrency, 0)
 	rec := concurrency.AllErrorRecorder{}
 	wg := sync.WaitGroup{}
@@ -510,7 +533,7 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 			// And restore the file.
 			name := fmt.Sprintf("%v", i)
 			params.Logger.Infof("Copying file %v: %v", name, fes[i].Name)
-			err := be.restoreFile(ctx, params, bh, &fes[i], bm.TransformHook, !bm.SkipCompress, name)
+			err := be.restoreFile(ctx, params, bh, &fes[i], bm, name, limiter, progress)
 			if err != nil {
 				rec.RecordError(vterrors.Wrapf(err, "can't restore file %v to %v", name, fes[i].Name))
 			}
@@ -521,7 +544,8 @@ func (be *BuiltinBackupEngine) restoreFiles(ctx context.Context, params RestoreP
 }
 
 // restoreFile restores an individual file.
-func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, transformHook string, compress bool, name string) (finalErr error) {
+func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle, fe *FileEntry, bm builtinBackupManifest, name string, limiter *bandwidthLimiter, progress *backupProgress) (finalErr error) {
+	transformHook := bm.TransformHook
 	// Open the source file for reading.
 	source, err := bh.ReadFile(ctx, name)
 	if err != nil {
@@ -546,14 +570,14 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}()
 
 	// Create a buffering output.
-	dst := bufio.NewWriterSize(dstFile, 2*1024*1024)
+	dst := bufio.NewWriterSize(progress.writer(dstFile), 2*1024*1024)
 
 	// Create hash to write the compressed data to.
 	hasher := newHasher()
 
 	// Create a Tee: we split the input into the hasher
 	// and into the gunziper.
-	reader := io.TeeReader(source, hasher)
+	reader := io.TeeReader(limiter.reader(ctx, source), hasher)
 
 	// Create the external read pipe, if any.
 	var wait hook.WaitFunc
@@ -567,22 +591,22 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 	}
 
 	// Create the uncompresser if needed.
-	if compress {
-		gz, err := pgzip.NewReader(reader)
+	var decompressor io.ReadCloser
+	if !bm.SkipCompress {
+		decompressor, err = newDecompressor(ctx, bm.CompressionEngine, bm.ExternalDecompressor, reader, params.Logger)
 		if err != nil {
-			return vterrors.Wrap(err, "can't open gzip decompressor")
+			return vterrors.Wrap(err, "can't open decompressor")
 		}
 		defer func() {
-			if cerr := gz.Close(); cerr != nil {
-				if finalErr != nil {
-					// We already have an error, just log this one.
-					log.Errorf("failed to close gzip decompressor %v: %v", name, cerr)
-				} else {
-					finalErr = vterrors.Wrap(err, "failed to close gzip decompressor")
-				}
+			if decompressor == nil {
+				return
+			}
+			if cerr := decompressor.Close(); cerr != nil {
+				// We already have an error, just log this one.
+				log.Errorf("failed to close decompressor %v: %v", name, cerr)
 			}
 		}()
-		reader = gz
+		reader = decompressor
 	}
 
 	// Copy the data. Will also write to the hasher.
@@ -590,6 +614,16 @@ func (be *BuiltinBackupEngine) restoreFile(ctx context.Context, params RestorePa
 		return vterrors.Wrap(err, "failed to copy file contents")
 	}
 
+	// Close the decompressor, so an external one has consumed all its
+	// input before we check the hash.
+	if decompressor != nil {
+		cerr := decompressor.Close()
+		decompressor = nil
+		if cerr != nil {
+			return vterrors.Wrap(cerr, "failed to close decompressor")
+		}
+	}
+
 	// Close the Pipe.
 	if wait != nil {
 		stderr, err := wait()
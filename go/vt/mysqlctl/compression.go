@@ -0,0 +1,266 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os/exec"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/klauspost/pgzip"
+	"github.com/planetscale/pargzip"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// PargzipCompressor is the default compression engine. It produces
+	// standard gzip files, compressing blocks in parallel.
+	PargzipCompressor = "pargzip"
+	// PgzipCompressor produces standard gzip files using pgzip.
+	PgzipCompressor = "pgzip"
+	// ZstdCompressor streams the data through the zstd binary.
+	ZstdCompressor = "zstd"
+	// ExternalCompressor streams the data through user provided commands.
+	ExternalCompressor = "external"
+
+	zstdBinaryName = "zstd"
+)
+
+var (
+	// backupCompressionEngine is only used at backup time. It is recorded
+	// in the MANIFEST, and the matching decompressor is used on restore.
+	backupCompressionEngine = flag.String("backup_compression_engine", PargzipCompressor, "if backup_storage_compress is true, the compression engine to use for new backups: pargzip, pgzip, zstd or external. Restores always use the engine recorded in the backup MANIFEST.")
+
+	// backupZstdPath is the directory holding the zstd binary, if not in PATH.
+	backupZstdPath = flag.String("backup_zstd_path", "", "if backup_compression_engine is zstd, directory location of the zstd executable, e.g., /usr/bin")
+
+	// backupExternalCompressor is the command that compresses stdin to stdout.
+	backupExternalCompressor = flag.String("backup_external_compressor", "", "if backup_compression_engine is external, the command (with its arguments) used to compress backup files, reading from stdin and writing to stdout, e.g., 'lz4 -c'")
+
+	// backupExternalDecompressor is recorded in the MANIFEST of external compressed backups.
+	backupExternalDecompressor = flag.String("backup_external_decompressor", "", "the command (with its arguments) used to decompress backups made with the external compression engine, reading from stdin and writing to stdout, e.g., 'lz4 -d -c'. Overrides the command recorded in the backup MANIFEST.")
+
+	// backupExternalCompressorExtension is appended to compressed file names, where the engine names files.
+	backupExternalCompressorExtension = flag.String("backup_external_compressor_extension", "", "if backup_compression_engine is external, the file extension to use for compressed backup files, e.g., '.lz4'")
+)
+
+// compressionEngineExtension returns the file name extension for files
+// compressed with the given engine.
+func compressionEngineExtension(engine string) string {
+	switch engine {
+	case ZstdCompressor:
+		return ".zst"
+	case ExternalCompressor:
+		return *backupExternalCompressorExtension
+	default:
+		return ".gz"
+	}
+}
+
+// validateCompressionEngine checks the backup_compression_engine flags are usable.
+func validateCompressionEngine(engine string) error {
+	switch engine {
+	case PargzipCompressor, PgzipCompressor, ZstdCompressor:
+		return nil
+	case ExternalCompressor:
+		if *backupExternalCompressor == "" {
+			return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "backup_external_compressor must be set when using the %v compression engine", ExternalCompressor)
+		}
+		if *backupExternalDecompressor == "" {
+			return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "backup_external_decompressor must be set when using the %v compression engine", ExternalCompressor)
+		}
+		return nil
+	default:
+		return vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown backup_compression_engine %q, supported engines are pargzip, pgzip, zstd and external", engine)
+	}
+}
+
+// newCompressor returns a WriteCloser compressing everything written to it
+// into writer, using the given engine. Closing it flushes the compressed
+// data but does not close writer.
+func newCompressor(ctx context.Context, engine string, writer io.Writer, logger logutil.Logger) (io.WriteCloser, error) {
+	if err := validateCompressionEngine(engine); err != nil {
+		return nil, err
+	}
+	switch engine {
+	case PgzipCompressor:
+		gz, err := pgzip.NewWriterLevel(writer, pgzip.BestSpeed)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "cannot create pgzip compressor")
+		}
+		if err := gz.SetConcurrency(*backupCompressBlockSize, *backupCompressBlocks); err != nil {
+			return nil, vterrors.Wrap(err, "cannot set pgzip concurrency")
+		}
+		return gz, nil
+	case ZstdCompressor:
+		args := []string{"-c", "-T" + strconv.Itoa(*backupCompressBlocks)}
+		return newExternalCompressor(ctx, zstdCommand(args...), writer, logger)
+	case ExternalCompressor:
+		return newExternalCompressor(ctx, strings.Fields(*backupExternalCompressor), writer, logger)
+	default:
+		gz := pargzip.NewWriter(writer)
+		gz.ChunkSize = *backupCompressBlockSize
+		gz.Parallel = *backupCompressBlocks
+		gz.CompressionLevel = pargzip.BestSpeed
+		return gz, nil
+	}
+}
+
+// newDecompressor returns a ReadCloser decompressing the data read from
+// reader. engine is the compression engine recorded in the MANIFEST; an
+// empty value means the backup predates the field and was gzip compressed.
+// externalDecompressor is the decompression command recorded in the
+// MANIFEST, and can be overridden with -backup_external_decompressor.
+func newDecompressor(ctx context.Context, engine, externalDecompressor string, reader io.Reader, logger logutil.Logger) (io.ReadCloser, error) {
+	switch engine {
+	case "", PargzipCompressor, PgzipCompressor:
+		gz, err := pgzip.NewReader(reader)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "can't create gzip decompressor")
+		}
+		return gz, nil
+	case ZstdCompressor:
+		return newExternalDecompressor(ctx, zstdCommand("-d", "-c"), reader, logger)
+	case ExternalCompressor:
+		if *backupExternalDecompressor != "" {
+			externalDecompressor = *backupExternalDecompressor
+		}
+		if externalDecompressor == "" {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "backup was compressed with an external command, but no decompressor is known; set backup_external_decompressor")
+		}
+		return newExternalDecompressor(ctx, strings.Fields(externalDecompressor), reader, logger)
+	default:
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "unknown compression engine %q in backup MANIFEST", engine)
+	}
+}
+
+func zstdCommand(args ...string) []string {
+	program := zstdBinaryName
+	if *backupZstdPath != "" {
+		program = path.Join(*backupZstdPath, zstdBinaryName)
+	}
+	return append([]string{program}, args...)
+}
+
+// externalCompressor pipes written data through a command, whose
+// output goes to the underlying writer.
+type externalCompressor struct {
+	cmd      *exec.Cmd
+	stdin    io.WriteCloser
+	stderrWg sync.WaitGroup
+}
+
+func newExternalCompressor(ctx context.Context, command []string, writer io.Writer, logger logutil.Logger) (*externalCompressor, error) {
+	if len(command) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "empty compressor command")
+	}
+	ec := &externalCompressor{
+		cmd: exec.CommandContext(ctx, command[0], command[1:]...),
+	}
+	ec.cmd.Stdout = writer
+	stdin, err := ec.cmd.StdinPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdin pipe")
+	}
+	ec.stdin = stdin
+	stderr, err := ec.cmd.StderrPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stderr pipe")
+	}
+	logger.Infof("Compressing with %v", command)
+	if err := ec.cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start compressor %v", command[0])
+	}
+	ec.stderrWg.Add(1)
+	go scanLinesToLogger(command[0]+" stderr", stderr, logger, ec.stderrWg.Done)
+	return ec, nil
+}
+
+// Write is part of the io.Writer interface.
+func (ec *externalCompressor) Write(p []byte) (int, error) {
+	return ec.stdin.Write(p)
+}
+
+// Close flushes the compressor and waits for the command to exit.
+func (ec *externalCompressor) Close() error {
+	if err := ec.stdin.Close(); err != nil {
+		return vterrors.Wrap(err, "cannot close compressor stdin")
+	}
+	ec.stderrWg.Wait()
+	if err := ec.cmd.Wait(); err != nil {
+		return vterrors.Wrap(err, "compressor failed")
+	}
+	return nil
+}
+
+// externalDecompressor reads the output of a command fed with the
+// compressed data.
+type externalDecompressor struct {
+	cmd      *exec.Cmd
+	stdout   io.ReadCloser
+	stderrWg sync.WaitGroup
+}
+
+func newExternalDecompressor(ctx context.Context, command []string, reader io.Reader, logger logutil.Logger) (*externalDecompressor, error) {
+	if len(command) == 0 {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "empty decompressor command")
+	}
+	ed := &externalDecompressor{
+		cmd: exec.CommandContext(ctx, command[0], command[1:]...),
+	}
+	ed.cmd.Stdin = reader
+	stdout, err := ed.cmd.StdoutPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stdout pipe")
+	}
+	ed.stdout = stdout
+	stderr, err := ed.cmd.StderrPipe()
+	if err != nil {
+		return nil, vterrors.Wrap(err, "cannot create stderr pipe")
+	}
+	logger.Infof("Decompressing with %v", command)
+	if err := ed.cmd.Start(); err != nil {
+		return nil, vterrors.Wrapf(err, "can't start decompressor %v", command[0])
+	}
+	ed.stderrWg.Add(1)
+	go scanLinesToLogger(command[0]+" stderr", stderr, logger, ed.stderrWg.Done)
+	return ed, nil
+}
+
+// Read is part of the io.Reader interface.
+func (ed *externalDecompressor) Read(p []byte) (int, error) {
+	return ed.stdout.Read(p)
+}
+
+// Close waits for the command to exit. If the output was not fully
+// consumed, the command is stopped by closing its stdout.
+func (ed *externalDecompressor) Close() error {
+	ed.stdout.Close()
+	ed.stderrWg.Wait()
+	if err := ed.cmd.Wait(); err != nil {
+		return vterrors.Wrap(err, "decompressor failed")
+	}
+	return nil
+}
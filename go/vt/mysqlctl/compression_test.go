@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"os/exec"
+	"testing"
+
+	"vitess.io/vitess/go/vt/logutil"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := make([]byte, 1024*1024)
+	// Half random, half compressible.
+	rand.Read(data[:len(data)/2])
+
+	defer func(compressor, decompressor string) {
+		*backupExternalCompressor = compressor
+		*backupExternalDecompressor = decompressor
+	}(*backupExternalCompressor, *backupExternalDecompressor)
+	*backupExternalCompressor = "gzip -c -1"
+	*backupExternalDecompressor = "gzip -d -c"
+
+	for _, engine := range []string{PargzipCompressor, PgzipCompressor, ZstdCompressor, ExternalCompressor} {
+		t.Run(engine, func(t *testing.T) {
+			binary := ""
+			switch engine {
+			case ZstdCompressor:
+				binary = zstdBinaryName
+			case ExternalCompressor:
+				binary = "gzip"
+			}
+			if binary != "" {
+				if _, err := exec.LookPath(binary); err != nil {
+					t.Skipf("%v not available", binary)
+				}
+			}
+
+			ctx := context.Background()
+			logger := logutil.NewMemoryLogger()
+			compressed := &bytes.Buffer{}
+			compressor, err := newCompressor(ctx, engine, compressed, logger)
+			if err != nil {
+				t.Fatalf("newCompressor(%v) failed: %v", engine, err)
+			}
+			if _, err := compressor.Write(data); err != nil {
+				t.Fatalf("Write failed: %v", err)
+			}
+			if err := compressor.Close(); err != nil {
+				t.Fatalf("Close failed: %v", err)
+			}
+			if compressed.Len() >= len(data) {
+				t.Errorf("compressed size %v not smaller than %v", compressed.Len(), len(data))
+			}
+
+			// The external decompressor comes from the MANIFEST, not the flag.
+			*backupExternalDecompressor = ""
+			defer func() { *backupExternalDecompressor = "gzip -d -c" }()
+			decompressor, err := newDecompressor(ctx, engine, "gzip -d -c", compressed, logger)
+			if err != nil {
+				t.Fatalf("newDecompressor(%v) failed: %v", engine, err)
+			}
+			got, err := ioutil.ReadAll(decompressor)
+			if err != nil {
+				t.Fatalf("ReadAll failed: %v", err)
+			}
+			if err := decompressor.Close(); err != nil {
+				t.Fatalf("Close failed: %v", err)
+			}
+			if !bytes.Equal(got, data) {
+				t.Errorf("decompressed data does not match the original")
+			}
+		})
+	}
+}
+
+func TestDecompressLegacyBackup(t *testing.T) {
+	// Backups without a CompressionEngine in their MANIFEST are gzip.
+	compressed := &bytes.Buffer{}
+	compressor, err := newCompressor(context.Background(), PargzipCompressor, compressed, logutil.NewMemoryLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressor.Write([]byte("legacy"))
+	compressor.Close()
+
+	decompressor, err := newDecompressor(context.Background(), "", "", compressed, logutil.NewMemoryLogger())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer decompressor.Close()
+	got, err := ioutil.ReadAll(decompressor)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "legacy" {
+		t.Errorf("got %q, want %q", got, "legacy")
+	}
+}
+
+func TestValidateCompressionEngine(t *testing.T) {
+	if err := validateCompressionEngine("snappy"); err == nil {
+		t.Errorf("expected an error for an unknown engine")
+	}
+	defer func(compressor string) { *backupExternalCompressor = compressor }(*backupExternalCompressor)
+	*backupExternalCompressor = ""
+	if err := validateCompressionEngine(ExternalCompressor); err == nil {
+		t.Errorf("expected an error for an external engine without a command")
+	}
+	if _, err := newDecompressor(context.Background(), "snappy", "", &bytes.Buffer{}, logutil.NewMemoryLogger()); err == nil {
+		t.Errorf("expected an error for an unknown MANIFEST engine")
+	}
+}
+
+func TestCompressionEngineExtension(t *testing.T) {
+	testcases := map[string]string{
+		PargzipCompressor: ".gz",
+		PgzipCompressor:   ".gz",
+		ZstdCompressor:    ".zst",
+	}
+	for engine, want := range testcases {
+		if got := compressionEngineExtension(engine); got != want {
+			t.Errorf("compressionEngineExtension(%v) = %v, want %v", engine, got, want)
+		}
+	}
+}
@@ -165,6 +165,9 @@ type FakeMysqlDaemon struct {
 	// SemiSyncReplicaEnabled represents the state of rpl_semi_sync_slave_enabled.
 	SemiSyncReplicaEnabled bool
 
+	// ConfigOverrides is set by SetConfigOverrides.
+	ConfigOverrides map[string]string
+
 	// TimeoutHook is a func that can be called at the beginning of any method to fake a timeout.
 	// all a test needs to do is make it { return context.DeadlineExceeded }
 	TimeoutHook func() error
@@ -237,6 +240,11 @@ func (fmd *FakeMysqlDaemon) RefreshConfig(ctx context.Context, cnf *mysqlctl.Myc
 	return nil
 }
 
+// SetConfigOverrides is part of the MysqlDaemon interface
+func (fmd *FakeMysqlDaemon) SetConfigOverrides(overrides map[string]string) {
+	fmd.ConfigOverrides = overrides
+}
+
 // Wait is part of the MysqlDaemon interface.
 func (fmd *FakeMysqlDaemon) Wait(ctx context.Context, cnf *mysqlctl.Mycnf) error {
 	return nil
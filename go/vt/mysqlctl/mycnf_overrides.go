@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ConfigVariableChange is a MySQL global variable whose running value
+// differs from the value in the config.
+type ConfigVariableChange struct {
+	Name    string
+	Running string
+	// Desired is the config value, converted to the format of the
+	// running value, so that it can be used in SET GLOBAL.
+	Desired string
+}
+
+// SetConfigOverrides sets the options which are applied on top of the
+// my.cnf template, the next time the config is generated. The names can
+// use either hyphens or underscores. An empty value adds the option
+// without a value.
+// Note when -mysqlctl_socket is used, the config is generated by
+// mysqlctld, which doesn't have the overrides.
+func (mysqld *Mysqld) SetConfigOverrides(overrides map[string]string) {
+	mysqld.mutex.Lock()
+	defer mysqld.mutex.Unlock()
+	mysqld.configOverrides = overrides
+}
+
+func (mysqld *Mysqld) getConfigOverrides() map[string]string {
+	mysqld.mutex.Lock()
+	defer mysqld.mutex.Unlock()
+	return mysqld.configOverrides
+}
+
+// ReadMycnfSettings returns the options of the [mysqld] section of the
+// my.cnf file of cnf. See MycnfSettings.
+func ReadMycnfSettings(cnf *Mycnf) (map[string]string, error) {
+	data, err := ioutil.ReadFile(cnf.path)
+	if err != nil {
+		return nil, err
+	}
+	return MycnfSettings(string(data)), nil
+}
+
+// MycnfSettings returns the options of the [mysqld] section of a my.cnf,
+// keyed by their variable name: lower case, with underscores, and without
+// the loose- prefix. Options without a value are ON.
+func MycnfSettings(config string) map[string]string {
+	settings := make(map[string]string)
+	section := "mysqld"
+	for _, line := range strings.Split(config, "\n") {
+		key, value, ok := parseMycnfLine(line, &section)
+		if !ok || section != "mysqld" {
+			continue
+		}
+		if value == "" {
+			value = "ON"
+		}
+		settings[key] = value
+	}
+	return settings
+}
+
+// MergeMycnfOverrides applies overrides to the [mysqld] section of a
+// my.cnf. The options which are already in the section are replaced in
+// place, the other ones are added at the end of the section.
+func MergeMycnfOverrides(config string, overrides map[string]string) string {
+	if len(overrides) == 0 {
+		return config
+	}
+	// pending are the overrides which aren't in the config yet.
+	pending := make(map[string]string, len(overrides))
+	for name, value := range overrides {
+		pending[variableName(name)] = value
+	}
+	overrides = make(map[string]string, len(pending))
+	for name, value := range pending {
+		overrides[name] = value
+	}
+
+	lines := strings.Split(strings.TrimSuffix(config, "\n"), "\n")
+	var out []string
+	section := "mysqld"
+	hasMysqld := false
+	appendPending := func() {
+		if len(pending) == 0 {
+			return
+		}
+		names := make([]string, 0, len(pending))
+		for name := range pending {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		out = append(out, "## overrides")
+		for _, name := range names {
+			out = append(out, mycnfLine(name, pending[name]))
+		}
+		pending = nil
+	}
+	for _, line := range lines {
+		previous := section
+		key, _, ok := parseMycnfLine(line, &section)
+		if section != previous && previous == "mysqld" && hasMysqld {
+			appendPending()
+		}
+		if section == "mysqld" && strings.HasPrefix(strings.TrimSpace(line), "[") {
+			hasMysqld = true
+		}
+		if ok && section == "mysqld" {
+			if value, found := overrides[key]; found {
+				out = append(out, mycnfLine(strings.TrimSpace(strings.SplitN(line, "=", 2)[0]), value))
+				delete(pending, key)
+				continue
+			}
+		}
+		out = append(out, line)
+	}
+	if len(pending) != 0 {
+		if !hasMysqld && section != "mysqld" {
+			out = append(out, "[mysqld]")
+		}
+		appendPending()
+	}
+	return strings.Join(out, "\n") + "\n"
+}
+
+// DiffConfigVariables returns the global variables whose running value
+// differs from their value in the config, sorted by name. The options of
+// the config which aren't global variables are ignored.
+func DiffConfigVariables(settings, variables map[string]string) []ConfigVariableChange {
+	var changes []ConfigVariableChange
+	for name, value := range settings {
+		running, ok := variables[name]
+		if !ok {
+			continue
+		}
+		desired := configValue(value, running)
+		if configValuesEqual(desired, running) {
+			continue
+		}
+		changes = append(changes, ConfigVariableChange{
+			Name:    name,
+			Running: running,
+			Desired: desired,
+		})
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Name < changes[j].Name
+	})
+	return changes
+}
+
+// parseMycnfLine parses a line of a my.cnf. It updates section on
+// section headers, and returns the variable name and the value of
+// options.
+func parseMycnfLine(line string, section *string) (string, string, bool) {
+	line = strings.TrimSpace(line)
+	if line == "" || line[0] == '#' || line[0] == ';' || line[0] == '!' {
+		return "", "", false
+	}
+	if line[0] == '[' {
+		*section = strings.ToLower(strings.TrimSpace(strings.Trim(line, "[]")))
+		return "", "", false
+	}
+	parts := strings.SplitN(line, "=", 2)
+	key := variableName(parts[0])
+	if len(parts) == 1 {
+		return key, "", true
+	}
+	return key, strings.Trim(strings.TrimSpace(parts[1]), `"'`), true
+}
+
+func mycnfLine(name, value string) string {
+	if value == "" {
+		return name
+	}
+	return name + " = " + value
+}
+
+// variableName returns the global variable name of a my.cnf option.
+func variableName(option string) string {
+	name := strings.ToLower(strings.TrimSpace(option))
+	name = strings.TrimPrefix(name, "loose-")
+	name = strings.TrimPrefix(name, "loose_")
+	return strings.Replace(name, "-", "_", -1)
+}
+
+// configValue converts a config value to the format of the running
+// value: booleans become ON or OFF, and sizes with a K, M or G suffix
+// become a number of bytes.
+func configValue(value, running string) string {
+	switch strings.ToUpper(running) {
+	case "ON", "OFF":
+		switch strings.ToUpper(value) {
+		case "1", "ON", "TRUE":
+			return "ON"
+		case "0", "OFF", "FALSE":
+			return "OFF"
+		}
+		return value
+	}
+	if _, err := strconv.ParseFloat(running, 64); err != nil || value == "" {
+		return value
+	}
+	multiplier := uint64(1)
+	switch value[len(value)-1] {
+	case 'k', 'K':
+		multiplier = 1 << 10
+	case 'm', 'M':
+		multiplier = 1 << 20
+	case 'g', 'G':
+		multiplier = 1 << 30
+	default:
+		return value
+	}
+	n, err := strconv.ParseUint(value[:len(value)-1], 10, 64)
+	if err != nil {
+		return value
+	}
+	return strconv.FormatUint(n*multiplier, 10)
+}
+
+func configValuesEqual(desired, running string) bool {
+	if strings.EqualFold(desired, running) {
+		return true
+	}
+	// Numbers can be formatted differently, e.g. 2 and 2.000000 for
+	// long_query_time.
+	d, err1 := strconv.ParseFloat(desired, 64)
+	r, err2 := strconv.ParseFloat(running, 64)
+	if err1 == nil && err2 == nil {
+		return d == r
+	}
+	// Directories may or may not have a trailing slash.
+	return len(desired) > 1 && len(running) > 1 && strings.TrimRight(desired, "/") == strings.TrimRight(running, "/")
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeMycnfOverrides(t *testing.T) {
+	config := `[mysqld]
+port = 3306
+# comment
+max-connections = 500
+skip-name-resolve
+[client]
+port = 3306
+`
+	got := MergeMycnfOverrides(config, map[string]string{
+		"max_connections":                "1000",
+		"port":                           "3307",
+		"innodb-flush-log-at-trx-commit": "2",
+		"log_slave_updates":              "",
+	})
+	want := `[mysqld]
+port = 3307
+# comment
+max-connections = 1000
+skip-name-resolve
+## overrides
+innodb_flush_log_at_trx_commit = 2
+log_slave_updates
+[client]
+port = 3306
+`
+	assert.Equal(t, want, got)
+
+	assert.Equal(t, config, MergeMycnfOverrides(config, nil))
+	assert.Equal(t, "[client]\nport = 3306\n[mysqld]\n## overrides\nport = 3307\n",
+		MergeMycnfOverrides("[client]\nport = 3306\n", map[string]string{"port": "3307"}))
+}
+
+func TestMycnfSettings(t *testing.T) {
+	settings := MycnfSettings(`[mysqld]
+Max-Connections = 500
+loose-rpl_semi_sync_master_timeout = 1000
+skip-name-resolve
+datadir = "/vt/data"
+[client]
+port = 3306
+`)
+	assert.Equal(t, map[string]string{
+		"max_connections":              "500",
+		"rpl_semi_sync_master_timeout": "1000",
+		"skip_name_resolve":            "ON",
+		"datadir":                      "/vt/data",
+	}, settings)
+}
+
+func TestDiffConfigVariables(t *testing.T) {
+	settings := map[string]string{
+		"max_connections":    "1000",
+		"max_allowed_packet": "64M",
+		"long_query_time":    "2",
+		"slow_query_log":     "1",
+		"general_log":        "true",
+		"binlog_format":      "row",
+		"datadir":            "/vt/data",
+		"not_a_variable":     "1",
+	}
+	variables := map[string]string{
+		"max_connections":    "500",
+		"max_allowed_packet": "67108864",
+		"long_query_time":    "2.000000",
+		"slow_query_log":     "ON",
+		"general_log":        "OFF",
+		"binlog_format":      "ROW",
+		"datadir":            "/vt/data/",
+	}
+	assert.Equal(t, []ConfigVariableChange{
+		{Name: "general_log", Running: "OFF", Desired: "ON"},
+		{Name: "max_connections", Running: "500", Desired: "1000"},
+	}, DiffConfigVariables(settings, variables))
+}
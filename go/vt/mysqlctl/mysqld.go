@@ -93,9 +93,10 @@ type Mysqld struct {
 	capabilities capabilitySet
 
 	// mutex protects the fields below.
-	mutex         sync.Mutex
-	onTermFuncs   []func()
-	cancelWaitCmd chan struct{}
+	mutex           sync.Mutex
+	onTermFuncs     []func()
+	cancelWaitCmd   chan struct{}
+	configOverrides map[string]string
 }
 
 // NewMysqld creates a Mysqld object based on the provided configuration
@@ -791,6 +792,7 @@ func (mysqld *Mysqld) initConfig(cnf *Mycnf, outFile string) error {
 	if err != nil {
 		return err
 	}
+	configData = MergeMycnfOverrides(configData, mysqld.getConfigOverrides())
 
 	return ioutil.WriteFile(outFile, []byte(configData), 0664)
 }
@@ -0,0 +1,248 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// MySQLShellBackupEngine encapsulates the logic of the mysqlshell engine.
+// It implements the BackupEngine interface by running a logical dump of
+// the instance with MySQL Shell's util.dumpInstance, and restores it with
+// util.loadDump. The dump itself is written by mysqlsh to a location
+// shared by all tablets; only the MANIFEST goes to the backup storage.
+type MySQLShellBackupEngine struct {
+}
+
+var (
+	// path where the mysqlsh program is located
+	mysqlShellPath = flag.String("mysql_shell_path", "", "directory location of the mysqlsh executable, e.g., /usr/bin")
+	// location where dumps are written
+	mysqlShellBackupLocation = flag.String("mysql_shell_backup_location", "", "directory where the mysqlshell backup engine writes its dumps. It must be reachable by all tablets that may restore the backup, e.g., a shared mount")
+	// flags to pass to mysqlsh itself
+	mysqlShellFlags = flag.String("mysql_shell_flags", "--defaults-file=/dev/null --js -h localhost", "flags to pass to the mysqlsh command. These should be space separated")
+	// options for the dump
+	mysqlShellDumpFlags = flag.String("mysql_shell_dump_flags", `{"threads": 4}`, "options to pass to util.dumpInstance when taking a backup, as a JSON object")
+	// options for the load
+	mysqlShellLoadFlags = flag.String("mysql_shell_load_flags", `{"threads": 4, "loadUsers": true, "updateGtidSet": "replace", "skipBinlog": true, "progressFile": ""}`, "options to pass to util.loadDump when restoring a backup, as a JSON object")
+)
+
+const (
+	mysqlShellBackupEngineName = "mysqlshell"
+	mysqlShellBinaryName       = "mysqlsh"
+	// mysqlShellMetadataFile is written by util.dumpInstance in the dump directory.
+	mysqlShellMetadataFile = "@.json"
+)
+
+// mysqlShellBackupManifest represents a backup taken with MySQL Shell.
+type mysqlShellBackupManifest struct {
+	// BackupManifest is an anonymous embedding of the base manifest struct.
+	BackupManifest
+
+	// BackupLocation is the directory holding the dump.
+	BackupLocation string
+
+	// Params are the util.dumpInstance options the backup was taken with.
+	Params string
+}
+
+// ExecuteBackup returns a boolean that indicates if the backup is usable,
+// and an overall error.
+func (be *MySQLShellBackupEngine) ExecuteBackup(ctx context.Context, params BackupParams, bh backupstorage.BackupHandle) (complete bool, finalErr error) {
+	if *mysqlShellBackupLocation == "" {
+		return false, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "mysql_shell_backup_location must be specified")
+	}
+	if err := validateMySQLShellOptions(*mysqlShellDumpFlags); err != nil {
+		return false, vterrors.Wrap(err, "invalid mysql_shell_dump_flags")
+	}
+
+	location := path.Join(*mysqlShellBackupLocation, bh.Directory(), bh.Name())
+	params.Logger.Infof("Dumping instance to %v", location)
+	script := fmt.Sprintf("util.dumpInstance(%q, %s)", location, *mysqlShellDumpFlags)
+	if err := runMySQLShell(ctx, script, params.Logger); err != nil {
+		return false, vterrors.Wrap(err, "mysqlsh dump failed")
+	}
+
+	metadata, err := ioutil.ReadFile(path.Join(location, mysqlShellMetadataFile))
+	if err != nil {
+		return false, vterrors.Wrapf(err, "cannot read dump metadata in %v", location)
+	}
+	replicationPosition, err := mysqlShellDumpPosition(metadata)
+	if err != nil {
+		return false, err
+	}
+
+	// open the MANIFEST
+	params.Logger.Infof("Writing backup MANIFEST")
+	mwc, err := bh.AddFile(ctx, backupManifestFileName, backupstorage.FileSizeUnknown)
+	if err != nil {
+		return false, vterrors.Wrapf(err, "cannot add %v to backup", backupManifestFileName)
+	}
+	defer closeFile(mwc, backupManifestFileName, params.Logger, &finalErr)
+
+	// JSON-encode and write the MANIFEST
+	bm := &mysqlShellBackupManifest{
+		// Common base fields
+		BackupManifest: BackupManifest{
+			BackupMethod: mysqlShellBackupEngineName,
+			Position:     replicationPosition,
+			BackupTime:   params.BackupTime.UTC().Format(time.RFC3339),
+			FinishedTime: time.Now().UTC().Format(time.RFC3339),
+		},
+
+		// MySQL Shell-specific fields
+		BackupLocation: location,
+		Params:         *mysqlShellDumpFlags,
+	}
+
+	data, err := json.MarshalIndent(bm, "", "  ")
+	if err != nil {
+		return false, vterrors.Wrapf(err, "cannot JSON encode %v", backupManifestFileName)
+	}
+	if _, err := mwc.Write([]byte(data)); err != nil {
+		return false, vterrors.Wrapf(err, "cannot write %v", backupManifestFileName)
+	}
+
+	params.Logger.Infof("Backup completed")
+	return true, nil
+}
+
+// ExecuteRestore restores from a backup. Any error is returned.
+// util.loadDump needs a running, empty mysqld. It is shut down once the
+// dump is loaded, as Restore() restarts it afterwards.
+func (be *MySQLShellBackupEngine) ExecuteRestore(ctx context.Context, params RestoreParams, bh backupstorage.BackupHandle) (*BackupManifest, error) {
+	var bm mysqlShellBackupManifest
+	if err := getBackupManifestInto(ctx, bh, &bm); err != nil {
+		return nil, err
+	}
+	if err := validateMySQLShellOptions(*mysqlShellLoadFlags); err != nil {
+		return nil, vterrors.Wrap(err, "invalid mysql_shell_load_flags")
+	}
+
+	// mark restore as in progress
+	if err := createStateFile(params.Cnf); err != nil {
+		return nil, err
+	}
+
+	params.Logger.Infof("Restore: waiting for mysqld to be ready")
+	if err := params.Mysqld.Wait(ctx, params.Cnf); err != nil {
+		return nil, err
+	}
+	// The dump brings its own GTID set.
+	if err := params.Mysqld.ResetReplication(ctx); err != nil {
+		return nil, vterrors.Wrap(err, "cannot reset replication before loading the dump")
+	}
+
+	params.Logger.Infof("Restore: loading dump from %v", bm.BackupLocation)
+	script := fmt.Sprintf("util.loadDump(%q, %s)", bm.BackupLocation, *mysqlShellLoadFlags)
+	if err := runMySQLShell(ctx, script, params.Logger); err != nil {
+		// don't delete the state file here because that is how we detect an interrupted restore
+		return nil, vterrors.Wrap(err, "mysqlsh load failed")
+	}
+
+	params.Logger.Infof("Restore: shutting down mysqld")
+	if err := params.Mysqld.Shutdown(ctx, params.Cnf, true); err != nil {
+		return nil, err
+	}
+
+	params.Logger.Infof("Restore: returning replication position %v", bm.Position)
+	return &bm.BackupManifest, nil
+}
+
+// ShouldDrainForBackup satisfies the BackupEngine interface
+// a MySQL Shell dump is consistent without stopping writes, hence false
+func (be *MySQLShellBackupEngine) ShouldDrainForBackup() bool {
+	return false
+}
+
+// runMySQLShell runs the given JavaScript with mysqlsh, sending its output
+// to the logger. mysqlsh reports the progress of dumps and loads there.
+func runMySQLShell(ctx context.Context, script string, logger logutil.Logger) error {
+	program := path.Join(*mysqlShellPath, mysqlShellBinaryName)
+	flagsToExec := append(strings.Fields(*mysqlShellFlags), "-e", script)
+	cmd := exec.CommandContext(ctx, program, flagsToExec...)
+	logger.Infof("Executing %v %v", program, flagsToExec)
+
+	cmdOut, err := cmd.StdoutPipe()
+	if err != nil {
+		return vterrors.Wrap(err, "cannot create stdout pipe")
+	}
+	cmdErr, err := cmd.StderrPipe()
+	if err != nil {
+		return vterrors.Wrap(err, "cannot create stderr pipe")
+	}
+	if err := cmd.Start(); err != nil {
+		return vterrors.Wrap(err, "can't start mysqlsh")
+	}
+
+	// Read stdout/stderr in the background and send each line to the logger.
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+	go scanLinesToLogger("mysqlsh stdout", cmdOut, logger, wg.Done)
+	go scanLinesToLogger("mysqlsh stderr", cmdErr, logger, wg.Done)
+	wg.Wait()
+
+	// Get exit status.
+	return cmd.Wait()
+}
+
+// validateMySQLShellOptions checks options are a JSON object, as they are
+// passed verbatim to the dump and load utilities.
+func validateMySQLShellOptions(options string) error {
+	var parsed map[string]interface{}
+	return json.Unmarshal([]byte(options), &parsed)
+}
+
+// mysqlShellDumpPosition extracts the replication position from the
+// metadata file written by util.dumpInstance.
+func mysqlShellDumpPosition(metadata []byte) (mysql.Position, error) {
+	var dump struct {
+		GtidExecuted string `json:"gtidExecuted"`
+	}
+	if err := json.Unmarshal(metadata, &dump); err != nil {
+		return mysql.Position{}, vterrors.Wrap(err, "cannot decode dump metadata")
+	}
+	// mysqlsh keeps the newlines MySQL puts in long GTID sets.
+	gtidExecuted := strings.ReplaceAll(dump.GtidExecuted, "\n", "")
+	if gtidExecuted == "" {
+		return mysql.Position{}, vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "dump metadata has no gtidExecuted; GTIDs must be enabled")
+	}
+	position, err := mysql.ParsePosition(mysql.Mysql56FlavorID, gtidExecuted)
+	if err != nil {
+		return mysql.Position{}, vterrors.Wrapf(err, "cannot parse gtidExecuted %q", gtidExecuted)
+	}
+	return position, nil
+}
+
+func init() {
+	BackupRestoreEngineMap[mysqlShellBackupEngineName] = &MySQLShellBackupEngine{}
+}
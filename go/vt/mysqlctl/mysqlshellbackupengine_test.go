@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mysqlctl
+
+import (
+	"testing"
+)
+
+func TestMySQLShellDumpPosition(t *testing.T) {
+	metadata := `{
+    "dumper": "mysqlsh Ver 8.0.23",
+    "version": "1.0.2",
+    "origin": "dumpInstance",
+    "binlogFile": "vt-0000000101-bin.000002",
+    "binlogPosition": 1234,
+    "gtidExecuted": "145e508e-ae54-11e9-8ce6-46824dd1815e:1-3,\n1e51f8be-ae54-11e9-a7c6-4280a041109b:1-8",
+    "gtidExecutedInconsistent": false
+}`
+	want := "145e508e-ae54-11e9-8ce6-46824dd1815e:1-3,1e51f8be-ae54-11e9-a7c6-4280a041109b:1-8"
+
+	pos, err := mysqlShellDumpPosition([]byte(metadata))
+	if err != nil {
+		t.Fatalf("mysqlShellDumpPosition error: %v", err)
+	}
+	if got := pos.String(); got != want {
+		t.Errorf("mysqlShellDumpPosition() = %v; want %v", got, want)
+	}
+}
+
+func TestMySQLShellDumpPositionNoGTID(t *testing.T) {
+	if _, err := mysqlShellDumpPosition([]byte(`{"gtidExecuted": ""}`)); err == nil {
+		t.Fatalf("expected error from mysqlShellDumpPosition but got nil")
+	}
+	if _, err := mysqlShellDumpPosition([]byte(`not json`)); err == nil {
+		t.Fatalf("expected error from mysqlShellDumpPosition but got nil")
+	}
+}
+
+func TestValidateMySQLShellOptions(t *testing.T) {
+	if err := validateMySQLShellOptions(*mysqlShellDumpFlags); err != nil {
+		t.Errorf("default dump flags are invalid: %v", err)
+	}
+	if err := validateMySQLShellOptions(*mysqlShellLoadFlags); err != nil {
+		t.Errorf("default load flags are invalid: %v", err)
+	}
+	if err := validateMySQLShellOptions(`threads: 4`); err == nil {
+		t.Errorf("expected an error for options that are not a JSON object")
+	}
+}
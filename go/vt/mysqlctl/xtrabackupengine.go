@@ -31,9 +31,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/klauspost/pgzip"
-	"github.com/planetscale/pargzip"
-
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
@@ -99,6 +96,14 @@ type xtraBackupManifest struct {
 	// false for backups that were created before the field existed, and those
 	// backups all had compression enabled.
 	SkipCompress bool
+
+	// CompressionEngine is the engine the backup was compressed with.
+	// Backups created before the field existed used gzip.
+	CompressionEngine string
+
+	// ExternalDecompressor is the command to decompress the backup with,
+	// if CompressionEngine is external.
+	ExternalDecompressor string
 }
 
 func (be *XtrabackupEngine) backupFileName() string {
@@ -108,7 +113,7 @@ func (be *XtrabackupEngine) backupFileName() string {
 		fileName += *xtrabackupStreamMode
 	}
 	if *backupStorageCompress {
-		fileName += ".gz"
+		fileName += compressionEngineExtension(*backupCompressionEngine)
 	}
 	return fileName
 }
@@ -130,6 +135,11 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 	if *xtrabackupUser == "" {
 		return false, vterrors.New(vtrpc.Code_INVALID_ARGUMENT, "xtrabackupUser must be specified.")
 	}
+	if *backupStorageCompress {
+		if err := validateCompressionEngine(*backupCompressionEngine); err != nil {
+			return false, err
+		}
+	}
 	// use a mysql connection to detect flavor at runtime
 	conn, err := params.Mysqld.GetDbaConnection(ctx)
 	if conn != nil && err == nil {
@@ -186,6 +196,12 @@ func (be *XtrabackupEngine) ExecuteBackup(ctx context.Context, params BackupPara
 		NumStripes:      int32(numStripes),
 		StripeBlockSize: int32(*xtrabackupStripeBlockSize),
 	}
+	if *backupStorageCompress {
+		bm.CompressionEngine = *backupCompressionEngine
+		if bm.CompressionEngine == ExternalCompressor {
+			bm.ExternalDecompressor = *backupExternalDecompressor
+		}
+	}
 
 	data, err := json.MarshalIndent(bm, "", "  ")
 	if err != nil {
@@ -269,20 +285,22 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 		return replicationPosition, vterrors.Wrap(err, "cannot create stderr pipe")
 	}
 
+	// The bandwidth limit is shared by all stripes.
+	limiter := newBandwidthLimiter(*backupBandwidthLimit, backupThrottledTime)
 	destWriters := []io.Writer{}
 	destBuffers := []*bufio.Writer{}
 	destCompressors := []io.WriteCloser{}
 	for _, file := range destFiles {
-		buffer := bufio.NewWriterSize(file, writerBufferSize)
+		buffer := bufio.NewWriterSize(limiter.writer(ctx, file), writerBufferSize)
 		destBuffers = append(destBuffers, buffer)
 		writer := io.Writer(buffer)
 
-		// Create the gzip compression pipe, if necessary.
+		// Create the compression pipe, if necessary.
 		if *backupStorageCompress {
-			compressor := pargzip.NewWriter(writer)
-			compressor.ChunkSize = *backupCompressBlockSize
-			compressor.Parallel = *backupCompressBlocks
-			compressor.CompressionLevel = pargzip.BestSpeed
+			compressor, err := newCompressor(ctx, *backupCompressionEngine, writer, params.Logger)
+			if err != nil {
+				return replicationPosition, vterrors.Wrap(err, "cannot create compressor")
+			}
 			writer = compressor
 			destCompressors = append(destCompressors, compressor)
 		}
@@ -334,7 +352,9 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Add a buffer in front of the raw stdout pipe so io.CopyN() can use the
 	// buffered reader's WriteTo() method instead of allocating a new buffer
 	// every time.
-	backupOutBuf := bufio.NewReaderSize(backupOut, int(blockSize))
+	progress := newBackupProgress("Backup", 0, backupBytes, backupProgressPercent, params.Logger)
+	defer progress.close()
+	backupOutBuf := bufio.NewReaderSize(progress.reader(backupOut), int(blockSize))
 	if _, err := copyToStripes(destWriters, backupOutBuf, blockSize); err != nil {
 		return replicationPosition, vterrors.Wrap(err, "cannot copy output from xtrabackup command")
 	}
@@ -342,7 +362,7 @@ func (be *XtrabackupEngine) backupFiles(ctx context.Context, params BackupParams
 	// Close compressor to flush it. After that all data is sent to the buffer.
 	for _, compressor := range destCompressors {
 		if err := compressor.Close(); err != nil {
-			return replicationPosition, vterrors.Wrap(err, "cannot close gzip compressor")
+			return replicationPosition, vterrors.Wrap(err, "cannot close compressor")
 		}
 	}
 
@@ -519,16 +539,18 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 		}
 	}()
 
+	// The bandwidth limit is shared by all stripes.
+	limiter := newBandwidthLimiter(*restoreBandwidthLimit, restoreThrottledTime)
 	srcReaders := []io.Reader{}
 	srcDecompressors := []io.ReadCloser{}
 	for _, file := range srcFiles {
-		reader := io.Reader(file)
+		reader := limiter.reader(ctx, file)
 
 		// Create the decompressor if needed.
 		if compressed {
-			decompressor, err := pgzip.NewReader(reader)
+			decompressor, err := newDecompressor(ctx, bm.CompressionEngine, bm.ExternalDecompressor, reader, logger)
 			if err != nil {
-				return vterrors.Wrap(err, "can't create gzip decompressor")
+				return vterrors.Wrap(err, "can't create decompressor")
 			}
 			srcDecompressors = append(srcDecompressors, decompressor)
 			reader = decompressor
@@ -539,12 +561,14 @@ func (be *XtrabackupEngine) extractFiles(ctx context.Context, logger logutil.Log
 	defer func() {
 		for _, decompressor := range srcDecompressors {
 			if cerr := decompressor.Close(); cerr != nil {
-				logger.Errorf("failed to close gzip decompressor: %v", cerr)
+				logger.Errorf("failed to close decompressor: %v", cerr)
 			}
 		}
 	}()
 
-	reader := stripeReader(srcReaders, int64(bm.StripeBlockSize))
+	progress := newBackupProgress("Restore", 0, restoreBytes, restoreProgressPercent, logger)
+	defer progress.close()
+	reader := progress.reader(stripeReader(srcReaders, int64(bm.StripeBlockSize)))
 
 	switch streamMode {
 	case streamModeTar:
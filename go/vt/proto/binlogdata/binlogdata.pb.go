@@ -685,6 +685,7 @@ type Rule struct {
 	// "select * from t", same as an empty Filter, or
 	// "select * from t where in_keyrange('-80')", same as "-80", or
 	// "select col1, col2 from t where in_keyrange(col1, 'hash', '-80'), or
+	// "select * from t where tenant_id in (1, 2) and deleted_at is null", or
 	// What is allowed in a select expression depends on whether
 	// it's a vstreamer or vreplication request. For more details,
 	// please refer to the specific package documentation.
@@ -692,10 +693,17 @@ type Rule struct {
 	// "exclude" value, which will cause the matched tables
 	// to be excluded.
 	// TODO(sougou): support this on vstreamer side also.
-	Filter               string   `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// dml_types, if set, limits the row events of the matching tables
+	// to the listed statement types: INSERT, UPDATE or DELETE.
+	// This is only supported by vstreamer. It does not apply to the
+	// rows sent by the copy phase. The columns of the row events can
+	// be limited with a select expression in the Filter, like
+	// "select id, updated_at from t".
+	DmlTypes             []VEventType `protobuf:"varint,3,rep,packed,name=dml_types,json=dmlTypes,proto3,enum=binlogdata.VEventType" json:"dml_types,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *Rule) Reset()         { *m = Rule{} }
@@ -745,6 +753,13 @@ func (m *Rule) GetFilter() string {
 	return ""
 }
 
+func (m *Rule) GetDmlTypes() []VEventType {
+	if m != nil {
+		return m.DmlTypes
+	}
+	return nil
+}
+
 // Filter represents a list of ordered rules. The first
 // match wins.
 type Filter struct {
@@ -951,11 +966,14 @@ func (m *BinlogSource) GetExternalCluster() string {
 // If After is set and not Before, it's an insert.
 // If both are set, it's an update.
 type RowChange struct {
-	Before               *query.Row `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
-	After                *query.Row `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Before *query.Row `protobuf:"bytes,1,opt,name=before,proto3" json:"before,omitempty"`
+	After  *query.Row `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+	// encoded is the row change serialized in the format
+	// requested by the VStream flags of vtgate, if any.
+	Encoded              []byte   `protobuf:"bytes,3,opt,name=encoded,proto3" json:"encoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RowChange) Reset()         { *m = RowChange{} }
@@ -1005,6 +1023,13 @@ func (m *RowChange) GetAfter() *query.Row {
 	return nil
 }
 
+func (m *RowChange) GetEncoded() []byte {
+	if m != nil {
+		return m.Encoded
+	}
+	return nil
+}
+
 // RowEvent represent row events for one table.
 type RowEvent struct {
 	TableName            string       `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
@@ -1063,11 +1088,14 @@ func (m *RowEvent) GetRowChanges() []*RowChange {
 
 // FieldEvent represents the field info for a table.
 type FieldEvent struct {
-	TableName            string         `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
-	Fields               []*query.Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	TableName string         `protobuf:"bytes,1,opt,name=table_name,json=tableName,proto3" json:"table_name,omitempty"`
+	Fields    []*query.Field `protobuf:"bytes,2,rep,name=fields,proto3" json:"fields,omitempty"`
+	// encoded_schema is the Avro schema of the encoded row changes of
+	// the table, if the VStream flags of vtgate request a format.
+	EncodedSchema        string   `protobuf:"bytes,3,opt,name=encoded_schema,json=encodedSchema,proto3" json:"encoded_schema,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *FieldEvent) Reset()         { *m = FieldEvent{} }
@@ -1117,6 +1145,13 @@ func (m *FieldEvent) GetFields() []*query.Field {
 	return nil
 }
 
+func (m *FieldEvent) GetEncodedSchema() string {
+	if m != nil {
+		return m.EncodedSchema
+	}
+	return ""
+}
+
 // ShardGtid contains the GTID position for one shard.
 // It's used in a request for requesting a starting position.
 // It's used in a response to transmit the current position
@@ -2252,130 +2287,132 @@ func init() {
 func init() { proto.RegisterFile("binlogdata.proto", fileDescriptor_5fd02bcb2e350dad) }
 
 var fileDescriptor_5fd02bcb2e350dad = []byte{
-	// 1955 bytes of a gzipped FileDescriptorProto
+	// 1994 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0x4b, 0x6f, 0x23, 0x59,
-	0x15, 0xee, 0xf2, 0xdb, 0xa7, 0x1c, 0xa7, 0x72, 0xf3, 0xc0, 0xb4, 0x66, 0xa2, 0x4c, 0x89, 0x99,
-	0x0e, 0x91, 0x70, 0x06, 0xc3, 0x34, 0x42, 0x62, 0x66, 0xf0, 0xa3, 0x3a, 0xed, 0x8e, 0x1f, 0xe9,
-	0xeb, 0xea, 0xf4, 0x68, 0x36, 0xa5, 0x4a, 0xf9, 0x26, 0x29, 0x52, 0x76, 0xb9, 0xab, 0xae, 0x93,
-	0xf1, 0x0f, 0x40, 0x62, 0x8f, 0x84, 0xf8, 0x0b, 0xac, 0x59, 0x02, 0x5b, 0x60, 0xc9, 0x0f, 0x60,
-	0x81, 0x1a, 0xf1, 0x23, 0xd8, 0xa1, 0xfb, 0xa8, 0x57, 0x7a, 0xa6, 0x93, 0x1e, 0x89, 0x05, 0x6c,
-	0xac, 0x7b, 0xcf, 0x3d, 0xe7, 0xdc, 0xf3, 0xfa, 0x4e, 0x1d, 0x5f, 0xd0, 0xce, 0xdc, 0xb9, 0xe7,
-	0x5f, 0x4c, 0x6d, 0x6a, 0x37, 0x17, 0x81, 0x4f, 0x7d, 0x04, 0x09, 0xe5, 0xa1, 0x7a, 0x4d, 0x83,
-	0x85, 0x23, 0x0e, 0x1e, 0xaa, 0xaf, 0x96, 0x24, 0x58, 0xc9, 0x4d, 0x9d, 0xfa, 0x0b, 0x3f, 0x91,
-	0xd2, 0x87, 0x50, 0xee, 0x5e, 0xda, 0x41, 0x48, 0x28, 0xda, 0x81, 0x92, 0xe3, 0xb9, 0x64, 0x4e,
-	0x1b, 0xca, 0x9e, 0xb2, 0x5f, 0xc4, 0x72, 0x87, 0x10, 0x14, 0x1c, 0x7f, 0x3e, 0x6f, 0xe4, 0x38,
-	0x95, 0xaf, 0x19, 0x6f, 0x48, 0x82, 0x6b, 0x12, 0x34, 0xf2, 0x82, 0x57, 0xec, 0xf4, 0x7f, 0xe5,
-	0x61, 0xa3, 0xc3, 0xed, 0x30, 0x03, 0x7b, 0x1e, 0xda, 0x0e, 0x75, 0xfd, 0x39, 0x3a, 0x02, 0x08,
-	0xa9, 0x4d, 0xc9, 0x8c, 0xcc, 0x69, 0xd8, 0x50, 0xf6, 0xf2, 0xfb, 0x6a, 0xeb, 0x51, 0x33, 0xe5,
-	0xc1, 0x1b, 0x22, 0xcd, 0x49, 0xc4, 0x8f, 0x53, 0xa2, 0xa8, 0x05, 0x2a, 0xb9, 0x26, 0x73, 0x6a,
-	0x51, 0xff, 0x8a, 0xcc, 0x1b, 0x85, 0x3d, 0x65, 0x5f, 0x6d, 0x6d, 0x34, 0x85, 0x83, 0x06, 0x3b,
-	0x31, 0xd9, 0x01, 0x06, 0x12, 0xaf, 0x1f, 0xfe, 0x39, 0x07, 0xd5, 0x58, 0x1b, 0x1a, 0x40, 0xc5,
-	0xb1, 0x29, 0xb9, 0xf0, 0x83, 0x15, 0x77, 0xb3, 0xde, 0xfa, 0xf8, 0x9e, 0x86, 0x34, 0xbb, 0x52,
-	0x0e, 0xc7, 0x1a, 0xd0, 0x0f, 0xa0, 0xec, 0x88, 0xe8, 0xf1, 0xe8, 0xa8, 0xad, 0xcd, 0xb4, 0x32,
-	0x19, 0x58, 0x1c, 0xf1, 0x20, 0x0d, 0xf2, 0xe1, 0x2b, 0x8f, 0x87, 0xac, 0x86, 0xd9, 0x52, 0xff,
-	0x9d, 0x02, 0x95, 0x48, 0x2f, 0xda, 0x84, 0xf5, 0xce, 0xc0, 0x7a, 0x31, 0xc2, 0x46, 0x77, 0x7c,
-	0x34, 0xea, 0x7f, 0x69, 0xf4, 0xb4, 0x07, 0xa8, 0x06, 0x95, 0xce, 0xc0, 0xea, 0x18, 0x47, 0xfd,
-	0x91, 0xa6, 0xa0, 0x35, 0xa8, 0x76, 0x06, 0x56, 0x77, 0x3c, 0x1c, 0xf6, 0x4d, 0x2d, 0x87, 0xd6,
-	0x41, 0xed, 0x0c, 0x2c, 0x3c, 0x1e, 0x0c, 0x3a, 0xed, 0xee, 0xb1, 0x96, 0x47, 0xdb, 0xb0, 0xd1,
-	0x19, 0x58, 0xbd, 0xe1, 0xc0, 0xea, 0x19, 0x27, 0xd8, 0xe8, 0xb6, 0x4d, 0xa3, 0xa7, 0x15, 0x10,
-	0x40, 0x89, 0x91, 0x7b, 0x03, 0xad, 0x28, 0xd7, 0x13, 0xc3, 0xd4, 0x4a, 0x52, 0x5d, 0x7f, 0x34,
-	0x31, 0xb0, 0xa9, 0x95, 0xe5, 0xf6, 0xc5, 0x49, 0xaf, 0x6d, 0x1a, 0x5a, 0x45, 0x6e, 0x7b, 0xc6,
-	0xc0, 0x30, 0x0d, 0xad, 0xfa, 0xac, 0x50, 0xc9, 0x69, 0xf9, 0x67, 0x85, 0x4a, 0x5e, 0x2b, 0xe8,
-	0xbf, 0x56, 0x60, 0x7b, 0x42, 0x03, 0x62, 0xcf, 0x8e, 0xc9, 0x0a, 0xdb, 0xf3, 0x0b, 0x82, 0xc9,
-	0xab, 0x25, 0x09, 0x29, 0x7a, 0x08, 0x95, 0x85, 0x1f, 0xba, 0x2c, 0x76, 0x3c, 0xc0, 0x55, 0x1c,
-	0xef, 0xd1, 0x21, 0x54, 0xaf, 0xc8, 0xca, 0x0a, 0x18, 0xbf, 0x0c, 0x18, 0x6a, 0xc6, 0x05, 0x19,
-	0x6b, 0xaa, 0x5c, 0xc9, 0x55, 0x3a, 0xbe, 0xf9, 0xbb, 0xe3, 0xab, 0x9f, 0xc3, 0xce, 0x6d, 0xa3,
-	0xc2, 0x85, 0x3f, 0x0f, 0x09, 0x1a, 0x00, 0x12, 0x82, 0x16, 0x4d, 0x72, 0xcb, 0xed, 0x53, 0x5b,
-	0xef, 0xbf, 0xb5, 0x00, 0xf0, 0xc6, 0xd9, 0x6d, 0x92, 0xfe, 0x15, 0x6c, 0x8a, 0x7b, 0x4c, 0xfb,
-	0xcc, 0x23, 0xe1, 0x7d, 0x5c, 0xdf, 0x81, 0x12, 0xe5, 0xcc, 0x8d, 0xdc, 0x5e, 0x7e, 0xbf, 0x8a,
-	0xe5, 0xee, 0x5d, 0x3d, 0x9c, 0xc2, 0x56, 0xf6, 0xe6, 0xff, 0x8a, 0x7f, 0x3f, 0x86, 0x02, 0x5e,
-	0x7a, 0x04, 0x6d, 0x41, 0x71, 0x66, 0x53, 0xe7, 0x52, 0x7a, 0x23, 0x36, 0xcc, 0x95, 0x73, 0xd7,
-	0xa3, 0x24, 0xe0, 0x29, 0xac, 0x62, 0xb9, 0xd3, 0x7f, 0xaf, 0x40, 0xe9, 0x09, 0x5f, 0xa2, 0x8f,
-	0xa0, 0x18, 0x2c, 0x99, 0xb3, 0x02, 0xeb, 0x5a, 0xda, 0x02, 0xa6, 0x19, 0x8b, 0x63, 0xd4, 0x87,
-	0xfa, 0xb9, 0x4b, 0xbc, 0x29, 0x87, 0xee, 0xd0, 0x9f, 0x8a, 0xaa, 0xa8, 0xb7, 0x3e, 0x48, 0x0b,
-	0x08, 0x9d, 0xcd, 0x27, 0x19, 0x46, 0x7c, 0x4b, 0x50, 0x7f, 0x0c, 0xf5, 0x2c, 0x07, 0x83, 0x93,
-	0x81, 0xb1, 0x35, 0x1e, 0x59, 0xc3, 0xfe, 0x64, 0xd8, 0x36, 0xbb, 0x4f, 0xb5, 0x07, 0x1c, 0x31,
-	0xc6, 0xc4, 0xb4, 0x8c, 0x27, 0x4f, 0xc6, 0xd8, 0xd4, 0x14, 0xfd, 0x37, 0x79, 0xa8, 0x89, 0xa0,
-	0x4c, 0xfc, 0x65, 0xe0, 0x10, 0x96, 0xc5, 0x2b, 0xb2, 0x0a, 0x17, 0xb6, 0x43, 0xa2, 0x2c, 0x46,
-	0x7b, 0x16, 0x90, 0xf0, 0xd2, 0x0e, 0xa6, 0xd2, 0x73, 0xb1, 0x41, 0x9f, 0x80, 0xca, 0xb3, 0x49,
-	0x2d, 0xba, 0x5a, 0x10, 0x9e, 0xc7, 0x7a, 0x6b, 0x2b, 0x29, 0x6c, 0x9e, 0x2b, 0x6a, 0xae, 0x16,
-	0x04, 0x03, 0x8d, 0xd7, 0x59, 0x34, 0x14, 0xee, 0x81, 0x86, 0xa4, 0x86, 0x8a, 0x99, 0x1a, 0x3a,
-	0x88, 0x13, 0x52, 0x92, 0x5a, 0xde, 0x88, 0x5e, 0x94, 0x24, 0xd4, 0x84, 0x92, 0x3f, 0xb7, 0xa6,
-	0x53, 0xaf, 0x51, 0xe6, 0x66, 0x7e, 0x27, 0xcd, 0x3b, 0x9e, 0xf7, 0x7a, 0x83, 0xb6, 0x28, 0x8b,
-	0xa2, 0x3f, 0xef, 0x4d, 0x3d, 0xf4, 0x21, 0xd4, 0xc9, 0x57, 0x94, 0x04, 0x73, 0xdb, 0xb3, 0x66,
-	0x2b, 0xd6, 0xbd, 0x2a, 0xdc, 0xf5, 0xb5, 0x88, 0x3a, 0x64, 0x44, 0xf4, 0x11, 0xac, 0x87, 0xd4,
-	0x5f, 0x58, 0xf6, 0x39, 0x25, 0x81, 0xe5, 0xf8, 0x8b, 0x55, 0xa3, 0xba, 0xa7, 0xec, 0x57, 0xf0,
-	0x1a, 0x23, 0xb7, 0x19, 0xb5, 0xeb, 0x2f, 0x56, 0xe8, 0xfb, 0xa0, 0xc5, 0xea, 0x1c, 0x6f, 0x19,
-	0x32, 0xa3, 0x81, 0x2b, 0x5c, 0x8f, 0xe8, 0x5d, 0x41, 0xd6, 0x9f, 0x43, 0x15, 0xfb, 0x37, 0xdd,
-	0x4b, 0xee, 0xba, 0x0e, 0xa5, 0x33, 0x72, 0xee, 0x07, 0x44, 0xd6, 0x34, 0xc8, 0x9e, 0x8f, 0xfd,
-	0x1b, 0x2c, 0x4f, 0xd0, 0x1e, 0x14, 0xf9, 0xf5, 0xb2, 0xb3, 0xa4, 0x59, 0xc4, 0x81, 0x6e, 0x43,
-	0x05, 0xfb, 0x37, 0xbc, 0x42, 0xd0, 0xfb, 0x20, 0x72, 0x61, 0xcd, 0xed, 0x59, 0x94, 0xe8, 0x2a,
-	0xa7, 0x8c, 0xec, 0x19, 0x41, 0x8f, 0x41, 0x0d, 0xfc, 0x1b, 0xcb, 0xe1, 0xd7, 0x0b, 0xd0, 0xaa,
-	0xad, 0xed, 0x4c, 0x1d, 0x47, 0xc6, 0x61, 0x08, 0xa2, 0x65, 0xa8, 0x3f, 0x07, 0x48, 0xca, 0xf0,
-	0xae, 0x4b, 0xbe, 0xc7, 0x12, 0x47, 0xbc, 0x69, 0xa4, 0xbf, 0x26, 0x4d, 0xe6, 0x1a, 0xb0, 0x3c,
-	0xd3, 0x7f, 0xa5, 0x40, 0x75, 0xc2, 0x0a, 0xed, 0x88, 0xba, 0xd3, 0x6f, 0x51, 0x9e, 0x08, 0x0a,
-	0x17, 0xd4, 0x9d, 0xf2, 0xba, 0xac, 0x62, 0xbe, 0x46, 0x9f, 0x44, 0x86, 0x2d, 0xac, 0xab, 0xb0,
-	0x51, 0xe0, 0xb7, 0x67, 0x4a, 0x81, 0xd7, 0xec, 0xc0, 0x0e, 0xe9, 0xc9, 0x31, 0xae, 0x70, 0xd6,
-	0x93, 0xe3, 0x50, 0xff, 0x1c, 0x8a, 0xa7, 0xdc, 0x8a, 0xc7, 0xa0, 0x72, 0xe5, 0x16, 0xd3, 0x16,
-	0xc1, 0x3c, 0x13, 0x9e, 0xd8, 0x62, 0x0c, 0x61, 0xb4, 0x0c, 0xf5, 0x36, 0xac, 0x1d, 0x4b, 0x6b,
-	0x39, 0xc3, 0xbb, 0xbb, 0xa3, 0xff, 0x31, 0x07, 0xe5, 0x67, 0xfe, 0x92, 0x95, 0x0a, 0xaa, 0x43,
-	0xce, 0x9d, 0x72, 0xb9, 0x3c, 0xce, 0xb9, 0x53, 0xf4, 0x73, 0xa8, 0xcf, 0xdc, 0x8b, 0xc0, 0x66,
-	0x15, 0x2c, 0xc0, 0x28, 0xfa, 0xc9, 0x77, 0xd3, 0x96, 0x0d, 0x23, 0x0e, 0x8e, 0xc8, 0xb5, 0x59,
-	0x7a, 0x9b, 0xc2, 0x58, 0x3e, 0x83, 0xb1, 0x0f, 0xa1, 0xee, 0xf9, 0x8e, 0xed, 0x59, 0x71, 0x87,
-	0x2f, 0x08, 0x1c, 0x70, 0xea, 0x49, 0xd4, 0xe6, 0x6f, 0xc5, 0xa5, 0x78, 0xcf, 0xb8, 0xa0, 0x4f,
-	0xa1, 0xb6, 0xb0, 0x03, 0xea, 0x3a, 0xee, 0xc2, 0x66, 0x33, 0x52, 0x89, 0x0b, 0x66, 0xcc, 0xce,
-	0xc4, 0x0d, 0x67, 0xd8, 0x19, 0xac, 0x42, 0xde, 0xbd, 0xac, 0x1b, 0x3f, 0xb8, 0x3a, 0xf7, 0xfc,
-	0x9b, 0xb0, 0x51, 0xe6, 0xf6, 0xaf, 0x0b, 0xfa, 0xcb, 0x88, 0xac, 0xff, 0x21, 0x0f, 0xa5, 0x53,
-	0x51, 0x9d, 0x07, 0x50, 0xe0, 0x31, 0x12, 0x73, 0xd0, 0x4e, 0xfa, 0x32, 0xc1, 0xc1, 0x03, 0xc4,
-	0x79, 0xd0, 0x7b, 0x50, 0xa5, 0xee, 0x8c, 0x84, 0xd4, 0x9e, 0x2d, 0x78, 0x50, 0xf3, 0x38, 0x21,
-	0x7c, 0x6d, 0x89, 0xbd, 0x07, 0xd5, 0x78, 0x72, 0x93, 0xc1, 0x4a, 0x08, 0xe8, 0x87, 0x50, 0x65,
-	0xf8, 0xe2, 0x73, 0x5a, 0xa3, 0xc8, 0x01, 0xbb, 0x75, 0x0b, 0x5d, 0xdc, 0x04, 0x5c, 0x09, 0x22,
-	0xc4, 0xfe, 0x04, 0x54, 0x8e, 0x08, 0x29, 0x24, 0x7a, 0xdd, 0x4e, 0xb6, 0xd7, 0x45, 0xc8, 0xc3,
-	0x90, 0x7c, 0x1e, 0xd0, 0x23, 0x28, 0x5e, 0x73, 0xf3, 0xca, 0x72, 0x5e, 0x4c, 0x3b, 0xca, 0x53,
-	0x21, 0xce, 0xd9, 0xc7, 0xf8, 0x17, 0xa2, 0xb2, 0x78, 0x97, 0xbb, 0xf5, 0x31, 0x96, 0x45, 0x87,
-	0x23, 0x1e, 0x36, 0xce, 0x4d, 0x67, 0x1e, 0x6f, 0x74, 0x55, 0xcc, 0x96, 0xe8, 0x03, 0xa8, 0x39,
-	0xcb, 0x20, 0xe0, 0x13, 0xaa, 0x3b, 0x23, 0x8d, 0x2d, 0x1e, 0x28, 0x55, 0xd2, 0x4c, 0x77, 0x46,
-	0xd0, 0xcf, 0xa0, 0xee, 0xd9, 0x21, 0x65, 0xc0, 0x93, 0x8e, 0x6c, 0xf3, 0xab, 0x32, 0xe8, 0x13,
-	0xc0, 0x13, 0x9e, 0xa8, 0x5e, 0xb2, 0xd1, 0x2f, 0xa1, 0x36, 0x74, 0xe7, 0xee, 0xcc, 0xf6, 0x38,
-	0x40, 0x59, 0xe0, 0x53, 0xad, 0x85, 0xaf, 0xef, 0xd7, 0x55, 0xd0, 0x2e, 0xa8, 0xcc, 0x04, 0xc7,
-	0xf7, 0x96, 0xb3, 0xb9, 0xa8, 0xf6, 0x3c, 0xae, 0x2e, 0x8e, 0xbb, 0x82, 0xc0, 0x90, 0x2a, 0x6f,
-	0x9a, 0x38, 0x97, 0x64, 0x66, 0xa3, 0x8f, 0x63, 0x64, 0x08, 0xb4, 0x37, 0xb2, 0x98, 0x4a, 0x8c,
-	0x8a, 0x30, 0xa3, 0xff, 0x25, 0x07, 0xf5, 0x53, 0x31, 0xae, 0x44, 0x23, 0xd2, 0xe7, 0xb0, 0x49,
-	0xce, 0xcf, 0x89, 0x43, 0xdd, 0x6b, 0x62, 0x39, 0xb6, 0xe7, 0x91, 0xc0, 0x92, 0x08, 0x56, 0x5b,
-	0xeb, 0x4d, 0xf1, 0xb7, 0xa5, 0xcb, 0xe9, 0xfd, 0x1e, 0xde, 0x88, 0x79, 0x25, 0x69, 0x8a, 0x0c,
-	0xd8, 0x74, 0x67, 0x33, 0x32, 0x75, 0x6d, 0x9a, 0x56, 0x20, 0x5a, 0xfe, 0xb6, 0xf4, 0xf4, 0xd4,
-	0x3c, 0xb2, 0x29, 0x49, 0xd4, 0xc4, 0x12, 0xb1, 0x9a, 0x0f, 0x99, 0x33, 0xc1, 0x45, 0x3c, 0x75,
-	0xad, 0x49, 0x49, 0x93, 0x13, 0xb1, 0x3c, 0xcc, 0x4c, 0x74, 0x85, 0x5b, 0x13, 0x5d, 0xf2, 0xd5,
-	0x2d, 0xde, 0xf9, 0xd5, 0xfd, 0x0c, 0xd6, 0x45, 0xbb, 0x8d, 0x52, 0x1f, 0x21, 0xfc, 0x1b, 0x7b,
-	0x6e, 0x8d, 0x26, 0x9b, 0x50, 0xff, 0x14, 0xd6, 0xe3, 0x40, 0xca, 0x89, 0xef, 0x00, 0x4a, 0xbc,
-	0x7c, 0xa2, 0x74, 0xa0, 0x37, 0xe1, 0x8b, 0x25, 0x87, 0xfe, 0xcb, 0x1c, 0xa0, 0x48, 0xde, 0xbf,
-	0x09, 0xff, 0x47, 0x93, 0xb1, 0x05, 0x45, 0x4e, 0x97, 0x99, 0x10, 0x1b, 0x16, 0x07, 0x16, 0xd4,
-	0xc5, 0x55, 0x9c, 0x06, 0x21, 0xfc, 0x9c, 0xfd, 0x62, 0x12, 0x2e, 0x3d, 0x8a, 0x25, 0x87, 0xfe,
-	0x27, 0x05, 0x36, 0x33, 0x71, 0x90, 0xb1, 0x4c, 0x10, 0xa3, 0xbc, 0x05, 0x31, 0xfb, 0x50, 0x59,
-	0x5c, 0xbd, 0x05, 0x59, 0xf1, 0xe9, 0xd7, 0xb6, 0xc3, 0x5d, 0x28, 0x04, 0xac, 0x2d, 0x8b, 0x6f,
-	0x6d, 0x7a, 0x38, 0xe1, 0x74, 0x36, 0xe1, 0x64, 0xfc, 0xc8, 0x4c, 0x38, 0xd2, 0x7e, 0x17, 0xd4,
-	0x54, 0x67, 0x60, 0xad, 0x24, 0x5b, 0x55, 0x32, 0x75, 0xdf, 0x58, 0x54, 0x6a, 0xaa, 0xa8, 0x58,
-	0x7f, 0x76, 0xfc, 0xd9, 0xc2, 0x23, 0x94, 0x88, 0x94, 0x55, 0x70, 0x42, 0xd0, 0xbf, 0x00, 0x35,
-	0x25, 0x79, 0xd7, 0x20, 0x93, 0x24, 0x21, 0x7f, 0x67, 0x12, 0xfe, 0xae, 0xc0, 0x76, 0x52, 0xcc,
-	0x4b, 0x8f, 0xfe, 0x5f, 0xd5, 0xa3, 0x1e, 0xc0, 0xce, 0x6d, 0xef, 0xde, 0xa9, 0xca, 0xbe, 0x45,
-	0xed, 0x1c, 0x7c, 0x06, 0x6a, 0x6a, 0x74, 0x67, 0xff, 0xf0, 0xfb, 0x47, 0xa3, 0x31, 0x36, 0xb4,
-	0x07, 0xa8, 0x02, 0x85, 0x89, 0x39, 0x3e, 0xd1, 0x14, 0xb6, 0x32, 0xbe, 0x30, 0xba, 0xe2, 0xd5,
-	0x80, 0xad, 0x2c, 0xc9, 0x94, 0x3f, 0xf8, 0xb7, 0x02, 0x90, 0x7c, 0xf1, 0x91, 0x0a, 0xe5, 0x17,
-	0xa3, 0xe3, 0xd1, 0xf8, 0xe5, 0x48, 0x28, 0x38, 0x32, 0xfb, 0x3d, 0x4d, 0x41, 0x55, 0x28, 0x8a,
-	0x67, 0x88, 0x1c, 0xbb, 0x41, 0xbe, 0x41, 0xe4, 0x51, 0x0d, 0x2a, 0xf1, 0x03, 0x44, 0x01, 0x95,
-	0x21, 0x1f, 0x3f, 0x33, 0xc8, 0x77, 0x85, 0x12, 0x53, 0x88, 0x8d, 0x93, 0x41, 0xbb, 0x6b, 0x68,
-	0x65, 0x76, 0x10, 0xbf, 0x30, 0x00, 0x94, 0xa2, 0xe7, 0x05, 0x26, 0x39, 0x31, 0x4c, 0x0d, 0xd8,
-	0x3d, 0x63, 0xf3, 0xa9, 0x81, 0x35, 0x95, 0xd1, 0xf0, 0xf8, 0xa5, 0x56, 0x63, 0xb4, 0x27, 0x7d,
-	0x63, 0xd0, 0xd3, 0xd6, 0xd0, 0x1a, 0x54, 0x9f, 0x1a, 0x6d, 0x6c, 0x76, 0x8c, 0xb6, 0xa9, 0xd5,
-	0xd9, 0xc9, 0x29, 0x37, 0x70, 0x9d, 0x5d, 0xf3, 0x6c, 0xfc, 0x02, 0x8f, 0xda, 0x03, 0x4d, 0x63,
-	0x9b, 0x53, 0x03, 0x4f, 0xfa, 0xe3, 0x91, 0xb6, 0xc1, 0xee, 0x19, 0xb4, 0x27, 0xe6, 0xc9, 0xb1,
-	0x86, 0x98, 0xfc, 0xa4, 0x7d, 0x6a, 0x9c, 0x8c, 0xfb, 0x23, 0x53, 0xdb, 0x3c, 0x78, 0xc4, 0xbe,
-	0x73, 0xe9, 0x09, 0x10, 0xa0, 0x64, 0xb6, 0x3b, 0x03, 0x63, 0xa2, 0x3d, 0x60, 0xeb, 0xc9, 0xd3,
-	0x36, 0xee, 0x4d, 0x34, 0xa5, 0xf3, 0xd3, 0xbf, 0xbe, 0xde, 0x55, 0xfe, 0xf6, 0x7a, 0x57, 0xf9,
-	0xc7, 0xeb, 0x5d, 0xe5, 0xb7, 0xff, 0xdc, 0x7d, 0xf0, 0xe5, 0xa3, 0x6b, 0x97, 0x92, 0x30, 0x6c,
-	0xba, 0xfe, 0xa1, 0x58, 0x1d, 0x5e, 0xf8, 0x87, 0xd7, 0xf4, 0x90, 0xbf, 0xac, 0x1d, 0x26, 0x18,
-	0x3c, 0x2b, 0x71, 0xca, 0x8f, 0xfe, 0x13, 0x00, 0x00, 0xff, 0xff, 0x22, 0xbd, 0x3f, 0x86, 0xb5,
-	0x13, 0x00, 0x00,
+	0x15, 0xee, 0x72, 0xf9, 0x79, 0x2a, 0x71, 0x2a, 0x37, 0x0f, 0x4c, 0x6b, 0x26, 0xca, 0x94, 0xe8,
+	0xe9, 0x10, 0x89, 0x64, 0xf0, 0x68, 0x1a, 0x21, 0x31, 0x33, 0xf8, 0x51, 0x9d, 0x76, 0xc7, 0xb1,
+	0xc3, 0x75, 0x75, 0x7a, 0x34, 0x9b, 0x52, 0x75, 0xf9, 0x26, 0x29, 0x52, 0x0f, 0x77, 0xd5, 0x75,
+	0xd2, 0xfe, 0x01, 0x48, 0xec, 0x91, 0x10, 0x7f, 0x81, 0x35, 0x4b, 0x60, 0x0b, 0x2c, 0xf9, 0x01,
+	0x2c, 0x50, 0x23, 0x7e, 0x04, 0x3b, 0x74, 0x1f, 0xf5, 0x70, 0x7a, 0xfa, 0x35, 0x12, 0x0b, 0x66,
+	0x63, 0xdd, 0x7b, 0xee, 0x39, 0xa7, 0xce, 0xeb, 0x3b, 0xf7, 0xf8, 0x82, 0xfe, 0xcc, 0x0b, 0xfd,
+	0xe8, 0x62, 0xea, 0x50, 0xe7, 0x60, 0x16, 0x47, 0x34, 0x42, 0x90, 0x53, 0xee, 0x6a, 0xd7, 0x34,
+	0x9e, 0xb9, 0xe2, 0xe0, 0xae, 0xf6, 0x7c, 0x4e, 0xe2, 0x85, 0xdc, 0x34, 0x69, 0x34, 0x8b, 0x72,
+	0x29, 0xe3, 0x04, 0x6a, 0xbd, 0x4b, 0x27, 0x4e, 0x08, 0x45, 0xdb, 0x50, 0x75, 0x7d, 0x8f, 0x84,
+	0xb4, 0xa5, 0xec, 0x2a, 0x7b, 0x15, 0x2c, 0x77, 0x08, 0x41, 0xd9, 0x8d, 0xc2, 0xb0, 0x55, 0xe2,
+	0x54, 0xbe, 0x66, 0xbc, 0x09, 0x89, 0xaf, 0x49, 0xdc, 0x52, 0x05, 0xaf, 0xd8, 0x19, 0xff, 0x56,
+	0x61, 0xbd, 0xcb, 0xed, 0xb0, 0x62, 0x27, 0x4c, 0x1c, 0x97, 0x7a, 0x51, 0x88, 0x8e, 0x00, 0x12,
+	0xea, 0x50, 0x12, 0x90, 0x90, 0x26, 0x2d, 0x65, 0x57, 0xdd, 0xd3, 0xda, 0xf7, 0x0f, 0x0a, 0x1e,
+	0xbc, 0x22, 0x72, 0x30, 0x49, 0xf9, 0x71, 0x41, 0x14, 0xb5, 0x41, 0x23, 0xd7, 0x24, 0xa4, 0x36,
+	0x8d, 0xae, 0x48, 0xd8, 0x2a, 0xef, 0x2a, 0x7b, 0x5a, 0x7b, 0xfd, 0x40, 0x38, 0x68, 0xb2, 0x13,
+	0x8b, 0x1d, 0x60, 0x20, 0xd9, 0xfa, 0xee, 0x5f, 0x4a, 0xd0, 0xc8, 0xb4, 0xa1, 0x21, 0xd4, 0x5d,
+	0x87, 0x92, 0x8b, 0x28, 0x5e, 0x70, 0x37, 0x9b, 0xed, 0x4f, 0xde, 0xd1, 0x90, 0x83, 0x9e, 0x94,
+	0xc3, 0x99, 0x06, 0xf4, 0x23, 0xa8, 0xb9, 0x22, 0x7a, 0x3c, 0x3a, 0x5a, 0x7b, 0xa3, 0xa8, 0x4c,
+	0x06, 0x16, 0xa7, 0x3c, 0x48, 0x07, 0x35, 0x79, 0xee, 0xf3, 0x90, 0xad, 0x60, 0xb6, 0x34, 0x7e,
+	0xaf, 0x40, 0x3d, 0xd5, 0x8b, 0x36, 0x60, 0xad, 0x3b, 0xb4, 0x9f, 0x8c, 0xb0, 0xd9, 0x1b, 0x1f,
+	0x8d, 0x06, 0x5f, 0x9b, 0x7d, 0xfd, 0x0e, 0x5a, 0x81, 0x7a, 0x77, 0x68, 0x77, 0xcd, 0xa3, 0xc1,
+	0x48, 0x57, 0xd0, 0x2a, 0x34, 0xba, 0x43, 0xbb, 0x37, 0x3e, 0x39, 0x19, 0x58, 0x7a, 0x09, 0xad,
+	0x81, 0xd6, 0x1d, 0xda, 0x78, 0x3c, 0x1c, 0x76, 0x3b, 0xbd, 0x63, 0x5d, 0x45, 0x5b, 0xb0, 0xde,
+	0x1d, 0xda, 0xfd, 0x93, 0xa1, 0xdd, 0x37, 0x4f, 0xb1, 0xd9, 0xeb, 0x58, 0x66, 0x5f, 0x2f, 0x23,
+	0x80, 0x2a, 0x23, 0xf7, 0x87, 0x7a, 0x45, 0xae, 0x27, 0xa6, 0xa5, 0x57, 0xa5, 0xba, 0xc1, 0x68,
+	0x62, 0x62, 0x4b, 0xaf, 0xc9, 0xed, 0x93, 0xd3, 0x7e, 0xc7, 0x32, 0xf5, 0xba, 0xdc, 0xf6, 0xcd,
+	0xa1, 0x69, 0x99, 0x7a, 0xe3, 0x71, 0xb9, 0x5e, 0xd2, 0xd5, 0xc7, 0xe5, 0xba, 0xaa, 0x97, 0x8d,
+	0xdf, 0x28, 0xb0, 0x35, 0xa1, 0x31, 0x71, 0x82, 0x63, 0xb2, 0xc0, 0x4e, 0x78, 0x41, 0x30, 0x79,
+	0x3e, 0x27, 0x09, 0x45, 0x77, 0xa1, 0x3e, 0x8b, 0x12, 0x8f, 0xc5, 0x8e, 0x07, 0xb8, 0x81, 0xb3,
+	0x3d, 0x3a, 0x84, 0xc6, 0x15, 0x59, 0xd8, 0x31, 0xe3, 0x97, 0x01, 0x43, 0x07, 0x59, 0x41, 0x66,
+	0x9a, 0xea, 0x57, 0x72, 0x55, 0x8c, 0xaf, 0xfa, 0xf6, 0xf8, 0x1a, 0xe7, 0xb0, 0x7d, 0xdb, 0xa8,
+	0x64, 0x16, 0x85, 0x09, 0x41, 0x43, 0x40, 0x42, 0xd0, 0xa6, 0x79, 0x6e, 0xb9, 0x7d, 0x5a, 0xfb,
+	0xc3, 0x37, 0x16, 0x00, 0x5e, 0x7f, 0x76, 0x9b, 0x64, 0xbc, 0x80, 0x0d, 0xf1, 0x1d, 0xcb, 0x79,
+	0xe6, 0x93, 0xe4, 0x5d, 0x5c, 0xdf, 0x86, 0x2a, 0xe5, 0xcc, 0xad, 0xd2, 0xae, 0xba, 0xd7, 0xc0,
+	0x72, 0xf7, 0xbe, 0x1e, 0x4e, 0x61, 0x73, 0xf9, 0xcb, 0xff, 0x13, 0xff, 0x3c, 0x28, 0xe3, 0xb9,
+	0x4f, 0xd0, 0x26, 0x54, 0x02, 0x87, 0xba, 0x97, 0xd2, 0x1b, 0xb1, 0x61, 0xae, 0x9c, 0x7b, 0x3e,
+	0x25, 0x31, 0x4f, 0x61, 0x03, 0xcb, 0x1d, 0xfa, 0x14, 0x1a, 0xd3, 0xc0, 0xb7, 0xe9, 0x62, 0x46,
+	0x92, 0x96, 0xba, 0xab, 0xee, 0x35, 0xdb, 0xdb, 0xc5, 0x4f, 0x9f, 0x09, 0x80, 0x2e, 0x66, 0x04,
+	0xd7, 0xa7, 0x81, 0xcf, 0x16, 0x89, 0xf1, 0x07, 0x05, 0xaa, 0x0f, 0x85, 0xfc, 0xc7, 0x50, 0x89,
+	0xe7, 0x3e, 0x49, 0x1b, 0x84, 0x5e, 0x94, 0x65, 0xe6, 0x60, 0x71, 0x8c, 0x06, 0xd0, 0x3c, 0xf7,
+	0x88, 0x3f, 0xe5, 0xea, 0x4e, 0xa2, 0xa9, 0x28, 0xa5, 0x66, 0xfb, 0xa3, 0xa2, 0x80, 0xd0, 0x79,
+	0xf0, 0x70, 0x89, 0x11, 0xdf, 0x12, 0x34, 0x1e, 0x40, 0x73, 0x99, 0x83, 0x61, 0xd0, 0xc4, 0xd8,
+	0x1e, 0x8f, 0xec, 0x93, 0xc1, 0xe4, 0xa4, 0x63, 0xf5, 0x1e, 0xe9, 0x77, 0x38, 0xcc, 0xcc, 0x89,
+	0x65, 0x9b, 0x0f, 0x1f, 0x8e, 0xb1, 0xa5, 0x2b, 0xc6, 0x6f, 0x55, 0x58, 0x11, 0x91, 0x9c, 0x44,
+	0xf3, 0xd8, 0x25, 0x2c, 0xf5, 0x57, 0x64, 0x91, 0xcc, 0x1c, 0x97, 0xa4, 0xa9, 0x4f, 0xf7, 0x2c,
+	0x8a, 0xc9, 0xa5, 0x13, 0x4f, 0x65, 0xb8, 0xc4, 0x06, 0x7d, 0x06, 0x1a, 0x2f, 0x01, 0xca, 0x03,
+	0xc6, 0x93, 0xdf, 0x6c, 0x6f, 0xe6, 0x68, 0xe0, 0x09, 0x16, 0xd1, 0x02, 0x9a, 0xad, 0x97, 0x21,
+	0x54, 0x7e, 0x07, 0x08, 0xe5, 0x85, 0x57, 0x59, 0x2a, 0xbc, 0xfd, 0x2c, 0x8b, 0x55, 0xa9, 0xe5,
+	0x95, 0xe8, 0x65, 0x99, 0x3d, 0x80, 0x6a, 0x14, 0xda, 0xd3, 0xa9, 0xdf, 0xaa, 0x71, 0x33, 0xbf,
+	0x57, 0xe4, 0x1d, 0x87, 0xfd, 0xfe, 0xb0, 0x23, 0x6a, 0xa9, 0x12, 0x85, 0xfd, 0xa9, 0x8f, 0xee,
+	0x41, 0x93, 0xbc, 0xa0, 0x24, 0x0e, 0x1d, 0xdf, 0x0e, 0x16, 0xac, 0xe5, 0xd5, 0xb9, 0xeb, 0xab,
+	0x29, 0xf5, 0x84, 0x11, 0xd1, 0xc7, 0xb0, 0x96, 0xd0, 0x68, 0x66, 0x3b, 0xe7, 0x94, 0xc4, 0xb6,
+	0x1b, 0xcd, 0x16, 0xad, 0xc6, 0xae, 0xb2, 0x57, 0xc7, 0xab, 0x8c, 0xdc, 0x61, 0xd4, 0x5e, 0x34,
+	0x5b, 0xa0, 0x1f, 0x82, 0x9e, 0xa9, 0x73, 0xfd, 0x79, 0xc2, 0x8c, 0x06, 0xae, 0x70, 0x2d, 0xa5,
+	0xf7, 0x04, 0xd9, 0xb8, 0x82, 0x06, 0x8e, 0x6e, 0x7a, 0x97, 0xdc, 0x75, 0x03, 0xaa, 0xcf, 0xc8,
+	0x79, 0x14, 0x13, 0x09, 0x04, 0x90, 0x17, 0x05, 0x8e, 0x6e, 0xb0, 0x3c, 0x41, 0xbb, 0x50, 0xe1,
+	0x9f, 0x6f, 0x95, 0x5e, 0x61, 0x11, 0x07, 0xa8, 0x05, 0x35, 0x12, 0xba, 0xd1, 0x94, 0x4c, 0x65,
+	0xe3, 0x4e, 0xb7, 0x86, 0x03, 0x75, 0x1c, 0xdd, 0xf0, 0xda, 0x41, 0x1f, 0x82, 0xc8, 0x92, 0x1d,
+	0x3a, 0x41, 0x5a, 0x02, 0x0d, 0x4e, 0x19, 0x39, 0x01, 0x41, 0x0f, 0x40, 0x8b, 0xa3, 0x1b, 0xdb,
+	0xe5, 0x86, 0x89, 0x1e, 0xa0, 0xb5, 0xb7, 0x96, 0x2a, 0x3c, 0x35, 0x1b, 0x43, 0x9c, 0x2e, 0x13,
+	0xe3, 0x05, 0x40, 0x5e, 0xa0, 0x6f, 0xfb, 0xc8, 0x0f, 0x58, 0x4a, 0x89, 0x3f, 0x4d, 0xf5, 0xaf,
+	0x48, 0x67, 0xb8, 0x06, 0x2c, 0xcf, 0x78, 0x72, 0x84, 0x03, 0x76, 0xe2, 0x5e, 0x92, 0xc0, 0x69,
+	0xa9, 0x32, 0x39, 0x82, 0x3a, 0xe1, 0x44, 0xe3, 0xd7, 0x0a, 0x34, 0x26, 0xac, 0x52, 0x8f, 0xa8,
+	0x37, 0xfd, 0x16, 0xf5, 0x8d, 0xa0, 0x7c, 0x41, 0xbd, 0xa9, 0x54, 0xce, 0xd7, 0xe8, 0xb3, 0xd4,
+	0xfe, 0x99, 0x7d, 0x95, 0xb4, 0xca, 0xdc, 0xc8, 0xa5, 0x5a, 0xe2, 0x45, 0x3f, 0x74, 0x12, 0x7a,
+	0x7a, 0x8c, 0xeb, 0x9c, 0xf5, 0xf4, 0x38, 0x31, 0xbe, 0x84, 0xca, 0x19, 0xb7, 0xe2, 0x01, 0x68,
+	0x5c, 0xb9, 0xcd, 0xb4, 0xa5, 0x7d, 0x62, 0x29, 0x8a, 0x99, 0xc5, 0x18, 0x92, 0x74, 0x99, 0x18,
+	0x1d, 0x58, 0x3d, 0x96, 0xd6, 0x72, 0x86, 0xf7, 0x77, 0xc7, 0xf8, 0x53, 0x09, 0x6a, 0x8f, 0xa3,
+	0x39, 0xab, 0x35, 0xd4, 0x84, 0x92, 0x37, 0xe5, 0x72, 0x2a, 0x2e, 0x79, 0x53, 0xf4, 0x73, 0x68,
+	0x06, 0xde, 0x45, 0xec, 0x30, 0x08, 0x08, 0x34, 0x8b, 0x86, 0xf4, 0xfd, 0xa2, 0x65, 0x27, 0x29,
+	0x07, 0x87, 0xf4, 0x6a, 0x50, 0xdc, 0x16, 0x40, 0xaa, 0x2e, 0x81, 0xf4, 0x1e, 0x34, 0xfd, 0xc8,
+	0x75, 0x7c, 0x3b, 0xbb, 0x57, 0xca, 0x22, 0x57, 0x9c, 0x7a, 0x2a, 0x89, 0xb7, 0xe3, 0x52, 0x79,
+	0xc7, 0xb8, 0xa0, 0xcf, 0x61, 0x65, 0xe6, 0xc4, 0xd4, 0x73, 0xbd, 0x99, 0xc3, 0x26, 0xb3, 0x2a,
+	0x17, 0x5c, 0x32, 0x7b, 0x29, 0x6e, 0x78, 0x89, 0x9d, 0xe1, 0x32, 0xe1, 0xed, 0xcf, 0xbe, 0x89,
+	0xe2, 0xab, 0x73, 0x3f, 0xba, 0x49, 0x5a, 0x35, 0x6e, 0xff, 0x9a, 0xa0, 0x3f, 0x4d, 0xc9, 0xc6,
+	0x1f, 0x55, 0xa8, 0x8a, 0xfe, 0x8f, 0xf6, 0xa1, 0xcc, 0x63, 0x24, 0xa6, 0xaf, 0xd7, 0xdd, 0x10,
+	0x9c, 0x07, 0x7d, 0x00, 0x0d, 0xea, 0x05, 0x24, 0xa1, 0x4e, 0x30, 0xe3, 0x41, 0x55, 0x71, 0x4e,
+	0xf8, 0xc6, 0x12, 0xfb, 0x00, 0x1a, 0xd9, 0xbc, 0x28, 0x83, 0x95, 0x13, 0xd0, 0x8f, 0xa1, 0xc1,
+	0x60, 0xc8, 0xa7, 0xc3, 0x56, 0x85, 0x23, 0x7e, 0xf3, 0x16, 0x08, 0xb9, 0x09, 0xb8, 0x1e, 0xcb,
+	0x15, 0xfa, 0x09, 0x68, 0x1c, 0x38, 0x52, 0x48, 0x34, 0xcb, 0xed, 0xe5, 0x66, 0x99, 0x02, 0x14,
+	0x43, 0x7e, 0xbf, 0xa0, 0xfb, 0x50, 0xb9, 0xe6, 0xe6, 0xd5, 0xe4, 0x94, 0x5a, 0x74, 0x94, 0xa7,
+	0x42, 0x9c, 0xb3, 0x11, 0xe0, 0x97, 0xa2, 0xb2, 0x5a, 0xf5, 0x57, 0x47, 0x00, 0x59, 0x74, 0x38,
+	0xe5, 0x61, 0x43, 0xe4, 0x34, 0xf0, 0x79, 0xa7, 0x6c, 0x60, 0xb6, 0x44, 0x1f, 0xc1, 0x8a, 0x3b,
+	0x8f, 0x63, 0x3e, 0x17, 0x7b, 0x01, 0x69, 0x6d, 0xf2, 0x40, 0x69, 0x92, 0x66, 0x79, 0x01, 0x41,
+	0x3f, 0x83, 0xa6, 0xef, 0x24, 0x94, 0x01, 0x4f, 0x3a, 0xb2, 0xb5, 0xab, 0xdc, 0x46, 0x9f, 0x00,
+	0x9e, 0xf0, 0x44, 0xf3, 0xf3, 0x8d, 0x71, 0x09, 0x2b, 0x27, 0x5e, 0xe8, 0x05, 0x8e, 0xcf, 0x01,
+	0xca, 0x02, 0x5f, 0xe8, 0x40, 0xe5, 0xf0, 0xdd, 0x9b, 0xcf, 0x0e, 0x68, 0xcc, 0x04, 0x37, 0xf2,
+	0xe7, 0x41, 0x28, 0xaa, 0x5d, 0xc5, 0x8d, 0xd9, 0x71, 0x4f, 0x10, 0x18, 0x52, 0xe5, 0x97, 0x44,
+	0x1b, 0x42, 0x9f, 0x64, 0xc8, 0x10, 0x68, 0x6f, 0x2d, 0x63, 0x2a, 0x37, 0x2a, 0xc5, 0x8c, 0xf1,
+	0xd7, 0x12, 0x34, 0xcf, 0xc4, 0x90, 0x94, 0x0e, 0x66, 0x5f, 0xc2, 0x06, 0x39, 0x3f, 0x27, 0x2e,
+	0xf5, 0xae, 0x89, 0xed, 0x3a, 0xbe, 0x4f, 0x62, 0x5b, 0x22, 0x58, 0x6b, 0xaf, 0x1d, 0x88, 0x3f,
+	0x4b, 0x3d, 0x4e, 0x1f, 0xf4, 0xf1, 0x7a, 0xc6, 0x2b, 0x49, 0x53, 0x64, 0xc2, 0x86, 0x17, 0x04,
+	0x64, 0xea, 0x39, 0xb4, 0xa8, 0x40, 0xdc, 0x19, 0x5b, 0xd2, 0xd3, 0x33, 0xeb, 0xc8, 0xa1, 0x24,
+	0x57, 0x93, 0x49, 0x64, 0x6a, 0xee, 0x31, 0x67, 0xe2, 0x8b, 0x6c, 0xd6, 0x5b, 0x95, 0x92, 0x16,
+	0x27, 0x62, 0x79, 0xb8, 0x34, 0x47, 0x96, 0x6f, 0xcd, 0x91, 0xf9, 0xb5, 0x5d, 0x79, 0xeb, 0xb5,
+	0xfd, 0x05, 0xac, 0x89, 0x76, 0x9b, 0xa6, 0x3e, 0x45, 0xf8, 0x6b, 0x7b, 0xee, 0x0a, 0xcd, 0x37,
+	0x89, 0xf1, 0x39, 0xac, 0x65, 0x81, 0x94, 0x73, 0xe6, 0x3e, 0x54, 0x79, 0xf9, 0xa4, 0xe9, 0x40,
+	0xaf, 0xc2, 0x17, 0x4b, 0x0e, 0xe3, 0x57, 0x25, 0x40, 0xa9, 0x7c, 0x74, 0x93, 0xfc, 0x9f, 0x26,
+	0x63, 0x13, 0x2a, 0x9c, 0x2e, 0x33, 0x21, 0x36, 0x2c, 0x0e, 0x2c, 0xa8, 0xb3, 0xab, 0x2c, 0x0d,
+	0x42, 0xf8, 0x17, 0xec, 0x17, 0x93, 0x64, 0xee, 0x53, 0x2c, 0x39, 0x8c, 0x3f, 0x2b, 0xb0, 0xb1,
+	0x14, 0x07, 0x19, 0xcb, 0x1c, 0x31, 0xca, 0x1b, 0x10, 0xb3, 0x07, 0xf5, 0xd9, 0xd5, 0x1b, 0x90,
+	0x95, 0x9d, 0x7e, 0x63, 0x3b, 0xdc, 0x81, 0x72, 0x1c, 0xdd, 0xa4, 0x77, 0x6d, 0x71, 0xba, 0xe1,
+	0x74, 0x36, 0x22, 0x2d, 0xf9, 0x51, 0xe4, 0x48, 0xed, 0xf7, 0x40, 0x2b, 0x74, 0x06, 0xd6, 0x4a,
+	0x96, 0xab, 0x4a, 0xa6, 0xee, 0xb5, 0x45, 0xa5, 0x15, 0x8a, 0x8a, 0xf5, 0x67, 0x37, 0x0a, 0x66,
+	0x3e, 0xa1, 0x44, 0xa4, 0xac, 0x8e, 0x73, 0x82, 0xf1, 0x15, 0x68, 0x05, 0xc9, 0xb7, 0xcd, 0x3b,
+	0x79, 0x12, 0xd4, 0xb7, 0x26, 0xe1, 0x1f, 0x0a, 0x6c, 0xe5, 0xc5, 0x3c, 0xf7, 0xe9, 0x77, 0xaa,
+	0x1e, 0x8d, 0x18, 0xb6, 0x6f, 0x7b, 0xf7, 0x5e, 0x55, 0xf6, 0x2d, 0x6a, 0x67, 0xff, 0x0b, 0xd0,
+	0x0a, 0xb3, 0x3f, 0x7b, 0x57, 0x18, 0x1c, 0x8d, 0xc6, 0xd8, 0xd4, 0xef, 0xa0, 0x3a, 0x94, 0x27,
+	0xd6, 0xf8, 0x54, 0x57, 0xd8, 0xca, 0xfc, 0xca, 0xec, 0x89, 0xb7, 0x0a, 0xb6, 0xb2, 0x25, 0x93,
+	0xba, 0xff, 0x1f, 0x05, 0x20, 0xbf, 0xf1, 0x91, 0x06, 0xb5, 0x27, 0xa3, 0xe3, 0xd1, 0xf8, 0xe9,
+	0x48, 0x28, 0x38, 0xb2, 0x06, 0x7d, 0x5d, 0x41, 0x0d, 0xa8, 0x88, 0xc7, 0x8f, 0x12, 0xfb, 0x82,
+	0x7c, 0xf9, 0x50, 0xd9, 0xb3, 0x48, 0xf6, 0xec, 0x51, 0x46, 0x35, 0x50, 0xb3, 0xc7, 0x0d, 0xf9,
+	0x9a, 0x51, 0x65, 0x0a, 0xb1, 0x79, 0x3a, 0xec, 0xf4, 0x4c, 0xbd, 0xc6, 0x0e, 0xb2, 0x77, 0x0d,
+	0x80, 0x6a, 0xfa, 0xa8, 0xc1, 0x24, 0xd9, 0x53, 0x08, 0xb0, 0xef, 0x8c, 0xad, 0x47, 0x26, 0xd6,
+	0x35, 0x46, 0xc3, 0xe3, 0xa7, 0xfa, 0x0a, 0xa3, 0x3d, 0x1c, 0x98, 0xc3, 0xbe, 0xbe, 0xca, 0xde,
+	0x42, 0x1e, 0x99, 0x1d, 0x6c, 0x75, 0xcd, 0x8e, 0xa5, 0x37, 0xd9, 0xc9, 0x19, 0x37, 0x70, 0x8d,
+	0x7d, 0xe6, 0xf1, 0xf8, 0x09, 0x1e, 0x75, 0x86, 0xba, 0xce, 0x36, 0x67, 0x26, 0x9e, 0x0c, 0xc6,
+	0x23, 0x7d, 0x9d, 0x7d, 0x67, 0xd8, 0x99, 0x58, 0xa7, 0xc7, 0x3a, 0x62, 0xf2, 0x93, 0xce, 0x99,
+	0x79, 0x3a, 0x1e, 0x8c, 0x2c, 0x7d, 0x63, 0xff, 0x3e, 0xbb, 0xe7, 0x8a, 0x13, 0x20, 0x40, 0xd5,
+	0xea, 0x74, 0x87, 0xe6, 0x44, 0xbf, 0xc3, 0xd6, 0x93, 0x47, 0x1d, 0xdc, 0x9f, 0xe8, 0x4a, 0xf7,
+	0xa7, 0x7f, 0x7b, 0xb9, 0xa3, 0xfc, 0xfd, 0xe5, 0x8e, 0xf2, 0xcf, 0x97, 0x3b, 0xca, 0xef, 0xfe,
+	0xb5, 0x73, 0xe7, 0xeb, 0xfb, 0xd7, 0x1e, 0x25, 0x49, 0x72, 0xe0, 0x45, 0x87, 0x62, 0x75, 0x78,
+	0x11, 0x1d, 0x5e, 0xd3, 0x43, 0xfe, 0x9e, 0x77, 0x98, 0x63, 0xf0, 0x59, 0x95, 0x53, 0x3e, 0xfd,
+	0xef, 0x00, 0xcc, 0x8b, 0x97, 0xc3, 0x2b, 0x14, 0x00, 0x00,
 }
 
 func (m *Charset) Marshal() (dAtA []byte, err error) {
@@ -2739,6 +2776,24 @@ func (m *Rule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.DmlTypes) > 0 {
+		dAtA2 := make([]byte, len(m.DmlTypes)*10)
+		var j1 int
+		for _, num := range m.DmlTypes {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintBinlogdata(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Filter) > 0 {
 		i -= len(m.Filter)
 		copy(dAtA[i:], m.Filter)
@@ -2934,6 +2989,13 @@ func (m *RowChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Encoded) > 0 {
+		i -= len(m.Encoded)
+		copy(dAtA[i:], m.Encoded)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.Encoded)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if m.After != nil {
 		{
 			size, err := m.After.MarshalToSizedBuffer(dAtA[:i])
@@ -3033,6 +3095,13 @@ func (m *FieldEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.EncodedSchema) > 0 {
+		i -= len(m.EncodedSchema)
+		copy(dAtA[i:], m.EncodedSchema)
+		i = encodeVarintBinlogdata(dAtA, i, uint64(len(m.EncodedSchema)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Fields) > 0 {
 		for iNdEx := len(m.Fields) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -4233,6 +4302,13 @@ func (m *Rule) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovBinlogdata(uint64(l))
 	}
+	if len(m.DmlTypes) > 0 {
+		l = 0
+		for _, e := range m.DmlTypes {
+			l += sovBinlogdata(uint64(e))
+		}
+		n += 1 + sovBinlogdata(uint64(l)) + l
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4325,6 +4401,10 @@ func (m *RowChange) Size() (n int) {
 		l = m.After.Size()
 		n += 1 + l + sovBinlogdata(uint64(l))
 	}
+	l = len(m.Encoded)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4369,6 +4449,10 @@ func (m *FieldEvent) Size() (n int) {
 			n += 1 + l + sovBinlogdata(uint64(l))
 		}
 	}
+	l = len(m.EncodedSchema)
+	if l > 0 {
+		n += 1 + l + sovBinlogdata(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5779,6 +5863,75 @@ func (m *Rule) Unmarshal(dAtA []byte) error {
 			}
 			m.Filter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v VEventType
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBinlogdata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= VEventType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DmlTypes = append(m.DmlTypes, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBinlogdata
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBinlogdata
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBinlogdata
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.DmlTypes) == 0 {
+					m.DmlTypes = make([]VEventType, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v VEventType
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBinlogdata
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= VEventType(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DmlTypes = append(m.DmlTypes, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DmlTypes", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
@@ -6356,6 +6509,40 @@ func (m *RowChange) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encoded", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Encoded = append(m.Encoded[:0], dAtA[iNdEx:postIndex]...)
+			if m.Encoded == nil {
+				m.Encoded = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
@@ -6596,6 +6783,38 @@ func (m *FieldEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncodedSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBinlogdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBinlogdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncodedSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBinlogdata(dAtA[iNdEx:])
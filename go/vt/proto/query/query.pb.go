@@ -1256,13 +1256,15 @@ func (m *Row) GetValues() []byte {
 // len(QueryResult[0].fields) is always equal to len(row) (for each
 // row in rows for each QueryResult in QueryResult[1:]).
 type QueryResult struct {
-	Fields               []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
-	RowsAffected         uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
-	InsertId             uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
-	Rows                 []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Fields       []*Field `protobuf:"bytes,1,rep,name=fields,proto3" json:"fields,omitempty"`
+	RowsAffected uint64   `protobuf:"varint,2,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	InsertId     uint64   `protobuf:"varint,3,opt,name=insert_id,json=insertId,proto3" json:"insert_id,omitempty"`
+	Rows         []*Row   `protobuf:"bytes,4,rep,name=rows,proto3" json:"rows,omitempty"`
+	// warnings are added to the warnings of the vtgate session.
+	Warnings             []*QueryWarning `protobuf:"bytes,6,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *QueryResult) Reset()         { *m = QueryResult{} }
@@ -1326,6 +1328,13 @@ func (m *QueryResult) GetRows() []*Row {
 	return nil
 }
 
+func (m *QueryResult) GetWarnings() []*QueryWarning {
+	if m != nil {
+		return m.Warnings
+	}
+	return nil
+}
+
 // QueryWarning is used to convey out of band query execution warnings
 // by storing in the vtgate.Session
 type QueryWarning struct {
@@ -4441,7 +4450,17 @@ type RealtimeStats struct {
 	CpuUsage float64 `protobuf:"fixed64,5,opt,name=cpu_usage,json=cpuUsage,proto3" json:"cpu_usage,omitempty"`
 	// qps is the average QPS (queries per second) rate in the last XX seconds
 	// where XX is usually 60 (See query_service_stats.go).
-	Qps                  float64  `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	Qps float64 `protobuf:"fixed64,6,opt,name=qps,proto3" json:"qps,omitempty"`
+	// table_schema_changed is the list of tables that have changed since the
+	// last health message. It is only set when the tablet is configured to
+	// signal schema changes.
+	TableSchemaChanged []string `protobuf:"bytes,7,rep,name=table_schema_changed,json=tableSchemaChanged,proto3" json:"table_schema_changed,omitempty"`
+	// replication_lag_ms is populated for replicas only. It is the same
+	// measurement as seconds_behind_master, in milliseconds. When heartbeats
+	// are enabled it has sub-second precision, which allows clients to do
+	// finer grained lag-aware routing.
+	// NOTE: This field must not be evaluated if "health_error" is not empty.
+	ReplicationLagMs     int64    `protobuf:"varint,8,opt,name=replication_lag_ms,json=replicationLagMs,proto3" json:"replication_lag_ms,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -4522,6 +4541,20 @@ func (m *RealtimeStats) GetQps() float64 {
 	return 0
 }
 
+func (m *RealtimeStats) GetTableSchemaChanged() []string {
+	if m != nil {
+		return m.TableSchemaChanged
+	}
+	return nil
+}
+
+func (m *RealtimeStats) GetReplicationLagMs() int64 {
+	if m != nil {
+		return m.ReplicationLagMs
+	}
+	return 0
+}
+
 // AggregateStats contains information about the health of a group of
 // tablets for a Target.  It is used to propagate stats from a vtgate
 // to another, or from the Gateway layer of a vtgate to the routing
@@ -4875,214 +4908,217 @@ func init() {
 func init() { proto.RegisterFile("query.proto", fileDescriptor_5c6ac9b241082464) }
 
 var fileDescriptor_5c6ac9b241082464 = []byte{
-	// 3303 bytes of a gzipped FileDescriptorProto
+	// 3357 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0x4d, 0x90, 0x1b, 0x49,
-	0x56, 0xee, 0x2a, 0xfd, 0xb4, 0xf4, 0xd4, 0x52, 0x67, 0x67, 0x77, 0xdb, 0x9a, 0xf6, 0x8c, 0xa7,
-	0xb7, 0x76, 0x67, 0xd7, 0x18, 0x68, 0x7b, 0xda, 0x5e, 0x63, 0x66, 0x17, 0x98, 0x6a, 0x75, 0xb5,
-	0x47, 0xb6, 0x54, 0x92, 0x53, 0x25, 0x7b, 0x3d, 0x41, 0x44, 0x45, 0x59, 0x4a, 0xab, 0x2b, 0xba,
-	0x54, 0xa5, 0xae, 0xaa, 0x6e, 0x8f, 0x6e, 0x86, 0x65, 0x59, 0xfe, 0x59, 0xfe, 0x77, 0xd9, 0x60,
-	0x83, 0x08, 0x0e, 0x04, 0x17, 0x22, 0xb8, 0x71, 0xe6, 0x30, 0x41, 0x70, 0x20, 0xe0, 0x08, 0x1c,
-	0x58, 0x86, 0x20, 0xe0, 0xb4, 0x41, 0x70, 0xe0, 0xc0, 0x81, 0x20, 0xf2, 0xa7, 0x4a, 0x52, 0xb7,
-	0xc6, 0xee, 0xf5, 0x32, 0xb1, 0x61, 0x8f, 0x6f, 0xf9, 0x7e, 0xf2, 0xe7, 0x7d, 0xf9, 0xf2, 0xbd,
-	0x57, 0xa9, 0x14, 0x94, 0x0e, 0x8f, 0x68, 0x38, 0xde, 0x1a, 0x85, 0x41, 0x1c, 0xe0, 0x1c, 0x27,
-	0x36, 0x2a, 0x71, 0x30, 0x0a, 0xfa, 0x4e, 0xec, 0x08, 0xf6, 0x46, 0xe9, 0x38, 0x0e, 0x47, 0x3d,
-	0x41, 0x68, 0x5f, 0x53, 0x20, 0x6f, 0x39, 0xe1, 0x80, 0xc6, 0x78, 0x03, 0x0a, 0x07, 0x74, 0x1c,
-	0x8d, 0x9c, 0x1e, 0xad, 0x2a, 0x9b, 0xca, 0xa5, 0x22, 0x49, 0x69, 0xbc, 0x06, 0xb9, 0x68, 0xdf,
-	0x09, 0xfb, 0x55, 0x95, 0x0b, 0x04, 0x81, 0xbf, 0x08, 0xa5, 0xd8, 0x79, 0xe8, 0xd1, 0xd8, 0x8e,
-	0xc7, 0x23, 0x5a, 0xcd, 0x6c, 0x2a, 0x97, 0x2a, 0xdb, 0x6b, 0x5b, 0xe9, 0x7c, 0x16, 0x17, 0x5a,
-	0xe3, 0x11, 0x25, 0x10, 0xa7, 0x6d, 0x8c, 0x21, 0xdb, 0xa3, 0x9e, 0x57, 0xcd, 0xf2, 0xb1, 0x78,
-	0x5b, 0xdb, 0x85, 0xca, 0x3d, 0xeb, 0x96, 0x13, 0xd3, 0x9a, 0xe3, 0x79, 0x34, 0xac, 0xef, 0xb2,
-	0xe5, 0x1c, 0x45, 0x34, 0xf4, 0x9d, 0x61, 0xba, 0x9c, 0x84, 0xc6, 0xe7, 0x20, 0x3f, 0x08, 0x83,
-	0xa3, 0x51, 0x54, 0x55, 0x37, 0x33, 0x97, 0x8a, 0x44, 0x52, 0xda, 0xcf, 0x02, 0x18, 0xc7, 0xd4,
-	0x8f, 0xad, 0xe0, 0x80, 0xfa, 0xf8, 0x75, 0x28, 0xc6, 0xee, 0x90, 0x46, 0xb1, 0x33, 0x1c, 0xf1,
-	0x21, 0x32, 0x64, 0xc2, 0xf8, 0x18, 0x93, 0x36, 0xa0, 0x30, 0x0a, 0x22, 0x37, 0x76, 0x03, 0x9f,
-	0xdb, 0x53, 0x24, 0x29, 0xad, 0xfd, 0x34, 0xe4, 0xee, 0x39, 0xde, 0x11, 0xc5, 0x6f, 0x42, 0x96,
-	0x1b, 0xac, 0x70, 0x83, 0x4b, 0x5b, 0x02, 0x74, 0x6e, 0x27, 0x17, 0xb0, 0xb1, 0x8f, 0x99, 0x26,
-	0x1f, 0x7b, 0x89, 0x08, 0x42, 0x3b, 0x80, 0xa5, 0x1d, 0xd7, 0xef, 0xdf, 0x73, 0x42, 0x97, 0x81,
-	0xf1, 0x9c, 0xc3, 0xe0, 0xcf, 0x41, 0x9e, 0x37, 0xa2, 0x6a, 0x66, 0x33, 0x73, 0xa9, 0xb4, 0xbd,
-	0x24, 0x3b, 0xf2, 0xb5, 0x11, 0x29, 0xd3, 0xfe, 0x4a, 0x01, 0xd8, 0x09, 0x8e, 0xfc, 0xfe, 0x5d,
-	0x26, 0xc4, 0x08, 0x32, 0xd1, 0xa1, 0x27, 0x81, 0x64, 0x4d, 0x7c, 0x07, 0x2a, 0x0f, 0x5d, 0xbf,
-	0x6f, 0x1f, 0xcb, 0xe5, 0x08, 0x2c, 0x4b, 0xdb, 0x9f, 0x93, 0xc3, 0x4d, 0x3a, 0x6f, 0x4d, 0xaf,
-	0x3a, 0x32, 0xfc, 0x38, 0x1c, 0x93, 0xf2, 0xc3, 0x69, 0xde, 0x46, 0x17, 0xf0, 0x69, 0x25, 0x36,
-	0xe9, 0x01, 0x1d, 0x27, 0x93, 0x1e, 0xd0, 0x31, 0xfe, 0x91, 0x69, 0x8b, 0x4a, 0xdb, 0xab, 0xc9,
-	0x5c, 0x53, 0x7d, 0xa5, 0x99, 0xef, 0xa8, 0x37, 0x15, 0xed, 0x2f, 0x16, 0xa1, 0x62, 0x7c, 0x40,
-	0x7b, 0x47, 0x31, 0x6d, 0x8d, 0xd8, 0x1e, 0x44, 0xb8, 0x09, 0xcb, 0xae, 0xdf, 0xf3, 0x8e, 0xfa,
-	0xb4, 0x6f, 0x3f, 0x72, 0xa9, 0xd7, 0x8f, 0xb8, 0x1f, 0x55, 0xd2, 0x75, 0xcf, 0xea, 0x6f, 0xd5,
-	0xa5, 0xf2, 0x1e, 0xd7, 0x25, 0x15, 0x77, 0x86, 0xc6, 0x97, 0x61, 0xa5, 0xe7, 0xb9, 0xd4, 0x8f,
-	0xed, 0x47, 0xcc, 0x5e, 0x3b, 0x0c, 0x1e, 0x47, 0xd5, 0xdc, 0xa6, 0x72, 0xa9, 0x40, 0x96, 0x85,
-	0x60, 0x8f, 0xf1, 0x49, 0xf0, 0x38, 0xc2, 0xef, 0x40, 0xe1, 0x71, 0x10, 0x1e, 0x78, 0x81, 0xd3,
-	0xaf, 0xe6, 0xf9, 0x9c, 0x17, 0xe7, 0xcf, 0x79, 0x5f, 0x6a, 0x91, 0x54, 0x1f, 0x5f, 0x02, 0x14,
-	0x1d, 0x7a, 0x76, 0x44, 0x3d, 0xda, 0x8b, 0x6d, 0xcf, 0x1d, 0xba, 0x71, 0xb5, 0xc0, 0x5d, 0xb2,
-	0x12, 0x1d, 0x7a, 0x1d, 0xce, 0x6e, 0x30, 0x2e, 0xb6, 0x61, 0x3d, 0x0e, 0x1d, 0x3f, 0x72, 0x7a,
-	0x6c, 0x30, 0xdb, 0x8d, 0x02, 0xcf, 0xe1, 0xee, 0x58, 0xe4, 0x53, 0x5e, 0x9e, 0x3f, 0xa5, 0x35,
-	0xe9, 0x52, 0x4f, 0x7a, 0x90, 0xb5, 0x78, 0x0e, 0x17, 0xbf, 0x0d, 0xeb, 0xd1, 0x81, 0x3b, 0xb2,
-	0xf9, 0x38, 0xf6, 0xc8, 0x73, 0x7c, 0xbb, 0xe7, 0xf4, 0xf6, 0x69, 0x15, 0xb8, 0xd9, 0x98, 0x09,
-	0xf9, 0xbe, 0xb7, 0x3d, 0xc7, 0xaf, 0x31, 0x09, 0x03, 0x9d, 0xe9, 0xf9, 0x34, 0xb4, 0x8f, 0x69,
-	0x18, 0xb1, 0xd5, 0x94, 0x9e, 0x06, 0x7a, 0x5b, 0x28, 0xdf, 0x13, 0xba, 0xa4, 0x32, 0x9a, 0xa1,
-	0xf1, 0x17, 0xe1, 0xfc, 0xbe, 0x13, 0xd9, 0xbd, 0x90, 0x3a, 0x31, 0xed, 0xdb, 0x31, 0x1d, 0x8e,
-	0xec, 0x58, 0xf8, 0xe0, 0x12, 0x5f, 0xc3, 0xda, 0xbe, 0x13, 0xd5, 0x84, 0xd4, 0xa2, 0xc3, 0x11,
-	0x8f, 0x23, 0x91, 0xf6, 0x25, 0xa8, 0xcc, 0xee, 0x26, 0x5e, 0x81, 0xb2, 0xf5, 0xa0, 0x6d, 0xd8,
-	0xba, 0xb9, 0x6b, 0x9b, 0x7a, 0xd3, 0x40, 0x0b, 0xb8, 0x0c, 0x45, 0xce, 0x6a, 0x99, 0x8d, 0x07,
-	0x48, 0xc1, 0x8b, 0x90, 0xd1, 0x1b, 0x0d, 0xa4, 0x6a, 0x37, 0xa1, 0x90, 0x6c, 0x0b, 0x5e, 0x86,
-	0x52, 0xd7, 0xec, 0xb4, 0x8d, 0x5a, 0x7d, 0xaf, 0x6e, 0xec, 0xa2, 0x05, 0x5c, 0x80, 0x6c, 0xab,
-	0x61, 0xb5, 0x91, 0x22, 0x5a, 0x7a, 0x1b, 0xa9, 0xac, 0xe7, 0xee, 0x8e, 0x8e, 0x32, 0xda, 0x9f,
-	0x2a, 0xb0, 0x36, 0x0f, 0x5e, 0x5c, 0x82, 0xc5, 0x5d, 0x63, 0x4f, 0xef, 0x36, 0x2c, 0xb4, 0x80,
-	0x57, 0x61, 0x99, 0x18, 0x6d, 0x43, 0xb7, 0xf4, 0x9d, 0x86, 0x61, 0x13, 0x43, 0xdf, 0x45, 0x0a,
-	0xc6, 0x50, 0x61, 0x2d, 0xbb, 0xd6, 0x6a, 0x36, 0xeb, 0x96, 0x65, 0xec, 0x22, 0x15, 0xaf, 0x01,
-	0xe2, 0xbc, 0xae, 0x39, 0xe1, 0x66, 0x30, 0x82, 0xa5, 0x8e, 0x41, 0xea, 0x7a, 0xa3, 0xfe, 0x3e,
-	0x1b, 0x00, 0x65, 0xf1, 0x67, 0xe0, 0x8d, 0x5a, 0xcb, 0xec, 0xd4, 0x3b, 0x96, 0x61, 0x5a, 0x76,
-	0xc7, 0xd4, 0xdb, 0x9d, 0xf7, 0x5a, 0x16, 0x1f, 0x59, 0x18, 0x97, 0xc3, 0x15, 0x00, 0xbd, 0x6b,
-	0xb5, 0xc4, 0x38, 0x28, 0xaf, 0x1d, 0x42, 0x65, 0x16, 0x79, 0xb6, 0x2a, 0xb9, 0x44, 0xbb, 0xdd,
-	0xd0, 0x4d, 0xd3, 0x20, 0x68, 0x01, 0xe7, 0x41, 0xbd, 0x77, 0x4d, 0xd8, 0x7a, 0x8b, 0xfa, 0xd7,
-	0x91, 0xca, 0x06, 0x62, 0xad, 0x5b, 0x21, 0xa5, 0xfd, 0x31, 0xca, 0xb0, 0x75, 0x33, 0xba, 0x41,
-	0x1f, 0xc5, 0xdb, 0xc4, 0x1d, 0xec, 0xc7, 0x28, 0xcb, 0xd6, 0xcd, 0x78, 0xf7, 0xdd, 0x78, 0x7f,
-	0xcf, 0xf1, 0xbc, 0x87, 0x4e, 0xef, 0x00, 0xe5, 0x6e, 0x67, 0x0b, 0x0a, 0x52, 0x6f, 0x67, 0x0b,
-	0x2a, 0xca, 0xdc, 0xce, 0x16, 0x32, 0x28, 0xab, 0xfd, 0xa5, 0x0a, 0x39, 0xbe, 0x3d, 0x2c, 0xce,
-	0x4f, 0x45, 0x6f, 0xde, 0x4e, 0x63, 0x9e, 0xfa, 0x94, 0x98, 0xc7, 0x5d, 0x41, 0x46, 0x5f, 0x41,
-	0xe0, 0x0b, 0x50, 0x0c, 0xc2, 0x81, 0x70, 0x12, 0x99, 0x37, 0x0a, 0x41, 0x38, 0xe0, 0x8e, 0xc1,
-	0x62, 0x36, 0x4b, 0x37, 0x0f, 0x9d, 0x88, 0xf2, 0xa3, 0x5b, 0x24, 0x29, 0x8d, 0x5f, 0x03, 0xa6,
-	0x67, 0xf3, 0x75, 0xe4, 0xb9, 0x6c, 0x31, 0x08, 0x07, 0x26, 0x5b, 0xca, 0x67, 0xa1, 0xdc, 0x0b,
-	0xbc, 0xa3, 0xa1, 0x6f, 0x7b, 0xd4, 0x1f, 0xc4, 0xfb, 0xd5, 0xc5, 0x4d, 0xe5, 0x52, 0x99, 0x2c,
-	0x09, 0x66, 0x83, 0xf3, 0x70, 0x15, 0x16, 0x7b, 0xfb, 0x4e, 0x18, 0x51, 0x71, 0x5c, 0xcb, 0x24,
-	0x21, 0xf9, 0xac, 0xb4, 0xe7, 0x0e, 0x1d, 0x2f, 0xe2, 0x47, 0xb3, 0x4c, 0x52, 0x9a, 0x19, 0xf1,
-	0xc8, 0x73, 0x06, 0x11, 0x3f, 0x52, 0x65, 0x22, 0x08, 0xfc, 0x26, 0x94, 0xe4, 0x84, 0x1c, 0x82,
-	0x12, 0x5f, 0x0e, 0x08, 0x16, 0x43, 0x40, 0xfb, 0x09, 0xc8, 0x90, 0xe0, 0x31, 0x9b, 0x53, 0xac,
-	0x28, 0xaa, 0x2a, 0x9b, 0x99, 0x4b, 0x98, 0x24, 0x24, 0xcb, 0x7b, 0x32, 0xf4, 0x8b, 0x8c, 0x90,
-	0x04, 0xfb, 0x6f, 0x2b, 0x50, 0xe2, 0x47, 0x96, 0xd0, 0xe8, 0xc8, 0x8b, 0x59, 0x8a, 0x90, 0xb1,
-	0x51, 0x99, 0x49, 0x11, 0x7c, 0x5f, 0x88, 0x94, 0x31, 0x00, 0x58, 0xb8, 0xb3, 0x9d, 0x47, 0x8f,
-	0x68, 0x2f, 0xa6, 0x22, 0x13, 0x66, 0xc9, 0x12, 0x63, 0xea, 0x92, 0xc7, 0x90, 0x77, 0xfd, 0x88,
-	0x86, 0xb1, 0xed, 0xf6, 0xf9, 0x9e, 0x64, 0x49, 0x41, 0x30, 0xea, 0x7d, 0x7c, 0x11, 0xb2, 0x3c,
-	0x60, 0x66, 0xf9, 0x2c, 0x20, 0x67, 0x21, 0xc1, 0x63, 0xc2, 0xf9, 0xb7, 0xb3, 0x85, 0x1c, 0xca,
-	0x6b, 0x5f, 0x86, 0x25, 0xbe, 0xb8, 0xfb, 0x4e, 0xe8, 0xbb, 0xfe, 0x80, 0xe7, 0xff, 0xa0, 0x2f,
-	0xfc, 0xa2, 0x4c, 0x78, 0x9b, 0xd9, 0x3c, 0xa4, 0x51, 0xe4, 0x0c, 0xa8, 0xcc, 0xc7, 0x09, 0xa9,
-	0xfd, 0x71, 0x06, 0x4a, 0x9d, 0x38, 0xa4, 0xce, 0x90, 0xa7, 0x76, 0xfc, 0x65, 0x80, 0x28, 0x76,
-	0x62, 0x3a, 0xa4, 0x7e, 0x9c, 0xd8, 0xf7, 0xba, 0x9c, 0x79, 0x4a, 0x6f, 0xab, 0x93, 0x28, 0x91,
-	0x29, 0x7d, 0xbc, 0x0d, 0x25, 0xca, 0xc4, 0x76, 0xcc, 0x4a, 0x04, 0x99, 0x86, 0x56, 0x92, 0x28,
-	0x96, 0xd6, 0x0e, 0x04, 0x68, 0xda, 0xde, 0xf8, 0x8e, 0x0a, 0xc5, 0x74, 0x34, 0xac, 0x43, 0xa1,
-	0xe7, 0xc4, 0x74, 0x10, 0x84, 0x63, 0x99, 0xb9, 0xdf, 0x7a, 0xda, 0xec, 0x5b, 0x35, 0xa9, 0x4c,
-	0xd2, 0x6e, 0xf8, 0x0d, 0x10, 0xe5, 0x90, 0x70, 0x4b, 0x61, 0x6f, 0x91, 0x73, 0xb8, 0x63, 0xbe,
-	0x03, 0x78, 0x14, 0xba, 0x43, 0x27, 0x1c, 0xdb, 0x07, 0x74, 0x9c, 0x64, 0xb9, 0xcc, 0x9c, 0x9d,
-	0x44, 0x52, 0xef, 0x0e, 0x1d, 0xcb, 0x88, 0x78, 0x73, 0xb6, 0xaf, 0xf4, 0x96, 0xd3, 0xfb, 0x33,
-	0xd5, 0x93, 0xd7, 0x0d, 0x51, 0x52, 0x21, 0xe4, 0xb8, 0x63, 0xb1, 0xa6, 0xf6, 0x05, 0x28, 0x24,
-	0x8b, 0xc7, 0x45, 0xc8, 0x19, 0x61, 0x18, 0x84, 0x68, 0x81, 0x07, 0xc6, 0x66, 0x43, 0xc4, 0xd6,
-	0xdd, 0x5d, 0x16, 0x5b, 0xff, 0x45, 0x4d, 0xd3, 0x34, 0xa1, 0x87, 0x47, 0x34, 0x8a, 0xf1, 0xcf,
-	0xc0, 0x2a, 0xe5, 0x2e, 0xe4, 0x1e, 0x53, 0xbb, 0xc7, 0x6b, 0x3a, 0xe6, 0x40, 0x0a, 0xc7, 0x7b,
-	0x79, 0x4b, 0x94, 0xa0, 0x49, 0xad, 0x47, 0x56, 0x52, 0x5d, 0xc9, 0xea, 0x63, 0x03, 0x56, 0xdd,
-	0xe1, 0x90, 0xf6, 0x5d, 0x27, 0x9e, 0x1e, 0x40, 0x6c, 0xd8, 0x7a, 0x52, 0xf2, 0xcc, 0x94, 0x8c,
-	0x64, 0x25, 0xed, 0x91, 0x0e, 0xf3, 0x16, 0xe4, 0x63, 0x5e, 0xde, 0x72, 0xdf, 0x2d, 0x6d, 0x97,
-	0x93, 0x88, 0xc3, 0x99, 0x44, 0x0a, 0xf1, 0x17, 0x40, 0x14, 0xcb, 0x3c, 0xb6, 0x4c, 0x1c, 0x62,
-	0x52, 0x03, 0x11, 0x21, 0xc7, 0x6f, 0x41, 0x65, 0x26, 0x3b, 0xf7, 0x39, 0x60, 0x19, 0x52, 0x9e,
-	0x4e, 0xb5, 0x7d, 0x7c, 0x05, 0x16, 0x03, 0x91, 0x0b, 0x79, 0xd4, 0x99, 0xac, 0x78, 0x36, 0x51,
-	0x92, 0x44, 0x8b, 0xc5, 0x86, 0x90, 0x46, 0x34, 0x3c, 0xa6, 0x7d, 0x36, 0xe8, 0x22, 0x1f, 0x14,
-	0x12, 0x56, 0xbd, 0xaf, 0xfd, 0x14, 0x2c, 0xa7, 0x10, 0x47, 0xa3, 0xc0, 0x8f, 0x28, 0xbe, 0x0c,
-	0xf9, 0x90, 0x9f, 0x77, 0x09, 0x2b, 0x96, 0x73, 0x4c, 0x45, 0x02, 0x22, 0x35, 0xb4, 0x3e, 0x2c,
-	0x0b, 0x0e, 0x8b, 0xdf, 0x7c, 0x27, 0xf1, 0x5b, 0x90, 0xa3, 0xac, 0x71, 0x62, 0x53, 0x48, 0xbb,
-	0xc6, 0xe5, 0x44, 0x48, 0xa7, 0x66, 0x51, 0x9f, 0x39, 0xcb, 0x7f, 0xaa, 0xb0, 0x2a, 0x57, 0xb9,
-	0xe3, 0xc4, 0xbd, 0xfd, 0x17, 0xd4, 0x1b, 0x7e, 0x14, 0x16, 0x19, 0xdf, 0x4d, 0x4f, 0xce, 0x1c,
-	0x7f, 0x48, 0x34, 0x98, 0x47, 0x38, 0x91, 0x3d, 0xb5, 0xfd, 0xb2, 0x7c, 0x2c, 0x3b, 0xd1, 0x54,
-	0xd5, 0x30, 0xc7, 0x71, 0xf2, 0xcf, 0x70, 0x9c, 0xc5, 0xb3, 0x38, 0x8e, 0xb6, 0x0b, 0x6b, 0xb3,
-	0x88, 0x4b, 0xe7, 0xf8, 0x31, 0x58, 0x14, 0x9b, 0x92, 0xc4, 0xc8, 0x79, 0xfb, 0x96, 0xa8, 0x68,
-	0x1f, 0xaa, 0xb0, 0x26, 0xc3, 0xd7, 0xa7, 0xe3, 0x1c, 0x4f, 0xe1, 0x9c, 0x3b, 0xd3, 0x01, 0x3d,
-	0xdb, 0xfe, 0x69, 0x35, 0x58, 0x3f, 0x81, 0xe3, 0x73, 0x1c, 0xd6, 0xef, 0x29, 0xb0, 0xb4, 0x43,
-	0x07, 0xae, 0xff, 0x82, 0xee, 0xc2, 0x14, 0xb8, 0xd9, 0x33, 0x39, 0xf1, 0x08, 0xca, 0xd2, 0x5e,
-	0x89, 0xd6, 0x69, 0xb4, 0x95, 0x79, 0xa7, 0xe5, 0x26, 0x2c, 0xc9, 0x0b, 0x08, 0xc7, 0x73, 0x9d,
-	0x28, 0xb5, 0xe7, 0xc4, 0x0d, 0x84, 0xce, 0x84, 0x44, 0xde, 0x55, 0x70, 0x42, 0xfb, 0x37, 0x05,
-	0xca, 0xb5, 0x60, 0x38, 0x74, 0xe3, 0x17, 0x14, 0xe3, 0xd3, 0x08, 0x65, 0xe7, 0xf9, 0xe3, 0xdb,
-	0x50, 0x49, 0xcc, 0x94, 0xd0, 0x9e, 0xc8, 0x34, 0xca, 0xa9, 0x4c, 0xf3, 0xef, 0x0a, 0x2c, 0x93,
-	0x40, 0x54, 0xf8, 0x2f, 0x37, 0x38, 0xd7, 0x00, 0x4d, 0x0c, 0x3d, 0x2b, 0x3c, 0xff, 0xa3, 0x40,
-	0xa5, 0x1d, 0xd2, 0x91, 0x13, 0xd2, 0x97, 0x1a, 0x1d, 0x56, 0xa6, 0xf7, 0x63, 0x59, 0xe0, 0x14,
-	0x09, 0x6f, 0x6b, 0x2b, 0xb0, 0x9c, 0xda, 0x2e, 0x00, 0xd3, 0xfe, 0x51, 0x81, 0x75, 0xe1, 0x62,
-	0x52, 0xd2, 0x7f, 0x41, 0x61, 0x49, 0xec, 0xcd, 0x4e, 0xd9, 0x5b, 0x85, 0x73, 0x27, 0x6d, 0x93,
-	0x66, 0x7f, 0x55, 0x85, 0xf3, 0x89, 0xf3, 0xbc, 0xe0, 0x86, 0xff, 0x00, 0xfe, 0xb0, 0x01, 0xd5,
-	0xd3, 0x20, 0x48, 0x84, 0xbe, 0xa1, 0x42, 0x55, 0x5c, 0xe2, 0x4c, 0xd5, 0x41, 0x2f, 0x8f, 0x6f,
-	0xe0, 0xb7, 0x61, 0x69, 0xe4, 0x84, 0xb1, 0xdb, 0x73, 0x47, 0x0e, 0xfb, 0x14, 0xcd, 0xf1, 0x32,
-	0xeb, 0xc4, 0x00, 0x33, 0x2a, 0xda, 0x05, 0x78, 0x6d, 0x0e, 0x22, 0x12, 0xaf, 0xff, 0x55, 0x00,
-	0x77, 0x62, 0x27, 0x8c, 0x3f, 0x05, 0x79, 0x69, 0xae, 0x33, 0xad, 0xc3, 0xea, 0x8c, 0xfd, 0xd3,
-	0xb8, 0xd0, 0xf8, 0x53, 0x91, 0x92, 0x3e, 0x16, 0x97, 0x69, 0xfb, 0x25, 0x2e, 0xff, 0xac, 0xc0,
-	0x46, 0x2d, 0x10, 0x17, 0xa2, 0x2f, 0xe5, 0x09, 0xd3, 0xde, 0x80, 0x0b, 0x73, 0x0d, 0x94, 0x00,
-	0xfc, 0x93, 0x02, 0xe7, 0x08, 0x75, 0xfa, 0x2f, 0xa7, 0xf1, 0x77, 0xe1, 0xfc, 0x29, 0xe3, 0x64,
-	0x8d, 0x72, 0x03, 0x0a, 0x43, 0x1a, 0x3b, 0xac, 0xc2, 0x95, 0x26, 0x6d, 0x24, 0xe3, 0x4e, 0xb4,
-	0x9b, 0x52, 0x83, 0xa4, 0xba, 0xda, 0x77, 0x55, 0x58, 0xe5, 0x75, 0xf6, 0xab, 0x8f, 0xbc, 0x33,
-	0xdd, 0xc2, 0xe4, 0x4f, 0x16, 0x7f, 0x4c, 0x61, 0x14, 0x52, 0x3b, 0xb9, 0x1d, 0x58, 0xe4, 0xbf,
-	0x3e, 0xc2, 0x28, 0xa4, 0x77, 0x05, 0x47, 0xfb, 0x1b, 0x05, 0xd6, 0x66, 0x21, 0x4e, 0xbf, 0x68,
-	0xfe, 0xbf, 0x6f, 0x5b, 0xe6, 0x84, 0x94, 0xcc, 0x59, 0x3e, 0x92, 0xb2, 0x67, 0xfe, 0x48, 0xfa,
-	0x5b, 0x15, 0xaa, 0xd3, 0xc6, 0xbc, 0xba, 0xd3, 0x99, 0xbd, 0xd3, 0xf9, 0x7e, 0x6f, 0xf9, 0xb4,
-	0xbf, 0x57, 0xe0, 0xb5, 0x39, 0x80, 0x7e, 0x7f, 0x2e, 0x32, 0x75, 0xb3, 0xa3, 0x3e, 0xf3, 0x66,
-	0xe7, 0x93, 0x77, 0x92, 0x7f, 0x50, 0x60, 0xad, 0x29, 0xee, 0xea, 0xc5, 0xcd, 0xc7, 0x8b, 0x1b,
-	0x83, 0xf9, 0x75, 0x7c, 0x76, 0xf2, 0x6b, 0x95, 0x56, 0x83, 0xf5, 0x13, 0xa6, 0x3d, 0xc7, 0x6d,
-	0xce, 0x7f, 0x2b, 0xb0, 0x22, 0x47, 0xd1, 0x5f, 0xd8, 0xf2, 0x65, 0x0e, 0x3a, 0xf8, 0x22, 0x64,
-	0xdc, 0x7e, 0x52, 0xf7, 0xce, 0xbe, 0x42, 0x60, 0x02, 0xed, 0x5d, 0xc0, 0xd3, 0x76, 0x3f, 0x07,
-	0x74, 0xff, 0xa1, 0xc2, 0x3a, 0x11, 0xd1, 0xf7, 0xd5, 0xef, 0x0b, 0x3f, 0xe8, 0xef, 0x0b, 0x4f,
-	0x4f, 0x5c, 0x1f, 0xf2, 0x62, 0x6a, 0x16, 0xea, 0x4f, 0x2e, 0x75, 0x9d, 0x48, 0xb4, 0x99, 0x53,
-	0x89, 0xf6, 0xf9, 0xe3, 0xd1, 0x87, 0x2a, 0x6c, 0x48, 0x43, 0x5e, 0xd5, 0x3a, 0x67, 0xf7, 0x88,
-	0xfc, 0x29, 0x8f, 0xf8, 0x2f, 0x05, 0x2e, 0xcc, 0x05, 0xf2, 0x87, 0x5e, 0xd1, 0x9c, 0xf0, 0x9e,
-	0xec, 0x33, 0xbd, 0x27, 0x77, 0x66, 0xef, 0xf9, 0xba, 0x0a, 0x15, 0x42, 0x3d, 0xea, 0x44, 0x2f,
-	0xf9, 0xed, 0xde, 0x09, 0x0c, 0x73, 0xa7, 0xee, 0x39, 0x57, 0x60, 0x39, 0x05, 0x42, 0x7e, 0x70,
-	0xf1, 0x0f, 0x74, 0x96, 0x07, 0xdf, 0xa3, 0x8e, 0x17, 0x27, 0x95, 0xa0, 0xf6, 0x27, 0x2a, 0x94,
-	0x09, 0xe3, 0xb8, 0x43, 0xda, 0x89, 0x9d, 0x38, 0xc2, 0x9f, 0x81, 0xa5, 0x7d, 0xae, 0x62, 0x4f,
-	0x3c, 0xa4, 0x48, 0x4a, 0x82, 0x27, 0x7e, 0x7d, 0xdc, 0x86, 0xf5, 0x88, 0xf6, 0x02, 0xbf, 0x1f,
-	0xd9, 0x0f, 0xe9, 0xbe, 0xeb, 0xf7, 0xed, 0xa1, 0x13, 0xc5, 0x34, 0xe4, 0xb0, 0x94, 0xc9, 0xaa,
-	0x14, 0xee, 0x70, 0x59, 0x93, 0x8b, 0xf0, 0x55, 0x58, 0x7b, 0xe8, 0xfa, 0x5e, 0x30, 0xb0, 0x47,
-	0x9e, 0x33, 0xa6, 0x61, 0x64, 0xf7, 0x82, 0x23, 0x5f, 0xe0, 0x91, 0x23, 0x58, 0xc8, 0xda, 0x42,
-	0x54, 0x63, 0x12, 0xfc, 0x3e, 0x5c, 0x9e, 0x3b, 0x8b, 0xfd, 0xc8, 0xf5, 0x62, 0x1a, 0xd2, 0xbe,
-	0x1d, 0xd2, 0x91, 0xe7, 0xf6, 0xc4, 0x0b, 0x2b, 0x01, 0xd4, 0xe7, 0xe7, 0x4c, 0xbd, 0x27, 0xd5,
-	0xc9, 0x44, 0x1b, 0x5f, 0x80, 0x62, 0x6f, 0x74, 0x64, 0x1f, 0xf1, 0x47, 0x0b, 0x0c, 0x3f, 0x85,
-	0x14, 0x7a, 0xa3, 0xa3, 0x2e, 0xa3, 0x31, 0x82, 0xcc, 0xe1, 0x48, 0x04, 0x67, 0x85, 0xb0, 0xa6,
-	0xf6, 0x3d, 0x05, 0x2a, 0xfa, 0x60, 0x10, 0xd2, 0x81, 0x13, 0x4b, 0x98, 0xae, 0xc2, 0x9a, 0x80,
-	0x64, 0x6c, 0x4b, 0x77, 0x15, 0xf6, 0x28, 0xc2, 0x1e, 0x29, 0x13, 0xbe, 0x2a, 0xec, 0xb9, 0x0e,
-	0xe7, 0x8e, 0xfc, 0xb9, 0x7d, 0x54, 0xde, 0x67, 0x2d, 0x95, 0x4e, 0xf7, 0xfa, 0x49, 0x78, 0x6d,
-	0x3e, 0x0a, 0x43, 0x57, 0xbc, 0x72, 0x2c, 0x93, 0x73, 0x73, 0x8c, 0x6e, 0xba, 0xfe, 0x53, 0xba,
-	0x3a, 0x1f, 0x70, 0xbc, 0x3e, 0xa6, 0xab, 0xf3, 0x81, 0xf6, 0x67, 0xe9, 0x6f, 0x8a, 0x89, 0xbb,
-	0xa4, 0x81, 0x23, 0x71, 0x64, 0xe5, 0x69, 0x8e, 0x5c, 0x85, 0x45, 0xe6, 0x8c, 0xae, 0x3f, 0xe0,
-	0xc6, 0x15, 0x48, 0x42, 0xe2, 0x0e, 0x7c, 0x5e, 0xda, 0x4e, 0x3f, 0x88, 0x69, 0xe8, 0x3b, 0x9e,
-	0x37, 0xb6, 0xc5, 0xf5, 0xa3, 0xcf, 0x1f, 0x94, 0xa5, 0xaf, 0x3e, 0x45, 0xf8, 0xf8, 0xac, 0xd0,
-	0x36, 0x52, 0x65, 0x92, 0xea, 0x5a, 0xe9, 0x7b, 0xd0, 0x2f, 0x41, 0x25, 0x94, 0x4e, 0x6c, 0x47,
-	0x6c, 0x7b, 0x64, 0xc8, 0x5d, 0x4b, 0x5e, 0x4d, 0x4c, 0x7b, 0x38, 0x29, 0x87, 0x33, 0x0e, 0xff,
-	0xdc, 0x01, 0xe7, 0x76, 0xb6, 0x90, 0x47, 0x8b, 0xda, 0x9f, 0x2b, 0xb0, 0x3a, 0xe7, 0xdb, 0x3d,
-	0xbd, 0x18, 0x50, 0xa6, 0xee, 0x1d, 0x7f, 0x1c, 0x72, 0xfc, 0x41, 0x8b, 0x7c, 0x43, 0x75, 0xfe,
-	0xf4, 0xa7, 0x3f, 0x7f, 0x7c, 0x42, 0x84, 0x16, 0x3b, 0x8b, 0xdc, 0x26, 0xf9, 0xda, 0x4e, 0x42,
-	0x52, 0x62, 0x3c, 0xf9, 0xc4, 0xee, 0xd4, 0x4d, 0x66, 0xf6, 0x99, 0x37, 0x99, 0x97, 0x7f, 0x3b,
-	0x03, 0xc5, 0xe6, 0xb8, 0x73, 0xe8, 0xed, 0x79, 0xce, 0x80, 0xbf, 0x0e, 0x69, 0xb6, 0xad, 0x07,
-	0x68, 0x01, 0xaf, 0x40, 0xd9, 0x6c, 0x59, 0xb6, 0xd9, 0x6d, 0x34, 0xec, 0xbd, 0x86, 0x7e, 0x0b,
-	0x29, 0x18, 0xc1, 0x52, 0x9b, 0xd4, 0xed, 0x3b, 0xc6, 0x03, 0xc1, 0x51, 0xf1, 0x2a, 0x2c, 0x77,
-	0xcd, 0xfa, 0xdd, 0xae, 0x31, 0x61, 0x66, 0xf1, 0x3a, 0xac, 0x34, 0xbb, 0x0d, 0xab, 0xde, 0x6e,
-	0x4c, 0xb1, 0x0b, 0xb8, 0x0c, 0xc5, 0x9d, 0x46, 0x6b, 0x47, 0x90, 0x88, 0x8d, 0xdf, 0x35, 0x3b,
-	0xf5, 0x5b, 0xa6, 0xb1, 0x2b, 0x58, 0x9b, 0x8c, 0xf5, 0xbe, 0x41, 0x5a, 0x7b, 0xf5, 0x64, 0xca,
-	0x77, 0x31, 0x82, 0xd2, 0x4e, 0xdd, 0xd4, 0x89, 0x1c, 0xe5, 0x89, 0x82, 0x2b, 0x50, 0x34, 0xcc,
-	0x6e, 0x53, 0xd2, 0x2a, 0xae, 0xc2, 0xaa, 0xde, 0xb5, 0x5a, 0x76, 0xdd, 0xac, 0x11, 0xa3, 0x69,
-	0x98, 0x96, 0x94, 0x64, 0xf1, 0x2a, 0x54, 0xac, 0x7a, 0xd3, 0xe8, 0x58, 0x7a, 0xb3, 0x2d, 0x99,
-	0x6c, 0x15, 0x85, 0x8e, 0x91, 0xe8, 0x20, 0xbc, 0x01, 0xeb, 0x66, 0xcb, 0x4e, 0x9e, 0xd6, 0xdd,
-	0xd3, 0x1b, 0x5d, 0x43, 0xca, 0x36, 0xf1, 0x79, 0xc0, 0x2d, 0xd3, 0xee, 0xb6, 0x77, 0x75, 0xcb,
-	0xb0, 0xcd, 0xd6, 0x7d, 0x29, 0x78, 0x17, 0x57, 0xa0, 0x30, 0x59, 0xc1, 0x13, 0x86, 0x42, 0xb9,
-	0xad, 0x13, 0x6b, 0x62, 0xec, 0x93, 0x27, 0x0c, 0x2c, 0xb8, 0x45, 0x5a, 0xdd, 0xf6, 0x44, 0x6d,
-	0x05, 0x4a, 0x12, 0x2c, 0xc9, 0xca, 0x32, 0xd6, 0x4e, 0xdd, 0xac, 0xa5, 0xeb, 0x7b, 0x52, 0xd8,
-	0x50, 0x91, 0x72, 0xf9, 0x00, 0xb2, 0x7c, 0x3b, 0x0a, 0x90, 0x35, 0x5b, 0xa6, 0x81, 0x16, 0xf0,
-	0x32, 0x40, 0xbd, 0x53, 0x37, 0x2d, 0xe3, 0x16, 0xd1, 0x1b, 0xcc, 0x6c, 0xce, 0x48, 0x00, 0x64,
-	0xd6, 0x2e, 0xc1, 0x62, 0xbd, 0xb3, 0xd7, 0x68, 0xe9, 0x96, 0x34, 0xb3, 0xde, 0xb9, 0xdb, 0x6d,
-	0x59, 0x4c, 0x88, 0x70, 0x09, 0xf2, 0xf5, 0x8e, 0x65, 0x7c, 0xc5, 0x62, 0x76, 0x71, 0x99, 0x40,
-	0x15, 0x3d, 0x79, 0xf7, 0xf2, 0xb7, 0x32, 0x90, 0xe5, 0xcf, 0xb9, 0xcb, 0x50, 0xe4, 0xbb, 0x6d,
-	0x3d, 0x68, 0xb3, 0x29, 0x8b, 0x90, 0xad, 0x9b, 0xd6, 0x4d, 0xf4, 0x73, 0x2a, 0x06, 0xc8, 0x75,
-	0x79, 0xfb, 0xe7, 0xf3, 0xac, 0x5d, 0x37, 0xad, 0xb7, 0x6f, 0xa0, 0xaf, 0xaa, 0x6c, 0xd8, 0xae,
-	0x20, 0x7e, 0x21, 0x11, 0x6c, 0x5f, 0x47, 0x5f, 0x4b, 0x05, 0xdb, 0xd7, 0xd1, 0x2f, 0x26, 0x82,
-	0x6b, 0xdb, 0xe8, 0xeb, 0xa9, 0xe0, 0xda, 0x36, 0xfa, 0xa5, 0x44, 0x70, 0xe3, 0x3a, 0xfa, 0xe5,
-	0x54, 0x70, 0xe3, 0x3a, 0xfa, 0x95, 0x3c, 0xb3, 0x85, 0x5b, 0x72, 0x6d, 0x1b, 0xfd, 0x6a, 0x21,
-	0xa5, 0x6e, 0x5c, 0x47, 0xbf, 0x56, 0x60, 0xfb, 0x9f, 0xee, 0x2a, 0xfa, 0x75, 0xc4, 0x96, 0xc9,
-	0x36, 0x08, 0xfd, 0x06, 0x6f, 0x32, 0x11, 0xfa, 0x4d, 0xc4, 0x6c, 0x64, 0x5c, 0x4e, 0x7e, 0x83,
-	0x4b, 0x1e, 0x18, 0x3a, 0x41, 0xbf, 0x95, 0x17, 0x8f, 0x3d, 0x6b, 0xf5, 0xa6, 0xde, 0x40, 0x98,
-	0xf7, 0x60, 0xa8, 0xfc, 0xce, 0x55, 0xd6, 0x64, 0xee, 0x89, 0x7e, 0xb7, 0xcd, 0x26, 0xbc, 0xa7,
-	0x93, 0xda, 0x7b, 0x3a, 0x41, 0xbf, 0x77, 0x95, 0x4d, 0x78, 0x4f, 0x27, 0x12, 0xaf, 0xdf, 0x6f,
-	0x33, 0x45, 0x2e, 0xfa, 0x83, 0xab, 0x6c, 0xd1, 0x92, 0xff, 0xcd, 0x36, 0x2e, 0x40, 0x66, 0xa7,
-	0x6e, 0xa1, 0x6f, 0xf1, 0xd9, 0x98, 0x8b, 0xa2, 0x3f, 0x44, 0x8c, 0xd9, 0x31, 0x2c, 0xf4, 0x6d,
-	0xc6, 0xcc, 0x59, 0xdd, 0x76, 0xc3, 0x40, 0xaf, 0xb3, 0xc5, 0xdd, 0x32, 0x5a, 0x4d, 0xc3, 0x22,
-	0x0f, 0xd0, 0x1f, 0x71, 0xf5, 0xdb, 0x9d, 0x96, 0x89, 0xbe, 0x83, 0x70, 0x05, 0xc0, 0xf8, 0x4a,
-	0x9b, 0x18, 0x9d, 0x4e, 0xbd, 0x65, 0xa2, 0x37, 0x2f, 0xef, 0x01, 0x3a, 0x19, 0x0e, 0x98, 0x01,
-	0x5d, 0xf3, 0x8e, 0xd9, 0xba, 0x6f, 0xa2, 0x05, 0x46, 0xb4, 0x89, 0xd1, 0xd6, 0x89, 0x81, 0x14,
-	0x0c, 0x90, 0x97, 0x4f, 0x48, 0x55, 0xbc, 0x04, 0x05, 0xd2, 0x6a, 0x34, 0x76, 0xf4, 0xda, 0x1d,
-	0x94, 0xd9, 0x31, 0xfe, 0xfa, 0xa3, 0x8b, 0xca, 0xdf, 0x7d, 0x74, 0x51, 0xf9, 0xee, 0x47, 0x17,
-	0x95, 0x6f, 0xfe, 0xeb, 0xc5, 0x05, 0x58, 0x76, 0x83, 0xad, 0x63, 0x37, 0xa6, 0x51, 0x24, 0xfe,
-	0x40, 0xf0, 0xbe, 0x26, 0x29, 0x37, 0xb8, 0x22, 0x5a, 0x57, 0x06, 0xc1, 0x95, 0xe3, 0xf8, 0x0a,
-	0x97, 0x5e, 0xe1, 0x11, 0xe4, 0x61, 0x9e, 0x13, 0xd7, 0xfe, 0x2f, 0x00, 0x00, 0xff, 0xff, 0xc4,
-	0x64, 0x72, 0x89, 0x9e, 0x30, 0x00, 0x00,
+	0x56, 0xee, 0x2a, 0xfd, 0xb4, 0xf4, 0xd4, 0x52, 0x67, 0x67, 0x77, 0xdb, 0x9a, 0x9e, 0x19, 0x4f,
+	0x6f, 0xed, 0xce, 0xae, 0x31, 0x4b, 0xdb, 0xd3, 0xf6, 0x1a, 0x33, 0xbb, 0xc0, 0x54, 0xab, 0xab,
+	0x3d, 0xb2, 0xa5, 0x92, 0x9c, 0x2a, 0xd9, 0xeb, 0x09, 0x22, 0x2a, 0xca, 0x52, 0x5a, 0x5d, 0xd1,
+	0xa5, 0x2a, 0xb9, 0xaa, 0xd4, 0x1e, 0xdd, 0x0c, 0xc3, 0xb2, 0xfc, 0xb3, 0xfc, 0xef, 0x42, 0xb0,
+	0xc1, 0x8d, 0xe0, 0x42, 0x04, 0x37, 0xce, 0x1c, 0x26, 0x08, 0x82, 0x20, 0xe0, 0x08, 0x1c, 0x58,
+	0x86, 0x20, 0xe0, 0x44, 0x10, 0x1c, 0x38, 0x70, 0x20, 0x88, 0xfc, 0xa9, 0x92, 0xd4, 0xad, 0xb1,
+	0x7b, 0xbd, 0x4c, 0x10, 0xf6, 0xcc, 0x2d, 0xdf, 0x4f, 0x66, 0xbe, 0xf7, 0xe5, 0xcb, 0x97, 0xa9,
+	0xac, 0x27, 0x28, 0x3d, 0x1a, 0xd3, 0x70, 0xb2, 0x33, 0x0a, 0x83, 0x38, 0xc0, 0x39, 0x4e, 0x6c,
+	0x55, 0xe2, 0x60, 0x14, 0xf4, 0x9d, 0xd8, 0x11, 0xec, 0xad, 0xd2, 0x71, 0x1c, 0x8e, 0x7a, 0x82,
+	0xd0, 0xbe, 0xa1, 0x40, 0xde, 0x72, 0xc2, 0x01, 0x8d, 0xf1, 0x16, 0x14, 0x8e, 0xe8, 0x24, 0x1a,
+	0x39, 0x3d, 0x5a, 0x55, 0xb6, 0x95, 0x8b, 0x45, 0x92, 0xd2, 0x78, 0x03, 0x72, 0xd1, 0xa1, 0x13,
+	0xf6, 0xab, 0x2a, 0x17, 0x08, 0x02, 0x7f, 0x05, 0x4a, 0xb1, 0xf3, 0xc0, 0xa3, 0xb1, 0x1d, 0x4f,
+	0x46, 0xb4, 0x9a, 0xd9, 0x56, 0x2e, 0x56, 0x76, 0x37, 0x76, 0xd2, 0xf9, 0x2c, 0x2e, 0xb4, 0x26,
+	0x23, 0x4a, 0x20, 0x4e, 0xdb, 0x18, 0x43, 0xb6, 0x47, 0x3d, 0xaf, 0x9a, 0xe5, 0x63, 0xf1, 0xb6,
+	0xb6, 0x0f, 0x95, 0xbb, 0xd6, 0x4d, 0x27, 0xa6, 0x35, 0xc7, 0xf3, 0x68, 0x58, 0xdf, 0x67, 0xe6,
+	0x8c, 0x23, 0x1a, 0xfa, 0xce, 0x30, 0x35, 0x27, 0xa1, 0xf1, 0x39, 0xc8, 0x0f, 0xc2, 0x60, 0x3c,
+	0x8a, 0xaa, 0xea, 0x76, 0xe6, 0x62, 0x91, 0x48, 0x4a, 0xfb, 0x29, 0x00, 0xe3, 0x98, 0xfa, 0xb1,
+	0x15, 0x1c, 0x51, 0x1f, 0xbf, 0x06, 0xc5, 0xd8, 0x1d, 0xd2, 0x28, 0x76, 0x86, 0x23, 0x3e, 0x44,
+	0x86, 0x4c, 0x19, 0x1f, 0xe3, 0xd2, 0x16, 0x14, 0x46, 0x41, 0xe4, 0xc6, 0x6e, 0xe0, 0x73, 0x7f,
+	0x8a, 0x24, 0xa5, 0xb5, 0x9f, 0x80, 0xdc, 0x5d, 0xc7, 0x1b, 0x53, 0xfc, 0x06, 0x64, 0xb9, 0xc3,
+	0x0a, 0x77, 0xb8, 0xb4, 0x23, 0x40, 0xe7, 0x7e, 0x72, 0x01, 0x1b, 0xfb, 0x98, 0x69, 0xf2, 0xb1,
+	0x57, 0x88, 0x20, 0xb4, 0x23, 0x58, 0xd9, 0x73, 0xfd, 0xfe, 0x5d, 0x27, 0x74, 0x19, 0x18, 0xcf,
+	0x39, 0x0c, 0xfe, 0x02, 0xe4, 0x79, 0x23, 0xaa, 0x66, 0xb6, 0x33, 0x17, 0x4b, 0xbb, 0x2b, 0xb2,
+	0x23, 0xb7, 0x8d, 0x48, 0x99, 0xf6, 0xe7, 0x0a, 0xc0, 0x5e, 0x30, 0xf6, 0xfb, 0x77, 0x98, 0x10,
+	0x23, 0xc8, 0x44, 0x8f, 0x3c, 0x09, 0x24, 0x6b, 0xe2, 0xdb, 0x50, 0x79, 0xe0, 0xfa, 0x7d, 0xfb,
+	0x58, 0x9a, 0x23, 0xb0, 0x2c, 0xed, 0x7e, 0x41, 0x0e, 0x37, 0xed, 0xbc, 0x33, 0x6b, 0x75, 0x64,
+	0xf8, 0x71, 0x38, 0x21, 0xe5, 0x07, 0xb3, 0xbc, 0xad, 0x2e, 0xe0, 0xd3, 0x4a, 0x6c, 0xd2, 0x23,
+	0x3a, 0x49, 0x26, 0x3d, 0xa2, 0x13, 0xfc, 0x43, 0xb3, 0x1e, 0x95, 0x76, 0xd7, 0x93, 0xb9, 0x66,
+	0xfa, 0x4a, 0x37, 0xdf, 0x56, 0x6f, 0x28, 0xda, 0x9f, 0x2e, 0x43, 0xc5, 0x78, 0x9f, 0xf6, 0xc6,
+	0x31, 0x6d, 0x8d, 0xd8, 0x1a, 0x44, 0xb8, 0x09, 0xab, 0xae, 0xdf, 0xf3, 0xc6, 0x7d, 0xda, 0xb7,
+	0x1f, 0xba, 0xd4, 0xeb, 0x47, 0x3c, 0x8e, 0x2a, 0xa9, 0xdd, 0xf3, 0xfa, 0x3b, 0x75, 0xa9, 0x7c,
+	0xc0, 0x75, 0x49, 0xc5, 0x9d, 0xa3, 0xf1, 0x25, 0x58, 0xeb, 0x79, 0x2e, 0xf5, 0x63, 0xfb, 0x21,
+	0xf3, 0xd7, 0x0e, 0x83, 0xc7, 0x51, 0x35, 0xb7, 0xad, 0x5c, 0x2c, 0x90, 0x55, 0x21, 0x38, 0x60,
+	0x7c, 0x12, 0x3c, 0x8e, 0xf0, 0xdb, 0x50, 0x78, 0x1c, 0x84, 0x47, 0x5e, 0xe0, 0xf4, 0xab, 0x79,
+	0x3e, 0xe7, 0x85, 0xc5, 0x73, 0xde, 0x93, 0x5a, 0x24, 0xd5, 0xc7, 0x17, 0x01, 0x45, 0x8f, 0x3c,
+	0x3b, 0xa2, 0x1e, 0xed, 0xc5, 0xb6, 0xe7, 0x0e, 0xdd, 0xb8, 0x5a, 0xe0, 0x21, 0x59, 0x89, 0x1e,
+	0x79, 0x1d, 0xce, 0x6e, 0x30, 0x2e, 0xb6, 0x61, 0x33, 0x0e, 0x1d, 0x3f, 0x72, 0x7a, 0x6c, 0x30,
+	0xdb, 0x8d, 0x02, 0xcf, 0x61, 0xad, 0x6a, 0x91, 0x4f, 0x79, 0x69, 0xf1, 0x94, 0xd6, 0xb4, 0x4b,
+	0x3d, 0xe9, 0x41, 0x36, 0xe2, 0x05, 0x5c, 0xfc, 0x16, 0x6c, 0x46, 0x47, 0xee, 0xc8, 0xe6, 0xe3,
+	0xd8, 0x23, 0xcf, 0xf1, 0xed, 0x9e, 0xd3, 0x3b, 0xa4, 0x55, 0xe0, 0x6e, 0x63, 0x26, 0xe4, 0xeb,
+	0xde, 0xf6, 0x1c, 0xbf, 0xc6, 0x24, 0x0c, 0x74, 0xa6, 0xe7, 0xd3, 0xd0, 0x3e, 0xa6, 0x61, 0xc4,
+	0xac, 0x29, 0x3d, 0x0d, 0xf4, 0xb6, 0x50, 0xbe, 0x2b, 0x74, 0x49, 0x65, 0x34, 0x47, 0xe3, 0xaf,
+	0xc0, 0xf9, 0x43, 0x27, 0xb2, 0x7b, 0x21, 0x75, 0x62, 0xda, 0xb7, 0x63, 0x3a, 0x1c, 0xd9, 0xb1,
+	0x88, 0xc1, 0x15, 0x6e, 0xc3, 0xc6, 0xa1, 0x13, 0xd5, 0x84, 0xd4, 0xa2, 0xc3, 0x11, 0xcf, 0x23,
+	0x91, 0xf6, 0x55, 0xa8, 0xcc, 0xaf, 0x26, 0x5e, 0x83, 0xb2, 0x75, 0xbf, 0x6d, 0xd8, 0xba, 0xb9,
+	0x6f, 0x9b, 0x7a, 0xd3, 0x40, 0x4b, 0xb8, 0x0c, 0x45, 0xce, 0x6a, 0x99, 0x8d, 0xfb, 0x48, 0xc1,
+	0xcb, 0x90, 0xd1, 0x1b, 0x0d, 0xa4, 0x6a, 0x37, 0xa0, 0x90, 0x2c, 0x0b, 0x5e, 0x85, 0x52, 0xd7,
+	0xec, 0xb4, 0x8d, 0x5a, 0xfd, 0xa0, 0x6e, 0xec, 0xa3, 0x25, 0x5c, 0x80, 0x6c, 0xab, 0x61, 0xb5,
+	0x91, 0x22, 0x5a, 0x7a, 0x1b, 0xa9, 0xac, 0xe7, 0xfe, 0x9e, 0x8e, 0x32, 0xda, 0x1f, 0x29, 0xb0,
+	0xb1, 0x08, 0x5e, 0x5c, 0x82, 0xe5, 0x7d, 0xe3, 0x40, 0xef, 0x36, 0x2c, 0xb4, 0x84, 0xd7, 0x61,
+	0x95, 0x18, 0x6d, 0x43, 0xb7, 0xf4, 0xbd, 0x86, 0x61, 0x13, 0x43, 0xdf, 0x47, 0x0a, 0xc6, 0x50,
+	0x61, 0x2d, 0xbb, 0xd6, 0x6a, 0x36, 0xeb, 0x96, 0x65, 0xec, 0x23, 0x15, 0x6f, 0x00, 0xe2, 0xbc,
+	0xae, 0x39, 0xe5, 0x66, 0x30, 0x82, 0x95, 0x8e, 0x41, 0xea, 0x7a, 0xa3, 0xfe, 0x1e, 0x1b, 0x00,
+	0x65, 0xf1, 0xe7, 0xe0, 0xf5, 0x5a, 0xcb, 0xec, 0xd4, 0x3b, 0x96, 0x61, 0x5a, 0x76, 0xc7, 0xd4,
+	0xdb, 0x9d, 0x77, 0x5b, 0x16, 0x1f, 0x59, 0x38, 0x97, 0xc3, 0x15, 0x00, 0xbd, 0x6b, 0xb5, 0xc4,
+	0x38, 0x28, 0xaf, 0x3d, 0x82, 0xca, 0x3c, 0xf2, 0xcc, 0x2a, 0x69, 0xa2, 0xdd, 0x6e, 0xe8, 0xa6,
+	0x69, 0x10, 0xb4, 0x84, 0xf3, 0xa0, 0xde, 0xbd, 0x2a, 0x7c, 0xbd, 0x49, 0xfd, 0x6b, 0x48, 0x65,
+	0x03, 0xb1, 0xd6, 0xcd, 0x90, 0xd2, 0xfe, 0x04, 0x65, 0x98, 0xdd, 0x8c, 0x6e, 0xd0, 0x87, 0xf1,
+	0x2e, 0x71, 0x07, 0x87, 0x31, 0xca, 0x32, 0xbb, 0x19, 0xef, 0x9e, 0x1b, 0x1f, 0x1e, 0x38, 0x9e,
+	0xf7, 0xc0, 0xe9, 0x1d, 0xa1, 0xdc, 0xad, 0x6c, 0x41, 0x41, 0xea, 0xad, 0x6c, 0x41, 0x45, 0x99,
+	0x5b, 0xd9, 0x42, 0x06, 0x65, 0xb5, 0x3f, 0x53, 0x21, 0xc7, 0x97, 0x87, 0xe5, 0xf9, 0x99, 0xec,
+	0xcd, 0xdb, 0x69, 0xce, 0x53, 0x9f, 0x92, 0xf3, 0x78, 0x28, 0xc8, 0xec, 0x2b, 0x08, 0xfc, 0x2a,
+	0x14, 0x83, 0x70, 0x20, 0x82, 0x44, 0x9e, 0x1b, 0x85, 0x20, 0x1c, 0xf0, 0xc0, 0x60, 0x39, 0x9b,
+	0x1d, 0x37, 0x0f, 0x9c, 0x88, 0xf2, 0xad, 0x5b, 0x24, 0x29, 0x8d, 0x5f, 0x01, 0xa6, 0x67, 0x73,
+	0x3b, 0xf2, 0x5c, 0xb6, 0x1c, 0x84, 0x03, 0x93, 0x99, 0xf2, 0x79, 0x28, 0xf7, 0x02, 0x6f, 0x3c,
+	0xf4, 0x6d, 0x8f, 0xfa, 0x83, 0xf8, 0xb0, 0xba, 0xbc, 0xad, 0x5c, 0x2c, 0x93, 0x15, 0xc1, 0x6c,
+	0x70, 0x1e, 0xae, 0xc2, 0x72, 0xef, 0xd0, 0x09, 0x23, 0x2a, 0xb6, 0x6b, 0x99, 0x24, 0x24, 0x9f,
+	0x95, 0xf6, 0xdc, 0xa1, 0xe3, 0x45, 0x7c, 0x6b, 0x96, 0x49, 0x4a, 0x33, 0x27, 0x1e, 0x7a, 0xce,
+	0x20, 0xe2, 0x5b, 0xaa, 0x4c, 0x04, 0x81, 0xdf, 0x80, 0x92, 0x9c, 0x90, 0x43, 0x50, 0xe2, 0xe6,
+	0x80, 0x60, 0x31, 0x04, 0xb4, 0x1f, 0x85, 0x0c, 0x09, 0x1e, 0xb3, 0x39, 0x85, 0x45, 0x51, 0x55,
+	0xd9, 0xce, 0x5c, 0xc4, 0x24, 0x21, 0xd9, 0xb9, 0x27, 0x53, 0xbf, 0x38, 0x11, 0x92, 0x64, 0xff,
+	0x57, 0x0a, 0x94, 0xf8, 0x96, 0x25, 0x34, 0x1a, 0x7b, 0x31, 0x3b, 0x22, 0x64, 0x6e, 0x54, 0xe6,
+	0x8e, 0x08, 0xbe, 0x2e, 0x44, 0xca, 0x18, 0x00, 0x2c, 0xdd, 0xd9, 0xce, 0xc3, 0x87, 0xb4, 0x17,
+	0x53, 0x71, 0x12, 0x66, 0xc9, 0x0a, 0x63, 0xea, 0x92, 0xc7, 0x90, 0x77, 0xfd, 0x88, 0x86, 0xb1,
+	0xed, 0xf6, 0xf9, 0x9a, 0x64, 0x49, 0x41, 0x30, 0xea, 0x7d, 0x7c, 0x01, 0xb2, 0x3c, 0x61, 0x66,
+	0xf9, 0x2c, 0x20, 0x67, 0x21, 0xc1, 0x63, 0xc2, 0xf9, 0xf8, 0x32, 0x14, 0x1e, 0x3b, 0xa1, 0xef,
+	0xfa, 0x83, 0xa8, 0x9a, 0xdf, 0xce, 0xcc, 0x64, 0x7c, 0x6e, 0xed, 0x3d, 0x21, 0x23, 0xa9, 0xd2,
+	0xad, 0x6c, 0x21, 0x87, 0xf2, 0xda, 0xd7, 0x60, 0x65, 0x56, 0xce, 0x2f, 0x0c, 0x41, 0x5f, 0x04,
+	0x52, 0x99, 0xf0, 0x36, 0x03, 0x69, 0x48, 0xa3, 0xc8, 0x19, 0x50, 0x79, 0x80, 0x27, 0xa4, 0xf6,
+	0x87, 0x19, 0x28, 0x75, 0xe2, 0x90, 0x3a, 0x43, 0x7e, 0x17, 0xc0, 0x5f, 0x03, 0x88, 0x62, 0x27,
+	0xa6, 0x43, 0xea, 0xc7, 0x09, 0x20, 0xaf, 0x49, 0x33, 0x66, 0xf4, 0x76, 0x3a, 0x89, 0x12, 0x99,
+	0xd1, 0xc7, 0xbb, 0x50, 0xa2, 0x4c, 0x6c, 0xc7, 0xec, 0x4e, 0x21, 0xcf, 0xad, 0xb5, 0x24, 0xed,
+	0xa5, 0x97, 0x0d, 0x02, 0x34, 0x6d, 0x6f, 0x7d, 0x57, 0x85, 0x62, 0x3a, 0x1a, 0xd6, 0xa1, 0xd0,
+	0x73, 0x62, 0x3a, 0x08, 0xc2, 0x89, 0x3c, 0xea, 0xdf, 0x7c, 0xda, 0xec, 0x3b, 0x35, 0xa9, 0x4c,
+	0xd2, 0x6e, 0xf8, 0x75, 0x10, 0xf7, 0x27, 0x11, 0xc7, 0xc2, 0xdf, 0x22, 0xe7, 0xf0, 0x48, 0x7e,
+	0x1b, 0xf0, 0x28, 0x74, 0x87, 0x4e, 0x38, 0xb1, 0x8f, 0xe8, 0x24, 0x39, 0x16, 0x33, 0x0b, 0x96,
+	0x1e, 0x49, 0xbd, 0xdb, 0x74, 0x22, 0x53, 0xe8, 0x8d, 0xf9, 0xbe, 0x32, 0xbc, 0x4e, 0x2f, 0xe8,
+	0x4c, 0x4f, 0x7e, 0xd1, 0x88, 0x92, 0x2b, 0x45, 0x8e, 0x47, 0x22, 0x6b, 0x6a, 0x5f, 0x82, 0x42,
+	0x62, 0x3c, 0x2e, 0x42, 0xce, 0x08, 0xc3, 0x20, 0x44, 0x4b, 0x3c, 0x93, 0x36, 0x1b, 0x22, 0x19,
+	0xef, 0xef, 0xb3, 0x64, 0xfc, 0x4f, 0x6a, 0x7a, 0xae, 0x13, 0xfa, 0x68, 0x4c, 0xa3, 0x18, 0xff,
+	0x24, 0xac, 0x53, 0x1e, 0x73, 0xee, 0x31, 0xb5, 0x7b, 0xfc, 0x12, 0xc8, 0x22, 0x4e, 0xe1, 0x78,
+	0xaf, 0xee, 0x88, 0x3b, 0x6b, 0x72, 0x39, 0x24, 0x6b, 0xa9, 0xae, 0x64, 0xf5, 0xb1, 0x01, 0xeb,
+	0xee, 0x70, 0x48, 0xfb, 0xae, 0x13, 0xcf, 0x0e, 0x20, 0x16, 0x6c, 0x33, 0xb9, 0x23, 0xcd, 0xdd,
+	0x31, 0xc9, 0x5a, 0xda, 0x23, 0x1d, 0xe6, 0x4d, 0xc8, 0xc7, 0xfc, 0x3e, 0xcc, 0x83, 0xbd, 0xb4,
+	0x5b, 0x4e, 0x52, 0x14, 0x67, 0x12, 0x29, 0xc4, 0x5f, 0x02, 0x71, 0xbb, 0xe6, 0xc9, 0x68, 0x1a,
+	0x10, 0xd3, 0x4b, 0x13, 0x11, 0x72, 0xfc, 0x26, 0x54, 0xe6, 0x8e, 0xf3, 0x3e, 0x07, 0x2c, 0x43,
+	0xca, 0x33, 0xdc, 0x7a, 0x1f, 0x5f, 0x86, 0xe5, 0x40, 0x1c, 0x9e, 0xd5, 0xfc, 0x9c, 0xc5, 0xf3,
+	0x27, 0x2b, 0x49, 0xb4, 0x58, 0x32, 0x09, 0x69, 0x44, 0xc3, 0x63, 0xda, 0x67, 0x83, 0x2e, 0xf3,
+	0x41, 0x21, 0x61, 0xd5, 0xfb, 0xda, 0x8f, 0xc3, 0x6a, 0x0a, 0x71, 0x34, 0x0a, 0xfc, 0x88, 0xe2,
+	0x4b, 0x90, 0x0f, 0x79, 0x82, 0x90, 0xb0, 0xe2, 0xd9, 0xcd, 0x28, 0x52, 0x07, 0x91, 0x1a, 0x5a,
+	0x1f, 0x56, 0x05, 0x87, 0x25, 0x7c, 0xbe, 0x92, 0xf8, 0x4d, 0xc8, 0x51, 0xd6, 0x38, 0xb1, 0x28,
+	0xa4, 0x5d, 0xe3, 0x72, 0x22, 0xa4, 0x33, 0xb3, 0xa8, 0xcf, 0x9c, 0xe5, 0x3f, 0x54, 0x58, 0x97,
+	0x56, 0xee, 0x39, 0x71, 0xef, 0xf0, 0x05, 0x8d, 0x86, 0x1f, 0x86, 0x65, 0xc6, 0x77, 0xd3, 0x9d,
+	0xb3, 0x20, 0x1e, 0x12, 0x0d, 0x16, 0x11, 0x4e, 0x64, 0xcf, 0x2c, 0xbf, 0xbc, 0x6f, 0x96, 0x9d,
+	0x68, 0xe6, 0x9a, 0xb1, 0x20, 0x70, 0xf2, 0xcf, 0x08, 0x9c, 0xe5, 0xb3, 0x04, 0x8e, 0xb6, 0x0f,
+	0x1b, 0xf3, 0x88, 0xcb, 0xe0, 0xf8, 0x32, 0x2c, 0x8b, 0x45, 0x49, 0x72, 0xe4, 0xa2, 0x75, 0x4b,
+	0x54, 0xb4, 0x0f, 0x55, 0xd8, 0x90, 0xe9, 0xeb, 0xd3, 0xb1, 0x8f, 0x67, 0x70, 0xce, 0x9d, 0x69,
+	0x83, 0x9e, 0x6d, 0xfd, 0xb4, 0x1a, 0x6c, 0x9e, 0xc0, 0xf1, 0x39, 0x36, 0xeb, 0xbf, 0x2b, 0xb0,
+	0xb2, 0x47, 0x07, 0xae, 0xff, 0x82, 0xae, 0xc2, 0x0c, 0xb8, 0xd9, 0x33, 0x05, 0xf1, 0x08, 0xca,
+	0xd2, 0x5f, 0x89, 0xd6, 0x69, 0xb4, 0x95, 0x45, 0xbb, 0xe5, 0x06, 0xac, 0xc8, 0x17, 0x0b, 0xc7,
+	0x73, 0x9d, 0x28, 0xf5, 0xe7, 0xc4, 0x93, 0x85, 0xce, 0x84, 0xa4, 0x14, 0x4f, 0x09, 0xed, 0x5f,
+	0x14, 0x28, 0xd7, 0x82, 0xe1, 0xd0, 0x8d, 0x5f, 0x50, 0x8c, 0x4f, 0x23, 0x94, 0x5d, 0x14, 0x8f,
+	0x6f, 0x41, 0x25, 0x71, 0x53, 0x42, 0x7b, 0xe2, 0xa4, 0x51, 0x4e, 0x9d, 0x34, 0xff, 0xaa, 0xc0,
+	0x2a, 0x09, 0xc4, 0x4f, 0x82, 0x97, 0x1b, 0x9c, 0xab, 0x80, 0xa6, 0x8e, 0x9e, 0x15, 0x9e, 0xff,
+	0x56, 0xa0, 0xd2, 0x0e, 0xe9, 0xc8, 0x09, 0xe9, 0x4b, 0x8d, 0x0e, 0xbb, 0xa6, 0xf7, 0x63, 0x79,
+	0xc1, 0x29, 0x12, 0xde, 0xd6, 0xd6, 0x60, 0x35, 0xf5, 0x5d, 0x00, 0xa6, 0xfd, 0xbd, 0x02, 0x9b,
+	0x22, 0xc4, 0xa4, 0xa4, 0xff, 0x82, 0xc2, 0x92, 0xf8, 0x9b, 0x9d, 0xf1, 0xb7, 0x0a, 0xe7, 0x4e,
+	0xfa, 0x26, 0xdd, 0xfe, 0x40, 0x85, 0xf3, 0x49, 0xf0, 0xbc, 0xe0, 0x8e, 0xff, 0x00, 0xf1, 0xb0,
+	0x05, 0xd5, 0xd3, 0x20, 0x48, 0x84, 0xbe, 0xa5, 0x42, 0x55, 0xbc, 0xfa, 0xcc, 0xdc, 0x83, 0x5e,
+	0x9e, 0xd8, 0xc0, 0x6f, 0xc1, 0xca, 0xc8, 0x09, 0x63, 0xb7, 0xe7, 0x8e, 0x1c, 0xf6, 0x53, 0x34,
+	0xb7, 0x9d, 0x39, 0x3d, 0xc0, 0x9c, 0x8a, 0xf6, 0x2a, 0xbc, 0xb2, 0x00, 0x11, 0x89, 0xd7, 0xff,
+	0x28, 0x80, 0x3b, 0xb1, 0x13, 0xc6, 0x9f, 0x82, 0x73, 0x69, 0x61, 0x30, 0x6d, 0xc2, 0xfa, 0x9c,
+	0xff, 0xb3, 0xb8, 0xd0, 0xf8, 0x53, 0x71, 0x24, 0x7d, 0x2c, 0x2e, 0xb3, 0xfe, 0x4b, 0x5c, 0xfe,
+	0x51, 0x81, 0xad, 0x5a, 0x20, 0x5e, 0x50, 0x5f, 0xca, 0x1d, 0xa6, 0xbd, 0x0e, 0xaf, 0x2e, 0x74,
+	0x50, 0x02, 0xf0, 0x0f, 0x0a, 0x9c, 0x23, 0xd4, 0xe9, 0xbf, 0x9c, 0xce, 0xdf, 0x81, 0xf3, 0xa7,
+	0x9c, 0x93, 0x77, 0x94, 0xeb, 0x50, 0x18, 0xd2, 0xd8, 0xe9, 0x3b, 0xb1, 0x23, 0x5d, 0xda, 0x4a,
+	0xc6, 0x9d, 0x6a, 0x37, 0xa5, 0x06, 0x49, 0x75, 0xb5, 0xef, 0xa9, 0xb0, 0xce, 0xef, 0xd9, 0x9f,
+	0xfd, 0xc8, 0x3b, 0xd3, 0x2b, 0x4c, 0xfe, 0xe4, 0xe5, 0x8f, 0x29, 0x8c, 0x42, 0x6a, 0x27, 0xaf,
+	0x03, 0xcb, 0xfc, 0x73, 0x25, 0x8c, 0x42, 0x7a, 0x47, 0x70, 0xb4, 0xbf, 0x54, 0x60, 0x63, 0x1e,
+	0xe2, 0xf4, 0x17, 0xcd, 0xff, 0xf5, 0x6b, 0xcb, 0x82, 0x94, 0x92, 0x39, 0xcb, 0x8f, 0xa4, 0xec,
+	0x99, 0x7f, 0x24, 0xfd, 0xb5, 0x0a, 0xd5, 0x59, 0x67, 0x3e, 0x7b, 0xd3, 0x99, 0x7f, 0xd3, 0xf9,
+	0x7e, 0x5f, 0xf9, 0xb4, 0xbf, 0x55, 0xe0, 0x95, 0x05, 0x80, 0x7e, 0x7f, 0x21, 0x32, 0xf3, 0xb2,
+	0xa3, 0x3e, 0xf3, 0x65, 0xe7, 0x93, 0x0f, 0x92, 0xbf, 0x53, 0x60, 0xa3, 0x29, 0xde, 0xea, 0xc5,
+	0xcb, 0xc7, 0x8b, 0x9b, 0x83, 0xf9, 0x73, 0x7c, 0x76, 0xfa, 0x79, 0x8b, 0xbd, 0xe6, 0x9c, 0x70,
+	0xed, 0x39, 0x5e, 0x73, 0xfe, 0x4b, 0x81, 0x35, 0x39, 0x8a, 0xde, 0x3b, 0x7a, 0x79, 0xd0, 0xc1,
+	0x17, 0x20, 0xe3, 0xf6, 0x93, 0x7b, 0xef, 0x7c, 0xd9, 0x02, 0x13, 0x68, 0xef, 0x00, 0x9e, 0xf5,
+	0xfb, 0x39, 0xa0, 0xfb, 0x37, 0x15, 0x36, 0x89, 0xc8, 0xbe, 0x9f, 0x7d, 0x5f, 0xf8, 0x41, 0xbf,
+	0x2f, 0x3c, 0xfd, 0xe0, 0xfa, 0x90, 0x5f, 0xa6, 0xe6, 0xa1, 0xfe, 0xe4, 0x8e, 0xae, 0x13, 0x07,
+	0x6d, 0xe6, 0xd4, 0x41, 0xfb, 0xfc, 0xf9, 0xe8, 0x43, 0x15, 0xb6, 0xa4, 0x23, 0x9f, 0xdd, 0x75,
+	0xce, 0x1e, 0x11, 0xf9, 0x53, 0x11, 0xf1, 0x9f, 0x0a, 0xbc, 0xba, 0x10, 0xc8, 0xff, 0xf7, 0x1b,
+	0xcd, 0x89, 0xe8, 0xc9, 0x3e, 0x33, 0x7a, 0x72, 0x67, 0x8e, 0x9e, 0x6f, 0xaa, 0x50, 0x21, 0xd4,
+	0xa3, 0x4e, 0xf4, 0x92, 0xbf, 0xee, 0x9d, 0xc0, 0x30, 0x77, 0xea, 0x9d, 0x73, 0x0d, 0x56, 0x53,
+	0x20, 0xe4, 0x0f, 0x2e, 0xfe, 0x03, 0x9d, 0x9d, 0x83, 0xef, 0x52, 0xc7, 0x8b, 0x93, 0x9b, 0xa0,
+	0xf6, 0x41, 0x06, 0xca, 0x84, 0x71, 0xdc, 0x21, 0x65, 0xdf, 0xbd, 0x23, 0xfc, 0x39, 0x58, 0x39,
+	0xe4, 0x2a, 0xf6, 0x34, 0x42, 0x8a, 0xa4, 0x24, 0x78, 0xe2, 0xeb, 0xe3, 0x2e, 0x6c, 0x46, 0xb4,
+	0x17, 0xf8, 0xfd, 0xc8, 0x7e, 0x40, 0x0f, 0x59, 0xe5, 0xda, 0xd0, 0x89, 0x62, 0x1a, 0x72, 0x58,
+	0xca, 0x64, 0x5d, 0x0a, 0xf7, 0xb8, 0xac, 0xc9, 0x45, 0xf8, 0x0a, 0x6c, 0x3c, 0x70, 0x7d, 0x2f,
+	0x18, 0xb0, 0x32, 0xa7, 0x09, 0x0d, 0x23, 0xbb, 0x17, 0x8c, 0x7d, 0x81, 0x47, 0x8e, 0x60, 0x21,
+	0x6b, 0x0b, 0x51, 0x8d, 0x49, 0xf0, 0x7b, 0x70, 0x69, 0xe1, 0x2c, 0xf6, 0x43, 0xd7, 0x8b, 0x69,
+	0x48, 0xfb, 0x76, 0x48, 0x47, 0x9e, 0xdb, 0x13, 0x25, 0x59, 0x02, 0xa8, 0x2f, 0x2e, 0x98, 0xfa,
+	0x40, 0xaa, 0x93, 0xa9, 0x36, 0x2b, 0xa5, 0xe8, 0x8d, 0xc6, 0xf6, 0x98, 0x17, 0x2d, 0x30, 0xfc,
+	0x14, 0x52, 0xe8, 0x8d, 0xc6, 0x5d, 0x46, 0xb3, 0xaf, 0xe9, 0x8f, 0x46, 0x22, 0x39, 0x2b, 0x84,
+	0x35, 0x99, 0xf1, 0xe2, 0xa3, 0x7f, 0xd4, 0x3b, 0xa4, 0x43, 0xc7, 0xee, 0x1d, 0x3a, 0xfe, 0x80,
+	0xf6, 0x65, 0x2a, 0xc6, 0x5c, 0xd6, 0xe1, 0xa2, 0x9a, 0x90, 0xe0, 0x2f, 0x03, 0x9e, 0xb1, 0xce,
+	0xf6, 0x9c, 0x81, 0x3d, 0x8c, 0x64, 0x99, 0x19, 0x9a, 0x91, 0x34, 0x9c, 0x41, 0x33, 0x62, 0x1f,
+	0x8d, 0x2a, 0xfa, 0x60, 0x10, 0xd2, 0x81, 0x13, 0xcb, 0x65, 0xb8, 0x02, 0x1b, 0x02, 0xf2, 0x89,
+	0x2d, 0xb7, 0x83, 0xc0, 0x4b, 0x11, 0x78, 0x49, 0x99, 0xd8, 0x0b, 0x02, 0xaf, 0x6b, 0x70, 0x6e,
+	0xec, 0x2f, 0xec, 0xa3, 0xf2, 0x3e, 0x1b, 0x63, 0x7f, 0x41, 0xaf, 0x1f, 0x83, 0x57, 0x16, 0xa3,
+	0x3c, 0x74, 0x45, 0xd9, 0x65, 0x99, 0x9c, 0x5b, 0x00, 0x6a, 0xd3, 0xf5, 0x9f, 0xd2, 0xd5, 0x79,
+	0xbf, 0x9a, 0xfd, 0xf8, 0xae, 0xce, 0xfb, 0xda, 0x1f, 0xa7, 0xdf, 0x2c, 0x93, 0x70, 0x4c, 0x13,
+	0x53, 0xb2, 0x51, 0x94, 0xa7, 0x6d, 0x94, 0x2a, 0x2c, 0xb3, 0x60, 0x77, 0xfd, 0x01, 0x77, 0xae,
+	0x40, 0x12, 0x12, 0x77, 0xe0, 0x8b, 0xd2, 0x77, 0xfa, 0x7e, 0x4c, 0x43, 0xdf, 0xf1, 0xbc, 0x89,
+	0x2d, 0x9e, 0x37, 0x7d, 0x5e, 0xe1, 0x96, 0x96, 0xa1, 0x8a, 0xf4, 0xf4, 0x79, 0xa1, 0x6d, 0xa4,
+	0xca, 0x24, 0xd5, 0xb5, 0x12, 0x55, 0xfc, 0x55, 0xa8, 0x84, 0x72, 0x93, 0xd8, 0x11, 0x5b, 0x1e,
+	0x99, 0xd2, 0x37, 0xa4, 0x75, 0x73, 0x3b, 0x88, 0x94, 0xc3, 0x59, 0xf2, 0xf9, 0x13, 0xda, 0xad,
+	0x6c, 0x21, 0x8f, 0x96, 0xb5, 0x3f, 0x51, 0x60, 0x7d, 0xc1, 0xdb, 0x40, 0xfa, 0xf0, 0xa0, 0xcc,
+	0xbc, 0x6b, 0xfe, 0x08, 0xe4, 0x98, 0x7d, 0x49, 0x51, 0xd7, 0xf9, 0xd3, 0x4f, 0x0b, 0xcc, 0x26,
+	0x4a, 0x84, 0x16, 0xdb, 0xeb, 0xdc, 0x27, 0x59, 0xfe, 0x27, 0x21, 0x29, 0x31, 0x9e, 0xac, 0xf9,
+	0x3b, 0xf5, 0x52, 0x9a, 0x7d, 0xe6, 0x4b, 0xe9, 0xa5, 0xdf, 0xc8, 0x40, 0xb1, 0x39, 0xe9, 0x3c,
+	0xf2, 0x0e, 0x3c, 0x67, 0xc0, 0xab, 0x4f, 0x9a, 0x6d, 0xeb, 0x3e, 0x5a, 0x62, 0x35, 0x82, 0x66,
+	0xcb, 0xb2, 0xcd, 0x6e, 0xa3, 0x61, 0x1f, 0x34, 0xf4, 0x9b, 0x48, 0x61, 0xc5, 0x76, 0x6d, 0x52,
+	0xb7, 0x6f, 0x1b, 0xf7, 0x05, 0x47, 0x65, 0x75, 0x72, 0x5d, 0xb3, 0x7e, 0xa7, 0x6b, 0x4c, 0x99,
+	0x59, 0xbc, 0x09, 0x6b, 0xcd, 0x6e, 0xc3, 0xaa, 0xb7, 0x1b, 0x33, 0xec, 0x02, 0xab, 0x30, 0xdc,
+	0x6b, 0xb4, 0xf6, 0x04, 0x89, 0xd8, 0xf8, 0x5d, 0xb3, 0x53, 0xbf, 0x69, 0x1a, 0xfb, 0x82, 0xb5,
+	0xcd, 0x58, 0xef, 0x19, 0xa4, 0x75, 0x50, 0x4f, 0xa6, 0x7c, 0x07, 0x23, 0x28, 0xed, 0xd5, 0x4d,
+	0x9d, 0xc8, 0x51, 0x9e, 0x28, 0xb8, 0x02, 0x45, 0xc3, 0xec, 0x36, 0x25, 0xad, 0xe2, 0x2a, 0xac,
+	0xb3, 0x62, 0x3e, 0xbb, 0x6e, 0xd6, 0x88, 0xd1, 0x64, 0x35, 0x7f, 0x42, 0x92, 0xc5, 0xeb, 0x50,
+	0xb1, 0xea, 0x4d, 0xa3, 0x63, 0xe9, 0xcd, 0xb6, 0x64, 0x32, 0x2b, 0x0a, 0x1d, 0x23, 0xd1, 0x41,
+	0x78, 0x0b, 0x36, 0xcd, 0x96, 0x9d, 0xd4, 0xfa, 0xdd, 0xd5, 0x1b, 0x5d, 0x43, 0xca, 0xb6, 0xf1,
+	0x79, 0xc0, 0x2d, 0xd3, 0xee, 0xb6, 0xf7, 0x75, 0xcb, 0xb0, 0xcd, 0xd6, 0x3d, 0x29, 0x78, 0x07,
+	0x57, 0xa0, 0x30, 0xb5, 0xe0, 0x09, 0x43, 0xa1, 0xdc, 0xd6, 0x89, 0x35, 0x75, 0xf6, 0xc9, 0x13,
+	0x06, 0x16, 0xdc, 0x24, 0xad, 0x6e, 0x7b, 0xaa, 0xb6, 0x06, 0x25, 0x09, 0x96, 0x64, 0x65, 0x19,
+	0x6b, 0xaf, 0x6e, 0xd6, 0x52, 0xfb, 0x9e, 0x14, 0xb6, 0x54, 0xa4, 0x5c, 0x3a, 0x82, 0x2c, 0x5f,
+	0x8e, 0x02, 0x64, 0xcd, 0x96, 0xc9, 0xca, 0x33, 0x57, 0x01, 0xea, 0x9d, 0xba, 0x69, 0x19, 0x37,
+	0x89, 0xde, 0x60, 0x6e, 0x73, 0x46, 0x02, 0x20, 0xf3, 0x76, 0x05, 0x96, 0xeb, 0x9d, 0x83, 0x46,
+	0x4b, 0xb7, 0xa4, 0x9b, 0xf5, 0xce, 0x9d, 0x6e, 0x8b, 0x55, 0x49, 0x3e, 0x41, 0xb8, 0x04, 0x79,
+	0x56, 0x10, 0xf9, 0x75, 0x8b, 0xf9, 0xc5, 0x65, 0x02, 0x55, 0xf4, 0xe4, 0x9d, 0x4b, 0xdf, 0xc9,
+	0x40, 0x96, 0xd7, 0x97, 0x97, 0xa1, 0xc8, 0x57, 0x9b, 0xd5, 0x81, 0xa2, 0x25, 0x5c, 0x84, 0x6c,
+	0xdd, 0xb4, 0x6e, 0xa0, 0x9f, 0x56, 0x31, 0x40, 0xae, 0xcb, 0xdb, 0x3f, 0x93, 0x67, 0xed, 0xba,
+	0x69, 0xbd, 0x75, 0x1d, 0x7d, 0xa0, 0xb2, 0x61, 0xbb, 0x82, 0xf8, 0xd9, 0x44, 0xb0, 0x7b, 0x0d,
+	0x7d, 0x23, 0x15, 0xec, 0x5e, 0x43, 0x3f, 0x97, 0x08, 0xae, 0xee, 0xa2, 0x6f, 0xa6, 0x82, 0xab,
+	0xbb, 0xe8, 0xe7, 0x13, 0xc1, 0xf5, 0x6b, 0xe8, 0x17, 0x52, 0xc1, 0xf5, 0x6b, 0xe8, 0x17, 0xf3,
+	0xcc, 0x17, 0xee, 0xc9, 0xd5, 0x5d, 0xf4, 0x4b, 0x85, 0x94, 0xba, 0x7e, 0x0d, 0xfd, 0x72, 0x81,
+	0xad, 0x7f, 0xba, 0xaa, 0xe8, 0x57, 0x10, 0x33, 0x93, 0x2d, 0x10, 0xfa, 0x55, 0xde, 0x64, 0x22,
+	0xf4, 0x6b, 0x88, 0xf9, 0xc8, 0xb8, 0x9c, 0xfc, 0x16, 0x97, 0xdc, 0x37, 0x74, 0x82, 0x7e, 0x3d,
+	0x2f, 0xaa, 0x4f, 0x6b, 0xf5, 0xa6, 0xde, 0x40, 0x98, 0xf7, 0x60, 0xa8, 0xfc, 0xe6, 0x15, 0xd6,
+	0x64, 0xe1, 0x89, 0x7e, 0xab, 0xcd, 0x26, 0xbc, 0xab, 0x93, 0xda, 0xbb, 0x3a, 0x41, 0xbf, 0x7d,
+	0x85, 0x4d, 0x78, 0x57, 0x27, 0x12, 0xaf, 0xdf, 0x69, 0x33, 0x45, 0x2e, 0xfa, 0xdd, 0x2b, 0xcc,
+	0x68, 0xc9, 0xff, 0x76, 0x1b, 0x17, 0x20, 0xb3, 0x57, 0xb7, 0xd0, 0x77, 0xf8, 0x6c, 0x2c, 0x44,
+	0xd1, 0xef, 0x21, 0xc6, 0xec, 0x18, 0x16, 0xfa, 0x7d, 0xc6, 0xcc, 0x59, 0xdd, 0x76, 0xc3, 0x40,
+	0xaf, 0x31, 0xe3, 0x6e, 0x1a, 0xad, 0xa6, 0x61, 0x91, 0xfb, 0xe8, 0x0f, 0xb8, 0xfa, 0xad, 0x4e,
+	0xcb, 0x44, 0xdf, 0x45, 0xac, 0xa0, 0xd4, 0xf8, 0x7a, 0x9b, 0x18, 0x9d, 0x4e, 0xbd, 0x65, 0xa2,
+	0x37, 0x2e, 0x1d, 0x00, 0x3a, 0x99, 0x0e, 0x98, 0x03, 0x5d, 0xf3, 0xb6, 0xd9, 0xba, 0x67, 0xa2,
+	0x25, 0x46, 0xb4, 0x89, 0xd1, 0xd6, 0x89, 0x81, 0x14, 0x0c, 0x90, 0x97, 0x35, 0xad, 0x2a, 0x5e,
+	0x81, 0x02, 0x69, 0x35, 0x1a, 0x7b, 0x7a, 0xed, 0x36, 0xca, 0xec, 0x19, 0x7f, 0xf1, 0xd1, 0x05,
+	0xe5, 0x6f, 0x3e, 0xba, 0xa0, 0x7c, 0xef, 0xa3, 0x0b, 0xca, 0xb7, 0xff, 0xf9, 0xc2, 0x12, 0xac,
+	0xba, 0xc1, 0xce, 0xb1, 0x1b, 0xd3, 0x28, 0x12, 0xff, 0x68, 0x78, 0x4f, 0x93, 0x94, 0x1b, 0x5c,
+	0x16, 0xad, 0xcb, 0x83, 0xe0, 0xf2, 0x71, 0x7c, 0x99, 0x4b, 0x2f, 0xf3, 0x0c, 0xf2, 0x20, 0xcf,
+	0x89, 0xab, 0xff, 0x3b, 0x00, 0x77, 0xd1, 0x17, 0xb7, 0x2f, 0x31, 0x00, 0x00,
 }
 
 func (m *Target) Marshal() (dAtA []byte, err error) {
@@ -5632,6 +5668,20 @@ func (m *QueryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Warnings) > 0 {
+		for iNdEx := len(m.Warnings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Warnings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.Rows) > 0 {
 		for iNdEx := len(m.Rows) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8502,6 +8552,20 @@ func (m *RealtimeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ReplicationLagMs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ReplicationLagMs))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.TableSchemaChanged) > 0 {
+		for iNdEx := len(m.TableSchemaChanged) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TableSchemaChanged[iNdEx])
+			copy(dAtA[i:], m.TableSchemaChanged[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.TableSchemaChanged[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	if m.Qps != 0 {
 		i -= 8
 		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Qps))))
@@ -9012,6 +9076,12 @@ func (m *QueryResult) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
+	if len(m.Warnings) > 0 {
+		for _, e := range m.Warnings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -10193,6 +10263,15 @@ func (m *RealtimeStats) Size() (n int) {
 	if m.Qps != 0 {
 		n += 9
 	}
+	if len(m.TableSchemaChanged) > 0 {
+		for _, s := range m.TableSchemaChanged {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ReplicationLagMs != 0 {
+		n += 1 + sovQuery(uint64(m.ReplicationLagMs))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -12025,6 +12104,40 @@ func (m *QueryResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Warnings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Warnings = append(m.Warnings, &QueryWarning{})
+			if err := m.Warnings[len(m.Warnings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -19500,6 +19613,57 @@ func (m *RealtimeStats) Unmarshal(dAtA []byte) error {
 			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
 			iNdEx += 8
 			m.Qps = float64(math.Float64frombits(v))
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TableSchemaChanged", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TableSchemaChanged = append(m.TableSchemaChanged, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicationLagMs", wireType)
+			}
+			m.ReplicationLagMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReplicationLagMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,2950 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: querylog.proto
+
+package querylog
+
+import (
+	fmt "fmt"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+
+	proto "github.com/golang/protobuf/proto"
+	query "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// TabletQuery is a query executed by vttablet.
+type TabletQuery struct {
+	Method          string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	CallInfo        string `protobuf:"bytes,2,opt,name=call_info,json=callInfo,proto3" json:"call_info,omitempty"`
+	Username        string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	ImmediateCaller string `protobuf:"bytes,4,opt,name=immediate_caller,json=immediateCaller,proto3" json:"immediate_caller,omitempty"`
+	EffectiveCaller string `protobuf:"bytes,5,opt,name=effective_caller,json=effectiveCaller,proto3" json:"effective_caller,omitempty"`
+	// start_time and end_time are in nanoseconds since the epoch.
+	StartTime   int64  `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime     int64  `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	PlanType    string `protobuf:"bytes,8,opt,name=plan_type,json=planType,proto3" json:"plan_type,omitempty"`
+	OriginalSql string `protobuf:"bytes,9,opt,name=original_sql,json=originalSql,proto3" json:"original_sql,omitempty"`
+	// bind_variables and rewritten_sql are omitted if the queries are
+	// redacted.
+	BindVariables   map[string]*query.BindVariable `protobuf:"bytes,10,rep,name=bind_variables,json=bindVariables,proto3" json:"bind_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumberOfQueries int64                          `protobuf:"varint,11,opt,name=number_of_queries,json=numberOfQueries,proto3" json:"number_of_queries,omitempty"`
+	RewrittenSql    string                         `protobuf:"bytes,12,opt,name=rewritten_sql,json=rewrittenSql,proto3" json:"rewritten_sql,omitempty"`
+	QuerySources    string                         `protobuf:"bytes,13,opt,name=query_sources,json=querySources,proto3" json:"query_sources,omitempty"`
+	// mysql_time, conn_wait_time and query_timeout are in nanoseconds.
+	MysqlTime            int64    `protobuf:"varint,14,opt,name=mysql_time,json=mysqlTime,proto3" json:"mysql_time,omitempty"`
+	ConnWaitTime         int64    `protobuf:"varint,15,opt,name=conn_wait_time,json=connWaitTime,proto3" json:"conn_wait_time,omitempty"`
+	RowsAffected         int64    `protobuf:"varint,16,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	ResponseSize         int64    `protobuf:"varint,17,opt,name=response_size,json=responseSize,proto3" json:"response_size,omitempty"`
+	Error                string   `protobuf:"bytes,18,opt,name=error,proto3" json:"error,omitempty"`
+	QueryTimeout         int64    `protobuf:"varint,19,opt,name=query_timeout,json=queryTimeout,proto3" json:"query_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TabletQuery) Reset()         { *m = TabletQuery{} }
+func (m *TabletQuery) String() string { return proto.CompactTextString(m) }
+func (*TabletQuery) ProtoMessage()    {}
+func (*TabletQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_528b464adc090130, []int{0}
+}
+func (m *TabletQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TabletQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TabletQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TabletQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TabletQuery.Merge(m, src)
+}
+func (m *TabletQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *TabletQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_TabletQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TabletQuery proto.InternalMessageInfo
+
+func (m *TabletQuery) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetCallInfo() string {
+	if m != nil {
+		return m.CallInfo
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetImmediateCaller() string {
+	if m != nil {
+		return m.ImmediateCaller
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetEffectiveCaller() string {
+	if m != nil {
+		return m.EffectiveCaller
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *TabletQuery) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *TabletQuery) GetPlanType() string {
+	if m != nil {
+		return m.PlanType
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetOriginalSql() string {
+	if m != nil {
+		return m.OriginalSql
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetBindVariables() map[string]*query.BindVariable {
+	if m != nil {
+		return m.BindVariables
+	}
+	return nil
+}
+
+func (m *TabletQuery) GetNumberOfQueries() int64 {
+	if m != nil {
+		return m.NumberOfQueries
+	}
+	return 0
+}
+
+func (m *TabletQuery) GetRewrittenSql() string {
+	if m != nil {
+		return m.RewrittenSql
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetQuerySources() string {
+	if m != nil {
+		return m.QuerySources
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetMysqlTime() int64 {
+	if m != nil {
+		return m.MysqlTime
+	}
+	return 0
+}
+
+func (m *TabletQuery) GetConnWaitTime() int64 {
+	if m != nil {
+		return m.ConnWaitTime
+	}
+	return 0
+}
+
+func (m *TabletQuery) GetRowsAffected() int64 {
+	if m != nil {
+		return m.RowsAffected
+	}
+	return 0
+}
+
+func (m *TabletQuery) GetResponseSize() int64 {
+	if m != nil {
+		return m.ResponseSize
+	}
+	return 0
+}
+
+func (m *TabletQuery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TabletQuery) GetQueryTimeout() int64 {
+	if m != nil {
+		return m.QueryTimeout
+	}
+	return 0
+}
+
+// GateQuery is a query executed by vtgate.
+type GateQuery struct {
+	Method          string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	RemoteAddr      string `protobuf:"bytes,2,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	Username        string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	ImmediateCaller string `protobuf:"bytes,4,opt,name=immediate_caller,json=immediateCaller,proto3" json:"immediate_caller,omitempty"`
+	EffectiveCaller string `protobuf:"bytes,5,opt,name=effective_caller,json=effectiveCaller,proto3" json:"effective_caller,omitempty"`
+	// start_time and end_time are in nanoseconds since the epoch.
+	StartTime int64 `protobuf:"varint,6,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   int64 `protobuf:"varint,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// plan_time, execute_time and commit_time are in nanoseconds.
+	PlanTime    int64  `protobuf:"varint,8,opt,name=plan_time,json=planTime,proto3" json:"plan_time,omitempty"`
+	ExecuteTime int64  `protobuf:"varint,9,opt,name=execute_time,json=executeTime,proto3" json:"execute_time,omitempty"`
+	CommitTime  int64  `protobuf:"varint,10,opt,name=commit_time,json=commitTime,proto3" json:"commit_time,omitempty"`
+	StmtType    string `protobuf:"bytes,11,opt,name=stmt_type,json=stmtType,proto3" json:"stmt_type,omitempty"`
+	Sql         string `protobuf:"bytes,12,opt,name=sql,proto3" json:"sql,omitempty"`
+	// bind_variables are omitted if the queries are redacted.
+	BindVariables        map[string]*query.BindVariable `protobuf:"bytes,13,rep,name=bind_variables,json=bindVariables,proto3" json:"bind_variables,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ShardQueries         uint64                         `protobuf:"varint,14,opt,name=shard_queries,json=shardQueries,proto3" json:"shard_queries,omitempty"`
+	RowsAffected         uint64                         `protobuf:"varint,15,opt,name=rows_affected,json=rowsAffected,proto3" json:"rows_affected,omitempty"`
+	RowsReturned         uint64                         `protobuf:"varint,16,opt,name=rows_returned,json=rowsReturned,proto3" json:"rows_returned,omitempty"`
+	Error                string                         `protobuf:"bytes,17,opt,name=error,proto3" json:"error,omitempty"`
+	Keyspace             string                         `protobuf:"bytes,18,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	Table                string                         `protobuf:"bytes,19,opt,name=table,proto3" json:"table,omitempty"`
+	TabletType           string                         `protobuf:"bytes,20,opt,name=tablet_type,json=tabletType,proto3" json:"tablet_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
+}
+
+func (m *GateQuery) Reset()         { *m = GateQuery{} }
+func (m *GateQuery) String() string { return proto.CompactTextString(m) }
+func (*GateQuery) ProtoMessage()    {}
+func (*GateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_528b464adc090130, []int{1}
+}
+func (m *GateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GateQuery.Merge(m, src)
+}
+func (m *GateQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GateQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GateQuery proto.InternalMessageInfo
+
+func (m *GateQuery) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *GateQuery) GetRemoteAddr() string {
+	if m != nil {
+		return m.RemoteAddr
+	}
+	return ""
+}
+
+func (m *GateQuery) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GateQuery) GetImmediateCaller() string {
+	if m != nil {
+		return m.ImmediateCaller
+	}
+	return ""
+}
+
+func (m *GateQuery) GetEffectiveCaller() string {
+	if m != nil {
+		return m.EffectiveCaller
+	}
+	return ""
+}
+
+func (m *GateQuery) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GateQuery) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GateQuery) GetPlanTime() int64 {
+	if m != nil {
+		return m.PlanTime
+	}
+	return 0
+}
+
+func (m *GateQuery) GetExecuteTime() int64 {
+	if m != nil {
+		return m.ExecuteTime
+	}
+	return 0
+}
+
+func (m *GateQuery) GetCommitTime() int64 {
+	if m != nil {
+		return m.CommitTime
+	}
+	return 0
+}
+
+func (m *GateQuery) GetStmtType() string {
+	if m != nil {
+		return m.StmtType
+	}
+	return ""
+}
+
+func (m *GateQuery) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *GateQuery) GetBindVariables() map[string]*query.BindVariable {
+	if m != nil {
+		return m.BindVariables
+	}
+	return nil
+}
+
+func (m *GateQuery) GetShardQueries() uint64 {
+	if m != nil {
+		return m.ShardQueries
+	}
+	return 0
+}
+
+func (m *GateQuery) GetRowsAffected() uint64 {
+	if m != nil {
+		return m.RowsAffected
+	}
+	return 0
+}
+
+func (m *GateQuery) GetRowsReturned() uint64 {
+	if m != nil {
+		return m.RowsReturned
+	}
+	return 0
+}
+
+func (m *GateQuery) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *GateQuery) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *GateQuery) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+func (m *GateQuery) GetTabletType() string {
+	if m != nil {
+		return m.TabletType
+	}
+	return ""
+}
+
+// Transaction is a transaction concluded by vttablet.
+type Transaction struct {
+	ConnId          int64  `protobuf:"varint,1,opt,name=conn_id,json=connId,proto3" json:"conn_id,omitempty"`
+	EffectiveCaller string `protobuf:"bytes,2,opt,name=effective_caller,json=effectiveCaller,proto3" json:"effective_caller,omitempty"`
+	ImmediateCaller string `protobuf:"bytes,3,opt,name=immediate_caller,json=immediateCaller,proto3" json:"immediate_caller,omitempty"`
+	// start_time and end_time are in nanoseconds since the epoch.
+	StartTime  int64    `protobuf:"varint,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime    int64    `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Conclusion string   `protobuf:"bytes,6,opt,name=conclusion,proto3" json:"conclusion,omitempty"`
+	Queries    []string `protobuf:"bytes,7,rep,name=queries,proto3" json:"queries,omitempty"`
+	// dropped_queries is the number of statements executed before queries
+	// which were not recorded.
+	DroppedQueries       int64    `protobuf:"varint,8,opt,name=dropped_queries,json=droppedQueries,proto3" json:"dropped_queries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Transaction) Reset()         { *m = Transaction{} }
+func (m *Transaction) String() string { return proto.CompactTextString(m) }
+func (*Transaction) ProtoMessage()    {}
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_528b464adc090130, []int{2}
+}
+func (m *Transaction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Transaction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Transaction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Transaction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Transaction.Merge(m, src)
+}
+func (m *Transaction) XXX_Size() int {
+	return m.Size()
+}
+func (m *Transaction) XXX_DiscardUnknown() {
+	xxx_messageInfo_Transaction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Transaction proto.InternalMessageInfo
+
+func (m *Transaction) GetConnId() int64 {
+	if m != nil {
+		return m.ConnId
+	}
+	return 0
+}
+
+func (m *Transaction) GetEffectiveCaller() string {
+	if m != nil {
+		return m.EffectiveCaller
+	}
+	return ""
+}
+
+func (m *Transaction) GetImmediateCaller() string {
+	if m != nil {
+		return m.ImmediateCaller
+	}
+	return ""
+}
+
+func (m *Transaction) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *Transaction) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *Transaction) GetConclusion() string {
+	if m != nil {
+		return m.Conclusion
+	}
+	return ""
+}
+
+func (m *Transaction) GetQueries() []string {
+	if m != nil {
+		return m.Queries
+	}
+	return nil
+}
+
+func (m *Transaction) GetDroppedQueries() int64 {
+	if m != nil {
+		return m.DroppedQueries
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TabletQuery)(nil), "querylog.TabletQuery")
+	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "querylog.TabletQuery.BindVariablesEntry")
+	proto.RegisterType((*GateQuery)(nil), "querylog.GateQuery")
+	proto.RegisterMapType((map[string]*query.BindVariable)(nil), "querylog.GateQuery.BindVariablesEntry")
+	proto.RegisterType((*Transaction)(nil), "querylog.Transaction")
+}
+
+func init() { proto.RegisterFile("querylog.proto", fileDescriptor_528b464adc090130) }
+
+var fileDescriptor_528b464adc090130 = []byte{
+	// 815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x95, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xc7, 0x71, 0x36, 0xfe, 0xd8, 0xb3, 0xfe, 0x48, 0xa6, 0x15, 0x2c, 0xa9, 0x70, 0xd3, 0x86,
+	0x0f, 0x97, 0x0b, 0x5b, 0x2a, 0x17, 0x20, 0xee, 0x5a, 0x84, 0x50, 0x2f, 0x50, 0xd5, 0x8d, 0x01,
+	0x89, 0x9b, 0xd5, 0x78, 0xf7, 0x38, 0x1d, 0x75, 0x77, 0xc6, 0x9e, 0x99, 0x75, 0x70, 0x1f, 0x04,
+	0xc1, 0x1b, 0x71, 0xc9, 0x23, 0xa0, 0xf0, 0x1e, 0x08, 0xcd, 0xc7, 0xae, 0x1d, 0x25, 0x8a, 0xb8,
+	0xe1, 0xa2, 0x77, 0x73, 0x7e, 0xe7, 0x3f, 0xc9, 0xf1, 0x99, 0xff, 0xdf, 0x86, 0xe1, 0xba, 0x42,
+	0xb9, 0x2d, 0xc4, 0xc5, 0x74, 0x25, 0x85, 0x16, 0xa4, 0x57, 0xd7, 0x27, 0x91, 0x3d, 0x39, 0xfc,
+	0xf8, 0xd7, 0x0e, 0x44, 0x73, 0xba, 0x28, 0x50, 0xbf, 0x32, 0x94, 0xbc, 0x0f, 0x9d, 0x12, 0xf5,
+	0x6b, 0x91, 0xc7, 0xad, 0xd3, 0xd6, 0x24, 0x4c, 0x7c, 0x45, 0x1e, 0x40, 0x98, 0xd1, 0xa2, 0x48,
+	0x19, 0x5f, 0x8a, 0xf8, 0xc0, 0xb6, 0x7a, 0x06, 0xbc, 0xe0, 0x4b, 0x41, 0x4e, 0xa0, 0x57, 0x29,
+	0x94, 0x9c, 0x96, 0x18, 0x07, 0xae, 0x57, 0xd7, 0xe4, 0x09, 0x1c, 0xb1, 0xb2, 0xc4, 0x9c, 0x51,
+	0x8d, 0xa9, 0xb9, 0x81, 0x32, 0x3e, 0xb4, 0x9a, 0x51, 0xc3, 0xbf, 0xb1, 0xd8, 0x48, 0x71, 0xb9,
+	0xc4, 0x4c, 0xb3, 0x4d, 0x23, 0x6d, 0x3b, 0x69, 0xc3, 0xbd, 0xf4, 0x23, 0x00, 0xa5, 0xa9, 0xd4,
+	0xa9, 0x66, 0x25, 0xc6, 0x9d, 0xd3, 0xd6, 0x24, 0x48, 0x42, 0x4b, 0xe6, 0xac, 0x44, 0xf2, 0x21,
+	0xf4, 0x90, 0xe7, 0xae, 0xd9, 0xb5, 0xcd, 0x2e, 0xf2, 0xdc, 0xb6, 0x1e, 0x40, 0xb8, 0x2a, 0x28,
+	0x4f, 0xf5, 0x76, 0x85, 0x71, 0xcf, 0x0d, 0x6b, 0xc0, 0x7c, 0xbb, 0x42, 0xf2, 0x08, 0xfa, 0x42,
+	0xb2, 0x0b, 0xc6, 0x69, 0x91, 0xaa, 0x75, 0x11, 0x87, 0xb6, 0x1f, 0xd5, 0xec, 0x7c, 0x5d, 0x90,
+	0x97, 0x30, 0x5c, 0x30, 0x9e, 0xa7, 0x1b, 0x2a, 0x99, 0xd9, 0x9b, 0x8a, 0xe1, 0x34, 0x98, 0x44,
+	0x4f, 0x27, 0xd3, 0x66, 0xe1, 0x7b, 0xfb, 0x9c, 0x3e, 0x67, 0x3c, 0xff, 0xb1, 0x96, 0x7e, 0xcb,
+	0xb5, 0xdc, 0x26, 0x83, 0xc5, 0x3e, 0x23, 0x9f, 0xc3, 0x31, 0xaf, 0xca, 0x05, 0xca, 0x54, 0x2c,
+	0x53, 0xf3, 0x37, 0x18, 0xaa, 0x38, 0xb2, 0x43, 0x8f, 0x5c, 0xe3, 0xe5, 0xf2, 0x95, 0xc3, 0xe4,
+	0x0c, 0x06, 0x12, 0x2f, 0x25, 0xd3, 0x1a, 0xb9, 0x1d, 0xb0, 0x6f, 0x07, 0xec, 0x37, 0xd0, 0x4c,
+	0x78, 0x06, 0x03, 0x3b, 0x4a, 0xaa, 0x44, 0x25, 0x33, 0x54, 0xf1, 0xc0, 0x89, 0x2c, 0x3c, 0x77,
+	0xcc, 0x2c, 0xb0, 0xdc, 0xaa, 0x75, 0xe1, 0x76, 0x34, 0x74, 0x0b, 0xb4, 0xc4, 0x6e, 0xe9, 0x63,
+	0x18, 0x66, 0x82, 0xf3, 0xf4, 0x92, 0x32, 0xbf, 0xe3, 0x91, 0x95, 0xf4, 0x0d, 0xfd, 0x89, 0x32,
+	0xb7, 0x66, 0x33, 0x8e, 0xb8, 0x54, 0x29, 0xb5, 0xaf, 0x83, 0x79, 0x7c, 0xe4, 0x44, 0x06, 0x3e,
+	0xf3, 0xcc, 0xcd, 0xac, 0x56, 0x82, 0x2b, 0x4c, 0x15, 0x7b, 0x8b, 0xf1, 0xb1, 0x17, 0x79, 0x78,
+	0xce, 0xde, 0x22, 0xb9, 0x0f, 0x6d, 0x94, 0x52, 0xc8, 0x98, 0xd8, 0x59, 0x5d, 0xb1, 0xfb, 0x24,
+	0x66, 0x02, 0x51, 0xe9, 0xf8, 0x9e, 0xbb, 0x6a, 0xe1, 0xdc, 0xb1, 0x93, 0x1f, 0x80, 0xdc, 0x5c,
+	0x32, 0x39, 0x82, 0xe0, 0x0d, 0x6e, 0xbd, 0x89, 0xcd, 0x91, 0x3c, 0x81, 0xf6, 0x86, 0x16, 0x15,
+	0x5a, 0xf7, 0x46, 0x4f, 0xef, 0x4d, 0xd7, 0x37, 0x1e, 0x28, 0x71, 0x8a, 0xaf, 0x0f, 0xbe, 0x6a,
+	0x3d, 0xfe, 0xa7, 0x0d, 0xe1, 0x77, 0x54, 0xe3, 0xdd, 0xb1, 0x78, 0x08, 0x91, 0xc4, 0x52, 0x68,
+	0x4c, 0x69, 0x9e, 0x4b, 0x1f, 0x0c, 0x70, 0xe8, 0x59, 0x9e, 0xcb, 0x77, 0x33, 0x1a, 0xac, 0x74,
+	0xd1, 0x08, 0x7c, 0x34, 0x4c, 0xf3, 0x11, 0xf4, 0xf1, 0x17, 0xcc, 0x2a, 0x8d, 0xae, 0x1f, 0xda,
+	0x7e, 0xe4, 0x99, 0x95, 0x3c, 0x84, 0x28, 0x13, 0x65, 0x59, 0x3b, 0x06, 0xac, 0x02, 0x1c, 0xaa,
+	0xff, 0x81, 0xd2, 0xa5, 0x76, 0xd9, 0x8b, 0xdc, 0x36, 0x0c, 0xb0, 0xd9, 0x3b, 0x82, 0x60, 0xe7,
+	0x68, 0x73, 0x24, 0xdf, 0xdf, 0x88, 0xda, 0xc0, 0x46, 0xed, 0xd3, 0x5d, 0xd4, 0x9a, 0x17, 0xfa,
+	0x0f, 0x41, 0x3b, 0x83, 0x81, 0x7a, 0x4d, 0x65, 0xde, 0x84, 0xcc, 0xb8, 0xfe, 0x30, 0xe9, 0x5b,
+	0xb8, 0x9f, 0xb0, 0x6b, 0x96, 0x1e, 0x39, 0xd1, 0x0d, 0x4b, 0x1b, 0x91, 0x44, 0x5d, 0x49, 0xee,
+	0x7d, 0xef, 0x45, 0x89, 0x67, 0x3b, 0x4b, 0x1f, 0xef, 0x5b, 0xfa, 0x04, 0x7a, 0x6f, 0x70, 0xab,
+	0x56, 0x34, 0x43, 0xef, 0xf5, 0xa6, 0x36, 0x37, 0xb4, 0x19, 0xd5, 0xda, 0x3c, 0x4c, 0x5c, 0x61,
+	0xb6, 0x6a, 0x0f, 0x7e, 0x6d, 0xf7, 0x6d, 0x0f, 0x1c, 0x32, 0x8b, 0xfb, 0xbf, 0x02, 0xf0, 0xfb,
+	0x01, 0x44, 0x73, 0x49, 0xb9, 0xa2, 0x99, 0x66, 0x82, 0x93, 0x0f, 0xa0, 0x6b, 0xbf, 0x12, 0x98,
+	0xcb, 0x40, 0x90, 0x74, 0x4c, 0xf9, 0x22, 0xbf, 0xd5, 0x9b, 0x07, 0xb7, 0x7b, 0xf3, 0x36, 0xc7,
+	0x07, 0xb7, 0x3b, 0xfe, 0xba, 0x8d, 0x0f, 0xef, 0xb2, 0x71, 0xfb, 0xba, 0x8d, 0xc7, 0x00, 0x99,
+	0xe0, 0x59, 0x51, 0x29, 0x26, 0xb8, 0x0d, 0x40, 0x98, 0xec, 0x11, 0x12, 0x43, 0xb7, 0x76, 0x40,
+	0xf7, 0x34, 0x98, 0x84, 0x49, 0x5d, 0x92, 0xcf, 0x60, 0x94, 0x4b, 0xb1, 0x5a, 0xe1, 0xce, 0x23,
+	0x2e, 0x06, 0x43, 0x8f, 0xbd, 0x4b, 0x9e, 0x7f, 0xf9, 0xc7, 0xd5, 0xb8, 0xf5, 0xe7, 0xd5, 0xb8,
+	0xf5, 0xd7, 0xd5, 0xb8, 0xf5, 0xdb, 0xdf, 0xe3, 0xf7, 0x7e, 0xfe, 0x64, 0xc3, 0x34, 0x2a, 0x35,
+	0x65, 0x62, 0xe6, 0x4e, 0xb3, 0x0b, 0x31, 0xdb, 0xe8, 0x99, 0xfd, 0x95, 0x9d, 0xd5, 0x7e, 0x5d,
+	0x74, 0x6c, 0xfd, 0xc5, 0xbf, 0x03, 0x00, 0xe2, 0x99, 0x9e, 0xa7, 0x9e, 0x07, 0x00, 0x00,
+}
+
+func (m *TabletQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TabletQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TabletQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.QueryTimeout != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.QueryTimeout))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.ResponseSize != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.ResponseSize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.RowsAffected != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.RowsAffected))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ConnWaitTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.ConnWaitTime))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.MysqlTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.MysqlTime))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.QuerySources) > 0 {
+		i -= len(m.QuerySources)
+		copy(dAtA[i:], m.QuerySources)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.QuerySources)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.RewrittenSql) > 0 {
+		i -= len(m.RewrittenSql)
+		copy(dAtA[i:], m.RewrittenSql)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.RewrittenSql)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.NumberOfQueries != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.NumberOfQueries))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.BindVariables) > 0 {
+		for k := range m.BindVariables {
+			v := m.BindVariables[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuerylog(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuerylog(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuerylog(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OriginalSql) > 0 {
+		i -= len(m.OriginalSql)
+		copy(dAtA[i:], m.OriginalSql)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.OriginalSql)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PlanType) > 0 {
+		i -= len(m.PlanType)
+		copy(dAtA[i:], m.PlanType)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.PlanType)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EffectiveCaller) > 0 {
+		i -= len(m.EffectiveCaller)
+		copy(dAtA[i:], m.EffectiveCaller)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.EffectiveCaller)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ImmediateCaller) > 0 {
+		i -= len(m.ImmediateCaller)
+		copy(dAtA[i:], m.ImmediateCaller)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.ImmediateCaller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CallInfo) > 0 {
+		i -= len(m.CallInfo)
+		copy(dAtA[i:], m.CallInfo)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.CallInfo)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TabletType) > 0 {
+		i -= len(m.TabletType)
+		copy(dAtA[i:], m.TabletType)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.TabletType)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.Table) > 0 {
+		i -= len(m.Table)
+		copy(dAtA[i:], m.Table)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Table)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Keyspace)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.RowsReturned != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.RowsReturned))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.RowsAffected != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.RowsAffected))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ShardQueries != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.ShardQueries))
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.BindVariables) > 0 {
+		for k := range m.BindVariables {
+			v := m.BindVariables[k]
+			baseI := i
+			if v != nil {
+				{
+					size, err := v.MarshalToSizedBuffer(dAtA[:i])
+					if err != nil {
+						return 0, err
+					}
+					i -= size
+					i = encodeVarintQuerylog(dAtA, i, uint64(size))
+				}
+				i--
+				dAtA[i] = 0x12
+			}
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintQuerylog(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintQuerylog(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Sql) > 0 {
+		i -= len(m.Sql)
+		copy(dAtA[i:], m.Sql)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Sql)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.StmtType) > 0 {
+		i -= len(m.StmtType)
+		copy(dAtA[i:], m.StmtType)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.StmtType)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CommitTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.CommitTime))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ExecuteTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.ExecuteTime))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PlanTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.PlanTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.EffectiveCaller) > 0 {
+		i -= len(m.EffectiveCaller)
+		copy(dAtA[i:], m.EffectiveCaller)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.EffectiveCaller)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ImmediateCaller) > 0 {
+		i -= len(m.ImmediateCaller)
+		copy(dAtA[i:], m.ImmediateCaller)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.ImmediateCaller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.RemoteAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Transaction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Transaction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Transaction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.DroppedQueries != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.DroppedQueries))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Queries) > 0 {
+		for iNdEx := len(m.Queries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Queries[iNdEx])
+			copy(dAtA[i:], m.Queries[iNdEx])
+			i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Queries[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Conclusion) > 0 {
+		i -= len(m.Conclusion)
+		copy(dAtA[i:], m.Conclusion)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.Conclusion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.EndTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.EndTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ImmediateCaller) > 0 {
+		i -= len(m.ImmediateCaller)
+		copy(dAtA[i:], m.ImmediateCaller)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.ImmediateCaller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.EffectiveCaller) > 0 {
+		i -= len(m.EffectiveCaller)
+		copy(dAtA[i:], m.EffectiveCaller)
+		i = encodeVarintQuerylog(dAtA, i, uint64(len(m.EffectiveCaller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ConnId != 0 {
+		i = encodeVarintQuerylog(dAtA, i, uint64(m.ConnId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuerylog(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuerylog(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TabletQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.CallInfo)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.ImmediateCaller)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.EffectiveCaller)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.EndTime))
+	}
+	l = len(m.PlanType)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.OriginalSql)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	if len(m.BindVariables) > 0 {
+		for k, v := range m.BindVariables {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuerylog(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuerylog(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuerylog(uint64(mapEntrySize))
+		}
+	}
+	if m.NumberOfQueries != 0 {
+		n += 1 + sovQuerylog(uint64(m.NumberOfQueries))
+	}
+	l = len(m.RewrittenSql)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.QuerySources)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	if m.MysqlTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.MysqlTime))
+	}
+	if m.ConnWaitTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.ConnWaitTime))
+	}
+	if m.RowsAffected != 0 {
+		n += 2 + sovQuerylog(uint64(m.RowsAffected))
+	}
+	if m.ResponseSize != 0 {
+		n += 2 + sovQuerylog(uint64(m.ResponseSize))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovQuerylog(uint64(l))
+	}
+	if m.QueryTimeout != 0 {
+		n += 2 + sovQuerylog(uint64(m.QueryTimeout))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GateQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.ImmediateCaller)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.EffectiveCaller)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.EndTime))
+	}
+	if m.PlanTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.PlanTime))
+	}
+	if m.ExecuteTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.ExecuteTime))
+	}
+	if m.CommitTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.CommitTime))
+	}
+	l = len(m.StmtType)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.Sql)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	if len(m.BindVariables) > 0 {
+		for k, v := range m.BindVariables {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.Size()
+				l += 1 + sovQuerylog(uint64(l))
+			}
+			mapEntrySize := 1 + len(k) + sovQuerylog(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sovQuerylog(uint64(mapEntrySize))
+		}
+	}
+	if m.ShardQueries != 0 {
+		n += 1 + sovQuerylog(uint64(m.ShardQueries))
+	}
+	if m.RowsAffected != 0 {
+		n += 1 + sovQuerylog(uint64(m.RowsAffected))
+	}
+	if m.RowsReturned != 0 {
+		n += 2 + sovQuerylog(uint64(m.RowsReturned))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 2 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.Keyspace)
+	if l > 0 {
+		n += 2 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.Table)
+	if l > 0 {
+		n += 2 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.TabletType)
+	if l > 0 {
+		n += 2 + l + sovQuerylog(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Transaction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConnId != 0 {
+		n += 1 + sovQuerylog(uint64(m.ConnId))
+	}
+	l = len(m.EffectiveCaller)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	l = len(m.ImmediateCaller)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	if m.StartTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.StartTime))
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovQuerylog(uint64(m.EndTime))
+	}
+	l = len(m.Conclusion)
+	if l > 0 {
+		n += 1 + l + sovQuerylog(uint64(l))
+	}
+	if len(m.Queries) > 0 {
+		for _, s := range m.Queries {
+			l = len(s)
+			n += 1 + l + sovQuerylog(uint64(l))
+		}
+	}
+	if m.DroppedQueries != 0 {
+		n += 1 + sovQuerylog(uint64(m.DroppedQueries))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovQuerylog(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuerylog(x uint64) (n int) {
+	return sovQuerylog(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TabletQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerylog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TabletQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TabletQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallInfo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallInfo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateCaller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImmediateCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCaller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindVariables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BindVariables == nil {
+				m.BindVariables = make(map[string]*query.BindVariable)
+			}
+			var mapkey string
+			var mapvalue *query.BindVariable
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuerylog
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuerylog
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuerylog
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &query.BindVariable{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuerylog(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BindVariables[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfQueries", wireType)
+			}
+			m.NumberOfQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfQueries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewrittenSql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewrittenSql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuerySources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuerySources = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MysqlTime", wireType)
+			}
+			m.MysqlTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MysqlTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnWaitTime", wireType)
+			}
+			m.ConnWaitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnWaitTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsAffected", wireType)
+			}
+			m.RowsAffected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsAffected |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResponseSize", wireType)
+			}
+			m.ResponseSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResponseSize |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueryTimeout", wireType)
+			}
+			m.QueryTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueryTimeout |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerylog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GateQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerylog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GateQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateCaller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImmediateCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCaller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanTime", wireType)
+			}
+			m.PlanTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+			}
+			m.ExecuteTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExecuteTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTime", wireType)
+			}
+			m.CommitTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StmtType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StmtType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BindVariables", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BindVariables == nil {
+				m.BindVariables = make(map[string]*query.BindVariable)
+			}
+			var mapkey string
+			var mapvalue *query.BindVariable
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuerylog
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuerylog
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuerylog
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &query.BindVariable{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipQuerylog(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthQuerylog
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.BindVariables[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShardQueries", wireType)
+			}
+			m.ShardQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShardQueries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsAffected", wireType)
+			}
+			m.RowsAffected = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsAffected |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsReturned", wireType)
+			}
+			m.RowsReturned = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsReturned |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Table", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Table = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabletType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TabletType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerylog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transaction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuerylog
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transaction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transaction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnId", wireType)
+			}
+			m.ConnId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnId |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveCaller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EffectiveCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateCaller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ImmediateCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Conclusion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Conclusion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Queries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Queries = append(m.Queries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DroppedQueries", wireType)
+			}
+			m.DroppedQueries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DroppedQueries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuerylog(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthQuerylog
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuerylog(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuerylog
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuerylog
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuerylog
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuerylog
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuerylog
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuerylog        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuerylog          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuerylog = fmt.Errorf("proto: unexpected end of group")
+)
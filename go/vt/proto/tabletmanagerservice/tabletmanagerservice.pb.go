@@ -29,72 +29,76 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("tabletmanagerservice.proto", fileDescriptor_9ee75fe63cfd9360) }
 
 var fileDescriptor_9ee75fe63cfd9360 = []byte{
-	// 1025 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0xdf, 0x6f, 0x1b, 0x45,
-	0x10, 0xc7, 0x6b, 0x89, 0x56, 0x62, 0xf9, 0xbd, 0x20, 0x2a, 0x05, 0xc9, 0x14, 0x9a, 0x42, 0x69,
-	0x21, 0x6e, 0x0b, 0xe5, 0xdd, 0x4d, 0x9b, 0x34, 0x28, 0x11, 0xc6, 0x6e, 0x12, 0x04, 0x12, 0xd2,
-	0xc6, 0x9e, 0xd8, 0x47, 0xce, 0xb7, 0xc7, 0xee, 0xda, 0x22, 0x4f, 0x48, 0xbc, 0x22, 0xf1, 0xcc,
-	0xdf, 0xc2, 0x5f, 0xc0, 0x23, 0x7f, 0x02, 0x0a, 0xff, 0x48, 0x75, 0xe7, 0xdb, 0xbd, 0xd9, 0xbb,
-	0xb9, 0xf5, 0xf9, 0xcd, 0xf2, 0x7c, 0x66, 0xbe, 0xbb, 0x73, 0xb3, 0x33, 0x7b, 0xc7, 0xb6, 0x8c,
-	0x38, 0x8b, 0xc1, 0xcc, 0x45, 0x22, 0xa6, 0xa0, 0x34, 0xa8, 0x65, 0x34, 0x86, 0x9d, 0x54, 0x49,
-	0x23, 0xf9, 0x7b, 0x94, 0x6d, 0xeb, 0xa6, 0xf7, 0xef, 0x44, 0x18, 0xb1, 0xc2, 0x1f, 0xfd, 0xbd,
-	0xcd, 0xde, 0x78, 0x91, 0xdb, 0x8e, 0x56, 0x36, 0x7e, 0xc0, 0x5e, 0x19, 0x44, 0xc9, 0x94, 0x77,
-	0x77, 0xea, 0x3e, 0x99, 0x61, 0x08, 0xbf, 0x2c, 0x40, 0x9b, 0xad, 0x0f, 0x1b, 0xed, 0x3a, 0x95,
-	0x89, 0x86, 0x8f, 0xaf, 0xf1, 0x43, 0x76, 0x7d, 0x14, 0x03, 0xa4, 0x9c, 0x62, 0x73, 0x8b, 0x0d,
-	0x76, 0xab, 0x19, 0x70, 0xd1, 0x7e, 0x62, 0xaf, 0x3d, 0xfb, 0x15, 0xc6, 0x0b, 0x03, 0xcf, 0xa5,
-	0xbc, 0xe0, 0x77, 0x08, 0x17, 0x64, 0xb7, 0x91, 0x3f, 0x59, 0x87, 0xb9, 0xf8, 0xdf, 0xb3, 0x57,
-	0xf7, 0xc1, 0x8c, 0xc6, 0x33, 0x98, 0x0b, 0x7e, 0x9b, 0x70, 0x73, 0x56, 0x1b, 0x7b, 0x3b, 0x0c,
-	0xb9, 0xc8, 0x53, 0xf6, 0xe6, 0x3e, 0x98, 0x01, 0xa8, 0x79, 0xa4, 0x75, 0x24, 0x13, 0xcd, 0xef,
-	0xd2, 0x9e, 0x08, 0xb1, 0x1a, 0x9f, 0xb5, 0x20, 0x71, 0x8a, 0x46, 0x60, 0x86, 0x20, 0x26, 0xdf,
-	0x26, 0xf1, 0x25, 0x99, 0x22, 0x64, 0x0f, 0xa5, 0xc8, 0xc3, 0x5c, 0x7c, 0xc1, 0x5e, 0x2f, 0x0c,
-	0xa7, 0x2a, 0x32, 0xc0, 0x03, 0x9e, 0x39, 0x60, 0x15, 0x3e, 0x5d, 0xcb, 0x39, 0x89, 0x1f, 0x19,
-	0xdb, 0x9d, 0x89, 0x64, 0x0a, 0x2f, 0x2e, 0x53, 0xe0, 0x54, 0x86, 0x4b, 0xb3, 0x0d, 0x7f, 0x67,
-	0x0d, 0x85, 0xd7, 0x3f, 0x84, 0x73, 0x05, 0x7a, 0x36, 0x32, 0xa2, 0x61, 0xfd, 0x18, 0x08, 0xad,
-	0xdf, 0xe7, 0xf0, 0xb3, 0x1e, 0x2e, 0x92, 0xe7, 0x20, 0x62, 0x33, 0xdb, 0x9d, 0xc1, 0xf8, 0x82,
-	0x7c, 0xd6, 0x3e, 0x12, 0x7a, 0xd6, 0x55, 0xd2, 0x09, 0xa5, 0xec, 0x9d, 0x83, 0x69, 0x22, 0x15,
-	0xac, 0xcc, 0xcf, 0x94, 0x92, 0x8a, 0xdf, 0x27, 0x22, 0xd4, 0x28, 0x2b, 0xf7, 0x79, 0x3b, 0xd8,
-	0xcf, 0x5e, 0x2c, 0xc5, 0xa4, 0x38, 0x23, 0x74, 0xf6, 0x4a, 0x20, 0x9c, 0x3d, 0xcc, 0x39, 0x89,
-	0x9f, 0xd9, 0x5b, 0x03, 0x05, 0xe7, 0x71, 0x34, 0x9d, 0xd9, 0x93, 0x48, 0x25, 0xa5, 0xc2, 0x58,
-	0xa1, 0x7b, 0x6d, 0x50, 0x7c, 0x58, 0xfa, 0x69, 0x1a, 0x5f, 0x16, 0x3a, 0x54, 0x11, 0x21, 0x7b,
-	0xe8, 0xb0, 0x78, 0x18, 0xae, 0xe4, 0x43, 0x39, 0xbe, 0xc8, 0xbb, 0xab, 0x26, 0x2b, 0xb9, 0x34,
-	0x87, 0x2a, 0x19, 0x53, 0xf8, 0x59, 0x1c, 0x27, 0x71, 0x19, 0x9e, 0x5a, 0x16, 0x06, 0x42, 0xcf,
-	0xc2, 0xe7, 0x70, 0x81, 0x15, 0x8d, 0x72, 0x0f, 0xcc, 0x78, 0xd6, 0xd7, 0x4f, 0xcf, 0x04, 0x59,
-	0x60, 0x35, 0x2a, 0x54, 0x60, 0x04, 0xec, 0x14, 0x7f, 0x63, 0xef, 0xfb, 0xe6, 0x7e, 0x1c, 0x0f,
-	0x54, 0xb4, 0xd4, 0xfc, 0xc1, 0xda, 0x48, 0x16, 0xb5, 0xda, 0x0f, 0x37, 0xf0, 0x68, 0xde, 0x72,
-	0x3f, 0x4d, 0x5b, 0x6c, 0xb9, 0x9f, 0xa6, 0xed, 0xb7, 0x9c, 0xc3, 0x58, 0x71, 0x08, 0x69, 0x1c,
-	0x8d, 0x85, 0x89, 0x64, 0x92, 0x35, 0x93, 0x85, 0x26, 0x15, 0x6b, 0x54, 0x48, 0x91, 0x80, 0x71,
-	0xe5, 0x1c, 0x09, 0x6d, 0x40, 0x15, 0x62, 0x54, 0xe5, 0x60, 0x20, 0x54, 0x39, 0x3e, 0x87, 0x7b,
-	0xe0, 0xca, 0x32, 0x90, 0x3a, 0xca, 0x16, 0x41, 0xf6, 0x40, 0x1f, 0x09, 0xf5, 0xc0, 0x2a, 0x89,
-	0xdb, 0xc5, 0xa9, 0x88, 0xcc, 0x9e, 0x2c, 0x95, 0x28, 0xff, 0x0a, 0x13, 0x6a, 0x17, 0x35, 0x14,
-	0x6b, 0x8d, 0x8c, 0x4c, 0x51, 0x6a, 0x49, 0xad, 0x0a, 0x13, 0xd2, 0xaa, 0xa1, 0xf8, 0x20, 0x54,
-	0x8c, 0x47, 0x51, 0x12, 0xcd, 0x17, 0x73, 0xf2, 0x20, 0xd0, 0x68, 0xe8, 0x20, 0x34, 0x79, 0xb8,
-	0x05, 0xcc, 0xd9, 0xdb, 0x23, 0x23, 0x94, 0xc1, 0xbb, 0xa5, 0xb7, 0xe0, 0x43, 0x56, 0xf4, 0x7e,
-	0x2b, 0xd6, 0xc9, 0xfd, 0xd1, 0x61, 0x5b, 0x55, 0xf3, 0x71, 0x62, 0xa2, 0xb8, 0x7f, 0x6e, 0x40,
-	0xf1, 0xaf, 0x5a, 0x44, 0x2b, 0x71, 0xbb, 0x86, 0xc7, 0x1b, 0x7a, 0xe1, 0xc1, 0xb0, 0x0f, 0x96,
-	0xd2, 0xe4, 0x60, 0x40, 0xf6, 0xd0, 0x60, 0xf0, 0x30, 0x9c, 0xdc, 0x13, 0xb4, 0x86, 0xac, 0x3d,
-	0x90, 0xc9, 0xad, 0x42, 0xa1, 0xe4, 0xd6, 0x59, 0x5c, 0x4c, 0xd8, 0x5a, 0x56, 0x38, 0x59, 0x4c,
-	0x34, 0x1a, 0x2a, 0xa6, 0x26, 0x0f, 0xbc, 0xdf, 0x21, 0x68, 0x58, 0x5b, 0x4c, 0x55, 0x28, 0xb4,
-	0xdf, 0x3a, 0x8b, 0xe7, 0xee, 0x41, 0x12, 0x99, 0x55, 0xd3, 0x20, 0xe7, 0x6e, 0x69, 0x0e, 0xcd,
-	0x5d, 0x4c, 0xb9, 0xe0, 0xbf, 0x77, 0xd8, 0xcd, 0x81, 0x4c, 0x17, 0x71, 0x7e, 0xeb, 0x4b, 0x85,
-	0x82, 0xc4, 0x7c, 0x23, 0x17, 0x2a, 0x11, 0x31, 0xa7, 0x92, 0xd3, 0xc0, 0x5a, 0xdd, 0x47, 0x9b,
-	0xb8, 0xe0, 0x02, 0xcd, 0x16, 0x57, 0x6c, 0x9f, 0x37, 0x2d, 0xbe, 0xb0, 0x87, 0x0a, 0xd4, 0xc3,
-	0xf0, 0x88, 0x78, 0x0a, 0x73, 0x69, 0xa0, 0xc8, 0x21, 0xe5, 0x89, 0x81, 0xd0, 0x88, 0xf0, 0x39,
-	0x5c, 0x13, 0xc7, 0xc9, 0x44, 0x7a, 0x32, 0xf7, 0xc8, 0xbb, 0x89, 0x0f, 0x85, 0x6a, 0xa2, 0xce,
-	0x3a, 0x39, 0xcd, 0x78, 0xb1, 0xcd, 0x53, 0xa1, 0x07, 0x4a, 0x66, 0xd0, 0x84, 0x07, 0x46, 0x27,
-	0xc2, 0xac, 0xe4, 0x17, 0x2d, 0x69, 0xfc, 0x42, 0x39, 0x02, 0x5b, 0x87, 0xb7, 0xe9, 0x57, 0x20,
-	0x7f, 0x57, 0xdb, 0x61, 0xc8, 0x45, 0x5e, 0xb2, 0x77, 0x4b, 0xe5, 0x21, 0xe8, 0xac, 0xab, 0xc1,
-	0x84, 0x87, 0x57, 0xe8, 0x38, 0xab, 0xb6, 0xd3, 0x16, 0x77, 0xba, 0x7f, 0x76, 0xd8, 0x07, 0x95,
-	0xd9, 0xd1, 0x4f, 0x26, 0xd9, 0x2b, 0xef, 0xea, 0x2e, 0xf1, 0x78, 0xfd, 0xac, 0xc1, 0xbc, 0x5d,
-	0xc8, 0xd7, 0x9b, 0xba, 0xe1, 0x9b, 0x46, 0x91, 0x78, 0x7b, 0x18, 0xee, 0x92, 0xef, 0x00, 0x18,
-	0x09, 0xdd, 0x34, 0xaa, 0xa4, 0x13, 0xfa, 0x8e, 0xdd, 0x78, 0x22, 0xc6, 0x17, 0x8b, 0x94, 0x53,
-	0x9f, 0x2a, 0x56, 0x26, 0x1b, 0xf8, 0xa3, 0x00, 0x61, 0x03, 0x3e, 0xe8, 0x70, 0x95, 0x5d, 0xfd,
-	0xb4, 0x91, 0x0a, 0xf6, 0x94, 0x9c, 0x17, 0xd1, 0x1b, 0x7a, 0x9d, 0x4f, 0x85, 0xaf, 0x7e, 0x35,
-	0x18, 0x69, 0x1e, 0xb2, 0xeb, 0x27, 0xf9, 0xbc, 0xa1, 0xbe, 0xc8, 0x9c, 0xe0, 0x21, 0x73, 0xab,
-	0x19, 0xb0, 0xf1, 0x9e, 0xec, 0xfe, 0x73, 0xd5, 0xed, 0xfc, 0x7b, 0xd5, 0xed, 0xfc, 0x77, 0xd5,
-	0xed, 0xfc, 0xf5, 0x7f, 0xf7, 0xda, 0x0f, 0x0f, 0x97, 0x91, 0x01, 0xad, 0x77, 0x22, 0xd9, 0x5b,
-	0xfd, 0xea, 0x4d, 0x65, 0x6f, 0x69, 0x7a, 0xf9, 0xc7, 0xa6, 0x1e, 0xf5, 0x69, 0xea, 0xec, 0x46,
-	0x6e, 0xfb, 0xf2, 0x65, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8d, 0x51, 0x5d, 0x35, 0xd5, 0x12, 0x00,
-	0x00,
+	// 1103 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x98, 0x5f, 0x6f, 0x1b, 0x45,
+	0x17, 0xc6, 0x6b, 0xe9, 0x6d, 0xa5, 0x77, 0x0a, 0xb4, 0x0c, 0x88, 0x4a, 0x41, 0x32, 0x85, 0xb6,
+	0xb4, 0x34, 0x10, 0xb7, 0x85, 0x72, 0xef, 0xa6, 0x4d, 0x1a, 0x48, 0x84, 0x6b, 0xe7, 0x0f, 0x02,
+	0xa9, 0xd2, 0xc4, 0x3e, 0xb1, 0x07, 0xaf, 0x77, 0x96, 0x99, 0xb1, 0x21, 0x57, 0x48, 0xdc, 0x22,
+	0x71, 0xcd, 0x47, 0x42, 0xe2, 0x86, 0x8f, 0x80, 0xc2, 0x17, 0x41, 0x6b, 0xef, 0xcc, 0x9e, 0xdd,
+	0x3d, 0x3b, 0xde, 0xdc, 0x45, 0x79, 0x7e, 0x73, 0x9e, 0x99, 0xb3, 0x67, 0xcf, 0x19, 0x2f, 0xdb,
+	0xb0, 0xe2, 0x34, 0x02, 0x3b, 0x13, 0xb1, 0x18, 0x83, 0x36, 0xa0, 0x17, 0x72, 0x08, 0x5b, 0x89,
+	0x56, 0x56, 0xf1, 0x77, 0x29, 0x6d, 0xe3, 0x56, 0xe1, 0xbf, 0x23, 0x61, 0xc5, 0x0a, 0x7f, 0xf2,
+	0xd7, 0x7d, 0xf6, 0xe6, 0xe1, 0x52, 0x3b, 0x58, 0x69, 0x7c, 0x8f, 0xfd, 0xaf, 0x27, 0xe3, 0x31,
+	0x6f, 0x6f, 0x55, 0xd7, 0xa4, 0x42, 0x1f, 0x7e, 0x9c, 0x83, 0xb1, 0x1b, 0x1f, 0xd4, 0xea, 0x26,
+	0x51, 0xb1, 0x81, 0x8f, 0xae, 0xf0, 0x7d, 0x76, 0x75, 0x10, 0x01, 0x24, 0x9c, 0x62, 0x97, 0x8a,
+	0x0b, 0x76, 0xbb, 0x1e, 0xf0, 0xd1, 0x5e, 0xb3, 0xeb, 0x2f, 0x7e, 0x86, 0xe1, 0xdc, 0xc2, 0x4b,
+	0xa5, 0xa6, 0xfc, 0x1e, 0xb1, 0x04, 0xe9, 0x2e, 0xf2, 0xc7, 0xeb, 0x30, 0x1f, 0xff, 0x5b, 0xf6,
+	0xff, 0x5d, 0xb0, 0x83, 0xe1, 0x04, 0x66, 0x82, 0xdf, 0x21, 0x96, 0x79, 0xd5, 0xc5, 0xbe, 0x1b,
+	0x86, 0x7c, 0xe4, 0x31, 0x7b, 0x6b, 0x17, 0x6c, 0x0f, 0xf4, 0x4c, 0x1a, 0x23, 0x55, 0x6c, 0xf8,
+	0x03, 0x7a, 0x25, 0x42, 0x9c, 0xc7, 0x27, 0x0d, 0x48, 0x9c, 0xa2, 0x01, 0xd8, 0x3e, 0x88, 0xd1,
+	0x37, 0x71, 0x74, 0x4e, 0xa6, 0x08, 0xe9, 0xa1, 0x14, 0x15, 0x30, 0x1f, 0x5f, 0xb0, 0x37, 0x32,
+	0xe1, 0x44, 0x4b, 0x0b, 0x3c, 0xb0, 0x72, 0x09, 0x38, 0x87, 0xfb, 0x6b, 0x39, 0x6f, 0xf1, 0x3d,
+	0x63, 0xdb, 0x13, 0x11, 0x8f, 0xe1, 0xf0, 0x3c, 0x01, 0x4e, 0x65, 0x38, 0x97, 0x5d, 0xf8, 0x7b,
+	0x6b, 0x28, 0xbc, 0xff, 0x3e, 0x9c, 0x69, 0x30, 0x93, 0x81, 0x15, 0x35, 0xfb, 0xc7, 0x40, 0x68,
+	0xff, 0x45, 0x0e, 0x3f, 0xeb, 0xfe, 0x3c, 0x7e, 0x09, 0x22, 0xb2, 0x93, 0xed, 0x09, 0x0c, 0xa7,
+	0xe4, 0xb3, 0x2e, 0x22, 0xa1, 0x67, 0x5d, 0x26, 0xbd, 0x51, 0xc2, 0xde, 0xde, 0x1b, 0xc7, 0x4a,
+	0xc3, 0x4a, 0x7e, 0xa1, 0xb5, 0xd2, 0x7c, 0x93, 0x88, 0x50, 0xa1, 0x9c, 0xdd, 0xa7, 0xcd, 0xe0,
+	0x62, 0xf6, 0x22, 0x25, 0x46, 0xd9, 0x3b, 0x42, 0x67, 0x2f, 0x07, 0xc2, 0xd9, 0xc3, 0x9c, 0xb7,
+	0xf8, 0x81, 0xdd, 0xe8, 0x69, 0x38, 0x8b, 0xe4, 0x78, 0xe2, 0xde, 0x44, 0x2a, 0x29, 0x25, 0xc6,
+	0x19, 0x3d, 0x6c, 0x82, 0xe2, 0x97, 0xa5, 0x9b, 0x24, 0xd1, 0x79, 0xe6, 0x43, 0x15, 0x11, 0xd2,
+	0x43, 0x2f, 0x4b, 0x01, 0xc3, 0x95, 0xbc, 0xaf, 0x86, 0xd3, 0x65, 0x77, 0x35, 0x64, 0x25, 0xe7,
+	0x72, 0xa8, 0x92, 0x31, 0x85, 0x9f, 0xc5, 0x51, 0x1c, 0xe5, 0xe1, 0xa9, 0x6d, 0x61, 0x20, 0xf4,
+	0x2c, 0x8a, 0x1c, 0x2e, 0xb0, 0xac, 0x51, 0xee, 0x80, 0x1d, 0x4e, 0xba, 0xe6, 0xf9, 0xa9, 0x20,
+	0x0b, 0xac, 0x42, 0x85, 0x0a, 0x8c, 0x80, 0xbd, 0xe3, 0x2f, 0xec, 0xbd, 0xa2, 0xdc, 0x8d, 0xa2,
+	0x9e, 0x96, 0x0b, 0xc3, 0x1f, 0xad, 0x8d, 0xe4, 0x50, 0xe7, 0xfd, 0xf8, 0x12, 0x2b, 0xea, 0x8f,
+	0xdc, 0x4d, 0x92, 0x06, 0x47, 0xee, 0x26, 0x49, 0xf3, 0x23, 0x2f, 0x61, 0xec, 0xd8, 0x87, 0x24,
+	0x92, 0x43, 0x61, 0xa5, 0x8a, 0x07, 0x56, 0xd8, 0xb9, 0x21, 0x1d, 0x2b, 0x54, 0xc8, 0x91, 0x80,
+	0x71, 0xe5, 0x1c, 0x08, 0x63, 0x41, 0x67, 0x66, 0x54, 0xe5, 0x60, 0x20, 0x54, 0x39, 0x45, 0x0e,
+	0xf7, 0xc0, 0x95, 0xd2, 0x53, 0x46, 0xa6, 0x9b, 0x20, 0x7b, 0x60, 0x11, 0x09, 0xf5, 0xc0, 0x32,
+	0x89, 0xdb, 0xc5, 0x89, 0x90, 0x76, 0x47, 0xe5, 0x4e, 0xd4, 0xfa, 0x12, 0x13, 0x6a, 0x17, 0x15,
+	0x14, 0x7b, 0x0d, 0xac, 0x4a, 0x50, 0x6a, 0x49, 0xaf, 0x12, 0x13, 0xf2, 0xaa, 0xa0, 0xf8, 0x45,
+	0x28, 0x89, 0x07, 0x32, 0x96, 0xb3, 0xf9, 0x8c, 0x7c, 0x11, 0x68, 0x34, 0xf4, 0x22, 0xd4, 0xad,
+	0xf0, 0x1b, 0x98, 0xb1, 0x9b, 0x03, 0x2b, 0xb4, 0xc5, 0xa7, 0xa5, 0x8f, 0x50, 0x84, 0x9c, 0xe9,
+	0x66, 0x23, 0xd6, 0xdb, 0xfd, 0xd6, 0x62, 0x1b, 0x65, 0xf9, 0x28, 0xb6, 0x32, 0xea, 0x9e, 0x59,
+	0xd0, 0xfc, 0x8b, 0x06, 0xd1, 0x72, 0xdc, 0xed, 0xe1, 0xe9, 0x25, 0x57, 0xe1, 0xc1, 0xb0, 0x0b,
+	0x8e, 0x32, 0xe4, 0x60, 0x40, 0x7a, 0x68, 0x30, 0x14, 0x30, 0x9c, 0xdc, 0x63, 0xb4, 0x87, 0xb4,
+	0x3d, 0x90, 0xc9, 0x2d, 0x43, 0xa1, 0xe4, 0x56, 0x59, 0x5c, 0x4c, 0x58, 0xcd, 0x2b, 0x9c, 0x2c,
+	0x26, 0x1a, 0x0d, 0x15, 0x53, 0xdd, 0x0a, 0x7c, 0xde, 0x3e, 0x18, 0x58, 0x5b, 0x4c, 0x65, 0x28,
+	0x74, 0xde, 0x2a, 0x8b, 0xe7, 0xee, 0x5e, 0x2c, 0xed, 0xaa, 0x69, 0x90, 0x73, 0x37, 0x97, 0x43,
+	0x73, 0x17, 0x53, 0x3e, 0xf8, 0xaf, 0x2d, 0x76, 0xab, 0xa7, 0x92, 0x79, 0x24, 0x2c, 0xf4, 0x21,
+	0x11, 0x1a, 0x62, 0xfb, 0x95, 0x9a, 0xeb, 0x58, 0x44, 0x9c, 0x4a, 0x4e, 0x0d, 0xeb, 0x7c, 0x9f,
+	0x5c, 0x66, 0x09, 0x2e, 0xd0, 0x74, 0x73, 0xd9, 0xf1, 0x79, 0xdd, 0xe6, 0x33, 0x3d, 0x54, 0xa0,
+	0x05, 0x0c, 0x8f, 0x88, 0xe7, 0x30, 0x53, 0x16, 0xb2, 0x1c, 0x52, 0x2b, 0x31, 0x10, 0x1a, 0x11,
+	0x45, 0x0e, 0xd7, 0xc4, 0x51, 0x3c, 0x52, 0x05, 0x9b, 0x87, 0xe4, 0xdd, 0x64, 0xa4, 0x28, 0xab,
+	0xcd, 0x46, 0xac, 0xb7, 0x33, 0x8c, 0x67, 0xc7, 0x3c, 0x11, 0xa6, 0xa7, 0x55, 0x0a, 0x8d, 0x78,
+	0x60, 0x74, 0x22, 0xcc, 0x59, 0x7e, 0xd6, 0x90, 0xc6, 0x3f, 0x28, 0x07, 0xe0, 0xea, 0xf0, 0x0e,
+	0xfd, 0x13, 0xa8, 0x78, 0xaa, 0xbb, 0x61, 0xc8, 0x47, 0x5e, 0xb0, 0x77, 0x72, 0xe7, 0x3e, 0x18,
+	0x2b, 0x74, 0x7a, 0x9e, 0xf0, 0x0e, 0x3d, 0xe7, 0xdc, 0xb6, 0x9a, 0xe2, 0xde, 0xf7, 0xf7, 0x16,
+	0x7b, 0xbf, 0x34, 0x3b, 0xba, 0xf1, 0x28, 0xfd, 0xc9, 0xbb, 0xba, 0x4b, 0x3c, 0x5d, 0x3f, 0x6b,
+	0x30, 0xef, 0x36, 0xf2, 0xe5, 0x65, 0x97, 0xe1, 0x9b, 0x46, 0x96, 0x78, 0xf7, 0x32, 0x3c, 0x20,
+	0x7f, 0x03, 0x60, 0x24, 0x74, 0xd3, 0x28, 0x93, 0xde, 0xe8, 0x15, 0xbb, 0xf6, 0x4c, 0x0c, 0xa7,
+	0xf3, 0x84, 0x53, 0x9f, 0x2a, 0x56, 0x92, 0x0b, 0xfc, 0x61, 0x80, 0x70, 0x01, 0x1f, 0xb5, 0xb8,
+	0x4e, 0xaf, 0x7e, 0xc6, 0x2a, 0x0d, 0x3b, 0x5a, 0xcd, 0xb2, 0xe8, 0x35, 0xbd, 0xae, 0x48, 0x85,
+	0xaf, 0x7e, 0x15, 0x18, 0x79, 0xee, 0xb3, 0xab, 0xc7, 0xcb, 0x79, 0x43, 0x7d, 0x91, 0x39, 0xc6,
+	0x43, 0xe6, 0x76, 0x3d, 0xe0, 0x93, 0x32, 0x65, 0x37, 0x07, 0x13, 0xf5, 0xd3, 0xa1, 0x16, 0xb1,
+	0x11, 0x43, 0xbb, 0xfc, 0xb2, 0x41, 0xde, 0x12, 0x4a, 0x50, 0xf0, 0x96, 0x50, 0x61, 0x57, 0x76,
+	0x7c, 0xc2, 0x6e, 0x7c, 0x2d, 0xa3, 0x08, 0x69, 0xe4, 0xfd, 0xab, 0xc4, 0x84, 0xee, 0x5f, 0x15,
+	0x34, 0x73, 0x7a, 0xcd, 0xae, 0xef, 0xcb, 0x05, 0xbc, 0x9a, 0x83, 0x96, 0x40, 0xcf, 0x7f, 0xa4,
+	0x87, 0xda, 0x6b, 0x01, 0xc3, 0x7d, 0x21, 0xb5, 0x4e, 0x85, 0x73, 0xb2, 0x2f, 0x78, 0x35, 0xd4,
+	0x17, 0x10, 0xe4, 0x22, 0x3f, 0xdb, 0xfe, 0xf3, 0xa2, 0xdd, 0xfa, 0xfb, 0xa2, 0xdd, 0xfa, 0xe7,
+	0xa2, 0xdd, 0xfa, 0xe3, 0xdf, 0xf6, 0x95, 0xef, 0x1e, 0x2f, 0xa4, 0x05, 0x63, 0xb6, 0xa4, 0xea,
+	0xac, 0xfe, 0xea, 0x8c, 0x55, 0x67, 0x61, 0x3b, 0xcb, 0xaf, 0x7f, 0x1d, 0xea, 0x5b, 0xe1, 0xe9,
+	0xb5, 0xa5, 0xf6, 0xf9, 0x7f, 0x03, 0x00, 0x6d, 0xb0, 0x08, 0xc4, 0x66, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -186,6 +190,14 @@ type TabletManagerClient interface {
 	RestoreFromBackup(ctx context.Context, in *tabletmanagerdata.RestoreFromBackupRequest, opts ...grpc.CallOption) (TabletManager_RestoreFromBackupClient, error)
 	// Generic VExec request. Can be used for various purposes
 	VExec(ctx context.Context, in *tabletmanagerdata.VExecRequest, opts ...grpc.CallOption) (*tabletmanagerdata.VExecResponse, error)
+	// ShowTransactions returns the transactions open on the tablet
+	ShowTransactions(ctx context.Context, in *tabletmanagerdata.ShowTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ShowTransactionsResponse, error)
+	// KillTransaction rolls back a transaction open on the tablet
+	KillTransaction(ctx context.Context, in *tabletmanagerdata.KillTransactionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillTransactionResponse, error)
+	// LiveQueries returns the queries executing on the tablet
+	LiveQueries(ctx context.Context, in *tabletmanagerdata.LiveQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.LiveQueriesResponse, error)
+	// KillQuery kills the query executing on a MySQL connection of the tablet
+	KillQuery(ctx context.Context, in *tabletmanagerdata.KillQueryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillQueryResponse, error)
 }
 
 type tabletManagerClient struct {
@@ -638,6 +650,42 @@ func (c *tabletManagerClient) VExec(ctx context.Context, in *tabletmanagerdata.V
 	return out, nil
 }
 
+func (c *tabletManagerClient) ShowTransactions(ctx context.Context, in *tabletmanagerdata.ShowTransactionsRequest, opts ...grpc.CallOption) (*tabletmanagerdata.ShowTransactionsResponse, error) {
+	out := new(tabletmanagerdata.ShowTransactionsResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/ShowTransactions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) KillTransaction(ctx context.Context, in *tabletmanagerdata.KillTransactionRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillTransactionResponse, error) {
+	out := new(tabletmanagerdata.KillTransactionResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/KillTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) LiveQueries(ctx context.Context, in *tabletmanagerdata.LiveQueriesRequest, opts ...grpc.CallOption) (*tabletmanagerdata.LiveQueriesResponse, error) {
+	out := new(tabletmanagerdata.LiveQueriesResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/LiveQueries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tabletManagerClient) KillQuery(ctx context.Context, in *tabletmanagerdata.KillQueryRequest, opts ...grpc.CallOption) (*tabletmanagerdata.KillQueryResponse, error) {
+	out := new(tabletmanagerdata.KillQueryResponse)
+	err := c.cc.Invoke(ctx, "/tabletmanagerservice.TabletManager/KillQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TabletManagerServer is the server API for TabletManager service.
 type TabletManagerServer interface {
 	// Ping returns the input payload
@@ -717,6 +765,14 @@ type TabletManagerServer interface {
 	RestoreFromBackup(*tabletmanagerdata.RestoreFromBackupRequest, TabletManager_RestoreFromBackupServer) error
 	// Generic VExec request. Can be used for various purposes
 	VExec(context.Context, *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error)
+	// ShowTransactions returns the transactions open on the tablet
+	ShowTransactions(context.Context, *tabletmanagerdata.ShowTransactionsRequest) (*tabletmanagerdata.ShowTransactionsResponse, error)
+	// KillTransaction rolls back a transaction open on the tablet
+	KillTransaction(context.Context, *tabletmanagerdata.KillTransactionRequest) (*tabletmanagerdata.KillTransactionResponse, error)
+	// LiveQueries returns the queries executing on the tablet
+	LiveQueries(context.Context, *tabletmanagerdata.LiveQueriesRequest) (*tabletmanagerdata.LiveQueriesResponse, error)
+	// KillQuery kills the query executing on a MySQL connection of the tablet
+	KillQuery(context.Context, *tabletmanagerdata.KillQueryRequest) (*tabletmanagerdata.KillQueryResponse, error)
 }
 
 // UnimplementedTabletManagerServer can be embedded to have forward compatible implementations.
@@ -855,6 +911,18 @@ func (*UnimplementedTabletManagerServer) RestoreFromBackup(req *tabletmanagerdat
 func (*UnimplementedTabletManagerServer) VExec(ctx context.Context, req *tabletmanagerdata.VExecRequest) (*tabletmanagerdata.VExecResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VExec not implemented")
 }
+func (*UnimplementedTabletManagerServer) ShowTransactions(ctx context.Context, req *tabletmanagerdata.ShowTransactionsRequest) (*tabletmanagerdata.ShowTransactionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShowTransactions not implemented")
+}
+func (*UnimplementedTabletManagerServer) KillTransaction(ctx context.Context, req *tabletmanagerdata.KillTransactionRequest) (*tabletmanagerdata.KillTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillTransaction not implemented")
+}
+func (*UnimplementedTabletManagerServer) LiveQueries(ctx context.Context, req *tabletmanagerdata.LiveQueriesRequest) (*tabletmanagerdata.LiveQueriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiveQueries not implemented")
+}
+func (*UnimplementedTabletManagerServer) KillQuery(ctx context.Context, req *tabletmanagerdata.KillQueryRequest) (*tabletmanagerdata.KillQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillQuery not implemented")
+}
 
 func RegisterTabletManagerServer(s *grpc.Server, srv TabletManagerServer) {
 	s.RegisterService(&_TabletManager_serviceDesc, srv)
@@ -1658,6 +1726,78 @@ func _TabletManager_VExec_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TabletManager_ShowTransactions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.ShowTransactionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).ShowTransactions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/ShowTransactions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).ShowTransactions(ctx, req.(*tabletmanagerdata.ShowTransactionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_KillTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.KillTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).KillTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/KillTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).KillTransaction(ctx, req.(*tabletmanagerdata.KillTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_LiveQueries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.LiveQueriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).LiveQueries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/LiveQueries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).LiveQueries(ctx, req.(*tabletmanagerdata.LiveQueriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TabletManager_KillQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(tabletmanagerdata.KillQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TabletManagerServer).KillQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabletmanagerservice.TabletManager/KillQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TabletManagerServer).KillQuery(ctx, req.(*tabletmanagerdata.KillQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _TabletManager_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabletmanagerservice.TabletManager",
 	HandlerType: (*TabletManagerServer)(nil),
@@ -1830,6 +1970,22 @@ var _TabletManager_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VExec",
 			Handler:    _TabletManager_VExec_Handler,
 		},
+		{
+			MethodName: "ShowTransactions",
+			Handler:    _TabletManager_ShowTransactions_Handler,
+		},
+		{
+			MethodName: "KillTransaction",
+			Handler:    _TabletManager_KillTransaction_Handler,
+		},
+		{
+			MethodName: "LiveQueries",
+			Handler:    _TabletManager_LiveQueries_Handler,
+		},
+		{
+			MethodName: "KillQuery",
+			Handler:    _TabletManager_KillQuery_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -77,8 +77,19 @@ func (m *RoutingRules) GetRules() []*RoutingRule {
 
 // RoutingRule specifies a routing rule.
 type RoutingRule struct {
-	FromTable            string   `protobuf:"bytes,1,opt,name=from_table,json=fromTable,proto3" json:"from_table,omitempty"`
-	ToTables             []string `protobuf:"bytes,2,rep,name=to_tables,json=toTables,proto3" json:"to_tables,omitempty"`
+	// from_table and to_tables can also be keyspaces, written ks.*, to route
+	// the tables of a keyspace that no rule of their own routes. Like the
+	// tables, ks.*@replica only routes the queries of the replica tablets.
+	FromTable string   `protobuf:"bytes,1,opt,name=from_table,json=fromTable,proto3" json:"from_table,omitempty"`
+	ToTables  []string `protobuf:"bytes,2,rep,name=to_tables,json=toTables,proto3" json:"to_tables,omitempty"`
+	// shift_to_table receives shift_percent percents of the sessions instead
+	// of to_tables, to cut the traffic over gradually, to a table of another
+	// keyspace, or another keyspace for the keyspace routes. The sessions are
+	// picked by their UUID, so that they keep using the same target, and a
+	// transaction keeps using the keyspace it already used. The sessions
+	// without a UUID, like those of the gRPC clients, are never shifted.
+	ShiftToTable         string   `protobuf:"bytes,3,opt,name=shift_to_table,json=shiftToTable,proto3" json:"shift_to_table,omitempty"`
+	ShiftPercent         uint32   `protobuf:"varint,4,opt,name=shift_percent,json=shiftPercent,proto3" json:"shift_percent,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -131,6 +142,20 @@ func (m *RoutingRule) GetToTables() []string {
 	return nil
 }
 
+func (m *RoutingRule) GetShiftToTable() string {
+	if m != nil {
+		return m.ShiftToTable
+	}
+	return ""
+}
+
+func (m *RoutingRule) GetShiftPercent() uint32 {
+	if m != nil {
+		return m.ShiftPercent
+	}
+	return 0
+}
+
 // Keyspace is the vschema for a keyspace.
 type Keyspace struct {
 	// If sharded is false, vindexes and tables are ignored.
@@ -305,10 +330,19 @@ type Table struct {
 	// column_list_authoritative is set to true if columns is
 	// an authoritative list for the table. This allows
 	// us to expand 'select *' expressions.
-	ColumnListAuthoritative bool     `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
-	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
-	XXX_unrecognized        []byte   `json:"-"`
-	XXX_sizecache           int32    `json:"-"`
+	ColumnListAuthoritative bool `protobuf:"varint,6,opt,name=column_list_authoritative,json=columnListAuthoritative,proto3" json:"column_list_authoritative,omitempty"`
+	// read_tablet_type, if set, is the tablet type the reads of the
+	// table go to by default, like "replica". It does not apply to the
+	// sessions whose target names a tablet type, or that are in a
+	// transaction.
+	ReadTabletType string `protobuf:"bytes,7,opt,name=read_tablet_type,json=readTabletType,proto3" json:"read_tablet_type,omitempty"`
+	// source is the fully qualified name of the table that a reference
+	// table is materialized from, like "global.t". The writes of the
+	// table are routed to its source.
+	Source               string   `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Table) Reset()         { *m = Table{} }
@@ -386,6 +420,20 @@ func (m *Table) GetColumnListAuthoritative() bool {
 	return false
 }
 
+func (m *Table) GetReadTabletType() string {
+	if m != nil {
+		return m.ReadTabletType
+	}
+	return ""
+}
+
+func (m *Table) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
 // ColumnVindex is used to associate a column to a vindex.
 type ColumnVindex struct {
 	// Legacy implementation, moving forward all vindexes should define a list of columns.
@@ -642,51 +690,55 @@ func init() {
 func init() { proto.RegisterFile("vschema.proto", fileDescriptor_3f6849254fea3e77) }
 
 var fileDescriptor_3f6849254fea3e77 = []byte{
-	// 695 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0xc1, 0x4e, 0xdb, 0x4c,
-	0x10, 0xfe, 0x9d, 0x90, 0x90, 0x8c, 0x49, 0xf8, 0xbb, 0x02, 0xea, 0x06, 0x11, 0x22, 0x8b, 0xaa,
-	0x69, 0x0f, 0x89, 0x14, 0xd4, 0x8a, 0xa6, 0xa2, 0x2a, 0x45, 0x1c, 0x50, 0x91, 0x5a, 0x19, 0xc4,
-	0xa1, 0x17, 0xcb, 0x38, 0x5b, 0x58, 0x91, 0x78, 0xcd, 0xee, 0xda, 0x25, 0x6f, 0xd2, 0x5e, 0xfb,
-	0x34, 0x3d, 0xf6, 0xde, 0x4b, 0x45, 0x8f, 0x7d, 0x89, 0xca, 0xbb, 0x6b, 0xb3, 0x81, 0xf4, 0xb6,
-	0xdf, 0xce, 0xcc, 0x37, 0xdf, 0xce, 0xce, 0x0c, 0x34, 0x52, 0x1e, 0x5e, 0xe0, 0x49, 0xd0, 0x8b,
-	0x19, 0x15, 0x14, 0x2d, 0x6a, 0xd8, 0xb2, 0xaf, 0x12, 0xcc, 0xa6, 0xea, 0xd6, 0x1d, 0xc2, 0x92,
-	0x47, 0x13, 0x41, 0xa2, 0x73, 0x2f, 0x19, 0x63, 0x8e, 0x9e, 0x41, 0x85, 0x65, 0x07, 0xc7, 0xea,
-	0x94, 0xbb, 0xf6, 0x60, 0xa5, 0x97, 0x93, 0x18, 0x5e, 0x9e, 0x72, 0x71, 0x0f, 0xc1, 0x36, 0x6e,
-	0xd1, 0x06, 0xc0, 0x27, 0x46, 0x27, 0xbe, 0x08, 0xce, 0xc6, 0xd8, 0xb1, 0x3a, 0x56, 0xb7, 0xee,
-	0xd5, 0xb3, 0x9b, 0x93, 0xec, 0x02, 0xad, 0x43, 0x5d, 0x50, 0x65, 0xe4, 0x4e, 0xa9, 0x53, 0xee,
-	0xd6, 0xbd, 0x9a, 0xa0, 0xd2, 0xc6, 0xdd, 0x3f, 0x25, 0xa8, 0xbd, 0xc3, 0x53, 0x1e, 0x07, 0x21,
-	0x46, 0x0e, 0x2c, 0xf2, 0x8b, 0x80, 0x8d, 0xf0, 0x48, 0xb2, 0xd4, 0xbc, 0x1c, 0xa2, 0x57, 0x50,
-	0x4b, 0x49, 0x34, 0xc2, 0xd7, 0x9a, 0xc2, 0x1e, 0x6c, 0x16, 0x02, 0xf3, 0xf0, 0xde, 0xa9, 0xf6,
-	0x38, 0x88, 0x04, 0x9b, 0x7a, 0x45, 0x00, 0x7a, 0x0e, 0x55, 0x9d, 0xbd, 0x2c, 0x43, 0x37, 0xee,
-	0x87, 0x2a, 0x35, 0x2a, 0x50, 0x3b, 0xa3, 0x1d, 0x70, 0x18, 0xbe, 0x4a, 0x08, 0xc3, 0x3e, 0xbe,
-	0x8e, 0xc7, 0x24, 0x24, 0xc2, 0x67, 0xea, 0xd9, 0xce, 0x82, 0x94, 0xb7, 0xa6, 0xed, 0x07, 0xda,
-	0xac, 0x8b, 0xd2, 0x3a, 0x82, 0xc6, 0x8c, 0x16, 0xf4, 0x3f, 0x94, 0x2f, 0xf1, 0x54, 0x97, 0x26,
-	0x3b, 0xa2, 0xc7, 0x50, 0x49, 0x83, 0x71, 0x82, 0x9d, 0x52, 0xc7, 0xea, 0xda, 0x83, 0xe5, 0x42,
-	0x92, 0x0a, 0xf4, 0x94, 0x75, 0x58, 0xda, 0xb1, 0x5a, 0x87, 0x60, 0x1b, 0xf2, 0xe6, 0x70, 0x6d,
-	0xcd, 0x72, 0x35, 0x0b, 0x2e, 0x19, 0x66, 0x50, 0xb9, 0xdf, 0x2c, 0xa8, 0xaa, 0x04, 0x08, 0xc1,
-	0x82, 0x98, 0xc6, 0xf9, 0x77, 0xc9, 0x33, 0xda, 0x86, 0x6a, 0x1c, 0xb0, 0x60, 0x92, 0xd7, 0x78,
-	0xfd, 0x8e, 0xaa, 0xde, 0x07, 0x69, 0xd5, 0x65, 0x52, 0xae, 0x68, 0x05, 0x2a, 0xf4, 0x73, 0x84,
-	0x99, 0x53, 0x96, 0x4c, 0x0a, 0xb4, 0x5e, 0x82, 0x6d, 0x38, 0xcf, 0x11, 0xbd, 0x62, 0x8a, 0xae,
-	0x9b, 0x22, 0xbf, 0x96, 0xa0, 0xa2, 0x3a, 0x67, 0x9e, 0xc6, 0xd7, 0xb0, 0x1c, 0xd2, 0x71, 0x32,
-	0x89, 0xfc, 0x3b, 0x0d, 0xb1, 0x5a, 0x88, 0xdd, 0x97, 0x76, 0x5d, 0xc8, 0x66, 0x68, 0x20, 0xcc,
-	0xd1, 0x2e, 0x34, 0x83, 0x44, 0x50, 0x9f, 0x44, 0x21, 0xc3, 0x13, 0x1c, 0x09, 0xa9, 0xdb, 0x1e,
-	0xac, 0x15, 0xe1, 0x7b, 0x89, 0xa0, 0x87, 0xb9, 0xd5, 0x6b, 0x04, 0x26, 0x44, 0x4f, 0x61, 0x51,
-	0x11, 0x72, 0x67, 0x41, 0xa6, 0x5d, 0xbe, 0x93, 0xd6, 0xcb, 0xed, 0x68, 0x0d, 0xaa, 0x31, 0x89,
-	0x22, 0x3c, 0x72, 0x2a, 0x52, 0xbf, 0x46, 0x68, 0x08, 0x8f, 0xf4, 0x0b, 0xc6, 0x84, 0x0b, 0x3f,
-	0x48, 0xc4, 0x05, 0x65, 0x44, 0x04, 0x82, 0xa4, 0xd8, 0xa9, 0xca, 0xc6, 0x7a, 0xa8, 0x1c, 0x8e,
-	0x08, 0x17, 0x7b, 0xa6, 0xd9, 0x3d, 0x81, 0x25, 0xf3, 0x75, 0x59, 0x0e, 0xe5, 0xaa, 0x6b, 0xa4,
-	0x51, 0x56, 0xb9, 0x28, 0x98, 0xe4, 0xc5, 0x95, 0xe7, 0x6c, 0xba, 0x72, 0xe9, 0x65, 0x39, 0x85,
-	0x39, 0x74, 0xf7, 0xa1, 0x31, 0xf3, 0xe8, 0x7f, 0xd2, 0xb6, 0xa0, 0xc6, 0xf1, 0x55, 0x82, 0xa3,
-	0x30, 0xa7, 0x2e, 0xb0, 0xbb, 0x0b, 0xd5, 0xfd, 0xd9, 0xe4, 0x96, 0x91, 0x7c, 0x53, 0x7f, 0x65,
-	0x16, 0xd5, 0x1c, 0xd8, 0x3d, 0xb5, 0x8a, 0x4e, 0xa6, 0x31, 0x56, 0xff, 0xea, 0xfe, 0xb4, 0x00,
-	0x8e, 0x59, 0x7a, 0x7a, 0x2c, 0x8b, 0x89, 0xde, 0x40, 0xfd, 0x52, 0x0f, 0x67, 0xbe, 0x92, 0xdc,
-	0xa2, 0xd2, 0xb7, 0x7e, 0xc5, 0x04, 0xeb, 0xa6, 0xbc, 0x0d, 0x42, 0x43, 0x68, 0xe8, 0x69, 0xf5,
-	0xd5, 0x62, 0x53, 0xd3, 0xb1, 0x3a, 0x6f, 0xb1, 0x71, 0x6f, 0x89, 0x19, 0xa8, 0xf5, 0x1e, 0x9a,
-	0xb3, 0xc4, 0x73, 0x1a, 0xf8, 0xc9, 0xec, 0xd4, 0x3d, 0xb8, 0xb7, 0x54, 0x8c, 0x9e, 0x7e, 0xfb,
-	0xe2, 0xfb, 0x4d, 0xdb, 0xfa, 0x71, 0xd3, 0xb6, 0x7e, 0xdd, 0xb4, 0xad, 0x2f, 0xbf, 0xdb, 0xff,
-	0x7d, 0xdc, 0x4a, 0x89, 0xc0, 0x9c, 0xf7, 0x08, 0xed, 0xab, 0x53, 0xff, 0x9c, 0xf6, 0x53, 0xd1,
-	0x97, 0xdb, 0xb9, 0xaf, 0xb9, 0xce, 0xaa, 0x12, 0x6e, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x39, 0x77, 0x25, 0xd3, 0x05, 0x00, 0x00,
+	// 763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x55, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xae, 0x63, 0xe2, 0x24, 0xe3, 0x24, 0xd0, 0x15, 0xa4, 0x6e, 0x10, 0x21, 0x72, 0xa9, 0x9a,
+	0xf6, 0x90, 0x48, 0x41, 0xad, 0x68, 0x2a, 0xaa, 0x52, 0xc4, 0x01, 0x15, 0xa9, 0xc8, 0x44, 0x1c,
+	0x7a, 0xb1, 0x8c, 0xb3, 0x10, 0x8b, 0xc4, 0x0e, 0xbb, 0xeb, 0x94, 0xbc, 0x47, 0x0f, 0x3d, 0xf7,
+	0x69, 0x7a, 0xec, 0x9d, 0x4b, 0x45, 0x8f, 0x7d, 0x89, 0xca, 0xbb, 0x6b, 0xb3, 0x81, 0xf4, 0xe6,
+	0x99, 0xf9, 0xe6, 0xef, 0xdb, 0x99, 0x31, 0x54, 0xa6, 0xd4, 0x1f, 0xe2, 0xb1, 0xd7, 0x9e, 0x90,
+	0x88, 0x45, 0xa8, 0x20, 0xc5, 0xba, 0x79, 0x15, 0x63, 0x32, 0x13, 0x5a, 0xbb, 0x07, 0x65, 0x27,
+	0x8a, 0x59, 0x10, 0x5e, 0x38, 0xf1, 0x08, 0x53, 0xf4, 0x0a, 0xf2, 0x24, 0xf9, 0xb0, 0xb4, 0xa6,
+	0xde, 0x32, 0xbb, 0xab, 0xed, 0x34, 0x88, 0x82, 0x72, 0x04, 0xc4, 0xfe, 0xa6, 0x81, 0xa9, 0xa8,
+	0xd1, 0x06, 0xc0, 0x39, 0x89, 0xc6, 0x2e, 0xf3, 0xce, 0x46, 0xd8, 0xd2, 0x9a, 0x5a, 0xab, 0xe4,
+	0x94, 0x12, 0x4d, 0x3f, 0x51, 0xa0, 0x75, 0x28, 0xb1, 0x48, 0x18, 0xa9, 0x95, 0x6b, 0xea, 0xad,
+	0x92, 0x53, 0x64, 0x11, 0xb7, 0x51, 0xb4, 0x05, 0x55, 0x3a, 0x0c, 0xce, 0x99, 0x9b, 0x42, 0x2c,
+	0x9d, 0xfb, 0x97, 0xb9, 0xb6, 0x2f, 0x60, 0xe8, 0x19, 0x54, 0x04, 0x6a, 0x82, 0x89, 0x8f, 0x43,
+	0x66, 0x2d, 0x35, 0xb5, 0x56, 0x45, 0x82, 0x8e, 0x85, 0xce, 0xfe, 0x9b, 0x83, 0xe2, 0x27, 0x3c,
+	0xa3, 0x13, 0xcf, 0xc7, 0xc8, 0x82, 0x02, 0x1d, 0x7a, 0x64, 0x80, 0x07, 0xbc, 0xa0, 0xa2, 0x93,
+	0x8a, 0xe8, 0x1d, 0x14, 0xa7, 0x41, 0x38, 0xc0, 0xd7, 0xb2, 0x1a, 0xb3, 0xbb, 0x99, 0x35, 0x9b,
+	0xba, 0xb7, 0x4f, 0x25, 0xe2, 0x20, 0x64, 0x64, 0xe6, 0x64, 0x0e, 0xe8, 0x35, 0x18, 0xb2, 0x11,
+	0x9d, 0xbb, 0x6e, 0x3c, 0x74, 0x15, 0x8d, 0x09, 0x47, 0x09, 0x46, 0x3b, 0x60, 0x11, 0x7c, 0x15,
+	0x07, 0x04, 0xbb, 0xf8, 0x7a, 0x32, 0x0a, 0xfc, 0x80, 0xb9, 0x44, 0x30, 0xc8, 0x5b, 0x29, 0x3a,
+	0x35, 0x69, 0x3f, 0x90, 0x66, 0xc9, 0x6f, 0xfd, 0x08, 0x2a, 0x73, 0xb5, 0xa0, 0x15, 0xd0, 0x2f,
+	0xf1, 0x4c, 0xb2, 0x9c, 0x7c, 0xa2, 0xe7, 0x90, 0x9f, 0x7a, 0xa3, 0x18, 0x5b, 0xb9, 0xa6, 0xd6,
+	0x32, 0xbb, 0xcb, 0x59, 0x49, 0xc2, 0xd1, 0x11, 0xd6, 0x5e, 0x6e, 0x47, 0xab, 0x1f, 0x82, 0xa9,
+	0x94, 0xb7, 0x20, 0xd6, 0xd6, 0x7c, 0xac, 0x6a, 0x16, 0x8b, 0xbb, 0x29, 0xa1, 0xec, 0x1f, 0x1a,
+	0x18, 0x22, 0x01, 0x42, 0xb0, 0xc4, 0x66, 0x93, 0xf4, 0xe5, 0xf9, 0x37, 0xda, 0x06, 0x63, 0xe2,
+	0x11, 0x6f, 0x9c, 0x72, 0xbc, 0x7e, 0xaf, 0xaa, 0xf6, 0x31, 0xb7, 0x4a, 0x9a, 0x04, 0x14, 0xad,
+	0x42, 0x3e, 0xfa, 0x1a, 0x62, 0x22, 0x67, 0x40, 0x08, 0xf5, 0xb7, 0x60, 0x2a, 0xe0, 0x05, 0x45,
+	0xaf, 0xaa, 0x45, 0x97, 0xd4, 0x22, 0x6f, 0x72, 0x90, 0x17, 0x13, 0xb4, 0xa8, 0xc6, 0xf7, 0xb0,
+	0xec, 0x47, 0xa3, 0x78, 0x1c, 0xba, 0xf7, 0x06, 0x62, 0x2d, 0x2b, 0x76, 0x9f, 0xdb, 0x25, 0x91,
+	0x55, 0x5f, 0x91, 0x30, 0x45, 0xbb, 0x50, 0xf5, 0x62, 0x16, 0xb9, 0x41, 0xe8, 0x13, 0x3c, 0x4e,
+	0xc6, 0x52, 0xe7, 0xac, 0xd5, 0x32, 0xf7, 0xbd, 0x98, 0x45, 0x87, 0xa9, 0xd5, 0xa9, 0x78, 0xaa,
+	0x88, 0x5e, 0x42, 0x41, 0x04, 0xa4, 0xd6, 0x52, 0x53, 0x9f, 0x7b, 0x39, 0x91, 0xd6, 0x49, 0xed,
+	0xa8, 0x06, 0xc6, 0x24, 0x08, 0x43, 0x3c, 0xb0, 0xf2, 0xbc, 0x7e, 0x29, 0xa1, 0x1e, 0x3c, 0x95,
+	0x1d, 0x8c, 0x02, 0xca, 0x5c, 0x2f, 0x66, 0xc3, 0x88, 0x04, 0xcc, 0x63, 0xc1, 0x14, 0x5b, 0x06,
+	0x1f, 0xac, 0x27, 0x02, 0x70, 0x14, 0x50, 0xb6, 0xa7, 0x9a, 0x51, 0x0b, 0x56, 0x08, 0xf6, 0x06,
+	0x62, 0xeb, 0x98, 0xcb, 0xd9, 0x29, 0xf0, 0xe8, 0xd5, 0x44, 0xcf, 0x69, 0x63, 0xfd, 0x84, 0xa7,
+	0x1a, 0x18, 0x34, 0x8a, 0x89, 0x8f, 0xad, 0xa2, 0xc8, 0x2e, 0x24, 0xbb, 0x0f, 0x65, 0x95, 0x9f,
+	0x04, 0x27, 0x92, 0x49, 0x96, 0xa5, 0x94, 0x70, 0x1f, 0x7a, 0xe3, 0xf4, 0x79, 0xf8, 0x77, 0xb2,
+	0x9f, 0x69, 0xf3, 0x3a, 0x3f, 0x09, 0xa9, 0x68, 0xef, 0x43, 0x65, 0x8e, 0xb6, 0xff, 0x86, 0xad,
+	0x43, 0x91, 0xe2, 0xab, 0x18, 0x87, 0x7e, 0x1a, 0x3a, 0x93, 0xed, 0x5d, 0x30, 0xf6, 0xe7, 0x93,
+	0x6b, 0x4a, 0xf2, 0x4d, 0x39, 0x0c, 0x89, 0x57, 0xb5, 0x6b, 0xb6, 0xc5, 0x61, 0x4c, 0x7a, 0x15,
+	0x93, 0x61, 0xdf, 0x68, 0x00, 0x27, 0x64, 0x7a, 0x7a, 0xc2, 0x9f, 0x03, 0x7d, 0x80, 0xd2, 0xa5,
+	0x5c, 0xef, 0xf4, 0x40, 0xda, 0xd9, 0x5b, 0xdd, 0xe1, 0xb2, 0x1b, 0x20, 0xc7, 0xfa, 0xce, 0x09,
+	0xf5, 0xa0, 0x22, 0xf7, 0xdd, 0x15, 0x67, 0x56, 0xec, 0xd7, 0xda, 0xa2, 0x33, 0x4b, 0x9d, 0x32,
+	0x51, 0xa4, 0xfa, 0x67, 0xa8, 0xce, 0x07, 0x5e, 0xb0, 0x02, 0x2f, 0xe6, 0xf7, 0xf6, 0xf1, 0x83,
+	0xb3, 0xa4, 0x6c, 0xc5, 0xc7, 0x37, 0x3f, 0x6f, 0x1b, 0xda, 0xaf, 0xdb, 0x86, 0xf6, 0xfb, 0xb6,
+	0xa1, 0x7d, 0xff, 0xd3, 0x78, 0xf4, 0x65, 0x6b, 0x1a, 0x30, 0x4c, 0x69, 0x3b, 0x88, 0x3a, 0xe2,
+	0xab, 0x73, 0x11, 0x75, 0xa6, 0xac, 0xc3, 0xff, 0x15, 0x1d, 0x19, 0xeb, 0xcc, 0xe0, 0xe2, 0xf6,
+	0xbf, 0x01, 0x00, 0xe8, 0x08, 0x5e, 0x73, 0x61, 0x06, 0x00, 0x00,
 }
 
 func (m *RoutingRules) Marshal() (dAtA []byte, err error) {
@@ -754,6 +806,18 @@ func (m *RoutingRule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.ShiftPercent != 0 {
+		i = encodeVarintVschema(dAtA, i, uint64(m.ShiftPercent))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ShiftToTable) > 0 {
+		i -= len(m.ShiftToTable)
+		copy(dAtA[i:], m.ShiftToTable)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.ShiftToTable)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.ToTables) > 0 {
 		for iNdEx := len(m.ToTables) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ToTables[iNdEx])
@@ -956,6 +1020,20 @@ func (m *Table) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Source) > 0 {
+		i -= len(m.Source)
+		copy(dAtA[i:], m.Source)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.Source)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ReadTabletType) > 0 {
+		i -= len(m.ReadTabletType)
+		copy(dAtA[i:], m.ReadTabletType)
+		i = encodeVarintVschema(dAtA, i, uint64(len(m.ReadTabletType)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.ColumnListAuthoritative {
 		i--
 		if m.ColumnListAuthoritative {
@@ -1263,6 +1341,13 @@ func (m *RoutingRule) Size() (n int) {
 			n += 1 + l + sovVschema(uint64(l))
 		}
 	}
+	l = len(m.ShiftToTable)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	if m.ShiftPercent != 0 {
+		n += 1 + sovVschema(uint64(m.ShiftPercent))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1374,6 +1459,14 @@ func (m *Table) Size() (n int) {
 	if m.ColumnListAuthoritative {
 		n += 2
 	}
+	l = len(m.ReadTabletType)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
+	l = len(m.Source)
+	if l > 0 {
+		n += 1 + l + sovVschema(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1661,6 +1754,57 @@ func (m *RoutingRule) Unmarshal(dAtA []byte) error {
 			}
 			m.ToTables = append(m.ToTables, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShiftToTable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShiftToTable = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShiftPercent", wireType)
+			}
+			m.ShiftPercent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ShiftPercent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
@@ -2500,6 +2644,70 @@ func (m *Table) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ColumnListAuthoritative = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReadTabletType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReadTabletType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVschema
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVschema
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVschema
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Source = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVschema(dAtA[iNdEx:])
@@ -765,8 +765,14 @@ func (m *Workflow_Stream) GetCopyStates() []*Workflow_Stream_CopyState {
 }
 
 type Workflow_Stream_CopyState struct {
-	Table                string   `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
-	LastPk               string   `protobuf:"bytes,2,opt,name=last_pk,json=lastPk,proto3" json:"last_pk,omitempty"`
+	Table string `protobuf:"bytes,1,opt,name=table,proto3" json:"table,omitempty"`
+	// last_pk is the primary key of the last row copied. The copy
+	// resumes after it.
+	LastPk     string `protobuf:"bytes,2,opt,name=last_pk,json=lastPk,proto3" json:"last_pk,omitempty"`
+	RowsCopied int64  `protobuf:"varint,3,opt,name=rows_copied,json=rowsCopied,proto3" json:"rows_copied,omitempty"`
+	// rows_remaining is estimated from the table statistics
+	// of the source.
+	RowsRemaining        int64    `protobuf:"varint,4,opt,name=rows_remaining,json=rowsRemaining,proto3" json:"rows_remaining,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -819,6 +825,265 @@ func (m *Workflow_Stream_CopyState) GetLastPk() string {
 	return ""
 }
 
+func (m *Workflow_Stream_CopyState) GetRowsCopied() int64 {
+	if m != nil {
+		return m.RowsCopied
+	}
+	return 0
+}
+
+func (m *Workflow_Stream_CopyState) GetRowsRemaining() int64 {
+	if m != nil {
+		return m.RowsRemaining
+	}
+	return 0
+}
+
+type ApplyVSchemaRequest struct {
+	Keyspace    string            `protobuf:"bytes,1,opt,name=keyspace,proto3" json:"keyspace,omitempty"`
+	SkipRebuild bool              `protobuf:"varint,2,opt,name=skip_rebuild,json=skipRebuild,proto3" json:"skip_rebuild,omitempty"`
+	DryRun      bool              `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Cells       []string          `protobuf:"bytes,4,rep,name=cells,proto3" json:"cells,omitempty"`
+	VSchema     *vschema.Keyspace `protobuf:"bytes,5,opt,name=v_schema,json=vSchema,proto3" json:"v_schema,omitempty"`
+	Sql         string            `protobuf:"bytes,6,opt,name=sql,proto3" json:"sql,omitempty"`
+	// PlanQueries is a sample of the queries of the keyspace, which are
+	// planned with the current and the new vschemas to report the plans
+	// the change would modify.
+	PlanQueries []string `protobuf:"bytes,7,rep,name=plan_queries,json=planQueries,proto3" json:"plan_queries,omitempty"`
+	// SkipValidation skips the validation of the vschema against the
+	// schema of the primary tablets of the keyspace.
+	SkipValidation       bool     `protobuf:"varint,8,opt,name=skip_validation,json=skipValidation,proto3" json:"skip_validation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplyVSchemaRequest) Reset()         { *m = ApplyVSchemaRequest{} }
+func (m *ApplyVSchemaRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplyVSchemaRequest) ProtoMessage()    {}
+func (*ApplyVSchemaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{7}
+}
+func (m *ApplyVSchemaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyVSchemaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyVSchemaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplyVSchemaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVSchemaRequest.Merge(m, src)
+}
+func (m *ApplyVSchemaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyVSchemaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVSchemaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVSchemaRequest proto.InternalMessageInfo
+
+func (m *ApplyVSchemaRequest) GetKeyspace() string {
+	if m != nil {
+		return m.Keyspace
+	}
+	return ""
+}
+
+func (m *ApplyVSchemaRequest) GetSkipRebuild() bool {
+	if m != nil {
+		return m.SkipRebuild
+	}
+	return false
+}
+
+func (m *ApplyVSchemaRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+func (m *ApplyVSchemaRequest) GetCells() []string {
+	if m != nil {
+		return m.Cells
+	}
+	return nil
+}
+
+func (m *ApplyVSchemaRequest) GetVSchema() *vschema.Keyspace {
+	if m != nil {
+		return m.VSchema
+	}
+	return nil
+}
+
+func (m *ApplyVSchemaRequest) GetSql() string {
+	if m != nil {
+		return m.Sql
+	}
+	return ""
+}
+
+func (m *ApplyVSchemaRequest) GetPlanQueries() []string {
+	if m != nil {
+		return m.PlanQueries
+	}
+	return nil
+}
+
+func (m *ApplyVSchemaRequest) GetSkipValidation() bool {
+	if m != nil {
+		return m.SkipValidation
+	}
+	return false
+}
+
+type ApplyVSchemaResponse struct {
+	VSchema              *vschema.Keyspace    `protobuf:"bytes,1,opt,name=v_schema,json=vSchema,proto3" json:"v_schema,omitempty"`
+	PlanChanges          []*VSchemaPlanChange `protobuf:"bytes,2,rep,name=plan_changes,json=planChanges,proto3" json:"plan_changes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApplyVSchemaResponse) Reset()         { *m = ApplyVSchemaResponse{} }
+func (m *ApplyVSchemaResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplyVSchemaResponse) ProtoMessage()    {}
+func (*ApplyVSchemaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{8}
+}
+func (m *ApplyVSchemaResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplyVSchemaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplyVSchemaResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplyVSchemaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplyVSchemaResponse.Merge(m, src)
+}
+func (m *ApplyVSchemaResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplyVSchemaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplyVSchemaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplyVSchemaResponse proto.InternalMessageInfo
+
+func (m *ApplyVSchemaResponse) GetVSchema() *vschema.Keyspace {
+	if m != nil {
+		return m.VSchema
+	}
+	return nil
+}
+
+func (m *ApplyVSchemaResponse) GetPlanChanges() []*VSchemaPlanChange {
+	if m != nil {
+		return m.PlanChanges
+	}
+	return nil
+}
+
+// VSchemaPlanChange is a query whose plan is modified by a vschema change.
+// The plans are in JSON, the errors are set instead when the query can't
+// be planned.
+type VSchemaPlanChange struct {
+	Query                string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	OldPlan              string   `protobuf:"bytes,2,opt,name=old_plan,json=oldPlan,proto3" json:"old_plan,omitempty"`
+	OldError             string   `protobuf:"bytes,3,opt,name=old_error,json=oldError,proto3" json:"old_error,omitempty"`
+	NewPlan              string   `protobuf:"bytes,4,opt,name=new_plan,json=newPlan,proto3" json:"new_plan,omitempty"`
+	NewError             string   `protobuf:"bytes,5,opt,name=new_error,json=newError,proto3" json:"new_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VSchemaPlanChange) Reset()         { *m = VSchemaPlanChange{} }
+func (m *VSchemaPlanChange) String() string { return proto.CompactTextString(m) }
+func (*VSchemaPlanChange) ProtoMessage()    {}
+func (*VSchemaPlanChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f41247b323a1ab2e, []int{9}
+}
+func (m *VSchemaPlanChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VSchemaPlanChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VSchemaPlanChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VSchemaPlanChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VSchemaPlanChange.Merge(m, src)
+}
+func (m *VSchemaPlanChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *VSchemaPlanChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_VSchemaPlanChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VSchemaPlanChange proto.InternalMessageInfo
+
+func (m *VSchemaPlanChange) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *VSchemaPlanChange) GetOldPlan() string {
+	if m != nil {
+		return m.OldPlan
+	}
+	return ""
+}
+
+func (m *VSchemaPlanChange) GetOldError() string {
+	if m != nil {
+		return m.OldError
+	}
+	return ""
+}
+
+func (m *VSchemaPlanChange) GetNewPlan() string {
+	if m != nil {
+		return m.NewPlan
+	}
+	return ""
+}
+
+func (m *VSchemaPlanChange) GetNewError() string {
+	if m != nil {
+		return m.NewError
+	}
+	return ""
+}
+
 type ChangeTabletTypeRequest struct {
 	TabletAlias          *topodata.TabletAlias `protobuf:"bytes,1,opt,name=tablet_alias,json=tabletAlias,proto3" json:"tablet_alias,omitempty"`
 	DbType               topodata.TabletType   `protobuf:"varint,2,opt,name=db_type,json=dbType,proto3,enum=topodata.TabletType" json:"db_type,omitempty"`
@@ -832,7 +1097,7 @@ func (m *ChangeTabletTypeRequest) Reset()         { *m = ChangeTabletTypeRequest
 func (m *ChangeTabletTypeRequest) String() string { return proto.CompactTextString(m) }
 func (*ChangeTabletTypeRequest) ProtoMessage()    {}
 func (*ChangeTabletTypeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{7}
+	return fileDescriptor_f41247b323a1ab2e, []int{10}
 }
 func (m *ChangeTabletTypeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -895,7 +1160,7 @@ func (m *ChangeTabletTypeResponse) Reset()         { *m = ChangeTabletTypeRespon
 func (m *ChangeTabletTypeResponse) String() string { return proto.CompactTextString(m) }
 func (*ChangeTabletTypeResponse) ProtoMessage()    {}
 func (*ChangeTabletTypeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{8}
+	return fileDescriptor_f41247b323a1ab2e, []int{11}
 }
 func (m *ChangeTabletTypeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,7 +1242,7 @@ func (m *CreateKeyspaceRequest) Reset()         { *m = CreateKeyspaceRequest{} }
 func (m *CreateKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyspaceRequest) ProtoMessage()    {}
 func (*CreateKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{9}
+	return fileDescriptor_f41247b323a1ab2e, []int{12}
 }
 func (m *CreateKeyspaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1346,7 @@ func (m *CreateKeyspaceResponse) Reset()         { *m = CreateKeyspaceResponse{}
 func (m *CreateKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateKeyspaceResponse) ProtoMessage()    {}
 func (*CreateKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{10}
+	return fileDescriptor_f41247b323a1ab2e, []int{13}
 }
 func (m *CreateKeyspaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1137,7 +1402,7 @@ func (m *CreateShardRequest) Reset()         { *m = CreateShardRequest{} }
 func (m *CreateShardRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateShardRequest) ProtoMessage()    {}
 func (*CreateShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{11}
+	return fileDescriptor_f41247b323a1ab2e, []int{14}
 }
 func (m *CreateShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1212,7 +1477,7 @@ func (m *CreateShardResponse) Reset()         { *m = CreateShardResponse{} }
 func (m *CreateShardResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateShardResponse) ProtoMessage()    {}
 func (*CreateShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{12}
+	return fileDescriptor_f41247b323a1ab2e, []int{15}
 }
 func (m *CreateShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1543,7 @@ func (m *DeleteKeyspaceRequest) Reset()         { *m = DeleteKeyspaceRequest{} }
 func (m *DeleteKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyspaceRequest) ProtoMessage()    {}
 func (*DeleteKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{13}
+	return fileDescriptor_f41247b323a1ab2e, []int{16}
 }
 func (m *DeleteKeyspaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1331,7 +1596,7 @@ func (m *DeleteKeyspaceResponse) Reset()         { *m = DeleteKeyspaceResponse{}
 func (m *DeleteKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteKeyspaceResponse) ProtoMessage()    {}
 func (*DeleteKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{14}
+	return fileDescriptor_f41247b323a1ab2e, []int{17}
 }
 func (m *DeleteKeyspaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1380,7 +1645,7 @@ func (m *DeleteShardsRequest) Reset()         { *m = DeleteShardsRequest{} }
 func (m *DeleteShardsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardsRequest) ProtoMessage()    {}
 func (*DeleteShardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{15}
+	return fileDescriptor_f41247b323a1ab2e, []int{18}
 }
 func (m *DeleteShardsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1440,7 +1705,7 @@ func (m *DeleteShardsResponse) Reset()         { *m = DeleteShardsResponse{} }
 func (m *DeleteShardsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteShardsResponse) ProtoMessage()    {}
 func (*DeleteShardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{16}
+	return fileDescriptor_f41247b323a1ab2e, []int{19}
 }
 func (m *DeleteShardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1484,7 +1749,7 @@ func (m *DeleteTabletsRequest) Reset()         { *m = DeleteTabletsRequest{} }
 func (m *DeleteTabletsRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteTabletsRequest) ProtoMessage()    {}
 func (*DeleteTabletsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{17}
+	return fileDescriptor_f41247b323a1ab2e, []int{20}
 }
 func (m *DeleteTabletsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1537,7 +1802,7 @@ func (m *DeleteTabletsResponse) Reset()         { *m = DeleteTabletsResponse{} }
 func (m *DeleteTabletsResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteTabletsResponse) ProtoMessage()    {}
 func (*DeleteTabletsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{18}
+	return fileDescriptor_f41247b323a1ab2e, []int{21}
 }
 func (m *DeleteTabletsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1591,7 +1856,7 @@ func (m *EmergencyReparentShardRequest) Reset()         { *m = EmergencyReparent
 func (m *EmergencyReparentShardRequest) String() string { return proto.CompactTextString(m) }
 func (*EmergencyReparentShardRequest) ProtoMessage()    {}
 func (*EmergencyReparentShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{19}
+	return fileDescriptor_f41247b323a1ab2e, []int{22}
 }
 func (m *EmergencyReparentShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1675,7 +1940,7 @@ func (m *EmergencyReparentShardResponse) Reset()         { *m = EmergencyReparen
 func (m *EmergencyReparentShardResponse) String() string { return proto.CompactTextString(m) }
 func (*EmergencyReparentShardResponse) ProtoMessage()    {}
 func (*EmergencyReparentShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{20}
+	return fileDescriptor_f41247b323a1ab2e, []int{23}
 }
 func (m *EmergencyReparentShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1743,7 +2008,7 @@ func (m *FindAllShardsInKeyspaceRequest) Reset()         { *m = FindAllShardsInK
 func (m *FindAllShardsInKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*FindAllShardsInKeyspaceRequest) ProtoMessage()    {}
 func (*FindAllShardsInKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{21}
+	return fileDescriptor_f41247b323a1ab2e, []int{24}
 }
 func (m *FindAllShardsInKeyspaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1790,7 +2055,7 @@ func (m *FindAllShardsInKeyspaceResponse) Reset()         { *m = FindAllShardsIn
 func (m *FindAllShardsInKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*FindAllShardsInKeyspaceResponse) ProtoMessage()    {}
 func (*FindAllShardsInKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{22}
+	return fileDescriptor_f41247b323a1ab2e, []int{25}
 }
 func (m *FindAllShardsInKeyspaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1838,7 +2103,7 @@ func (m *GetBackupsRequest) Reset()         { *m = GetBackupsRequest{} }
 func (m *GetBackupsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBackupsRequest) ProtoMessage()    {}
 func (*GetBackupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{23}
+	return fileDescriptor_f41247b323a1ab2e, []int{26}
 }
 func (m *GetBackupsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +2157,7 @@ func (m *GetBackupsResponse) Reset()         { *m = GetBackupsResponse{} }
 func (m *GetBackupsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBackupsResponse) ProtoMessage()    {}
 func (*GetBackupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{24}
+	return fileDescriptor_f41247b323a1ab2e, []int{27}
 }
 func (m *GetBackupsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1938,7 +2203,7 @@ func (m *GetCellInfoNamesRequest) Reset()         { *m = GetCellInfoNamesRequest
 func (m *GetCellInfoNamesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoNamesRequest) ProtoMessage()    {}
 func (*GetCellInfoNamesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{25}
+	return fileDescriptor_f41247b323a1ab2e, []int{28}
 }
 func (m *GetCellInfoNamesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1978,7 +2243,7 @@ func (m *GetCellInfoNamesResponse) Reset()         { *m = GetCellInfoNamesRespon
 func (m *GetCellInfoNamesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoNamesResponse) ProtoMessage()    {}
 func (*GetCellInfoNamesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{26}
+	return fileDescriptor_f41247b323a1ab2e, []int{29}
 }
 func (m *GetCellInfoNamesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2025,7 +2290,7 @@ func (m *GetCellInfoRequest) Reset()         { *m = GetCellInfoRequest{} }
 func (m *GetCellInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoRequest) ProtoMessage()    {}
 func (*GetCellInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{27}
+	return fileDescriptor_f41247b323a1ab2e, []int{30}
 }
 func (m *GetCellInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2072,7 +2337,7 @@ func (m *GetCellInfoResponse) Reset()         { *m = GetCellInfoResponse{} }
 func (m *GetCellInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCellInfoResponse) ProtoMessage()    {}
 func (*GetCellInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{28}
+	return fileDescriptor_f41247b323a1ab2e, []int{31}
 }
 func (m *GetCellInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2118,7 +2383,7 @@ func (m *GetCellsAliasesRequest) Reset()         { *m = GetCellsAliasesRequest{}
 func (m *GetCellsAliasesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetCellsAliasesRequest) ProtoMessage()    {}
 func (*GetCellsAliasesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{29}
+	return fileDescriptor_f41247b323a1ab2e, []int{32}
 }
 func (m *GetCellsAliasesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2158,7 +2423,7 @@ func (m *GetCellsAliasesResponse) Reset()         { *m = GetCellsAliasesResponse
 func (m *GetCellsAliasesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCellsAliasesResponse) ProtoMessage()    {}
 func (*GetCellsAliasesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{30}
+	return fileDescriptor_f41247b323a1ab2e, []int{33}
 }
 func (m *GetCellsAliasesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2204,7 +2469,7 @@ func (m *GetKeyspacesRequest) Reset()         { *m = GetKeyspacesRequest{} }
 func (m *GetKeyspacesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspacesRequest) ProtoMessage()    {}
 func (*GetKeyspacesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{31}
+	return fileDescriptor_f41247b323a1ab2e, []int{34}
 }
 func (m *GetKeyspacesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2244,7 +2509,7 @@ func (m *GetKeyspacesResponse) Reset()         { *m = GetKeyspacesResponse{} }
 func (m *GetKeyspacesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspacesResponse) ProtoMessage()    {}
 func (*GetKeyspacesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{32}
+	return fileDescriptor_f41247b323a1ab2e, []int{35}
 }
 func (m *GetKeyspacesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2291,7 +2556,7 @@ func (m *GetKeyspaceRequest) Reset()         { *m = GetKeyspaceRequest{} }
 func (m *GetKeyspaceRequest) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspaceRequest) ProtoMessage()    {}
 func (*GetKeyspaceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{33}
+	return fileDescriptor_f41247b323a1ab2e, []int{36}
 }
 func (m *GetKeyspaceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2338,7 +2603,7 @@ func (m *GetKeyspaceResponse) Reset()         { *m = GetKeyspaceResponse{} }
 func (m *GetKeyspaceResponse) String() string { return proto.CompactTextString(m) }
 func (*GetKeyspaceResponse) ProtoMessage()    {}
 func (*GetKeyspaceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{34}
+	return fileDescriptor_f41247b323a1ab2e, []int{37}
 }
 func (m *GetKeyspaceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2400,7 +2665,7 @@ func (m *GetSchemaRequest) Reset()         { *m = GetSchemaRequest{} }
 func (m *GetSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaRequest) ProtoMessage()    {}
 func (*GetSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{35}
+	return fileDescriptor_f41247b323a1ab2e, []int{38}
 }
 func (m *GetSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2482,7 +2747,7 @@ func (m *GetSchemaResponse) Reset()         { *m = GetSchemaResponse{} }
 func (m *GetSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSchemaResponse) ProtoMessage()    {}
 func (*GetSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{36}
+	return fileDescriptor_f41247b323a1ab2e, []int{39}
 }
 func (m *GetSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2530,7 +2795,7 @@ func (m *GetShardRequest) Reset()         { *m = GetShardRequest{} }
 func (m *GetShardRequest) String() string { return proto.CompactTextString(m) }
 func (*GetShardRequest) ProtoMessage()    {}
 func (*GetShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{37}
+	return fileDescriptor_f41247b323a1ab2e, []int{40}
 }
 func (m *GetShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2584,7 +2849,7 @@ func (m *GetShardResponse) Reset()         { *m = GetShardResponse{} }
 func (m *GetShardResponse) String() string { return proto.CompactTextString(m) }
 func (*GetShardResponse) ProtoMessage()    {}
 func (*GetShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{38}
+	return fileDescriptor_f41247b323a1ab2e, []int{41}
 }
 func (m *GetShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2634,7 +2899,7 @@ func (m *GetSrvKeyspacesRequest) Reset()         { *m = GetSrvKeyspacesRequest{}
 func (m *GetSrvKeyspacesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSrvKeyspacesRequest) ProtoMessage()    {}
 func (*GetSrvKeyspacesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{39}
+	return fileDescriptor_f41247b323a1ab2e, []int{42}
 }
 func (m *GetSrvKeyspacesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2689,7 +2954,7 @@ func (m *GetSrvKeyspacesResponse) Reset()         { *m = GetSrvKeyspacesResponse
 func (m *GetSrvKeyspacesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSrvKeyspacesResponse) ProtoMessage()    {}
 func (*GetSrvKeyspacesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{40}
+	return fileDescriptor_f41247b323a1ab2e, []int{43}
 }
 func (m *GetSrvKeyspacesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2736,7 +3001,7 @@ func (m *GetSrvVSchemaRequest) Reset()         { *m = GetSrvVSchemaRequest{} }
 func (m *GetSrvVSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetSrvVSchemaRequest) ProtoMessage()    {}
 func (*GetSrvVSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{41}
+	return fileDescriptor_f41247b323a1ab2e, []int{44}
 }
 func (m *GetSrvVSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2783,7 +3048,7 @@ func (m *GetSrvVSchemaResponse) Reset()         { *m = GetSrvVSchemaResponse{} }
 func (m *GetSrvVSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSrvVSchemaResponse) ProtoMessage()    {}
 func (*GetSrvVSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{42}
+	return fileDescriptor_f41247b323a1ab2e, []int{45}
 }
 func (m *GetSrvVSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2830,7 +3095,7 @@ func (m *GetTabletRequest) Reset()         { *m = GetTabletRequest{} }
 func (m *GetTabletRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTabletRequest) ProtoMessage()    {}
 func (*GetTabletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{43}
+	return fileDescriptor_f41247b323a1ab2e, []int{46}
 }
 func (m *GetTabletRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2877,7 +3142,7 @@ func (m *GetTabletResponse) Reset()         { *m = GetTabletResponse{} }
 func (m *GetTabletResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTabletResponse) ProtoMessage()    {}
 func (*GetTabletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{44}
+	return fileDescriptor_f41247b323a1ab2e, []int{47}
 }
 func (m *GetTabletResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2942,7 +3207,7 @@ func (m *GetTabletsRequest) Reset()         { *m = GetTabletsRequest{} }
 func (m *GetTabletsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTabletsRequest) ProtoMessage()    {}
 func (*GetTabletsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{45}
+	return fileDescriptor_f41247b323a1ab2e, []int{48}
 }
 func (m *GetTabletsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3017,7 +3282,7 @@ func (m *GetTabletsResponse) Reset()         { *m = GetTabletsResponse{} }
 func (m *GetTabletsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTabletsResponse) ProtoMessage()    {}
 func (*GetTabletsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{46}
+	return fileDescriptor_f41247b323a1ab2e, []int{49}
 }
 func (m *GetTabletsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3064,7 +3329,7 @@ func (m *GetVSchemaRequest) Reset()         { *m = GetVSchemaRequest{} }
 func (m *GetVSchemaRequest) String() string { return proto.CompactTextString(m) }
 func (*GetVSchemaRequest) ProtoMessage()    {}
 func (*GetVSchemaRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{47}
+	return fileDescriptor_f41247b323a1ab2e, []int{50}
 }
 func (m *GetVSchemaRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3111,7 +3376,7 @@ func (m *GetVSchemaResponse) Reset()         { *m = GetVSchemaResponse{} }
 func (m *GetVSchemaResponse) String() string { return proto.CompactTextString(m) }
 func (*GetVSchemaResponse) ProtoMessage()    {}
 func (*GetVSchemaResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{48}
+	return fileDescriptor_f41247b323a1ab2e, []int{51}
 }
 func (m *GetVSchemaResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3159,7 +3424,7 @@ func (m *GetWorkflowsRequest) Reset()         { *m = GetWorkflowsRequest{} }
 func (m *GetWorkflowsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowsRequest) ProtoMessage()    {}
 func (*GetWorkflowsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{49}
+	return fileDescriptor_f41247b323a1ab2e, []int{52}
 }
 func (m *GetWorkflowsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3213,7 +3478,7 @@ func (m *GetWorkflowsResponse) Reset()         { *m = GetWorkflowsResponse{} }
 func (m *GetWorkflowsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetWorkflowsResponse) ProtoMessage()    {}
 func (*GetWorkflowsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{50}
+	return fileDescriptor_f41247b323a1ab2e, []int{53}
 }
 func (m *GetWorkflowsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3529,7 @@ func (m *InitShardPrimaryRequest) Reset()         { *m = InitShardPrimaryRequest
 func (m *InitShardPrimaryRequest) String() string { return proto.CompactTextString(m) }
 func (*InitShardPrimaryRequest) ProtoMessage()    {}
 func (*InitShardPrimaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{51}
+	return fileDescriptor_f41247b323a1ab2e, []int{54}
 }
 func (m *InitShardPrimaryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3339,7 +3604,7 @@ func (m *InitShardPrimaryResponse) Reset()         { *m = InitShardPrimaryRespon
 func (m *InitShardPrimaryResponse) String() string { return proto.CompactTextString(m) }
 func (*InitShardPrimaryResponse) ProtoMessage()    {}
 func (*InitShardPrimaryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{52}
+	return fileDescriptor_f41247b323a1ab2e, []int{55}
 }
 func (m *InitShardPrimaryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3407,7 +3672,7 @@ func (m *PlannedReparentShardRequest) Reset()         { *m = PlannedReparentShar
 func (m *PlannedReparentShardRequest) String() string { return proto.CompactTextString(m) }
 func (*PlannedReparentShardRequest) ProtoMessage()    {}
 func (*PlannedReparentShardRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{53}
+	return fileDescriptor_f41247b323a1ab2e, []int{56}
 }
 func (m *PlannedReparentShardRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3491,7 +3756,7 @@ func (m *PlannedReparentShardResponse) Reset()         { *m = PlannedReparentSha
 func (m *PlannedReparentShardResponse) String() string { return proto.CompactTextString(m) }
 func (*PlannedReparentShardResponse) ProtoMessage()    {}
 func (*PlannedReparentShardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{54}
+	return fileDescriptor_f41247b323a1ab2e, []int{57}
 }
 func (m *PlannedReparentShardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3567,7 +3832,7 @@ func (m *RemoveKeyspaceCellRequest) Reset()         { *m = RemoveKeyspaceCellReq
 func (m *RemoveKeyspaceCellRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveKeyspaceCellRequest) ProtoMessage()    {}
 func (*RemoveKeyspaceCellRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{55}
+	return fileDescriptor_f41247b323a1ab2e, []int{58}
 }
 func (m *RemoveKeyspaceCellRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3634,7 +3899,7 @@ func (m *RemoveKeyspaceCellResponse) Reset()         { *m = RemoveKeyspaceCellRe
 func (m *RemoveKeyspaceCellResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveKeyspaceCellResponse) ProtoMessage()    {}
 func (*RemoveKeyspaceCellResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{56}
+	return fileDescriptor_f41247b323a1ab2e, []int{59}
 }
 func (m *RemoveKeyspaceCellResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3683,7 +3948,7 @@ func (m *RemoveShardCellRequest) Reset()         { *m = RemoveShardCellRequest{}
 func (m *RemoveShardCellRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveShardCellRequest) ProtoMessage()    {}
 func (*RemoveShardCellRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{57}
+	return fileDescriptor_f41247b323a1ab2e, []int{60}
 }
 func (m *RemoveShardCellRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3757,7 +4022,7 @@ func (m *RemoveShardCellResponse) Reset()         { *m = RemoveShardCellResponse
 func (m *RemoveShardCellResponse) String() string { return proto.CompactTextString(m) }
 func (*RemoveShardCellResponse) ProtoMessage()    {}
 func (*RemoveShardCellResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{58}
+	return fileDescriptor_f41247b323a1ab2e, []int{61}
 }
 func (m *RemoveShardCellResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3799,7 +4064,7 @@ func (m *ReparentTabletRequest) Reset()         { *m = ReparentTabletRequest{} }
 func (m *ReparentTabletRequest) String() string { return proto.CompactTextString(m) }
 func (*ReparentTabletRequest) ProtoMessage()    {}
 func (*ReparentTabletRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{59}
+	return fileDescriptor_f41247b323a1ab2e, []int{62}
 }
 func (m *ReparentTabletRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3851,7 +4116,7 @@ func (m *ReparentTabletResponse) Reset()         { *m = ReparentTabletResponse{}
 func (m *ReparentTabletResponse) String() string { return proto.CompactTextString(m) }
 func (*ReparentTabletResponse) ProtoMessage()    {}
 func (*ReparentTabletResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{60}
+	return fileDescriptor_f41247b323a1ab2e, []int{63}
 }
 func (m *ReparentTabletResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3913,7 +4178,7 @@ func (m *ShardReplicationPositionsRequest) Reset()         { *m = ShardReplicati
 func (m *ShardReplicationPositionsRequest) String() string { return proto.CompactTextString(m) }
 func (*ShardReplicationPositionsRequest) ProtoMessage()    {}
 func (*ShardReplicationPositionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{61}
+	return fileDescriptor_f41247b323a1ab2e, []int{64}
 }
 func (m *ShardReplicationPositionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3972,7 +4237,7 @@ func (m *ShardReplicationPositionsResponse) Reset()         { *m = ShardReplicat
 func (m *ShardReplicationPositionsResponse) String() string { return proto.CompactTextString(m) }
 func (*ShardReplicationPositionsResponse) ProtoMessage()    {}
 func (*ShardReplicationPositionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{62}
+	return fileDescriptor_f41247b323a1ab2e, []int{65}
 }
 func (m *ShardReplicationPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4028,7 +4293,7 @@ func (m *TabletExternallyReparentedRequest) Reset()         { *m = TabletExterna
 func (m *TabletExternallyReparentedRequest) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedRequest) ProtoMessage()    {}
 func (*TabletExternallyReparentedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{63}
+	return fileDescriptor_f41247b323a1ab2e, []int{66}
 }
 func (m *TabletExternallyReparentedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4343,7 @@ func (m *TabletExternallyReparentedResponse) Reset()         { *m = TabletExtern
 func (m *TabletExternallyReparentedResponse) String() string { return proto.CompactTextString(m) }
 func (*TabletExternallyReparentedResponse) ProtoMessage()    {}
 func (*TabletExternallyReparentedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f41247b323a1ab2e, []int{64}
+	return fileDescriptor_f41247b323a1ab2e, []int{67}
 }
 func (m *TabletExternallyReparentedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4148,6 +4413,9 @@ func init() {
 	proto.RegisterType((*Workflow_ShardStream)(nil), "vtctldata.Workflow.ShardStream")
 	proto.RegisterType((*Workflow_Stream)(nil), "vtctldata.Workflow.Stream")
 	proto.RegisterType((*Workflow_Stream_CopyState)(nil), "vtctldata.Workflow.Stream.CopyState")
+	proto.RegisterType((*ApplyVSchemaRequest)(nil), "vtctldata.ApplyVSchemaRequest")
+	proto.RegisterType((*ApplyVSchemaResponse)(nil), "vtctldata.ApplyVSchemaResponse")
+	proto.RegisterType((*VSchemaPlanChange)(nil), "vtctldata.VSchemaPlanChange")
 	proto.RegisterType((*ChangeTabletTypeRequest)(nil), "vtctldata.ChangeTabletTypeRequest")
 	proto.RegisterType((*ChangeTabletTypeResponse)(nil), "vtctldata.ChangeTabletTypeResponse")
 	proto.RegisterType((*CreateKeyspaceRequest)(nil), "vtctldata.CreateKeyspaceRequest")
@@ -4216,178 +4484,193 @@ func init() {
 func init() { proto.RegisterFile("vtctldata.proto", fileDescriptor_f41247b323a1ab2e) }
 
 var fileDescriptor_f41247b323a1ab2e = []byte{
-	// 2731 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x1a, 0x4d, 0x6f, 0x1b, 0xc7,
-	0xb5, 0xcb, 0x2f, 0x89, 0x8f, 0x1f, 0x92, 0x56, 0x94, 0xb4, 0x61, 0x6c, 0x59, 0x5e, 0xc7, 0x8e,
-	0xea, 0xc4, 0x94, 0xad, 0x24, 0x86, 0xe1, 0x24, 0xad, 0x6d, 0x89, 0x32, 0xe4, 0x38, 0xaa, 0xba,
-	0x54, 0x15, 0x34, 0x87, 0x6e, 0x47, 0xe4, 0x88, 0x5e, 0x68, 0xb9, 0xcb, 0xec, 0x0c, 0x29, 0x31,
-	0x3d, 0xf4, 0xd2, 0x1e, 0x02, 0x14, 0xe8, 0xb5, 0x40, 0x50, 0xa0, 0xa7, 0xa2, 0xe8, 0xad, 0x97,
-	0x00, 0x2d, 0x8a, 0x1e, 0x8b, 0x1e, 0x7a, 0xe8, 0xb5, 0xb7, 0xc2, 0xfd, 0x19, 0xbd, 0x14, 0xf3,
-	0xb5, 0x5c, 0x2e, 0x97, 0xb4, 0x2c, 0x1b, 0x28, 0x7a, 0x12, 0xe7, 0xcd, 0x7b, 0xf3, 0xde, 0xbc,
-	0xef, 0x37, 0x2b, 0x98, 0xeb, 0xd3, 0x26, 0x75, 0x5b, 0x88, 0xa2, 0x5a, 0x37, 0xf0, 0xa9, 0xaf,
-	0xe7, 0x43, 0x40, 0x75, 0xfe, 0xc8, 0xf1, 0x5c, 0xbf, 0x3d, 0xdc, 0xac, 0x96, 0x5c, 0xbf, 0xdd,
-	0xa3, 0x8e, 0x2b, 0x97, 0xe5, 0xce, 0x80, 0x7c, 0xe1, 0x36, 0xa9, 0x5a, 0x2f, 0x05, 0xb8, 0xeb,
-	0x3a, 0x4d, 0x44, 0x1d, 0xdf, 0x8b, 0x50, 0xad, 0x50, 0x74, 0xe4, 0x62, 0xda, 0x41, 0x1e, 0x6a,
-	0xe3, 0x20, 0xb2, 0x51, 0xa6, 0x7e, 0xd7, 0x8f, 0x1e, 0xdf, 0x27, 0xcd, 0x67, 0xb8, 0xa3, 0x96,
-	0xc5, 0x3e, 0xa5, 0x4e, 0x07, 0x8b, 0x95, 0xf9, 0x19, 0x54, 0xeb, 0x67, 0xb8, 0xd9, 0xa3, 0xf8,
-	0x90, 0x49, 0xb8, 0xe5, 0x77, 0x3a, 0xc8, 0x6b, 0x59, 0xf8, 0x8b, 0x1e, 0x26, 0x54, 0xd7, 0x21,
-	0x83, 0x82, 0x36, 0x31, 0xb4, 0xb5, 0xf4, 0x7a, 0xde, 0xe2, 0xbf, 0xf5, 0xeb, 0x50, 0x46, 0x4d,
-	0x26, 0x8b, 0xcd, 0x8e, 0xf1, 0x7b, 0xd4, 0x48, 0xad, 0x69, 0xeb, 0x69, 0xab, 0x24, 0xa0, 0x07,
-	0x02, 0x68, 0x6e, 0xc1, 0x9b, 0x89, 0x07, 0x93, 0xae, 0xef, 0x11, 0xac, 0xbf, 0x05, 0x59, 0xdc,
-	0xc7, 0x1e, 0x35, 0xb4, 0x35, 0x6d, 0xbd, 0xb0, 0x59, 0xae, 0x29, 0x1d, 0xd4, 0x19, 0xd4, 0x12,
-	0x9b, 0xe6, 0x57, 0x1a, 0x18, 0x07, 0xec, 0x9a, 0x9f, 0x22, 0x8a, 0x03, 0x07, 0xb9, 0xce, 0x97,
-	0xb8, 0x81, 0x29, 0x75, 0xbc, 0x36, 0xd1, 0xaf, 0x42, 0x91, 0xa2, 0xa0, 0x8d, 0xa9, 0xcd, 0x35,
-	0xc1, 0x4f, 0xca, 0x5b, 0x05, 0x01, 0xe3, 0x54, 0xfa, 0x3b, 0xb0, 0x40, 0xfc, 0x5e, 0xd0, 0xc4,
-	0x36, 0x3e, 0xeb, 0x06, 0x98, 0x10, 0xc7, 0xf7, 0xb8, 0xb8, 0x79, 0x6b, 0x5e, 0x6c, 0xd4, 0x43,
-	0xb8, 0x7e, 0x19, 0xa0, 0x19, 0x60, 0x44, 0xb1, 0xdd, 0x6a, 0xb9, 0x46, 0x9a, 0x63, 0xe5, 0x05,
-	0x64, 0xbb, 0xe5, 0x9a, 0xff, 0x4c, 0xc1, 0x62, 0x92, 0x18, 0x55, 0x98, 0x3d, 0xf5, 0x83, 0x93,
-	0x63, 0xd7, 0x3f, 0x95, 0x22, 0x84, 0x6b, 0xfd, 0x6d, 0x98, 0x93, 0xfc, 0x4f, 0xf0, 0x80, 0x74,
-	0x51, 0x13, 0x4b, 0xee, 0x65, 0x01, 0xfe, 0x44, 0x42, 0x19, 0xa2, 0xbc, 0x4b, 0x88, 0x28, 0x04,
-	0x28, 0x0b, 0x70, 0x88, 0x78, 0x03, 0xe6, 0x08, 0xf5, 0xbb, 0x36, 0x3a, 0xa6, 0x38, 0xb0, 0x9b,
-	0x7e, 0x77, 0x60, 0x64, 0xd6, 0xb4, 0xf5, 0x59, 0xab, 0xc4, 0xc0, 0x0f, 0x19, 0x74, 0xcb, 0xef,
-	0x0e, 0xf4, 0x27, 0x50, 0xe6, 0x5a, 0xb1, 0x89, 0x94, 0xd3, 0xc8, 0xae, 0xa5, 0xd7, 0x0b, 0x9b,
-	0xd7, 0x6a, 0x43, 0xd7, 0x9c, 0xa4, 0x59, 0xab, 0xc4, 0x49, 0xc3, 0x1b, 0xea, 0x90, 0x69, 0x62,
-	0xd7, 0x35, 0x72, 0x5c, 0x22, 0xfe, 0x5b, 0x28, 0x9f, 0xf9, 0x9f, 0x4d, 0x07, 0x5d, 0x4c, 0x8c,
-	0x19, 0xa5, 0x7c, 0x06, 0x3b, 0x60, 0x20, 0xfd, 0xdb, 0x30, 0x8f, 0xcf, 0x28, 0x0e, 0x3c, 0xe4,
-	0xda, 0x4d, 0xb7, 0x47, 0x28, 0x0e, 0x8c, 0x59, 0x8e, 0x36, 0xa7, 0xe0, 0x5b, 0x02, 0x6c, 0xee,
-	0xc1, 0x6c, 0x78, 0x43, 0x1d, 0x32, 0x1e, 0xea, 0x28, 0x73, 0xf2, 0xdf, 0x7a, 0x0d, 0x66, 0x47,
-	0x14, 0x58, 0xd8, 0xd4, 0x6b, 0xa1, 0x97, 0x2b, 0x4a, 0x2b, 0xc4, 0x31, 0x7f, 0x04, 0xd9, 0xc6,
-	0x33, 0x14, 0xb4, 0x98, 0x71, 0x42, 0x42, 0x69, 0x9c, 0x93, 0x38, 0xa3, 0x54, 0x84, 0xd1, 0x75,
-	0xc8, 0x12, 0x46, 0xc8, 0xb5, 0x5f, 0xd8, 0x9c, 0x1b, 0x72, 0xe1, 0xe7, 0x59, 0x62, 0xd7, 0xfc,
-	0x5d, 0x1e, 0x66, 0x3f, 0x53, 0x46, 0x4e, 0x12, 0xf8, 0xbb, 0x90, 0x13, 0x16, 0x96, 0xe2, 0xbe,
-	0x1d, 0x51, 0xbb, 0x22, 0xac, 0x59, 0xc3, 0xb8, 0x7e, 0xea, 0x8b, 0xbf, 0x96, 0x24, 0x63, 0x07,
-	0x08, 0xcb, 0x4b, 0x49, 0xce, 0x7f, 0x80, 0x20, 0xd3, 0xef, 0xc0, 0x52, 0x07, 0x9d, 0xd9, 0x7d,
-	0x3b, 0x92, 0x3d, 0x6c, 0x17, 0xb5, 0xb9, 0xbb, 0xa4, 0x2d, 0xbd, 0x83, 0xce, 0x0e, 0xa3, 0xf4,
-	0xa8, 0xad, 0x3f, 0x81, 0x12, 0xbf, 0x9e, 0x4d, 0x68, 0x80, 0x51, 0x47, 0xb9, 0xcc, 0xf5, 0x24,
-	0xd6, 0x5c, 0x1d, 0x0d, 0x81, 0x57, 0xf7, 0x68, 0x30, 0xb0, 0x8a, 0x24, 0x02, 0xaa, 0xfe, 0x18,
-	0x16, 0xc6, 0x50, 0xf4, 0x79, 0x48, 0x9f, 0xe0, 0x81, 0x54, 0x14, 0xfb, 0xa9, 0x7f, 0x00, 0xd9,
-	0x3e, 0x72, 0x7b, 0x4a, 0x4d, 0x57, 0x5e, 0xc0, 0xca, 0x12, 0xd8, 0xf7, 0x53, 0xf7, 0xb4, 0xea,
-	0x2e, 0x2c, 0x26, 0xdc, 0x7f, 0xaa, 0xc5, 0x97, 0x21, 0xc7, 0x85, 0x24, 0x46, 0x8a, 0x27, 0x34,
-	0xb9, 0xaa, 0xfe, 0x51, 0x83, 0x42, 0x84, 0x8b, 0xfe, 0x3e, 0xcc, 0x28, 0x15, 0x68, 0x5c, 0x05,
-	0xd5, 0x44, 0xb9, 0x84, 0x48, 0x0a, 0x55, 0xdf, 0x61, 0x31, 0xcc, 0x43, 0xa2, 0xe9, 0x7b, 0x34,
-	0xf0, 0x5d, 0xc1, 0xa6, 0xb0, 0x79, 0x39, 0xe6, 0x45, 0x22, 0xf0, 0xe8, 0x96, 0xc0, 0xb2, 0x44,
-	0xa0, 0xaa, 0x25, 0xd1, 0xdf, 0x05, 0xdd, 0x21, 0x76, 0x37, 0x70, 0x3a, 0x28, 0x18, 0xd8, 0x04,
-	0x07, 0x7d, 0xc7, 0x6b, 0x73, 0x37, 0x98, 0xb5, 0xe6, 0x1d, 0xb2, 0x2f, 0x36, 0x1a, 0x02, 0x5e,
-	0xfd, 0x75, 0x06, 0x72, 0x52, 0xec, 0x32, 0xa4, 0x9c, 0x16, 0xbf, 0x74, 0xda, 0x4a, 0x39, 0x2d,
-	0xbd, 0xa2, 0x9c, 0x59, 0x78, 0xb8, 0x58, 0xe8, 0xb7, 0x98, 0x67, 0x31, 0x86, 0xd2, 0xb3, 0x96,
-	0x86, 0xd2, 0x09, 0xb9, 0x1e, 0xba, 0x0e, 0x22, 0x96, 0x44, 0xd2, 0x3f, 0x86, 0x92, 0x28, 0x58,
-	0xb6, 0x74, 0xe8, 0x0c, 0xa7, 0x32, 0x6a, 0x91, 0x32, 0xf6, 0x88, 0xff, 0x6c, 0xf0, 0x7d, 0xab,
-	0x78, 0x14, 0x59, 0x31, 0x73, 0x74, 0x7d, 0xe2, 0x30, 0xd3, 0x18, 0x59, 0x61, 0x0e, 0xb5, 0xd6,
-	0xaf, 0x01, 0x4f, 0x5a, 0x76, 0x88, 0x20, 0x12, 0x4c, 0x91, 0x01, 0xf7, 0x15, 0x12, 0xbb, 0x04,
-	0x45, 0x14, 0xcb, 0x0c, 0x23, 0x16, 0xfa, 0x0a, 0xcc, 0xb4, 0x8e, 0x6c, 0x1e, 0x76, 0x22, 0xa5,
-	0xe4, 0x5a, 0x47, 0x7b, 0x2c, 0xf0, 0x1e, 0xc2, 0x12, 0x0d, 0x90, 0x47, 0x22, 0x25, 0x8a, 0x50,
-	0xd4, 0xe9, 0x1a, 0x79, 0x2e, 0x76, 0xb1, 0x26, 0xab, 0x1f, 0x2b, 0x53, 0x56, 0x25, 0x82, 0x7a,
-	0xa0, 0x30, 0xf5, 0x0d, 0x28, 0x32, 0x14, 0xbb, 0xd7, 0x6d, 0x21, 0x8a, 0x5b, 0x06, 0x24, 0x50,
-	0x16, 0xd8, 0xcf, 0x1f, 0x08, 0x04, 0xdd, 0x80, 0x99, 0x0e, 0x26, 0x04, 0xb5, 0xb1, 0x51, 0xe0,
-	0xc2, 0xa8, 0xa5, 0x5e, 0x87, 0x02, 0x4b, 0xd1, 0x36, 0x17, 0x9a, 0x18, 0x45, 0xee, 0x0e, 0x6f,
-	0x4d, 0x76, 0xa6, 0x1a, 0xcb, 0xdd, 0x0d, 0x86, 0x6c, 0x41, 0x53, 0xfd, 0x24, 0xd5, 0xfb, 0x90,
-	0x0f, 0x37, 0x98, 0x42, 0xa2, 0xf5, 0x4e, 0x2c, 0x98, 0x42, 0x5c, 0x44, 0xa8, 0xdd, 0x3d, 0x91,
-	0xd6, 0xce, 0xb1, 0xe5, 0xfe, 0x89, 0xf9, 0xb5, 0x06, 0x2b, 0x5b, 0xcf, 0x90, 0xd7, 0xc6, 0x07,
-	0x61, 0x6e, 0x56, 0xe5, 0xfd, 0x5e, 0x98, 0xc4, 0x11, 0xb3, 0xb9, 0xac, 0xc5, 0x13, 0x1c, 0x42,
-	0xe6, 0x76, 0xbe, 0xd0, 0x6f, 0x71, 0xfd, 0xb3, 0xd4, 0xcf, 0xd9, 0x95, 0x37, 0x2b, 0x71, 0x22,
-	0xce, 0x27, 0xd7, 0x3a, 0x62, 0x7f, 0xb9, 0xb9, 0x82, 0x81, 0x1d, 0xf4, 0x3c, 0xe9, 0xc7, 0xb9,
-	0x56, 0x30, 0xb0, 0x7a, 0x9e, 0xf9, 0x5b, 0x0d, 0x8c, 0x71, 0xe9, 0x64, 0x8f, 0xf0, 0x01, 0x94,
-	0x8e, 0xf0, 0xb1, 0x1f, 0x60, 0x5b, 0x3a, 0xac, 0x90, 0x6f, 0x3e, 0xce, 0xca, 0x2a, 0x0a, 0x34,
-	0xb1, 0xd2, 0xdf, 0x83, 0xa2, 0xa8, 0x8e, 0x92, 0x2a, 0x35, 0x81, 0xaa, 0xc0, 0xb1, 0x24, 0xd1,
-	0x2a, 0x14, 0x4e, 0x11, 0xb1, 0x47, 0xa5, 0xcc, 0x9f, 0x22, 0xb2, 0x2d, 0x04, 0xfd, 0x26, 0x0d,
-	0x4b, 0x5b, 0xbc, 0x17, 0x08, 0xcb, 0xcd, 0xb0, 0x47, 0x1a, 0x4b, 0xff, 0x15, 0xc8, 0x1e, 0xfb,
-	0x2a, 0xfb, 0xcf, 0x5a, 0x62, 0xa1, 0x6f, 0x40, 0x05, 0xb9, 0xae, 0x7f, 0x6a, 0xe3, 0x4e, 0x97,
-	0x0e, 0xec, 0xbe, 0x2d, 0xfa, 0x32, 0xc9, 0x6c, 0x81, 0xef, 0xd5, 0xd9, 0xd6, 0x61, 0x83, 0x6f,
-	0xe8, 0xb7, 0xa1, 0xc2, 0x63, 0xd6, 0xf1, 0xda, 0x76, 0xd3, 0x77, 0x7b, 0x1d, 0x4f, 0xb8, 0x7c,
-	0x86, 0xb3, 0xd2, 0xd5, 0xde, 0x16, 0xdf, 0xe2, 0xee, 0xff, 0x64, 0x9c, 0x82, 0x1b, 0x29, 0xcb,
-	0x8d, 0x64, 0x8c, 0x17, 0xcd, 0xdd, 0x16, 0x57, 0x79, 0xec, 0x2c, 0x6e, 0xb4, 0x07, 0x50, 0x64,
-	0xc9, 0x07, 0xb7, 0xec, 0xe3, 0xc0, 0xef, 0x10, 0x23, 0x17, 0x4f, 0x66, 0xea, 0x8c, 0x5a, 0x83,
-	0xa3, 0xed, 0x04, 0x7e, 0xc7, 0x2a, 0x90, 0xf0, 0x37, 0xd1, 0x6f, 0x42, 0x86, 0x73, 0x9f, 0xe1,
-	0xdc, 0x97, 0xc7, 0x29, 0x39, 0x6f, 0x8e, 0xc3, 0x92, 0xc1, 0x11, 0x22, 0x91, 0x46, 0x49, 0xc4,
-	0x75, 0x91, 0x01, 0xc3, 0xde, 0xe0, 0x0e, 0x94, 0x88, 0x87, 0xba, 0xe4, 0x99, 0x4f, 0x79, 0x68,
-	0x27, 0x46, 0x75, 0x51, 0xa1, 0xb0, 0x95, 0xb9, 0x0b, 0xcb, 0x71, 0xbb, 0x49, 0xf7, 0xda, 0x88,
-	0x55, 0x8a, 0xc2, 0xe6, 0x62, 0x24, 0x32, 0x13, 0xba, 0x8a, 0x5f, 0x68, 0xa0, 0x8b, 0xb3, 0x44,
-	0x33, 0x20, 0x1d, 0x60, 0x5a, 0xc5, 0xb9, 0x0c, 0x20, 0x4a, 0x6a, 0xa4, 0xd3, 0xc8, 0x73, 0xc8,
-	0xde, 0x88, 0x9f, 0xa4, 0xa3, 0x7e, 0x72, 0x1d, 0xca, 0x8e, 0xd7, 0x74, 0x7b, 0x2d, 0x6c, 0x77,
-	0x51, 0xc0, 0x9a, 0x64, 0xd9, 0xe2, 0x49, 0xe8, 0x3e, 0x07, 0x9a, 0xbf, 0xd1, 0x60, 0x71, 0x44,
-	0x9c, 0x0b, 0xde, 0x4b, 0xbf, 0x11, 0xad, 0x13, 0x2c, 0x52, 0x86, 0xd8, 0xd1, 0xae, 0x27, 0x74,
-	0x47, 0x1b, 0xb9, 0x01, 0x46, 0xad, 0x81, 0x8d, 0xcf, 0x1c, 0x42, 0x89, 0x14, 0x5e, 0xb8, 0xd0,
-	0x43, 0xb1, 0x55, 0xe7, 0x3b, 0xe6, 0xf7, 0x61, 0x69, 0x1b, 0xbb, 0x78, 0x3c, 0x68, 0xa6, 0xe9,
-	0xec, 0x12, 0xe4, 0x03, 0xdc, 0xec, 0x05, 0xc4, 0xe9, 0xab, 0x00, 0x1a, 0x02, 0x4c, 0x03, 0x96,
-	0xe3, 0x47, 0x8a, 0x7b, 0x9b, 0x3f, 0xd7, 0x60, 0x51, 0x6c, 0x71, 0xa9, 0x89, 0xe2, 0xb5, 0x1e,
-	0x56, 0x7d, 0x51, 0xcc, 0xc7, 0xef, 0x27, 0xf7, 0xa7, 0x73, 0x66, 0xad, 0x37, 0x9b, 0x4a, 0x6c,
-	0xe7, 0x38, 0x2c, 0xca, 0xd2, 0x2e, 0x0c, 0xbc, 0x7b, 0x2c, 0x2b, 0xb2, 0xb9, 0x0c, 0x95, 0x51,
-	0x31, 0xa4, 0x7c, 0x03, 0x05, 0x17, 0x29, 0x27, 0x94, 0xef, 0x23, 0xd9, 0xaa, 0xcb, 0x2c, 0x8c,
-	0x95, 0x9c, 0x13, 0xf2, 0x70, 0x29, 0x92, 0x87, 0x31, 0x61, 0x71, 0x23, 0x92, 0x8a, 0x6c, 0x18,
-	0xa4, 0xdc, 0x45, 0x0e, 0x94, 0xbd, 0x82, 0xb9, 0xa2, 0xec, 0x10, 0xb2, 0x96, 0x32, 0xfd, 0x32,
-	0x05, 0x97, 0xeb, 0x1d, 0x1c, 0xb4, 0xb1, 0xd7, 0x1c, 0x58, 0x58, 0xb8, 0xdb, 0xb9, 0xbd, 0x3b,
-	0xb9, 0xc1, 0xb8, 0x0b, 0x05, 0x0f, 0x0f, 0xe5, 0x99, 0xda, 0x65, 0x80, 0x87, 0x95, 0x90, 0xfa,
-	0x77, 0x60, 0xce, 0x69, 0x7b, 0x2c, 0xdd, 0xcb, 0x96, 0x95, 0x18, 0x99, 0x69, 0x8a, 0x28, 0x0b,
-	0x6c, 0xd9, 0x04, 0x12, 0x7d, 0x1b, 0x96, 0x4e, 0x91, 0x43, 0x43, 0xea, 0x70, 0x3e, 0xcd, 0x86,
-	0x6e, 0xcd, 0x93, 0xc4, 0x76, 0x2f, 0x10, 0xad, 0xf2, 0x22, 0x43, 0x57, 0xe4, 0x6a, 0x6e, 0xfd,
-	0xb3, 0x06, 0xab, 0x93, 0x34, 0x22, 0x03, 0xec, 0xe5, 0x55, 0xf2, 0x00, 0xe6, 0xbb, 0x81, 0xdf,
-	0xf1, 0x29, 0x6e, 0x9d, 0x4f, 0x2f, 0x73, 0x0a, 0x5d, 0x29, 0xe7, 0x06, 0xe4, 0xf8, 0x48, 0xac,
-	0x74, 0x12, 0x1f, 0x98, 0xe5, 0xae, 0xf9, 0x11, 0xac, 0xee, 0x38, 0x5e, 0xeb, 0xa1, 0xeb, 0x0a,
-	0xef, 0xdb, 0xf5, 0x5e, 0x22, 0xf4, 0xcc, 0xbf, 0x68, 0x70, 0x65, 0x22, 0xb9, 0xbc, 0xfd, 0x5e,
-	0x2c, 0x9c, 0xee, 0x46, 0xc2, 0xe9, 0x05, 0xb4, 0x22, 0xdc, 0xe4, 0xbc, 0xa0, 0x9a, 0xef, 0x4f,
-	0x64, 0xef, 0x3d, 0x71, 0x46, 0xb8, 0x31, 0x3a, 0x23, 0x24, 0xa4, 0xa7, 0x70, 0x28, 0x30, 0xeb,
-	0xb0, 0xf0, 0x18, 0xd3, 0x47, 0xa8, 0x79, 0xd2, 0xeb, 0x92, 0x0b, 0xbb, 0xb0, 0xb9, 0x0d, 0x7a,
-	0xf4, 0x18, 0x79, 0xf3, 0x1a, 0xcc, 0x1c, 0x09, 0x90, 0xbc, 0x7a, 0xa5, 0x16, 0x3e, 0xd5, 0x08,
-	0xdc, 0x5d, 0xef, 0xd8, 0xb7, 0x14, 0x92, 0xf9, 0x06, 0xac, 0x3c, 0xc6, 0x74, 0x0b, 0xbb, 0x2e,
-	0x83, 0xb3, 0x84, 0xaf, 0x44, 0x32, 0x6f, 0x83, 0x31, 0xbe, 0x25, 0xd9, 0x54, 0x20, 0xcb, 0xaa,
-	0x85, 0x7a, 0x75, 0x11, 0x0b, 0x73, 0x9d, 0x8b, 0xa4, 0x28, 0x22, 0xcd, 0x07, 0x1f, 0xcd, 0xb5,
-	0xe1, 0x68, 0x6e, 0xee, 0xc0, 0xe2, 0x08, 0x66, 0x58, 0x16, 0xf2, 0x6c, 0xdb, 0x76, 0xbc, 0x63,
-	0x5f, 0xd6, 0x85, 0xc8, 0x10, 0x1d, 0xa2, 0xcf, 0x36, 0xe5, 0x2f, 0x96, 0x69, 0xe5, 0x39, 0x44,
-	0x26, 0x1b, 0x25, 0xfd, 0x37, 0x5a, 0x78, 0xb3, 0xe1, 0x96, 0x64, 0xb3, 0x0b, 0x33, 0xa3, 0x69,
-	0x6c, 0x23, 0x62, 0xaf, 0x09, 0x44, 0x35, 0xb9, 0x16, 0x8e, 0xa1, 0xe8, 0xab, 0xfb, 0x50, 0x8c,
-	0x6e, 0x24, 0xb8, 0xc6, 0xcd, 0x51, 0xd7, 0xa8, 0x8c, 0xde, 0x47, 0xb0, 0x89, 0xba, 0xc7, 0x12,
-	0x57, 0x8d, 0x72, 0xcb, 0xf0, 0x3e, 0xbb, 0x50, 0x19, 0x05, 0xcb, 0xbb, 0xdc, 0x81, 0xbc, 0x72,
-	0x14, 0x75, 0x9b, 0xc4, 0x52, 0x3a, 0xc4, 0x32, 0x6f, 0x73, 0x33, 0xbd, 0x4c, 0xcc, 0xed, 0x8c,
-	0xc8, 0x74, 0xf1, 0xee, 0xe4, 0x67, 0x29, 0x98, 0x7f, 0x8c, 0xa9, 0x68, 0x1d, 0x5f, 0xbd, 0xc3,
-	0x5f, 0x96, 0x63, 0x62, 0x38, 0x2b, 0x8b, 0x15, 0x6b, 0x4e, 0xf0, 0x99, 0x68, 0x4e, 0xe4, 0x7e,
-	0x9a, 0xef, 0x97, 0x24, 0xf4, 0x40, 0xa0, 0x5d, 0x03, 0xd5, 0xad, 0xd8, 0x7d, 0x07, 0x9f, 0x12,
-	0x59, 0x2a, 0x8b, 0x12, 0x78, 0xc8, 0x60, 0xfa, 0x3a, 0xcc, 0x8b, 0x47, 0x2a, 0xee, 0xe2, 0xb6,
-	0xef, 0xb9, 0x03, 0x9e, 0xac, 0x67, 0xe5, 0x4c, 0xcc, 0xe3, 0xe2, 0x7b, 0x9e, 0x3b, 0x18, 0x62,
-	0x12, 0xe7, 0x4b, 0x85, 0x99, 0x8b, 0x60, 0x36, 0x18, 0x98, 0x61, 0x9a, 0xfb, 0x3c, 0x03, 0x28,
-	0x2d, 0x48, 0x65, 0x7e, 0x08, 0x39, 0xd9, 0x6b, 0x0b, 0x05, 0x5c, 0xab, 0x8d, 0x3f, 0x9e, 0x0a,
-	0x92, 0x6d, 0x7c, 0xec, 0x78, 0x8e, 0x7c, 0x8a, 0xe1, 0x10, 0xf3, 0x29, 0xcc, 0xb1, 0x13, 0x5f,
-	0x4f, 0xcb, 0x67, 0xde, 0x17, 0x56, 0x1a, 0x29, 0x28, 0x61, 0x03, 0xa6, 0x4d, 0x6d, 0xc0, 0xcc,
-	0x27, 0x3c, 0x22, 0x1b, 0x41, 0x3f, 0xee, 0xc1, 0x2f, 0x4a, 0x71, 0x2c, 0xa6, 0x95, 0x21, 0xc5,
-	0xc2, 0xfc, 0xbb, 0x88, 0xe1, 0xd1, 0xc3, 0xa4, 0x3c, 0x3f, 0x84, 0x12, 0x09, 0xfa, 0x76, 0xdc,
-	0xf7, 0xdf, 0x1f, 0x8d, 0xe4, 0x24, 0xd2, 0x5a, 0x14, 0xa8, 0xde, 0x85, 0x22, 0xa0, 0xea, 0x21,
-	0x2c, 0x8c, 0xa1, 0x24, 0x04, 0xf6, 0x3b, 0xa3, 0x81, 0x1d, 0x71, 0xd8, 0x08, 0x75, 0x34, 0xb2,
-	0x6f, 0xf2, 0x10, 0x6e, 0x04, 0xfd, 0xc3, 0xd1, 0x00, 0x48, 0x4a, 0x90, 0x7b, 0xb0, 0x14, 0xc3,
-	0x0d, 0x07, 0x4e, 0x26, 0xec, 0x70, 0x30, 0x0b, 0xe3, 0x4e, 0x3e, 0xa0, 0x47, 0x48, 0x80, 0x84,
-	0xbf, 0xcd, 0xa7, 0xdc, 0xa4, 0x72, 0xaa, 0x7c, 0xd5, 0xc0, 0x33, 0x3f, 0xe6, 0x0e, 0xac, 0x4e,
-	0x93, 0x92, 0xad, 0x87, 0x8f, 0x36, 0x93, 0x66, 0x60, 0xb9, 0x6f, 0xfe, 0x41, 0x8b, 0xd0, 0x5f,
-	0xbc, 0x04, 0x0e, 0xbd, 0x26, 0x1d, 0xf1, 0x1a, 0xfe, 0x82, 0x46, 0x03, 0xa7, 0xa9, 0x46, 0x12,
-	0xb9, 0x4a, 0xe8, 0x61, 0xb3, 0xe7, 0xef, 0x61, 0xcd, 0x07, 0x3c, 0x69, 0xc6, 0x7a, 0x53, 0xfd,
-	0x26, 0xcc, 0x08, 0xb4, 0x61, 0xe3, 0x1e, 0xbf, 0xb4, 0x42, 0x30, 0x37, 0xf8, 0xa5, 0x63, 0xb6,
-	0x9f, 0x96, 0x75, 0x1f, 0x71, 0x96, 0x71, 0x07, 0x78, 0x17, 0x66, 0x63, 0xc6, 0x5f, 0x08, 0x8d,
-	0x1f, 0x7a, 0xdd, 0x4c, 0x5f, 0xda, 0xdd, 0xe2, 0x99, 0x5b, 0x3d, 0xe1, 0x9c, 0x4b, 0xd7, 0x57,
-	0xa0, 0x80, 0x9a, 0xd4, 0xe9, 0x63, 0x91, 0xc2, 0x44, 0xaf, 0x0e, 0x02, 0xc4, 0xd3, 0x97, 0x28,
-	0x45, 0x91, 0x33, 0x87, 0xa5, 0x48, 0x7d, 0x55, 0x48, 0x2a, 0x45, 0x8a, 0xc0, 0x1a, 0x62, 0x99,
-	0xff, 0xd1, 0x60, 0x65, 0xd7, 0x73, 0x44, 0xae, 0x91, 0x7d, 0xe4, 0xc5, 0xfd, 0xc1, 0x82, 0xaa,
-	0x7a, 0x92, 0xc4, 0x2e, 0x6e, 0xca, 0x8f, 0x2e, 0xca, 0xbd, 0xa7, 0x36, 0xb3, 0x2b, 0x92, 0xb0,
-	0xce, 0xe8, 0x22, 0x1b, 0xc3, 0xf1, 0x37, 0x13, 0x1d, 0x7f, 0x5f, 0x4f, 0x1f, 0xff, 0x08, 0x8c,
-	0xf1, 0xcb, 0x87, 0xf9, 0x56, 0x35, 0xd3, 0xda, 0xd4, 0x66, 0xfa, 0xab, 0x14, 0xbc, 0xb9, 0xef,
-	0x22, 0xcf, 0xc3, 0xad, 0xff, 0xf1, 0x6c, 0x74, 0x1f, 0x4a, 0xa8, 0xef, 0x3b, 0xc3, 0xe9, 0x21,
-	0x33, 0x8d, 0xb2, 0xc8, 0x71, 0x15, 0xed, 0xeb, 0xd1, 0xe7, 0x9f, 0x34, 0xb8, 0x94, 0xac, 0x8b,
-	0xff, 0x83, 0xa9, 0xe8, 0xa7, 0xf0, 0x86, 0x85, 0x3b, 0x7e, 0x3f, 0x7c, 0x34, 0x60, 0xed, 0xe1,
-	0x79, 0xac, 0xa8, 0xca, 0x47, 0x2a, 0xf2, 0xe9, 0x2b, 0xf9, 0xd1, 0x66, 0xe4, 0xed, 0x20, 0x13,
-	0x7f, 0xb5, 0xb8, 0x04, 0xd5, 0x24, 0x01, 0xe4, 0x14, 0xfe, 0xb5, 0x06, 0xcb, 0x62, 0x9b, 0xab,
-	0xf4, 0xbc, 0xc2, 0xbd, 0xe0, 0x71, 0x49, 0xc9, 0x9e, 0x4e, 0x92, 0x3d, 0x33, 0x51, 0xf6, 0x6c,
-	0x5c, 0xf6, 0x37, 0x60, 0x65, 0x4c, 0x38, 0x29, 0xf8, 0x0e, 0x2c, 0x29, 0x67, 0x18, 0x2d, 0x7f,
-	0xb7, 0x62, 0xf5, 0x6a, 0xfa, 0x47, 0x06, 0xf3, 0x27, 0xec, 0xfe, 0xa3, 0xe7, 0x5c, 0xd8, 0xab,
-	0x36, 0x60, 0xe6, 0x5c, 0xce, 0xa4, 0xb0, 0xcc, 0x03, 0x58, 0x93, 0x9e, 0x1c, 0x7e, 0x4d, 0x52,
-	0x5f, 0x1f, 0x5e, 0x61, 0x84, 0xfc, 0x7d, 0x1a, 0xae, 0x4e, 0x39, 0x56, 0x5e, 0xef, 0x0c, 0x2a,
-	0xd1, 0xef, 0x73, 0x84, 0x22, 0xda, 0x1b, 0x8e, 0x4e, 0xf5, 0xb1, 0x46, 0x70, 0xca, 0x59, 0xd1,
-	0xaf, 0x81, 0x0d, 0x79, 0x8e, 0xe8, 0xc0, 0x16, 0x83, 0xf1, 0x1d, 0xfd, 0x73, 0x00, 0x99, 0xc1,
-	0x3b, 0xa8, 0x2b, 0x3f, 0x54, 0x7d, 0xf8, 0x52, 0xfc, 0x84, 0x32, 0x3f, 0x45, 0x5d, 0xc1, 0x25,
-	0x4f, 0xd5, 0xba, 0x6a, 0x83, 0x31, 0x49, 0x98, 0x84, 0x5e, 0xef, 0xd6, 0x68, 0xaf, 0xb7, 0x52,
-	0x8b, 0xff, 0xbf, 0x83, 0x38, 0x20, 0xfa, 0xed, 0x6f, 0x0f, 0xca, 0xa3, 0xdc, 0xcf, 0xf3, 0x6c,
-	0x10, 0x6f, 0x1e, 0x22, 0xdd, 0xa3, 0x05, 0x57, 0x05, 0xb0, 0x2e, 0x3f, 0x4c, 0xbb, 0xe1, 0xd3,
-	0x0f, 0x6e, 0x5d, 0xd0, 0xa7, 0xff, 0xaa, 0x81, 0x39, 0xed, 0xd0, 0x0b, 0x3b, 0xf8, 0x45, 0x6b,
-	0xc8, 0x5d, 0x28, 0xf8, 0xee, 0x39, 0x2b, 0x08, 0xf8, 0xae, 0x4a, 0xb2, 0x8f, 0xee, 0xfd, 0xed,
-	0xf9, 0xaa, 0xf6, 0x8f, 0xe7, 0xab, 0xda, 0xbf, 0x9e, 0xaf, 0x6a, 0xbf, 0xfa, 0xf7, 0xea, 0xb7,
-	0x3e, 0xbf, 0xd1, 0x77, 0x28, 0x26, 0xa4, 0xe6, 0xf8, 0x1b, 0xe2, 0xd7, 0x46, 0xdb, 0xdf, 0xe8,
-	0xd3, 0x0d, 0xfe, 0x2f, 0x25, 0x1b, 0xa1, 0x0f, 0x1d, 0xe5, 0x38, 0xe0, 0xbd, 0xff, 0x06, 0x00,
-	0x00, 0xff, 0xff, 0xda, 0xbd, 0xf5, 0x12, 0x0f, 0x23, 0x00, 0x00,
+	// 2972 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x4f, 0x6c, 0x1b, 0xc7,
+	0xd5, 0xff, 0x96, 0x94, 0x28, 0xf2, 0x91, 0xa2, 0xa4, 0xd5, 0xbf, 0x35, 0x63, 0xcb, 0xf6, 0x3a,
+	0x76, 0xf4, 0x39, 0x31, 0x95, 0x28, 0x7f, 0x10, 0xe4, 0xcf, 0x97, 0xd8, 0x92, 0x1c, 0xc8, 0x49,
+	0xfc, 0x29, 0x2b, 0x7d, 0x0e, 0xbe, 0x1c, 0xba, 0x1d, 0x91, 0x23, 0x7a, 0xa1, 0xe5, 0xee, 0x7a,
+	0x67, 0x48, 0x89, 0xe9, 0xa1, 0x40, 0xd1, 0x1e, 0x02, 0x14, 0xc8, 0xb5, 0x45, 0x2e, 0x3d, 0xf5,
+	0xd0, 0x5b, 0x2f, 0x01, 0x5a, 0x14, 0x3d, 0x16, 0x3d, 0xf4, 0xd0, 0x6b, 0x6f, 0x45, 0x0a, 0xf4,
+	0xde, 0x73, 0x2f, 0xc5, 0xcc, 0x9b, 0x59, 0x0e, 0xff, 0x88, 0x96, 0x95, 0x00, 0x45, 0x4f, 0xda,
+	0x79, 0x7f, 0x66, 0xde, 0xbc, 0x79, 0xf3, 0xde, 0x6f, 0x1e, 0x05, 0x73, 0x5d, 0xde, 0xe0, 0x61,
+	0x93, 0x70, 0x52, 0x4f, 0xd2, 0x98, 0xc7, 0x76, 0x29, 0x23, 0xd4, 0xe6, 0x0f, 0x83, 0x28, 0x8c,
+	0x5b, 0x7d, 0x66, 0x6d, 0x36, 0x8c, 0x5b, 0x1d, 0x1e, 0x84, 0x6a, 0x58, 0x6d, 0xf7, 0xd8, 0x93,
+	0xb0, 0xc1, 0xf5, 0x78, 0x39, 0xa5, 0x49, 0x18, 0x34, 0x08, 0x0f, 0xe2, 0xc8, 0xd0, 0x5a, 0xe5,
+	0xe4, 0x30, 0xa4, 0xbc, 0x4d, 0x22, 0xd2, 0xa2, 0xa9, 0xc1, 0xa8, 0xf2, 0x38, 0x89, 0xcd, 0xe9,
+	0xbb, 0xac, 0xf1, 0x98, 0xb6, 0xf5, 0xb0, 0xd2, 0xe5, 0x3c, 0x68, 0x53, 0x1c, 0xb9, 0x9f, 0x42,
+	0x6d, 0xe7, 0x94, 0x36, 0x3a, 0x9c, 0x3e, 0x12, 0x16, 0x6e, 0xc5, 0xed, 0x36, 0x89, 0x9a, 0x1e,
+	0x7d, 0xd2, 0xa1, 0x8c, 0xdb, 0x36, 0x4c, 0x91, 0xb4, 0xc5, 0x1c, 0xeb, 0x5a, 0x7e, 0xbd, 0xe4,
+	0xc9, 0x6f, 0xfb, 0x26, 0x54, 0x49, 0x43, 0xd8, 0xe2, 0x8b, 0x69, 0xe2, 0x0e, 0x77, 0x72, 0xd7,
+	0xac, 0xf5, 0xbc, 0x37, 0x8b, 0xd4, 0x03, 0x24, 0xba, 0x5b, 0xf0, 0xdc, 0xd8, 0x89, 0x59, 0x12,
+	0x47, 0x8c, 0xda, 0xcf, 0xc3, 0x34, 0xed, 0xd2, 0x88, 0x3b, 0xd6, 0x35, 0x6b, 0xbd, 0xbc, 0x59,
+	0xad, 0x6b, 0x1f, 0xec, 0x08, 0xaa, 0x87, 0x4c, 0xf7, 0x0b, 0x0b, 0x9c, 0x03, 0xb1, 0xcd, 0x8f,
+	0x09, 0xa7, 0x69, 0x40, 0xc2, 0xe0, 0x73, 0xba, 0x4f, 0x39, 0x0f, 0xa2, 0x16, 0xb3, 0xaf, 0x43,
+	0x85, 0x93, 0xb4, 0x45, 0xb9, 0x2f, 0x3d, 0x21, 0x67, 0x2a, 0x79, 0x65, 0xa4, 0x49, 0x2d, 0xfb,
+	0x45, 0x58, 0x60, 0x71, 0x27, 0x6d, 0x50, 0x9f, 0x9e, 0x26, 0x29, 0x65, 0x2c, 0x88, 0x23, 0x69,
+	0x6e, 0xc9, 0x9b, 0x47, 0xc6, 0x4e, 0x46, 0xb7, 0xaf, 0x00, 0x34, 0x52, 0x4a, 0x38, 0xf5, 0x9b,
+	0xcd, 0xd0, 0xc9, 0x4b, 0xa9, 0x12, 0x52, 0xb6, 0x9b, 0xa1, 0xfb, 0x97, 0x1c, 0x2c, 0x8e, 0x33,
+	0xa3, 0x06, 0xc5, 0x93, 0x38, 0x3d, 0x3e, 0x0a, 0xe3, 0x13, 0x65, 0x42, 0x36, 0xb6, 0x5f, 0x80,
+	0x39, 0xb5, 0xfe, 0x31, 0xed, 0xb1, 0x84, 0x34, 0xa8, 0x5a, 0xbd, 0x8a, 0xe4, 0x0f, 0x15, 0x55,
+	0x08, 0xaa, 0xbd, 0x64, 0x82, 0x68, 0x40, 0x15, 0xc9, 0x99, 0xe0, 0x2d, 0x98, 0x63, 0x3c, 0x4e,
+	0x7c, 0x72, 0xc4, 0x69, 0xea, 0x37, 0xe2, 0xa4, 0xe7, 0x4c, 0x5d, 0xb3, 0xd6, 0x8b, 0xde, 0xac,
+	0x20, 0xdf, 0x15, 0xd4, 0xad, 0x38, 0xe9, 0xd9, 0x0f, 0xa0, 0x2a, 0xbd, 0xe2, 0x33, 0x65, 0xa7,
+	0x33, 0x7d, 0x2d, 0xbf, 0x5e, 0xde, 0xbc, 0x51, 0xef, 0x87, 0xe6, 0x59, 0x9e, 0xf5, 0x66, 0xa5,
+	0x6a, 0xb6, 0x43, 0x1b, 0xa6, 0x1a, 0x34, 0x0c, 0x9d, 0x82, 0xb4, 0x48, 0x7e, 0xa3, 0xf3, 0x45,
+	0xfc, 0xf9, 0xbc, 0x97, 0x50, 0xe6, 0xcc, 0x68, 0xe7, 0x0b, 0xda, 0x81, 0x20, 0xd9, 0xff, 0x0d,
+	0xf3, 0xf4, 0x94, 0xd3, 0x34, 0x22, 0xa1, 0xdf, 0x08, 0x3b, 0x8c, 0xd3, 0xd4, 0x29, 0x4a, 0xb1,
+	0x39, 0x4d, 0xdf, 0x42, 0xb2, 0xfb, 0x10, 0x8a, 0xd9, 0x0e, 0x6d, 0x98, 0x8a, 0x48, 0x5b, 0x1f,
+	0xa7, 0xfc, 0xb6, 0xeb, 0x50, 0x1c, 0x70, 0x60, 0x79, 0xd3, 0xae, 0x67, 0x51, 0xae, 0x35, 0xbd,
+	0x4c, 0xc6, 0xfd, 0x1e, 0x4c, 0xef, 0x3f, 0x26, 0x69, 0x53, 0x1c, 0x4e, 0xa6, 0xa8, 0x0e, 0xe7,
+	0x78, 0x78, 0xa1, 0x9c, 0xb1, 0xd0, 0x4d, 0x98, 0x66, 0x42, 0x51, 0x7a, 0xbf, 0xbc, 0x39, 0xd7,
+	0x5f, 0x45, 0xce, 0xe7, 0x21, 0xd7, 0xfd, 0x47, 0x09, 0x8a, 0x9f, 0xea, 0x43, 0x1e, 0x67, 0xf0,
+	0x7b, 0x50, 0xc0, 0x13, 0x56, 0xe6, 0xbe, 0x60, 0xb8, 0x5d, 0x2b, 0xd6, 0xbd, 0xfe, 0xbd, 0xfe,
+	0x28, 0xc6, 0xbf, 0x9e, 0x52, 0x13, 0x13, 0xe0, 0xc9, 0x3b, 0xf9, 0x67, 0x9c, 0x00, 0xd5, 0xec,
+	0x57, 0x60, 0xb9, 0x4d, 0x4e, 0xfd, 0xae, 0x6f, 0x64, 0x0f, 0x3f, 0x24, 0x2d, 0x19, 0x2e, 0x79,
+	0xcf, 0x6e, 0x93, 0xd3, 0x47, 0xa6, 0x3e, 0x69, 0xd9, 0x0f, 0x60, 0x56, 0x6e, 0xcf, 0x67, 0x3c,
+	0xa5, 0xa4, 0xad, 0x43, 0xe6, 0xe6, 0xb8, 0xa5, 0xa5, 0x3b, 0xf6, 0x51, 0x6e, 0x27, 0xe2, 0x69,
+	0xcf, 0xab, 0x30, 0x83, 0x54, 0xfb, 0x3e, 0x2c, 0x8c, 0x88, 0xd8, 0xf3, 0x90, 0x3f, 0xa6, 0x3d,
+	0xe5, 0x28, 0xf1, 0x69, 0xbf, 0x0e, 0xd3, 0x5d, 0x12, 0x76, 0xb4, 0x9b, 0xae, 0x3e, 0x65, 0x29,
+	0x0f, 0xa5, 0xdf, 0xca, 0xbd, 0x69, 0xd5, 0x76, 0x61, 0x71, 0xcc, 0xfe, 0x27, 0x9e, 0xf8, 0x0a,
+	0x14, 0xa4, 0x91, 0xcc, 0xc9, 0xc9, 0x84, 0xa6, 0x46, 0xb5, 0xdf, 0x58, 0x50, 0x36, 0x56, 0xb1,
+	0x5f, 0x83, 0x19, 0xed, 0x02, 0x4b, 0xba, 0xa0, 0x36, 0xd6, 0x2e, 0x34, 0x49, 0x8b, 0xda, 0xf7,
+	0x61, 0x0e, 0xc3, 0xdf, 0x6f, 0xc4, 0x11, 0x4f, 0xe3, 0x10, 0x97, 0x29, 0x6f, 0x5e, 0x19, 0x8a,
+	0x22, 0xbc, 0x78, 0x7c, 0x0b, 0xa5, 0xbc, 0x2a, 0x37, 0x87, 0xcc, 0x7e, 0x09, 0xec, 0x80, 0xf9,
+	0x49, 0x1a, 0xb4, 0x49, 0xda, 0xf3, 0x19, 0x4d, 0xbb, 0x41, 0xd4, 0x92, 0x61, 0x50, 0xf4, 0xe6,
+	0x03, 0xb6, 0x87, 0x8c, 0x7d, 0xa4, 0xd7, 0xfe, 0x3e, 0x05, 0x05, 0x65, 0x76, 0x15, 0x72, 0x41,
+	0x53, 0x6e, 0x3a, 0xef, 0xe5, 0x82, 0xa6, 0xbd, 0xa4, 0x83, 0x19, 0x23, 0x1c, 0x07, 0xf6, 0x1d,
+	0x28, 0xe0, 0x82, 0x2a, 0xb2, 0x96, 0xfb, 0xd6, 0xa1, 0x5d, 0x77, 0xc3, 0x80, 0x30, 0x4f, 0x09,
+	0xd9, 0xef, 0xc2, 0x2c, 0x16, 0x2c, 0x5f, 0x05, 0xf4, 0x94, 0xd4, 0x72, 0xea, 0x46, 0x19, 0xbb,
+	0x27, 0x3f, 0xf7, 0x25, 0xdf, 0xab, 0x1c, 0x1a, 0x23, 0x71, 0x1c, 0x49, 0xcc, 0x02, 0x71, 0x34,
+	0xce, 0x34, 0x1e, 0x87, 0x1e, 0xdb, 0x37, 0x40, 0x26, 0x2d, 0x3f, 0x13, 0xc0, 0x04, 0x53, 0x11,
+	0xc4, 0x3d, 0x2d, 0x24, 0x36, 0xc1, 0x09, 0xa7, 0x2a, 0xc3, 0xe0, 0xc0, 0x5e, 0x85, 0x99, 0xe6,
+	0xa1, 0x2f, 0xaf, 0x1d, 0xa6, 0x94, 0x42, 0xf3, 0xf0, 0xa1, 0xb8, 0x78, 0x77, 0x61, 0x99, 0xa7,
+	0x24, 0x62, 0x46, 0x89, 0x62, 0x9c, 0xb4, 0x13, 0xa7, 0x24, 0xcd, 0xae, 0xd4, 0x55, 0xf5, 0x13,
+	0x65, 0xca, 0x5b, 0x32, 0x44, 0x0f, 0xb4, 0xa4, 0xbd, 0x01, 0x15, 0x21, 0xe2, 0x77, 0x92, 0x26,
+	0xe1, 0xb4, 0xe9, 0xc0, 0x18, 0xcd, 0xb2, 0xf8, 0xfc, 0x3f, 0x14, 0xb0, 0x1d, 0x98, 0x69, 0x53,
+	0xc6, 0x48, 0x8b, 0x3a, 0x65, 0x69, 0x8c, 0x1e, 0xda, 0x3b, 0x50, 0x16, 0x29, 0xda, 0x97, 0x46,
+	0x33, 0xa7, 0x22, 0xc3, 0xe1, 0xf9, 0xb3, 0x83, 0xa9, 0x2e, 0x72, 0xf7, 0xbe, 0x10, 0xf6, 0xa0,
+	0xa1, 0x3f, 0x59, 0xed, 0x47, 0x16, 0x94, 0x32, 0x8e, 0xf0, 0x88, 0x59, 0xf0, 0x70, 0x20, 0x3c,
+	0x12, 0x12, 0xc6, 0xfd, 0xe4, 0x58, 0x1d, 0x77, 0x41, 0x0c, 0xf7, 0x8e, 0xed, 0xab, 0x50, 0x4e,
+	0xe3, 0x13, 0x26, 0x6a, 0x45, 0x40, 0x31, 0xb1, 0xe5, 0x3d, 0x10, 0xa4, 0x2d, 0x49, 0x11, 0x05,
+	0x5d, 0x0a, 0xa4, 0xb4, 0x4d, 0x82, 0x48, 0xc4, 0x1a, 0xa6, 0x88, 0x59, 0x41, 0xf5, 0x34, 0xd1,
+	0xfd, 0x32, 0x07, 0x8b, 0x77, 0x93, 0x24, 0xec, 0x3d, 0xda, 0x97, 0x70, 0x42, 0x63, 0x84, 0x49,
+	0x17, 0xee, 0x3a, 0x54, 0xd8, 0x71, 0x90, 0xf8, 0x29, 0x3d, 0xec, 0x04, 0x21, 0x06, 0x62, 0xd1,
+	0x2b, 0x0b, 0x9a, 0x87, 0x24, 0x79, 0x92, 0x69, 0xcf, 0x4f, 0x3b, 0x91, 0x0a, 0xf1, 0x42, 0x33,
+	0xed, 0x79, 0x1d, 0x79, 0xf0, 0xa2, 0xd2, 0x30, 0x67, 0x4a, 0xde, 0x55, 0x1c, 0xd8, 0x2f, 0x41,
+	0xb1, 0xeb, 0x23, 0x9e, 0x91, 0xf1, 0x54, 0xde, 0x5c, 0xa8, 0x6b, 0x7c, 0x93, 0x15, 0x82, 0x99,
+	0x2e, 0x9a, 0x28, 0x12, 0x0e, 0x7b, 0xa2, 0x0b, 0x97, 0xf8, 0x14, 0x16, 0x25, 0x21, 0x89, 0xfc,
+	0x27, 0x1d, 0x9a, 0x06, 0xb2, 0x6e, 0x89, 0xc9, 0xcb, 0x82, 0xf6, 0x09, 0x92, 0x64, 0xd1, 0x16,
+	0x46, 0x77, 0x49, 0x18, 0x34, 0x65, 0x52, 0x91, 0x31, 0x56, 0xf4, 0xaa, 0x82, 0xfc, 0x28, 0xa3,
+	0xba, 0x3f, 0xb1, 0x60, 0x69, 0xd0, 0x23, 0x0a, 0xdc, 0x98, 0x46, 0x5a, 0x4f, 0x35, 0xf2, 0x3d,
+	0x65, 0x52, 0xe3, 0x31, 0x89, 0x5a, 0x54, 0x27, 0x8d, 0xcb, 0x46, 0x94, 0xa8, 0xf9, 0xf7, 0x42,
+	0x12, 0x6d, 0x49, 0x21, 0x34, 0x18, 0xbf, 0x99, 0xfb, 0x73, 0x0b, 0x16, 0x46, 0x44, 0x84, 0xff,
+	0xc4, 0x26, 0x75, 0xba, 0xc5, 0x81, 0x7d, 0x09, 0x8a, 0x71, 0xd8, 0xf4, 0x85, 0xba, 0x8a, 0x93,
+	0x99, 0x38, 0x6c, 0x0a, 0x35, 0xfb, 0x39, 0x28, 0x09, 0x16, 0x4d, 0xd3, 0x38, 0x55, 0xe8, 0x43,
+	0xc8, 0xee, 0x88, 0xb1, 0xd0, 0x8b, 0xe8, 0x09, 0xea, 0x4d, 0xa1, 0x5e, 0x44, 0x4f, 0xb4, 0x9e,
+	0x60, 0xa1, 0x9e, 0xba, 0xe3, 0x11, 0x3d, 0x91, 0x7a, 0xee, 0x57, 0x16, 0xac, 0xa2, 0x41, 0x07,
+	0x19, 0x34, 0xd0, 0x91, 0xf3, 0x66, 0x86, 0x21, 0x88, 0x48, 0x39, 0x8e, 0x35, 0x29, 0x1f, 0x95,
+	0x79, 0x7f, 0x60, 0xdf, 0x91, 0xd7, 0x5f, 0x20, 0x0f, 0xb9, 0x89, 0xea, 0xe6, 0xd2, 0xb0, 0x92,
+	0x5c, 0xa7, 0xd0, 0x3c, 0x14, 0x7f, 0xcf, 0x8c, 0x31, 0xf7, 0x97, 0x16, 0x38, 0xa3, 0xd6, 0xa9,
+	0x53, 0x7c, 0x1d, 0x66, 0x0f, 0xe9, 0x51, 0x9c, 0x52, 0x5f, 0xe5, 0x4b, 0xb4, 0x6f, 0x7e, 0x78,
+	0x29, 0xaf, 0x82, 0x62, 0x38, 0xb2, 0x5f, 0x85, 0x0a, 0x82, 0x33, 0xa5, 0x95, 0x3b, 0x43, 0xab,
+	0x2c, 0xa5, 0x94, 0xd2, 0x1a, 0x94, 0x4f, 0x08, 0xf3, 0x07, 0xad, 0x2c, 0x9d, 0x10, 0xb6, 0x8d,
+	0x86, 0x7e, 0x9d, 0x87, 0xe5, 0x2d, 0x09, 0x45, 0xb3, 0xf8, 0xe9, 0x43, 0xf4, 0x11, 0xf4, 0xb1,
+	0x04, 0xd3, 0x47, 0xb1, 0x06, 0x1f, 0x45, 0x0f, 0x07, 0xf6, 0x06, 0x2c, 0x91, 0x30, 0x8c, 0x4f,
+	0x7c, 0xda, 0x4e, 0x78, 0xcf, 0xcf, 0x22, 0x14, 0x17, 0x5b, 0x90, 0xbc, 0x1d, 0xc1, 0x52, 0xb1,
+	0x64, 0xbf, 0x0c, 0x4b, 0xb2, 0x64, 0x04, 0x51, 0xcb, 0x6f, 0xc4, 0x61, 0xa7, 0x1d, 0x61, 0xc6,
+	0xc5, 0xf3, 0xb7, 0x35, 0x6f, 0x4b, 0xb2, 0x64, 0xf6, 0x7d, 0x30, 0xaa, 0x21, 0x0f, 0x69, 0x5a,
+	0x1e, 0x92, 0x33, 0x8a, 0xd9, 0x76, 0x9b, 0xd2, 0xe5, 0x43, 0x73, 0xc9, 0x43, 0x7b, 0x1f, 0x2a,
+	0xa2, 0xf6, 0xd1, 0xa6, 0x7f, 0x94, 0xc6, 0x6d, 0xe6, 0x14, 0x86, 0x6b, 0xa9, 0x9e, 0xa3, 0xbe,
+	0x2f, 0xc5, 0xee, 0xa7, 0x71, 0xdb, 0x2b, 0xb3, 0xec, 0x9b, 0xd9, 0xb7, 0x61, 0x4a, 0xae, 0x3e,
+	0x23, 0x57, 0x5f, 0x19, 0xd5, 0x94, 0x6b, 0x4b, 0x19, 0x51, 0x8b, 0x0e, 0x09, 0x33, 0x70, 0x3a,
+	0x96, 0x95, 0x8a, 0x20, 0x6a, 0x71, 0xfb, 0x15, 0x98, 0x65, 0x11, 0x49, 0xd8, 0xe3, 0x98, 0xcb,
+	0xca, 0x32, 0xb6, 0xa8, 0x54, 0xb4, 0x88, 0x18, 0xb9, 0xbb, 0xb0, 0x32, 0x7c, 0x6e, 0x2a, 0xbc,
+	0x36, 0x86, 0xf2, 0x66, 0x79, 0x73, 0xd1, 0xb8, 0xf2, 0x63, 0x40, 0xed, 0x4f, 0x2d, 0xb0, 0x71,
+	0x2e, 0xc4, 0xa2, 0xe7, 0xc8, 0xbf, 0x57, 0x00, 0x10, 0xd1, 0x19, 0x40, 0xb7, 0x24, 0x29, 0x0f,
+	0x07, 0xe2, 0x24, 0x6f, 0xc6, 0xc9, 0x4d, 0xa8, 0x06, 0x51, 0x23, 0xec, 0x34, 0xa9, 0x9f, 0x90,
+	0x54, 0xbc, 0xd1, 0xd4, 0x0b, 0x43, 0x51, 0xf7, 0x24, 0xd1, 0xfd, 0x85, 0x05, 0x8b, 0x03, 0xe6,
+	0x5c, 0x70, 0x5f, 0xf6, 0x2d, 0x13, 0xa6, 0x88, 0x9b, 0xd2, 0x97, 0x36, 0x41, 0x77, 0x16, 0x8e,
+	0x3e, 0x09, 0x53, 0x4a, 0x9a, 0x3d, 0x9f, 0x9e, 0x06, 0x8c, 0x33, 0x65, 0x3c, 0x86, 0xd0, 0x5d,
+	0x64, 0xed, 0x48, 0x8e, 0xfb, 0x09, 0x2c, 0x6f, 0xd3, 0x90, 0x8e, 0x5e, 0x9a, 0x49, 0x3e, 0xbb,
+	0x0c, 0xa5, 0x94, 0x36, 0x3a, 0x29, 0x0b, 0xba, 0xfa, 0x02, 0xf5, 0x09, 0xae, 0x03, 0x2b, 0xc3,
+	0x53, 0xe2, 0xbe, 0x45, 0x35, 0x58, 0x44, 0x96, 0xb4, 0x9a, 0xe9, 0xb5, 0xd6, 0x33, 0xd0, 0x89,
+	0x58, 0x72, 0x74, 0x7f, 0x8a, 0x3f, 0x79, 0x65, 0xf1, 0xf2, 0xa3, 0x5d, 0x1a, 0xf9, 0xc1, 0x51,
+	0x86, 0x09, 0xd5, 0xb9, 0x08, 0xf2, 0xee, 0x91, 0x02, 0x84, 0xee, 0x0a, 0x2c, 0x0d, 0x9a, 0xa1,
+	0xec, 0xeb, 0x69, 0x3a, 0xa6, 0x9c, 0xcc, 0xbe, 0x77, 0xa0, 0x6a, 0x66, 0x61, 0xaa, 0xed, 0x3c,
+	0x23, 0x0f, 0xcf, 0x1a, 0x79, 0x98, 0x32, 0x71, 0x6f, 0x30, 0xa9, 0x28, 0xbc, 0xaa, 0xec, 0xae,
+	0x48, 0xa2, 0x82, 0xaa, 0xee, 0xaa, 0x3e, 0x87, 0x6c, 0x69, 0x65, 0xd3, 0x97, 0x39, 0xb8, 0xb2,
+	0xd3, 0xa6, 0x69, 0x8b, 0x46, 0x8d, 0x9e, 0x47, 0x31, 0xdc, 0xce, 0x1d, 0xdd, 0xe3, 0xf1, 0xed,
+	0x1b, 0x50, 0x96, 0x95, 0x4a, 0xd9, 0x33, 0x11, 0xe4, 0x82, 0xa8, 0x61, 0x28, 0x68, 0xff, 0x0f,
+	0xcc, 0x05, 0xad, 0x48, 0xa4, 0x7b, 0xf5, 0x62, 0x42, 0xe4, 0x71, 0xa6, 0x6e, 0x15, 0xa5, 0xd5,
+	0x1b, 0x84, 0xd9, 0xdb, 0xb0, 0x7c, 0x42, 0x02, 0x9e, 0x69, 0x67, 0xed, 0x91, 0xe9, 0x2c, 0xac,
+	0x05, 0xa5, 0xbe, 0xdd, 0x49, 0xf1, 0xa5, 0xb6, 0x28, 0xc4, 0xb5, 0xba, 0x6e, 0x9b, 0xfc, 0xce,
+	0x82, 0xb5, 0xb3, 0x3c, 0xa2, 0x2e, 0xd8, 0xb3, 0xbb, 0xe4, 0x7d, 0x98, 0x4f, 0xd2, 0xb8, 0x1d,
+	0x73, 0xda, 0x3c, 0x9f, 0x5f, 0xe6, 0xb4, 0xb8, 0x76, 0xce, 0x2d, 0x28, 0xc8, 0x8e, 0x8c, 0xf6,
+	0xc9, 0x70, 0xbf, 0x46, 0x71, 0xdd, 0x77, 0x60, 0xed, 0x7e, 0x10, 0x35, 0xef, 0x86, 0x21, 0x46,
+	0xdf, 0x6e, 0xf4, 0x0c, 0x57, 0xcf, 0xfd, 0xbd, 0x05, 0x57, 0xcf, 0x54, 0x57, 0xbb, 0x7f, 0x38,
+	0x74, 0x9d, 0xde, 0x30, 0xae, 0xd3, 0x53, 0x74, 0xf1, 0xba, 0xa9, 0xe7, 0xaa, 0x7e, 0xfb, 0x7d,
+	0x08, 0x65, 0x83, 0x3c, 0xe6, 0x89, 0x7a, 0x6b, 0xf0, 0x89, 0x3a, 0x26, 0x3d, 0x65, 0x6f, 0x52,
+	0x77, 0x07, 0x16, 0x3e, 0xa0, 0xfc, 0x1e, 0x69, 0x1c, 0x77, 0x12, 0x76, 0xe1, 0x10, 0x76, 0xb7,
+	0xc1, 0x36, 0xa7, 0x51, 0x3b, 0xaf, 0xc3, 0xcc, 0x21, 0x92, 0xd4, 0xd6, 0x97, 0xea, 0x59, 0xa7,
+	0x10, 0x65, 0x77, 0xa3, 0xa3, 0xd8, 0xd3, 0x42, 0xee, 0x25, 0x58, 0xfd, 0x80, 0xf2, 0x2d, 0x1a,
+	0x86, 0x82, 0x2e, 0x12, 0xbe, 0x36, 0xc9, 0x7d, 0x19, 0x9c, 0x51, 0x96, 0x5a, 0x66, 0x09, 0xa6,
+	0x45, 0xb5, 0xd0, 0x4d, 0x3f, 0x1c, 0xb8, 0xeb, 0x60, 0x1b, 0x1a, 0x06, 0xf8, 0x90, 0x9d, 0x21,
+	0xab, 0xdf, 0x19, 0x72, 0xef, 0xc3, 0xe2, 0x80, 0x64, 0x56, 0x16, 0x4a, 0x82, 0xed, 0x07, 0xd1,
+	0x51, 0xec, 0x58, 0xc3, 0x3d, 0x9c, 0x4c, 0xbc, 0xd8, 0x50, 0x5f, 0x22, 0xd3, 0xaa, 0x79, 0x98,
+	0x4a, 0x36, 0xda, 0xfa, 0xaf, 0x2d, 0x58, 0x1d, 0x61, 0xa9, 0x65, 0x76, 0x61, 0x66, 0x30, 0x8d,
+	0x6d, 0x18, 0xe7, 0x75, 0x86, 0x52, 0x5d, 0x8d, 0x31, 0x30, 0xb4, 0x7e, 0x6d, 0x0f, 0x2a, 0x26,
+	0x63, 0x4c, 0x68, 0xdc, 0x1e, 0x0c, 0x8d, 0xa5, 0xc1, 0xfd, 0xe0, 0x32, 0x66, 0x78, 0x2c, 0x4b,
+	0xd7, 0xe8, 0xb0, 0xcc, 0xf6, 0xb3, 0x0b, 0x4b, 0x83, 0x64, 0xb5, 0x97, 0x57, 0xa0, 0xa4, 0x03,
+	0x45, 0xef, 0x66, 0x6c, 0x29, 0xed, 0x4b, 0xb9, 0x2f, 0xcb, 0x63, 0x7a, 0x96, 0x3b, 0x77, 0x7f,
+	0xc0, 0xa6, 0x8b, 0xa3, 0x93, 0x1f, 0xe7, 0x60, 0xfe, 0x03, 0xca, 0x07, 0xdf, 0x86, 0x17, 0x47,
+	0xf8, 0x2b, 0xaa, 0x4b, 0x91, 0xb5, 0x6a, 0x70, 0x24, 0xc0, 0x09, 0x3d, 0x45, 0x70, 0xa2, 0xf8,
+	0x79, 0xc9, 0x9f, 0x55, 0xd4, 0x03, 0x14, 0xbb, 0x01, 0x1a, 0xad, 0xf8, 0xdd, 0x80, 0x9e, 0x30,
+	0x55, 0x2a, 0x2b, 0x8a, 0xf8, 0x48, 0xd0, 0xec, 0x75, 0x98, 0xc7, 0x1e, 0xa9, 0x0c, 0x71, 0x3f,
+	0x8e, 0xc2, 0x9e, 0x4c, 0xd6, 0x45, 0xd5, 0x92, 0x91, 0xf7, 0xe2, 0x7f, 0xa3, 0xb0, 0xd7, 0x97,
+	0x64, 0xc1, 0xe7, 0x5a, 0xb2, 0x60, 0x48, 0xee, 0x07, 0x9f, 0xa3, 0xa4, 0xbb, 0x07, 0x0b, 0x86,
+	0x17, 0x94, 0x33, 0xdf, 0x86, 0xc2, 0xc0, 0x6b, 0xf0, 0x46, 0x7d, 0xb4, 0x77, 0x8f, 0x2a, 0xdb,
+	0xf4, 0x28, 0x88, 0x02, 0xd5, 0x09, 0x94, 0x14, 0xf7, 0x23, 0x98, 0x13, 0x33, 0x7e, 0x37, 0x90,
+	0xcf, 0x7d, 0x0b, 0x4f, 0x69, 0xa0, 0xa0, 0x64, 0x00, 0xcc, 0x9a, 0x08, 0xc0, 0xdc, 0x07, 0xf2,
+	0x46, 0xee, 0xa7, 0xdd, 0xe1, 0x08, 0x7e, 0x5a, 0x8a, 0xc3, 0x77, 0x7c, 0xce, 0x78, 0xc7, 0xbb,
+	0x7f, 0xc2, 0x3b, 0x3c, 0x38, 0x99, 0xb2, 0xe7, 0xff, 0x61, 0x96, 0xa5, 0x5d, 0x7f, 0x38, 0xf6,
+	0x5f, 0x1b, 0xbc, 0xc9, 0xe3, 0x54, 0xeb, 0x26, 0x51, 0xb7, 0x25, 0x0d, 0x52, 0xed, 0x11, 0x2c,
+	0x8c, 0x88, 0x8c, 0xb9, 0xd8, 0x2f, 0x0e, 0x5e, 0x6c, 0x23, 0x60, 0x0d, 0x6d, 0xf3, 0x66, 0xdf,
+	0x96, 0x57, 0x78, 0x3f, 0xed, 0x0e, 0x35, 0x47, 0xc6, 0x25, 0xc8, 0x87, 0xb0, 0x3c, 0x24, 0x9b,
+	0x3d, 0x38, 0x85, 0xb1, 0xfe, 0x50, 0xeb, 0x60, 0x31, 0x6b, 0x1d, 0x18, 0x2a, 0xc0, 0xb2, 0x6f,
+	0xf7, 0x23, 0x79, 0xa4, 0xea, 0x55, 0xf9, 0x6d, 0x2f, 0x9e, 0xfb, 0xae, 0x0c, 0x60, 0x3d, 0x9b,
+	0xb2, 0x6c, 0x3d, 0xeb, 0x19, 0x9e, 0xf5, 0x06, 0x56, 0x7c, 0xf7, 0xd7, 0x96, 0xa1, 0x7f, 0xf1,
+	0x12, 0xd8, 0x8f, 0x9a, 0xbc, 0xd9, 0xfd, 0x11, 0x0d, 0x5c, 0x9e, 0x06, 0x0d, 0xfd, 0x24, 0x51,
+	0xa3, 0x31, 0x18, 0x76, 0xfa, 0xfc, 0x18, 0xd6, 0x7d, 0x5f, 0x26, 0xcd, 0x21, 0x6c, 0x6a, 0xdf,
+	0x86, 0x19, 0x14, 0xeb, 0x03, 0xf7, 0xe1, 0x4d, 0x6b, 0x01, 0x77, 0x43, 0x6e, 0xfa, 0xfc, 0x8d,
+	0x31, 0xf7, 0x1e, 0xd8, 0xa6, 0xc2, 0x45, 0xfa, 0x46, 0xae, 0x27, 0x33, 0xb7, 0xee, 0x20, 0x9e,
+	0xcb, 0xd7, 0x57, 0xa1, 0x4c, 0x1a, 0x3c, 0xe8, 0x52, 0x4c, 0x61, 0x88, 0xd5, 0x01, 0x49, 0x32,
+	0x7d, 0x61, 0x29, 0x32, 0xe6, 0xec, 0x97, 0x22, 0xfd, 0xa3, 0xd6, 0xb8, 0x52, 0xa4, 0x15, 0xbc,
+	0xbe, 0x94, 0xfb, 0x4f, 0x0b, 0x56, 0x77, 0xa3, 0x00, 0x73, 0x8d, 0xc2, 0x91, 0x17, 0x8f, 0x07,
+	0x0f, 0x6a, 0xba, 0x23, 0x4e, 0x43, 0xda, 0xe0, 0xbe, 0x79, 0xde, 0x93, 0xc1, 0xec, 0xaa, 0x52,
+	0xdc, 0x11, 0x7a, 0x06, 0xa3, 0xff, 0xfc, 0x9d, 0x32, 0x9f, 0xbf, 0xdf, 0x0d, 0x8e, 0xbf, 0x07,
+	0xce, 0xe8, 0xe6, 0xb3, 0x7c, 0xab, 0xc1, 0xb4, 0x35, 0x11, 0x4c, 0x7f, 0x91, 0x83, 0xe7, 0x44,
+	0x87, 0x2d, 0xa2, 0xcd, 0x7f, 0xf3, 0xdb, 0xe8, 0x2d, 0x98, 0x25, 0xdd, 0x38, 0xe8, 0xbf, 0x1e,
+	0xa6, 0x26, 0x69, 0x56, 0xa4, 0xac, 0xd6, 0xfd, 0x6e, 0xfc, 0xf9, 0x5b, 0x0b, 0x2e, 0x8f, 0xf7,
+	0xc5, 0x7f, 0xc0, 0xab, 0xe8, 0x87, 0x70, 0xc9, 0xa3, 0xed, 0xb8, 0x9b, 0x35, 0x0d, 0x04, 0x3c,
+	0x3c, 0xcf, 0x29, 0xea, 0xf2, 0x91, 0x33, 0x7e, 0x79, 0x1d, 0xdf, 0xb4, 0x19, 0xe8, 0x1d, 0x4c,
+	0x0d, 0x77, 0x2d, 0x2e, 0x43, 0x6d, 0x9c, 0x01, 0xea, 0x15, 0xfe, 0x95, 0x05, 0x2b, 0xc8, 0x96,
+	0x2e, 0x3d, 0xaf, 0x71, 0x4f, 0x69, 0x2e, 0x69, 0xdb, 0xf3, 0xe3, 0x6c, 0x9f, 0x3a, 0xd3, 0xf6,
+	0xe9, 0x61, 0xdb, 0x2f, 0xc1, 0xea, 0x88, 0x71, 0xca, 0xf0, 0xfb, 0xb0, 0xac, 0x83, 0x61, 0xb0,
+	0xfc, 0xdd, 0x19, 0xaa, 0x57, 0x93, 0x7f, 0xe3, 0x72, 0x7f, 0x00, 0x2b, 0xc3, 0xf3, 0x5c, 0x38,
+	0xaa, 0x36, 0x60, 0xe6, 0x5c, 0xc1, 0xa4, 0xa5, 0xdc, 0x03, 0xb8, 0xa6, 0x22, 0x39, 0xfb, 0x31,
+	0x53, 0xff, 0xf8, 0xf5, 0x2d, 0x9e, 0x90, 0xbf, 0xca, 0xc3, 0xf5, 0x09, 0xd3, 0xaa, 0xed, 0x9d,
+	0xc2, 0x92, 0xf9, 0xf3, 0x30, 0xe3, 0x84, 0x77, 0xfa, 0x4f, 0xa7, 0x9d, 0x11, 0x20, 0x38, 0x61,
+	0x2e, 0xf3, 0xc7, 0xe8, 0x7d, 0x35, 0x0f, 0x22, 0xb0, 0xc5, 0x74, 0x94, 0x63, 0x7f, 0x06, 0xa0,
+	0x32, 0x78, 0x9b, 0x24, 0xea, 0x27, 0x8f, 0xb7, 0x9f, 0x69, 0x3d, 0x74, 0xe6, 0xc7, 0x24, 0xc1,
+	0x55, 0x4a, 0x5c, 0x8f, 0x6b, 0x3e, 0x38, 0x67, 0x19, 0x33, 0x06, 0xeb, 0xdd, 0x19, 0xc4, 0x7a,
+	0xab, 0xf5, 0xe1, 0x7f, 0xb7, 0xc1, 0x09, 0xcc, 0x9f, 0x9e, 0x1f, 0x42, 0x75, 0x70, 0xf5, 0xf3,
+	0xb4, 0x0d, 0x86, 0xc1, 0x83, 0x81, 0x1e, 0x3d, 0xb8, 0x8e, 0xc4, 0x1d, 0xf5, 0x7f, 0x11, 0x61,
+	0xd6, 0xfa, 0xa1, 0xcd, 0x0b, 0xc6, 0xf4, 0x1f, 0x2c, 0x70, 0x27, 0x4d, 0x7a, 0xe1, 0x00, 0xbf,
+	0x68, 0x0d, 0x79, 0x03, 0xca, 0xf2, 0x97, 0xa7, 0xf3, 0x54, 0x10, 0x10, 0xbf, 0x49, 0xa1, 0xe0,
+	0xbd, 0x37, 0xff, 0xf8, 0xcd, 0x9a, 0xf5, 0xe7, 0x6f, 0xd6, 0xac, 0xbf, 0x7e, 0xb3, 0x66, 0xfd,
+	0xec, 0x6f, 0x6b, 0xff, 0xf5, 0xd9, 0xad, 0x6e, 0xc0, 0x29, 0x63, 0xf5, 0x20, 0xde, 0xc0, 0xaf,
+	0x8d, 0x56, 0xbc, 0xd1, 0xe5, 0x1b, 0xf2, 0x3f, 0x9a, 0x36, 0xb2, 0x18, 0x3a, 0x2c, 0x48, 0xc2,
+	0xab, 0xff, 0x1a, 0x00, 0xce, 0x77, 0xc0, 0x17, 0x8e, 0x25, 0x00, 0x00,
 }
 
 func (m *ExecuteVtctlCommandRequest) Marshal() (dAtA []byte, err error) {
@@ -5067,6 +5350,16 @@ func (m *Workflow_Stream_CopyState) MarshalToSizedBuffer(dAtA []byte) (int, erro
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.RowsRemaining != 0 {
+		i = encodeVarintVtctldata(dAtA, i, uint64(m.RowsRemaining))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RowsCopied != 0 {
+		i = encodeVarintVtctldata(dAtA, i, uint64(m.RowsCopied))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.LastPk) > 0 {
 		i -= len(m.LastPk)
 		copy(dAtA[i:], m.LastPk)
@@ -5084,6 +5377,222 @@ func (m *Workflow_Stream_CopyState) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplyVSchemaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyVSchemaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyVSchemaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.SkipValidation {
+		i--
+		if m.SkipValidation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PlanQueries) > 0 {
+		for iNdEx := len(m.PlanQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PlanQueries[iNdEx])
+			copy(dAtA[i:], m.PlanQueries[iNdEx])
+			i = encodeVarintVtctldata(dAtA, i, uint64(len(m.PlanQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Sql) > 0 {
+		i -= len(m.Sql)
+		copy(dAtA[i:], m.Sql)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.Sql)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.VSchema != nil {
+		{
+			size, err := m.VSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVtctldata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Cells) > 0 {
+		for iNdEx := len(m.Cells) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Cells[iNdEx])
+			copy(dAtA[i:], m.Cells[iNdEx])
+			i = encodeVarintVtctldata(dAtA, i, uint64(len(m.Cells[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SkipRebuild {
+		i--
+		if m.SkipRebuild {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Keyspace) > 0 {
+		i -= len(m.Keyspace)
+		copy(dAtA[i:], m.Keyspace)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.Keyspace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplyVSchemaResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplyVSchemaResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplyVSchemaResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PlanChanges) > 0 {
+		for iNdEx := len(m.PlanChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlanChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintVtctldata(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.VSchema != nil {
+		{
+			size, err := m.VSchema.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintVtctldata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VSchemaPlanChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VSchemaPlanChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VSchemaPlanChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NewError) > 0 {
+		i -= len(m.NewError)
+		copy(dAtA[i:], m.NewError)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.NewError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NewPlan) > 0 {
+		i -= len(m.NewPlan)
+		copy(dAtA[i:], m.NewPlan)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.NewPlan)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OldError) > 0 {
+		i -= len(m.OldError)
+		copy(dAtA[i:], m.OldError)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.OldError)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldPlan) > 0 {
+		i -= len(m.OldPlan)
+		copy(dAtA[i:], m.OldPlan)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.OldPlan)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Query) > 0 {
+		i -= len(m.Query)
+		copy(dAtA[i:], m.Query)
+		i = encodeVarintVtctldata(dAtA, i, uint64(len(m.Query)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ChangeTabletTypeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8183,50 +8692,78 @@ func (m *Workflow_Stream_CopyState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVtctldata(uint64(l))
 	}
+	if m.RowsCopied != 0 {
+		n += 1 + sovVtctldata(uint64(m.RowsCopied))
+	}
+	if m.RowsRemaining != 0 {
+		n += 1 + sovVtctldata(uint64(m.RowsRemaining))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ChangeTabletTypeRequest) Size() (n int) {
+func (m *ApplyVSchemaRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.TabletAlias != nil {
-		l = m.TabletAlias.Size()
+	l = len(m.Keyspace)
+	if l > 0 {
 		n += 1 + l + sovVtctldata(uint64(l))
 	}
-	if m.DbType != 0 {
-		n += 1 + sovVtctldata(uint64(m.DbType))
+	if m.SkipRebuild {
+		n += 2
 	}
 	if m.DryRun {
 		n += 2
 	}
+	if len(m.Cells) > 0 {
+		for _, s := range m.Cells {
+			l = len(s)
+			n += 1 + l + sovVtctldata(uint64(l))
+		}
+	}
+	if m.VSchema != nil {
+		l = m.VSchema.Size()
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	l = len(m.Sql)
+	if l > 0 {
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	if len(m.PlanQueries) > 0 {
+		for _, s := range m.PlanQueries {
+			l = len(s)
+			n += 1 + l + sovVtctldata(uint64(l))
+		}
+	}
+	if m.SkipValidation {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
 	return n
 }
 
-func (m *ChangeTabletTypeResponse) Size() (n int) {
+func (m *ApplyVSchemaResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BeforeTablet != nil {
-		l = m.BeforeTablet.Size()
-		n += 1 + l + sovVtctldata(uint64(l))
-	}
-	if m.AfterTablet != nil {
-		l = m.AfterTablet.Size()
+	if m.VSchema != nil {
+		l = m.VSchema.Size()
 		n += 1 + l + sovVtctldata(uint64(l))
 	}
-	if m.WasDryRun {
-		n += 2
+	if len(m.PlanChanges) > 0 {
+		for _, e := range m.PlanChanges {
+			l = e.Size()
+			n += 1 + l + sovVtctldata(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -8234,33 +8771,110 @@ func (m *ChangeTabletTypeResponse) Size() (n int) {
 	return n
 }
 
-func (m *CreateKeyspaceRequest) Size() (n int) {
+func (m *VSchemaPlanChange) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Query)
 	if l > 0 {
 		n += 1 + l + sovVtctldata(uint64(l))
 	}
-	if m.Force {
-		n += 2
+	l = len(m.OldPlan)
+	if l > 0 {
+		n += 1 + l + sovVtctldata(uint64(l))
 	}
-	if m.AllowEmptyVSchema {
-		n += 2
+	l = len(m.OldError)
+	if l > 0 {
+		n += 1 + l + sovVtctldata(uint64(l))
 	}
-	l = len(m.ShardingColumnName)
+	l = len(m.NewPlan)
 	if l > 0 {
 		n += 1 + l + sovVtctldata(uint64(l))
 	}
-	if m.ShardingColumnType != 0 {
-		n += 1 + sovVtctldata(uint64(m.ShardingColumnType))
+	l = len(m.NewError)
+	if l > 0 {
+		n += 1 + l + sovVtctldata(uint64(l))
 	}
-	if len(m.ServedFroms) > 0 {
-		for _, e := range m.ServedFroms {
-			l = e.Size()
-			n += 1 + l + sovVtctldata(uint64(l))
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeTabletTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TabletAlias != nil {
+		l = m.TabletAlias.Size()
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	if m.DbType != 0 {
+		n += 1 + sovVtctldata(uint64(m.DbType))
+	}
+	if m.DryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ChangeTabletTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BeforeTablet != nil {
+		l = m.BeforeTablet.Size()
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	if m.AfterTablet != nil {
+		l = m.AfterTablet.Size()
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	if m.WasDryRun {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CreateKeyspaceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	if m.Force {
+		n += 2
+	}
+	if m.AllowEmptyVSchema {
+		n += 2
+	}
+	l = len(m.ShardingColumnName)
+	if l > 0 {
+		n += 1 + l + sovVtctldata(uint64(l))
+	}
+	if m.ShardingColumnType != 0 {
+		n += 1 + sovVtctldata(uint64(m.ShardingColumnType))
+	}
+	if len(m.ServedFroms) > 0 {
+		for _, e := range m.ServedFroms {
+			l = e.Size()
+			n += 1 + l + sovVtctldata(uint64(l))
 		}
 	}
 	if m.Type != 0 {
@@ -11433,6 +12047,660 @@ func (m *Workflow_Stream_CopyState) Unmarshal(dAtA []byte) error {
 			}
 			m.LastPk = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsCopied", wireType)
+			}
+			m.RowsCopied = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsCopied |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RowsRemaining", wireType)
+			}
+			m.RowsRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RowsRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVtctldata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyVSchemaRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVtctldata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyVSchemaRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyVSchemaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyspace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keyspace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipRebuild", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipRebuild = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cells", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cells = append(m.Cells, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VSchema == nil {
+				m.VSchema = &vschema.Keyspace{}
+			}
+			if err := m.VSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sql", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sql = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanQueries = append(m.PlanQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SkipValidation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SkipValidation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVtctldata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplyVSchemaResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVtctldata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplyVSchemaResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplyVSchemaResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VSchema", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VSchema == nil {
+				m.VSchema = &vschema.Keyspace{}
+			}
+			if err := m.VSchema.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanChanges = append(m.PlanChanges, &VSchemaPlanChange{})
+			if err := m.PlanChanges[len(m.PlanChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVtctldata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VSchemaPlanChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVtctldata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VSchemaPlanChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VSchemaPlanChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Query", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Query = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldPlan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldPlan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OldError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPlan", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPlan = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtctldata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtctldata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtctldata(dAtA[iNdEx:])
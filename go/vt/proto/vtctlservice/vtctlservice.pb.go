@@ -29,55 +29,55 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 func init() { proto.RegisterFile("vtctlservice.proto", fileDescriptor_27055cdbb1148d2b) }
 
 var fileDescriptor_27055cdbb1148d2b = []byte{
-	// 753 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xdf, 0x4e, 0x14, 0x3f,
-	0x14, 0xc7, 0xd9, 0x8b, 0x1f, 0xf9, 0x59, 0x51, 0x48, 0x25, 0x1a, 0x17, 0x76, 0x58, 0x50, 0x54,
-	0xfc, 0xc3, 0x1a, 0xbc, 0xf4, 0x0a, 0xd6, 0x15, 0x09, 0x09, 0x41, 0x20, 0x90, 0x90, 0x70, 0x51,
-	0x66, 0x0e, 0xec, 0x84, 0x99, 0x76, 0x98, 0x96, 0x95, 0x8d, 0x2f, 0xe2, 0x1b, 0xe9, 0xa5, 0x8f,
-	0x60, 0xf0, 0x45, 0xcc, 0x4e, 0xb7, 0xa5, 0xd3, 0x69, 0xd9, 0xbd, 0x82, 0xed, 0xe7, 0x7b, 0xbe,
-	0xa7, 0xff, 0xce, 0xe9, 0x20, 0xdc, 0x13, 0xa1, 0x48, 0x38, 0xe4, 0xbd, 0x38, 0x84, 0xd5, 0x2c,
-	0x67, 0x82, 0xe1, 0x29, 0x73, 0xac, 0x3e, 0x5d, 0xfc, 0x8a, 0x88, 0x20, 0x12, 0xaf, 0x5d, 0xa2,
-	0xff, 0x0e, 0x07, 0x43, 0xb8, 0x8b, 0x1e, 0x75, 0xae, 0x21, 0xbc, 0x12, 0x50, 0xfc, 0x6e, 0xb3,
-	0x34, 0x25, 0x34, 0xc2, 0xcb, 0xab, 0xb7, 0x11, 0x0e, 0xbe, 0x07, 0x97, 0x57, 0xc0, 0x45, 0xfd,
-	0xc5, 0x28, 0x19, 0xcf, 0x18, 0xe5, 0xb0, 0x34, 0xf1, 0xbe, 0xb6, 0xf6, 0x73, 0x16, 0x4d, 0x16,
-	0x30, 0xc2, 0x27, 0x68, 0xa6, 0xdd, 0x25, 0xf4, 0x1c, 0x0e, 0xc8, 0x69, 0x02, 0xe2, 0xa0, 0x9f,
-	0x01, 0x5e, 0x32, 0xac, 0x6c, 0xa8, 0xd2, 0x3d, 0xbb, 0x53, 0xa3, 0x72, 0xe1, 0x23, 0xf4, 0xb0,
-	0x9d, 0x03, 0x11, 0xb0, 0x0d, 0x7d, 0x9e, 0x91, 0x10, 0x70, 0xd3, 0x0c, 0x2c, 0x21, 0x65, 0xbd,
-	0x78, 0x87, 0x42, 0x1b, 0xef, 0xa0, 0xfb, 0x92, 0xed, 0x77, 0x49, 0x1e, 0xe1, 0x46, 0x25, 0xa6,
-	0x18, 0x57, 0x96, 0x81, 0x0f, 0x9b, 0x13, 0xfd, 0x04, 0x09, 0x78, 0x26, 0x5a, 0x46, 0xae, 0x89,
-	0xda, 0x0a, 0x6d, 0xfc, 0x15, 0x4d, 0x49, 0x56, 0x64, 0xe4, 0x38, 0xa8, 0x04, 0x49, 0xa0, 0x4c,
-	0x17, 0xbc, 0x5c, 0x5b, 0x1e, 0xa0, 0x07, 0x92, 0xc8, 0x2d, 0xe7, 0xb8, 0x1a, 0x33, 0x24, 0xca,
-	0xb4, 0xe9, 0x17, 0x68, 0x57, 0x86, 0x1e, 0x77, 0x52, 0xc8, 0xcf, 0x81, 0x86, 0xfd, 0x3d, 0xc8,
-	0x48, 0x0e, 0x54, 0xc8, 0xcd, 0x7d, 0x65, 0x5e, 0x2d, 0xa7, 0x44, 0xe5, 0x59, 0x19, 0x43, 0xa9,
-	0x13, 0xe6, 0xe8, 0xc9, 0xe7, 0x98, 0x46, 0xeb, 0x49, 0x22, 0x57, 0xb8, 0x45, 0xf5, 0xde, 0x9b,
-	0x3e, 0x1e, 0x8d, 0x4a, 0xf9, 0x7a, 0x1c, 0xa9, 0xce, 0xb9, 0x8d, 0xd0, 0x26, 0x88, 0x0d, 0x12,
-	0x5e, 0x5c, 0x65, 0x1c, 0xcf, 0x1b, 0xb1, 0xb7, 0xc3, 0xca, 0xb9, 0xe1, 0xa1, 0xda, 0xec, 0x04,
-	0xcd, 0x6c, 0x82, 0x68, 0x43, 0x92, 0x6c, 0xd1, 0x33, 0xb6, 0x43, 0x52, 0xe0, 0xa5, 0xda, 0xb1,
-	0xa1, 0xab, 0x76, 0xaa, 0x1a, 0xf3, 0x8a, 0x1b, 0x14, 0x37, 0xdc, 0x51, 0xae, 0x2b, 0x5e, 0xc2,
-	0xda, 0xef, 0x18, 0x4d, 0x0f, 0x01, 0x5f, 0x4f, 0x62, 0xc2, 0x81, 0xe3, 0xc5, 0x6a, 0x90, 0x62,
-	0xca, 0x77, 0xe9, 0x2e, 0x89, 0x35, 0x57, 0x7d, 0x7e, 0xd6, 0x5c, 0xed, 0x33, 0x0b, 0x7c, 0xd8,
-	0xac, 0x1a, 0x03, 0x94, 0xab, 0xc6, 0x04, 0xae, 0xaa, 0x29, 0x73, 0x6d, 0xf9, 0x05, 0xdd, 0xdb,
-	0x04, 0xb1, 0x1f, 0x76, 0x21, 0x25, 0x78, 0xae, 0xac, 0x97, 0xa3, 0xca, 0x6c, 0xde, 0x0d, 0xb5,
-	0x53, 0x07, 0xfd, 0x3f, 0x18, 0x2e, 0x6a, 0xa3, 0x6e, 0x69, 0xcd, 0x6a, 0x98, 0x73, 0x32, 0xeb,
-	0x3c, 0xf6, 0xf3, 0xde, 0xed, 0x32, 0xad, 0xf3, 0x30, 0x99, 0xe7, 0x3c, 0xca, 0x12, 0xb3, 0x45,
-	0x48, 0x78, 0x38, 0x5c, 0xf0, 0x42, 0x25, 0xec, 0xb0, 0xbc, 0xe8, 0xa6, 0x5f, 0x60, 0x6d, 0xa1,
-	0x6c, 0x1d, 0xf6, 0x16, 0xca, 0x51, 0xcf, 0x16, 0x2a, 0x68, 0xd5, 0xa1, 0xea, 0x5f, 0x4e, 0xb5,
-	0xaf, 0x0e, 0xab, 0x9d, 0x4b, 0x9a, 0xa9, 0x95, 0x5a, 0x66, 0xd6, 0x32, 0x1b, 0x1e, 0x6a, 0xdd,
-	0xbc, 0x23, 0x96, 0x5f, 0x9c, 0x25, 0xec, 0x5b, 0xe5, 0xe6, 0x69, 0xe0, 0xb9, 0x79, 0x06, 0x37,
-	0xfb, 0xc4, 0x16, 0x8d, 0xe5, 0xf9, 0xef, 0xe6, 0x71, 0x4a, 0xf2, 0x7e, 0xa9, 0x4f, 0xd8, 0xd0,
-	0xd5, 0x27, 0xaa, 0x1a, 0x6d, 0x1f, 0xa3, 0xd9, 0xdd, 0x84, 0x50, 0x0a, 0x51, 0xb9, 0x6d, 0x9b,
-	0x5f, 0x04, 0x2e, 0x81, 0x4a, 0xf3, 0x72, 0xa4, 0x4e, 0xa7, 0x0a, 0x11, 0xde, 0x83, 0x94, 0xf5,
-	0xf4, 0x43, 0x37, 0x68, 0x07, 0xf8, 0xb9, 0x61, 0x50, 0xc5, 0x2a, 0xcd, 0xf2, 0x08, 0x95, 0x59,
-	0x17, 0x92, 0x17, 0xd9, 0x8b, 0x0c, 0x8b, 0x95, 0x58, 0xcd, 0x5c, 0x75, 0x51, 0x91, 0x98, 0xcf,
-	0xbc, 0x5a, 0xdb, 0xf0, 0x1a, 0x37, 0x4b, 0x71, 0x26, 0x72, 0x3d, 0xf3, 0xb6, 0x42, 0x1b, 0x5f,
-	0xa3, 0xa7, 0xc3, 0xcd, 0xca, 0x92, 0x38, 0x24, 0x22, 0x66, 0x74, 0x97, 0xf1, 0x78, 0xf0, 0x97,
-	0xe3, 0x37, 0x86, 0x83, 0x57, 0xa5, 0xd2, 0xbd, 0x1d, 0x4f, 0xac, 0x33, 0x7f, 0x47, 0x75, 0x39,
-	0x9b, 0xce, 0xb5, 0x80, 0x9c, 0x92, 0x24, 0xd1, 0x2f, 0x2e, 0x44, 0xd8, 0x74, 0xf3, 0xcb, 0x54,
-	0xee, 0x77, 0x63, 0xaa, 0x55, 0xf2, 0x8d, 0x8f, 0xbf, 0x6e, 0x82, 0xda, 0xef, 0x9b, 0xa0, 0xf6,
-	0xe7, 0x26, 0xa8, 0xfd, 0xf8, 0x1b, 0x4c, 0x1c, 0xaf, 0xf4, 0x62, 0x01, 0x9c, 0xaf, 0xc6, 0xac,
-	0x25, 0xff, 0x6b, 0x9d, 0xb3, 0x56, 0x4f, 0xb4, 0x8a, 0x8f, 0xdd, 0x96, 0xf9, 0x29, 0x7c, 0x3a,
-	0x59, 0x8c, 0x7d, 0xf8, 0x17, 0x00, 0x00, 0xff, 0xff, 0xe0, 0x50, 0xd7, 0x8b, 0x35, 0x0b, 0x00,
-	0x00,
+	// 765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xdb, 0x4e, 0x1b, 0x3b,
+	0x14, 0x86, 0xc9, 0xc5, 0x46, 0x7b, 0x7b, 0xd3, 0x52, 0xb9, 0x27, 0x35, 0x90, 0x10, 0x68, 0x69,
+	0x4b, 0x0f, 0xa4, 0xa2, 0x97, 0xbd, 0x82, 0x34, 0xa5, 0x08, 0x09, 0x71, 0x12, 0x48, 0x48, 0xbd,
+	0x30, 0x93, 0x05, 0x19, 0xe1, 0xcc, 0x0c, 0x63, 0x93, 0x12, 0xf5, 0x45, 0xaa, 0x3e, 0x51, 0x2f,
+	0xfb, 0x08, 0x15, 0x7d, 0x91, 0x2a, 0xe3, 0xd8, 0x2c, 0x7b, 0x6c, 0xc8, 0x15, 0xc4, 0xdf, 0xbf,
+	0xfe, 0xe5, 0xd3, 0x5a, 0x1e, 0x42, 0xfb, 0x32, 0x92, 0x5c, 0x40, 0xde, 0x8f, 0x23, 0x58, 0xce,
+	0xf2, 0x54, 0xa6, 0x74, 0x0a, 0x8f, 0x55, 0xa7, 0x8b, 0x5f, 0x1d, 0x26, 0x99, 0xc2, 0x2b, 0xe7,
+	0xe4, 0x9f, 0x83, 0xe1, 0x10, 0xed, 0x92, 0xfb, 0xed, 0x4b, 0x88, 0x2e, 0x24, 0x14, 0xbf, 0x5b,
+	0x69, 0xaf, 0xc7, 0x92, 0x0e, 0x5d, 0x5c, 0xbe, 0x8e, 0xf0, 0xf0, 0x5d, 0x38, 0xbf, 0x00, 0x21,
+	0xab, 0xcf, 0x6f, 0x93, 0x89, 0x2c, 0x4d, 0x04, 0x2c, 0x4c, 0xbc, 0xab, 0xac, 0xfc, 0x78, 0x48,
+	0x26, 0x0b, 0xd8, 0xa1, 0x3b, 0x64, 0x6a, 0x35, 0xcb, 0xf8, 0xe0, 0x60, 0x2f, 0xea, 0x42, 0x8f,
+	0xd1, 0x3a, 0xb2, 0xc1, 0x40, 0xa7, 0x99, 0x0b, 0x72, 0xed, 0x4f, 0xbf, 0x90, 0x7b, 0xad, 0x2e,
+	0x4b, 0x4e, 0x61, 0x9f, 0x1d, 0x73, 0x90, 0xfb, 0x83, 0x0c, 0xe8, 0x02, 0x0a, 0x73, 0xa1, 0xb6,
+	0x7e, 0x7a, 0xa3, 0xc6, 0xd8, 0x1f, 0x92, 0xbb, 0xad, 0x1c, 0x98, 0x84, 0x4d, 0x18, 0x88, 0x8c,
+	0x45, 0x40, 0x1b, 0x38, 0xd0, 0x42, 0xda, 0x7a, 0xfe, 0x06, 0x85, 0x31, 0xde, 0x22, 0xff, 0x2b,
+	0xb6, 0xd7, 0x65, 0x79, 0x87, 0xd6, 0x4a, 0x31, 0xc5, 0xb8, 0xb6, 0xac, 0x87, 0x30, 0x9e, 0xe8,
+	0x47, 0xe0, 0x10, 0x98, 0xa8, 0x8d, 0x7c, 0x13, 0x75, 0x15, 0xc6, 0x78, 0x87, 0x4c, 0x29, 0x56,
+	0x64, 0x14, 0xd6, 0x99, 0x61, 0xe0, 0x3b, 0x33, 0x9b, 0x1b, 0xcb, 0x7d, 0x72, 0x47, 0x11, 0xb5,
+	0xe5, 0x82, 0x96, 0x63, 0x46, 0x44, 0x9b, 0x36, 0xc2, 0x02, 0xe3, 0x9a, 0x92, 0x47, 0xed, 0x1e,
+	0xe4, 0xa7, 0x90, 0x44, 0x83, 0x5d, 0xc8, 0x58, 0x0e, 0x89, 0x54, 0x9b, 0xfb, 0x12, 0xdf, 0x56,
+	0xaf, 0x44, 0xe7, 0x59, 0x1a, 0x43, 0x69, 0x12, 0xe6, 0xe4, 0xf1, 0xa7, 0x38, 0xe9, 0xac, 0x72,
+	0xae, 0x56, 0xb8, 0x91, 0x98, 0xbd, 0xc7, 0x3e, 0x01, 0x8d, 0x4e, 0xf9, 0x6a, 0x1c, 0xa9, 0xc9,
+	0xb9, 0x49, 0xc8, 0x3a, 0xc8, 0x35, 0x16, 0x9d, 0x5d, 0x64, 0x82, 0xce, 0xa2, 0xd8, 0xeb, 0x61,
+	0xed, 0x5c, 0x0b, 0x50, 0x5c, 0x3b, 0xeb, 0x20, 0x5b, 0xc0, 0xf9, 0x46, 0x72, 0x92, 0x6e, 0xb1,
+	0x1e, 0x08, 0xab, 0x76, 0x5c, 0xe8, 0xab, 0x9d, 0xb2, 0x06, 0x5f, 0x71, 0x44, 0x69, 0xcd, 0x1f,
+	0xe5, 0xbb, 0xe2, 0x16, 0x36, 0x7e, 0x47, 0x64, 0x7a, 0x04, 0xc4, 0x2a, 0x8f, 0x99, 0x00, 0x41,
+	0xe7, 0xcb, 0x41, 0x9a, 0x69, 0xdf, 0x85, 0x9b, 0x24, 0xce, 0x5c, 0xcd, 0xf9, 0x39, 0x73, 0x75,
+	0xcf, 0xac, 0x1e, 0xc2, 0xb8, 0x6a, 0x10, 0xb0, 0xab, 0x06, 0x03, 0x5f, 0xd5, 0xd8, 0xdc, 0x58,
+	0x7e, 0x26, 0xff, 0xad, 0x83, 0x1c, 0x75, 0xce, 0x19, 0x5b, 0x6f, 0xb7, 0xcd, 0x59, 0x3f, 0x34,
+	0x4e, 0x6d, 0xf2, 0xef, 0x70, 0xb8, 0xa8, 0x8d, 0xaa, 0xa3, 0xc5, 0xd5, 0x30, 0xe3, 0x65, 0xce,
+	0x79, 0xec, 0xe5, 0xfd, 0xeb, 0x65, 0x3a, 0xe7, 0x81, 0x59, 0xe0, 0x3c, 0x6c, 0x09, 0x6e, 0x11,
+	0x0a, 0xea, 0xa7, 0x62, 0xae, 0x14, 0xe6, 0xbc, 0x15, 0x8d, 0xb0, 0xc0, 0xd9, 0x42, 0xd5, 0x3a,
+	0xdc, 0x2d, 0x54, 0xa3, 0x81, 0x2d, 0xd4, 0xd0, 0xa9, 0x43, 0xdd, 0xbf, 0xbc, 0xea, 0x50, 0x1d,
+	0x96, 0x3b, 0x97, 0x32, 0xd3, 0x2b, 0x75, 0xcc, 0x9c, 0x65, 0xd6, 0x02, 0xd4, 0xb9, 0x79, 0x87,
+	0x69, 0x7e, 0x76, 0xc2, 0xd3, 0xaf, 0xa5, 0x9b, 0x67, 0x40, 0xe0, 0xe6, 0x21, 0x8e, 0xfb, 0xc4,
+	0x46, 0x12, 0xab, 0xf3, 0xdf, 0xce, 0xe3, 0x1e, 0xcb, 0x07, 0x56, 0x9f, 0x70, 0xa1, 0xaf, 0x4f,
+	0x94, 0x35, 0xc6, 0x3e, 0x26, 0x0f, 0xb6, 0x39, 0x4b, 0x12, 0xe8, 0xd8, 0x6d, 0x1b, 0x7f, 0x64,
+	0xf8, 0x04, 0x3a, 0xcd, 0x8b, 0x5b, 0x75, 0x26, 0x55, 0x44, 0xe8, 0x2e, 0xf4, 0xd2, 0xbe, 0x79,
+	0xe8, 0x86, 0xed, 0x80, 0x3e, 0x43, 0x06, 0x65, 0xac, 0xd3, 0x2c, 0xde, 0xa2, 0xc2, 0x75, 0xa1,
+	0x78, 0x91, 0xbd, 0xc8, 0x30, 0x5f, 0x8a, 0x35, 0xcc, 0x57, 0x17, 0x25, 0x09, 0x7e, 0xe6, 0xf5,
+	0xda, 0x46, 0xd7, 0xb8, 0x61, 0xc5, 0x61, 0xe4, 0x7b, 0xe6, 0x5d, 0x85, 0x31, 0xbe, 0x24, 0x4f,
+	0x46, 0x9b, 0x95, 0xf1, 0x38, 0x62, 0x32, 0x4e, 0x93, 0xed, 0x54, 0xc4, 0xc3, 0xbf, 0x82, 0xbe,
+	0x46, 0x0e, 0x41, 0x95, 0x4e, 0xf7, 0x66, 0x3c, 0xb1, 0xc9, 0xfc, 0x8d, 0x54, 0xd5, 0x6c, 0xda,
+	0x97, 0x12, 0xf2, 0x84, 0x71, 0x6e, 0x5e, 0x5c, 0xe8, 0x50, 0xec, 0x16, 0x96, 0xe9, 0xdc, 0x6f,
+	0xc7, 0x54, 0xeb, 0xe4, 0x6b, 0x1f, 0x7e, 0x5e, 0xd5, 0x2b, 0xbf, 0xae, 0xea, 0x95, 0xdf, 0x57,
+	0xf5, 0xca, 0xf7, 0x3f, 0xf5, 0x89, 0xa3, 0xa5, 0x7e, 0x2c, 0x41, 0x88, 0xe5, 0x38, 0x6d, 0xaa,
+	0xff, 0x9a, 0xa7, 0x69, 0xb3, 0x2f, 0x9b, 0xc5, 0xf7, 0x73, 0x13, 0x7f, 0x5d, 0x1f, 0x4f, 0x16,
+	0x63, 0xef, 0xff, 0x0e, 0x00, 0x8b, 0x56, 0x59, 0x6b, 0x88, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -191,6 +191,10 @@ var _Vtctl_serviceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type VtctldClient interface {
+	// ApplyVSchema applies a vschema to a keyspace, after validating it
+	// against the schema of the keyspace. It can also report the plans of a
+	// sample of queries the vschema would change.
+	ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error)
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
@@ -302,6 +306,15 @@ func NewVtctldClient(cc *grpc.ClientConn) VtctldClient {
 	return &vtctldClient{cc}
 }
 
+func (c *vtctldClient) ApplyVSchema(ctx context.Context, in *vtctldata.ApplyVSchemaRequest, opts ...grpc.CallOption) (*vtctldata.ApplyVSchemaResponse, error) {
+	out := new(vtctldata.ApplyVSchemaResponse)
+	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ApplyVSchema", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *vtctldClient) ChangeTabletType(ctx context.Context, in *vtctldata.ChangeTabletTypeRequest, opts ...grpc.CallOption) (*vtctldata.ChangeTabletTypeResponse, error) {
 	out := new(vtctldata.ChangeTabletTypeResponse)
 	err := c.cc.Invoke(ctx, "/vtctlservice.Vtctld/ChangeTabletType", in, out, opts...)
@@ -565,6 +578,10 @@ func (c *vtctldClient) TabletExternallyReparented(ctx context.Context, in *vtctl
 
 // VtctldServer is the server API for Vtctld service.
 type VtctldServer interface {
+	// ApplyVSchema applies a vschema to a keyspace, after validating it
+	// against the schema of the keyspace. It can also report the plans of a
+	// sample of queries the vschema would change.
+	ApplyVSchema(context.Context, *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error)
 	// ChangeTabletType changes the db type for the specified tablet, if possible.
 	// This is used primarily to arrange replicas, and it will not convert a
 	// primary. For that, use InitShardPrimary.
@@ -672,6 +689,9 @@ type VtctldServer interface {
 type UnimplementedVtctldServer struct {
 }
 
+func (*UnimplementedVtctldServer) ApplyVSchema(ctx context.Context, req *vtctldata.ApplyVSchemaRequest) (*vtctldata.ApplyVSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyVSchema not implemented")
+}
 func (*UnimplementedVtctldServer) ChangeTabletType(ctx context.Context, req *vtctldata.ChangeTabletTypeRequest) (*vtctldata.ChangeTabletTypeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChangeTabletType not implemented")
 }
@@ -764,6 +784,24 @@ func RegisterVtctldServer(s *grpc.Server, srv VtctldServer) {
 	s.RegisterService(&_Vtctld_serviceDesc, srv)
 }
 
+func _Vtctld_ApplyVSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(vtctldata.ApplyVSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VtctldServer).ApplyVSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/vtctlservice.Vtctld/ApplyVSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VtctldServer).ApplyVSchema(ctx, req.(*vtctldata.ApplyVSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Vtctld_ChangeTabletType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(vtctldata.ChangeTabletTypeRequest)
 	if err := dec(in); err != nil {
@@ -1290,6 +1328,10 @@ var _Vtctld_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "vtctlservice.Vtctld",
 	HandlerType: (*VtctldServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ApplyVSchema",
+			Handler:    _Vtctld_ApplyVSchema_Handler,
+		},
 		{
 			MethodName: "ChangeTabletType",
 			Handler:    _Vtctld_ChangeTabletType_Handler,
@@ -161,7 +161,16 @@ type Session struct {
 	// Session UUID
 	SessionUUID string `protobuf:"bytes,22,opt,name=SessionUUID,proto3" json:"SessionUUID,omitempty"`
 	// enable_system_settings defines if we can use reserved connections.
-	EnableSystemSettings bool     `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	EnableSystemSettings bool `protobuf:"varint,23,opt,name=enable_system_settings,json=enableSystemSettings,proto3" json:"enable_system_settings,omitempty"`
+	// max_replication_lag, if set, is the maximum replication lag in seconds
+	// of the replicas this session reads from.
+	MaxReplicationLag float64 `protobuf:"fixed64,24,opt,name=max_replication_lag,json=maxReplicationLag,proto3" json:"max_replication_lag,omitempty"`
+	// character_set_client, if set, is the character set of the queries
+	// sent by the client, set with SET NAMES.
+	CharacterSetClient string `protobuf:"bytes,25,opt,name=character_set_client,json=characterSetClient,proto3" json:"character_set_client,omitempty"`
+	// character_set_results, if set, is the character set of the results
+	// sent to the client, set with SET NAMES or SET character_set_results.
+	CharacterSetResults  string   `protobuf:"bytes,26,opt,name=character_set_results,json=characterSetResults,proto3" json:"character_set_results,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -354,6 +363,27 @@ func (m *Session) GetEnableSystemSettings() bool {
 	return false
 }
 
+func (m *Session) GetMaxReplicationLag() float64 {
+	if m != nil {
+		return m.MaxReplicationLag
+	}
+	return 0
+}
+
+func (m *Session) GetCharacterSetClient() string {
+	if m != nil {
+		return m.CharacterSetClient
+	}
+	return ""
+}
+
+func (m *Session) GetCharacterSetResults() string {
+	if m != nil {
+		return m.CharacterSetResults
+	}
+	return ""
+}
+
 type Session_ShardSession struct {
 	Target        *query.Target         `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
 	TransactionId int64                 `protobuf:"varint,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
@@ -1072,7 +1102,10 @@ func (m *ResolveTransactionResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_ResolveTransactionResponse proto.InternalMessageInfo
 
 type VStreamFlags struct {
-	MinimizeSkew         bool     `protobuf:"varint,1,opt,name=minimize_skew,json=minimizeSkew,proto3" json:"minimize_skew,omitempty"`
+	MinimizeSkew bool `protobuf:"varint,1,opt,name=minimize_skew,json=minimizeSkew,proto3" json:"minimize_skew,omitempty"`
+	// format, if set to "json" or "avro", makes vtgate serialize
+	// the row changes in that format, with a fingerprint of their schema.
+	Format               string   `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1118,6 +1151,13 @@ func (m *VStreamFlags) GetMinimizeSkew() bool {
 	return false
 }
 
+func (m *VStreamFlags) GetFormat() string {
+	if m != nil {
+		return m.Format
+	}
+	return ""
+}
+
 // VStreamRequest is the payload for VStream.
 type VStreamRequest struct {
 	CallerId   *vtrpc.CallerID     `protobuf:"bytes,1,opt,name=caller_id,json=callerId,proto3" json:"caller_id,omitempty"`
@@ -1273,98 +1313,104 @@ func init() {
 func init() { proto.RegisterFile("vtgate.proto", fileDescriptor_aab96496ceaf1ebb) }
 
 var fileDescriptor_aab96496ceaf1ebb = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x1b, 0xb7,
-	0x12, 0xce, 0xea, 0x5f, 0xa3, 0xbf, 0x35, 0x2d, 0x3b, 0x1b, 0x9f, 0x1c, 0x1f, 0x41, 0x49, 0x10,
-	0xc5, 0xa7, 0xb0, 0x5b, 0xa7, 0x45, 0x83, 0xa2, 0x45, 0x6b, 0xcb, 0x76, 0xaa, 0xc0, 0x8e, 0x5c,
-	0x4a, 0xb6, 0x81, 0xa2, 0xc5, 0x62, 0xad, 0xa5, 0x65, 0xc2, 0xd2, 0xae, 0x42, 0x52, 0x52, 0xd5,
-	0x97, 0xe8, 0x6d, 0xd1, 0x17, 0xe8, 0x4d, 0xef, 0xfb, 0x0a, 0xbd, 0x6c, 0xde, 0xa0, 0x48, 0xdf,
-	0xa1, 0xd7, 0x05, 0xb9, 0x5c, 0x79, 0xa5, 0xb8, 0x8d, 0x93, 0x20, 0x37, 0x82, 0x38, 0xdf, 0x70,
-	0x38, 0xfc, 0xbe, 0x19, 0x92, 0x0b, 0xf9, 0x91, 0xe8, 0x3a, 0x82, 0xac, 0x0f, 0x98, 0x2f, 0x7c,
-	0x94, 0x0a, 0x46, 0x2b, 0xe6, 0x29, 0xf5, 0x7a, 0x7e, 0xd7, 0x75, 0x84, 0x13, 0x20, 0x2b, 0xb9,
-	0x67, 0x43, 0xc2, 0x26, 0x7a, 0x50, 0x14, 0xfe, 0xc0, 0x8f, 0x82, 0x23, 0xc1, 0x06, 0x9d, 0x60,
-	0x50, 0x7d, 0x9e, 0x83, 0x74, 0x8b, 0x70, 0x4e, 0x7d, 0x0f, 0xdd, 0x83, 0x22, 0xf5, 0x6c, 0xc1,
-	0x1c, 0x8f, 0x3b, 0x1d, 0x41, 0x7d, 0xcf, 0x32, 0x2a, 0x46, 0x2d, 0x83, 0x0b, 0xd4, 0x6b, 0x5f,
-	0x1a, 0x51, 0x1d, 0x8a, 0xfc, 0xdc, 0x61, 0xae, 0xcd, 0x83, 0x79, 0xdc, 0x8a, 0x55, 0xe2, 0xb5,
-	0xdc, 0xe6, 0xed, 0x75, 0x9d, 0x9d, 0x8e, 0xb7, 0xde, 0x92, 0x5e, 0x7a, 0x80, 0x0b, 0x3c, 0x32,
-	0xe2, 0x68, 0x15, 0xc0, 0x19, 0x0a, 0xbf, 0xe3, 0xf7, 0xfb, 0x54, 0x58, 0x09, 0xb5, 0x4e, 0xc4,
-	0x82, 0xee, 0x40, 0x41, 0x38, 0xac, 0x4b, 0x84, 0xcd, 0x05, 0xa3, 0x5e, 0xd7, 0x4a, 0x56, 0x8c,
-	0x5a, 0x16, 0xe7, 0x03, 0x63, 0x4b, 0xd9, 0xd0, 0x06, 0xa4, 0xfd, 0x81, 0x50, 0x29, 0xa4, 0x2a,
-	0x46, 0x2d, 0xb7, 0xb9, 0xb4, 0x1e, 0x6c, 0x7c, 0xf7, 0x3b, 0xd2, 0x19, 0x0a, 0xd2, 0x0c, 0x40,
-	0x1c, 0x7a, 0xa1, 0x6d, 0x30, 0x23, 0xdb, 0xb3, 0xfb, 0xbe, 0x4b, 0xac, 0x74, 0xc5, 0xa8, 0x15,
-	0x37, 0x6f, 0x86, 0xc9, 0x47, 0x76, 0x7a, 0xe0, 0xbb, 0x04, 0x97, 0xc4, 0xac, 0x01, 0x6d, 0x40,
-	0x66, 0xec, 0x30, 0x8f, 0x7a, 0x5d, 0x6e, 0x65, 0xd4, 0xc6, 0x17, 0xf5, 0xaa, 0x5f, 0xc9, 0xdf,
-	0x93, 0x00, 0xc3, 0x53, 0x27, 0xf4, 0x39, 0xe4, 0x07, 0x8c, 0x5c, 0xb2, 0x95, 0xbd, 0x06, 0x5b,
-	0xb9, 0x01, 0x23, 0x53, 0xae, 0xb6, 0xa0, 0x30, 0xf0, 0xb9, 0xb8, 0x8c, 0x00, 0xd7, 0x88, 0x90,
-	0x97, 0x53, 0xa6, 0x21, 0xee, 0x42, 0xb1, 0xe7, 0x70, 0x61, 0x53, 0x8f, 0x13, 0x26, 0x6c, 0xea,
-	0x5a, 0xb9, 0x8a, 0x51, 0x4b, 0xe0, 0xbc, 0xb4, 0x36, 0x94, 0xb1, 0xe1, 0xa2, 0xff, 0x02, 0x9c,
-	0xf9, 0x43, 0xcf, 0xb5, 0x99, 0x3f, 0xe6, 0x56, 0x5e, 0x79, 0x64, 0x95, 0x05, 0xfb, 0x63, 0x8e,
-	0x6c, 0x58, 0x1e, 0x72, 0xc2, 0x6c, 0x97, 0x9c, 0x51, 0x8f, 0xb8, 0xf6, 0xc8, 0x61, 0xd4, 0x39,
-	0xed, 0x11, 0x6e, 0x15, 0x54, 0x42, 0x0f, 0xe6, 0x13, 0x3a, 0xe2, 0x84, 0xed, 0x04, 0xce, 0xc7,
-	0xa1, 0xef, 0xae, 0x27, 0xd8, 0x04, 0x97, 0x87, 0x57, 0x40, 0xa8, 0x09, 0x26, 0x9f, 0x70, 0x41,
-	0xfa, 0x91, 0xd0, 0x45, 0x15, 0xfa, 0xee, 0x4b, 0x7b, 0x55, 0x7e, 0x73, 0x51, 0x4b, 0x7c, 0xd6,
-	0x8a, 0xfe, 0x03, 0x59, 0xe6, 0x8f, 0xed, 0x8e, 0x3f, 0xf4, 0x84, 0x55, 0xaa, 0x18, 0xb5, 0x38,
-	0xce, 0x30, 0x7f, 0x5c, 0x97, 0x63, 0x59, 0x82, 0xdc, 0x19, 0x91, 0x81, 0x4f, 0x3d, 0xc1, 0x2d,
-	0xb3, 0x12, 0xaf, 0x65, 0x71, 0xc4, 0x82, 0x6a, 0x60, 0x52, 0xcf, 0x66, 0x84, 0x13, 0x36, 0x22,
-	0xae, 0xdd, 0xf1, 0x3d, 0xcf, 0x5a, 0x50, 0x85, 0x5a, 0xa4, 0x1e, 0xd6, 0xe6, 0xba, 0xef, 0x79,
-	0x52, 0xe1, 0x9e, 0xdf, 0xb9, 0x08, 0x05, 0xb2, 0x90, 0x2a, 0xc6, 0x57, 0x28, 0x2c, 0x67, 0x84,
-	0x9d, 0xb7, 0x0e, 0x8b, 0x4a, 0x1e, 0x15, 0xe5, 0x9c, 0x38, 0x4c, 0x9c, 0x12, 0x47, 0x58, 0x8b,
-	0x2a, 0xe3, 0x05, 0x09, 0xed, 0xfb, 0x9d, 0x8b, 0x2f, 0x43, 0x00, 0x7d, 0x01, 0x26, 0x23, 0x8e,
-	0x6b, 0x3b, 0x67, 0x82, 0x30, 0x7b, 0xcc, 0xa8, 0x20, 0x56, 0x59, 0x2d, 0xba, 0x1c, 0x2e, 0x8a,
-	0x89, 0xe3, 0x6e, 0x49, 0xf8, 0x44, 0xa2, 0xb8, 0xc8, 0x66, 0xc6, 0xa8, 0x02, 0xb9, 0x9d, 0x9d,
-	0xfd, 0x96, 0x60, 0x8e, 0x20, 0xdd, 0x89, 0xb5, 0xa4, 0xba, 0x2b, 0x6a, 0x92, 0x1e, 0x3a, 0xbd,
-	0xa3, 0xa3, 0xc6, 0x8e, 0xb5, 0x1c, 0x78, 0x44, 0x4c, 0xe8, 0x43, 0x58, 0x26, 0x9e, 0x24, 0xda,
-	0xd6, 0xaa, 0x71, 0x22, 0x84, 0xea, 0x8b, 0x9b, 0x8a, 0xa6, 0x72, 0x80, 0x06, 0x52, 0xb5, 0x34,
-	0xb6, 0xf2, 0xab, 0x01, 0xf9, 0x28, 0x13, 0xe8, 0x1e, 0xa4, 0x82, 0xae, 0x56, 0xc7, 0x4d, 0x6e,
-	0xb3, 0xa0, 0xdb, 0xa9, 0xad, 0x8c, 0x58, 0x83, 0xf2, 0x74, 0x8a, 0xf6, 0x2e, 0x75, 0xad, 0x98,
-	0xa2, 0xa7, 0x10, 0xb1, 0x36, 0x5c, 0xf4, 0x08, 0xf2, 0x42, 0xae, 0x2a, 0x6c, 0xa7, 0x47, 0x1d,
-	0x6e, 0xc5, 0xf5, 0xc1, 0x30, 0x3d, 0x04, 0xdb, 0x0a, 0xdd, 0x92, 0x20, 0xce, 0x89, 0xcb, 0x01,
-	0xfa, 0x1f, 0xe4, 0xa6, 0x62, 0x53, 0x57, 0x9d, 0x49, 0x71, 0x0c, 0xa1, 0xa9, 0xe1, 0xae, 0x7c,
-	0x03, 0xb7, 0xfe, 0xb1, 0xa2, 0x91, 0x09, 0xf1, 0x0b, 0x32, 0x51, 0x5b, 0xc8, 0x62, 0xf9, 0x17,
-	0x3d, 0x80, 0xe4, 0xc8, 0xe9, 0x0d, 0x89, 0xca, 0xf3, 0xf2, 0x94, 0xd8, 0xa6, 0xde, 0x74, 0x2e,
-	0x0e, 0x3c, 0x3e, 0x89, 0x3d, 0x32, 0x56, 0xb6, 0xa1, 0x7c, 0x55, 0x51, 0x5f, 0x11, 0xb8, 0x1c,
-	0x0d, 0x9c, 0x8d, 0xc4, 0x78, 0x92, 0xc8, 0xc4, 0xcd, 0x44, 0xf5, 0x17, 0x03, 0x8a, 0xb3, 0xf2,
-	0xa3, 0x0f, 0x60, 0x69, 0xbe, 0x60, 0xec, 0xae, 0xa0, 0xae, 0x0e, 0x8b, 0x66, 0xab, 0xe3, 0xb1,
-	0xa0, 0x2e, 0xfa, 0x18, 0xac, 0x97, 0xa6, 0x08, 0xda, 0x27, 0xfe, 0x50, 0xa8, 0x85, 0x0d, 0xbc,
-	0x34, 0x3b, 0xab, 0x1d, 0x80, 0xb2, 0x98, 0x75, 0x23, 0xc8, 0xbb, 0xa4, 0x73, 0xa1, 0x16, 0x0a,
-	0x84, 0xc8, 0xe0, 0x05, 0x0d, 0xb5, 0x25, 0x22, 0xd7, 0xe1, 0xd5, 0x9f, 0x63, 0x50, 0xd4, 0x07,
-	0x36, 0x26, 0xcf, 0x86, 0x84, 0x0b, 0xf4, 0x1e, 0x64, 0x3b, 0x4e, 0xaf, 0x47, 0x98, 0xad, 0x53,
-	0xcc, 0x6d, 0x96, 0xd6, 0x83, 0x6b, 0xab, 0xae, 0xec, 0x8d, 0x1d, 0x9c, 0x09, 0x3c, 0x1a, 0x2e,
-	0x7a, 0x00, 0xe9, 0xb0, 0xf3, 0x62, 0x53, 0xdf, 0x68, 0xe7, 0xe1, 0x10, 0x47, 0xf7, 0x21, 0xa9,
-	0x54, 0xd0, 0x65, 0xb1, 0x10, 0x6a, 0x22, 0xcf, 0x38, 0x75, 0x7c, 0xe3, 0x00, 0x47, 0x1f, 0x81,
-	0xae, 0x0d, 0x5b, 0x4c, 0x06, 0x44, 0x15, 0x43, 0x71, 0xb3, 0x3c, 0x5f, 0x45, 0xed, 0xc9, 0x80,
-	0x60, 0x10, 0xd3, 0xff, 0xb2, 0x48, 0x2f, 0xc8, 0x84, 0x0f, 0x9c, 0x0e, 0xb1, 0xd5, 0x85, 0xa7,
-	0x2e, 0xa6, 0x2c, 0x2e, 0x84, 0x56, 0x55, 0xf9, 0xd1, 0x8b, 0x2b, 0x7d, 0x9d, 0x8b, 0xeb, 0x49,
-	0x22, 0x93, 0x34, 0x53, 0xd5, 0x1f, 0x0c, 0x28, 0x4d, 0x99, 0xe2, 0x03, 0xdf, 0xe3, 0x72, 0xc5,
-	0x24, 0x61, 0xcc, 0x67, 0x73, 0x34, 0xe1, 0xc3, 0xfa, 0xae, 0x34, 0xe3, 0x00, 0x7d, 0x1d, 0x8e,
-	0xd6, 0x20, 0xc5, 0x08, 0x1f, 0xf6, 0x84, 0x26, 0x09, 0x45, 0xaf, 0x37, 0xac, 0x10, 0xac, 0x3d,
-	0xaa, 0xcf, 0x63, 0xb0, 0xa8, 0x33, 0xda, 0x76, 0x44, 0xe7, 0xfc, 0x9d, 0x0b, 0xf8, 0x7f, 0x48,
-	0xcb, 0x6c, 0x28, 0x91, 0x05, 0x15, 0xbf, 0x5a, 0xc2, 0xd0, 0xe3, 0x2d, 0x44, 0x74, 0xf8, 0xcc,
-	0x3b, 0x28, 0x19, 0xbc, 0x83, 0x1c, 0x1e, 0x7d, 0x07, 0xbd, 0x23, 0xad, 0xab, 0x3f, 0x19, 0x50,
-	0x9e, 0xe5, 0xf4, 0x9d, 0x49, 0xfd, 0x3e, 0xa4, 0x03, 0x21, 0x43, 0x36, 0x97, 0x75, 0x6e, 0x81,
-	0xcc, 0x27, 0x54, 0x9c, 0x07, 0xa1, 0x43, 0x37, 0xd9, 0xac, 0xe5, 0x96, 0x60, 0xc4, 0xe9, 0xbf,
-	0x55, 0xcb, 0x4e, 0xfb, 0x30, 0xf6, 0x7a, 0x7d, 0x18, 0x7f, 0xe3, 0x3e, 0x4c, 0xbc, 0x42, 0x9b,
-	0xe4, 0xb5, 0x1e, 0x90, 0x11, 0x6e, 0x53, 0xff, 0xce, 0x6d, 0xb5, 0x0e, 0x4b, 0x73, 0x44, 0x69,
-	0x19, 0x2f, 0xfb, 0xcb, 0x78, 0x65, 0x7f, 0x7d, 0x0b, 0xb7, 0x30, 0xe1, 0x7e, 0x6f, 0x44, 0x22,
-	0x95, 0xf7, 0x66, 0x94, 0x23, 0x48, 0xb8, 0x42, 0xdf, 0x9a, 0x59, 0xac, 0xfe, 0x57, 0x6f, 0xc3,
-	0xca, 0x55, 0xe1, 0x83, 0x44, 0xab, 0x0f, 0x21, 0x7f, 0x1c, 0x6c, 0x61, 0xaf, 0xe7, 0x74, 0xb9,
-	0x7c, 0x93, 0xf7, 0xa9, 0x47, 0xfb, 0xf4, 0x7b, 0x62, 0xf3, 0x0b, 0x32, 0xd6, 0x9f, 0x07, 0xf9,
-	0xd0, 0xd8, 0xba, 0x20, 0xe3, 0xea, 0x5f, 0x06, 0x14, 0xf5, 0xac, 0x37, 0xcb, 0x73, 0x4e, 0xf1,
-	0xd8, 0x35, 0x15, 0xbf, 0x0f, 0xc9, 0x91, 0xba, 0xd1, 0xc2, 0x93, 0x3d, 0xf2, 0x51, 0x74, 0x2c,
-	0x2f, 0x1a, 0x1c, 0xe0, 0x92, 0xfe, 0x33, 0xda, 0x13, 0x84, 0xa9, 0x92, 0x90, 0xf4, 0x47, 0x3c,
-	0xf7, 0x14, 0x82, 0xb5, 0x07, 0x5a, 0x83, 0xe4, 0x99, 0xdc, 0xba, 0xae, 0x8e, 0x72, 0x28, 0x76,
-	0x94, 0x16, 0x1c, 0xb8, 0x54, 0x3f, 0x83, 0xd2, 0x74, 0xdf, 0x97, 0x4a, 0x93, 0x11, 0x91, 0xaf,
-	0x4b, 0x43, 0x75, 0xd7, 0xcc, 0x52, 0xc7, 0xbb, 0x12, 0xc2, 0xda, 0x63, 0x6d, 0x07, 0x4a, 0x73,
-	0x9f, 0x1e, 0xa8, 0x04, 0xb9, 0xa3, 0xa7, 0xad, 0xc3, 0xdd, 0x7a, 0x63, 0xaf, 0xb1, 0xbb, 0x63,
-	0xde, 0x40, 0x00, 0xa9, 0x56, 0xe3, 0xe9, 0xe3, 0xfd, 0x5d, 0xd3, 0x40, 0x59, 0x48, 0x1e, 0x1c,
-	0xed, 0xb7, 0x1b, 0x66, 0x4c, 0xfe, 0x6d, 0x9f, 0x34, 0x0f, 0xeb, 0x66, 0x7c, 0xed, 0x53, 0xc8,
-	0xd5, 0xd5, 0x07, 0x54, 0x93, 0xb9, 0x84, 0xc9, 0x09, 0x4f, 0x9b, 0xf8, 0x60, 0x6b, 0xdf, 0xbc,
-	0x81, 0xd2, 0x10, 0x3f, 0xc4, 0x72, 0x66, 0x06, 0x12, 0x87, 0xcd, 0x56, 0xdb, 0x8c, 0xa1, 0x22,
-	0xc0, 0xd6, 0x51, 0xbb, 0x59, 0x6f, 0x1e, 0x1c, 0x34, 0xda, 0x66, 0x7c, 0x7b, 0xef, 0xb7, 0x17,
-	0xab, 0xc6, 0xef, 0x2f, 0x56, 0x8d, 0x3f, 0x5e, 0xac, 0x1a, 0x3f, 0xfe, 0xb9, 0x7a, 0x03, 0x4a,
-	0xd4, 0x5f, 0x1f, 0x51, 0x41, 0x38, 0x0f, 0xbe, 0x17, 0xbf, 0xbe, 0xa3, 0x47, 0xd4, 0xdf, 0x08,
-	0xfe, 0x6d, 0x74, 0xfd, 0x8d, 0x91, 0xd8, 0x50, 0xe8, 0x46, 0x40, 0xcf, 0x69, 0x4a, 0x8d, 0x1e,
-	0xfe, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb1, 0xa5, 0xb0, 0xf3, 0xaf, 0x0e, 0x00, 0x00,
+	// 1538 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x6e, 0x23, 0x49,
+	0x15, 0x9e, 0xf6, 0x5f, 0xec, 0x63, 0xc7, 0xee, 0x54, 0x9c, 0x6c, 0x4f, 0x58, 0x82, 0xe5, 0xdd,
+	0xd5, 0x7a, 0x06, 0x94, 0x2c, 0x01, 0xc4, 0x0a, 0x81, 0x20, 0x71, 0x32, 0x8b, 0x97, 0x64, 0x12,
+	0xca, 0xce, 0x8c, 0x84, 0x40, 0xad, 0x4a, 0x77, 0xc5, 0x29, 0xc5, 0xee, 0xf2, 0x56, 0x95, 0x9d,
+	0x09, 0x2f, 0xc1, 0x2d, 0xe2, 0x05, 0xb8, 0xe1, 0x9e, 0x57, 0xe0, 0x12, 0xde, 0x00, 0x0d, 0x17,
+	0xbc, 0x01, 0xd7, 0xa8, 0x7e, 0xda, 0x69, 0x7b, 0x03, 0x93, 0x9d, 0xd1, 0xdc, 0x58, 0x3e, 0xe7,
+	0x3b, 0xe7, 0xd4, 0xa9, 0xf3, 0xdb, 0x05, 0xb5, 0x99, 0x1a, 0x12, 0x45, 0x77, 0x26, 0x82, 0x2b,
+	0x8e, 0x4a, 0x96, 0xda, 0xf2, 0x2f, 0x58, 0x32, 0xe2, 0xc3, 0x98, 0x28, 0x62, 0x91, 0xad, 0xea,
+	0x57, 0x53, 0x2a, 0x6e, 0x1d, 0x51, 0x57, 0x7c, 0xc2, 0xb3, 0xe0, 0x4c, 0x89, 0x49, 0x64, 0x89,
+	0xf6, 0xbf, 0x6b, 0xb0, 0xd2, 0xa7, 0x52, 0x32, 0x9e, 0xa0, 0x4f, 0xa0, 0xce, 0x92, 0x50, 0x09,
+	0x92, 0x48, 0x12, 0x29, 0xc6, 0x93, 0xc0, 0x6b, 0x79, 0x9d, 0x32, 0x5e, 0x65, 0xc9, 0xe0, 0x8e,
+	0x89, 0xba, 0x50, 0x97, 0x57, 0x44, 0xc4, 0xa1, 0xb4, 0x7a, 0x32, 0xc8, 0xb5, 0xf2, 0x9d, 0xea,
+	0xde, 0x87, 0x3b, 0xce, 0x3b, 0x67, 0x6f, 0xa7, 0xaf, 0xa5, 0x1c, 0x81, 0x57, 0x65, 0x86, 0x92,
+	0x68, 0x1b, 0x80, 0x4c, 0x15, 0x8f, 0xf8, 0x78, 0xcc, 0x54, 0x50, 0x30, 0xe7, 0x64, 0x38, 0xe8,
+	0x23, 0x58, 0x55, 0x44, 0x0c, 0xa9, 0x0a, 0xa5, 0x12, 0x2c, 0x19, 0x06, 0xc5, 0x96, 0xd7, 0xa9,
+	0xe0, 0x9a, 0x65, 0xf6, 0x0d, 0x0f, 0xed, 0xc2, 0x0a, 0x9f, 0x28, 0xe3, 0x42, 0xa9, 0xe5, 0x75,
+	0xaa, 0x7b, 0x1b, 0x3b, 0xf6, 0xe2, 0x47, 0xaf, 0x68, 0x34, 0x55, 0xf4, 0xd4, 0x82, 0x38, 0x95,
+	0x42, 0x07, 0xe0, 0x67, 0xae, 0x17, 0x8e, 0x79, 0x4c, 0x83, 0x95, 0x96, 0xd7, 0xa9, 0xef, 0x7d,
+	0x90, 0x3a, 0x9f, 0xb9, 0xe9, 0x09, 0x8f, 0x29, 0x6e, 0xa8, 0x45, 0x06, 0xda, 0x85, 0xf2, 0x0d,
+	0x11, 0x09, 0x4b, 0x86, 0x32, 0x28, 0x9b, 0x8b, 0xaf, 0xbb, 0x53, 0x7f, 0xad, 0x7f, 0x5f, 0x5a,
+	0x0c, 0xcf, 0x85, 0xd0, 0xcf, 0xa1, 0x36, 0x11, 0xf4, 0x2e, 0x5a, 0x95, 0x07, 0x44, 0xab, 0x3a,
+	0x11, 0x74, 0x1e, 0xab, 0x7d, 0x58, 0x9d, 0x70, 0xa9, 0xee, 0x2c, 0xc0, 0x03, 0x2c, 0xd4, 0xb4,
+	0xca, 0xdc, 0xc4, 0xc7, 0x50, 0x1f, 0x11, 0xa9, 0x42, 0x96, 0x48, 0x2a, 0x54, 0xc8, 0xe2, 0xa0,
+	0xda, 0xf2, 0x3a, 0x05, 0x5c, 0xd3, 0xdc, 0x9e, 0x61, 0xf6, 0x62, 0xf4, 0x6d, 0x80, 0x4b, 0x3e,
+	0x4d, 0xe2, 0x50, 0xf0, 0x1b, 0x19, 0xd4, 0x8c, 0x44, 0xc5, 0x70, 0x30, 0xbf, 0x91, 0x28, 0x84,
+	0xcd, 0xa9, 0xa4, 0x22, 0x8c, 0xe9, 0x25, 0x4b, 0x68, 0x1c, 0xce, 0x88, 0x60, 0xe4, 0x62, 0x44,
+	0x65, 0xb0, 0x6a, 0x1c, 0x7a, 0xb2, 0xec, 0xd0, 0xb9, 0xa4, 0xe2, 0xd0, 0x0a, 0xbf, 0x48, 0x65,
+	0x8f, 0x12, 0x25, 0x6e, 0x71, 0x73, 0x7a, 0x0f, 0x84, 0x4e, 0xc1, 0x97, 0xb7, 0x52, 0xd1, 0x71,
+	0xc6, 0x74, 0xdd, 0x98, 0xfe, 0xf8, 0x6b, 0x77, 0x35, 0x72, 0x4b, 0x56, 0x1b, 0x72, 0x91, 0x8b,
+	0xbe, 0x05, 0x15, 0xc1, 0x6f, 0xc2, 0x88, 0x4f, 0x13, 0x15, 0x34, 0x5a, 0x5e, 0x27, 0x8f, 0xcb,
+	0x82, 0xdf, 0x74, 0x35, 0xad, 0x4b, 0x50, 0x92, 0x19, 0x9d, 0x70, 0x96, 0x28, 0x19, 0xf8, 0xad,
+	0x7c, 0xa7, 0x82, 0x33, 0x1c, 0xd4, 0x01, 0x9f, 0x25, 0xa1, 0xa0, 0x92, 0x8a, 0x19, 0x8d, 0xc3,
+	0x88, 0x27, 0x49, 0xb0, 0x66, 0x0a, 0xb5, 0xce, 0x12, 0xec, 0xd8, 0x5d, 0x9e, 0x24, 0x3a, 0xc3,
+	0x23, 0x1e, 0x5d, 0xa7, 0x09, 0x0a, 0x50, 0xcb, 0x7b, 0x63, 0x7e, 0xaa, 0x5a, 0xc3, 0x11, 0x68,
+	0x07, 0xd6, 0x4d, 0x7a, 0x8c, 0x95, 0x2b, 0x4a, 0x84, 0xba, 0xa0, 0x44, 0x05, 0xeb, 0xc6, 0xe3,
+	0x35, 0x0d, 0x1d, 0xf3, 0xe8, 0xfa, 0x97, 0x29, 0x80, 0x7e, 0x01, 0xbe, 0xa0, 0x24, 0x0e, 0xc9,
+	0xa5, 0xa2, 0x22, 0xbc, 0x11, 0x4c, 0xd1, 0xa0, 0x69, 0x0e, 0xdd, 0x4c, 0x0f, 0xc5, 0x94, 0xc4,
+	0xfb, 0x1a, 0x7e, 0xa9, 0x51, 0x5c, 0x17, 0x0b, 0x34, 0x6a, 0x41, 0xf5, 0xf0, 0xf0, 0xb8, 0xaf,
+	0x04, 0x51, 0x74, 0x78, 0x1b, 0x6c, 0x98, 0xee, 0xca, 0xb2, 0xb4, 0x84, 0x73, 0xef, 0xfc, 0xbc,
+	0x77, 0x18, 0x6c, 0x5a, 0x89, 0x0c, 0x0b, 0xfd, 0x10, 0x36, 0x69, 0xa2, 0x03, 0x1d, 0xba, 0xac,
+	0x49, 0xaa, 0x94, 0xe9, 0x8b, 0x0f, 0x4c, 0x98, 0x9a, 0x16, 0xb5, 0xa9, 0xea, 0x3b, 0x4c, 0xdf,
+	0x75, 0x4c, 0x5e, 0x85, 0x82, 0x4e, 0x46, 0x2c, 0x22, 0xa6, 0x0f, 0x47, 0x64, 0x18, 0x04, 0x2d,
+	0xaf, 0xe3, 0xe1, 0xb5, 0x31, 0x79, 0x85, 0xef, 0x90, 0x63, 0x32, 0x44, 0x9f, 0x41, 0x33, 0xba,
+	0x22, 0x82, 0x44, 0xfa, 0xaa, 0x92, 0xaa, 0x30, 0x1a, 0x31, 0x9a, 0xa8, 0xe0, 0xb1, 0x71, 0x08,
+	0xcd, 0xb1, 0x3e, 0x55, 0x5d, 0x83, 0xa0, 0x3d, 0xd8, 0x58, 0xd4, 0x10, 0x54, 0x4e, 0x47, 0x4a,
+	0x06, 0x5b, 0x46, 0x65, 0x3d, 0xab, 0x82, 0x2d, 0xb4, 0xf5, 0x57, 0x0f, 0x6a, 0xd9, 0xfc, 0xa0,
+	0x4f, 0xa0, 0x64, 0x67, 0x8d, 0x19, 0x82, 0xd5, 0xbd, 0x55, 0xd7, 0xe4, 0x03, 0xc3, 0xc4, 0x0e,
+	0xd4, 0x33, 0x33, 0x3b, 0x51, 0x58, 0x1c, 0xe4, 0x4c, 0xd2, 0x56, 0x33, 0xdc, 0x5e, 0x8c, 0x3e,
+	0x87, 0x9a, 0xd2, 0xb1, 0x50, 0x21, 0x19, 0x31, 0x22, 0x83, 0xbc, 0x1b, 0x57, 0xf3, 0xd1, 0x3c,
+	0x30, 0xe8, 0xbe, 0x06, 0x71, 0x55, 0xdd, 0x11, 0xe8, 0x3b, 0x50, 0x9d, 0x97, 0x20, 0x8b, 0xcd,
+	0xa4, 0xcc, 0x63, 0x48, 0x59, 0xbd, 0x78, 0xeb, 0xb7, 0xf0, 0xf8, 0x7f, 0xf6, 0x19, 0xf2, 0x21,
+	0x7f, 0x4d, 0x6f, 0xcd, 0x15, 0x2a, 0x58, 0xff, 0x45, 0x4f, 0xa0, 0x38, 0x23, 0xa3, 0x29, 0x35,
+	0x7e, 0xde, 0xcd, 0xae, 0x03, 0x96, 0xcc, 0x75, 0xb1, 0x95, 0xf8, 0x49, 0xee, 0x73, 0x6f, 0xeb,
+	0x00, 0x9a, 0xf7, 0xb5, 0xda, 0x3d, 0x86, 0x9b, 0x59, 0xc3, 0x95, 0x8c, 0x8d, 0x2f, 0x0b, 0xe5,
+	0xbc, 0x5f, 0x68, 0xff, 0xc5, 0x83, 0xfa, 0x62, 0x51, 0xa2, 0xef, 0xc3, 0xc6, 0x72, 0x19, 0x87,
+	0x43, 0xc5, 0x62, 0x67, 0x16, 0x2d, 0xd6, 0xec, 0x17, 0x8a, 0xc5, 0xe8, 0xc7, 0x10, 0x7c, 0x4d,
+	0x45, 0xb1, 0x31, 0xe5, 0x53, 0x65, 0x0e, 0xf6, 0xf0, 0xc6, 0xa2, 0xd6, 0xc0, 0x82, 0xba, 0xec,
+	0x5c, 0x7b, 0xea, 0x0d, 0x17, 0x5d, 0x9b, 0x83, 0x6c, 0x22, 0xca, 0x78, 0xcd, 0x41, 0x03, 0x8d,
+	0xe8, 0x73, 0x64, 0xfb, 0xcf, 0x39, 0xa8, 0xbb, 0x35, 0x82, 0xe9, 0x57, 0x53, 0x2a, 0x15, 0xfa,
+	0x1e, 0x54, 0x22, 0x32, 0x1a, 0x51, 0x11, 0x3a, 0x17, 0xab, 0x7b, 0x8d, 0x1d, 0xbb, 0x4c, 0xbb,
+	0x86, 0xdf, 0x3b, 0xc4, 0x65, 0x2b, 0xd1, 0x8b, 0xd1, 0x13, 0x58, 0x49, 0xe7, 0x41, 0x6e, 0x2e,
+	0x9b, 0x9d, 0x07, 0x38, 0xc5, 0xd1, 0xa7, 0x50, 0x34, 0x59, 0x70, 0x65, 0xb1, 0x96, 0xe6, 0x44,
+	0x4f, 0x5e, 0xb3, 0x54, 0xb0, 0xc5, 0xd1, 0x8f, 0xc0, 0xd5, 0x46, 0xa8, 0x6e, 0x27, 0xd4, 0x14,
+	0x43, 0x7d, 0xaf, 0xb9, 0x5c, 0x45, 0x83, 0xdb, 0x09, 0xc5, 0xa0, 0xe6, 0xff, 0x75, 0x91, 0x5e,
+	0xd3, 0x5b, 0x39, 0x21, 0x11, 0x0d, 0xcd, 0x1a, 0x36, 0xeb, 0xb2, 0x82, 0x57, 0x53, 0xae, 0xa9,
+	0xfc, 0xec, 0x3a, 0x5d, 0x79, 0xc8, 0x3a, 0xfd, 0xb2, 0x50, 0x2e, 0xfa, 0xa5, 0xf6, 0x1f, 0x3c,
+	0x68, 0xcc, 0x23, 0x25, 0x27, 0x3c, 0x91, 0xfa, 0xc4, 0x22, 0x15, 0x82, 0x8b, 0xa5, 0x30, 0xe1,
+	0xb3, 0xee, 0x91, 0x66, 0x63, 0x8b, 0x7e, 0x93, 0x18, 0x3d, 0x85, 0x92, 0x6d, 0x63, 0x17, 0x24,
+	0x94, 0x5d, 0xba, 0xb6, 0x8b, 0xb1, 0x93, 0x68, 0xff, 0x23, 0x07, 0xeb, 0xce, 0xa3, 0x03, 0xa2,
+	0xa2, 0xab, 0xf7, 0x9e, 0xc0, 0xef, 0xc2, 0x8a, 0xf6, 0x86, 0x51, 0x5d, 0x50, 0xf9, 0xfb, 0x53,
+	0x98, 0x4a, 0xbc, 0x43, 0x12, 0x89, 0x5c, 0xf8, 0x3a, 0x2b, 0xda, 0xaf, 0x33, 0x22, 0xb3, 0x5f,
+	0x67, 0xef, 0x29, 0xd7, 0xed, 0x3f, 0x79, 0xd0, 0x5c, 0x8c, 0xe9, 0x7b, 0x4b, 0xf5, 0x67, 0xb0,
+	0x92, 0x4e, 0x6c, 0x1b, 0xcd, 0x4d, 0xe7, 0x9b, 0x4d, 0xf3, 0x4b, 0xa6, 0xae, 0xac, 0xe9, 0x54,
+	0x4c, 0x37, 0x6b, 0xb3, 0xaf, 0x04, 0x25, 0xe3, 0x77, 0x6a, 0xd9, 0x79, 0x1f, 0xe6, 0xbe, 0x59,
+	0x1f, 0xe6, 0xdf, 0xba, 0x0f, 0x0b, 0x6f, 0xc8, 0x4d, 0xf1, 0x41, 0x9f, 0xb5, 0x99, 0xd8, 0x96,
+	0xfe, 0x7f, 0x6c, 0xdb, 0x5d, 0xd8, 0x58, 0x0a, 0x94, 0x4b, 0xe3, 0x5d, 0x7f, 0x79, 0x6f, 0xec,
+	0xaf, 0xdf, 0xc1, 0x63, 0x4c, 0x25, 0x1f, 0xcd, 0x68, 0xa6, 0xf2, 0xde, 0x2e, 0xe4, 0x08, 0x0a,
+	0xb1, 0x72, 0x5b, 0xb3, 0x82, 0xcd, 0xff, 0xf6, 0x87, 0xb0, 0x75, 0x9f, 0x79, 0xeb, 0x68, 0xfb,
+	0x57, 0x50, 0x7b, 0x61, 0xaf, 0xf0, 0x6c, 0x44, 0x86, 0x52, 0xbf, 0x14, 0xc6, 0x2c, 0x61, 0x63,
+	0xf6, 0x7b, 0x1a, 0xca, 0x6b, 0x7a, 0xe3, 0x1e, 0x2d, 0xb5, 0x94, 0xd9, 0xbf, 0xa6, 0x37, 0x68,
+	0x13, 0x4a, 0x97, 0x5c, 0x8c, 0x89, 0x72, 0x07, 0x39, 0xaa, 0xfd, 0x1f, 0x0f, 0xea, 0xce, 0xda,
+	0xdb, 0xf9, 0xbf, 0x54, 0x09, 0xb9, 0x07, 0x56, 0xc2, 0xa7, 0x50, 0x9c, 0x99, 0x4d, 0x97, 0x4e,
+	0xfc, 0xcc, 0x13, 0xee, 0x85, 0x5e, 0x40, 0xd8, 0xe2, 0x3a, 0x2d, 0x97, 0x6c, 0xa4, 0xa8, 0x08,
+	0x0a, 0x2e, 0x2d, 0x19, 0xc9, 0x67, 0x06, 0xc1, 0x4e, 0x02, 0x3d, 0x85, 0xe2, 0xa5, 0x0e, 0x89,
+	0xab, 0x9a, 0x66, 0x5a, 0x04, 0xd9, 0x70, 0x61, 0x2b, 0xd2, 0xfe, 0x19, 0x34, 0xe6, 0xf7, 0xbe,
+	0xab, 0x00, 0x3a, 0xa3, 0xfa, 0x5b, 0xd8, 0x6b, 0xe5, 0x97, 0x8f, 0x7a, 0x71, 0xa4, 0x21, 0xec,
+	0x24, 0x9e, 0x1e, 0x42, 0x63, 0xe9, 0xa1, 0x84, 0x1a, 0x50, 0x3d, 0x7f, 0xde, 0x3f, 0x3b, 0xea,
+	0xf6, 0x9e, 0xf5, 0x8e, 0x0e, 0xfd, 0x47, 0x08, 0xa0, 0xd4, 0xef, 0x3d, 0xff, 0xe2, 0xf8, 0xc8,
+	0xf7, 0x50, 0x05, 0x8a, 0x27, 0xe7, 0xc7, 0x83, 0x9e, 0x9f, 0xd3, 0x7f, 0x07, 0x2f, 0x4f, 0xcf,
+	0xba, 0x7e, 0xfe, 0xe9, 0x4f, 0xa1, 0xda, 0x35, 0xcf, 0xbd, 0x53, 0x11, 0x53, 0xa1, 0x15, 0x9e,
+	0x9f, 0xe2, 0x93, 0xfd, 0x63, 0xff, 0x11, 0x5a, 0x81, 0xfc, 0x19, 0xd6, 0x9a, 0x65, 0x28, 0x9c,
+	0x9d, 0xf6, 0x07, 0x7e, 0x0e, 0xd5, 0x01, 0xf6, 0xcf, 0x07, 0xa7, 0xdd, 0xd3, 0x93, 0x93, 0xde,
+	0xc0, 0xcf, 0x1f, 0x3c, 0xfb, 0xdb, 0xeb, 0x6d, 0xef, 0xef, 0xaf, 0xb7, 0xbd, 0x7f, 0xbe, 0xde,
+	0xf6, 0xfe, 0xf8, 0xaf, 0xed, 0x47, 0xd0, 0x60, 0x7c, 0x67, 0xc6, 0x14, 0x95, 0xd2, 0xbe, 0x6e,
+	0x7f, 0xf3, 0x91, 0xa3, 0x18, 0xdf, 0xb5, 0xff, 0x76, 0x87, 0x7c, 0x77, 0xa6, 0x76, 0x0d, 0xba,
+	0x6b, 0xc3, 0x73, 0x51, 0x32, 0xd4, 0x0f, 0xfe, 0x3b, 0x00, 0x9f, 0xfe, 0xda, 0x56, 0x5d, 0x0f,
+	0x00, 0x00,
 }
 
 func (m *Session) Marshal() (dAtA []byte, err error) {
@@ -1391,6 +1437,32 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.CharacterSetResults) > 0 {
+		i -= len(m.CharacterSetResults)
+		copy(dAtA[i:], m.CharacterSetResults)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.CharacterSetResults)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd2
+	}
+	if len(m.CharacterSetClient) > 0 {
+		i -= len(m.CharacterSetClient)
+		copy(dAtA[i:], m.CharacterSetClient)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.CharacterSetClient)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
+	}
+	if m.MaxReplicationLag != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.MaxReplicationLag))))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc1
+	}
 	if m.EnableSystemSettings {
 		i--
 		if m.EnableSystemSettings {
@@ -2290,6 +2362,13 @@ func (m *VStreamFlags) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarintVtgate(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if m.MinimizeSkew {
 		i--
 		if m.MinimizeSkew {
@@ -2543,6 +2622,17 @@ func (m *Session) Size() (n int) {
 	if m.EnableSystemSettings {
 		n += 3
 	}
+	if m.MaxReplicationLag != 0 {
+		n += 10
+	}
+	l = len(m.CharacterSetClient)
+	if l > 0 {
+		n += 2 + l + sovVtgate(uint64(l))
+	}
+	l = len(m.CharacterSetResults)
+	if l > 0 {
+		n += 2 + l + sovVtgate(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2814,6 +2904,10 @@ func (m *VStreamFlags) Size() (n int) {
 	if m.MinimizeSkew {
 		n += 2
 	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sovVtgate(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3707,6 +3801,81 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.EnableSystemSettings = bool(v != 0)
+		case 24:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicationLag", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.MaxReplicationLag = float64(math.Float64frombits(v))
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CharacterSetClient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CharacterSetClient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 26:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CharacterSetResults", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CharacterSetResults = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtgate(dAtA[iNdEx:])
@@ -5415,6 +5584,38 @@ func (m *VStreamFlags) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.MinimizeSkew = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtgate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtgate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Format = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtgate(dAtA[iNdEx:])
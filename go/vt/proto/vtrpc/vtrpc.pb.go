@@ -288,6 +288,41 @@ func (LegacyErrorCode) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_750b4cf641561858, []int{1}
 }
 
+// Applied tells whether the writes of a failed request could have been
+// applied.
+type Applied int32
+
+const (
+	// APPLIED_UNKNOWN means that nothing is known about the writes.
+	Applied_APPLIED_UNKNOWN Applied = 0
+	// NOT_APPLIED means that the request failed before any write was
+	// applied, or that its writes were rolled back.
+	Applied_NOT_APPLIED Applied = 1
+	// MAYBE_APPLIED means that the request failed after its writes were
+	// sent to MySQL, so that they could have been applied.
+	Applied_MAYBE_APPLIED Applied = 2
+)
+
+var Applied_name = map[int32]string{
+	0: "APPLIED_UNKNOWN",
+	1: "NOT_APPLIED",
+	2: "MAYBE_APPLIED",
+}
+
+var Applied_value = map[string]int32{
+	"APPLIED_UNKNOWN": 0,
+	"NOT_APPLIED":     1,
+	"MAYBE_APPLIED":   2,
+}
+
+func (x Applied) String() string {
+	return proto.EnumName(Applied_name, int32(x))
+}
+
+func (Applied) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_750b4cf641561858, []int{2}
+}
+
 // CallerID is passed along RPCs to identify the originating client
 // for a request. It is not meant to be secure, but only
 // informational.  The client can put whatever info they want in these
@@ -375,12 +410,16 @@ func (m *CallerID) GetSubcomponent() string {
 // We use this so the clients don't have to parse the error messages,
 // but instead can depend on the value of the code.
 type RPCError struct {
-	LegacyCode           LegacyErrorCode `protobuf:"varint,1,opt,name=legacy_code,json=legacyCode,proto3,enum=vtrpc.LegacyErrorCode" json:"legacy_code,omitempty"`
-	Message              string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Code                 Code            `protobuf:"varint,3,opt,name=code,proto3,enum=vtrpc.Code" json:"code,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	LegacyCode LegacyErrorCode `protobuf:"varint,1,opt,name=legacy_code,json=legacyCode,proto3,enum=vtrpc.LegacyErrorCode" json:"legacy_code,omitempty"`
+	Message    string          `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Code       Code            `protobuf:"varint,3,opt,name=code,proto3,enum=vtrpc.Code" json:"code,omitempty"`
+	Applied    Applied         `protobuf:"varint,4,opt,name=applied,proto3,enum=vtrpc.Applied" json:"applied,omitempty"`
+	// details are the structured details of the error, e.g. the MySQL
+	// error number.
+	Details              map[string]string `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *RPCError) Reset()         { *m = RPCError{} }
@@ -437,57 +476,80 @@ func (m *RPCError) GetCode() Code {
 	return Code_OK
 }
 
+func (m *RPCError) GetApplied() Applied {
+	if m != nil {
+		return m.Applied
+	}
+	return Applied_APPLIED_UNKNOWN
+}
+
+func (m *RPCError) GetDetails() map[string]string {
+	if m != nil {
+		return m.Details
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("vtrpc.Code", Code_name, Code_value)
 	proto.RegisterEnum("vtrpc.LegacyErrorCode", LegacyErrorCode_name, LegacyErrorCode_value)
+	proto.RegisterEnum("vtrpc.Applied", Applied_name, Applied_value)
 	proto.RegisterType((*CallerID)(nil), "vtrpc.CallerID")
 	proto.RegisterType((*RPCError)(nil), "vtrpc.RPCError")
+	proto.RegisterMapType((map[string]string)(nil), "vtrpc.RPCError.DetailsEntry")
 }
 
 func init() { proto.RegisterFile("vtrpc.proto", fileDescriptor_750b4cf641561858) }
 
 var fileDescriptor_750b4cf641561858 = []byte{
-	// 628 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x93, 0xcb, 0x4e, 0x1b, 0x3f,
-	0x14, 0xc6, 0xc9, 0x85, 0x5c, 0x4e, 0x02, 0x31, 0xe6, 0x16, 0xfe, 0x7f, 0x9a, 0x56, 0x59, 0x55,
-	0x2c, 0x88, 0xd4, 0x2e, 0xba, 0x76, 0xc6, 0x87, 0x60, 0x31, 0x78, 0x52, 0x8f, 0x87, 0x92, 0x6e,
-	0xac, 0x10, 0x46, 0x28, 0x55, 0x60, 0xa2, 0x49, 0x8a, 0xd4, 0x4d, 0x9f, 0xa3, 0x4f, 0xd2, 0x67,
-	0xe8, 0xb2, 0x8f, 0x50, 0xd1, 0x4d, 0x1f, 0xa3, 0xb2, 0x93, 0x29, 0x0a, 0xec, 0xe6, 0x7c, 0xbf,
-	0xe3, 0xe3, 0xef, 0x7c, 0x4e, 0xa0, 0x76, 0x3f, 0x4f, 0xa7, 0xa3, 0xe3, 0x69, 0x9a, 0xcc, 0x13,
-	0xba, 0xee, 0x8a, 0xf6, 0x27, 0xa8, 0x78, 0xc3, 0xc9, 0x24, 0x4e, 0x05, 0xa7, 0x87, 0x50, 0x9d,
-	0xa6, 0xe3, 0xbb, 0xd1, 0x78, 0x3a, 0x9c, 0x34, 0x73, 0xaf, 0x72, 0xaf, 0xab, 0xea, 0x51, 0xb0,
-	0x74, 0x94, 0xdc, 0x4e, 0x93, 0xbb, 0xf8, 0x6e, 0xde, 0xcc, 0x2f, 0xe8, 0x3f, 0x81, 0xb6, 0xa1,
-	0x3e, 0xfb, 0x7c, 0xf5, 0xd8, 0x50, 0x70, 0x0d, 0x2b, 0x5a, 0xfb, 0x2b, 0x54, 0x54, 0xdf, 0xc3,
-	0x34, 0x4d, 0x52, 0xfa, 0x0e, 0x6a, 0x93, 0xf8, 0x66, 0x38, 0xfa, 0x62, 0x46, 0xc9, 0x75, 0xec,
-	0x6e, 0xdb, 0x7c, 0xb3, 0x77, 0xbc, 0x70, 0xe8, 0x3b, 0xe2, 0x1a, 0xbd, 0xe4, 0x3a, 0x56, 0xb0,
-	0x68, 0xb5, 0xdf, 0xb4, 0x09, 0xe5, 0xdb, 0x78, 0x36, 0x1b, 0xde, 0xc4, 0x4b, 0x13, 0x59, 0x49,
-	0x5f, 0x42, 0xd1, 0xcd, 0x2a, 0xb8, 0x59, 0xb5, 0xe5, 0x2c, 0x37, 0xc0, 0x81, 0xa3, 0xef, 0x79,
-	0x28, 0xba, 0x19, 0x25, 0xc8, 0x07, 0x67, 0x64, 0x8d, 0xd6, 0xa1, 0xe2, 0x31, 0xe9, 0xa1, 0x8f,
-	0x9c, 0xe4, 0x68, 0x0d, 0xca, 0x91, 0x3c, 0x93, 0xc1, 0x07, 0x49, 0xf2, 0x74, 0x07, 0x88, 0x90,
-	0x17, 0xcc, 0x17, 0xdc, 0x30, 0xd5, 0x8b, 0xce, 0x51, 0x6a, 0x52, 0xa0, 0xbb, 0xb0, 0xc5, 0x91,
-	0x71, 0x5f, 0x48, 0x34, 0x78, 0xe9, 0x21, 0x72, 0xe4, 0xa4, 0x48, 0x37, 0xa0, 0x2a, 0x03, 0x6d,
-	0x4e, 0x82, 0x48, 0x72, 0xb2, 0x4e, 0x29, 0x6c, 0x32, 0x5f, 0x21, 0xe3, 0x03, 0x83, 0x97, 0x22,
-	0xd4, 0x21, 0x29, 0xd9, 0x93, 0x7d, 0x54, 0xe7, 0x22, 0x0c, 0x45, 0x20, 0x0d, 0x47, 0x29, 0x90,
-	0x93, 0x32, 0xdd, 0x86, 0x46, 0x24, 0x59, 0xa4, 0x4f, 0x51, 0x6a, 0xe1, 0x31, 0x8d, 0x9c, 0x10,
-	0xba, 0x07, 0x54, 0x61, 0x18, 0x44, 0xca, 0xb3, 0xb7, 0x9c, 0xb2, 0x28, 0xb4, 0x7a, 0x85, 0xee,
-	0xc3, 0xf6, 0x09, 0x13, 0x3e, 0x72, 0xd3, 0x57, 0xe8, 0x05, 0x92, 0x0b, 0x2d, 0x02, 0x49, 0xaa,
-	0xd6, 0x39, 0xeb, 0x06, 0xca, 0x76, 0x01, 0x25, 0x50, 0x0f, 0x22, 0x6d, 0x82, 0x13, 0xa3, 0x98,
-	0xec, 0x21, 0xa9, 0xd1, 0x2d, 0xd8, 0x88, 0xa4, 0x38, 0xef, 0xfb, 0x68, 0xd7, 0x40, 0x4e, 0xea,
-	0x76, 0x73, 0x21, 0x35, 0x2a, 0xc9, 0x7c, 0xb2, 0x41, 0x1b, 0x50, 0x8b, 0x24, 0xbb, 0x60, 0xc2,
-	0x67, 0x5d, 0x1f, 0xc9, 0xa6, 0x5d, 0x88, 0x33, 0xcd, 0x8c, 0x1f, 0x84, 0x21, 0x69, 0x1c, 0xfd,
-	0xc9, 0x43, 0xe3, 0xc9, 0x9b, 0xd8, 0x25, 0xc3, 0xc8, 0xf3, 0x30, 0x0c, 0x8d, 0x8f, 0x3d, 0xe6,
-	0x0d, 0xc8, 0x9a, 0x0d, 0x6d, 0x91, 0xa7, 0xf5, 0xb8, 0x54, 0x73, 0xb4, 0x09, 0x3b, 0xcb, 0x5c,
-	0x0d, 0x2a, 0x15, 0xa8, 0x8c, 0xb8, 0x90, 0xbb, 0x8c, 0x1b, 0x21, 0xfb, 0x91, 0xce, 0xd4, 0x02,
-	0x3d, 0x84, 0xe6, 0xb3, 0x90, 0x33, 0x5a, 0xa4, 0xff, 0xc1, 0x9e, 0x75, 0xde, 0x53, 0x42, 0x0f,
-	0x56, 0xe7, 0xad, 0xdb, 0x93, 0xcf, 0x42, 0xce, 0x68, 0x89, 0xbe, 0x80, 0x83, 0xe7, 0xb1, 0x66,
-	0xb8, 0x4c, 0xff, 0x87, 0xfd, 0xf7, 0x11, 0xaa, 0x81, 0xb1, 0x4f, 0x19, 0xa2, 0xba, 0x78, 0x84,
-	0x15, 0xeb, 0xd4, 0xca, 0x42, 0x1a, 0x7d, 0x99, 0xa9, 0x55, 0x7a, 0x00, 0xbb, 0x59, 0x8a, 0xab,
-	0x56, 0xc0, 0xda, 0xd4, 0x8a, 0xc9, 0x50, 0xa0, 0xd4, 0xab, 0xac, 0x66, 0xd9, 0x93, 0x47, 0xcf,
-	0x58, 0xbd, 0x8b, 0x3f, 0x1e, 0x5a, 0xb9, 0x9f, 0x0f, 0xad, 0xdc, 0xaf, 0x87, 0x56, 0xee, 0xdb,
-	0xef, 0xd6, 0x1a, 0x34, 0xc6, 0xc9, 0xf1, 0xfd, 0x78, 0x1e, 0xcf, 0x66, 0x8b, 0x7f, 0xee, 0xc7,
-	0xf6, 0xb2, 0x1a, 0x27, 0x9d, 0xc5, 0x57, 0xe7, 0x26, 0xe9, 0xdc, 0xcf, 0x3b, 0x8e, 0x76, 0xdc,
-	0xaf, 0xfe, 0xaa, 0xe4, 0x8a, 0xb7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xcb, 0x67, 0xe4, 0x15,
-	0xf3, 0x03, 0x00, 0x00,
+	// 741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x54, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0xa9, 0xf7, 0xa5, 0x2c, 0x4d, 0x26, 0x8e, 0xa3, 0xa4, 0xae, 0x1a, 0x68, 0x65, 0x78,
+	0x21, 0x01, 0x29, 0xd0, 0x16, 0xd9, 0x8d, 0x38, 0xd7, 0xca, 0x20, 0xd4, 0x50, 0x1d, 0x92, 0xae,
+	0xd5, 0x0d, 0xa1, 0x48, 0x84, 0xa1, 0x56, 0x11, 0x05, 0x4a, 0x11, 0xe0, 0x3f, 0xe9, 0xa2, 0xdf,
+	0xd1, 0x6f, 0xe8, 0xb2, 0x9f, 0x50, 0xb8, 0x9b, 0x7e, 0x46, 0x31, 0x7c, 0xc4, 0x95, 0xbd, 0x9b,
+	0x7b, 0xce, 0xe1, 0xe5, 0xb9, 0xe7, 0x0e, 0x09, 0xd6, 0x61, 0x9f, 0x6c, 0x17, 0x83, 0x6d, 0x12,
+	0xef, 0x63, 0x5a, 0x4d, 0x8b, 0xfe, 0x2f, 0xd0, 0xb0, 0xe7, 0xeb, 0x75, 0x94, 0x08, 0x4e, 0xcf,
+	0xa1, 0xb9, 0x4d, 0x56, 0x9b, 0xc5, 0x6a, 0x3b, 0x5f, 0x77, 0x8d, 0x37, 0xc6, 0x45, 0x53, 0x3d,
+	0x00, 0x9a, 0x5d, 0xc4, 0x9f, 0xb6, 0xf1, 0x26, 0xda, 0xec, 0xbb, 0x66, 0xc6, 0x7e, 0x01, 0x68,
+	0x1f, 0x5a, 0xbb, 0xcf, 0x1f, 0x1f, 0x04, 0xe5, 0x54, 0x70, 0x84, 0xf5, 0x7f, 0x37, 0xa1, 0xa1,
+	0xa6, 0x36, 0x26, 0x49, 0x9c, 0xd0, 0xef, 0xc1, 0x5a, 0x47, 0xb7, 0xf3, 0xc5, 0x5d, 0xb8, 0x88,
+	0x97, 0x51, 0xfa, 0xba, 0xf6, 0xdb, 0xb3, 0x41, 0x66, 0xd1, 0x49, 0x99, 0x54, 0x68, 0xc7, 0xcb,
+	0x48, 0x41, 0x26, 0xd5, 0x67, 0xda, 0x85, 0xfa, 0xa7, 0x68, 0xb7, 0x9b, 0xdf, 0x46, 0xb9, 0x8b,
+	0xa2, 0xa4, 0xdf, 0x40, 0x25, 0xed, 0x55, 0x4e, 0x7b, 0x59, 0x79, 0xaf, 0xb4, 0x41, 0x4a, 0xd0,
+	0x0b, 0xa8, 0xcf, 0xb7, 0xdb, 0xf5, 0x2a, 0x5a, 0x76, 0x2b, 0xa9, 0xa6, 0x9d, 0x6b, 0x58, 0x86,
+	0xaa, 0x82, 0xa6, 0xdf, 0x41, 0x7d, 0x19, 0xed, 0xe7, 0xab, 0xf5, 0xae, 0x5b, 0x7d, 0x53, 0xbe,
+	0xb0, 0xde, 0x9e, 0xe7, 0xca, 0xc2, 0xff, 0x80, 0x67, 0x34, 0x6e, 0xf6, 0xc9, 0x9d, 0x2a, 0xc4,
+	0xaf, 0xdf, 0x41, 0xeb, 0xff, 0x04, 0x25, 0x50, 0xfe, 0x35, 0xba, 0xcb, 0xc3, 0xd4, 0x47, 0x7a,
+	0x0a, 0xd5, 0xc3, 0x7c, 0xfd, 0xb9, 0x30, 0x9f, 0x15, 0xef, 0xcc, 0x1f, 0x8c, 0xcb, 0x3f, 0x4c,
+	0xa8, 0xa4, 0x13, 0xd6, 0xc0, 0x74, 0x3f, 0x90, 0x12, 0x6d, 0x41, 0xc3, 0x66, 0xd2, 0x46, 0x07,
+	0x39, 0x31, 0xa8, 0x05, 0xf5, 0x40, 0x7e, 0x90, 0xee, 0x4f, 0x92, 0x98, 0xf4, 0x14, 0x88, 0x90,
+	0xd7, 0xcc, 0x11, 0x3c, 0x64, 0x6a, 0x1c, 0x4c, 0x50, 0xfa, 0xa4, 0x4c, 0x5f, 0xc0, 0x33, 0x8e,
+	0x8c, 0x3b, 0x42, 0x62, 0x88, 0x37, 0x36, 0x22, 0x47, 0x4e, 0x2a, 0xf4, 0x04, 0x9a, 0xd2, 0xf5,
+	0xc3, 0x2b, 0x37, 0x90, 0x9c, 0x54, 0x29, 0x85, 0x36, 0x73, 0x14, 0x32, 0x3e, 0x0b, 0xf1, 0x46,
+	0x78, 0xbe, 0x47, 0x6a, 0xfa, 0xc9, 0x29, 0xaa, 0x89, 0xf0, 0x3c, 0xe1, 0xca, 0x90, 0xa3, 0x14,
+	0xc8, 0x49, 0x9d, 0x3e, 0x87, 0x4e, 0x20, 0x59, 0xe0, 0xbf, 0x47, 0xe9, 0x0b, 0x9b, 0xf9, 0xc8,
+	0x09, 0xa1, 0x67, 0x40, 0x15, 0x7a, 0x6e, 0xa0, 0x6c, 0xfd, 0x96, 0xf7, 0x2c, 0xf0, 0x34, 0xde,
+	0xa0, 0x2f, 0xe1, 0xf9, 0x15, 0x13, 0x0e, 0xf2, 0x70, 0xaa, 0xd0, 0x76, 0x25, 0x17, 0xbe, 0x70,
+	0x25, 0x69, 0x6a, 0xe7, 0x6c, 0xe4, 0x2a, 0xad, 0x02, 0x4a, 0xa0, 0xe5, 0x06, 0x7e, 0xe8, 0x5e,
+	0x85, 0x8a, 0xc9, 0x31, 0x12, 0x8b, 0x3e, 0x83, 0x93, 0x40, 0x8a, 0xc9, 0xd4, 0x41, 0x3d, 0x06,
+	0x72, 0xd2, 0xd2, 0x93, 0x0b, 0xe9, 0xa3, 0x92, 0xcc, 0x21, 0x27, 0xb4, 0x03, 0x56, 0x20, 0xd9,
+	0x35, 0x13, 0x0e, 0x1b, 0x39, 0x48, 0xda, 0x7a, 0x20, 0xce, 0x7c, 0x16, 0x3a, 0xae, 0xe7, 0x91,
+	0xce, 0xe5, 0xbf, 0x26, 0x74, 0x1e, 0xdd, 0x18, 0x3d, 0xa4, 0x17, 0xd8, 0x36, 0x7a, 0x5e, 0xe8,
+	0xe0, 0x98, 0xd9, 0x33, 0x52, 0xd2, 0xa1, 0x65, 0x79, 0x6a, 0x8f, 0x39, 0x6a, 0xd0, 0x2e, 0x9c,
+	0xe6, 0xb9, 0x86, 0xa8, 0x94, 0xab, 0x0a, 0x26, 0x0d, 0x79, 0xc4, 0x78, 0x28, 0xe4, 0x34, 0xf0,
+	0x0b, 0xb4, 0x4c, 0xcf, 0xa1, 0xfb, 0x24, 0xe4, 0x82, 0xad, 0xd0, 0xd7, 0x70, 0xa6, 0x9d, 0x8f,
+	0x95, 0xf0, 0x67, 0xc7, 0xfd, 0xaa, 0xfa, 0xc9, 0x27, 0x21, 0x17, 0x6c, 0x8d, 0x7e, 0x0d, 0xaf,
+	0x9e, 0xc6, 0x5a, 0xd0, 0x75, 0xfa, 0x15, 0xbc, 0xfc, 0x31, 0x40, 0x35, 0x0b, 0xf5, 0x2a, 0x3d,
+	0x54, 0xd7, 0x0f, 0x64, 0x43, 0x3b, 0xd5, 0xb0, 0x90, 0xa1, 0x7f, 0x53, 0xa0, 0x4d, 0xfa, 0x0a,
+	0x5e, 0x14, 0x29, 0x1e, 0x5b, 0x01, 0x6d, 0xd3, 0x57, 0x4c, 0x7a, 0x02, 0xa5, 0x7f, 0xcc, 0x59,
+	0x9a, 0x7b, 0xb4, 0xf4, 0x82, 0x6b, 0x5d, 0x8e, 0xa0, 0x9e, 0x7f, 0x2b, 0xfa, 0x6e, 0xb0, 0xe9,
+	0xd4, 0xd1, 0x33, 0x14, 0xf7, 0xb2, 0xa4, 0x57, 0xa5, 0x8d, 0xe4, 0x04, 0x31, 0xf4, 0x72, 0x27,
+	0x6c, 0x36, 0xc2, 0x2f, 0x90, 0x39, 0xc2, 0x3f, 0xef, 0x7b, 0xc6, 0x5f, 0xf7, 0x3d, 0xe3, 0xef,
+	0xfb, 0x9e, 0xf1, 0xdb, 0x3f, 0xbd, 0x12, 0x74, 0x56, 0xf1, 0xe0, 0xb0, 0xda, 0x47, 0xbb, 0x5d,
+	0xf6, 0x73, 0xfa, 0xb9, 0x9f, 0x57, 0xab, 0x78, 0x98, 0x9d, 0x86, 0xb7, 0xf1, 0xf0, 0xb0, 0x1f,
+	0xa6, 0xec, 0x30, 0xfd, 0x12, 0x3f, 0xd6, 0xd2, 0xe2, 0xdb, 0xff, 0x06, 0x00, 0xd5, 0xe9, 0x1e,
+	0xa3, 0xd6, 0x04, 0x00, 0x00,
 }
 
 func (m *CallerID) Marshal() (dAtA []byte, err error) {
@@ -562,6 +624,30 @@ func (m *RPCError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Details) > 0 {
+		for k := range m.Details {
+			v := m.Details[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintVtrpc(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintVtrpc(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintVtrpc(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Applied != 0 {
+		i = encodeVarintVtrpc(dAtA, i, uint64(m.Applied))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Code != 0 {
 		i = encodeVarintVtrpc(dAtA, i, uint64(m.Code))
 		i--
@@ -633,6 +719,17 @@ func (m *RPCError) Size() (n int) {
 	if m.Code != 0 {
 		n += 1 + sovVtrpc(uint64(m.Code))
 	}
+	if m.Applied != 0 {
+		n += 1 + sovVtrpc(uint64(m.Applied))
+	}
+	if len(m.Details) > 0 {
+		for k, v := range m.Details {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovVtrpc(uint64(len(k))) + 1 + len(v) + sovVtrpc(uint64(len(v)))
+			n += mapEntrySize + 1 + sovVtrpc(uint64(mapEntrySize))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -894,6 +991,152 @@ func (m *RPCError) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			m.Applied = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Applied |= Applied(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Details", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVtrpc
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthVtrpc
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthVtrpc
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Details == nil {
+				m.Details = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowVtrpc
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVtrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthVtrpc
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthVtrpc
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowVtrpc
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthVtrpc
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthVtrpc
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipVtrpc(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if skippy < 0 {
+						return ErrInvalidLengthVtrpc
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Details[mapkey] = mapvalue
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVtrpc(dAtA[iNdEx:])
@@ -35,6 +35,7 @@ var (
 	onlineDDLGeneratedTableNameRegexp = regexp.MustCompile(`^_[0-f]{8}_[0-f]{4}_[0-f]{4}_[0-f]{4}_[0-f]{12}_([0-9]{14})_(gho|ghc|del|new|vrepl)$`)
 	ptOSCGeneratedTableNameRegexp     = regexp.MustCompile(`^_.*_old$`)
 	revertStatementRegexp             = regexp.MustCompile(`(?i)^revert\s+(.*)$`)
+	declarativeOptionRegexp           = regexp.MustCompile(`(^|\s)--?declarative(\s|$)`)
 )
 
 const (
@@ -174,8 +175,21 @@ func ParseOnlineDDLStatement(sql string) (ddlStmt sqlparser.DDLStatement, action
 	return ddlStmt, action, fmt.Errorf("Unsupported query type: %s", sql)
 }
 
+// IsDeclarativeOptions returns true if the strategy options request a
+// declarative migration: the migration is a CREATE TABLE statement with the
+// desired definition of the table, and each tablet computes and runs the
+// ALTER TABLE statement turning its current table into the desired one.
+func IsDeclarativeOptions(options string) bool {
+	return declarativeOptionRegexp.MatchString(options)
+}
+
 // NewOnlineDDL creates a schema change request with self generated UUID and RequestTime
 func NewOnlineDDL(keyspace string, table string, sql string, strategy DDLStrategy, options string, requestContext string) (*OnlineDDL, error) {
+	if IsDeclarativeOptions(options) {
+		if _, action, err := ParseOnlineDDLStatement(sql); err != nil || (action != sqlparser.CreateDDLAction && action != sqlparser.DropDDLAction) {
+			return nil, fmt.Errorf("declarative migrations only support CREATE TABLE and DROP TABLE statements: %s", sql)
+		}
+	}
 	u, err := createUUID("_")
 	if err != nil {
 		return nil, err
@@ -193,6 +207,18 @@ func NewOnlineDDL(keyspace string, table string, sql string, strategy DDLStrateg
 	}, nil
 }
 
+// IsDeclarative returns true if this is a declarative migration, see
+// IsDeclarativeOptions.
+func (onlineDDL *OnlineDDL) IsDeclarative() bool {
+	return IsDeclarativeOptions(onlineDDL.Options)
+}
+
+// RuntimeOptions returns the options to pass to the migration tool, which
+// are the options without the ones interpreted by Vitess.
+func (onlineDDL *OnlineDDL) RuntimeOptions() string {
+	return strings.TrimSpace(declarativeOptionRegexp.ReplaceAllString(onlineDDL.Options, " "))
+}
+
 // RequestTimeSeconds converts request time to seconds (losing nano precision)
 func (onlineDDL *OnlineDDL) RequestTimeSeconds() int64 {
 	return onlineDDL.RequestTime / int64(time.Second)
@@ -151,6 +151,35 @@ func TestGetActionStr(t *testing.T) {
 	}
 }
 
+func TestDeclarativeOptions(t *testing.T) {
+	tt := []struct {
+		options        string
+		isDeclarative  bool
+		runtimeOptions string
+	}{
+		{options: "", runtimeOptions: ""},
+		{options: "--max-load=Threads_running=100", runtimeOptions: "--max-load=Threads_running=100"},
+		{options: "-declarative", isDeclarative: true, runtimeOptions: ""},
+		{options: "--declarative --max-load=Threads_running=100", isDeclarative: true, runtimeOptions: "--max-load=Threads_running=100"},
+		{options: "--max-load=Threads_running=100 -declarative", isDeclarative: true, runtimeOptions: "--max-load=Threads_running=100"},
+		{options: "--not-declarative", runtimeOptions: "--not-declarative"},
+	}
+	for _, ts := range tt {
+		t.Run(ts.options, func(t *testing.T) {
+			onlineDDL := &OnlineDDL{Options: ts.options}
+			assert.Equal(t, ts.isDeclarative, onlineDDL.IsDeclarative())
+			assert.Equal(t, ts.runtimeOptions, onlineDDL.RuntimeOptions())
+		})
+	}
+
+	_, err := NewOnlineDDL("ks", "t", "create table t (id int primary key)", DDLStrategyOnline, "-declarative", "")
+	assert.NoError(t, err)
+	_, err = NewOnlineDDL("ks", "t", "drop table t", DDLStrategyOnline, "-declarative", "")
+	assert.NoError(t, err)
+	_, err = NewOnlineDDL("ks", "t", "alter table t add column c int", DDLStrategyOnline, "-declarative", "")
+	assert.Error(t, err)
+}
+
 func TestIsOnlineDDLTableName(t *testing.T) {
 	names := []string{
 		"_4e5dcf80_354b_11eb_82cd_f875a4d24e90_20201203114014_gho",
@@ -0,0 +1,412 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package schemadiff computes the ALTER TABLE statement which turns a table
+into the table defined by a desired CREATE TABLE statement.
+
+Both tables are normalized before being compared, so that the differences
+between a CREATE TABLE statement written by hand and the one returned by
+SHOW CREATE TABLE don't cause spurious changes:
+  - inline key definitions such as `id int primary key` become indexes,
+  - unnamed indexes and constraints get the names MySQL gives them,
+  - the display width of the integer types is ignored,
+  - nullable columns without a default get DEFAULT NULL,
+  - numeric defaults are compared as strings,
+  - the column charset and collation are ignored when they are the
+    default ones of the table,
+  - only the table options of the desired table are compared, except
+    AUTO_INCREMENT which is always ignored.
+
+Columns are matched by name, so renaming a column drops it and adds a new
+one. The position of the existing columns is not changed, and the new
+columns are added after the column preceding them in the desired table, or
+last if they are the first column.
+*/
+package schemadiff
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// DiffCreateTableQueries parses the CREATE TABLE statements and returns the
+// ALTER TABLE statement turning the from table into the to table. It
+// returns nil if the tables are the same.
+func DiffCreateTableQueries(from, to string) (*sqlparser.AlterTable, error) {
+	fromCreate, err := parseCreateTable(from)
+	if err != nil {
+		return nil, err
+	}
+	toCreate, err := parseCreateTable(to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffTables(fromCreate, toCreate)
+}
+
+func parseCreateTable(sql string) (*sqlparser.CreateTable, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	create, ok := stmt.(*sqlparser.CreateTable)
+	if !ok {
+		return nil, fmt.Errorf("not a CREATE TABLE statement: %s", sql)
+	}
+	return create, nil
+}
+
+// DiffTables returns the ALTER TABLE statement turning the from table into
+// the to table, or nil if the tables are the same. The statement is on the
+// table of to.
+func DiffTables(from, to *sqlparser.CreateTable) (*sqlparser.AlterTable, error) {
+	for _, create := range []*sqlparser.CreateTable{from, to} {
+		if !create.FullyParsed || create.TableSpec == nil {
+			return nil, fmt.Errorf("cannot analyze CREATE TABLE statement: %s", sqlparser.String(create))
+		}
+	}
+	fromTable := newTable(from)
+	toTable := newTable(to)
+
+	var drops, changes, adds []sqlparser.AlterOption
+
+	// Constraints, which are foreign keys and checks.
+	for _, name := range fromTable.constraintNames {
+		fromConstraint := fromTable.constraints[name]
+		toConstraint, ok := toTable.constraints[name]
+		if ok && sqlparser.String(fromConstraint) == sqlparser.String(toConstraint) {
+			continue
+		}
+		if _, isFK := fromConstraint.Details.(*sqlparser.ForeignKeyDefinition); !isFK {
+			return nil, fmt.Errorf("cannot drop or change check constraint %s", fromConstraint.Name.String())
+		}
+		drops = append(drops, &sqlparser.DropKey{Type: sqlparser.ForeignKeyType, Name: fromConstraint.Name})
+	}
+	for _, name := range toTable.constraintNames {
+		toConstraint := toTable.constraints[name]
+		fromConstraint, ok := fromTable.constraints[name]
+		if ok && sqlparser.String(fromConstraint) == sqlparser.String(toConstraint) {
+			continue
+		}
+		adds = append(adds, &sqlparser.AddConstraintDefinition{ConstraintDefinition: toConstraint})
+	}
+
+	// Indexes. A changed index is dropped and added again.
+	for _, name := range fromTable.indexNames {
+		fromIndex := fromTable.indexes[name]
+		toIndex, ok := toTable.indexes[name]
+		if ok && sqlparser.String(fromIndex) == sqlparser.String(toIndex) {
+			continue
+		}
+		if !ok && toTable.needsIndex(fromIndex) {
+			// MySQL creates the indexes of the foreign keys if
+			// they don't have one, and refuses to drop them.
+			continue
+		}
+		if fromIndex.Info.Primary {
+			drops = append(drops, &sqlparser.DropKey{Type: sqlparser.PrimaryKeyType})
+		} else {
+			drops = append(drops, &sqlparser.DropKey{Type: sqlparser.NormalKeyType, Name: fromIndex.Info.Name})
+		}
+	}
+	var addIndexes []sqlparser.AlterOption
+	for _, name := range toTable.indexNames {
+		toIndex := toTable.indexes[name]
+		fromIndex, ok := fromTable.indexes[name]
+		if ok && sqlparser.String(fromIndex) == sqlparser.String(toIndex) {
+			continue
+		}
+		addIndexes = append(addIndexes, &sqlparser.AddIndexDefinition{IndexDefinition: toIndex})
+	}
+
+	// Columns.
+	var addColumns []sqlparser.AlterOption
+	for _, name := range fromTable.columnNames {
+		if _, ok := toTable.columns[name]; !ok {
+			drops = append(drops, &sqlparser.DropColumn{Name: &sqlparser.ColName{Name: fromTable.columns[name].Name}})
+		}
+	}
+	for i, name := range toTable.columnNames {
+		toColumn := toTable.columns[name]
+		fromColumn, ok := fromTable.columns[name]
+		if !ok {
+			add := &sqlparser.AddColumns{Columns: []*sqlparser.ColumnDefinition{toColumn}}
+			if i > 0 {
+				add.After = &sqlparser.ColName{Name: toTable.columns[toTable.columnNames[i-1]].Name}
+			}
+			addColumns = append(addColumns, add)
+			continue
+		}
+		if sqlparser.String(fromColumn) != sqlparser.String(toColumn) {
+			changes = append(changes, &sqlparser.ModifyColumn{NewColDefinition: toColumn})
+		}
+	}
+
+	// Table options. Only the options of the desired table are compared.
+	var options sqlparser.TableOptions
+	for _, name := range toTable.optionNames {
+		toOption := toTable.options[name]
+		fromOption, ok := fromTable.options[name]
+		if ok && formatOption(fromOption) == formatOption(toOption) {
+			continue
+		}
+		options = append(options, toOption)
+	}
+
+	alterOptions := append(drops, changes...)
+	alterOptions = append(alterOptions, addColumns...)
+	alterOptions = append(alterOptions, addIndexes...)
+	alterOptions = append(alterOptions, adds...)
+	if len(options) > 0 {
+		alterOptions = append(alterOptions, options)
+	}
+	if len(alterOptions) == 0 {
+		return nil, nil
+	}
+	return &sqlparser.AlterTable{
+		Table:        to.Table,
+		AlterOptions: alterOptions,
+	}, nil
+}
+
+// table is the normalized definition of a table, indexed by the lower
+// case names of its parts.
+type table struct {
+	columnNames []string
+	columns     map[string]*sqlparser.ColumnDefinition
+
+	indexNames []string
+	indexes    map[string]*sqlparser.IndexDefinition
+
+	constraintNames []string
+	constraints     map[string]*sqlparser.ConstraintDefinition
+
+	optionNames []string
+	options     map[string]*sqlparser.TableOption
+}
+
+func newTable(create *sqlparser.CreateTable) *table {
+	spec := sqlparser.CloneRefOfTableSpec(create.TableSpec)
+	t := &table{
+		columns:     make(map[string]*sqlparser.ColumnDefinition),
+		indexes:     make(map[string]*sqlparser.IndexDefinition),
+		constraints: make(map[string]*sqlparser.ConstraintDefinition),
+		options:     make(map[string]*sqlparser.TableOption),
+	}
+
+	for _, option := range spec.Options {
+		name := optionName(option.Name)
+		if name == "auto_increment" {
+			continue
+		}
+		option.Name = name
+		t.optionNames = append(t.optionNames, name)
+		t.options[name] = option
+	}
+	tableCharset, tableCollate := "", ""
+	if option := t.options["default charset"]; option != nil {
+		tableCharset = strings.ToLower(formatOptionValue(option))
+	}
+	if option := t.options["default collate"]; option != nil {
+		tableCollate = strings.ToLower(formatOptionValue(option))
+	}
+
+	indexes := spec.Indexes
+	for _, column := range spec.Columns {
+		if index := column.InlineIndex(); index != nil {
+			indexes = append(indexes, index)
+			column.ClearKeyOption()
+		}
+	}
+	primaryColumns := make(map[string]bool)
+	for _, index := range indexes {
+		if index.Info.Primary {
+			for _, column := range index.Columns {
+				primaryColumns[column.Column.Lowered()] = true
+			}
+		}
+	}
+
+	for _, column := range spec.Columns {
+		normalizeColumn(column, primaryColumns[column.Name.Lowered()], tableCharset, tableCollate)
+		name := column.Name.Lowered()
+		t.columnNames = append(t.columnNames, name)
+		t.columns[name] = column
+	}
+
+	for _, index := range indexes {
+		normalizeIndexInfo(index.Info)
+		if index.Info.Name.IsEmpty() && len(index.Columns) > 0 {
+			index.Info.Name = uniqueName(t.indexes, index.Columns[0].Column.String())
+		}
+		name := index.Info.Name.Lowered()
+		t.indexNames = append(t.indexNames, name)
+		t.indexes[name] = index
+	}
+
+	fkCount, checkCount := 0, 0
+	for _, constraint := range spec.Constraints {
+		if constraint.Name.IsEmpty() {
+			// The names MySQL gives to unnamed constraints.
+			if _, isFK := constraint.Details.(*sqlparser.ForeignKeyDefinition); isFK {
+				fkCount++
+				constraint.Name = sqlparser.NewColIdent(fmt.Sprintf("%s_ibfk_%d", create.Table.Name.String(), fkCount))
+			} else {
+				checkCount++
+				constraint.Name = sqlparser.NewColIdent(fmt.Sprintf("%s_chk_%d", create.Table.Name.String(), checkCount))
+			}
+		}
+		name := constraint.Name.Lowered()
+		t.constraintNames = append(t.constraintNames, name)
+		t.constraints[name] = constraint
+	}
+	return t
+}
+
+// needsIndex returns true if a foreign key of the table can use index.
+func (t *table) needsIndex(index *sqlparser.IndexDefinition) bool {
+	for _, constraint := range t.constraints {
+		fk, ok := constraint.Details.(*sqlparser.ForeignKeyDefinition)
+		if !ok || len(fk.Source) > len(index.Columns) {
+			continue
+		}
+		matches := true
+		for i, column := range fk.Source {
+			if !column.Equal(index.Columns[i].Column) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// uniqueName returns name, with a suffix if needed to be unique among the
+// indexes, as MySQL names the unnamed indexes.
+func uniqueName(indexes map[string]*sqlparser.IndexDefinition, name string) sqlparser.ColIdent {
+	unique := name
+	for i := 2; indexes[strings.ToLower(unique)] != nil; i++ {
+		unique = name + "_" + strconv.Itoa(i)
+	}
+	return sqlparser.NewColIdent(unique)
+}
+
+// integerTypes are the types whose display width is ignored.
+var integerTypes = map[string]bool{
+	"tinyint":   true,
+	"smallint":  true,
+	"mediumint": true,
+	"int":       true,
+	"bigint":    true,
+}
+
+// typeAliases maps the type aliases to the types MySQL shows.
+var typeAliases = map[string]string{
+	"integer": "int",
+	"bool":    "tinyint",
+	"boolean": "tinyint",
+}
+
+func normalizeColumn(column *sqlparser.ColumnDefinition, primary bool, tableCharset, tableCollate string) {
+	ct := &column.Type
+	ct.Type = strings.ToLower(ct.Type)
+	if alias, ok := typeAliases[ct.Type]; ok {
+		ct.Type = alias
+	}
+	if len(ct.EnumValues) == 0 {
+		ct.EnumValues = nil
+	}
+	if integerTypes[ct.Type] && !ct.Zerofill {
+		ct.Length = nil
+	}
+	ct.Charset = strings.ToLower(ct.Charset)
+	ct.Collate = strings.ToLower(ct.Collate)
+	if ct.Charset == tableCharset {
+		ct.Charset = ""
+	}
+	if ct.Collate == tableCollate {
+		ct.Collate = ""
+	}
+
+	if ct.Options == nil {
+		ct.Options = &sqlparser.ColumnTypeOptions{}
+	}
+	opts := ct.Options
+	if opts.Null == nil {
+		nullable := !primary
+		opts.Null = &nullable
+	}
+	if *opts.Null && opts.Default == nil && !opts.Autoincrement {
+		opts.Default = &sqlparser.NullVal{}
+	}
+	if literal, ok := opts.Default.(*sqlparser.Literal); ok {
+		switch literal.Type {
+		case sqlparser.IntVal, sqlparser.FloatVal:
+			opts.Default = sqlparser.NewStrLiteral(literal.Val)
+		}
+	}
+}
+
+func normalizeIndexInfo(info *sqlparser.IndexInfo) {
+	// The constraint names of the indexes aren't shown by MySQL.
+	info.ConstraintName = sqlparser.ColIdent{}
+	switch {
+	case info.Primary:
+		info.Type = sqlparser.PrimaryKeyTypeStr
+		info.Name = sqlparser.NewColIdent("PRIMARY")
+	case info.Spatial:
+		info.Type = "spatial key"
+	case info.Fulltext:
+		info.Type = "fulltext key"
+	case info.Unique:
+		info.Type = "unique key"
+	default:
+		info.Type = "key"
+	}
+}
+
+// optionName returns the canonical name of a table option.
+func optionName(name string) string {
+	name = strings.Join(strings.Fields(strings.ToLower(name)), " ")
+	switch name {
+	case "charset", "character set", "default character set", "default charset":
+		return "default charset"
+	case "collate", "default collate":
+		return "default collate"
+	}
+	return name
+}
+
+func formatOptionValue(option *sqlparser.TableOption) string {
+	if option.String != "" {
+		return option.String
+	}
+	if option.Value != nil {
+		return option.Value.Val
+	}
+	return sqlparser.String(option.Tables)
+}
+
+func formatOption(option *sqlparser.TableOption) string {
+	return option.Name + " " + strings.ToLower(formatOptionValue(option))
+}
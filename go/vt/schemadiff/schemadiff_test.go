@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemadiff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+func TestDiffCreateTableQueries(t *testing.T) {
+	testcases := []struct {
+		name     string
+		from, to string
+		diff     string
+	}{{
+		name: "same",
+		from: "create table t (id int primary key, name varchar(10))",
+		to:   "create table t (id int primary key, name varchar(10))",
+	}, {
+		name: "show create table equivalent",
+		from: "CREATE TABLE `t` (\n" +
+			"  `id` int(11) NOT NULL AUTO_INCREMENT,\n" +
+			"  `name` varchar(10) DEFAULT NULL,\n" +
+			"  `cnt` int(11) NOT NULL DEFAULT '0',\n" +
+			"  PRIMARY KEY (`id`),\n" +
+			"  KEY `name` (`name`)\n" +
+			") ENGINE=InnoDB AUTO_INCREMENT=12 DEFAULT CHARSET=utf8mb4",
+		to: "create table t (id int auto_increment primary key, name varchar(10), cnt integer not null default 0, index (name)) engine=innodb",
+	}, {
+		name: "add column",
+		from: "create table t (id int primary key, name varchar(10))",
+		to:   "create table t (id int primary key, age int, name varchar(10))",
+		diff: "alter table t add column age int null default null after id",
+	}, {
+		name: "drop column",
+		from: "create table t (id int primary key, name varchar(10))",
+		to:   "create table t (id int primary key)",
+		diff: "alter table t drop column `name`",
+	}, {
+		name: "modify column",
+		from: "create table t (id int primary key, name varchar(10))",
+		to:   "create table t (id int primary key, name varchar(20) not null)",
+		diff: "alter table t modify column `name` varchar(20) not null",
+	}, {
+		name: "indexes",
+		from: "create table t (id int primary key, a int, b int, key a_idx (a), key b_idx (b))",
+		to:   "create table t (id int primary key, a int, b int, key a_idx (a, b), unique key c_idx (b))",
+		diff: "alter table t drop key a_idx, drop key b_idx, add key a_idx (a, b), add unique key c_idx (b)",
+	}, {
+		name: "primary key",
+		from: "create table t (id int primary key, a int not null)",
+		to:   "create table t (id int, a int not null, primary key (id, a))",
+		diff: "alter table t drop primary key, add primary key (id, a)",
+	}, {
+		name: "foreign key",
+		from: "create table t (id int primary key, p int, key p (p))",
+		to:   "create table t (id int primary key, p int, foreign key (p) references parent (id))",
+		diff: "alter table t add constraint t_ibfk_1 foreign key (p) references parent (id)",
+	}, {
+		name: "table options",
+		from: "create table t (id int primary key) engine=InnoDB default charset=utf8mb4 comment='x'",
+		to:   "create table t (id int primary key) charset=utf8mb4 comment='y'",
+		diff: "alter table t comment 'y'",
+	}, {
+		name: "column charset",
+		from: "create table t (id int primary key, name varchar(10)) default charset=utf8mb4",
+		to:   "create table t (id int primary key, name varchar(10) character set utf8mb4) default charset=utf8mb4",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(t *testing.T) {
+			alter, err := DiffCreateTableQueries(tc.from, tc.to)
+			require.NoError(t, err)
+			if tc.diff == "" {
+				if alter != nil {
+					t.Errorf("unexpected diff: %s", sqlparser.String(alter))
+				}
+				return
+			}
+			require.NotNil(t, alter)
+			assert.Equal(t, tc.diff, sqlparser.String(alter))
+			_, err = sqlparser.Parse(sqlparser.String(alter))
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestDiffCreateTableQueriesErrors(t *testing.T) {
+	_, err := DiffCreateTableQueries("create table t (id int)", "alter table t add column a int")
+	assert.Error(t, err)
+	_, err = DiffCreateTableQueries("create table t (id int)", "create table t (")
+	assert.Error(t, err)
+	_, err = DiffCreateTableQueries(
+		"create table t (id int, constraint c check (id > 0))",
+		"create table t (id int)")
+	assert.EqualError(t, err, "cannot drop or change check constraint c")
+}
@@ -621,6 +621,15 @@ func (tw *TimingsWrapper) Add(name string, elapsed time.Duration) {
 	tw.timings.Add([]string{tw.name, name}, elapsed)
 }
 
+// AddWithExemplar behaves like Timings.AddWithExemplar.
+func (tw *TimingsWrapper) AddWithExemplar(name string, elapsed time.Duration, exemplar func() string) {
+	if tw.name == "" {
+		tw.timings.AddWithExemplar([]string{name}, elapsed, exemplar)
+		return
+	}
+	tw.timings.AddWithExemplar([]string{tw.name, name}, elapsed, exemplar)
+}
+
 // Record behaves like Timings.Record.
 func (tw *TimingsWrapper) Record(name string, startTime time.Time) {
 	if tw.name == "" {
@@ -658,6 +667,16 @@ func (tw *MultiTimingsWrapper) Add(names []string, elapsed time.Duration) {
 	tw.timings.Add(newlabels, elapsed)
 }
 
+// AddWithExemplar behaves like MultiTimings.AddWithExemplar.
+func (tw *MultiTimingsWrapper) AddWithExemplar(names []string, elapsed time.Duration, exemplar func() string) {
+	if tw.name == "" {
+		tw.timings.AddWithExemplar(names, elapsed, exemplar)
+		return
+	}
+	newlabels := combineLabels(tw.name, names)
+	tw.timings.AddWithExemplar(newlabels, elapsed, exemplar)
+}
+
 // Record behaves like MultiTimings.Record.
 func (tw *MultiTimingsWrapper) Record(names []string, startTime time.Time) {
 	if tw.name == "" {
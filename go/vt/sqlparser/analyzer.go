@@ -62,6 +62,7 @@ const (
 	StmtFlush
 	StmtCallProc
 	StmtRevert
+	StmtKill
 )
 
 //ASTToStatementType returns a StatementType from an AST stmt
@@ -85,7 +86,7 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtRevert
 	case *Use:
 		return StmtUse
-	case *OtherRead, *OtherAdmin, *Load:
+	case *OtherRead, *OtherAdmin, *Load, *AnalyzeTable:
 		return StmtOther
 	case Explain:
 		return StmtExplain
@@ -109,6 +110,8 @@ func ASTToStatementType(stmt Statement) StatementType {
 		return StmtFlush
 	case *CallProc:
 		return StmtCallProc
+	case *Kill:
+		return StmtKill
 	default:
 		return StmtUnknown
 	}
@@ -188,6 +191,8 @@ func Preview(sql string) StatementType {
 		return StmtLockTables
 	case "unlock":
 		return StmtUnlockTables
+	case "kill":
+		return StmtKill
 	}
 	// For the following statements it is not sufficient to rely
 	// on loweredFirstWord. This is because they are not statements
@@ -280,6 +285,8 @@ func (s StatementType) String() string {
 		return "FLUSH"
 	case StmtCallProc:
 		return "CALL_PROC"
+	case StmtKill:
+		return "KILL"
 	default:
 		return "UNKNOWN"
 	}
@@ -78,6 +78,7 @@ func TestPreview(t *testing.T) {
 		{"revoke", StmtPriv},
 		{"truncate", StmtDDL},
 		{"flush", StmtFlush},
+		{"kill query 12", StmtKill},
 		{"unknown", StmtUnknown},
 
 		{"/* leading comment */ select ...", StmtSelect},
@@ -565,6 +565,21 @@ type (
 		Wild  string
 	}
 
+	// AnalyzeTable represents an ANALYZE TABLE statement.
+	AnalyzeTable struct {
+		IsLocal bool
+		Tables  TableNames
+	}
+
+	// KillType is an enum for Kill.Type
+	KillType int8
+
+	// Kill represents a KILL statement.
+	Kill struct {
+		Type          KillType
+		ProcesslistID uint64
+	}
+
 	// OtherRead represents a DESCRIBE, or EXPLAIN statement.
 	// It should be used only as an indicator. It does not contain
 	// the full AST for the statement.
@@ -597,6 +612,8 @@ func (*SRollback) iStatement()         {}
 func (*Savepoint) iStatement()         {}
 func (*Release) iStatement()           {}
 func (*OtherRead) iStatement()         {}
+func (*AnalyzeTable) iStatement()      {}
+func (*Kill) iStatement()              {}
 func (*OtherAdmin) iStatement()        {}
 func (*Select) iSelectStatement()      {}
 func (*Union) iSelectStatement()       {}
@@ -1258,6 +1275,7 @@ type (
 		Table                  TableName
 		ShowTablesOpt          *ShowTablesOpt
 		Scope                  Scope
+		Full                   bool
 		ShowCollationFilterOpt Expr
 	}
 
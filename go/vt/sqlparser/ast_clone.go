@@ -51,6 +51,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfAlterView(in)
 	case *AlterVschema:
 		return CloneRefOfAlterVschema(in)
+	case *AnalyzeTable:
+		return CloneRefOfAnalyzeTable(in)
 	case *AndExpr:
 		return CloneRefOfAndExpr(in)
 	case Argument:
@@ -161,6 +163,8 @@ func CloneSQLNode(in SQLNode) SQLNode {
 		return CloneRefOfJoinTableExpr(in)
 	case *KeyState:
 		return CloneRefOfKeyState(in)
+	case *Kill:
+		return CloneRefOfKill(in)
 	case *Limit:
 		return CloneRefOfLimit(in)
 	case ListArg:
@@ -454,6 +458,16 @@ func CloneRefOfAlterVschema(n *AlterVschema) *AlterVschema {
 	return &out
 }
 
+// CloneRefOfAnalyzeTable creates a deep clone of the input.
+func CloneRefOfAnalyzeTable(n *AnalyzeTable) *AnalyzeTable {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	out.Tables = CloneTableNames(n.Tables)
+	return &out
+}
+
 // CloneRefOfAndExpr creates a deep clone of the input.
 func CloneRefOfAndExpr(n *AndExpr) *AndExpr {
 	if n == nil {
@@ -997,6 +1011,15 @@ func CloneRefOfKeyState(n *KeyState) *KeyState {
 	return &out
 }
 
+// CloneRefOfKill creates a deep clone of the input.
+func CloneRefOfKill(n *Kill) *Kill {
+	if n == nil {
+		return nil
+	}
+	out := *n
+	return &out
+}
+
 // CloneRefOfLimit creates a deep clone of the input.
 func CloneRefOfLimit(n *Limit) *Limit {
 	if n == nil {
@@ -2114,6 +2137,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfAlterView(in)
 	case *AlterVschema:
 		return CloneRefOfAlterVschema(in)
+	case *AnalyzeTable:
+		return CloneRefOfAnalyzeTable(in)
 	case *Begin:
 		return CloneRefOfBegin(in)
 	case *CallProc:
@@ -2142,6 +2167,8 @@ func CloneStatement(in Statement) Statement {
 		return CloneRefOfFlush(in)
 	case *Insert:
 		return CloneRefOfInsert(in)
+	case *Kill:
+		return CloneRefOfKill(in)
 	case *Load:
 		return CloneRefOfLoad(in)
 	case *LockTables:
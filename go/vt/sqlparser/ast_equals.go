@@ -110,6 +110,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfAlterVschema(a, b)
+	case *AnalyzeTable:
+		b, ok := inB.(*AnalyzeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAnalyzeTable(a, b)
 	case *AndExpr:
 		b, ok := inB.(*AndExpr)
 		if !ok {
@@ -440,6 +446,12 @@ func EqualsSQLNode(inA, inB SQLNode) bool {
 			return false
 		}
 		return EqualsRefOfKeyState(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
 	case *Limit:
 		b, ok := inB.(*Limit)
 		if !ok {
@@ -1066,6 +1078,18 @@ func EqualsRefOfAlterVschema(a, b *AlterVschema) bool {
 		EqualsRefOfAutoIncSpec(a.AutoIncSpec, b.AutoIncSpec)
 }
 
+// EqualsRefOfAnalyzeTable does deep equals between the two objects.
+func EqualsRefOfAnalyzeTable(a, b *AnalyzeTable) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.IsLocal == b.IsLocal &&
+		EqualsTableNames(a.Tables, b.Tables)
+}
+
 // EqualsRefOfAndExpr does deep equals between the two objects.
 func EqualsRefOfAndExpr(a, b *AndExpr) bool {
 	if a == b {
@@ -1737,6 +1761,18 @@ func EqualsRefOfKeyState(a, b *KeyState) bool {
 	return a.Enable == b.Enable
 }
 
+// EqualsRefOfKill does deep equals between the two objects.
+func EqualsRefOfKill(a, b *Kill) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+	return a.Type == b.Type &&
+		a.ProcesslistID == b.ProcesslistID
+}
+
 // EqualsRefOfLimit does deep equals between the two objects.
 func EqualsRefOfLimit(a, b *Limit) bool {
 	if a == b {
@@ -2298,6 +2334,7 @@ func EqualsRefOfShowLegacy(a, b *ShowLegacy) bool {
 		EqualsTableName(a.Table, b.Table) &&
 		EqualsRefOfShowTablesOpt(a.ShowTablesOpt, b.ShowTablesOpt) &&
 		a.Scope == b.Scope &&
+		a.Full == b.Full &&
 		EqualsExpr(a.ShowCollationFilterOpt, b.ShowCollationFilterOpt)
 }
 
@@ -3415,6 +3452,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfAlterVschema(a, b)
+	case *AnalyzeTable:
+		b, ok := inB.(*AnalyzeTable)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfAnalyzeTable(a, b)
 	case *Begin:
 		b, ok := inB.(*Begin)
 		if !ok {
@@ -3499,6 +3542,12 @@ func EqualsStatement(inA, inB Statement) bool {
 			return false
 		}
 		return EqualsRefOfInsert(a, b)
+	case *Kill:
+		b, ok := inB.(*Kill)
+		if !ok {
+			return false
+		}
+		return EqualsRefOfKill(a, b)
 	case *Load:
 		b, ok := inB.(*Load)
 		if !ok {
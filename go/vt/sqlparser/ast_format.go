@@ -17,6 +17,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -638,7 +639,9 @@ func (node *ShowLegacy) Format(buf *TrackedBuffer) {
 		buf.astPrintf(node, "%v", opt.Filter)
 		return
 	}
-	if node.Scope == ImplicitScope {
+	if node.Scope == ImplicitScope && node.Full {
+		buf.astPrintf(node, "show full %s", nodeType)
+	} else if node.Scope == ImplicitScope {
 		buf.astPrintf(node, "show %s", nodeType)
 	} else {
 		buf.astPrintf(node, "show %s %s", node.Scope.ToString(), nodeType)
@@ -735,6 +738,20 @@ func (node *CallProc) Format(buf *TrackedBuffer) {
 	buf.astPrintf(node, "call %v(%v)", node.Name, node.Params)
 }
 
+// Format formats the node.
+func (node *AnalyzeTable) Format(buf *TrackedBuffer) {
+	buf.WriteString("analyze ")
+	if node.IsLocal {
+		buf.WriteString("local ")
+	}
+	buf.astPrintf(node, "table %v", node.Tables)
+}
+
+// Format formats the node.
+func (node *Kill) Format(buf *TrackedBuffer) {
+	buf.astPrintf(node, "kill %s %s", node.Type.ToString(), strconv.FormatUint(node.ProcesslistID, 10))
+}
+
 // Format formats the node.
 func (node *OtherRead) Format(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
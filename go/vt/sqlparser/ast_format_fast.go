@@ -18,6 +18,7 @@ limitations under the License.
 package sqlparser
 
 import (
+	"strconv"
 	"strings"
 
 	"vitess.io/vitess/go/sqltypes"
@@ -895,7 +896,10 @@ func (node *ShowLegacy) formatFast(buf *TrackedBuffer) {
 		opt.Filter.formatFast(buf)
 		return
 	}
-	if node.Scope == ImplicitScope {
+	if node.Scope == ImplicitScope && node.Full {
+		buf.WriteString("show full ")
+		buf.WriteString(nodeType)
+	} else if node.Scope == ImplicitScope {
 		buf.WriteString("show ")
 		buf.WriteString(nodeType)
 	} else {
@@ -1012,6 +1016,24 @@ func (node *CallProc) formatFast(buf *TrackedBuffer) {
 	buf.WriteByte(')')
 }
 
+// formatFast formats the node.
+func (node *AnalyzeTable) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("analyze ")
+	if node.IsLocal {
+		buf.WriteString("local ")
+	}
+	buf.WriteString("table ")
+	node.Tables.formatFast(buf)
+}
+
+// formatFast formats the node.
+func (node *Kill) formatFast(buf *TrackedBuffer) {
+	buf.WriteString("kill ")
+	buf.WriteString(node.Type.ToString())
+	buf.WriteByte(' ')
+	buf.WriteString(strconv.FormatUint(node.ProcesslistID, 10))
+}
+
 // formatFast formats the node.
 func (node *OtherRead) formatFast(buf *TrackedBuffer) {
 	buf.WriteString("otherread")
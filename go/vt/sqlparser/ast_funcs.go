@@ -19,6 +19,8 @@ package sqlparser
 import (
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"strconv"
 	"strings"
 
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
@@ -156,6 +158,46 @@ func (ts *TableSpec) AddConstraint(cd *ConstraintDefinition) {
 	ts.Constraints = append(ts.Constraints, cd)
 }
 
+// InlineIndex returns the index defined by the key option of the column,
+// as in `id int primary key`, or nil if the column has no key option.
+// The column keeps its key option.
+func (col *ColumnDefinition) InlineIndex() *IndexDefinition {
+	if col.Type.Options == nil {
+		return nil
+	}
+	info := &IndexInfo{Name: col.Name}
+	switch col.Type.Options.KeyOpt {
+	case colKeyPrimary, colKey:
+		// A KEY column option is a primary key.
+		info.Type = PrimaryKeyTypeStr
+		info.Name = NewColIdent("PRIMARY")
+		info.Primary = true
+		info.Unique = true
+	case colKeyUnique, colKeyUniqueKey:
+		info.Type = "unique key"
+		info.Unique = true
+	case colKeySpatialKey:
+		info.Type = "spatial key"
+		info.Spatial = true
+	case colKeyFulltextKey:
+		info.Type = "fulltext key"
+		info.Fulltext = true
+	default:
+		return nil
+	}
+	return &IndexDefinition{
+		Info:    info,
+		Columns: []*IndexColumn{{Column: col.Name}},
+	}
+}
+
+// ClearKeyOption removes the key option of the column.
+func (col *ColumnDefinition) ClearKeyOption() {
+	if col.Type.Options != nil {
+		col.Type.Options.KeyOpt = colKeyNone
+	}
+}
+
 // DescribeType returns the abbreviated type information as required for
 // describe table
 func (ct *ColumnType) DescribeType() string {
@@ -1138,6 +1180,27 @@ func (ty IndexHintsType) ToString() string {
 	}
 }
 
+// ToString returns the type as a string
+func (ty KillType) ToString() string {
+	switch ty {
+	case ConnectionKill:
+		return ConnectionStr
+	case QueryKill:
+		return QueryStr
+	default:
+		return "Unknown KillType"
+	}
+}
+
+// parseProcesslistID parses the connection id of a KILL statement.
+func parseProcesslistID(id string) (uint64, error) {
+	n, err := strconv.ParseUint(id, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid connection id: %s", id)
+	}
+	return n, nil
+}
+
 // ToString returns the type as a string
 func (ty ExplainType) ToString() string {
 	switch ty {
@@ -824,6 +824,34 @@ func TestShowTableStatus(t *testing.T) {
 	require.NotNil(t, tree)
 }
 
+func TestExplainAndAnalyze(t *testing.T) {
+	tree, err := Parse("explain format = json select * from t where a = 1")
+	require.NoError(t, err)
+	explain, ok := tree.(*ExplainStmt)
+	require.True(t, ok)
+	assert.Equal(t, JSONType, explain.Type)
+	assert.IsType(t, &Select{}, explain.Statement)
+	assert.Equal(t, StmtExplain, ASTToStatementType(tree))
+
+	tree, err = Parse("explain analyze update t set a = 1")
+	require.NoError(t, err)
+	explain, ok = tree.(*ExplainStmt)
+	require.True(t, ok)
+	assert.Equal(t, AnalyzeType, explain.Type)
+	assert.IsType(t, &Update{}, explain.Statement)
+
+	tree, err = Parse("analyze local table t1, ks.t2")
+	require.NoError(t, err)
+	analyze, ok := tree.(*AnalyzeTable)
+	require.True(t, ok)
+	assert.True(t, analyze.IsLocal)
+	assert.Equal(t, TableNames{
+		{Name: NewTableIdent("t1")},
+		{Name: NewTableIdent("t2"), Qualifier: NewTableIdent("ks")},
+	}, analyze.Tables)
+	assert.Equal(t, StmtOther, ASTToStatementType(tree))
+}
+
 func BenchmarkStringTraces(b *testing.B) {
 	for _, trace := range []string{"django_queries.txt", "lobsters.sql.gz"} {
 		b.Run(trace, func(b *testing.B) {
@@ -50,6 +50,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfAlterView(in, f)
 	case *AlterVschema:
 		return VisitRefOfAlterVschema(in, f)
+	case *AnalyzeTable:
+		return VisitRefOfAnalyzeTable(in, f)
 	case *AndExpr:
 		return VisitRefOfAndExpr(in, f)
 	case Argument:
@@ -160,6 +162,8 @@ func VisitSQLNode(in SQLNode, f Visit) error {
 		return VisitRefOfJoinTableExpr(in, f)
 	case *KeyState:
 		return VisitRefOfKeyState(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
 	case *Limit:
 		return VisitRefOfLimit(in, f)
 	case ListArg:
@@ -505,6 +509,18 @@ func VisitRefOfAlterVschema(in *AlterVschema, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfAnalyzeTable(in *AnalyzeTable, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	if err := VisitTableNames(in.Tables, f); err != nil {
+		return err
+	}
+	return nil
+}
 func VisitRefOfAndExpr(in *AndExpr, f Visit) error {
 	if in == nil {
 		return nil
@@ -1245,6 +1261,15 @@ func VisitRefOfKeyState(in *KeyState, f Visit) error {
 	}
 	return nil
 }
+func VisitRefOfKill(in *Kill, f Visit) error {
+	if in == nil {
+		return nil
+	}
+	if cont, err := f(in); err != nil || !cont {
+		return err
+	}
+	return nil
+}
 func VisitRefOfLimit(in *Limit, f Visit) error {
 	if in == nil {
 		return nil
@@ -2601,6 +2626,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfAlterView(in, f)
 	case *AlterVschema:
 		return VisitRefOfAlterVschema(in, f)
+	case *AnalyzeTable:
+		return VisitRefOfAnalyzeTable(in, f)
 	case *Begin:
 		return VisitRefOfBegin(in, f)
 	case *CallProc:
@@ -2629,6 +2656,8 @@ func VisitStatement(in Statement, f Visit) error {
 		return VisitRefOfFlush(in, f)
 	case *Insert:
 		return VisitRefOfInsert(in, f)
+	case *Kill:
+		return VisitRefOfKill(in, f)
 	case *Load:
 		return VisitRefOfLoad(in, f)
 	case *LockTables:
@@ -36,6 +36,18 @@ const (
 	DirectiveIgnoreMaxPayloadSize = "IGNORE_MAX_PAYLOAD_SIZE"
 	// DirectiveIgnoreMaxMemoryRows skips memory row validation when set.
 	DirectiveIgnoreMaxMemoryRows = "IGNORE_MAX_MEMORY_ROWS"
+	// DirectiveCacheableFor lets vttablet cache the result of a read-only
+	// query for the given duration, e.g. CACHEABLE_FOR=5s.
+	DirectiveCacheableFor = "CACHEABLE_FOR"
+	// DirectiveAllowTruncatedResult lets vttablet return the first rows of
+	// a select exceeding the max result size instead of an error.
+	DirectiveAllowTruncatedResult = "ALLOW_TRUNCATED_RESULT"
+	// DirectiveWorkload names the workload of a query in the per-workload
+	// stats of vttablet, e.g. WORKLOAD=billing.
+	DirectiveWorkload = "WORKLOAD"
+	// DirectiveAllowUnsafeDML lets an update or delete run on a vttablet
+	// rejecting unsafe DMLs, e.g. without a WHERE clause.
+	DirectiveAllowUnsafeDML = "ALLOW_UNSAFE_DML"
 )
 
 func isNonSpace(r rune) bool {
@@ -306,6 +318,33 @@ func SkipQueryPlanCacheDirective(stmt Statement) bool {
 	return false
 }
 
+// WorkloadDirective returns the value of the workload directive, or ""
+// if it is not set.
+func WorkloadDirective(stmt Statement) string {
+	var comments Comments
+	switch stmt := stmt.(type) {
+	case *Select:
+		comments = stmt.Comments
+	case *Insert:
+		comments = stmt.Comments
+	case *Update:
+		comments = stmt.Comments
+	case *Delete:
+		comments = stmt.Comments
+	default:
+		return ""
+	}
+	switch val := ExtractCommentDirectives(comments)[DirectiveWorkload].(type) {
+	case string:
+		return val
+	case int:
+		return strconv.Itoa(val)
+	case bool:
+		return strconv.FormatBool(val)
+	}
+	return ""
+}
+
 // IgnoreMaxPayloadSizeDirective returns true if the max payload size override
 // directive is set to true.
 func IgnoreMaxPayloadSizeDirective(stmt Statement) bool {
@@ -347,3 +386,21 @@ func IgnoreMaxMaxMemoryRowsDirective(stmt Statement) bool {
 		return false
 	}
 }
+
+// MaxExecutionTimeHint returns true if the first SELECT of the statement
+// sets a MAX_EXECUTION_TIME optimizer hint.
+func MaxExecutionTimeHint(stmt Statement) bool {
+	switch stmt := stmt.(type) {
+	case *Select:
+		for _, comment := range stmt.Comments {
+			if strings.HasPrefix(comment, "/*+") && strings.Contains(strings.ToUpper(comment), "MAX_EXECUTION_TIME") {
+				return true
+			}
+		}
+	case *Union:
+		return MaxExecutionTimeHint(stmt.FirstStatement)
+	case *ParenSelect:
+		return MaxExecutionTimeHint(stmt.Select)
+	}
+	return false
+}
@@ -415,6 +415,49 @@ func TestIgnoreMaxPayloadSizeDirective(t *testing.T) {
 	}
 }
 
+func TestWorkloadDirective(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected string
+	}{
+		{"insert /*vt+ WORKLOAD=billing */ into user(id) values (1), (2)", "billing"},
+		{"update /*vt+ WORKLOAD=billing */ users set name=1", "billing"},
+		{"select /*vt+ WORKLOAD=42 */ * from users", "42"},
+		{"delete /*vt+ WORKLOAD=billing */ from users", "billing"},
+		{"select * from users", ""},
+		{"show /*vt+ WORKLOAD=billing */ create table users", ""},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			assert.Equal(t, test.expected, WorkloadDirective(stmt))
+		})
+	}
+}
+
+func TestMaxExecutionTimeHint(t *testing.T) {
+	testCases := []struct {
+		query    string
+		expected bool
+	}{
+		{"select /*+ MAX_EXECUTION_TIME(10) */ * from users", true},
+		{"select /*+ SET_VAR(sort_buffer_size = 16M) max_execution_time(10) */ * from users", true},
+		{"select /*+ MAX_EXECUTION_TIME(10) */ * from users union select * from customers", true},
+		{"select /* MAX_EXECUTION_TIME(10) */ * from users", false},
+		{"select * from users where name = 'MAX_EXECUTION_TIME'", false},
+		{"select * from users", false},
+		{"update /*+ MAX_EXECUTION_TIME(10) */ users set name=1", false},
+	}
+
+	for _, test := range testCases {
+		t.Run(test.query, func(t *testing.T) {
+			stmt, _ := Parse(test.query)
+			assert.Equal(t, test.expected, MaxExecutionTimeHint(stmt))
+		})
+	}
+}
+
 func TestIgnoreMaxMaxMemoryRowsDirective(t *testing.T) {
 	testCases := []struct {
 		query    string
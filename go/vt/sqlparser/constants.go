@@ -204,6 +204,10 @@ const (
 	TraditionalStr = "traditional"
 	AnalyzeStr     = "analyze"
 
+	// Kill Types
+	ConnectionStr = "connection"
+	QueryStr      = "query"
+
 	// Lock Types
 	ReadStr             = "read"
 	ReadLocalStr        = "read local"
@@ -443,6 +447,12 @@ const (
 	AnalyzeType
 )
 
+// Constant for Enum Type - KillType
+const (
+	ConnectionKill KillType = iota
+	QueryKill
+)
+
 // Constant for Enum Type - SelectIntoType
 const (
 	IntoOutfile SelectIntoType = iota
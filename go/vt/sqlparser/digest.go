@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// Digest returns the digest of the shape of query: the queries which only
+// differ by their literals, bind variable names, comments or whitespace
+// have the same digest. Queries which cannot be parsed are digested as is.
+func Digest(query string) string {
+	text, err := DigestText(query)
+	if err != nil {
+		text = query
+	}
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:])
+}
+
+// DigestText returns the normalized text of query used by Digest. The
+// literals are replaced by question marks, and the comments are dropped.
+func DigestText(query string) (string, error) {
+	stmt, reservedVars, err := Parse2(StripLeadingComments(query))
+	if err != nil {
+		return "", err
+	}
+	if err := Normalize(stmt, reservedVars, map[string]*querypb.BindVariable{}, "bv"); err != nil {
+		return "", err
+	}
+	buf := NewTrackedBuffer(func(buf *TrackedBuffer, node SQLNode) {
+		switch node.(type) {
+		case Comments:
+		case Argument, ListArg:
+			buf.WriteString("?")
+		default:
+			node.Format(buf)
+		}
+	})
+	buf.Myprintf("%v", stmt)
+	return buf.String(), nil
+}
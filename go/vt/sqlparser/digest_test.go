@@ -0,0 +1,46 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDigest(t *testing.T) {
+	sameShape := []string{
+		"select * from t where a = 1 and b in (1, 2, 3)",
+		"select * from t where a = 2 and b in (4, 5)",
+		"/* leading */ select /* inner */ * from t where a = :vtg1 and b in ::vtg2",
+		"SELECT *   FROM t WHERE a = 'x' AND b IN ('y')",
+	}
+	for _, query := range sameShape {
+		assert.Equal(t, Digest(sameShape[0]), Digest(query), query)
+	}
+	assert.NotEqual(t, Digest("select * from t where a = 1"), Digest("select * from t where b = 1"))
+	assert.NotEqual(t, Digest("select * from t where a = 1"), Digest("select * from u where a = 1"))
+	assert.Equal(t, Digest("not a query"), Digest("not a query"))
+	assert.Len(t, Digest("select 1"), 64)
+
+	text, err := DigestText("select /* c */ * from t where a = 1 and b in (1, 2)")
+	require.NoError(t, err)
+	assert.Equal(t, "select * from t where a = ? and b in ?", text)
+	_, err = DigestText("not a query")
+	assert.Error(t, err)
+}
@@ -19,6 +19,7 @@ package sqlparser
 import (
 	"fmt"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
@@ -45,6 +46,12 @@ func Convert(e Expr) (evalengine.Expr, error) {
 		}
 		return evalengine.NewLiteralIntFromBytes([]byte("0"))
 	case *BinaryExpr:
+		if interval, ok := node.Right.(*IntervalExpr); ok && (node.Operator == PlusOp || node.Operator == MinusOp) {
+			return convertDateAdd(node.Left, interval.Expr, interval.Unit, node.Operator == MinusOp)
+		}
+		if interval, ok := node.Left.(*IntervalExpr); ok && node.Operator == PlusOp {
+			return convertDateAdd(node.Right, interval.Expr, interval.Unit, false)
+		}
 		var op evalengine.BinaryExpr
 		switch node.Operator {
 		case PlusOp:
@@ -71,7 +78,91 @@ func Convert(e Expr) (evalengine.Expr, error) {
 			Left:  left,
 			Right: right,
 		}, nil
+	case *CollateExpr:
+		collation := collations.LookupByName(node.Charset)
+		if collation == nil {
+			return nil, ErrExprNotSupported
+		}
+		expr, err := Convert(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.CollateExpr{Expr: expr, Collation: collation.ID()}, nil
+	case *TimestampFuncExpr:
+		if !evalengine.IsIntervalUnitSupported(node.Unit) {
+			return nil, ErrExprNotSupported
+		}
+		left, err := Convert(node.Expr1)
+		if err != nil {
+			return nil, err
+		}
+		right, err := Convert(node.Expr2)
+		if err != nil {
+			return nil, err
+		}
+		switch node.Name {
+		case "timestampdiff":
+			return &evalengine.TimestampDiffExpr{Unit: node.Unit, Left: left, Right: right}, nil
+		case "timestampadd":
+			return &evalengine.DateAddExpr{Date: right, Interval: left, Unit: node.Unit}, nil
+		}
+	case *FuncExpr:
+		return convertFuncExpr(node)
+	}
+	return nil, ErrExprNotSupported
+}
+
+func convertFuncExpr(node *FuncExpr) (evalengine.Expr, error) {
+	if !node.Qualifier.IsEmpty() || node.Distinct {
+		return nil, ErrExprNotSupported
+	}
+	args := make([]Expr, 0, len(node.Exprs))
+	for _, expr := range node.Exprs {
+		aliased, ok := expr.(*AliasedExpr)
+		if !ok {
+			return nil, ErrExprNotSupported
+		}
+		args = append(args, aliased.Expr)
+	}
 
+	name := node.Name.Lowered()
+	switch name {
+	case "date_add", "date_sub", "adddate", "subdate":
+		if len(args) != 2 {
+			return nil, ErrExprNotSupported
+		}
+		subtract := name == "date_sub" || name == "subdate"
+		if interval, ok := args[1].(*IntervalExpr); ok {
+			return convertDateAdd(args[0], interval.Expr, interval.Unit, subtract)
+		}
+		if name == "adddate" || name == "subdate" {
+			// ADDDATE(expr, days) and SUBDATE(expr, days)
+			return convertDateAdd(args[0], args[1], "day", subtract)
+		}
+		return nil, ErrExprNotSupported
 	}
+	// The functions returning the current time, such as NOW(), are left to
+	// MySQL: it evaluates them in its own time zone, and at the start of
+	// the statement.
 	return nil, ErrExprNotSupported
 }
+
+func convertDateAdd(date, interval Expr, unit string, subtract bool) (evalengine.Expr, error) {
+	if !evalengine.IsIntervalUnitSupported(unit) {
+		return nil, ErrExprNotSupported
+	}
+	dateExpr, err := Convert(date)
+	if err != nil {
+		return nil, err
+	}
+	intervalExpr, err := Convert(interval)
+	if err != nil {
+		return nil, err
+	}
+	return &evalengine.DateAddExpr{
+		Date:     dateExpr,
+		Interval: intervalExpr,
+		Unit:     unit,
+		Subtract: subtract,
+	}, nil
+}
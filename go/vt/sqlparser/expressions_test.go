@@ -70,6 +70,39 @@ func TestEvaluate(t *testing.T) {
 	}, {
 		expression: ":float_bind_variable",
 		expected:   sqltypes.NewFloat64(2.2),
+	}, {
+		expression: ":decimal_bind_variable + 1",
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("3.30")),
+	}, {
+		expression: ":decimal_bind_variable * :decimal_bind_variable",
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("5.2900")),
+	}, {
+		expression: ":decimal_bind_variable / 3",
+		expected:   sqltypes.MakeTrusted(sqltypes.Decimal, []byte("0.766667")),
+	}, {
+		expression: ":decimal_bind_variable - 2.5",
+		expected:   sqltypes.NewFloat64(-0.20000000000000018),
+	}, {
+		expression: "date_add('2021-01-31', interval 1 month)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-02-28")),
+	}, {
+		expression: "date_sub('2021-03-01', interval 1 day)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-02-28")),
+	}, {
+		expression: "'2021-03-01 10:00:00' - interval 90 minute",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-03-01 08:30:00")),
+	}, {
+		expression: "adddate('2021-03-01', 1)",
+		expected:   sqltypes.MakeTrusted(sqltypes.Date, []byte("2021-03-02")),
+	}, {
+		expression: "timestampadd(hour, 2, '2021-03-01')",
+		expected:   sqltypes.MakeTrusted(sqltypes.Datetime, []byte("2021-03-01 02:00:00")),
+	}, {
+		expression: "timestampdiff(month, '2021-01-31', '2021-02-28')",
+		expected:   sqltypes.NewInt64(0),
+	}, {
+		expression: "timestampdiff(day, '2021-03-01', '2021-01-01')",
+		expected:   sqltypes.NewInt64(-59),
 	}}
 
 	for _, test := range tests {
@@ -87,6 +120,10 @@ func TestEvaluate(t *testing.T) {
 					"string_bind_variable": sqltypes.StringBindVariable("bar"),
 					"uint64_bind_variable": sqltypes.Uint64BindVariable(22),
 					"float_bind_variable":  sqltypes.Float64BindVariable(2.2),
+					"decimal_bind_variable": &querypb.BindVariable{
+						Type:  sqltypes.Decimal,
+						Value: []byte("2.30"),
+					},
 				},
 				Row: nil,
 			}
@@ -100,3 +137,15 @@ func TestEvaluate(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertCurrentTime(t *testing.T) {
+	// The current time is evaluated by MySQL, in its time zone.
+	for _, expression := range []string{"now()", "current_timestamp(3)", "utc_timestamp()", "curdate()", "sysdate()"} {
+		t.Run(expression, func(t *testing.T) {
+			stmt, err := Parse("select " + expression)
+			require.NoError(t, err)
+			_, err = Convert(stmt.(*Select).SelectExprs[0].(*AliasedExpr).Expr)
+			assert.Equal(t, ErrExprNotSupported, err)
+		})
+	}
+}
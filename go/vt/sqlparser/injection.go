@@ -0,0 +1,260 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// InjectionKind is the kind of construct reported by FindInjections.
+type InjectionKind int
+
+// The kinds of constructs reported by FindInjections.
+const (
+	// InjectionStackedQueries is a statement that follows another one,
+	// like in "select a from t where id = 1; drop table t".
+	InjectionStackedQueries = InjectionKind(iota)
+	// InjectionTautology is an OR condition that is always true, appended
+	// to a literal, like in "select a from t where name = 'x' or '1'='1'".
+	InjectionTautology
+	// InjectionCommentedString is a string literal followed by a comment
+	// that hides the end of the query, like in
+	// "select a from t where name = 'admin'-- ' and password = 'x'".
+	InjectionCommentedString
+)
+
+// String returns the name of the kind.
+func (k InjectionKind) String() string {
+	switch k {
+	case InjectionStackedQueries:
+		return "stacked queries"
+	case InjectionTautology:
+		return "tautology"
+	case InjectionCommentedString:
+		return "commented string"
+	default:
+		return "unknown"
+	}
+}
+
+// InjectionKindByName returns the kind named name, as returned by String.
+func InjectionKindByName(name string) (InjectionKind, bool) {
+	for k := InjectionStackedQueries; k <= InjectionCommentedString; k++ {
+		if k.String() == name {
+			return k, true
+		}
+	}
+	return 0, false
+}
+
+// InjectionFinding is a construct of a query that often comes from a SQL
+// injection.
+type InjectionFinding struct {
+	Kind InjectionKind
+	// Pos is the offset of the construct in the query.
+	Pos int
+	// Text is the text of the construct.
+	Text string
+}
+
+// injectionToken is a token of the query, with its offsets.
+type injectionToken struct {
+	typ        int
+	start, end int
+}
+
+// FindInjections returns the constructs of sql that are commonly used by
+// SQL injections. They are heuristics: a finding does not mean that the
+// query was injected, and an injected query does not always have findings.
+// The query does not need to be valid, since the injections often break
+// the syntax of the query they are in.
+func FindInjections(sql string) []InjectionFinding {
+	tokens := injectionTokens(sql)
+	var findings []InjectionFinding
+	for i, tok := range tokens {
+		switch tok.typ {
+		case ';':
+			if end, ok := stackedQuery(tokens, i+1); ok {
+				findings = append(findings, InjectionFinding{
+					Kind: InjectionStackedQueries,
+					Pos:  tokens[i+1].start,
+					Text: sql[tokens[i+1].start:end],
+				})
+			}
+		case OR:
+			if i == 0 || !isInjectionLiteral(tokens[i-1].typ) {
+				continue
+			}
+			if end, ok := tautology(sql, tokens, i+1); ok {
+				findings = append(findings, InjectionFinding{
+					Kind: InjectionTautology,
+					Pos:  tok.start,
+					Text: sql[tok.start:end],
+				})
+			}
+		case STRING:
+			if i+1 == len(tokens) {
+				continue
+			}
+			next := tokens[i+1]
+			comment := sql[next.start:next.end]
+			switch {
+			case next.typ == COMMENT && !strings.HasPrefix(comment, "/*") && strings.TrimSpace(sql[next.end:]) == "":
+			case next.typ == LEX_ERROR && strings.HasPrefix(comment, "/*"):
+				// An unterminated comment.
+			default:
+				continue
+			}
+			findings = append(findings, InjectionFinding{
+				Kind: InjectionCommentedString,
+				Pos:  next.start,
+				Text: comment,
+			})
+		}
+	}
+	return findings
+}
+
+// injectionTokens returns the tokens of sql, including the comments.
+// The tokens stop at the first lexing error, which is included.
+func injectionTokens(sql string) []injectionToken {
+	tkn := NewStringTokenizer(sql)
+	// The special comments are scanned by another tokenizer, which
+	// would lose the offsets.
+	tkn.SkipSpecialComments = true
+	var tokens []injectionToken
+	for {
+		start := tkn.Pos
+		typ, _ := tkn.Scan()
+		if typ == 0 {
+			return tokens
+		}
+		// Scan skips the blanks before the token.
+		for start < tkn.Pos && strings.IndexByte(" \t\r\n", sql[start]) >= 0 {
+			start++
+		}
+		tokens = append(tokens, injectionToken{typ: typ, start: start, end: tkn.Pos})
+		if typ == LEX_ERROR {
+			return tokens
+		}
+	}
+}
+
+// stackedQuery returns the end of the statement that starts at tokens[i],
+// if there is one.
+func stackedQuery(tokens []injectionToken, i int) (int, bool) {
+	if i == len(tokens) || tokens[i].typ == ';' || tokens[i].typ == COMMENT {
+		return 0, false
+	}
+	end := tokens[i].end
+	for _, tok := range tokens[i:] {
+		if tok.typ == ';' {
+			break
+		}
+		end = tok.end
+	}
+	return end, true
+}
+
+// tautology returns the end of the condition that starts at tokens[i], if
+// it is always true. Only the conditions made of literals are recognized,
+// like "1", "true", "1=1" or "'a'<>'b'".
+func tautology(sql string, tokens []injectionToken, i int) (int, bool) {
+	if i == len(tokens) {
+		return 0, false
+	}
+	left := tokens[i]
+	if i+1 == len(tokens) || endsCondition(tokens[i+1].typ) {
+		switch left.typ {
+		case TRUE:
+			return left.end, true
+		case INTEGRAL, FLOAT:
+			f, err := strconv.ParseFloat(sql[left.start:left.end], 64)
+			return left.end, err == nil && f != 0
+		}
+		return 0, false
+	}
+	if i+2 >= len(tokens) {
+		return 0, false
+	}
+	op, right := tokens[i+1], tokens[i+2]
+	if !isInjectionLiteral(left.typ) || !isInjectionLiteral(right.typ) {
+		return 0, false
+	}
+	if i+3 < len(tokens) && !endsCondition(tokens[i+3].typ) {
+		return 0, false
+	}
+	cmp := compareInjectionLiterals(literalValue(sql, left), literalValue(sql, right))
+	var ok bool
+	switch op.typ {
+	case '=', NULL_SAFE_EQUAL:
+		ok = cmp == 0
+	case NE:
+		ok = cmp != 0
+	case '<':
+		ok = cmp < 0
+	case '>':
+		ok = cmp > 0
+	case LE:
+		ok = cmp <= 0
+	case GE:
+		ok = cmp >= 0
+	}
+	return right.end, ok
+}
+
+// endsCondition returns true if a condition ends before a token of type
+// typ.
+func endsCondition(typ int) bool {
+	switch typ {
+	case ';', ')', COMMENT, AND, OR, LEX_ERROR, GROUP, HAVING, ORDER, LIMIT, UNION, FOR:
+		return true
+	}
+	return false
+}
+
+func isInjectionLiteral(typ int) bool {
+	return typ == STRING || typ == INTEGRAL || typ == FLOAT
+}
+
+// literalValue returns the value of a literal token, without the quotes
+// of the strings.
+func literalValue(sql string, tok injectionToken) string {
+	val := sql[tok.start:tok.end]
+	if tok.typ == STRING && len(val) >= 2 {
+		return val[1 : len(val)-1]
+	}
+	return val
+}
+
+// compareInjectionLiterals compares two literals, as numbers if they both
+// are numbers.
+func compareInjectionLiterals(a, b string) int {
+	fa, erra := strconv.ParseFloat(a, 64)
+	fb, errb := strconv.ParseFloat(b, 64)
+	if erra != nil || errb != nil {
+		return strings.Compare(a, b)
+	}
+	switch {
+	case fa < fb:
+		return -1
+	case fa > fb:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sqlparser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindInjections(t *testing.T) {
+	testcases := []struct {
+		in   string
+		want []InjectionFinding
+	}{{
+		in: "select a from t where id = 1",
+	}, {
+		in: "select a from t where id = 1; drop table t",
+		want: []InjectionFinding{{
+			Kind: InjectionStackedQueries,
+			Pos:  30,
+			Text: "drop table t",
+		}},
+	}, {
+		in: "select a from t where id = 1; drop table t; select 1",
+		want: []InjectionFinding{{
+			Kind: InjectionStackedQueries,
+			Pos:  30,
+			Text: "drop table t",
+		}, {
+			Kind: InjectionStackedQueries,
+			Pos:  44,
+			Text: "select 1",
+		}},
+	}, {
+		// A trailing semicolon or comment is not a stacked query.
+		in: "select a from t where id = 1; /* trailing */",
+	}, {
+		in: "select a from t where name = 'x' or '1'='1'",
+		want: []InjectionFinding{{
+			Kind: InjectionTautology,
+			Pos:  33,
+			Text: "or '1'='1'",
+		}},
+	}, {
+		in: "select a from t where id = 1 or 1 = 1 limit 10",
+		want: []InjectionFinding{{
+			Kind: InjectionTautology,
+			Pos:  29,
+			Text: "or 1 = 1",
+		}},
+	}, {
+		in: "select a from t where id = 1 || 2 > 1.5",
+		want: []InjectionFinding{{
+			Kind: InjectionTautology,
+			Pos:  29,
+			Text: "|| 2 > 1.5",
+		}},
+	}, {
+		in: "select a from t where id = 1 or true",
+		want: []InjectionFinding{{
+			Kind: InjectionTautology,
+			Pos:  29,
+			Text: "or true",
+		}},
+	}, {
+		// The conditions that can be false, or are not appended to a
+		// literal, are ignored.
+		in: "select a from t where id = 1 or 1 = 2 or 0 or 'a' <> 'a' or b = 1 or 1 = 1 + b",
+	}, {
+		in: "select a from t where b = c or 1 = 1",
+	}, {
+		in: "select a from t where name = 'admin'-- ' and password = 'x'",
+		want: []InjectionFinding{{
+			Kind: InjectionCommentedString,
+			Pos:  36,
+			Text: "-- ' and password = 'x'",
+		}},
+	}, {
+		in: "select a from t where name = 'admin' # and password = 'x'",
+		want: []InjectionFinding{{
+			Kind: InjectionCommentedString,
+			Pos:  37,
+			Text: "# and password = 'x'",
+		}},
+	}, {
+		in: "select a from t where name = 'admin' /* and password = 'x'",
+		want: []InjectionFinding{{
+			Kind: InjectionCommentedString,
+			Pos:  37,
+			Text: "/* and password = 'x'",
+		}},
+	}, {
+		// The comments that do not hide the end of the query are ignored.
+		in: "select a from t where name = 'admin' -- comment\n and b = 1",
+	}, {
+		in: "select a from t where name = 'admin' /* trailing */",
+	}, {
+		in: "select a from t where name = '' or ''='' -- \n; drop table t",
+		want: []InjectionFinding{{
+			Kind: InjectionTautology,
+			Pos:  32,
+			Text: "or ''=''",
+		}, {
+			Kind: InjectionStackedQueries,
+			Pos:  47,
+			Text: "drop table t",
+		}},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.in, func(t *testing.T) {
+			assert.Equal(t, tcase.want, FindInjections(tcase.in))
+		})
+	}
+}
+
+func TestInjectionKindString(t *testing.T) {
+	assert.Equal(t, "stacked queries", InjectionStackedQueries.String())
+	assert.Equal(t, "tautology", InjectionTautology.String())
+	assert.Equal(t, "commented string", InjectionCommentedString.String())
+}
+
+func TestInjectionKindByName(t *testing.T) {
+	for _, kind := range []InjectionKind{InjectionStackedQueries, InjectionTautology, InjectionCommentedString} {
+		got, ok := InjectionKindByName(kind.String())
+		assert.True(t, ok)
+		assert.Equal(t, kind, got)
+	}
+	_, ok := InjectionKindByName("unknown")
+	assert.False(t, ok)
+}
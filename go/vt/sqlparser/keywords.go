@@ -251,7 +251,7 @@ var keywords = []keyword{
 	{"keys", KEYS},
 	{"keyspaces", KEYSPACES},
 	{"key_block_size", KEY_BLOCK_SIZE},
-	{"kill", UNUSED},
+	{"kill", KILL},
 	{"last", LAST},
 	{"language", LANGUAGE},
 	{"last_insert_id", LAST_INSERT_ID},
@@ -65,7 +65,7 @@ func newNormalizer(reserved map[string]struct{}, bindVars map[string]*querypb.Bi
 func (nz *normalizer) WalkStatement(cursor *Cursor) bool {
 	switch node := cursor.Node().(type) {
 	// no need to normalize the statement types
-	case *Set, *Show, *Begin, *Commit, *Rollback, *Savepoint, *SetTransaction, DDLStatement, *SRollback, *Release, *OtherAdmin, *OtherRead:
+	case *Set, *Show, *Begin, *Commit, *Rollback, *Savepoint, *SetTransaction, DDLStatement, *SRollback, *Release, *OtherAdmin, *OtherRead, *AnalyzeTable, *Kill:
 		return false
 	case *Select:
 		_ = Rewrite(node, nz.WalkSelect, nil)
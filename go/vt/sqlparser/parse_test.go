@@ -1307,8 +1307,14 @@ var (
 		input:  "drop index `PRIMARY` on a lock none",
 		output: "alter table a drop primary key, lock none",
 	}, {
-		input:  "analyze table a",
-		output: "otherread",
+		input: "analyze table a",
+	}, {
+		input: "analyze table a, b.c",
+	}, {
+		input: "analyze local table a",
+	}, {
+		input:  "analyze no_write_to_binlog table a",
+		output: "analyze local table a",
 	}, {
 		input: "flush tables",
 	}, {
@@ -1430,7 +1436,7 @@ var (
 		output: "show processlist",
 	}, {
 		input:  "show full processlist",
-		output: "show processlist",
+		output: "show full processlist",
 	}, {
 		input:  "show profile cpu for query 1",
 		output: "show profile",
@@ -1587,6 +1593,21 @@ var (
 	}, {
 		input:  "use ks@replica",
 		output: "use `ks@replica`",
+	}, {
+		input:  "use ks:-80@replica",
+		output: "use `ks:-80@replica`",
+	}, {
+		input:  "use ks:80-c0",
+		output: "use `ks:80-c0`",
+	}, {
+		input:  "use ks[-80]@rdonly",
+		output: "use `ks[-80]@rdonly`",
+	}, {
+		input:  "select * from ks[-80].t",
+		output: "select * from `ks[-80]`.t",
+	}, {
+		input:  "select * from ks[deadbeef].t use index (a)",
+		output: "select * from `ks[deadbeef]`.t use index (a)",
 	}, {
 		input:  "describe select * from t",
 		output: "explain select * from t",
@@ -1650,6 +1671,13 @@ var (
 	}, {
 		input:  "unlock tables",
 		output: "unlock tables",
+	}, {
+		input:  "kill 12",
+		output: "kill connection 12",
+	}, {
+		input: "kill connection 12",
+	}, {
+		input: "kill query 18446744073709551615",
 	}, {
 		input: "select /* EQ true */ 1 from t where a = true",
 	}, {
@@ -1997,6 +2025,12 @@ func TestInvalid(t *testing.T) {
 	}, {
 		input: "/*!*/",
 		err:   "Query was empty",
+	}, {
+		input: "kill query 18446744073709551616",
+		err:   "invalid connection id",
+	}, {
+		input: "kill query",
+		err:   "syntax error",
 	}}
 
 	for _, tcase := range invalidSQL {
@@ -78,426 +78,427 @@ const UNLOCK = 57375
 const KEYS = 57376
 const DO = 57377
 const CALL = 57378
-const DISTINCTROW = 57379
-const PARSER = 57380
-const OUTFILE = 57381
-const S3 = 57382
-const DATA = 57383
-const LOAD = 57384
-const LINES = 57385
-const TERMINATED = 57386
-const ESCAPED = 57387
-const ENCLOSED = 57388
-const DUMPFILE = 57389
-const CSV = 57390
-const HEADER = 57391
-const MANIFEST = 57392
-const OVERWRITE = 57393
-const STARTING = 57394
-const OPTIONALLY = 57395
-const VALUES = 57396
-const LAST_INSERT_ID = 57397
-const NEXT = 57398
-const VALUE = 57399
-const SHARE = 57400
-const MODE = 57401
-const SQL_NO_CACHE = 57402
-const SQL_CACHE = 57403
-const SQL_CALC_FOUND_ROWS = 57404
-const JOIN = 57405
-const STRAIGHT_JOIN = 57406
-const LEFT = 57407
-const RIGHT = 57408
-const INNER = 57409
-const OUTER = 57410
-const CROSS = 57411
-const NATURAL = 57412
-const USE = 57413
-const FORCE = 57414
-const ON = 57415
-const USING = 57416
-const INPLACE = 57417
-const COPY = 57418
-const ALGORITHM = 57419
-const NONE = 57420
-const SHARED = 57421
-const EXCLUSIVE = 57422
-const ID = 57423
-const AT_ID = 57424
-const AT_AT_ID = 57425
-const HEX = 57426
-const STRING = 57427
-const INTEGRAL = 57428
-const FLOAT = 57429
-const HEXNUM = 57430
-const VALUE_ARG = 57431
-const LIST_ARG = 57432
-const COMMENT = 57433
-const COMMENT_KEYWORD = 57434
-const BIT_LITERAL = 57435
-const COMPRESSION = 57436
-const NULL = 57437
-const TRUE = 57438
-const FALSE = 57439
-const OFF = 57440
-const DISCARD = 57441
-const IMPORT = 57442
-const ENABLE = 57443
-const DISABLE = 57444
-const TABLESPACE = 57445
-const OR = 57446
-const XOR = 57447
-const AND = 57448
-const NOT = 57449
-const BETWEEN = 57450
-const CASE = 57451
-const WHEN = 57452
-const THEN = 57453
-const ELSE = 57454
-const END = 57455
-const LE = 57456
-const GE = 57457
-const NE = 57458
-const NULL_SAFE_EQUAL = 57459
-const IS = 57460
-const LIKE = 57461
-const REGEXP = 57462
-const IN = 57463
-const SHIFT_LEFT = 57464
-const SHIFT_RIGHT = 57465
-const DIV = 57466
-const MOD = 57467
-const UNARY = 57468
-const COLLATE = 57469
-const BINARY = 57470
-const UNDERSCORE_BINARY = 57471
-const UNDERSCORE_UTF8MB4 = 57472
-const UNDERSCORE_UTF8 = 57473
-const UNDERSCORE_LATIN1 = 57474
-const INTERVAL = 57475
-const JSON_EXTRACT_OP = 57476
-const JSON_UNQUOTE_EXTRACT_OP = 57477
-const CREATE = 57478
-const ALTER = 57479
-const DROP = 57480
-const RENAME = 57481
-const ANALYZE = 57482
-const ADD = 57483
-const FLUSH = 57484
-const CHANGE = 57485
-const MODIFY = 57486
-const REVERT = 57487
-const SCHEMA = 57488
-const TABLE = 57489
-const INDEX = 57490
-const VIEW = 57491
-const TO = 57492
-const IGNORE = 57493
-const IF = 57494
-const UNIQUE = 57495
-const PRIMARY = 57496
-const COLUMN = 57497
-const SPATIAL = 57498
-const FULLTEXT = 57499
-const KEY_BLOCK_SIZE = 57500
-const CHECK = 57501
-const INDEXES = 57502
-const ACTION = 57503
-const CASCADE = 57504
-const CONSTRAINT = 57505
-const FOREIGN = 57506
-const NO = 57507
-const REFERENCES = 57508
-const RESTRICT = 57509
-const SHOW = 57510
-const DESCRIBE = 57511
-const EXPLAIN = 57512
-const DATE = 57513
-const ESCAPE = 57514
-const REPAIR = 57515
-const OPTIMIZE = 57516
-const TRUNCATE = 57517
-const COALESCE = 57518
-const EXCHANGE = 57519
-const REBUILD = 57520
-const PARTITIONING = 57521
-const REMOVE = 57522
-const MAXVALUE = 57523
-const PARTITION = 57524
-const REORGANIZE = 57525
-const LESS = 57526
-const THAN = 57527
-const PROCEDURE = 57528
-const TRIGGER = 57529
-const VINDEX = 57530
-const VINDEXES = 57531
-const DIRECTORY = 57532
-const NAME = 57533
-const UPGRADE = 57534
-const STATUS = 57535
-const VARIABLES = 57536
-const WARNINGS = 57537
-const CASCADED = 57538
-const DEFINER = 57539
-const OPTION = 57540
-const SQL = 57541
-const UNDEFINED = 57542
-const SEQUENCE = 57543
-const MERGE = 57544
-const TEMPORARY = 57545
-const TEMPTABLE = 57546
-const INVOKER = 57547
-const SECURITY = 57548
-const FIRST = 57549
-const AFTER = 57550
-const LAST = 57551
-const VITESS_MIGRATION = 57552
-const CANCEL = 57553
-const RETRY = 57554
-const COMPLETE = 57555
-const BEGIN = 57556
-const START = 57557
-const TRANSACTION = 57558
-const COMMIT = 57559
-const ROLLBACK = 57560
-const SAVEPOINT = 57561
-const RELEASE = 57562
-const WORK = 57563
-const BIT = 57564
-const TINYINT = 57565
-const SMALLINT = 57566
-const MEDIUMINT = 57567
-const INT = 57568
-const INTEGER = 57569
-const BIGINT = 57570
-const INTNUM = 57571
-const REAL = 57572
-const DOUBLE = 57573
-const FLOAT_TYPE = 57574
-const DECIMAL = 57575
-const NUMERIC = 57576
-const TIME = 57577
-const TIMESTAMP = 57578
-const DATETIME = 57579
-const YEAR = 57580
-const CHAR = 57581
-const VARCHAR = 57582
-const BOOL = 57583
-const CHARACTER = 57584
-const VARBINARY = 57585
-const NCHAR = 57586
-const TEXT = 57587
-const TINYTEXT = 57588
-const MEDIUMTEXT = 57589
-const LONGTEXT = 57590
-const BLOB = 57591
-const TINYBLOB = 57592
-const MEDIUMBLOB = 57593
-const LONGBLOB = 57594
-const JSON = 57595
-const ENUM = 57596
-const GEOMETRY = 57597
-const POINT = 57598
-const LINESTRING = 57599
-const POLYGON = 57600
-const GEOMETRYCOLLECTION = 57601
-const MULTIPOINT = 57602
-const MULTILINESTRING = 57603
-const MULTIPOLYGON = 57604
-const NULLX = 57605
-const AUTO_INCREMENT = 57606
-const APPROXNUM = 57607
-const SIGNED = 57608
-const UNSIGNED = 57609
-const ZEROFILL = 57610
-const COLLATION = 57611
-const DATABASES = 57612
-const SCHEMAS = 57613
-const TABLES = 57614
-const VITESS_METADATA = 57615
-const VSCHEMA = 57616
-const FULL = 57617
-const PROCESSLIST = 57618
-const COLUMNS = 57619
-const FIELDS = 57620
-const ENGINES = 57621
-const PLUGINS = 57622
-const EXTENDED = 57623
-const KEYSPACES = 57624
-const VITESS_KEYSPACES = 57625
-const VITESS_SHARDS = 57626
-const VITESS_TABLETS = 57627
-const VITESS_MIGRATIONS = 57628
-const CODE = 57629
-const PRIVILEGES = 57630
-const FUNCTION = 57631
-const OPEN = 57632
-const TRIGGERS = 57633
-const EVENT = 57634
-const USER = 57635
-const NAMES = 57636
-const CHARSET = 57637
-const GLOBAL = 57638
-const SESSION = 57639
-const ISOLATION = 57640
-const LEVEL = 57641
-const READ = 57642
-const WRITE = 57643
-const ONLY = 57644
-const REPEATABLE = 57645
-const COMMITTED = 57646
-const UNCOMMITTED = 57647
-const SERIALIZABLE = 57648
-const CURRENT_TIMESTAMP = 57649
-const DATABASE = 57650
-const CURRENT_DATE = 57651
-const CURRENT_TIME = 57652
-const LOCALTIME = 57653
-const LOCALTIMESTAMP = 57654
-const CURRENT_USER = 57655
-const UTC_DATE = 57656
-const UTC_TIME = 57657
-const UTC_TIMESTAMP = 57658
-const REPLACE = 57659
-const CONVERT = 57660
-const CAST = 57661
-const SUBSTR = 57662
-const SUBSTRING = 57663
-const GROUP_CONCAT = 57664
-const SEPARATOR = 57665
-const TIMESTAMPADD = 57666
-const TIMESTAMPDIFF = 57667
-const MATCH = 57668
-const AGAINST = 57669
-const BOOLEAN = 57670
-const LANGUAGE = 57671
-const WITH = 57672
-const QUERY = 57673
-const EXPANSION = 57674
-const WITHOUT = 57675
-const VALIDATION = 57676
-const UNUSED = 57677
-const ARRAY = 57678
-const CUME_DIST = 57679
-const DESCRIPTION = 57680
-const DENSE_RANK = 57681
-const EMPTY = 57682
-const EXCEPT = 57683
-const FIRST_VALUE = 57684
-const GROUPING = 57685
-const GROUPS = 57686
-const JSON_TABLE = 57687
-const LAG = 57688
-const LAST_VALUE = 57689
-const LATERAL = 57690
-const LEAD = 57691
-const MEMBER = 57692
-const NTH_VALUE = 57693
-const NTILE = 57694
-const OF = 57695
-const OVER = 57696
-const PERCENT_RANK = 57697
-const RANK = 57698
-const RECURSIVE = 57699
-const ROW_NUMBER = 57700
-const SYSTEM = 57701
-const WINDOW = 57702
-const ACTIVE = 57703
-const ADMIN = 57704
-const BUCKETS = 57705
-const CLONE = 57706
-const COMPONENT = 57707
-const DEFINITION = 57708
-const ENFORCED = 57709
-const EXCLUDE = 57710
-const FOLLOWING = 57711
-const GEOMCOLLECTION = 57712
-const GET_MASTER_PUBLIC_KEY = 57713
-const HISTOGRAM = 57714
-const HISTORY = 57715
-const INACTIVE = 57716
-const INVISIBLE = 57717
-const LOCKED = 57718
-const MASTER_COMPRESSION_ALGORITHMS = 57719
-const MASTER_PUBLIC_KEY_PATH = 57720
-const MASTER_TLS_CIPHERSUITES = 57721
-const MASTER_ZSTD_COMPRESSION_LEVEL = 57722
-const NESTED = 57723
-const NETWORK_NAMESPACE = 57724
-const NOWAIT = 57725
-const NULLS = 57726
-const OJ = 57727
-const OLD = 57728
-const OPTIONAL = 57729
-const ORDINALITY = 57730
-const ORGANIZATION = 57731
-const OTHERS = 57732
-const PATH = 57733
-const PERSIST = 57734
-const PERSIST_ONLY = 57735
-const PRECEDING = 57736
-const PRIVILEGE_CHECKS_USER = 57737
-const PROCESS = 57738
-const RANDOM = 57739
-const REFERENCE = 57740
-const REQUIRE_ROW_FORMAT = 57741
-const RESOURCE = 57742
-const RESPECT = 57743
-const RESTART = 57744
-const RETAIN = 57745
-const REUSE = 57746
-const ROLE = 57747
-const SECONDARY = 57748
-const SECONDARY_ENGINE = 57749
-const SECONDARY_LOAD = 57750
-const SECONDARY_UNLOAD = 57751
-const SKIP = 57752
-const SRID = 57753
-const THREAD_PRIORITY = 57754
-const TIES = 57755
-const UNBOUNDED = 57756
-const VCPU = 57757
-const VISIBLE = 57758
-const FORMAT = 57759
-const TREE = 57760
-const VITESS = 57761
-const TRADITIONAL = 57762
-const LOCAL = 57763
-const LOW_PRIORITY = 57764
-const NO_WRITE_TO_BINLOG = 57765
-const LOGS = 57766
-const ERROR = 57767
-const GENERAL = 57768
-const HOSTS = 57769
-const OPTIMIZER_COSTS = 57770
-const USER_RESOURCES = 57771
-const SLOW = 57772
-const CHANNEL = 57773
-const RELAY = 57774
-const EXPORT = 57775
-const AVG_ROW_LENGTH = 57776
-const CONNECTION = 57777
-const CHECKSUM = 57778
-const DELAY_KEY_WRITE = 57779
-const ENCRYPTION = 57780
-const ENGINE = 57781
-const INSERT_METHOD = 57782
-const MAX_ROWS = 57783
-const MIN_ROWS = 57784
-const PACK_KEYS = 57785
-const PASSWORD = 57786
-const FIXED = 57787
-const DYNAMIC = 57788
-const COMPRESSED = 57789
-const REDUNDANT = 57790
-const COMPACT = 57791
-const ROW_FORMAT = 57792
-const STATS_AUTO_RECALC = 57793
-const STATS_PERSISTENT = 57794
-const STATS_SAMPLE_PAGES = 57795
-const STORAGE = 57796
-const MEMORY = 57797
-const DISK = 57798
+const KILL = 57379
+const DISTINCTROW = 57380
+const PARSER = 57381
+const OUTFILE = 57382
+const S3 = 57383
+const DATA = 57384
+const LOAD = 57385
+const LINES = 57386
+const TERMINATED = 57387
+const ESCAPED = 57388
+const ENCLOSED = 57389
+const DUMPFILE = 57390
+const CSV = 57391
+const HEADER = 57392
+const MANIFEST = 57393
+const OVERWRITE = 57394
+const STARTING = 57395
+const OPTIONALLY = 57396
+const VALUES = 57397
+const LAST_INSERT_ID = 57398
+const NEXT = 57399
+const VALUE = 57400
+const SHARE = 57401
+const MODE = 57402
+const SQL_NO_CACHE = 57403
+const SQL_CACHE = 57404
+const SQL_CALC_FOUND_ROWS = 57405
+const JOIN = 57406
+const STRAIGHT_JOIN = 57407
+const LEFT = 57408
+const RIGHT = 57409
+const INNER = 57410
+const OUTER = 57411
+const CROSS = 57412
+const NATURAL = 57413
+const USE = 57414
+const FORCE = 57415
+const ON = 57416
+const USING = 57417
+const INPLACE = 57418
+const COPY = 57419
+const ALGORITHM = 57420
+const NONE = 57421
+const SHARED = 57422
+const EXCLUSIVE = 57423
+const ID = 57424
+const AT_ID = 57425
+const AT_AT_ID = 57426
+const HEX = 57427
+const STRING = 57428
+const INTEGRAL = 57429
+const FLOAT = 57430
+const HEXNUM = 57431
+const VALUE_ARG = 57432
+const LIST_ARG = 57433
+const COMMENT = 57434
+const COMMENT_KEYWORD = 57435
+const BIT_LITERAL = 57436
+const COMPRESSION = 57437
+const NULL = 57438
+const TRUE = 57439
+const FALSE = 57440
+const OFF = 57441
+const DISCARD = 57442
+const IMPORT = 57443
+const ENABLE = 57444
+const DISABLE = 57445
+const TABLESPACE = 57446
+const OR = 57447
+const XOR = 57448
+const AND = 57449
+const NOT = 57450
+const BETWEEN = 57451
+const CASE = 57452
+const WHEN = 57453
+const THEN = 57454
+const ELSE = 57455
+const END = 57456
+const LE = 57457
+const GE = 57458
+const NE = 57459
+const NULL_SAFE_EQUAL = 57460
+const IS = 57461
+const LIKE = 57462
+const REGEXP = 57463
+const IN = 57464
+const SHIFT_LEFT = 57465
+const SHIFT_RIGHT = 57466
+const DIV = 57467
+const MOD = 57468
+const UNARY = 57469
+const COLLATE = 57470
+const BINARY = 57471
+const UNDERSCORE_BINARY = 57472
+const UNDERSCORE_UTF8MB4 = 57473
+const UNDERSCORE_UTF8 = 57474
+const UNDERSCORE_LATIN1 = 57475
+const INTERVAL = 57476
+const JSON_EXTRACT_OP = 57477
+const JSON_UNQUOTE_EXTRACT_OP = 57478
+const CREATE = 57479
+const ALTER = 57480
+const DROP = 57481
+const RENAME = 57482
+const ANALYZE = 57483
+const ADD = 57484
+const FLUSH = 57485
+const CHANGE = 57486
+const MODIFY = 57487
+const REVERT = 57488
+const SCHEMA = 57489
+const TABLE = 57490
+const INDEX = 57491
+const VIEW = 57492
+const TO = 57493
+const IGNORE = 57494
+const IF = 57495
+const UNIQUE = 57496
+const PRIMARY = 57497
+const COLUMN = 57498
+const SPATIAL = 57499
+const FULLTEXT = 57500
+const KEY_BLOCK_SIZE = 57501
+const CHECK = 57502
+const INDEXES = 57503
+const ACTION = 57504
+const CASCADE = 57505
+const CONSTRAINT = 57506
+const FOREIGN = 57507
+const NO = 57508
+const REFERENCES = 57509
+const RESTRICT = 57510
+const SHOW = 57511
+const DESCRIBE = 57512
+const EXPLAIN = 57513
+const DATE = 57514
+const ESCAPE = 57515
+const REPAIR = 57516
+const OPTIMIZE = 57517
+const TRUNCATE = 57518
+const COALESCE = 57519
+const EXCHANGE = 57520
+const REBUILD = 57521
+const PARTITIONING = 57522
+const REMOVE = 57523
+const MAXVALUE = 57524
+const PARTITION = 57525
+const REORGANIZE = 57526
+const LESS = 57527
+const THAN = 57528
+const PROCEDURE = 57529
+const TRIGGER = 57530
+const VINDEX = 57531
+const VINDEXES = 57532
+const DIRECTORY = 57533
+const NAME = 57534
+const UPGRADE = 57535
+const STATUS = 57536
+const VARIABLES = 57537
+const WARNINGS = 57538
+const CASCADED = 57539
+const DEFINER = 57540
+const OPTION = 57541
+const SQL = 57542
+const UNDEFINED = 57543
+const SEQUENCE = 57544
+const MERGE = 57545
+const TEMPORARY = 57546
+const TEMPTABLE = 57547
+const INVOKER = 57548
+const SECURITY = 57549
+const FIRST = 57550
+const AFTER = 57551
+const LAST = 57552
+const VITESS_MIGRATION = 57553
+const CANCEL = 57554
+const RETRY = 57555
+const COMPLETE = 57556
+const BEGIN = 57557
+const START = 57558
+const TRANSACTION = 57559
+const COMMIT = 57560
+const ROLLBACK = 57561
+const SAVEPOINT = 57562
+const RELEASE = 57563
+const WORK = 57564
+const BIT = 57565
+const TINYINT = 57566
+const SMALLINT = 57567
+const MEDIUMINT = 57568
+const INT = 57569
+const INTEGER = 57570
+const BIGINT = 57571
+const INTNUM = 57572
+const REAL = 57573
+const DOUBLE = 57574
+const FLOAT_TYPE = 57575
+const DECIMAL = 57576
+const NUMERIC = 57577
+const TIME = 57578
+const TIMESTAMP = 57579
+const DATETIME = 57580
+const YEAR = 57581
+const CHAR = 57582
+const VARCHAR = 57583
+const BOOL = 57584
+const CHARACTER = 57585
+const VARBINARY = 57586
+const NCHAR = 57587
+const TEXT = 57588
+const TINYTEXT = 57589
+const MEDIUMTEXT = 57590
+const LONGTEXT = 57591
+const BLOB = 57592
+const TINYBLOB = 57593
+const MEDIUMBLOB = 57594
+const LONGBLOB = 57595
+const JSON = 57596
+const ENUM = 57597
+const GEOMETRY = 57598
+const POINT = 57599
+const LINESTRING = 57600
+const POLYGON = 57601
+const GEOMETRYCOLLECTION = 57602
+const MULTIPOINT = 57603
+const MULTILINESTRING = 57604
+const MULTIPOLYGON = 57605
+const NULLX = 57606
+const AUTO_INCREMENT = 57607
+const APPROXNUM = 57608
+const SIGNED = 57609
+const UNSIGNED = 57610
+const ZEROFILL = 57611
+const COLLATION = 57612
+const DATABASES = 57613
+const SCHEMAS = 57614
+const TABLES = 57615
+const VITESS_METADATA = 57616
+const VSCHEMA = 57617
+const FULL = 57618
+const PROCESSLIST = 57619
+const COLUMNS = 57620
+const FIELDS = 57621
+const ENGINES = 57622
+const PLUGINS = 57623
+const EXTENDED = 57624
+const KEYSPACES = 57625
+const VITESS_KEYSPACES = 57626
+const VITESS_SHARDS = 57627
+const VITESS_TABLETS = 57628
+const VITESS_MIGRATIONS = 57629
+const CODE = 57630
+const PRIVILEGES = 57631
+const FUNCTION = 57632
+const OPEN = 57633
+const TRIGGERS = 57634
+const EVENT = 57635
+const USER = 57636
+const NAMES = 57637
+const CHARSET = 57638
+const GLOBAL = 57639
+const SESSION = 57640
+const ISOLATION = 57641
+const LEVEL = 57642
+const READ = 57643
+const WRITE = 57644
+const ONLY = 57645
+const REPEATABLE = 57646
+const COMMITTED = 57647
+const UNCOMMITTED = 57648
+const SERIALIZABLE = 57649
+const CURRENT_TIMESTAMP = 57650
+const DATABASE = 57651
+const CURRENT_DATE = 57652
+const CURRENT_TIME = 57653
+const LOCALTIME = 57654
+const LOCALTIMESTAMP = 57655
+const CURRENT_USER = 57656
+const UTC_DATE = 57657
+const UTC_TIME = 57658
+const UTC_TIMESTAMP = 57659
+const REPLACE = 57660
+const CONVERT = 57661
+const CAST = 57662
+const SUBSTR = 57663
+const SUBSTRING = 57664
+const GROUP_CONCAT = 57665
+const SEPARATOR = 57666
+const TIMESTAMPADD = 57667
+const TIMESTAMPDIFF = 57668
+const MATCH = 57669
+const AGAINST = 57670
+const BOOLEAN = 57671
+const LANGUAGE = 57672
+const WITH = 57673
+const QUERY = 57674
+const EXPANSION = 57675
+const WITHOUT = 57676
+const VALIDATION = 57677
+const UNUSED = 57678
+const ARRAY = 57679
+const CUME_DIST = 57680
+const DESCRIPTION = 57681
+const DENSE_RANK = 57682
+const EMPTY = 57683
+const EXCEPT = 57684
+const FIRST_VALUE = 57685
+const GROUPING = 57686
+const GROUPS = 57687
+const JSON_TABLE = 57688
+const LAG = 57689
+const LAST_VALUE = 57690
+const LATERAL = 57691
+const LEAD = 57692
+const MEMBER = 57693
+const NTH_VALUE = 57694
+const NTILE = 57695
+const OF = 57696
+const OVER = 57697
+const PERCENT_RANK = 57698
+const RANK = 57699
+const RECURSIVE = 57700
+const ROW_NUMBER = 57701
+const SYSTEM = 57702
+const WINDOW = 57703
+const ACTIVE = 57704
+const ADMIN = 57705
+const BUCKETS = 57706
+const CLONE = 57707
+const COMPONENT = 57708
+const DEFINITION = 57709
+const ENFORCED = 57710
+const EXCLUDE = 57711
+const FOLLOWING = 57712
+const GEOMCOLLECTION = 57713
+const GET_MASTER_PUBLIC_KEY = 57714
+const HISTOGRAM = 57715
+const HISTORY = 57716
+const INACTIVE = 57717
+const INVISIBLE = 57718
+const LOCKED = 57719
+const MASTER_COMPRESSION_ALGORITHMS = 57720
+const MASTER_PUBLIC_KEY_PATH = 57721
+const MASTER_TLS_CIPHERSUITES = 57722
+const MASTER_ZSTD_COMPRESSION_LEVEL = 57723
+const NESTED = 57724
+const NETWORK_NAMESPACE = 57725
+const NOWAIT = 57726
+const NULLS = 57727
+const OJ = 57728
+const OLD = 57729
+const OPTIONAL = 57730
+const ORDINALITY = 57731
+const ORGANIZATION = 57732
+const OTHERS = 57733
+const PATH = 57734
+const PERSIST = 57735
+const PERSIST_ONLY = 57736
+const PRECEDING = 57737
+const PRIVILEGE_CHECKS_USER = 57738
+const PROCESS = 57739
+const RANDOM = 57740
+const REFERENCE = 57741
+const REQUIRE_ROW_FORMAT = 57742
+const RESOURCE = 57743
+const RESPECT = 57744
+const RESTART = 57745
+const RETAIN = 57746
+const REUSE = 57747
+const ROLE = 57748
+const SECONDARY = 57749
+const SECONDARY_ENGINE = 57750
+const SECONDARY_LOAD = 57751
+const SECONDARY_UNLOAD = 57752
+const SKIP = 57753
+const SRID = 57754
+const THREAD_PRIORITY = 57755
+const TIES = 57756
+const UNBOUNDED = 57757
+const VCPU = 57758
+const VISIBLE = 57759
+const FORMAT = 57760
+const TREE = 57761
+const VITESS = 57762
+const TRADITIONAL = 57763
+const LOCAL = 57764
+const LOW_PRIORITY = 57765
+const NO_WRITE_TO_BINLOG = 57766
+const LOGS = 57767
+const ERROR = 57768
+const GENERAL = 57769
+const HOSTS = 57770
+const OPTIMIZER_COSTS = 57771
+const USER_RESOURCES = 57772
+const SLOW = 57773
+const CHANNEL = 57774
+const RELAY = 57775
+const EXPORT = 57776
+const AVG_ROW_LENGTH = 57777
+const CONNECTION = 57778
+const CHECKSUM = 57779
+const DELAY_KEY_WRITE = 57780
+const ENCRYPTION = 57781
+const ENGINE = 57782
+const INSERT_METHOD = 57783
+const MAX_ROWS = 57784
+const MIN_ROWS = 57785
+const PACK_KEYS = 57786
+const PASSWORD = 57787
+const FIXED = 57788
+const DYNAMIC = 57789
+const COMPRESSED = 57790
+const REDUNDANT = 57791
+const COMPACT = 57792
+const ROW_FORMAT = 57793
+const STATS_AUTO_RECALC = 57794
+const STATS_PERSISTENT = 57795
+const STATS_SAMPLE_PAGES = 57796
+const STORAGE = 57797
+const MEMORY = 57798
+const DISK = 57799
 
 var yyToknames = [...]string{
 	"$end",
@@ -536,6 +537,7 @@ var yyToknames = [...]string{
 	"KEYS",
 	"DO",
 	"CALL",
+	"KILL",
 	"DISTINCTROW",
 	"PARSER",
 	"OUTFILE",
@@ -987,2967 +989,2925 @@ var yyExca = [...]int{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 44,
-	164, 934,
-	-2, 91,
 	-1, 45,
-	1, 112,
-	474, 112,
-	-2, 118,
+	165, 939,
+	-2, 92,
 	-1, 46,
-	143, 118,
-	259, 118,
-	312, 118,
-	-2, 326,
-	-1, 53,
-	34, 472,
-	165, 472,
-	177, 472,
-	210, 486,
-	211, 486,
-	-2, 474,
-	-1, 58,
-	167, 496,
-	-2, 494,
-	-1, 84,
-	56, 564,
-	-2, 572,
-	-1, 109,
 	1, 113,
-	474, 113,
-	-2, 118,
-	-1, 119,
-	170, 231,
-	171, 231,
-	-2, 320,
-	-1, 138,
-	143, 118,
-	259, 118,
-	312, 118,
-	-2, 335,
-	-1, 579,
-	150, 955,
-	-2, 951,
-	-1, 580,
-	150, 956,
-	-2, 952,
-	-1, 599,
-	56, 565,
+	475, 113,
+	-2, 119,
+	-1, 47,
+	144, 119,
+	260, 119,
+	313, 119,
+	-2, 327,
+	-1, 54,
+	34, 473,
+	166, 473,
+	178, 473,
+	211, 487,
+	212, 487,
+	-2, 475,
+	-1, 59,
+	168, 497,
+	-2, 495,
+	-1, 86,
+	57, 569,
 	-2, 577,
-	-1, 600,
-	56, 566,
-	-2, 578,
-	-1, 621,
-	118, 1299,
-	-2, 84,
-	-1, 622,
-	118, 1181,
+	-1, 111,
+	1, 114,
+	475, 114,
+	-2, 119,
+	-1, 121,
+	171, 232,
+	172, 232,
+	-2, 321,
+	-1, 140,
+	144, 119,
+	260, 119,
+	313, 119,
+	-2, 336,
+	-1, 581,
+	151, 960,
+	-2, 956,
+	-1, 582,
+	151, 961,
+	-2, 957,
+	-1, 604,
+	57, 570,
+	-2, 582,
+	-1, 605,
+	57, 571,
+	-2, 583,
+	-1, 626,
+	119, 1304,
 	-2, 85,
-	-1, 628,
-	118, 1231,
-	-2, 928,
-	-1, 766,
-	118, 1118,
-	-2, 925,
-	-1, 799,
-	176, 38,
-	181, 38,
-	-2, 242,
-	-1, 880,
-	1, 373,
-	474, 373,
-	-2, 118,
-	-1, 1120,
-	1, 269,
-	474, 269,
-	-2, 118,
-	-1, 1198,
-	170, 231,
-	171, 231,
-	-2, 320,
-	-1, 1207,
-	176, 39,
-	181, 39,
+	-1, 627,
+	119, 1186,
+	-2, 86,
+	-1, 633,
+	119, 1236,
+	-2, 933,
+	-1, 771,
+	119, 1123,
+	-2, 930,
+	-1, 804,
+	177, 39,
+	182, 39,
 	-2, 243,
-	-1, 1420,
-	150, 960,
-	-2, 954,
-	-1, 1512,
-	74, 66,
-	82, 66,
-	-2, 70,
-	-1, 1533,
+	-1, 885,
+	1, 374,
+	475, 374,
+	-2, 119,
+	-1, 1126,
 	1, 270,
-	474, 270,
-	-2, 118,
-	-1, 1945,
-	5, 821,
-	18, 821,
-	20, 821,
-	32, 821,
-	83, 821,
-	-2, 604,
-	-1, 2157,
-	46, 896,
-	-2, 890,
+	475, 270,
+	-2, 119,
+	-1, 1204,
+	171, 232,
+	172, 232,
+	-2, 321,
+	-1, 1213,
+	177, 40,
+	182, 40,
+	-2, 244,
+	-1, 1427,
+	151, 965,
+	-2, 959,
+	-1, 1519,
+	75, 67,
+	83, 67,
+	-2, 71,
+	-1, 1540,
+	1, 271,
+	475, 271,
+	-2, 119,
+	-1, 1952,
+	5, 826,
+	18, 826,
+	20, 826,
+	32, 826,
+	84, 826,
+	-2, 609,
+	-1, 2164,
+	47, 901,
+	-2, 895,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 28297
+const yyLast = 27865
 
 var yyAct = [...]int{
-	579, 2242, 2186, 1858, 2231, 2170, 1997, 2208, 1746, 1548,
-	2158, 522, 2086, 939, 551, 2108, 1925, 1827, 1021, 1530,
-	1713, 1926, 1994, 537, 1457, 1597, 1068, 1747, 1922, 1563,
-	1831, 1733, 83, 3, 520, 892, 1183, 1811, 1568, 1812,
-	829, 769, 1509, 1075, 1937, 147, 1884, 1223, 1673, 180,
-	1406, 1414, 180, 1810, 485, 180, 1647, 1570, 1595, 1320,
-	501, 1804, 180, 1112, 794, 133, 1105, 1498, 626, 1096,
-	180, 1491, 1073, 1205, 1078, 919, 601, 81, 1459, 1095,
-	1098, 1060, 524, 1440, 513, 586, 1383, 957, 33, 776,
-	1102, 1182, 501, 1295, 807, 501, 180, 501, 777, 1559,
-	781, 773, 800, 795, 79, 623, 1212, 1474, 796, 1111,
-	1514, 1085, 1325, 886, 1417, 797, 110, 1172, 937, 150,
-	111, 1549, 1197, 116, 1180, 1109, 117, 508, 871, 8,
-	7, 6, 78, 1177, 1034, 1850, 1849, 1626, 1282, 1872,
-	2110, 1873, 1454, 1455, 1372, 1037, 182, 183, 184, 1371,
-	1370, 1369, 1368, 1367, 511, 1360, 512, 2200, 1711, 770,
-	592, 608, 612, 2154, 1971, 112, 2065, 958, 587, 118,
-	2132, 2131, 833, 180, 2081, 460, 831, 2082, 2248, 832,
-	2205, 610, 84, 2241, 834, 80, 2181, 2234, 1998, 845,
-	846, 509, 849, 850, 851, 852, 1614, 1663, 855, 856,
-	857, 858, 859, 860, 861, 862, 863, 864, 865, 866,
-	867, 868, 869, 627, 958, 811, 2204, 620, 171, 86,
-	87, 88, 89, 90, 91, 1901, 788, 2029, 786, 112,
-	787, 2180, 1712, 968, 789, 1633, 810, 1951, 926, 1632,
-	928, 842, 1871, 113, 177, 135, 1661, 514, 107, 1524,
-	453, 454, 1184, 489, 155, 835, 836, 837, 564, 1515,
-	570, 571, 568, 569, 1456, 567, 566, 565, 1777, 1952,
-	1953, 1776, 847, 1573, 1778, 572, 573, 925, 927, 911,
-	968, 1525, 1526, 912, 35, 145, 785, 72, 39, 40,
-	134, 1113, 905, 1114, 899, 900, 935, 583, 112, 582,
-	1794, 171, 182, 183, 184, 105, 488, 848, 152, 1542,
-	153, 790, 956, 2020, 1860, 122, 123, 144, 143, 170,
-	1361, 1362, 1363, 2018, 499, 1359, 113, 503, 964, 104,
-	107, 172, 497, 585, 877, 2183, 1832, 155, 1596, 2144,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 897, 1572, 994, 1854, 898, 899, 900, 71,
-	1306, 1304, 1305, 1855, 1301, 1629, 1296, 139, 120, 146,
-	127, 119, 1272, 140, 141, 964, 872, 913, 156, 934,
-	924, 2233, 2201, 923, 929, 107, 906, 99, 161, 128,
-	1861, 152, 102, 153, 176, 101, 100, 916, 917, 922,
-	932, 918, 170, 131, 129, 124, 125, 126, 130, 1641,
-	106, 914, 915, 121, 1273, 1308, 1274, 1309, 1302, 1310,
-	1862, 881, 132, 489, 1863, 1298, 854, 489, 853, 1885,
-	2128, 2076, 1598, 1492, 1300, 818, 816, 827, 1970, 826,
-	825, 824, 105, 823, 822, 809, 821, 820, 809, 815,
-	517, 791, 180, 1191, 885, 828, 2246, 180, 175, 1515,
-	180, 156, 2077, 109, 963, 960, 961, 962, 967, 969,
-	966, 161, 965, 1887, 878, 1299, 488, 489, 930, 959,
-	488, 1631, 2249, 1646, 774, 2220, 501, 501, 501, 803,
-	774, 774, 106, 802, 148, 772, 909, 895, 1864, 901,
-	902, 903, 904, 931, 501, 501, 2179, 1211, 1210, 1791,
-	1786, 963, 960, 961, 962, 967, 969, 966, 887, 965,
-	936, 1181, 614, 1620, 1662, 1574, 959, 2171, 819, 817,
-	488, 1714, 1716, 1313, 944, 1889, 838, 1893, 950, 1888,
-	1820, 1886, 1628, 809, 1910, 1909, 1891, 106, 142, 2184,
-	1908, 784, 783, 1787, 782, 1890, 1649, 933, 2145, 1443,
-	136, 1648, 1842, 137, 884, 1616, 780, 459, 1892, 1894,
-	1284, 1283, 1285, 1286, 1287, 1789, 451, 148, 1784, 1640,
-	1649, 808, 1639, 180, 808, 1648, 2165, 812, 802, 2049,
-	1785, 802, 805, 806, 809, 774, 1950, 813, 1738, 799,
-	803, 1681, 1004, 896, 2244, 941, 942, 2245, 809, 2243,
-	1531, 501, 73, 1606, 180, 814, 180, 180, 798, 501,
-	1692, 1066, 1006, 1007, 1520, 501, 1089, 908, 1715, 1019,
-	1065, 1689, 623, 890, 994, 953, 951, 952, 888, 910,
-	1773, 1022, 880, 595, 984, 1390, 844, 994, 1470, 894,
-	1792, 1790, 809, 876, 1355, 974, 2136, 830, 1094, 1388,
-	1389, 1387, 94, 1061, 149, 154, 151, 157, 158, 159,
-	160, 162, 163, 164, 165, 972, 973, 971, 1079, 808,
-	166, 167, 168, 169, 1935, 920, 802, 805, 806, 1615,
-	774, 1326, 971, 974, 799, 803, 1297, 1580, 975, 1036,
-	1039, 1041, 1043, 1044, 1046, 1048, 1049, 95, 974, 1058,
-	1115, 1040, 1042, 1077, 1045, 1047, 954, 1050, 983, 982,
-	992, 993, 985, 986, 987, 988, 989, 990, 991, 984,
-	808, 879, 994, 873, 514, 874, 812, 802, 875, 1903,
-	627, 973, 971, 1032, 808, 1441, 813, 149, 154, 151,
-	157, 158, 159, 160, 162, 163, 164, 165, 974, 1006,
-	1007, 1788, 893, 166, 167, 168, 169, 1613, 1611, 180,
-	1006, 1007, 1067, 1173, 818, 1071, 1074, 816, 1674, 972,
-	973, 971, 1955, 1185, 1186, 1187, 1608, 1905, 808, 1608,
-	843, 987, 988, 989, 990, 991, 984, 974, 501, 994,
-	1207, 921, 182, 183, 184, 1082, 1408, 1327, 1216, 1441,
-	1612, 1699, 1220, 1610, 174, 501, 501, 2235, 501, 1291,
-	501, 501, 1217, 501, 501, 501, 501, 501, 501, 2250,
-	1189, 1190, 182, 183, 184, 2064, 1799, 71, 501, 1203,
-	1289, 1110, 180, 1256, 613, 2236, 2063, 1251, 1252, 1386,
-	985, 986, 987, 988, 989, 990, 991, 984, 1269, 1196,
-	994, 1279, 1409, 1225, 1976, 1226, 1808, 1228, 1230, 501,
-	2225, 1234, 1236, 1238, 1240, 1242, 1807, 180, 1290, 1253,
-	1577, 1215, 1472, 1292, 1378, 1380, 1381, 180, 1259, 1260,
-	1277, 180, 1800, 1276, 1265, 1266, 1379, 2251, 2226, 1288,
-	1213, 1213, 1275, 1687, 1666, 1667, 1668, 180, 1179, 1267,
-	1188, 1686, 779, 1214, 180, 1193, 1194, 1206, 1912, 1192,
-	1278, 180, 180, 180, 180, 180, 180, 180, 180, 180,
-	501, 501, 501, 618, 615, 616, 972, 973, 971, 1261,
-	1258, 1330, 182, 183, 184, 1471, 1780, 2229, 1334, 1257,
-	1336, 1337, 1338, 1339, 974, 1341, 1232, 180, 2032, 1322,
-	1254, 182, 183, 184, 596, 1590, 1913, 2228, 2227, 1356,
-	972, 973, 971, 1328, 1329, 596, 1008, 1009, 1010, 1011,
-	1012, 1013, 1014, 1015, 1016, 1017, 2216, 1333, 974, 1475,
-	1476, 2214, 1384, 2099, 1340, 1407, 2061, 788, 1314, 2037,
-	112, 787, 1319, 1958, 1410, 983, 982, 992, 993, 985,
-	986, 987, 988, 989, 990, 991, 984, 1914, 501, 994,
-	1332, 983, 982, 992, 993, 985, 986, 987, 988, 989,
-	990, 991, 984, 1418, 1688, 994, 182, 183, 184, 1429,
-	1432, 1411, 1412, 1809, 1817, 1442, 1805, 1366, 1351, 1352,
-	1353, 1657, 501, 501, 182, 183, 184, 1624, 1588, 1623,
-	1385, 1323, 1280, 180, 1268, 1264, 1424, 972, 973, 971,
-	1263, 972, 973, 971, 1262, 1064, 1324, 501, 1857, 1419,
-	1983, 2219, 1464, 2126, 180, 974, 2125, 501, 1420, 974,
-	1923, 180, 1996, 180, 1022, 80, 182, 183, 184, 1934,
-	1270, 180, 180, 1418, 1983, 2177, 1448, 1449, 501, 1983,
-	2166, 501, 1983, 596, 1983, 2134, 2079, 596, 972, 973,
-	971, 623, 501, 1834, 623, 1421, 540, 539, 542, 543,
-	544, 545, 1819, 1510, 1516, 541, 974, 546, 1425, 1426,
-	1608, 596, 1431, 1434, 1435, 1550, 1551, 1552, 1734, 1489,
-	2047, 596, 1373, 1374, 1375, 1376, 1485, 1516, 1420, 1983,
-	1988, 1968, 1967, 1535, 1964, 1965, 1534, 1609, 1447, 1964,
-	1963, 1450, 1451, 596, 1483, 596, 35, 501, 1515, 1851,
-	1734, 180, 1176, 1836, 82, 501, 1538, 1829, 1830, 180,
-	1587, 1589, 1513, 1495, 596, 35, 1517, 1539, 1487, 970,
-	596, 1565, 1934, 501, 1519, 1176, 1175, 1427, 1428, 501,
-	1121, 1120, 1571, 1216, 1518, 1216, 2044, 1495, 1522, 1517,
-	1741, 1494, 1608, 1607, 2115, 1537, 1465, 1515, 970, 627,
-	1536, 1767, 627, 1521, 1484, 2135, 1477, 1983, 1966, 1515,
-	1495, 2066, 1523, 1742, 1594, 514, 35, 1704, 1703, 1934,
-	1483, 71, 589, 501, 1483, 1407, 1608, 1591, 1473, 1452,
-	1407, 1407, 1543, 1364, 1544, 1545, 1546, 1547, 1561, 1562,
-	71, 1814, 1495, 1578, 1566, 1575, 580, 1583, 1584, 1585,
-	1555, 1556, 1557, 1558, 1312, 1576, 1604, 1107, 1605, 2067,
-	2068, 2069, 1247, 2169, 1617, 180, 811, 1529, 1600, 180,
-	180, 180, 180, 180, 1483, 1619, 1213, 793, 1566, 1599,
-	1621, 1622, 1618, 180, 180, 180, 180, 810, 1603, 792,
-	180, 71, 71, 2088, 1995, 181, 180, 71, 181, 2055,
-	1178, 181, 1564, 180, 1856, 1601, 502, 1560, 181, 2031,
-	1248, 1249, 1250, 1944, 1554, 1553, 181, 1294, 1208, 1204,
-	1174, 96, 177, 2070, 1938, 1939, 1567, 1859, 180, 501,
-	2089, 1184, 2238, 2232, 1652, 1653, 1941, 1923, 502, 1655,
-	1825, 502, 181, 502, 1813, 1824, 1656, 1244, 1823, 1581,
-	1357, 1315, 1943, 1755, 1754, 1627, 983, 982, 992, 993,
-	985, 986, 987, 988, 989, 990, 991, 984, 2071, 2072,
-	994, 1760, 1384, 1504, 1505, 1644, 983, 982, 992, 993,
-	985, 986, 987, 988, 989, 990, 991, 984, 2222, 1814,
-	994, 1758, 1245, 1246, 1756, 2203, 1759, 1382, 1915, 1757,
-	1391, 1392, 1393, 1394, 1395, 1396, 1397, 1398, 1399, 1400,
-	1401, 1402, 1403, 1404, 1405, 1723, 1076, 2188, 2048, 181,
-	1986, 1732, 1660, 180, 1731, 2187, 1500, 1503, 1504, 1505,
-	1501, 180, 1502, 1506, 2159, 2161, 1938, 1939, 2224, 2207,
-	1385, 2209, 2191, 2162, 1669, 992, 993, 985, 986, 987,
-	988, 989, 990, 991, 984, 180, 98, 994, 2156, 1444,
-	1311, 581, 1720, 1818, 1437, 840, 180, 180, 180, 180,
-	180, 103, 606, 602, 1727, 1721, 1748, 1682, 180, 1438,
-	587, 839, 180, 1722, 1069, 180, 180, 2007, 603, 180,
-	180, 180, 1739, 1698, 1743, 1813, 1070, 1736, 1870, 943,
-	1678, 1679, 1779, 1061, 1710, 1844, 1843, 452, 113, 1718,
-	2113, 1080, 1081, 605, 1765, 604, 1726, 1960, 1959, 173,
-	1798, 1696, 455, 1602, 1795, 1796, 1222, 1768, 1735, 1221,
-	1209, 1770, 1737, 2042, 1475, 1476, 1750, 1751, 1797, 1753,
-	1801, 1802, 1803, 1468, 1761, 1749, 1821, 1318, 1752, 1782,
-	1422, 1423, 180, 2127, 1766, 1683, 1771, 1774, 2083, 1322,
-	1730, 1508, 1307, 501, 590, 591, 1665, 593, 1729, 501,
-	1783, 2215, 501, 2213, 1216, 2212, 1571, 2192, 1833, 501,
-	2190, 2041, 1982, 1816, 1592, 594, 1806, 82, 1918, 1837,
-	1839, 1848, 2040, 1700, 606, 602, 1466, 1815, 1734, 180,
-	1500, 1503, 1504, 1505, 1501, 1693, 1502, 1506, 1690, 1847,
-	603, 2240, 2239, 589, 1090, 1083, 2240, 180, 2163, 1846,
-	1957, 1469, 80, 1724, 1725, 1074, 85, 1196, 77, 1,
-	472, 1453, 1059, 599, 600, 605, 1419, 604, 484, 2230,
-	1281, 1271, 1999, 2085, 1989, 1420, 1845, 1838, 1569, 801,
-	138, 1532, 501, 1533, 2173, 93, 767, 92, 1407, 804,
-	907, 1593, 2080, 1793, 1541, 1881, 1127, 1125, 1126, 1866,
-	1124, 1865, 1129, 1128, 1123, 1358, 498, 1507, 178, 1116,
-	1883, 1084, 841, 462, 1969, 1354, 1625, 468, 501, 1874,
-	1002, 1728, 1775, 624, 617, 1929, 1882, 1868, 181, 180,
-	1869, 2185, 1880, 181, 2155, 1896, 181, 2157, 1895, 501,
-	1902, 2109, 2160, 2153, 2223, 501, 501, 2206, 1540, 1467,
-	1924, 1072, 1881, 1748, 2039, 1917, 1697, 1927, 1031, 1439,
-	1099, 523, 502, 502, 502, 1911, 1463, 1377, 180, 538,
-	535, 536, 1478, 1740, 976, 521, 515, 1091, 1933, 1499,
-	502, 502, 982, 992, 993, 985, 986, 987, 988, 989,
-	990, 991, 984, 1932, 1497, 994, 1496, 1942, 1946, 1316,
-	1948, 1103, 1949, 1940, 1936, 1097, 1482, 1630, 1853, 955,
-	1947, 598, 510, 97, 1436, 1961, 1962, 2026, 2143, 1977,
-	1664, 180, 2028, 597, 180, 180, 180, 1954, 61, 38,
-	501, 505, 2199, 946, 607, 32, 31, 30, 29, 1670,
-	1671, 1672, 28, 180, 1985, 23, 22, 21, 20, 19,
-	1973, 1972, 25, 18, 1974, 1975, 17, 16, 108, 181,
-	2000, 501, 501, 501, 48, 180, 45, 1990, 43, 115,
-	114, 1984, 46, 1571, 2008, 1987, 1993, 42, 882, 1992,
-	27, 1904, 26, 15, 14, 13, 12, 502, 11, 10,
-	181, 9, 181, 181, 5, 502, 4, 949, 24, 1020,
-	1921, 502, 2, 0, 2005, 2006, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1919, 0, 0, 2016,
-	0, 0, 0, 2038, 0, 0, 983, 982, 992, 993,
-	985, 986, 987, 988, 989, 990, 991, 984, 0, 0,
-	994, 0, 0, 0, 0, 0, 1748, 0, 0, 0,
-	0, 0, 0, 2043, 0, 0, 0, 0, 0, 0,
-	0, 0, 2052, 0, 0, 0, 0, 0, 0, 0,
-	1676, 0, 0, 2060, 1677, 2062, 0, 2051, 0, 2058,
-	0, 2059, 0, 501, 501, 1684, 1685, 0, 0, 0,
-	2057, 1691, 0, 0, 1694, 1695, 501, 0, 0, 501,
-	2074, 2073, 1701, 0, 1702, 0, 0, 1705, 1706, 1707,
-	1708, 1709, 2087, 2084, 0, 2092, 0, 0, 0, 2013,
-	2014, 0, 2015, 1719, 2091, 2017, 0, 2019, 0, 0,
-	0, 0, 0, 0, 501, 501, 501, 180, 2090, 0,
-	0, 0, 0, 2011, 0, 181, 0, 2107, 501, 0,
-	501, 2102, 2104, 2105, 0, 0, 501, 0, 0, 1927,
-	2112, 2106, 0, 1927, 0, 2118, 2114, 0, 0, 1763,
-	1764, 0, 0, 2121, 502, 0, 0, 0, 180, 2116,
-	2123, 0, 2124, 0, 0, 0, 0, 2030, 0, 501,
-	180, 502, 502, 0, 502, 0, 502, 502, 0, 502,
-	502, 502, 502, 502, 502, 2130, 2137, 0, 0, 0,
-	514, 0, 0, 0, 502, 0, 2133, 2053, 181, 0,
-	2054, 0, 0, 2056, 0, 1876, 1877, 2152, 0, 0,
-	1927, 0, 2164, 0, 0, 0, 501, 501, 0, 0,
-	1897, 1898, 0, 1899, 1900, 502, 0, 0, 2172, 2087,
-	2174, 0, 0, 181, 1906, 1907, 0, 0, 0, 0,
-	2167, 0, 0, 181, 550, 2025, 501, 181, 2189, 2182,
-	501, 0, 2193, 2195, 0, 1748, 0, 0, 0, 0,
-	0, 2202, 0, 181, 0, 0, 0, 2198, 2211, 2098,
-	181, 0, 2210, 182, 183, 184, 0, 181, 181, 181,
-	181, 181, 181, 181, 181, 181, 502, 502, 502, 2221,
-	0, 0, 2120, 179, 0, 0, 458, 0, 2122, 496,
-	0, 171, 2111, 514, 0, 0, 458, 0, 0, 0,
-	0, 2024, 0, 181, 458, 2237, 0, 1956, 1878, 1879,
-	0, 0, 2247, 0, 0, 0, 113, 0, 0, 0,
-	0, 611, 611, 477, 0, 0, 0, 155, 0, 0,
-	458, 0, 476, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 474, 983, 982, 992, 993, 985, 986,
-	987, 988, 989, 990, 991, 984, 0, 0, 994, 0,
-	0, 0, 0, 0, 502, 0, 0, 0, 1781, 0,
-	0, 0, 0, 0, 1930, 0, 0, 0, 0, 0,
-	0, 152, 471, 153, 0, 2023, 0, 0, 0, 0,
-	0, 483, 170, 0, 0, 1945, 2009, 0, 502, 502,
-	0, 0, 0, 0, 0, 0, 0, 458, 0, 181,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 0, 502, 994, 0, 0, 0, 0, 0,
-	181, 0, 0, 502, 0, 0, 0, 181, 489, 181,
-	0, 0, 0, 0, 0, 0, 0, 181, 181, 0,
-	0, 156, 0, 0, 502, 0, 0, 502, 0, 0,
-	0, 161, 0, 0, 0, 461, 463, 464, 502, 480,
-	482, 490, 0, 0, 0, 478, 479, 491, 465, 466,
-	495, 494, 481, 0, 470, 467, 469, 475, 0, 0,
-	0, 488, 473, 492, 983, 982, 992, 993, 985, 986,
-	987, 988, 989, 990, 991, 984, 0, 0, 994, 0,
-	2010, 0, 0, 0, 2012, 0, 0, 0, 0, 0,
-	0, 0, 0, 502, 0, 2021, 2022, 181, 0, 0,
-	0, 502, 0, 0, 0, 181, 0, 0, 0, 0,
-	0, 2036, 0, 0, 2093, 2094, 2095, 2096, 2097, 502,
-	0, 0, 2100, 2101, 0, 502, 0, 0, 2045, 2046,
-	0, 978, 2050, 981, 0, 0, 0, 148, 0, 995,
-	996, 997, 998, 999, 1000, 1001, 0, 979, 980, 977,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 0, 549, 994, 0, 0, 0, 0, 502,
-	1875, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 493, 0, 2078,
-	983, 982, 992, 993, 985, 986, 987, 988, 989, 990,
-	991, 984, 0, 0, 994, 486, 0, 0, 0, 0,
-	0, 181, 0, 0, 0, 181, 181, 181, 181, 181,
-	487, 0, 0, 500, 0, 0, 0, 0, 0, 181,
-	181, 181, 181, 2103, 1144, 0, 181, 0, 0, 0,
-	0, 0, 181, 0, 0, 0, 0, 0, 0, 181,
-	0, 0, 0, 0, 0, 625, 458, 1675, 771, 2196,
-	778, 458, 0, 0, 458, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 181, 502, 0, 983, 982, 992,
-	993, 985, 986, 987, 988, 989, 990, 991, 984, 0,
-	0, 994, 0, 2139, 2140, 2141, 2142, 0, 2146, 0,
-	2147, 2148, 2149, 0, 2150, 2151, 0, 149, 154, 151,
-	157, 158, 159, 160, 162, 163, 164, 165, 0, 0,
-	0, 0, 0, 166, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2178, 0, 0, 0, 1132, 0, 0,
-	0, 0, 0, 0, 35, 36, 37, 72, 39, 40,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
-	0, 0, 0, 0, 76, 0, 0, 181, 0, 41,
-	67, 68, 0, 65, 69, 0, 0, 458, 0, 0,
-	66, 1145, 0, 0, 0, 0, 2217, 2218, 0, 0,
-	0, 181, 0, 611, 0, 0, 0, 0, 0, 0,
-	0, 0, 181, 181, 181, 181, 181, 0, 458, 54,
-	458, 1106, 0, 0, 181, 0, 0, 0, 181, 71,
-	0, 181, 181, 0, 0, 181, 181, 181, 0, 0,
-	0, 0, 1158, 1161, 1162, 1163, 1164, 1165, 1166, 0,
-	1167, 1168, 1169, 1170, 1171, 1146, 1147, 1148, 1149, 1130,
-	1131, 1159, 0, 1133, 0, 1134, 1135, 1136, 1137, 1138,
-	1139, 1140, 1141, 1142, 1143, 1150, 1151, 1152, 1153, 1154,
-	1155, 1156, 1157, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 181, 0,
-	0, 44, 47, 50, 49, 52, 0, 64, 0, 502,
-	70, 0, 0, 0, 0, 502, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 502, 0, 0, 0, 0,
-	0, 0, 0, 53, 75, 74, 0, 0, 62, 63,
-	51, 0, 0, 0, 0, 181, 0, 0, 1160, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 181, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 458, 0, 0, 0, 0, 0, 55,
-	56, 0, 57, 58, 59, 60, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 502, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1219, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 502, 0, 0, 0, 0, 0,
-	1219, 1219, 0, 0, 0, 181, 458, 0, 0, 625,
-	625, 625, 0, 0, 0, 502, 0, 0, 0, 0,
-	0, 502, 502, 0, 0, 0, 0, 945, 947, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 458, 73, 0, 181, 0, 0, 0, 0, 0,
-	0, 458, 0, 0, 0, 1321, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 458, 0, 0, 0, 0, 0, 0, 458, 0,
-	0, 0, 0, 0, 0, 1342, 1343, 458, 458, 458,
-	458, 458, 458, 458, 0, 0, 0, 181, 0, 0,
-	181, 181, 181, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 181,
-	0, 458, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 1062, 1087, 0, 0, 502, 502, 502,
-	0, 181, 625, 0, 0, 0, 0, 0, 1117, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 611, 1321, 457, 0, 0, 611, 611,
-	0, 0, 611, 611, 611, 504, 0, 0, 1219, 0,
-	0, 0, 0, 584, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 611, 611,
-	611, 611, 611, 0, 0, 0, 0, 1461, 0, 775,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 458, 552,
-	34, 0, 0, 0, 1321, 458, 0, 458, 0, 502,
-	502, 0, 0, 0, 0, 458, 458, 0, 0, 0,
-	0, 0, 502, 0, 0, 502, 0, 0, 0, 0,
-	0, 0, 0, 0, 34, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 870, 0, 0, 0,
-	502, 502, 502, 181, 0, 0, 0, 0, 0, 0,
-	0, 771, 0, 0, 502, 0, 502, 0, 0, 588,
-	0, 0, 502, 0, 1218, 0, 0, 0, 1224, 1224,
-	0, 1224, 0, 1224, 1224, 458, 1233, 1224, 1224, 1224,
-	1224, 1224, 0, 1586, 181, 0, 0, 0, 0, 1218,
-	1218, 771, 0, 0, 0, 502, 181, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1293, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 502, 502, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 502, 0, 0, 0, 502, 0, 0, 0,
-	0, 0, 0, 625, 625, 625, 0, 0, 0, 458,
-	0, 0, 0, 458, 458, 458, 458, 458, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 458, 458, 458,
-	458, 0, 0, 0, 1650, 0, 0, 0, 0, 0,
-	458, 0, 0, 0, 0, 0, 0, 458, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 458, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1413, 0, 625, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1218, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1445, 1446, 0, 0, 0,
-	611, 611, 0, 0, 0, 883, 0, 0, 0, 0,
-	889, 0, 0, 891, 0, 0, 0, 0, 0, 0,
-	1479, 611, 0, 0, 0, 0, 0, 0, 0, 0,
-	1087, 0, 0, 625, 0, 0, 0, 458, 0, 0,
-	0, 0, 0, 0, 0, 1461, 0, 0, 0, 0,
-	0, 625, 0, 0, 625, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 771, 0, 0, 611, 458,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1219,
-	458, 458, 458, 458, 458, 0, 0, 0, 0, 0,
-	0, 0, 1762, 0, 0, 0, 458, 0, 0, 458,
-	458, 0, 0, 458, 1772, 1321, 0, 0, 0, 0,
-	0, 171, 0, 0, 0, 0, 0, 0, 0, 0,
-	778, 0, 1826, 0, 0, 0, 0, 0, 1582, 0,
-	0, 0, 0, 0, 0, 0, 113, 0, 135, 0,
-	0, 0, 0, 0, 0, 0, 771, 155, 0, 0,
-	0, 0, 778, 0, 0, 938, 938, 938, 0, 0,
-	0, 0, 0, 0, 0, 0, 458, 1093, 0, 0,
-	1104, 0, 0, 0, 0, 34, 0, 0, 145, 0,
-	0, 1219, 0, 134, 0, 0, 0, 0, 1003, 1005,
-	0, 1321, 0, 0, 0, 0, 771, 0, 0, 0,
-	0, 152, 0, 153, 0, 0, 0, 0, 1199, 1200,
-	144, 143, 170, 458, 0, 0, 0, 0, 0, 1018,
-	0, 0, 0, 1023, 1024, 1025, 1026, 1027, 1028, 1029,
-	1030, 458, 1033, 1035, 1038, 1038, 1038, 1035, 1038, 1038,
-	1035, 1038, 1051, 1052, 1053, 1054, 1055, 1056, 1057, 0,
-	0, 0, 0, 0, 1063, 0, 0, 0, 34, 0,
-	139, 1201, 146, 171, 1198, 611, 140, 141, 0, 0,
-	0, 156, 0, 0, 1195, 0, 0, 0, 0, 0,
-	0, 161, 0, 0, 0, 1100, 0, 0, 113, 0,
-	135, 0, 0, 0, 0, 0, 0, 0, 0, 155,
-	0, 0, 1659, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 458, 0, 0, 0, 0, 0, 0,
-	0, 0, 1122, 0, 0, 0, 1219, 0, 0, 0,
-	145, 0, 0, 0, 0, 134, 0, 0, 0, 0,
+	581, 2249, 2193, 2238, 2215, 2177, 2004, 1865, 2115, 1933,
+	1753, 524, 1720, 2165, 2093, 1604, 553, 1834, 1026, 1537,
+	539, 1464, 2001, 1929, 1754, 1183, 1932, 1074, 1450, 1555,
+	1189, 1838, 85, 3, 1575, 1818, 1570, 1740, 774, 522,
+	834, 1819, 944, 1081, 519, 1944, 897, 149, 924, 1817,
+	182, 1421, 1891, 182, 1229, 489, 182, 1680, 1602, 597,
+	1654, 505, 135, 182, 1516, 1211, 1577, 1811, 1118, 1413,
+	799, 182, 1498, 1326, 1111, 606, 1084, 1505, 83, 1079,
+	1102, 1466, 1104, 1065, 1390, 515, 1447, 591, 1481, 34,
+	631, 1101, 812, 526, 505, 1218, 781, 505, 182, 505,
+	962, 778, 786, 805, 802, 1301, 782, 1566, 1108, 800,
+	801, 1117, 1521, 1091, 600, 1332, 81, 891, 112, 1178,
+	113, 876, 1115, 118, 1203, 119, 942, 152, 512, 1556,
+	1039, 1188, 8, 7, 1186, 6, 628, 1042, 1857, 1856,
+	1633, 80, 1879, 2117, 1880, 590, 184, 185, 186, 1461,
+	1462, 1379, 1378, 1288, 1377, 1376, 1375, 1374, 462, 1367,
+	463, 2207, 1718, 2161, 1978, 613, 617, 775, 2072, 963,
+	2139, 592, 120, 2138, 114, 182, 2088, 838, 836, 2089,
+	2255, 837, 2212, 2248, 464, 82, 2188, 2241, 839, 2005,
+	1670, 850, 851, 513, 854, 855, 856, 857, 1621, 963,
+	860, 861, 862, 863, 864, 865, 866, 867, 868, 869,
+	870, 871, 872, 873, 874, 816, 625, 86, 2211, 1908,
+	2187, 1190, 2036, 632, 791, 179, 1719, 815, 1784, 1958,
+	793, 1783, 792, 36, 1785, 973, 74, 40, 41, 114,
+	1424, 847, 1580, 1119, 794, 1120, 840, 841, 842, 589,
+	1959, 1960, 1878, 1522, 1668, 88, 89, 90, 91, 92,
+	93, 1532, 1533, 1531, 1640, 973, 173, 931, 1639, 933,
+	109, 1463, 455, 456, 853, 795, 916, 1833, 493, 917,
+	910, 566, 852, 572, 573, 570, 571, 585, 569, 568,
+	567, 115, 173, 137, 904, 905, 902, 584, 574, 575,
+	903, 904, 905, 157, 940, 1801, 930, 932, 114, 73,
+	1549, 109, 174, 106, 961, 2190, 1867, 115, 184, 185,
+	186, 2027, 1579, 790, 1368, 1369, 1370, 107, 2025, 157,
+	969, 492, 503, 1366, 147, 507, 882, 501, 1839, 136,
+	2151, 988, 987, 997, 998, 990, 991, 992, 993, 994,
+	995, 996, 989, 587, 1603, 999, 1278, 154, 1636, 155,
+	969, 1312, 1310, 1311, 1205, 1206, 146, 145, 172, 109,
+	1788, 101, 1302, 918, 911, 178, 104, 1307, 2240, 103,
+	102, 1861, 877, 154, 937, 155, 2208, 939, 923, 1862,
+	493, 493, 1868, 886, 172, 1798, 1793, 1870, 1279, 1314,
+	1280, 1315, 1648, 1316, 921, 922, 919, 920, 859, 929,
+	858, 1306, 928, 934, 1869, 1304, 141, 1207, 148, 2135,
+	1204, 2083, 142, 143, 1605, 1499, 107, 158, 927, 823,
+	821, 1308, 108, 2256, 832, 831, 830, 163, 1977, 177,
+	1794, 829, 828, 492, 492, 827, 2084, 2227, 826, 825,
+	820, 796, 1305, 158, 182, 1197, 890, 833, 814, 182,
+	779, 779, 1796, 163, 777, 1791, 968, 965, 966, 967,
+	972, 974, 971, 108, 970, 779, 883, 1792, 111, 807,
+	808, 964, 935, 892, 2253, 1217, 1216, 1187, 1892, 619,
+	505, 505, 505, 493, 1581, 2186, 968, 965, 966, 967,
+	972, 974, 971, 936, 970, 914, 1522, 849, 505, 505,
+	1638, 964, 900, 814, 906, 907, 908, 909, 1669, 1871,
+	814, 1627, 824, 822, 814, 1319, 949, 843, 2178, 2191,
+	1827, 108, 1894, 1917, 938, 941, 1635, 1799, 1797, 1916,
+	1915, 814, 955, 150, 1653, 789, 492, 988, 987, 997,
+	998, 990, 991, 992, 993, 994, 995, 996, 989, 2152,
+	788, 999, 75, 787, 1849, 1721, 1723, 896, 889, 150,
+	785, 453, 1013, 1014, 1015, 1016, 1017, 1018, 1019, 1020,
+	1021, 1022, 1623, 2172, 1699, 182, 1290, 1289, 1291, 1292,
+	1293, 1011, 1012, 1696, 1896, 813, 1900, 144, 1895, 881,
+	1893, 817, 807, 1656, 1538, 1898, 2056, 1681, 1655, 138,
+	901, 818, 139, 1957, 1897, 1009, 505, 946, 947, 182,
+	1745, 182, 182, 814, 505, 1688, 1072, 1899, 1901, 819,
+	505, 1647, 1613, 2251, 1646, 1071, 2252, 913, 2250, 1527,
+	1095, 1656, 958, 956, 1027, 957, 1655, 1024, 1795, 915,
+	813, 895, 848, 999, 1780, 989, 925, 813, 999, 885,
+	1333, 813, 1722, 817, 807, 1477, 628, 1066, 807, 810,
+	811, 899, 779, 818, 1362, 893, 804, 808, 813, 878,
+	979, 879, 1085, 96, 880, 1100, 990, 991, 992, 993,
+	994, 995, 996, 989, 976, 803, 999, 1041, 1044, 1046,
+	1048, 1049, 1051, 1053, 1054, 1045, 1047, 1622, 1050, 1052,
+	979, 1055, 1063, 151, 156, 153, 159, 160, 161, 162,
+	164, 165, 166, 167, 1011, 1012, 2143, 835, 97, 168,
+	169, 170, 171, 1011, 1012, 1942, 1303, 1121, 959, 151,
+	156, 153, 159, 160, 161, 162, 164, 165, 166, 167,
+	184, 185, 186, 632, 1415, 168, 169, 170, 171, 884,
+	813, 1448, 184, 185, 186, 1910, 1806, 807, 810, 811,
+	1448, 779, 1706, 926, 182, 804, 808, 1334, 1179, 992,
+	993, 994, 995, 996, 989, 898, 1587, 999, 1191, 1192,
+	1193, 987, 997, 998, 990, 991, 992, 993, 994, 995,
+	996, 989, 1620, 505, 999, 1213, 1385, 1387, 1388, 1618,
+	1416, 823, 1073, 1222, 2242, 821, 1962, 1226, 1386, 1088,
+	505, 505, 1807, 505, 1397, 505, 505, 1223, 505, 505,
+	505, 505, 505, 505, 1615, 1195, 1196, 2071, 1395, 1396,
+	1394, 1209, 2243, 505, 1083, 978, 976, 182, 1262, 1673,
+	1674, 1675, 1257, 1258, 176, 1116, 1479, 1297, 1619, 1482,
+	1483, 1202, 979, 1275, 997, 998, 990, 991, 992, 993,
+	994, 995, 996, 989, 505, 1231, 999, 1232, 1221, 1234,
+	1236, 1259, 182, 1240, 1242, 1244, 1246, 1248, 2232, 2257,
+	1615, 618, 182, 1265, 1266, 1295, 182, 182, 73, 1271,
+	1272, 1285, 2070, 1219, 1219, 1694, 1185, 1220, 1983, 615,
+	1393, 1919, 182, 1693, 1617, 1815, 2233, 1296, 1814, 182,
+	1478, 1200, 1199, 1198, 1212, 1194, 182, 182, 182, 182,
+	182, 182, 182, 182, 182, 505, 505, 505, 977, 978,
+	976, 601, 977, 978, 976, 977, 978, 976, 1584, 623,
+	1298, 1335, 1336, 1283, 784, 1294, 979, 1337, 2258, 1920,
+	979, 1284, 182, 979, 1341, 1340, 1343, 1344, 1345, 1346,
+	1282, 1348, 1347, 1260, 1281, 1273, 516, 184, 185, 186,
+	1267, 1787, 2236, 620, 621, 1363, 1328, 988, 987, 997,
+	998, 990, 991, 992, 993, 994, 995, 996, 989, 1391,
+	1414, 999, 1264, 1263, 793, 1329, 792, 1320, 1238, 1417,
+	1070, 1325, 2235, 114, 184, 185, 186, 1695, 1597, 2234,
+	184, 185, 186, 505, 1595, 2223, 1389, 2221, 1339, 1398,
+	1399, 1400, 1401, 1402, 1403, 1404, 1405, 1406, 1407, 1408,
+	1409, 1410, 1411, 1412, 1436, 1439, 2106, 2068, 2044, 1816,
+	1449, 1418, 1419, 1965, 1429, 1430, 1921, 505, 505, 1824,
+	1812, 1358, 1359, 1360, 977, 978, 976, 1425, 182, 1664,
+	1373, 1431, 1912, 977, 978, 976, 1392, 977, 978, 976,
+	1631, 1630, 979, 505, 184, 185, 186, 1471, 1451, 1330,
+	182, 979, 1286, 505, 1274, 979, 2039, 182, 1426, 182,
+	1027, 1473, 977, 978, 976, 1270, 1269, 182, 182, 1268,
+	1069, 1455, 1456, 1864, 505, 1427, 601, 505, 1990, 2226,
+	979, 542, 541, 544, 545, 546, 547, 2133, 505, 2132,
+	543, 1472, 548, 184, 185, 186, 2003, 1276, 1425, 1517,
+	1841, 1484, 1428, 988, 987, 997, 998, 990, 991, 992,
+	993, 994, 995, 996, 989, 82, 628, 999, 84, 628,
+	1550, 1492, 1551, 1552, 1553, 1554, 1542, 1990, 2184, 1496,
+	1826, 1557, 1558, 1559, 1541, 1990, 2173, 1546, 1562, 1563,
+	1564, 1565, 1523, 505, 1990, 601, 1427, 182, 1990, 2141,
+	1741, 505, 2086, 601, 1523, 182, 1594, 1596, 1545, 1615,
+	601, 2054, 601, 1990, 1995, 1774, 1494, 1975, 1974, 505,
+	1971, 1972, 1578, 1522, 1572, 505, 1520, 1971, 1970, 1222,
+	1741, 1222, 1525, 1490, 601, 1490, 1529, 1522, 1858, 1614,
+	1182, 1843, 1836, 1837, 601, 1501, 1528, 1544, 1543, 36,
+	2033, 1502, 601, 632, 2073, 1524, 632, 1601, 975, 601,
+	1182, 1181, 1941, 1526, 1127, 1126, 1491, 1524, 2051, 505,
+	1502, 1414, 1930, 975, 1748, 1522, 1414, 1414, 36, 1432,
+	1433, 1941, 1583, 1438, 1441, 1442, 1616, 2142, 582, 1990,
+	1573, 1973, 1568, 1569, 1611, 1502, 1612, 1502, 1749, 1582,
+	1941, 1585, 1530, 2074, 2075, 2076, 1711, 1624, 36, 1454,
+	816, 182, 1457, 1458, 1710, 182, 182, 182, 182, 182,
+	1219, 1607, 815, 1610, 1573, 73, 1606, 2122, 1625, 182,
+	182, 182, 182, 1590, 1591, 1592, 182, 1490, 183, 1253,
+	1626, 183, 1615, 182, 183, 1628, 1629, 594, 1490, 506,
+	182, 183, 1522, 1615, 73, 1821, 1598, 1480, 1459, 183,
+	988, 987, 997, 998, 990, 991, 992, 993, 994, 995,
+	996, 989, 1371, 1318, 999, 182, 505, 1113, 798, 2038,
+	797, 2176, 506, 73, 73, 506, 183, 506, 1254, 1255,
+	1256, 1951, 1659, 1660, 2095, 2002, 2062, 1662, 1184, 1571,
+	1863, 1608, 1567, 1561, 1663, 1560, 988, 987, 997, 998,
+	990, 991, 992, 993, 994, 995, 996, 989, 1300, 1634,
+	999, 1391, 1214, 73, 1210, 1180, 988, 987, 997, 998,
+	990, 991, 992, 993, 994, 995, 996, 989, 980, 1651,
+	999, 98, 179, 1945, 1946, 2077, 1866, 2096, 1190, 2245,
+	1677, 1678, 1679, 1820, 2239, 1948, 1683, 1250, 1930, 1832,
+	1684, 1831, 1830, 183, 1588, 1364, 1321, 1950, 1765, 461,
+	182, 1691, 1692, 1766, 516, 1762, 1761, 1698, 182, 2229,
+	1701, 1702, 1667, 1037, 1767, 2210, 1511, 1512, 1708, 1676,
+	1709, 2078, 2079, 1712, 1713, 1714, 1715, 1716, 1392, 1821,
+	1763, 1690, 182, 1251, 1252, 1764, 1922, 1730, 1082, 1726,
+	1727, 2166, 2168, 182, 182, 182, 182, 182, 1077, 1080,
+	2169, 100, 1734, 1755, 2055, 182, 1689, 1993, 592, 182,
+	1739, 1738, 182, 182, 2195, 514, 182, 182, 182, 2231,
+	2216, 1750, 2194, 1705, 2214, 1746, 2198, 2163, 1317, 1786,
+	1728, 583, 1066, 1717, 105, 1770, 1771, 1825, 1729, 1725,
+	1444, 1772, 845, 844, 2014, 1820, 1743, 1805, 1075, 1877,
+	1733, 948, 1851, 454, 1850, 115, 1445, 1742, 1744, 2120,
+	1076, 1967, 1966, 1609, 1228, 1804, 1227, 1808, 1809, 1810,
+	1215, 1802, 1803, 2049, 1757, 1758, 1775, 1760, 1789, 182,
+	1777, 1768, 1773, 175, 1756, 1475, 457, 1759, 1828, 1781,
+	505, 1778, 1482, 1483, 1324, 2134, 505, 1578, 2090, 505,
+	1515, 1222, 1313, 1790, 1672, 1840, 505, 1737, 1328, 2222,
+	1823, 595, 596, 84, 598, 1736, 1844, 2220, 1855, 1813,
+	2219, 1507, 1510, 1511, 1512, 1508, 182, 1509, 1513, 2199,
+	2197, 1945, 1946, 1822, 2048, 1989, 1854, 1599, 599, 2047,
+	1925, 1741, 2247, 2246, 182, 1700, 1846, 1853, 1697, 1096,
+	1089, 1202, 2247, 1685, 1686, 1507, 1510, 1511, 1512, 1508,
+	2170, 1509, 1513, 1964, 1476, 594, 1845, 82, 87, 611,
+	607, 79, 1, 1852, 1703, 476, 588, 1426, 1460, 505,
+	1064, 488, 2237, 1287, 1277, 1414, 608, 2006, 2092, 1996,
+	1576, 806, 1873, 140, 1427, 1539, 1540, 1875, 2180, 95,
+	1876, 772, 1872, 94, 1885, 1886, 809, 912, 1890, 1086,
+	1087, 610, 1600, 609, 2087, 505, 1883, 1884, 1800, 1548,
+	1133, 1888, 183, 1889, 1131, 1881, 182, 183, 1132, 1887,
+	1130, 1904, 1905, 1135, 1906, 1907, 505, 1909, 1903, 1134,
+	1129, 1365, 505, 505, 502, 1913, 1914, 1514, 180, 1931,
+	1755, 1122, 1090, 846, 1902, 466, 1934, 1976, 506, 506,
+	506, 1361, 1632, 472, 1007, 182, 611, 607, 1735, 1918,
+	1937, 1782, 629, 622, 1936, 2192, 506, 506, 1888, 2162,
+	2164, 1940, 2116, 608, 2167, 2160, 2230, 2213, 1547, 1474,
+	1078, 1952, 2046, 1924, 1704, 1949, 1928, 1939, 1036, 1331,
+	1446, 1953, 1105, 1955, 525, 1956, 604, 605, 610, 1470,
+	609, 1384, 1968, 1969, 540, 537, 1984, 538, 182, 1485,
+	1747, 182, 182, 182, 981, 523, 1961, 505, 1963, 1954,
+	517, 1097, 1506, 1504, 1503, 1322, 1109, 1947, 1943, 1103,
+	182, 1992, 1489, 1637, 1860, 1980, 960, 603, 99, 1443,
+	1979, 1981, 1982, 183, 1997, 2150, 1671, 2007, 505, 505,
+	505, 2035, 182, 602, 1578, 1991, 62, 39, 1994, 509,
+	2206, 2015, 951, 612, 2000, 1380, 1381, 1382, 1383, 32,
+	31, 1999, 30, 29, 506, 28, 23, 183, 22, 183,
+	183, 21, 506, 20, 19, 33, 25, 18, 506, 17,
+	16, 110, 49, 46, 44, 117, 2017, 116, 2020, 2021,
+	2019, 2022, 2012, 2013, 2024, 47, 2026, 2016, 43, 887,
+	27, 2028, 2029, 2023, 26, 15, 14, 13, 12, 11,
+	1434, 1435, 10, 9, 5, 4, 954, 2043, 24, 2018,
+	2045, 1025, 2, 1755, 0, 2050, 0, 0, 0, 0,
+	0, 0, 0, 0, 2052, 2053, 2059, 0, 2057, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 516, 0,
+	0, 0, 0, 0, 2058, 2066, 0, 0, 0, 0,
+	505, 505, 2065, 0, 0, 0, 0, 2064, 0, 0,
+	2067, 0, 2069, 505, 0, 0, 505, 2081, 2080, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	2091, 0, 2099, 0, 0, 2085, 0, 0, 0, 0,
+	0, 1536, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 505, 505, 505, 182, 0, 2097, 0, 2094, 0,
+	0, 2098, 183, 0, 0, 505, 0, 505, 2109, 2111,
+	2112, 2121, 0, 505, 552, 2113, 0, 2119, 1934, 2110,
+	0, 0, 1934, 0, 2114, 2100, 2101, 2102, 2103, 2104,
+	2128, 506, 2125, 2107, 2108, 182, 2123, 2130, 0, 2131,
+	1574, 0, 0, 0, 0, 2105, 505, 182, 506, 506,
+	0, 506, 2137, 506, 506, 0, 506, 506, 506, 506,
+	506, 506, 0, 2144, 181, 0, 0, 460, 2127, 0,
+	500, 506, 0, 2140, 2129, 183, 2159, 460, 0, 2146,
+	2147, 2148, 2149, 0, 2153, 460, 2154, 2155, 2156, 1934,
+	2157, 2158, 0, 505, 505, 0, 0, 0, 0, 2171,
+	0, 0, 506, 616, 616, 554, 35, 2179, 0, 0,
+	183, 0, 460, 0, 0, 0, 0, 2174, 0, 2189,
+	183, 0, 0, 505, 183, 183, 0, 505, 2196, 2185,
+	2202, 2200, 1755, 0, 0, 2094, 2181, 0, 0, 0,
+	183, 35, 2209, 2032, 2205, 2218, 2217, 183, 0, 0,
+	0, 184, 185, 186, 183, 183, 183, 183, 183, 183,
+	183, 183, 183, 506, 506, 506, 2228, 0, 0, 0,
+	2203, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2224, 2225, 0, 0, 0, 593, 0, 460,
+	183, 2244, 0, 0, 0, 0, 0, 0, 0, 2254,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 481, 0, 0, 0, 0, 983, 0, 986, 0,
+	480, 0, 0, 0, 1000, 1001, 1002, 1003, 1004, 1005,
+	1006, 478, 984, 985, 982, 988, 987, 997, 998, 990,
+	991, 992, 993, 994, 995, 996, 989, 0, 0, 999,
+	0, 506, 0, 988, 987, 997, 998, 990, 991, 992,
+	993, 994, 995, 996, 989, 0, 0, 999, 0, 0,
+	475, 0, 2031, 0, 0, 0, 0, 0, 0, 487,
+	0, 0, 0, 0, 0, 506, 506, 0, 2030, 0,
+	0, 0, 0, 0, 0, 0, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1707, 0,
+	0, 506, 0, 0, 0, 0, 0, 0, 183, 0,
+	0, 506, 0, 0, 0, 183, 493, 183, 0, 0,
+	0, 0, 0, 0, 0, 183, 183, 0, 1731, 1732,
+	1080, 0, 506, 0, 0, 506, 0, 0, 0, 0,
+	0, 0, 0, 465, 467, 468, 506, 484, 486, 494,
+	0, 551, 0, 482, 483, 495, 469, 470, 499, 498,
+	485, 0, 474, 471, 473, 479, 0, 0, 0, 492,
+	477, 496, 988, 987, 997, 998, 990, 991, 992, 993,
+	994, 995, 996, 989, 0, 0, 999, 0, 988, 987,
+	997, 998, 990, 991, 992, 993, 994, 995, 996, 989,
+	0, 506, 999, 0, 0, 183, 0, 0, 0, 506,
+	0, 0, 504, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 506, 0, 0,
+	0, 0, 0, 506, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1882, 630, 0, 0, 776, 0,
+	783, 0, 0, 0, 0, 0, 0, 0, 460, 1682,
+	0, 0, 0, 460, 988, 987, 997, 998, 990, 991,
+	992, 993, 994, 995, 996, 989, 0, 506, 999, 988,
+	987, 997, 998, 990, 991, 992, 993, 994, 995, 996,
+	989, 0, 0, 999, 0, 497, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 490, 0, 0, 0, 0, 0, 183,
+	0, 0, 0, 183, 183, 183, 183, 183, 491, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 183, 183,
+	183, 0, 0, 0, 183, 0, 0, 0, 0, 0,
+	0, 183, 0, 0, 0, 0, 1911, 0, 183, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 506, 943, 943, 943, 0, 460,
+	0, 1926, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 35, 0, 0, 616, 0,
+	0, 0, 0, 0, 0, 0, 1008, 1010, 0, 0,
+	0, 0, 0, 460, 0, 460, 1112, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1023, 0, 0,
+	0, 1028, 1029, 1030, 1031, 1032, 1033, 1034, 1035, 0,
+	1038, 1040, 1043, 1043, 1043, 1040, 1043, 1043, 1040, 1043,
+	1056, 1057, 1058, 1059, 1060, 1061, 1062, 0, 183, 0,
+	0, 0, 1068, 0, 0, 0, 183, 0, 0, 35,
+	0, 0, 0, 0, 0, 173, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	183, 0, 0, 0, 0, 0, 1106, 0, 0, 0,
+	115, 183, 183, 183, 183, 183, 0, 0, 0, 0,
+	0, 0, 157, 183, 0, 0, 0, 183, 0, 0,
+	183, 183, 0, 0, 183, 183, 183, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 2037, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 460, 0,
+	0, 0, 0, 0, 0, 516, 154, 0, 155, 0,
+	0, 0, 2060, 0, 0, 2061, 0, 172, 2063, 0,
+	0, 0, 0, 0, 0, 0, 0, 183, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 506, 0,
+	1225, 0, 0, 0, 506, 0, 0, 506, 0, 0,
+	0, 0, 0, 0, 506, 0, 0, 0, 0, 0,
+	0, 630, 630, 630, 0, 1225, 1225, 0, 0, 0,
+	0, 460, 0, 0, 183, 0, 158, 0, 0, 950,
+	952, 0, 0, 0, 0, 0, 163, 0, 0, 0,
+	0, 0, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 460, 0, 0, 0,
+	0, 0, 0, 0, 1067, 0, 460, 2118, 516, 0,
+	1327, 460, 0, 0, 0, 0, 0, 506, 0, 0,
+	0, 0, 0, 0, 0, 0, 460, 0, 0, 0,
+	0, 0, 0, 460, 0, 0, 0, 0, 0, 0,
+	1349, 1350, 460, 460, 460, 460, 460, 460, 460, 0,
+	0, 0, 0, 506, 0, 0, 0, 459, 0, 0,
+	0, 0, 0, 0, 183, 0, 0, 508, 0, 0,
+	0, 0, 0, 0, 506, 586, 460, 1093, 0, 0,
+	506, 506, 150, 0, 0, 630, 0, 0, 0, 0,
+	0, 1123, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 780, 183, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 616, 1327,
+	943, 943, 943, 616, 616, 0, 0, 616, 616, 616,
+	0, 0, 0, 1225, 0, 0, 183, 0, 0, 183,
+	183, 183, 0, 0, 0, 506, 0, 0, 0, 0,
+	0, 0, 0, 616, 616, 616, 616, 616, 183, 875,
+	0, 0, 1468, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 506, 506, 506, 0,
+	183, 0, 0, 0, 460, 0, 0, 0, 0, 0,
+	1327, 460, 0, 460, 0, 0, 0, 0, 0, 0,
+	0, 460, 460, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 151, 156, 153, 159, 160, 161, 162, 164,
+	165, 166, 167, 0, 776, 0, 0, 0, 168, 169,
+	170, 171, 0, 0, 0, 0, 0, 1224, 0, 0,
+	0, 1230, 1230, 0, 1230, 0, 1230, 1230, 0, 1239,
+	1230, 1230, 1230, 1230, 1230, 0, 0, 0, 0, 0,
+	0, 460, 1224, 1224, 776, 0, 0, 0, 0, 1593,
+	0, 0, 1518, 0, 0, 0, 0, 0, 506, 506,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 506, 0, 0, 506, 1299, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 506,
+	506, 506, 183, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 506, 0, 506, 0, 0, 0, 0,
+	0, 506, 0, 0, 0, 0, 630, 630, 630, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 183, 0, 460, 0, 0, 0, 460,
+	460, 460, 460, 460, 506, 183, 0, 0, 0, 0,
+	0, 0, 0, 460, 460, 460, 460, 0, 0, 0,
+	1657, 0, 0, 0, 0, 0, 0, 460, 0, 0,
+	0, 0, 0, 0, 460, 0, 0, 0, 888, 0,
+	0, 0, 0, 894, 0, 0, 0, 0, 0, 0,
+	0, 506, 506, 0, 0, 0, 0, 0, 0, 460,
+	0, 0, 0, 0, 1420, 0, 630, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	1224, 506, 0, 0, 0, 506, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1452, 1453,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 616, 616, 0,
+	0, 0, 0, 0, 1486, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1093, 0, 0, 630, 616, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 460, 630, 0, 0, 630, 0,
+	0, 0, 1468, 0, 0, 0, 0, 0, 0, 776,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 616, 460, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1225, 460, 460, 460,
+	460, 460, 0, 1099, 0, 0, 1110, 0, 0, 1769,
+	0, 1687, 0, 460, 593, 0, 460, 460, 0, 0,
+	460, 1779, 1327, 0, 783, 0, 0, 0, 0, 0,
+	0, 0, 1589, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	776, 1724, 0, 0, 0, 0, 783, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 173, 0,
+	0, 0, 0, 0, 0, 0, 0, 1106, 0, 0,
+	0, 0, 0, 460, 1751, 1752, 0, 0, 1106, 1106,
+	1106, 1106, 1106, 115, 0, 137, 0, 0, 1225, 0,
+	776, 0, 0, 0, 1518, 157, 0, 1106, 1327, 0,
+	0, 1106, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	460, 0, 0, 0, 0, 0, 147, 0, 0, 0,
+	0, 136, 0, 0, 0, 0, 0, 0, 460, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 1128, 154,
+	0, 155, 0, 0, 0, 0, 124, 125, 146, 145,
+	172, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 616, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1848, 0, 0, 0, 0, 1666, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 141, 122,
+	148, 129, 121, 0, 142, 143, 0, 0, 0, 158,
+	460, 1261, 0, 0, 0, 0, 0, 0, 0, 163,
+	130, 0, 0, 1225, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 133, 131, 126, 127, 128, 132,
+	0, 0, 0, 0, 123, 0, 1309, 0, 0, 460,
+	0, 0, 0, 134, 0, 0, 1323, 0, 0, 0,
+	0, 1110, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 1338, 0, 0, 0,
+	0, 0, 0, 1342, 0, 0, 0, 0, 0, 0,
+	0, 0, 1351, 1352, 1353, 1354, 1355, 1356, 1357, 0,
+	0, 0, 460, 0, 0, 460, 460, 460, 0, 0,
+	0, 0, 0, 0, 1225, 1935, 0, 35, 0, 0,
+	0, 0, 0, 1224, 460, 150, 1110, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 458, 152, 0, 153, 0, 0, 0, 0,
-	1199, 1200, 144, 143, 170, 0, 0, 0, 0, 0,
+	1106, 0, 0, 0, 0, 0, 460, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 148, 0, 0,
-	0, 0, 0, 0, 0, 1255, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 458, 0, 0, 458, 458,
-	458, 0, 139, 1201, 146, 0, 1198, 1219, 140, 141,
-	0, 0, 0, 156, 0, 0, 0, 458, 0, 0,
-	1303, 0, 0, 161, 0, 0, 0, 0, 1218, 0,
-	1317, 142, 0, 0, 0, 0, 0, 0, 0, 458,
-	0, 0, 0, 136, 0, 0, 137, 0, 0, 0,
-	1331, 0, 0, 0, 0, 0, 0, 1335, 0, 0,
-	0, 0, 0, 0, 0, 0, 1344, 1345, 1346, 1347,
-	1348, 1349, 1350, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 144,
+	0, 0, 0, 0, 0, 0, 0, 173, 0, 0,
+	0, 138, 0, 0, 139, 0, 0, 0, 1201, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1104, 0, 0, 0, 0, 0, 0, 0, 0, 1219,
+	0, 0, 115, 0, 137, 0, 1225, 0, 0, 0,
+	0, 1835, 0, 0, 157, 1224, 0, 1842, 0, 0,
+	1835, 0, 0, 0, 0, 630, 0, 1847, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1828, 0, 0, 148,
-	1218, 0, 1835, 0, 0, 1828, 0, 0, 0, 0,
-	625, 0, 1840, 0, 0, 0, 0, 149, 154, 151,
-	157, 158, 159, 160, 162, 163, 164, 165, 0, 0,
-	0, 0, 0, 166, 167, 168, 169, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 142, 0, 0, 0, 0, 0, 938,
-	938, 938, 0, 0, 0, 136, 0, 0, 137, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1461, 0, 0, 0, 625, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1486, 0, 0,
-	0, 0, 0, 0, 1490, 0, 1493, 0, 0, 0,
-	0, 0, 0, 0, 0, 1512, 0, 0, 0, 0,
-	0, 1224, 458, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 458, 0, 0, 0, 0, 0,
-	0, 0, 625, 0, 0, 1218, 0, 0, 1931, 1224,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 149,
-	154, 151, 157, 158, 159, 160, 162, 163, 164, 165,
-	0, 0, 0, 0, 0, 166, 167, 168, 169, 0,
-	0, 0, 0, 0, 1579, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1219, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1511, 0, 0, 771, 0, 0, 1218, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 2001, 2002, 2003, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1104, 0,
-	0, 0, 1634, 1635, 1636, 1637, 1638, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1642, 1643, 1104, 1645,
-	0, 0, 0, 0, 0, 0, 0, 0, 1218, 1651,
-	0, 0, 0, 0, 0, 0, 1654, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1658, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1828, 2075, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1828,
-	0, 0, 625, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1828, 1828, 1828,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2117, 0, 2119, 0, 0, 0, 0, 0, 1828,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1828, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1769,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 625,
-	625, 0, 0, 0, 0, 0, 0, 0, 1680, 0,
-	0, 588, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1218, 0, 2194,
-	0, 0, 0, 1828, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 1717, 0,
-	0, 0, 0, 0, 0, 1822, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1100, 0, 0, 0, 0, 0,
-	0, 1744, 1745, 0, 0, 1100, 1100, 1100, 1100, 1100,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 1511, 1852, 0, 1100, 0, 0, 0, 1100, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	1867, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1841,
-	0, 0, 1916, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1978, 0, 0, 1979, 1980, 1981,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 1991, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 1928, 0, 34, 0, 0, 0, 2004, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 1100, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2027, 0, 0, 0, 0, 0, 0, 2033, 2034,
-	2035, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 2129, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 2138, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 1928, 0, 34, 0, 1928, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 34, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 1928, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 750, 737, 34, 2168, 686,
-	753, 657, 675, 762, 677, 680, 720, 636, 699, 327,
-	672, 0, 661, 632, 668, 633, 659, 688, 237, 692,
-	656, 739, 702, 752, 285, 0, 638, 662, 341, 722,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 759, 289, 709, 0, 388, 312,
-	0, 0, 0, 690, 742, 697, 733, 685, 721, 646,
-	708, 754, 673, 717, 755, 275, 221, 190, 324, 389,
-	251, 0, 0, 0, 182, 183, 184, 0, 2175, 2176,
-	0, 0, 0, 0, 0, 212, 0, 219, 714, 749,
-	670, 716, 233, 273, 239, 232, 404, 719, 765, 631,
-	711, 0, 634, 637, 761, 745, 665, 666, 0, 0,
-	0, 0, 0, 0, 0, 689, 698, 730, 683, 0,
-	0, 0, 0, 0, 0, 0, 0, 663, 0, 707,
-	0, 0, 0, 642, 635, 0, 0, 0, 0, 687,
-	0, 0, 0, 645, 0, 664, 731, 0, 629, 259,
-	639, 313, 0, 735, 744, 684, 435, 748, 682, 681,
-	751, 726, 643, 741, 676, 284, 641, 281, 186, 201,
-	0, 674, 323, 362, 368, 740, 660, 669, 224, 667,
-	366, 337, 421, 208, 249, 359, 342, 364, 706, 724,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 940,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 655, 736, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 728, 764, 336,
-	367, 214, 423, 387, 650, 654, 648, 649, 700, 701,
-	651, 756, 757, 758, 732, 644, 0, 652, 653, 0,
-	738, 746, 747, 705, 185, 198, 287, 760, 356, 252,
-	448, 430, 426, 630, 647, 230, 658, 0, 0, 671,
-	678, 679, 691, 693, 694, 695, 696, 704, 712, 713,
-	715, 723, 725, 727, 729, 734, 743, 763, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 703,
-	710, 297, 246, 263, 272, 718, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 750, 737, 0, 0, 686, 753,
-	657, 675, 762, 677, 680, 720, 636, 699, 327, 672,
-	0, 661, 632, 668, 633, 659, 688, 237, 692, 656,
-	739, 702, 752, 285, 0, 638, 662, 341, 722, 379,
-	223, 294, 292, 407, 247, 240, 236, 222, 269, 300,
-	339, 397, 333, 759, 289, 709, 0, 388, 312, 0,
-	0, 0, 690, 742, 697, 733, 685, 721, 646, 708,
-	754, 673, 717, 755, 275, 221, 190, 324, 389, 251,
-	0, 0, 0, 182, 183, 184, 0, 0, 0, 0,
-	0, 0, 0, 0, 212, 0, 219, 714, 749, 670,
-	716, 233, 273, 239, 232, 404, 719, 765, 631, 711,
-	0, 634, 637, 761, 745, 665, 666, 0, 0, 0,
-	0, 0, 0, 0, 689, 698, 730, 683, 0, 0,
-	0, 0, 0, 0, 1920, 0, 663, 0, 707, 0,
-	0, 0, 642, 635, 0, 0, 0, 0, 687, 0,
-	0, 0, 645, 0, 664, 731, 0, 629, 259, 639,
-	313, 0, 735, 744, 684, 435, 748, 682, 681, 751,
-	726, 643, 741, 676, 284, 641, 281, 186, 201, 0,
-	674, 323, 362, 368, 740, 660, 669, 224, 667, 366,
-	337, 421, 208, 249, 359, 342, 364, 706, 724, 365,
-	290, 409, 354, 419, 436, 437, 231, 317, 427, 401,
-	433, 447, 202, 228, 331, 394, 424, 385, 310, 405,
-	406, 280, 384, 257, 189, 288, 444, 200, 374, 216,
-	193, 396, 417, 213, 377, 0, 0, 0, 195, 415,
-	393, 307, 277, 278, 194, 0, 358, 235, 255, 226,
-	326, 412, 413, 225, 449, 204, 432, 197, 940, 431,
-	319, 408, 416, 308, 299, 196, 414, 306, 298, 283,
-	245, 265, 352, 293, 353, 266, 315, 314, 316, 0,
-	191, 0, 390, 425, 450, 210, 655, 736, 403, 441,
-	446, 0, 355, 211, 256, 244, 351, 254, 286, 440,
-	442, 443, 445, 209, 349, 262, 330, 420, 248, 428,
-	318, 205, 268, 386, 282, 291, 728, 764, 336, 367,
-	214, 423, 387, 650, 654, 648, 649, 700, 701, 651,
-	756, 757, 758, 732, 644, 0, 652, 653, 0, 738,
-	746, 747, 705, 185, 198, 287, 760, 356, 252, 448,
-	430, 426, 630, 647, 230, 658, 0, 0, 671, 678,
-	679, 691, 693, 694, 695, 696, 704, 712, 713, 715,
-	723, 725, 727, 729, 734, 743, 763, 187, 188, 199,
-	207, 217, 229, 242, 250, 260, 264, 267, 270, 271,
-	274, 279, 296, 301, 302, 303, 304, 320, 321, 322,
-	325, 328, 329, 332, 334, 335, 338, 344, 345, 346,
-	347, 348, 350, 357, 361, 369, 370, 371, 372, 373,
-	375, 376, 380, 381, 382, 383, 391, 395, 410, 411,
-	422, 434, 438, 261, 418, 439, 0, 295, 703, 710,
-	297, 246, 263, 272, 718, 429, 392, 203, 363, 253,
-	192, 220, 206, 227, 241, 243, 276, 305, 311, 340,
-	343, 258, 238, 218, 360, 215, 378, 398, 399, 400,
-	402, 309, 234, 750, 737, 0, 0, 686, 753, 657,
-	675, 762, 677, 680, 720, 636, 699, 327, 672, 0,
-	661, 632, 668, 633, 659, 688, 237, 692, 656, 739,
-	702, 752, 285, 0, 638, 662, 341, 722, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 759, 289, 709, 0, 388, 312, 0, 0,
-	0, 690, 742, 697, 733, 685, 721, 646, 708, 754,
-	673, 717, 755, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 714, 749, 670, 716,
-	233, 273, 239, 232, 404, 719, 765, 631, 711, 0,
-	634, 637, 761, 745, 665, 666, 0, 0, 0, 0,
-	0, 0, 0, 689, 698, 730, 683, 0, 0, 0,
-	0, 0, 0, 1773, 0, 663, 0, 707, 0, 0,
-	0, 642, 635, 0, 0, 0, 0, 687, 0, 0,
-	0, 645, 0, 664, 731, 0, 629, 259, 639, 313,
-	0, 735, 744, 684, 435, 748, 682, 681, 751, 726,
-	643, 741, 676, 284, 641, 281, 186, 201, 0, 674,
-	323, 362, 368, 740, 660, 669, 224, 667, 366, 337,
-	421, 208, 249, 359, 342, 364, 706, 724, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 940, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 655, 736, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 728, 764, 336, 367, 214,
-	423, 387, 650, 654, 648, 649, 700, 701, 651, 756,
-	757, 758, 732, 644, 0, 652, 653, 0, 738, 746,
-	747, 705, 185, 198, 287, 760, 356, 252, 448, 430,
-	426, 630, 647, 230, 658, 0, 0, 671, 678, 679,
-	691, 693, 694, 695, 696, 704, 712, 713, 715, 723,
-	725, 727, 729, 734, 743, 763, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 703, 710, 297,
-	246, 263, 272, 718, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 750, 737, 0, 0, 686, 753, 657, 675,
-	762, 677, 680, 720, 636, 699, 327, 672, 0, 661,
-	632, 668, 633, 659, 688, 237, 692, 656, 739, 702,
-	752, 285, 0, 638, 662, 341, 722, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 759, 289, 709, 0, 388, 312, 0, 0, 0,
-	690, 742, 697, 733, 685, 721, 646, 708, 754, 673,
-	717, 755, 275, 221, 190, 324, 389, 251, 0, 0,
-	0, 182, 183, 184, 0, 0, 0, 0, 0, 0,
-	0, 0, 212, 0, 219, 714, 749, 670, 716, 233,
-	273, 239, 232, 404, 719, 765, 631, 711, 0, 634,
-	637, 761, 745, 665, 666, 0, 0, 0, 0, 0,
-	0, 0, 689, 698, 730, 683, 0, 0, 0, 0,
-	0, 0, 1488, 0, 663, 0, 707, 0, 0, 0,
-	642, 635, 0, 0, 0, 0, 687, 0, 0, 0,
-	645, 0, 664, 731, 0, 629, 259, 639, 313, 0,
-	735, 744, 684, 435, 748, 682, 681, 751, 726, 643,
-	741, 676, 284, 641, 281, 186, 201, 0, 674, 323,
-	362, 368, 740, 660, 669, 224, 667, 366, 337, 421,
-	208, 249, 359, 342, 364, 706, 724, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 940, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 655, 736, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 728, 764, 336, 367, 214, 423,
-	387, 650, 654, 648, 649, 700, 701, 651, 756, 757,
-	758, 732, 644, 0, 652, 653, 0, 738, 746, 747,
-	705, 185, 198, 287, 760, 356, 252, 448, 430, 426,
-	630, 647, 230, 658, 0, 0, 671, 678, 679, 691,
-	693, 694, 695, 696, 704, 712, 713, 715, 723, 725,
-	727, 729, 734, 743, 763, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 703, 710, 297, 246,
-	263, 272, 718, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 750, 737, 0, 0, 686, 753, 657, 675, 762,
-	677, 680, 720, 636, 699, 327, 672, 0, 661, 632,
-	668, 633, 659, 688, 237, 692, 656, 739, 702, 752,
-	285, 0, 638, 662, 341, 722, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	759, 289, 709, 0, 388, 312, 0, 0, 0, 690,
-	742, 697, 733, 685, 721, 646, 708, 754, 673, 717,
-	755, 275, 221, 190, 324, 389, 251, 71, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 714, 749, 670, 716, 233, 273,
-	239, 232, 404, 719, 765, 631, 711, 0, 634, 637,
-	761, 745, 665, 666, 0, 0, 0, 0, 0, 0,
-	0, 689, 698, 730, 683, 0, 0, 0, 0, 0,
-	0, 0, 0, 663, 0, 707, 0, 0, 0, 642,
-	635, 0, 0, 0, 0, 687, 0, 0, 0, 645,
-	0, 664, 731, 0, 629, 259, 639, 313, 0, 735,
-	744, 684, 435, 748, 682, 681, 751, 726, 643, 741,
-	676, 284, 641, 281, 186, 201, 0, 674, 323, 362,
-	368, 740, 660, 669, 224, 667, 366, 337, 421, 208,
-	249, 359, 342, 364, 706, 724, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 940, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 655, 736, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 728, 764, 336, 367, 214, 423, 387,
-	650, 654, 648, 649, 700, 701, 651, 756, 757, 758,
-	732, 644, 0, 652, 653, 0, 738, 746, 747, 705,
-	185, 198, 287, 760, 356, 252, 448, 430, 426, 630,
-	647, 230, 658, 0, 0, 671, 678, 679, 691, 693,
-	694, 695, 696, 704, 712, 713, 715, 723, 725, 727,
-	729, 734, 743, 763, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 703, 710, 297, 246, 263,
-	272, 718, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	750, 737, 0, 0, 686, 753, 657, 675, 762, 677,
-	680, 720, 636, 699, 327, 672, 0, 661, 632, 668,
-	633, 659, 688, 237, 692, 656, 739, 702, 752, 285,
-	0, 638, 662, 341, 722, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 759,
-	289, 709, 0, 388, 312, 0, 0, 0, 690, 742,
-	697, 733, 685, 721, 646, 708, 754, 673, 717, 755,
-	275, 221, 190, 324, 389, 251, 0, 0, 0, 182,
-	183, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 0, 219, 714, 749, 670, 716, 233, 273, 239,
-	232, 404, 719, 765, 631, 711, 0, 634, 637, 761,
-	745, 665, 666, 0, 0, 0, 0, 0, 0, 0,
-	689, 698, 730, 683, 0, 0, 0, 0, 0, 0,
-	0, 0, 663, 0, 707, 0, 0, 0, 642, 635,
-	0, 0, 0, 0, 687, 0, 0, 0, 645, 0,
-	664, 731, 0, 629, 259, 639, 313, 0, 735, 744,
-	684, 435, 748, 682, 681, 751, 726, 643, 741, 676,
-	284, 641, 281, 186, 201, 0, 674, 323, 362, 368,
-	740, 660, 669, 224, 667, 366, 337, 421, 208, 249,
-	359, 342, 364, 706, 724, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 940, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 655, 736, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 728, 764, 336, 367, 214, 423, 387, 650,
-	654, 648, 649, 700, 701, 651, 756, 757, 758, 732,
-	644, 0, 652, 653, 0, 738, 746, 747, 705, 185,
-	198, 287, 760, 356, 252, 448, 430, 426, 630, 647,
-	230, 658, 0, 0, 671, 678, 679, 691, 693, 694,
-	695, 696, 704, 712, 713, 715, 723, 725, 727, 729,
-	734, 743, 763, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 703, 710, 297, 246, 263, 272,
-	718, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 750,
-	737, 0, 0, 686, 753, 657, 675, 762, 677, 680,
-	720, 636, 699, 327, 672, 0, 661, 632, 668, 633,
-	659, 688, 237, 692, 656, 739, 702, 752, 285, 0,
-	638, 662, 341, 722, 379, 223, 294, 292, 407, 247,
-	240, 236, 222, 269, 300, 339, 397, 333, 759, 289,
-	709, 0, 388, 312, 0, 0, 0, 690, 742, 697,
-	733, 685, 721, 646, 708, 754, 673, 717, 755, 275,
-	221, 190, 324, 389, 251, 0, 0, 0, 182, 183,
-	184, 0, 0, 0, 0, 0, 0, 0, 0, 212,
-	0, 219, 714, 749, 670, 716, 233, 273, 239, 232,
-	404, 719, 765, 631, 711, 0, 634, 637, 761, 745,
-	665, 666, 0, 0, 0, 0, 0, 0, 0, 689,
-	698, 730, 683, 0, 0, 0, 0, 0, 0, 0,
-	0, 663, 0, 707, 0, 0, 0, 642, 635, 0,
-	0, 0, 0, 687, 0, 0, 0, 645, 0, 664,
-	731, 0, 629, 259, 639, 313, 0, 735, 744, 684,
-	435, 748, 682, 681, 751, 726, 643, 741, 676, 284,
-	641, 281, 186, 201, 0, 674, 323, 362, 368, 740,
-	660, 669, 224, 667, 366, 337, 421, 208, 249, 359,
-	342, 364, 706, 724, 365, 290, 409, 354, 419, 436,
-	437, 231, 317, 427, 401, 433, 447, 202, 228, 331,
-	394, 424, 385, 310, 405, 406, 280, 384, 257, 189,
-	288, 444, 200, 374, 216, 193, 396, 417, 213, 377,
-	0, 0, 0, 195, 415, 393, 307, 277, 278, 194,
-	0, 358, 235, 255, 226, 326, 412, 413, 225, 449,
-	204, 432, 197, 640, 431, 319, 408, 416, 308, 299,
-	196, 414, 306, 298, 283, 245, 265, 352, 293, 353,
-	266, 315, 314, 316, 0, 191, 0, 390, 425, 450,
-	210, 655, 736, 403, 441, 446, 0, 355, 211, 256,
-	244, 351, 254, 286, 440, 442, 443, 445, 209, 349,
-	262, 330, 420, 248, 428, 628, 766, 622, 621, 282,
-	291, 728, 764, 336, 367, 214, 423, 387, 650, 654,
-	648, 649, 700, 701, 651, 756, 757, 758, 732, 644,
-	0, 652, 653, 0, 738, 746, 747, 705, 185, 198,
-	287, 760, 356, 252, 448, 430, 426, 630, 647, 230,
-	658, 0, 0, 671, 678, 679, 691, 693, 694, 695,
-	696, 704, 712, 713, 715, 723, 725, 727, 729, 734,
-	743, 763, 187, 188, 199, 207, 217, 229, 242, 250,
-	260, 264, 267, 270, 271, 274, 279, 296, 301, 302,
-	303, 304, 320, 321, 322, 325, 328, 329, 332, 334,
-	335, 338, 344, 345, 346, 347, 348, 350, 357, 361,
-	369, 370, 371, 372, 373, 375, 376, 380, 381, 382,
-	383, 391, 395, 410, 411, 422, 434, 438, 261, 418,
-	439, 0, 295, 703, 710, 297, 246, 263, 272, 718,
-	429, 392, 203, 363, 253, 192, 220, 206, 227, 241,
-	243, 276, 305, 311, 340, 343, 258, 238, 218, 360,
-	215, 378, 398, 399, 400, 402, 309, 234, 750, 737,
-	0, 0, 686, 753, 657, 675, 762, 677, 680, 720,
-	636, 699, 327, 672, 0, 661, 632, 668, 633, 659,
-	688, 237, 692, 656, 739, 702, 752, 285, 0, 638,
-	662, 341, 722, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 759, 289, 709,
-	0, 388, 312, 0, 0, 0, 690, 742, 697, 733,
-	685, 721, 646, 708, 754, 673, 717, 755, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 714, 749, 670, 716, 233, 273, 239, 232, 404,
-	719, 765, 631, 711, 0, 634, 637, 761, 745, 665,
-	666, 0, 0, 0, 0, 0, 0, 0, 689, 698,
-	730, 683, 0, 0, 0, 0, 0, 0, 0, 0,
-	663, 0, 707, 0, 0, 0, 642, 635, 0, 0,
-	0, 0, 687, 0, 0, 0, 645, 0, 664, 731,
-	0, 629, 259, 639, 313, 0, 735, 744, 684, 435,
-	748, 682, 681, 751, 726, 643, 741, 676, 284, 641,
-	281, 186, 201, 0, 674, 323, 362, 368, 740, 660,
-	669, 224, 667, 366, 337, 421, 208, 249, 359, 342,
-	364, 706, 724, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 1108, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 640, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	655, 736, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 628, 766, 622, 621, 282, 291,
-	728, 764, 336, 367, 214, 423, 387, 650, 654, 648,
-	649, 700, 701, 651, 756, 757, 758, 732, 644, 0,
-	652, 653, 0, 738, 746, 747, 705, 185, 198, 287,
-	760, 356, 252, 448, 430, 426, 630, 647, 230, 658,
-	0, 0, 671, 678, 679, 691, 693, 694, 695, 696,
-	704, 712, 713, 715, 723, 725, 727, 729, 734, 743,
-	763, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 703, 710, 297, 246, 263, 272, 718, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 750, 737, 0,
-	0, 686, 753, 657, 675, 762, 677, 680, 720, 636,
-	699, 327, 672, 0, 661, 632, 668, 633, 659, 688,
-	237, 692, 656, 739, 702, 752, 285, 0, 638, 662,
-	341, 722, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 759, 289, 709, 0,
-	388, 312, 0, 0, 0, 690, 742, 697, 733, 685,
-	721, 646, 708, 754, 673, 717, 755, 275, 221, 190,
-	324, 389, 251, 0, 0, 0, 182, 183, 184, 0,
-	0, 0, 0, 0, 0, 0, 0, 212, 0, 219,
-	714, 749, 670, 716, 233, 273, 239, 232, 404, 719,
-	765, 631, 711, 0, 634, 637, 761, 745, 665, 666,
-	0, 0, 0, 0, 0, 0, 0, 689, 698, 730,
-	683, 0, 0, 0, 0, 0, 0, 0, 0, 663,
-	0, 707, 0, 0, 0, 642, 635, 0, 0, 0,
-	0, 687, 0, 0, 0, 645, 0, 664, 731, 0,
-	629, 259, 639, 313, 0, 735, 744, 684, 435, 748,
-	682, 681, 751, 726, 643, 741, 676, 284, 641, 281,
-	186, 201, 0, 674, 323, 362, 368, 740, 660, 669,
-	224, 667, 366, 337, 421, 208, 249, 359, 342, 364,
-	706, 724, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 619, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 640, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 655,
-	736, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 628, 766, 622, 621, 282, 291, 728,
-	764, 336, 367, 214, 423, 387, 650, 654, 648, 649,
-	700, 701, 651, 756, 757, 758, 732, 644, 0, 652,
-	653, 0, 738, 746, 747, 705, 185, 198, 287, 760,
-	356, 252, 448, 430, 426, 630, 647, 230, 658, 0,
-	0, 671, 678, 679, 691, 693, 694, 695, 696, 704,
-	712, 713, 715, 723, 725, 727, 729, 734, 743, 763,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 703, 710, 297, 246, 263, 272, 718, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 327, 0, 0, 1415,
-	0, 519, 0, 0, 0, 237, 0, 518, 0, 0,
-	0, 285, 0, 0, 1416, 341, 0, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 562, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 553, 554, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 221, 190, 324, 389, 251, 71, 0,
-	0, 182, 183, 184, 540, 539, 542, 543, 544, 545,
-	0, 0, 212, 541, 219, 546, 547, 548, 0, 233,
-	273, 239, 232, 404, 0, 0, 0, 516, 533, 0,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 531, 609, 0, 0, 0, 577, 0, 532, 0,
-	0, 525, 526, 528, 527, 529, 534, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 313, 0,
-	576, 0, 0, 435, 0, 0, 574, 0, 0, 0,
-	0, 0, 284, 0, 281, 186, 201, 0, 0, 323,
-	362, 368, 0, 0, 0, 224, 0, 366, 337, 421,
-	208, 249, 359, 342, 364, 0, 0, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 0, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 0, 0, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 0, 0, 336, 367, 214, 423,
-	387, 564, 575, 570, 571, 568, 569, 563, 567, 566,
-	565, 578, 555, 556, 557, 558, 560, 0, 572, 573,
-	559, 185, 198, 287, 0, 356, 252, 448, 430, 426,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 0, 0, 297, 246,
-	263, 272, 0, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 327, 0, 0, 0, 0, 519, 0, 0, 0,
-	237, 0, 518, 0, 0, 0, 285, 0, 0, 0,
-	341, 0, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 562, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 553, 554, 0,
-	0, 0, 0, 0, 0, 1527, 0, 275, 221, 190,
-	324, 389, 251, 71, 0, 0, 182, 183, 184, 540,
-	539, 542, 543, 544, 545, 0, 0, 212, 541, 219,
-	546, 547, 548, 1528, 233, 273, 239, 232, 404, 0,
-	0, 0, 516, 533, 0, 561, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 530, 531, 0, 0, 0,
-	0, 577, 0, 532, 0, 0, 525, 526, 528, 527,
-	529, 534, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 313, 0, 576, 0, 0, 435, 0,
-	0, 574, 0, 0, 0, 0, 0, 284, 0, 281,
-	186, 201, 0, 0, 323, 362, 368, 0, 0, 0,
-	224, 0, 366, 337, 421, 208, 249, 359, 342, 364,
-	0, 0, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 417, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 0, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 0,
-	0, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 318, 205, 268, 386, 282, 291, 0,
-	0, 336, 367, 214, 423, 387, 564, 575, 570, 571,
-	568, 569, 563, 567, 566, 565, 578, 555, 556, 557,
-	558, 560, 0, 572, 573, 559, 185, 198, 287, 0,
-	356, 252, 448, 430, 426, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 0, 0, 297, 246, 263, 272, 0, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 327, 0, 0, 0,
-	0, 519, 0, 0, 0, 237, 0, 518, 0, 0,
-	0, 285, 0, 0, 0, 341, 0, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 562, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 553, 554, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 221, 190, 324, 389, 251, 71, 0,
-	596, 182, 183, 184, 540, 539, 542, 543, 544, 545,
-	0, 0, 212, 541, 219, 546, 547, 548, 0, 233,
-	273, 239, 232, 404, 0, 0, 0, 516, 533, 0,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 531, 0, 0, 0, 0, 577, 0, 532, 0,
-	0, 525, 526, 528, 527, 529, 534, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 313, 0,
-	576, 0, 0, 435, 0, 0, 574, 0, 0, 0,
-	0, 0, 284, 0, 281, 186, 201, 0, 0, 323,
-	362, 368, 0, 0, 0, 224, 0, 366, 337, 421,
-	208, 249, 359, 342, 364, 0, 0, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 0, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 0, 0, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 0, 0, 336, 367, 214, 423,
-	387, 564, 575, 570, 571, 568, 569, 563, 567, 566,
-	565, 578, 555, 556, 557, 558, 560, 0, 572, 573,
-	559, 185, 198, 287, 0, 356, 252, 448, 430, 426,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 0, 0, 297, 246,
-	263, 272, 0, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 327, 0, 0, 0, 0, 519, 0, 0, 0,
-	237, 0, 518, 0, 0, 0, 285, 0, 0, 0,
-	341, 0, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 562, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 553, 554, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 221, 190,
-	324, 389, 251, 71, 0, 0, 182, 183, 184, 540,
-	539, 542, 543, 544, 545, 0, 0, 212, 541, 219,
-	546, 547, 548, 0, 233, 273, 239, 232, 404, 0,
-	0, 0, 516, 533, 0, 561, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 530, 531, 609, 0, 0,
-	0, 577, 0, 532, 0, 0, 525, 526, 528, 527,
-	529, 534, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 313, 0, 576, 0, 0, 435, 0,
-	0, 574, 0, 0, 0, 0, 0, 284, 0, 281,
-	186, 201, 0, 0, 323, 362, 368, 0, 0, 0,
-	224, 0, 366, 337, 421, 208, 249, 359, 342, 364,
-	0, 0, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 417, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 0, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 0,
-	0, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 318, 205, 268, 386, 282, 291, 0,
-	0, 336, 367, 214, 423, 387, 564, 575, 570, 571,
-	568, 569, 563, 567, 566, 565, 578, 555, 556, 557,
-	558, 560, 0, 572, 573, 559, 185, 198, 287, 0,
-	356, 252, 448, 430, 426, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 0, 0, 297, 246, 263, 272, 0, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 327, 0, 0, 0,
-	0, 519, 0, 0, 0, 237, 0, 518, 0, 0,
-	0, 285, 0, 0, 0, 341, 0, 379, 223, 294,
-	292, 407, 247, 240, 236, 222, 269, 300, 339, 397,
-	333, 562, 289, 0, 0, 388, 312, 0, 0, 0,
-	0, 0, 553, 554, 0, 0, 0, 0, 0, 0,
-	0, 0, 275, 221, 190, 324, 389, 251, 71, 0,
-	0, 182, 183, 184, 540, 1433, 542, 543, 544, 545,
-	0, 0, 212, 541, 219, 546, 547, 548, 0, 233,
-	273, 239, 232, 404, 0, 0, 0, 516, 533, 0,
-	561, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	530, 531, 609, 0, 0, 0, 577, 0, 532, 0,
-	0, 525, 526, 528, 527, 529, 534, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 259, 0, 313, 0,
-	576, 0, 0, 435, 0, 0, 574, 0, 0, 0,
-	0, 0, 284, 0, 281, 186, 201, 0, 0, 323,
-	362, 368, 0, 0, 0, 224, 0, 366, 337, 421,
-	208, 249, 359, 342, 364, 0, 0, 365, 290, 409,
-	354, 419, 436, 437, 231, 317, 427, 401, 433, 447,
-	202, 228, 331, 394, 424, 385, 310, 405, 406, 280,
-	384, 257, 189, 288, 444, 200, 374, 216, 193, 396,
-	417, 213, 377, 0, 0, 0, 195, 415, 393, 307,
-	277, 278, 194, 0, 358, 235, 255, 226, 326, 412,
-	413, 225, 449, 204, 432, 197, 0, 431, 319, 408,
-	416, 308, 299, 196, 414, 306, 298, 283, 245, 265,
-	352, 293, 353, 266, 315, 314, 316, 0, 191, 0,
-	390, 425, 450, 210, 0, 0, 403, 441, 446, 0,
-	355, 211, 256, 244, 351, 254, 286, 440, 442, 443,
-	445, 209, 349, 262, 330, 420, 248, 428, 318, 205,
-	268, 386, 282, 291, 0, 0, 336, 367, 214, 423,
-	387, 564, 575, 570, 571, 568, 569, 563, 567, 566,
-	565, 578, 555, 556, 557, 558, 560, 0, 572, 573,
-	559, 185, 198, 287, 0, 356, 252, 448, 430, 426,
-	0, 0, 230, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 187, 188, 199, 207, 217,
-	229, 242, 250, 260, 264, 267, 270, 271, 274, 279,
-	296, 301, 302, 303, 304, 320, 321, 322, 325, 328,
-	329, 332, 334, 335, 338, 344, 345, 346, 347, 348,
-	350, 357, 361, 369, 370, 371, 372, 373, 375, 376,
-	380, 381, 382, 383, 391, 395, 410, 411, 422, 434,
-	438, 261, 418, 439, 0, 295, 0, 0, 297, 246,
-	263, 272, 0, 429, 392, 203, 363, 253, 192, 220,
-	206, 227, 241, 243, 276, 305, 311, 340, 343, 258,
-	238, 218, 360, 215, 378, 398, 399, 400, 402, 309,
-	234, 327, 0, 0, 0, 0, 519, 0, 0, 0,
-	237, 0, 518, 0, 0, 0, 285, 0, 0, 0,
-	341, 0, 379, 223, 294, 292, 407, 247, 240, 236,
-	222, 269, 300, 339, 397, 333, 562, 289, 0, 0,
-	388, 312, 0, 0, 0, 0, 0, 553, 554, 0,
-	0, 0, 0, 0, 0, 0, 0, 275, 221, 190,
-	324, 389, 251, 71, 0, 0, 182, 183, 184, 540,
-	1430, 542, 543, 544, 545, 0, 0, 212, 541, 219,
-	546, 547, 548, 0, 233, 273, 239, 232, 404, 0,
-	0, 0, 516, 533, 0, 561, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 530, 531, 609, 0, 0,
-	0, 577, 0, 532, 0, 0, 525, 526, 528, 527,
-	529, 534, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 259, 0, 313, 0, 576, 0, 0, 435, 0,
-	0, 574, 0, 0, 0, 0, 0, 284, 0, 281,
-	186, 201, 0, 0, 323, 362, 368, 0, 0, 0,
-	224, 0, 366, 337, 421, 208, 249, 359, 342, 364,
-	0, 0, 365, 290, 409, 354, 419, 436, 437, 231,
-	317, 427, 401, 433, 447, 202, 228, 331, 394, 424,
-	385, 310, 405, 406, 280, 384, 257, 189, 288, 444,
-	200, 374, 216, 193, 396, 417, 213, 377, 0, 0,
-	0, 195, 415, 393, 307, 277, 278, 194, 0, 358,
-	235, 255, 226, 326, 412, 413, 225, 449, 204, 432,
-	197, 0, 431, 319, 408, 416, 308, 299, 196, 414,
-	306, 298, 283, 245, 265, 352, 293, 353, 266, 315,
-	314, 316, 0, 191, 0, 390, 425, 450, 210, 0,
-	0, 403, 441, 446, 0, 355, 211, 256, 244, 351,
-	254, 286, 440, 442, 443, 445, 209, 349, 262, 330,
-	420, 248, 428, 318, 205, 268, 386, 282, 291, 0,
-	0, 336, 367, 214, 423, 387, 564, 575, 570, 571,
-	568, 569, 563, 567, 566, 565, 578, 555, 556, 557,
-	558, 560, 0, 572, 573, 559, 185, 198, 287, 0,
-	356, 252, 448, 430, 426, 0, 0, 230, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	187, 188, 199, 207, 217, 229, 242, 250, 260, 264,
-	267, 270, 271, 274, 279, 296, 301, 302, 303, 304,
-	320, 321, 322, 325, 328, 329, 332, 334, 335, 338,
-	344, 345, 346, 347, 348, 350, 357, 361, 369, 370,
-	371, 372, 373, 375, 376, 380, 381, 382, 383, 391,
-	395, 410, 411, 422, 434, 438, 261, 418, 439, 0,
-	295, 0, 0, 297, 246, 263, 272, 0, 429, 392,
-	203, 363, 253, 192, 220, 206, 227, 241, 243, 276,
-	305, 311, 340, 343, 258, 238, 218, 360, 215, 378,
-	398, 399, 400, 402, 309, 234, 589, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 327,
-	0, 0, 0, 0, 519, 0, 0, 0, 237, 0,
-	518, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 562, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 553, 554, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 71, 0, 0, 182, 183, 184, 540, 539, 542,
-	543, 544, 545, 0, 0, 212, 541, 219, 546, 547,
-	548, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	516, 533, 0, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 531, 0, 0, 0, 0, 577,
-	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 576, 0, 0, 435, 0, 0, 574,
-	0, 0, 0, 0, 0, 284, 0, 281, 186, 201,
-	0, 0, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 0, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 564, 575, 570, 571, 568, 569,
-	563, 567, 566, 565, 578, 555, 556, 557, 558, 560,
-	0, 572, 573, 559, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 0, 519,
-	0, 0, 0, 237, 0, 518, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 562,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	553, 554, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 71, 0, 0, 182,
-	183, 184, 540, 539, 542, 543, 544, 545, 0, 0,
-	212, 541, 219, 546, 547, 548, 0, 233, 273, 239,
-	232, 404, 0, 0, 0, 516, 533, 0, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 531,
-	0, 0, 0, 0, 577, 0, 532, 0, 0, 525,
-	526, 528, 527, 529, 534, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 576, 0,
-	0, 435, 0, 0, 574, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 564,
-	575, 570, 571, 568, 569, 563, 567, 566, 565, 578,
-	555, 556, 557, 558, 560, 0, 572, 573, 559, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 562, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 553, 554, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 71, 0, 0, 182, 183, 184, 540, 539, 542,
-	543, 544, 545, 0, 0, 212, 541, 219, 546, 547,
-	548, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	0, 533, 0, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 531, 0, 0, 0, 0, 577,
-	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 576, 0, 0, 435, 0, 0, 574,
-	0, 0, 0, 0, 0, 284, 0, 281, 186, 201,
-	0, 0, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 2197, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 564, 575, 570, 571, 568, 569,
-	563, 567, 566, 565, 578, 555, 556, 557, 558, 560,
-	0, 572, 573, 559, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 562,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	553, 554, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 71, 0, 596, 182,
-	183, 184, 540, 539, 542, 543, 544, 545, 0, 0,
-	212, 541, 219, 546, 547, 548, 0, 233, 273, 239,
-	232, 404, 0, 0, 0, 0, 533, 0, 561, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 530, 531,
-	0, 0, 0, 0, 577, 0, 532, 0, 0, 525,
-	526, 528, 527, 529, 534, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 576, 0,
-	0, 435, 0, 0, 574, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 564,
-	575, 570, 571, 568, 569, 563, 567, 566, 565, 578,
-	555, 556, 557, 558, 560, 0, 572, 573, 559, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 0,
-	0, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 562, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 553, 554, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 71, 0, 0, 182, 183, 184, 540, 539, 542,
-	543, 544, 545, 0, 0, 212, 541, 219, 546, 547,
-	548, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	0, 533, 0, 561, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 530, 531, 0, 0, 0, 0, 577,
-	0, 532, 0, 0, 525, 526, 528, 527, 529, 534,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 576, 0, 0, 435, 0, 0, 574,
-	0, 0, 0, 0, 0, 284, 0, 281, 186, 201,
-	0, 0, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 0, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 564, 575, 570, 571, 568, 569,
-	563, 567, 566, 565, 578, 555, 556, 557, 558, 560,
-	0, 572, 573, 559, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 0, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 0,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 0, 0, 0, 182,
-	183, 184, 0, 0, 0, 0, 0, 0, 0, 0,
-	212, 0, 219, 0, 0, 0, 0, 233, 273, 239,
-	232, 404, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 983, 982, 992, 993, 985, 986,
-	987, 988, 989, 990, 991, 984, 0, 0, 994, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 0, 0,
-	0, 435, 0, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 327,
-	0, 0, 0, 0, 0, 0, 0, 0, 237, 809,
-	0, 0, 0, 0, 285, 0, 0, 0, 341, 0,
-	379, 223, 294, 292, 407, 247, 240, 236, 222, 269,
-	300, 339, 397, 333, 0, 289, 0, 0, 388, 312,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 275, 221, 190, 324, 389,
-	251, 0, 0, 0, 182, 183, 184, 0, 0, 0,
-	0, 0, 0, 0, 0, 212, 0, 219, 0, 0,
-	0, 0, 233, 273, 239, 232, 404, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 259,
-	0, 313, 0, 0, 0, 808, 435, 0, 0, 0,
-	0, 0, 0, 805, 806, 284, 774, 281, 186, 201,
-	799, 803, 323, 362, 368, 0, 0, 0, 224, 0,
-	366, 337, 421, 208, 249, 359, 342, 364, 0, 0,
-	365, 290, 409, 354, 419, 436, 437, 231, 317, 427,
-	401, 433, 447, 202, 228, 331, 394, 424, 385, 310,
-	405, 406, 280, 384, 257, 189, 288, 444, 200, 374,
-	216, 193, 396, 417, 213, 377, 0, 0, 0, 195,
-	415, 393, 307, 277, 278, 194, 0, 358, 235, 255,
-	226, 326, 412, 413, 225, 449, 204, 432, 197, 0,
-	431, 319, 408, 416, 308, 299, 196, 414, 306, 298,
-	283, 245, 265, 352, 293, 353, 266, 315, 314, 316,
-	0, 191, 0, 390, 425, 450, 210, 0, 0, 403,
-	441, 446, 0, 355, 211, 256, 244, 351, 254, 286,
-	440, 442, 443, 445, 209, 349, 262, 330, 420, 248,
-	428, 318, 205, 268, 386, 282, 291, 0, 0, 336,
-	367, 214, 423, 387, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 185, 198, 287, 0, 356, 252,
-	448, 430, 426, 0, 0, 230, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 187, 188,
-	199, 207, 217, 229, 242, 250, 260, 264, 267, 270,
-	271, 274, 279, 296, 301, 302, 303, 304, 320, 321,
-	322, 325, 328, 329, 332, 334, 335, 338, 344, 345,
-	346, 347, 348, 350, 357, 361, 369, 370, 371, 372,
-	373, 375, 376, 380, 381, 382, 383, 391, 395, 410,
-	411, 422, 434, 438, 261, 418, 439, 0, 295, 0,
-	0, 297, 246, 263, 272, 0, 429, 392, 203, 363,
-	253, 192, 220, 206, 227, 241, 243, 276, 305, 311,
-	340, 343, 258, 238, 218, 360, 215, 378, 398, 399,
-	400, 402, 309, 234, 327, 0, 0, 0, 1086, 0,
-	0, 0, 0, 237, 0, 0, 0, 0, 0, 285,
-	0, 0, 0, 341, 0, 379, 223, 294, 292, 407,
-	247, 240, 236, 222, 269, 300, 339, 397, 333, 0,
-	289, 0, 0, 388, 312, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	275, 221, 190, 324, 389, 251, 0, 0, 0, 182,
-	183, 184, 0, 1088, 0, 0, 0, 0, 0, 0,
-	212, 0, 219, 0, 0, 0, 0, 233, 273, 239,
-	232, 404, 972, 973, 971, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	974, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 259, 0, 313, 0, 0, 0,
-	0, 435, 0, 0, 0, 0, 0, 0, 0, 0,
-	284, 0, 281, 186, 201, 0, 0, 323, 362, 368,
-	0, 0, 0, 224, 0, 366, 337, 421, 208, 249,
-	359, 342, 364, 0, 0, 365, 290, 409, 354, 419,
-	436, 437, 231, 317, 427, 401, 433, 447, 202, 228,
-	331, 394, 424, 385, 310, 405, 406, 280, 384, 257,
-	189, 288, 444, 200, 374, 216, 193, 396, 417, 213,
-	377, 0, 0, 0, 195, 415, 393, 307, 277, 278,
-	194, 0, 358, 235, 255, 226, 326, 412, 413, 225,
-	449, 204, 432, 197, 0, 431, 319, 408, 416, 308,
-	299, 196, 414, 306, 298, 283, 245, 265, 352, 293,
-	353, 266, 315, 314, 316, 0, 191, 0, 390, 425,
-	450, 210, 0, 0, 403, 441, 446, 0, 355, 211,
-	256, 244, 351, 254, 286, 440, 442, 443, 445, 209,
-	349, 262, 330, 420, 248, 428, 318, 205, 268, 386,
-	282, 291, 0, 0, 336, 367, 214, 423, 387, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 185,
-	198, 287, 0, 356, 252, 448, 430, 426, 0, 0,
-	230, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 187, 188, 199, 207, 217, 229, 242,
-	250, 260, 264, 267, 270, 271, 274, 279, 296, 301,
-	302, 303, 304, 320, 321, 322, 325, 328, 329, 332,
-	334, 335, 338, 344, 345, 346, 347, 348, 350, 357,
-	361, 369, 370, 371, 372, 373, 375, 376, 380, 381,
-	382, 383, 391, 395, 410, 411, 422, 434, 438, 261,
-	418, 439, 0, 295, 0, 0, 297, 246, 263, 272,
-	0, 429, 392, 203, 363, 253, 192, 220, 206, 227,
-	241, 243, 276, 305, 311, 340, 343, 258, 238, 218,
-	360, 215, 378, 398, 399, 400, 402, 309, 234, 35,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 71, 0, 596, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 1460, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 1462, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 1458, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 768,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 774,
-	281, 186, 201, 772, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 1460, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 1462, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 35, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 71, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	1480, 0, 0, 1481, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 1119, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 1118, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 596, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 71, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 1462,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 1088, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 0, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 1365, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1243, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1241, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1239, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1237, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1235, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1231, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
+	0, 0, 0, 0, 1493, 147, 0, 0, 0, 0,
+	136, 1497, 0, 1500, 0, 0, 0, 0, 0, 0,
+	0, 0, 1519, 0, 2034, 0, 0, 0, 154, 0,
+	155, 2040, 2041, 2042, 0, 1205, 1206, 146, 145, 172,
+	0, 0, 0, 0, 0, 151, 156, 153, 159, 160,
+	161, 162, 164, 165, 166, 167, 0, 0, 0, 0,
+	630, 168, 169, 170, 171, 0, 0, 0, 1468, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1150, 0, 0, 141, 1207, 148,
+	0, 1204, 0, 142, 143, 0, 1230, 0, 158, 0,
+	0, 1586, 0, 0, 0, 0, 0, 0, 163, 460,
+	0, 0, 0, 0, 0, 0, 0, 630, 0, 0,
+	1224, 460, 0, 1938, 1230, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1935, 0, 35,
+	0, 1935, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 1225, 0, 0, 1138, 0,
+	0, 0, 0, 0, 0, 0, 35, 0, 776, 0,
+	0, 1224, 0, 0, 150, 1110, 0, 0, 0, 1641,
+	1642, 1643, 1644, 1645, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1649, 1650, 1110, 1652, 0, 1935, 2008,
+	2009, 2010, 1151, 0, 0, 0, 0, 1658, 0, 0,
+	35, 2175, 0, 0, 1661, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 144, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 1665,
+	138, 0, 0, 139, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1164, 1167, 1168, 1169, 1170, 1171, 1172,
+	0, 1173, 1174, 1175, 1176, 1177, 1152, 1153, 1154, 1155,
+	1136, 1137, 1165, 1224, 1139, 0, 1140, 1141, 1142, 1143,
+	1144, 1145, 1146, 1147, 1148, 1149, 1156, 1157, 1158, 1159,
+	1160, 1161, 1162, 1163, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 1835, 2082, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1835, 0, 0, 630, 0, 0,
+	0, 0, 0, 0, 151, 156, 153, 159, 160, 161,
+	162, 164, 165, 166, 167, 0, 0, 0, 0, 1166,
+	168, 169, 170, 171, 0, 0, 0, 0, 0, 0,
+	0, 0, 1835, 1835, 1835, 36, 37, 38, 74, 40,
+	41, 0, 0, 0, 0, 0, 2124, 0, 2126, 0,
+	0, 0, 0, 0, 1835, 78, 0, 1776, 0, 0,
+	42, 68, 69, 0, 66, 70, 72, 0, 0, 0,
+	0, 0, 67, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 1835, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 55, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 73, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 1829, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 630, 630, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1224, 0, 2201, 0, 0, 0, 1835, 0,
+	1859, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 45, 48, 51, 50, 53, 1874, 65,
+	0, 0, 71, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 54, 77, 76, 0, 0,
+	63, 64, 52, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 56, 57, 0, 58, 59, 60, 61, 0, 0,
+	1923, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 1985, 0, 75, 1986, 1987, 1988, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1998, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 2011, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 2182, 2183, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 0, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 2136,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 2145, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 945, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 1927, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 945, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 1780, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 945, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 1495, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 945, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	73, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 0, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 945, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 0, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 945, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 0, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 645, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	633, 771, 627, 626, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 0, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 1114, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 645, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	633, 771, 627, 626, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 755, 742, 0, 0, 691, 758, 662,
+	680, 767, 682, 685, 725, 641, 704, 329, 677, 0,
+	666, 637, 673, 638, 664, 693, 239, 697, 661, 744,
+	707, 757, 287, 0, 643, 0, 667, 343, 727, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 764, 291, 714, 0, 390, 314, 0,
+	0, 0, 695, 747, 702, 738, 690, 726, 651, 713,
+	759, 678, 722, 760, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 719, 754, 675,
+	721, 235, 275, 241, 234, 406, 724, 770, 636, 716,
+	0, 639, 642, 766, 750, 670, 671, 0, 0, 0,
+	0, 0, 0, 0, 694, 703, 735, 688, 0, 0,
+	0, 0, 0, 0, 0, 0, 668, 0, 712, 0,
+	0, 0, 647, 640, 0, 0, 0, 0, 692, 0,
+	0, 0, 650, 0, 669, 736, 0, 634, 261, 644,
+	315, 0, 740, 749, 689, 437, 753, 687, 686, 756,
+	731, 648, 746, 681, 286, 646, 283, 188, 203, 0,
+	679, 325, 364, 370, 745, 665, 674, 226, 672, 368,
+	339, 423, 210, 251, 361, 344, 366, 711, 729, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 624, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 645, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 660, 741, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	633, 771, 627, 626, 284, 293, 733, 769, 338, 369,
+	216, 425, 389, 655, 659, 653, 654, 705, 706, 656,
+	761, 762, 763, 737, 649, 0, 657, 658, 0, 743,
+	751, 752, 710, 187, 200, 289, 765, 358, 254, 450,
+	432, 428, 635, 652, 232, 663, 0, 0, 676, 683,
+	684, 696, 698, 699, 700, 701, 709, 717, 718, 720,
+	728, 730, 732, 734, 739, 748, 768, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 708, 715,
+	299, 248, 265, 274, 723, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 329, 0, 0, 1422, 0, 521, 0,
+	0, 0, 239, 0, 520, 0, 0, 0, 287, 0,
+	0, 0, 1423, 343, 0, 381, 225, 296, 294, 409,
+	249, 242, 238, 224, 271, 302, 341, 399, 335, 564,
+	291, 0, 0, 390, 314, 0, 0, 0, 0, 0,
+	555, 556, 0, 0, 0, 0, 0, 0, 0, 0,
+	277, 223, 192, 326, 391, 253, 73, 0, 0, 184,
+	185, 186, 542, 541, 544, 545, 546, 547, 0, 0,
+	214, 543, 221, 548, 549, 550, 0, 235, 275, 241,
+	234, 406, 0, 0, 0, 518, 535, 0, 563, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 532, 533,
+	614, 0, 0, 0, 579, 0, 534, 0, 0, 527,
+	528, 530, 529, 531, 536, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 261, 0, 315, 0, 578, 0,
+	0, 437, 0, 0, 576, 0, 0, 0, 0, 0,
+	286, 0, 283, 188, 203, 0, 0, 325, 364, 370,
+	0, 0, 0, 226, 0, 368, 339, 423, 210, 251,
+	361, 344, 366, 0, 0, 367, 292, 411, 356, 421,
+	438, 439, 233, 319, 429, 403, 435, 449, 204, 230,
+	333, 396, 426, 387, 312, 407, 408, 282, 386, 259,
+	191, 290, 446, 202, 376, 218, 195, 398, 419, 215,
+	379, 0, 0, 0, 197, 417, 395, 309, 279, 280,
+	196, 0, 360, 237, 257, 228, 328, 414, 415, 227,
+	451, 206, 434, 199, 0, 433, 321, 410, 418, 310,
+	301, 198, 416, 308, 300, 285, 247, 267, 354, 295,
+	355, 268, 317, 316, 318, 0, 193, 0, 392, 427,
+	452, 212, 0, 0, 405, 443, 448, 0, 357, 213,
+	258, 246, 353, 256, 288, 442, 444, 445, 447, 211,
+	351, 264, 332, 422, 250, 430, 320, 207, 270, 388,
+	284, 293, 0, 0, 338, 369, 216, 425, 389, 566,
+	577, 572, 573, 570, 571, 565, 569, 568, 567, 580,
+	557, 558, 559, 560, 562, 0, 574, 575, 561, 187,
+	200, 289, 0, 358, 254, 450, 432, 428, 0, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 201, 209, 219, 231, 244,
+	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
+	304, 305, 306, 322, 323, 324, 327, 330, 331, 334,
+	336, 337, 340, 346, 347, 348, 349, 350, 352, 359,
+	363, 371, 372, 373, 374, 375, 377, 378, 382, 383,
+	384, 385, 393, 397, 412, 413, 424, 436, 440, 263,
+	420, 441, 0, 297, 0, 0, 299, 248, 265, 274,
+	0, 431, 394, 205, 365, 255, 194, 222, 208, 229,
+	243, 245, 278, 307, 313, 342, 345, 260, 240, 220,
+	362, 217, 380, 400, 401, 402, 404, 311, 236, 329,
+	0, 0, 0, 0, 521, 0, 0, 0, 239, 0,
+	520, 0, 0, 0, 287, 0, 0, 0, 0, 343,
+	0, 381, 225, 296, 294, 409, 249, 242, 238, 224,
+	271, 302, 341, 399, 335, 564, 291, 0, 0, 390,
+	314, 0, 0, 0, 0, 0, 555, 556, 0, 0,
+	0, 0, 0, 0, 1534, 0, 277, 223, 192, 326,
+	391, 253, 73, 0, 0, 184, 185, 186, 542, 541,
+	544, 545, 546, 547, 0, 0, 214, 543, 221, 548,
+	549, 550, 1535, 235, 275, 241, 234, 406, 0, 0,
+	0, 518, 535, 0, 563, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 532, 533, 0, 0, 0, 0,
+	579, 0, 534, 0, 0, 527, 528, 530, 529, 531,
+	536, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	261, 0, 315, 0, 578, 0, 0, 437, 0, 0,
+	576, 0, 0, 0, 0, 0, 286, 0, 283, 188,
+	203, 0, 0, 325, 364, 370, 0, 0, 0, 226,
+	0, 368, 339, 423, 210, 251, 361, 344, 366, 0,
+	0, 367, 292, 411, 356, 421, 438, 439, 233, 319,
+	429, 403, 435, 449, 204, 230, 333, 396, 426, 387,
+	312, 407, 408, 282, 386, 259, 191, 290, 446, 202,
+	376, 218, 195, 398, 419, 215, 379, 0, 0, 0,
+	197, 417, 395, 309, 279, 280, 196, 0, 360, 237,
+	257, 228, 328, 414, 415, 227, 451, 206, 434, 199,
+	0, 433, 321, 410, 418, 310, 301, 198, 416, 308,
+	300, 285, 247, 267, 354, 295, 355, 268, 317, 316,
+	318, 0, 193, 0, 392, 427, 452, 212, 0, 0,
+	405, 443, 448, 0, 357, 213, 258, 246, 353, 256,
+	288, 442, 444, 445, 447, 211, 351, 264, 332, 422,
+	250, 430, 320, 207, 270, 388, 284, 293, 0, 0,
+	338, 369, 216, 425, 389, 566, 577, 572, 573, 570,
+	571, 565, 569, 568, 567, 580, 557, 558, 559, 560,
+	562, 0, 574, 575, 561, 187, 200, 289, 0, 358,
+	254, 450, 432, 428, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 201, 209, 219, 231, 244, 252, 262, 266, 269,
+	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
+	323, 324, 327, 330, 331, 334, 336, 337, 340, 346,
+	347, 348, 349, 350, 352, 359, 363, 371, 372, 373,
+	374, 375, 377, 378, 382, 383, 384, 385, 393, 397,
+	412, 413, 424, 436, 440, 263, 420, 441, 0, 297,
+	0, 0, 299, 248, 265, 274, 0, 431, 394, 205,
+	365, 255, 194, 222, 208, 229, 243, 245, 278, 307,
+	313, 342, 345, 260, 240, 220, 362, 217, 380, 400,
+	401, 402, 404, 311, 236, 329, 0, 0, 0, 0,
+	521, 0, 0, 0, 239, 0, 520, 0, 0, 0,
+	287, 0, 0, 0, 0, 343, 0, 381, 225, 296,
+	294, 409, 249, 242, 238, 224, 271, 302, 341, 399,
+	335, 564, 291, 0, 0, 390, 314, 0, 0, 0,
+	0, 0, 555, 556, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 223, 192, 326, 391, 253, 73, 0,
+	601, 184, 185, 186, 542, 541, 544, 545, 546, 547,
+	0, 0, 214, 543, 221, 548, 549, 550, 0, 235,
+	275, 241, 234, 406, 0, 0, 0, 518, 535, 0,
+	563, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	532, 533, 0, 0, 0, 0, 579, 0, 534, 0,
+	0, 527, 528, 530, 529, 531, 536, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 261, 0, 315, 0,
+	578, 0, 0, 437, 0, 0, 576, 0, 0, 0,
+	0, 0, 286, 0, 283, 188, 203, 0, 0, 325,
+	364, 370, 0, 0, 0, 226, 0, 368, 339, 423,
+	210, 251, 361, 344, 366, 0, 0, 367, 292, 411,
+	356, 421, 438, 439, 233, 319, 429, 403, 435, 449,
+	204, 230, 333, 396, 426, 387, 312, 407, 408, 282,
+	386, 259, 191, 290, 446, 202, 376, 218, 195, 398,
+	419, 215, 379, 0, 0, 0, 197, 417, 395, 309,
+	279, 280, 196, 0, 360, 237, 257, 228, 328, 414,
+	415, 227, 451, 206, 434, 199, 0, 433, 321, 410,
+	418, 310, 301, 198, 416, 308, 300, 285, 247, 267,
+	354, 295, 355, 268, 317, 316, 318, 0, 193, 0,
+	392, 427, 452, 212, 0, 0, 405, 443, 448, 0,
+	357, 213, 258, 246, 353, 256, 288, 442, 444, 445,
+	447, 211, 351, 264, 332, 422, 250, 430, 320, 207,
+	270, 388, 284, 293, 0, 0, 338, 369, 216, 425,
+	389, 566, 577, 572, 573, 570, 571, 565, 569, 568,
+	567, 580, 557, 558, 559, 560, 562, 0, 574, 575,
+	561, 187, 200, 289, 0, 358, 254, 450, 432, 428,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 190, 201, 209, 219,
+	231, 244, 252, 262, 266, 269, 272, 273, 276, 281,
+	298, 303, 304, 305, 306, 322, 323, 324, 327, 330,
+	331, 334, 336, 337, 340, 346, 347, 348, 349, 350,
+	352, 359, 363, 371, 372, 373, 374, 375, 377, 378,
+	382, 383, 384, 385, 393, 397, 412, 413, 424, 436,
+	440, 263, 420, 441, 0, 297, 0, 0, 299, 248,
+	265, 274, 0, 431, 394, 205, 365, 255, 194, 222,
+	208, 229, 243, 245, 278, 307, 313, 342, 345, 260,
+	240, 220, 362, 217, 380, 400, 401, 402, 404, 311,
+	236, 329, 0, 0, 0, 0, 521, 0, 0, 0,
+	239, 0, 520, 0, 0, 0, 287, 0, 0, 0,
+	0, 343, 0, 381, 225, 296, 294, 409, 249, 242,
+	238, 224, 271, 302, 341, 399, 335, 564, 291, 0,
+	0, 390, 314, 0, 0, 0, 0, 0, 555, 556,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 223,
+	192, 326, 391, 253, 73, 0, 0, 184, 185, 186,
+	542, 541, 544, 545, 546, 547, 0, 0, 214, 543,
+	221, 548, 549, 550, 0, 235, 275, 241, 234, 406,
+	0, 0, 0, 518, 535, 0, 563, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 532, 533, 614, 0,
+	0, 0, 579, 0, 534, 0, 0, 527, 528, 530,
+	529, 531, 536, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 261, 0, 315, 0, 578, 0, 0, 437,
+	0, 0, 576, 0, 0, 0, 0, 0, 286, 0,
+	283, 188, 203, 0, 0, 325, 364, 370, 0, 0,
+	0, 226, 0, 368, 339, 423, 210, 251, 361, 344,
+	366, 0, 0, 367, 292, 411, 356, 421, 438, 439,
+	233, 319, 429, 403, 435, 449, 204, 230, 333, 396,
+	426, 387, 312, 407, 408, 282, 386, 259, 191, 290,
+	446, 202, 376, 218, 195, 398, 419, 215, 379, 0,
+	0, 0, 197, 417, 395, 309, 279, 280, 196, 0,
+	360, 237, 257, 228, 328, 414, 415, 227, 451, 206,
+	434, 199, 0, 433, 321, 410, 418, 310, 301, 198,
+	416, 308, 300, 285, 247, 267, 354, 295, 355, 268,
+	317, 316, 318, 0, 193, 0, 392, 427, 452, 212,
+	0, 0, 405, 443, 448, 0, 357, 213, 258, 246,
+	353, 256, 288, 442, 444, 445, 447, 211, 351, 264,
+	332, 422, 250, 430, 320, 207, 270, 388, 284, 293,
+	0, 0, 338, 369, 216, 425, 389, 566, 577, 572,
+	573, 570, 571, 565, 569, 568, 567, 580, 557, 558,
+	559, 560, 562, 0, 574, 575, 561, 187, 200, 289,
+	0, 358, 254, 450, 432, 428, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 201, 209, 219, 231, 244, 252, 262,
+	266, 269, 272, 273, 276, 281, 298, 303, 304, 305,
+	306, 322, 323, 324, 327, 330, 331, 334, 336, 337,
+	340, 346, 347, 348, 349, 350, 352, 359, 363, 371,
+	372, 373, 374, 375, 377, 378, 382, 383, 384, 385,
+	393, 397, 412, 413, 424, 436, 440, 263, 420, 441,
+	0, 297, 0, 0, 299, 248, 265, 274, 0, 431,
+	394, 205, 365, 255, 194, 222, 208, 229, 243, 245,
+	278, 307, 313, 342, 345, 260, 240, 220, 362, 217,
+	380, 400, 401, 402, 404, 311, 236, 329, 0, 0,
+	0, 0, 521, 0, 0, 0, 239, 0, 520, 0,
+	0, 0, 287, 0, 0, 0, 0, 343, 0, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 564, 291, 0, 0, 390, 314, 0,
+	0, 0, 0, 0, 555, 556, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 223, 192, 326, 391, 253,
+	73, 0, 0, 184, 185, 186, 542, 1440, 544, 545,
+	546, 547, 0, 0, 214, 543, 221, 548, 549, 550,
+	0, 235, 275, 241, 234, 406, 0, 0, 0, 518,
+	535, 0, 563, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 532, 533, 614, 0, 0, 0, 579, 0,
+	534, 0, 0, 527, 528, 530, 529, 531, 536, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 261, 0,
+	315, 0, 578, 0, 0, 437, 0, 0, 576, 0,
+	0, 0, 0, 0, 286, 0, 283, 188, 203, 0,
+	0, 325, 364, 370, 0, 0, 0, 226, 0, 368,
+	339, 423, 210, 251, 361, 344, 366, 0, 0, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 0, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 0, 0, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 0, 0, 338, 369,
+	216, 425, 389, 566, 577, 572, 573, 570, 571, 565,
+	569, 568, 567, 580, 557, 558, 559, 560, 562, 0,
+	574, 575, 561, 187, 200, 289, 0, 358, 254, 450,
+	432, 428, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 263, 420, 441, 0, 297, 0, 0,
+	299, 248, 265, 274, 0, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 329, 0, 0, 0, 0, 521, 0,
+	0, 0, 239, 0, 520, 0, 0, 0, 287, 0,
+	0, 0, 0, 343, 0, 381, 225, 296, 294, 409,
+	249, 242, 238, 224, 271, 302, 341, 399, 335, 564,
+	291, 0, 0, 390, 314, 0, 0, 0, 0, 0,
+	555, 556, 0, 0, 0, 0, 0, 0, 0, 0,
+	277, 223, 192, 326, 391, 253, 73, 0, 0, 184,
+	185, 186, 542, 1437, 544, 545, 546, 547, 0, 0,
+	214, 543, 221, 548, 549, 550, 0, 235, 275, 241,
+	234, 406, 0, 0, 0, 518, 535, 0, 563, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 532, 533,
+	614, 0, 0, 0, 579, 0, 534, 0, 0, 527,
+	528, 530, 529, 531, 536, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 261, 0, 315, 0, 578, 0,
+	0, 437, 0, 0, 576, 0, 0, 0, 0, 0,
+	286, 0, 283, 188, 203, 0, 0, 325, 364, 370,
+	0, 0, 0, 226, 0, 368, 339, 423, 210, 251,
+	361, 344, 366, 0, 0, 367, 292, 411, 356, 421,
+	438, 439, 233, 319, 429, 403, 435, 449, 204, 230,
+	333, 396, 426, 387, 312, 407, 408, 282, 386, 259,
+	191, 290, 446, 202, 376, 218, 195, 398, 419, 215,
+	379, 0, 0, 0, 197, 417, 395, 309, 279, 280,
+	196, 0, 360, 237, 257, 228, 328, 414, 415, 227,
+	451, 206, 434, 199, 0, 433, 321, 410, 418, 310,
+	301, 198, 416, 308, 300, 285, 247, 267, 354, 295,
+	355, 268, 317, 316, 318, 0, 193, 0, 392, 427,
+	452, 212, 0, 0, 405, 443, 448, 0, 357, 213,
+	258, 246, 353, 256, 288, 442, 444, 445, 447, 211,
+	351, 264, 332, 422, 250, 430, 320, 207, 270, 388,
+	284, 293, 0, 0, 338, 369, 216, 425, 389, 566,
+	577, 572, 573, 570, 571, 565, 569, 568, 567, 580,
+	557, 558, 559, 560, 562, 0, 574, 575, 561, 187,
+	200, 289, 0, 358, 254, 450, 432, 428, 0, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 201, 209, 219, 231, 244,
+	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
+	304, 305, 306, 322, 323, 324, 327, 330, 331, 334,
+	336, 337, 340, 346, 347, 348, 349, 350, 352, 359,
+	363, 371, 372, 373, 374, 375, 377, 378, 382, 383,
+	384, 385, 393, 397, 412, 413, 424, 436, 440, 263,
+	420, 441, 0, 297, 0, 0, 299, 248, 265, 274,
+	0, 431, 394, 205, 365, 255, 194, 222, 208, 229,
+	243, 245, 278, 307, 313, 342, 345, 260, 240, 220,
+	362, 217, 380, 400, 401, 402, 404, 311, 236, 594,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 329, 0, 0, 0, 0, 521, 0, 0,
+	0, 239, 0, 520, 0, 0, 0, 287, 0, 0,
+	0, 0, 343, 0, 381, 225, 296, 294, 409, 249,
+	242, 238, 224, 271, 302, 341, 399, 335, 564, 291,
+	0, 0, 390, 314, 0, 0, 0, 0, 0, 555,
+	556, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	223, 192, 326, 391, 253, 73, 0, 0, 184, 185,
+	186, 542, 541, 544, 545, 546, 547, 0, 0, 214,
+	543, 221, 548, 549, 550, 0, 235, 275, 241, 234,
+	406, 0, 0, 0, 518, 535, 0, 563, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 532, 533, 0,
+	0, 0, 0, 579, 0, 534, 0, 0, 527, 528,
+	530, 529, 531, 536, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 261, 0, 315, 0, 578, 0, 0,
+	437, 0, 0, 576, 0, 0, 0, 0, 0, 286,
+	0, 283, 188, 203, 0, 0, 325, 364, 370, 0,
+	0, 0, 226, 0, 368, 339, 423, 210, 251, 361,
+	344, 366, 0, 0, 367, 292, 411, 356, 421, 438,
+	439, 233, 319, 429, 403, 435, 449, 204, 230, 333,
+	396, 426, 387, 312, 407, 408, 282, 386, 259, 191,
+	290, 446, 202, 376, 218, 195, 398, 419, 215, 379,
+	0, 0, 0, 197, 417, 395, 309, 279, 280, 196,
+	0, 360, 237, 257, 228, 328, 414, 415, 227, 451,
+	206, 434, 199, 0, 433, 321, 410, 418, 310, 301,
+	198, 416, 308, 300, 285, 247, 267, 354, 295, 355,
+	268, 317, 316, 318, 0, 193, 0, 392, 427, 452,
+	212, 0, 0, 405, 443, 448, 0, 357, 213, 258,
+	246, 353, 256, 288, 442, 444, 445, 447, 211, 351,
+	264, 332, 422, 250, 430, 320, 207, 270, 388, 284,
+	293, 0, 0, 338, 369, 216, 425, 389, 566, 577,
+	572, 573, 570, 571, 565, 569, 568, 567, 580, 557,
+	558, 559, 560, 562, 0, 574, 575, 561, 187, 200,
+	289, 0, 358, 254, 450, 432, 428, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 201, 209, 219, 231, 244, 252,
+	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
+	305, 306, 322, 323, 324, 327, 330, 331, 334, 336,
+	337, 340, 346, 347, 348, 349, 350, 352, 359, 363,
+	371, 372, 373, 374, 375, 377, 378, 382, 383, 384,
+	385, 393, 397, 412, 413, 424, 436, 440, 263, 420,
+	441, 0, 297, 0, 0, 299, 248, 265, 274, 0,
+	431, 394, 205, 365, 255, 194, 222, 208, 229, 243,
+	245, 278, 307, 313, 342, 345, 260, 240, 220, 362,
+	217, 380, 400, 401, 402, 404, 311, 236, 329, 0,
+	0, 0, 0, 521, 0, 0, 0, 239, 0, 520,
+	0, 0, 0, 287, 0, 0, 0, 0, 343, 0,
+	381, 225, 296, 294, 409, 249, 242, 238, 224, 271,
+	302, 341, 399, 335, 564, 291, 0, 0, 390, 314,
+	0, 0, 0, 0, 0, 555, 556, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 223, 192, 326, 391,
+	253, 73, 0, 0, 184, 185, 186, 542, 541, 544,
+	545, 546, 547, 0, 0, 214, 543, 221, 548, 549,
+	550, 0, 235, 275, 241, 234, 406, 0, 0, 0,
+	518, 535, 0, 563, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 532, 533, 0, 0, 0, 0, 579,
+	0, 534, 0, 0, 527, 528, 530, 529, 531, 536,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
+	0, 315, 0, 578, 0, 0, 437, 0, 0, 576,
+	0, 0, 0, 0, 0, 286, 0, 283, 188, 203,
+	0, 0, 325, 364, 370, 0, 0, 0, 226, 0,
+	368, 339, 423, 210, 251, 361, 344, 366, 0, 0,
+	367, 292, 411, 356, 421, 438, 439, 233, 319, 429,
+	403, 435, 449, 204, 230, 333, 396, 426, 387, 312,
+	407, 408, 282, 386, 259, 191, 290, 446, 202, 376,
+	218, 195, 398, 419, 215, 379, 0, 0, 0, 197,
+	417, 395, 309, 279, 280, 196, 0, 360, 237, 257,
+	228, 328, 414, 415, 227, 451, 206, 434, 199, 0,
+	433, 321, 410, 418, 310, 301, 198, 416, 308, 300,
+	285, 247, 267, 354, 295, 355, 268, 317, 316, 318,
+	0, 193, 0, 392, 427, 452, 212, 0, 0, 405,
+	443, 448, 0, 357, 213, 258, 246, 353, 256, 288,
+	442, 444, 445, 447, 211, 351, 264, 332, 422, 250,
+	430, 320, 207, 270, 388, 284, 293, 0, 0, 338,
+	369, 216, 425, 389, 566, 577, 572, 573, 570, 571,
+	565, 569, 568, 567, 580, 557, 558, 559, 560, 562,
+	0, 574, 575, 561, 187, 200, 289, 0, 358, 254,
+	450, 432, 428, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	201, 209, 219, 231, 244, 252, 262, 266, 269, 272,
+	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
+	324, 327, 330, 331, 334, 336, 337, 340, 346, 347,
+	348, 349, 350, 352, 359, 363, 371, 372, 373, 374,
+	375, 377, 378, 382, 383, 384, 385, 393, 397, 412,
+	413, 424, 436, 440, 263, 420, 441, 0, 297, 0,
+	0, 299, 248, 265, 274, 0, 431, 394, 205, 365,
+	255, 194, 222, 208, 229, 243, 245, 278, 307, 313,
+	342, 345, 260, 240, 220, 362, 217, 380, 400, 401,
+	402, 404, 311, 236, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 287,
+	0, 0, 0, 0, 343, 0, 381, 225, 296, 294,
+	409, 249, 242, 238, 224, 271, 302, 341, 399, 335,
+	564, 291, 0, 0, 390, 314, 0, 0, 0, 0,
+	0, 555, 556, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 223, 192, 326, 391, 253, 73, 0, 0,
+	184, 185, 186, 542, 541, 544, 545, 546, 547, 0,
+	0, 214, 543, 221, 548, 549, 550, 0, 235, 275,
+	241, 234, 406, 0, 0, 0, 0, 535, 0, 563,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 532,
+	533, 0, 0, 0, 0, 579, 0, 534, 0, 0,
+	527, 528, 530, 529, 531, 536, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 261, 0, 315, 0, 578,
+	0, 0, 437, 0, 0, 576, 0, 0, 0, 0,
+	0, 286, 0, 283, 188, 203, 0, 0, 325, 364,
+	370, 0, 0, 0, 226, 0, 368, 339, 423, 210,
+	251, 361, 344, 366, 2204, 0, 367, 292, 411, 356,
+	421, 438, 439, 233, 319, 429, 403, 435, 449, 204,
+	230, 333, 396, 426, 387, 312, 407, 408, 282, 386,
+	259, 191, 290, 446, 202, 376, 218, 195, 398, 419,
+	215, 379, 0, 0, 0, 197, 417, 395, 309, 279,
+	280, 196, 0, 360, 237, 257, 228, 328, 414, 415,
+	227, 451, 206, 434, 199, 0, 433, 321, 410, 418,
+	310, 301, 198, 416, 308, 300, 285, 247, 267, 354,
+	295, 355, 268, 317, 316, 318, 0, 193, 0, 392,
+	427, 452, 212, 0, 0, 405, 443, 448, 0, 357,
+	213, 258, 246, 353, 256, 288, 442, 444, 445, 447,
+	211, 351, 264, 332, 422, 250, 430, 320, 207, 270,
+	388, 284, 293, 0, 0, 338, 369, 216, 425, 389,
+	566, 577, 572, 573, 570, 571, 565, 569, 568, 567,
+	580, 557, 558, 559, 560, 562, 0, 574, 575, 561,
+	187, 200, 289, 0, 358, 254, 450, 432, 428, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 201, 209, 219, 231,
+	244, 252, 262, 266, 269, 272, 273, 276, 281, 298,
+	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
+	334, 336, 337, 340, 346, 347, 348, 349, 350, 352,
+	359, 363, 371, 372, 373, 374, 375, 377, 378, 382,
+	383, 384, 385, 393, 397, 412, 413, 424, 436, 440,
+	263, 420, 441, 0, 297, 0, 0, 299, 248, 265,
+	274, 0, 431, 394, 205, 365, 255, 194, 222, 208,
+	229, 243, 245, 278, 307, 313, 342, 345, 260, 240,
+	220, 362, 217, 380, 400, 401, 402, 404, 311, 236,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
+	343, 0, 381, 225, 296, 294, 409, 249, 242, 238,
+	224, 271, 302, 341, 399, 335, 564, 291, 0, 0,
+	390, 314, 0, 0, 0, 0, 0, 555, 556, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 223, 192,
+	326, 391, 253, 73, 0, 601, 184, 185, 186, 542,
+	541, 544, 545, 546, 547, 0, 0, 214, 543, 221,
+	548, 549, 550, 0, 235, 275, 241, 234, 406, 0,
+	0, 0, 0, 535, 0, 563, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 532, 533, 0, 0, 0,
+	0, 579, 0, 534, 0, 0, 527, 528, 530, 529,
+	531, 536, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 261, 0, 315, 0, 578, 0, 0, 437, 0,
+	0, 576, 0, 0, 0, 0, 0, 286, 0, 283,
+	188, 203, 0, 0, 325, 364, 370, 0, 0, 0,
+	226, 0, 368, 339, 423, 210, 251, 361, 344, 366,
+	0, 0, 367, 292, 411, 356, 421, 438, 439, 233,
+	319, 429, 403, 435, 449, 204, 230, 333, 396, 426,
+	387, 312, 407, 408, 282, 386, 259, 191, 290, 446,
+	202, 376, 218, 195, 398, 419, 215, 379, 0, 0,
+	0, 197, 417, 395, 309, 279, 280, 196, 0, 360,
+	237, 257, 228, 328, 414, 415, 227, 451, 206, 434,
+	199, 0, 433, 321, 410, 418, 310, 301, 198, 416,
+	308, 300, 285, 247, 267, 354, 295, 355, 268, 317,
+	316, 318, 0, 193, 0, 392, 427, 452, 212, 0,
+	0, 405, 443, 448, 0, 357, 213, 258, 246, 353,
+	256, 288, 442, 444, 445, 447, 211, 351, 264, 332,
+	422, 250, 430, 320, 207, 270, 388, 284, 293, 0,
+	0, 338, 369, 216, 425, 389, 566, 577, 572, 573,
+	570, 571, 565, 569, 568, 567, 580, 557, 558, 559,
+	560, 562, 0, 574, 575, 561, 187, 200, 289, 0,
+	358, 254, 450, 432, 428, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 201, 209, 219, 231, 244, 252, 262, 266,
+	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
+	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
+	346, 347, 348, 349, 350, 352, 359, 363, 371, 372,
+	373, 374, 375, 377, 378, 382, 383, 384, 385, 393,
+	397, 412, 413, 424, 436, 440, 263, 420, 441, 0,
+	297, 0, 0, 299, 248, 265, 274, 0, 431, 394,
+	205, 365, 255, 194, 222, 208, 229, 243, 245, 278,
+	307, 313, 342, 345, 260, 240, 220, 362, 217, 380,
+	400, 401, 402, 404, 311, 236, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 0, 343, 0, 381, 225,
+	296, 294, 409, 249, 242, 238, 224, 271, 302, 341,
+	399, 335, 564, 291, 0, 0, 390, 314, 0, 0,
+	0, 0, 0, 555, 556, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 223, 192, 326, 391, 253, 73,
+	0, 0, 184, 185, 186, 542, 541, 544, 545, 546,
+	547, 0, 0, 214, 543, 221, 548, 549, 550, 0,
+	235, 275, 241, 234, 406, 0, 0, 0, 0, 535,
+	0, 563, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 532, 533, 0, 0, 0, 0, 579, 0, 534,
+	0, 0, 527, 528, 530, 529, 531, 536, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
+	0, 578, 0, 0, 437, 0, 0, 576, 0, 0,
+	0, 0, 0, 286, 0, 283, 188, 203, 0, 0,
+	325, 364, 370, 0, 0, 0, 226, 0, 368, 339,
+	423, 210, 251, 361, 344, 366, 0, 0, 367, 292,
+	411, 356, 421, 438, 439, 233, 319, 429, 403, 435,
+	449, 204, 230, 333, 396, 426, 387, 312, 407, 408,
+	282, 386, 259, 191, 290, 446, 202, 376, 218, 195,
+	398, 419, 215, 379, 0, 0, 0, 197, 417, 395,
+	309, 279, 280, 196, 0, 360, 237, 257, 228, 328,
+	414, 415, 227, 451, 206, 434, 199, 0, 433, 321,
+	410, 418, 310, 301, 198, 416, 308, 300, 285, 247,
+	267, 354, 295, 355, 268, 317, 316, 318, 0, 193,
+	0, 392, 427, 452, 212, 0, 0, 405, 443, 448,
+	0, 357, 213, 258, 246, 353, 256, 288, 442, 444,
+	445, 447, 211, 351, 264, 332, 422, 250, 430, 320,
+	207, 270, 388, 284, 293, 0, 0, 338, 369, 216,
+	425, 389, 566, 577, 572, 573, 570, 571, 565, 569,
+	568, 567, 580, 557, 558, 559, 560, 562, 0, 574,
+	575, 561, 187, 200, 289, 0, 358, 254, 450, 432,
+	428, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 201, 209,
+	219, 231, 244, 252, 262, 266, 269, 272, 273, 276,
+	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
+	330, 331, 334, 336, 337, 340, 346, 347, 348, 349,
+	350, 352, 359, 363, 371, 372, 373, 374, 375, 377,
+	378, 382, 383, 384, 385, 393, 397, 412, 413, 424,
+	436, 440, 263, 420, 441, 0, 297, 0, 0, 299,
+	248, 265, 274, 0, 431, 394, 205, 365, 255, 194,
+	222, 208, 229, 243, 245, 278, 307, 313, 342, 345,
+	260, 240, 220, 362, 217, 380, 400, 401, 402, 404,
+	311, 236, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 239, 0, 0, 0, 0, 0, 287, 0, 0,
+	0, 0, 343, 0, 381, 225, 296, 294, 409, 249,
+	242, 238, 224, 271, 302, 341, 399, 335, 0, 291,
+	0, 0, 390, 314, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	223, 192, 326, 391, 253, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 221, 0, 0, 0, 0, 235, 275, 241, 234,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 988, 987, 997, 998, 990, 991, 992,
+	993, 994, 995, 996, 989, 0, 0, 999, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 261, 0, 315, 0, 0, 0, 0,
+	437, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	0, 283, 188, 203, 0, 0, 325, 364, 370, 0,
+	0, 0, 226, 0, 368, 339, 423, 210, 251, 361,
+	344, 366, 0, 0, 367, 292, 411, 356, 421, 438,
+	439, 233, 319, 429, 403, 435, 449, 204, 230, 333,
+	396, 426, 387, 312, 407, 408, 282, 386, 259, 191,
+	290, 446, 202, 376, 218, 195, 398, 419, 215, 379,
+	0, 0, 0, 197, 417, 395, 309, 279, 280, 196,
+	0, 360, 237, 257, 228, 328, 414, 415, 227, 451,
+	206, 434, 199, 0, 433, 321, 410, 418, 310, 301,
+	198, 416, 308, 300, 285, 247, 267, 354, 295, 355,
+	268, 317, 316, 318, 0, 193, 0, 392, 427, 452,
+	212, 0, 0, 405, 443, 448, 0, 357, 213, 258,
+	246, 353, 256, 288, 442, 444, 445, 447, 211, 351,
+	264, 332, 422, 250, 430, 320, 207, 270, 388, 284,
+	293, 0, 0, 338, 369, 216, 425, 389, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
+	289, 0, 358, 254, 450, 432, 428, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 201, 209, 219, 231, 244, 252,
+	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
+	305, 306, 322, 323, 324, 327, 330, 331, 334, 336,
+	337, 340, 346, 347, 348, 349, 350, 352, 359, 363,
+	371, 372, 373, 374, 375, 377, 378, 382, 383, 384,
+	385, 393, 397, 412, 413, 424, 436, 440, 263, 420,
+	441, 0, 297, 0, 0, 299, 248, 265, 274, 0,
+	431, 394, 205, 365, 255, 194, 222, 208, 229, 243,
+	245, 278, 307, 313, 342, 345, 260, 240, 220, 362,
+	217, 380, 400, 401, 402, 404, 311, 236, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 239, 814, 0,
+	0, 0, 0, 287, 0, 0, 0, 0, 343, 0,
+	381, 225, 296, 294, 409, 249, 242, 238, 224, 271,
+	302, 341, 399, 335, 0, 291, 0, 0, 390, 314,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 223, 192, 326, 391,
+	253, 0, 0, 0, 184, 185, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 221, 0, 0,
+	0, 0, 235, 275, 241, 234, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
+	0, 315, 0, 0, 0, 813, 437, 0, 0, 0,
+	0, 0, 0, 810, 811, 286, 779, 283, 188, 203,
+	804, 808, 325, 364, 370, 0, 0, 0, 226, 0,
+	368, 339, 423, 210, 251, 361, 344, 366, 0, 0,
+	367, 292, 411, 356, 421, 438, 439, 233, 319, 429,
+	403, 435, 449, 204, 230, 333, 396, 426, 387, 312,
+	407, 408, 282, 386, 259, 191, 290, 446, 202, 376,
+	218, 195, 398, 419, 215, 379, 0, 0, 0, 197,
+	417, 395, 309, 279, 280, 196, 0, 360, 237, 257,
+	228, 328, 414, 415, 227, 451, 206, 434, 199, 0,
+	433, 321, 410, 418, 310, 301, 198, 416, 308, 300,
+	285, 247, 267, 354, 295, 355, 268, 317, 316, 318,
+	0, 193, 0, 392, 427, 452, 212, 0, 0, 405,
+	443, 448, 0, 357, 213, 258, 246, 353, 256, 288,
+	442, 444, 445, 447, 211, 351, 264, 332, 422, 250,
+	430, 320, 207, 270, 388, 284, 293, 0, 0, 338,
+	369, 216, 425, 389, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 187, 200, 289, 0, 358, 254,
+	450, 432, 428, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	201, 209, 219, 231, 244, 252, 262, 266, 269, 272,
+	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
+	324, 327, 330, 331, 334, 336, 337, 340, 346, 347,
+	348, 349, 350, 352, 359, 363, 371, 372, 373, 374,
+	375, 377, 378, 382, 383, 384, 385, 393, 397, 412,
+	413, 424, 436, 440, 263, 420, 441, 0, 297, 0,
+	0, 299, 248, 265, 274, 0, 431, 394, 205, 365,
+	255, 194, 222, 208, 229, 243, 245, 278, 307, 313,
+	342, 345, 260, 240, 220, 362, 217, 380, 400, 401,
+	402, 404, 311, 236, 329, 0, 0, 0, 1092, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 287,
+	0, 0, 0, 0, 343, 0, 381, 225, 296, 294,
+	409, 249, 242, 238, 224, 271, 302, 341, 399, 335,
+	0, 291, 0, 0, 390, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 223, 192, 326, 391, 253, 0, 0, 0,
+	184, 185, 186, 0, 1094, 0, 0, 0, 0, 0,
+	0, 214, 0, 221, 0, 0, 0, 0, 235, 275,
+	241, 234, 406, 977, 978, 976, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 979, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
+	0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 0, 283, 188, 203, 0, 0, 325, 364,
+	370, 0, 0, 0, 226, 0, 368, 339, 423, 210,
+	251, 361, 344, 366, 0, 0, 367, 292, 411, 356,
+	421, 438, 439, 233, 319, 429, 403, 435, 449, 204,
+	230, 333, 396, 426, 387, 312, 407, 408, 282, 386,
+	259, 191, 290, 446, 202, 376, 218, 195, 398, 419,
+	215, 379, 0, 0, 0, 197, 417, 395, 309, 279,
+	280, 196, 0, 360, 237, 257, 228, 328, 414, 415,
+	227, 451, 206, 434, 199, 0, 433, 321, 410, 418,
+	310, 301, 198, 416, 308, 300, 285, 247, 267, 354,
+	295, 355, 268, 317, 316, 318, 0, 193, 0, 392,
+	427, 452, 212, 0, 0, 405, 443, 448, 0, 357,
+	213, 258, 246, 353, 256, 288, 442, 444, 445, 447,
+	211, 351, 264, 332, 422, 250, 430, 320, 207, 270,
+	388, 284, 293, 0, 0, 338, 369, 216, 425, 389,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 200, 289, 0, 358, 254, 450, 432, 428, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 201, 209, 219, 231,
+	244, 252, 262, 266, 269, 272, 273, 276, 281, 298,
+	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
+	334, 336, 337, 340, 346, 347, 348, 349, 350, 352,
+	359, 363, 371, 372, 373, 374, 375, 377, 378, 382,
+	383, 384, 385, 393, 397, 412, 413, 424, 436, 440,
+	263, 420, 441, 0, 297, 0, 0, 299, 248, 265,
+	274, 0, 431, 394, 205, 365, 255, 194, 222, 208,
+	229, 243, 245, 278, 307, 313, 342, 345, 260, 240,
+	220, 362, 217, 380, 400, 401, 402, 404, 311, 236,
+	36, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 0, 287, 0,
+	0, 0, 0, 343, 0, 381, 225, 296, 294, 409,
+	249, 242, 238, 224, 271, 302, 341, 399, 335, 0,
+	291, 0, 0, 390, 314, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	277, 223, 192, 326, 391, 253, 73, 0, 601, 184,
+	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	214, 0, 221, 0, 0, 0, 0, 235, 275, 241,
+	234, 406, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 261, 0, 315, 0, 0, 0,
+	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
+	286, 0, 283, 188, 203, 0, 0, 325, 364, 370,
+	0, 0, 0, 226, 0, 368, 339, 423, 210, 251,
+	361, 344, 366, 0, 0, 367, 292, 411, 356, 421,
+	438, 439, 233, 319, 429, 403, 435, 449, 204, 230,
+	333, 396, 426, 387, 312, 407, 408, 282, 386, 259,
+	191, 290, 446, 202, 376, 218, 195, 398, 419, 215,
+	379, 0, 0, 0, 197, 417, 395, 309, 279, 280,
+	196, 0, 360, 237, 257, 228, 328, 414, 415, 227,
+	451, 206, 434, 199, 0, 433, 321, 410, 418, 310,
+	301, 198, 416, 308, 300, 285, 247, 267, 354, 295,
+	355, 268, 317, 316, 318, 0, 193, 0, 392, 427,
+	452, 212, 0, 0, 405, 443, 448, 0, 357, 213,
+	258, 246, 353, 256, 288, 442, 444, 445, 447, 211,
+	351, 264, 332, 422, 250, 430, 320, 207, 270, 388,
+	284, 293, 0, 0, 338, 369, 216, 425, 389, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 1229, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 0, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	327, 0, 1227, 0, 0, 0, 0, 0, 0, 237,
-	0, 0, 0, 0, 0, 285, 0, 0, 0, 341,
-	0, 379, 223, 294, 292, 407, 247, 240, 236, 222,
-	269, 300, 339, 397, 333, 0, 289, 0, 0, 388,
-	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 275, 221, 190, 324,
-	389, 251, 0, 0, 0, 182, 183, 184, 0, 0,
-	0, 0, 0, 0, 0, 0, 212, 0, 219, 0,
-	0, 0, 0, 233, 273, 239, 232, 404, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	259, 0, 313, 0, 0, 0, 0, 435, 0, 0,
-	0, 0, 0, 0, 0, 0, 284, 0, 281, 186,
-	201, 0, 0, 323, 362, 368, 0, 0, 0, 224,
-	0, 366, 337, 421, 208, 249, 359, 342, 364, 0,
-	0, 365, 290, 409, 354, 419, 436, 437, 231, 317,
-	427, 401, 433, 447, 202, 228, 331, 394, 424, 385,
-	310, 405, 406, 280, 384, 257, 189, 288, 444, 200,
-	374, 216, 193, 396, 417, 213, 377, 0, 0, 0,
-	195, 415, 393, 307, 277, 278, 194, 0, 358, 235,
-	255, 226, 326, 412, 413, 225, 449, 204, 432, 197,
-	0, 431, 319, 408, 416, 308, 299, 196, 414, 306,
-	298, 283, 245, 265, 352, 293, 353, 266, 315, 314,
-	316, 0, 191, 0, 390, 425, 450, 210, 0, 0,
-	403, 441, 446, 0, 355, 211, 256, 244, 351, 254,
-	286, 440, 442, 443, 445, 209, 349, 262, 330, 420,
-	248, 428, 318, 205, 268, 386, 282, 291, 0, 0,
-	336, 367, 214, 423, 387, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 185, 198, 287, 0, 356,
-	252, 448, 430, 426, 0, 0, 230, 0, 0, 0,
+	200, 289, 0, 358, 254, 450, 432, 428, 0, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 201, 209, 219, 231, 244,
+	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
+	304, 305, 306, 322, 323, 324, 327, 330, 331, 334,
+	336, 337, 340, 346, 347, 348, 349, 350, 352, 359,
+	363, 371, 372, 373, 374, 375, 377, 378, 382, 383,
+	384, 385, 393, 397, 412, 413, 424, 436, 440, 263,
+	420, 441, 0, 297, 0, 0, 299, 248, 265, 274,
+	0, 431, 394, 205, 365, 255, 194, 222, 208, 229,
+	243, 245, 278, 307, 313, 342, 345, 260, 240, 220,
+	362, 217, 380, 400, 401, 402, 404, 311, 236, 329,
+	0, 0, 0, 1467, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 0, 287, 0, 0, 0, 0, 343,
+	0, 381, 225, 296, 294, 409, 249, 242, 238, 224,
+	271, 302, 341, 399, 335, 0, 291, 0, 0, 390,
+	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 223, 192, 326,
+	391, 253, 0, 0, 0, 184, 185, 186, 0, 1469,
+	0, 0, 0, 0, 0, 0, 214, 0, 221, 0,
+	0, 0, 0, 235, 275, 241, 234, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	261, 0, 315, 0, 0, 0, 0, 437, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 0, 283, 188,
+	203, 0, 0, 325, 364, 370, 0, 0, 0, 226,
+	0, 368, 339, 423, 210, 251, 361, 344, 366, 0,
+	1465, 367, 292, 411, 356, 421, 438, 439, 233, 319,
+	429, 403, 435, 449, 204, 230, 333, 396, 426, 387,
+	312, 407, 408, 282, 386, 259, 191, 290, 446, 202,
+	376, 218, 195, 398, 419, 215, 379, 0, 0, 0,
+	197, 417, 395, 309, 279, 280, 196, 0, 360, 237,
+	257, 228, 328, 414, 415, 227, 451, 206, 434, 199,
+	0, 433, 321, 410, 418, 310, 301, 198, 416, 308,
+	300, 285, 247, 267, 354, 295, 355, 268, 317, 316,
+	318, 0, 193, 0, 392, 427, 452, 212, 0, 0,
+	405, 443, 448, 0, 357, 213, 258, 246, 353, 256,
+	288, 442, 444, 445, 447, 211, 351, 264, 332, 422,
+	250, 430, 320, 207, 270, 388, 284, 293, 0, 0,
+	338, 369, 216, 425, 389, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 200, 289, 0, 358,
+	254, 450, 432, 428, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 201, 209, 219, 231, 244, 252, 262, 266, 269,
+	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
+	323, 324, 327, 330, 331, 334, 336, 337, 340, 346,
+	347, 348, 349, 350, 352, 359, 363, 371, 372, 373,
+	374, 375, 377, 378, 382, 383, 384, 385, 393, 397,
+	412, 413, 424, 436, 440, 263, 420, 441, 0, 297,
+	0, 0, 299, 248, 265, 274, 0, 431, 394, 205,
+	365, 255, 194, 222, 208, 229, 243, 245, 278, 307,
+	313, 342, 345, 260, 240, 220, 362, 217, 380, 400,
+	401, 402, 404, 311, 236, 329, 0, 0, 0, 0,
+	0, 0, 0, 0, 239, 0, 0, 0, 0, 0,
+	287, 0, 0, 0, 0, 343, 0, 381, 225, 296,
+	294, 409, 249, 242, 238, 224, 271, 302, 341, 399,
+	335, 0, 291, 0, 0, 390, 314, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 223, 192, 326, 391, 253, 0, 0,
+	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 214, 0, 221, 0, 0, 0, 0, 235,
+	275, 241, 234, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 773, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 261, 0, 315, 0,
+	0, 0, 0, 437, 0, 0, 0, 0, 0, 0,
+	0, 0, 286, 779, 283, 188, 203, 777, 0, 325,
+	364, 370, 0, 0, 0, 226, 0, 368, 339, 423,
+	210, 251, 361, 344, 366, 0, 0, 367, 292, 411,
+	356, 421, 438, 439, 233, 319, 429, 403, 435, 449,
+	204, 230, 333, 396, 426, 387, 312, 407, 408, 282,
+	386, 259, 191, 290, 446, 202, 376, 218, 195, 398,
+	419, 215, 379, 0, 0, 0, 197, 417, 395, 309,
+	279, 280, 196, 0, 360, 237, 257, 228, 328, 414,
+	415, 227, 451, 206, 434, 199, 0, 433, 321, 410,
+	418, 310, 301, 198, 416, 308, 300, 285, 247, 267,
+	354, 295, 355, 268, 317, 316, 318, 0, 193, 0,
+	392, 427, 452, 212, 0, 0, 405, 443, 448, 0,
+	357, 213, 258, 246, 353, 256, 288, 442, 444, 445,
+	447, 211, 351, 264, 332, 422, 250, 430, 320, 207,
+	270, 388, 284, 293, 0, 0, 338, 369, 216, 425,
+	389, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 187, 200, 289, 0, 358, 254, 450, 432, 428,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 190, 201, 209, 219,
+	231, 244, 252, 262, 266, 269, 272, 273, 276, 281,
+	298, 303, 304, 305, 306, 322, 323, 324, 327, 330,
+	331, 334, 336, 337, 340, 346, 347, 348, 349, 350,
+	352, 359, 363, 371, 372, 373, 374, 375, 377, 378,
+	382, 383, 384, 385, 393, 397, 412, 413, 424, 436,
+	440, 263, 420, 441, 0, 297, 0, 0, 299, 248,
+	265, 274, 0, 431, 394, 205, 365, 255, 194, 222,
+	208, 229, 243, 245, 278, 307, 313, 342, 345, 260,
+	240, 220, 362, 217, 380, 400, 401, 402, 404, 311,
+	236, 329, 0, 0, 0, 1467, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 287, 0, 0, 0,
+	0, 343, 0, 381, 225, 296, 294, 409, 249, 242,
+	238, 224, 271, 302, 341, 399, 335, 0, 291, 0,
+	0, 390, 314, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 223,
+	192, 326, 391, 253, 0, 0, 0, 184, 185, 186,
+	0, 1469, 0, 0, 0, 0, 0, 0, 214, 0,
+	221, 0, 0, 0, 0, 235, 275, 241, 234, 406,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 261, 0, 315, 0, 0, 0, 0, 437,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
+	283, 188, 203, 0, 0, 325, 364, 370, 0, 0,
+	0, 226, 0, 368, 339, 423, 210, 251, 361, 344,
+	366, 0, 0, 367, 292, 411, 356, 421, 438, 439,
+	233, 319, 429, 403, 435, 449, 204, 230, 333, 396,
+	426, 387, 312, 407, 408, 282, 386, 259, 191, 290,
+	446, 202, 376, 218, 195, 398, 419, 215, 379, 0,
+	0, 0, 197, 417, 395, 309, 279, 280, 196, 0,
+	360, 237, 257, 228, 328, 414, 415, 227, 451, 206,
+	434, 199, 0, 433, 321, 410, 418, 310, 301, 198,
+	416, 308, 300, 285, 247, 267, 354, 295, 355, 268,
+	317, 316, 318, 0, 193, 0, 392, 427, 452, 212,
+	0, 0, 405, 443, 448, 0, 357, 213, 258, 246,
+	353, 256, 288, 442, 444, 445, 447, 211, 351, 264,
+	332, 422, 250, 430, 320, 207, 270, 388, 284, 293,
+	0, 0, 338, 369, 216, 425, 389, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 200, 289,
+	0, 358, 254, 450, 432, 428, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 201, 209, 219, 231, 244, 252, 262,
+	266, 269, 272, 273, 276, 281, 298, 303, 304, 305,
+	306, 322, 323, 324, 327, 330, 331, 334, 336, 337,
+	340, 346, 347, 348, 349, 350, 352, 359, 363, 371,
+	372, 373, 374, 375, 377, 378, 382, 383, 384, 385,
+	393, 397, 412, 413, 424, 436, 440, 263, 420, 441,
+	0, 297, 0, 0, 299, 248, 265, 274, 0, 431,
+	394, 205, 365, 255, 194, 222, 208, 229, 243, 245,
+	278, 307, 313, 342, 345, 260, 240, 220, 362, 217,
+	380, 400, 401, 402, 404, 311, 236, 36, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
+	343, 0, 381, 225, 296, 294, 409, 249, 242, 238,
+	224, 271, 302, 341, 399, 335, 0, 291, 0, 0,
+	390, 314, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 223, 192,
+	326, 391, 253, 73, 0, 0, 184, 185, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 221,
+	0, 0, 0, 0, 235, 275, 241, 234, 406, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 261, 0, 315, 0, 0, 0, 0, 437, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 0, 283,
+	188, 203, 0, 0, 325, 364, 370, 0, 0, 0,
+	226, 0, 368, 339, 423, 210, 251, 361, 344, 366,
+	0, 0, 367, 292, 411, 356, 421, 438, 439, 233,
+	319, 429, 403, 435, 449, 204, 230, 333, 396, 426,
+	387, 312, 407, 408, 282, 386, 259, 191, 290, 446,
+	202, 376, 218, 195, 398, 419, 215, 379, 0, 0,
+	0, 197, 417, 395, 309, 279, 280, 196, 0, 360,
+	237, 257, 228, 328, 414, 415, 227, 451, 206, 434,
+	199, 0, 433, 321, 410, 418, 310, 301, 198, 416,
+	308, 300, 285, 247, 267, 354, 295, 355, 268, 317,
+	316, 318, 0, 193, 0, 392, 427, 452, 212, 0,
+	0, 405, 443, 448, 0, 357, 213, 258, 246, 353,
+	256, 288, 442, 444, 445, 447, 211, 351, 264, 332,
+	422, 250, 430, 320, 207, 270, 388, 284, 293, 0,
+	0, 338, 369, 216, 425, 389, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 187, 200, 289, 0,
+	358, 254, 450, 432, 428, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 201, 209, 219, 231, 244, 252, 262, 266,
+	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
+	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
+	346, 347, 348, 349, 350, 352, 359, 363, 371, 372,
+	373, 374, 375, 377, 378, 382, 383, 384, 385, 393,
+	397, 412, 413, 424, 436, 440, 263, 420, 441, 0,
+	297, 0, 0, 299, 248, 265, 274, 0, 431, 394,
+	205, 365, 255, 194, 222, 208, 229, 243, 245, 278,
+	307, 313, 342, 345, 260, 240, 220, 362, 217, 380,
+	400, 401, 402, 404, 311, 236, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 0, 343, 0, 381, 225,
+	296, 294, 409, 249, 242, 238, 224, 271, 302, 341,
+	399, 335, 0, 291, 0, 0, 390, 314, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 223, 192, 326, 391, 253, 0,
+	0, 0, 184, 185, 186, 0, 0, 1487, 0, 0,
+	1488, 0, 0, 214, 0, 221, 0, 0, 0, 0,
+	235, 275, 241, 234, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
+	0, 0, 0, 0, 437, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 188, 203, 0, 0,
+	325, 364, 370, 0, 0, 0, 226, 0, 368, 339,
+	423, 210, 251, 361, 344, 366, 0, 0, 367, 292,
+	411, 356, 421, 438, 439, 233, 319, 429, 403, 435,
+	449, 204, 230, 333, 396, 426, 387, 312, 407, 408,
+	282, 386, 259, 191, 290, 446, 202, 376, 218, 195,
+	398, 419, 215, 379, 0, 0, 0, 197, 417, 395,
+	309, 279, 280, 196, 0, 360, 237, 257, 228, 328,
+	414, 415, 227, 451, 206, 434, 199, 0, 433, 321,
+	410, 418, 310, 301, 198, 416, 308, 300, 285, 247,
+	267, 354, 295, 355, 268, 317, 316, 318, 0, 193,
+	0, 392, 427, 452, 212, 0, 0, 405, 443, 448,
+	0, 357, 213, 258, 246, 353, 256, 288, 442, 444,
+	445, 447, 211, 351, 264, 332, 422, 250, 430, 320,
+	207, 270, 388, 284, 293, 0, 0, 338, 369, 216,
+	425, 389, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 200, 289, 0, 358, 254, 450, 432,
+	428, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 201, 209,
+	219, 231, 244, 252, 262, 266, 269, 272, 273, 276,
+	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
+	330, 331, 334, 336, 337, 340, 346, 347, 348, 349,
+	350, 352, 359, 363, 371, 372, 373, 374, 375, 377,
+	378, 382, 383, 384, 385, 393, 397, 412, 413, 424,
+	436, 440, 263, 420, 441, 0, 297, 0, 0, 299,
+	248, 265, 274, 0, 431, 394, 205, 365, 255, 194,
+	222, 208, 229, 243, 245, 278, 307, 313, 342, 345,
+	260, 240, 220, 362, 217, 380, 400, 401, 402, 404,
+	311, 236, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 239, 0, 1125, 0, 0, 0, 287, 0, 0,
+	0, 0, 343, 0, 381, 225, 296, 294, 409, 249,
+	242, 238, 224, 271, 302, 341, 399, 335, 0, 291,
+	0, 0, 390, 314, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	223, 192, 326, 391, 253, 0, 0, 0, 184, 185,
+	186, 0, 1124, 0, 0, 0, 0, 0, 0, 214,
+	0, 221, 0, 0, 0, 0, 235, 275, 241, 234,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 261, 0, 315, 0, 0, 0, 0,
+	437, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	0, 283, 188, 203, 0, 0, 325, 364, 370, 0,
+	0, 0, 226, 0, 368, 339, 423, 210, 251, 361,
+	344, 366, 0, 0, 367, 292, 411, 356, 421, 438,
+	439, 233, 319, 429, 403, 435, 449, 204, 230, 333,
+	396, 426, 387, 312, 407, 408, 282, 386, 259, 191,
+	290, 446, 202, 376, 218, 195, 398, 419, 215, 379,
+	0, 0, 0, 197, 417, 395, 309, 279, 280, 196,
+	0, 360, 237, 257, 228, 328, 414, 415, 227, 451,
+	206, 434, 199, 0, 433, 321, 410, 418, 310, 301,
+	198, 416, 308, 300, 285, 247, 267, 354, 295, 355,
+	268, 317, 316, 318, 0, 193, 0, 392, 427, 452,
+	212, 0, 0, 405, 443, 448, 0, 357, 213, 258,
+	246, 353, 256, 288, 442, 444, 445, 447, 211, 351,
+	264, 332, 422, 250, 430, 320, 207, 270, 388, 284,
+	293, 0, 0, 338, 369, 216, 425, 389, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
+	289, 0, 358, 254, 450, 432, 428, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 201, 209, 219, 231, 244, 252,
+	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
+	305, 306, 322, 323, 324, 327, 330, 331, 334, 336,
+	337, 340, 346, 347, 348, 349, 350, 352, 359, 363,
+	371, 372, 373, 374, 375, 377, 378, 382, 383, 384,
+	385, 393, 397, 412, 413, 424, 436, 440, 263, 420,
+	441, 0, 297, 0, 0, 299, 248, 265, 274, 0,
+	431, 394, 205, 365, 255, 194, 222, 208, 229, 243,
+	245, 278, 307, 313, 342, 345, 260, 240, 220, 362,
+	217, 380, 400, 401, 402, 404, 311, 236, 329, 0,
+	0, 0, 0, 0, 0, 0, 0, 239, 0, 0,
+	0, 0, 0, 287, 0, 0, 0, 0, 343, 0,
+	381, 225, 296, 294, 409, 249, 242, 238, 224, 271,
+	302, 341, 399, 335, 0, 291, 0, 0, 390, 314,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 223, 192, 326, 391,
+	253, 0, 0, 601, 184, 185, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 221, 0, 0,
+	0, 0, 235, 275, 241, 234, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
+	0, 315, 0, 0, 0, 0, 437, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 0, 283, 188, 203,
+	0, 0, 325, 364, 370, 0, 0, 0, 226, 0,
+	368, 339, 423, 210, 251, 361, 344, 366, 0, 0,
+	367, 292, 411, 356, 421, 438, 439, 233, 319, 429,
+	403, 435, 449, 204, 230, 333, 396, 426, 387, 312,
+	407, 408, 282, 386, 259, 191, 290, 446, 202, 376,
+	218, 195, 398, 419, 215, 379, 0, 0, 0, 197,
+	417, 395, 309, 279, 280, 196, 0, 360, 237, 257,
+	228, 328, 414, 415, 227, 451, 206, 434, 199, 0,
+	433, 321, 410, 418, 310, 301, 198, 416, 308, 300,
+	285, 247, 267, 354, 295, 355, 268, 317, 316, 318,
+	0, 193, 0, 392, 427, 452, 212, 0, 0, 405,
+	443, 448, 0, 357, 213, 258, 246, 353, 256, 288,
+	442, 444, 445, 447, 211, 351, 264, 332, 422, 250,
+	430, 320, 207, 270, 388, 284, 293, 0, 0, 338,
+	369, 216, 425, 389, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 187, 200, 289, 0, 358, 254,
+	450, 432, 428, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	201, 209, 219, 231, 244, 252, 262, 266, 269, 272,
+	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
+	324, 327, 330, 331, 334, 336, 337, 340, 346, 347,
+	348, 349, 350, 352, 359, 363, 371, 372, 373, 374,
+	375, 377, 378, 382, 383, 384, 385, 393, 397, 412,
+	413, 424, 436, 440, 263, 420, 441, 0, 297, 0,
+	0, 299, 248, 265, 274, 0, 431, 394, 205, 365,
+	255, 194, 222, 208, 229, 243, 245, 278, 307, 313,
+	342, 345, 260, 240, 220, 362, 217, 380, 400, 401,
+	402, 404, 311, 236, 329, 0, 0, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 287,
+	0, 0, 0, 0, 343, 0, 381, 225, 296, 294,
+	409, 249, 242, 238, 224, 271, 302, 341, 399, 335,
+	0, 291, 0, 0, 390, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 223, 192, 326, 391, 253, 73, 0, 0,
+	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 221, 0, 0, 0, 0, 235, 275,
+	241, 234, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
+	0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 0, 283, 188, 203, 0, 0, 325, 364,
+	370, 0, 0, 0, 226, 0, 368, 339, 423, 210,
+	251, 361, 344, 366, 0, 0, 367, 292, 411, 356,
+	421, 438, 439, 233, 319, 429, 403, 435, 449, 204,
+	230, 333, 396, 426, 387, 312, 407, 408, 282, 386,
+	259, 191, 290, 446, 202, 376, 218, 195, 398, 419,
+	215, 379, 0, 0, 0, 197, 417, 395, 309, 279,
+	280, 196, 0, 360, 237, 257, 228, 328, 414, 415,
+	227, 451, 206, 434, 199, 0, 433, 321, 410, 418,
+	310, 301, 198, 416, 308, 300, 285, 247, 267, 354,
+	295, 355, 268, 317, 316, 318, 0, 193, 0, 392,
+	427, 452, 212, 0, 0, 405, 443, 448, 0, 357,
+	213, 258, 246, 353, 256, 288, 442, 444, 445, 447,
+	211, 351, 264, 332, 422, 250, 430, 320, 207, 270,
+	388, 284, 293, 0, 0, 338, 369, 216, 425, 389,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 200, 289, 0, 358, 254, 450, 432, 428, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 201, 209, 219, 231,
+	244, 252, 262, 266, 269, 272, 273, 276, 281, 298,
+	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
+	334, 336, 337, 340, 346, 347, 348, 349, 350, 352,
+	359, 363, 371, 372, 373, 374, 375, 377, 378, 382,
+	383, 384, 385, 393, 397, 412, 413, 424, 436, 440,
+	263, 420, 441, 0, 297, 0, 0, 299, 248, 265,
+	274, 0, 431, 394, 205, 365, 255, 194, 222, 208,
+	229, 243, 245, 278, 307, 313, 342, 345, 260, 240,
+	220, 362, 217, 380, 400, 401, 402, 404, 311, 236,
+	329, 0, 0, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
+	343, 0, 381, 225, 296, 294, 409, 249, 242, 238,
+	224, 271, 302, 341, 399, 335, 0, 291, 0, 0,
+	390, 314, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 223, 192,
+	326, 391, 253, 0, 0, 0, 184, 185, 186, 0,
+	1469, 0, 0, 0, 0, 0, 0, 214, 0, 221,
+	0, 0, 0, 0, 235, 275, 241, 234, 406, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 261, 0, 315, 0, 0, 0, 0, 437, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 0, 283,
+	188, 203, 0, 0, 325, 364, 370, 0, 0, 0,
+	226, 0, 368, 339, 423, 210, 251, 361, 344, 366,
+	0, 0, 367, 292, 411, 356, 421, 438, 439, 233,
+	319, 429, 403, 435, 449, 204, 230, 333, 396, 426,
+	387, 312, 407, 408, 282, 386, 259, 191, 290, 446,
+	202, 376, 218, 195, 398, 419, 215, 379, 0, 0,
+	0, 197, 417, 395, 309, 279, 280, 196, 0, 360,
+	237, 257, 228, 328, 414, 415, 227, 451, 206, 434,
+	199, 0, 433, 321, 410, 418, 310, 301, 198, 416,
+	308, 300, 285, 247, 267, 354, 295, 355, 268, 317,
+	316, 318, 0, 193, 0, 392, 427, 452, 212, 0,
+	0, 405, 443, 448, 0, 357, 213, 258, 246, 353,
+	256, 288, 442, 444, 445, 447, 211, 351, 264, 332,
+	422, 250, 430, 320, 207, 270, 388, 284, 293, 0,
+	0, 338, 369, 216, 425, 389, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 187, 200, 289, 0,
+	358, 254, 450, 432, 428, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 201, 209, 219, 231, 244, 252, 262, 266,
+	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
+	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
+	346, 347, 348, 349, 350, 352, 359, 363, 371, 372,
+	373, 374, 375, 377, 378, 382, 383, 384, 385, 393,
+	397, 412, 413, 424, 436, 440, 263, 420, 441, 0,
+	297, 0, 0, 299, 248, 265, 274, 0, 431, 394,
+	205, 365, 255, 194, 222, 208, 229, 243, 245, 278,
+	307, 313, 342, 345, 260, 240, 220, 362, 217, 380,
+	400, 401, 402, 404, 311, 236, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 0, 343, 0, 381, 225,
+	296, 294, 409, 249, 242, 238, 224, 271, 302, 341,
+	399, 335, 0, 291, 0, 0, 390, 314, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 223, 192, 326, 391, 253, 0,
+	0, 0, 184, 185, 186, 0, 1094, 0, 0, 0,
+	0, 0, 0, 214, 0, 221, 0, 0, 0, 0,
+	235, 275, 241, 234, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
+	0, 0, 0, 0, 437, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 188, 203, 0, 0,
+	325, 364, 370, 0, 0, 0, 226, 0, 368, 339,
+	423, 210, 251, 361, 344, 366, 0, 0, 367, 292,
+	411, 356, 421, 438, 439, 233, 319, 429, 403, 435,
+	449, 204, 230, 333, 396, 426, 387, 312, 407, 408,
+	282, 386, 259, 191, 290, 446, 202, 376, 218, 195,
+	398, 419, 215, 379, 0, 0, 0, 197, 417, 395,
+	309, 279, 280, 196, 0, 360, 237, 257, 228, 328,
+	414, 415, 227, 451, 206, 434, 199, 0, 433, 321,
+	410, 418, 310, 301, 198, 416, 308, 300, 285, 247,
+	267, 354, 295, 355, 268, 317, 316, 318, 0, 193,
+	0, 392, 427, 452, 212, 0, 0, 405, 443, 448,
+	0, 357, 213, 258, 246, 353, 256, 288, 442, 444,
+	445, 447, 211, 351, 264, 332, 422, 250, 430, 320,
+	207, 270, 388, 284, 293, 0, 0, 338, 369, 216,
+	425, 389, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 200, 289, 0, 358, 254, 450, 432,
+	428, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 201, 209,
+	219, 231, 244, 252, 262, 266, 269, 272, 273, 276,
+	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
+	330, 331, 334, 336, 337, 340, 346, 347, 348, 349,
+	350, 352, 359, 363, 371, 372, 373, 374, 375, 377,
+	378, 382, 383, 384, 385, 393, 397, 412, 413, 424,
+	436, 440, 263, 420, 441, 0, 297, 0, 0, 299,
+	248, 265, 274, 0, 431, 394, 205, 365, 255, 194,
+	222, 208, 229, 243, 245, 278, 307, 313, 342, 345,
+	260, 240, 220, 362, 217, 380, 400, 401, 402, 404,
+	311, 236, 329, 0, 0, 0, 0, 0, 0, 0,
+	0, 239, 0, 0, 0, 0, 0, 287, 0, 0,
+	0, 0, 343, 0, 381, 225, 296, 294, 409, 249,
+	242, 238, 224, 271, 302, 341, 399, 335, 0, 291,
+	0, 0, 390, 314, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	223, 192, 326, 391, 253, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 221, 0, 0, 0, 0, 235, 275, 241, 234,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 261, 0, 315, 0, 0, 0, 0,
+	437, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	0, 283, 188, 203, 0, 0, 325, 364, 370, 0,
+	0, 0, 226, 0, 368, 339, 423, 210, 251, 361,
+	344, 366, 0, 0, 367, 292, 411, 356, 421, 438,
+	439, 233, 319, 429, 403, 435, 449, 204, 230, 333,
+	396, 426, 387, 312, 407, 408, 282, 386, 259, 191,
+	290, 446, 202, 376, 218, 195, 398, 419, 215, 379,
+	0, 0, 0, 197, 417, 395, 309, 279, 280, 196,
+	0, 360, 237, 257, 228, 328, 414, 415, 227, 451,
+	206, 434, 199, 0, 433, 321, 410, 418, 310, 301,
+	198, 416, 308, 300, 285, 247, 267, 354, 295, 355,
+	268, 317, 316, 318, 0, 193, 0, 392, 427, 452,
+	212, 0, 0, 405, 443, 448, 0, 357, 213, 258,
+	246, 353, 256, 288, 442, 444, 445, 447, 211, 351,
+	264, 332, 422, 250, 430, 320, 207, 270, 388, 284,
+	293, 0, 0, 338, 369, 216, 425, 389, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
+	289, 1372, 358, 254, 450, 432, 428, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 201, 209, 219, 231, 244, 252,
+	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
+	305, 306, 322, 323, 324, 327, 330, 331, 334, 336,
+	337, 340, 346, 347, 348, 349, 350, 352, 359, 363,
+	371, 372, 373, 374, 375, 377, 378, 382, 383, 384,
+	385, 393, 397, 412, 413, 424, 436, 440, 263, 420,
+	441, 0, 297, 0, 0, 299, 248, 265, 274, 0,
+	431, 394, 205, 365, 255, 194, 222, 208, 229, 243,
+	245, 278, 307, 313, 342, 345, 260, 240, 220, 362,
+	217, 380, 400, 401, 402, 404, 311, 236, 329, 0,
+	1249, 0, 0, 0, 0, 0, 0, 239, 0, 0,
+	0, 0, 0, 287, 0, 0, 0, 0, 343, 0,
+	381, 225, 296, 294, 409, 249, 242, 238, 224, 271,
+	302, 341, 399, 335, 0, 291, 0, 0, 390, 314,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 223, 192, 326, 391,
+	253, 0, 0, 0, 184, 185, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 221, 0, 0,
+	0, 0, 235, 275, 241, 234, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
+	0, 315, 0, 0, 0, 0, 437, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 0, 283, 188, 203,
+	0, 0, 325, 364, 370, 0, 0, 0, 226, 0,
+	368, 339, 423, 210, 251, 361, 344, 366, 0, 0,
+	367, 292, 411, 356, 421, 438, 439, 233, 319, 429,
+	403, 435, 449, 204, 230, 333, 396, 426, 387, 312,
+	407, 408, 282, 386, 259, 191, 290, 446, 202, 376,
+	218, 195, 398, 419, 215, 379, 0, 0, 0, 197,
+	417, 395, 309, 279, 280, 196, 0, 360, 237, 257,
+	228, 328, 414, 415, 227, 451, 206, 434, 199, 0,
+	433, 321, 410, 418, 310, 301, 198, 416, 308, 300,
+	285, 247, 267, 354, 295, 355, 268, 317, 316, 318,
+	0, 193, 0, 392, 427, 452, 212, 0, 0, 405,
+	443, 448, 0, 357, 213, 258, 246, 353, 256, 288,
+	442, 444, 445, 447, 211, 351, 264, 332, 422, 250,
+	430, 320, 207, 270, 388, 284, 293, 0, 0, 338,
+	369, 216, 425, 389, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 187, 200, 289, 0, 358, 254,
+	450, 432, 428, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	201, 209, 219, 231, 244, 252, 262, 266, 269, 272,
+	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
+	324, 327, 330, 331, 334, 336, 337, 340, 346, 347,
+	348, 349, 350, 352, 359, 363, 371, 372, 373, 374,
+	375, 377, 378, 382, 383, 384, 385, 393, 397, 412,
+	413, 424, 436, 440, 263, 420, 441, 0, 297, 0,
+	0, 299, 248, 265, 274, 0, 431, 394, 205, 365,
+	255, 194, 222, 208, 229, 243, 245, 278, 307, 313,
+	342, 345, 260, 240, 220, 362, 217, 380, 400, 401,
+	402, 404, 311, 236, 329, 0, 1247, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 287,
+	0, 0, 0, 0, 343, 0, 381, 225, 296, 294,
+	409, 249, 242, 238, 224, 271, 302, 341, 399, 335,
+	0, 291, 0, 0, 390, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 223, 192, 326, 391, 253, 0, 0, 0,
+	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 221, 0, 0, 0, 0, 235, 275,
+	241, 234, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
+	0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 0, 283, 188, 203, 0, 0, 325, 364,
+	370, 0, 0, 0, 226, 0, 368, 339, 423, 210,
+	251, 361, 344, 366, 0, 0, 367, 292, 411, 356,
+	421, 438, 439, 233, 319, 429, 403, 435, 449, 204,
+	230, 333, 396, 426, 387, 312, 407, 408, 282, 386,
+	259, 191, 290, 446, 202, 376, 218, 195, 398, 419,
+	215, 379, 0, 0, 0, 197, 417, 395, 309, 279,
+	280, 196, 0, 360, 237, 257, 228, 328, 414, 415,
+	227, 451, 206, 434, 199, 0, 433, 321, 410, 418,
+	310, 301, 198, 416, 308, 300, 285, 247, 267, 354,
+	295, 355, 268, 317, 316, 318, 0, 193, 0, 392,
+	427, 452, 212, 0, 0, 405, 443, 448, 0, 357,
+	213, 258, 246, 353, 256, 288, 442, 444, 445, 447,
+	211, 351, 264, 332, 422, 250, 430, 320, 207, 270,
+	388, 284, 293, 0, 0, 338, 369, 216, 425, 389,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 200, 289, 0, 358, 254, 450, 432, 428, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 201, 209, 219, 231,
+	244, 252, 262, 266, 269, 272, 273, 276, 281, 298,
+	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
+	334, 336, 337, 340, 346, 347, 348, 349, 350, 352,
+	359, 363, 371, 372, 373, 374, 375, 377, 378, 382,
+	383, 384, 385, 393, 397, 412, 413, 424, 436, 440,
+	263, 420, 441, 0, 297, 0, 0, 299, 248, 265,
+	274, 0, 431, 394, 205, 365, 255, 194, 222, 208,
+	229, 243, 245, 278, 307, 313, 342, 345, 260, 240,
+	220, 362, 217, 380, 400, 401, 402, 404, 311, 236,
+	329, 0, 1245, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
+	343, 0, 381, 225, 296, 294, 409, 249, 242, 238,
+	224, 271, 302, 341, 399, 335, 0, 291, 0, 0,
+	390, 314, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 223, 192,
+	326, 391, 253, 0, 0, 0, 184, 185, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 221,
+	0, 0, 0, 0, 235, 275, 241, 234, 406, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 261, 0, 315, 0, 0, 0, 0, 437, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 0, 283,
+	188, 203, 0, 0, 325, 364, 370, 0, 0, 0,
+	226, 0, 368, 339, 423, 210, 251, 361, 344, 366,
+	0, 0, 367, 292, 411, 356, 421, 438, 439, 233,
+	319, 429, 403, 435, 449, 204, 230, 333, 396, 426,
+	387, 312, 407, 408, 282, 386, 259, 191, 290, 446,
+	202, 376, 218, 195, 398, 419, 215, 379, 0, 0,
+	0, 197, 417, 395, 309, 279, 280, 196, 0, 360,
+	237, 257, 228, 328, 414, 415, 227, 451, 206, 434,
+	199, 0, 433, 321, 410, 418, 310, 301, 198, 416,
+	308, 300, 285, 247, 267, 354, 295, 355, 268, 317,
+	316, 318, 0, 193, 0, 392, 427, 452, 212, 0,
+	0, 405, 443, 448, 0, 357, 213, 258, 246, 353,
+	256, 288, 442, 444, 445, 447, 211, 351, 264, 332,
+	422, 250, 430, 320, 207, 270, 388, 284, 293, 0,
+	0, 338, 369, 216, 425, 389, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 187, 200, 289, 0,
+	358, 254, 450, 432, 428, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 201, 209, 219, 231, 244, 252, 262, 266,
+	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
+	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
+	346, 347, 348, 349, 350, 352, 359, 363, 371, 372,
+	373, 374, 375, 377, 378, 382, 383, 384, 385, 393,
+	397, 412, 413, 424, 436, 440, 263, 420, 441, 0,
+	297, 0, 0, 299, 248, 265, 274, 0, 431, 394,
+	205, 365, 255, 194, 222, 208, 229, 243, 245, 278,
+	307, 313, 342, 345, 260, 240, 220, 362, 217, 380,
+	400, 401, 402, 404, 311, 236, 329, 0, 1243, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 0, 343, 0, 381, 225,
+	296, 294, 409, 249, 242, 238, 224, 271, 302, 341,
+	399, 335, 0, 291, 0, 0, 390, 314, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 223, 192, 326, 391, 253, 0,
+	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 221, 0, 0, 0, 0,
+	235, 275, 241, 234, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
+	0, 0, 0, 0, 437, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 188, 203, 0, 0,
+	325, 364, 370, 0, 0, 0, 226, 0, 368, 339,
+	423, 210, 251, 361, 344, 366, 0, 0, 367, 292,
+	411, 356, 421, 438, 439, 233, 319, 429, 403, 435,
+	449, 204, 230, 333, 396, 426, 387, 312, 407, 408,
+	282, 386, 259, 191, 290, 446, 202, 376, 218, 195,
+	398, 419, 215, 379, 0, 0, 0, 197, 417, 395,
+	309, 279, 280, 196, 0, 360, 237, 257, 228, 328,
+	414, 415, 227, 451, 206, 434, 199, 0, 433, 321,
+	410, 418, 310, 301, 198, 416, 308, 300, 285, 247,
+	267, 354, 295, 355, 268, 317, 316, 318, 0, 193,
+	0, 392, 427, 452, 212, 0, 0, 405, 443, 448,
+	0, 357, 213, 258, 246, 353, 256, 288, 442, 444,
+	445, 447, 211, 351, 264, 332, 422, 250, 430, 320,
+	207, 270, 388, 284, 293, 0, 0, 338, 369, 216,
+	425, 389, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 200, 289, 0, 358, 254, 450, 432,
+	428, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 201, 209,
+	219, 231, 244, 252, 262, 266, 269, 272, 273, 276,
+	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
+	330, 331, 334, 336, 337, 340, 346, 347, 348, 349,
+	350, 352, 359, 363, 371, 372, 373, 374, 375, 377,
+	378, 382, 383, 384, 385, 393, 397, 412, 413, 424,
+	436, 440, 263, 420, 441, 0, 297, 0, 0, 299,
+	248, 265, 274, 0, 431, 394, 205, 365, 255, 194,
+	222, 208, 229, 243, 245, 278, 307, 313, 342, 345,
+	260, 240, 220, 362, 217, 380, 400, 401, 402, 404,
+	311, 236, 329, 0, 1241, 0, 0, 0, 0, 0,
+	0, 239, 0, 0, 0, 0, 0, 287, 0, 0,
+	0, 0, 343, 0, 381, 225, 296, 294, 409, 249,
+	242, 238, 224, 271, 302, 341, 399, 335, 0, 291,
+	0, 0, 390, 314, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 277,
+	223, 192, 326, 391, 253, 0, 0, 0, 184, 185,
+	186, 0, 0, 0, 0, 0, 0, 0, 0, 214,
+	0, 221, 0, 0, 0, 0, 235, 275, 241, 234,
+	406, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 261, 0, 315, 0, 0, 0, 0,
+	437, 0, 0, 0, 0, 0, 0, 0, 0, 286,
+	0, 283, 188, 203, 0, 0, 325, 364, 370, 0,
+	0, 0, 226, 0, 368, 339, 423, 210, 251, 361,
+	344, 366, 0, 0, 367, 292, 411, 356, 421, 438,
+	439, 233, 319, 429, 403, 435, 449, 204, 230, 333,
+	396, 426, 387, 312, 407, 408, 282, 386, 259, 191,
+	290, 446, 202, 376, 218, 195, 398, 419, 215, 379,
+	0, 0, 0, 197, 417, 395, 309, 279, 280, 196,
+	0, 360, 237, 257, 228, 328, 414, 415, 227, 451,
+	206, 434, 199, 0, 433, 321, 410, 418, 310, 301,
+	198, 416, 308, 300, 285, 247, 267, 354, 295, 355,
+	268, 317, 316, 318, 0, 193, 0, 392, 427, 452,
+	212, 0, 0, 405, 443, 448, 0, 357, 213, 258,
+	246, 353, 256, 288, 442, 444, 445, 447, 211, 351,
+	264, 332, 422, 250, 430, 320, 207, 270, 388, 284,
+	293, 0, 0, 338, 369, 216, 425, 389, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 187, 200,
+	289, 0, 358, 254, 450, 432, 428, 0, 0, 232,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 189, 190, 201, 209, 219, 231, 244, 252,
+	262, 266, 269, 272, 273, 276, 281, 298, 303, 304,
+	305, 306, 322, 323, 324, 327, 330, 331, 334, 336,
+	337, 340, 346, 347, 348, 349, 350, 352, 359, 363,
+	371, 372, 373, 374, 375, 377, 378, 382, 383, 384,
+	385, 393, 397, 412, 413, 424, 436, 440, 263, 420,
+	441, 0, 297, 0, 0, 299, 248, 265, 274, 0,
+	431, 394, 205, 365, 255, 194, 222, 208, 229, 243,
+	245, 278, 307, 313, 342, 345, 260, 240, 220, 362,
+	217, 380, 400, 401, 402, 404, 311, 236, 329, 0,
+	1237, 0, 0, 0, 0, 0, 0, 239, 0, 0,
+	0, 0, 0, 287, 0, 0, 0, 0, 343, 0,
+	381, 225, 296, 294, 409, 249, 242, 238, 224, 271,
+	302, 341, 399, 335, 0, 291, 0, 0, 390, 314,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 277, 223, 192, 326, 391,
+	253, 0, 0, 0, 184, 185, 186, 0, 0, 0,
+	0, 0, 0, 0, 0, 214, 0, 221, 0, 0,
+	0, 0, 235, 275, 241, 234, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 261,
+	0, 315, 0, 0, 0, 0, 437, 0, 0, 0,
+	0, 0, 0, 0, 0, 286, 0, 283, 188, 203,
+	0, 0, 325, 364, 370, 0, 0, 0, 226, 0,
+	368, 339, 423, 210, 251, 361, 344, 366, 0, 0,
+	367, 292, 411, 356, 421, 438, 439, 233, 319, 429,
+	403, 435, 449, 204, 230, 333, 396, 426, 387, 312,
+	407, 408, 282, 386, 259, 191, 290, 446, 202, 376,
+	218, 195, 398, 419, 215, 379, 0, 0, 0, 197,
+	417, 395, 309, 279, 280, 196, 0, 360, 237, 257,
+	228, 328, 414, 415, 227, 451, 206, 434, 199, 0,
+	433, 321, 410, 418, 310, 301, 198, 416, 308, 300,
+	285, 247, 267, 354, 295, 355, 268, 317, 316, 318,
+	0, 193, 0, 392, 427, 452, 212, 0, 0, 405,
+	443, 448, 0, 357, 213, 258, 246, 353, 256, 288,
+	442, 444, 445, 447, 211, 351, 264, 332, 422, 250,
+	430, 320, 207, 270, 388, 284, 293, 0, 0, 338,
+	369, 216, 425, 389, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 187, 200, 289, 0, 358, 254,
+	450, 432, 428, 0, 0, 232, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 189, 190,
+	201, 209, 219, 231, 244, 252, 262, 266, 269, 272,
+	273, 276, 281, 298, 303, 304, 305, 306, 322, 323,
+	324, 327, 330, 331, 334, 336, 337, 340, 346, 347,
+	348, 349, 350, 352, 359, 363, 371, 372, 373, 374,
+	375, 377, 378, 382, 383, 384, 385, 393, 397, 412,
+	413, 424, 436, 440, 263, 420, 441, 0, 297, 0,
+	0, 299, 248, 265, 274, 0, 431, 394, 205, 365,
+	255, 194, 222, 208, 229, 243, 245, 278, 307, 313,
+	342, 345, 260, 240, 220, 362, 217, 380, 400, 401,
+	402, 404, 311, 236, 329, 0, 1235, 0, 0, 0,
+	0, 0, 0, 239, 0, 0, 0, 0, 0, 287,
+	0, 0, 0, 0, 343, 0, 381, 225, 296, 294,
+	409, 249, 242, 238, 224, 271, 302, 341, 399, 335,
+	0, 291, 0, 0, 390, 314, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 277, 223, 192, 326, 391, 253, 0, 0, 0,
+	184, 185, 186, 0, 0, 0, 0, 0, 0, 0,
+	0, 214, 0, 221, 0, 0, 0, 0, 235, 275,
+	241, 234, 406, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 261, 0, 315, 0, 0,
+	0, 0, 437, 0, 0, 0, 0, 0, 0, 0,
+	0, 286, 0, 283, 188, 203, 0, 0, 325, 364,
+	370, 0, 0, 0, 226, 0, 368, 339, 423, 210,
+	251, 361, 344, 366, 0, 0, 367, 292, 411, 356,
+	421, 438, 439, 233, 319, 429, 403, 435, 449, 204,
+	230, 333, 396, 426, 387, 312, 407, 408, 282, 386,
+	259, 191, 290, 446, 202, 376, 218, 195, 398, 419,
+	215, 379, 0, 0, 0, 197, 417, 395, 309, 279,
+	280, 196, 0, 360, 237, 257, 228, 328, 414, 415,
+	227, 451, 206, 434, 199, 0, 433, 321, 410, 418,
+	310, 301, 198, 416, 308, 300, 285, 247, 267, 354,
+	295, 355, 268, 317, 316, 318, 0, 193, 0, 392,
+	427, 452, 212, 0, 0, 405, 443, 448, 0, 357,
+	213, 258, 246, 353, 256, 288, 442, 444, 445, 447,
+	211, 351, 264, 332, 422, 250, 430, 320, 207, 270,
+	388, 284, 293, 0, 0, 338, 369, 216, 425, 389,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	187, 200, 289, 0, 358, 254, 450, 432, 428, 0,
+	0, 232, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 189, 190, 201, 209, 219, 231,
+	244, 252, 262, 266, 269, 272, 273, 276, 281, 298,
+	303, 304, 305, 306, 322, 323, 324, 327, 330, 331,
+	334, 336, 337, 340, 346, 347, 348, 349, 350, 352,
+	359, 363, 371, 372, 373, 374, 375, 377, 378, 382,
+	383, 384, 385, 393, 397, 412, 413, 424, 436, 440,
+	263, 420, 441, 0, 297, 0, 0, 299, 248, 265,
+	274, 0, 431, 394, 205, 365, 255, 194, 222, 208,
+	229, 243, 245, 278, 307, 313, 342, 345, 260, 240,
+	220, 362, 217, 380, 400, 401, 402, 404, 311, 236,
+	329, 0, 1233, 0, 0, 0, 0, 0, 0, 239,
+	0, 0, 0, 0, 0, 287, 0, 0, 0, 0,
+	343, 0, 381, 225, 296, 294, 409, 249, 242, 238,
+	224, 271, 302, 341, 399, 335, 0, 291, 0, 0,
+	390, 314, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 277, 223, 192,
+	326, 391, 253, 0, 0, 0, 184, 185, 186, 0,
+	0, 0, 0, 0, 0, 0, 0, 214, 0, 221,
+	0, 0, 0, 0, 235, 275, 241, 234, 406, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 261, 0, 315, 0, 0, 0, 0, 437, 0,
+	0, 0, 0, 0, 0, 0, 0, 286, 0, 283,
+	188, 203, 0, 0, 325, 364, 370, 0, 0, 0,
+	226, 0, 368, 339, 423, 210, 251, 361, 344, 366,
+	0, 0, 367, 292, 411, 356, 421, 438, 439, 233,
+	319, 429, 403, 435, 449, 204, 230, 333, 396, 426,
+	387, 312, 407, 408, 282, 386, 259, 191, 290, 446,
+	202, 376, 218, 195, 398, 419, 215, 379, 0, 0,
+	0, 197, 417, 395, 309, 279, 280, 196, 0, 360,
+	237, 257, 228, 328, 414, 415, 227, 451, 206, 434,
+	199, 0, 433, 321, 410, 418, 310, 301, 198, 416,
+	308, 300, 285, 247, 267, 354, 295, 355, 268, 317,
+	316, 318, 0, 193, 0, 392, 427, 452, 212, 0,
+	0, 405, 443, 448, 0, 357, 213, 258, 246, 353,
+	256, 288, 442, 444, 445, 447, 211, 351, 264, 332,
+	422, 250, 430, 320, 207, 270, 388, 284, 293, 0,
+	0, 338, 369, 216, 425, 389, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 187, 200, 289, 0,
+	358, 254, 450, 432, 428, 0, 0, 232, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	189, 190, 201, 209, 219, 231, 244, 252, 262, 266,
+	269, 272, 273, 276, 281, 298, 303, 304, 305, 306,
+	322, 323, 324, 327, 330, 331, 334, 336, 337, 340,
+	346, 347, 348, 349, 350, 352, 359, 363, 371, 372,
+	373, 374, 375, 377, 378, 382, 383, 384, 385, 393,
+	397, 412, 413, 424, 436, 440, 263, 420, 441, 0,
+	297, 0, 0, 299, 248, 265, 274, 0, 431, 394,
+	205, 365, 255, 194, 222, 208, 229, 243, 245, 278,
+	307, 313, 342, 345, 260, 240, 220, 362, 217, 380,
+	400, 401, 402, 404, 311, 236, 329, 0, 0, 0,
+	0, 0, 0, 0, 0, 239, 0, 0, 0, 0,
+	0, 287, 0, 0, 0, 0, 343, 0, 381, 225,
+	296, 294, 409, 249, 242, 238, 224, 271, 302, 341,
+	399, 335, 0, 291, 0, 0, 390, 314, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 277, 223, 192, 326, 391, 253, 1208,
+	0, 0, 184, 185, 186, 0, 0, 0, 0, 0,
+	0, 0, 0, 214, 0, 221, 0, 0, 0, 0,
+	235, 275, 241, 234, 406, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 261, 0, 315,
+	0, 0, 0, 0, 437, 0, 0, 0, 0, 0,
+	0, 0, 0, 286, 0, 283, 188, 203, 0, 0,
+	325, 364, 370, 0, 0, 0, 226, 0, 368, 339,
+	423, 210, 251, 361, 344, 366, 0, 0, 367, 292,
+	411, 356, 421, 438, 439, 233, 319, 429, 403, 435,
+	449, 204, 230, 333, 396, 426, 387, 312, 407, 408,
+	282, 386, 259, 191, 290, 446, 202, 376, 218, 195,
+	398, 419, 215, 379, 0, 0, 0, 197, 417, 395,
+	309, 279, 280, 196, 0, 360, 237, 257, 228, 328,
+	414, 415, 227, 451, 206, 434, 199, 0, 433, 321,
+	410, 418, 310, 301, 198, 416, 308, 300, 285, 247,
+	267, 354, 295, 355, 268, 317, 316, 318, 0, 193,
+	0, 392, 427, 452, 212, 0, 0, 405, 443, 448,
+	0, 357, 213, 258, 246, 353, 256, 288, 442, 444,
+	445, 447, 211, 351, 264, 332, 422, 250, 430, 320,
+	207, 270, 388, 284, 293, 0, 0, 338, 369, 216,
+	425, 389, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 187, 200, 289, 0, 358, 254, 450, 432,
+	428, 0, 0, 232, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 189, 190, 201, 209,
+	219, 231, 244, 252, 262, 266, 269, 272, 273, 276,
+	281, 298, 303, 304, 305, 306, 322, 323, 324, 327,
+	330, 331, 334, 336, 337, 340, 346, 347, 348, 349,
+	350, 352, 359, 363, 371, 372, 373, 374, 375, 377,
+	378, 382, 383, 384, 385, 393, 397, 412, 413, 424,
+	436, 440, 263, 420, 441, 0, 297, 0, 0, 299,
+	248, 265, 274, 0, 431, 394, 205, 365, 255, 194,
+	222, 208, 229, 243, 245, 278, 307, 313, 342, 345,
+	260, 240, 220, 362, 217, 380, 400, 401, 402, 404,
+	311, 236, 1107, 0, 0, 0, 0, 0, 0, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 0, 287, 0, 0, 0, 0, 343,
+	0, 381, 225, 296, 294, 409, 249, 242, 238, 224,
+	271, 302, 341, 399, 335, 0, 291, 0, 0, 390,
+	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 223, 192, 326,
+	391, 253, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 214, 0, 221, 0,
+	0, 0, 0, 235, 275, 241, 234, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	261, 0, 315, 0, 0, 0, 0, 437, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 0, 283, 188,
+	203, 0, 0, 325, 364, 370, 0, 0, 0, 226,
+	0, 368, 339, 423, 210, 251, 361, 344, 366, 0,
+	0, 367, 292, 411, 356, 421, 438, 439, 233, 319,
+	429, 403, 435, 449, 204, 230, 333, 396, 426, 387,
+	312, 407, 408, 282, 386, 259, 191, 290, 446, 202,
+	376, 218, 195, 398, 419, 215, 379, 0, 0, 0,
+	197, 417, 395, 309, 279, 280, 196, 0, 360, 237,
+	257, 228, 328, 414, 415, 227, 451, 206, 434, 199,
+	0, 433, 321, 410, 418, 310, 301, 198, 416, 308,
+	300, 285, 247, 267, 354, 295, 355, 268, 317, 316,
+	318, 0, 193, 0, 392, 427, 452, 212, 0, 0,
+	405, 443, 448, 0, 357, 213, 258, 246, 353, 256,
+	288, 442, 444, 445, 447, 211, 351, 264, 332, 422,
+	250, 430, 320, 207, 270, 388, 284, 293, 0, 0,
+	338, 369, 216, 425, 389, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 200, 289, 0, 358,
+	254, 450, 432, 428, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 201, 209, 219, 231, 244, 252, 262, 266, 269,
+	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
+	323, 324, 327, 330, 331, 334, 336, 337, 340, 346,
+	347, 348, 349, 350, 352, 359, 363, 371, 372, 373,
+	374, 375, 377, 378, 382, 383, 384, 385, 393, 397,
+	412, 413, 424, 436, 440, 263, 420, 441, 0, 297,
+	0, 0, 299, 248, 265, 274, 0, 431, 394, 205,
+	365, 255, 194, 222, 208, 229, 243, 245, 278, 307,
+	313, 342, 345, 260, 240, 220, 362, 217, 380, 400,
+	401, 402, 404, 311, 236, 329, 0, 0, 0, 0,
+	0, 0, 0, 1098, 239, 0, 0, 0, 0, 0,
+	287, 0, 0, 0, 0, 343, 0, 381, 225, 296,
+	294, 409, 249, 242, 238, 224, 271, 302, 341, 399,
+	335, 0, 291, 0, 0, 390, 314, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 277, 223, 192, 326, 391, 253, 0, 0,
+	0, 184, 185, 186, 0, 0, 0, 0, 0, 0,
+	0, 0, 214, 0, 221, 0, 0, 0, 0, 235,
+	275, 241, 234, 406, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 261, 0, 315, 0,
+	0, 0, 0, 437, 0, 0, 0, 0, 0, 0,
+	0, 0, 286, 0, 283, 188, 203, 0, 0, 325,
+	364, 370, 0, 0, 0, 226, 0, 368, 339, 423,
+	210, 251, 361, 344, 366, 0, 0, 367, 292, 411,
+	356, 421, 438, 439, 233, 319, 429, 403, 435, 449,
+	204, 230, 333, 396, 426, 387, 312, 407, 408, 282,
+	386, 259, 191, 290, 446, 202, 376, 218, 195, 398,
+	419, 215, 379, 0, 0, 0, 197, 417, 395, 309,
+	279, 280, 196, 0, 360, 237, 257, 228, 328, 414,
+	415, 227, 451, 206, 434, 199, 0, 433, 321, 410,
+	418, 310, 301, 198, 416, 308, 300, 285, 247, 267,
+	354, 295, 355, 268, 317, 316, 318, 0, 193, 0,
+	392, 427, 452, 212, 0, 0, 405, 443, 448, 0,
+	357, 213, 258, 246, 353, 256, 288, 442, 444, 445,
+	447, 211, 351, 264, 332, 422, 250, 430, 320, 207,
+	270, 388, 284, 293, 0, 0, 338, 369, 216, 425,
+	389, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 187, 200, 289, 0, 358, 254, 450, 432, 428,
+	0, 0, 232, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 189, 190, 201, 209, 219,
+	231, 244, 252, 262, 266, 269, 272, 273, 276, 281,
+	298, 303, 304, 305, 306, 322, 323, 324, 327, 330,
+	331, 334, 336, 337, 340, 346, 347, 348, 349, 350,
+	352, 359, 363, 371, 372, 373, 374, 375, 377, 378,
+	382, 383, 384, 385, 393, 397, 412, 413, 424, 436,
+	440, 263, 420, 441, 0, 297, 0, 0, 299, 248,
+	265, 274, 0, 431, 394, 205, 365, 255, 194, 222,
+	208, 229, 243, 245, 278, 307, 313, 342, 345, 260,
+	240, 220, 362, 217, 380, 400, 401, 402, 404, 311,
+	236, 329, 0, 0, 0, 0, 0, 0, 0, 0,
+	239, 0, 0, 0, 0, 0, 287, 0, 0, 0,
+	0, 343, 0, 381, 225, 296, 294, 409, 249, 242,
+	238, 224, 271, 302, 341, 399, 335, 0, 291, 0,
+	0, 390, 314, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 277, 223,
+	192, 326, 391, 253, 0, 0, 0, 184, 185, 186,
+	0, 953, 0, 0, 0, 0, 0, 0, 214, 0,
+	221, 0, 0, 0, 0, 235, 275, 241, 234, 406,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 261, 0, 315, 0, 0, 0, 0, 437,
+	0, 0, 0, 0, 0, 0, 0, 0, 286, 0,
+	283, 188, 203, 0, 0, 325, 364, 370, 0, 0,
+	0, 226, 0, 368, 339, 423, 210, 251, 361, 344,
+	366, 0, 0, 367, 292, 411, 356, 421, 438, 439,
+	233, 319, 429, 403, 435, 449, 204, 230, 333, 396,
+	426, 387, 312, 407, 408, 282, 386, 259, 191, 290,
+	446, 202, 376, 218, 195, 398, 419, 215, 379, 0,
+	0, 0, 197, 417, 395, 309, 279, 280, 196, 0,
+	360, 237, 257, 228, 328, 414, 415, 227, 451, 206,
+	434, 199, 0, 433, 321, 410, 418, 310, 301, 198,
+	416, 308, 300, 285, 247, 267, 354, 295, 355, 268,
+	317, 316, 318, 0, 193, 0, 392, 427, 452, 212,
+	0, 0, 405, 443, 448, 0, 357, 213, 258, 246,
+	353, 256, 288, 442, 444, 445, 447, 211, 351, 264,
+	332, 422, 250, 430, 320, 207, 270, 388, 284, 293,
+	0, 0, 338, 369, 216, 425, 389, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 187, 200, 289,
+	0, 358, 254, 450, 432, 428, 0, 0, 232, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 189, 190, 201, 209, 219, 231, 244, 252, 262,
+	266, 269, 272, 273, 276, 281, 298, 303, 304, 305,
+	306, 322, 323, 324, 327, 330, 331, 334, 336, 337,
+	340, 346, 347, 348, 349, 350, 352, 359, 363, 371,
+	372, 373, 374, 375, 377, 378, 382, 383, 384, 385,
+	393, 397, 412, 413, 424, 436, 440, 263, 420, 441,
+	0, 297, 0, 0, 299, 248, 265, 274, 0, 431,
+	394, 205, 365, 255, 194, 222, 208, 229, 243, 245,
+	278, 307, 313, 342, 345, 260, 240, 220, 362, 217,
+	380, 400, 401, 402, 404, 311, 236, 329, 0, 0,
+	0, 0, 0, 0, 0, 0, 239, 0, 0, 0,
+	0, 0, 287, 0, 0, 0, 0, 343, 0, 381,
+	225, 296, 294, 409, 249, 242, 238, 224, 271, 302,
+	341, 399, 335, 0, 291, 0, 0, 390, 314, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 277, 223, 192, 326, 391, 253,
+	0, 0, 0, 184, 185, 186, 0, 0, 0, 0,
+	0, 0, 0, 0, 214, 0, 221, 0, 0, 0,
+	0, 235, 275, 241, 234, 406, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 511, 0, 261, 0,
+	315, 0, 0, 0, 0, 437, 0, 0, 0, 0,
+	0, 0, 0, 0, 286, 0, 283, 188, 203, 0,
+	0, 325, 364, 370, 0, 0, 0, 226, 0, 368,
+	339, 423, 210, 251, 361, 344, 366, 0, 0, 367,
+	292, 411, 356, 421, 438, 439, 233, 319, 429, 403,
+	435, 449, 204, 230, 333, 396, 426, 387, 312, 407,
+	408, 282, 386, 259, 191, 290, 446, 202, 376, 218,
+	195, 398, 419, 215, 379, 0, 0, 0, 197, 417,
+	395, 309, 279, 280, 196, 0, 360, 237, 257, 228,
+	328, 414, 415, 227, 451, 206, 434, 199, 0, 433,
+	321, 410, 418, 310, 301, 198, 416, 308, 300, 285,
+	247, 267, 354, 295, 355, 268, 317, 316, 318, 0,
+	193, 0, 392, 427, 452, 212, 0, 0, 405, 443,
+	448, 0, 357, 213, 258, 246, 353, 256, 288, 442,
+	444, 445, 447, 211, 351, 264, 332, 422, 250, 430,
+	320, 207, 270, 388, 284, 293, 0, 0, 338, 369,
+	216, 425, 389, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 187, 200, 289, 0, 358, 254, 450,
+	432, 428, 0, 0, 232, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 189, 190, 201,
+	209, 219, 231, 244, 252, 262, 266, 269, 272, 273,
+	276, 281, 298, 303, 304, 305, 306, 322, 323, 324,
+	327, 330, 331, 334, 336, 337, 340, 346, 347, 348,
+	349, 350, 352, 359, 363, 371, 372, 373, 374, 375,
+	377, 378, 382, 383, 384, 385, 393, 397, 412, 413,
+	424, 436, 440, 510, 420, 441, 0, 297, 0, 0,
+	299, 248, 265, 274, 0, 431, 394, 205, 365, 255,
+	194, 222, 208, 229, 243, 245, 278, 307, 313, 342,
+	345, 260, 240, 220, 362, 217, 380, 400, 401, 402,
+	404, 311, 236, 329, 0, 0, 0, 0, 0, 0,
+	0, 0, 239, 0, 0, 0, 0, 0, 287, 0,
+	0, 0, 0, 343, 0, 381, 225, 296, 294, 409,
+	249, 242, 238, 224, 271, 302, 341, 399, 335, 0,
+	291, 0, 0, 390, 314, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	277, 223, 192, 326, 391, 253, 0, 0, 0, 184,
+	185, 186, 0, 0, 0, 0, 0, 0, 0, 0,
+	214, 0, 221, 0, 0, 0, 0, 235, 275, 241,
+	234, 406, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 261, 0, 315, 0, 0, 458,
+	0, 437, 0, 0, 0, 0, 0, 0, 0, 0,
+	286, 0, 283, 188, 203, 0, 0, 325, 364, 370,
+	0, 0, 0, 226, 0, 368, 339, 423, 210, 251,
+	361, 344, 366, 0, 0, 367, 292, 411, 356, 421,
+	438, 439, 233, 319, 429, 403, 435, 449, 204, 230,
+	333, 396, 426, 387, 312, 407, 408, 282, 386, 259,
+	191, 290, 446, 202, 376, 218, 195, 398, 419, 215,
+	379, 0, 0, 0, 197, 417, 395, 309, 279, 280,
+	196, 0, 360, 237, 257, 228, 328, 414, 415, 227,
+	451, 206, 434, 199, 0, 433, 321, 410, 418, 310,
+	301, 198, 416, 308, 300, 285, 247, 267, 354, 295,
+	355, 268, 317, 316, 318, 0, 193, 0, 392, 427,
+	452, 212, 0, 0, 405, 443, 448, 0, 357, 213,
+	258, 246, 353, 256, 288, 442, 444, 445, 447, 211,
+	351, 264, 332, 422, 250, 430, 320, 207, 270, 388,
+	284, 293, 0, 0, 338, 369, 216, 425, 389, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 187,
-	188, 199, 207, 217, 229, 242, 250, 260, 264, 267,
-	270, 271, 274, 279, 296, 301, 302, 303, 304, 320,
-	321, 322, 325, 328, 329, 332, 334, 335, 338, 344,
-	345, 346, 347, 348, 350, 357, 361, 369, 370, 371,
-	372, 373, 375, 376, 380, 381, 382, 383, 391, 395,
-	410, 411, 422, 434, 438, 261, 418, 439, 0, 295,
-	0, 0, 297, 246, 263, 272, 0, 429, 392, 203,
-	363, 253, 192, 220, 206, 227, 241, 243, 276, 305,
-	311, 340, 343, 258, 238, 218, 360, 215, 378, 398,
-	399, 400, 402, 309, 234, 327, 0, 0, 0, 0,
-	0, 0, 0, 0, 237, 0, 0, 0, 0, 0,
-	285, 0, 0, 0, 341, 0, 379, 223, 294, 292,
-	407, 247, 240, 236, 222, 269, 300, 339, 397, 333,
-	0, 289, 0, 0, 388, 312, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 275, 221, 190, 324, 389, 251, 1202, 0, 0,
-	182, 183, 184, 0, 0, 0, 0, 0, 0, 0,
-	0, 212, 0, 219, 0, 0, 0, 0, 233, 273,
-	239, 232, 404, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 259, 0, 313, 0, 0,
-	0, 0, 435, 0, 0, 0, 0, 0, 0, 0,
-	0, 284, 0, 281, 186, 201, 0, 0, 323, 362,
-	368, 0, 0, 0, 224, 0, 366, 337, 421, 208,
-	249, 359, 342, 364, 0, 0, 365, 290, 409, 354,
-	419, 436, 437, 231, 317, 427, 401, 433, 447, 202,
-	228, 331, 394, 424, 385, 310, 405, 406, 280, 384,
-	257, 189, 288, 444, 200, 374, 216, 193, 396, 417,
-	213, 377, 0, 0, 0, 195, 415, 393, 307, 277,
-	278, 194, 0, 358, 235, 255, 226, 326, 412, 413,
-	225, 449, 204, 432, 197, 0, 431, 319, 408, 416,
-	308, 299, 196, 414, 306, 298, 283, 245, 265, 352,
-	293, 353, 266, 315, 314, 316, 0, 191, 0, 390,
-	425, 450, 210, 0, 0, 403, 441, 446, 0, 355,
-	211, 256, 244, 351, 254, 286, 440, 442, 443, 445,
-	209, 349, 262, 330, 420, 248, 428, 318, 205, 268,
-	386, 282, 291, 0, 0, 336, 367, 214, 423, 387,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	185, 198, 287, 0, 356, 252, 448, 430, 426, 0,
-	0, 230, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 187, 188, 199, 207, 217, 229,
-	242, 250, 260, 264, 267, 270, 271, 274, 279, 296,
-	301, 302, 303, 304, 320, 321, 322, 325, 328, 329,
-	332, 334, 335, 338, 344, 345, 346, 347, 348, 350,
-	357, 361, 369, 370, 371, 372, 373, 375, 376, 380,
-	381, 382, 383, 391, 395, 410, 411, 422, 434, 438,
-	261, 418, 439, 0, 295, 0, 0, 297, 246, 263,
-	272, 0, 429, 392, 203, 363, 253, 192, 220, 206,
-	227, 241, 243, 276, 305, 311, 340, 343, 258, 238,
-	218, 360, 215, 378, 398, 399, 400, 402, 309, 234,
-	1101, 0, 0, 0, 0, 0, 0, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	1092, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 948, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 0, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	507, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 506, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234, 327, 0, 0,
-	0, 0, 0, 0, 0, 0, 237, 0, 0, 0,
-	0, 0, 285, 0, 0, 0, 341, 0, 379, 223,
-	294, 292, 407, 247, 240, 236, 222, 269, 300, 339,
-	397, 333, 0, 289, 0, 0, 388, 312, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 275, 221, 190, 324, 389, 251, 0,
-	0, 0, 182, 183, 184, 0, 0, 0, 0, 0,
-	0, 0, 0, 212, 0, 219, 0, 0, 0, 0,
-	233, 273, 239, 232, 404, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 259, 0, 313,
-	0, 0, 456, 0, 435, 0, 0, 0, 0, 0,
-	0, 0, 0, 284, 0, 281, 186, 201, 0, 0,
-	323, 362, 368, 0, 0, 0, 224, 0, 366, 337,
-	421, 208, 249, 359, 342, 364, 0, 0, 365, 290,
-	409, 354, 419, 436, 437, 231, 317, 427, 401, 433,
-	447, 202, 228, 331, 394, 424, 385, 310, 405, 406,
-	280, 384, 257, 189, 288, 444, 200, 374, 216, 193,
-	396, 417, 213, 377, 0, 0, 0, 195, 415, 393,
-	307, 277, 278, 194, 0, 358, 235, 255, 226, 326,
-	412, 413, 225, 449, 204, 432, 197, 0, 431, 319,
-	408, 416, 308, 299, 196, 414, 306, 298, 283, 245,
-	265, 352, 293, 353, 266, 315, 314, 316, 0, 191,
-	0, 390, 425, 450, 210, 0, 0, 403, 441, 446,
-	0, 355, 211, 256, 244, 351, 254, 286, 440, 442,
-	443, 445, 209, 349, 262, 330, 420, 248, 428, 318,
-	205, 268, 386, 282, 291, 0, 0, 336, 367, 214,
-	423, 387, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 185, 198, 287, 0, 356, 252, 448, 430,
-	426, 0, 0, 230, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 187, 188, 199, 207,
-	217, 229, 242, 250, 260, 264, 267, 270, 271, 274,
-	279, 296, 301, 302, 303, 304, 320, 321, 322, 325,
-	328, 329, 332, 334, 335, 338, 344, 345, 346, 347,
-	348, 350, 357, 361, 369, 370, 371, 372, 373, 375,
-	376, 380, 381, 382, 383, 391, 395, 410, 411, 422,
-	434, 438, 261, 418, 439, 0, 295, 0, 0, 297,
-	246, 263, 272, 0, 429, 392, 203, 363, 253, 192,
-	220, 206, 227, 241, 243, 276, 305, 311, 340, 343,
-	258, 238, 218, 360, 215, 378, 398, 399, 400, 402,
-	309, 234, 327, 0, 0, 0, 0, 0, 0, 0,
-	0, 237, 0, 0, 0, 0, 0, 285, 0, 0,
-	0, 341, 0, 379, 223, 294, 292, 407, 247, 240,
-	236, 222, 269, 300, 339, 397, 333, 0, 289, 0,
-	0, 388, 312, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 275, 221,
-	190, 324, 389, 251, 0, 0, 0, 182, 183, 184,
-	0, 0, 0, 0, 0, 0, 0, 0, 212, 0,
-	219, 0, 0, 0, 0, 233, 273, 239, 232, 404,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 259, 0, 313, 0, 0, 0, 0, 435,
-	0, 0, 0, 0, 0, 0, 0, 0, 284, 0,
-	281, 186, 201, 0, 0, 323, 362, 368, 0, 0,
-	0, 224, 0, 366, 337, 421, 208, 249, 359, 342,
-	364, 0, 0, 365, 290, 409, 354, 419, 436, 437,
-	231, 317, 427, 401, 433, 447, 202, 228, 331, 394,
-	424, 385, 310, 405, 406, 280, 384, 257, 189, 288,
-	444, 200, 374, 216, 193, 396, 417, 213, 377, 0,
-	0, 0, 195, 415, 393, 307, 277, 278, 194, 0,
-	358, 235, 255, 226, 326, 412, 413, 225, 449, 204,
-	432, 197, 0, 431, 319, 408, 416, 308, 299, 196,
-	414, 306, 298, 283, 245, 265, 352, 293, 353, 266,
-	315, 314, 316, 0, 191, 0, 390, 425, 450, 210,
-	0, 0, 403, 441, 446, 0, 355, 211, 256, 244,
-	351, 254, 286, 440, 442, 443, 445, 209, 349, 262,
-	330, 420, 248, 428, 318, 205, 268, 386, 282, 291,
-	0, 0, 336, 367, 214, 423, 387, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 185, 198, 287,
-	0, 356, 252, 448, 430, 426, 0, 0, 230, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 187, 188, 199, 207, 217, 229, 242, 250, 260,
-	264, 267, 270, 271, 274, 279, 296, 301, 302, 303,
-	304, 320, 321, 322, 325, 328, 329, 332, 334, 335,
-	338, 344, 345, 346, 347, 348, 350, 357, 361, 369,
-	370, 371, 372, 373, 375, 376, 380, 381, 382, 383,
-	391, 395, 410, 411, 422, 434, 438, 261, 418, 439,
-	0, 295, 0, 0, 297, 246, 263, 272, 0, 429,
-	392, 203, 363, 253, 192, 220, 206, 227, 241, 243,
-	276, 305, 311, 340, 343, 258, 238, 218, 360, 215,
-	378, 398, 399, 400, 402, 309, 234,
+	200, 289, 0, 358, 254, 450, 432, 428, 0, 0,
+	232, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 189, 190, 201, 209, 219, 231, 244,
+	252, 262, 266, 269, 272, 273, 276, 281, 298, 303,
+	304, 305, 306, 322, 323, 324, 327, 330, 331, 334,
+	336, 337, 340, 346, 347, 348, 349, 350, 352, 359,
+	363, 371, 372, 373, 374, 375, 377, 378, 382, 383,
+	384, 385, 393, 397, 412, 413, 424, 436, 440, 263,
+	420, 441, 0, 297, 0, 0, 299, 248, 265, 274,
+	0, 431, 394, 205, 365, 255, 194, 222, 208, 229,
+	243, 245, 278, 307, 313, 342, 345, 260, 240, 220,
+	362, 217, 380, 400, 401, 402, 404, 311, 236, 329,
+	0, 0, 0, 0, 0, 0, 0, 0, 239, 0,
+	0, 0, 0, 0, 287, 0, 0, 0, 0, 343,
+	0, 381, 225, 296, 294, 409, 249, 242, 238, 224,
+	271, 302, 341, 399, 335, 0, 291, 0, 0, 390,
+	314, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 277, 223, 192, 326,
+	391, 253, 0, 0, 0, 184, 185, 186, 0, 0,
+	0, 0, 0, 0, 0, 0, 214, 0, 221, 0,
+	0, 0, 0, 235, 275, 241, 234, 406, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	261, 0, 315, 0, 0, 0, 0, 437, 0, 0,
+	0, 0, 0, 0, 0, 0, 286, 0, 283, 188,
+	203, 0, 0, 325, 364, 370, 0, 0, 0, 226,
+	0, 368, 339, 423, 210, 251, 361, 344, 366, 0,
+	0, 367, 292, 411, 356, 421, 438, 439, 233, 319,
+	429, 403, 435, 449, 204, 230, 333, 396, 426, 387,
+	312, 407, 408, 282, 386, 259, 191, 290, 446, 202,
+	376, 218, 195, 398, 419, 215, 379, 0, 0, 0,
+	197, 417, 395, 309, 279, 280, 196, 0, 360, 237,
+	257, 228, 328, 414, 415, 227, 451, 206, 434, 199,
+	0, 433, 321, 410, 418, 310, 301, 198, 416, 308,
+	300, 285, 247, 267, 354, 295, 355, 268, 317, 316,
+	318, 0, 193, 0, 392, 427, 452, 212, 0, 0,
+	405, 443, 448, 0, 357, 213, 258, 246, 353, 256,
+	288, 442, 444, 445, 447, 211, 351, 264, 332, 422,
+	250, 430, 320, 207, 270, 388, 284, 293, 0, 0,
+	338, 369, 216, 425, 389, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 187, 200, 289, 0, 358,
+	254, 450, 432, 428, 0, 0, 232, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 189,
+	190, 201, 209, 219, 231, 244, 252, 262, 266, 269,
+	272, 273, 276, 281, 298, 303, 304, 305, 306, 322,
+	323, 324, 327, 330, 331, 334, 336, 337, 340, 346,
+	347, 348, 349, 350, 352, 359, 363, 371, 372, 373,
+	374, 375, 377, 378, 382, 383, 384, 385, 393, 397,
+	412, 413, 424, 436, 440, 263, 420, 441, 0, 297,
+	0, 0, 299, 248, 265, 274, 0, 431, 394, 205,
+	365, 255, 194, 222, 208, 229, 243, 245, 278, 307,
+	313, 342, 345, 260, 240, 220, 362, 217, 380, 400,
+	401, 402, 404, 311, 236,
 }
 
 var yyPact = [...]int{
-	2708, -1000, -342, 1647, -1000, -1000, -1000, -1000, -1000, -1000,
+	4449, -1000, -334, 1672, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1601, 1240, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, 581, 1270, 222, 1508, 213, 167, 27823, 412,
-	85, 27368, 403, 2109, 27823, -1000, 99, -1000, 86, 27823,
-	91, 26913, -1000, -1000, -284, 12775, 1450, 10, 8, 27823,
-	106, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1246,
-	1573, 1579, 1598, 1090, 1603, -1000, 10942, 10942, 354, 354,
-	354, 9122, -1000, -1000, 16883, 27823, 27823, 1275, 402, 952,
-	389, 387, 386, -1000, -106, -1000, -1000, -1000, -1000, 1508,
-	-1000, -1000, 168, -1000, 254, 1237, -1000, 1225, -1000, 419,
-	416, 250, 330, 329, 248, 247, 245, 244, 242, 241,
-	240, 238, 259, -1000, 539, 539, -172, -179, 296, 321,
-	321, 321, 369, 1477, 1461, -1000, 623, -1000, 539, 539,
-	164, 539, 539, 539, 539, 221, 219, 539, 539, 539,
-	539, 539, 539, 539, 539, 539, 539, 539, 539, 539,
-	539, 539, 27823, -1000, 162, 580, 246, 613, 1508, 212,
+	-1000, -1000, -1000, -1000, 1607, 1292, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 601, 1349, 205, 1535, 3633, 147, 27390,
+	406, 106, 26934, -281, 2116, 27390, -1000, 103, -1000, 93,
+	27390, 98, 26478, -1000, -1000, -281, 12309, 1499, 7, -3,
+	27390, 125, -204, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1331, 1600, 1606, 1631, 1150, 1755, -1000, 10472, 10472,
+	320, 320, 320, 8648, -1000, -1000, 16426, 27390, 27390, 1354,
+	405, 999, 397, 394, 379, -1000, -111, -1000, -1000, -1000,
+	-1000, 1535, -1000, -1000, 131, -1000, 253, 1287, -1000, 1285,
+	-1000, 495, 429, 250, 323, 322, 249, 248, 245, 242,
+	241, 236, 235, 234, 260, -1000, 608, 608, -171, -175,
+	2750, 306, 306, 306, 359, 1519, 1518, -1000, 484, -1000,
+	608, 608, 130, 608, 608, 608, 608, 202, 200, 608,
+	608, 608, 608, 608, 608, 608, 608, 608, 608, 608,
+	608, 608, 608, 608, 27390, -1000, 167, 525, 247, 640,
+	1535, 183, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -3974,27 +3934,26 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, 27390, 403, 999, 313, -1000, 27390, -1000,
+	500, 402, -1000, -1000, 658, 658, 6, 658, 658, 658,
+	658, 69, 471, -14, -1000, 68, 195, 193, 177, 644,
+	102, 61, -1000, -1000, 172, 644, 97, -1000, 658, 6768,
+	6768, 6768, -1000, 1530, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 358, -1000, -1000, -1000, -1000, 27390, 26022, 227,
+	619, -1000, -1000, -1000, 24, 1180, 969, -1000, 12309, 2155,
+	1291, 1291, -1000, -1000, 439, -1000, -1000, 13677, 13677, 13677,
+	13677, 13677, 13677, 13677, 13677, 13677, 13677, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 27823, 400, 952, 349, -1000, 27823, -1000, 483, 27823,
-	636, 636, 63, 636, 636, 636, 636, 82, 462, -10,
-	-1000, 73, 201, 187, 191, 673, 74, 62, -1000, -1000,
-	189, 673, 90, -1000, 636, 7246, 7246, 7246, -1000, 1498,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 367, -1000,
-	-1000, -1000, -1000, 27823, 26458, 278, 598, -1000, -1000, -1000,
-	23, -1000, -1000, 1146, 568, -1000, 12775, 2381, 1241, 1241,
-	-1000, -1000, 471, -1000, -1000, 14140, 14140, 14140, 14140, 14140,
-	14140, 14140, 14140, 14140, 14140, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 1241,
-	479, -1000, 12320, 1241, 1241, 1241, 1241, 1241, 1241, 1241,
-	1241, 12775, 1241, 1241, 1241, 1241, 1241, 1241, 1241, 1241,
-	1241, 1241, 1241, 1241, 1241, 1241, 1241, 1241, 1241, -1000,
-	-1000, -1000, 27823, -1000, 1241, 987, 1601, -1000, 1240, -1000,
-	-1000, -1000, 1494, 12775, 12775, 1601, -1000, 1390, 10942, -1000,
-	-1000, 1481, -1000, -1000, -1000, -1000, -1000, 711, 1633, -1000,
-	15505, 476, 1632, 26003, -1000, 19626, 25548, 1205, 8653, -24,
-	-1000, -1000, -1000, 592, 18716, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1291, 496, -1000, 11853, 1291, 1291, 1291, 1291, 1291,
+	1291, 1291, 1291, 12309, 1291, 1291, 1291, 1291, 1291, 1291,
+	1291, 1291, 1291, 1291, 1291, 1291, 1291, 1291, 1291, 1291,
+	1291, -1000, -1000, -1000, 27390, -1000, 1291, 1021, 920, -1000,
+	-1000, 1607, -1000, 1292, -1000, -1000, -1000, 1538, 12309, 12309,
+	1607, -1000, 1441, 10472, -1000, -1000, 1658, -1000, -1000, -1000,
+	-1000, -1000, 724, 1648, -1000, 15045, 489, 1647, 25566, -1000,
+	19175, 25110, 1284, 8178, -73, -1000, -1000, -1000, 618, 18263,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	1498, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, 1530, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
@@ -4006,191 +3965,191 @@ var yyPact = [...]int{
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1128, 27823, -1000,
-	-1000, 2563, 952, -1000, 1269, -1000, 1123, -1000, 1249, 162,
-	352, 1287, 952, 952, 952, 352, -1000, -1000, -1000, 539,
-	539, 257, 213, 3838, -1000, -1000, -1000, 25086, 1268, 952,
-	-1000, 1267, -1000, 1531, 337, 579, 579, 952, -1000, -1000,
-	27823, 952, 1530, 1527, 27823, 27823, -1000, 24631, -1000, 24176,
-	23721, 867, 27823, 23266, 22811, 22356, 21901, 21446, -1000, 1347,
-	-1000, 1262, -1000, -1000, -1000, 27823, 27823, 27823, -6, -1000,
-	-1000, 27823, 952, -1000, -1000, 860, 851, 539, 539, 850,
-	986, 982, 977, 539, 539, 820, 976, 1012, 190, 813,
-	804, 801, 831, 974, 108, 810, 789, 794, 27823, 1266,
-	-1000, 150, 578, 220, 270, 200, 27823, 132, 1571, 198,
-	1508, 1449, 1202, 366, 349, 1308, 27823, 1553, 349, -1000,
-	7715, -1000, -1000, 973, 12775, -1000, 679, 673, 673, -1000,
-	-1000, -1000, -1000, -1000, -1000, 636, 27823, 679, -1000, -1000,
-	-1000, 673, 636, 27823, 636, 636, 636, 636, 673, 636,
-	27823, 27823, 27823, 27823, 27823, 27823, 27823, 27823, 27823, 7246,
-	7246, 7246, 528, 636, -1000, 1307, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 89, -1000, -1000, -1000, -1000, -1000,
-	1647, -1000, -1000, -1000, -115, 1181, 20991, -1000, -288, -289,
-	-290, -291, -1000, -1000, -1000, -292, -297, -1000, -1000, -1000,
-	12775, 12775, 12775, 12775, 786, 530, 14140, 756, 533, 14140,
-	14140, 14140, 14140, 14140, 14140, 14140, 14140, 14140, 14140, 14140,
-	14140, 14140, 14140, 14140, 718, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 952, -1000, 1637, 1039, 1039, 491, 491,
-	491, 491, 491, 491, 491, 491, 491, 14595, 9577, 7715,
-	1090, 1117, 1601, 10942, 10942, 12775, 12775, 11852, 11397, 10942,
-	1472, 631, 568, 27823, -1000, 881, -1000, -1000, 13685, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 27823, 27823, 10942, 10942, 10942, 10942, 10942, -1000, 1177,
-	-1000, -175, 16428, 12775, -1000, 1579, 1090, 1481, 1546, 1641,
-	520, 863, 1176, -1000, 964, 1579, 18261, 1222, -1000, 1481,
-	-1000, -1000, -1000, 27823, -1000, -1000, 20536, -1000, -1000, 6777,
-	27823, 234, 27823, -1000, 1190, 1567, -1000, -1000, -1000, 1568,
-	17806, 27823, 1145, 1122, -1000, -1000, 474, 8184, -24, -1000,
-	8184, 1160, -1000, -67, -37, 10032, 467, -1000, -1000, -1000,
-	296, 15050, 1114, -1000, 25, -1000, -1000, -1000, 1249, -1000,
-	1249, 1249, 1249, 1249, -6, -6, -6, -6, -1000, -1000,
-	-1000, -1000, -1000, 1264, 1263, -1000, 1249, 1249, 1249, 1249,
+	-1000, -1000, 1171, 27390, -1000, -1000, 4093, 999, -1000, 1333,
+	-1000, 1167, -1000, 1306, 167, 317, 1363, 999, 999, 999,
+	317, -1000, -1000, -1000, 608, 608, 258, 3633, 3972, -1000,
+	-1000, -1000, 24647, 1332, 999, -1000, 1330, -1000, 1551, 314,
+	512, 512, 999, -1000, -1000, 27390, 999, 1547, 1545, 27390,
+	27390, -1000, 24191, -1000, 23735, 23279, 918, 27390, 22823, 22367,
+	21911, 21455, 20999, -1000, 1417, -1000, 1299, -1000, -1000, -1000,
+	27390, 27390, 27390, 18, -1000, -1000, 27390, 999, -1000, -1000,
+	913, 912, 608, 608, 890, 1020, 1017, 1016, 608, 608,
+	885, 1005, 1048, 173, 884, 880, 863, 871, 1003, 123,
+	865, 827, 860, 27390, 1326, -1000, 155, 617, 209, 246,
+	212, 27390, 132, 1591, 181, 1535, 1496, 1280, 357, 313,
+	1382, 27390, 1580, 313, -1000, 7238, 27390, -1000, 1000, 12309,
+	-1000, 648, 644, 644, -1000, -1000, -1000, -1000, -1000, -1000,
+	658, 27390, 648, -1000, -1000, -1000, 644, 658, 27390, 658,
+	658, 658, 658, 644, 658, 27390, 27390, 27390, 27390, 27390,
+	27390, 27390, 27390, 27390, 6768, 6768, 6768, 547, 658, -1000,
+	1381, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 96,
+	-1000, -1000, -1000, -1000, -1000, 1672, -1000, -1000, -1000, -112,
+	1279, 20543, -1000, -285, -286, -287, -288, -1000, -1000, -1000,
+	-290, -291, -1000, -1000, -1000, 12309, 12309, 12309, 12309, 707,
+	554, 13677, 816, 711, 13677, 13677, 13677, 13677, 13677, 13677,
+	13677, 13677, 13677, 13677, 13677, 13677, 13677, 13677, 13677, 665,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, 999, -1000,
+	1669, 1033, 1033, 509, 509, 509, 509, 509, 509, 509,
+	509, 509, 14133, 9104, 7238, 1150, 1165, 1607, 10472, 10472,
+	12309, 12309, 11384, 10928, 10472, 1528, 646, 969, 27390, -1000,
+	1032, -1000, -1000, 13221, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, 27390, 27390, 10472, 10472,
+	10472, 10472, 10472, -1000, 1265, -1000, -169, 15970, 12309, -1000,
+	-1000, 1606, 1150, 1658, 1568, 1664, 536, 837, 1264, -1000,
+	834, 1606, 17807, 1244, -1000, 1658, -1000, -1000, -1000, 27390,
+	-1000, -1000, 20087, -1000, -1000, 6298, 27390, 225, 27390, -1000,
+	1204, 1601, -1000, -1000, -1000, 1587, 17351, 27390, 1182, 1170,
+	-1000, -1000, 488, 7708, -73, -1000, 7708, 1209, -1000, -54,
+	-58, 9560, 460, -1000, -1000, -1000, 2750, 14589, 1093, -1000,
+	25, -1000, -1000, -1000, 1306, -1000, 1306, 1306, 1306, 1306,
+	18, 18, 18, 18, -1000, -1000, -1000, -1000, -1000, 1313,
+	1311, -1000, 1306, 1306, 1306, 1306, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 1256, 1256, 1256,
-	1251, 1251, 314, -1000, 12775, 178, 27823, 1539, 791, 150,
-	27823, 587, 1306, -1000, 27823, 1287, 1287, 1287, 27823, 970,
-	877, -1000, 1175, -1000, -1000, 1597, -1000, -1000, 514, 671,
-	668, 565, 27823, 114, 233, -1000, 308, -1000, 27823, 1254,
-	1524, 579, 952, -1000, 952, -1000, -1000, -1000, -1000, 463,
-	-1000, -1000, 952, 1174, -1000, 1140, 707, 662, 704, 661,
-	1174, -1000, -1000, -151, 1174, -1000, 1174, -1000, 1174, -1000,
-	1174, -1000, 1174, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 534, 27823, 114, 718, -1000, 356, -1000, -1000, 718,
-	718, -1000, -1000, -1000, -1000, 971, 969, -1000, -1000, -1000,
+	-1000, -1000, -1000, 1310, 1310, 1310, 1307, 1307, 284, -1000,
+	12309, 146, 27390, 1577, 858, 155, 27390, 675, 1380, -1000,
+	27390, 1363, 1363, 1363, 27390, 935, 929, -1000, 1263, -1000,
+	-1000, 1630, -1000, -1000, 594, 708, 704, 491, 27390, 129,
+	224, -1000, 298, -1000, 27390, 1309, 1544, 512, 999, -1000,
+	999, -1000, -1000, -1000, -1000, 481, -1000, -1000, 999, 1260,
+	-1000, 1249, 807, 702, 751, 695, 1260, -1000, -1000, -150,
+	1260, -1000, 1260, -1000, 1260, -1000, 1260, -1000, 1260, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 551, 27390, 129,
+	665, -1000, 353, -1000, -1000, 665, 665, -1000, -1000, -1000,
+	-1000, 992, 991, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -332,
+	27390, 369, 134, 179, 27390, 27390, 27390, 27390, 27390, 475,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 194, 27390, 27390,
+	27390, 27390, 461, -1000, -1000, 27390, -1000, -1000, -1000, 1259,
+	-1000, 969, 27390, -1000, -1000, 658, 658, -1000, -1000, 27390,
+	658, -1000, -1000, -1000, -1000, -1000, -1000, 658, -1000, -1000,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -334, 27823, 376, 142, 151, 27823, 27823,
-	27823, 27823, 27823, 424, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, 202, 27823, 27823, 27823, 27823, 401, -1000, -1000, 27823,
-	-1000, -1000, -1000, -1000, 568, 27823, -1000, -1000, 636, 636,
-	-1000, -1000, 27823, 636, -1000, -1000, -1000, -1000, -1000, -1000,
-	636, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -1000, 963, -1000, 27823, 27823, -1000,
-	-1000, -1000, -1000, -1000, 70, -71, 177, -1000, -1000, -1000,
-	-1000, 1576, -1000, 568, 530, 633, 583, -1000, -1000, 806,
-	-1000, -1000, 1277, -1000, -1000, -1000, -1000, 756, 14140, 14140,
-	14140, 589, 1277, 2508, 1344, 1652, 491, 656, 656, 504,
-	504, 504, 504, 504, 717, 717, -1000, -1000, -1000, -1000,
-	881, -1000, -1000, -1000, 881, 10942, 10942, 1172, 1241, 451,
-	-1000, 1246, -1000, -1000, 1579, 1092, 1092, 829, 1011, 619,
-	1626, 1092, 608, 1623, 1092, 1092, 10942, -1000, -1000, 695,
-	-1000, 12775, 881, -1000, 892, 1166, 1165, 1092, 881, 881,
-	1092, 1092, 27823, -1000, -280, -1000, -86, 460, 1241, -1000,
-	20081, -1000, -1000, 881, 1146, 1494, -1000, -1000, 1466, -1000,
-	1387, 12775, 12775, 12775, -1000, -1000, -1000, 1494, 1578, -1000,
-	1400, 1397, 1615, 10942, 19626, 1481, -1000, -1000, -1000, 448,
-	1615, 1189, 1241, -1000, 27823, 19626, 19626, 19626, 19626, 19626,
-	-1000, 1321, 1320, -1000, 1361, 1358, 1338, 27823, -1000, 1111,
-	1090, 17806, 234, 1157, 19626, 27823, -1000, -1000, 19626, 27823,
-	6308, -1000, 1160, -24, -49, -1000, -1000, -1000, -1000, 568,
-	-1000, 858, -1000, 2216, -1000, 315, -1000, -1000, -1000, -1000,
-	480, 15, -1000, -1000, -6, -6, -1000, -1000, 467, 748,
-	467, 467, 467, 958, 958, -1000, -1000, -1000, -1000, -1000,
-	787, -1000, -1000, -1000, 777, -1000, -1000, 960, 1342, 178,
-	-1000, -1000, 539, 956, 1455, -1000, -1000, 1049, 374, -1000,
-	1552, 27823, -1000, 1305, 1302, 1297, -1000, -1000, -1000, -1000,
-	-1000, 3686, 27823, 1105, -1000, 111, 27823, 1040, 27823, -1000,
-	1100, 27823, -1000, 952, -1000, -1000, 7715, -1000, 27823, 1241,
-	-1000, -1000, -1000, -1000, 398, 1506, 1505, 114, 111, 467,
-	952, -1000, -1000, -1000, -1000, -1000, -337, 1096, 27823, 141,
-	-1000, 1253, 993, -1000, 1283, -1000, -1000, -1000, -1000, 109,
-	215, 216, 331, -1000, 377, 1342, 27823, -1000, -1000, -1000,
-	-1000, 673, -1000, -1000, 673, -1000, -1000, -1000, -1000, -1000,
-	-1000, 1496, -75, -311, -1000, -307, -1000, -1000, -1000, -1000,
-	589, 1277, 2421, -1000, 14140, 14140, -1000, -1000, 1092, 1092,
-	10942, 7715, 1601, 1494, -1000, -1000, 285, 718, 285, 14140,
-	14140, -1000, 14140, 14140, -1000, -119, 1168, 622, -1000, 12775,
-	672, -1000, -1000, 14140, 14140, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 385, 380, 379, 27823, -1000, -1000,
-	-1000, 878, 929, 1369, 568, 568, -1000, -1000, 27823, -1000,
-	-1000, -1000, -1000, 1604, 12775, -1000, 1158, -1000, 5839, 1579,
-	1294, 27823, 1241, 1647, 15973, 27823, 1167, -1000, 566, 1567,
-	1281, 1293, 1393, -1000, -1000, -1000, -1000, 1319, -1000, 1280,
-	-1000, -1000, -1000, -1000, -1000, 1090, 1615, 19626, 1135, -1000,
-	1135, -1000, 446, -1000, -1000, -1000, -80, -52, -1000, -1000,
-	-1000, 296, -1000, -1000, -1000, 684, 14140, 1640, -1000, 915,
-	1519, -1000, 1518, -1000, -1000, 467, 467, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, 1087, -1000, 1082, 1156, 1079, 54,
-	-1000, 1194, 1493, 539, 539, -1000, 775, -1000, 952, -1000,
-	27823, -1000, -1000, 27823, 27823, 27823, 1595, 1155, -1000, 27823,
-	-1000, -1000, 27823, -1000, -1000, 1396, 178, 1077, -1000, -1000,
-	-1000, 233, 27823, -1000, 1039, 111, -1000, -1000, -1000, -1000,
-	-1000, -1000, 1243, -1000, -1000, -1000, 1009, -1000, -159, 952,
-	27823, 27823, 27823, -1000, 27823, -1000, -1000, -1000, 636, 636,
-	-1000, 1485, -1000, 952, -1000, 14140, 1277, 1277, -1000, -1000,
-	881, -1000, 1579, -1000, 881, 1249, 1249, -1000, 1249, 1251,
-	-1000, 1249, 79, 1249, 69, 881, 881, 2295, 2211, 2145,
-	1797, 1241, -113, -1000, 568, 12775, 1257, 876, 1241, 1241,
-	1241, 1058, 911, -6, -1000, -1000, -1000, 1607, 1594, 568,
-	-1000, -1000, -1000, 1535, 1017, 1134, -1000, -1000, 10487, 1068,
-	1394, 439, 1058, 1601, 27823, 12775, -1000, -1000, 12775, 1248,
-	-1000, 12775, -1000, -1000, -1000, 1601, 1601, 1135, -1000, -1000,
-	505, -1000, -1000, -1000, -1000, -1000, 1277, -66, -1000, -1000,
-	-1000, -1000, -1000, -6, 908, -6, 757, -1000, 746, -1000,
-	-1000, -218, -1000, -1000, 1211, 1323, -1000, -1000, 1243, -1000,
-	-1000, -1000, 27823, 27823, -1000, -1000, 230, -1000, 279, 1034,
-	-1000, -173, -1000, -1000, 1565, 27823, -1000, -1000, 7715, -1000,
-	-1000, 1242, 1286, -1000, -1000, -1000, -1000, -1000, -1000, 1277,
-	-1000, 1494, -1000, -1000, 218, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 14140, 14140, 14140, 14140, 14140, 1579, 905,
-	568, 14140, 14140, 19171, 27823, 27823, 17338, -6, 0, -1000,
-	12775, 12775, 1511, -1000, 1241, -1000, 1170, 27823, 1241, 27823,
-	-1000, 1579, -1000, 568, 568, 27823, 568, 1579, -1000, -1000,
-	467, -1000, 467, 1003, 1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 1560, 1155, -1000, 228, 27823, -1000, 233,
-	-1000, -180, -181, 1240, 1032, 1153, -1000, 538, 27823, 27823,
-	-1000, -1000, -1000, 892, 892, 892, 892, 211, 881, -1000,
-	892, 892, 1030, -1000, 1030, 1030, 460, -271, -1000, 1445,
-	1420, 568, 1146, 1638, -1000, 1241, 1647, 436, 1134, -1000,
-	-1000, 1027, -1000, -1000, -1000, -1000, -1000, 1240, 1241, 1212,
-	-1000, -1000, -1000, 180, -1000, 7715, 5370, 1022, -1000, -1000,
-	-1000, -1000, -1000, 881, 161, -162, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, 0, 287, -1000, 1403, 1420, -1000, 1593,
-	1426, 1590, -1000, 27823, 1134, 27823, -1000, 180, 13230, 27823,
-	-1000, -56, -1000, -1000, -1000, -1000, -1000, 1283, -1000, 1366,
-	-130, -169, 1419, 1422, 1422, 1403, -1000, 1588, 1586, -1000,
-	903, 1584, 898, 1120, -1000, -1000, 892, 881, 998, 309,
-	-1000, -1000, -159, -1000, 1359, -1000, 1417, 797, -1000, -1000,
-	-1000, -1000, 880, 879, -1000, 859, -1000, -1000, -1000, 1290,
-	166, -1000, -160, -1000, 744, -1000, -1000, -1000, -1000, -1000,
-	1289, -1000, 1631, -1000, -165, -1000, -1000, -1000, 1636, 425,
-	425, -171, -1000, -1000, -1000, 304, 799, -1000, -1000, -1000,
-	-1000, -1000,
+	-1000, -1000, 980, -1000, 27390, 27390, -1000, -1000, -1000, -1000,
+	-1000, 54, -64, 170, -1000, -1000, -1000, -1000, 1594, -1000,
+	969, 554, 736, 584, -1000, -1000, 750, -1000, -1000, 1266,
+	-1000, -1000, -1000, -1000, 816, 13677, 13677, 13677, 417, 1266,
+	2409, 732, 660, 509, 643, 643, 514, 514, 514, 514,
+	514, 552, 552, -1000, -1000, -1000, -1000, 1032, -1000, -1000,
+	-1000, 1032, 10472, 10472, 1255, 1291, 474, -1000, 1331, -1000,
+	-1000, 1606, 1140, 1140, 830, 994, 581, 1646, 1140, 572,
+	1643, 1140, 1140, 10472, -1000, -1000, 655, -1000, 12309, 1032,
+	-1000, 857, 1221, 1213, 1140, 1032, 1032, 1140, 1140, 27390,
+	-1000, -277, -1000, -93, 493, 1291, -1000, 19631, -1000, -1000,
+	1032, 1180, 1538, -1000, -1000, 1500, -1000, 1438, 12309, 12309,
+	12309, -1000, -1000, -1000, 1538, 1605, -1000, 1466, 1465, 1638,
+	10472, 19175, 1658, -1000, -1000, -1000, 469, 1638, 1233, 1291,
+	-1000, 27390, 19175, 19175, 19175, 19175, 19175, -1000, 1402, 1401,
+	-1000, 1426, 1394, 1410, 27390, -1000, 1158, 1150, 17351, 225,
+	1130, 19175, 27390, -1000, -1000, 19175, 27390, 5828, -1000, 1209,
+	-73, -90, -1000, -1000, -1000, -1000, 969, -1000, 892, -1000,
+	287, -1000, 283, -1000, -1000, -1000, -1000, 366, 19, -1000,
+	-1000, 18, 18, -1000, -1000, 460, 677, 460, 460, 460,
+	971, 971, -1000, -1000, -1000, -1000, -1000, 828, -1000, -1000,
+	-1000, 825, -1000, -1000, 965, 1411, 146, -1000, -1000, 608,
+	970, 1508, -1000, -1000, 1086, 363, -1000, 1574, 27390, -1000,
+	1378, 1377, 1375, -1000, -1000, -1000, -1000, -1000, 261, 27390,
+	1149, -1000, 112, 27390, 1056, 27390, -1000, 1147, 27390, -1000,
+	999, -1000, -1000, 7238, -1000, 27390, 1291, -1000, -1000, -1000,
+	-1000, 399, 1534, 1532, 129, 112, 460, 999, -1000, -1000,
+	-1000, -1000, -1000, -335, 1144, 27390, 166, -1000, 1308, 1027,
+	-1000, 1361, -1000, -1000, -1000, -1000, 110, 208, 188, 351,
+	-1000, 423, 1411, 27390, -1000, -1000, -1000, -1000, 644, -1000,
+	-1000, 644, -1000, -1000, -1000, -1000, -1000, -1000, 1527, -66,
+	-309, -1000, -305, -1000, -1000, -1000, -1000, 417, 1266, 2394,
+	-1000, 13677, 13677, -1000, -1000, 1140, 1140, 10472, 7238, 1607,
+	1538, -1000, -1000, 343, 665, 343, 13677, 13677, -1000, 13677,
+	13677, -1000, -126, 1142, 647, -1000, 12309, 956, -1000, -1000,
+	13677, 13677, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 374, 373, 367, 27390, -1000, -1000, -1000, 870, 967,
+	1436, 969, 969, -1000, -1000, 27390, -1000, -1000, -1000, -1000,
+	1636, 12309, -1000, 1202, -1000, 5358, 1606, 1374, 27390, 1291,
+	1672, 15514, 27390, 1207, -1000, 616, 1601, 1359, 1371, 1567,
+	-1000, -1000, -1000, -1000, 1393, -1000, 1317, -1000, -1000, -1000,
+	-1000, -1000, 1150, 1638, 19175, 1177, -1000, 1177, -1000, 462,
+	-1000, -1000, -1000, -89, -72, -1000, -1000, -1000, 2750, -1000,
+	-1000, -1000, 717, 13677, 1663, -1000, 964, 1543, -1000, 1542,
+	-1000, -1000, 460, 460, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 1134, -1000, 1127, 1198, 1124, 53, -1000, 1267, 1523,
+	608, 608, -1000, 818, -1000, 999, -1000, 27390, -1000, -1000,
+	27390, 27390, 27390, 1628, 1196, -1000, 27390, -1000, -1000, 27390,
+	-1000, -1000, 1462, 146, 1120, -1000, -1000, -1000, 224, 27390,
+	-1000, 1033, 112, -1000, -1000, -1000, -1000, -1000, -1000, 1303,
+	-1000, -1000, -1000, 1052, -1000, -159, 999, 27390, 27390, 27390,
+	-1000, 27390, -1000, -1000, -1000, 658, 658, -1000, 1522, -1000,
+	999, -1000, 13677, 1266, 1266, -1000, -1000, 1032, -1000, 1606,
+	-1000, 1032, 1306, 1306, -1000, 1306, 1307, -1000, 1306, 83,
+	1306, 76, 1032, 1032, 2318, 2302, 2173, 1220, 1291, -119,
+	-1000, 969, 12309, 1286, 1013, 1291, 1291, 1291, 1116, 959,
+	18, -1000, -1000, -1000, 1634, 1627, 969, -1000, -1000, -1000,
+	1555, 1188, 1175, -1000, -1000, 10016, 1118, 1459, 455, 1116,
+	1607, 27390, 12309, -1000, -1000, 12309, 1304, -1000, 12309, -1000,
+	-1000, -1000, 1607, 1607, 1177, -1000, -1000, 518, -1000, -1000,
+	-1000, -1000, -1000, 1266, -44, -1000, -1000, -1000, -1000, -1000,
+	18, 958, 18, 812, -1000, 747, -1000, -1000, -217, -1000,
+	-1000, 1214, 1405, -1000, -1000, 1303, -1000, -1000, -1000, 27390,
+	27390, -1000, -1000, 219, -1000, 262, 1109, -1000, -172, -1000,
+	-1000, 1585, 27390, -1000, -1000, 7238, -1000, -1000, 1302, 1362,
+	-1000, -1000, -1000, -1000, -1000, -1000, 1266, -1000, 1538, -1000,
+	-1000, 233, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	13677, 13677, 13677, 13677, 13677, 1606, 957, 969, 13677, 13677,
+	18719, 27390, 27390, 16882, 18, -1, -1000, 12309, 12309, 1540,
+	-1000, 1291, -1000, 1262, 27390, 1291, 27390, -1000, 1606, -1000,
+	969, 969, 27390, 969, 1606, -1000, -1000, 460, -1000, 460,
+	1045, 1043, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	1582, 1196, -1000, 216, 27390, -1000, 224, -1000, -179, -182,
+	1292, 1105, 1194, -1000, 607, 27390, 27390, -1000, -1000, -1000,
+	857, 857, 857, 857, 211, 1032, -1000, 857, 857, 1101,
+	-1000, 1101, 1101, 493, -272, -1000, 1493, 1456, 969, 1180,
+	1660, -1000, 1291, 1672, 432, 1175, -1000, -1000, 1092, -1000,
+	-1000, -1000, -1000, -1000, 1292, 1291, 1289, -1000, -1000, -1000,
+	180, -1000, 7238, 4888, 1084, -1000, -1000, -1000, -1000, -1000,
+	1032, 149, -163, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1, 266, -1000, 1479, 1456, -1000, 1623, 1489, 1622, -1000,
+	27390, 1175, 27390, -1000, 180, 12765, 27390, -1000, -53, -1000,
+	-1000, -1000, -1000, -1000, 1361, -1000, 1415, -129, -168, 1483,
+	1480, 1480, 1479, -1000, 1613, 1610, -1000, 938, 1602, 936,
+	1169, -1000, -1000, 857, 1032, 1035, 270, -1000, -1000, -159,
+	-1000, 1409, -1000, 1477, 814, -1000, -1000, -1000, -1000, 930,
+	923, -1000, 893, -1000, -1000, -1000, 1370, 162, -1000, -161,
+	-1000, 740, -1000, -1000, -1000, -1000, -1000, 1365, -1000, 1642,
+	-1000, -166, -1000, -1000, -1000, 1652, 453, 453, -170, -1000,
+	-1000, -1000, 254, 859, -1000, -1000, -1000, -1000, -1000,
 }
 
 var yyPgo = [...]int{
-	0, 1902, 1899, 32, 88, 85, 1898, 1897, 1896, 1894,
-	131, 130, 129, 1891, 1889, 1888, 1886, 1885, 1884, 1883,
-	1882, 1880, 1878, 1877, 1872, 65, 122, 37, 39, 126,
-	1870, 1869, 53, 1868, 1866, 1864, 120, 116, 463, 1858,
-	119, 1857, 1856, 1853, 1852, 1849, 1848, 1847, 1846, 1845,
-	1842, 1838, 1837, 1836, 1835, 182, 1834, 1833, 5, 1832,
-	56, 1831, 1829, 1828, 1823, 1822, 87, 1820, 1818, 1814,
-	104, 1813, 1812, 48, 114, 51, 74, 1811, 1809, 76,
-	814, 1808, 93, 128, 1807, 181, 1806, 42, 79, 69,
-	1805, 44, 1804, 1803, 90, 1801, 1799, 1796, 67, 1794,
-	1779, 3133, 1777, 66, 80, 20, 31, 1776, 1775, 1774,
-	1773, 34, 450, 1772, 1771, 23, 1770, 1769, 145, 1767,
-	86, 18, 1766, 16, 14, 21, 1761, 82, 1760, 11,
-	58, 30, 1759, 83, 1758, 1756, 1755, 1754, 43, 1751,
-	72, 107, 160, 1749, 1748, 7, 15, 1747, 1744, 1743,
-	1742, 1741, 1737, 10, 1734, 2, 1731, 26, 1725, 17,
-	22, 71, 47, 28, 8, 1724, 121, 1723, 27, 125,
-	63, 109, 1722, 1721, 1720, 844, 140, 1717, 1716, 35,
-	1715, 113, 124, 1714, 1486, 1713, 1712, 68, 1276, 2523,
-	13, 111, 1711, 1709, 2164, 59, 78, 24, 1708, 75,
-	1707, 1706, 1705, 127, 118, 50, 841, 46, 1704, 1703,
-	1702, 1700, 1698, 1697, 1696, 133, 9, 19, 99, 29,
-	1694, 1693, 1692, 61, 41, 1691, 108, 103, 64, 94,
-	1690, 112, 106, 73, 1689, 40, 1687, 1686, 1685, 1684,
-	45, 1683, 1681, 1680, 1679, 98, 89, 57, 36, 1678,
-	38, 91, 102, 101, 1674, 25, 123, 12, 1673, 6,
-	0, 1672, 3, 117, 1501, 115, 1671, 1670, 1, 1669,
-	4, 1668, 1662, 81, 1661, 1660, 1659, 1658, 3249, 559,
-	110, 1656, 134,
+	0, 1952, 1951, 32, 89, 87, 1948, 1946, 1945, 1944,
+	135, 133, 132, 1943, 1942, 1939, 1938, 1937, 1936, 1935,
+	1934, 1930, 1929, 1928, 1925, 62, 124, 35, 41, 125,
+	1917, 1915, 49, 1914, 1913, 1912, 120, 118, 478, 1911,
+	127, 1910, 1909, 1907, 1906, 1905, 1904, 1903, 1901, 1898,
+	1896, 1895, 1893, 1892, 1890, 1889, 217, 1883, 1882, 5,
+	1880, 60, 1879, 1877, 1876, 1873, 1871, 100, 1866, 1865,
+	1859, 116, 1858, 1459, 57, 240, 51, 76, 1857, 1856,
+	75, 854, 1854, 105, 121, 1853, 909, 1852, 64, 91,
+	80, 1849, 45, 1848, 1847, 108, 1846, 1845, 1844, 77,
+	1843, 1842, 2954, 1841, 74, 82, 12, 37, 1840, 1835,
+	1834, 1830, 39, 44, 1829, 1827, 20, 1825, 1824, 137,
+	1821, 84, 18, 1819, 26, 16, 9, 1814, 93, 1812,
+	11, 58, 31, 1810, 86, 1808, 1804, 1803, 1802, 43,
+	1800, 79, 88, 59, 1799, 1798, 4, 8, 1797, 1796,
+	1795, 1794, 1792, 1790, 13, 1789, 2, 1785, 27, 1784,
+	17, 22, 72, 54, 23, 10, 1783, 129, 1782, 24,
+	122, 68, 111, 1781, 1778, 1774, 891, 143, 1773, 1772,
+	46, 1771, 117, 134, 1767, 1511, 1765, 1763, 90, 1278,
+	2411, 42, 113, 1762, 1761, 2064, 73, 81, 21, 1758,
+	48, 1757, 1754, 1751, 128, 126, 69, 855, 52, 1750,
+	1749, 1743, 1740, 1738, 1734, 1730, 25, 29, 19, 107,
+	36, 1729, 1728, 1724, 67, 38, 1722, 110, 109, 70,
+	92, 1717, 115, 95, 65, 1716, 40, 1713, 1711, 1709,
+	1708, 47, 1706, 1705, 1703, 1701, 106, 96, 66, 30,
+	1700, 34, 131, 103, 101, 1699, 15, 123, 14, 1698,
+	6, 0, 1697, 7, 119, 1544, 104, 1694, 1693, 1,
+	1692, 3, 1691, 1690, 83, 1688, 1686, 1685, 1682, 1681,
+	2155, 28, 112, 1678, 130,
 }
 
-//line sql.y:5200
+//line sql.y:5227
 type yySymType struct {
 	union             interface{}
 	empty             struct{}
@@ -4417,6 +4376,11 @@ func (st *yySymType) joinTypeUnion() JoinType {
 	return v
 }
 
+func (st *yySymType) killTypeUnion() KillType {
+	v, _ := st.union.(KillType)
+	return v
+}
+
 func (st *yySymType) limitUnion() *Limit {
 	v, _ := st.union.(*Limit)
 	return v
@@ -4628,129 +4592,103 @@ func (st *yySymType) whensUnion() []*When {
 }
 
 var yyR1 = [...]int{
-	0, 276, 277, 277, 1, 1, 1, 1, 1, 1,
+	0, 278, 279, 279, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 260, 260, 260, 263, 263,
-	21, 50, 3, 3, 3, 3, 2, 2, 8, 9,
-	4, 5, 5, 10, 10, 62, 62, 11, 12, 12,
-	12, 12, 280, 280, 96, 96, 94, 94, 95, 95,
-	161, 161, 13, 14, 14, 171, 171, 170, 170, 170,
-	172, 172, 172, 172, 206, 206, 15, 15, 15, 15,
-	15, 71, 71, 262, 262, 261, 259, 259, 258, 258,
-	257, 23, 24, 33, 33, 33, 33, 34, 35, 264,
-	264, 236, 39, 39, 38, 38, 38, 38, 40, 40,
-	37, 37, 36, 36, 238, 238, 225, 225, 237, 237,
-	237, 237, 237, 237, 237, 224, 144, 144, 144, 144,
-	144, 144, 144, 144, 144, 144, 144, 208, 208, 208,
-	208, 211, 211, 209, 209, 209, 209, 209, 209, 209,
-	209, 209, 210, 210, 210, 210, 210, 212, 212, 212,
-	212, 212, 213, 213, 213, 213, 213, 213, 213, 213,
-	213, 213, 213, 213, 213, 213, 213, 214, 214, 214,
-	214, 214, 214, 214, 214, 223, 223, 215, 215, 218,
-	218, 219, 219, 219, 220, 220, 221, 221, 216, 216,
-	216, 216, 217, 217, 217, 226, 250, 250, 249, 249,
-	247, 247, 247, 247, 235, 235, 244, 244, 244, 244,
-	244, 234, 234, 230, 230, 230, 231, 231, 232, 232,
-	229, 229, 233, 233, 246, 246, 245, 227, 227, 228,
-	228, 252, 252, 252, 252, 253, 269, 270, 268, 268,
-	268, 268, 268, 60, 60, 60, 183, 183, 183, 242,
-	242, 241, 241, 241, 243, 243, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 240, 240, 240, 240, 240,
-	240, 240, 240, 240, 240, 178, 178, 178, 267, 267,
-	267, 267, 267, 267, 266, 266, 266, 239, 239, 239,
-	265, 265, 130, 130, 131, 131, 30, 30, 30, 30,
-	30, 30, 29, 29, 29, 25, 25, 25, 25, 25,
+	1, 1, 1, 1, 1, 1, 261, 261, 261, 264,
+	264, 21, 51, 3, 3, 3, 3, 2, 2, 8,
+	9, 4, 5, 5, 10, 10, 63, 63, 11, 12,
+	12, 12, 12, 282, 282, 97, 97, 95, 95, 96,
+	96, 162, 162, 13, 14, 14, 172, 172, 171, 171,
+	171, 173, 173, 173, 173, 207, 207, 15, 15, 15,
+	15, 15, 72, 72, 263, 263, 262, 260, 260, 259,
+	259, 258, 23, 24, 33, 33, 33, 33, 34, 35,
+	265, 265, 237, 39, 39, 38, 38, 38, 38, 40,
+	40, 37, 37, 36, 36, 239, 239, 226, 226, 238,
+	238, 238, 238, 238, 238, 238, 225, 145, 145, 145,
+	145, 145, 145, 145, 145, 145, 145, 145, 209, 209,
+	209, 209, 212, 212, 210, 210, 210, 210, 210, 210,
+	210, 210, 210, 211, 211, 211, 211, 211, 213, 213,
+	213, 213, 213, 214, 214, 214, 214, 214, 214, 214,
+	214, 214, 214, 214, 214, 214, 214, 214, 215, 215,
+	215, 215, 215, 215, 215, 215, 224, 224, 216, 216,
+	219, 219, 220, 220, 220, 221, 221, 222, 222, 217,
+	217, 217, 217, 218, 218, 218, 227, 251, 251, 250,
+	250, 248, 248, 248, 248, 236, 236, 245, 245, 245,
+	245, 245, 235, 235, 231, 231, 231, 232, 232, 233,
+	233, 230, 230, 234, 234, 247, 247, 246, 228, 228,
+	229, 229, 253, 253, 253, 253, 254, 270, 271, 269,
+	269, 269, 269, 269, 61, 61, 61, 184, 184, 184,
+	243, 243, 242, 242, 242, 244, 244, 241, 241, 241,
+	241, 241, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 241, 241, 241, 241, 241, 241, 241, 241, 241,
+	241, 241, 241, 241, 241, 241, 179, 179, 179, 268,
+	268, 268, 268, 268, 268, 267, 267, 267, 240, 240,
+	240, 266, 266, 131, 131, 132, 132, 30, 30, 30,
+	30, 30, 30, 29, 29, 29, 25, 25, 25, 25,
 	25, 25, 25, 25, 25, 25, 25, 25, 25, 25,
-	25, 25, 25, 25, 25, 25, 25, 31, 31, 26,
-	26, 26, 26, 26, 26, 26, 26, 26, 16, 16,
+	25, 25, 25, 25, 25, 25, 25, 25, 31, 31,
+	26, 26, 26, 26, 26, 26, 26, 26, 26, 16,
 	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
-	16, 16, 16, 16, 16, 16, 16, 256, 256, 256,
-	256, 256, 256, 256, 256, 256, 256, 256, 256, 256,
-	256, 256, 256, 256, 256, 256, 256, 256, 256, 222,
-	222, 222, 254, 254, 255, 255, 17, 22, 22, 18,
-	18, 18, 18, 19, 19, 41, 42, 42, 42, 42,
+	16, 16, 16, 16, 16, 16, 16, 16, 257, 257,
+	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
+	257, 257, 257, 257, 257, 257, 257, 257, 257, 257,
+	223, 223, 223, 255, 255, 256, 256, 17, 22, 22,
+	18, 18, 18, 18, 19, 19, 41, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
 	42, 42, 42, 42, 42, 42, 42, 42, 42, 42,
-	271, 271, 177, 177, 185, 185, 176, 176, 199, 199,
-	199, 179, 179, 179, 180, 180, 275, 275, 275, 43,
-	43, 45, 45, 46, 47, 47, 201, 201, 202, 202,
-	48, 49, 61, 61, 61, 61, 61, 61, 63, 63,
-	63, 7, 7, 7, 7, 57, 57, 57, 6, 6,
-	44, 44, 51, 272, 272, 273, 274, 274, 274, 274,
-	52, 54, 20, 20, 20, 20, 20, 20, 78, 78,
-	66, 66, 66, 66, 66, 66, 66, 66, 66, 66,
-	66, 66, 72, 72, 72, 67, 67, 281, 55, 56,
-	56, 70, 70, 70, 64, 64, 64, 69, 69, 69,
-	75, 75, 77, 77, 77, 77, 77, 79, 79, 79,
-	79, 79, 79, 79, 74, 74, 76, 76, 76, 76,
-	192, 192, 192, 191, 191, 86, 86, 87, 87, 88,
-	88, 89, 89, 89, 128, 104, 104, 160, 160, 159,
-	159, 162, 162, 90, 90, 90, 90, 91, 91, 92,
-	92, 93, 93, 200, 200, 197, 197, 197, 196, 196,
-	97, 97, 97, 99, 98, 98, 98, 98, 100, 100,
-	102, 102, 101, 101, 103, 105, 105, 105, 105, 105,
-	106, 106, 85, 85, 85, 85, 85, 85, 85, 85,
-	174, 174, 108, 108, 107, 107, 107, 107, 107, 107,
-	107, 107, 107, 107, 119, 119, 119, 119, 119, 119,
-	109, 109, 109, 109, 109, 109, 109, 73, 73, 120,
-	120, 120, 127, 121, 121, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 112,
-	112, 112, 112, 112, 112, 112, 112, 112, 112, 116,
-	116, 116, 116, 114, 114, 114, 114, 114, 114, 114,
-	114, 114, 114, 114, 114, 114, 114, 115, 115, 115,
+	42, 272, 272, 178, 178, 186, 186, 177, 177, 200,
+	200, 200, 180, 180, 180, 181, 181, 277, 277, 277,
+	43, 43, 46, 46, 47, 48, 48, 202, 202, 203,
+	203, 49, 50, 62, 62, 62, 62, 62, 62, 64,
+	64, 64, 7, 7, 7, 7, 58, 58, 58, 6,
+	6, 44, 44, 52, 273, 273, 274, 275, 275, 275,
+	275, 53, 55, 45, 276, 276, 276, 20, 20, 20,
+	20, 20, 20, 79, 79, 67, 67, 67, 67, 67,
+	67, 67, 67, 67, 67, 67, 67, 73, 73, 73,
+	68, 68, 283, 56, 57, 57, 71, 71, 71, 65,
+	65, 65, 70, 70, 70, 76, 76, 78, 78, 78,
+	78, 78, 80, 80, 80, 80, 80, 80, 80, 75,
+	75, 77, 77, 77, 77, 193, 193, 193, 192, 192,
+	87, 87, 88, 88, 89, 89, 90, 90, 90, 129,
+	105, 105, 161, 161, 160, 160, 163, 163, 91, 91,
+	91, 91, 92, 92, 93, 93, 94, 94, 201, 201,
+	198, 198, 198, 197, 197, 98, 98, 98, 100, 99,
+	99, 99, 99, 101, 101, 103, 103, 102, 102, 104,
+	106, 106, 106, 106, 106, 107, 107, 86, 86, 86,
+	86, 86, 86, 86, 86, 175, 175, 109, 109, 108,
+	108, 108, 108, 108, 108, 108, 108, 108, 108, 120,
+	120, 120, 120, 120, 120, 110, 110, 110, 110, 110,
+	110, 110, 74, 74, 121, 121, 121, 128, 122, 122,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 113, 113, 113, 113, 113, 113,
+	113, 113, 113, 113, 117, 117, 117, 117, 115, 115,
 	115, 115, 115, 115, 115, 115, 115, 115, 115, 115,
-	115, 115, 115, 282, 282, 118, 117, 117, 117, 117,
-	117, 117, 117, 68, 68, 68, 68, 68, 205, 205,
-	205, 207, 207, 207, 207, 207, 207, 207, 207, 207,
-	207, 207, 207, 207, 134, 134, 65, 65, 132, 132,
-	133, 135, 135, 129, 129, 129, 111, 111, 111, 111,
-	111, 111, 111, 111, 113, 113, 113, 136, 136, 137,
-	137, 138, 138, 139, 139, 140, 141, 141, 141, 142,
-	142, 142, 142, 32, 32, 32, 32, 32, 27, 27,
-	27, 27, 28, 28, 28, 80, 80, 80, 80, 82,
-	82, 81, 81, 58, 58, 59, 59, 59, 83, 83,
-	84, 84, 84, 84, 157, 157, 157, 143, 143, 143,
-	143, 149, 149, 149, 145, 145, 147, 147, 147, 148,
-	148, 148, 146, 154, 154, 156, 156, 155, 155, 151,
-	151, 152, 152, 153, 153, 153, 150, 150, 110, 110,
-	110, 110, 110, 158, 158, 158, 158, 163, 163, 123,
-	123, 125, 125, 124, 126, 164, 164, 168, 165, 165,
-	169, 169, 169, 169, 169, 166, 166, 167, 167, 193,
-	193, 193, 173, 173, 184, 184, 181, 181, 182, 182,
-	175, 175, 186, 186, 186, 53, 122, 122, 251, 251,
-	248, 189, 189, 190, 190, 194, 194, 198, 198, 195,
-	195, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 187, 187,
-	187, 187, 187, 187, 187, 187, 187, 187, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
+	115, 115, 116, 116, 116, 116, 116, 116, 116, 116,
+	116, 116, 116, 116, 116, 116, 116, 116, 284, 284,
+	119, 118, 118, 118, 118, 118, 118, 118, 69, 69,
+	69, 69, 69, 206, 206, 206, 208, 208, 208, 208,
+	208, 208, 208, 208, 208, 208, 208, 208, 208, 135,
+	135, 66, 66, 133, 133, 134, 136, 136, 130, 130,
+	130, 112, 112, 112, 112, 112, 112, 112, 112, 114,
+	114, 114, 137, 137, 138, 138, 139, 139, 140, 140,
+	141, 142, 142, 142, 143, 143, 143, 143, 32, 32,
+	32, 32, 32, 27, 27, 27, 27, 28, 28, 28,
+	81, 81, 81, 81, 83, 83, 82, 82, 59, 59,
+	60, 60, 60, 84, 84, 85, 85, 85, 85, 158,
+	158, 158, 144, 144, 144, 144, 150, 150, 150, 146,
+	146, 148, 148, 148, 149, 149, 149, 147, 155, 155,
+	157, 157, 156, 156, 152, 152, 153, 153, 154, 154,
+	154, 151, 151, 111, 111, 111, 111, 111, 159, 159,
+	159, 159, 164, 164, 124, 124, 126, 126, 125, 127,
+	165, 165, 169, 166, 166, 170, 170, 170, 170, 170,
+	167, 167, 168, 168, 194, 194, 194, 174, 174, 185,
+	185, 182, 182, 183, 183, 176, 176, 187, 187, 187,
+	54, 123, 123, 252, 252, 249, 190, 190, 191, 191,
+	195, 195, 199, 199, 196, 196, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
@@ -4764,106 +4702,134 @@ var yyR1 = [...]int{
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
 	188, 188, 188, 188, 188, 188, 188, 188, 188, 188,
-	188, 188, 188, 188, 278, 279, 203, 204, 204, 204,
+	188, 188, 188, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 189,
+	189, 189, 189, 189, 189, 189, 189, 189, 189, 280,
+	281, 204, 205, 205, 205,
 }
 
 var yyR2 = [...]int{
 	0, 2, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 1, 1, 0, 1,
-	2, 3, 5, 6, 6, 7, 4, 6, 5, 7,
-	8, 1, 3, 7, 8, 1, 1, 9, 9, 8,
-	7, 7, 1, 1, 1, 3, 1, 3, 1, 3,
-	0, 4, 3, 5, 4, 1, 3, 3, 2, 2,
-	2, 2, 2, 1, 1, 1, 2, 2, 6, 11,
-	2, 0, 2, 0, 2, 1, 0, 2, 1, 3,
-	3, 5, 3, 6, 7, 7, 7, 5, 2, 1,
-	1, 4, 0, 1, 1, 1, 2, 2, 0, 1,
-	4, 4, 4, 4, 2, 4, 1, 3, 1, 1,
-	3, 4, 3, 3, 3, 3, 0, 2, 3, 3,
-	4, 2, 3, 3, 2, 3, 2, 3, 1, 1,
-	1, 2, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 2, 2, 2, 2, 2, 1, 2, 2,
-	2, 2, 4, 4, 2, 2, 3, 3, 3, 3,
-	1, 1, 1, 1, 1, 6, 6, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 3, 0, 3, 0,
-	5, 0, 3, 5, 0, 1, 0, 1, 0, 2,
-	2, 2, 0, 2, 2, 5, 0, 1, 1, 2,
-	1, 3, 2, 3, 0, 1, 3, 3, 3, 4,
-	2, 0, 2, 1, 1, 1, 1, 1, 0, 1,
-	1, 1, 0, 1, 1, 3, 3, 3, 1, 3,
-	1, 10, 11, 11, 12, 5, 3, 3, 1, 1,
-	2, 2, 2, 0, 1, 1, 0, 1, 2, 0,
-	1, 1, 3, 2, 1, 2, 3, 3, 4, 4,
-	3, 3, 3, 3, 4, 4, 3, 3, 3, 3,
+	1, 1, 1, 1, 1, 0, 1, 1, 1, 0,
+	1, 2, 3, 5, 6, 6, 7, 4, 6, 5,
+	7, 8, 1, 3, 7, 8, 1, 1, 9, 9,
+	8, 7, 7, 1, 1, 1, 3, 1, 3, 1,
+	3, 0, 4, 3, 5, 4, 1, 3, 3, 2,
+	2, 2, 2, 2, 1, 1, 1, 2, 2, 6,
+	11, 2, 0, 2, 0, 2, 1, 0, 2, 1,
+	3, 3, 5, 3, 6, 7, 7, 7, 5, 2,
+	1, 1, 4, 0, 1, 1, 1, 2, 2, 0,
+	1, 4, 4, 4, 4, 2, 4, 1, 3, 1,
+	1, 3, 4, 3, 3, 3, 3, 0, 2, 3,
+	3, 4, 2, 3, 3, 2, 3, 2, 3, 1,
+	1, 1, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 2, 2, 2, 2, 2, 1, 2,
+	2, 2, 2, 4, 4, 2, 2, 3, 3, 3,
+	3, 1, 1, 1, 1, 1, 6, 6, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 3, 0, 3,
+	0, 5, 0, 3, 5, 0, 1, 0, 1, 0,
+	2, 2, 2, 0, 2, 2, 5, 0, 1, 1,
+	2, 1, 3, 2, 3, 0, 1, 3, 3, 3,
+	4, 2, 0, 2, 1, 1, 1, 1, 1, 0,
+	1, 1, 1, 0, 1, 1, 3, 3, 3, 1,
+	3, 1, 10, 11, 11, 12, 5, 3, 3, 1,
+	1, 2, 2, 2, 0, 1, 1, 0, 1, 2,
+	0, 1, 1, 3, 2, 1, 2, 3, 3, 4,
+	4, 3, 3, 3, 3, 4, 4, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 4, 5, 0, 2, 2, 1, 1,
+	3, 3, 3, 3, 4, 5, 0, 2, 2, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	0, 1, 0, 2, 0, 2, 0, 1, 5, 1,
-	3, 7, 1, 3, 3, 1, 2, 2, 2, 5,
-	5, 5, 6, 6, 5, 5, 2, 2, 2, 2,
-	3, 3, 3, 4, 1, 3, 5, 1, 3, 3,
-	3, 3, 3, 3, 3, 3, 2, 2, 2, 4,
-	4, 2, 10, 3, 6, 7, 5, 5, 5, 12,
-	7, 5, 9, 4, 4, 4, 4, 5, 3, 7,
-	4, 4, 4, 4, 3, 3, 3, 7, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 2, 0,
-	2, 2, 1, 3, 8, 8, 3, 3, 5, 6,
-	6, 5, 5, 3, 2, 3, 3, 3, 7, 3,
-	3, 3, 3, 4, 7, 5, 2, 4, 4, 4,
-	4, 4, 5, 5, 4, 4, 4, 4, 4, 4,
-	4, 4, 4, 4, 4, 2, 4, 2, 4, 5,
-	4, 4, 3, 3, 5, 2, 3, 3, 3, 3,
-	1, 1, 0, 1, 0, 1, 1, 1, 0, 2,
-	2, 0, 2, 2, 0, 2, 0, 1, 1, 2,
-	1, 1, 2, 1, 1, 5, 0, 1, 0, 1,
-	2, 3, 0, 3, 3, 3, 3, 1, 1, 1,
-	1, 1, 1, 1, 1, 0, 1, 1, 3, 3,
-	2, 2, 3, 1, 3, 2, 1, 2, 1, 2,
-	2, 3, 3, 3, 6, 4, 7, 6, 1, 3,
-	2, 2, 2, 2, 1, 1, 1, 3, 2, 1,
-	1, 1, 0, 1, 1, 0, 3, 0, 2, 0,
-	2, 1, 2, 2, 0, 1, 1, 0, 1, 1,
-	0, 1, 0, 1, 2, 3, 4, 1, 1, 1,
-	1, 1, 1, 1, 1, 3, 1, 2, 3, 5,
-	0, 1, 2, 1, 1, 0, 2, 1, 3, 1,
-	1, 1, 3, 3, 3, 3, 7, 0, 3, 1,
-	3, 1, 3, 4, 4, 4, 3, 2, 4, 0,
-	1, 0, 2, 0, 1, 0, 1, 2, 1, 1,
-	1, 2, 2, 1, 2, 3, 2, 3, 2, 2,
-	2, 1, 1, 3, 3, 0, 5, 4, 5, 5,
-	0, 2, 1, 3, 3, 3, 2, 3, 1, 2,
-	0, 3, 1, 1, 3, 3, 4, 4, 5, 3,
-	4, 5, 6, 2, 1, 2, 1, 2, 1, 2,
-	1, 1, 1, 1, 1, 1, 1, 0, 2, 1,
-	1, 1, 3, 1, 3, 1, 1, 1, 1, 1,
+	1, 0, 1, 0, 2, 0, 2, 0, 1, 5,
+	1, 3, 7, 1, 3, 3, 1, 2, 2, 2,
+	5, 5, 5, 6, 6, 5, 5, 2, 2, 2,
+	2, 3, 3, 3, 4, 1, 3, 5, 1, 3,
+	3, 3, 3, 3, 3, 3, 3, 2, 2, 2,
+	4, 4, 2, 10, 3, 6, 7, 5, 5, 5,
+	12, 7, 5, 9, 4, 4, 4, 4, 5, 3,
+	7, 4, 4, 4, 4, 3, 3, 3, 7, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 2,
+	0, 2, 2, 1, 3, 8, 8, 3, 3, 5,
+	6, 6, 5, 5, 3, 2, 4, 3, 3, 7,
+	3, 3, 3, 3, 4, 7, 5, 2, 4, 4,
+	4, 4, 4, 5, 5, 4, 4, 4, 4, 4,
+	4, 4, 4, 4, 4, 4, 2, 4, 2, 4,
+	5, 4, 4, 3, 3, 5, 2, 3, 3, 3,
+	3, 1, 1, 0, 1, 0, 1, 1, 1, 0,
+	2, 2, 0, 2, 2, 0, 2, 0, 1, 1,
+	2, 1, 1, 2, 1, 1, 5, 0, 1, 0,
+	1, 2, 3, 0, 3, 3, 3, 3, 1, 1,
+	1, 1, 1, 1, 1, 1, 0, 1, 1, 3,
+	3, 2, 2, 3, 1, 3, 2, 1, 2, 1,
+	2, 2, 3, 3, 0, 1, 1, 3, 3, 6,
+	4, 7, 6, 1, 3, 2, 2, 2, 2, 1,
+	1, 1, 3, 2, 1, 1, 1, 0, 1, 1,
+	0, 3, 0, 2, 0, 2, 1, 2, 2, 0,
+	1, 1, 0, 1, 1, 0, 1, 0, 1, 2,
+	3, 4, 1, 1, 1, 1, 1, 1, 1, 1,
+	3, 1, 2, 3, 5, 0, 1, 2, 1, 1,
+	0, 2, 1, 3, 1, 1, 1, 3, 3, 3,
+	3, 7, 0, 3, 1, 3, 1, 3, 4, 4,
+	4, 3, 2, 4, 0, 1, 0, 2, 0, 1,
+	0, 1, 2, 1, 1, 1, 2, 2, 1, 2,
+	3, 2, 3, 2, 2, 2, 1, 1, 3, 3,
+	0, 5, 4, 5, 5, 0, 2, 1, 3, 3,
+	3, 2, 3, 1, 2, 0, 3, 1, 1, 3,
+	3, 4, 4, 5, 3, 4, 5, 6, 2, 1,
+	2, 1, 2, 1, 2, 1, 1, 1, 1, 1,
+	1, 1, 0, 2, 1, 1, 1, 3, 1, 3,
+	1, 1, 1, 1, 1, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 2, 2, 2, 2, 2,
-	2, 2, 2, 2, 3, 1, 1, 1, 1, 4,
-	5, 5, 6, 4, 4, 6, 6, 6, 8, 8,
-	8, 8, 9, 8, 5, 4, 2, 2, 2, 2,
-	2, 2, 2, 2, 2, 2, 2, 2, 2, 2,
-	2, 8, 8, 0, 2, 3, 4, 4, 4, 4,
-	4, 4, 4, 0, 3, 4, 7, 3, 1, 1,
-	1, 2, 3, 3, 1, 2, 2, 1, 2, 1,
-	2, 2, 1, 2, 0, 1, 0, 2, 1, 2,
-	4, 0, 2, 1, 3, 5, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 2, 2, 0, 3, 0,
-	2, 0, 3, 1, 3, 2, 0, 1, 1, 0,
-	2, 4, 4, 0, 2, 2, 1, 1, 3, 3,
-	3, 3, 3, 3, 3, 0, 3, 3, 3, 0,
-	3, 1, 1, 0, 4, 0, 1, 1, 0, 3,
-	1, 3, 2, 1, 0, 2, 4, 0, 9, 3,
-	5, 0, 3, 3, 0, 1, 0, 2, 2, 0,
-	2, 2, 2, 0, 2, 1, 2, 3, 3, 0,
-	2, 1, 2, 3, 4, 3, 0, 1, 2, 1,
-	5, 4, 4, 1, 3, 3, 5, 0, 5, 1,
-	3, 1, 2, 3, 1, 1, 3, 3, 1, 3,
-	3, 3, 3, 3, 2, 1, 2, 1, 1, 1,
-	1, 1, 1, 1, 0, 1, 0, 2, 0, 3,
-	0, 1, 0, 1, 1, 5, 0, 1, 0, 1,
-	2, 1, 1, 1, 1, 1, 1, 0, 1, 1,
+	2, 2, 2, 2, 2, 2, 2, 2, 2, 3,
+	1, 1, 1, 1, 4, 5, 5, 6, 4, 4,
+	6, 6, 6, 8, 8, 8, 8, 9, 8, 5,
+	4, 2, 2, 2, 2, 2, 2, 2, 2, 2,
+	2, 2, 2, 2, 2, 2, 8, 8, 0, 2,
+	3, 4, 4, 4, 4, 4, 4, 4, 0, 3,
+	4, 7, 3, 1, 1, 1, 2, 3, 3, 1,
+	2, 2, 1, 2, 1, 2, 2, 1, 2, 0,
+	1, 0, 2, 1, 2, 4, 0, 2, 1, 3,
+	5, 1, 1, 1, 1, 1, 1, 1, 1, 1,
+	2, 2, 0, 3, 0, 2, 0, 3, 1, 3,
+	2, 0, 1, 1, 0, 2, 4, 4, 0, 2,
+	2, 1, 1, 3, 3, 3, 3, 3, 3, 3,
+	0, 3, 3, 3, 0, 3, 1, 1, 0, 4,
+	0, 1, 1, 0, 3, 1, 3, 2, 1, 0,
+	2, 4, 0, 9, 3, 5, 0, 3, 3, 0,
+	1, 0, 2, 2, 0, 2, 2, 2, 0, 2,
+	1, 2, 3, 3, 0, 2, 1, 2, 3, 4,
+	3, 0, 1, 2, 1, 5, 4, 4, 1, 3,
+	3, 5, 0, 5, 1, 3, 1, 2, 3, 1,
+	1, 3, 3, 1, 3, 3, 3, 3, 3, 2,
+	1, 2, 1, 1, 1, 1, 1, 1, 1, 0,
+	1, 0, 2, 0, 3, 0, 1, 0, 1, 1,
+	5, 0, 1, 0, 1, 2, 1, 1, 1, 1,
+	1, 1, 0, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
@@ -4904,303 +4870,303 @@ var yyR2 = [...]int{
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
 	1, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 0, 0, 1, 1,
+	1, 0, 0, 1, 1,
 }
 
 var yyChk = [...]int{
-	-1000, -276, -1, -3, -8, -9, -10, -11, -12, -13,
-	-14, -15, -16, -17, -18, -19, -41, -42, -43, -45,
-	-46, -47, -48, -49, -6, -44, -20, -21, -50, -51,
-	-52, -53, -54, -4, -278, 6, 7, 8, -62, 10,
-	11, 31, -23, -33, 153, -34, -24, 154, -35, 156,
-	155, 192, 157, 185, 71, 231, 232, 234, 235, 236,
-	237, -63, 190, 191, 159, 35, 42, 32, 33, 36,
-	162, 81, 9, 334, 187, 186, 26, -277, 474, -70,
-	5, -138, 16, -3, -55, -281, -55, -55, -55, -55,
-	-55, -55, -236, -238, 81, 126, 81, -71, -184, 165,
-	174, 173, 170, -264, 107, 220, 325, 163, -39, -38,
-	-37, -36, -40, 30, -30, -31, -256, -29, -26, 158,
-	155, 200, 102, 103, 192, 193, 194, 157, 176, 191,
-	195, 190, 209, -25, 77, 32, 347, 350, -243, 154,
-	160, 161, 335, 105, 104, 72, 156, -240, 281, 451,
-	-40, 453, 95, 97, 452, 41, 165, 454, 455, 456,
-	457, 175, 458, 459, 460, 461, 467, 468, 469, 470,
-	106, 5, 164, -264, -80, 291, 227, 77, -198, -194,
-	-260, -188, 84, 85, 86, 344, 178, 378, 379, 225,
-	77, 281, 451, 231, 245, 239, 266, 258, 345, 380,
-	228, 179, 213, 448, 256, 312, 453, 381, 193, 304,
-	286, 294, 95, 234, 321, 466, 230, 382, 464, 97,
-	452, 76, 48, 41, 188, 254, 250, 454, 214, 383,
-	355, 207, 105, 102, 473, 248, 47, 28, 463, 104,
-	46, 455, 384, 456, 296, 271, 442, 45, 309, 194,
-	385, 80, 349, 450, 298, 249, 295, 224, 462, 159,
-	386, 434, 306, 443, 387, 272, 276, 388, 313, 49,
-	389, 390, 444, 103, 391, 75, 457, 243, 244, 392,
-	222, 177, 315, 270, 175, 34, 299, 346, 226, 55,
-	201, 316, 43, 274, 42, 438, 393, 441, 269, 265,
-	50, 394, 395, 396, 397, 458, 268, 242, 264, 472,
-	219, 459, 59, 161, 278, 277, 279, 208, 311, 261,
-	398, 399, 400, 182, 78, 401, 251, 19, 402, 403,
-	307, 215, 404, 53, 405, 406, 319, 191, 407, 51,
-	460, 38, 196, 461, 408, 409, 410, 411, 412, 305,
-	413, 297, 273, 275, 203, 293, 348, 414, 247, 195,
-	465, 415, 183, 449, 197, 200, 190, 320, 184, 416,
-	417, 418, 419, 420, 229, 421, 422, 235, 467, 40,
-	423, 424, 425, 426, 223, 218, 314, 323, 58, 79,
-	283, 427, 447, 241, 216, 428, 232, 52, 468, 469,
-	470, 210, 471, 289, 106, 220, 221, 44, 262, 202,
-	429, 430, 252, 253, 267, 240, 263, 233, 435, 204,
-	308, 192, 431, 322, 217, 284, 352, 209, 310, 446,
-	351, 260, 257, 211, 432, 166, 205, 206, 433, 436,
-	300, 290, 301, 302, 227, 303, 291, 212, 350, 255,
-	285, 164, -184, 165, 166, -264, 164, -101, -194, 164,
-	-166, 286, -185, 287, 288, 299, 300, 306, -177, 307,
-	305, 203, -275, 313, 164, 308, 153, 144, 296, 297,
-	290, 303, 291, 212, -271, -260, 456, 471, 312, 259,
-	292, 298, 314, 438, 302, 301, -194, 233, -201, 238,
-	-189, -260, -188, 236, -101, -61, 434, 157, -203, -203,
-	-72, 438, 440, -121, -85, -107, 110, -112, 30, 24,
-	-111, -108, -129, -126, -127, 144, 145, 147, 146, 148,
-	133, 134, 141, 111, 149, -116, -114, -115, -117, 88,
-	87, 96, 89, 90, 91, 92, 98, 99, 100, -189,
-	-194, -124, -278, 65, 66, 335, 336, 337, 338, 343,
-	339, 113, 54, 330, 324, 333, 332, 331, 328, 329,
-	326, 327, 341, 342, 169, 325, 163, 139, 334, -260,
-	-188, 41, 289, 289, -101, 227, -5, -4, -278, 6,
-	21, 22, -142, 18, 17, -279, 83, -64, -77, 60,
-	61, -79, 22, 37, 64, 62, 21, -56, -76, 135,
-	-85, -194, -76, -175, 168, -175, -175, -165, -206, 233,
-	-169, 314, 313, -190, -167, -189, -187, -166, 311, 158,
-	353, 109, 23, 25, 112, 144, 17, 113, 36, 160,
-	259, 176, 143, 172, 335, 153, 69, 354, 326, 327,
-	324, 330, 337, 338, 325, 287, 30, 11, 356, 26,
-	186, 22, 37, 137, 155, 116, 117, 189, 24, 187,
-	100, 359, 20, 72, 181, 12, 174, 14, 360, 361,
-	15, 169, 168, 128, 165, 67, 9, 149, 27, 125,
-	63, 362, 29, 363, 364, 365, 366, 65, 126, 18,
-	328, 329, 32, 439, 367, 343, 198, 139, 70, 56,
-	440, 110, 368, 369, 98, 370, 101, 73, 445, 107,
-	16, 68, 39, 371, 199, 372, 171, 373, 317, 374,
-	127, 156, 334, 66, 375, 163, 288, 6, 340, 31,
-	185, 173, 64, 376, 164, 115, 341, 342, 167, 99,
-	5, 170, 33, 10, 71, 74, 331, 332, 333, 54,
-	347, 114, 13, 377, 318, 108, 312, -237, 126, -224,
-	-228, -189, 180, -253, 176, -101, -246, -245, -189, -80,
-	164, -260, 165, 165, 165, -55, 334, -36, -37, -166,
-	143, 197, 82, 82, -228, -227, -226, -265, 199, 180,
-	-252, -244, 172, 181, -234, 173, 174, -229, 165, 29,
-	-265, -229, 171, 181, 199, 199, 106, 199, 106, 199,
-	199, 199, 199, 199, 199, 199, 199, 199, 196, -235,
-	118, -235, 351, 351, -240, -265, -265, -265, 167, 34,
-	34, -186, -229, 167, 23, -235, -235, -166, 143, -235,
-	-235, -235, -235, 207, 207, -235, -235, -235, -235, -235,
-	-235, -235, -235, -235, -235, -235, -235, -235, -235, -235,
-	-101, -83, 214, 153, 155, 158, 73, 88, 228, 118,
-	-38, 209, -22, -101, 164, -260, -181, 169, -55, -101,
-	150, -101, -179, 126, 13, -179, -176, 289, 293, 294,
-	295, -179, -179, -179, -179, 210, 304, -230, 165, 34,
-	177, 289, 210, 304, 210, 211, 210, 211, 210, -199,
-	12, 128, 325, 309, 306, 203, 164, 204, 166, 310,
-	-260, 441, 211, -199, 289, 206, -179, -204, -278, -190,
-	259, -204, -204, 31, 167, -189, -57, -189, 88, -7,
-	-3, -11, -10, -12, 118, -78, 289, -66, 144, 456,
-	442, 443, 444, 441, 305, 449, 447, 445, 210, 446,
-	82, 109, 107, 108, 125, -85, -109, 128, 110, 126,
-	127, 112, 130, 129, 140, 133, 134, 135, 136, 137,
-	138, 139, 131, 132, 143, 118, 119, 120, 121, 122,
-	123, 124, -174, -278, -127, -278, 151, 152, -112, -112,
-	-112, -112, -112, -112, -112, -112, -112, -112, -278, 150,
-	-2, -121, -4, -278, -278, -278, -278, -278, -278, -278,
-	-278, -134, -85, -278, -282, -278, -282, -118, -278, -282,
-	-118, -282, -118, -282, -282, -118, -282, -118, -282, -282,
-	-118, -278, -278, -278, -278, -278, -278, -278, -203, -272,
-	-273, -104, -101, -278, 88, -138, -3, -55, -157, 20,
-	32, -85, -139, -140, -85, -138, 56, -74, -76, -79,
-	60, 61, 94, 12, -192, -191, 23, -189, 88, 150,
-	12, -102, 27, -101, -87, -88, -89, -90, -104, -128,
-	-278, 12, -94, -95, -101, -103, -194, 82, 233, -169,
-	-206, -171, -170, 315, 317, 118, -193, -189, 88, 30,
-	83, 82, -101, -208, -211, -213, -212, -214, -209, -210,
-	256, 257, 144, 260, 262, 263, 264, 265, 266, 267,
-	268, 269, 270, 271, 31, 188, 252, 253, 254, 255,
-	272, 273, 274, 275, 276, 277, 278, 279, 239, 258,
-	345, 240, 241, 242, 243, 244, 245, 247, 248, 249,
-	250, 251, -263, -260, 81, 83, 82, -215, 81, -83,
-	-182, 169, -251, -248, 74, -260, -260, -260, -182, -235,
-	-235, 196, -29, -26, -256, 16, -25, -26, 158, 102,
-	103, 155, 81, -224, 81, -233, -263, -260, 81, 29,
-	171, 170, -232, -229, -232, -233, -260, -129, -189, -194,
-	-260, 29, 29, -162, -189, -162, -162, 21, -162, 21,
-	-162, 21, 89, -189, -162, 21, -162, 21, -162, 21,
-	-162, 21, -162, 21, 30, 75, 76, 30, 78, 79,
-	80, -129, -129, -224, -166, -101, -260, 89, 89, -235,
-	-235, 89, 88, 88, 88, -235, -235, 89, 88, -260,
-	88, -266, 182, 224, 226, 89, 89, 89, 89, 30,
-	88, -267, 30, 463, 462, 464, 465, 466, 89, 30,
-	89, 30, 89, -189, 81, -82, 216, 118, 205, 205,
-	164, 164, 218, -101, 229, 230, 228, 21, 217, 219,
-	221, 41, 82, 167, -181, 73, -96, -101, 24, -181,
-	-195, -194, -187, 88, -85, -231, 12, 128, -199, -199,
-	-179, -101, -231, -199, -179, -101, -179, -179, -179, -179,
-	-199, -179, -194, -194, -101, -101, -101, -101, -101, -101,
-	-101, -204, -204, -204, -180, 126, -179, 73, -202, 236,
-	270, 435, 436, 437, 82, 347, -94, 441, 441, 441,
-	441, 441, 441, -85, -85, -85, -85, -119, 98, 110,
-	99, 100, -112, -120, -124, -127, 93, 128, 126, 127,
-	112, -112, -112, -112, -112, -112, -112, -112, -112, -112,
-	-112, -112, -112, -112, -112, -112, -205, -260, 88, 144,
-	-260, -111, -111, -189, -75, 22, 37, -74, -190, -195,
-	-187, -70, -279, -279, -138, -74, -74, -85, -85, -129,
-	88, -74, -129, 88, -74, -74, -69, 22, 37, -132,
-	-133, 114, -129, -279, -112, -189, -189, -74, -75, -75,
-	-74, -74, 82, -274, 317, 318, 439, -197, 199, -196,
-	23, -194, 88, -122, -121, -142, -279, -143, 27, 10,
-	128, 82, 19, 82, -141, 25, 26, -142, -113, -189,
-	89, 92, -86, 82, 12, -79, -101, -191, 135, -195,
-	-101, -161, 199, -101, 31, 82, -97, -99, -98, -100,
-	63, 67, 69, 64, 65, 66, 70, -200, 23, -87,
-	-3, -278, -101, -94, -280, 82, 12, 74, -280, 82,
-	150, -169, -171, 82, 316, 318, 319, 73, 101, -85,
-	-217, 143, -242, -241, -240, -224, -226, -227, -228, 83,
-	-144, -220, 284, -215, -215, -215, -215, -215, -216, -166,
-	-216, -216, -216, 81, 81, -215, -215, -215, -215, -218,
-	81, -218, -218, -219, 81, -219, -253, -85, -250, -249,
-	-247, -248, 175, 95, 347, -245, -141, 89, -82, -101,
-	110, 73, -189, -251, -251, -251, -194, -260, 88, -260,
-	88, 82, 17, -225, -224, -130, 224, -255, 199, -252,
-	-246, 81, 29, -232, -233, -233, 150, -260, 82, 27,
-	106, 106, 106, 106, 347, 155, 31, -224, -130, -205,
-	167, -205, -205, 88, 88, -178, 471, -94, 166, 223,
-	-84, 330, 88, 84, -101, -101, -101, -101, -101, 158,
-	155, 207, -101, -101, -94, -101, 82, -60, 184, 179,
-	-194, -101, -179, -179, -101, -179, -179, 88, -101, -189,
-	-66, 317, 347, 20, -67, 20, 98, 99, 100, -120,
-	-112, -112, -112, -73, 189, 109, -279, -279, -74, -74,
-	-278, 150, -5, -142, -279, -279, 82, 74, 23, 12,
-	12, -279, 12, 12, -279, -279, -74, -135, -133, 116,
-	-85, -279, -279, 82, 82, -279, -279, -279, -279, -279,
-	-273, 438, 318, -105, 71, 168, 72, -278, -196, -279,
-	-157, 39, 47, 58, -85, -85, -140, -157, -173, 20,
-	12, 54, 54, -106, 13, -76, -87, -79, 150, -106,
-	-110, 31, 54, -3, -278, -278, -164, -168, -129, -88,
-	-89, -89, -88, -89, 63, 63, 63, 68, 63, 68,
-	63, -98, -194, -279, -279, -3, -161, 74, -87, -101,
-	-87, -103, -194, 135, -170, -172, 320, 317, 323, -260,
-	88, 82, -240, -228, 98, 110, 30, 73, 281, 95,
-	171, 29, 170, -221, 285, -216, -216, -217, -260, 88,
-	144, -217, -217, -217, -223, 88, -223, 89, 89, 83,
-	-32, -27, -28, 32, 77, -247, -235, 88, 38, 83,
-	166, 24, -101, 73, 73, 73, 16, -159, -189, 82,
-	83, -131, 225, -129, 83, -189, 83, -159, -233, -190,
-	-189, -278, 164, 30, 30, -130, -131, -217, -260, 473,
-	472, 83, -101, -81, 214, 222, 81, 85, -262, 74,
-	205, 281, 205, 208, 167, -60, -32, -101, -199, -199,
-	32, 317, 450, 448, -73, 109, -112, -112, -279, -279,
-	-75, -190, -138, -157, -207, 144, 256, 188, 254, 250,
-	270, 261, 283, 252, 284, -205, -207, -112, -112, -112,
-	-112, 344, -138, 117, -85, 115, -112, -112, 165, 165,
-	165, -162, 40, 88, 88, 59, -101, -136, 14, -85,
-	135, -142, -163, 73, -164, -123, -125, -124, -278, -158,
-	-279, -189, -162, -106, 82, 118, -92, -91, 73, 74,
-	-93, 73, -91, 63, 63, -279, -106, -87, -106, -106,
-	150, 317, 321, 322, -240, 98, -112, 10, 88, 29,
-	29, -217, -217, 83, 82, 83, 82, 83, 82, -183,
-	384, 110, -28, -27, -235, -235, 89, -260, -101, -101,
-	-101, -101, 17, 82, -224, -129, 54, -250, 83, -254,
-	-255, -101, -111, -131, -160, 81, 83, -259, 347, -261,
-	-260, -189, -189, -189, -101, -179, -179, 32, -260, -112,
-	-279, -142, -279, -215, -215, -215, -219, -215, 244, -215,
-	244, -279, -279, 20, 20, 20, 20, -278, -65, 340,
-	-85, 82, 82, -278, -278, -278, -279, 88, -216, -137,
-	15, 17, 28, -163, 82, -279, -279, 82, 54, 150,
-	-279, -138, -168, -85, -85, 81, -85, -138, -106, -115,
-	-216, 88, -216, 89, 89, 384, 30, 78, 79, 80,
-	30, 75, 76, -160, -159, -189, 201, 183, -279, 82,
-	-222, 347, 350, 23, -159, -258, -257, -190, 81, 74,
-	-157, -216, -260, -112, -112, -112, -112, -112, -142, 88,
-	-112, -112, -159, -279, -159, -159, -197, -216, -146, -151,
-	-176, -85, -121, 29, -125, 54, -3, -189, -123, -189,
-	-142, -159, -142, -217, -217, 83, 83, 23, 202, -101,
-	-255, 351, 351, -3, 83, 82, 118, -159, -101, -279,
-	-279, -279, -279, -68, 128, 347, -279, -279, -279, -279,
-	-279, -279, -105, -149, 434, -154, 43, -152, -153, 44,
-	-150, 45, 53, 10, -123, 150, 83, -3, -278, 81,
-	-58, 347, -257, -239, -190, 88, 89, 83, -279, 345,
-	70, 348, -146, 48, 262, -156, -155, 52, 44, -153,
-	17, 46, 17, -164, -189, -58, -112, 198, -159, -59,
-	213, 438, -262, 59, 346, 349, -147, 50, -145, 49,
-	-145, -155, 17, 17, 88, 17, 88, -279, -279, 83,
-	176, -259, 59, -148, 51, 73, 101, 88, 88, 88,
-	-269, -270, 73, 215, 347, 73, 101, -270, 73, 11,
-	10, 348, -268, 184, 179, 182, 31, -268, 349, 178,
-	30, 98,
+	-1000, -278, -1, -3, -8, -9, -10, -11, -12, -13,
+	-14, -15, -16, -17, -18, -19, -41, -42, -43, -46,
+	-47, -48, -49, -50, -6, -44, -20, -21, -51, -52,
+	-53, -54, -55, -45, -4, -280, 6, 7, 8, -63,
+	10, 11, 31, -23, -33, 154, -34, -24, 155, -35,
+	157, 156, 193, 158, 186, 72, 232, 233, 235, 236,
+	237, 238, -64, 191, 192, 160, 35, 43, 32, 33,
+	36, 163, 37, 82, 9, 335, 188, 187, 26, -279,
+	475, -71, 5, -139, 16, -3, -56, -283, -56, -56,
+	-56, -56, -56, -56, -237, -239, 82, 127, 82, -72,
+	-185, 166, 175, 174, 171, -265, 108, 221, 326, 164,
+	-39, -38, -37, -36, -40, 30, -30, -31, -257, -29,
+	-26, 159, 156, 201, 103, 104, 193, 194, 195, 158,
+	177, 192, 196, 191, 210, -25, 78, 32, 348, 351,
+	-244, 155, 161, 162, 336, 106, 105, 73, 157, -241,
+	282, 452, -40, 454, 96, 98, 453, 42, 166, 455,
+	456, 457, 458, 176, 459, 460, 461, 462, 468, 469,
+	470, 471, 107, 5, 165, -265, -81, 292, 228, 78,
+	-199, -195, -261, -189, 85, 86, 87, 345, 179, 379,
+	380, 226, 78, 282, 452, 232, 246, 240, 267, 259,
+	346, 381, 229, 180, 214, 449, 257, 313, 454, 382,
+	194, 305, 287, 295, 96, 235, 322, 467, 231, 383,
+	465, 98, 453, 77, 49, 42, 189, 255, 251, 455,
+	215, 384, 356, 208, 106, 103, 474, 249, 48, 28,
+	464, 105, 47, 456, 385, 457, 297, 272, 443, 46,
+	310, 195, 386, 81, 350, 451, 299, 250, 296, 225,
+	463, 160, 387, 435, 307, 444, 388, 273, 277, 389,
+	314, 50, 390, 391, 445, 104, 392, 76, 458, 244,
+	245, 393, 223, 178, 316, 271, 176, 34, 300, 347,
+	227, 56, 202, 317, 44, 275, 43, 439, 394, 442,
+	270, 266, 51, 395, 396, 397, 398, 459, 269, 243,
+	265, 473, 220, 460, 60, 162, 279, 278, 280, 209,
+	312, 262, 399, 400, 401, 183, 79, 402, 252, 19,
+	403, 404, 308, 216, 405, 54, 406, 407, 320, 192,
+	408, 52, 461, 39, 197, 462, 409, 410, 411, 412,
+	413, 306, 414, 298, 274, 276, 204, 294, 349, 415,
+	248, 196, 466, 416, 184, 450, 198, 201, 191, 321,
+	185, 417, 418, 419, 420, 421, 230, 422, 423, 236,
+	468, 41, 424, 425, 426, 427, 224, 219, 315, 324,
+	59, 80, 284, 428, 448, 242, 217, 429, 233, 53,
+	469, 470, 471, 211, 472, 290, 107, 221, 222, 45,
+	263, 203, 430, 431, 253, 254, 268, 241, 264, 234,
+	436, 205, 309, 193, 432, 323, 218, 285, 353, 210,
+	311, 447, 352, 261, 258, 212, 433, 167, 206, 207,
+	434, 437, 301, 291, 302, 303, 228, 304, 292, 213,
+	351, 256, 286, 165, -185, 166, 167, -265, 165, -102,
+	-195, -73, 439, 441, -167, 287, -186, 288, 289, 300,
+	301, 307, -178, 308, 306, 204, -277, 314, 165, 309,
+	154, 145, 297, 298, 291, 304, 292, 213, -272, -261,
+	457, 472, 313, 260, 293, 299, 315, 439, 303, 302,
+	-195, 234, -202, 239, -190, -261, -189, 237, -102, -62,
+	435, 158, -204, -204, -73, -122, -86, -108, 111, -113,
+	30, 24, -112, -109, -130, -127, -128, 145, 146, 148,
+	147, 149, 134, 135, 142, 112, 150, -117, -115, -116,
+	-118, 89, 88, 97, 90, 91, 92, 93, 99, 100,
+	101, -190, -195, -125, -280, 66, 67, 336, 337, 338,
+	339, 344, 340, 114, 55, 331, 325, 334, 333, 332,
+	329, 330, 327, 328, 342, 343, 170, 326, 164, 140,
+	335, -261, -189, 42, 290, 290, -102, 228, -276, 453,
+	349, -5, -4, -280, 6, 21, 22, -143, 18, 17,
+	-281, 84, -65, -78, 61, 62, -80, 22, 38, 65,
+	63, 21, -57, -77, 136, -86, -195, -77, -176, 169,
+	-176, -176, -166, -207, 234, -170, 315, 314, -191, -168,
+	-190, -188, -167, 312, 159, 354, 110, 23, 25, 113,
+	145, 17, 114, 36, 161, 260, 177, 144, 173, 336,
+	154, 70, 355, 327, 328, 325, 331, 338, 339, 326,
+	288, 30, 11, 357, 26, 187, 22, 38, 138, 156,
+	117, 118, 190, 24, 188, 101, 360, 20, 73, 182,
+	12, 175, 14, 361, 362, 15, 170, 169, 129, 166,
+	68, 9, 150, 27, 126, 64, 363, 29, 364, 365,
+	366, 367, 66, 127, 18, 329, 330, 32, 440, 368,
+	344, 199, 140, 71, 57, 441, 111, 369, 370, 99,
+	371, 102, 74, 446, 108, 16, 69, 40, 372, 200,
+	373, 172, 374, 318, 375, 128, 157, 335, 67, 376,
+	164, 289, 6, 341, 31, 186, 174, 65, 377, 165,
+	116, 342, 343, 168, 100, 5, 171, 33, 10, 72,
+	75, 332, 333, 334, 55, 348, 115, 13, 378, 319,
+	109, 313, -238, 127, -225, -229, -190, 181, -254, 177,
+	-102, -247, -246, -190, -81, 165, -261, 166, 166, 166,
+	-56, 335, -36, -37, -167, 144, 198, 83, 83, -229,
+	-228, -227, -266, 200, 181, -253, -245, 173, 182, -235,
+	174, 175, -230, 166, 29, -266, -230, 172, 182, 200,
+	200, 107, 200, 107, 200, 200, 200, 200, 200, 200,
+	200, 200, 200, 197, -236, 119, -236, 352, 352, -241,
+	-266, -266, -266, 168, 34, 34, -187, -230, 168, 23,
+	-236, -236, -167, 144, -236, -236, -236, -236, 208, 208,
+	-236, -236, -236, -236, -236, -236, -236, -236, -236, -236,
+	-236, -236, -236, -236, -236, -102, -84, 215, 154, 156,
+	159, 74, 89, 229, 119, -38, 210, -22, -102, 165,
+	-261, -182, 170, -56, -102, 151, 165, -180, 127, 13,
+	-180, -177, 290, 294, 295, 296, -180, -180, -180, -180,
+	211, 305, -231, 166, 34, 178, 290, 211, 305, 211,
+	212, 211, 212, 211, -200, 12, 129, 326, 310, 307,
+	204, 165, 205, 167, 311, -261, 442, 212, -200, 290,
+	207, -180, -205, -280, -191, 260, -205, -205, 31, 168,
+	-190, -58, -190, 89, -7, -3, -11, -10, -12, 119,
+	-79, 290, -67, 145, 457, 443, 444, 445, 442, 306,
+	450, 448, 446, 211, 447, 83, 110, 108, 109, 126,
+	-86, -110, 129, 111, 127, 128, 113, 131, 130, 141,
+	134, 135, 136, 137, 138, 139, 140, 132, 133, 144,
+	119, 120, 121, 122, 123, 124, 125, -175, -280, -128,
+	-280, 152, 153, -113, -113, -113, -113, -113, -113, -113,
+	-113, -113, -113, -280, 151, -2, -122, -4, -280, -280,
+	-280, -280, -280, -280, -280, -280, -135, -86, -280, -284,
+	-280, -284, -119, -280, -284, -119, -284, -119, -284, -284,
+	-119, -284, -119, -284, -284, -119, -280, -280, -280, -280,
+	-280, -280, -280, -204, -273, -274, -105, -102, -280, 89,
+	90, -139, -3, -56, -158, 20, 32, -86, -140, -141,
+	-86, -139, 57, -75, -77, -80, 61, 62, 95, 12,
+	-193, -192, 23, -190, 89, 151, 12, -103, 27, -102,
+	-88, -89, -90, -91, -105, -129, -280, 12, -95, -96,
+	-102, -104, -195, 83, 234, -170, -207, -172, -171, 316,
+	318, 119, -194, -190, 89, 30, 84, 83, -102, -209,
+	-212, -214, -213, -215, -210, -211, 257, 258, 145, 261,
+	263, 264, 265, 266, 267, 268, 269, 270, 271, 272,
+	31, 189, 253, 254, 255, 256, 273, 274, 275, 276,
+	277, 278, 279, 280, 240, 259, 346, 241, 242, 243,
+	244, 245, 246, 248, 249, 250, 251, 252, -264, -261,
+	82, 84, 83, -216, 82, -84, -183, 170, -252, -249,
+	75, -261, -261, -261, -183, -236, -236, 197, -29, -26,
+	-257, 16, -25, -26, 159, 103, 104, 156, 82, -225,
+	82, -234, -264, -261, 82, 29, 172, 171, -233, -230,
+	-233, -234, -261, -130, -190, -195, -261, 29, 29, -163,
+	-190, -163, -163, 21, -163, 21, -163, 21, 90, -190,
+	-163, 21, -163, 21, -163, 21, -163, 21, -163, 21,
+	30, 76, 77, 30, 79, 80, 81, -130, -130, -225,
+	-167, -102, -261, 90, 90, -236, -236, 90, 89, 89,
+	89, -236, -236, 90, 89, -261, 89, -267, 183, 225,
+	227, 90, 90, 90, 90, 30, 89, -268, 30, 464,
+	463, 465, 466, 467, 90, 30, 90, 30, 90, -190,
+	82, -83, 217, 119, 206, 206, 165, 165, 219, -102,
+	230, 231, 229, 21, 218, 220, 222, 42, 83, 168,
+	-182, 74, -97, -102, 24, -182, -196, -195, -188, -95,
+	89, -86, -232, 12, 129, -200, -200, -180, -102, -232,
+	-200, -180, -102, -180, -180, -180, -180, -200, -180, -195,
+	-195, -102, -102, -102, -102, -102, -102, -102, -205, -205,
+	-205, -181, 127, -180, 74, -203, 237, 271, 436, 437,
+	438, 83, 348, -95, 442, 442, 442, 442, 442, 442,
+	-86, -86, -86, -86, -120, 99, 111, 100, 101, -113,
+	-121, -125, -128, 94, 129, 127, 128, 113, -113, -113,
+	-113, -113, -113, -113, -113, -113, -113, -113, -113, -113,
+	-113, -113, -113, -206, -261, 89, 145, -261, -112, -112,
+	-190, -76, 22, 38, -75, -191, -196, -188, -71, -281,
+	-281, -139, -75, -75, -86, -86, -130, 89, -75, -130,
+	89, -75, -75, -70, 22, 38, -133, -134, 115, -130,
+	-281, -113, -190, -190, -75, -76, -76, -75, -75, 83,
+	-275, 318, 319, 440, -198, 200, -197, 23, -195, 89,
+	-123, -122, -143, -281, -144, 27, 10, 129, 83, 19,
+	83, -142, 25, 26, -143, -114, -190, 90, 93, -87,
+	83, 12, -80, -102, -192, 136, -196, -102, -162, 200,
+	-102, 31, 83, -98, -100, -99, -101, 64, 68, 70,
+	65, 66, 67, 71, -201, 23, -88, -3, -280, -102,
+	-95, -282, 83, 12, 75, -282, 83, 151, -170, -172,
+	83, 317, 319, 320, 74, 102, -86, -218, 144, -243,
+	-242, -241, -225, -227, -228, -229, 84, -145, -221, 285,
+	-216, -216, -216, -216, -216, -217, -167, -217, -217, -217,
+	82, 82, -216, -216, -216, -216, -219, 82, -219, -219,
+	-220, 82, -220, -254, -86, -251, -250, -248, -249, 176,
+	96, 348, -246, -142, 90, -83, -102, 111, 74, -190,
+	-252, -252, -252, -195, -261, 89, -261, 89, 83, 17,
+	-226, -225, -131, 225, -256, 200, -253, -247, 82, 29,
+	-233, -234, -234, 151, -261, 83, 27, 107, 107, 107,
+	107, 348, 156, 31, -225, -131, -206, 168, -206, -206,
+	89, 89, -179, 472, -95, 167, 224, -85, 331, 89,
+	85, -102, -102, -102, -102, -102, 159, 156, 208, -102,
+	-102, -95, -102, 83, -61, 185, 180, -195, -102, -180,
+	-180, -102, -180, -180, 89, -102, -190, -67, 318, 348,
+	20, -68, 20, 99, 100, 101, -121, -113, -113, -113,
+	-74, 190, 110, -281, -281, -75, -75, -280, 151, -5,
+	-143, -281, -281, 83, 75, 23, 12, 12, -281, 12,
+	12, -281, -281, -75, -136, -134, 117, -86, -281, -281,
+	83, 83, -281, -281, -281, -281, -281, -274, 439, 319,
+	-106, 72, 169, 73, -280, -197, -281, -158, 40, 48,
+	59, -86, -86, -141, -158, -174, 20, 12, 55, 55,
+	-107, 13, -77, -88, -80, 151, -107, -111, 31, 55,
+	-3, -280, -280, -165, -169, -130, -89, -90, -90, -89,
+	-90, 64, 64, 64, 69, 64, 69, 64, -99, -195,
+	-281, -281, -3, -162, 75, -88, -102, -88, -104, -195,
+	136, -171, -173, 321, 318, 324, -261, 89, 83, -241,
+	-229, 99, 111, 30, 74, 282, 96, 172, 29, 171,
+	-222, 286, -217, -217, -218, -261, 89, 145, -218, -218,
+	-218, -224, 89, -224, 90, 90, 84, -32, -27, -28,
+	32, 78, -248, -236, 89, 39, 84, 167, 24, -102,
+	74, 74, 74, 16, -160, -190, 83, 84, -132, 226,
+	-130, 84, -190, 84, -160, -234, -191, -190, -280, 165,
+	30, 30, -131, -132, -218, -261, 474, 473, 84, -102,
+	-82, 215, 223, 82, 86, -263, 75, 206, 282, 206,
+	209, 168, -61, -32, -102, -200, -200, 32, 318, 451,
+	449, -74, 110, -113, -113, -281, -281, -76, -191, -139,
+	-158, -208, 145, 257, 189, 255, 251, 271, 262, 284,
+	253, 285, -206, -208, -113, -113, -113, -113, 345, -139,
+	118, -86, 116, -113, -113, 166, 166, 166, -163, 41,
+	89, 89, 60, -102, -137, 14, -86, 136, -143, -164,
+	74, -165, -124, -126, -125, -280, -159, -281, -190, -163,
+	-107, 83, 119, -93, -92, 74, 75, -94, 74, -92,
+	64, 64, -281, -107, -88, -107, -107, 151, 318, 322,
+	323, -241, 99, -113, 10, 89, 29, 29, -218, -218,
+	84, 83, 84, 83, 84, 83, -184, 385, 111, -28,
+	-27, -236, -236, 90, -261, -102, -102, -102, -102, 17,
+	83, -225, -130, 55, -251, 84, -255, -256, -102, -112,
+	-132, -161, 82, 84, -260, 348, -262, -261, -190, -190,
+	-190, -102, -180, -180, 32, -261, -113, -281, -143, -281,
+	-216, -216, -216, -220, -216, 245, -216, 245, -281, -281,
+	20, 20, 20, 20, -280, -66, 341, -86, 83, 83,
+	-280, -280, -280, -281, 89, -217, -138, 15, 17, 28,
+	-164, 83, -281, -281, 83, 55, 151, -281, -139, -169,
+	-86, -86, 82, -86, -139, -107, -116, -217, 89, -217,
+	90, 90, 385, 30, 79, 80, 81, 30, 76, 77,
+	-161, -160, -190, 202, 184, -281, 83, -223, 348, 351,
+	23, -160, -259, -258, -191, 82, 75, -158, -217, -261,
+	-113, -113, -113, -113, -113, -143, 89, -113, -113, -160,
+	-281, -160, -160, -198, -217, -147, -152, -177, -86, -122,
+	29, -126, 55, -3, -190, -124, -190, -143, -160, -143,
+	-218, -218, 84, 84, 23, 203, -102, -256, 352, 352,
+	-3, 84, 83, 119, -160, -102, -281, -281, -281, -281,
+	-69, 129, 348, -281, -281, -281, -281, -281, -281, -106,
+	-150, 435, -155, 44, -153, -154, 45, -151, 46, 54,
+	10, -124, 151, 84, -3, -280, 82, -59, 348, -258,
+	-240, -191, 89, 90, 84, -281, 346, 71, 349, -147,
+	49, 263, -157, -156, 53, 45, -154, 17, 47, 17,
+	-165, -190, -59, -113, 199, -160, -60, 214, 439, -263,
+	60, 347, 350, -148, 51, -146, 50, -146, -156, 17,
+	17, 89, 17, 89, -281, -281, 84, 177, -260, 60,
+	-149, 52, 74, 102, 89, 89, 89, -270, -271, 74,
+	216, 348, 74, 102, -271, 74, 11, 10, 349, -269,
+	185, 180, 183, 31, -269, 350, 179, 30, 99,
 }
 
 var yyDef = [...]int{
-	34, -2, 2, 4, 5, 6, 7, 8, 9, 10,
+	35, -2, 2, 4, 5, 6, 7, 8, 9, 10,
 	11, 12, 13, 14, 15, 16, 17, 18, 19, 20,
 	21, 22, 23, 24, 25, 26, 27, 28, 29, 30,
-	31, 32, 33, 821, 0, 557, 557, 557, 557, 557,
-	557, 557, 0, 0, -2, -2, -2, 845, 957, 0,
-	934, 0, 0, -2, 490, 491, 0, 493, -2, 0,
-	0, 502, 1366, 1366, 552, 0, 0, 0, 0, 0,
-	0, 1364, 55, 56, 508, 509, 510, 1, 3, 0,
-	561, 829, 0, 0, -2, 559, 0, 0, 940, 940,
-	940, 0, 86, 87, 0, 0, 0, 845, 0, 0,
-	0, 0, 0, 557, 0, 935, 109, 110, 90, -2,
-	114, 115, 0, 119, 368, 329, 371, 327, 357, -2,
-	320, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 332, 224, 224, 0, 0, -2, 320,
-	320, 320, 0, 0, 0, 354, 942, 274, 224, 224,
-	0, 224, 224, 224, 224, 0, 0, 224, 224, 224,
-	224, 224, 224, 224, 224, 224, 224, 224, 224, 224,
-	224, 224, 0, 108, 858, 0, 0, 0, 118, 958,
-	955, 956, 35, 36, 37, 1098, 1099, 1100, 1101, 1102,
-	1103, 1104, 1105, 1106, 1107, 1108, 1109, 1110, 1111, 1112,
-	1113, 1114, 1115, 1116, 1117, 1118, 1119, 1120, 1121, 1122,
-	1123, 1124, 1125, 1126, 1127, 1128, 1129, 1130, 1131, 1132,
-	1133, 1134, 1135, 1136, 1137, 1138, 1139, 1140, 1141, 1142,
-	1143, 1144, 1145, 1146, 1147, 1148, 1149, 1150, 1151, 1152,
-	1153, 1154, 1155, 1156, 1157, 1158, 1159, 1160, 1161, 1162,
-	1163, 1164, 1165, 1166, 1167, 1168, 1169, 1170, 1171, 1172,
-	1173, 1174, 1175, 1176, 1177, 1178, 1179, 1180, 1181, 1182,
-	1183, 1184, 1185, 1186, 1187, 1188, 1189, 1190, 1191, 1192,
-	1193, 1194, 1195, 1196, 1197, 1198, 1199, 1200, 1201, 1202,
-	1203, 1204, 1205, 1206, 1207, 1208, 1209, 1210, 1211, 1212,
-	1213, 1214, 1215, 1216, 1217, 1218, 1219, 1220, 1221, 1222,
-	1223, 1224, 1225, 1226, 1227, 1228, 1229, 1230, 1231, 1232,
-	1233, 1234, 1235, 1236, 1237, 1238, 1239, 1240, 1241, 1242,
-	1243, 1244, 1245, 1246, 1247, 1248, 1249, 1250, 1251, 1252,
-	1253, 1254, 1255, 1256, 1257, 1258, 1259, 1260, 1261, 1262,
-	1263, 1264, 1265, 1266, 1267, 1268, 1269, 1270, 1271, 1272,
-	1273, 1274, 1275, 1276, 1277, 1278, 1279, 1280, 1281, 1282,
-	1283, 1284, 1285, 1286, 1287, 1288, 1289, 1290, 1291, 1292,
-	1293, 1294, 1295, 1296, 1297, 1298, 1299, 1300, 1301, 1302,
-	1303, 1304, 1305, 1306, 1307, 1308, 1309, 1310, 1311, 1312,
-	1313, 1314, 1315, 1316, 1317, 1318, 1319, 1320, 1321, 1322,
-	1323, 1324, 1325, 1326, 1327, 1328, 1329, 1330, 1331, 1332,
-	1333, 1334, 1335, 1336, 1337, 1338, 1339, 1340, 1341, 1342,
-	1343, 1344, 1345, 1346, 1347, 1348, 1349, 1350, 1351, 1352,
-	1353, 1354, 1355, 1356, 1357, 1358, 1359, 1360, 1361, 1362,
-	1363, 0, 0, 0, 936, 557, 0, 424, 642, 0,
-	481, 481, 0, 481, 481, 481, 481, 0, 0, 0,
-	436, 0, 0, 0, 0, 478, 0, 0, 455, 457,
-	0, 478, 0, 465, 481, 1367, 1367, 1367, 925, 0,
-	475, 473, 487, 488, 470, 471, 489, 492, 0, 497,
-	500, 951, 952, 0, 515, 0, 1174, 507, 520, 521,
-	0, 553, 554, 40, 693, 652, 0, 658, 660, 0,
-	695, 696, 697, 698, 699, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 725, 726, 727, 728, 806,
-	807, 808, 809, 810, 811, 812, 813, 662, 663, 803,
-	0, 914, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 794, 0, 763, 763, 763, 763, 763, 763, 763,
-	763, 763, 0, 0, 0, 0, 0, 0, 0, -2,
-	-2, 1366, 0, 530, 0, 0, 821, 51, 0, 557,
-	562, 563, 864, 0, 0, 821, 1365, 0, 0, -2,
-	-2, 573, 579, 580, 581, 582, 583, 558, 0, 586,
-	590, 0, 0, 0, 941, 0, 0, 72, 0, 1330,
-	918, -2, -2, 0, 0, 953, 954, 927, -2, 961,
-	962, 963, 964, 965, 966, 967, 968, 969, 970, 971,
+	31, 32, 33, 34, 826, 0, 562, 562, 562, 562,
+	562, 562, 562, 0, 0, -2, -2, -2, 850, 962,
+	0, 939, 0, 557, -2, 491, 492, 0, 494, -2,
+	0, 0, 503, 1371, 1371, 557, 0, 0, 0, 0,
+	0, 0, 534, 1369, 56, 57, 509, 510, 511, 1,
+	3, 0, 566, 834, 0, 0, -2, 564, 0, 0,
+	945, 945, 945, 0, 87, 88, 0, 0, 0, 850,
+	0, 0, 0, 0, 0, 562, 0, 940, 110, 111,
+	91, -2, 115, 116, 0, 120, 369, 330, 372, 328,
+	358, -2, 321, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 333, 225, 225, 0, 0,
+	-2, 321, 321, 321, 0, 0, 0, 355, 947, 275,
+	225, 225, 0, 225, 225, 225, 225, 0, 0, 225,
+	225, 225, 225, 225, 225, 225, 225, 225, 225, 225,
+	225, 225, 225, 225, 0, 109, 863, 0, 0, 0,
+	119, 963, 960, 961, 36, 37, 38, 1103, 1104, 1105,
+	1106, 1107, 1108, 1109, 1110, 1111, 1112, 1113, 1114, 1115,
+	1116, 1117, 1118, 1119, 1120, 1121, 1122, 1123, 1124, 1125,
+	1126, 1127, 1128, 1129, 1130, 1131, 1132, 1133, 1134, 1135,
+	1136, 1137, 1138, 1139, 1140, 1141, 1142, 1143, 1144, 1145,
+	1146, 1147, 1148, 1149, 1150, 1151, 1152, 1153, 1154, 1155,
+	1156, 1157, 1158, 1159, 1160, 1161, 1162, 1163, 1164, 1165,
+	1166, 1167, 1168, 1169, 1170, 1171, 1172, 1173, 1174, 1175,
+	1176, 1177, 1178, 1179, 1180, 1181, 1182, 1183, 1184, 1185,
+	1186, 1187, 1188, 1189, 1190, 1191, 1192, 1193, 1194, 1195,
+	1196, 1197, 1198, 1199, 1200, 1201, 1202, 1203, 1204, 1205,
+	1206, 1207, 1208, 1209, 1210, 1211, 1212, 1213, 1214, 1215,
+	1216, 1217, 1218, 1219, 1220, 1221, 1222, 1223, 1224, 1225,
+	1226, 1227, 1228, 1229, 1230, 1231, 1232, 1233, 1234, 1235,
+	1236, 1237, 1238, 1239, 1240, 1241, 1242, 1243, 1244, 1245,
+	1246, 1247, 1248, 1249, 1250, 1251, 1252, 1253, 1254, 1255,
+	1256, 1257, 1258, 1259, 1260, 1261, 1262, 1263, 1264, 1265,
+	1266, 1267, 1268, 1269, 1270, 1271, 1272, 1273, 1274, 1275,
+	1276, 1277, 1278, 1279, 1280, 1281, 1282, 1283, 1284, 1285,
+	1286, 1287, 1288, 1289, 1290, 1291, 1292, 1293, 1294, 1295,
+	1296, 1297, 1298, 1299, 1300, 1301, 1302, 1303, 1304, 1305,
+	1306, 1307, 1308, 1309, 1310, 1311, 1312, 1313, 1314, 1315,
+	1316, 1317, 1318, 1319, 1320, 1321, 1322, 1323, 1324, 1325,
+	1326, 1327, 1328, 1329, 1330, 1331, 1332, 1333, 1334, 1335,
+	1336, 1337, 1338, 1339, 1340, 1341, 1342, 1343, 1344, 1345,
+	1346, 1347, 1348, 1349, 1350, 1351, 1352, 1353, 1354, 1355,
+	1356, 1357, 1358, 1359, 1360, 1361, 1362, 1363, 1364, 1365,
+	1366, 1367, 1368, 0, 0, 0, 941, 562, 0, 425,
+	647, 0, 558, 559, 482, 482, 0, 482, 482, 482,
+	482, 0, 0, 0, 437, 0, 0, 0, 0, 479,
+	0, 0, 456, 458, 0, 479, 0, 466, 482, 1372,
+	1372, 1372, 930, 0, 476, 474, 488, 489, 471, 472,
+	490, 493, 0, 498, 501, 956, 957, 0, 516, 0,
+	1179, 508, 521, 522, 0, 41, 698, 657, 0, 663,
+	665, 0, 700, 701, 702, 703, 704, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 730, 731, 732,
+	733, 811, 812, 813, 814, 815, 816, 817, 818, 667,
+	668, 808, 0, 919, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 799, 0, 768, 768, 768, 768, 768,
+	768, 768, 768, 768, 0, 0, 0, 0, 0, 0,
+	0, -2, -2, 1371, 0, 531, 0, 0, 0, 535,
+	536, 826, 52, 0, 562, 567, 568, 869, 0, 0,
+	826, 1370, 0, 0, -2, -2, 578, 584, 585, 586,
+	587, 588, 563, 0, 591, 595, 0, 0, 0, 946,
+	0, 0, 73, 0, 1335, 923, -2, -2, 0, 0,
+	958, 959, 932, -2, 966, 967, 968, 969, 970, 971,
 	972, 973, 974, 975, 976, 977, 978, 979, 980, 981,
 	982, 983, 984, 985, 986, 987, 988, 989, 990, 991,
 	992, 993, 994, 995, 996, 997, 998, 999, 1000, 1001,
@@ -5213,172 +5179,172 @@ var yyDef = [...]int{
 	1062, 1063, 1064, 1065, 1066, 1067, 1068, 1069, 1070, 1071,
 	1072, 1073, 1074, 1075, 1076, 1077, 1078, 1079, 1080, 1081,
 	1082, 1083, 1084, 1085, 1086, 1087, 1088, 1089, 1090, 1091,
-	1092, 1093, 1094, 1095, 1096, 1097, -2, 0, 0, 128,
-	129, 0, 38, 250, 0, 124, 0, 244, 197, 858,
-	938, 948, 0, 0, 0, 938, 92, 116, 117, 224,
-	224, 0, 118, 118, 336, 337, 338, 0, 0, -2,
-	248, 0, 321, 0, 0, 238, 238, 242, 240, 241,
-	0, 0, 0, 0, 0, 0, 348, 0, 349, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 408, 0,
-	225, 0, 366, 367, 275, 0, 0, 0, 0, 346,
-	347, 0, 0, 943, 944, 0, 0, 224, 224, 0,
-	0, 0, 0, 224, 224, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	102, 849, 0, 0, 0, 0, 0, 0, 0, 0,
-	-2, 0, 416, 0, 936, 0, 0, 0, 936, 423,
-	0, 425, 426, 0, 0, 427, 0, 478, 478, 476,
-	477, 429, 430, 431, 432, 481, 0, 0, 233, 234,
-	235, 478, 481, 0, 481, 481, 481, 481, 478, 481,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 1367,
-	1367, 1367, 484, 481, 462, 463, 466, 467, 1368, 1369,
-	972, 468, 469, 926, 498, 501, 518, 516, 517, 519,
-	511, 512, 513, 514, 0, 532, 533, 538, 0, 0,
-	0, 0, 544, 545, 546, 0, 0, 549, 550, 551,
-	0, 0, 0, 0, 0, 656, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 680, 681, 682, 683, 684,
-	685, 686, 659, 0, 673, 0, 0, 0, 715, 716,
-	717, 718, 719, 720, 721, 722, 723, 0, 570, 0,
-	0, 0, 821, 0, 0, 0, 0, 0, 0, 0,
-	567, 0, 795, 0, 746, 0, 747, 755, 0, 748,
-	756, 749, 757, 750, 751, 758, 752, 759, 753, 754,
-	760, 0, 0, 0, 570, 570, 0, 0, 41, 522,
-	523, 0, 625, 946, 531, 829, 0, 572, 867, 0,
-	0, 830, 822, 823, 826, 829, 0, 595, 584, 574,
-	577, 578, 560, 0, 587, 591, 0, 593, 594, 0,
-	0, 70, 0, 641, 0, 597, 599, 600, 601, 623,
-	0, 0, 0, 0, 66, 68, 642, 0, 1330, 924,
-	0, 74, 75, 0, 0, 0, 212, 929, 930, 931,
-	-2, 231, 0, 136, 204, 148, 149, 150, 197, 152,
-	197, 197, 197, 197, 208, 208, 208, 208, 180, 181,
-	182, 183, 184, 0, 0, 167, 197, 197, 197, 197,
-	187, 188, 189, 190, 191, 192, 193, 194, 153, 154,
-	155, 156, 157, 158, 159, 160, 161, 199, 199, 199,
-	201, 201, 0, 39, 0, 216, 0, 826, 0, 849,
-	0, 0, 0, 949, 0, 948, 948, 948, 0, 0,
-	0, 369, 330, 358, 370, 0, 333, 334, -2, 0,
-	0, 320, 0, 322, 0, 232, 0, -2, 0, 0,
-	0, 238, 242, 239, 242, 230, 243, 350, 803, 0,
-	351, 352, 0, 388, 611, 0, 0, 0, 0, 0,
-	394, 395, 396, 0, 398, 399, 400, 401, 402, 403,
-	404, 405, 406, 407, 359, 360, 361, 362, 363, 364,
-	365, 0, 0, 322, 0, 355, 0, 276, 277, 0,
-	0, 280, 281, 282, 283, 0, 0, 286, 287, 288,
-	289, 290, 314, 315, 316, 291, 292, 293, 294, 295,
-	296, 297, 308, 309, 310, 311, 312, 313, 298, 299,
-	300, 301, 302, 305, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 383, 384, 385, 386, 846, 847,
-	848, 0, 0, 0, 0, 0, 263, 64, 937, 0,
-	643, 959, 960, 482, 483, 0, 236, 237, 481, 481,
-	433, 456, 0, 481, 437, 458, 438, 440, 439, 441,
-	481, 444, 479, 480, 445, 446, 447, 448, 449, 450,
-	451, 452, 453, 454, 460, 0, 461, 0, 0, 499,
-	503, 504, 505, 506, 0, 0, 535, 540, 541, 542,
-	543, 555, 548, 694, 653, 654, 655, 657, 674, 0,
-	676, 678, 664, 665, 689, 690, 691, 0, 0, 0,
-	0, 687, 669, 0, 700, 701, 702, 703, 704, 705,
-	706, 707, 708, 709, 710, 711, 714, 778, 779, 780,
-	0, 712, 713, 724, 0, 0, 0, 571, 804, 0,
-	-2, 0, 692, 913, 829, 0, 0, 0, 0, 697,
-	806, 0, 697, 806, 0, 0, 0, 568, 569, 801,
-	798, 0, 0, 764, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 525, 526, 528, 0, 645, 0, 626,
-	0, 628, 629, 0, 947, 864, 52, 42, 0, 865,
-	0, 0, 0, 0, 825, 827, 828, 864, 0, 814,
-	0, 0, 650, 0, 0, 575, 48, 592, 588, 0,
-	650, 0, 0, 640, 0, 0, 0, 0, 0, 0,
-	630, 0, 0, 633, 0, 0, 0, 0, 624, 0,
-	0, 0, -2, 0, 0, 0, 62, 63, 0, 0,
-	0, 919, 73, 0, 0, 78, 79, 920, 921, 922,
-	923, 0, 111, -2, 271, 130, 132, 133, 134, 125,
-	135, 206, 205, 151, 208, 208, 174, 175, 212, 0,
-	212, 212, 212, 0, 0, 168, 169, 170, 171, 162,
-	0, 163, 164, 165, 0, 166, 249, 0, 833, 217,
-	218, 220, 224, 0, 0, 245, 246, 0, 0, 101,
-	0, 0, 950, 0, 0, 0, 107, 120, 121, 122,
-	123, 118, 0, 0, 126, 324, 0, 0, 0, 247,
-	0, 0, 226, 242, 227, 228, 0, 353, 0, 0,
-	390, 391, 392, 393, 0, 0, 0, 322, 324, 212,
-	0, 278, 279, 284, 285, 303, 0, 0, 0, 0,
-	859, 860, 0, 863, 93, 376, 378, 377, 381, 0,
-	0, 0, 0, 417, 263, 833, 0, 421, 264, 265,
-	422, 478, 443, 459, 478, 435, 442, 485, 464, 495,
-	539, 0, 0, 0, 547, 0, 675, 677, 679, 666,
-	687, 670, 0, 667, 0, 0, 661, 729, 0, 0,
-	570, 0, 821, 864, 733, 734, 0, 0, 0, 0,
-	0, 771, 0, 0, 772, 0, 821, 0, 799, 0,
-	0, 745, 765, 0, 0, 766, 767, 768, 769, 770,
-	524, 527, 529, 605, 0, 0, 0, 0, 627, 945,
-	44, 0, 0, 0, 831, 832, 824, 43, 0, 932,
-	933, 815, 816, 817, 0, 585, 596, 576, 0, 829,
-	907, 0, 0, 899, 0, 0, 650, 915, 0, 598,
-	619, 621, 0, 616, 631, 632, 634, 0, 636, 0,
-	638, 639, 602, 603, 604, 0, 650, 0, 650, 67,
-	650, 69, 0, 644, 76, 77, 0, 0, 83, 213,
-	214, 118, 273, 131, 137, 0, 0, 0, 141, 0,
-	0, 144, 146, 147, 207, 212, 212, 176, 209, 210,
-	211, 177, 178, 179, 0, 195, 0, 0, 0, 266,
-	88, 837, 836, 224, 224, 219, 0, 222, 0, 198,
-	0, 939, 103, 0, 0, 0, 0, 328, 609, 0,
-	339, 340, 0, 323, 387, 0, 216, 0, 229, 804,
-	612, 0, 0, 341, 0, 324, 344, 345, 356, 306,
-	307, 304, 607, 850, 851, 852, 0, 862, 96, 0,
-	0, 0, 0, 374, 0, 419, 420, 65, 481, 481,
-	534, 0, 537, 0, 668, 0, 688, 671, 730, 731,
-	0, 805, 829, 46, 0, 197, 197, 784, 197, 201,
-	787, 197, 789, 197, 792, 0, 0, 0, 0, 0,
-	0, 0, 796, 744, 802, 0, 0, 0, 0, 0,
-	0, 0, 0, 208, 869, 866, 45, 819, 0, 651,
-	589, 49, 53, 0, 907, 898, 909, 911, 0, 0,
-	0, 903, 0, 821, 0, 0, 613, 620, 0, 0,
-	614, 0, 615, 635, 637, -2, 821, 650, 60, 61,
-	0, 80, 81, 82, 272, 138, 139, 0, 142, 143,
-	145, 172, 173, 208, 0, 208, 0, 202, 0, 255,
-	267, 0, 834, 835, 0, 0, 221, 223, 607, 104,
-	105, 106, 0, 0, 127, 325, 0, 215, 0, 0,
-	412, 409, 342, 343, 0, 0, 861, 375, 0, 94,
-	95, 0, 0, 380, 418, 428, 434, 536, 556, 672,
-	732, 864, 735, 781, 208, 785, 786, 788, 790, 791,
-	793, 737, 736, 0, 0, 0, 0, 0, 829, 0,
-	800, 0, 0, 0, 0, 0, 625, 208, 889, 50,
-	0, 0, 0, 54, 0, 912, 0, 0, 0, 0,
-	71, 829, 916, 917, 617, 0, 622, 829, 59, 140,
-	212, 196, 212, 0, 0, 268, 838, 839, 840, 841,
-	842, 843, 844, 0, 331, 610, 0, 0, 389, 0,
-	397, 0, 0, 0, 0, 97, 98, 0, 0, 0,
-	47, 782, 783, 0, 0, 0, 0, 773, 0, 797,
-	0, 0, 0, 647, 0, 0, 645, 871, 870, 883,
-	896, 820, 818, 0, 910, 0, 902, 905, 901, 904,
-	57, 0, 58, 185, 186, 200, 203, 0, 0, 0,
-	413, 410, 411, 853, 608, 0, 0, 0, 382, 738,
-	740, 739, 741, 0, 0, 0, 743, 761, 762, 646,
-	648, 649, 606, 889, 0, 882, 0, -2, 891, 0,
-	0, 0, 897, 0, 900, 0, 618, 853, 0, 0,
-	372, 855, 99, 100, 317, 318, 319, 93, 742, 0,
-	0, 0, 876, 874, 874, 884, 885, 0, 0, 892,
-	0, 0, 0, 908, 906, 89, 0, 0, 0, 0,
-	856, 857, 96, 774, 0, 777, 879, 0, 872, 875,
-	873, 886, 0, 0, 893, 0, 895, 414, 415, 251,
-	0, 379, 775, 868, 0, 877, 878, 887, 888, 894,
-	252, 253, 0, 854, 0, 880, 881, 254, 0, 0,
-	0, 0, 256, 258, 259, 0, 0, 257, 776, 260,
-	261, 262,
+	1092, 1093, 1094, 1095, 1096, 1097, 1098, 1099, 1100, 1101,
+	1102, -2, 0, 0, 129, 130, 0, 39, 251, 0,
+	125, 0, 245, 198, 863, 943, 953, 0, 0, 0,
+	943, 93, 117, 118, 225, 225, 0, 119, 119, 337,
+	338, 339, 0, 0, -2, 249, 0, 322, 0, 0,
+	239, 239, 243, 241, 242, 0, 0, 0, 0, 0,
+	0, 349, 0, 350, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 409, 0, 226, 0, 367, 368, 276,
+	0, 0, 0, 0, 347, 348, 0, 0, 948, 949,
+	0, 0, 225, 225, 0, 0, 0, 0, 225, 225,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 103, 854, 0, 0, 0,
+	0, 0, 0, 0, 0, -2, 0, 417, 0, 941,
+	0, 0, 0, 941, 424, 0, 0, 427, 0, 0,
+	428, 0, 479, 479, 477, 478, 430, 431, 432, 433,
+	482, 0, 0, 234, 235, 236, 479, 482, 0, 482,
+	482, 482, 482, 479, 482, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 1372, 1372, 1372, 485, 482, 463,
+	464, 467, 468, 1373, 1374, 977, 469, 470, 931, 499,
+	502, 519, 517, 518, 520, 512, 513, 514, 515, 0,
+	537, 538, 543, 0, 0, 0, 0, 549, 550, 551,
+	0, 0, 554, 555, 556, 0, 0, 0, 0, 0,
+	661, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	685, 686, 687, 688, 689, 690, 691, 664, 0, 678,
+	0, 0, 0, 720, 721, 722, 723, 724, 725, 726,
+	727, 728, 0, 575, 0, 0, 0, 826, 0, 0,
+	0, 0, 0, 0, 0, 572, 0, 800, 0, 751,
+	0, 752, 760, 0, 753, 761, 754, 762, 755, 756,
+	763, 757, 764, 758, 759, 765, 0, 0, 0, 575,
+	575, 0, 0, 42, 523, 524, 0, 630, 951, 532,
+	533, 834, 0, 577, 872, 0, 0, 835, 827, 828,
+	831, 834, 0, 600, 589, 579, 582, 583, 565, 0,
+	592, 596, 0, 598, 599, 0, 0, 71, 0, 646,
+	0, 602, 604, 605, 606, 628, 0, 0, 0, 0,
+	67, 69, 647, 0, 1335, 929, 0, 75, 76, 0,
+	0, 0, 213, 934, 935, 936, -2, 232, 0, 137,
+	205, 149, 150, 151, 198, 153, 198, 198, 198, 198,
+	209, 209, 209, 209, 181, 182, 183, 184, 185, 0,
+	0, 168, 198, 198, 198, 198, 188, 189, 190, 191,
+	192, 193, 194, 195, 154, 155, 156, 157, 158, 159,
+	160, 161, 162, 200, 200, 200, 202, 202, 0, 40,
+	0, 217, 0, 831, 0, 854, 0, 0, 0, 954,
+	0, 953, 953, 953, 0, 0, 0, 370, 331, 359,
+	371, 0, 334, 335, -2, 0, 0, 321, 0, 323,
+	0, 233, 0, -2, 0, 0, 0, 239, 243, 240,
+	243, 231, 244, 351, 808, 0, 352, 353, 0, 389,
+	616, 0, 0, 0, 0, 0, 395, 396, 397, 0,
+	399, 400, 401, 402, 403, 404, 405, 406, 407, 408,
+	360, 361, 362, 363, 364, 365, 366, 0, 0, 323,
+	0, 356, 0, 277, 278, 0, 0, 281, 282, 283,
+	284, 0, 0, 287, 288, 289, 290, 291, 315, 316,
+	317, 292, 293, 294, 295, 296, 297, 298, 309, 310,
+	311, 312, 313, 314, 299, 300, 301, 302, 303, 306,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	384, 385, 386, 387, 851, 852, 853, 0, 0, 0,
+	0, 0, 264, 65, 942, 0, 648, 964, 965, 426,
+	483, 484, 0, 237, 238, 482, 482, 434, 457, 0,
+	482, 438, 459, 439, 441, 440, 442, 482, 445, 480,
+	481, 446, 447, 448, 449, 450, 451, 452, 453, 454,
+	455, 461, 0, 462, 0, 0, 500, 504, 505, 506,
+	507, 0, 0, 540, 545, 546, 547, 548, 560, 553,
+	699, 658, 659, 660, 662, 679, 0, 681, 683, 669,
+	670, 694, 695, 696, 0, 0, 0, 0, 692, 674,
+	0, 705, 706, 707, 708, 709, 710, 711, 712, 713,
+	714, 715, 716, 719, 783, 784, 785, 0, 717, 718,
+	729, 0, 0, 0, 576, 809, 0, -2, 0, 697,
+	918, 834, 0, 0, 0, 0, 702, 811, 0, 702,
+	811, 0, 0, 0, 573, 574, 806, 803, 0, 0,
+	769, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	526, 527, 529, 0, 650, 0, 631, 0, 633, 634,
+	0, 952, 869, 53, 43, 0, 870, 0, 0, 0,
+	0, 830, 832, 833, 869, 0, 819, 0, 0, 655,
+	0, 0, 580, 49, 597, 593, 0, 655, 0, 0,
+	645, 0, 0, 0, 0, 0, 0, 635, 0, 0,
+	638, 0, 0, 0, 0, 629, 0, 0, 0, -2,
+	0, 0, 0, 63, 64, 0, 0, 0, 924, 74,
+	0, 0, 79, 80, 925, 926, 927, 928, 0, 112,
+	-2, 272, 131, 133, 134, 135, 126, 136, 207, 206,
+	152, 209, 209, 175, 176, 213, 0, 213, 213, 213,
+	0, 0, 169, 170, 171, 172, 163, 0, 164, 165,
+	166, 0, 167, 250, 0, 838, 218, 219, 221, 225,
+	0, 0, 246, 247, 0, 0, 102, 0, 0, 955,
+	0, 0, 0, 108, 121, 122, 123, 124, 119, 0,
+	0, 127, 325, 0, 0, 0, 248, 0, 0, 227,
+	243, 228, 229, 0, 354, 0, 0, 391, 392, 393,
+	394, 0, 0, 0, 323, 325, 213, 0, 279, 280,
+	285, 286, 304, 0, 0, 0, 0, 864, 865, 0,
+	868, 94, 377, 379, 378, 382, 0, 0, 0, 0,
+	418, 264, 838, 0, 422, 265, 266, 423, 479, 444,
+	460, 479, 436, 443, 486, 465, 496, 544, 0, 0,
+	0, 552, 0, 680, 682, 684, 671, 692, 675, 0,
+	672, 0, 0, 666, 734, 0, 0, 575, 0, 826,
+	869, 738, 739, 0, 0, 0, 0, 0, 776, 0,
+	0, 777, 0, 826, 0, 804, 0, 0, 750, 770,
+	0, 0, 771, 772, 773, 774, 775, 525, 528, 530,
+	610, 0, 0, 0, 0, 632, 950, 45, 0, 0,
+	0, 836, 837, 829, 44, 0, 937, 938, 820, 821,
+	822, 0, 590, 601, 581, 0, 834, 912, 0, 0,
+	904, 0, 0, 655, 920, 0, 603, 624, 626, 0,
+	621, 636, 637, 639, 0, 641, 0, 643, 644, 607,
+	608, 609, 0, 655, 0, 655, 68, 655, 70, 0,
+	649, 77, 78, 0, 0, 84, 214, 215, 119, 274,
+	132, 138, 0, 0, 0, 142, 0, 0, 145, 147,
+	148, 208, 213, 213, 177, 210, 211, 212, 178, 179,
+	180, 0, 196, 0, 0, 0, 267, 89, 842, 841,
+	225, 225, 220, 0, 223, 0, 199, 0, 944, 104,
+	0, 0, 0, 0, 329, 614, 0, 340, 341, 0,
+	324, 388, 0, 217, 0, 230, 809, 617, 0, 0,
+	342, 0, 325, 345, 346, 357, 307, 308, 305, 612,
+	855, 856, 857, 0, 867, 97, 0, 0, 0, 0,
+	375, 0, 420, 421, 66, 482, 482, 539, 0, 542,
+	0, 673, 0, 693, 676, 735, 736, 0, 810, 834,
+	47, 0, 198, 198, 789, 198, 202, 792, 198, 794,
+	198, 797, 0, 0, 0, 0, 0, 0, 0, 801,
+	749, 807, 0, 0, 0, 0, 0, 0, 0, 0,
+	209, 874, 871, 46, 824, 0, 656, 594, 50, 54,
+	0, 912, 903, 914, 916, 0, 0, 0, 908, 0,
+	826, 0, 0, 618, 625, 0, 0, 619, 0, 620,
+	640, 642, -2, 826, 655, 61, 62, 0, 81, 82,
+	83, 273, 139, 140, 0, 143, 144, 146, 173, 174,
+	209, 0, 209, 0, 203, 0, 256, 268, 0, 839,
+	840, 0, 0, 222, 224, 612, 105, 106, 107, 0,
+	0, 128, 326, 0, 216, 0, 0, 413, 410, 343,
+	344, 0, 0, 866, 376, 0, 95, 96, 0, 0,
+	381, 419, 429, 435, 541, 561, 677, 737, 869, 740,
+	786, 209, 790, 791, 793, 795, 796, 798, 742, 741,
+	0, 0, 0, 0, 0, 834, 0, 805, 0, 0,
+	0, 0, 0, 630, 209, 894, 51, 0, 0, 0,
+	55, 0, 917, 0, 0, 0, 0, 72, 834, 921,
+	922, 622, 0, 627, 834, 60, 141, 213, 197, 213,
+	0, 0, 269, 843, 844, 845, 846, 847, 848, 849,
+	0, 332, 615, 0, 0, 390, 0, 398, 0, 0,
+	0, 0, 98, 99, 0, 0, 0, 48, 787, 788,
+	0, 0, 0, 0, 778, 0, 802, 0, 0, 0,
+	652, 0, 0, 650, 876, 875, 888, 901, 825, 823,
+	0, 915, 0, 907, 910, 906, 909, 58, 0, 59,
+	186, 187, 201, 204, 0, 0, 0, 414, 411, 412,
+	858, 613, 0, 0, 0, 383, 743, 745, 744, 746,
+	0, 0, 0, 748, 766, 767, 651, 653, 654, 611,
+	894, 0, 887, 0, -2, 896, 0, 0, 0, 902,
+	0, 905, 0, 623, 858, 0, 0, 373, 860, 100,
+	101, 318, 319, 320, 94, 747, 0, 0, 0, 881,
+	879, 879, 889, 890, 0, 0, 897, 0, 0, 0,
+	913, 911, 90, 0, 0, 0, 0, 861, 862, 97,
+	779, 0, 782, 884, 0, 877, 880, 878, 891, 0,
+	0, 898, 0, 900, 415, 416, 252, 0, 380, 780,
+	873, 0, 882, 883, 892, 893, 899, 253, 254, 0,
+	859, 0, 885, 886, 255, 0, 0, 0, 0, 257,
+	259, 260, 0, 0, 258, 781, 261, 262, 263,
 }
 
 var yyTok1 = [...]int{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 111, 3, 3, 3, 138, 130, 3,
-	81, 83, 135, 133, 82, 134, 150, 136, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 474,
-	119, 118, 120, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 112, 3, 3, 3, 139, 131, 3,
+	82, 84, 136, 134, 83, 135, 151, 137, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 475,
+	120, 119, 121, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 140, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 141, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 129, 3, 141,
+	3, 3, 3, 3, 130, 3, 142,
 }
 
 var yyTok2 = [...]int{
@@ -5389,13 +5355,13 @@ var yyTok2 = [...]int{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75, 76, 77, 78, 79, 80, 84,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
 	85, 86, 87, 88, 89, 90, 91, 92, 93, 94,
 	95, 96, 97, 98, 99, 100, 101, 102, 103, 104,
-	105, 106, 107, 108, 109, 110, 112, 113, 114, 115,
-	116, 117, 121, 122, 123, 124, 125, 126, 127, 128,
-	131, 132, 137, 139, 142, 143, 144, 145, 146, 147,
-	148, 149, 151, 152, 153, 154, 155, 156, 157, 158,
+	105, 106, 107, 108, 109, 110, 111, 113, 114, 115,
+	116, 117, 118, 122, 123, 124, 125, 126, 127, 128,
+	129, 132, 133, 138, 140, 143, 144, 145, 146, 147,
+	148, 149, 150, 152, 153, 154, 155, 156, 157, 158,
 	159, 160, 161, 162, 163, 164, 165, 166, 167, 168,
 	169, 170, 171, 172, 173, 174, 175, 176, 177, 178,
 	179, 180, 181, 182, 183, 184, 185, 186, 187, 188,
@@ -5450,7 +5416,8 @@ var yyTok3 = [...]int{
 	57780, 455, 57781, 456, 57782, 457, 57783, 458, 57784, 459,
 	57785, 460, 57786, 461, 57787, 462, 57788, 463, 57789, 464,
 	57790, 465, 57791, 466, 57792, 467, 57793, 468, 57794, 469,
-	57795, 470, 57796, 471, 57797, 472, 57798, 473, 0,
+	57795, 470, 57796, 471, 57797, 472, 57798, 473, 57799, 474,
+	0,
 }
 
 var yyErrorMessages = [...]struct {
@@ -5800,84 +5767,84 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:424
+//line sql.y:426
 		{
 			setParseTree(yylex, yyDollar[1].statementUnion())
 		}
 	case 2:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:429
+//line sql.y:431
 		{
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:430
+//line sql.y:432
 		{
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:434
+//line sql.y:436
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 34:
+	case 35:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:467
+//line sql.y:470
 		{
 			setParseTree(yylex, nil)
 		}
-	case 35:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:473
+//line sql.y:476
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), NoAt)
 		}
-	case 36:
+	case 37:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:477
+//line sql.y:480
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), SingleAt)
 		}
-	case 37:
+	case 38:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:481
+//line sql.y:484
 		{
 			yyVAL.colIdent = NewColIdentWithAt(string(yyDollar[1].str), DoubleAt)
 		}
-	case 38:
+	case 39:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:486
+//line sql.y:489
 		{
 			yyVAL.colIdent = NewColIdentWithAt("", NoAt)
 		}
-	case 39:
+	case 40:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:490
+//line sql.y:493
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 40:
+	case 41:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:496
+//line sql.y:499
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 41:
+	case 42:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:502
+//line sql.y:505
 		{
 			yyLOCAL = &Load{}
 		}
 		yyVAL.union = yyLOCAL
-	case 42:
+	case 43:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:508
+//line sql.y:511
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -5887,34 +5854,34 @@ yydefault:
 			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 43:
+	case 44:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:517
+//line sql.y:520
 		{
 			yyLOCAL = &Union{FirstStatement: &ParenSelect{Select: yyDollar[2].selStmtUnion()}, OrderBy: yyDollar[4].orderByUnion(), Limit: yyDollar[5].limitUnion(), Lock: yyDollar[6].lockUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 44:
+	case 45:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:521
+//line sql.y:524
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 45:
+	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:525
+//line sql.y:528
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), SelectExprs{&Nextval{Expr: yyDollar[5].exprUnion()}}, []string{yyDollar[3].str} /*options*/, TableExprs{&AliasedTableExpr{Expr: yyDollar[7].tableName}}, nil /*where*/, nil /*groupBy*/, nil /*having*/)
 		}
 		yyVAL.union = yyLOCAL
-	case 46:
+	case 47:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:548
+//line sql.y:551
 		{
 			sel := yyDollar[1].selStmtUnion().(*Select)
 			sel.OrderBy = yyDollar[2].orderByUnion()
@@ -5923,58 +5890,58 @@ yydefault:
 			yyLOCAL = sel
 		}
 		yyVAL.union = yyLOCAL
-	case 47:
+	case 48:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:556
+//line sql.y:559
 		{
 			yyLOCAL = Unionize(yyDollar[1].selStmtUnion(), yyDollar[3].selStmtUnion(), yyDollar[2].booleanUnion(), yyDollar[4].orderByUnion(), yyDollar[5].limitUnion(), yyDollar[6].lockUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 48:
+	case 49:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:562
+//line sql.y:565
 		{
 			yyLOCAL = &Stream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 49:
+	case 50:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:568
+//line sql.y:571
 		{
 			yyLOCAL = &VStream{Comments: Comments(yyDollar[2].strs), SelectExpr: yyDollar[3].selectExprUnion(), Table: yyDollar[5].tableName, Where: NewWhere(WhereClause, yyDollar[6].exprUnion()), Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 50:
+	case 51:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:576
+//line sql.y:579
 		{
 			yyLOCAL = NewSelect(Comments(yyDollar[2].strs), yyDollar[4].selectExprsUnion() /*SelectExprs*/, yyDollar[3].strs /*options*/, yyDollar[5].tableExprsUnion() /*from*/, NewWhere(WhereClause, yyDollar[6].exprUnion()), GroupBy(yyDollar[7].exprsUnion()), NewWhere(HavingClause, yyDollar[8].exprUnion()))
 		}
 		yyVAL.union = yyLOCAL
-	case 51:
+	case 52:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:582
+//line sql.y:585
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 52:
+	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectStatement
-//line sql.y:586
+//line sql.y:589
 		{
 			yyLOCAL = &ParenSelect{Select: yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 53:
+	case 54:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:593
+//line sql.y:596
 		{
 			// insert_data returns a *Insert pre-filled with Columns & Values
 			ins := yyDollar[6].insUnion()
@@ -5987,10 +5954,10 @@ yydefault:
 			yyLOCAL = ins
 		}
 		yyVAL.union = yyLOCAL
-	case 54:
+	case 55:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:605
+//line sql.y:608
 		{
 			cols := make(Columns, 0, len(yyDollar[7].updateExprsUnion()))
 			vals := make(ValTuple, 0, len(yyDollar[8].updateExprsUnion()))
@@ -6001,258 +5968,258 @@ yydefault:
 			yyLOCAL = &Insert{Action: yyDollar[1].insertActionUnion(), Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Table: yyDollar[4].tableName, Partitions: yyDollar[5].partitionsUnion(), Columns: cols, Rows: Values{vals}, OnDup: OnDup(yyDollar[8].updateExprsUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 55:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:617
+//line sql.y:620
 		{
 			yyLOCAL = InsertAct
 		}
 		yyVAL.union = yyLOCAL
-	case 56:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL InsertAction
-//line sql.y:621
+//line sql.y:624
 		{
 			yyLOCAL = ReplaceAct
 		}
 		yyVAL.union = yyLOCAL
-	case 57:
+	case 58:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:627
+//line sql.y:630
 		{
 			yyLOCAL = &Update{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: yyDollar[4].tableExprsUnion(), Exprs: yyDollar[6].updateExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 58:
+	case 59:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:633
+//line sql.y:636
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), TableExprs: TableExprs{&AliasedTableExpr{Expr: yyDollar[5].tableName}}, Partitions: yyDollar[6].partitionsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion()), OrderBy: yyDollar[8].orderByUnion(), Limit: yyDollar[9].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 59:
+	case 60:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:637
+//line sql.y:640
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[5].tableNamesUnion(), TableExprs: yyDollar[7].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[8].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 60:
+	case 61:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:641
+//line sql.y:644
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 61:
+	case 62:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:645
+//line sql.y:648
 		{
 			yyLOCAL = &Delete{Comments: Comments(yyDollar[2].strs), Ignore: yyDollar[3].ignoreUnion(), Targets: yyDollar[4].tableNamesUnion(), TableExprs: yyDollar[6].tableExprsUnion(), Where: NewWhere(WhereClause, yyDollar[7].exprUnion())}
 		}
 		yyVAL.union = yyLOCAL
-	case 62:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:650
+//line sql.y:653
 		{
 		}
-	case 63:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:651
+//line sql.y:654
 		{
 		}
-	case 64:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:655
+//line sql.y:658
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName.ToViewName()}
 		}
 		yyVAL.union = yyLOCAL
-	case 65:
+	case 66:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:659
+//line sql.y:662
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName.ToViewName())
 		}
-	case 66:
+	case 67:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:665
+//line sql.y:668
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 67:
+	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:669
+//line sql.y:672
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 68:
+	case 69:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableNames
-//line sql.y:675
+//line sql.y:678
 		{
 			yyLOCAL = TableNames{yyDollar[1].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 69:
+	case 70:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:679
+//line sql.y:682
 		{
 			yySLICE := (*TableNames)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableName)
 		}
-	case 70:
+	case 71:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:684
+//line sql.y:687
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 71:
+	case 72:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:688
+//line sql.y:691
 		{
 			yyLOCAL = yyDollar[3].partitionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 72:
+	case 73:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:694
+//line sql.y:697
 		{
 			yyLOCAL = &Set{Comments: Comments(yyDollar[2].strs), Exprs: yyDollar[3].setExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 73:
+	case 74:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:700
+//line sql.y:703
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Scope: yyDollar[3].scopeUnion(), Characteristics: yyDollar[5].characteristicsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 74:
+	case 75:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:704
+//line sql.y:707
 		{
 			yyLOCAL = &SetTransaction{Comments: Comments(yyDollar[2].strs), Characteristics: yyDollar[4].characteristicsUnion(), Scope: ImplicitScope}
 		}
 		yyVAL.union = yyLOCAL
-	case 75:
+	case 76:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []Characteristic
-//line sql.y:710
+//line sql.y:713
 		{
 			yyLOCAL = []Characteristic{yyDollar[1].characteristicUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 76:
+	case 77:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:714
+//line sql.y:717
 		{
 			yySLICE := (*[]Characteristic)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].characteristicUnion())
 		}
-	case 77:
+	case 78:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:720
+//line sql.y:723
 		{
 			yyLOCAL = yyDollar[3].isolationLevelUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 78:
+	case 79:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:724
+//line sql.y:727
 		{
 			yyLOCAL = ReadWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 79:
+	case 80:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Characteristic
-//line sql.y:728
+//line sql.y:731
 		{
 			yyLOCAL = ReadOnly
 		}
 		yyVAL.union = yyLOCAL
-	case 80:
+	case 81:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:734
+//line sql.y:737
 		{
 			yyLOCAL = RepeatableRead
 		}
 		yyVAL.union = yyLOCAL
-	case 81:
+	case 82:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:738
+//line sql.y:741
 		{
 			yyLOCAL = ReadCommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 82:
+	case 83:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:742
+//line sql.y:745
 		{
 			yyLOCAL = ReadUncommitted
 		}
 		yyVAL.union = yyLOCAL
-	case 83:
+	case 84:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsolationLevel
-//line sql.y:746
+//line sql.y:749
 		{
 			yyLOCAL = Serializable
 		}
 		yyVAL.union = yyLOCAL
-	case 84:
+	case 85:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:752
+//line sql.y:755
 		{
 			yyLOCAL = SessionScope
 		}
 		yyVAL.union = yyLOCAL
-	case 85:
+	case 86:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Scope
-//line sql.y:756
+//line sql.y:759
 		{
 			yyLOCAL = GlobalScope
 		}
 		yyVAL.union = yyLOCAL
-	case 86:
+	case 87:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:762
+//line sql.y:765
 		{
 			yyDollar[1].createTableUnion().TableSpec = yyDollar[2].tableSpecUnion()
 			yyDollar[1].createTableUnion().FullyParsed = true
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 87:
+	case 88:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:768
+//line sql.y:771
 		{
 			// Create table [name] like [name]
 			yyDollar[1].createTableUnion().OptLike = yyDollar[2].optLikeUnion()
@@ -6260,10 +6227,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].createTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 88:
+	case 89:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:775
+//line sql.y:778
 		{
 			indexDef := yyDollar[1].alterTableUnion().AlterOptions[0].(*AddIndexDefinition).IndexDefinition
 			indexDef.Columns = yyDollar[3].indexColumnsUnion()
@@ -6273,539 +6240,531 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 89:
+	case 90:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:784
+//line sql.y:787
 		{
 			yyLOCAL = &CreateView{ViewName: yyDollar[7].tableName.ToViewName(), IsReplace: yyDollar[2].booleanUnion(), Algorithm: yyDollar[3].str, Definer: yyDollar[4].str, Security: yyDollar[5].str, Columns: yyDollar[8].columnsUnion(), Select: yyDollar[10].selStmtUnion(), CheckOption: yyDollar[11].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 90:
+	case 91:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:788
+//line sql.y:791
 		{
 			yyDollar[1].createDatabaseUnion().FullyParsed = true
 			yyDollar[1].createDatabaseUnion().CreateOptions = yyDollar[2].collateAndCharsetsUnion()
 			yyLOCAL = yyDollar[1].createDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 91:
+	case 92:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:795
+//line sql.y:798
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 92:
+	case 93:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:799
+//line sql.y:802
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 93:
+	case 94:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:804
+//line sql.y:807
 		{
 			yyVAL.colIdent = NewColIdent("")
 		}
-	case 94:
+	case 95:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:808
+//line sql.y:811
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 95:
+	case 96:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:814
+//line sql.y:817
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 96:
+	case 97:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:819
+//line sql.y:822
 		{
 			var v []VindexParam
 			yyLOCAL = v
 		}
 		yyVAL.union = yyLOCAL
-	case 97:
+	case 98:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:824
+//line sql.y:827
 		{
 			yyLOCAL = yyDollar[2].vindexParamsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 98:
+	case 99:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []VindexParam
-//line sql.y:830
+//line sql.y:833
 		{
 			yyLOCAL = make([]VindexParam, 0, 4)
 			yyLOCAL = append(yyLOCAL, yyDollar[1].vindexParam)
 		}
 		yyVAL.union = yyLOCAL
-	case 99:
+	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:835
+//line sql.y:838
 		{
 			yySLICE := (*[]VindexParam)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].vindexParam)
 		}
-	case 100:
+	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:841
+//line sql.y:844
 		{
 			yyVAL.vindexParam = VindexParam{Key: yyDollar[1].colIdent, Val: yyDollar[3].str}
 		}
-	case 101:
+	case 102:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *CreateTable
-//line sql.y:847
+//line sql.y:850
 		{
 			yyLOCAL = &CreateTable{Table: yyDollar[5].tableName, IfNotExists: yyDollar[4].booleanUnion(), Temp: yyDollar[2].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 102:
+	case 103:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:854
+//line sql.y:857
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[3].tableName}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 103:
+	case 104:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:861
+//line sql.y:864
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[6].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[3].colIdent, Type: string(yyDollar[2].str)}, Options: yyDollar[4].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 104:
+	case 105:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:866
+//line sql.y:869
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Fulltext: true}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 105:
+	case 106:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:871
+//line sql.y:874
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Spatial: true}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 106:
+	case 107:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AlterTable
-//line sql.y:876
+//line sql.y:879
 		{
 			yyLOCAL = &AlterTable{Table: yyDollar[7].tableName, AlterOptions: []AlterOption{&AddIndexDefinition{IndexDefinition: &IndexDefinition{Info: &IndexInfo{Name: yyDollar[4].colIdent, Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Unique: true}, Options: yyDollar[5].indexOptionsUnion()}}}}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 107:
+	case 108:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *CreateDatabase
-//line sql.y:883
+//line sql.y:886
 		{
 			yyLOCAL = &CreateDatabase{Comments: Comments(yyDollar[3].strs), DBName: yyDollar[5].tableIdent, IfNotExists: yyDollar[4].booleanUnion()}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 108:
+	case 109:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *AlterDatabase
-//line sql.y:890
+//line sql.y:893
 		{
 			yyLOCAL = &AlterDatabase{}
 			setDDL(yylex, yyLOCAL)
 		}
 		yyVAL.union = yyLOCAL
-	case 111:
+	case 112:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:901
+//line sql.y:904
 		{
 			yyLOCAL = yyDollar[2].tableSpecUnion()
 			yyLOCAL.Options = yyDollar[4].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 112:
+	case 113:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:907
+//line sql.y:910
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 113:
+	case 114:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:911
+//line sql.y:914
 		{
 			yyLOCAL = yyDollar[1].collateAndCharsetsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 114:
+	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:917
+//line sql.y:920
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 115:
+	case 116:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []CollateAndCharset
-//line sql.y:921
+//line sql.y:924
 		{
 			yyLOCAL = []CollateAndCharset{yyDollar[1].collateAndCharset}
 		}
 		yyVAL.union = yyLOCAL
-	case 116:
+	case 117:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:925
+//line sql.y:928
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 117:
+	case 118:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:929
+//line sql.y:932
 		{
 			yySLICE := (*[]CollateAndCharset)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].collateAndCharset)
 		}
-	case 118:
+	case 119:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:934
+//line sql.y:937
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 119:
+	case 120:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:938
+//line sql.y:941
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 120:
+	case 121:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:944
+//line sql.y:947
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 121:
+	case 122:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:948
+//line sql.y:951
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CharacterSetType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 122:
+	case 123:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:954
+//line sql.y:957
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (yyDollar[4].colIdent.String()), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 123:
+	case 124:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:958
+//line sql.y:961
 		{
 			yyVAL.collateAndCharset = CollateAndCharset{Type: CollateType, Value: (encodeSQLString(yyDollar[4].str)), IsDefault: yyDollar[1].booleanUnion()}
 		}
-	case 124:
+	case 125:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:965
+//line sql.y:968
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 125:
+	case 126:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *OptLike
-//line sql.y:969
+//line sql.y:972
 		{
 			yyLOCAL = &OptLike{LikeTable: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 126:
+	case 127:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*ColumnDefinition
-//line sql.y:975
+//line sql.y:978
 		{
 			yyLOCAL = []*ColumnDefinition{yyDollar[1].columnDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 127:
+	case 128:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:979
+//line sql.y:982
 		{
 			yySLICE := (*[]*ColumnDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].columnDefinitionUnion())
 		}
-	case 128:
+	case 129:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:985
+//line sql.y:988
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddColumn(yyDollar[1].columnDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 129:
+	case 130:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *TableSpec
-//line sql.y:990
+//line sql.y:993
 		{
 			yyLOCAL = &TableSpec{}
 			yyLOCAL.AddConstraint(yyDollar[1].constraintDefinitionUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 130:
+	case 131:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:995
+//line sql.y:998
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 		}
-	case 131:
+	case 132:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:999
+//line sql.y:1002
 		{
 			yyVAL.tableSpecUnion().AddColumn(yyDollar[3].columnDefinitionUnion())
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[4].constraintDefinitionUnion())
 		}
-	case 132:
+	case 133:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1004
+//line sql.y:1007
 		{
 			yyVAL.tableSpecUnion().AddIndex(yyDollar[3].indexDefinitionUnion())
 		}
-	case 133:
+	case 134:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1008
+//line sql.y:1011
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 134:
+	case 135:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1012
+//line sql.y:1015
 		{
 			yyVAL.tableSpecUnion().AddConstraint(yyDollar[3].constraintDefinitionUnion())
 		}
-	case 135:
+	case 136:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnDefinition
-//line sql.y:1018
+//line sql.y:1021
 		{
 			yyDollar[2].columnType.Options = yyDollar[3].columnTypeOptionsUnion()
 			yyLOCAL = &ColumnDefinition{Name: yyDollar[1].colIdent, Type: yyDollar[2].columnType}
 		}
 		yyVAL.union = yyLOCAL
-	case 136:
+	case 137:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1028
+//line sql.y:1031
 		{
 			yyLOCAL = &ColumnTypeOptions{Null: nil, Default: nil, OnUpdate: nil, Autoincrement: false, KeyOpt: colKeyNone, Comment: nil}
 		}
 		yyVAL.union = yyLOCAL
-	case 137:
+	case 138:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1032
+//line sql.y:1035
 		{
 			val := true
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 138:
+	case 139:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1038
+//line sql.y:1041
 		{
 			val := false
 			yyDollar[1].columnTypeOptionsUnion().Null = &val
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 139:
+	case 140:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1044
+//line sql.y:1047
 		{
 			yyDollar[1].columnTypeOptionsUnion().Default = yyDollar[3].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 140:
+	case 141:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1049
+//line sql.y:1052
 		{
 			yyDollar[1].columnTypeOptionsUnion().OnUpdate = yyDollar[4].exprUnion()
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 141:
+	case 142:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1054
+//line sql.y:1057
 		{
 			yyDollar[1].columnTypeOptionsUnion().Autoincrement = true
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 142:
+	case 143:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1059
+//line sql.y:1062
 		{
 			yyDollar[1].columnTypeOptionsUnion().Comment = NewStrLiteral(yyDollar[3].str)
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 143:
+	case 144:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1064
+//line sql.y:1067
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyPrimary
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 144:
+	case 145:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1069
+//line sql.y:1072
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKey
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 145:
+	case 146:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1074
+//line sql.y:1077
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyUniqueKey
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 146:
+	case 147:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColumnTypeOptions
-//line sql.y:1079
+//line sql.y:1082
 		{
 			yyDollar[1].columnTypeOptionsUnion().KeyOpt = colKeyUnique
 			yyLOCAL = yyDollar[1].columnTypeOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 147:
+	case 148:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1086
+//line sql.y:1089
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Unsigned = yyDollar[2].booleanUnion()
 			yyVAL.columnType.Zerofill = yyDollar[3].booleanUnion()
 		}
-	case 151:
+	case 152:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1097
+//line sql.y:1100
 		{
 			yyVAL.columnType = yyDollar[1].columnType
 			yyVAL.columnType.Length = yyDollar[2].literalUnion()
 		}
-	case 152:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1102
-		{
-			yyVAL.columnType = yyDollar[1].columnType
-		}
 	case 153:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1108
+//line sql.y:1105
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = yyDollar[1].columnType
 		}
 	case 154:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1112
+//line sql.y:1111
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 155:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1116
+//line sql.y:1115
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 156:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1120
+//line sql.y:1119
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 157:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1124
+//line sql.y:1123
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 158:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1128
+//line sql.y:1127
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 159:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1132
+//line sql.y:1131
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 160:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1136
+//line sql.y:1135
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 161:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1140
+//line sql.y:1139
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 162:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1146
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1143
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
-			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
-			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 163:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1152
+//line sql.y:1149
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6813,7 +6772,7 @@ yydefault:
 		}
 	case 164:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1158
+//line sql.y:1155
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6821,7 +6780,7 @@ yydefault:
 		}
 	case 165:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1164
+//line sql.y:1161
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
@@ -6829,1420 +6788,1428 @@ yydefault:
 		}
 	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1170
+//line sql.y:1167
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
 			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 167:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1178
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1173
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType.Length = yyDollar[2].LengthScaleOption.Length
+			yyVAL.columnType.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 	case 168:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1182
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1181
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 169:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1186
+//line sql.y:1185
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 170:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1190
+//line sql.y:1189
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1194
+//line sql.y:1193
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 172:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1200
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1197
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 173:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:1204
+//line sql.y:1203
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 174:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1208
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line sql.y:1207
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Collate: yyDollar[4].str}
 		}
 	case 175:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1212
+//line sql.y:1211
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 176:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1216
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line sql.y:1215
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 	case 177:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1220
+//line sql.y:1219
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 178:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1224
+//line sql.y:1223
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1228
+//line sql.y:1227
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 180:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1232
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line sql.y:1231
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), Charset: yyDollar[2].str, Collate: yyDollar[3].str}
 		}
 	case 181:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1236
+//line sql.y:1235
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 182:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1240
+//line sql.y:1239
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 183:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1244
+//line sql.y:1243
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 184:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1248
+//line sql.y:1247
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 185:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1252
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1251
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 186:
 		yyDollar = yyS[yypt-6 : yypt+1]
-//line sql.y:1257
+//line sql.y:1255
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 187:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1263
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line sql.y:1260
 		{
-			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str), EnumValues: yyDollar[3].strs, Charset: yyDollar[5].str, Collate: yyDollar[6].str}
 		}
 	case 188:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1267
+//line sql.y:1266
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 189:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1271
+//line sql.y:1270
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1275
+//line sql.y:1274
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 191:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1279
+//line sql.y:1278
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 192:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1283
+//line sql.y:1282
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 193:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1287
+//line sql.y:1286
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 194:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1291
+//line sql.y:1290
 		{
 			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
 		}
 	case 195:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1297
+//line sql.y:1294
+		{
+			yyVAL.columnType = ColumnType{Type: string(yyDollar[1].str)}
+		}
+	case 196:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1300
 		{
 			yyVAL.strs = make([]string, 0, 4)
 			yyVAL.strs = append(yyVAL.strs, encodeSQLString(yyDollar[1].str))
 		}
-	case 196:
+	case 197:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1302
+//line sql.y:1305
 		{
 			yyVAL.strs = append(yyDollar[1].strs, encodeSQLString(yyDollar[3].str))
 		}
-	case 197:
+	case 198:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1307
+//line sql.y:1310
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 198:
+	case 199:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Literal
-//line sql.y:1311
+//line sql.y:1314
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[2].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 199:
+	case 200:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1316
+//line sql.y:1319
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 200:
+	case 201:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1320
+//line sql.y:1323
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 201:
+	case 202:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1328
+//line sql.y:1331
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{}
 		}
-	case 202:
+	case 203:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1332
+//line sql.y:1335
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 			}
 		}
-	case 203:
+	case 204:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1338
+//line sql.y:1341
 		{
 			yyVAL.LengthScaleOption = LengthScaleOption{
 				Length: NewIntLiteral(yyDollar[2].str),
 				Scale:  NewIntLiteral(yyDollar[4].str),
 			}
 		}
-	case 204:
+	case 205:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1346
+//line sql.y:1349
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 205:
+	case 206:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1350
+//line sql.y:1353
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 206:
+	case 207:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1355
+//line sql.y:1358
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 207:
+	case 208:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1359
+//line sql.y:1362
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 208:
+	case 209:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1364
+//line sql.y:1367
 		{
 			yyVAL.str = ""
 		}
-	case 209:
+	case 210:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1368
+//line sql.y:1371
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 210:
+	case 211:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1372
+//line sql.y:1375
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 211:
+	case 212:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1376
+//line sql.y:1379
 		{
 			yyVAL.str = string(yyDollar[2].str)
 		}
-	case 212:
+	case 213:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1381
+//line sql.y:1384
 		{
 			yyVAL.str = ""
 		}
-	case 213:
+	case 214:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1385
+//line sql.y:1388
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 214:
+	case 215:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1389
+//line sql.y:1392
 		{
 			yyVAL.str = encodeSQLString(yyDollar[2].str)
 		}
-	case 215:
+	case 216:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexDefinition
-//line sql.y:1396
+//line sql.y:1399
 		{
 			yyLOCAL = &IndexDefinition{Info: yyDollar[1].indexInfoUnion(), Columns: yyDollar[3].indexColumnsUnion(), Options: yyDollar[5].indexOptionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 216:
+	case 217:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1401
+//line sql.y:1404
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 217:
+	case 218:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1405
+//line sql.y:1408
 		{
 			yyLOCAL = yyDollar[1].indexOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 218:
+	case 219:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:1411
+//line sql.y:1414
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 219:
+	case 220:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1415
+//line sql.y:1418
 		{
 			yySLICE := (*[]*IndexOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].indexOptionUnion())
 		}
-	case 220:
+	case 221:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1421
+//line sql.y:1424
 		{
 			yyLOCAL = yyDollar[1].indexOptionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 221:
+	case 222:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1425
+//line sql.y:1428
 		{
 			// should not be string
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 222:
+	case 223:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1430
+//line sql.y:1433
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 223:
+	case 224:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:1434
+//line sql.y:1437
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str) + " " + string(yyDollar[2].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 224:
+	case 225:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1440
+//line sql.y:1443
 		{
 			yyVAL.str = ""
 		}
-	case 225:
+	case 226:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1444
+//line sql.y:1447
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 226:
+	case 227:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1450
+//line sql.y:1453
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent("PRIMARY"), Primary: true, Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 227:
+	case 228:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1454
+//line sql.y:1457
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Spatial: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 228:
+	case 229:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1458
+//line sql.y:1461
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str) + " " + string(yyDollar[2].str), Name: NewColIdent(yyDollar[3].str), Fulltext: true, Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 229:
+	case 230:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1462
+//line sql.y:1465
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), ConstraintName: NewColIdent(yyDollar[1].str), Name: NewColIdent(yyDollar[4].str), Unique: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 230:
+	case 231:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexInfo
-//line sql.y:1466
+//line sql.y:1469
 		{
 			yyLOCAL = &IndexInfo{Type: string(yyDollar[1].str), Name: NewColIdent(yyDollar[2].str), Unique: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 231:
+	case 232:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1471
+//line sql.y:1474
 		{
 			yyVAL.str = ""
 		}
-	case 232:
+	case 233:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1475
+//line sql.y:1478
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 233:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1481
-		{
-			yyVAL.str = string(yyDollar[1].str)
-		}
 	case 234:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1485
+//line sql.y:1484
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 235:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1489
+//line sql.y:1488
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 236:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1496
+//line sql.y:1492
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 237:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1500
+//line sql.y:1499
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
 	case 238:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line sql.y:1503
+		{
+			yyVAL.str = string(yyDollar[1].str)
+		}
+	case 239:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1505
+//line sql.y:1508
 		{
 			yyVAL.str = "key"
 		}
-	case 239:
+	case 240:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1509
+//line sql.y:1512
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 240:
+	case 241:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1515
+//line sql.y:1518
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 241:
+	case 242:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1519
+//line sql.y:1522
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 242:
+	case 243:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1524
+//line sql.y:1527
 		{
 			yyVAL.str = ""
 		}
-	case 243:
+	case 244:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1528
+//line sql.y:1531
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 244:
+	case 245:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexColumn
-//line sql.y:1534
+//line sql.y:1537
 		{
 			yyLOCAL = []*IndexColumn{yyDollar[1].indexColumnUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 245:
+	case 246:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1538
+//line sql.y:1541
 		{
 			yySLICE := (*[]*IndexColumn)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].indexColumnUnion())
 		}
-	case 246:
+	case 247:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *IndexColumn
-//line sql.y:1544
+//line sql.y:1547
 		{
 			yyLOCAL = &IndexColumn{Column: yyDollar[1].colIdent, Length: yyDollar[2].literalUnion(), Direction: yyDollar[3].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 247:
+	case 248:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1550
+//line sql.y:1553
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 248:
+	case 249:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1554
+//line sql.y:1557
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 249:
+	case 250:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1560
+//line sql.y:1563
 		{
 			yyLOCAL = &ConstraintDefinition{Name: yyDollar[2].colIdent, Details: yyDollar[3].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 250:
+	case 251:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConstraintDefinition
-//line sql.y:1564
+//line sql.y:1567
 		{
 			yyLOCAL = &ConstraintDefinition{Details: yyDollar[1].constraintInfoUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 251:
+	case 252:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1570
+//line sql.y:1573
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 252:
+	case 253:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1574
+//line sql.y:1577
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnDelete: yyDollar[11].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 253:
+	case 254:
 		yyDollar = yyS[yypt-11 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1578
+//line sql.y:1581
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnUpdate: yyDollar[11].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 254:
+	case 255:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1582
+//line sql.y:1585
 		{
 			yyLOCAL = &ForeignKeyDefinition{Source: yyDollar[4].columnsUnion(), ReferencedTable: yyDollar[7].tableName, ReferencedColumns: yyDollar[9].columnsUnion(), OnDelete: yyDollar[11].ReferenceActionUnion(), OnUpdate: yyDollar[12].ReferenceActionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 255:
+	case 256:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL ConstraintInfo
-//line sql.y:1588
+//line sql.y:1591
 		{
 			yyLOCAL = &CheckConstraintDefinition{Expr: yyDollar[3].exprUnion(), Enforced: yyDollar[5].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 256:
+	case 257:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1594
+//line sql.y:1597
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 257:
+	case 258:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1600
+//line sql.y:1603
 		{
 			yyLOCAL = yyDollar[3].ReferenceActionUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 258:
+	case 259:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1606
+//line sql.y:1609
 		{
 			yyLOCAL = Restrict
 		}
 		yyVAL.union = yyLOCAL
-	case 259:
+	case 260:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1610
+//line sql.y:1613
 		{
 			yyLOCAL = Cascade
 		}
 		yyVAL.union = yyLOCAL
-	case 260:
+	case 261:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1614
+//line sql.y:1617
 		{
 			yyLOCAL = NoAction
 		}
 		yyVAL.union = yyLOCAL
-	case 261:
+	case 262:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1618
+//line sql.y:1621
 		{
 			yyLOCAL = SetDefault
 		}
 		yyVAL.union = yyLOCAL
-	case 262:
+	case 263:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ReferenceAction
-//line sql.y:1622
+//line sql.y:1625
 		{
 			yyLOCAL = SetNull
 		}
 		yyVAL.union = yyLOCAL
-	case 263:
+	case 264:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1627
+//line sql.y:1630
 		{
 			yyVAL.str = ""
 		}
-	case 264:
+	case 265:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1631
+//line sql.y:1634
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 265:
+	case 266:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1635
+//line sql.y:1638
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 266:
+	case 267:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1640
+//line sql.y:1643
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 267:
+	case 268:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1644
+//line sql.y:1647
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 268:
+	case 269:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:1648
+//line sql.y:1651
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 269:
+	case 270:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1653
+//line sql.y:1656
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 270:
+	case 271:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1657
+//line sql.y:1660
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 271:
+	case 272:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1663
+//line sql.y:1666
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 272:
+	case 273:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1667
+//line sql.y:1670
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableOptionUnion())
 		}
-	case 273:
+	case 274:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1671
+//line sql.y:1674
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 274:
+	case 275:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableOptions
-//line sql.y:1677
+//line sql.y:1680
 		{
 			yyLOCAL = TableOptions{yyDollar[1].tableOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 275:
+	case 276:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1681
+//line sql.y:1684
 		{
 			yySLICE := (*TableOptions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].tableOptionUnion())
 		}
-	case 276:
+	case 277:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1687
+//line sql.y:1690
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 277:
+	case 278:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1691
+//line sql.y:1694
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 278:
+	case 279:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1695
+//line sql.y:1698
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[2].str)), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 279:
+	case 280:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1699
+//line sql.y:1702
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[2].str), String: yyDollar[4].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 280:
+	case 281:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1703
+//line sql.y:1706
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 281:
+	case 282:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1707
+//line sql.y:1710
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 282:
+	case 283:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1711
+//line sql.y:1714
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 283:
+	case 284:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1715
+//line sql.y:1718
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 284:
+	case 285:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1719
+//line sql.y:1722
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 285:
+	case 286:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1723
+//line sql.y:1726
 		{
 			yyLOCAL = &TableOption{Name: (string(yyDollar[1].str) + " " + string(yyDollar[2].str)), Value: NewStrLiteral(yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 286:
+	case 287:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1727
+//line sql.y:1730
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 287:
+	case 288:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1731
+//line sql.y:1734
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 288:
+	case 289:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1735
+//line sql.y:1738
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 289:
+	case 290:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1739
+//line sql.y:1742
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 290:
+	case 291:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1743
+//line sql.y:1746
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 291:
+	case 292:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1747
+//line sql.y:1750
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 292:
+	case 293:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1751
+//line sql.y:1754
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 293:
+	case 294:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1755
+//line sql.y:1758
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 294:
+	case 295:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1759
+//line sql.y:1762
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 295:
+	case 296:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1763
+//line sql.y:1766
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 296:
+	case 297:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1767
+//line sql.y:1770
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewStrLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 297:
+	case 298:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1771
+//line sql.y:1774
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 298:
+	case 299:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1775
+//line sql.y:1778
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 299:
+	case 300:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1779
+//line sql.y:1782
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 300:
+	case 301:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1783
+//line sql.y:1786
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 301:
+	case 302:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1787
+//line sql.y:1790
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 302:
+	case 303:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1791
+//line sql.y:1794
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Value: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 303:
+	case 304:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1795
+//line sql.y:1798
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), String: (yyDollar[3].colIdent.String() + yyDollar[4].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 304:
+	case 305:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *TableOption
-//line sql.y:1799
+//line sql.y:1802
 		{
 			yyLOCAL = &TableOption{Name: string(yyDollar[1].str), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 305:
+	case 306:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1804
+//line sql.y:1807
 		{
 			yyVAL.str = ""
 		}
-	case 306:
+	case 307:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1808
+//line sql.y:1811
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 307:
+	case 308:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:1812
+//line sql.y:1815
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 317:
+	case 318:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1831
+//line sql.y:1834
 		{
 			yyVAL.str = yyDollar[1].colIdent.String()
 		}
-	case 318:
+	case 319:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1835
+//line sql.y:1838
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 319:
+	case 320:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:1839
+//line sql.y:1842
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 320:
+	case 321:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:1844
+//line sql.y:1847
 		{
 			yyVAL.str = ""
 		}
-	case 322:
+	case 323:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1850
+//line sql.y:1853
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 323:
+	case 324:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1854
+//line sql.y:1857
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 324:
+	case 325:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1859
+//line sql.y:1862
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 325:
+	case 326:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:1863
+//line sql.y:1866
 		{
 			yyLOCAL = yyDollar[2].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 326:
+	case 327:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1868
+//line sql.y:1871
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 327:
+	case 328:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1872
+//line sql.y:1875
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 328:
+	case 329:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:1876
+//line sql.y:1879
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &OrderByOption{Cols: yyDollar[5].columnsUnion()})
 		}
-	case 329:
+	case 330:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1880
+//line sql.y:1883
 		{
 			yyLOCAL = yyDollar[1].alterOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 330:
+	case 331:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1884
+//line sql.y:1887
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionsUnion()...)
 		}
-	case 331:
+	case 332:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1888
+//line sql.y:1891
 		{
 			yyLOCAL = append(append(yyDollar[1].alterOptionsUnion(), yyDollar[3].alterOptionsUnion()...), &OrderByOption{Cols: yyDollar[7].columnsUnion()})
 		}
 		yyVAL.union = yyLOCAL
-	case 332:
+	case 333:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1894
+//line sql.y:1897
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 333:
+	case 334:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1898
+//line sql.y:1901
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 334:
+	case 335:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:1902
+//line sql.y:1905
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 335:
+	case 336:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1908
+//line sql.y:1911
 		{
 			yyLOCAL = yyDollar[1].tableOptionsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 336:
+	case 337:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1912
+//line sql.y:1915
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 337:
+	case 338:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1916
+//line sql.y:1919
 		{
 			yyLOCAL = &AddConstraintDefinition{ConstraintDefinition: yyDollar[2].constraintDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 338:
+	case 339:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1920
+//line sql.y:1923
 		{
 			yyLOCAL = &AddIndexDefinition{IndexDefinition: yyDollar[2].indexDefinitionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 339:
+	case 340:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1924
+//line sql.y:1927
 		{
 			yyLOCAL = &AddColumns{Columns: yyDollar[4].columnDefinitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 340:
+	case 341:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1928
+//line sql.y:1931
 		{
 			yyLOCAL = &AddColumns{Columns: []*ColumnDefinition{yyDollar[3].columnDefinitionUnion()}, First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 341:
+	case 342:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1932
+//line sql.y:1935
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 342:
+	case 343:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1936
+//line sql.y:1939
 		{
 			yyLOCAL = &AlterColumn{Column: yyDollar[3].colNameUnion(), DropDefault: false, DefaultVal: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 343:
+	case 344:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1940
+//line sql.y:1943
 		{
 			yyLOCAL = &ChangeColumn{OldColumn: yyDollar[3].colNameUnion(), NewColDefinition: yyDollar[4].columnDefinitionUnion(), First: yyDollar[5].colNameUnion(), After: yyDollar[6].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 344:
+	case 345:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1944
+//line sql.y:1947
 		{
 			yyLOCAL = &ModifyColumn{NewColDefinition: yyDollar[3].columnDefinitionUnion(), First: yyDollar[4].colNameUnion(), After: yyDollar[5].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 345:
+	case 346:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1948
+//line sql.y:1951
 		{
 			yyLOCAL = &AlterCharset{CharacterSet: yyDollar[4].str, Collate: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 346:
+	case 347:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1952
+//line sql.y:1955
 		{
 			yyLOCAL = &KeyState{Enable: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 347:
+	case 348:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1956
+//line sql.y:1959
 		{
 			yyLOCAL = &KeyState{Enable: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 348:
+	case 349:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1960
+//line sql.y:1963
 		{
 			yyLOCAL = &TablespaceOperation{Import: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 349:
+	case 350:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1964
+//line sql.y:1967
 		{
 			yyLOCAL = &TablespaceOperation{Import: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 350:
+	case 351:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1968
+//line sql.y:1971
 		{
 			yyLOCAL = &DropColumn{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 351:
+	case 352:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1972
+//line sql.y:1975
 		{
 			yyLOCAL = &DropKey{Type: NormalKeyType, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 352:
+	case 353:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1976
+//line sql.y:1979
 		{
 			yyLOCAL = &DropKey{Type: PrimaryKeyType}
 		}
 		yyVAL.union = yyLOCAL
-	case 353:
+	case 354:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1980
+//line sql.y:1983
 		{
 			yyLOCAL = &DropKey{Type: ForeignKeyType, Name: yyDollar[4].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 354:
+	case 355:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1984
+//line sql.y:1987
 		{
 			yyLOCAL = &Force{}
 		}
 		yyVAL.union = yyLOCAL
-	case 355:
+	case 356:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1988
+//line sql.y:1991
 		{
 			yyLOCAL = &RenameTableName{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 356:
+	case 357:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:1992
+//line sql.y:1995
 		{
 			yyLOCAL = &RenameIndex{OldName: yyDollar[3].colIdent, NewName: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 357:
+	case 358:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:1998
+//line sql.y:2001
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 358:
+	case 359:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2002
+//line sql.y:2005
 		{
 			yySLICE := (*[]AlterOption)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].alterOptionUnion())
 		}
-	case 359:
+	case 360:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2008
+//line sql.y:2011
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 360:
+	case 361:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2012
+//line sql.y:2015
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 361:
+	case 362:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2016
+//line sql.y:2019
 		{
 			yyLOCAL = AlgorithmValue(string(yyDollar[3].str))
 		}
 		yyVAL.union = yyLOCAL
-	case 362:
+	case 363:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2020
+//line sql.y:2023
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 363:
+	case 364:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2024
+//line sql.y:2027
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 364:
+	case 365:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2028
+//line sql.y:2031
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 365:
+	case 366:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2032
+//line sql.y:2035
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 366:
+	case 367:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2036
+//line sql.y:2039
 		{
 			yyLOCAL = &Validation{With: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 367:
+	case 368:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:2040
+//line sql.y:2043
 		{
 			yyLOCAL = &Validation{With: false}
 		}
 		yyVAL.union = yyLOCAL
-	case 368:
+	case 369:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2046
+//line sql.y:2049
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 369:
+	case 370:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2052
+//line sql.y:2055
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8250,10 +8217,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 370:
+	case 371:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2059
+//line sql.y:2062
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().AlterOptions = yyDollar[2].alterOptionsUnion()
@@ -8261,28 +8228,28 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 371:
+	case 372:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2066
+//line sql.y:2069
 		{
 			yyDollar[1].alterTableUnion().FullyParsed = true
 			yyDollar[1].alterTableUnion().PartitionSpec = yyDollar[2].partSpecUnion()
 			yyLOCAL = yyDollar[1].alterTableUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 372:
+	case 373:
 		yyDollar = yyS[yypt-10 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2072
+//line sql.y:2075
 		{
 			yyLOCAL = &AlterView{ViewName: yyDollar[6].tableName.ToViewName(), Algorithm: yyDollar[2].str, Definer: yyDollar[3].str, Security: yyDollar[4].str, Columns: yyDollar[7].columnsUnion(), Select: yyDollar[9].selStmtUnion(), CheckOption: yyDollar[10].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 373:
+	case 374:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2076
+//line sql.y:2079
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8290,10 +8257,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 374:
+	case 375:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2083
+//line sql.y:2086
 		{
 			yyDollar[1].alterDatabaseUnion().FullyParsed = true
 			yyDollar[1].alterDatabaseUnion().DBName = yyDollar[2].tableIdent
@@ -8301,10 +8268,10 @@ yydefault:
 			yyLOCAL = yyDollar[1].alterDatabaseUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 375:
+	case 376:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2090
+//line sql.y:2093
 		{
 			yyLOCAL = &AlterVschema{
 				Action: CreateVindexDDLAction,
@@ -8317,10 +8284,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 376:
+	case 377:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2102
+//line sql.y:2105
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropVindexDDLAction,
@@ -8331,26 +8298,26 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 377:
+	case 378:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2112
+//line sql.y:2115
 		{
 			yyLOCAL = &AlterVschema{Action: AddVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 378:
+	case 379:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2116
+//line sql.y:2119
 		{
 			yyLOCAL = &AlterVschema{Action: DropVschemaTableDDLAction, Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 379:
+	case 380:
 		yyDollar = yyS[yypt-12 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2120
+//line sql.y:2123
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddColVindexDDLAction,
@@ -8364,10 +8331,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 380:
+	case 381:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2133
+//line sql.y:2136
 		{
 			yyLOCAL = &AlterVschema{
 				Action: DropColVindexDDLAction,
@@ -8378,18 +8345,18 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 381:
+	case 382:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2143
+//line sql.y:2146
 		{
 			yyLOCAL = &AlterVschema{Action: AddSequenceDDLAction, Table: yyDollar[5].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 382:
+	case 383:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2147
+//line sql.y:2150
 		{
 			yyLOCAL = &AlterVschema{
 				Action: AddAutoIncDDLAction,
@@ -8401,10 +8368,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 383:
+	case 384:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2158
+//line sql.y:2161
 		{
 			yyLOCAL = &AlterMigration{
 				Type: RetryMigrationType,
@@ -8412,10 +8379,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 384:
+	case 385:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2165
+//line sql.y:2168
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CompleteMigrationType,
@@ -8423,10 +8390,10 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 385:
+	case 386:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2172
+//line sql.y:2175
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelMigrationType,
@@ -8434,282 +8401,282 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 386:
+	case 387:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2179
+//line sql.y:2182
 		{
 			yyLOCAL = &AlterMigration{
 				Type: CancelAllMigrationType,
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 387:
+	case 388:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2187
+//line sql.y:2190
 		{
 			yyLOCAL = &PartitionSpec{Action: AddAction, Definitions: []*PartitionDefinition{yyDollar[4].partDefUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 388:
+	case 389:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2191
+//line sql.y:2194
 		{
 			yyLOCAL = &PartitionSpec{Action: DropAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 389:
+	case 390:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2195
+//line sql.y:2198
 		{
 			yyLOCAL = &PartitionSpec{Action: ReorganizeAction, Names: yyDollar[3].partitionsUnion(), Definitions: yyDollar[6].partDefsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 390:
+	case 391:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2199
+//line sql.y:2202
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 391:
+	case 392:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2203
+//line sql.y:2206
 		{
 			yyLOCAL = &PartitionSpec{Action: DiscardAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 392:
+	case 393:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2207
+//line sql.y:2210
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 393:
+	case 394:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2211
+//line sql.y:2214
 		{
 			yyLOCAL = &PartitionSpec{Action: ImportAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 394:
+	case 395:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2215
+//line sql.y:2218
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 395:
+	case 396:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2219
+//line sql.y:2222
 		{
 			yyLOCAL = &PartitionSpec{Action: TruncateAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 396:
+	case 397:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2223
+//line sql.y:2226
 		{
 			yyLOCAL = &PartitionSpec{Action: CoalesceAction, Number: NewIntLiteral(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 397:
+	case 398:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2227
+//line sql.y:2230
 		{
 			yyLOCAL = &PartitionSpec{Action: ExchangeAction, Names: Partitions{yyDollar[3].colIdent}, TableName: yyDollar[6].tableName, WithoutValidation: yyDollar[7].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 398:
+	case 399:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2231
+//line sql.y:2234
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 399:
+	case 400:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2235
+//line sql.y:2238
 		{
 			yyLOCAL = &PartitionSpec{Action: AnalyzeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 400:
+	case 401:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2239
+//line sql.y:2242
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 401:
+	case 402:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2243
+//line sql.y:2246
 		{
 			yyLOCAL = &PartitionSpec{Action: CheckAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 402:
+	case 403:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2247
+//line sql.y:2250
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 403:
+	case 404:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2251
+//line sql.y:2254
 		{
 			yyLOCAL = &PartitionSpec{Action: OptimizeAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 404:
+	case 405:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2255
+//line sql.y:2258
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 405:
+	case 406:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2259
+//line sql.y:2262
 		{
 			yyLOCAL = &PartitionSpec{Action: RebuildAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 406:
+	case 407:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2263
+//line sql.y:2266
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, Names: yyDollar[3].partitionsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 407:
+	case 408:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2267
+//line sql.y:2270
 		{
 			yyLOCAL = &PartitionSpec{Action: RepairAction, IsAll: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 408:
+	case 409:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *PartitionSpec
-//line sql.y:2271
+//line sql.y:2274
 		{
 			yyLOCAL = &PartitionSpec{Action: UpgradeAction}
 		}
 		yyVAL.union = yyLOCAL
-	case 409:
+	case 410:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2276
+//line sql.y:2279
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 410:
+	case 411:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2280
+//line sql.y:2283
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 411:
+	case 412:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2284
+//line sql.y:2287
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 412:
+	case 413:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*PartitionDefinition
-//line sql.y:2291
+//line sql.y:2294
 		{
 			yyLOCAL = []*PartitionDefinition{yyDollar[1].partDefUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 413:
+	case 414:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2295
+//line sql.y:2298
 		{
 			yySLICE := (*[]*PartitionDefinition)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].partDefUnion())
 		}
-	case 414:
+	case 415:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2301
+//line sql.y:2304
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Limit: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 415:
+	case 416:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL *PartitionDefinition
-//line sql.y:2305
+//line sql.y:2308
 		{
 			yyLOCAL = &PartitionDefinition{Name: yyDollar[2].colIdent, Maxvalue: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 416:
+	case 417:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2311
+//line sql.y:2314
 		{
 			yyLOCAL = &RenameTable{TablePairs: yyDollar[3].renameTablePairsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 417:
+	case 418:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL []*RenameTablePair
-//line sql.y:2317
+//line sql.y:2320
 		{
 			yyLOCAL = []*RenameTablePair{{FromTable: yyDollar[1].tableName, ToTable: yyDollar[3].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 418:
+	case 419:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:2321
+//line sql.y:2324
 		{
 			yySLICE := (*[]*RenameTablePair)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, &RenameTablePair{FromTable: yyDollar[3].tableName, ToTable: yyDollar[5].tableName})
 		}
-	case 419:
+	case 420:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2327
+//line sql.y:2330
 		{
 			yyLOCAL = &DropTable{FromTables: yyDollar[5].tableNamesUnion(), IfExists: yyDollar[4].booleanUnion(), Temp: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 420:
+	case 421:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2331
+//line sql.y:2334
 		{
 			// Change this to an alter statement
 			if yyDollar[3].colIdent.Lowered() == "primary" {
@@ -8719,371 +8686,371 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 421:
+	case 422:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2340
+//line sql.y:2343
 		{
 			yyLOCAL = &DropView{FromTables: yyDollar[4].tableNamesUnion(), IfExists: yyDollar[3].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 422:
+	case 423:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2344
+//line sql.y:2347
 		{
 			yyLOCAL = &DropDatabase{Comments: Comments(yyDollar[3].strs), DBName: yyDollar[5].tableIdent, IfExists: yyDollar[4].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 423:
+	case 424:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2350
+//line sql.y:2353
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[3].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 424:
+	case 425:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2354
+//line sql.y:2357
 		{
 			yyLOCAL = &TruncateTable{Table: yyDollar[2].tableName}
 		}
 		yyVAL.union = yyLOCAL
-	case 425:
-		yyDollar = yyS[yypt-3 : yypt+1]
+	case 426:
+		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2359
+//line sql.y:2362
 		{
-			yyLOCAL = &OtherRead{}
+			yyLOCAL = &AnalyzeTable{IsLocal: yyDollar[2].booleanUnion(), Tables: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 426:
+	case 427:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2365
+//line sql.y:2368
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Charset, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 427:
+	case 428:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2369
+//line sql.y:2372
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Collation, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 428:
+	case 429:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2373
+//line sql.y:2376
 		{
 			yyLOCAL = &Show{&ShowBasic{Full: yyDollar[2].booleanUnion(), Command: Column, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 429:
+	case 430:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2377
+//line sql.y:2380
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 430:
+	case 431:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2381
+//line sql.y:2384
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Database, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 431:
+	case 432:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2385
+//line sql.y:2388
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 432:
+	case 433:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2389
+//line sql.y:2392
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Keyspace, Filter: yyDollar[3].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 433:
+	case 434:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2393
+//line sql.y:2396
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Function, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 434:
+	case 435:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2397
+//line sql.y:2400
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Index, Tbl: yyDollar[5].tableName, DbName: yyDollar[6].tableIdent, Filter: yyDollar[7].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 435:
+	case 436:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2401
+//line sql.y:2404
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: OpenTable, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 436:
+	case 437:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2405
+//line sql.y:2408
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Privilege}}
 		}
 		yyVAL.union = yyLOCAL
-	case 437:
+	case 438:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2409
+//line sql.y:2412
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Procedure, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 438:
+	case 439:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2413
+//line sql.y:2416
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 439:
+	case 440:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2417
+//line sql.y:2420
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: StatusGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 440:
+	case 441:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2421
+//line sql.y:2424
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableSession, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 441:
+	case 442:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2425
+//line sql.y:2428
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VariableGlobal, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 442:
+	case 443:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2429
+//line sql.y:2432
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: TableStatus, DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 443:
+	case 444:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2433
+//line sql.y:2436
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Table, Full: yyDollar[2].booleanUnion(), DbName: yyDollar[4].tableIdent, Filter: yyDollar[5].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 444:
+	case 445:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2437
+//line sql.y:2440
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: Trigger, DbName: yyDollar[3].tableIdent, Filter: yyDollar[4].showFilterUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 445:
+	case 446:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2441
+//line sql.y:2444
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateDb, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 446:
+	case 447:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2445
+//line sql.y:2448
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateE, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 447:
+	case 448:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2449
+//line sql.y:2452
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateF, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 448:
+	case 449:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2453
+//line sql.y:2456
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateProc, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 449:
+	case 450:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2457
+//line sql.y:2460
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTbl, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 450:
+	case 451:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2461
+//line sql.y:2464
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateTr, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 451:
+	case 452:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2465
+//line sql.y:2468
 		{
 			yyLOCAL = &Show{&ShowCreate{Command: CreateV, Op: yyDollar[4].tableName}}
 		}
 		yyVAL.union = yyLOCAL
-	case 452:
+	case 453:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2469
+//line sql.y:2472
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 453:
+	case 454:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2473
+//line sql.y:2476
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 454:
+	case 455:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2477
+//line sql.y:2480
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 455:
+	case 456:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2481
+//line sql.y:2484
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 456:
+	case 457:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2485
+//line sql.y:2488
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 457:
+	case 458:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2489
+//line sql.y:2492
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 458:
+	case 459:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2493
+//line sql.y:2496
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Table: yyDollar[4].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 459:
+	case 460:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2497
+//line sql.y:2500
 		{
-			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope}}
+			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[3].str), Scope: ImplicitScope, Full: yyDollar[2].booleanUnion()}}
 		}
 		yyVAL.union = yyLOCAL
-	case 460:
+	case 461:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2501
+//line sql.y:2504
 		{
 			showTablesOpt := &ShowTablesOpt{Filter: yyDollar[4].showFilterUnion()}
 			yyLOCAL = &Show{&ShowLegacy{Scope: VitessMetadataScope, Type: string(yyDollar[3].str), ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 461:
+	case 462:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2506
+//line sql.y:2509
 		{
 			yyLOCAL = &Show{&ShowBasic{Command: VitessMigrations, Filter: yyDollar[4].showFilterUnion(), DbName: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 462:
+	case 463:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2510
+//line sql.y:2513
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 463:
+	case 464:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2514
+//line sql.y:2517
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 464:
+	case 465:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2518
+//line sql.y:2521
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str) + " " + string(yyDollar[3].str), OnTable: yyDollar[5].tableName, Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 465:
+	case 466:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2522
+//line sql.y:2525
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 466:
+	case 467:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2527
+//line sql.y:2530
 		{
 			// This should probably be a different type (ShowVitessTopoOpt), but
 			// just getting the thing working for now
@@ -9091,1204 +9058,1241 @@ yydefault:
 			yyLOCAL = &Show{&ShowLegacy{Type: yyDollar[2].str, ShowTablesOpt: showTablesOpt}}
 		}
 		yyVAL.union = yyLOCAL
-	case 467:
+	case 468:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2541
+//line sql.y:2544
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].colIdent.String()), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 468:
+	case 469:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2545
+//line sql.y:2548
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 469:
+	case 470:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2549
+//line sql.y:2552
 		{
 			yyLOCAL = &Show{&ShowLegacy{Type: string(yyDollar[2].str), Scope: ImplicitScope}}
 		}
 		yyVAL.union = yyLOCAL
-	case 470:
+	case 471:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2555
+//line sql.y:2558
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 471:
+	case 472:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2559
+//line sql.y:2562
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 472:
+	case 473:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2565
+//line sql.y:2568
 		{
 			yyVAL.str = ""
 		}
-	case 473:
+	case 474:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2569
+//line sql.y:2572
 		{
 			yyVAL.str = "extended "
 		}
-	case 474:
+	case 475:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2575
+//line sql.y:2578
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 475:
+	case 476:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2579
+//line sql.y:2582
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 476:
+	case 477:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2585
+//line sql.y:2588
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 477:
+	case 478:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2589
+//line sql.y:2592
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 478:
+	case 479:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2595
+//line sql.y:2598
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 479:
+	case 480:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2599
+//line sql.y:2602
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 480:
+	case 481:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2603
+//line sql.y:2606
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 481:
+	case 482:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2609
+//line sql.y:2612
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 482:
+	case 483:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2613
+//line sql.y:2616
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 483:
+	case 484:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2617
+//line sql.y:2620
 		{
 			yyLOCAL = &ShowFilter{Filter: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 484:
+	case 485:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2623
+//line sql.y:2626
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 485:
+	case 486:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ShowFilter
-//line sql.y:2627
+//line sql.y:2630
 		{
 			yyLOCAL = &ShowFilter{Like: string(yyDollar[2].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 486:
+	case 487:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2633
+//line sql.y:2636
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 487:
+	case 488:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2637
+//line sql.y:2640
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 488:
+	case 489:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2641
+//line sql.y:2644
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 489:
+	case 490:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2647
+//line sql.y:2650
 		{
 			yyLOCAL = &Use{DBName: yyDollar[2].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 490:
+	case 491:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2651
+//line sql.y:2654
 		{
 			yyLOCAL = &Use{DBName: TableIdent{v: ""}}
 		}
 		yyVAL.union = yyLOCAL
-	case 491:
+	case 492:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2657
+//line sql.y:2660
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 492:
+	case 493:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2661
+//line sql.y:2664
 		{
 			yyLOCAL = &Begin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 493:
+	case 494:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2667
+//line sql.y:2670
 		{
 			yyLOCAL = &Commit{}
 		}
 		yyVAL.union = yyLOCAL
-	case 494:
+	case 495:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2673
+//line sql.y:2676
 		{
 			yyLOCAL = &Rollback{}
 		}
 		yyVAL.union = yyLOCAL
-	case 495:
+	case 496:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2677
+//line sql.y:2680
 		{
 			yyLOCAL = &SRollback{Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 496:
+	case 497:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2682
+//line sql.y:2685
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 497:
+	case 498:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2684
+//line sql.y:2687
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 498:
+	case 499:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2687
+//line sql.y:2690
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 499:
+	case 500:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2689
+//line sql.y:2692
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 500:
+	case 501:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2694
+//line sql.y:2697
 		{
 			yyLOCAL = &Savepoint{Name: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 501:
+	case 502:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2700
+//line sql.y:2703
 		{
 			yyLOCAL = &Release{Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 502:
+	case 503:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2705
+//line sql.y:2708
 		{
 			yyLOCAL = EmptyType
 		}
 		yyVAL.union = yyLOCAL
-	case 503:
+	case 504:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2709
+//line sql.y:2712
 		{
 			yyLOCAL = JSONType
 		}
 		yyVAL.union = yyLOCAL
-	case 504:
+	case 505:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2713
+//line sql.y:2716
 		{
 			yyLOCAL = TreeType
 		}
 		yyVAL.union = yyLOCAL
-	case 505:
+	case 506:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2717
+//line sql.y:2720
 		{
 			yyLOCAL = VitessType
 		}
 		yyVAL.union = yyLOCAL
-	case 506:
+	case 507:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2721
+//line sql.y:2724
 		{
 			yyLOCAL = TraditionalType
 		}
 		yyVAL.union = yyLOCAL
-	case 507:
+	case 508:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ExplainType
-//line sql.y:2725
+//line sql.y:2728
 		{
 			yyLOCAL = AnalyzeType
 		}
 		yyVAL.union = yyLOCAL
-	case 508:
+	case 509:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2731
+//line sql.y:2734
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 509:
+	case 510:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2735
+//line sql.y:2738
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 510:
+	case 511:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2739
+//line sql.y:2742
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 511:
+	case 512:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2745
+//line sql.y:2748
 		{
 			yyLOCAL = yyDollar[1].selStmtUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 512:
+	case 513:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2749
+//line sql.y:2752
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 513:
+	case 514:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2753
+//line sql.y:2756
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 514:
+	case 515:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2757
+//line sql.y:2760
 		{
 			yyLOCAL = yyDollar[1].statementUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 515:
+	case 516:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2762
+//line sql.y:2765
 		{
 			yyVAL.str = ""
 		}
-	case 516:
+	case 517:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2766
+//line sql.y:2769
 		{
 			yyVAL.str = yyDollar[1].colIdent.val
 		}
-	case 517:
+	case 518:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2770
+//line sql.y:2773
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str)
 		}
-	case 518:
+	case 519:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2776
+//line sql.y:2779
 		{
 			yyLOCAL = &ExplainTab{Table: yyDollar[2].tableName, Wild: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 519:
+	case 520:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2780
+//line sql.y:2783
 		{
 			yyLOCAL = &ExplainStmt{Type: yyDollar[2].explainTypeUnion(), Statement: yyDollar[3].statementUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 520:
+	case 521:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2786
+//line sql.y:2789
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 521:
+	case 522:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2790
+//line sql.y:2793
 		{
 			yyLOCAL = &OtherAdmin{}
 		}
 		yyVAL.union = yyLOCAL
-	case 522:
+	case 523:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2796
+//line sql.y:2799
 		{
 			yyLOCAL = &LockTables{Tables: yyDollar[3].tableAndLockTypesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 523:
+	case 524:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableAndLockTypes
-//line sql.y:2802
+//line sql.y:2805
 		{
 			yyLOCAL = TableAndLockTypes{yyDollar[1].tableAndLockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 524:
+	case 525:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2806
+//line sql.y:2809
 		{
 			yySLICE := (*TableAndLockTypes)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableAndLockTypeUnion())
 		}
-	case 525:
+	case 526:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *TableAndLockType
-//line sql.y:2812
+//line sql.y:2815
 		{
 			yyLOCAL = &TableAndLockType{Table: yyDollar[1].aliasedTableNameUnion(), Lock: yyDollar[2].lockTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 526:
+	case 527:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2818
+//line sql.y:2821
 		{
 			yyLOCAL = Read
 		}
 		yyVAL.union = yyLOCAL
-	case 527:
+	case 528:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2822
+//line sql.y:2825
 		{
 			yyLOCAL = ReadLocal
 		}
 		yyVAL.union = yyLOCAL
-	case 528:
+	case 529:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2826
+//line sql.y:2829
 		{
 			yyLOCAL = Write
 		}
 		yyVAL.union = yyLOCAL
-	case 529:
+	case 530:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL LockType
-//line sql.y:2830
+//line sql.y:2833
 		{
 			yyLOCAL = LowPriorityWrite
 		}
 		yyVAL.union = yyLOCAL
-	case 530:
+	case 531:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2836
+//line sql.y:2839
 		{
 			yyLOCAL = &UnlockTables{}
 		}
 		yyVAL.union = yyLOCAL
-	case 531:
+	case 532:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2842
+//line sql.y:2845
 		{
 			yyLOCAL = &RevertMigration{UUID: string(yyDollar[3].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 532:
+	case 533:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2848
+//line sql.y:2851
 		{
-			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
+			id, err := parseProcesslistID(yyDollar[3].str)
+			if err != nil {
+				yylex.Error(err.Error())
+				return 1
+			}
+			yyLOCAL = &Kill{Type: yyDollar[2].killTypeUnion(), ProcesslistID: id}
 		}
 		yyVAL.union = yyLOCAL
-	case 533:
-		yyDollar = yyS[yypt-3 : yypt+1]
-		var yyLOCAL Statement
-//line sql.y:2852
-		{
+	case 534:
+		yyDollar = yyS[yypt-0 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:2861
+		{
+			yyLOCAL = ConnectionKill
+		}
+		yyVAL.union = yyLOCAL
+	case 535:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:2865
+		{
+			yyLOCAL = ConnectionKill
+		}
+		yyVAL.union = yyLOCAL
+	case 536:
+		yyDollar = yyS[yypt-1 : yypt+1]
+		var yyLOCAL KillType
+//line sql.y:2869
+		{
+			yyLOCAL = QueryKill
+		}
+		yyVAL.union = yyLOCAL
+	case 537:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2875
+		{
+			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), FlushOptions: yyDollar[3].strs}
+		}
+		yyVAL.union = yyLOCAL
+	case 538:
+		yyDollar = yyS[yypt-3 : yypt+1]
+		var yyLOCAL Statement
+//line sql.y:2879
+		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 534:
+	case 539:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2856
+//line sql.y:2883
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 535:
+	case 540:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2860
+//line sql.y:2887
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 536:
+	case 541:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2864
+//line sql.y:2891
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), WithLock: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 537:
+	case 542:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:2868
+//line sql.y:2895
 		{
 			yyLOCAL = &Flush{IsLocal: yyDollar[2].booleanUnion(), TableNames: yyDollar[4].tableNamesUnion(), ForExport: true}
 		}
 		yyVAL.union = yyLOCAL
-	case 538:
+	case 543:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2874
+//line sql.y:2901
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 539:
+	case 544:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2878
+//line sql.y:2905
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[3].str)
 		}
-	case 540:
+	case 545:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2884
+//line sql.y:2911
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 541:
+	case 546:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2888
+//line sql.y:2915
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 542:
+	case 547:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2892
+//line sql.y:2919
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 543:
+	case 548:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2896
+//line sql.y:2923
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 544:
+	case 549:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2900
+//line sql.y:2927
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 545:
+	case 550:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2904
+//line sql.y:2931
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 546:
+	case 551:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2908
+//line sql.y:2935
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 547:
+	case 552:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2912
+//line sql.y:2939
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str) + yyDollar[3].str
 		}
-	case 548:
+	case 553:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2916
+//line sql.y:2943
 		{
 			yyVAL.str = string(yyDollar[1].str) + " " + string(yyDollar[2].str)
 		}
-	case 549:
+	case 554:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2920
+//line sql.y:2947
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 550:
+	case 555:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2924
+//line sql.y:2951
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 551:
+	case 556:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2928
+//line sql.y:2955
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 552:
+	case 557:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2933
+//line sql.y:2960
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 553:
+	case 558:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2937
+//line sql.y:2964
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 554:
+	case 559:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2941
+//line sql.y:2968
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 555:
+	case 560:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2946
+//line sql.y:2973
 		{
 			yyVAL.str = ""
 		}
-	case 556:
+	case 561:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:2950
+//line sql.y:2977
 		{
 			yyVAL.str = " " + string(yyDollar[1].str) + " " + string(yyDollar[2].str) + " " + yyDollar[3].colIdent.String()
 		}
-	case 557:
+	case 562:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2955
+//line sql.y:2982
 		{
 			setAllowComments(yylex, true)
 		}
-	case 558:
+	case 563:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2959
+//line sql.y:2986
 		{
 			yyVAL.strs = yyDollar[2].strs
 			setAllowComments(yylex, false)
 		}
-	case 559:
+	case 564:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2965
+//line sql.y:2992
 		{
 			yyVAL.strs = nil
 		}
-	case 560:
+	case 565:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:2969
+//line sql.y:2996
 		{
 			yyVAL.strs = append(yyDollar[1].strs, yyDollar[2].str)
 		}
-	case 561:
+	case 566:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2975
+//line sql.y:3002
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 562:
+	case 567:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2979
+//line sql.y:3006
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 563:
+	case 568:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:2983
+//line sql.y:3010
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 564:
+	case 569:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:2988
+//line sql.y:3015
 		{
 			yyVAL.str = ""
 		}
-	case 565:
+	case 570:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2992
+//line sql.y:3019
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 566:
+	case 571:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:2996
+//line sql.y:3023
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 567:
+	case 572:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3001
+//line sql.y:3028
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 568:
+	case 573:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3005
+//line sql.y:3032
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 569:
+	case 574:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:3009
+//line sql.y:3036
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 570:
+	case 575:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3014
+//line sql.y:3041
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 571:
+	case 576:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3018
+//line sql.y:3045
 		{
 			yyLOCAL = yyDollar[1].selectExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 572:
+	case 577:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3023
+//line sql.y:3050
 		{
 			yyVAL.strs = nil
 		}
-	case 573:
+	case 578:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3027
+//line sql.y:3054
 		{
 			yyVAL.strs = []string{yyDollar[1].str}
 		}
-	case 574:
+	case 579:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3031
+//line sql.y:3058
 		{ // TODO: This is a hack since I couldn't get it to work in a nicer way. I got 'conflicts: 8 shift/reduce'
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str}
 		}
-	case 575:
+	case 580:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3035
+//line sql.y:3062
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str}
 		}
-	case 576:
+	case 581:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3039
+//line sql.y:3066
 		{
 			yyVAL.strs = []string{yyDollar[1].str, yyDollar[2].str, yyDollar[3].str, yyDollar[4].str}
 		}
-	case 577:
+	case 582:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3045
+//line sql.y:3072
 		{
 			yyVAL.str = SQLNoCacheStr
 		}
-	case 578:
+	case 583:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3049
+//line sql.y:3076
 		{
 			yyVAL.str = SQLCacheStr
 		}
-	case 579:
+	case 584:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3053
+//line sql.y:3080
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 580:
+	case 585:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3057
+//line sql.y:3084
 		{
 			yyVAL.str = DistinctStr
 		}
-	case 581:
+	case 586:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3061
+//line sql.y:3088
 		{
 			yyVAL.str = StraightJoinHint
 		}
-	case 582:
+	case 587:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3065
+//line sql.y:3092
 		{
 			yyVAL.str = SQLCalcFoundRowsStr
 		}
-	case 583:
+	case 588:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3069
+//line sql.y:3096
 		{
 			yyVAL.str = AllStr // These are not picked up by NewSelect, and so ALL will be dropped. But this is OK, since it's redundant anyway
 		}
-	case 584:
+	case 589:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExprs
-//line sql.y:3075
+//line sql.y:3102
 		{
 			yyLOCAL = SelectExprs{yyDollar[1].selectExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 585:
+	case 590:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3079
+//line sql.y:3106
 		{
 			yySLICE := (*SelectExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].selectExprUnion())
 		}
-	case 586:
+	case 591:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3085
+//line sql.y:3112
 		{
 			yyLOCAL = &StarExpr{}
 		}
 		yyVAL.union = yyLOCAL
-	case 587:
+	case 592:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3089
+//line sql.y:3116
 		{
 			yyLOCAL = &AliasedExpr{Expr: yyDollar[1].exprUnion(), As: yyDollar[2].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 588:
+	case 593:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3093
+//line sql.y:3120
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Name: yyDollar[1].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 589:
+	case 594:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL SelectExpr
-//line sql.y:3097
+//line sql.y:3124
 		{
 			yyLOCAL = &StarExpr{TableName: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}}
 		}
 		yyVAL.union = yyLOCAL
-	case 590:
+	case 595:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3102
+//line sql.y:3129
 		{
 			yyVAL.colIdent = ColIdent{}
 		}
-	case 591:
+	case 596:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3106
+//line sql.y:3133
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 592:
+	case 597:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3110
+//line sql.y:3137
 		{
 			yyVAL.colIdent = yyDollar[2].colIdent
 		}
-	case 594:
+	case 599:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3117
+//line sql.y:3144
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 595:
+	case 600:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3122
+//line sql.y:3149
 		{
 			yyLOCAL = TableExprs{&AliasedTableExpr{Expr: TableName{Name: NewTableIdent("dual")}}}
 		}
 		yyVAL.union = yyLOCAL
-	case 596:
+	case 601:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3126
+//line sql.y:3153
 		{
 			yyLOCAL = yyDollar[2].tableExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 597:
+	case 602:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExprs
-//line sql.y:3132
+//line sql.y:3159
 		{
 			yyLOCAL = TableExprs{yyDollar[1].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 598:
+	case 603:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3136
+//line sql.y:3163
 		{
 			yySLICE := (*TableExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].tableExprUnion())
 		}
-	case 601:
+	case 606:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3146
+//line sql.y:3173
 		{
 			yyLOCAL = yyDollar[1].aliasedTableNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 602:
+	case 607:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3150
+//line sql.y:3177
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].derivedTableUnion(), As: yyDollar[3].tableIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 603:
+	case 608:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3154
+//line sql.y:3181
 		{
 			yyLOCAL = &ParenTableExpr{Exprs: yyDollar[2].tableExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 604:
+	case 609:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *DerivedTable
-//line sql.y:3160
+//line sql.y:3187
 		{
 			yyLOCAL = &DerivedTable{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 605:
+	case 610:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3166
+//line sql.y:3193
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, As: yyDollar[2].tableIdent, Hints: yyDollar[3].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 606:
+	case 611:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL *AliasedTableExpr
-//line sql.y:3170
+//line sql.y:3197
 		{
 			yyLOCAL = &AliasedTableExpr{Expr: yyDollar[1].tableName, Partitions: yyDollar[4].partitionsUnion(), As: yyDollar[6].tableIdent, Hints: yyDollar[7].indexHintsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 607:
+	case 612:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3175
+//line sql.y:3202
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 608:
+	case 613:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3179
+//line sql.y:3206
 		{
 			yyLOCAL = yyDollar[2].columnsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 609:
+	case 614:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:3185
+//line sql.y:3212
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 610:
+	case 615:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3189
+//line sql.y:3216
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 611:
+	case 616:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Partitions
-//line sql.y:3195
+//line sql.y:3222
 		{
 			yyLOCAL = Partitions{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 612:
+	case 617:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3199
+//line sql.y:3226
 		{
 			yySLICE := (*Partitions)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 613:
+	case 618:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3212
+//line sql.y:3239
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 614:
+	case 619:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3216
+//line sql.y:3243
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 615:
+	case 620:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3220
+//line sql.y:3247
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion(), Condition: yyDollar[4].joinCondition}
 		}
 		yyVAL.union = yyLOCAL
-	case 616:
+	case 621:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL TableExpr
-//line sql.y:3224
+//line sql.y:3251
 		{
 			yyLOCAL = &JoinTableExpr{LeftExpr: yyDollar[1].tableExprUnion(), Join: yyDollar[2].joinTypeUnion(), RightExpr: yyDollar[3].tableExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 617:
+	case 622:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3230
+//line sql.y:3257
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 618:
+	case 623:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:3232
+//line sql.y:3259
 		{
 			yyVAL.joinCondition = JoinCondition{Using: yyDollar[3].columnsUnion()}
 		}
-	case 619:
+	case 624:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3236
+//line sql.y:3263
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 620:
+	case 625:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3238
+//line sql.y:3265
 		{
 			yyVAL.joinCondition = yyDollar[1].joinCondition
 		}
-	case 621:
+	case 626:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3242
+//line sql.y:3269
 		{
 			yyVAL.joinCondition = JoinCondition{}
 		}
-	case 622:
+	case 627:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3244
+//line sql.y:3271
 		{
 			yyVAL.joinCondition = JoinCondition{On: yyDollar[2].exprUnion()}
 		}
-	case 623:
+	case 628:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3247
+//line sql.y:3274
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 624:
+	case 629:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3249
+//line sql.y:3276
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 625:
+	case 630:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3252
+//line sql.y:3279
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 626:
+	case 631:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3256
+//line sql.y:3283
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 627:
+	case 632:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3260
+//line sql.y:3287
 		{
 			yyVAL.tableIdent = yyDollar[2].tableIdent
 		}
-	case 629:
+	case 634:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3267
+//line sql.y:3294
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 630:
+	case 635:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3273
+//line sql.y:3300
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 631:
+	case 636:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3277
+//line sql.y:3304
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 632:
+	case 637:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3281
+//line sql.y:3308
 		{
 			yyLOCAL = NormalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 633:
+	case 638:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3287
+//line sql.y:3314
 		{
 			yyLOCAL = StraightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 634:
+	case 639:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3293
+//line sql.y:3320
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 635:
+	case 640:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3297
+//line sql.y:3324
 		{
 			yyLOCAL = LeftJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 636:
+	case 641:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3301
+//line sql.y:3328
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 637:
+	case 642:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3305
+//line sql.y:3332
 		{
 			yyLOCAL = RightJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 638:
+	case 643:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3311
+//line sql.y:3338
 		{
 			yyLOCAL = NaturalJoinType
 		}
 		yyVAL.union = yyLOCAL
-	case 639:
+	case 644:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL JoinType
-//line sql.y:3315
+//line sql.y:3342
 		{
 			if yyDollar[2].joinTypeUnion() == LeftJoinType {
 				yyLOCAL = NaturalLeftJoinType
@@ -10297,668 +10301,668 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 640:
+	case 645:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:3325
+//line sql.y:3352
 		{
 			yyVAL.tableName = yyDollar[2].tableName
 		}
-	case 641:
+	case 646:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3329
+//line sql.y:3356
 		{
 			yyVAL.tableName = yyDollar[1].tableName
 		}
-	case 642:
+	case 647:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3335
+//line sql.y:3362
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 643:
+	case 648:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3339
+//line sql.y:3366
 		{
 			yyVAL.tableName = TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}
 		}
-	case 644:
+	case 649:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3345
+//line sql.y:3372
 		{
 			yyVAL.tableName = TableName{Name: yyDollar[1].tableIdent}
 		}
-	case 645:
+	case 650:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3350
+//line sql.y:3377
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 646:
+	case 651:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3354
+//line sql.y:3381
 		{
 			yyLOCAL = &IndexHints{Type: UseOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 647:
+	case 652:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3358
+//line sql.y:3385
 		{
 			yyLOCAL = &IndexHints{Type: UseOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 648:
+	case 653:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3362
+//line sql.y:3389
 		{
 			yyLOCAL = &IndexHints{Type: IgnoreOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 649:
+	case 654:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *IndexHints
-//line sql.y:3366
+//line sql.y:3393
 		{
 			yyLOCAL = &IndexHints{Type: ForceOp, Indexes: yyDollar[4].columnsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 650:
+	case 655:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3371
+//line sql.y:3398
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 651:
+	case 656:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3375
+//line sql.y:3402
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 652:
+	case 657:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3381
+//line sql.y:3408
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 653:
+	case 658:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3385
+//line sql.y:3412
 		{
 			yyLOCAL = &AndExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 654:
+	case 659:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3389
+//line sql.y:3416
 		{
 			yyLOCAL = &OrExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 655:
+	case 660:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3393
+//line sql.y:3420
 		{
 			yyLOCAL = &XorExpr{Left: yyDollar[1].exprUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 656:
+	case 661:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3397
+//line sql.y:3424
 		{
 			yyLOCAL = &NotExpr{Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 657:
+	case 662:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3401
+//line sql.y:3428
 		{
 			yyLOCAL = &IsExpr{Operator: yyDollar[3].isExprOperatorUnion(), Expr: yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 658:
+	case 663:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3405
+//line sql.y:3432
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 659:
+	case 664:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3409
+//line sql.y:3436
 		{
 			yyLOCAL = &Default{ColName: yyDollar[2].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 660:
+	case 665:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:3415
+//line sql.y:3442
 		{
 			yyVAL.str = ""
 		}
-	case 661:
+	case 666:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3419
+//line sql.y:3446
 		{
 			yyVAL.str = string(yyDollar[2].colIdent.String())
 		}
-	case 662:
+	case 667:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3425
+//line sql.y:3452
 		{
 			yyLOCAL = BoolVal(true)
 		}
 		yyVAL.union = yyLOCAL
-	case 663:
+	case 668:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL BoolVal
-//line sql.y:3429
+//line sql.y:3456
 		{
 			yyLOCAL = BoolVal(false)
 		}
 		yyVAL.union = yyLOCAL
-	case 664:
+	case 669:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3435
+//line sql.y:3462
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: yyDollar[2].comparisonExprOperatorUnion(), Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 665:
+	case 670:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3439
+//line sql.y:3466
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: InOp, Right: yyDollar[3].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 666:
+	case 671:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3443
+//line sql.y:3470
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotInOp, Right: yyDollar[4].colTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 667:
+	case 672:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3447
+//line sql.y:3474
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: LikeOp, Right: yyDollar[3].exprUnion(), Escape: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 668:
+	case 673:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3451
+//line sql.y:3478
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotLikeOp, Right: yyDollar[4].exprUnion(), Escape: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 669:
+	case 674:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3455
+//line sql.y:3482
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: RegexpOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 670:
+	case 675:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3459
+//line sql.y:3486
 		{
 			yyLOCAL = &ComparisonExpr{Left: yyDollar[1].exprUnion(), Operator: NotRegexpOp, Right: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 671:
+	case 676:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3463
+//line sql.y:3490
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: BetweenOp, From: yyDollar[3].exprUnion(), To: yyDollar[5].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 672:
+	case 677:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3467
+//line sql.y:3494
 		{
 			yyLOCAL = &RangeCond{Left: yyDollar[1].exprUnion(), Operator: NotBetweenOp, From: yyDollar[4].exprUnion(), To: yyDollar[6].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 673:
+	case 678:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3471
+//line sql.y:3498
 		{
 			yyLOCAL = &ExistsExpr{Subquery: yyDollar[2].subqueryUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 674:
+	case 679:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3477
+//line sql.y:3504
 		{
 			yyLOCAL = IsNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 675:
+	case 680:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3481
+//line sql.y:3508
 		{
 			yyLOCAL = IsNotNullOp
 		}
 		yyVAL.union = yyLOCAL
-	case 676:
+	case 681:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3485
+//line sql.y:3512
 		{
 			yyLOCAL = IsTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 677:
+	case 682:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3489
+//line sql.y:3516
 		{
 			yyLOCAL = IsNotTrueOp
 		}
 		yyVAL.union = yyLOCAL
-	case 678:
+	case 683:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3493
+//line sql.y:3520
 		{
 			yyLOCAL = IsFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 679:
+	case 684:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL IsExprOperator
-//line sql.y:3497
+//line sql.y:3524
 		{
 			yyLOCAL = IsNotFalseOp
 		}
 		yyVAL.union = yyLOCAL
-	case 680:
+	case 685:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3503
+//line sql.y:3530
 		{
 			yyLOCAL = EqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 681:
+	case 686:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3507
+//line sql.y:3534
 		{
 			yyLOCAL = LessThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 682:
+	case 687:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3511
+//line sql.y:3538
 		{
 			yyLOCAL = GreaterThanOp
 		}
 		yyVAL.union = yyLOCAL
-	case 683:
+	case 688:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3515
+//line sql.y:3542
 		{
 			yyLOCAL = LessEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 684:
+	case 689:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3519
+//line sql.y:3546
 		{
 			yyLOCAL = GreaterEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 685:
+	case 690:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3523
+//line sql.y:3550
 		{
 			yyLOCAL = NotEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 686:
+	case 691:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ComparisonExprOperator
-//line sql.y:3527
+//line sql.y:3554
 		{
 			yyLOCAL = NullSafeEqualOp
 		}
 		yyVAL.union = yyLOCAL
-	case 687:
+	case 692:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3532
+//line sql.y:3559
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 688:
+	case 693:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3536
+//line sql.y:3563
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 689:
+	case 694:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3542
+//line sql.y:3569
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 690:
+	case 695:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3546
+//line sql.y:3573
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 691:
+	case 696:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ColTuple
-//line sql.y:3550
+//line sql.y:3577
 		{
 			yyLOCAL = ListArg(yyDollar[1].str)
 			bindVariable(yylex, yyDollar[1].str[2:])
 		}
 		yyVAL.union = yyLOCAL
-	case 692:
+	case 697:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *Subquery
-//line sql.y:3557
+//line sql.y:3584
 		{
 			yyLOCAL = &Subquery{yyDollar[2].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 693:
+	case 698:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:3563
+//line sql.y:3590
 		{
 			yyLOCAL = Exprs{yyDollar[1].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 694:
+	case 699:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:3567
+//line sql.y:3594
 		{
 			yySLICE := (*Exprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].exprUnion())
 		}
-	case 695:
+	case 700:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3573
+//line sql.y:3600
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 696:
+	case 701:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3577
+//line sql.y:3604
 		{
 			yyLOCAL = yyDollar[1].boolValUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 697:
+	case 702:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3581
+//line sql.y:3608
 		{
 			yyLOCAL = yyDollar[1].colNameUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 698:
+	case 703:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3585
+//line sql.y:3612
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 699:
+	case 704:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3589
+//line sql.y:3616
 		{
 			yyLOCAL = yyDollar[1].subqueryUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 700:
+	case 705:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3593
+//line sql.y:3620
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitAndOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 701:
+	case 706:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3597
+//line sql.y:3624
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitOrOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 702:
+	case 707:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3601
+//line sql.y:3628
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: BitXorOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 703:
+	case 708:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3605
+//line sql.y:3632
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: PlusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 704:
+	case 709:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3609
+//line sql.y:3636
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MinusOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 705:
+	case 710:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3613
+//line sql.y:3640
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: MultOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 706:
+	case 711:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3617
+//line sql.y:3644
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: DivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 707:
+	case 712:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3621
+//line sql.y:3648
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: IntDivOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 708:
+	case 713:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3625
+//line sql.y:3652
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 709:
+	case 714:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3629
+//line sql.y:3656
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ModOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 710:
+	case 715:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3633
+//line sql.y:3660
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftLeftOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 711:
+	case 716:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3637
+//line sql.y:3664
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].exprUnion(), Operator: ShiftRightOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 712:
+	case 717:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3641
+//line sql.y:3668
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 713:
+	case 718:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3645
+//line sql.y:3672
 		{
 			yyLOCAL = &BinaryExpr{Left: yyDollar[1].colNameUnion(), Operator: JSONUnquoteExtractOp, Right: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 714:
+	case 719:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3649
+//line sql.y:3676
 		{
 			yyLOCAL = &CollateExpr{Expr: yyDollar[1].exprUnion(), Charset: yyDollar[3].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 715:
+	case 720:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3653
+//line sql.y:3680
 		{
 			yyLOCAL = &UnaryExpr{Operator: BinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 716:
+	case 721:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3657
+//line sql.y:3684
 		{
 			yyLOCAL = &UnaryExpr{Operator: UBinaryOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 717:
+	case 722:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3661
+//line sql.y:3688
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 718:
+	case 723:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3665
+//line sql.y:3692
 		{
 			yyLOCAL = &UnaryExpr{Operator: Utf8mb4Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 719:
+	case 724:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3669
+//line sql.y:3696
 		{
 			yyLOCAL = &UnaryExpr{Operator: Latin1Op, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 720:
+	case 725:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3673
+//line sql.y:3700
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 721:
+	case 726:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3677
+//line sql.y:3704
 		{
 			yyLOCAL = handleUnaryMinus(yyDollar[2].exprUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 722:
+	case 727:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3681
+//line sql.y:3708
 		{
 			yyLOCAL = &UnaryExpr{Operator: TildaOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 723:
+	case 728:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3685
+//line sql.y:3712
 		{
 			yyLOCAL = &UnaryExpr{Operator: BangOp, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 724:
+	case 729:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3689
+//line sql.y:3716
 		{
 			// This rule prevents the usage of INTERVAL
 			// as a function. If support is needed for that,
@@ -10967,666 +10971,666 @@ yydefault:
 			yyLOCAL = &IntervalExpr{Expr: yyDollar[2].exprUnion(), Unit: yyDollar[3].colIdent.String()}
 		}
 		yyVAL.union = yyLOCAL
-	case 729:
+	case 734:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3707
+//line sql.y:3734
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 730:
+	case 735:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3711
+//line sql.y:3738
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 731:
+	case 736:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3715
+//line sql.y:3742
 		{
 			yyLOCAL = &FuncExpr{Name: yyDollar[1].colIdent, Distinct: true, Exprs: yyDollar[4].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 732:
+	case 737:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3719
+//line sql.y:3746
 		{
 			yyLOCAL = &FuncExpr{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].colIdent, Exprs: yyDollar[5].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 733:
+	case 738:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3729
+//line sql.y:3756
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("left"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 734:
+	case 739:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3733
+//line sql.y:3760
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("right"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 735:
+	case 740:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3737
+//line sql.y:3764
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 736:
+	case 741:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3741
+//line sql.y:3768
 		{
 			yyLOCAL = &ConvertExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].convertTypeUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 737:
+	case 742:
 		yyDollar = yyS[yypt-6 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3745
+//line sql.y:3772
 		{
 			yyLOCAL = &ConvertUsingExpr{Expr: yyDollar[3].exprUnion(), Type: yyDollar[5].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 738:
+	case 743:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3749
+//line sql.y:3776
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 739:
+	case 744:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3753
+//line sql.y:3780
 		{
 			yyLOCAL = &SubstrExpr{Name: yyDollar[3].colNameUnion(), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 740:
+	case 745:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3757
+//line sql.y:3784
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 741:
+	case 746:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3761
+//line sql.y:3788
 		{
 			yyLOCAL = &SubstrExpr{StrVal: NewStrLiteral(yyDollar[3].str), From: yyDollar[5].exprUnion(), To: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 742:
+	case 747:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3765
+//line sql.y:3792
 		{
 			yyLOCAL = &MatchExpr{Columns: yyDollar[3].selectExprsUnion(), Expr: yyDollar[7].exprUnion(), Option: yyDollar[8].matchExprOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 743:
+	case 748:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3769
+//line sql.y:3796
 		{
 			yyLOCAL = &GroupConcatExpr{Distinct: yyDollar[3].booleanUnion(), Exprs: yyDollar[4].selectExprsUnion(), OrderBy: yyDollar[5].orderByUnion(), Separator: yyDollar[6].str, Limit: yyDollar[7].limitUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 744:
+	case 749:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3773
+//line sql.y:3800
 		{
 			yyLOCAL = &CaseExpr{Expr: yyDollar[2].exprUnion(), Whens: yyDollar[3].whensUnion(), Else: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 745:
+	case 750:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3777
+//line sql.y:3804
 		{
 			yyLOCAL = &ValuesFuncExpr{Name: yyDollar[3].colNameUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 746:
+	case 751:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3781
+//line sql.y:3808
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 747:
+	case 752:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3791
+//line sql.y:3818
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 748:
+	case 753:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3795
+//line sql.y:3822
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_timestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 749:
+	case 754:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3799
+//line sql.y:3826
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 750:
+	case 755:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3804
+//line sql.y:3831
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("utc_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 751:
+	case 756:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3809
+//line sql.y:3836
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtime")}
 		}
 		yyVAL.union = yyLOCAL
-	case 752:
+	case 757:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3814
+//line sql.y:3841
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("localtimestamp")}
 		}
 		yyVAL.union = yyLOCAL
-	case 753:
+	case 758:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3820
+//line sql.y:3847
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_date")}
 		}
 		yyVAL.union = yyLOCAL
-	case 754:
+	case 759:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3825
+//line sql.y:3852
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("current_time")}
 		}
 		yyVAL.union = yyLOCAL
-	case 755:
+	case 760:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3830
+//line sql.y:3857
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 756:
+	case 761:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3834
+//line sql.y:3861
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_timestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 757:
+	case 762:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3838
+//line sql.y:3865
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("utc_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 758:
+	case 763:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3843
+//line sql.y:3870
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtime"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 759:
+	case 764:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3848
+//line sql.y:3875
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("localtimestamp"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 760:
+	case 765:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3853
+//line sql.y:3880
 		{
 			yyLOCAL = &CurTimeFuncExpr{Name: NewColIdent("current_time"), Fsp: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 761:
+	case 766:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3857
+//line sql.y:3884
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampadd"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 762:
+	case 767:
 		yyDollar = yyS[yypt-8 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3861
+//line sql.y:3888
 		{
 			yyLOCAL = &TimestampFuncExpr{Name: string("timestampdiff"), Unit: yyDollar[3].colIdent.String(), Expr1: yyDollar[5].exprUnion(), Expr2: yyDollar[7].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 765:
+	case 770:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3871
+//line sql.y:3898
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 766:
+	case 771:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3881
+//line sql.y:3908
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("if"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 767:
+	case 772:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3885
+//line sql.y:3912
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("database"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 768:
+	case 773:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3889
+//line sql.y:3916
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("schema"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 769:
+	case 774:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3893
+//line sql.y:3920
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("mod"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 770:
+	case 775:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3897
+//line sql.y:3924
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("replace"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 771:
+	case 776:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3901
+//line sql.y:3928
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 772:
+	case 777:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:3905
+//line sql.y:3932
 		{
 			yyLOCAL = &FuncExpr{Name: NewColIdent("substr"), Exprs: yyDollar[3].selectExprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 773:
+	case 778:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3911
+//line sql.y:3938
 		{
 			yyLOCAL = NoOption
 		}
 		yyVAL.union = yyLOCAL
-	case 774:
+	case 779:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3915
+//line sql.y:3942
 		{
 			yyLOCAL = BooleanModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 775:
+	case 780:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3919
+//line sql.y:3946
 		{
 			yyLOCAL = NaturalLanguageModeOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 776:
+	case 781:
 		yyDollar = yyS[yypt-7 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3923
+//line sql.y:3950
 		{
 			yyLOCAL = NaturalLanguageModeWithQueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 777:
+	case 782:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL MatchExprOption
-//line sql.y:3927
+//line sql.y:3954
 		{
 			yyLOCAL = QueryExpansionOpt
 		}
 		yyVAL.union = yyLOCAL
-	case 778:
+	case 783:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3933
+//line sql.y:3960
 		{
 			yyVAL.str = string(yyDollar[1].colIdent.String())
 		}
-	case 779:
+	case 784:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3937
+//line sql.y:3964
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 780:
+	case 785:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:3941
+//line sql.y:3968
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 781:
+	case 786:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3947
+//line sql.y:3974
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 782:
+	case 787:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3951
+//line sql.y:3978
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: yyDollar[3].str, Operator: CharacterSetOp}
 		}
 		yyVAL.union = yyLOCAL
-	case 783:
+	case 788:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3955
+//line sql.y:3982
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion(), Charset: string(yyDollar[3].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 784:
+	case 789:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3959
+//line sql.y:3986
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 785:
+	case 790:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3963
+//line sql.y:3990
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 786:
+	case 791:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3967
+//line sql.y:3994
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 			yyLOCAL.Length = yyDollar[2].LengthScaleOption.Length
 			yyLOCAL.Scale = yyDollar[2].LengthScaleOption.Scale
 		}
 		yyVAL.union = yyLOCAL
-	case 787:
+	case 792:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3973
+//line sql.y:4000
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 788:
+	case 793:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3977
+//line sql.y:4004
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 789:
+	case 794:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3981
+//line sql.y:4008
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 790:
+	case 795:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3985
+//line sql.y:4012
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 791:
+	case 796:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3989
+//line sql.y:4016
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str), Length: yyDollar[2].literalUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 792:
+	case 797:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3993
+//line sql.y:4020
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 793:
+	case 798:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *ConvertType
-//line sql.y:3997
+//line sql.y:4024
 		{
 			yyLOCAL = &ConvertType{Type: string(yyDollar[1].str)}
 		}
 		yyVAL.union = yyLOCAL
-	case 794:
+	case 799:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4002
+//line sql.y:4029
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 795:
+	case 800:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4006
+//line sql.y:4033
 		{
 			yyLOCAL = yyDollar[1].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 796:
+	case 801:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4011
+//line sql.y:4038
 		{
 			yyVAL.str = string("")
 		}
-	case 797:
+	case 802:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4015
+//line sql.y:4042
 		{
 			yyVAL.str = " separator " + encodeSQLString(yyDollar[2].str)
 		}
-	case 798:
+	case 803:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*When
-//line sql.y:4021
+//line sql.y:4048
 		{
 			yyLOCAL = []*When{yyDollar[1].whenUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 799:
+	case 804:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4025
+//line sql.y:4052
 		{
 			yySLICE := (*[]*When)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[2].whenUnion())
 		}
-	case 800:
+	case 805:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *When
-//line sql.y:4031
+//line sql.y:4058
 		{
 			yyLOCAL = &When{Cond: yyDollar[2].exprUnion(), Val: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 801:
+	case 806:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4036
+//line sql.y:4063
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 802:
+	case 807:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4040
+//line sql.y:4067
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 803:
+	case 808:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4046
+//line sql.y:4073
 		{
 			yyLOCAL = &ColName{Name: yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 804:
+	case 809:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4050
+//line sql.y:4077
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Name: yyDollar[1].tableIdent}, Name: yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 805:
+	case 810:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *ColName
-//line sql.y:4054
+//line sql.y:4081
 		{
 			yyLOCAL = &ColName{Qualifier: TableName{Qualifier: yyDollar[1].tableIdent, Name: yyDollar[3].tableIdent}, Name: yyDollar[5].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 806:
+	case 811:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4060
+//line sql.y:4087
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 807:
+	case 812:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4064
+//line sql.y:4091
 		{
 			yyLOCAL = NewHexLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 808:
+	case 813:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4068
+//line sql.y:4095
 		{
 			yyLOCAL = NewBitLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 809:
+	case 814:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4072
+//line sql.y:4099
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 810:
+	case 815:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4076
+//line sql.y:4103
 		{
 			yyLOCAL = NewFloatLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 811:
+	case 816:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4080
+//line sql.y:4107
 		{
 			yyLOCAL = NewHexNumLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 812:
+	case 817:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4084
+//line sql.y:4111
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str)
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 813:
+	case 818:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4089
+//line sql.y:4116
 		{
 			yyLOCAL = &NullVal{}
 		}
 		yyVAL.union = yyLOCAL
-	case 814:
+	case 819:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4095
+//line sql.y:4122
 		{
 			// TODO(sougou): Deprecate this construct.
 			if yyDollar[1].colIdent.Lowered() != "value" {
@@ -11636,707 +11640,707 @@ yydefault:
 			yyLOCAL = NewIntLiteral("1")
 		}
 		yyVAL.union = yyLOCAL
-	case 815:
+	case 820:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4104
+//line sql.y:4131
 		{
 			yyLOCAL = NewIntLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 816:
+	case 821:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4108
+//line sql.y:4135
 		{
 			yyLOCAL = NewArgument(yyDollar[1].str)
 			bindVariable(yylex, yyDollar[1].str[1:])
 		}
 		yyVAL.union = yyLOCAL
-	case 817:
+	case 822:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4114
+//line sql.y:4141
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 818:
+	case 823:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4118
+//line sql.y:4145
 		{
 			yyLOCAL = yyDollar[3].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 819:
+	case 824:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4123
+//line sql.y:4150
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 820:
+	case 825:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4127
+//line sql.y:4154
 		{
 			yyLOCAL = yyDollar[2].exprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 821:
+	case 826:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4132
+//line sql.y:4159
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 822:
+	case 827:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4136
+//line sql.y:4163
 		{
 			yyLOCAL = yyDollar[3].orderByUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 823:
+	case 828:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderBy
-//line sql.y:4142
+//line sql.y:4169
 		{
 			yyLOCAL = OrderBy{yyDollar[1].orderUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 824:
+	case 829:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4146
+//line sql.y:4173
 		{
 			yySLICE := (*OrderBy)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].orderUnion())
 		}
-	case 825:
+	case 830:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Order
-//line sql.y:4152
+//line sql.y:4179
 		{
 			yyLOCAL = &Order{Expr: yyDollar[1].exprUnion(), Direction: yyDollar[2].orderDirectionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 826:
+	case 831:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4157
+//line sql.y:4184
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 827:
+	case 832:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4161
+//line sql.y:4188
 		{
 			yyLOCAL = AscOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 828:
+	case 833:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL OrderDirection
-//line sql.y:4165
+//line sql.y:4192
 		{
 			yyLOCAL = DescOrder
 		}
 		yyVAL.union = yyLOCAL
-	case 829:
+	case 834:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4170
+//line sql.y:4197
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 830:
+	case 835:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4174
+//line sql.y:4201
 		{
 			yyLOCAL = &Limit{Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 831:
+	case 836:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4178
+//line sql.y:4205
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[2].exprUnion(), Rowcount: yyDollar[4].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 832:
+	case 837:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Limit
-//line sql.y:4182
+//line sql.y:4209
 		{
 			yyLOCAL = &Limit{Offset: yyDollar[4].exprUnion(), Rowcount: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 833:
+	case 838:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4187
+//line sql.y:4214
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 834:
+	case 839:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4191
+//line sql.y:4218
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 835:
+	case 840:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4195
+//line sql.y:4222
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion(), yyDollar[2].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 836:
+	case 841:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4199
+//line sql.y:4226
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 837:
+	case 842:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []AlterOption
-//line sql.y:4203
+//line sql.y:4230
 		{
 			yyLOCAL = []AlterOption{yyDollar[1].alterOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 838:
+	case 843:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4210
+//line sql.y:4237
 		{
 			yyLOCAL = &LockOption{Type: DefaultType}
 		}
 		yyVAL.union = yyLOCAL
-	case 839:
+	case 844:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4214
+//line sql.y:4241
 		{
 			yyLOCAL = &LockOption{Type: NoneType}
 		}
 		yyVAL.union = yyLOCAL
-	case 840:
+	case 845:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4218
+//line sql.y:4245
 		{
 			yyLOCAL = &LockOption{Type: SharedType}
 		}
 		yyVAL.union = yyLOCAL
-	case 841:
+	case 846:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4222
+//line sql.y:4249
 		{
 			yyLOCAL = &LockOption{Type: ExclusiveType}
 		}
 		yyVAL.union = yyLOCAL
-	case 842:
+	case 847:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4228
+//line sql.y:4255
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 843:
+	case 848:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4232
+//line sql.y:4259
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 844:
+	case 849:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL AlterOption
-//line sql.y:4236
+//line sql.y:4263
 		{
 			yyLOCAL = AlgorithmValue(yyDollar[3].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 845:
+	case 850:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4241
+//line sql.y:4268
 		{
 			yyVAL.str = ""
 		}
-	case 846:
+	case 851:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4245
+//line sql.y:4272
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 847:
+	case 852:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4249
+//line sql.y:4276
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 848:
+	case 853:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4253
+//line sql.y:4280
 		{
 			yyVAL.str = string(yyDollar[3].str)
 		}
-	case 849:
+	case 854:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4258
+//line sql.y:4285
 		{
 			yyVAL.str = ""
 		}
-	case 850:
+	case 855:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4262
+//line sql.y:4289
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 851:
+	case 856:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4268
+//line sql.y:4295
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 852:
+	case 857:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4272
+//line sql.y:4299
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 853:
+	case 858:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4277
+//line sql.y:4304
 		{
 			yyVAL.str = ""
 		}
-	case 854:
+	case 859:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4281
+//line sql.y:4308
 		{
 			yyVAL.str = yyDollar[2].str
 		}
-	case 855:
+	case 860:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4286
+//line sql.y:4313
 		{
 			yyVAL.str = "cascaded"
 		}
-	case 856:
+	case 861:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4290
+//line sql.y:4317
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 857:
+	case 862:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4294
+//line sql.y:4321
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 858:
+	case 863:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4299
+//line sql.y:4326
 		{
 			yyVAL.str = ""
 		}
-	case 859:
+	case 864:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4303
+//line sql.y:4330
 		{
 			yyVAL.str = yyDollar[3].str
 		}
-	case 860:
+	case 865:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4309
+//line sql.y:4336
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 861:
+	case 866:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4313
+//line sql.y:4340
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 862:
+	case 867:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4317
+//line sql.y:4344
 		{
 			yyVAL.str = encodeSQLString(yyDollar[1].str) + "@" + string(yyDollar[2].str)
 		}
-	case 863:
+	case 868:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4321
+//line sql.y:4348
 		{
 			yyVAL.str = string(yyDollar[1].str)
 		}
-	case 864:
+	case 869:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4326
+//line sql.y:4353
 		{
 			yyLOCAL = NoLock
 		}
 		yyVAL.union = yyLOCAL
-	case 865:
+	case 870:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4330
+//line sql.y:4357
 		{
 			yyLOCAL = ForUpdateLock
 		}
 		yyVAL.union = yyLOCAL
-	case 866:
+	case 871:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL Lock
-//line sql.y:4334
+//line sql.y:4361
 		{
 			yyLOCAL = ShareModeLock
 		}
 		yyVAL.union = yyLOCAL
-	case 867:
+	case 872:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4339
+//line sql.y:4366
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 868:
+	case 873:
 		yyDollar = yyS[yypt-9 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4343
+//line sql.y:4370
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfileS3, FileName: encodeSQLString(yyDollar[4].str), Charset: yyDollar[5].str, FormatOption: yyDollar[6].str, ExportOption: yyDollar[7].str, Manifest: yyDollar[8].str, Overwrite: yyDollar[9].str}
 		}
 		yyVAL.union = yyLOCAL
-	case 869:
+	case 874:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4347
+//line sql.y:4374
 		{
 			yyLOCAL = &SelectInto{Type: IntoDumpfile, FileName: encodeSQLString(yyDollar[3].str), Charset: "", FormatOption: "", ExportOption: "", Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 870:
+	case 875:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *SelectInto
-//line sql.y:4351
+//line sql.y:4378
 		{
 			yyLOCAL = &SelectInto{Type: IntoOutfile, FileName: encodeSQLString(yyDollar[3].str), Charset: yyDollar[4].str, FormatOption: "", ExportOption: yyDollar[5].str, Manifest: "", Overwrite: ""}
 		}
 		yyVAL.union = yyLOCAL
-	case 871:
+	case 876:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4356
+//line sql.y:4383
 		{
 			yyVAL.str = ""
 		}
-	case 872:
+	case 877:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4360
+//line sql.y:4387
 		{
 			yyVAL.str = " format csv" + yyDollar[3].str
 		}
-	case 873:
+	case 878:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4364
+//line sql.y:4391
 		{
 			yyVAL.str = " format text" + yyDollar[3].str
 		}
-	case 874:
+	case 879:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4369
+//line sql.y:4396
 		{
 			yyVAL.str = ""
 		}
-	case 875:
+	case 880:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4373
+//line sql.y:4400
 		{
 			yyVAL.str = " header"
 		}
-	case 876:
+	case 881:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4378
+//line sql.y:4405
 		{
 			yyVAL.str = ""
 		}
-	case 877:
+	case 882:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4382
+//line sql.y:4409
 		{
 			yyVAL.str = " manifest on"
 		}
-	case 878:
+	case 883:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4386
+//line sql.y:4413
 		{
 			yyVAL.str = " manifest off"
 		}
-	case 879:
+	case 884:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4391
+//line sql.y:4418
 		{
 			yyVAL.str = ""
 		}
-	case 880:
+	case 885:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4395
+//line sql.y:4422
 		{
 			yyVAL.str = " overwrite on"
 		}
-	case 881:
+	case 886:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4399
+//line sql.y:4426
 		{
 			yyVAL.str = " overwrite off"
 		}
-	case 882:
+	case 887:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4405
+//line sql.y:4432
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 883:
+	case 888:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4410
+//line sql.y:4437
 		{
 			yyVAL.str = ""
 		}
-	case 884:
+	case 889:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4414
+//line sql.y:4441
 		{
 			yyVAL.str = " lines" + yyDollar[2].str
 		}
-	case 885:
+	case 890:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4420
+//line sql.y:4447
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 886:
+	case 891:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4424
+//line sql.y:4451
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 887:
+	case 892:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4430
+//line sql.y:4457
 		{
 			yyVAL.str = " starting by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 888:
+	case 893:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4434
+//line sql.y:4461
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 889:
+	case 894:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4439
+//line sql.y:4466
 		{
 			yyVAL.str = ""
 		}
-	case 890:
+	case 895:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4443
+//line sql.y:4470
 		{
 			yyVAL.str = " " + yyDollar[1].str + yyDollar[2].str
 		}
-	case 891:
+	case 896:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4449
+//line sql.y:4476
 		{
 			yyVAL.str = yyDollar[1].str
 		}
-	case 892:
+	case 897:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4453
+//line sql.y:4480
 		{
 			yyVAL.str = yyDollar[1].str + yyDollar[2].str
 		}
-	case 893:
+	case 898:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4459
+//line sql.y:4486
 		{
 			yyVAL.str = " terminated by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 894:
+	case 899:
 		yyDollar = yyS[yypt-4 : yypt+1]
-//line sql.y:4463
+//line sql.y:4490
 		{
 			yyVAL.str = yyDollar[1].str + " enclosed by " + encodeSQLString(yyDollar[4].str)
 		}
-	case 895:
+	case 900:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4467
+//line sql.y:4494
 		{
 			yyVAL.str = " escaped by " + encodeSQLString(yyDollar[3].str)
 		}
-	case 896:
+	case 901:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4472
+//line sql.y:4499
 		{
 			yyVAL.str = ""
 		}
-	case 897:
+	case 902:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4476
+//line sql.y:4503
 		{
 			yyVAL.str = " optionally"
 		}
-	case 898:
+	case 903:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4489
+//line sql.y:4516
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[2].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 899:
+	case 904:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4493
+//line sql.y:4520
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[1].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 900:
+	case 905:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4497
+//line sql.y:4524
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[5].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 901:
+	case 906:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4501
+//line sql.y:4528
 		{
 			yyLOCAL = &Insert{Rows: yyDollar[4].valuesUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 902:
+	case 907:
 		yyDollar = yyS[yypt-4 : yypt+1]
 		var yyLOCAL *Insert
-//line sql.y:4505
+//line sql.y:4532
 		{
 			yyLOCAL = &Insert{Columns: yyDollar[2].columnsUnion(), Rows: yyDollar[4].selStmtUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 903:
+	case 908:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4511
+//line sql.y:4538
 		{
 			yyLOCAL = Columns{yyDollar[1].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 904:
+	case 909:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL Columns
-//line sql.y:4515
+//line sql.y:4542
 		{
 			yyLOCAL = Columns{yyDollar[3].colIdent}
 		}
 		yyVAL.union = yyLOCAL
-	case 905:
+	case 910:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4519
+//line sql.y:4546
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].colIdent)
 		}
-	case 906:
+	case 911:
 		yyDollar = yyS[yypt-5 : yypt+1]
-//line sql.y:4523
+//line sql.y:4550
 		{
 			yySLICE := (*Columns)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[5].colIdent)
 		}
-	case 907:
+	case 912:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4528
+//line sql.y:4555
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 908:
+	case 913:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4532
+//line sql.y:4559
 		{
 			yyLOCAL = yyDollar[5].updateExprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 909:
+	case 914:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Values
-//line sql.y:4538
+//line sql.y:4565
 		{
 			yyLOCAL = Values{yyDollar[1].valTupleUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 910:
+	case 915:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4542
+//line sql.y:4569
 		{
 			yySLICE := (*Values)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].valTupleUnion())
 		}
-	case 911:
+	case 916:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4548
+//line sql.y:4575
 		{
 			yyLOCAL = yyDollar[1].valTupleUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 912:
+	case 917:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4552
+//line sql.y:4579
 		{
 			yyLOCAL = ValTuple{}
 		}
 		yyVAL.union = yyLOCAL
-	case 913:
+	case 918:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL ValTuple
-//line sql.y:4558
+//line sql.y:4585
 		{
 			yyLOCAL = ValTuple(yyDollar[2].exprsUnion())
 		}
 		yyVAL.union = yyLOCAL
-	case 914:
+	case 919:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4564
+//line sql.y:4591
 		{
 			if len(yyDollar[1].valTupleUnion()) == 1 {
 				yyLOCAL = yyDollar[1].valTupleUnion()[0]
@@ -12345,329 +12349,329 @@ yydefault:
 			}
 		}
 		yyVAL.union = yyLOCAL
-	case 915:
+	case 920:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL UpdateExprs
-//line sql.y:4574
+//line sql.y:4601
 		{
 			yyLOCAL = UpdateExprs{yyDollar[1].updateExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 916:
+	case 921:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4578
+//line sql.y:4605
 		{
 			yySLICE := (*UpdateExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].updateExprUnion())
 		}
-	case 917:
+	case 922:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *UpdateExpr
-//line sql.y:4584
+//line sql.y:4611
 		{
 			yyLOCAL = &UpdateExpr{Name: yyDollar[1].colNameUnion(), Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 918:
+	case 923:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL SetExprs
-//line sql.y:4590
+//line sql.y:4617
 		{
 			yyLOCAL = SetExprs{yyDollar[1].setExprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 919:
+	case 924:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line sql.y:4594
+//line sql.y:4621
 		{
 			yySLICE := (*SetExprs)(yyIaddr(yyVAL.union))
 			*yySLICE = append(*yySLICE, yyDollar[3].setExprUnion())
 		}
-	case 920:
+	case 925:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4600
+//line sql.y:4627
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("on")}
 		}
 		yyVAL.union = yyLOCAL
-	case 921:
+	case 926:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4604
+//line sql.y:4631
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: NewStrLiteral("off")}
 		}
 		yyVAL.union = yyLOCAL
-	case 922:
+	case 927:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4608
+//line sql.y:4635
 		{
 			yyLOCAL = &SetExpr{Name: yyDollar[1].colIdent, Scope: ImplicitScope, Expr: yyDollar[3].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 923:
+	case 928:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4612
+//line sql.y:4639
 		{
 			yyLOCAL = &SetExpr{Name: NewColIdent(string(yyDollar[1].str)), Scope: ImplicitScope, Expr: yyDollar[2].exprUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 924:
+	case 929:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *SetExpr
-//line sql.y:4616
+//line sql.y:4643
 		{
 			yyDollar[2].setExprUnion().Scope = yyDollar[1].scopeUnion()
 			yyLOCAL = yyDollar[2].setExprUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 926:
+	case 931:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line sql.y:4624
+//line sql.y:4651
 		{
 			yyVAL.str = "charset"
 		}
-	case 929:
+	case 934:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4634
+//line sql.y:4661
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].colIdent.String())
 		}
 		yyVAL.union = yyLOCAL
-	case 930:
+	case 935:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4638
+//line sql.y:4665
 		{
 			yyLOCAL = NewStrLiteral(yyDollar[1].str)
 		}
 		yyVAL.union = yyLOCAL
-	case 931:
+	case 936:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Expr
-//line sql.y:4642
+//line sql.y:4669
 		{
 			yyLOCAL = &Default{}
 		}
 		yyVAL.union = yyLOCAL
-	case 934:
+	case 939:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4651
+//line sql.y:4678
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 935:
+	case 940:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4653
+//line sql.y:4680
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 936:
+	case 941:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4656
+//line sql.y:4683
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 937:
+	case 942:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4658
+//line sql.y:4685
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 938:
+	case 943:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4661
+//line sql.y:4688
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 939:
+	case 944:
 		yyDollar = yyS[yypt-3 : yypt+1]
 		var yyLOCAL bool
-//line sql.y:4663
+//line sql.y:4690
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 940:
+	case 945:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4666
+//line sql.y:4693
 		{
 			yyLOCAL = false
 		}
 		yyVAL.union = yyLOCAL
-	case 941:
+	case 946:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Ignore
-//line sql.y:4668
+//line sql.y:4695
 		{
 			yyLOCAL = true
 		}
 		yyVAL.union = yyLOCAL
-	case 942:
+	case 947:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4671
+//line sql.y:4698
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 943:
+	case 948:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4673
+//line sql.y:4700
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 944:
+	case 949:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4675
+//line sql.y:4702
 		{
 			yyVAL.empty = struct{}{}
 		}
-	case 945:
+	case 950:
 		yyDollar = yyS[yypt-5 : yypt+1]
 		var yyLOCAL Statement
-//line sql.y:4679
+//line sql.y:4706
 		{
 			yyLOCAL = &CallProc{Name: yyDollar[2].tableName, Params: yyDollar[4].exprsUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 946:
+	case 951:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4684
+//line sql.y:4711
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 947:
+	case 952:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL Exprs
-//line sql.y:4688
+//line sql.y:4715
 		{
 			yyLOCAL = yyDollar[1].exprsUnion()
 		}
 		yyVAL.union = yyLOCAL
-	case 948:
+	case 953:
 		yyDollar = yyS[yypt-0 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4693
+//line sql.y:4720
 		{
 			yyLOCAL = nil
 		}
 		yyVAL.union = yyLOCAL
-	case 949:
+	case 954:
 		yyDollar = yyS[yypt-1 : yypt+1]
 		var yyLOCAL []*IndexOption
-//line sql.y:4695
+//line sql.y:4722
 		{
 			yyLOCAL = []*IndexOption{yyDollar[1].indexOptionUnion()}
 		}
 		yyVAL.union = yyLOCAL
-	case 950:
+	case 955:
 		yyDollar = yyS[yypt-2 : yypt+1]
 		var yyLOCAL *IndexOption
-//line sql.y:4699
+//line sql.y:4726
 		{
 			yyLOCAL = &IndexOption{Name: string(yyDollar[1].str), String: string(yyDollar[2].colIdent.String())}
 		}
 		yyVAL.union = yyLOCAL
-	case 951:
+	case 956:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4705
+//line sql.y:4732
 		{
 			yyVAL.colIdent = yyDollar[1].colIdent
 		}
-	case 952:
+	case 957:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4709
+//line sql.y:4736
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 954:
+	case 959:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4716
+//line sql.y:4743
 		{
 			yyVAL.colIdent = NewColIdent(string(yyDollar[1].str))
 		}
-	case 955:
+	case 960:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4722
+//line sql.y:4749
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].colIdent.String()))
 		}
-	case 956:
+	case 961:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4726
+//line sql.y:4753
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 957:
+	case 962:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:4731
+//line sql.y:4758
 		{
 			yyVAL.tableIdent = NewTableIdent("")
 		}
-	case 958:
+	case 963:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4735
+//line sql.y:4762
 		{
 			yyVAL.tableIdent = yyDollar[1].tableIdent
 		}
-	case 960:
+	case 965:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:4742
+//line sql.y:4769
 		{
 			yyVAL.tableIdent = NewTableIdent(string(yyDollar[1].str))
 		}
-	case 1364:
+	case 1369:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5170
+//line sql.y:5197
 		{
 			if incNesting(yylex) {
 				yylex.Error("max nesting level reached")
 				return 1
 			}
 		}
-	case 1365:
+	case 1370:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5179
+//line sql.y:5206
 		{
 			decNesting(yylex)
 		}
-	case 1366:
+	case 1371:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5184
+//line sql.y:5211
 		{
 			skipToEnd(yylex)
 		}
-	case 1367:
+	case 1372:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line sql.y:5189
+//line sql.y:5216
 		{
 			skipToEnd(yylex)
 		}
-	case 1368:
+	case 1373:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5193
+//line sql.y:5220
 		{
 			skipToEnd(yylex)
 		}
-	case 1369:
+	case 1374:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line sql.y:5197
+//line sql.y:5224
 		{
 			skipToEnd(yylex)
 		}
@@ -153,6 +153,7 @@ func bindVariable(yylex yyLexer, bvar string) {
   orderDirection  OrderDirection
   explainType 	  ExplainType
   lockType LockType
+  killType KillType
 
   boolean bool
   boolVal BoolVal
@@ -162,7 +163,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %token LEX_ERROR
 %left <str> UNION
 %token <str> SELECT STREAM VSTREAM INSERT UPDATE DELETE FROM WHERE GROUP HAVING ORDER BY LIMIT OFFSET FOR
-%token <str> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK UNLOCK KEYS DO CALL
+%token <str> ALL DISTINCT AS EXISTS ASC DESC INTO DUPLICATE KEY DEFAULT SET LOCK UNLOCK KEYS DO CALL KILL
 %token <str> DISTINCTROW PARSER
 %token <str> OUTFILE S3 DATA LOAD LINES TERMINATED ESCAPED ENCLOSED
 %token <str> DUMPFILE CSV HEADER MANIFEST OVERWRITE STARTING OPTIONALLY
@@ -291,7 +292,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <collateAndCharset> collate character_set
 %type <collateAndCharsets> create_options create_options_opt
 %type <boolean> default_optional
-%type <statement> analyze_statement show_statement use_statement other_statement
+%type <statement> analyze_statement show_statement use_statement other_statement kill_statement
 %type <statement> begin_statement commit_statement rollback_statement savepoint_statement release_statement load_statement
 %type <statement> lock_statement unlock_statement call_statement
 %type <statement> revert_statement
@@ -412,6 +413,7 @@ func bindVariable(yylex yyLexer, bvar string) {
 %type <tableAndLockTypes> lock_table_list
 %type <tableAndLockType> lock_table
 %type <lockType> lock_type
+%type <killType> kill_type_opt
 %type <empty> session_or_local_opt
 
 
@@ -463,6 +465,7 @@ command:
 | unlock_statement
 | call_statement
 | revert_statement
+| kill_statement
 | /*empty*/
 {
   setParseTree(yylex, nil)
@@ -2355,9 +2358,9 @@ truncate_statement:
     $$ = &TruncateTable{Table: $2}
   }
 analyze_statement:
-  ANALYZE TABLE table_name
+  ANALYZE local_opt TABLE table_name_list
   {
-    $$ = &OtherRead{}
+    $$ = &AnalyzeTable{IsLocal: $2, Tables: $4}
   }
 
 show_statement:
@@ -2495,7 +2498,7 @@ show_statement:
   }
 | SHOW full_opt PROCESSLIST from_database_opt like_or_where_opt
   {
-      $$ = &Show{&ShowLegacy{Type: string($3), Scope: ImplicitScope}}
+    $$ = &Show{&ShowLegacy{Type: string($3), Scope: ImplicitScope, Full: $2}}
   }
 | SHOW VITESS_METADATA VARIABLES like_opt
   {
@@ -2843,6 +2846,30 @@ revert_statement:
     $$ = &RevertMigration{UUID: string($3)}
   }
 
+kill_statement:
+  KILL kill_type_opt INTEGRAL
+  {
+    id, err := parseProcesslistID($3)
+    if err != nil {
+      yylex.Error(err.Error())
+      return 1
+    }
+    $$ = &Kill{Type: $2, ProcesslistID: id}
+  }
+
+kill_type_opt:
+  {
+    $$ = ConnectionKill
+  }
+| CONNECTION
+  {
+    $$ = ConnectionKill
+  }
+| QUERY
+  {
+    $$ = QueryKill
+  }
+
 flush_statement:
   FLUSH local_opt flush_option_list
   {
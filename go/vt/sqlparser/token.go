@@ -44,6 +44,8 @@ type Tokenizer struct {
 	nesting        int
 	multi          bool
 	specialComment *Tokenizer
+	// afterUse is true right after the USE keyword was scanned.
+	afterUse bool
 
 	Pos int
 	buf string
@@ -123,6 +125,12 @@ func (tkn *Tokenizer) Scan() (int, string) {
 	}
 
 	tkn.skipBlank()
+	if tkn.afterUse {
+		tkn.afterUse = false
+		if target, ok := tkn.scanUseTarget(); ok {
+			return ID, target
+		}
+	}
 	switch ch := tkn.cur(); {
 	case ch == '@':
 		tokenID := AT_ID
@@ -309,6 +317,14 @@ func (tkn *Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 
 	for {
 		ch := tkn.cur()
+		if ch == '[' && !isVariable {
+			// A keyspace with a key range or keyspace id destination
+			// e.g. ks[-80] in ks[-80].t.
+			if !tkn.skipKeyRange() {
+				break
+			}
+			continue
+		}
 		if !isLetter(ch) && !isDigit(ch) && ch != '@' && !(isVariable && isCarat(ch)) {
 			break
 		}
@@ -319,6 +335,9 @@ func (tkn *Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 	}
 	keywordName := tkn.buf[start:tkn.Pos]
 	if keywordID, found := keywordLookupTable.LookupString(keywordName); found {
+		if keywordID == USE {
+			tkn.afterUse = true
+		}
 		return keywordID, keywordName
 	}
 	// dual must always be case-insensitive
@@ -328,6 +347,48 @@ func (tkn *Tokenizer) scanIdentifier(isVariable bool) (int, string) {
 	return ID, keywordName
 }
 
+// skipKeyRange skips a key range or keyspace id between square brackets,
+// e.g. [-80] or [80-c0] or [deadbeef]. It returns false and does not move
+// the cursor if there is none.
+func (tkn *Tokenizer) skipKeyRange() bool {
+	dist := 1
+	for ch := tkn.peek(dist); ch != ']'; ch = tkn.peek(dist) {
+		if digitVal(ch) == 16 && ch != '-' {
+			return false
+		}
+		dist++
+	}
+	if dist == 1 {
+		return false
+	}
+	tkn.skip(dist + 1)
+	return true
+}
+
+// scanUseTarget scans the target of a USE statement which is not quoted,
+// e.g. ks:-80@replica. It returns false and does not move the cursor if the
+// next word is not a target with a shard.
+func (tkn *Tokenizer) scanUseTarget() (string, bool) {
+	end := tkn.Pos
+	hasShard := false
+	for ; end < len(tkn.buf); end++ {
+		ch := uint16(tkn.buf[end])
+		if ch == ':' {
+			hasShard = true
+			continue
+		}
+		if !isLetter(ch) && !isDigit(ch) && ch != '-' && ch != '@' && ch != '[' && ch != ']' {
+			break
+		}
+	}
+	if !hasShard {
+		return "", false
+	}
+	target := tkn.buf[tkn.Pos:end]
+	tkn.Pos = end
+	return target, true
+}
+
 // scanHex scans a hex numeral; assumes x' or X' has already been scanned
 func (tkn *Tokenizer) scanHex() (int, string) {
 	start := tkn.Pos
@@ -667,6 +728,7 @@ func (tkn *Tokenizer) reset() {
 	tkn.posVarIndex = 0
 	tkn.nesting = 0
 	tkn.SkipToEnd = false
+	tkn.afterUse = false
 }
 
 func isLetter(ch uint16) bool {
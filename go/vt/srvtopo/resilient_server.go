@@ -185,6 +185,11 @@ type srvKeyspaceEntry struct {
 	value     *topodatapb.SrvKeyspace
 	lastError error
 
+	// version is the version of value. The watch resumes from it when
+	// it is restarted, so the changes made while it was down are not
+	// missed.
+	version topo.Version
+
 	// lastValueTime is the time when the cached value is known to be valid,
 	// either because the watch last obtained a non-nil value or when a
 	// running watch first got an error.
@@ -408,7 +413,7 @@ func (server *ResilientServer) GetSrvKeyspace(ctx context.Context, cell, keyspac
 	if shouldRefresh && (entry.watchState == watchStateIdle) {
 		entry.watchState = watchStateStarting
 		entry.watchStartingChan = make(chan struct{})
-		go server.watchSrvKeyspace(ctx, entry, cell, keyspace)
+		go server.watchSrvKeyspace(ctx, entry, cell, keyspace, entry.version)
 	}
 
 	// If the cached value is still valid, use it. Otherwise wait
@@ -446,11 +451,11 @@ func (server *ResilientServer) GetSrvKeyspace(ctx context.Context, cell, keyspac
 // watchSrvKeyspace is started in a separate goroutine and attempts to establish
 // a watch. The caller context is provided to show in the UI in case the watch
 // fails due to an error like a mistyped keyspace.
-func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry *srvKeyspaceEntry, cell, keyspace string) {
+func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry *srvKeyspaceEntry, cell, keyspace string, version topo.Version) {
 	// We use a background context, as starting the watch should keep going
 	// even if the current query context is short-lived.
 	newCtx := context.Background()
-	current, changes, cancel := server.topoServer.WatchSrvKeyspace(newCtx, cell, keyspace)
+	current, changes, cancel := server.topoServer.WatchSrvKeyspaceFrom(newCtx, cell, keyspace, version)
 
 	entry.mutex.Lock()
 
@@ -464,6 +469,7 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 		// if the node disappears, delete the cached value
 		if topo.IsErrType(current.Err, topo.NoNode) {
 			entry.value = nil
+			entry.version = nil
 		}
 
 		server.counts.Add(errorCategory, 1)
@@ -474,6 +480,7 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 		if !netErr && time.Since(entry.lastValueTime) > server.cacheTTL {
 			log.Errorf("WatchSrvKeyspace clearing cached entry for %v/%v", cell, keyspace)
 			entry.value = nil
+			entry.version = nil
 		}
 
 		entry.watchState = watchStateIdle
@@ -488,6 +495,7 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 	close(entry.watchStartingChan)
 	entry.watchStartingChan = nil
 	entry.value = current.Value
+	entry.version = current.Version
 	entry.lastValueTime = time.Now()
 
 	entry.lastError = nil
@@ -510,6 +518,7 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 			entry.mutex.Lock()
 			if topo.IsErrType(c.Err, topo.NoNode) {
 				entry.value = nil
+				entry.version = nil
 			}
 			entry.watchState = watchStateIdle
 
@@ -528,6 +537,7 @@ func (server *ResilientServer) watchSrvKeyspace(callerCtx context.Context, entry
 		// We got a new value, save it.
 		entry.mutex.Lock()
 		entry.value = c.Value
+		entry.version = c.Version
 		entry.lastError = nil
 		entry.lastErrorCtx = nil
 		entry.lastErrorTime = time.Time{}
@@ -551,26 +561,37 @@ func (server *ResilientServer) WatchSrvVSchema(ctx context.Context, cell string,
 
 		foundFirstValue := false
 
+		// version is the version of the last value, the watch resumes
+		// from it when it is restarted.
+		var version topo.Version
 		for {
-			current, changes, _ := server.topoServer.WatchSrvVSchema(ctx, cell)
+			current, changes, _ := server.topoServer.WatchSrvVSchemaFrom(ctx, cell, version)
 			callback(current.Value, current.Err)
 			if !foundFirstValue {
 				foundFirstValue = true
 				wg.Done()
 			}
 			if current.Err != nil {
+				if topo.IsErrType(current.Err, topo.NoNode) {
+					version = nil
+				}
 				// Don't log if there is no VSchema to start with.
 				if !topo.IsErrType(current.Err, topo.NoNode) {
 					log.Warningf("Error watching vschema for cell %s (will wait 5s before retrying): %v", cell, current.Err)
 				}
 			} else {
+				version = current.Version
 				for c := range changes {
 					// Note we forward topo.ErrNoNode as is.
 					callback(c.Value, c.Err)
 					if c.Err != nil {
+						if topo.IsErrType(c.Err, topo.NoNode) {
+							version = nil
+						}
 						log.Warningf("Error while watching vschema for cell %s (will wait 5s before retrying): %v", cell, c.Err)
 						break
 					}
+					version = c.Version
 				}
 			}
 
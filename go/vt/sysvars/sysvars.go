@@ -44,6 +44,7 @@ var (
 	utf8 = "'utf8'"
 
 	Autocommit                  = SystemVariable{Name: "autocommit", IsBoolean: true, Default: on}
+	CharacterSetResults         = SystemVariable{Name: "character_set_results", IdentifierAsString: true}
 	Charset                     = SystemVariable{Name: "charset", Default: utf8, IdentifierAsString: true}
 	ClientFoundRows             = SystemVariable{Name: "client_found_rows", IsBoolean: true, Default: off}
 	SessionEnableSystemSettings = SystemVariable{Name: "enable_system_settings", IsBoolean: true, Default: on}
@@ -67,6 +68,9 @@ var (
 	ReadAfterWriteTimeOut = SystemVariable{Name: "read_after_write_timeout"}
 	SessionTrackGTIDs     = SystemVariable{Name: "session_track_gtids", IdentifierAsString: true}
 
+	// Lag-aware routing
+	MaxReplicationLag = SystemVariable{Name: "max_replication_lag"}
+
 	VitessAware = []SystemVariable{
 		Autocommit,
 		ClientFoundRows,
@@ -79,11 +83,13 @@ var (
 		Workload,
 		Charset,
 		Names,
+		CharacterSetResults,
 		SessionUUID,
 		SessionEnableSystemSettings,
 		ReadAfterWriteGTID,
 		ReadAfterWriteTimeOut,
 		SessionTrackGTIDs,
+		MaxReplicationLag,
 	}
 
 	ReadOnly = []SystemVariable{
@@ -217,7 +223,6 @@ var (
 		{Name: "character_set_connection"},
 		{Name: "character_set_database"},
 		{Name: "character_set_filesystem"},
-		{Name: "character_set_results"},
 		{Name: "character_set_server"},
 		{Name: "collation_connection"},
 		{Name: "collation_database"},
@@ -0,0 +1,82 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tableacl
+
+import (
+	"sync"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+// GroupSource resolves the groups of a user, so that the ACLs can grant
+// access to groups which are not in the caller ids sent by vtgate.
+type GroupSource interface {
+	// Groups returns the groups username belongs to.
+	Groups(username string) []string
+}
+
+var (
+	// groupSourceMu protects groupSource.
+	groupSourceMu sync.RWMutex
+	groupSource   GroupSource
+)
+
+// SetGroupSource sets the source of the group memberships used by
+// ResolveGroups. nil removes the source.
+func SetGroupSource(source GroupSource) {
+	groupSourceMu.Lock()
+	defer groupSourceMu.Unlock()
+	groupSource = source
+}
+
+// ResolveGroups returns callerID with the groups of its user from the group
+// source added. callerID is returned as is if there's no group source or
+// no additional group.
+func ResolveGroups(callerID *querypb.VTGateCallerID) *querypb.VTGateCallerID {
+	groupSourceMu.RLock()
+	source := groupSource
+	groupSourceMu.RUnlock()
+	if source == nil || callerID == nil {
+		return callerID
+	}
+	groups := source.Groups(callerID.Username)
+	if len(groups) == 0 {
+		return callerID
+	}
+	resolved := &querypb.VTGateCallerID{
+		Username: callerID.Username,
+		Groups:   make([]string, 0, len(callerID.Groups)+len(groups)),
+	}
+	resolved.Groups = append(resolved.Groups, callerID.Groups...)
+	resolved.Groups = append(resolved.Groups, groups...)
+	return resolved
+}
+
+// Memberships maps the groups to their members. It is the format of the
+// static group sources.
+type Memberships map[string][]string
+
+// UserGroups returns the groups of each user of the memberships.
+func (m Memberships) UserGroups() map[string][]string {
+	userGroups := make(map[string][]string)
+	for group, members := range m {
+		for _, member := range members {
+			userGroups[member] = append(userGroups[member], group)
+		}
+	}
+	return userGroups
+}
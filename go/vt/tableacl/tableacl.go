@@ -29,6 +29,7 @@ import (
 	"github.com/tchap/go-patricia/patricia"
 
 	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/tableacl/acl"
 
@@ -74,6 +75,8 @@ type tableACL struct {
 	sync.RWMutex
 	entries aclEntries
 	config  tableaclpb.Config
+	// data is the content of the config file last loaded by init.
+	data []byte
 	// callback is executed on successful reload.
 	callback func()
 	// ACL Factory override for testing
@@ -83,6 +86,9 @@ type tableACL struct {
 // currentTableACL stores current effective ACL information.
 var currentTableACL tableACL
 
+// reloads counts the loads of the config file by their result.
+var reloads = stats.NewCountersWithSingleLabel("TableACLReloads", "Table ACL config file loads", "Result", "Success", "Failure", "Unchanged")
+
 // Init initiates table ACLs.
 //
 // The config file can be binary-proto-encoded, or json-encoded.
@@ -110,17 +116,37 @@ func (tacl *tableACL) init(configFile string, aclCB func()) error {
 	data, err := ioutil.ReadFile(configFile)
 	if err != nil {
 		log.Infof("unable to read tableACL config file: %v  Error: %v", configFile, err)
+		reloads.Add("Failure", 1)
 		return err
 	}
+	// Reloading the same config would needlessly run the callback,
+	// which usually clears the plan cache.
+	tacl.RLock()
+	unchanged := tacl.data != nil && bytes.Equal(tacl.data, data)
+	tacl.RUnlock()
+	if unchanged {
+		reloads.Add("Unchanged", 1)
+		return nil
+	}
 	config := &tableaclpb.Config{}
 	if err := proto.Unmarshal(data, config); err != nil {
 		// try to parse tableacl as json file
 		if jsonErr := json2.Unmarshal(data, config); jsonErr != nil {
 			log.Infof("unable to parse tableACL config file as a protobuf or json file.  protobuf err: %v  json err: %v", err, jsonErr)
+			reloads.Add("Failure", 1)
 			return fmt.Errorf("unable to unmarshal Table ACL data: %s", data)
 		}
 	}
-	return tacl.Set(config)
+	if err := tacl.Set(config); err != nil {
+		reloads.Add("Failure", 1)
+		return err
+	}
+	tacl.Lock()
+	tacl.data = data
+	tacl.Unlock()
+	reloads.Add("Success", 1)
+	log.Infof("loaded tableACL config file %v", configFile)
+	return nil
 }
 
 func (tacl *tableACL) SetCallback(callback func()) {
@@ -189,6 +215,7 @@ func (tacl *tableACL) Set(config *tableaclpb.Config) error {
 	tacl.Lock()
 	tacl.entries = entries
 	tacl.config = *config
+	tacl.data = nil
 	callback := tacl.callback
 	tacl.Unlock()
 	if callback != nil {
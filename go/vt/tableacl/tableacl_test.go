@@ -22,6 +22,8 @@ import (
 	"io/ioutil"
 	"os"
 	"reflect"
+	"sort"
+	"strings"
 	"testing"
 
 	"github.com/golang/protobuf/proto"
@@ -75,6 +77,90 @@ func TestInitWithValidConfig(t *testing.T) {
 	}
 }
 
+func TestInitUnchangedConfig(t *testing.T) {
+	tacl := tableACL{factory: &simpleacl.Factory{}}
+	f, err := ioutil.TempFile("", "tableacl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := io.WriteString(f, aclJSON); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	callbacks := 0
+	cb := func() { callbacks++ }
+	for i := 0; i < 2; i++ {
+		if err := tacl.init(f.Name(), cb); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if callbacks != 1 {
+		t.Errorf("callbacks after loading the same config twice: %d, want 1", callbacks)
+	}
+
+	if err := ioutil.WriteFile(f.Name(), []byte(strings.Replace(aclJSON, "test_table", "other_table", 1)), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := tacl.init(f.Name(), cb); err != nil {
+		t.Fatal(err)
+	}
+	if callbacks != 2 {
+		t.Errorf("callbacks after changing the config: %d, want 2", callbacks)
+	}
+	if got := tacl.Authorized("other_table", READER).GroupName; got != "group01" {
+		t.Errorf("group of other_table: %q, want group01", got)
+	}
+}
+
+type fakeGroupSource map[string][]string
+
+func (f fakeGroupSource) Groups(username string) []string {
+	return f[username]
+}
+
+func TestResolveGroups(t *testing.T) {
+	callerID := &querypb.VTGateCallerID{Username: "u1", Groups: []string{"g0"}}
+	if got := ResolveGroups(callerID); got != callerID {
+		t.Errorf("ResolveGroups without a source: %v, want %v", got, callerID)
+	}
+
+	SetGroupSource(fakeGroupSource{"u1": {"g1", "g2"}})
+	defer SetGroupSource(nil)
+	want := &querypb.VTGateCallerID{Username: "u1", Groups: []string{"g0", "g1", "g2"}}
+	if got := ResolveGroups(callerID); !proto.Equal(got, want) {
+		t.Errorf("ResolveGroups: %v, want %v", got, want)
+	}
+	if len(callerID.Groups) != 1 {
+		t.Errorf("ResolveGroups modified its argument: %v", callerID)
+	}
+	other := &querypb.VTGateCallerID{Username: "u2"}
+	if got := ResolveGroups(other); got != other {
+		t.Errorf("ResolveGroups for a user without groups: %v, want %v", got, other)
+	}
+	if got := ResolveGroups(nil); got != nil {
+		t.Errorf("ResolveGroups(nil): %v, want nil", got)
+	}
+}
+
+func TestMembershipsUserGroups(t *testing.T) {
+	m := Memberships{
+		"readers": {"u1", "u2"},
+		"writers": {"u1"},
+	}
+	got := m.UserGroups()
+	sort.Strings(got["u1"])
+	want := map[string][]string{
+		"u1": {"readers", "writers"},
+		"u2": {"readers"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("UserGroups: %v, want %v", got, want)
+	}
+}
+
 func TestInitFromProto(t *testing.T) {
 	tacl := tableACL{factory: &simpleacl.Factory{}}
 	readerACL := tacl.Authorized("my_test_table", READER)
@@ -0,0 +1,214 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"flag"
+	"path"
+	"sync"
+	"time"
+
+	"context"
+
+	"vitess.io/vitess/go/stats"
+)
+
+var _ Conn = (*CacheConn)(nil)
+
+var (
+	// topoReadCacheTTL enables the CacheConn for all the cells.
+	topoReadCacheTTL = flag.Duration("topo_read_cache_ttl", 0, "if set, the SrvKeyspace, SrvVSchema and Tablet records read from the topology are cached for this long. A cached record is also refreshed by the watches on it, and replaced when it is written by this process.")
+
+	topoCacheHits = stats.NewCountersWithMultiLabels(
+		"TopologyCacheHits",
+		"TopologyCacheHits hits per file type",
+		[]string{"Type", "Cell"})
+
+	topoCacheMisses = stats.NewCountersWithMultiLabels(
+		"TopologyCacheMisses",
+		"TopologyCacheMisses misses per file type",
+		[]string{"Type", "Cell"})
+)
+
+// cachedFiles are the files cached by the CacheConn. They are read
+// often, by every vtgate, and in bulk when a lot of them restart.
+// The Tablet records are mostly written by the tablets themselves, so
+// the copies cached by the other processes are only refreshed by the
+// TTL. An update made with a stale version fails and invalidates the
+// record, so the read-modify-write loops read it again from the topo.
+var cachedFiles = map[string]bool{
+	SrvKeyspaceFile: true,
+	SrvVSchemaFile:  true,
+	TabletFile:      true,
+}
+
+// cacheEntry is a file in the cache.
+type cacheEntry struct {
+	contents []byte
+	version  Version
+	expires  time.Time
+}
+
+// The CacheConn is a wrapper for a Conn that caches the reads of some
+// files for a TTL. The watches on a cached file keep it up to date,
+// and the writes to it made through the CacheConn are written through
+// to the cache, or invalidate it if they fail.
+// All the other operations are passed to the underlying Conn.
+type CacheConn struct {
+	Conn
+
+	cell string
+	ttl  time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// NewCacheConn returns a CacheConn
+func NewCacheConn(cell string, conn Conn, ttl time.Duration) *CacheConn {
+	return &CacheConn{
+		Conn:    conn,
+		cell:    cell,
+		ttl:     ttl,
+		entries: make(map[string]*cacheEntry),
+	}
+}
+
+// withReadCache wraps conn in a CacheConn if the cache is enabled.
+func withReadCache(cell string, conn Conn) Conn {
+	if *topoReadCacheTTL <= 0 {
+		return conn
+	}
+	return NewCacheConn(cell, conn, *topoReadCacheTTL)
+}
+
+// Get is part of the Conn interface
+func (cc *CacheConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	fileType := path.Base(filePath)
+	if !cachedFiles[fileType] {
+		return cc.Conn.Get(ctx, filePath)
+	}
+	statsKey := []string{fileType, cc.cell}
+
+	cc.mu.Lock()
+	entry, ok := cc.entries[filePath]
+	cc.mu.Unlock()
+	if ok && time.Now().Before(entry.expires) {
+		topoCacheHits.Add(statsKey, 1)
+		return entry.contents, entry.version, nil
+	}
+
+	topoCacheMisses.Add(statsKey, 1)
+	contents, version, err := cc.Conn.Get(ctx, filePath)
+	if err != nil {
+		cc.invalidate(filePath)
+		return nil, nil, err
+	}
+	cc.store(filePath, contents, version)
+	return contents, version, nil
+}
+
+// Create is part of the Conn interface
+func (cc *CacheConn) Create(ctx context.Context, filePath string, contents []byte) (Version, error) {
+	version, err := cc.Conn.Create(ctx, filePath, contents)
+	cc.writeThrough(filePath, contents, version, err)
+	return version, err
+}
+
+// Update is part of the Conn interface
+func (cc *CacheConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	newVersion, err := cc.Conn.Update(ctx, filePath, contents, version)
+	cc.writeThrough(filePath, contents, newVersion, err)
+	return newVersion, err
+}
+
+// Delete is part of the Conn interface
+func (cc *CacheConn) Delete(ctx context.Context, filePath string, version Version) error {
+	defer cc.invalidate(filePath)
+	return cc.Conn.Delete(ctx, filePath, version)
+}
+
+// Watch is part of the Conn interface
+func (cc *CacheConn) Watch(ctx context.Context, filePath string) (*WatchData, <-chan *WatchData, CancelFunc) {
+	current, changes, cancel := cc.Conn.Watch(ctx, filePath)
+	return cc.refreshFromWatch(filePath, true /*latest*/, current, changes, cancel)
+}
+
+// WatchFrom is part of the Conn interface
+func (cc *CacheConn) WatchFrom(ctx context.Context, filePath string, version Version) (*WatchData, <-chan *WatchData, CancelFunc) {
+	current, changes, cancel := cc.Conn.WatchFrom(ctx, filePath, version)
+	return cc.refreshFromWatch(filePath, version == nil, current, changes, cancel)
+}
+
+// refreshFromWatch updates the cached file with the values seen
+// by a watch on it, and forwards the changes to the caller. latest is
+// set if the current value of the watch is the latest version of
+// the file, which is not the case when a watch is resumed.
+func (cc *CacheConn) refreshFromWatch(filePath string, latest bool, current *WatchData, changes <-chan *WatchData, cancel CancelFunc) (*WatchData, <-chan *WatchData, CancelFunc) {
+	if !cachedFiles[path.Base(filePath)] {
+		return current, changes, cancel
+	}
+	if current.Err != nil {
+		cc.invalidate(filePath)
+		return current, changes, cancel
+	}
+	if latest {
+		cc.store(filePath, current.Contents, current.Version)
+	} else {
+		cc.invalidate(filePath)
+	}
+
+	forwarded := make(chan *WatchData, 10)
+	go func() {
+		defer close(forwarded)
+		for wd := range changes {
+			if wd.Err != nil {
+				cc.invalidate(filePath)
+			} else {
+				cc.store(filePath, wd.Contents, wd.Version)
+			}
+			forwarded <- wd
+		}
+	}()
+	return current, forwarded, cancel
+}
+
+// writeThrough stores the file written by this process in the cache.
+// A failed write, for instance with a stale version, invalidates it.
+func (cc *CacheConn) writeThrough(filePath string, contents []byte, version Version, err error) {
+	if err != nil || version == nil || !cachedFiles[path.Base(filePath)] {
+		cc.invalidate(filePath)
+		return
+	}
+	cc.store(filePath, contents, version)
+}
+
+func (cc *CacheConn) store(filePath string, contents []byte, version Version) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.entries[filePath] = &cacheEntry{
+		contents: contents,
+		version:  version,
+		expires:  time.Now().Add(cc.ttl),
+	}
+}
+
+func (cc *CacheConn) invalidate(filePath string) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	delete(cc.entries, filePath)
+}
@@ -0,0 +1,177 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The countingConn is a fakeConn that counts the Get calls,
+// and returns the same watch for all the files. Its updates
+// return version and updateErr.
+type countingConn struct {
+	fakeConn
+	gets      int
+	contents  string
+	changes   chan *WatchData
+	version   Version
+	updateErr error
+}
+
+// Get is part of the Conn interface
+func (cc *countingConn) Get(ctx context.Context, filePath string) ([]byte, Version, error) {
+	cc.gets++
+	return []byte(cc.contents), nil, nil
+}
+
+// Update is part of the Conn interface
+func (cc *countingConn) Update(ctx context.Context, filePath string, contents []byte, version Version) (Version, error) {
+	return cc.version, cc.updateErr
+}
+
+// Watch is part of the Conn interface
+func (cc *countingConn) Watch(ctx context.Context, filePath string) (*WatchData, <-chan *WatchData, CancelFunc) {
+	return &WatchData{Contents: []byte(cc.contents)}, cc.changes, func() {}
+}
+
+func TestCacheConnGet(t *testing.T) {
+	ctx := context.Background()
+	conn := &countingConn{contents: "v1"}
+	cacheConn := NewCacheConn("cell1", conn, time.Hour)
+
+	for i := 0; i < 2; i++ {
+		contents, _, err := cacheConn.Get(ctx, "keyspaces/ks/SrvKeyspace")
+		require.NoError(t, err)
+		assert.Equal(t, "v1", string(contents))
+	}
+	assert.Equal(t, 1, conn.gets)
+	assert.Equal(t, int64(1), topoCacheHits.Counts()["SrvKeyspace.cell1"])
+	assert.Equal(t, int64(1), topoCacheMisses.Counts()["SrvKeyspace.cell1"])
+
+	// The tablets are cached, the other files are not.
+	for i := 0; i < 2; i++ {
+		_, _, err := cacheConn.Get(ctx, "keyspaces/ks/Keyspace")
+		require.NoError(t, err)
+		_, _, err = cacheConn.Get(ctx, "tablets/cell1-0000000100/Tablet")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, conn.gets)
+	assert.Equal(t, int64(1), topoCacheHits.Counts()["Tablet.cell1"])
+
+	// An update invalidates the file.
+	conn.contents = "v2"
+	_, err := cacheConn.Update(ctx, "keyspaces/ks/SrvKeyspace", []byte("v2"), nil)
+	require.NoError(t, err)
+	contents, _, err := cacheConn.Get(ctx, "keyspaces/ks/SrvKeyspace")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(contents))
+	assert.Equal(t, 5, conn.gets)
+}
+
+func TestCacheConnWriteThrough(t *testing.T) {
+	ctx := context.Background()
+	conn := &countingConn{contents: "v1", version: &fakeVersion{2}}
+	cacheConn := NewCacheConn("cell1", conn, time.Hour)
+	tabletPath := "tablets/cell1-0000000100/Tablet"
+
+	_, _, err := cacheConn.Get(ctx, tabletPath)
+	require.NoError(t, err)
+	assert.Equal(t, 1, conn.gets)
+
+	// A successful update replaces the cached tablet.
+	version, err := cacheConn.Update(ctx, tabletPath, []byte("v2"), nil)
+	require.NoError(t, err)
+	contents, gotVersion, err := cacheConn.Get(ctx, tabletPath)
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(contents))
+	assert.Equal(t, version, gotVersion)
+	assert.Equal(t, 1, conn.gets)
+
+	// An update with a stale version invalidates the tablet, so that
+	// the next read gets it from the topo.
+	conn.contents = "v3"
+	conn.updateErr = NewError(BadVersion, tabletPath)
+	_, err = cacheConn.Update(ctx, tabletPath, []byte("v2bis"), version)
+	require.True(t, IsErrType(err, BadVersion))
+	contents, _, err = cacheConn.Get(ctx, tabletPath)
+	require.NoError(t, err)
+	assert.Equal(t, "v3", string(contents))
+	assert.Equal(t, 2, conn.gets)
+}
+
+// fakeVersion is a Version for the tests.
+type fakeVersion struct {
+	v int
+}
+
+// String is part of the Version interface.
+func (fv *fakeVersion) String() string {
+	return fmt.Sprintf("%d", fv.v)
+}
+
+func TestCacheConnTTL(t *testing.T) {
+	ctx := context.Background()
+	conn := &countingConn{contents: "v1"}
+	cacheConn := NewCacheConn("cell1", conn, time.Millisecond)
+
+	_, _, err := cacheConn.Get(ctx, "SrvVSchema")
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, _, err = cacheConn.Get(ctx, "SrvVSchema")
+	require.NoError(t, err)
+	assert.Equal(t, 2, conn.gets)
+}
+
+func TestCacheConnWatch(t *testing.T) {
+	ctx := context.Background()
+	conn := &countingConn{contents: "v1", changes: make(chan *WatchData, 10)}
+	cacheConn := NewCacheConn("cell1", conn, time.Hour)
+
+	current, changes, _ := cacheConn.Watch(ctx, "tablets/cell1-0000000100/Tablet")
+	require.NoError(t, current.Err)
+	contents, _, err := cacheConn.Get(ctx, "tablets/cell1-0000000100/Tablet")
+	require.NoError(t, err)
+	assert.Equal(t, "v1", string(contents))
+	assert.Equal(t, 0, conn.gets)
+
+	// A change seen by the watch refreshes the file.
+	conn.changes <- &WatchData{Contents: []byte("v2")}
+	wd := <-changes
+	assert.Equal(t, "v2", string(wd.Contents))
+	contents, _, err = cacheConn.Get(ctx, "tablets/cell1-0000000100/Tablet")
+	require.NoError(t, err)
+	assert.Equal(t, "v2", string(contents))
+	assert.Equal(t, 0, conn.gets)
+
+	// The end of the watch invalidates the file.
+	conn.changes <- &WatchData{Err: NewError(Interrupted, "watch")}
+	close(conn.changes)
+	wd = <-changes
+	assert.True(t, IsErrType(wd.Err, Interrupted))
+	_, ok := <-changes
+	assert.False(t, ok)
+	_, _, err = cacheConn.Get(ctx, "tablets/cell1-0000000100/Tablet")
+	require.NoError(t, err)
+	assert.Equal(t, 1, conn.gets)
+}
@@ -154,6 +154,19 @@ type Conn interface {
 	// filePath is a path relative to the root directory of the cell.
 	Watch(ctx context.Context, filePath string) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc)
 
+	// WatchFrom is used to resume a watch that was interrupted, for
+	// instance by a disconnect. It has the same contract as Watch, but
+	// version is the last Version of the file seen by the caller.
+	// If the implementation keeps the history of the file, 'current'
+	// is the value of the file at that version, and all the changes
+	// made to the file since then are sent on the 'changes' channel, so
+	// none of them are missed. Otherwise, and if that version is not
+	// in the history anymore, it is the same as Watch.
+	// If version is nil, WatchFrom is the same as Watch.
+	//
+	// filePath is a path relative to the root directory of the cell.
+	WatchFrom(ctx context.Context, filePath string, version Version) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc)
+
 	//
 	// Master election methods. This is meant to have a small
 	// number of processes elect a master within a group. The
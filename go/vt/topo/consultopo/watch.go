@@ -32,6 +32,13 @@ var (
 	watchPollDuration = flag.Duration("topo_consul_watch_poll_duration", 30*time.Second, "time of the long poll for watch queries.")
 )
 
+// WatchFrom is part of the topo.Conn interface.
+// Consul doesn't keep the history of the keys, so the watch
+// starts from the current version of the file.
+func (s *Server) WatchFrom(ctx context.Context, filePath string, version topo.Version) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	return s.Watch(ctx, filePath)
+}
+
 // Watch is part of the topo.Conn interface.
 func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
 	// Initial get.
@@ -23,6 +23,7 @@ import (
 	"context"
 
 	"github.com/coreos/etcd/clientv3"
+	"github.com/coreos/etcd/etcdserver/api/v3rpc/rpctypes"
 	"github.com/coreos/etcd/mvcc/mvccpb"
 
 	"vitess.io/vitess/go/vt/proto/vtrpc"
@@ -42,6 +43,36 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 		// Generic error.
 		return &topo.WatchData{Err: convertError(err, nodePath)}, nil, nil
 	}
+	// We start watching from the response we got, not from the
+	// file original version, as the server may not have that much history.
+	return s.watch(nodePath, initial, initial.Header.Revision)
+}
+
+// WatchFrom is part of the topo.Conn interface.
+func (s *Server) WatchFrom(ctx context.Context, filePath string, version topo.Version) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	if version == nil {
+		return s.Watch(ctx, filePath)
+	}
+	nodePath := path.Join(s.root, filePath)
+	revision := int64(version.(EtcdVersion))
+
+	// Get the file as of the version the caller has seen, and
+	// replay all the changes made after it.
+	initial, err := s.cli.Get(ctx, nodePath, clientv3.WithRev(revision))
+	if err == rpctypes.ErrCompacted {
+		// The version is not in the history anymore.
+		return s.Watch(ctx, filePath)
+	}
+	if err != nil {
+		// Generic error.
+		return &topo.WatchData{Err: convertError(err, nodePath)}, nil, nil
+	}
+	return s.watch(nodePath, initial, revision+1)
+}
+
+// watch starts watching nodePath from the provided revision. The
+// initial response has the current value of the file.
+func (s *Server) watch(nodePath string, initial *clientv3.GetResponse, revision int64) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
 	if len(initial.Kvs) != 1 {
 		// Node doesn't exist.
 		return &topo.WatchData{Err: topo.NewError(topo.NoNode, nodePath)}, nil, nil
@@ -57,10 +88,8 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 	// Create a context, will be used to cancel the watch on retry.
 	watchCtx, watchCancel := context.WithCancel(outerCtx)
 
-	// Create the Watcher.  We start watching from the response we
-	// got, not from the file original version, as the server may
-	// not have that much history.
-	watcher := s.cli.Watch(watchCtx, nodePath, clientv3.WithRev(initial.Header.Revision))
+	// Create the Watcher.
+	watcher := s.cli.Watch(watchCtx, nodePath, clientv3.WithRev(revision))
 	if watcher == nil {
 		watchCancel()
 		outerCancel()
@@ -72,7 +101,7 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 	go func() {
 		defer close(notifications)
 
-		var currVersion = initial.Header.Revision
+		var currVersion = revision
 		var watchRetries int
 		for {
 			select {
@@ -116,9 +145,11 @@ func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <
 				for _, ev := range wresp.Events {
 					switch ev.Type {
 					case mvccpb.PUT:
+						// The version of a file is its ModRevision, as
+						// returned by Get, so the watch can be resumed from it.
 						notifications <- &topo.WatchData{
 							Contents: ev.Kv.Value,
-							Version:  EtcdVersion(ev.Kv.Version),
+							Version:  EtcdVersion(ev.Kv.ModRevision),
 						}
 					case mvccpb.DELETE:
 						// Node is gone, send a final notice.
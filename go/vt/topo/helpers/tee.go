@@ -163,6 +163,11 @@ func (c *TeeConn) Watch(ctx context.Context, filePath string) (*topo.WatchData,
 	return c.primary.Watch(ctx, filePath)
 }
 
+// WatchFrom is part of the topo.Conn interface
+func (c *TeeConn) WatchFrom(ctx context.Context, filePath string, version topo.Version) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	return c.primary.WatchFrom(ctx, filePath, version)
+}
+
 //
 // Lock management.
 //
@@ -27,6 +27,12 @@ import (
 	vtv1beta1 "vitess.io/vitess/go/vt/topo/k8stopo/apis/topo/v1beta1"
 )
 
+// WatchFrom is part of the topo.Conn interface.
+// The watch starts from the current version of the file.
+func (s *Server) WatchFrom(ctx context.Context, filePath string, version topo.Version) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	return s.Watch(ctx, filePath)
+}
+
 // Watch is part of the topo.Conn interface.
 func (s *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
 	log.Info("Starting Kubernetes topo Watch on ", filePath)
@@ -294,6 +294,13 @@ func (ts *Server) DeleteKeyspace(ctx context.Context, keyspace string) error {
 		return err
 	}
 
+	if err := ts.SaveQueryRules(ctx, keyspace, nil); err != nil {
+		return err
+	}
+	if err := ts.DeleteQueryRulesHistory(ctx, keyspace); err != nil {
+		return err
+	}
+
 	event.Dispatch(&events.KeyspaceChange{
 		KeyspaceName: keyspace,
 		Keyspace:     nil,
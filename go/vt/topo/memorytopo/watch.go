@@ -24,6 +24,13 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 )
 
+// WatchFrom is part of the topo.Conn interface.
+// The memorytopo doesn't keep the history of the files, so the
+// watch starts from the current version of the file.
+func (c *Conn) WatchFrom(ctx context.Context, filePath string, version topo.Version) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	return c.Watch(ctx, filePath)
+}
+
 // Watch is part of the topo.Conn interface.
 func (c *Conn) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
 	c.factory.mu.Lock()
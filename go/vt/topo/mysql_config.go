@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+
+	"context"
+
+	"vitess.io/vitess/go/vt/topo/topoproto"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// mysqlConfigPath returns the path of the MySQL config overrides of a
+// tablet, in the cell of the tablet.
+func mysqlConfigPath(tabletAlias *topodatapb.TabletAlias) string {
+	return path.Join(TabletsPath, topoproto.TabletAliasString(tabletAlias), MysqlConfigFile)
+}
+
+// GetMysqlConfigOverrides returns the MySQL config overrides of a
+// tablet, as a map of option names to values. They are applied on top
+// of the my.cnf template of the tablet. A tablet without overrides has
+// an empty map.
+func (ts *Server) GetMysqlConfigOverrides(ctx context.Context, tabletAlias *topodatapb.TabletAlias) (map[string]string, error) {
+	conn, err := ts.ConnForCell(ctx, tabletAlias.Cell)
+	if err != nil {
+		return nil, err
+	}
+	data, _, err := conn.Get(ctx, mysqlConfigPath(tabletAlias))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return map[string]string{}, nil
+		}
+		return nil, err
+	}
+	overrides := make(map[string]string)
+	if err := json.Unmarshal(data, &overrides); err != nil {
+		return nil, fmt.Errorf("bad MySQL config overrides in %v: %v", mysqlConfigPath(tabletAlias), err)
+	}
+	return overrides, nil
+}
+
+// SaveMysqlConfigOverrides saves the MySQL config overrides of a
+// tablet. If overrides is empty, they are deleted.
+func (ts *Server) SaveMysqlConfigOverrides(ctx context.Context, tabletAlias *topodatapb.TabletAlias, overrides map[string]string) error {
+	conn, err := ts.ConnForCell(ctx, tabletAlias.Cell)
+	if err != nil {
+		return err
+	}
+	nodePath := mysqlConfigPath(tabletAlias)
+	if len(overrides) == 0 {
+		if err := conn.Delete(ctx, nodePath, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+	data, err := json.MarshalIndent(overrides, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = conn.Update(ctx, nodePath, data, nil)
+	return err
+}
@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package topo
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"time"
+
+	"context"
+)
+
+// QueryRulesVersion is a version of the custom query rules of a keyspace,
+// as saved by SaveQueryRulesVersion.
+type QueryRulesVersion struct {
+	// Version increases with every saved version.
+	Version int64
+	Time    time.Time
+	Author  string
+	Rules   json.RawMessage
+}
+
+// QueryRulesPath returns the path of the custom query rules of a
+// keyspace in the global cell. The tablets of the keyspace apply them
+// when started with -topocustomrule_cell=global and
+// -topocustomrule_path set to this path.
+func QueryRulesPath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, QueryRulesFile)
+}
+
+// GetQueryRules returns the custom query rules of a keyspace, in their
+// JSON format. It returns a NoNode error if the keyspace has no rules.
+func (ts *Server) GetQueryRules(ctx context.Context, keyspace string) ([]byte, error) {
+	data, _, err := ts.globalCell.Get(ctx, QueryRulesPath(keyspace))
+	return data, err
+}
+
+// QueryRulesHistoryPath returns the path of the last versions of the
+// custom query rules of a keyspace in the global cell.
+func QueryRulesHistoryPath(keyspace string) string {
+	return path.Join(KeyspacesPath, keyspace, QueryRulesHistFile)
+}
+
+// SaveQueryRules saves the custom query rules of a keyspace. The rules
+// are not validated. If data is empty, the rules are deleted.
+func (ts *Server) SaveQueryRules(ctx context.Context, keyspace string, data []byte) error {
+	nodePath := QueryRulesPath(keyspace)
+	if len(data) == 0 {
+		if err := ts.globalCell.Delete(ctx, nodePath, nil); err != nil && !IsErrType(err, NoNode) {
+			return err
+		}
+		return nil
+	}
+	_, err := ts.globalCell.Update(ctx, nodePath, data, nil)
+	return err
+}
+
+// GetQueryRulesHistory returns the last versions of the custom query
+// rules of a keyspace, oldest first.
+func (ts *Server) GetQueryRulesHistory(ctx context.Context, keyspace string) ([]*QueryRulesVersion, error) {
+	history, _, err := ts.getQueryRulesHistory(ctx, keyspace)
+	return history, err
+}
+
+func (ts *Server) getQueryRulesHistory(ctx context.Context, keyspace string) ([]*QueryRulesVersion, Version, error) {
+	data, version, err := ts.globalCell.Get(ctx, QueryRulesHistoryPath(keyspace))
+	if err != nil {
+		if IsErrType(err, NoNode) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+	var history []*QueryRulesVersion
+	if err := json.Unmarshal(data, &history); err != nil {
+		return nil, nil, fmt.Errorf("bad query rules history for keyspace %s: %v", keyspace, err)
+	}
+	return history, version, nil
+}
+
+// SaveQueryRulesVersion saves the custom query rules of a keyspace like
+// SaveQueryRules, and records them as a new version in its history. Only
+// the last historySize versions are kept, or all of them if historySize
+// is 0. If the keyspace has rules but no history yet, its current rules
+// are recorded first, so they can be rolled back to.
+//
+// The keyspace is locked while the rules and their history are saved,
+// and both are written with a version check. If the history can't be
+// saved, the previous rules are restored.
+func (ts *Server) SaveQueryRulesVersion(ctx context.Context, keyspace string, data []byte, author string, historySize int) (qrv *QueryRulesVersion, err error) {
+	rules := json.RawMessage(data)
+	if len(data) == 0 {
+		rules = json.RawMessage("[]")
+	}
+	if !json.Valid(rules) {
+		return nil, fmt.Errorf("query rules of keyspace %s are not valid JSON", keyspace)
+	}
+
+	ctx, unlock, lockErr := ts.LockKeyspace(ctx, keyspace, "SaveQueryRulesVersion")
+	if lockErr != nil {
+		return nil, lockErr
+	}
+	defer unlock(&err)
+
+	nodePath := QueryRulesPath(keyspace)
+	current, rulesVersion, err := ts.globalCell.Get(ctx, nodePath)
+	if err != nil {
+		if !IsErrType(err, NoNode) {
+			return nil, err
+		}
+		current, rulesVersion = nil, nil
+	}
+	history, historyVersion, err := ts.getQueryRulesHistory(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if len(history) == 0 && len(current) != 0 {
+		if !json.Valid(current) {
+			return nil, fmt.Errorf("current query rules of keyspace %s are not valid JSON", keyspace)
+		}
+		history = append(history, &QueryRulesVersion{
+			Version: 1,
+			Time:    now,
+			Rules:   json.RawMessage(current),
+		})
+	}
+
+	qrv = &QueryRulesVersion{
+		Version: 1,
+		Time:    now,
+		Author:  author,
+		Rules:   rules,
+	}
+	if len(history) != 0 {
+		qrv.Version = history[len(history)-1].Version + 1
+	}
+	history = append(history, qrv)
+	if historySize > 0 && len(history) > historySize {
+		history = history[len(history)-historySize:]
+	}
+	contents, err := json.MarshalIndent(history, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	newRulesVersion, err := ts.writeQueryRules(ctx, nodePath, data, rulesVersion)
+	if err != nil {
+		return nil, err
+	}
+	historyPath := QueryRulesHistoryPath(keyspace)
+	if historyVersion == nil {
+		_, err = ts.globalCell.Create(ctx, historyPath, contents)
+	} else {
+		_, err = ts.globalCell.Update(ctx, historyPath, contents, historyVersion)
+	}
+	if err != nil {
+		if _, restoreErr := ts.writeQueryRules(ctx, nodePath, current, newRulesVersion); restoreErr != nil {
+			return nil, fmt.Errorf("query rules of keyspace %s saved, but not their version %d (%v), and the previous rules can't be restored: %v", keyspace, qrv.Version, err, restoreErr)
+		}
+		return nil, fmt.Errorf("can't save version %d of the query rules of keyspace %s: %v", qrv.Version, keyspace, err)
+	}
+	return qrv, nil
+}
+
+// writeQueryRules writes or deletes the query rules at nodePath, if they
+// are still at version, which is nil if they don't exist. It returns
+// their new version, nil if they were deleted.
+func (ts *Server) writeQueryRules(ctx context.Context, nodePath string, data []byte, version Version) (Version, error) {
+	switch {
+	case len(data) == 0 && version == nil:
+		return nil, nil
+	case len(data) == 0:
+		return nil, ts.globalCell.Delete(ctx, nodePath, version)
+	case version == nil:
+		return ts.globalCell.Create(ctx, nodePath, data)
+	default:
+		return ts.globalCell.Update(ctx, nodePath, data, version)
+	}
+}
+
+// DeleteQueryRulesHistory deletes the history of the custom query rules
+// of a keyspace.
+func (ts *Server) DeleteQueryRulesHistory(ctx context.Context, keyspace string) error {
+	if err := ts.globalCell.Delete(ctx, QueryRulesHistoryPath(keyspace), nil); err != nil && !IsErrType(err, NoNode) {
+		return err
+	}
+	return nil
+}
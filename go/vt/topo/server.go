@@ -77,6 +77,9 @@ const (
 	SrvKeyspaceFile      = "SrvKeyspace"
 	RoutingRulesFile     = "RoutingRules"
 	ExternalClustersFile = "ExternalClusters"
+	QueryRulesFile       = "QueryRules"
+	QueryRulesHistFile   = "QueryRulesHistory"
+	MysqlConfigFile      = "MysqlConfig"
 )
 
 // Path for all object types.
@@ -186,7 +189,7 @@ func NewWithFactory(factory Factory, serverAddress, root string) (*Server, error
 	if err != nil {
 		return nil, err
 	}
-	conn = NewStatsConn(GlobalCell, conn)
+	conn = withReadCache(GlobalCell, NewStatsConn(GlobalCell, conn))
 
 	var connReadOnly Conn
 	if factory.HasGlobalReadOnlyCell(serverAddress, root) {
@@ -194,7 +197,7 @@ func NewWithFactory(factory Factory, serverAddress, root string) (*Server, error
 		if err != nil {
 			return nil, err
 		}
-		connReadOnly = NewStatsConn(GlobalReadOnlyCell, connReadOnly)
+		connReadOnly = withReadCache(GlobalReadOnlyCell, NewStatsConn(GlobalReadOnlyCell, connReadOnly))
 	} else {
 		connReadOnly = conn
 	}
@@ -272,7 +275,7 @@ func (ts *Server) ConnForCell(ctx context.Context, cell string) (Conn, error) {
 	conn, err = ts.factory.Create(cell, ci.ServerAddress, ci.Root)
 	switch {
 	case err == nil:
-		conn = NewStatsConn(cell, conn)
+		conn = withReadCache(cell, NewStatsConn(cell, conn))
 		ts.cells[cell] = conn
 		return conn, nil
 	case IsErrType(err, NoNode):
@@ -44,22 +44,31 @@ func srvKeyspaceFileName(keyspace string) string {
 
 // WatchSrvKeyspaceData is returned / streamed by WatchSrvKeyspace.
 // The WatchSrvKeyspace API guarantees exactly one of Value or Err will be set.
+// Version is the version of the file Value was read from.
 type WatchSrvKeyspaceData struct {
-	Value *topodatapb.SrvKeyspace
-	Err   error
+	Version Version
+	Value   *topodatapb.SrvKeyspace
+	Err     error
 }
 
 // WatchSrvKeyspace will set a watch on the SrvKeyspace object.
 // It has the same contract as Conn.Watch, but it also unpacks the
 // contents into a SrvKeyspace object.
 func (ts *Server) WatchSrvKeyspace(ctx context.Context, cell, keyspace string) (*WatchSrvKeyspaceData, <-chan *WatchSrvKeyspaceData, CancelFunc) {
+	return ts.WatchSrvKeyspaceFrom(ctx, cell, keyspace, nil)
+}
+
+// WatchSrvKeyspaceFrom resumes a watch on the SrvKeyspace object from version,
+// the last Version seen by the caller. It has the same contract as
+// Conn.WatchFrom, but it also unpacks the contents into a SrvKeyspace object.
+func (ts *Server) WatchSrvKeyspaceFrom(ctx context.Context, cell, keyspace string, version Version) (*WatchSrvKeyspaceData, <-chan *WatchSrvKeyspaceData, CancelFunc) {
 	conn, err := ts.ConnForCell(ctx, cell)
 	if err != nil {
 		return &WatchSrvKeyspaceData{Err: err}, nil, nil
 	}
 
 	filePath := srvKeyspaceFileName(keyspace)
-	current, wdChannel, cancel := conn.Watch(ctx, filePath)
+	current, wdChannel, cancel := conn.WatchFrom(ctx, filePath, version)
 	if current.Err != nil {
 		return &WatchSrvKeyspaceData{Err: current.Err}, nil, nil
 	}
@@ -100,11 +109,11 @@ func (ts *Server) WatchSrvKeyspace(ctx context.Context, cell, keyspace string) (
 				return
 			}
 
-			changes <- &WatchSrvKeyspaceData{Value: value}
+			changes <- &WatchSrvKeyspaceData{Version: wd.Version, Value: value}
 		}
 	}()
 
-	return &WatchSrvKeyspaceData{Value: value}, changes, cancel
+	return &WatchSrvKeyspaceData{Version: current.Version, Value: value}, changes, cancel
 }
 
 // GetSrvKeyspaceNames returns the SrvKeyspace objects for a cell.
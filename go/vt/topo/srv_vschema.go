@@ -34,21 +34,30 @@ import (
 
 // WatchSrvVSchemaData is returned / streamed by WatchSrvVSchema.
 // The WatchSrvVSchema API guarantees exactly one of Value or Err will be set.
+// Version is the version of the file Value was read from.
 type WatchSrvVSchemaData struct {
-	Value *vschemapb.SrvVSchema
-	Err   error
+	Version Version
+	Value   *vschemapb.SrvVSchema
+	Err     error
 }
 
 // WatchSrvVSchema will set a watch on the SrvVSchema object.
 // It has the same contract as Conn.Watch, but it also unpacks the
 // contents into a SrvVSchema object.
 func (ts *Server) WatchSrvVSchema(ctx context.Context, cell string) (*WatchSrvVSchemaData, <-chan *WatchSrvVSchemaData, CancelFunc) {
+	return ts.WatchSrvVSchemaFrom(ctx, cell, nil)
+}
+
+// WatchSrvVSchemaFrom resumes a watch on the SrvVSchema object from version,
+// the last Version seen by the caller. It has the same contract as
+// Conn.WatchFrom, but it also unpacks the contents into a SrvVSchema object.
+func (ts *Server) WatchSrvVSchemaFrom(ctx context.Context, cell string, version Version) (*WatchSrvVSchemaData, <-chan *WatchSrvVSchemaData, CancelFunc) {
 	conn, err := ts.ConnForCell(ctx, cell)
 	if err != nil {
 		return &WatchSrvVSchemaData{Err: err}, nil, nil
 	}
 
-	current, wdChannel, cancel := conn.Watch(ctx, SrvVSchemaFile)
+	current, wdChannel, cancel := conn.WatchFrom(ctx, SrvVSchemaFile, version)
 	if current.Err != nil {
 		return &WatchSrvVSchemaData{Err: current.Err}, nil, nil
 	}
@@ -88,11 +97,11 @@ func (ts *Server) WatchSrvVSchema(ctx context.Context, cell string) (*WatchSrvVS
 				changes <- &WatchSrvVSchemaData{Err: vterrors.Wrapf(err, "error unpacking SrvVSchema object")}
 				return
 			}
-			changes <- &WatchSrvVSchemaData{Value: value}
+			changes <- &WatchSrvVSchemaData{Version: wd.Version, Value: value}
 		}
 	}()
 
-	return &WatchSrvVSchemaData{Value: value}, changes, cancel
+	return &WatchSrvVSchemaData{Version: current.Version, Value: value}, changes, cancel
 }
 
 // UpdateSrvVSchema updates the SrvVSchema file for a cell.
@@ -153,10 +162,39 @@ func (ts *Server) RebuildSrvVSchema(ctx context.Context, cells []string) error {
 		}
 	}
 
+	srvVSchema, err := ts.BuildSrvVSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	// now save the SrvVSchema in all cells in parallel
+	wg := sync.WaitGroup{}
+	mu := sync.Mutex{}
+	var finalErr error
+	for _, cell := range cells {
+		wg.Add(1)
+		go func(cell string) {
+			defer wg.Done()
+			if err := ts.UpdateSrvVSchema(ctx, cell, srvVSchema); err != nil {
+				log.Errorf("%v: UpdateSrvVSchema(%v) failed", err, cell)
+				mu.Lock()
+				finalErr = err
+				mu.Unlock()
+			}
+		}(cell)
+	}
+	wg.Wait()
+
+	return finalErr
+}
+
+// BuildSrvVSchema builds the SrvVSchema from the vschemas of all the
+// keyspaces and the routing rules, without saving it in any cell.
+func (ts *Server) BuildSrvVSchema(ctx context.Context) (*vschemapb.SrvVSchema, error) {
 	// get the keyspaces
 	keyspaces, err := ts.GetKeyspaces(ctx)
 	if err != nil {
-		return fmt.Errorf("GetKeyspaces failed: %v", err)
+		return nil, fmt.Errorf("GetKeyspaces failed: %v", err)
 	}
 
 	// build the SrvVSchema in parallel, protected by mu
@@ -189,29 +227,13 @@ func (ts *Server) RebuildSrvVSchema(ctx context.Context, cells []string) error {
 	}
 	wg.Wait()
 	if finalErr != nil {
-		return finalErr
+		return nil, finalErr
 	}
 
 	rr, err := ts.GetRoutingRules(ctx)
 	if err != nil {
-		return fmt.Errorf("GetRoutingRules failed: %v", err)
+		return nil, fmt.Errorf("GetRoutingRules failed: %v", err)
 	}
 	srvVSchema.RoutingRules = rr
-
-	// now save the SrvVSchema in all cells in parallel
-	for _, cell := range cells {
-		wg.Add(1)
-		go func(cell string) {
-			defer wg.Done()
-			if err := ts.UpdateSrvVSchema(ctx, cell, srvVSchema); err != nil {
-				log.Errorf("%v: UpdateSrvVSchema(%v) failed", err, cell)
-				mu.Lock()
-				finalErr = err
-				mu.Unlock()
-			}
-		}(cell)
-	}
-	wg.Wait()
-
-	return finalErr
+	return srvVSchema, nil
 }
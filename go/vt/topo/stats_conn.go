@@ -138,6 +138,14 @@ func (st *StatsConn) Watch(ctx context.Context, filePath string) (current *Watch
 	return st.conn.Watch(ctx, filePath)
 }
 
+// WatchFrom is part of the Conn interface
+func (st *StatsConn) WatchFrom(ctx context.Context, filePath string, version Version) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc) {
+	startTime := time.Now()
+	statsKey := []string{"WatchFrom", st.cell}
+	defer topoStatsConnTimings.Record(statsKey, startTime)
+	return st.conn.WatchFrom(ctx, filePath, version)
+}
+
 // NewMasterParticipation is part of the Conn interface
 func (st *StatsConn) NewMasterParticipation(name, id string) (MasterParticipation, error) {
 	startTime := time.Now()
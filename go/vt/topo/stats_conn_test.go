@@ -86,6 +86,11 @@ func (st *fakeConn) Watch(ctx context.Context, filePath string) (current *WatchD
 	return current, changes, cancel
 }
 
+// WatchFrom is part of the Conn interface
+func (st *fakeConn) WatchFrom(ctx context.Context, filePath string, version Version) (current *WatchData, changes <-chan *WatchData, cancel CancelFunc) {
+	return current, changes, cancel
+}
+
 // NewMasterParticipation is part of the Conn interface
 func (st *fakeConn) NewMasterParticipation(name, id string) (mp MasterParticipation, err error) {
 	if name == "error" {
@@ -275,6 +280,19 @@ func TestStatsConnTopoWatch(t *testing.T) {
 
 }
 
+//TestStatsConnTopoWatchFrom emits stats on WatchFrom
+func TestStatsConnTopoWatchFrom(t *testing.T) {
+	conn := &fakeConn{}
+	statsConn := NewStatsConn("global", conn)
+	ctx := context.Background()
+
+	statsConn.WatchFrom(ctx, "", conn.v)
+	timingCounts := topoStatsConnTimings.Counts()["WatchFrom.global"]
+	if got, want := timingCounts, int64(1); got != want {
+		t.Errorf("stats were not properly recorded: got = %d, want = %d", got, want)
+	}
+}
+
 //TestStatsConnTopoNewMasterParticipation emits stats on NewMasterParticipation
 func TestStatsConnTopoNewMasterParticipation(t *testing.T) {
 	conn := &fakeConn{}
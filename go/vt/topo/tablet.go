@@ -378,6 +378,9 @@ func (ts *Server) DeleteTablet(ctx context.Context, tabletAlias *topodatapb.Tabl
 	if err := conn.Delete(ctx, tabletPath, nil); err != nil {
 		return err
 	}
+	if err := ts.SaveMysqlConfigOverrides(ctx, tabletAlias, nil); err != nil {
+		log.Warningf("failed to delete the MySQL config overrides of tablet %v: %v", topoproto.TabletAliasString(tabletAlias), err)
+	}
 
 	// Only try to log if we have the required info.
 	if tErr == nil {
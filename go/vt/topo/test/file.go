@@ -201,3 +201,4 @@ func checkFileInCell(t *testing.T, conn topo.Conn, hasCells bool) {
 	expected = expected[:len(expected)-1]
 	checkListDir(ctx, t, conn, "/", expected)
 }
+
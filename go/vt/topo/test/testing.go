@@ -110,5 +110,6 @@ func TopoServerTestSuite(t *testing.T, factory func() *topo.Server) {
 	ts = factory()
 	checkWatch(t, ts)
 	checkWatchInterrupt(t, ts)
+	checkWatchFrom(t, ts)
 	ts.Close()
 }
@@ -216,3 +216,62 @@ func checkWatchInterrupt(t *testing.T, ts *topo.Server) {
 	// And calling cancel() again should just work.
 	cancel()
 }
+
+// checkWatchFrom tests resuming a watch with WatchFrom.
+func checkWatchFrom(t *testing.T, ts *topo.Server) {
+	ctx := context.Background()
+	conn, err := ts.ConnForCell(ctx, LocalCellName)
+	if err != nil {
+		t.Fatalf("ConnForCell(test) failed: %v", err)
+	}
+
+	filePath := "keyspaces/test_keyspace/WatchFrom"
+	version, err := conn.Create(ctx, filePath, []byte("v1"))
+	if err != nil {
+		t.Fatalf("Create failed: %v", err)
+	}
+	defer conn.Delete(ctx, filePath, nil)
+	for _, contents := range []string{"v2", "v3"} {
+		if _, err := conn.Update(ctx, filePath, []byte(contents), nil); err != nil {
+			t.Fatalf("Update failed: %v", err)
+		}
+	}
+
+	// Resume the watch from the first version. Depending on the
+	// implementation, we get the first version and the changes made
+	// since, or just the latest version. Either way, we eventually
+	// get the latest version.
+	current, changes, cancel := conn.WatchFrom(ctx, filePath, version)
+	if current.Err != nil {
+		t.Fatalf("WatchFrom failed: %v", current.Err)
+	}
+	seen := []string{string(current.Contents)}
+	for seen[len(seen)-1] != "v3" {
+		wd, ok := <-changes
+		if !ok {
+			t.Fatalf("watch channel unexpectedly closed")
+		}
+		if wd.Err != nil {
+			t.Fatalf("watch interrupted: %v", wd.Err)
+		}
+		seen = append(seen, string(wd.Contents))
+	}
+	if seen[0] != "v1" && seen[0] != "v3" {
+		t.Errorf("WatchFrom returned unexpected values: %v", seen)
+	}
+	cancel()
+	for range changes {
+	}
+
+	// Without a version, WatchFrom is the same as Watch.
+	current, changes, cancel = conn.WatchFrom(ctx, filePath, nil)
+	if current.Err != nil {
+		t.Fatalf("WatchFrom failed: %v", current.Err)
+	}
+	if got := string(current.Contents); got != "v3" {
+		t.Errorf("WatchFrom(nil) returned %v, expected v3", got)
+	}
+	cancel()
+	for range changes {
+	}
+}
@@ -244,6 +244,40 @@ func TestWatchSrvKeyspaceCancel(t *testing.T) {
 	cancel()
 }
 
+func TestWatchSrvKeyspaceVersion(t *testing.T) {
+	cell := "cell1"
+	keyspace := "ks1"
+	ctx := context.Background()
+	ts := memorytopo.NewServer(cell)
+	conn, err := ts.ConnForCell(ctx, cell)
+	require.NoError(t, err)
+	filePath := "/keyspaces/" + keyspace + "/SrvKeyspace"
+
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, cell, keyspace, &topodatapb.SrvKeyspace{}))
+	current, changes, cancel := waitForInitialSrvKeyspace(t, ts, cell, keyspace)
+	defer cancel()
+	_, version, err := conn.Get(ctx, filePath)
+	require.NoError(t, err)
+	require.Equal(t, version, current.Version)
+
+	// The changes carry the version they were read from, so the
+	// watch can be resumed from it.
+	wanted := &topodatapb.SrvKeyspace{ShardingColumnName: "scn1"}
+	require.NoError(t, ts.UpdateSrvKeyspace(ctx, cell, keyspace, wanted))
+	wd := <-changes
+	require.NoError(t, wd.Err)
+	require.True(t, proto.Equal(wanted, wd.Value))
+	_, version, err = conn.Get(ctx, filePath)
+	require.NoError(t, err)
+	require.Equal(t, version, wd.Version)
+
+	resumed, _, resumedCancel := ts.WatchSrvKeyspaceFrom(ctx, cell, keyspace, wd.Version)
+	defer resumedCancel()
+	require.NoError(t, resumed.Err)
+	require.True(t, proto.Equal(wanted, resumed.Value))
+	require.Equal(t, version, resumed.Version)
+}
+
 func TestUpdateSrvKeyspacePartitions(t *testing.T) {
 	cell := "cell1"
 	cell2 := "cell2"
@@ -28,6 +28,13 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 )
 
+// WatchFrom is part of the topo.Conn interface.
+// Zookeeper doesn't keep the history of the nodes, so the
+// watch starts from the current version of the file.
+func (zs *Server) WatchFrom(ctx context.Context, filePath string, version topo.Version) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
+	return zs.Watch(ctx, filePath)
+}
+
 // Watch is part of the topo.Conn interface.
 func (zs *Server) Watch(ctx context.Context, filePath string) (*topo.WatchData, <-chan *topo.WatchData, topo.CancelFunc) {
 	zkPath := path.Join(zs.root, filePath)
@@ -673,6 +673,38 @@ func (itmc *internalTabletManagerClient) VExec(ctx context.Context, tablet *topo
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
+func (itmc *internalTabletManagerClient) ShowTransactions(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.TransactionInfo, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.ShowTransactions(ctx)
+}
+
+func (itmc *internalTabletManagerClient) KillTransaction(ctx context.Context, tablet *topodatapb.Tablet, transactionID int64) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.KillTransaction(ctx, transactionID)
+}
+
+func (itmc *internalTabletManagerClient) LiveQueries(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return nil, fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.LiveQueries(ctx)
+}
+
+func (itmc *internalTabletManagerClient) KillQuery(ctx context.Context, tablet *topodatapb.Tablet, connID int64) error {
+	t, ok := tabletMap[tablet.Alias.Uid]
+	if !ok {
+		return fmt.Errorf("tmclient: cannot find tablet %v", tablet.Alias.Uid)
+	}
+	return t.tm.KillQuery(ctx, connID)
+}
+
 func (itmc *internalTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
@@ -717,7 +749,7 @@ func (itmc *internalTabletManagerClient) Backup(ctx context.Context, tablet *top
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
-func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (itmc *internalTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
 	return nil, fmt.Errorf("not implemented in vtcombo")
 }
 
@@ -21,11 +21,13 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"time"
 
 	"context"
 
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/wrangler"
@@ -56,8 +58,8 @@ func init() {
 	addCommand("Tablets", command{
 		"RestoreFromBackup",
 		commandRestoreFromBackup,
-		"<tablet alias>",
-		"Stops mysqld and restores the data from the latest backup."})
+		"[-restore_to_pos <position> | -restore_to_timestamp <time>] [-binlog_source_tablet <tablet alias>] <tablet alias>",
+		"Stops mysqld and restores the data from the latest backup. With -restore_to_pos or -restore_to_timestamp, restores the latest backup taken before that point and applies binlogs from -binlog_source_tablet (or the tablet's -binlog_host flags) up to it, leaving the tablet DRAINED."})
 }
 
 func commandBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
@@ -222,12 +224,39 @@ func commandRemoveBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *f
 }
 
 func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	restoreToPos := subFlags.String("restore_to_pos", "", "Restore to this replication position, applying binlogs on top of the latest backup taken before it")
+	restoreToTimestamp := subFlags.String("restore_to_timestamp", "", "Restore to this time (RFC3339, e.g. 2021-04-29T10:00:00Z), applying binlogs on top of the latest backup taken before it")
+	binlogSourceTablet := subFlags.String("binlog_source_tablet", "", "Tablet alias whose mysqld serves the binlogs for a point-in-time restore. Defaults to the -binlog_host flags of the restored tablet")
 	if err := subFlags.Parse(args); err != nil {
 		return err
 	}
 	if subFlags.NArg() != 1 {
 		return fmt.Errorf("the RestoreFromBackup command requires the <tablet alias> argument")
 	}
+	if *restoreToPos != "" && *restoreToTimestamp != "" {
+		return fmt.Errorf("only one of -restore_to_pos and -restore_to_timestamp can be specified")
+	}
+
+	req := &tabletmanagerdatapb.RestoreFromBackupRequest{
+		RestoreToPos: *restoreToPos,
+	}
+	if *restoreToTimestamp != "" {
+		t, err := time.Parse(time.RFC3339, *restoreToTimestamp)
+		if err != nil {
+			return fmt.Errorf("invalid -restore_to_timestamp %v: %v", *restoreToTimestamp, err)
+		}
+		req.RestoreToTimestamp = logutil.TimeToProto(t)
+	}
+	if *binlogSourceTablet != "" {
+		if *restoreToPos == "" && *restoreToTimestamp == "" {
+			return fmt.Errorf("-binlog_source_tablet requires -restore_to_pos or -restore_to_timestamp")
+		}
+		sourceAlias, err := topoproto.ParseTabletAlias(*binlogSourceTablet)
+		if err != nil {
+			return err
+		}
+		req.BinlogSourceTablet = sourceAlias
+	}
 
 	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
 	if err != nil {
@@ -237,7 +266,7 @@ func commandRestoreFromBackup(ctx context.Context, wr *wrangler.Wrangler, subFla
 	if err != nil {
 		return err
 	}
-	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet)
+	stream, err := wr.TabletManagerClient().RestoreFromBackup(ctx, tabletInfo.Tablet, req)
 	if err != nil {
 		return err
 	}
@@ -24,6 +24,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"google.golang.org/grpc"
 	"k8s.io/apimachinery/pkg/util/sets"
 
@@ -35,6 +36,7 @@ import (
 	"vitess.io/vitess/go/vt/logutil"
 	"vitess.io/vitess/go/vt/mysqlctl/backupstorage"
 	"vitess.io/vitess/go/vt/mysqlctl/mysqlctlproto"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/topotools"
@@ -42,6 +44,7 @@ import (
 	"vitess.io/vitess/go/vt/vtctl/reparentutil"
 	"vitess.io/vitess/go/vt/vtctl/workflow"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
 
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
@@ -77,6 +80,97 @@ func NewVtctldServer(ts *topo.Server) *VtctldServer {
 	}
 }
 
+// ApplyVSchema is part of the vtctlservicepb.VtctldServer interface.
+func (s *VtctldServer) ApplyVSchema(ctx context.Context, req *vtctldatapb.ApplyVSchemaRequest) (*vtctldatapb.ApplyVSchemaResponse, error) {
+	if (req.VSchema == nil) == (req.Sql == "") {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "exactly one of VSchema and Sql is required")
+	}
+
+	if _, err := s.ts.GetKeyspace(ctx, req.Keyspace); err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return nil, vterrors.Errorf(vtrpc.Code_NOT_FOUND, "keyspace(%s) doesn't exist, check if the keyspace is initialized", req.Keyspace)
+		}
+
+		return nil, err
+	}
+
+	srvVSchema, err := s.ts.BuildSrvVSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vs := req.VSchema
+	if req.Sql != "" {
+		stmt, err := sqlparser.Parse(req.Sql)
+		if err != nil {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "error parsing vschema statement `%s`: %v", req.Sql, err)
+		}
+
+		ddl, ok := stmt.(*sqlparser.AlterVschema)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "error parsing vschema statement `%s`: not a ddl statement", req.Sql)
+		}
+
+		current := proto.Clone(srvVSchema.Keyspaces[req.Keyspace]).(*vschemapb.Keyspace)
+		if vs, err = topotools.ApplyVSchemaDDL(req.Keyspace, current, ddl); err != nil {
+			return nil, err
+		}
+	}
+
+	// Build the vschemas of all the keyspaces with the new one, to resolve
+	// the sequences and the sources it references.
+	next := proto.Clone(srvVSchema).(*vschemapb.SrvVSchema)
+	next.Keyspaces[req.Keyspace] = vs
+
+	nextVSchema, err := vindexes.BuildVSchema(next)
+	if err != nil {
+		return nil, err
+	}
+
+	ks := nextVSchema.Keyspaces[req.Keyspace]
+	if ks.Error != nil {
+		return nil, vterrors.Errorf(vtrpc.Code_INVALID_ARGUMENT, "invalid vschema for keyspace %s: %v", req.Keyspace, ks.Error)
+	}
+
+	if !req.SkipValidation {
+		if err := validateVSchemaTables(ctx, s.ts, s.tmc, req.Keyspace, vs, ks); err != nil {
+			return nil, err
+		}
+	}
+
+	resp := &vtctldatapb.ApplyVSchemaResponse{
+		VSchema: vs,
+	}
+
+	if len(req.PlanQueries) > 0 {
+		currentVSchema, err := vindexes.BuildVSchema(srvVSchema)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.PlanChanges = planVSchemaChanges(req.Keyspace, currentVSchema, nextVSchema, req.PlanQueries)
+	}
+
+	if req.DryRun {
+		return resp, nil
+	}
+
+	if err := s.ts.SaveVSchema(ctx, req.Keyspace, vs); err != nil {
+		return nil, fmt.Errorf("SaveVSchema(%v) failed: %w", req.Keyspace, err)
+	}
+
+	if req.SkipRebuild {
+		log.Warningf("Skipping rebuild of SrvVSchema, will need to run RebuildVSchemaGraph for changes to take effect")
+		return resp, nil
+	}
+
+	if err := s.ts.RebuildSrvVSchema(ctx, req.Cells); err != nil {
+		return nil, fmt.Errorf("RebuildSrvVSchema(%v) failed: %w", req.Cells, err)
+	}
+
+	return resp, nil
+}
+
 // ChangeTabletType is part of the vtctlservicepb.VtctldServer interface.
 func (s *VtctldServer) ChangeTabletType(ctx context.Context, req *vtctldatapb.ChangeTabletTypeRequest) (*vtctldatapb.ChangeTabletTypeResponse, error) {
 	tablet, err := s.ts.GetTablet(ctx, req.TabletAlias)
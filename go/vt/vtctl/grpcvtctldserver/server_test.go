@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -63,6 +64,200 @@ func init() {
 	})
 }
 
+func TestApplyVSchema(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	currentVSchema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"hash": {
+				Type: "hash",
+			},
+		},
+		Tables: map[string]*vschemapb.Table{
+			"t1": {
+				ColumnVindexes: []*vschemapb.ColumnVindex{{Column: "id", Name: "hash"}},
+			},
+		},
+	}
+	newVSchema := func(table string, column string) *vschemapb.Keyspace {
+		return &vschemapb.Keyspace{
+			Sharded: true,
+			Vindexes: map[string]*vschemapb.Vindex{
+				"hash": {
+					Type: "hash",
+				},
+			},
+			Tables: map[string]*vschemapb.Table{
+				table: {
+					ColumnVindexes: []*vschemapb.ColumnVindex{{Column: column, Name: "hash"}},
+				},
+			},
+		}
+	}
+	setup := func(t *testing.T) (*topo.Server, vtctlservicepb.VtctldServer) {
+		ts := memorytopo.NewServer("zone1")
+		primary := &topodatapb.Tablet{
+			Alias:    &topodatapb.TabletAlias{Cell: "zone1", Uid: 100},
+			Keyspace: "testkeyspace",
+			Shard:    "-",
+			Type:     topodatapb.TabletType_MASTER,
+		}
+		testutil.AddTablet(ctx, t, ts, primary, &testutil.AddTabletOptions{AlsoSetShardMaster: true})
+		require.NoError(t, ts.SaveVSchema(ctx, "testkeyspace", currentVSchema))
+
+		tmc := &testutil.TabletManagerClient{
+			GetSchemaResults: map[string]struct {
+				Schema *tabletmanagerdatapb.SchemaDefinition
+				Error  error
+			}{
+				"zone1-0000000100": {
+					Schema: &tabletmanagerdatapb.SchemaDefinition{
+						TableDefinitions: []*tabletmanagerdatapb.TableDefinition{
+							{Name: "t1", Columns: []string{"id", "c"}},
+						},
+					},
+				},
+			},
+		}
+		vtctld := testutil.NewVtctldServerWithTabletManagerClient(t, ts, tmc, func(ts *topo.Server) vtctlservicepb.VtctldServer {
+			return NewVtctldServer(ts)
+		})
+		return ts, vtctld
+	}
+
+	t.Run("vschema", func(t *testing.T) {
+		ts, vtctld := setup(t)
+		vs := newVSchema("t1", "c")
+
+		resp, err := vtctld.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+			Keyspace: "testkeyspace",
+			VSchema:  vs,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, vs, resp.VSchema)
+		assert.Empty(t, resp.PlanChanges)
+
+		saved, err := ts.GetVSchema(ctx, "testkeyspace")
+		require.NoError(t, err)
+		assert.Equal(t, vs, saved)
+
+		srvVSchema, err := ts.GetSrvVSchema(ctx, "zone1")
+		require.NoError(t, err)
+		assert.Equal(t, vs, srvVSchema.Keyspaces["testkeyspace"])
+	})
+
+	t.Run("sql", func(t *testing.T) {
+		ts, vtctld := setup(t)
+
+		resp, err := vtctld.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+			Keyspace: "testkeyspace",
+			Sql:      "alter vschema on t1 add vindex hash_c(c) using hash",
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.VSchema.Tables["t1"].ColumnVindexes, 2)
+		assert.Equal(t, "c", resp.VSchema.Tables["t1"].ColumnVindexes[1].Columns[0])
+
+		saved, err := ts.GetVSchema(ctx, "testkeyspace")
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(resp.VSchema, saved), "saved vschema %v, want %v", saved, resp.VSchema)
+	})
+
+	t.Run("dry run with plan changes", func(t *testing.T) {
+		ts, vtctld := setup(t)
+
+		resp, err := vtctld.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+			Keyspace: "testkeyspace",
+			VSchema:  newVSchema("t1", "c"),
+			DryRun:   true,
+			PlanQueries: []string{
+				"select * from t1 where id = :id",
+				"select * from t1",
+				"select * from t1 where id = :id",
+				"select * from nope",
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.PlanChanges, 1)
+		change := resp.PlanChanges[0]
+		assert.Equal(t, "select * from t1 where id = :id", change.Query)
+		assert.Contains(t, change.OldPlan, `"OperatorType":"Route","Variant":"SelectEqualUnique"`)
+		assert.Contains(t, change.NewPlan, `"OperatorType":"Route","Variant":"SelectScatter"`)
+		assert.Empty(t, change.OldError)
+		assert.Empty(t, change.NewError)
+
+		saved, err := ts.GetVSchema(ctx, "testkeyspace")
+		require.NoError(t, err)
+		assert.Equal(t, currentVSchema, saved)
+	})
+
+	t.Run("skip validation", func(t *testing.T) {
+		_, vtctld := setup(t)
+
+		_, err := vtctld.ApplyVSchema(ctx, &vtctldatapb.ApplyVSchemaRequest{
+			Keyspace:       "testkeyspace",
+			VSchema:        newVSchema("t2", "id"),
+			SkipValidation: true,
+		})
+		assert.NoError(t, err)
+	})
+
+	errorTests := []struct {
+		name string
+		req  *vtctldatapb.ApplyVSchemaRequest
+		err  string
+	}{
+		{
+			name: "no vschema nor sql",
+			req:  &vtctldatapb.ApplyVSchemaRequest{Keyspace: "testkeyspace"},
+			err:  "exactly one of VSchema and Sql is required",
+		},
+		{
+			name: "unknown keyspace",
+			req:  &vtctldatapb.ApplyVSchemaRequest{Keyspace: "nope", VSchema: newVSchema("t1", "id")},
+			err:  "keyspace(nope) doesn't exist",
+		},
+		{
+			name: "unknown vindex type",
+			req: &vtctldatapb.ApplyVSchemaRequest{Keyspace: "testkeyspace", VSchema: &vschemapb.Keyspace{
+				Sharded:  true,
+				Vindexes: map[string]*vschemapb.Vindex{"v": {Type: "nope"}},
+			}},
+			err: `vindexType "nope" not found`,
+		},
+		{
+			name: "bad sql",
+			req:  &vtctldatapb.ApplyVSchemaRequest{Keyspace: "testkeyspace", Sql: "select 1"},
+			err:  "not a ddl statement",
+		},
+		{
+			name: "unknown table",
+			req:  &vtctldatapb.ApplyVSchemaRequest{Keyspace: "testkeyspace", VSchema: newVSchema("t2", "id")},
+			err:  "shard testkeyspace/-: table t2 not found",
+		},
+		{
+			name: "unknown column",
+			req:  &vtctldatapb.ApplyVSchemaRequest{Keyspace: "testkeyspace", VSchema: newVSchema("t1", "nope")},
+			err:  "shard testkeyspace/-: column nope of vindex hash not found in table t1",
+		},
+	}
+	for _, tt := range errorTests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ts, vtctld := setup(t)
+
+			_, err := vtctld.ApplyVSchema(ctx, tt.req)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.err)
+
+			saved, err := ts.GetVSchema(ctx, "testkeyspace")
+			require.NoError(t, err)
+			assert.Equal(t, currentVSchema, saved)
+		})
+	}
+}
+
 func TestChangeTabletType(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,312 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcvtctldserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/semantics"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
+	"vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// validateVSchemaTables checks that the tables of the vschema of a keyspace
+// exist in the schema of the primary tablet of each of its shards, with
+// their vindex, auto increment and declared columns.
+func validateVSchemaTables(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, keyspace string, vs *vschemapb.Keyspace, ks *vindexes.KeyspaceSchema) error {
+	shards, err := ts.FindAllShardsInKeyspace(ctx, keyspace)
+	if err != nil {
+		return err
+	}
+	if len(shards) == 0 {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "keyspace %s has no shards to validate its vschema against", keyspace)
+	}
+
+	names := make([]string, 0, len(shards))
+	for name := range shards {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	rec := concurrency.AllErrorRecorder{}
+	for _, name := range names {
+		si := shards[name]
+		if !si.HasMaster() {
+			rec.RecordError(fmt.Errorf("shard %s/%s has no primary tablet", keyspace, name))
+			continue
+		}
+		tablet, err := ts.GetTablet(ctx, si.MasterAlias)
+		if err != nil {
+			return fmt.Errorf("GetTablet(%v) failed: %w", si.MasterAlias, err)
+		}
+		sd, err := tmc.GetSchema(ctx, tablet.Tablet, nil, nil, true)
+		if err != nil {
+			return fmt.Errorf("GetSchema(%v) failed: %w", tablet.Tablet, err)
+		}
+		for _, err := range vschemaTableErrors(vs, ks, sd) {
+			rec.RecordError(fmt.Errorf("shard %s/%s: %v", keyspace, name, err))
+		}
+	}
+	if rec.HasErrors() {
+		return vterrors.Errorf(vtrpc.Code_FAILED_PRECONDITION, "the vschema of keyspace %s doesn't match its schema: %v", keyspace, rec.Error())
+	}
+	return nil
+}
+
+// vschemaTableErrors returns the tables and the columns of a vschema which
+// are missing from a schema.
+func vschemaTableErrors(vs *vschemapb.Keyspace, ks *vindexes.KeyspaceSchema, sd *tabletmanagerdatapb.SchemaDefinition) []error {
+	tds := make(map[string]*tabletmanagerdatapb.TableDefinition, len(sd.TableDefinitions))
+	for _, td := range sd.TableDefinitions {
+		tds[td.Name] = td
+	}
+
+	names := make([]string, 0, len(vs.Tables))
+	for name := range vs.Tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		td, ok := tds[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("table %s not found", name))
+			continue
+		}
+		columns := make(map[string]bool, len(td.Columns))
+		for _, column := range td.Columns {
+			columns[strings.ToLower(column)] = true
+		}
+		checkColumn := func(column, usage string) {
+			if !columns[strings.ToLower(column)] {
+				errs = append(errs, fmt.Errorf("column %s of %s not found in table %s", column, usage, name))
+			}
+		}
+
+		table := ks.Tables[name]
+		for _, cv := range table.ColumnVindexes {
+			for _, column := range cv.Columns {
+				checkColumn(column.String(), "vindex "+cv.Name)
+			}
+		}
+		if ai := vs.Tables[name].AutoIncrement; ai != nil {
+			checkColumn(ai.Column, "auto increment")
+		}
+		for _, column := range table.Columns {
+			checkColumn(column.Name.String(), "the column list")
+		}
+	}
+	return errs
+}
+
+// planVSchemaChanges plans queries with the current and the next vschemas,
+// for a session targeting a keyspace, and returns the queries whose plans
+// differ.
+func planVSchemaChanges(keyspace string, current, next *vindexes.VSchema, queries []string) []*vtctldatapb.VSchemaPlanChange {
+	var changes []*vtctldatapb.VSchemaPlanChange
+	seen := make(map[string]bool, len(queries))
+	for _, query := range queries {
+		if seen[query] {
+			continue
+		}
+		seen[query] = true
+
+		oldPlan, oldErr := planQuery(query, &plannerVSchema{vschema: current, keyspace: keyspace})
+		newPlan, newErr := planQuery(query, &plannerVSchema{vschema: next, keyspace: keyspace})
+		if oldPlan == newPlan && oldErr == newErr {
+			continue
+		}
+		changes = append(changes, &vtctldatapb.VSchemaPlanChange{
+			Query:    query,
+			OldPlan:  oldPlan,
+			OldError: oldErr,
+			NewPlan:  newPlan,
+			NewError: newErr,
+		})
+	}
+	return changes
+}
+
+// planQuery returns the plan of a query in JSON, or the error planning it.
+func planQuery(query string, vschema *plannerVSchema) (string, string) {
+	stmt, reservedVars, err := sqlparser.Parse2(query)
+	if err != nil {
+		return "", err.Error()
+	}
+	result, err := sqlparser.RewriteAST(stmt, vschema.keyspace)
+	if err != nil {
+		return "", err.Error()
+	}
+	plan, err := planbuilder.BuildFromStmt(query, result.AST, reservedVars, vschema, result.BindVarNeeds)
+	if err != nil {
+		return "", err.Error()
+	}
+	data, err := json.Marshal(plan)
+	if err != nil {
+		return "", err.Error()
+	}
+	return string(data), ""
+}
+
+// plannerVSchema implements planbuilder.ContextVSchema to plan the queries
+// of a session targeting a keyspace, with the default settings of vtgate.
+type plannerVSchema struct {
+	vschema  *vindexes.VSchema
+	keyspace string
+}
+
+var _ planbuilder.ContextVSchema = (*plannerVSchema)(nil)
+
+func (pv *plannerVSchema) FindTable(name sqlparser.TableName) (*vindexes.Table, string, topodatapb.TabletType, key.Destination, error) {
+	destKeyspace, destTabletType, dest, err := topoproto.ParseDestination(name.Qualifier.String(), topodatapb.TabletType_MASTER)
+	if err != nil {
+		return nil, "", destTabletType, nil, err
+	}
+	if destKeyspace == "" {
+		destKeyspace = pv.keyspace
+	}
+	table, err := pv.vschema.FindTable(destKeyspace, name.Name.String())
+	if err != nil {
+		return nil, "", destTabletType, nil, err
+	}
+	return table, destKeyspace, destTabletType, dest, nil
+}
+
+func (pv *plannerVSchema) FindTableOrVindex(name sqlparser.TableName) (*vindexes.Table, vindexes.Vindex, string, topodatapb.TabletType, key.Destination, error) {
+	destKeyspace, destTabletType, dest, err := topoproto.ParseDestination(name.Qualifier.String(), topodatapb.TabletType_MASTER)
+	if err != nil {
+		return nil, nil, "", destTabletType, nil, err
+	}
+	if destKeyspace == "" {
+		destKeyspace = pv.keyspace
+	}
+	table, vindex, err := pv.vschema.FindTableOrVindex(destKeyspace, name.Name.String(), destTabletType)
+	if err != nil {
+		return nil, nil, "", destTabletType, nil, err
+	}
+	return table, vindex, destKeyspace, destTabletType, dest, nil
+}
+
+func (pv *plannerVSchema) DefaultKeyspace() (*vindexes.Keyspace, error) {
+	ks, ok := pv.vschema.Keyspaces[pv.keyspace]
+	if !ok {
+		return nil, vterrors.NewErrorf(vtrpc.Code_NOT_FOUND, vterrors.BadDb, "Unknown database '%s' in vschema", pv.keyspace)
+	}
+	return ks.Keyspace, nil
+}
+
+func (pv *plannerVSchema) TargetString() string {
+	return pv.keyspace
+}
+
+func (pv *plannerVSchema) Destination() key.Destination {
+	return nil
+}
+
+func (pv *plannerVSchema) TabletType() topodatapb.TabletType {
+	return topodatapb.TabletType_MASTER
+}
+
+func (pv *plannerVSchema) TargetDestination(qualifier string) (key.Destination, *vindexes.Keyspace, topodatapb.TabletType, error) {
+	keyspace := pv.keyspace
+	if qualifier != "" {
+		keyspace = qualifier
+	}
+	ks, ok := pv.vschema.Keyspaces[keyspace]
+	if !ok {
+		return nil, nil, topodatapb.TabletType_MASTER, vterrors.NewErrorf(vtrpc.Code_NOT_FOUND, vterrors.BadDb, "Unknown database '%s' in vschema", keyspace)
+	}
+	return nil, ks.Keyspace, topodatapb.TabletType_MASTER, nil
+}
+
+func (pv *plannerVSchema) AnyKeyspace() (*vindexes.Keyspace, error) {
+	return pv.DefaultKeyspace()
+}
+
+func (pv *plannerVSchema) FirstSortedKeyspace() (*vindexes.Keyspace, error) {
+	keyspaces, err := pv.AllKeyspace()
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(keyspaces, func(i, j int) bool {
+		return keyspaces[i].Name < keyspaces[j].Name
+	})
+	return keyspaces[0], nil
+}
+
+func (pv *plannerVSchema) SysVarSetEnabled() bool {
+	return true
+}
+
+func (pv *plannerVSchema) KeyspaceExists(keyspace string) bool {
+	_, ok := pv.vschema.Keyspaces[keyspace]
+	return ok
+}
+
+func (pv *plannerVSchema) AllKeyspace() ([]*vindexes.Keyspace, error) {
+	if len(pv.vschema.Keyspaces) == 0 {
+		return nil, vterrors.New(vtrpc.Code_FAILED_PRECONDITION, "no keyspaces available")
+	}
+	keyspaces := make([]*vindexes.Keyspace, 0, len(pv.vschema.Keyspaces))
+	for _, ks := range pv.vschema.Keyspaces {
+		keyspaces = append(keyspaces, ks.Keyspace)
+	}
+	return keyspaces, nil
+}
+
+func (pv *plannerVSchema) KeyspaceTables(keyspace string) map[string]*vindexes.Table {
+	ks, ok := pv.vschema.Keyspaces[keyspace]
+	if !ok {
+		return nil
+	}
+	return ks.Tables
+}
+
+func (pv *plannerVSchema) GetSemTable() *semantics.SemTable {
+	return nil
+}
+
+func (pv *plannerVSchema) Planner() planbuilder.PlannerVersion {
+	return planbuilder.V3
+}
+
+func (pv *plannerVSchema) ErrorIfShardedF(keyspace *vindexes.Keyspace, _, errFmt string, params ...interface{}) error {
+	if keyspace.Sharded {
+		return fmt.Errorf(errFmt, params...)
+	}
+	return nil
+}
+
+func (pv *plannerVSchema) WarnUnshardedOnly(string, ...interface{}) {}
@@ -39,6 +39,7 @@ import (
 	"vitess.io/vitess/go/vt/wrangler"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 )
 
 // This file contains the query command group for vtctl.
@@ -85,6 +86,16 @@ func init() {
 		commandVtTabletStreamHealth,
 		"[-count <count, default 1>] <tablet alias>",
 		"Executes the StreamHealth streaming query to a vttablet process. Will stop after getting <count> answers."})
+	addCommand(queriesGroupName, command{
+		"VtTabletLiveQueries",
+		commandVtTabletLiveQueries,
+		"<tablet alias>",
+		"Lists the queries executing on the given tablet, with their connection and transaction IDs."})
+	addCommand(queriesGroupName, command{
+		"VtTabletKill",
+		commandVtTabletKill,
+		"[-transaction_id <transaction_id>] [-conn_id <connection_id>] <tablet alias>",
+		"Kills the query executing on the given MySQL connection of the tablet, or terminates the given transaction. See VtTabletLiveQueries for the IDs."})
 }
 
 type bindvars map[string]interface{}
@@ -476,3 +487,51 @@ func printQueryResult(writer io.Writer, qr *sqltypes.Result) {
 	// Print table.
 	table.Render()
 }
+
+func commandVtTabletLiveQueries(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the VtTabletLiveQueries command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	queries, err := wr.TabletManagerClient().LiveQueries(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), &tabletmanagerdatapb.LiveQueriesResponse{Queries: queries})
+}
+
+func commandVtTabletKill(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	transactionID := subFlags.Int64("transaction_id", 0, "the transaction to terminate")
+	connID := subFlags.Int64("conn_id", 0, "the MySQL connection whose query to kill")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the VtTabletKill command")
+	}
+	if (*transactionID == 0) == (*connID == 0) {
+		return fmt.Errorf("exactly one of -transaction_id and -conn_id is required for the VtTabletKill command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	if *transactionID != 0 {
+		return wr.TabletManagerClient().KillTransaction(ctx, tabletInfo.Tablet, *transactionID)
+	}
+	return wr.TabletManagerClient().KillQuery(ctx, tabletInfo.Tablet, *connID)
+}
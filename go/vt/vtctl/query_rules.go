@@ -0,0 +1,225 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtctl
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"strconv"
+
+	"context"
+
+	"vitess.io/vitess/go/vt/wrangler"
+)
+
+// This file contains the Query Rules command group for vtctl.
+
+const queryRulesGroupName = "Query Rules"
+
+func init() {
+	addCommandGroup(queryRulesGroupName)
+
+	addCommand(queryRulesGroupName, command{
+		"GetQueryRules",
+		commandGetQueryRules,
+		"<keyspace>",
+		"Displays the custom query rules of a keyspace, as read by the topocustomrule source of its tablets."})
+
+	addCommand(queryRulesGroupName, command{
+		"ValidateQueryRules",
+		commandValidateQueryRules,
+		"{-rules=<rules> || -rules_file=<rules_file>}",
+		"Validates custom query rules. The rules must have distinct names."})
+
+	addCommand(queryRulesGroupName, command{
+		"DiffQueryRules",
+		commandDiffQueryRules,
+		"{-rules=<rules> || -rules_file=<rules_file>} <keyspace>",
+		"Displays the rules that would be added, removed or changed by applying custom query rules to a keyspace."})
+
+	addCommand(queryRulesGroupName, command{
+		"ApplyQueryRules",
+		commandApplyQueryRules,
+		"{-rules=<rules> || -rules_file=<rules_file>} [-dry_run] [-query_log=<query_log_file>] [-author=<author>] <keyspace>",
+		"Applies custom query rules to a keyspace, and displays the changes made. With -query_log, also displays how many queries of a tablet query log sample, in the JSON format, each rule matches. The rules are recorded as a new version in the history of the keyspace."})
+
+	addCommand(queryRulesGroupName, command{
+		"GetQueryRulesHistory",
+		commandGetQueryRulesHistory,
+		"<keyspace>",
+		"Displays the last versions of the custom query rules applied to a keyspace, with their time and author."})
+
+	addCommand(queryRulesGroupName, command{
+		"RollbackQueryRules",
+		commandRollbackQueryRules,
+		"[-dry_run] [-author=<author>] <keyspace> <version>",
+		"Applies a previous version of the custom query rules of a keyspace, as listed by GetQueryRulesHistory, and displays the changes made."})
+}
+
+func commandGetQueryRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetQueryRules command")
+	}
+	qrs, err := wr.GetQueryRules(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), qrs)
+}
+
+func commandValidateQueryRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	queryRules := subFlags.String("rules", "", "Specify rules as a string")
+	queryRulesFile := subFlags.String("rules_file", "", "Specify rules in a file")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 0 {
+		return fmt.Errorf("ValidateQueryRules doesn't take any arguments")
+	}
+	data, err := readQueryRules(*queryRules, *queryRulesFile)
+	if err != nil {
+		return err
+	}
+	qrs, err := wrangler.ValidateQueryRules(data)
+	if err != nil {
+		return err
+	}
+	wr.Logger().Printf("%d query rules are valid\n", len(qrs.CopyUnderlying()))
+	return nil
+}
+
+func commandDiffQueryRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	queryRules := subFlags.String("rules", "", "Specify rules as a string")
+	queryRulesFile := subFlags.String("rules_file", "", "Specify rules in a file")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the DiffQueryRules command")
+	}
+	data, err := readQueryRules(*queryRules, *queryRulesFile)
+	if err != nil {
+		return err
+	}
+	changes, err := wr.DiffQueryRules(ctx, subFlags.Arg(0), data)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), changes)
+}
+
+func commandApplyQueryRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	queryRules := subFlags.String("rules", "", "Specify rules as a string")
+	queryRulesFile := subFlags.String("rules_file", "", "Specify rules in a file")
+	dryRun := subFlags.Bool("dry_run", false, "Only display the changes, don't apply them")
+	queryLogFile := subFlags.String("query_log", "", "A sample of a tablet query log in the JSON format, to count the queries matched by each rule")
+	author := subFlags.String("author", currentUser(), "The author of the rules, recorded in their history. Defaults to the OS user")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the ApplyQueryRules command")
+	}
+	data, err := readQueryRules(*queryRules, *queryRulesFile)
+	if err != nil {
+		return err
+	}
+
+	result := struct {
+		Changes []wrangler.QueryRuleChange
+		Matches *wrangler.QueryLogMatches `json:",omitempty"`
+	}{}
+	if *queryLogFile != "" {
+		qrs, err := wrangler.ValidateQueryRules(data)
+		if err != nil {
+			return err
+		}
+		f, err := os.Open(*queryLogFile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		if result.Matches, err = wrangler.MatchQueryLog(qrs, f); err != nil {
+			return err
+		}
+	}
+	if result.Changes, err = wr.ApplyQueryRules(ctx, subFlags.Arg(0), data, *author, *dryRun); err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), result)
+}
+
+func commandGetQueryRulesHistory(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <keyspace> argument is required for the GetQueryRulesHistory command")
+	}
+	history, err := wr.GetQueryRulesHistory(ctx, subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), history)
+}
+
+func commandRollbackQueryRules(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	dryRun := subFlags.Bool("dry_run", false, "Only display the changes, don't apply them")
+	author := subFlags.String("author", currentUser(), "The author of the rollback, recorded in the history of the rules. Defaults to the OS user")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <keyspace> and <version> arguments are required for the RollbackQueryRules command")
+	}
+	version, err := strconv.ParseInt(subFlags.Arg(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("bad version %q: %v", subFlags.Arg(1), err)
+	}
+	changes, err := wr.RollbackQueryRules(ctx, subFlags.Arg(0), version, *author, *dryRun)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), changes)
+}
+
+// currentUser returns the name of the OS user running the command, or ""
+// if it cannot be determined.
+func currentUser() string {
+	if u, err := user.Current(); err == nil {
+		return u.Username
+	}
+	return ""
+}
+
+func readQueryRules(queryRules, queryRulesFile string) ([]byte, error) {
+	switch {
+	case queryRules != "" && queryRulesFile != "":
+		return nil, fmt.Errorf("only one of -rules and -rules_file can be specified")
+	case queryRulesFile != "":
+		return ioutil.ReadFile(queryRulesFile)
+	case queryRules != "":
+		return []byte(queryRules), nil
+	}
+	return nil, fmt.Errorf("one of -rules or -rules_file is required")
+}
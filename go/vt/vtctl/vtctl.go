@@ -117,6 +117,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/wrangler"
 
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
@@ -174,6 +175,12 @@ var commands = []commandGroup{
 			{"SetReadWrite", commandSetReadWrite,
 				"<tablet alias>",
 				"Sets the tablet as read-write."},
+			{"GetMysqlConfigOverrides", commandGetMysqlConfigOverrides,
+				"<tablet alias>",
+				"Outputs the MySQL config overrides of the tablet, which are applied on top of its my.cnf template."},
+			{"SetMysqlConfigOverrides", commandSetMysqlConfigOverrides,
+				"[-clear] [-remove=<name1,name2,...>] <tablet alias> [<name>=<value> ...]",
+				"Sets MySQL config overrides of the tablet, which are applied on top of its my.cnf template. An empty value adds the option without a value. The tablet regenerates my.cnf and changes its dynamic global variables when it reloads the overrides, see -mysql_config_reload_interval."},
 			{"StartReplication", commandStartReplication,
 				"<table alias>",
 				"Starts replication on the specified tablet."},
@@ -331,6 +338,9 @@ var commands = []commandGroup{
 			{"VDiff", commandVDiff,
 				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] <keyspace.workflow>",
 				"Perform a diff of all tables in the workflow"},
+			{"VDiffChecksum", commandVDiffChecksum,
+				"[-source_cell=<cell>] [-target_cell=<cell>] [-tablet_types=replica] [-filtered_replication_wait_time=30s] [-chunk_size=100000] [-max_rows_per_second=0] <keyspace.workflow>",
+				"Compare the row counts and checksums of all tables in the workflow by primary key ranges, and report the mismatched ranges"},
 			{"MigrateServedTypes", commandMigrateServedTypes,
 				"[-cells=c1,c2,...] [-reverse] [-skip-refresh-state] [-filtered_replication_wait_time=30s] [-reverse_replication=false] <keyspace/shard> <served tablet type>",
 				"Migrates a serving type from the source shard to the shards that it replicates to. This command also rebuilds the serving graph. The <keyspace/shard> argument can specify any of the shards involved in the migration."},
@@ -436,6 +446,13 @@ var commands = []commandGroup{
 				"<keyspace name>",
 				"Validates that the master permissions from shard 0 match those of all of the other tablets in the keyspace."},
 
+			{"ShowTransactions", commandShowTransactions,
+				"<tablet alias>",
+				"Displays the transactions open on a tablet, with their ids, start times and queries."},
+			{"KillTransaction", commandKillTransaction,
+				"<tablet alias> <transaction id>",
+				"Terminates a transaction open on a tablet. See ShowTransactions for the ids."},
+
 			{"GetVSchema", commandGetVSchema,
 				"<keyspace>",
 				"Displays the VTGate routing schema."},
@@ -728,6 +745,67 @@ func commandGetTablet(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag
 	return printJSON(wr.Logger(), tabletInfo.Tablet)
 }
 
+func commandGetMysqlConfigOverrides(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the GetMysqlConfigOverrides command")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	overrides, err := wr.TopoServer().GetMysqlConfigOverrides(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), overrides)
+}
+
+func commandSetMysqlConfigOverrides(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	clearOverrides := subFlags.Bool("clear", false, "Removes the existing overrides first")
+	remove := subFlags.String("remove", "", "Comma separated list of overrides to remove")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() < 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the SetMysqlConfigOverrides command")
+	}
+
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	if _, err := wr.TopoServer().GetTablet(ctx, tabletAlias); err != nil {
+		return err
+	}
+	overrides, err := wr.TopoServer().GetMysqlConfigOverrides(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	if *clearOverrides {
+		overrides = make(map[string]string)
+	}
+	if *remove != "" {
+		for _, name := range strings.Split(*remove, ",") {
+			delete(overrides, strings.TrimSpace(name))
+		}
+	}
+	for _, arg := range subFlags.Args()[1:] {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return fmt.Errorf("invalid override %v, expected <name>=<value>", arg)
+		}
+		overrides[strings.TrimSpace(parts[0])] = strings.TrimSpace(parts[1])
+	}
+	if err := wr.TopoServer().SaveMysqlConfigOverrides(ctx, tabletAlias, overrides); err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), overrides)
+}
+
 func commandUpdateTabletAddrs(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	hostname := subFlags.String("hostname", "", "The fully qualified host name of the server on which the tablet is running.")
 	mysqlHost := subFlags.String("mysql_host", "", "The mysql host for the mysql server")
@@ -2358,6 +2436,36 @@ func commandVDiff(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.Fla
 	return err
 }
 
+func commandVDiffChecksum(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	sourceCell := subFlags.String("source_cell", "", "The source cell to compare from")
+	targetCell := subFlags.String("target_cell", "", "The target cell to compare with")
+	tabletTypes := subFlags.String("tablet_types", "master,replica,rdonly", "Tablet types for source and target")
+	filteredReplicationWaitTime := subFlags.Duration("filtered_replication_wait_time", 30*time.Second, "Specifies the maximum time to wait, in seconds, for filtered replication to catch up on master migrations. The migration will be cancelled on a timeout.")
+	chunkSize := subFlags.Int64("chunk_size", 100000, "Number of values of the first primary key column in each checksummed range")
+	maxRowsPerSecond := subFlags.Int64("max_rows_per_second", 0, "Max rows checksummed per second across all shards, 0 means no throttling")
+	format := subFlags.String("format", "", "Format of report") //"json" or ""
+	tables := subFlags.String("tables", "", "Only run the checksums for these tables in the workflow")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("<keyspace.workflow> is required")
+	}
+	keyspace, workflow, err := splitKeyspaceWorkflow(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	_, err = wr.VDiffChecksum(ctx, keyspace, workflow, *sourceCell, *targetCell, *tabletTypes, *filteredReplicationWaitTime, *format, *chunkSize, *maxRowsPerSecond, *tables)
+	if err != nil {
+		log.Errorf("vdiff checksum returning with error: %v", err)
+		if strings.Contains(err.Error(), "context deadline exceeded") {
+			return fmt.Errorf("vdiff checksum timed out: you may want to increase it with the flag -filtered_replication_wait_time=<timeoutSeconds>")
+		}
+	}
+	return err
+}
+
 func splitKeyspaceWorkflow(in string) (keyspace, workflow string, err error) {
 	splits := strings.Split(in, ".")
 	if len(splits) != 2 {
@@ -2809,7 +2917,7 @@ func commandApplySchema(ctx context.Context, wr *wrangler.Wrangler, subFlags *fl
 	allowLongUnavailability := subFlags.Bool("allow_long_unavailability", false, "Allow large schema changes which incur a longer unavailability of the database.")
 	sql := subFlags.String("sql", "", "A list of semicolon-delimited SQL commands")
 	sqlFile := subFlags.String("sql-file", "", "Identifies the file that contains the SQL commands")
-	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100'")
+	ddlStrategy := subFlags.String("ddl_strategy", string(schema.DDLStrategyDirect), "Online DDL strategy, compatible with @@ddl_strategy session variable (examples: 'gh-ost', 'pt-osc', 'gh-ost --max-load=Threads_running=100', 'online -declarative' to apply CREATE TABLE statements as the desired table definitions)")
 	waitReplicasTimeout := subFlags.Duration("wait_replicas_timeout", wrangler.DefaultWaitReplicasTimeout, "The amount of time to wait for replicas to receive the schema change via replication.")
 	skipPreflight := subFlags.Bool("skip_preflight", false, "Skip pre-apply schema checks, and dircetly forward schema change query to shards")
 	if err := subFlags.Parse(args); err != nil {
@@ -3044,6 +3152,50 @@ func commandGetPermissions(ctx context.Context, wr *wrangler.Wrangler, subFlags
 	return err
 }
 
+func commandShowTransactions(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 1 {
+		return fmt.Errorf("the <tablet alias> argument is required for the ShowTransactions command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	txs, err := wr.TabletManagerClient().ShowTransactions(ctx, tabletInfo.Tablet)
+	if err != nil {
+		return err
+	}
+	return printJSON(wr.Logger(), &tabletmanagerdatapb.ShowTransactionsResponse{Transactions: txs})
+}
+
+func commandKillTransaction(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if subFlags.NArg() != 2 {
+		return fmt.Errorf("the <tablet alias> and <transaction id> arguments are required for the KillTransaction command")
+	}
+	tabletAlias, err := topoproto.ParseTabletAlias(subFlags.Arg(0))
+	if err != nil {
+		return err
+	}
+	transactionID, err := strconv.ParseInt(subFlags.Arg(1), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid transaction id %v: %v", subFlags.Arg(1), err)
+	}
+	tabletInfo, err := wr.TopoServer().GetTablet(ctx, tabletAlias)
+	if err != nil {
+		return err
+	}
+	return wr.TabletManagerClient().KillTransaction(ctx, tabletInfo.Tablet, transactionID)
+}
+
 func commandValidatePermissionsShard(ctx context.Context, wr *wrangler.Wrangler, subFlags *flag.FlagSet, args []string) error {
 	if err := subFlags.Parse(args); err != nil {
 		return err
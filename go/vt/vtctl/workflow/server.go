@@ -27,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtctl/workflow/vexec"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -170,7 +171,7 @@ func (s *Server) GetWorkflows(ctx context.Context, req *vtctldatapb.GetWorkflows
 			Message: message,
 		}
 
-		stream.CopyStates, err = s.getWorkflowCopyStates(ctx, tablet, id)
+		stream.CopyStates, err = s.getWorkflowCopyStates(ctx, tablet, id, &bls)
 		if err != nil {
 			return err
 		}
@@ -316,8 +317,8 @@ func (s *Server) GetWorkflows(ctx context.Context, req *vtctldatapb.GetWorkflows
 	}, nil
 }
 
-func (s *Server) getWorkflowCopyStates(ctx context.Context, tablet *topo.TabletInfo, id int64) ([]*vtctldatapb.Workflow_Stream_CopyState, error) {
-	query := fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id = %d", id)
+func (s *Server) getWorkflowCopyStates(ctx context.Context, tablet *topo.TabletInfo, id int64, bls *binlogdatapb.BinlogSource) ([]*vtctldatapb.Workflow_Stream_CopyState, error) {
+	query := fmt.Sprintf("select table_name, lastpk, rows_copied from _vt.copy_state where vrepl_id = %d", id)
 	qr, err := s.tmc.VReplicationExec(ctx, tablet.Tablet, query)
 	if err != nil {
 		return nil, err
@@ -329,13 +330,75 @@ func (s *Server) getWorkflowCopyStates(ctx context.Context, tablet *topo.TabletI
 	}
 
 	copyStates := make([]*vtctldatapb.Workflow_Stream_CopyState, len(result.Rows))
+	tables := make([]string, len(result.Rows))
 	for i, row := range result.Rows {
+		rowsCopied, err := evalengine.ToInt64(row[2])
+		if err != nil {
+			return nil, err
+		}
+
 		// These fields are technically varbinary, but this is close enough.
 		copyStates[i] = &vtctldatapb.Workflow_Stream_CopyState{
-			Table:  row[0].ToString(),
-			LastPk: row[1].ToString(),
+			Table:      row[0].ToString(),
+			LastPk:     row[1].ToString(),
+			RowsCopied: rowsCopied,
+		}
+		tables[i] = copyStates[i].Table
+	}
+
+	if len(copyStates) == 0 {
+		return copyStates, nil
+	}
+
+	// The remaining rows are only an estimate, based on the table statistics
+	// of the source, so failing to get them does not fail the workflow.
+	rowCounts, err := GetSourceRowCounts(ctx, s.ts, s.tmc, bls, tables)
+	if err != nil {
+		log.Warningf("cannot estimate the rows remaining to copy for stream %d on %s: %v", id, tablet.AliasString(), err)
+		return copyStates, nil
+	}
+
+	for _, copyState := range copyStates {
+		rowCount, ok := rowCounts[copyState.Table]
+		if !ok {
+			continue
+		}
+
+		copyState.RowsRemaining = int64(rowCount) - copyState.RowsCopied
+		if copyState.RowsRemaining < 0 {
+			copyState.RowsRemaining = 0
 		}
 	}
 
 	return copyStates, nil
 }
+
+// GetSourceRowCounts returns the estimated row counts of the tables on the
+// primary of the source shard of a stream.
+func GetSourceRowCounts(ctx context.Context, ts *topo.Server, tmc tmclient.TabletManagerClient, bls *binlogdatapb.BinlogSource, tables []string) (map[string]uint64, error) {
+	si, err := ts.GetShard(ctx, bls.Keyspace, bls.Shard)
+	if err != nil {
+		return nil, err
+	}
+
+	if si.MasterAlias == nil {
+		return nil, fmt.Errorf("shard %s/%s has no master", bls.Keyspace, bls.Shard)
+	}
+
+	source, err := ts.GetTablet(ctx, si.MasterAlias)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := tmc.GetSchema(ctx, source.Tablet, tables, nil, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rowCounts := make(map[string]uint64, len(tables))
+	for _, td := range schema.GetTableDefinitions() {
+		rowCounts[td.Name] = td.RowCount
+	}
+
+	return rowCounts, nil
+}
@@ -29,6 +29,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/netutil"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
@@ -45,6 +46,7 @@ import (
 	logutilpb "vitess.io/vitess/go/vt/proto/logutil"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtctldatapb "vitess.io/vitess/go/vt/proto/vtctldata"
 	"vitess.io/vitess/go/vt/proto/vttime"
 )
 
@@ -192,6 +194,15 @@ func unmarshalRequest(r *http.Request, v interface{}) error {
 	return json.Unmarshal(data, v)
 }
 
+// httpUser returns the user who made an HTTP request: the user it was
+// authenticated as with basic authentication, or else its remote address.
+func httpUser(r *http.Request) string {
+	if user, _, ok := r.BasicAuth(); ok && user != "" {
+		return user
+	}
+	return r.RemoteAddr
+}
+
 func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, realtimeStats *realtimeStats) {
 	tabletHealthCache := newTabletHealthCache(ts)
 	tmClient := tmclient.NewTabletManagerClient()
@@ -644,6 +655,144 @@ func initAPI(ctx context.Context, ts *topo.Server, actions *ActionRepository, re
 			schemamanager.NewUIController(req.SQL, req.Keyspace, w), executor)
 	})
 
+	// VSchema
+	handleAPI("vschema/", func(w http.ResponseWriter, r *http.Request) error {
+		// Get the vschema of a keyspace with GET vschema/<keyspace>, and
+		// validate, plan and apply a new one with POST vschema/<keyspace>,
+		// whose body is an ApplyVSchemaRequest.
+		keyspace := getItemPath(r.URL.Path)
+		if keyspace == "" {
+			return errors.New("keyspace is required")
+		}
+		wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmClient)
+
+		var resp interface{}
+		switch r.Method {
+		case http.MethodGet:
+			vs, err := wr.TopoServer().GetVSchema(r.Context(), keyspace)
+			if err != nil {
+				return err
+			}
+			resp = vs
+		case http.MethodPost:
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				http.Error(w, "403 Forbidden", http.StatusForbidden)
+				return nil
+			}
+			data, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return err
+			}
+			req := &vtctldatapb.ApplyVSchemaRequest{}
+			if err := json2.Unmarshal(data, req); err != nil {
+				return fmt.Errorf("can't unmarshal request: %v", err)
+			}
+			req.Keyspace = keyspace
+			if resp, err = wr.VtctldServer().ApplyVSchema(r.Context(), req); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported vschema request %v %v", r.Method, r.URL.Path)
+		}
+
+		data, err := vtctl.MarshalJSON(resp)
+		if err != nil {
+			return fmt.Errorf("cannot marshal data: %v", err)
+		}
+		w.Header().Set("Content-Type", jsonContentType)
+		w.Write(data)
+		return nil
+	})
+
+	// Query Rules
+	handleAPI("query_rules/", func(w http.ResponseWriter, r *http.Request) error {
+		// Get the rules with GET query_rules/<keyspace>, their last versions
+		// with GET query_rules/<keyspace>/history, and validate, diff, apply
+		// or roll back rules with POST query_rules/<keyspace>/<action>.
+		keyspace, action := getItemPath(r.URL.Path), ""
+		if i := strings.Index(keyspace, "/"); i >= 0 {
+			keyspace, action = keyspace[:i], keyspace[i+1:]
+		}
+		if keyspace == "" {
+			return errors.New("keyspace is required")
+		}
+		wr := wrangler.New(logutil.NewConsoleLogger(), ts, tmClient)
+
+		var resp interface{}
+		switch {
+		case r.Method == http.MethodGet && action == "":
+			qrs, err := wr.GetQueryRules(r.Context(), keyspace)
+			if err != nil {
+				return err
+			}
+			resp = qrs
+		case r.Method == http.MethodGet && action == "history":
+			history, err := wr.GetQueryRulesHistory(r.Context(), keyspace)
+			if err != nil {
+				return err
+			}
+			resp = history
+		case r.Method == http.MethodPost:
+			if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+				http.Error(w, "403 Forbidden", http.StatusForbidden)
+				return nil
+			}
+			req := struct {
+				Rules    json.RawMessage
+				DryRun   bool
+				QueryLog string
+				Author   string
+				Version  int64
+			}{}
+			if err := unmarshalRequest(r, &req); err != nil {
+				return fmt.Errorf("can't unmarshal request: %v", err)
+			}
+			if req.Author == "" {
+				req.Author = httpUser(r)
+			}
+			var err error
+			switch action {
+			case "validate":
+				resp, err = wrangler.ValidateQueryRules(req.Rules)
+			case "diff":
+				resp, err = wr.DiffQueryRules(r.Context(), keyspace, req.Rules)
+			case "apply":
+				result := struct {
+					Changes []wrangler.QueryRuleChange
+					Matches *wrangler.QueryLogMatches `json:",omitempty"`
+				}{}
+				if req.QueryLog != "" {
+					qrs, err := wrangler.ValidateQueryRules(req.Rules)
+					if err != nil {
+						return err
+					}
+					if result.Matches, err = wrangler.MatchQueryLog(qrs, strings.NewReader(req.QueryLog)); err != nil {
+						return err
+					}
+				}
+				result.Changes, err = wr.ApplyQueryRules(r.Context(), keyspace, req.Rules, req.Author, req.DryRun)
+				resp = result
+			case "rollback":
+				resp, err = wr.RollbackQueryRules(r.Context(), keyspace, req.Version, req.Author, req.DryRun)
+			default:
+				return fmt.Errorf("unknown query rules action %q, expected validate, diff, apply or rollback", action)
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unsupported query rules request %v %v", r.Method, r.URL.Path)
+		}
+
+		data, err := json.MarshalIndent(resp, "", "  ")
+		if err != nil {
+			return fmt.Errorf("json error: %v", err)
+		}
+		w.Header().Set("Content-Type", jsonContentType)
+		w.Write(data)
+		return nil
+	})
+
 	// Features
 	handleAPI("features", func(w http.ResponseWriter, r *http.Request) error {
 		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
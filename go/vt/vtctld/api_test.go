@@ -412,6 +412,66 @@ func TestAPI(t *testing.T) {
 		   "Output": ""
 		}`, http.StatusOK},
 		{"POST", "vtctl/", `["Panic"]`, `uncaught panic: this command panics on purpose`, http.StatusInternalServerError},
+
+		// VSchema
+		{"GET", "vschema/ks1", "", `{
+			"sharded": true,
+			"vindexes": {"name1": {"type": "hash", "params": {}, "owner": ""}},
+			"tables": {
+				"table1": {"type": "", "column_vindexes": [{"column": "column1", "name": "name1", "columns": []}], "auto_increment": null, "columns": [], "pinned": "", "column_list_authoritative": false, "read_tablet_type": "", "source": ""}
+			},
+			"require_explicit_routing": false
+		}`, http.StatusOK},
+		{"POST", "vschema/ks1", `{"sql": "alter vschema on table2 add vindex name1(column2)", "dry_run": true, "skip_validation": true}`, `{
+			"v_schema": {
+				"sharded": true,
+				"vindexes": {"name1": {"type": "hash", "params": {}, "owner": ""}},
+				"tables": {
+					"table1": {"type": "", "column_vindexes": [{"column": "column1", "name": "name1", "columns": []}], "auto_increment": null, "columns": [], "pinned": "", "column_list_authoritative": false, "read_tablet_type": "", "source": ""},
+					"table2": {"type": "", "column_vindexes": [{"column": "", "name": "name1", "columns": ["column2"]}], "auto_increment": null, "columns": [], "pinned": "", "column_list_authoritative": false, "read_tablet_type": "", "source": ""}
+				},
+				"require_explicit_routing": false
+			},
+			"plan_changes": []
+		}`, http.StatusOK},
+		{"GET", "vschema/ks1", "", `{
+			"sharded": true,
+			"vindexes": {"name1": {"type": "hash", "params": {}, "owner": ""}},
+			"tables": {
+				"table1": {"type": "", "column_vindexes": [{"column": "column1", "name": "name1", "columns": []}], "auto_increment": null, "columns": [], "pinned": "", "column_list_authoritative": false, "read_tablet_type": "", "source": ""}
+			},
+			"require_explicit_routing": false
+		}`, http.StatusOK},
+		{"POST", "vschema/ks1", `{"v_schema": {"vindexes": {"name1": {"type": "nope"}}}, "skip_validation": true}`, "Code: INVALID_ARGUMENT\ninvalid vschema for keyspace ks1: vindexType \"nope\" not found", http.StatusInternalServerError},
+		{"POST", "vschema/does_not_exist", `{"sql": "alter vschema add table table2"}`, "Code: NOT_FOUND\nkeyspace(does_not_exist) doesn't exist", http.StatusInternalServerError},
+
+		// Query Rules
+		{"GET", "query_rules/ks1", "", `[]`, http.StatusOK},
+		{"POST", "query_rules/ks1/validate", `{"Rules": [{"Description": "no name", "Action": "FAIL"}]}`, `query rule 0 has no name`, http.StatusInternalServerError},
+		{"POST", "query_rules/ks1/diff", `{"Rules": [{"Name": "r1", "Description": "d1", "Query": "select.*", "Action": "FAIL"}]}`, `[
+			{"Name": "r1", "Change": "added", "New": {"Description": "d1", "Name": "r1", "Query": "select.*", "Action": "FAIL"}}
+		]`, http.StatusOK},
+		{"POST", "query_rules/ks1/apply", `{
+			"Rules": [{"Name": "r1", "Description": "d1", "Query": "select.*", "Action": "FAIL"}],
+			"DryRun": true,
+			"QueryLog": "{\"Username\": \"u\", \"PlanType\": \"Select\", \"OriginalSQL\": \"select * from t1\"}\n{\"Username\": \"u\", \"PlanType\": \"Insert\", \"OriginalSQL\": \"insert into t1 values (1)\"}\n"
+		}`, `{
+			"Changes": [{"Name": "r1", "Change": "added", "New": {"Description": "d1", "Name": "r1", "Query": "select.*", "Action": "FAIL"}}],
+			"Matches": {"Queries": 2, "Skipped": 0, "Rules": [{"Name": "r1", "Matches": 1}]}
+		}`, http.StatusOK},
+		{"GET", "query_rules/ks1", "", `[]`, http.StatusOK},
+		{"POST", "query_rules/ks1/apply", `{"Rules": [{"Name": "r1", "Description": "d1", "Query": "select.*", "Action": "FAIL"}]}`, `{
+			"Changes": [{"Name": "r1", "Change": "added", "New": {"Description": "d1", "Name": "r1", "Query": "select.*", "Action": "FAIL"}}]
+		}`, http.StatusOK},
+		{"GET", "query_rules/ks1", "", `[{"Description": "d1", "Name": "r1", "Query": "select.*", "Action": "FAIL"}]`, http.StatusOK},
+		{"POST", "query_rules/ks1/apply", `{"Rules": []}`, `{
+			"Changes": [{"Name": "r1", "Change": "removed", "Old": {"Description": "d1", "Name": "r1", "Query": "select.*", "Action": "FAIL"}}]
+		}`, http.StatusOK},
+		{"POST", "query_rules/ks1/rollback", `{"Version": 1, "Author": "admin"}`, `[
+			{"Name": "r1", "Change": "added", "New": {"Description": "d1", "Name": "r1", "Query": "select.*", "Action": "FAIL"}}
+		]`, http.StatusOK},
+		{"GET", "query_rules/ks1", "", `[{"Description": "d1", "Name": "r1", "Query": "select.*", "Action": "FAIL"}]`, http.StatusOK},
+		{"POST", "query_rules/ks1/rollback", `{"Version": 7}`, `version 7 of the query rules of keyspace ks1 not found in their history`, http.StatusInternalServerError},
 	}
 	for _, in := range table {
 		t.Run(in.method+in.path, func(t *testing.T) {
@@ -461,3 +521,15 @@ func TestAPI(t *testing.T) {
 
 	}
 }
+
+func TestHTTPUser(t *testing.T) {
+	r := httptest.NewRequest("POST", "/api/query_rules/ks1/apply", nil)
+	r.RemoteAddr = "10.0.0.1:1234"
+	if got, want := httpUser(r), "10.0.0.1:1234"; got != want {
+		t.Errorf("httpUser() = %q, want %q", got, want)
+	}
+	r.SetBasicAuth("alice", "secret")
+	if got, want := httpUser(r), "alice"; got != want {
+		t.Errorf("httpUser() = %q, want %q", got, want)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vtctl"
 	"vitess.io/vitess/go/vt/workflow"
+	"vitess.io/vitess/go/vt/workflow/lookupvindex"
 	"vitess.io/vitess/go/vt/workflow/resharding"
 	"vitess.io/vitess/go/vt/workflow/reshardingworkflowgen"
 	"vitess.io/vitess/go/vt/workflow/topovalidator"
@@ -61,6 +62,9 @@ func initWorkflowManager(ts *topo.Server) {
 		// Register workflow that generates Horizontal Resharding workflows.
 		reshardingworkflowgen.Register()
 
+		// Register the workflow that creates and backfills lookup vindexes.
+		lookupvindex.Register()
+
 		// Unregister the blacklisted workflows.
 		for _, name := range workflowManagerDisable {
 			workflow.Unregister(name)
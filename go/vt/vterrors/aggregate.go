@@ -17,6 +17,7 @@ limitations under the License.
 package vterrors
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 
@@ -76,6 +77,8 @@ var errorPriorities = map[vtrpcpb.Code]int{
 // Aggregate aggregates several errors into a single one.
 // The resulting error code will be the one with the highest
 // priority as defined by the priority constants in this package.
+// The aggregated errors, along with the shards they happened on,
+// can be enumerated with Parts.
 func Aggregate(errors []error) error {
 	if len(errors) == 0 {
 		return nil
@@ -83,7 +86,43 @@ func Aggregate(errors []error) error {
 	if len(errors) == 1 {
 		return errors[0]
 	}
-	return New(aggregateCodes(errors), aggregateErrors(errors))
+	var parts []error
+	for _, err := range errors {
+		if agg, ok := err.(*aggregateError); ok {
+			parts = append(parts, agg.errs...)
+			continue
+		}
+		parts = append(parts, err)
+	}
+	// sort the parts like the message, so we always have deterministic ordering
+	sort.SliceStable(parts, func(i, j int) bool {
+		return parts[i].Error() < parts[j].Error()
+	})
+	code := aggregateCodes(errors)
+	return &aggregateError{
+		fundamental: fundamental{
+			msg:   aggregateErrors(errors),
+			code:  code,
+			state: aggregateStates(parts, code),
+			stack: callers(),
+		},
+		errs: parts,
+	}
+}
+
+// aggregateError is the error returned by Aggregate.
+type aggregateError struct {
+	fundamental
+	errs []error
+}
+
+func findAggregate(err error) (*aggregateError, bool) {
+	for ; err != nil; err = Cause(err) {
+		if agg, ok := err.(*aggregateError); ok {
+			return agg, true
+		}
+	}
+	return nil, false
 }
 
 func aggregateCodes(errors []error) vtrpcpb.Code {
@@ -97,6 +136,17 @@ func aggregateCodes(errors []error) vtrpcpb.Code {
 	return highCode
 }
 
+// aggregateStates returns the state of the first error with the aggregated
+// code, so that the state matches the code.
+func aggregateStates(errors []error, code vtrpcpb.Code) State {
+	for _, e := range errors {
+		if Code(e) == code {
+			return ErrState(e)
+		}
+	}
+	return Undefined
+}
+
 // ConcatenateErrors aggregates an array of errors into a single error by string concatenation.
 func aggregateErrors(errs []error) string {
 	errStrs := make([]string, 0, len(errs))
@@ -107,3 +157,68 @@ func aggregateErrors(errs []error) string {
 	sort.Strings(errStrs)
 	return strings.Join(errStrs, "\n")
 }
+
+// WrapShard returns an error annotating err with the target it happened
+// on: its keyspace, shard and tablet type. Its message is prefixed with
+// "target: keyspace.shard.tablet_type". If err is nil, WrapShard returns nil.
+func WrapShard(err error, keyspace, shard, tabletType string) error {
+	if err == nil {
+		return nil
+	}
+	return &shardError{
+		wrapping: wrapping{
+			cause: err,
+			msg:   fmt.Sprintf("target: %s.%s.%s", keyspace, shard, tabletType),
+			stack: callers(),
+		},
+		keyspace:   keyspace,
+		shard:      shard,
+		tabletType: tabletType,
+	}
+}
+
+type shardError struct {
+	wrapping
+	keyspace   string
+	shard      string
+	tabletType string
+}
+
+// Part is one of the errors aggregated by Aggregate.
+type Part struct {
+	// Keyspace, Shard and TabletType are the target the error happened
+	// on, if it was annotated with WrapShard.
+	Keyspace   string
+	Shard      string
+	TabletType string
+	Code       vtrpcpb.Code
+	Err        error
+}
+
+// Parts returns the errors aggregated into err by Aggregate, sorted by
+// message. An error that is not an aggregate is its only part.
+// Parts returns nil if err is nil.
+func Parts(err error) []Part {
+	if err == nil {
+		return nil
+	}
+	errs := []error{err}
+	if agg, ok := findAggregate(err); ok {
+		errs = agg.errs
+	}
+	parts := make([]Part, 0, len(errs))
+	for _, e := range errs {
+		part := Part{
+			Code: Code(e),
+			Err:  e,
+		}
+		for cause := e; cause != nil; cause = Cause(cause) {
+			if se, ok := cause.(*shardError); ok {
+				part.Keyspace, part.Shard, part.TabletType = se.keyspace, se.shard, se.tabletType
+				break
+			}
+		}
+		parts = append(parts, part)
+	}
+	return parts
+}
@@ -21,6 +21,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -101,3 +104,72 @@ func TestAggregateVtGateErrors(t *testing.T) {
 		}
 	}
 }
+
+func TestAggregateParts(t *testing.T) {
+	err1 := WrapShard(New(vtrpcpb.Code_UNAVAILABLE, "tablet down"), "ks", "-80", "master")
+	err2 := WrapShard(New(vtrpcpb.Code_INVALID_ARGUMENT, "bad query"), "ks", "80-", "master")
+	err3 := errors.New("no shard")
+
+	agg := Aggregate([]error{err1, err2, err3})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, Code(agg))
+	assert.Equal(t, "no shard\ntarget: ks.-80.master: tablet down\ntarget: ks.80-.master: bad query", agg.Error())
+	want := []Part{{
+		Code: vtrpcpb.Code_UNKNOWN,
+		Err:  err3,
+	}, {
+		Keyspace:   "ks",
+		Shard:      "-80",
+		TabletType: "master",
+		Code:       vtrpcpb.Code_UNAVAILABLE,
+		Err:        err1,
+	}, {
+		Keyspace:   "ks",
+		Shard:      "80-",
+		TabletType: "master",
+		Code:       vtrpcpb.Code_INVALID_ARGUMENT,
+		Err:        err2,
+	}}
+	assert.Equal(t, want, Parts(agg))
+	// The parts are still found once the aggregate is wrapped.
+	assert.Equal(t, want, Parts(Wrap(agg, "scatter failed")))
+
+	// Aggregates of aggregates are flattened.
+	err4 := WrapShard(New(vtrpcpb.Code_DATA_LOSS, "corrupt"), "other", "0", "replica")
+	agg = Aggregate([]error{agg, err4})
+	assert.Equal(t, vtrpcpb.Code_DATA_LOSS, Code(agg))
+	parts := Parts(agg)
+	require.Len(t, parts, 4)
+	assert.Equal(t, "other", parts[3].Keyspace)
+	assert.Equal(t, vtrpcpb.Code_DATA_LOSS, parts[3].Code)
+
+	// A single error is its only part.
+	assert.Equal(t, []Part{want[1]}, Parts(err1))
+	assert.Nil(t, Parts(nil))
+}
+
+func TestWrapShard(t *testing.T) {
+	assert.Nil(t, WrapShard(nil, "ks", "0", "master"))
+	err := WrapShard(New(vtrpcpb.Code_UNAVAILABLE, "tablet down"), "ks", "0", "master")
+	assert.Equal(t, "target: ks.0.master: tablet down", err.Error())
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, Code(err))
+	assert.Equal(t, "tablet down", RootCause(err).Error())
+}
+
+func TestAggregateState(t *testing.T) {
+	err1 := NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, NetPacketTooLarge, "too many rows")
+	err2 := NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, WrongNumberOfColumnsInSelect, "bad select")
+	err3 := New(vtrpcpb.Code_UNAVAILABLE, "tablet down")
+
+	// The state is the state of the error with the aggregated code.
+	agg := Aggregate([]error{err1, WrapShard(err2, "ks", "0", "master"), err3})
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, Code(agg))
+	assert.Equal(t, WrongNumberOfColumnsInSelect, ErrState(agg))
+	assert.Equal(t, WrongNumberOfColumnsInSelect, ErrState(Wrap(agg, "scatter failed")))
+
+	agg = Aggregate([]error{err1, err3})
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, Code(agg))
+	assert.Equal(t, NetPacketTooLarge, ErrState(agg))
+
+	agg = Aggregate([]error{err3, errors.New("no shard")})
+	assert.Equal(t, Undefined, ErrState(agg))
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vterrors
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// The keys of the details attached to errors by Vitess. Other keys can
+// be used freely.
+const (
+	DetailKeyspace    = "keyspace"
+	DetailShard       = "shard"
+	DetailTabletType  = "tablet_type"
+	DetailTabletAlias = "tablet_alias"
+	DetailQueryDigest = "query_digest"
+	DetailErrno       = "errno"
+	DetailSQLState    = "sqlstate"
+)
+
+// WithDetail returns an error annotating err with a key/value detail.
+// See WithDetails.
+func WithDetail(err error, key, value string) error {
+	return WithDetails(err, map[string]string{key: value})
+}
+
+// WithDetails returns an error annotating err with key/value details,
+// which are returned by Details. Unlike the message of err, which is
+// unchanged, the details are sent as gRPC error details by ToGRPC, and
+// restored by FromGRPC. If err is nil, WithDetails returns nil.
+func WithDetails(err error, details map[string]string) error {
+	if err == nil {
+		return nil
+	}
+	copied := make(map[string]string, len(details))
+	for key, value := range details {
+		copied[key] = value
+	}
+	return &detailsError{
+		cause:   err,
+		details: copied,
+	}
+}
+
+type detailsError struct {
+	cause   error
+	details map[string]string
+}
+
+func (d *detailsError) Error() string { return d.cause.Error() }
+func (d *detailsError) Cause() error  { return d.cause }
+
+func (d *detailsError) Format(s fmt.State, verb rune) {
+	if f, ok := d.cause.(fmt.Formatter); ok {
+		f.Format(s, verb)
+		return
+	}
+	panicIfError(fmt.Fprintf(s, "%s", d.cause.Error()))
+}
+
+// Details returns the key/value details of err: the ones attached with
+// WithDetails, and the target of WrapShard. The outermost details win.
+// The details of the parts of an aggregated error are not returned.
+// Details returns nil if err has none.
+func Details(err error) map[string]string {
+	var details map[string]string
+	add := func(key, value string) {
+		if details == nil {
+			details = make(map[string]string)
+		}
+		if _, ok := details[key]; !ok {
+			details[key] = value
+		}
+	}
+	for ; err != nil; err = Cause(err) {
+		switch err := err.(type) {
+		case *detailsError:
+			for key, value := range err.details {
+				add(key, value)
+			}
+		case *shardError:
+			add(DetailKeyspace, err.keyspace)
+			add(DetailShard, err.shard)
+			add(DetailTabletType, err.tabletType)
+		case *aggregateError:
+			return details
+		}
+	}
+	return details
+}
+
+// Detail returns the detail of err for key, or "" if it has none.
+func Detail(err error, key string) string {
+	return Details(err)[key]
+}
+
+// SQLErrorDetails returns the MySQL error number and SQL state of err, as
+// attached in its DetailErrno and DetailSQLState details. It returns false
+// if err has no valid MySQL error number.
+func SQLErrorDetails(err error) (int, string, bool) {
+	details := Details(err)
+	errno, convErr := strconv.Atoi(details[DetailErrno])
+	if convErr != nil || errno == 0 {
+		return 0, "", false
+	}
+	return errno, details[DetailSQLState], true
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vterrors
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestDetails(t *testing.T) {
+	assert.Nil(t, WithDetails(nil, map[string]string{"a": "b"}))
+	assert.Nil(t, Details(nil))
+	assert.Nil(t, Details(errors.New("no details")))
+
+	err := New(vtrpcpb.Code_ALREADY_EXISTS, "Duplicate entry '1' for key 'PRIMARY' (errno 1062) (sqlstate 23000)")
+	details := map[string]string{
+		DetailErrno:       "1062",
+		DetailSQLState:    "23000",
+		DetailTabletAlias: "zone1-0000000100",
+	}
+	withDetails := WithDetails(err, details)
+	details[DetailErrno] = "changed"
+	assert.Equal(t, err.Error(), withDetails.Error())
+	assert.Equal(t, vtrpcpb.Code_ALREADY_EXISTS, Code(withDetails))
+	assert.Equal(t, "1062", Detail(withDetails, DetailErrno))
+
+	// The outermost details win, and the target of WrapShard is a detail.
+	wrapped := WrapShard(WithDetail(withDetails, DetailTabletAlias, "zone1-0000000101"), "ks", "-80", "master")
+	assert.Equal(t, map[string]string{
+		DetailErrno:       "1062",
+		DetailSQLState:    "23000",
+		DetailTabletAlias: "zone1-0000000101",
+		DetailKeyspace:    "ks",
+		DetailShard:       "-80",
+		DetailTabletType:  "master",
+	}, Details(wrapped))
+
+	errno, sqlState, ok := SQLErrorDetails(wrapped)
+	assert.True(t, ok)
+	assert.Equal(t, 1062, errno)
+	assert.Equal(t, "23000", sqlState)
+	_, _, ok = SQLErrorDetails(err)
+	assert.False(t, ok)
+
+	// The details of the parts of an aggregate are not its details.
+	assert.Nil(t, Details(Aggregate([]error{wrapped, errors.New("other")})))
+}
+
+func TestDetailsGRPC(t *testing.T) {
+	err := WithDetails(New(vtrpcpb.Code_ABORTED, "deadlock (errno 1213) (sqlstate 40001)"), map[string]string{
+		DetailErrno:       "1213",
+		DetailQueryDigest: "abcd",
+	})
+	grpcErr := ToGRPC(err)
+	st, ok := status.FromError(grpcErr)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Aborted, st.Code())
+	assert.Equal(t, err.Error(), st.Message())
+
+	fromGRPC := FromGRPC(grpcErr)
+	assert.Equal(t, vtrpcpb.Code_ABORTED, Code(fromGRPC))
+	assert.Equal(t, map[string]string{
+		DetailErrno:       "1213",
+		DetailQueryDigest: "abcd",
+	}, Details(fromGRPC))
+	assert.Equal(t, 1213, Errno(fromGRPC))
+
+	// Errors without details are unchanged.
+	fromGRPC = FromGRPC(ToGRPC(New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet")))
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, Code(fromGRPC))
+	assert.Nil(t, Details(fromGRPC))
+}
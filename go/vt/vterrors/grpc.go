@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"io"
 
+	structpb "github.com/golang/protobuf/ptypes/struct"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -120,7 +121,24 @@ func ToGRPC(err error) error {
 	if err == nil {
 		return nil
 	}
-	return status.Errorf(codes.Code(Code(err)), "%v", truncateError(err))
+	st := status.New(codes.Code(Code(err)), truncateError(err))
+	if details := Details(err); len(details) != 0 {
+		if withDetails, detailsErr := st.WithDetails(detailsToStruct(details)); detailsErr == nil {
+			st = withDetails
+		}
+	}
+	if applied := WritesApplied(err); applied != AppliedUnknown {
+		// Only the code and whether the writes were applied are sent:
+		// the message is the message of the status.
+		rpcErr := &vtrpcpb.RPCError{
+			Code:    Code(err),
+			Applied: vtrpcpb.Applied(applied),
+		}
+		if withApplied, appliedErr := st.WithDetails(rpcErr); appliedErr == nil {
+			st = withApplied
+		}
+	}
+	return st.Err()
 }
 
 // FromGRPC returns a gRPC error as a vtError, translating between error codes.
@@ -136,8 +154,63 @@ func FromGRPC(err error) error {
 		return err
 	}
 	code := codes.Unknown
+	var details map[string]string
+	applied := AppliedUnknown
 	if s, ok := status.FromError(err); ok {
 		code = s.Code()
+		details = detailsFromStatus(s)
+		applied = appliedFromStatus(s)
+	}
+	vterr := New(vtrpcpb.Code(code), err.Error())
+	if len(details) != 0 {
+		vterr = WithDetails(vterr, details)
+	}
+	if applied != AppliedUnknown {
+		vterr = WithApplied(vterr, applied)
+	}
+	return vterr
+}
+
+// detailsToStruct returns the details of an error as the gRPC error
+// detail sent by ToGRPC.
+func detailsToStruct(details map[string]string) *structpb.Struct {
+	fields := make(map[string]*structpb.Value, len(details))
+	for key, value := range details {
+		fields[key] = &structpb.Value{Kind: &structpb.Value_StringValue{StringValue: value}}
+	}
+	return &structpb.Struct{Fields: fields}
+}
+
+// detailsFromStatus returns the details sent by ToGRPC, and ignores the
+// other gRPC error details.
+func detailsFromStatus(s *status.Status) map[string]string {
+	var details map[string]string
+	for _, detail := range s.Details() {
+		st, ok := detail.(*structpb.Struct)
+		if !ok {
+			continue
+		}
+		for key, value := range st.Fields {
+			str, ok := value.Kind.(*structpb.Value_StringValue)
+			if !ok {
+				continue
+			}
+			if details == nil {
+				details = make(map[string]string)
+			}
+			details[key] = str.StringValue
+		}
+	}
+	return details
+}
+
+// appliedFromStatus returns whether the writes of the failed request could
+// have been applied, as sent by ToGRPC.
+func appliedFromStatus(s *status.Status) Applied {
+	for _, detail := range s.Details() {
+		if rpcErr, ok := detail.(*vtrpcpb.RPCError); ok {
+			return Applied(rpcErr.Applied)
+		}
 	}
-	return New(vtrpcpb.Code(code), err.Error())
+	return AppliedUnknown
 }
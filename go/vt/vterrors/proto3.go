@@ -35,7 +35,14 @@ func FromVTRPC(rpcErr *vtrpcpb.RPCError) error {
 	if code == vtrpcpb.Code_OK {
 		code = LegacyErrorCodeToCode(rpcErr.LegacyCode)
 	}
-	return New(code, rpcErr.Message)
+	err := New(code, rpcErr.Message)
+	if len(rpcErr.Details) != 0 {
+		err = WithDetails(err, rpcErr.Details)
+	}
+	if applied := Applied(rpcErr.Applied); applied != AppliedUnknown {
+		err = WithApplied(err, applied)
+	}
+	return err
 }
 
 // ToVTRPC converts from vtError to a vtrpcpb.RPCError.
@@ -48,5 +55,7 @@ func ToVTRPC(err error) *vtrpcpb.RPCError {
 		LegacyCode: CodeToLegacyErrorCode(code),
 		Code:       code,
 		Message:    err.Error(),
+		Applied:    vtrpcpb.Applied(WritesApplied(err)),
+		Details:    Details(err),
 	}
 }
@@ -0,0 +1,238 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vterrors
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Applied tells whether the writes of a failed request could have been
+// applied.
+type Applied int
+
+const (
+	// AppliedUnknown means that nothing is known about the writes: they
+	// are classified from the error code and MySQL error number.
+	AppliedUnknown Applied = iota
+	// NotApplied means that the request failed before any write was
+	// applied, or that its writes were rolled back.
+	NotApplied
+	// MaybeApplied means that the request failed after its writes were
+	// sent to MySQL, so that they could have been applied.
+	MaybeApplied
+)
+
+func (a Applied) String() string {
+	switch a {
+	case NotApplied:
+		return "NotApplied"
+	case MaybeApplied:
+		return "MaybeApplied"
+	}
+	return "Unknown"
+}
+
+// WithApplied returns an error annotating err with whether its writes
+// could have been applied. Its message is the message of err.
+// If err is nil, WithApplied returns nil.
+func WithApplied(err error, applied Applied) error {
+	if err == nil {
+		return nil
+	}
+	return &appliedError{
+		cause:   err,
+		applied: applied,
+	}
+}
+
+type appliedError struct {
+	cause   error
+	applied Applied
+}
+
+func (a *appliedError) Error() string { return a.cause.Error() }
+func (a *appliedError) Cause() error  { return a.cause }
+
+func (a *appliedError) Format(s fmt.State, verb rune) {
+	if f, ok := a.cause.(fmt.Formatter); ok {
+		f.Format(s, verb)
+		return
+	}
+	panicIfError(fmt.Fprintf(s, "%s", a.cause.Error()))
+}
+
+// WritesApplied returns whether the writes of the request that failed
+// with err could have been applied, as annotated by the outermost
+// WithApplied.
+func WritesApplied(err error) Applied {
+	for ; err != nil; err = Cause(err) {
+		if a, ok := err.(*appliedError); ok {
+			return a.applied
+		}
+		if _, ok := err.(*aggregateError); ok {
+			break
+		}
+	}
+	return AppliedUnknown
+}
+
+// The MySQL error numbers used to classify errors. They are duplicated
+// from the mysql package, which depends on this one.
+const (
+	errnoConCount              = 1040
+	errnoOutOfResources        = 1041
+	errnoServerShutdown        = 1053
+	errnoGotSignal             = 1078
+	errnoForcingClose          = 1080
+	errnoCantCreateThread      = 1135
+	errnoAbortingConnection    = 1152
+	errnoTooManyUserConnection = 1203
+	errnoLockWaitTimeout       = 1205
+	errnoLockDeadlock          = 1213
+	errnoSpecifiedAccessDenied = 1227
+	errnoOptionPreventsStmt    = 1290
+	errnoServerGone            = 2006
+	errnoServerLost            = 2013
+)
+
+// errnoRetry classifies the transient MySQL errors: whether the writes
+// of the failed statement could have been applied. The other MySQL errors
+// are not retryable.
+var errnoRetry = map[int]Applied{
+	// MySQL rolled back the statement, or the transaction.
+	errnoLockWaitTimeout: NotApplied,
+	errnoLockDeadlock:    NotApplied,
+	// MySQL refused the connection or the statement.
+	errnoConCount:              NotApplied,
+	errnoOutOfResources:        NotApplied,
+	errnoCantCreateThread:      NotApplied,
+	errnoTooManyUserConnection: NotApplied,
+	// MySQL is read-only, or a failover is in progress: only when
+	// tabletserver classified them as FAILED_PRECONDITION.
+	errnoOptionPreventsStmt:    NotApplied,
+	errnoSpecifiedAccessDenied: NotApplied,
+	// The statement could not be sent.
+	errnoServerGone: NotApplied,
+	// The statement was sent, but its result was lost.
+	errnoServerShutdown:     MaybeApplied,
+	errnoGotSignal:          MaybeApplied,
+	errnoForcingClose:       MaybeApplied,
+	errnoAbortingConnection: MaybeApplied,
+	errnoServerLost:         MaybeApplied,
+}
+
+var errnoRegexp = regexp.MustCompile(`\(errno (\d+)\)`)
+
+// Errno returns the MySQL error number of err, from its details or else
+// as found in its message, or 0 if it has none.
+func Errno(err error) int {
+	if err == nil {
+		return 0
+	}
+	if errno, _, ok := SQLErrorDetails(err); ok {
+		return errno
+	}
+	match := errnoRegexp.FindStringSubmatch(err.Error())
+	if match == nil {
+		return 0
+	}
+	errno, _ := strconv.Atoi(match[1])
+	return errno
+}
+
+// IsRetryable returns true if the request that failed with err may
+// succeed if it is sent again, possibly to another tablet. It does not
+// tell whether that is safe for writes: see IsSafeToRetryWrites.
+//
+// An error is retryable if it is a transient MySQL error (deadlocks, lock
+// wait timeouts, lost connections, too many connections, a read-only MySQL
+// or a failover in progress), or, without a MySQL error number, if its
+// code is UNAVAILABLE, FAILED_PRECONDITION, RESOURCE_EXHAUSTED, ABORTED or
+// DEADLINE_EXCEEDED.
+// An aggregated error is retryable if all its parts are.
+func IsRetryable(err error) bool {
+	retryable, _ := classify(err)
+	return retryable
+}
+
+// IsSafeToRetryWrites returns true if the request that failed with err is
+// retryable and could not have applied any write, so that sending it
+// again cannot apply its writes twice. Whether writes were applied is
+// taken from WithApplied if err was annotated with it, and otherwise
+// inferred from the code and MySQL error number of err.
+// An aggregated error is safe to retry if all its parts are.
+func IsSafeToRetryWrites(err error) bool {
+	retryable, applied := classify(err)
+	return retryable && applied == NotApplied
+}
+
+func classify(err error) (bool, Applied) {
+	if err == nil {
+		return false, AppliedUnknown
+	}
+	if _, ok := findAggregate(err); ok {
+		applied := WritesApplied(err)
+		for _, part := range Parts(err) {
+			retryable, partApplied := classify(part.Err)
+			if !retryable {
+				return false, AppliedUnknown
+			}
+			if applied == AppliedUnknown && partApplied != NotApplied {
+				applied = partApplied
+			}
+		}
+		if applied == AppliedUnknown {
+			applied = NotApplied
+		}
+		return true, applied
+	}
+
+	retryable, applied := classifyCode(err)
+	if marked := WritesApplied(err); marked != AppliedUnknown {
+		applied = marked
+	}
+	return retryable, applied
+}
+
+func classifyCode(err error) (bool, Applied) {
+	code := Code(err)
+	if errno := Errno(err); errno != 0 {
+		applied, ok := errnoRetry[errno]
+		if !ok {
+			return false, AppliedUnknown
+		}
+		if (errno == errnoOptionPreventsStmt || errno == errnoSpecifiedAccessDenied) && code != vtrpcpb.Code_FAILED_PRECONDITION {
+			return false, AppliedUnknown
+		}
+		return true, applied
+	}
+	switch code {
+	case vtrpcpb.Code_UNAVAILABLE, vtrpcpb.Code_FAILED_PRECONDITION, vtrpcpb.Code_RESOURCE_EXHAUSTED:
+		// The request was refused.
+		return true, NotApplied
+	case vtrpcpb.Code_ABORTED:
+		// The transaction was rolled back.
+		return true, NotApplied
+	case vtrpcpb.Code_DEADLINE_EXCEEDED:
+		return true, MaybeApplied
+	}
+	return false, AppliedUnknown
+}
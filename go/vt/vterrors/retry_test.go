@@ -0,0 +1,167 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vterrors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestIsRetryable(t *testing.T) {
+	testcases := []struct {
+		err       error
+		retryable bool
+		safe      bool
+	}{{
+		err: nil,
+	}, {
+		err: errors.New("unknown error"),
+	}, {
+		err: context.Canceled,
+	}, {
+		err:       context.DeadlineExceeded,
+		retryable: true,
+	}, {
+		err:       New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err:       New(vtrpcpb.Code_FAILED_PRECONDITION, "operation not allowed in state NOT_SERVING"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err:       New(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool connection limit exceeded"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err:       New(vtrpcpb.Code_ABORTED, "transaction 1: ended at 2021-01-01 (exceeded timeout: 30s)"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err: New(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error"),
+	}, {
+		err: New(vtrpcpb.Code_ALREADY_EXISTS, "Duplicate entry '1' for key 'PRIMARY' (errno 1062) (sqlstate 23000)"),
+	}, {
+		err:       New(vtrpcpb.Code_ABORTED, "Deadlock found when trying to get lock (errno 1213) (sqlstate 40001)"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err:       New(vtrpcpb.Code_DEADLINE_EXCEEDED, "Lock wait timeout exceeded (errno 1205) (sqlstate HY000)"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err:       New(vtrpcpb.Code_UNAVAILABLE, "MySQL server has gone away (errno 2006) (sqlstate HY000)"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err:       New(vtrpcpb.Code_CANCELED, "Lost connection to MySQL server during query (errno 2013) (sqlstate HY000)"),
+		retryable: true,
+	}, {
+		err:       New(vtrpcpb.Code_FAILED_PRECONDITION, "The MySQL server is running with the --read-only option (errno 1290) (sqlstate HY000)"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err: New(vtrpcpb.Code_UNKNOWN, "The MySQL server is running with the --secure-file-priv option (errno 1290) (sqlstate HY000)"),
+	}, {
+		err:       New(vtrpcpb.Code_FAILED_PRECONDITION, "failover in progress (errno 1227) (sqlstate 42000)"),
+		retryable: true,
+		safe:      true,
+	}, {
+		err: New(vtrpcpb.Code_FAILED_PRECONDITION, "Duplicate entry '1' for key 'name' (errno 1169) (sqlstate 23000)"),
+	}, {
+		err:       WithApplied(New(vtrpcpb.Code_DEADLINE_EXCEEDED, "context deadline exceeded"), NotApplied),
+		retryable: true,
+		safe:      true,
+	}, {
+		err:       Wrap(WithApplied(New(vtrpcpb.Code_UNAVAILABLE, "connection closed"), MaybeApplied), "commit"),
+		retryable: true,
+	}, {
+		err: WithApplied(New(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error"), NotApplied),
+	}, {
+		err: Aggregate([]error{
+			WrapShard(New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet"), "ks", "-80", "MASTER"),
+			WrapShard(New(vtrpcpb.Code_FAILED_PRECONDITION, "not serving"), "ks", "80-", "MASTER"),
+		}),
+		retryable: true,
+		safe:      true,
+	}, {
+		err: Aggregate([]error{
+			WrapShard(New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet"), "ks", "-80", "MASTER"),
+			WrapShard(New(vtrpcpb.Code_DEADLINE_EXCEEDED, "context deadline exceeded"), "ks", "80-", "MASTER"),
+		}),
+		retryable: true,
+	}, {
+		err: Aggregate([]error{
+			New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet"),
+			New(vtrpcpb.Code_INVALID_ARGUMENT, "syntax error"),
+		}),
+	}}
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%v", tc.err), func(t *testing.T) {
+			assert.Equal(t, tc.retryable, IsRetryable(tc.err), "IsRetryable")
+			assert.Equal(t, tc.safe, IsSafeToRetryWrites(tc.err), "IsSafeToRetryWrites")
+		})
+	}
+}
+
+func TestWithApplied(t *testing.T) {
+	assert.Nil(t, WithApplied(nil, NotApplied))
+
+	err := New(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet")
+	assert.Equal(t, AppliedUnknown, WritesApplied(err))
+
+	marked := WithApplied(err, NotApplied)
+	assert.Equal(t, err.Error(), marked.Error())
+	assert.Equal(t, vtrpcpb.Code_UNAVAILABLE, Code(marked))
+	assert.Equal(t, err, Cause(marked))
+	assert.Equal(t, NotApplied, WritesApplied(marked))
+	assert.Equal(t, NotApplied, WritesApplied(Wrap(marked, "wrapped")))
+
+	// The outermost annotation wins.
+	assert.Equal(t, MaybeApplied, WritesApplied(WithApplied(marked, MaybeApplied)))
+}
+
+func TestErrno(t *testing.T) {
+	assert.Equal(t, 0, Errno(nil))
+	assert.Equal(t, 0, Errno(errors.New("no errno")))
+	assert.Equal(t, 1213, Errno(Wrap(errors.New("Deadlock found (errno 1213) (sqlstate 40001)"), "target: ks.0.master")))
+}
+
+func TestAppliedOverRPC(t *testing.T) {
+	err := WithApplied(New(vtrpcpb.Code_DEADLINE_EXCEEDED, "context deadline exceeded"), NotApplied)
+
+	fromGRPC := FromGRPC(ToGRPC(err))
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, Code(fromGRPC))
+	assert.Equal(t, NotApplied, WritesApplied(fromGRPC))
+	assert.True(t, IsSafeToRetryWrites(fromGRPC))
+
+	rpcErr := ToVTRPC(err)
+	assert.Equal(t, vtrpcpb.Applied_NOT_APPLIED, rpcErr.Applied)
+	assert.Equal(t, NotApplied, WritesApplied(FromVTRPC(rpcErr)))
+
+	// Without an annotation, nothing is sent.
+	err = New(vtrpcpb.Code_DEADLINE_EXCEEDED, "context deadline exceeded")
+	assert.Equal(t, AppliedUnknown, WritesApplied(FromGRPC(ToGRPC(err))))
+	assert.Equal(t, vtrpcpb.Applied_APPLIED_UNKNOWN, ToVTRPC(err).Applied)
+	assert.False(t, IsSafeToRetryWrites(FromGRPC(ToGRPC(err))))
+}
@@ -168,7 +168,10 @@ func Code(err error) vtrpcpb.Code {
 	if err == nil {
 		return vtrpcpb.Code_OK
 	}
-	if err, ok := err.(*fundamental); ok {
+	switch err := err.(type) {
+	case *fundamental:
+		return err.code
+	case *aggregateError:
 		return err.code
 	}
 
@@ -194,7 +197,10 @@ func ErrState(err error) State {
 	if err == nil {
 		return Undefined
 	}
-	if err, ok := err.(*fundamental); ok {
+	switch err := err.(type) {
+	case *fundamental:
+		return err.state
+	case *aggregateError:
 		return err.state
 	}
 
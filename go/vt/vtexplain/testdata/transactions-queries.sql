@@ -0,0 +1,25 @@
+/* single-shard transaction */
+begin;
+update user set nickname='alice' where id=1;
+update user set nickname='bob' where id=1;
+commit;
+
+/* cross-shard transaction */
+begin;
+update user set nickname='alice' where id=1;
+update user set nickname='bob' where id=3;
+commit;
+
+/* rolled back transaction */
+begin;
+update user set nickname='alice' where id=1;
+rollback;
+
+/* locking reads */
+begin;
+select * from user where id=1 for update;
+commit;
+select * from user where id=1 for update;
+
+/* autocommit insert with a lookup vindex on another shard */
+insert into user (id, name) values(1, 'alice');
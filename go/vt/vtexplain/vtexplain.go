@@ -51,6 +51,9 @@ const (
 
 	// ModeTwoPC enables the twopc feature
 	ModeTwoPC = "twopc"
+
+	// ModeSingle only allows transactions on a single shard
+	ModeSingle = "single"
 )
 
 // Options to control the explain process
@@ -75,6 +78,11 @@ type Options struct {
 	// Target is used to override the "database" target in the
 	// vtgate session to simulate `USE <target>`
 	Target string
+
+	// ExplainTransactions adds to each explain the shards on which it
+	// begins, commits or rolls back transactions and takes locks, and
+	// flags cross-shard transactions.
+	ExplainTransactions bool
 }
 
 // TabletQuery defines a query that was sent to a given tablet and how it was
@@ -141,6 +149,9 @@ type Explain struct {
 
 	// list of queries / bind vars sent to each tablet
 	TabletActions map[string]*TabletActions
+
+	// transactional behavior of the statement, if ExplainTransactions is set
+	Transaction *TransactionActions `json:",omitempty"`
 }
 
 // Init sets up the fake execution environment
@@ -150,6 +161,11 @@ func Init(vSchemaStr, sqlSchema, ksShardMapStr string, opts *Options) error {
 		return fmt.Errorf("invalid replication mode \"%s\"", opts.ReplicationMode)
 	}
 
+	txTracker = nil
+	if opts.ExplainTransactions {
+		txTracker = newTransactionTracker(opts.ExecutionMode)
+	}
+
 	parsedDDLs, err := parseSchema(sqlSchema, opts)
 	if err != nil {
 		return fmt.Errorf("parseSchema: %v", err)
@@ -282,11 +298,15 @@ func explain(sql string) (*Explain, error) {
 		return nil, err
 	}
 
-	return &Explain{
+	e := &Explain{
 		SQL:           sql,
 		Plans:         plans,
 		TabletActions: tabletActions,
-	}, nil
+	}
+	if txTracker != nil {
+		e.Transaction = txTracker.explain(tabletActions, vtgateSession.GetInTransaction())
+	}
+	return e, nil
 }
 
 type outputQuery struct {
@@ -327,6 +347,9 @@ func ExplainsAsText(explains []*Explain) string {
 			fmt.Fprintf(&b, "%d %s: %s\n", q.Time, q.tablet, q.sql)
 		}
 		fmt.Fprintf(&b, "\n")
+		if explain.Transaction != nil {
+			explain.Transaction.writeText(&b)
+		}
 	}
 	fmt.Fprintf(&b, "----------------------------------------------------------------------\n")
 	return b.String()
@@ -138,6 +138,12 @@ func TestExplain(t *testing.T) {
 			Normalize:       false,
 			Target:          "ks_sharded/40-80",
 		}},
+		{"transactions", &Options{
+			ReplicationMode:     "ROW",
+			NumShards:           4,
+			Normalize:           false,
+			ExplainTransactions: true,
+		}},
 	}
 
 	for _, tst := range tests {
@@ -182,6 +188,17 @@ func TestErrors(t *testing.T) {
 	}
 }
 
+func TestSingleModeCrossShardTransaction(t *testing.T) {
+	initTest(ModeSingle, defaultTestOpts(), &testopts{}, t)
+
+	_, err := Run("begin; update user set nickname='alice' where id=1; update user set nickname='bob' where id=3; commit")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "multi-db transaction attempted")
+
+	_, err = Run("rollback")
+	require.NoError(t, err)
+}
+
 func TestJSONOutput(t *testing.T) {
 	sql := "select 1 from user where id = 1"
 	explains, err := Run(sql)
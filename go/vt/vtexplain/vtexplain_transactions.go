@@ -0,0 +1,184 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtexplain
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var txTracker *transactionTracker
+
+// TransactionActions describes the transactional behavior of a statement
+type TransactionActions struct {
+	// Mode is the execution mode the transactions are committed with
+	Mode string
+
+	// Shards on which the statement began a transaction, in order
+	Begins []string `json:",omitempty"`
+
+	// Shards committed by the statement, in the order of the commits
+	Commits []string `json:",omitempty"`
+
+	// Shards rolled back by the statement
+	Rollbacks []string `json:",omitempty"`
+
+	// Shards on which the statement took row locks with a locking read
+	Locks []string `json:",omitempty"`
+
+	// Shards of the transaction the statement is part of, including the
+	// ones begun by the previous statements of the transaction
+	Shards []string `json:",omitempty"`
+
+	// CrossShard is true if the transaction spans more than one shard
+	CrossShard bool `json:",omitempty"`
+
+	// Warnings about the transactional behavior of the statement
+	Warnings []string `json:",omitempty"`
+}
+
+// transactionTracker follows the shards of the transaction open in the
+// vtgate session across the explained statements.
+type transactionTracker struct {
+	mode   string
+	shards map[string]bool
+}
+
+func newTransactionTracker(mode string) *transactionTracker {
+	if mode == "" {
+		mode = ModeMulti
+	}
+	return &transactionTracker{mode: mode, shards: make(map[string]bool)}
+}
+
+type tabletEvent struct {
+	tablet string
+	time   int
+	sql    string
+}
+
+// explain returns the transactional actions of a statement, given the
+// queries it sent to each tablet and whether the session is still in a
+// transaction after it.
+func (tt *transactionTracker) explain(tabletActions map[string]*TabletActions, inTransaction bool) *TransactionActions {
+	var events []tabletEvent
+	for tablet, actions := range tabletActions {
+		for _, q := range actions.TabletQueries {
+			events = append(events, tabletEvent{tablet: tablet, time: q.Time, sql: q.SQL})
+		}
+	}
+	// Like the text output, order the events by logical time, then by tablet.
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].time == events[j].time {
+			return events[i].tablet < events[j].tablet
+		}
+		return events[i].time < events[j].time
+	})
+
+	ta := &TransactionActions{Mode: tt.mode}
+	lockedOutsideTx := false
+	for _, event := range events {
+		switch event.sql {
+		case "begin":
+			ta.Begins = appendShard(ta.Begins, event.tablet)
+			tt.shards[event.tablet] = true
+		case "commit", "start commit", "commit prepared":
+			ta.Commits = appendShard(ta.Commits, event.tablet)
+		case "rollback":
+			ta.Rollbacks = appendShard(ta.Rollbacks, event.tablet)
+		default:
+			if !isLockingRead(event.sql) {
+				continue
+			}
+			ta.Locks = appendShard(ta.Locks, event.tablet)
+			if !tt.shards[event.tablet] {
+				lockedOutsideTx = true
+			}
+		}
+	}
+
+	for shard := range tt.shards {
+		ta.Shards = append(ta.Shards, shard)
+	}
+	sort.Strings(ta.Shards)
+	ta.CrossShard = len(ta.Shards) > 1
+
+	if ta.CrossShard && len(ta.Commits) > 0 && tt.mode == ModeMulti {
+		ta.Warnings = append(ta.Warnings, "cross-shard transaction is committed one shard at a time and is not atomic")
+	}
+	if lockedOutsideTx {
+		ta.Warnings = append(ta.Warnings, "locking read outside of a transaction: the locks are released when the statement completes")
+	}
+
+	// The transaction ends with the statement unless the session is still in one.
+	if !inTransaction {
+		tt.shards = make(map[string]bool)
+	}
+	return ta
+}
+
+func appendShard(shards []string, shard string) []string {
+	for _, s := range shards {
+		if s == shard {
+			return shards
+		}
+	}
+	return append(shards, shard)
+}
+
+// isLockingRead returns true if sql is a SELECT ... FOR UPDATE or
+// LOCK IN SHARE MODE.
+func isLockingRead(sql string) bool {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return false
+	}
+	sel, ok := stmt.(*sqlparser.Select)
+	return ok && sel.Lock != sqlparser.NoLock
+}
+
+func (ta *TransactionActions) writeText(b *bytes.Buffer) {
+	if len(ta.Begins) == 0 && len(ta.Commits) == 0 && len(ta.Rollbacks) == 0 && len(ta.Locks) == 0 && len(ta.Warnings) == 0 {
+		return
+	}
+	fmt.Fprintf(b, "transaction (%s):\n", ta.Mode)
+	for _, line := range []struct {
+		name   string
+		shards []string
+	}{
+		{"begin", ta.Begins},
+		{"commit order", ta.Commits},
+		{"rollback", ta.Rollbacks},
+		{"locks", ta.Locks},
+		{"shards", ta.Shards},
+	} {
+		if len(line.shards) > 0 {
+			fmt.Fprintf(b, "  %s: %s\n", line.name, strings.Join(line.shards, ", "))
+		}
+	}
+	if ta.CrossShard {
+		fmt.Fprintf(b, "  cross-shard: true\n")
+	}
+	for _, warning := range ta.Warnings {
+		fmt.Fprintf(b, "  WARNING: %s\n", warning)
+	}
+	fmt.Fprintf(b, "\n")
+}
@@ -80,8 +80,11 @@ func newFakeResolver(opts *Options, serv srvtopo.Server, cell string) *vtgate.Re
 	_ = gw.WaitForTablets(ctx, []topodatapb.TabletType{topodatapb.TabletType_REPLICA})
 
 	txMode := vtgatepb.TransactionMode_MULTI
-	if opts.ExecutionMode == ModeTwoPC {
+	switch opts.ExecutionMode {
+	case ModeTwoPC:
 		txMode = vtgatepb.TransactionMode_TWOPC
+	case ModeSingle:
+		txMode = vtgatepb.TransactionMode_SINGLE
 	}
 	tc := vtgate.NewTxConn(gw, txMode)
 	sc := vtgate.NewScatterConn("", tc, gw)
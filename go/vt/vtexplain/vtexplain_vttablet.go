@@ -152,6 +152,10 @@ func (t *explainTablet) Commit(ctx context.Context, target *querypb.Target, tran
 func (t *explainTablet) Rollback(ctx context.Context, target *querypb.Target, transactionID int64) (int64, error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "rollback",
+	})
 	t.mu.Unlock()
 	return t.tsv.Rollback(ctx, target, transactionID)
 }
@@ -178,6 +182,10 @@ func (t *explainTablet) Execute(ctx context.Context, target *querypb.Target, sql
 func (t *explainTablet) Prepare(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "prepare",
+	})
 	t.mu.Unlock()
 	return t.tsv.Prepare(ctx, target, transactionID, dtid)
 }
@@ -186,6 +194,10 @@ func (t *explainTablet) Prepare(ctx context.Context, target *querypb.Target, tra
 func (t *explainTablet) CommitPrepared(ctx context.Context, target *querypb.Target, dtid string) (err error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "commit prepared",
+	})
 	t.mu.Unlock()
 	return t.tsv.CommitPrepared(ctx, target, dtid)
 }
@@ -194,6 +206,10 @@ func (t *explainTablet) CommitPrepared(ctx context.Context, target *querypb.Targ
 func (t *explainTablet) CreateTransaction(ctx context.Context, target *querypb.Target, dtid string, participants []*querypb.Target) (err error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "create transaction",
+	})
 	t.mu.Unlock()
 	return t.tsv.CreateTransaction(ctx, target, dtid, participants)
 }
@@ -202,6 +218,10 @@ func (t *explainTablet) CreateTransaction(ctx context.Context, target *querypb.T
 func (t *explainTablet) StartCommit(ctx context.Context, target *querypb.Target, transactionID int64, dtid string) (err error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "start commit",
+	})
 	t.mu.Unlock()
 	return t.tsv.StartCommit(ctx, target, transactionID, dtid)
 }
@@ -210,6 +230,10 @@ func (t *explainTablet) StartCommit(ctx context.Context, target *querypb.Target,
 func (t *explainTablet) SetRollback(ctx context.Context, target *querypb.Target, dtid string, transactionID int64) (err error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "set rollback",
+	})
 	t.mu.Unlock()
 	return t.tsv.SetRollback(ctx, target, dtid, transactionID)
 }
@@ -218,6 +242,10 @@ func (t *explainTablet) SetRollback(ctx context.Context, target *querypb.Target,
 func (t *explainTablet) ConcludeTransaction(ctx context.Context, target *querypb.Target, dtid string) (err error) {
 	t.mu.Lock()
 	t.currentTime = batchTime.Wait()
+	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "conclude transaction",
+	})
 	t.mu.Unlock()
 	return t.tsv.ConcludeTransaction(ctx, target, dtid)
 }
@@ -256,6 +284,9 @@ func (t *explainTablet) BeginExecute(ctx context.Context, target *querypb.Target
 	t.currentTime = batchTime.Wait()
 	bindVariables = sqltypes.CopyBindVariables(bindVariables)
 	t.tabletQueries = append(t.tabletQueries, &TabletQuery{
+		Time: t.currentTime,
+		SQL:  "begin",
+	}, &TabletQuery{
 		Time:     t.currentTime,
 		SQL:      sql,
 		BindVars: bindVariables,
@@ -580,7 +611,7 @@ func (t *explainTablet) HandleQuery(c *mysql.Conn, query string, callback func(*
 		resultJSON, _ := json.MarshalIndent(result, "", "    ")
 		log.V(100).Infof("query %s result %s\n", query, string(resultJSON))
 
-	case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtSet, sqlparser.StmtShow:
+	case sqlparser.StmtBegin, sqlparser.StmtCommit, sqlparser.StmtRollback, sqlparser.StmtSet, sqlparser.StmtShow:
 		result = &sqltypes.Result{}
 	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
 		result = &sqltypes.Result{
@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package audit records security relevant events of the MySQL protocol
+listener of vtgate: connections opening and closing, failed
+authentications, and the statements matching the configured criteria.
+Events are written as JSON to a sink, which is a file, syslog, or a gRPC
+service.
+
+Events are queued and written in the background, so a slow sink doesn't
+slow down the queries. Events which don't fit in the queue are dropped
+and counted in the AuditEventsDropped stat.
+*/
+package audit
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+)
+
+var (
+	sinkName   = flag.String("mysql_audit_log_sink", "", "sink of the audit log of the mysql server: file, syslog or grpc. Disabled if empty")
+	sinkTarget = flag.String("mysql_audit_log_target", "", "target of the audit log sink: the path of the file, the syslog tag, or the address of the gRPC service")
+	statements = flag.String("mysql_audit_log_statements", "ddl", "comma separated list of the statements to audit: ddl, dml, all, or none")
+	tables     = flag.String("mysql_audit_log_tables", "", "comma separated list of sensitive tables. If set, only the dml statements on those tables are audited. Tables can be qualified with their keyspace")
+	queueSize  = flag.Int("mysql_audit_log_queue_size", 10000, "number of audit events which can wait to be written to the sink")
+
+	eventCounts   = stats.NewCountersWithSingleLabel("AuditEvents", "Audit events written to the sink", "Type")
+	droppedCounts = stats.NewCountersWithSingleLabel("AuditEventsDropped", "Audit events dropped because the queue was full", "Type")
+	errorCount    = stats.NewCounter("AuditSinkErrors", "Errors writing audit events to the sink")
+)
+
+// EventType is the type of an audit event.
+type EventType string
+
+// The types of audit events.
+const (
+	// Connect is recorded when a connection is authenticated.
+	Connect = EventType("Connect")
+	// Disconnect is recorded when an authenticated connection is closed.
+	Disconnect = EventType("Disconnect")
+	// AuthFailure is recorded when the authentication of a user fails.
+	AuthFailure = EventType("AuthFailure")
+	// Statement is recorded for the statements matching the criteria.
+	Statement = EventType("Statement")
+)
+
+// Event is an audit event.
+type Event struct {
+	Time          time.Time `json:"time"`
+	Type          EventType `json:"type"`
+	ConnectionID  uint32    `json:"connection_id,omitempty"`
+	User          string    `json:"user,omitempty"`
+	RemoteAddr    string    `json:"remote_addr,omitempty"`
+	Target        string    `json:"target,omitempty"`
+	StatementType string    `json:"statement_type,omitempty"`
+	Tables        []string  `json:"tables,omitempty"`
+	SQL           string    `json:"sql,omitempty"`
+	Error         string    `json:"error,omitempty"`
+}
+
+// marshal returns the encoding of the event in the sinks.
+func (ev *Event) marshal() ([]byte, error) {
+	return json.Marshal(ev)
+}
+
+// Sink writes audit events.
+type Sink interface {
+	// Write writes an event. It is only called by the background
+	// writer of the Logger, so it doesn't have to be thread safe.
+	Write(ev *Event) error
+	// Close flushes and closes the sink.
+	Close() error
+}
+
+// SinkFactory creates a sink from its target.
+type SinkFactory func(target string) (Sink, error)
+
+var (
+	sinksMu sync.Mutex
+	sinks   = make(map[string]SinkFactory)
+)
+
+// RegisterSink registers a sink factory under name.
+func RegisterSink(name string, factory SinkFactory) {
+	sinksMu.Lock()
+	defer sinksMu.Unlock()
+	if _, ok := sinks[name]; ok {
+		panic(fmt.Sprintf("audit sink %s is already registered", name))
+	}
+	sinks[name] = factory
+}
+
+// NewSink creates the sink registered under name.
+func NewSink(name, target string) (Sink, error) {
+	sinksMu.Lock()
+	factory, ok := sinks[name]
+	sinksMu.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown audit sink: %q", name)
+	}
+	return factory(target)
+}
+
+// Logger queues the audit events and writes them to its sink in the
+// background. A nil Logger records nothing.
+type Logger struct {
+	criteria *Criteria
+	sink     Sink
+	done     chan struct{}
+
+	// mu protects queue and closed, so no event is queued once the
+	// queue is closed.
+	mu     sync.RWMutex
+	queue  chan *Event
+	closed bool
+
+	// now is time.Now, except in tests.
+	now func() time.Time
+}
+
+// NewLogger returns a Logger writing the events to sink. Close must be
+// called to flush the queue and close the sink.
+func NewLogger(sink Sink, criteria *Criteria, queueSize int) *Logger {
+	l := &Logger{
+		criteria: criteria,
+		sink:     sink,
+		queue:    make(chan *Event, queueSize),
+		done:     make(chan struct{}),
+		now:      time.Now,
+	}
+	go l.run()
+	return l
+}
+
+// NewLoggerFromFlags returns the Logger configured by the flags, or nil
+// if auditing is disabled.
+func NewLoggerFromFlags() (*Logger, error) {
+	if *sinkName == "" {
+		return nil, nil
+	}
+	criteria, err := NewCriteria(*statements, *tables)
+	if err != nil {
+		return nil, err
+	}
+	sink, err := NewSink(*sinkName, *sinkTarget)
+	if err != nil {
+		return nil, err
+	}
+	log.Infof("Writing the mysql server audit log to the %s sink %s", *sinkName, *sinkTarget)
+	return NewLogger(sink, criteria, *queueSize), nil
+}
+
+func (l *Logger) run() {
+	defer close(l.done)
+	for ev := range l.queue {
+		if err := l.sink.Write(ev); err != nil {
+			errorCount.Add(1)
+			log.Warningf("Cannot write audit event: %v", err)
+			continue
+		}
+		eventCounts.Add(string(ev.Type), 1)
+	}
+}
+
+// Record queues an event. Its time is set if empty. Events recorded
+// after Close are dropped.
+func (l *Logger) Record(ev *Event) {
+	if l == nil {
+		return
+	}
+	if ev.Time.IsZero() {
+		ev.Time = l.now()
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.closed {
+		droppedCounts.Add(string(ev.Type), 1)
+		return
+	}
+	select {
+	case l.queue <- ev:
+	default:
+		droppedCounts.Add(string(ev.Type), 1)
+	}
+}
+
+// RecordStatement queues a Statement event if sql matches the criteria.
+// ev holds the fields describing the connection.
+func (l *Logger) RecordStatement(ev *Event, sql string, err error) {
+	if l == nil {
+		return
+	}
+	stmtType, tables, ok := l.criteria.Match(sql)
+	if !ok {
+		return
+	}
+	ev.Type = Statement
+	ev.StatementType = stmtType
+	ev.Tables = tables
+	ev.SQL = sql
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	l.Record(ev)
+}
+
+// Close writes the queued events and closes the sink.
+func (l *Logger) Close() error {
+	if l == nil {
+		return nil
+	}
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	l.closed = true
+	close(l.queue)
+	l.mu.Unlock()
+	<-l.done
+	return l.sink.Close()
+}
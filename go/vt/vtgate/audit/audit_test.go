@@ -0,0 +1,231 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCriteria(t *testing.T) {
+	testcases := []struct {
+		statements, tables string
+		sql                string
+		match              bool
+		stmtType           string
+		matchedTables      []string
+	}{{
+		statements: "ddl",
+		sql:        "create table t1(id int)",
+		match:      true,
+		stmtType:   "DDL",
+	}, {
+		statements: "ddl",
+		sql:        "/* comment */ grant select on t1 to u1",
+		match:      true,
+		stmtType:   "PRIV",
+	}, {
+		statements: "ddl",
+		sql:        "insert into t1 values (1)",
+		match:      false,
+	}, {
+		statements:    "dml",
+		sql:           "insert into t1 values (1)",
+		match:         true,
+		stmtType:      "INSERT",
+		matchedTables: []string{"t1"},
+	}, {
+		statements: "dml",
+		tables:     "secrets",
+		sql:        "update t1 set a = 1",
+		match:      false,
+	}, {
+		statements:    "dml",
+		tables:        "secrets",
+		sql:           "update t1 join ks.secrets on t1.id = secrets.id set a = 1",
+		match:         true,
+		stmtType:      "UPDATE",
+		matchedTables: []string{"t1", "ks.secrets"},
+	}, {
+		statements:    "ddl,dml",
+		tables:        "ks.secrets",
+		sql:           "delete from ks.secrets where id = 1",
+		match:         true,
+		stmtType:      "DELETE",
+		matchedTables: []string{"ks.secrets"},
+	}, {
+		statements: "dml",
+		tables:     "ks.secrets",
+		sql:        "delete from other.secrets where id = 1",
+		match:      false,
+	}, {
+		statements: "dml",
+		tables:     "secrets",
+		sql:        "delete from secrets where not parseable (",
+		match:      true,
+		stmtType:   "DELETE",
+	}, {
+		statements: "dml",
+		sql:        "select * from t1",
+		match:      false,
+	}, {
+		statements: "all",
+		sql:        "select * from t1",
+		match:      true,
+		stmtType:   "SELECT",
+	}, {
+		statements: "none",
+		sql:        "drop table t1",
+		match:      false,
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.sql, func(t *testing.T) {
+			c, err := NewCriteria(tc.statements, tc.tables)
+			require.NoError(t, err)
+			stmtType, tables, match := c.Match(tc.sql)
+			assert.Equal(t, tc.match, match)
+			if match {
+				assert.Equal(t, tc.stmtType, stmtType)
+				assert.Equal(t, tc.matchedTables, tables)
+			}
+		})
+	}
+
+	_, err := NewCriteria("ddl,select", "")
+	assert.EqualError(t, err, `unknown class of statements to audit: "select"`)
+}
+
+// fakeSink records the events in memory.
+type fakeSink struct {
+	mu     sync.Mutex
+	events []*Event
+	err    error
+	closed bool
+}
+
+func (fs *fakeSink) Write(ev *Event) error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.err != nil {
+		return fs.err
+	}
+	fs.events = append(fs.events, ev)
+	return nil
+}
+
+func (fs *fakeSink) Close() error {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	fs.closed = true
+	return nil
+}
+
+func TestLogger(t *testing.T) {
+	criteria, err := NewCriteria("ddl", "")
+	require.NoError(t, err)
+	sink := &fakeSink{}
+	l := NewLogger(sink, criteria, 10)
+	now := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	l.Record(&Event{Type: Connect, User: "u1"})
+	l.RecordStatement(&Event{User: "u1"}, "select 1", nil)
+	l.RecordStatement(&Event{User: "u1"}, "drop table t1", errors.New("denied"))
+	l.Record(&Event{Type: Disconnect, User: "u1"})
+	require.NoError(t, l.Close())
+
+	// Events recorded once closed are dropped.
+	l.Record(&Event{Type: Connect, User: "u2"})
+	require.NoError(t, l.Close())
+
+	want := []*Event{
+		{Time: now, Type: Connect, User: "u1"},
+		{Time: now, Type: Statement, User: "u1", StatementType: "DDL", SQL: "drop table t1", Error: "denied"},
+		{Time: now, Type: Disconnect, User: "u1"},
+	}
+	assert.Equal(t, want, sink.events)
+	assert.True(t, sink.closed)
+
+	// A nil Logger records nothing.
+	var nilLogger *Logger
+	nilLogger.Record(&Event{Type: Connect})
+	nilLogger.RecordStatement(&Event{}, "drop table t1", nil)
+	assert.NoError(t, nilLogger.Close())
+}
+
+func TestLoggerDropsWhenFull(t *testing.T) {
+	criteria, err := NewCriteria("ddl", "")
+	require.NoError(t, err)
+	sink := &fakeSink{}
+	// Blocks the writer until the queue is full.
+	sink.mu.Lock()
+	l := NewLogger(sink, criteria, 1)
+	before := droppedCounts.Counts()[string(AuthFailure)]
+	for i := 0; i < 5; i++ {
+		l.Record(&Event{Type: AuthFailure})
+	}
+	sink.mu.Unlock()
+	require.NoError(t, l.Close())
+	dropped := droppedCounts.Counts()[string(AuthFailure)] - before
+	assert.Equal(t, int64(5), dropped+int64(len(sink.events)))
+	assert.True(t, dropped >= 3, "dropped %d events", dropped)
+}
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "audit.log")
+
+	_, err = NewSink("file", "")
+	assert.Error(t, err)
+	_, err = NewSink("unknown", path)
+	assert.EqualError(t, err, `unknown audit sink: "unknown"`)
+
+	sink, err := NewSink("file", path)
+	require.NoError(t, err)
+	ev := &Event{
+		Time:         time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		Type:         AuthFailure,
+		ConnectionID: 3,
+		User:         "u1",
+		RemoteAddr:   "127.0.0.1:1234",
+		Error:        "access denied",
+	}
+	require.NoError(t, sink.Write(ev))
+	require.NoError(t, sink.Write(ev))
+	require.NoError(t, sink.Close())
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, `{"time":"2021-01-01T00:00:00Z","type":"AuthFailure","connection_id":3,"user":"u1","remote_addr":"127.0.0.1:1234","error":"access denied"}`, lines[0])
+	got := &Event{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), got))
+	assert.Equal(t, ev, got)
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+// Criteria selects the statements to audit.
+type Criteria struct {
+	all bool
+	ddl bool
+	dml bool
+	// tables restricts the audited dml statements to those touching
+	// these tables, if not empty.
+	tables map[string]bool
+}
+
+// NewCriteria parses the comma separated list of statement classes
+// (ddl, dml, all or none) and the comma separated list of sensitive tables.
+func NewCriteria(statements, tables string) (*Criteria, error) {
+	c := &Criteria{}
+	for _, class := range strings.Split(statements, ",") {
+		switch strings.ToLower(strings.TrimSpace(class)) {
+		case "", "none":
+		case "all":
+			c.all = true
+		case "ddl":
+			c.ddl = true
+		case "dml":
+			c.dml = true
+		default:
+			return nil, fmt.Errorf("unknown class of statements to audit: %q", class)
+		}
+	}
+	for _, table := range strings.Split(tables, ",") {
+		table = strings.ToLower(strings.TrimSpace(table))
+		if table == "" {
+			continue
+		}
+		if c.tables == nil {
+			c.tables = make(map[string]bool)
+		}
+		c.tables[table] = true
+	}
+	return c, nil
+}
+
+// Match returns whether sql must be audited, with its statement type
+// and, for dml statements, the tables it touches.
+func (c *Criteria) Match(sql string) (stmtType string, tables []string, ok bool) {
+	typ := sqlparser.Preview(sql)
+	switch typ {
+	case sqlparser.StmtDDL, sqlparser.StmtPriv:
+		return typ.String(), nil, c.all || c.ddl
+	case sqlparser.StmtInsert, sqlparser.StmtReplace, sqlparser.StmtUpdate, sqlparser.StmtDelete:
+		if !c.all && !c.dml {
+			return "", nil, false
+		}
+		stmt, err := sqlparser.Parse(sql)
+		if err != nil {
+			// A statement we can't analyze may touch a sensitive table.
+			return typ.String(), nil, true
+		}
+		tables = statementTables(stmt)
+		return typ.String(), tables, c.all || c.matchTables(tables)
+	}
+	return typ.String(), nil, c.all
+}
+
+// matchTables returns true if one of tables is sensitive.
+func (c *Criteria) matchTables(tables []string) bool {
+	if len(c.tables) == 0 {
+		return true
+	}
+	for _, table := range tables {
+		if c.tables[strings.ToLower(table)] {
+			return true
+		}
+		// Unqualified sensitive tables match in every keyspace.
+		if i := strings.LastIndexByte(table, '.'); i >= 0 && c.tables[strings.ToLower(table[i+1:])] {
+			return true
+		}
+	}
+	return false
+}
+
+// statementTables returns the tables of stmt, qualified by their keyspace
+// if the statement does.
+func statementTables(stmt sqlparser.Statement) []string {
+	var tables []string
+	seen := make(map[string]bool)
+	add := func(name sqlparser.TableName) {
+		if name.IsEmpty() {
+			return
+		}
+		table := name.Name.String()
+		if !name.Qualifier.IsEmpty() {
+			table = name.Qualifier.String() + "." + table
+		}
+		if !seen[table] {
+			seen[table] = true
+			tables = append(tables, table)
+		}
+	}
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		switch node := node.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if name, ok := node.Expr.(sqlparser.TableName); ok {
+				add(name)
+			}
+		case *sqlparser.Insert:
+			add(node.Table)
+		}
+		return true, nil
+	}, stmt)
+	return tables
+}
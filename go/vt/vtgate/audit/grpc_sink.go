@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/vt/grpcclient"
+)
+
+var (
+	grpcCert    = flag.String("mysql_audit_log_grpc_cert", "", "the cert to use to connect to the gRPC audit sink")
+	grpcKey     = flag.String("mysql_audit_log_grpc_key", "", "the key to use to connect to the gRPC audit sink")
+	grpcCA      = flag.String("mysql_audit_log_grpc_ca", "", "the server ca to use to validate the gRPC audit sink")
+	grpcName    = flag.String("mysql_audit_log_grpc_server_name", "", "the server name to use to validate the gRPC audit sink")
+	grpcTimeout = flag.Duration("mysql_audit_log_grpc_timeout", 10*time.Second, "timeout of the calls to the gRPC audit sink")
+)
+
+// grpcRecordMethod is the method called for each event by the gRPC sink.
+// The events are sent as JSON, with the "json" content-subtype, and the
+// response is ignored.
+const grpcRecordMethod = "/vtaudit.Audit/Record"
+
+func init() {
+	RegisterSink("grpc", newGRPCSink)
+}
+
+// jsonCodec encodes the gRPC messages as JSON.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+func (jsonCodec) Name() string {
+	return "json"
+}
+
+// grpcSink sends the events to a gRPC service.
+type grpcSink struct {
+	cc      *grpc.ClientConn
+	timeout time.Duration
+}
+
+func newGRPCSink(address string) (Sink, error) {
+	if address == "" {
+		return nil, fmt.Errorf("the grpc audit sink needs the address of the service as its target")
+	}
+	opt, err := grpcclient.SecureDialOption(*grpcCert, *grpcKey, *grpcCA, *grpcName)
+	if err != nil {
+		return nil, err
+	}
+	cc, err := grpcclient.Dial(address, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	return &grpcSink{cc: cc, timeout: *grpcTimeout}, nil
+}
+
+// Write is part of the Sink interface.
+func (gs *grpcSink) Write(ev *Event) error {
+	ctx, cancel := context.WithTimeout(context.Background(), gs.timeout)
+	defer cancel()
+	var reply json.RawMessage
+	return gs.cc.Invoke(ctx, grpcRecordMethod, ev, &reply, grpc.ForceCodec(jsonCodec{}))
+}
+
+// Close is part of the Sink interface.
+func (gs *grpcSink) Close() error {
+	return gs.cc.Close()
+}
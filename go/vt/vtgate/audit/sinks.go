@@ -0,0 +1,90 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package audit
+
+import (
+	"fmt"
+	"log/syslog"
+	"os"
+)
+
+func init() {
+	RegisterSink("file", newFileSink)
+	RegisterSink("syslog", newSyslogSink)
+}
+
+// fileSink appends the events to a file, one JSON object per line.
+type fileSink struct {
+	file *os.File
+}
+
+func newFileSink(path string) (Sink, error) {
+	if path == "" {
+		return nil, fmt.Errorf("the file audit sink needs the path of the file as its target")
+	}
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &fileSink{file: file}, nil
+}
+
+// Write is part of the Sink interface.
+func (fs *fileSink) Write(ev *Event) error {
+	data, err := ev.marshal()
+	if err != nil {
+		return err
+	}
+	_, err = fs.file.Write(append(data, '\n'))
+	return err
+}
+
+// Close is part of the Sink interface.
+func (fs *fileSink) Close() error {
+	return fs.file.Close()
+}
+
+// syslogSink sends the events to the local syslog daemon, with the
+// auth facility.
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+func newSyslogSink(tag string) (Sink, error) {
+	writer, err := syslog.New(syslog.LOG_AUTH|syslog.LOG_INFO, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogSink{writer: writer}, nil
+}
+
+// Write is part of the Sink interface.
+func (ss *syslogSink) Write(ev *Event) error {
+	data, err := ev.marshal()
+	if err != nil {
+		return err
+	}
+	if ev.Type == AuthFailure {
+		return ss.writer.Warning(string(data))
+	}
+	return ss.writer.Info(string(data))
+}
+
+// Close is part of the Sink interface.
+func (ss *syslogSink) Close() error {
+	return ss.writer.Close()
+}
@@ -15,6 +15,8 @@ limitations under the License.
 */
 
 // Package buffer provides a buffer for MASTER traffic during failovers.
+// Optionally, REPLICA and RDONLY reads can be buffered as well while the
+// tablets change their type during a reparent.
 //
 // Instead of returning an error to the application (when the vttablet master
 // becomes unavailable), the buffer will automatically retry buffered requests
@@ -83,6 +85,9 @@ type Buffer struct {
 	// bufferSizeSema limits how many requests can be buffered
 	// ("-buffer_size") and is shared by all shardBuffer instances.
 	bufferSizeSema *sync2.Semaphore
+	// readsBufferSizeSema limits how many read requests can be buffered
+	// ("-buffer_reads_size") and is shared by all read shardBuffer instances.
+	readsBufferSizeSema *sync2.Semaphore
 
 	// mu guards all fields in this group.
 	// In particular, it is used to serialize the following Go routines:
@@ -95,6 +100,10 @@ type Buffer struct {
 	// progress.
 	// Key Format: "<keyspace>/<shard>"
 	buffers map[string]*shardBuffer
+	// readBuffers holds a shardBuffer object per shard and read tablet type.
+	// They are created on demand.
+	// Key Format: "<keyspace>/<shard>"
+	readBuffers map[string]map[topodatapb.TabletType]*shardBuffer
 	// stopped is true after Shutdown() was run.
 	stopped bool
 }
@@ -109,6 +118,7 @@ func newWithNow(now func() time.Time) *Buffer {
 		log.Fatalf("Invalid buffer configuration: %v", err)
 	}
 	bufferSize.Set(int64(*size))
+	readsBufferSize.Set(int64(*readsSize))
 	keyspaces, shards := keyspaceShardsToSets(*shards)
 
 	if *enabledDryRun {
@@ -140,16 +150,22 @@ func newWithNow(now func() time.Time) *Buffer {
 		}
 	}
 
+	if *enabledReads {
+		log.Infof("vtgate buffer enabled for reads. REPLICA and RDONLY requests will be buffered during detected failovers as well.")
+	}
+
 	if !*enabledDryRun && !*enabled {
 		log.Infof("vtgate buffer not enabled.")
 	}
 
 	return &Buffer{
-		keyspaces:      keyspaces,
-		shards:         shards,
-		now:            now,
-		bufferSizeSema: sync2.NewSemaphore(*size, 0),
-		buffers:        make(map[string]*shardBuffer),
+		keyspaces:           keyspaces,
+		shards:              shards,
+		now:                 now,
+		bufferSizeSema:      sync2.NewSemaphore(*size, 0),
+		readsBufferSizeSema: sync2.NewSemaphore(*readsSize, 0),
+		buffers:             make(map[string]*shardBuffer),
+		readBuffers:         make(map[string]map[topodatapb.TabletType]*shardBuffer),
 	}
 }
 
@@ -179,6 +195,15 @@ func (b *Buffer) mode(keyspace, shard string) bufferMode {
 	return bufferDisabled
 }
 
+// readMode is the same as mode, but for the reads. Reads are not buffered
+// unless -enable_buffer_reads is set.
+func (b *Buffer) readMode(keyspace, shard string) bufferMode {
+	if !*enabledReads {
+		return bufferDisabled
+	}
+	return b.mode(keyspace, shard)
+}
+
 // RetryDoneFunc will be returned for each buffered request and must be called
 // after the buffered request was retried.
 // Without this signal, the buffer would not know how many buffered requests are
@@ -213,6 +238,31 @@ func (b *Buffer) WaitForFailoverEnd(ctx context.Context, keyspace, shard string,
 	return sb.waitForFailoverEnd(ctx, keyspace, shard, err)
 }
 
+// WaitForReadFailoverEnd is the same as WaitForFailoverEnd, but for reads
+// against a REPLICA or RDONLY tablet of keyspace/shard. Besides the errors
+// caused by a failover, the errors of a tablet which is no longer of the
+// requested type (e.g. because it is being promoted) start the buffering.
+// Read buffering ends together with the MASTER buffering, when the new MASTER
+// is seen.
+func (b *Buffer) WaitForReadFailoverEnd(ctx context.Context, keyspace, shard string, tabletType topodatapb.TabletType, err error) (RetryDoneFunc, error) {
+	if err != nil && !causedByReadFailover(err) {
+		return nil, nil
+	}
+
+	sb := b.getOrCreateReadBuffer(keyspace, shard, tabletType)
+	if sb == nil {
+		// Buffer is shut down. Ignore all calls.
+		readVariables.requestsSkipped.Add([]string{keyspace, shard, topoproto.TabletTypeLString(tabletType), skippedShutdown}, 1)
+		return nil, nil
+	}
+	if sb.disabled() {
+		readVariables.requestsSkipped.Add([]string{keyspace, shard, topoproto.TabletTypeLString(tabletType), skippedDisabled}, 1)
+		return nil, nil
+	}
+
+	return sb.waitForFailoverEnd(ctx, keyspace, shard, err)
+}
+
 // ProcessMasterHealth notifies the buffer to record a new master
 // and end any failover buffering that may be in progress
 func (b *Buffer) ProcessMasterHealth(th *discovery.TabletHealth) {
@@ -232,6 +282,9 @@ func (b *Buffer) ProcessMasterHealth(th *discovery.TabletHealth) {
 		return
 	}
 	sb.recordExternallyReparentedTimestamp(timestamp, th.Tablet.Alias)
+	for _, rsb := range b.getReadBuffers(th.Target.Keyspace, th.Target.Shard) {
+		rsb.recordExternallyReparentedTimestamp(timestamp, th.Tablet.Alias)
+	}
 }
 
 // StatsUpdate keeps track of the "tablet_externally_reparented_timestamp" of
@@ -255,6 +308,9 @@ func (b *Buffer) StatsUpdate(ts *discovery.LegacyTabletStats) {
 		return
 	}
 	sb.recordExternallyReparentedTimestamp(timestamp, ts.Tablet.Alias)
+	for _, rsb := range b.getReadBuffers(ts.Target.Keyspace, ts.Target.Shard) {
+		rsb.recordExternallyReparentedTimestamp(timestamp, ts.Tablet.Alias)
+	}
 }
 
 // causedByFailover returns true if "err" was supposedly caused by a failover.
@@ -288,6 +344,15 @@ func causedByFailover(err error) bool {
 	return false
 }
 
+// causedByReadFailover returns true if "err" of a read was supposedly caused
+// by a failover. This is also the case if the tablet changed its type.
+func causedByReadFailover(err error) bool {
+	if causedByFailover(err) {
+		return true
+	}
+	return vterrors.Code(err) == vtrpcpb.Code_FAILED_PRECONDITION && strings.Contains(err.Error(), "invalid tablet type")
+}
+
 // getOrCreateBuffer returns the ShardBuffer for the given keyspace and shard.
 // It returns nil if Buffer is shut down and all calls should be ignored.
 func (b *Buffer) getOrCreateBuffer(keyspace, shard string) *shardBuffer {
@@ -309,12 +374,61 @@ func (b *Buffer) getOrCreateBuffer(keyspace, shard string) *shardBuffer {
 	// Look it up again because it could have been created in the meantime.
 	sb, ok = b.buffers[key]
 	if !ok {
-		sb = newShardBuffer(b.mode(keyspace, shard), keyspace, shard, b.now, b.bufferSizeSema)
+		sb = newShardBuffer(b.mode(keyspace, shard), keyspace, shard, topodatapb.TabletType_MASTER, b.now, b.bufferSizeSema)
 		b.buffers[key] = sb
 	}
 	return sb
 }
 
+// getOrCreateReadBuffer returns the read ShardBuffer for the given keyspace,
+// shard and tablet type.
+// It returns nil if Buffer is shut down and all calls should be ignored.
+func (b *Buffer) getOrCreateReadBuffer(keyspace, shard string, tabletType topodatapb.TabletType) *shardBuffer {
+	key := topoproto.KeyspaceShardString(keyspace, shard)
+	b.mu.RLock()
+	sb, ok := b.readBuffers[key][tabletType]
+	stopped := b.stopped
+	b.mu.RUnlock()
+
+	if stopped {
+		return nil
+	}
+	if ok {
+		return sb
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	// Look it up again because it could have been created in the meantime.
+	sb, ok = b.readBuffers[key][tabletType]
+	if !ok {
+		sb = newShardBuffer(b.readMode(keyspace, shard), keyspace, shard, tabletType, b.now, b.readsBufferSizeSema)
+		// Start from the MASTER seen so far. Otherwise, the next MASTER health
+		// update would be mistaken for the end of a failover.
+		if master, ok := b.buffers[key]; ok {
+			sb.copyMasterFrom(master)
+		}
+		if b.readBuffers[key] == nil {
+			b.readBuffers[key] = make(map[topodatapb.TabletType]*shardBuffer)
+		}
+		b.readBuffers[key][tabletType] = sb
+	}
+	return sb
+}
+
+// getReadBuffers returns the read ShardBuffers of the given keyspace and shard.
+func (b *Buffer) getReadBuffers(keyspace, shard string) []*shardBuffer {
+	key := topoproto.KeyspaceShardString(keyspace, shard)
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var result []*shardBuffer
+	for _, sb := range b.readBuffers[key] {
+		result = append(result, sb)
+	}
+	return result
+}
+
 // Shutdown blocks until all pending ShardBuffer objects are shut down.
 // In particular, it guarantees that all launched Go routines are stopped after
 // it returns.
@@ -330,6 +444,11 @@ func (b *Buffer) shutdown() {
 	for _, sb := range b.buffers {
 		sb.shutdown()
 	}
+	for _, sbs := range b.readBuffers {
+		for _, sb := range sbs {
+			sb.shutdown()
+		}
+	}
 	b.stopped = true
 }
 
@@ -340,4 +459,9 @@ func (b *Buffer) waitForShutdown() {
 	for _, sb := range b.buffers {
 		sb.waitForShutdown()
 	}
+	for _, sbs := range b.readBuffers {
+		for _, sb := range sbs {
+			sb.waitForShutdown()
+		}
+	}
 }
@@ -48,7 +48,14 @@ var (
 	nonFailoverErr = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION,
 		"vttablet: rpc error: code = 9 desc = gRPCServerError: retry: TODO(mberlin): Insert here any realistic error not caused by a failover")
 
+	// invalidTabletTypeErr is returned by a REPLICA tablet which is being
+	// promoted to MASTER.
+	invalidTabletTypeErr = vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION,
+		"vttablet: rpc error: code = FailedPrecondition desc = invalid tablet type: REPLICA, want: MASTER or MASTER")
+
 	statsKeyJoined = fmt.Sprintf("%s.%s", keyspace, shard)
+	// readStatsKeyJoined is the stats key of the REPLICA read buffer.
+	readStatsKeyJoined = fmt.Sprintf("%s.%s.%s", keyspace, shard, "replica")
 
 	statsKeyJoinedFailoverEndDetected = statsKeyJoined + "." + string(stopFailoverEndDetected)
 
@@ -904,6 +911,164 @@ func TestShutdown(t *testing.T) {
 
 // resetVariables resets the task level variables. The code does not reset these
 // with very failover.
+// TestBufferReads tests that REPLICA reads are buffered during a reparent and
+// drained once the new MASTER is seen.
+func TestBufferReads(t *testing.T) {
+	resetVariables()
+
+	flag.Set("enable_buffer", "true")
+	flag.Set("enable_buffer_reads", "true")
+	defer resetFlagsForTesting()
+
+	now := time.Now()
+	b := newWithNow(func() time.Time { return now })
+	defer b.Shutdown()
+
+	b.StatsUpdate(&discovery.LegacyTabletStats{
+		Tablet:                              oldMaster,
+		Target:                              &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_MASTER},
+		TabletExternallyReparentedTimestamp: now.Unix(),
+	})
+
+	// Reads with errors not related to the failover are not buffered.
+	if retryDone, err := b.WaitForReadFailoverEnd(context.Background(), keyspace, shard, topodatapb.TabletType_REPLICA, nonFailoverErr); err != nil || retryDone != nil {
+		t.Fatalf("requests with non-failover errors must never be buffered. err: %v retryDone: %v", err, retryDone)
+	}
+
+	// A read which hit a tablet that changed its type starts buffering.
+	stopped := issueReadRequest(context.Background(), b, invalidTabletTypeErr)
+	if err := waitForReadRequestsInFlight(b, 1); err != nil {
+		t.Fatal(err)
+	}
+	// Subsequent reads are buffered as well.
+	stopped2 := issueReadRequest(context.Background(), b, nil)
+	if err := waitForReadRequestsInFlight(b, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := readVariables.starts.Counts()[readStatsKeyJoined], int64(1); got != want {
+		t.Fatalf("read buffering start was not tracked: got = %v, want = %v", got, want)
+	}
+	// Read buffering does not start the MASTER buffering.
+	if got, want := starts.Counts()[statsKeyJoined], int64(0); got != want {
+		t.Fatalf("MASTER buffering must not have started: got = %v, want = %v", got, want)
+	}
+	// Read requests do not use the slots of the MASTER buffer.
+	if err := waitForPoolSlots(b, *size); err != nil {
+		t.Fatal(err)
+	}
+
+	// A periodic update of the current MASTER does not stop the buffering.
+	b.StatsUpdate(&discovery.LegacyTabletStats{
+		Tablet:                              oldMaster,
+		Target:                              &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_MASTER},
+		TabletExternallyReparentedTimestamp: now.Unix(),
+	})
+	if err := waitForReadRequestsInFlight(b, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	// Mimic the reparent end.
+	now = now.Add(1 * time.Second)
+	b.StatsUpdate(&discovery.LegacyTabletStats{
+		Tablet:                              newMaster,
+		Target:                              &querypb.Target{Keyspace: keyspace, Shard: shard, TabletType: topodatapb.TabletType_MASTER},
+		TabletExternallyReparentedTimestamp: now.Unix(),
+	})
+	if err := <-stopped; err != nil {
+		t.Fatalf("request should have been buffered and not returned an error: %v", err)
+	}
+	if err := <-stopped2; err != nil {
+		t.Fatalf("request should have been buffered and not returned an error: %v", err)
+	}
+	sb := b.getOrCreateReadBuffer(keyspace, shard, topodatapb.TabletType_REPLICA)
+	if err := waitForBufferState(sb, stateIdle); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := readVariables.requestsDrained.Counts()[readStatsKeyJoined], int64(2); got != want {
+		t.Fatalf("wrong number of drained read requests: got = %v, want = %v", got, want)
+	}
+	if _, ok := readVariables.lastDrainDurationMs.Counts()[readStatsKeyJoined]; !ok {
+		t.Fatalf("a drain duration must have been recorded: %v", readVariables.lastDrainDurationMs.Counts())
+	}
+	if got, want := b.readsBufferSizeSema.Size(), *readsSize; got != want {
+		t.Fatalf("not all read pool slots were returned: got = %v, want = %v", got, want)
+	}
+}
+
+// TestBufferReadsDisabled tests that reads are not buffered unless
+// -enable_buffer_reads is set.
+func TestBufferReadsDisabled(t *testing.T) {
+	resetVariables()
+
+	flag.Set("enable_buffer", "true")
+	defer resetFlagsForTesting()
+	b := New()
+	defer b.Shutdown()
+
+	if retryDone, err := b.WaitForReadFailoverEnd(context.Background(), keyspace, shard, topodatapb.TabletType_REPLICA, invalidTabletTypeErr); err != nil || retryDone != nil {
+		t.Fatalf("reads must not be buffered. err: %v retryDone: %v", err, retryDone)
+	}
+	if got, want := readVariables.requestsSkipped.Counts()[readStatsKeyJoined+"."+skippedDisabled], int64(1); got != want {
+		t.Fatalf("skipped read request was not tracked: got = %v, want = %v", got, want)
+	}
+	// Tablet type errors do not start the MASTER buffering.
+	if retryDone, err := b.WaitForFailoverEnd(context.Background(), keyspace, shard, invalidTabletTypeErr); err != nil || retryDone != nil {
+		t.Fatalf("MASTER requests must not be buffered for tablet type errors. err: %v retryDone: %v", err, retryDone)
+	}
+}
+
+// issueReadRequest is the same as issueRequest() but for REPLICA reads.
+func issueReadRequest(ctx context.Context, b *Buffer, err error) chan error {
+	bufferingStopped := make(chan error)
+
+	go func() {
+		retryDone, err := b.WaitForReadFailoverEnd(ctx, keyspace, shard, topodatapb.TabletType_REPLICA, err)
+		if err != nil {
+			bufferingStopped <- err
+		}
+		if retryDone != nil {
+			defer retryDone()
+		}
+		defer close(bufferingStopped)
+	}()
+
+	return bufferingStopped
+}
+
+// waitForReadRequestsInFlight is the same as waitForRequestsInFlight() but for
+// the REPLICA read buffer.
+func waitForReadRequestsInFlight(b *Buffer, count int) error {
+	start := time.Now()
+	sb := b.getOrCreateReadBuffer(keyspace, shard, topodatapb.TabletType_REPLICA)
+	for {
+		got, want := sb.sizeForTesting(), count
+		if got == want {
+			return nil
+		}
+
+		if time.Since(start) > 10*time.Second {
+			return fmt.Errorf("wrong buffered read requests in flight: got = %v, want = %v", got, want)
+		}
+		time.Sleep(1 * time.Millisecond)
+	}
+}
+
+// waitForBufferState is the same as waitForState() but for any shardBuffer.
+func waitForBufferState(sb *shardBuffer, want bufferState) error {
+	start := time.Now()
+	for {
+		got := sb.stateForTesting()
+		if got == want {
+			return nil
+		}
+
+		if time.Since(start) > 10*time.Second {
+			return fmt.Errorf("wrong buffer state: got = %v, want = %v", got, want)
+		}
+		time.Sleep(1 * time.Millisecond)
+	}
+}
+
 func resetVariables() {
 	starts.ResetAll()
 	stops.ResetAll()
@@ -916,6 +1081,10 @@ func resetVariables() {
 	requestsDrained.ResetAll()
 	requestsEvicted.ResetAll()
 	requestsSkipped.ResetAll()
+
+	readVariables.starts.ResetAll()
+	readVariables.requestsDrained.ResetAll()
+	readVariables.requestsSkipped.ResetAll()
 }
 
 // checkVariables makes sure that the invariants described in variables.go
@@ -37,6 +37,10 @@ var (
 
 	drainConcurrency = flag.Int("buffer_drain_concurrency", 1, "Maximum number of requests retried simultaneously. More concurrency will increase the load on the MASTER vttablet when draining the buffer.")
 
+	enabledReads = flag.Bool("enable_buffer_reads", false, "Also buffer REPLICA and RDONLY reads while a tablet of the requested type is promoted or demoted, for the keyspaces and shards for which MASTER traffic is buffered. Reads are only buffered if no other tablet can serve them.")
+	readsWindow  = flag.Duration("buffer_reads_window", 5*time.Second, "Duration for how long a read request should be buffered at most.")
+	readsSize    = flag.Int("buffer_reads_size", 10, "Maximum number of buffered read requests in flight (across all ongoing failovers). Read requests do not use the slots of -buffer_size.")
+
 	shards = flag.String("buffer_keyspace_shards", "", "If not empty, limit buffering to these entries (comma separated). Entry format: keyspace or keyspace/shard. Requires --enable_buffer=true.")
 )
 
@@ -49,6 +53,9 @@ func resetFlagsForTesting() {
 	flag.Set("buffer_keyspace_shards", "")
 	flag.Set("buffer_max_failover_duration", "20s")
 	flag.Set("buffer_min_time_between_failovers", "1m")
+	flag.Set("enable_buffer_reads", "false")
+	flag.Set("buffer_reads_size", "10")
+	flag.Set("buffer_reads_window", "5s")
 }
 
 func verifyFlags() error {
@@ -69,6 +76,19 @@ func verifyFlags() error {
 		return fmt.Errorf("-buffer_drain_concurrency must be >= 1 (specified value: %d)", *drainConcurrency)
 	}
 
+	if *readsWindow < 1*time.Second {
+		return fmt.Errorf("-buffer_reads_window must be >= 1s (specified value: %v)", *readsWindow)
+	}
+	if *readsWindow > *maxFailoverDuration {
+		return fmt.Errorf("-buffer_reads_window must be <= -buffer_max_failover_duration: %v vs. %v", *readsWindow, *maxFailoverDuration)
+	}
+	if *readsSize < 1 {
+		return fmt.Errorf("-buffer_reads_size must be >= 1 (specified value: %d)", *readsSize)
+	}
+	if *enabledReads && !*enabled && !*enabledDryRun {
+		return errors.New("-enable_buffer_reads also requires that -enable_buffer or -enable_buffer_dry_run is set")
+	}
+
 	if *shards != "" && !*enabled {
 		return fmt.Errorf("-buffer_keyspace_shards=%v also requires that -enable_buffer is set", *shards)
 	}
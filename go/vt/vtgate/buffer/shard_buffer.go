@@ -45,7 +45,8 @@ const (
 	stateDraining bufferState = "DRAINING"
 )
 
-// shardBuffer buffers requests during a failover for a particular shard.
+// shardBuffer buffers requests during a failover for a particular shard and
+// tablet type.
 // The object will be reused across failovers. If no failover is currently in
 // progress, the state is "IDLE".
 //
@@ -59,9 +60,18 @@ type shardBuffer struct {
 	mode     bufferMode
 	keyspace string
 	shard    string
-	now      func() time.Time
+	// name identifies the buffer in log messages.
+	name string
+	now  func() time.Time
+	// window and size point to the flags of the buffering window of a request
+	// and of the size of "bufferSizeSema". They differ between MASTER and read
+	// buffers.
+	window *time.Duration
+	size   *int
 	// bufferSizeSema is the shared pool of slots. See "Buffer.bufferSizeSema".
 	bufferSizeSema *sync2.Semaphore
+	// vars are the stats variables updated by this buffer.
+	vars *bufferVariables
 	// statsKey is used to update the stats variables.
 	statsKey []string
 	// statsKeyJoined is all elements of "statsKey" in one string, joined by ".".
@@ -120,21 +130,35 @@ type entry struct {
 	bufferCancel func()
 }
 
-func newShardBuffer(mode bufferMode, keyspace, shard string, now func() time.Time, bufferSizeSema *sync2.Semaphore) *shardBuffer {
-	statsKey := []string{keyspace, shard}
-	initVariablesForShard(statsKey)
-
-	return &shardBuffer{
+// newShardBuffer creates the buffer of a shard for the given tablet type.
+// Only MASTER and read (REPLICA or RDONLY) buffers are supported.
+func newShardBuffer(mode bufferMode, keyspace, shard string, tabletType topodatapb.TabletType, now func() time.Time, bufferSizeSema *sync2.Semaphore) *shardBuffer {
+	sb := &shardBuffer{
 		mode:           mode,
 		keyspace:       keyspace,
 		shard:          shard,
+		name:           topoproto.KeyspaceShardString(keyspace, shard),
 		now:            now,
+		window:         window,
+		size:           size,
 		bufferSizeSema: bufferSizeSema,
-		statsKey:       statsKey,
+		vars:           masterVariables,
+		statsKey:       []string{keyspace, shard},
 		statsKeyJoined: fmt.Sprintf("%s.%s", keyspace, shard),
-		logTooRecent:   logutil.NewThrottledLogger(fmt.Sprintf("FailoverTooRecent-%v", topoproto.KeyspaceShardString(keyspace, shard)), 5*time.Second),
 		state:          stateIdle,
 	}
+	if tabletType != topodatapb.TabletType_MASTER {
+		tabletTypeStr := topoproto.TabletTypeLString(tabletType)
+		sb.name = fmt.Sprintf("%v (%v)", sb.name, tabletTypeStr)
+		sb.window = readsWindow
+		sb.size = readsSize
+		sb.vars = readVariables
+		sb.statsKey = []string{keyspace, shard, tabletTypeStr}
+		sb.statsKeyJoined = fmt.Sprintf("%s.%s.%s", keyspace, shard, tabletTypeStr)
+	}
+	sb.logTooRecent = logutil.NewThrottledLogger(fmt.Sprintf("FailoverTooRecent-%v", sb.name), 5*time.Second)
+	sb.vars.initForShard(sb.statsKey)
+	return sb
 }
 
 // disabled returns true if neither buffering nor the dry-run mode is enabled.
@@ -186,10 +210,10 @@ func (sb *shardBuffer) waitForFailoverEnd(ctx context.Context, keyspace, shard s
 
 			sb.logTooRecent.Infof("%v for shard: %s because the last failover which triggered buffering is too recent (%v < %v)."+
 				" (A failover was detected by this seen error: %v.)",
-				msg, topoproto.KeyspaceShardString(keyspace, shard), lastBufferingStopped, *minTimeBetweenFailovers, err)
+				msg, sb.name, lastBufferingStopped, *minTimeBetweenFailovers, err)
 
 			statsKeyWithReason := append(sb.statsKey, string(skippedLastFailoverTooRecent))
-			requestsSkipped.Add(statsKeyWithReason, 1)
+			sb.vars.requestsSkipped.Add(statsKeyWithReason, 1)
 			return nil, nil
 		}
 
@@ -208,10 +232,10 @@ func (sb *shardBuffer) waitForFailoverEnd(ctx context.Context, keyspace, shard s
 
 			sb.logTooRecent.Infof("%v for shard: %s because the last reparent is too recent (%v < %v)."+
 				" (A failover was detected by this seen error: %v.)",
-				msg, topoproto.KeyspaceShardString(keyspace, shard), lastReparentAgo, *minTimeBetweenFailovers, err)
+				msg, sb.name, lastReparentAgo, *minTimeBetweenFailovers, err)
 
 			statsKeyWithReason := append(sb.statsKey, string(skippedLastReparentTooRecent))
-			requestsSkipped.Add(statsKeyWithReason, 1)
+			sb.vars.requestsSkipped.Add(statsKeyWithReason, 1)
 			return nil, nil
 		}
 
@@ -221,8 +245,8 @@ func (sb *shardBuffer) waitForFailoverEnd(ctx context.Context, keyspace, shard s
 	if sb.mode == bufferDryRun {
 		sb.mu.Unlock()
 		// Dry-run. Do not actually buffer the request and return early.
-		lastRequestsDryRunMax.Add(sb.statsKey, 1)
-		requestsBufferedDryRun.Add(sb.statsKey, 1)
+		sb.vars.lastRequestsDryRunMax.Add(sb.statsKey, 1)
+		sb.vars.requestsBufferedDryRun.Add(sb.statsKey, 1)
 		return nil, nil
 	}
 
@@ -261,9 +285,9 @@ func (sb *shardBuffer) shouldBufferLocked(failoverDetected bool) bool {
 
 func (sb *shardBuffer) startBufferingLocked(err error) {
 	// Reset monitoring data from previous failover.
-	lastRequestsInFlightMax.Set(sb.statsKey, 0)
-	lastRequestsDryRunMax.Set(sb.statsKey, 0)
-	failoverDurationSumMs.Reset(sb.statsKey)
+	sb.vars.lastRequestsInFlightMax.Set(sb.statsKey, 0)
+	sb.vars.lastRequestsDryRunMax.Set(sb.statsKey, 0)
+	sb.vars.failoverDurationSumMs.Reset(sb.statsKey)
 
 	sb.lastStart = sb.now()
 	sb.logErrorIfStateNotLocked(stateIdle)
@@ -276,9 +300,9 @@ func (sb *shardBuffer) startBufferingLocked(err error) {
 	if sb.mode == bufferDryRun {
 		msg = "Dry-run: Would have started buffering"
 	}
-	starts.Add(sb.statsKey, 1)
+	sb.vars.starts.Add(sb.statsKey, 1)
 	log.Infof("%v for shard: %s (window: %v, size: %v, max failover duration: %v) (A failover was detected by this seen error: %v.)",
-		msg, topoproto.KeyspaceShardString(sb.keyspace, sb.shard), *window, *size, *maxFailoverDuration, err)
+		msg, sb.name, *sb.window, *sb.size, *maxFailoverDuration, err)
 }
 
 // logErrorIfStateNotLocked logs an error if the current state is not "state".
@@ -306,7 +330,7 @@ func (sb *shardBuffer) bufferRequestLocked(ctx context.Context) (*entry, error)
 			// there is at least one other shard failing over as well which consumes
 			// the whole buffer.
 			statsKeyWithReason := append(sb.statsKey, string(skippedBufferFull))
-			requestsSkipped.Add(statsKeyWithReason, 1)
+			sb.vars.requestsSkipped.Add(statsKeyWithReason, 1)
 			return nil, bufferFullError
 		}
 
@@ -320,20 +344,20 @@ func (sb *shardBuffer) bufferRequestLocked(ctx context.Context) (*entry, error)
 		sb.unblockAndWait(e, entryEvictedError, false /* releaseSlot */, false /* blockingWait */)
 		sb.queue = sb.queue[1:]
 		statsKeyWithReason := append(sb.statsKey, evictedBufferFull)
-		requestsEvicted.Add(statsKeyWithReason, 1)
+		sb.vars.requestsEvicted.Add(statsKeyWithReason, 1)
 	}
 
 	e := &entry{
 		done:     make(chan struct{}),
-		deadline: sb.now().Add(*window),
+		deadline: sb.now().Add(*sb.window),
 	}
 	e.bufferCtx, e.bufferCancel = context.WithCancel(ctx)
 	sb.queue = append(sb.queue, e)
 
-	if max := lastRequestsInFlightMax.Counts()[sb.statsKeyJoined]; max < int64(len(sb.queue)) {
-		lastRequestsInFlightMax.Set(sb.statsKey, int64(len(sb.queue)))
+	if max := sb.vars.lastRequestsInFlightMax.Counts()[sb.statsKeyJoined]; max < int64(len(sb.queue)) {
+		sb.vars.lastRequestsInFlightMax.Set(sb.statsKey, int64(len(sb.queue)))
 	}
-	requestsBuffered.Add(sb.statsKey, 1)
+	sb.vars.requestsBuffered.Add(sb.statsKey, 1)
 
 	if len(sb.queue) == 1 {
 		sb.timeoutThread.notifyQueueNotEmpty()
@@ -424,7 +448,7 @@ func (sb *shardBuffer) evictOldestEntry(e *entry) {
 	sb.unblockAndWait(e, nil /* err */, true /* releaseSlot */, false /* blockingWait */)
 	sb.queue = sb.queue[1:]
 	statsKeyWithReason := append(sb.statsKey, evictedWindowExceeded)
-	requestsEvicted.Add(statsKeyWithReason, 1)
+	sb.vars.requestsEvicted.Add(statsKeyWithReason, 1)
 }
 
 // remove must be called when the request was canceled from outside and not
@@ -460,7 +484,7 @@ func (sb *shardBuffer) remove(toRemove *entry) {
 
 			// Track it as "ContextDone" eviction.
 			statsKeyWithReason := append(sb.statsKey, string(evictedContextDone))
-			requestsEvicted.Add(statsKeyWithReason, 1)
+			sb.vars.requestsEvicted.Add(statsKeyWithReason, 1)
 			return
 		}
 	}
@@ -499,6 +523,19 @@ func (sb *shardBuffer) recordExternallyReparentedTimestamp(timestamp int64, alia
 	sb.stopBufferingLocked(stopFailoverEndDetected, "failover end detected")
 }
 
+// copyMasterFrom initializes the MASTER tracked by a new buffer with the one
+// tracked by "master".
+func (sb *shardBuffer) copyMasterFrom(master *shardBuffer) {
+	master.mu.RLock()
+	defer master.mu.RUnlock()
+	sb.mu.Lock()
+	defer sb.mu.Unlock()
+
+	sb.externallyReparented = master.externallyReparented
+	sb.currentMaster = master.currentMaster
+	sb.lastReparent = master.lastReparent
+}
+
 func (sb *shardBuffer) stopBufferingDueToMaxDuration() {
 	sb.mu.Lock()
 	defer sb.mu.Unlock()
@@ -517,18 +554,18 @@ func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 	d := sb.lastEnd.Sub(sb.lastStart)
 
 	statsKeyWithReason := append(sb.statsKey, string(reason))
-	stops.Add(statsKeyWithReason, 1)
+	sb.vars.stops.Add(statsKeyWithReason, 1)
 
-	lastFailoverDurationMs.Set(sb.statsKey, int64(d/time.Millisecond))
-	failoverDurationSumMs.Add(sb.statsKey, int64(d/time.Millisecond))
+	sb.vars.lastFailoverDurationMs.Set(sb.statsKey, int64(d/time.Millisecond))
+	sb.vars.failoverDurationSumMs.Add(sb.statsKey, int64(d/time.Millisecond))
 	if sb.mode == bufferDryRun {
 		utilDryRunMax := int64(
-			float64(lastRequestsDryRunMax.Counts()[sb.statsKeyJoined]) / float64(*size) * 100.0)
-		utilizationDryRunSum.Add(sb.statsKey, utilDryRunMax)
+			float64(sb.vars.lastRequestsDryRunMax.Counts()[sb.statsKeyJoined]) / float64(*sb.size) * 100.0)
+		sb.vars.utilizationDryRunSum.Add(sb.statsKey, utilDryRunMax)
 	} else {
 		utilMax := int64(
-			float64(lastRequestsInFlightMax.Counts()[sb.statsKeyJoined]) / float64(*size) * 100.0)
-		utilizationSum.Add(sb.statsKey, utilMax)
+			float64(sb.vars.lastRequestsInFlightMax.Counts()[sb.statsKeyJoined]) / float64(*sb.size) * 100.0)
+		sb.vars.utilizationSum.Add(sb.statsKey, utilMax)
 	}
 
 	sb.logErrorIfStateNotLocked(stateBuffering)
@@ -542,7 +579,7 @@ func (sb *shardBuffer) stopBufferingLocked(reason stopReason, details string) {
 	if sb.mode == bufferDryRun {
 		msg = "Dry-run: Would have stopped buffering"
 	}
-	log.Infof("%v for shard: %s after: %.1f seconds due to: %v. Draining %d buffered requests now.", msg, topoproto.KeyspaceShardString(sb.keyspace, sb.shard), d.Seconds(), details, len(q))
+	log.Infof("%v for shard: %s after: %.1f seconds due to: %v. Draining %d buffered requests now.", msg, sb.name, d.Seconds(), details, len(q))
 
 	// Start the drain. (Use a new Go routine to release the lock.)
 	sb.wg.Add(1)
@@ -562,8 +599,10 @@ func (sb *shardBuffer) drain(q []*entry) {
 		sb.unblockAndWait(e, nil /* err */, true /* releaseSlot */, true /* blockingWait */)
 	}
 	d := sb.now().Sub(start)
-	log.Infof("Draining finished for shard: %s Took: %v for: %d requests.", topoproto.KeyspaceShardString(sb.keyspace, sb.shard), d, len(q))
-	requestsDrained.Add(sb.statsKey, int64(len(q)))
+	log.Infof("Draining finished for shard: %s Took: %v for: %d requests.", sb.name, d, len(q))
+	sb.vars.requestsDrained.Add(sb.statsKey, int64(len(q)))
+	sb.vars.lastDrainDurationMs.Set(sb.statsKey, int64(d/time.Millisecond))
+	sb.vars.drainDurationSumMs.Add(sb.statsKey, int64(d/time.Millisecond))
 
 	// Draining is done. Change state from "draining" to "idle".
 	sb.mu.Lock()
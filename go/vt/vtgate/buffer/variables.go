@@ -93,6 +93,12 @@ var (
 		"BufferRequestsEvicted",
 		"Evicted buffered requests",
 		[]string{"Keyspace", "ShardName", "Reason"})
+	// drainDurationSumMs is the cumulative sum of the durations of all drains.
+	// In connection with "starts" it can be used to calculate a moving average.
+	drainDurationSumMs = stats.NewCountersWithMultiLabels(
+		"BufferDrainDurationSumMs",
+		"Total duration of the buffer drains",
+		[]string{"Keyspace", "ShardName"})
 	// requestsSkipped tracks how many requests would have been buffered but
 	// eventually were not (includes dry-run bufferings).
 	// See the type "skippedReason" below for all possible values of "Reason".
@@ -143,33 +149,140 @@ const (
 	skippedLastFailoverTooRecent = "LastFailoverTooRecent"
 )
 
-// initVariablesForShard is used to initialize all shard variables to 0.
+// bufferVariables groups the stats variables updated by a shardBuffer.
+// MASTER and read buffers publish their stats under different names.
+type bufferVariables struct {
+	starts                  *stats.CountersWithMultiLabels
+	stops                   *stats.CountersWithMultiLabels
+	failoverDurationSumMs   *stats.CountersWithMultiLabels
+	utilizationSum          *stats.GaugesWithMultiLabels
+	utilizationDryRunSum    *stats.CountersWithMultiLabels
+	requestsBuffered        *stats.CountersWithMultiLabels
+	requestsBufferedDryRun  *stats.CountersWithMultiLabels
+	requestsDrained         *stats.CountersWithMultiLabels
+	requestsEvicted         *stats.CountersWithMultiLabels
+	requestsSkipped         *stats.CountersWithMultiLabels
+	drainDurationSumMs      *stats.CountersWithMultiLabels
+	lastFailoverDurationMs  *stats.GaugesWithMultiLabels
+	lastRequestsInFlightMax *stats.GaugesWithMultiLabels
+	lastRequestsDryRunMax   *stats.GaugesWithMultiLabels
+	lastDrainDurationMs     *stats.GaugesWithMultiLabels
+}
+
+// masterVariables are the stats variables of the MASTER buffers.
+// Their labels are "Keyspace" and "ShardName".
+var masterVariables = &bufferVariables{
+	starts:                  starts,
+	stops:                   stops,
+	failoverDurationSumMs:   failoverDurationSumMs,
+	utilizationSum:          utilizationSum,
+	utilizationDryRunSum:    utilizationDryRunSum,
+	requestsBuffered:        requestsBuffered,
+	requestsBufferedDryRun:  requestsBufferedDryRun,
+	requestsDrained:         requestsDrained,
+	requestsEvicted:         requestsEvicted,
+	requestsSkipped:         requestsSkipped,
+	drainDurationSumMs:      drainDurationSumMs,
+	lastFailoverDurationMs:  lastFailoverDurationMs,
+	lastRequestsInFlightMax: lastRequestsInFlightMax,
+	lastRequestsDryRunMax:   lastRequestsDryRunMax,
+	lastDrainDurationMs:     lastDrainDurationMs,
+}
+
+// readVariables are the stats variables of the REPLICA and RDONLY buffers.
+// They have the same meaning as their MASTER counterparts and their labels
+// are "Keyspace", "ShardName" and "TabletType".
+var readVariables = &bufferVariables{
+	starts: stats.NewCountersWithMultiLabels(
+		"BufferReadsStarts",
+		"Read buffering operation starts, including dry-run",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	stops: stats.NewCountersWithMultiLabels(
+		"BufferReadsStops",
+		"Read buffering operation stops, including dry-runs",
+		[]string{"Keyspace", "ShardName", "TabletType", "Reason"}),
+	failoverDurationSumMs: stats.NewCountersWithMultiLabels(
+		"BufferReadsFailoverDurationSumMs",
+		"Total read buffering failover duration",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	utilizationSum: stats.NewGaugesWithMultiLabels(
+		"BufferReadsUtilizationSum",
+		"Cumulative read buffer utilization (in %) during failover",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	utilizationDryRunSum: stats.NewCountersWithMultiLabels(
+		"BufferReadsUtilizationDryRunSum",
+		"Cumulative read buffer utilization % during failover (dry-run)",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	requestsBuffered: stats.NewCountersWithMultiLabels(
+		"BufferReadsRequestsBuffered",
+		"Buffered read requests",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	requestsBufferedDryRun: stats.NewCountersWithMultiLabels(
+		"BufferReadsRequestsBufferedDryRun",
+		"Buffered read requests (dry-run)",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	requestsDrained: stats.NewCountersWithMultiLabels(
+		"BufferReadsRequestsDrained",
+		"Drained buffered read requests",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	requestsEvicted: stats.NewCountersWithMultiLabels(
+		"BufferReadsRequestsEvicted",
+		"Evicted buffered read requests",
+		[]string{"Keyspace", "ShardName", "TabletType", "Reason"}),
+	requestsSkipped: stats.NewCountersWithMultiLabels(
+		"BufferReadsRequestsSkipped",
+		"Skipped read buffering requests (incl. dry-run)",
+		[]string{"Keyspace", "ShardName", "TabletType", "Reason"}),
+	drainDurationSumMs: stats.NewCountersWithMultiLabels(
+		"BufferReadsDrainDurationSumMs",
+		"Total duration of the drains of the read buffers",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	lastFailoverDurationMs: stats.NewGaugesWithMultiLabels(
+		"BufferReadsLastFailoverDurationMs",
+		"Read buffering duration during the last failover. The value for a given shard and tablet type will be reset at the next failover.",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	lastRequestsInFlightMax: stats.NewGaugesWithMultiLabels(
+		"BufferReadsLastRequestsInFlightMax",
+		"The max value of buffered read requests in flight of the last failover. The value for a given shard and tablet type will be reset at the next failover.",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	lastRequestsDryRunMax: stats.NewGaugesWithMultiLabels(
+		"BufferReadsLastRequestsDryRunMax",
+		"Max # of read requests which were seen during a dry-run buffering of the last failover",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+	lastDrainDurationMs: stats.NewGaugesWithMultiLabels(
+		"BufferReadsLastDrainDurationMs",
+		"Duration of the last drain of the read buffer. The value for a given shard and tablet type will be reset at the next drain.",
+		[]string{"Keyspace", "ShardName", "TabletType"}),
+}
+
+// initForShard is used to initialize all shard variables to 0.
 // If we don't do this, monitoring frameworks may not correctly calculate rates
 // for the first failover of the shard because they see a transition from
 // "no value for this label set (NaN)" to "a value".
-// "statsKey" should have two members for keyspace and shard.
-func initVariablesForShard(statsKey []string) {
-	starts.Reset(statsKey)
+// "statsKey" must have one member per label, without the "Reason" label.
+func (v *bufferVariables) initForShard(statsKey []string) {
+	v.starts.Reset(statsKey)
 	for _, reason := range stopReasons {
 		key := append(statsKey, string(reason))
-		stops.Reset(key)
+		v.stops.Reset(key)
 	}
 
-	failoverDurationSumMs.Reset(statsKey)
+	v.failoverDurationSumMs.Reset(statsKey)
 
-	utilizationSum.Set(statsKey, 0)
-	utilizationDryRunSum.Reset(statsKey)
+	v.utilizationSum.Set(statsKey, 0)
+	v.utilizationDryRunSum.Reset(statsKey)
 
-	requestsBuffered.Reset(statsKey)
-	requestsBufferedDryRun.Reset(statsKey)
-	requestsDrained.Reset(statsKey)
+	v.requestsBuffered.Reset(statsKey)
+	v.requestsBufferedDryRun.Reset(statsKey)
+	v.requestsDrained.Reset(statsKey)
+	v.drainDurationSumMs.Reset(statsKey)
 	for _, reason := range evictReasons {
 		key := append(statsKey, string(reason))
-		requestsEvicted.Reset(key)
+		v.requestsEvicted.Reset(key)
 	}
 	for _, reason := range skippedReasons {
 		key := append(statsKey, string(reason))
-		requestsSkipped.Reset(key)
+		v.requestsSkipped.Reset(key)
 	}
 }
 
@@ -194,4 +307,10 @@ var (
 		"BufferLastRequestsDryRunMax",
 		"Max # of requests which were seen during a dry-run buffering of the last failover",
 		[]string{"Keyspace", "ShardName"})
+	lastDrainDurationMs = stats.NewGaugesWithMultiLabels(
+		"BufferLastDrainDurationMs",
+		"Duration of the last drain of the buffer. The value for a given shard will be reset at the next drain.",
+		[]string{"Keyspace", "ShardName"})
+	// readsBufferSize publishes the configured per vtgate read buffer size.
+	readsBufferSize = stats.NewGauge("BufferReadsSize", "The configured per vtgate read buffer size")
 )
@@ -263,9 +263,10 @@ func (dg *DiscoveryGateway) withRetry(ctx context.Context, target *querypb.Targe
 		// Note: We only buffer once and only "!inTransaction" queries i.e.
 		// a) no transaction is necessary (e.g. critical reads) or
 		// b) no transaction was created yet.
-		if !bufferedOnce && !inTransaction && target.TabletType == topodatapb.TabletType_MASTER {
+		// Reads are only buffered if no other tablet is left to serve them.
+		if !bufferedOnce && !inTransaction && canBuffer(target, invalidTablets, dg.healthyTablets) {
 			// The next call blocks if we should buffer during a failover.
-			retryDone, bufferErr := dg.buffer.WaitForFailoverEnd(ctx, target.Keyspace, target.Shard, err)
+			retryDone, bufferErr := waitForFailoverEnd(ctx, dg.buffer, target, err)
 			if bufferErr != nil {
 				// Buffering failed e.g. buffer is already full. Do not retry.
 				err = vterrors.Errorf(
@@ -376,6 +377,16 @@ func nextTablet(cell string, tablets []discovery.LegacyTabletStats, offset, leng
 	return -1
 }
 
+// healthyTablets returns the keys of the healthy tablets of the target,
+// as they are recorded in the invalid tablets of a request.
+func (dg *DiscoveryGateway) healthyTablets(target *querypb.Target) []string {
+	var keys []string
+	for _, t := range dg.tsc.GetHealthyTabletStats(target.Keyspace, target.Shard, target.TabletType) {
+		keys = append(keys, t.Key)
+	}
+	return keys
+}
+
 func (dg *DiscoveryGateway) updateStats(target *querypb.Target, startTime time.Time, err error) {
 	elapsed := time.Since(startTime)
 	aggr := dg.getStatsAggregator(target)
@@ -17,6 +17,7 @@ limitations under the License.
 package engine
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
@@ -25,6 +26,7 @@ import (
 type comparer struct {
 	orderBy, weightString int
 	desc                  bool
+	collation             collations.ID
 }
 
 // compare compares two rows given the comparer and returns which one should be earlier in the result set
@@ -32,7 +34,7 @@ type comparer struct {
 // 1 is the second row should be earlier
 // 0 if both the rows have equal ordering
 func (c *comparer) compare(r1, r2 []sqltypes.Value) (int, error) {
-	cmp, err := evalengine.NullsafeCompare(r1[c.orderBy], r2[c.orderBy])
+	cmp, err := evalengine.NullsafeCompareWithCollation(r1[c.orderBy], r2[c.orderBy], c.collation)
 	if err != nil {
 		_, isComparisonErr := err.(evalengine.UnsupportedComparisonError)
 		if !(isComparisonErr && c.weightString != -1) {
@@ -53,15 +55,24 @@ func (c *comparer) compare(r1, r2 []sqltypes.Value) (int, error) {
 	return cmp, nil
 }
 
-// extractSlices extracts the three fields of OrderbyParams into a slice of comparers
+// extractSlices extracts the fields of OrderbyParams into a slice of comparers
 func extractSlices(input []OrderbyParams) []*comparer {
 	var result []*comparer
 	for _, order := range input {
-		result = append(result, &comparer{
+		c := &comparer{
 			orderBy:      order.Col,
 			weightString: order.WeightStringCol,
 			desc:         order.Desc,
-		})
+			collation:    order.CollationID,
+		}
+		// A weight string requested for a collated ordering was computed by
+		// mysql under that collation, so it is compared instead of the value.
+		if c.collation != collations.Unknown && c.weightString != -1 {
+			c.orderBy = c.weightString
+			c.weightString = -1
+			c.collation = collations.Unknown
+		}
+		result = append(result, c)
 	}
 	return result
 }
@@ -118,7 +118,7 @@ func (del *Delete) execDeleteUnsharded(vcursor VCursor, bindVars map[string]*que
 }
 
 func (del *Delete) execDeleteEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	key, err := del.Values[0].ResolveValue(bindVars)
+	key, err := del.vindexKey(bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +207,8 @@ func (del *Delete) deleteVindexEntries(vcursor VCursor, bindVars map[string]*que
 	}
 
 	for _, row := range subQueryResults.Rows {
-		colnum := 1
-		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[0])
+		colnum := del.ksidLength()
+		ksid, err := resolveKeyspaceID(vcursor, del.KsidVindex, row[:del.ksidLength()])
 		if err != nil {
 			return err
 		}
@@ -256,6 +256,9 @@ func addFieldsIfNotEmpty(dml DML, other map[string]interface{}) {
 	if dml.KsidVindex != nil {
 		other["KsidVindex"] = dml.KsidVindex.String()
 	}
+	if dml.KsidLength > 1 {
+		other["KsidLength"] = dml.KsidLength
+	}
 	if len(dml.Values) > 0 {
 		other["Values"] = dml.Values
 	}
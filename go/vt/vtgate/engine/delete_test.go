@@ -223,6 +223,45 @@ func TestDeleteOwnedVindex(t *testing.T) {
 	})
 }
 
+func TestDeleteOwnedVindexMultiColumn(t *testing.T) {
+	ks := buildTestVSchema().Keyspaces["sharded"]
+	del := &Delete{
+		DML: DML{
+			Opcode:           Equal,
+			Keyspace:         ks.Keyspace,
+			Query:            "dummy_delete",
+			Vindex:           ks.Vindexes["region"],
+			Values:           []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}},
+			Table:            ks.Tables["rg_tbl"],
+			OwnedVindexQuery: "dummy_subquery",
+			KsidVindex:       ks.Vindexes["region"],
+			KsidLength:       2,
+		},
+	}
+
+	results := []*sqltypes.Result{sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields(
+			"cola|colb|c3",
+			"int64|int64|int64",
+		),
+		"1|2|6",
+	)}
+
+	vc := newDMLTestVCursor("-20", "20-")
+	vc.results = results
+
+	_, err := del.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations sharded [] Destinations:DestinationKeyspaceID(0106e7ea22ce92708f)`,
+		// The first two columns returned by the subquery are the columns
+		// of the multi-column vindex. The owned vindex values come after.
+		`ExecuteMultiShard sharded.-20: dummy_subquery {} false false`,
+		`Execute delete from lkp_rg where from = :from and toc = :toc from: type:INT64 value:"6" toc: type:VARBINARY value:"\001\006\347\352\"\316\222p\217"  true`,
+		`ExecuteMultiShard sharded.-20: dummy_delete {} true true`,
+	})
+}
+
 func TestDeleteSharded(t *testing.T) {
 	ks := buildTestVSchema().Keyspaces["sharded"]
 	del := &Delete{
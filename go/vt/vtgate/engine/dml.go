@@ -20,6 +20,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
@@ -40,14 +41,18 @@ type DML struct {
 	Query string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 
 	// Values specifies the vindex values to use for routing.
-	// For now, only one value is specified.
+	// For a multi-column vindex, there is one value per column.
 	Values []sqltypes.PlanValue
 
 	// Keyspace Id Vindex
-	KsidVindex vindexes.SingleColumn
+	KsidVindex vindexes.Vindex
+
+	// KsidLength is the number of columns of KsidVindex. They are
+	// the first columns returned by OwnedVindexQuery. Defaults to 1.
+	KsidLength int
 
 	// Table specifies the table for the update.
 	Table *vindexes.Table
@@ -100,12 +105,37 @@ func (op DMLOpcode) String() string {
 	return opcodeName[op]
 }
 
-func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.SingleColumn) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+// vindexKey resolves the values of the vindex columns for the Equal opcode.
+func (dml *DML) vindexKey(bindVars map[string]*querypb.BindVariable) ([]sqltypes.Value, error) {
+	key := make([]sqltypes.Value, 0, len(dml.Values))
+	for _, pv := range dml.Values {
+		value, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, err
+		}
+		key = append(key, value)
+	}
+	return key, nil
+}
+
+// ksidLength returns the number of columns of the keyspace id vindex.
+func (dml *DML) ksidLength() int {
+	if dml.KsidLength == 0 {
+		return 1
+	}
+	return dml.KsidLength
+}
+
+func resolveMultiValueShards(vcursor VCursor, keyspace *vindexes.Keyspace, query string, bindVars map[string]*querypb.BindVariable, pv sqltypes.PlanValue, vindex vindexes.Vindex) ([]*srvtopo.ResolvedShard, []*querypb.BoundQuery, error) {
+	single, ok := vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex '%s' is not a single column vindex", vindex.String())
+	}
 	keys, err := pv.ResolveList(bindVars)
 	if err != nil {
 		return nil, nil, err
 	}
-	rss, err := resolveMultiShard(vcursor, vindex, keyspace, keys)
+	rss, err := resolveMultiShard(vcursor, single, keyspace, keys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -0,0 +1,222 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package engine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var _ Primitive = (*ExplainVitess)(nil)
+
+// ExplainVitess describes the plan of its input, one row per primitive of
+// the plan tree, instead of executing it. The shards a route is sent to are
+// resolved against the bind variables of the query.
+type ExplainVitess struct {
+	Input Primitive
+
+	noTxNeeded
+}
+
+var explainVitessFields = []*querypb.Field{
+	{Name: "operator", Type: querypb.Type_VARCHAR},
+	{Name: "variant", Type: querypb.Type_VARCHAR},
+	{Name: "keyspace", Type: querypb.Type_VARCHAR},
+	{Name: "destination", Type: querypb.Type_VARCHAR},
+	{Name: "tabletType", Type: querypb.Type_VARCHAR},
+	{Name: "query", Type: querypb.Type_VARCHAR},
+	{Name: "vindex", Type: querypb.Type_VARCHAR},
+	{Name: "values", Type: querypb.Type_VARCHAR},
+	{Name: "shards", Type: querypb.Type_VARCHAR},
+}
+
+// RouteType implements the Primitive interface
+func (e *ExplainVitess) RouteType() string {
+	return "ExplainVitess"
+}
+
+// GetKeyspaceName implements the Primitive interface
+func (e *ExplainVitess) GetKeyspaceName() string {
+	return e.Input.GetKeyspaceName()
+}
+
+// GetTableName implements the Primitive interface
+func (e *ExplainVitess) GetTableName() string {
+	return e.Input.GetTableName()
+}
+
+// Execute implements the Primitive interface
+func (e *ExplainVitess) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, _ bool) (*sqltypes.Result, error) {
+	lines := treeLines(PrimitiveToPlanDescription(e.Input))
+	primitives := flattenPrimitives(e.Input)
+
+	rows := make([][]sqltypes.Value, 0, len(lines))
+	for i, line := range lines {
+		var keyspaceName, targetDest, vindex string
+		if line.descr.Keyspace != nil {
+			keyspaceName = line.descr.Keyspace.Name
+		}
+		if line.descr.TargetDestination != nil {
+			targetDest = line.descr.TargetDestination.String()
+		}
+		if v, ok := line.descr.Other["Vindex"].(string); ok {
+			vindex = v
+		}
+		var values, shards string
+		if route, ok := primitives[i].(*Route); ok {
+			values = planValuesToString(route.Values)
+			shards = routeShards(vcursor, route, bindVars)
+		}
+
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewVarChar(line.header + line.descr.OperatorType), // operator
+			sqltypes.NewVarChar(line.descr.Variant),                    // variant
+			sqltypes.NewVarChar(keyspaceName),                          // keyspace
+			sqltypes.NewVarChar(targetDest),                            // destination
+			sqltypes.NewVarChar(line.descr.TargetTabletType.String()),  // tabletType
+			sqltypes.NewVarChar(extractQuery(line.descr.Other)),        // query
+			sqltypes.NewVarChar(vindex),                                // vindex
+			sqltypes.NewVarChar(values),                                // values
+			sqltypes.NewVarChar(shards),                                // shards
+		})
+	}
+	return &sqltypes.Result{
+		Fields: explainVitessFields,
+		Rows:   rows,
+	}, nil
+}
+
+// StreamExecute implements the Primitive interface
+func (e *ExplainVitess) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
+	result, err := e.Execute(vcursor, bindVars, wantfields)
+	if err != nil {
+		return err
+	}
+	return callback(result)
+}
+
+// GetFields implements the Primitive interface
+func (e *ExplainVitess) GetFields(VCursor, map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
+	return &sqltypes.Result{Fields: explainVitessFields}, nil
+}
+
+// Inputs implements the Primitive interface
+func (e *ExplainVitess) Inputs() []Primitive {
+	return []Primitive{e.Input}
+}
+
+func (e *ExplainVitess) description() PrimitiveDescription {
+	return PrimitiveDescription{OperatorType: "ExplainVitess"}
+}
+
+// routeShards returns the comma separated names of the shards the route
+// is sent to, or an empty string if they cannot be resolved.
+func routeShards(vcursor VCursor, route *Route, bindVars map[string]*querypb.BindVariable) string {
+	rss, _, err := route.findRoute(vcursor, bindVars)
+	if err != nil {
+		return ""
+	}
+	shards := make([]string, 0, len(rss))
+	for _, rs := range rss {
+		shards = append(shards, rs.Target.Shard)
+	}
+	return strings.Join(shards, ",")
+}
+
+func planValuesToString(pvs []sqltypes.PlanValue) string {
+	values := make([]string, 0, len(pvs))
+	for _, pv := range pvs {
+		values = append(values, planValueToString(pv))
+	}
+	return strings.Join(values, ", ")
+}
+
+func planValueToString(pv sqltypes.PlanValue) string {
+	switch {
+	case pv.Key != "":
+		return ":" + pv.Key
+	case !pv.Value.IsNull():
+		return pv.Value.ToString()
+	case pv.ListKey != "":
+		return "::" + pv.ListKey
+	case pv.Values != nil:
+		return "(" + planValuesToString(pv.Values) + ")"
+	}
+	return "null"
+}
+
+// flattenPrimitives returns the primitives of the tree in the order of the
+// lines returned by treeLines.
+func flattenPrimitives(root Primitive) []Primitive {
+	output := []Primitive{root}
+	for _, input := range root.Inputs() {
+		output = append(output, flattenPrimitives(input)...)
+	}
+	return output
+}
+
+func extractQuery(m map[string]interface{}) string {
+	queryObj, ok := m["Query"]
+	if !ok {
+		return ""
+	}
+	query, ok := queryObj.(string)
+	if !ok {
+		return ""
+	}
+
+	return query
+}
+
+type description struct {
+	header string
+	descr  PrimitiveDescription
+}
+
+func treeLines(root PrimitiveDescription) []description {
+	l := len(root.Inputs) - 1
+	output := []description{{
+		header: "",
+		descr:  root,
+	}}
+	for i, child := range root.Inputs {
+		childLines := treeLines(child)
+		var header string
+		var lastHdr string
+		if i == l {
+			header = "└─" + " "
+			lastHdr = strings.Repeat(" ", 3)
+		} else {
+			header = "├─" + " "
+			lastHdr = "│" + strings.Repeat(" ", 2)
+		}
+
+		for x, childLine := range childLines {
+			if x == 0 {
+				childLine.header = header + childLine.header
+			} else {
+				childLine.header = lastHdr + childLine.header
+			}
+
+			output = append(output, childLine)
+		}
+	}
+	return output
+}
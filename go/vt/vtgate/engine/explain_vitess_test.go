@@ -14,17 +14,23 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package planbuilder
+package engine
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/test/utils"
-	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
-type Descr = engine.PrimitiveDescription
+type Descr = PrimitiveDescription
 
 func TestTreeStructure(t *testing.T) {
 	var classical, popRock Descr
@@ -149,3 +155,50 @@ func toString(descriptions []description) string {
 	}
 	return strings.Trim(output, " \n\t")
 }
+
+func TestExplainVitessExecute(t *testing.T) {
+	ks := &vindexes.Keyspace{Name: "ks", Sharded: true}
+	vindex, _ := vindexes.NewHash("hash", nil)
+	left := NewRoute(SelectEqualUnique, ks, "select a from t1 where id = :id", "select a from t1 where 1 != 1")
+	left.Vindex = vindex.(vindexes.SingleColumn)
+	left.Values = []sqltypes.PlanValue{{Key: "id"}}
+	right := NewRoute(SelectScatter, ks, "select b from t2", "select b from t2 where 1 != 1")
+	explain := &ExplainVitess{Input: &Join{
+		Opcode: NormalJoin,
+		Left:   left,
+		Right:  right,
+		Cols:   []int{-1, 1},
+	}}
+
+	vc := &loggingVCursor{shards: []string{"-20", "20-"}}
+	bindVars := map[string]*querypb.BindVariable{"id": sqltypes.Int64BindVariable(1)}
+	result, err := explain.Execute(vc, bindVars, true)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [type:INT64 value:"1" ] Destinations:DestinationKeyspaceID(166b40b44aba4bd6)`,
+		`ResolveDestinations ks [] Destinations:DestinationAllShards()`,
+	})
+	require.Equal(t, explainVitessFields, result.Fields)
+	want := `[` +
+		`[VARCHAR("Join") VARCHAR("Join") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("")] ` +
+		`[VARCHAR("├─ Route") VARCHAR("SelectEqualUnique") VARCHAR("ks") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select a from t1 where id = :id") VARCHAR("hash") VARCHAR(":id") VARCHAR("-20")] ` +
+		`[VARCHAR("└─ Route") VARCHAR("SelectScatter") VARCHAR("ks") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select b from t2") VARCHAR("") VARCHAR("") VARCHAR("-20,20-")]` +
+		`]`
+	require.Equal(t, want, fmt.Sprintf("%v", result.Rows))
+
+	// A route whose shards cannot be resolved is still described.
+	vc = &loggingVCursor{shards: []string{"-20", "20-"}}
+	result, err = explain.Execute(vc, map[string]*querypb.BindVariable{}, true)
+	require.NoError(t, err)
+	require.Equal(t, `VARCHAR("")`, fmt.Sprintf("%v", result.Rows[1][8]))
+}
+
+func TestPlanValuesToString(t *testing.T) {
+	pvs := []sqltypes.PlanValue{
+		{Key: "a"},
+		{Value: sqltypes.NewInt64(1)},
+		{ListKey: "list"},
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewVarChar("x")}, {Key: "b"}}},
+	}
+	require.Equal(t, ":a, 1, ::list, (x, :b)", planValuesToString(pvs))
+}
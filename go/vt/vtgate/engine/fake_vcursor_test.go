@@ -41,6 +41,7 @@ import (
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -85,6 +86,18 @@ func (t *noopVCursor) GetSessionEnableSystemSettings() bool {
 	panic("implement me")
 }
 
+func (t *noopVCursor) SetMaxReplicationLag(float64) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetCharacterSetClient(string) {
+	panic("implement me")
+}
+
+func (t *noopVCursor) SetCharacterSetResults(string) {
+	panic("implement me")
+}
+
 func (t *noopVCursor) SetReadAfterWriteTimeout(f float64) {
 	panic("implement me")
 }
@@ -237,6 +250,10 @@ func (t *noopVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value,
 	panic("unimplemented")
 }
 
+func (t *noopVCursor) ResolveReadDestinations(keyspace string, tabletType topodatapb.TabletType, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	panic("unimplemented")
+}
+
 func (t *noopVCursor) SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error {
 	panic("unimplemented")
 }
@@ -404,6 +421,11 @@ func (f *loggingVCursor) StreamExecuteMulti(query string, rss []*srvtopo.Resolve
 	return callback(r)
 }
 
+func (f *loggingVCursor) ResolveReadDestinations(keyspace string, tabletType topodatapb.TabletType, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	f.log = append(f.log, fmt.Sprintf("ReadTabletType %s", topoproto.TabletTypeLString(tabletType)))
+	return f.ResolveDestinations(keyspace, ids, destinations)
+}
+
 func (f *loggingVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	f.log = append(f.log, fmt.Sprintf("ResolveDestinations %v %v %v", keyspace, ids, key.DestinationsString(destinations)))
 	if f.shardErr != nil {
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -206,6 +207,81 @@ func TestMemorySortExecuteWeightString(t *testing.T) {
 	utils.MustMatch(t, wantResult, result)
 }
 
+func TestMemorySortExecuteCollation(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"c1|c2",
+		"varchar|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"C|1",
+			"b|2",
+			"Á|3",
+			"d|4",
+		)},
+	}
+
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			WeightStringCol: -1,
+			Col:             0,
+			CollationID:     collations.LookupByName("utf8mb4_general_ci").ID(),
+		}},
+		Input: fp,
+	}
+
+	result, err := ms.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		fields,
+		"Á|3",
+		"b|2",
+		"C|1",
+		"d|4",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
+func TestMemorySortExecuteCollatedWeightString(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"c1|c2|weight_string(c1 collate utf8mb4_general_ci)",
+		"varchar|int64|varbinary",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|1|3",
+			"b|2|2",
+			"c|3|1",
+		)},
+	}
+
+	// The weight strings computed by mysql are compared instead of the
+	// values, which the made up weights below order in reverse.
+	ms := &MemorySort{
+		OrderBy: []OrderbyParams{{
+			WeightStringCol: 2,
+			Col:             0,
+			CollationID:     collations.LookupByName("utf8mb4_general_ci").ID(),
+		}},
+		Input:               fp,
+		TruncateColumnCount: 2,
+	}
+
+	result, err := ms.Execute(nil, nil, false)
+	require.NoError(t, err)
+
+	wantResult := sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("c1|c2", "varchar|int64"),
+		"c|3",
+		"b|2",
+		"a|1",
+	)
+	utils.MustMatch(t, wantResult, result)
+}
+
 func TestMemorySortStreamExecute(t *testing.T) {
 	fields := sqltypes.MakeTestFields(
 		"c1|c2",
@@ -53,6 +53,10 @@ type OrderedAggregate struct {
 	// from the result received. If 0, no truncation happens.
 	TruncateColumnCount int `json:",omitempty"`
 
+	// Having is the predicate of the HAVING clause. It is evaluated on
+	// the aggregated rows, which are returned only if it is true.
+	Having evalengine.Expr `json:",omitempty"`
+
 	// Input is the primitive that will feed into this Primitive.
 	Input Primitive
 }
@@ -64,6 +68,10 @@ type AggregateParams struct {
 	Col    int
 	// Alias is set only for distinct opcodes.
 	Alias string `json:",omitempty"`
+	// CountCol is set only for AggregateAvg. Col is the sum of the
+	// values, and CountCol their count: the average is computed
+	// once the sums and counts of all the shards are aggregated.
+	CountCol int `json:",omitempty"`
 }
 
 func (ap AggregateParams) isDistinct() bool {
@@ -71,6 +79,9 @@ func (ap AggregateParams) isDistinct() bool {
 }
 
 func (ap AggregateParams) String() string {
+	if ap.Opcode == AggregateAvg {
+		return fmt.Sprintf("%s(%d/%d)", ap.Opcode.String(), ap.Col, ap.CountCol)
+	}
 	if ap.Alias != "" {
 		return fmt.Sprintf("%s(%d) AS %s", ap.Opcode.String(), ap.Col, ap.Alias)
 	}
@@ -89,6 +100,7 @@ const (
 	AggregateMax
 	AggregateCountDistinct
 	AggregateSumDistinct
+	AggregateAvg
 )
 
 var (
@@ -109,6 +121,7 @@ var SupportedAggregates = map[string]AggregateOpcode{
 	"sum":   AggregateSum,
 	"min":   AggregateMin,
 	"max":   AggregateMax,
+	"avg":   AggregateAvg,
 	// These functions don't exist in mysql, but are used
 	// to display the plan.
 	"count_distinct": AggregateCountDistinct,
@@ -206,6 +219,9 @@ func (oa *OrderedAggregate) execute(vcursor VCursor, bindVars map[string]*queryp
 	if current != nil {
 		out.Rows = append(out.Rows, current)
 	}
+	if out.Rows, err = oa.finish(result.Fields, out.Rows); err != nil {
+		return nil, err
+	}
 	return out, nil
 }
 
@@ -216,6 +232,14 @@ func (oa *OrderedAggregate) StreamExecute(vcursor VCursor, bindVars map[string]*
 	var fields []*querypb.Field
 
 	cb := func(qr *sqltypes.Result) error {
+		rows, err := oa.finish(fields, qr.Rows)
+		if err != nil {
+			return err
+		}
+		if len(qr.Fields) == 0 && len(rows) == 0 {
+			return nil
+		}
+		qr.Rows = rows
 		return callback(qr.Truncate(oa.TruncateColumnCount))
 	}
 
@@ -369,6 +393,9 @@ func (oa *OrderedAggregate) merge(fields []*querypb.Field, row1, row2 []sqltypes
 			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], countOne, opcodeType[aggr.Opcode])
 		case AggregateSumDistinct:
 			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], opcodeType[aggr.Opcode])
+		case AggregateAvg:
+			result[aggr.Col] = evalengine.NullsafeAdd(row1[aggr.Col], row2[aggr.Col], fields[aggr.Col].Type)
+			result[aggr.CountCol] = evalengine.NullsafeAdd(row1[aggr.CountCol], row2[aggr.CountCol], sqltypes.Int64)
 		default:
 			return nil, sqltypes.NULL, fmt.Errorf("BUG: Unexpected opcode: %v", aggr.Opcode)
 		}
@@ -388,6 +415,59 @@ func (oa *OrderedAggregate) createEmptyRow() ([]sqltypes.Value, error) {
 			return nil, err
 		}
 		out[i] = value
+		if aggr.Opcode == AggregateAvg {
+			for len(out) <= aggr.CountCol {
+				out = append(out, sqltypes.NULL)
+			}
+			out[aggr.CountCol] = countZero
+		}
+	}
+	return out, nil
+}
+
+// finish computes the averages of the aggregated rows, and drops the rows
+// for which the HAVING predicate is not true.
+func (oa *OrderedAggregate) finish(fields []*querypb.Field, rows [][]sqltypes.Value) ([][]sqltypes.Value, error) {
+	hasAvg := false
+	for _, aggr := range oa.Aggregates {
+		if aggr.Opcode == AggregateAvg {
+			hasAvg = true
+			break
+		}
+	}
+	if !hasAvg && oa.Having == nil {
+		return rows, nil
+	}
+	out := rows[:0]
+	for _, row := range rows {
+		if hasAvg {
+			row = sqltypes.CopyRow(row)
+			for _, aggr := range oa.Aggregates {
+				if aggr.Opcode != AggregateAvg {
+					continue
+				}
+				avg, err := evalengine.Divide(row[aggr.Col], row[aggr.CountCol])
+				if err != nil {
+					return nil, err
+				}
+				if len(fields) > aggr.Col {
+					if avg, err = evalengine.Cast(avg, fields[aggr.Col].Type); err != nil {
+						return nil, err
+					}
+				}
+				row[aggr.Col] = avg
+			}
+		}
+		if oa.Having != nil {
+			result, err := oa.Having.Evaluate(evalengine.ExpressionEnv{Row: row})
+			if err != nil {
+				return nil, err
+			}
+			if !result.IsTrue() {
+				continue
+			}
+		}
+		out = append(out, row)
 	}
 	return out, nil
 }
@@ -402,7 +482,8 @@ func createEmptyValueFor(opcode AggregateOpcode) (sqltypes.Value, error) {
 		AggregateSumDistinct,
 		AggregateSum,
 		AggregateMin,
-		AggregateMax:
+		AggregateMax,
+		AggregateAvg:
 		return sqltypes.NULL, nil
 
 	}
@@ -425,6 +506,9 @@ func (oa *OrderedAggregate) description() PrimitiveDescription {
 		"GroupBy":    groupBy,
 		"Distinct":   strconv.FormatBool(oa.HasDistinct),
 	}
+	if oa.Having != nil {
+		other["Having"] = oa.Having.String()
+	}
 	return PrimitiveDescription{
 		OperatorType: "Aggregate",
 		Variant:      "Ordered",
@@ -27,6 +27,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
 
@@ -625,6 +627,97 @@ func TestOrderedAggregateMergeFail(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestOrderedAggregateAvgHaving(t *testing.T) {
+	fields := sqltypes.MakeTestFields(
+		"col|avg(id)|count(*)|count(id)",
+		"varbinary|decimal|int64|int64",
+	)
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			fields,
+			"a|3|1|2",
+			"a|4|1|1",
+			"b|10|3|4",
+			"c|null|1|0",
+			"c|null|2|0",
+		)},
+	}
+
+	// select col, avg(id), count(*) from t group by col having count(*) > 1
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode:   AggregateAvg,
+			Col:      1,
+			CountCol: 3,
+		}, {
+			Opcode: AggregateCount,
+			Col:    2,
+		}},
+		Keys: []int{0},
+		Having: &evalengine.BinaryOp{
+			Expr:  &evalengine.GreaterThanOp{},
+			Left:  evalengine.NewColumn(2),
+			Right: evalengine.NewLiteralInt(1),
+		},
+		TruncateColumnCount: 3,
+		Input:               fp,
+	}
+	wantResult := sqltypes.MakeTestResult(
+		fields[:3],
+		"a|2.3333|2",
+		"b|2.5000|3",
+		"c|null|3",
+	)
+
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+
+	fp.rewind()
+	result, err = wrapStreamExecute(oa, nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, wantResult, result)
+
+	// The rows for which the predicate is NULL are dropped.
+	oa.Having.(*evalengine.BinaryOp).Left = evalengine.NewColumn(1)
+	fp.rewind()
+	result, err = oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+	assert.Equal(t, sqltypes.MakeTestResult(
+		fields[:3],
+		"a|2.3333|2",
+		"b|2.5000|3",
+	), result)
+}
+
+func TestOrderedAggregateHavingNoInput(t *testing.T) {
+	fp := &fakePrimitive{
+		results: []*sqltypes.Result{sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields(
+				"count(*)",
+				"int64",
+			),
+		)},
+	}
+
+	// select count(*) from t having count(*) > 0
+	oa := &OrderedAggregate{
+		Aggregates: []AggregateParams{{
+			Opcode: AggregateCount,
+			Col:    0,
+		}},
+		Having: &evalengine.BinaryOp{
+			Expr:  &evalengine.GreaterThanOp{},
+			Left:  evalengine.NewColumn(0),
+			Right: evalengine.NewLiteralInt(0),
+		},
+		Input: fp,
+	}
+	result, err := oa.Execute(nil, nil, false)
+	require.NoError(t, err)
+	assert.Empty(t, result.Rows)
+}
+
 func TestMerge(t *testing.T) {
 	assert := assert.New(t)
 	oa := &OrderedAggregate{
@@ -653,7 +746,7 @@ func TestMerge(t *testing.T) {
 
 	merged, _, err := oa.merge(fields, r.Rows[0], r.Rows[1], sqltypes.NULL)
 	assert.NoError(err)
-	want := sqltypes.MakeTestResult(fields, "1|5|6|2|bc").Rows[0]
+	want := sqltypes.MakeTestResult(fields, "1|5|6.0|2|bc").Rows[0]
 	assert.Equal(want, merged)
 
 	// swap and retry
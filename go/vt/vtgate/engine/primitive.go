@@ -35,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 )
 
@@ -85,6 +86,12 @@ type (
 		// Will replace all of the Topo functions.
 		ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error)
 
+		// ResolveReadDestinations is like ResolveDestinations, for the reads
+		// of the tables that have a read tablet type in the vschema. The
+		// tablet type of the session is used instead if its target names
+		// one, or if it is in a transaction.
+		ResolveReadDestinations(keyspace string, tabletType topodatapb.TabletType, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error)
+
 		ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) error
 
 		SubmitOnlineDDL(onlineDDl *schema.OnlineDDL) error
@@ -148,6 +155,14 @@ type (
 		SetReadAfterWriteTimeout(float64)
 		SetSessionTrackGTIDs(bool)
 
+		// SetMaxReplicationLag sets the maximum replication lag, in seconds, of the replicas the session reads from
+		SetMaxReplicationLag(float64)
+
+		// SetCharacterSetClient sets the character set of the queries sent by the client
+		SetCharacterSetClient(string)
+		// SetCharacterSetResults sets the character set of the results sent to the client
+		SetCharacterSetResults(string)
+
 		// HasCreatedTempTable will mark the session as having created temp tables
 		HasCreatedTempTable()
 	}
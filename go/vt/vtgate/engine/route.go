@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/key"
@@ -63,6 +64,10 @@ type Route struct {
 	// this is only used in conjunction with TargetDestination
 	TargetTabletType topodatapb.TabletType
 
+	// ReadTabletType, if set, is the tablet type the query reads from
+	// by default, instead of the tablet type of the session.
+	ReadTabletType topodatapb.TabletType
+
 	// Query specifies the query to be executed.
 	Query string
 
@@ -73,8 +78,9 @@ type Route struct {
 	FieldQuery string
 
 	// Vindex specifies the vindex to be used.
-	Vindex vindexes.SingleColumn
+	Vindex vindexes.Vindex
 	// Values specifies the vindex values to use for routing.
+	// For a multi-column vindex, there is one value per column.
 	Values []sqltypes.PlanValue
 
 	// OrderBy specifies the key order for merge sorting. This will be
@@ -130,10 +136,16 @@ type OrderbyParams struct {
 	// It is set to -1 if such a column is not added to the query
 	WeightStringCol int
 	Desc            bool
+	// CollationID is the collation used to compare textual values across
+	// shards, if the ordering specifies one.
+	CollationID collations.ID `json:",omitempty"`
 }
 
 func (obp OrderbyParams) String() string {
 	val := strconv.Itoa(obp.Col)
+	if obp.CollationID != collations.Unknown {
+		val += " COLLATE " + obp.CollationID.String()
+	}
 	if obp.Desc {
 		val += " DESC"
 	} else {
@@ -232,36 +244,60 @@ func (route *Route) Execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 		cancel := vcursor.SetContextTimeout(time.Duration(route.QueryTimeout) * time.Millisecond)
 		defer cancel()
 	}
-	qr, err := route.execute(vcursor, bindVars, wantfields)
+	qr, err := route.execute(route.readVCursor(vcursor), bindVars, wantfields)
 	if err != nil {
 		return nil, err
 	}
 	return qr.Truncate(route.TruncateColumnCount), nil
 }
 
-func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
-	var rss []*srvtopo.ResolvedShard
-	var bvs []map[string]*querypb.BindVariable
-	var err error
+// readVCursor returns the vcursor the route reads through. If the route
+// has a read tablet type, its destinations are resolved for it.
+func (route *Route) readVCursor(vcursor VCursor) VCursor {
+	if route.ReadTabletType == topodatapb.TabletType_UNKNOWN {
+		return vcursor
+	}
+	return &readVCursor{VCursor: vcursor, tabletType: route.ReadTabletType}
+}
+
+// readVCursor is a VCursor that resolves the destinations for the read
+// tablet type of a route.
+type readVCursor struct {
+	VCursor
+	tabletType topodatapb.TabletType
+}
+
+// ResolveDestinations is part of the VCursor interface.
+func (vc *readVCursor) ResolveDestinations(keyspace string, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	return vc.VCursor.ResolveReadDestinations(keyspace, vc.tabletType, ids, destinations)
+}
+
+// findRoute returns the shards the query is sent to, along with the bind
+// variables to send to each of them.
+func (route *Route) findRoute(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
 	switch route.Opcode {
 	case SelectDBA:
-		rss, bvs, err = route.paramsSystemQuery(vcursor, bindVars)
+		return route.paramsSystemQuery(vcursor, bindVars)
 	case SelectUnsharded, SelectNext, SelectReference:
-		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
+		return route.paramsAnyShard(vcursor, bindVars)
 	case SelectScatter:
-		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
+		return route.paramsAllShards(vcursor, bindVars)
 	case SelectEqual, SelectEqualUnique:
-		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
+		return route.paramsSelectEqual(vcursor, bindVars)
 	case SelectIN:
-		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
+		return route.paramsSelectIn(vcursor, bindVars)
 	case SelectMultiEqual:
-		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
+		return route.paramsSelectMultiEqual(vcursor, bindVars)
 	case SelectNone:
-		rss, bvs, err = nil, nil, nil
+		return nil, nil, nil
 	default:
 		// Unreachable.
-		return nil, fmt.Errorf("unsupported query route: %v", route)
+		return nil, nil, fmt.Errorf("unsupported query route: %v", route)
 	}
+}
+
+func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool) (*sqltypes.Result, error) {
+	rss, bvs, err := route.findRoute(vcursor, bindVars)
 	if err != nil {
 		return nil, err
 	}
@@ -301,31 +337,12 @@ func (route *Route) execute(vcursor VCursor, bindVars map[string]*querypb.BindVa
 
 // StreamExecute performs a streaming exec.
 func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.BindVariable, wantfields bool, callback func(*sqltypes.Result) error) error {
-	var rss []*srvtopo.ResolvedShard
-	var bvs []map[string]*querypb.BindVariable
-	var err error
 	if route.QueryTimeout != 0 {
 		cancel := vcursor.SetContextTimeout(time.Duration(route.QueryTimeout) * time.Millisecond)
 		defer cancel()
 	}
-	switch route.Opcode {
-	case SelectDBA:
-		rss, bvs, err = route.paramsSystemQuery(vcursor, bindVars)
-	case SelectUnsharded, SelectNext, SelectReference:
-		rss, bvs, err = route.paramsAnyShard(vcursor, bindVars)
-	case SelectScatter:
-		rss, bvs, err = route.paramsAllShards(vcursor, bindVars)
-	case SelectEqual, SelectEqualUnique:
-		rss, bvs, err = route.paramsSelectEqual(vcursor, bindVars)
-	case SelectIN:
-		rss, bvs, err = route.paramsSelectIn(vcursor, bindVars)
-	case SelectMultiEqual:
-		rss, bvs, err = route.paramsSelectMultiEqual(vcursor, bindVars)
-	case SelectNone:
-		rss, bvs, err = nil, nil, nil
-	default:
-		return fmt.Errorf("query %q cannot be used for streaming", route.Query)
-	}
+	vcursor = route.readVCursor(vcursor)
+	rss, bvs, err := route.findRoute(vcursor, bindVars)
 	if err != nil {
 		return err
 	}
@@ -368,7 +385,7 @@ func (route *Route) StreamExecute(vcursor VCursor, bindVars map[string]*querypb.
 
 // GetFields fetches the field info.
 func (route *Route) GetFields(vcursor VCursor, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
-	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
+	rss, _, err := route.readVCursor(vcursor).ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAnyShard{}})
 	if err != nil {
 		return nil, err
 	}
@@ -384,7 +401,11 @@ func (route *Route) GetFields(vcursor VCursor, bindVars map[string]*querypb.Bind
 }
 
 func (route *Route) paramsAllShards(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{key.DestinationAllShards{}})
+	var destination key.Destination = key.DestinationAllShards{}
+	if route.TargetDestination != nil {
+		destination = route.TargetDestination
+	}
+	rss, _, err := vcursor.ResolveDestinations(route.Keyspace.Name, nil, []key.Destination{destination})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -515,11 +536,21 @@ func (route *Route) paramsAnyShard(vcursor VCursor, bindVars map[string]*querypb
 }
 
 func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
-	key, err := route.Values[0].ResolveValue(bindVars)
-	if err != nil {
-		return nil, nil, err
+	keys := make([]sqltypes.Value, 0, len(route.Values))
+	for _, pv := range route.Values {
+		key, err := pv.ResolveValue(bindVars)
+		if err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, key)
+	}
+	var rss []*srvtopo.ResolvedShard
+	var err error
+	if vindex, ok := route.Vindex.(vindexes.SingleColumn); ok {
+		rss, _, err = resolveShards(vcursor, vindex, route.Keyspace, keys)
+	} else {
+		rss, err = resolveMultiColumnShards(vcursor, route.Vindex, route.Keyspace, [][]sqltypes.Value{keys})
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, []sqltypes.Value{key})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -531,23 +562,76 @@ func (route *Route) paramsSelectEqual(vcursor VCursor, bindVars map[string]*quer
 }
 
 func (route *Route) paramsSelectIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	if _, ok := route.Vindex.(vindexes.MultiColumn); ok {
+		return route.paramsMultiColumnSelectIn(vcursor, bindVars)
+	}
+	vindex, err := route.singleColumnVindex()
+	if err != nil {
+		return nil, nil, err
+	}
 	keys, err := route.Values[0].ResolveList(bindVars)
 	if err != nil {
 		return nil, nil, err
 	}
-	rss, values, err := resolveShards(vcursor, route.Vindex, route.Keyspace, keys)
+	rss, values, err := resolveShards(vcursor, vindex, route.Keyspace, keys)
 	if err != nil {
 		return nil, nil, err
 	}
 	return rss, shardVars(bindVars, values), nil
 }
 
+// paramsMultiColumnSelectIn routes to the shards of all the combinations
+// of the values of the columns of a multi-column vindex. A column has
+// either a single value, or the list of values of an IN constraint.
+// The shards get the original query.
+func (route *Route) paramsMultiColumnSelectIn(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	rows := [][]sqltypes.Value{nil}
+	for _, pv := range route.Values {
+		var values []sqltypes.Value
+		if pv.IsList() {
+			list, err := pv.ResolveList(bindVars)
+			if err != nil {
+				return nil, nil, err
+			}
+			values = list
+		} else {
+			value, err := pv.ResolveValue(bindVars)
+			if err != nil {
+				return nil, nil, err
+			}
+			values = []sqltypes.Value{value}
+		}
+		combined := make([][]sqltypes.Value, 0, len(rows)*len(values))
+		for _, row := range rows {
+			for _, value := range values {
+				next := make([]sqltypes.Value, len(row), len(row)+1)
+				copy(next, row)
+				combined = append(combined, append(next, value))
+			}
+		}
+		rows = combined
+	}
+	rss, err := resolveMultiColumnShards(vcursor, route.Vindex, route.Keyspace, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+	multiBindVars := make([]map[string]*querypb.BindVariable, len(rss))
+	for i := range multiBindVars {
+		multiBindVars[i] = bindVars
+	}
+	return rss, multiBindVars, nil
+}
+
 func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]*querypb.BindVariable) ([]*srvtopo.ResolvedShard, []map[string]*querypb.BindVariable, error) {
+	vindex, err := route.singleColumnVindex()
+	if err != nil {
+		return nil, nil, err
+	}
 	keys, err := route.Values[0].ResolveList(bindVars)
 	if err != nil {
 		return nil, nil, err
 	}
-	rss, _, err := resolveShards(vcursor, route.Vindex, route.Keyspace, keys)
+	rss, _, err := resolveShards(vcursor, vindex, route.Keyspace, keys)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -558,6 +642,16 @@ func (route *Route) paramsSelectMultiEqual(vcursor VCursor, bindVars map[string]
 	return rss, multiBindVars, nil
 }
 
+// singleColumnVindex returns the vindex of the route for the opcodes
+// that only support single column vindexes.
+func (route *Route) singleColumnVindex() (vindexes.SingleColumn, error) {
+	vindex, ok := route.Vindex.(vindexes.SingleColumn)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "vindex '%s' is not a single column vindex: %s", route.Vindex.String(), routeName[route.Opcode])
+	}
+	return vindex, nil
+}
+
 func resolveShards(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKeys []sqltypes.Value) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
 	// Convert vindexKeys to []*querypb.Value
 	ids := make([]*querypb.Value, len(vindexKeys))
@@ -615,8 +709,21 @@ func (route *Route) sort(in *sqltypes.Result) (*sqltypes.Result, error) {
 	return out, err
 }
 
-func resolveSingleShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *vindexes.Keyspace, vindexKey sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+// resolveMultiColumnShards resolves the shards of the rows of values of
+// the columns of a multi-column vindex.
+func resolveMultiColumnShards(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, rowsColValues [][]sqltypes.Value) ([]*srvtopo.ResolvedShard, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, rowsColValues)
+	if err != nil {
+		return nil, err
+	}
+	rss, _, err := vcursor.ResolveDestinations(keyspace.Name, nil, destinations)
+	return rss, err
+}
+
+// resolveSingleShard resolves the shard of the values of the vindex
+// columns, which must map to a single keyspace id.
+func resolveSingleShard(vcursor VCursor, vindex vindexes.Vindex, keyspace *vindexes.Keyspace, vindexKey []sqltypes.Value) (*srvtopo.ResolvedShard, []byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -651,8 +758,8 @@ func resolveMultiShard(vcursor VCursor, vindex vindexes.SingleColumn, keyspace *
 	return rss, nil
 }
 
-func resolveKeyspaceID(vcursor VCursor, vindex vindexes.SingleColumn, vindexKey sqltypes.Value) ([]byte, error) {
-	destinations, err := vindex.Map(vcursor, []sqltypes.Value{vindexKey})
+func resolveKeyspaceID(vcursor VCursor, vindex vindexes.Vindex, vindexKey []sqltypes.Value) ([]byte, error) {
+	destinations, err := vindexes.Map(vindex, vcursor, [][]sqltypes.Value{vindexKey})
 	if err != nil {
 		return nil, err
 	}
@@ -731,6 +838,9 @@ func (route *Route) description() PrimitiveDescription {
 	if route.SysTableTableName != nil {
 		other["SysTableTableName"] = route.SysTableTableName.String()
 	}
+	if route.ReadTabletType != topodatapb.TabletType_UNKNOWN {
+		other["ReadTabletType"] = topoproto.TabletTypeLString(route.ReadTabletType)
+	}
 	orderBy := GenericJoin(route.OrderBy, orderByToString)
 	if orderBy != "" {
 		other["OrderBy"] = orderBy
@@ -31,7 +31,9 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 )
 
@@ -76,6 +78,52 @@ func TestSelectUnsharded(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectReadTabletType(t *testing.T) {
+	sel := NewRoute(
+		SelectUnsharded,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: false,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.ReadTabletType = topodatapb.TabletType_REPLICA
+
+	vc := &loggingVCursor{
+		shards:  []string{"0"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ReadTabletType replica`,
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.0: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	vc.Rewind()
+	result, err = wrapStreamExecute(sel, vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ReadTabletType replica`,
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`StreamExecuteMulti dummy_select ks.0: {} `,
+	})
+	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
+
+	vc.Rewind()
+	vc.results = []*sqltypes.Result{defaultSelectResult}
+	_, err = sel.GetFields(vc, map[string]*querypb.BindVariable{})
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ReadTabletType replica`,
+		`ResolveDestinations ks [] Destinations:DestinationAnyShard()`,
+		`ExecuteMultiShard ks.0: dummy_select_field {} false false`,
+	})
+}
+
 func TestSelectInformationSchemaWithTableAndSchemaWithRoutedTables(t *testing.T) {
 	stringToExpr := func(in string) evalengine.Expr {
 		var schema evalengine.Expr
@@ -201,6 +249,31 @@ func TestSelectScatter(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectScatterTargetDestination(t *testing.T) {
+	sel := NewRoute(
+		SelectScatter,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.TargetDestination = key.DestinationShard("20-")
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationShard(20-)`,
+		`ExecuteMultiShard ks.DestinationShard(20-): dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+}
+
 func TestSelectEqualUnique(t *testing.T) {
 	vindex, _ := vindexes.NewHash("", nil)
 	sel := NewRoute(
@@ -237,6 +310,60 @@ func TestSelectEqualUnique(t *testing.T) {
 	expectResult(t, "sel.StreamExecute", result, defaultSelectResult)
 }
 
+func TestSelectEqualUniqueMultiColumn(t *testing.T) {
+	vindex, _ := vindexes.NewRegionExperimental("region", map[string]string{"region_bytes": "1"})
+	sel := NewRoute(
+		SelectEqualUnique,
+		&vindexes.Keyspace{
+			Name:    "ks",
+			Sharded: true,
+		},
+		"dummy_select",
+		"dummy_select_field",
+	)
+	sel.Vindex = vindex
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(2)}}
+
+	vc := &loggingVCursor{
+		shards:  []string{"-20", "20-"},
+		results: []*sqltypes.Result{defaultSelectResult},
+	}
+	result, err := sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(0106e7ea22ce92708f)`,
+		`ExecuteMultiShard ks.-20: dummy_select {} false false`,
+	})
+	expectResult(t, "sel.Execute", result, defaultSelectResult)
+
+	// Only the region of a partial vindex.
+	sel.Opcode = SelectEqual
+	sel.Values = []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}}
+	vc.Rewind()
+	_, err = sel.Execute(vc, map[string]*querypb.BindVariable{}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyRange(01-02)`,
+		`ExecuteMultiShard ks.-20: dummy_select {} ks.20-: dummy_select {} false false`,
+	})
+
+	// All the combinations of the values of the columns.
+	sel.Opcode = SelectIN
+	sel.Values = []sqltypes.PlanValue{
+		{Values: []sqltypes.PlanValue{{Value: sqltypes.NewInt64(1)}, {Value: sqltypes.NewInt64(0x30)}}},
+		{ListKey: "ids"},
+	}
+	vc.Rewind()
+	_, err = sel.Execute(vc, map[string]*querypb.BindVariable{
+		"ids": sqltypes.TestBindVariable([]interface{}{2, 3}),
+	}, false)
+	require.NoError(t, err)
+	vc.ExpectLog(t, []string{
+		`ResolveDestinations ks [] Destinations:DestinationKeyspaceID(0106e7ea22ce92708f),DestinationKeyspaceID(014eb190c9a2fa169c),DestinationKeyspaceID(3006e7ea22ce92708f),DestinationKeyspaceID(304eb190c9a2fa169c)`,
+		`ExecuteMultiShard ks.-20: dummy_select {ids: type:TUPLE values:<type:INT64 value:"2" > values:<type:INT64 value:"3" > } false false`,
+	})
+}
+
 func TestSelectNone(t *testing.T) {
 	vindex, _ := vindexes.NewHash("", nil)
 	sel := NewRoute(
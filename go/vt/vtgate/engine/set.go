@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 
+	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/vt/sysvars"
 
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
@@ -411,17 +412,18 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 	case sysvars.SessionEnableSystemSettings.Name:
 		err = svss.setBoolSysVar(env, vcursor.Session().SetSessionEnableSystemSettings)
 	case sysvars.Charset.Name, sysvars.Names.Name:
-		str, err := svss.evalAsString(env)
+		charset, err := svss.evalAsCharset(env, "charset/names")
 		if err != nil {
 			return err
 		}
-		switch strings.ToLower(str) {
-		case "", "utf8", "utf8mb4", "latin1", "default":
-			// do nothing
-			break
-		default:
-			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "unexpected value for charset/names: %v", str)
+		vcursor.Session().SetCharacterSetClient(charset)
+		vcursor.Session().SetCharacterSetResults(charset)
+	case sysvars.CharacterSetResults.Name:
+		charset, err := svss.evalAsCharset(env, svss.Name)
+		if err != nil {
+			return err
 		}
+		vcursor.Session().SetCharacterSetResults(charset)
 	case sysvars.ReadAfterWriteGTID.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -434,6 +436,15 @@ func (svss *SysVarSetAware) Execute(vcursor VCursor, env evalengine.ExpressionEn
 			return err
 		}
 		vcursor.Session().SetReadAfterWriteTimeout(val)
+	case sysvars.MaxReplicationLag.Name:
+		val, err := svss.evalAsFloat(env)
+		if err != nil {
+			return err
+		}
+		if val < 0 {
+			return vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "variable 'max_replication_lag' can't be set to the value: %v", val)
+		}
+		vcursor.Session().SetMaxReplicationLag(val)
 	case sysvars.SessionTrackGTIDs.Name:
 		str, err := svss.evalAsString(env)
 		if err != nil {
@@ -498,6 +509,31 @@ func (svss *SysVarSetAware) evalAsString(env evalengine.ExpressionEnv) (string,
 	return v.ToString(), nil
 }
 
+// evalAsCharset evaluates the name of a character set the queries and the
+// results can be converted from and to. It returns "" for the character set
+// of the handshake, and binary for NULL, which disables the conversion.
+func (svss *SysVarSetAware) evalAsCharset(env evalengine.ExpressionEnv, name string) (string, error) {
+	value, err := svss.Expr.Evaluate(env)
+	if err != nil {
+		return "", err
+	}
+	v := value.Value()
+	if v.IsNull() {
+		return "binary", nil
+	}
+	if !v.IsText() && !v.IsBinary() {
+		return "", vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongTypeForVar, "Incorrect argument type to variable '%s': %s", svss.Name, v.Type().String())
+	}
+	charset := strings.ToLower(v.ToString())
+	switch {
+	case charset == "" || charset == "default":
+		return "", nil
+	case !mysql.IsConvertibleCharset(charset):
+		return "", vterrors.NewErrorf(vtrpcpb.Code_INVALID_ARGUMENT, vterrors.WrongValueForVar, "unexpected value for %s: %v", name, v.ToString())
+	}
+	return charset, nil
+}
+
 func (svss *SysVarSetAware) setBoolSysVar(env evalengine.ExpressionEnv, setter func(bool) error) error {
 	value, err := svss.Expr.Evaluate(env)
 	if err != nil {
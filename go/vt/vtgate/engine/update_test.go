@@ -628,6 +628,21 @@ func buildTestVSchema() *vindexes.VSchema {
 						},
 						Owner: "t1",
 					},
+					"region": {
+						Type: "region_experimental",
+						Params: map[string]string{
+							"region_bytes": "1",
+						},
+					},
+					"rgcol": {
+						Type: "lookup",
+						Params: map[string]string{
+							"table": "lkp_rg",
+							"from":  "from",
+							"to":    "toc",
+						},
+						Owner: "rg_tbl",
+					},
 				},
 				Tables: map[string]*vschemapb.Table{
 					"t1": {
@@ -648,6 +663,15 @@ func buildTestVSchema() *vindexes.VSchema {
 							Columns: []string{"id"},
 						}},
 					},
+					"rg_tbl": {
+						ColumnVindexes: []*vschemapb.ColumnVindex{{
+							Name:    "region",
+							Columns: []string{"cola", "colb"},
+						}, {
+							Name:    "rgcol",
+							Columns: []string{"c3"},
+						}},
+					},
 				},
 			},
 		},
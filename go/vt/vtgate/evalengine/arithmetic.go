@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"math"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	"strconv"
@@ -185,6 +186,12 @@ func NullsafeAdd(v1, v2 sqltypes.Value, resultType querypb.Type) sqltypes.Value
 // necessary conversions. If none are numeric, then it's
 // a simple binary comparison. Uncomparable values return an error.
 func NullsafeCompare(v1, v2 sqltypes.Value) (int, error) {
+	return NullsafeCompareWithCollation(v1, v2, collations.Unknown)
+}
+
+// NullsafeCompareWithCollation compares two values like NullsafeCompare, except
+// that two textual values are compared with the given collation, if it is known.
+func NullsafeCompareWithCollation(v1, v2 sqltypes.Value, collation collations.ID) (int, error) {
 	// Based on the categorization defined for the types,
 	// we're going to allow comparison of the following:
 	// Null, isNumber, IsBinary. This will exclude IsQuoted
@@ -209,6 +216,9 @@ func NullsafeCompare(v1, v2 sqltypes.Value) (int, error) {
 		}
 		return compareNumeric(lv1, lv2)
 	}
+	if c := collations.LookupByID(collation); c != nil && isTextual(v1) && isTextual(v2) {
+		return c.Collate(v1.Raw(), v2.Raw()), nil
+	}
 	if isByteComparable(v1) && isByteComparable(v2) {
 		return bytes.Compare(v1.ToBytes(), v2.ToBytes()), nil
 	}
@@ -249,6 +259,10 @@ func isByteComparable(v sqltypes.Value) bool {
 	return false
 }
 
+func isTextual(v sqltypes.Value) bool {
+	return v.IsText() || v.IsBinary()
+}
+
 // Min returns the minimum of v1 and v2. If one of the
 // values is NULL, it returns the other value. If both
 // are NULL, it returns NULL.
@@ -296,6 +310,8 @@ func addNumeric(v1, v2 EvalResult) EvalResult {
 		case sqltypes.Uint64:
 			return uintPlusUint(v1.uval, v2.uval)
 		}
+	case sqltypes.Decimal:
+		return decimalResult(v1.decimal.add(toDecimal(v2)))
 	case sqltypes.Float64:
 		return floatPlusAny(v1.fval, v2)
 	}
@@ -314,6 +330,8 @@ func addNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 		case sqltypes.Uint64:
 			return uintPlusUintWithError(v1.uval, v2.uval)
 		}
+	case sqltypes.Decimal:
+		return decimalResult(v1.decimal.add(toDecimal(v2))), nil
 	case sqltypes.Float64:
 		return floatPlusAny(v1.fval, v2), nil
 	}
@@ -324,6 +342,9 @@ func addNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 func subtractNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
+	if isDecimalArithmetic(v1, v2) {
+		return decimalResult(toDecimal(v1).sub(toDecimal(v2))), nil
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		switch v2.typ {
@@ -343,6 +364,8 @@ func subtractNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 		case sqltypes.Float64:
 			return anyMinusFloat(v1, v2.fval), nil
 		}
+	case sqltypes.Decimal:
+		return anyMinusFloat(v1, v2.fval), nil
 	case sqltypes.Float64:
 		return floatMinusAny(v1.fval, v2), nil
 	}
@@ -361,6 +384,8 @@ func multiplyNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 		case sqltypes.Uint64:
 			return uintTimesUintWithError(v1.uval, v2.uval)
 		}
+	case sqltypes.Decimal:
+		return decimalResult(v1.decimal.mul(toDecimal(v2))), nil
 	case sqltypes.Float64:
 		return floatTimesAny(v1.fval, v2), nil
 	}
@@ -371,6 +396,13 @@ func multiplyNumericWithError(v1, v2 EvalResult) (EvalResult, error) {
 func divideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
+	if isDecimalArithmetic(v1, v2) {
+		result, ok := toDecimal(v1).div(toDecimal(v2))
+		if !ok {
+			return resultNull, nil
+		}
+		return decimalResult(result), nil
+	}
 	switch v1.typ {
 	case sqltypes.Int64:
 		return floatDivideAnyWithError(float64(v1.ival), v2)
@@ -378,6 +410,9 @@ func divideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 	case sqltypes.Uint64:
 		return floatDivideAnyWithError(float64(v1.uval), v2)
 
+	case sqltypes.Decimal:
+		return floatDivideAnyWithError(v1.decimal.float64(), v2)
+
 	case sqltypes.Float64:
 		return floatDivideAnyWithError(v1.fval, v2)
 	}
@@ -385,16 +420,20 @@ func divideNumericWithError(i1, i2 EvalResult) (EvalResult, error) {
 }
 
 // makeNumericAndprioritize reorders the input parameters
-// to be Float64, Uint64, Int64.
+// to be Float64, Decimal, Uint64, Int64.
 func makeNumericAndprioritize(i1, i2 EvalResult) (EvalResult, EvalResult) {
 	v1 := makeNumeric(i1)
 	v2 := makeNumeric(i2)
 	switch v1.typ {
 	case sqltypes.Int64:
-		if v2.typ == sqltypes.Uint64 || v2.typ == sqltypes.Float64 {
+		if v2.typ == sqltypes.Uint64 || v2.typ == sqltypes.Decimal || v2.typ == sqltypes.Float64 {
 			return v2, v1
 		}
 	case sqltypes.Uint64:
+		if v2.typ == sqltypes.Decimal || v2.typ == sqltypes.Float64 {
+			return v2, v1
+		}
+	case sqltypes.Decimal:
 		if v2.typ == sqltypes.Float64 {
 			return v2, v1
 		}
@@ -532,6 +571,8 @@ func floatPlusAny(v1 float64, v2 EvalResult) EvalResult {
 		v2.fval = float64(v2.ival)
 	case sqltypes.Uint64:
 		v2.fval = float64(v2.uval)
+	case sqltypes.Decimal:
+		v2.fval = v2.decimal.float64()
 	}
 	return EvalResult{typ: sqltypes.Float64, fval: v1 + v2.fval}
 }
@@ -542,6 +583,8 @@ func floatMinusAny(v1 float64, v2 EvalResult) EvalResult {
 		v2.fval = float64(v2.ival)
 	case sqltypes.Uint64:
 		v2.fval = float64(v2.uval)
+	case sqltypes.Decimal:
+		v2.fval = v2.decimal.float64()
 	}
 	return EvalResult{typ: sqltypes.Float64, fval: v1 - v2.fval}
 }
@@ -552,6 +595,8 @@ func floatTimesAny(v1 float64, v2 EvalResult) EvalResult {
 		v2.fval = float64(v2.ival)
 	case sqltypes.Uint64:
 		v2.fval = float64(v2.uval)
+	case sqltypes.Decimal:
+		v2.fval = v2.decimal.float64()
 	}
 	return EvalResult{typ: sqltypes.Float64, fval: v1 * v2.fval}
 }
@@ -562,6 +607,8 @@ func floatDivideAnyWithError(v1 float64, v2 EvalResult) (EvalResult, error) {
 		v2.fval = float64(v2.ival)
 	case sqltypes.Uint64:
 		v2.fval = float64(v2.uval)
+	case sqltypes.Decimal:
+		v2.fval = v2.decimal.float64()
 	}
 	result := v1 / v2.fval
 	divisorLessThanOne := v2.fval < 1
@@ -580,6 +627,8 @@ func anyMinusFloat(v1 EvalResult, v2 float64) EvalResult {
 		v1.fval = float64(v1.ival)
 	case sqltypes.Uint64:
 		v1.fval = float64(v1.uval)
+	case sqltypes.Decimal:
+		v1.fval = v1.decimal.float64()
 	}
 	return EvalResult{typ: sqltypes.Float64, fval: v1.fval - v2}
 }
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"bytes"
+
+	"vitess.io/vitess/go/mysql/collations"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// collate compares two strings with the given collation. Strings without
+// a collation, or with an unsupported one, are compared byte-wise.
+func collate(collation collations.ID, a, b []byte) int {
+	if c := collations.LookupByID(collation); c != nil {
+		return c.Collate(a, b)
+	}
+	return bytes.Compare(a, b)
+}
+
+// mergeCollations returns the collation used to compare two strings: an
+// explicit collation wins over no collation, but two different explicit
+// collations cannot be compared.
+func mergeCollations(left, right collations.ID) (collations.ID, error) {
+	switch {
+	case left == right || right == collations.Unknown:
+		return left, nil
+	case left == collations.Unknown:
+		return right, nil
+	}
+	return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Illegal mix of collations (%s) and (%s)", left, right)
+}
+
+// CollateExpr evaluates Expr with the given Collation, like the COLLATE operator.
+type CollateExpr struct {
+	Expr      Expr
+	Collation collations.ID
+}
+
+var _ Expr = (*CollateExpr)(nil)
+
+// Evaluate implements the Expr interface
+func (c *CollateExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	result, err := c.Expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	result.collation = c.Collation
+	return result, nil
+}
+
+// Type implements the Expr interface
+func (c *CollateExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	return c.Expr.Type(env)
+}
+
+// String implements the Expr interface
+func (c *CollateExpr) String() string {
+	return c.Expr.String() + " collate " + c.Collation.String()
+}
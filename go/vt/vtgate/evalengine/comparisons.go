@@ -0,0 +1,334 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type (
+	// Comparison ops
+	EqualOp        struct{}
+	NotEqualOp     struct{}
+	LessThanOp     struct{}
+	LessEqualOp    struct{}
+	GreaterThanOp  struct{}
+	GreaterEqualOp struct{}
+
+	// Logical ops
+	AndOp struct{}
+	OrOp  struct{}
+
+	// InExpr checks whether Left is equal to any of Right.
+	// If Negate is set, it checks the opposite.
+	InExpr struct {
+		Left   Expr
+		Right  []Expr
+		Negate bool
+	}
+
+	// IsNullExpr checks whether Expr is NULL.
+	// If Negate is set, it checks the opposite.
+	IsNullExpr struct {
+		Expr   Expr
+		Negate bool
+	}
+
+	// NotExpr negates the truth value of Expr.
+	NotExpr struct {
+		Expr Expr
+	}
+)
+
+var _ BinaryExpr = (*EqualOp)(nil)
+var _ BinaryExpr = (*NotEqualOp)(nil)
+var _ BinaryExpr = (*LessThanOp)(nil)
+var _ BinaryExpr = (*LessEqualOp)(nil)
+var _ BinaryExpr = (*GreaterThanOp)(nil)
+var _ BinaryExpr = (*GreaterEqualOp)(nil)
+var _ BinaryExpr = (*AndOp)(nil)
+var _ BinaryExpr = (*OrOp)(nil)
+
+var _ Expr = (*InExpr)(nil)
+var _ Expr = (*IsNullExpr)(nil)
+var _ Expr = (*NotExpr)(nil)
+
+var (
+	resultTrue  = EvalResult{typ: sqltypes.Int64, ival: 1}
+	resultFalse = EvalResult{typ: sqltypes.Int64, ival: 0}
+	resultNull  = EvalResult{typ: sqltypes.Null}
+)
+
+func boolResult(b bool) EvalResult {
+	if b {
+		return resultTrue
+	}
+	return resultFalse
+}
+
+// compareResults compares two non-NULL values. If any of them is numeric,
+// both are compared as numbers, otherwise they are compared as strings
+// using their collation.
+func compareResults(l, r EvalResult) (int, error) {
+	if sqltypes.IsNumber(l.typ) || sqltypes.IsNumber(r.typ) {
+		return compareNumeric(toComparableNumeric(l), toComparableNumeric(r))
+	}
+	collation, err := mergeCollations(l.collation, r.collation)
+	if err != nil {
+		return 0, err
+	}
+	return collate(collation, l.bytes, r.bytes), nil
+}
+
+// toComparableNumeric converts v to one of the types handled by compareNumeric.
+func toComparableNumeric(v EvalResult) EvalResult {
+	v = makeNumeric(v)
+	switch {
+	case sqltypes.IsSigned(v.typ):
+		v.typ = sqltypes.Int64
+	case sqltypes.IsUnsigned(v.typ):
+		v.typ = sqltypes.Uint64
+	case sqltypes.IsFloat(v.typ):
+		v.typ = sqltypes.Float64
+	}
+	return v
+}
+
+func compareWith(left, right EvalResult, match func(int) bool) (EvalResult, error) {
+	if left.typ == sqltypes.Null || right.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	cmp, err := compareResults(left, right)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return boolResult(match(cmp)), nil
+}
+
+// truthValue returns the truth value of v in a logical expression: v is
+// true if it is not zero once converted to a number.
+func truthValue(v EvalResult) (value bool, isNull bool) {
+	if v.typ == sqltypes.Null {
+		return false, true
+	}
+	cmp, _ := compareNumeric(toComparableNumeric(v), resultFalse)
+	return cmp != 0, false
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *EqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp == 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *NotEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp != 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *LessThanOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp < 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *LessEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp <= 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *GreaterThanOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp > 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (e *GreaterEqualOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	return compareWith(left, right, func(cmp int) bool { return cmp >= 0 })
+}
+
+// Evaluate implements the BinaryExpr interface
+func (a *AndOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lNull := truthValue(left)
+	r, rNull := truthValue(right)
+	switch {
+	case (!lNull && !l) || (!rNull && !r):
+		return resultFalse, nil
+	case lNull || rNull:
+		return resultNull, nil
+	}
+	return resultTrue, nil
+}
+
+// Evaluate implements the BinaryExpr interface
+func (o *OrOp) Evaluate(left, right EvalResult) (EvalResult, error) {
+	l, lNull := truthValue(left)
+	r, rNull := truthValue(right)
+	switch {
+	case (!lNull && l) || (!rNull && r):
+		return resultTrue, nil
+	case lNull || rNull:
+		return resultNull, nil
+	}
+	return resultFalse, nil
+}
+
+// Type implements the BinaryExpr interface
+func (e *EqualOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// Type implements the BinaryExpr interface
+func (e *NotEqualOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// Type implements the BinaryExpr interface
+func (e *LessThanOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// Type implements the BinaryExpr interface
+func (e *LessEqualOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// Type implements the BinaryExpr interface
+func (e *GreaterThanOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// Type implements the BinaryExpr interface
+func (e *GreaterEqualOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// Type implements the BinaryExpr interface
+func (a *AndOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// Type implements the BinaryExpr interface
+func (o *OrOp) Type(querypb.Type) querypb.Type { return sqltypes.Int64 }
+
+// String implements the BinaryExpr interface
+func (e *EqualOp) String() string { return "=" }
+
+// String implements the BinaryExpr interface
+func (e *NotEqualOp) String() string { return "!=" }
+
+// String implements the BinaryExpr interface
+func (e *LessThanOp) String() string { return "<" }
+
+// String implements the BinaryExpr interface
+func (e *LessEqualOp) String() string { return "<=" }
+
+// String implements the BinaryExpr interface
+func (e *GreaterThanOp) String() string { return ">" }
+
+// String implements the BinaryExpr interface
+func (e *GreaterEqualOp) String() string { return ">=" }
+
+// String implements the BinaryExpr interface
+func (a *AndOp) String() string { return "and" }
+
+// String implements the BinaryExpr interface
+func (o *OrOp) String() string { return "or" }
+
+// IsTrue returns true if the result of a predicate is true: it is not
+// NULL and it is not zero.
+func (e EvalResult) IsTrue() bool {
+	value, isNull := truthValue(e)
+	return value && !isNull
+}
+
+// Evaluate implements the Expr interface
+func (i *InExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := i.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	sawNull := false
+	for _, expr := range i.Right {
+		right, err := expr.Evaluate(env)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if right.typ == sqltypes.Null {
+			sawNull = true
+			continue
+		}
+		cmp, err := compareResults(left, right)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		if cmp == 0 {
+			return boolResult(!i.Negate), nil
+		}
+	}
+	if sawNull {
+		return resultNull, nil
+	}
+	return boolResult(i.Negate), nil
+}
+
+// Evaluate implements the Expr interface
+func (i *IsNullExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	v, err := i.Expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	return boolResult((v.typ == sqltypes.Null) != i.Negate), nil
+}
+
+// Evaluate implements the Expr interface
+func (n *NotExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	v, err := n.Expr.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	value, isNull := truthValue(v)
+	if isNull {
+		return resultNull, nil
+	}
+	return boolResult(!value), nil
+}
+
+// Type implements the Expr interface
+func (i *InExpr) Type(ExpressionEnv) (querypb.Type, error) { return sqltypes.Int64, nil }
+
+// Type implements the Expr interface
+func (i *IsNullExpr) Type(ExpressionEnv) (querypb.Type, error) { return sqltypes.Int64, nil }
+
+// Type implements the Expr interface
+func (n *NotExpr) Type(ExpressionEnv) (querypb.Type, error) { return sqltypes.Int64, nil }
+
+// String implements the Expr interface
+func (i *InExpr) String() string {
+	values := make([]string, len(i.Right))
+	for j, expr := range i.Right {
+		values[j] = expr.String()
+	}
+	op := " in "
+	if i.Negate {
+		op = " not in "
+	}
+	return i.Left.String() + op + "(" + strings.Join(values, ", ") + ")"
+}
+
+// String implements the Expr interface
+func (i *IsNullExpr) String() string {
+	if i.Negate {
+		return i.Expr.String() + " is not null"
+	}
+	return i.Expr.String() + " is null"
+}
+
+// String implements the Expr interface
+func (n *NotExpr) String() string {
+	return "not " + n.Expr.String()
+}
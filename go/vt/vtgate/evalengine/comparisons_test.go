@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/collations"
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func TestComparisons(t *testing.T) {
+	row := []sqltypes.Value{
+		sqltypes.NewInt64(10),
+		sqltypes.MakeTrusted(sqltypes.Decimal, []byte("2.5")),
+		sqltypes.NewVarChar("abc"),
+		sqltypes.NULL,
+		sqltypes.NewUint64(10),
+	}
+	op := func(op BinaryExpr, left, right Expr) Expr {
+		return &BinaryOp{Expr: op, Left: left, Right: right}
+	}
+	testcases := []struct {
+		expr Expr
+		want sqltypes.Value
+	}{{
+		expr: op(&EqualOp{}, NewColumn(0), NewColumn(4)),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&NotEqualOp{}, NewColumn(0), NewLiteralInt(10)),
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: op(&LessThanOp{}, NewColumn(1), NewLiteralInt(3)),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&LessEqualOp{}, NewColumn(0), NewColumn(1)),
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: op(&GreaterThanOp{}, NewColumn(2), NewLiteralString([]byte("abb"))),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&GreaterEqualOp{}, NewColumn(0), NewLiteralString([]byte("10"))),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&EqualOp{}, NewColumn(3), NewColumn(3)),
+		want: sqltypes.NULL,
+	}, {
+		expr: op(&AndOp{}, NewColumn(3), NewLiteralInt(0)),
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: op(&AndOp{}, NewColumn(3), NewLiteralInt(1)),
+		want: sqltypes.NULL,
+	}, {
+		expr: op(&OrOp{}, NewColumn(3), NewLiteralInt(1)),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&OrOp{}, NewColumn(3), NewLiteralInt(0)),
+		want: sqltypes.NULL,
+	}, {
+		expr: op(&AndOp{}, NewColumn(1), NewColumn(0)),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: &InExpr{Left: NewColumn(0), Right: []Expr{NewLiteralInt(1), NewColumn(4)}},
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: &InExpr{Left: NewColumn(2), Right: []Expr{NewLiteralString([]byte("abd")), NewColumn(3)}},
+		want: sqltypes.NULL,
+	}, {
+		expr: &InExpr{Left: NewColumn(2), Right: []Expr{NewLiteralString([]byte("abc"))}, Negate: true},
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: &InExpr{Left: NewColumn(3), Right: []Expr{NewLiteralInt(1)}},
+		want: sqltypes.NULL,
+	}, {
+		expr: &IsNullExpr{Expr: NewColumn(3)},
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: &IsNullExpr{Expr: NewColumn(3), Negate: true},
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: &NotExpr{Expr: op(&EqualOp{}, NewColumn(0), NewLiteralInt(11))},
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: &NotExpr{Expr: NewColumn(3)},
+		want: sqltypes.NULL,
+	}}
+	for _, tc := range testcases {
+		t.Run(fmt.Sprintf("%s %s", tc.expr.String(), tc.want.String()), func(t *testing.T) {
+			result, err := tc.expr.Evaluate(ExpressionEnv{Row: row})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, result.Value())
+			assert.Equal(t, !tc.want.IsNull() && tc.want.ToString() == "1", result.IsTrue())
+		})
+	}
+}
+
+func TestCollatedComparisons(t *testing.T) {
+	collate := func(expr Expr, name string) Expr {
+		return &CollateExpr{Expr: expr, Collation: collations.LookupByName(name).ID()}
+	}
+	op := func(op BinaryExpr, left, right Expr) Expr {
+		return &BinaryOp{Expr: op, Left: left, Right: right}
+	}
+	testcases := []struct {
+		expr Expr
+		want sqltypes.Value
+	}{{
+		expr: op(&EqualOp{}, NewLiteralString([]byte("abc")), NewLiteralString([]byte("ABC"))),
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: op(&EqualOp{}, collate(NewLiteralString([]byte("abc")), "utf8mb4_general_ci"), NewLiteralString([]byte("ABC "))),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&EqualOp{}, NewLiteralString([]byte("abc")), collate(NewLiteralString([]byte("ABC ")), "utf8mb4_bin")),
+		want: sqltypes.NewInt64(0),
+	}, {
+		expr: op(&EqualOp{}, collate(NewLiteralString([]byte("café")), "utf8mb4_general_ci"), NewLiteralString([]byte("CAFE"))),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&LessThanOp{}, collate(NewLiteralString([]byte("a")), "utf8mb4_general_ci"), NewLiteralString([]byte("B"))),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: op(&EqualOp{}, collate(NewLiteralString([]byte("abc")), "utf8mb4_bin"), NewLiteralString([]byte("abc  "))),
+		want: sqltypes.NewInt64(1),
+	}, {
+		expr: &InExpr{Left: collate(NewLiteralString([]byte("x")), "utf8mb4_general_ci"), Right: []Expr{NewLiteralString([]byte("y")), NewLiteralString([]byte("X"))}},
+		want: sqltypes.NewInt64(1),
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.expr.String(), func(t *testing.T) {
+			result, err := tc.expr.Evaluate(ExpressionEnv{})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, result.Value())
+		})
+	}
+
+	mixed := op(&EqualOp{}, collate(NewLiteralString([]byte("a")), "utf8mb4_bin"), collate(NewLiteralString([]byte("a")), "utf8mb4_general_ci"))
+	_, err := mixed.Evaluate(ExpressionEnv{})
+	assert.EqualError(t, err, "Illegal mix of collations (utf8mb4_bin) and (utf8mb4_general_ci)")
+}
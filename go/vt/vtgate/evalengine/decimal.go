@@ -0,0 +1,243 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"math/big"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// divPrecisionIncrement is the number of digits added to the scale of
+	// the dividend in a DECIMAL division, like MySQL's default
+	// div_precision_increment.
+	divPrecisionIncrement = 4
+
+	// maxDecimalScale is the maximum number of digits after the decimal
+	// point that MySQL supports for a DECIMAL.
+	maxDecimalScale = 30
+)
+
+var (
+	bigTen = big.NewInt(10)
+	bigOne = big.NewInt(1)
+)
+
+// decimal is an exact fixed-point number, whose value is unscaled * 10^-scale.
+// It is used to evaluate DECIMAL arithmetic without the rounding errors
+// of float64.
+type decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// parseDecimal parses the textual representation of a DECIMAL, as returned by MySQL.
+func parseDecimal(b []byte) (*decimal, error) {
+	s := strings.TrimSpace(string(b))
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	intPart, fracPart := s, ""
+	if dot := strings.IndexByte(s, '.'); dot >= 0 {
+		intPart, fracPart = s[:dot], s[dot+1:]
+	}
+	digits := intPart + fracPart
+	if digits == "" {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not parse decimal value: '%s'", string(b))
+	}
+	for _, c := range digits {
+		if c < '0' || c > '9' {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not parse decimal value: '%s'", string(b))
+		}
+	}
+	unscaled, _ := new(big.Int).SetString(digits, 10)
+	if neg {
+		unscaled.Neg(unscaled)
+	}
+	return &decimal{unscaled: unscaled, scale: int32(len(fracPart))}, nil
+}
+
+func newDecimalFromInt(i int64) *decimal {
+	return &decimal{unscaled: big.NewInt(i)}
+}
+
+func newDecimalFromUint(u uint64) *decimal {
+	return &decimal{unscaled: new(big.Int).SetUint64(u)}
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(int64(n)), nil)
+}
+
+// rescale returns d with the given scale, which must not be lower than d.scale.
+func (d *decimal) rescale(scale int32) *decimal {
+	if scale == d.scale {
+		return d
+	}
+	unscaled := new(big.Int).Mul(d.unscaled, pow10(scale-d.scale))
+	return &decimal{unscaled: unscaled, scale: scale}
+}
+
+// roundTo rounds d half away from zero so it has at most the given scale.
+func (d *decimal) roundTo(scale int32) *decimal {
+	if scale >= d.scale {
+		return d
+	}
+	return &decimal{unscaled: quoRound(d.unscaled, pow10(d.scale-scale)), scale: scale}
+}
+
+// equalize returns d1 and d2 rescaled to the same scale.
+func equalize(d1, d2 *decimal) (*decimal, *decimal) {
+	if d1.scale < d2.scale {
+		return d1.rescale(d2.scale), d2
+	}
+	return d1, d2.rescale(d1.scale)
+}
+
+func (d *decimal) add(o *decimal) *decimal {
+	d1, d2 := equalize(d, o)
+	return &decimal{unscaled: new(big.Int).Add(d1.unscaled, d2.unscaled), scale: d1.scale}
+}
+
+func (d *decimal) sub(o *decimal) *decimal {
+	d1, d2 := equalize(d, o)
+	return &decimal{unscaled: new(big.Int).Sub(d1.unscaled, d2.unscaled), scale: d1.scale}
+}
+
+func (d *decimal) mul(o *decimal) *decimal {
+	result := &decimal{unscaled: new(big.Int).Mul(d.unscaled, o.unscaled), scale: d.scale + o.scale}
+	return result.roundTo(maxDecimalScale)
+}
+
+// div divides d by o. Like in MySQL, the scale of the result is the scale
+// of the dividend plus divPrecisionIncrement. It returns false if o is zero.
+func (d *decimal) div(o *decimal) (*decimal, bool) {
+	if o.unscaled.Sign() == 0 {
+		return nil, false
+	}
+	scale := d.scale + divPrecisionIncrement
+	if scale > maxDecimalScale {
+		scale = maxDecimalScale
+	}
+	// d/o * 10^scale = d.unscaled * 10^(o.scale+scale-d.scale) / o.unscaled
+	num := new(big.Int).Set(d.unscaled)
+	shift := o.scale + scale - d.scale
+	if shift >= 0 {
+		num.Mul(num, pow10(shift))
+	}
+	den := new(big.Int).Set(o.unscaled)
+	if shift < 0 {
+		den.Mul(den, pow10(-shift))
+	}
+	return &decimal{unscaled: quoRound(num, den), scale: scale}, true
+}
+
+// quoRound returns num/den rounded half away from zero.
+func quoRound(num, den *big.Int) *big.Int {
+	quo, rem := new(big.Int).QuoRem(num, den, new(big.Int))
+	if rem.Sign() == 0 {
+		return quo
+	}
+	rem.Abs(rem).Lsh(rem, 1)
+	if rem.Cmp(new(big.Int).Abs(den)) >= 0 {
+		if num.Sign()*den.Sign() < 0 {
+			quo.Sub(quo, bigOne)
+		} else {
+			quo.Add(quo, bigOne)
+		}
+	}
+	return quo
+}
+
+func (d *decimal) cmp(o *decimal) int {
+	d1, d2 := equalize(d, o)
+	return d1.unscaled.Cmp(d2.unscaled)
+}
+
+func (d *decimal) float64() float64 {
+	f, _ := new(big.Rat).SetFrac(d.unscaled, pow10(d.scale)).Float64()
+	return f
+}
+
+// int64 rounds d to an integer. ok is false if the result overflows int64.
+func (d *decimal) int64() (i int64, ok bool) {
+	v := d.roundTo(0).unscaled
+	if !v.IsInt64() {
+		return 0, false
+	}
+	return v.Int64(), true
+}
+
+// uint64 rounds d to an unsigned integer. ok is false if the result overflows uint64.
+func (d *decimal) uint64() (u uint64, ok bool) {
+	v := d.roundTo(0).unscaled
+	if !v.IsUint64() {
+		return 0, false
+	}
+	return v.Uint64(), true
+}
+
+// String returns the representation of d, with exactly d.scale digits after the decimal point.
+func (d *decimal) String() string {
+	digits := new(big.Int).Abs(d.unscaled).String()
+	if d.scale > 0 {
+		if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+			digits = strings.Repeat("0", pad) + digits
+		}
+		dot := len(digits) - int(d.scale)
+		digits = digits[:dot] + "." + digits[dot:]
+	}
+	if d.unscaled.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+// toDecimal converts an integral or DECIMAL result to a decimal.
+func toDecimal(v EvalResult) *decimal {
+	switch v.typ {
+	case sqltypes.Decimal:
+		return v.decimal
+	case sqltypes.Uint64:
+		return newDecimalFromUint(v.uval)
+	default:
+		return newDecimalFromInt(v.ival)
+	}
+}
+
+func decimalResult(d *decimal) EvalResult {
+	return EvalResult{typ: sqltypes.Decimal, decimal: d}
+}
+
+// isDecimalArithmetic returns true if the arithmetic between v1 and v2 must be
+// done on decimals: at least one of them is a DECIMAL and none of them is a float.
+func isDecimalArithmetic(v1, v2 EvalResult) bool {
+	if v1.typ == sqltypes.Float64 || v2.typ == sqltypes.Float64 {
+		return false
+	}
+	return v1.typ == sqltypes.Decimal || v2.typ == sqltypes.Decimal
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+func newDecimal(s string) sqltypes.Value {
+	return sqltypes.MakeTrusted(sqltypes.Decimal, []byte(s))
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	tcases := []struct {
+		name   string
+		f      func(v1, v2 sqltypes.Value) (sqltypes.Value, error)
+		v1, v2 sqltypes.Value
+		out    sqltypes.Value
+	}{{
+		name: "add",
+		f:    Add,
+		v1:   newDecimal("1.1"),
+		v2:   newDecimal("2.2"),
+		out:  newDecimal("3.3"),
+	}, {
+		name: "add int",
+		f:    Add,
+		v1:   sqltypes.NewInt64(-3),
+		v2:   newDecimal("1.25"),
+		out:  newDecimal("-1.75"),
+	}, {
+		name: "add float",
+		f:    Add,
+		v1:   newDecimal("1.5"),
+		v2:   sqltypes.NewFloat64(1.25),
+		out:  sqltypes.NewFloat64(2.75),
+	}, {
+		name: "subtract",
+		f:    Subtract,
+		v1:   newDecimal("0.3"),
+		v2:   newDecimal("0.1"),
+		out:  newDecimal("0.2"),
+	}, {
+		name: "subtract uint",
+		f:    Subtract,
+		v1:   sqltypes.NewUint64(18446744073709551615),
+		v2:   newDecimal("0.5"),
+		out:  newDecimal("18446744073709551614.5"),
+	}, {
+		name: "multiply",
+		f:    Multiply,
+		v1:   newDecimal("1.10"),
+		v2:   newDecimal("-0.3"),
+		out:  newDecimal("-0.330"),
+	}, {
+		name: "divide",
+		f:    Divide,
+		v1:   newDecimal("1.0"),
+		v2:   newDecimal("3"),
+		out:  newDecimal("0.33333"),
+	}, {
+		name: "divide rounds half away from zero",
+		f:    Divide,
+		v1:   newDecimal("-2"),
+		v2:   sqltypes.NewInt64(3),
+		out:  newDecimal("-0.6667"),
+	}, {
+		name: "divide by zero",
+		f:    Divide,
+		v1:   newDecimal("2"),
+		v2:   newDecimal("0.00"),
+		out:  sqltypes.NULL,
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			got, err := tcase.f(tcase.v1, tcase.v2)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+		})
+	}
+}
+
+func TestDecimalCompare(t *testing.T) {
+	tcases := []struct {
+		v1, v2 sqltypes.Value
+		out    int
+	}{
+		{newDecimal("1.10"), newDecimal("1.1"), 0},
+		{newDecimal("0.1"), sqltypes.NewFloat64(0.1), 0},
+		{newDecimal("-1.5"), sqltypes.NewInt64(-1), -1},
+		{newDecimal("18446744073709551615.1"), sqltypes.NewUint64(18446744073709551615), 1},
+		{newDecimal("99999999999999999999.99"), newDecimal("99999999999999999999.98"), 1},
+	}
+	for _, tcase := range tcases {
+		t.Run(fmt.Sprintf("%v %v", tcase.v1, tcase.v2), func(t *testing.T) {
+			got, err := NullsafeCompare(tcase.v1, tcase.v2)
+			require.NoError(t, err)
+			assert.Equal(t, tcase.out, got)
+		})
+	}
+}
+
+func TestParseDecimal(t *testing.T) {
+	for _, in := range []string{"0", "-0.5", "123.456", "0.000001", "-98765432109876543210.0123456789"} {
+		d, err := parseDecimal([]byte(in))
+		require.NoError(t, err)
+		assert.Equal(t, in, d.String())
+	}
+	d, err := parseDecimal([]byte("+.5"))
+	require.NoError(t, err)
+	assert.Equal(t, "0.5", d.String())
+
+	for _, in := range []string{"", "-", "1.2.3", "1e5", "abc"} {
+		_, err := parseDecimal([]byte(in))
+		assert.Error(t, err, in)
+	}
+}
@@ -104,6 +104,8 @@ func ToFloat64(v sqltypes.Value) (float64, error) {
 		return float64(num.uval), nil
 	case sqltypes.Float64:
 		return num.fval, nil
+	case sqltypes.Decimal:
+		return num.decimal.float64(), nil
 	}
 
 	if sqltypes.IsText(num.typ) || sqltypes.IsBinary(num.typ) {
@@ -157,12 +159,18 @@ func newEvalResult(v sqltypes.Value) (EvalResult, error) {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return EvalResult{uval: uval, typ: sqltypes.Uint64}, nil
-	case v.IsFloat() || v.Type() == sqltypes.Decimal:
+	case v.IsFloat():
 		fval, err := strconv.ParseFloat(string(raw), 64)
 		if err != nil {
 			return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
 		}
 		return EvalResult{fval: fval, typ: sqltypes.Float64}, nil
+	case v.Type() == sqltypes.Decimal:
+		dval, err := parseDecimal(raw)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		return decimalResult(dval), nil
 	default:
 		return EvalResult{typ: v.Type(), bytes: raw}, nil
 	}
@@ -206,6 +214,9 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.uval), 10))
 		case sqltypes.Float64, sqltypes.Float32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, int64(v.fval), 10))
+		case sqltypes.Decimal:
+			ival, _ := v.decimal.int64()
+			return sqltypes.MakeTrusted(resultType, strconv.AppendInt(nil, ival, 10))
 		}
 	case sqltypes.IsUnsigned(resultType):
 		switch v.typ {
@@ -215,6 +226,9 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.ival), 10))
 		case sqltypes.Float64, sqltypes.Float32:
 			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uint64(v.fval), 10))
+		case sqltypes.Decimal:
+			uval, _ := v.decimal.uint64()
+			return sqltypes.MakeTrusted(resultType, strconv.AppendUint(nil, uval, 10))
 		}
 	case sqltypes.IsFloat(resultType) || resultType == sqltypes.Decimal:
 		switch v.typ {
@@ -228,6 +242,11 @@ func (v EvalResult) toSQLValue(resultType querypb.Type) sqltypes.Value {
 				format = 'f'
 			}
 			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, float64(v.fval), format, -1, 64))
+		case sqltypes.Decimal:
+			if resultType == sqltypes.Decimal {
+				return sqltypes.MakeTrusted(resultType, []byte(v.decimal.String()))
+			}
+			return sqltypes.MakeTrusted(resultType, strconv.AppendFloat(nil, v.decimal.float64(), 'g', -1, 64))
 		}
 	default:
 		return sqltypes.MakeTrusted(resultType, v.bytes)
@@ -245,6 +264,8 @@ func hashCode(v EvalResult) int64 {
 		val = float64(v.uval)
 	case sqltypes.Float64:
 		val = v.fval
+	case sqltypes.Decimal:
+		val = v.decimal.float64()
 	}
 
 	// this will not work for ±0, NaN and ±Inf,
@@ -253,6 +274,18 @@ func hashCode(v EvalResult) int64 {
 }
 
 func compareNumeric(v1, v2 EvalResult) (int, error) {
+	// Decimals are compared exactly with integers and other decimals,
+	// and as floats otherwise.
+	if isDecimalArithmetic(v1, v2) {
+		return toDecimal(v1).cmp(toDecimal(v2)), nil
+	}
+	if v1.typ == sqltypes.Decimal {
+		v1 = EvalResult{typ: sqltypes.Float64, fval: v1.decimal.float64()}
+	}
+	if v2.typ == sqltypes.Decimal {
+		v2 = EvalResult{typ: sqltypes.Float64, fval: v2.decimal.float64()}
+	}
+
 	// Equalize the types.
 	switch v1.typ {
 	case sqltypes.Int64:
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strconv"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -34,6 +35,10 @@ type (
 		uval  uint64
 		fval  float64
 		bytes []byte
+		// decimal is set for results of type Decimal
+		decimal *decimal
+		// collation is used to compare string results
+		collation collations.ID
 	}
 	//ExpressionEnv contains the environment that the expression
 	//evaluates in, such as the current row and bindvars
@@ -100,7 +105,7 @@ func NewLiteralFloat(val []byte) (Expr, error) {
 	return &Literal{EvalResult{typ: sqltypes.Float64, fval: fval}}, nil
 }
 
-//NewLiteralFloat returns a literal expression
+//NewLiteralString returns a literal expression
 func NewLiteralString(val []byte) Expr {
 	return &Literal{EvalResult{typ: sqltypes.VarBinary, bytes: val}}
 }
@@ -192,7 +197,10 @@ func (m *Multiplication) Type(left querypb.Type) querypb.Type {
 }
 
 //Type implements the BinaryExpr interface
-func (d *Division) Type(querypb.Type) querypb.Type {
+func (d *Division) Type(left querypb.Type) querypb.Type {
+	if left == sqltypes.Decimal {
+		return sqltypes.Decimal
+	}
 	return sqltypes.Float64
 }
 
@@ -278,10 +286,14 @@ func (c *Column) String() string {
 func mergeNumericalTypes(ltype, rtype querypb.Type) querypb.Type {
 	switch ltype {
 	case sqltypes.Int64:
-		if rtype == sqltypes.Uint64 || rtype == sqltypes.Float64 {
+		if rtype == sqltypes.Uint64 || rtype == sqltypes.Decimal || rtype == sqltypes.Float64 {
 			return rtype
 		}
 	case sqltypes.Uint64:
+		if rtype == sqltypes.Decimal || rtype == sqltypes.Float64 {
+			return rtype
+		}
+	case sqltypes.Decimal:
 		if rtype == sqltypes.Float64 {
 			return rtype
 		}
@@ -315,6 +327,12 @@ func evaluateByType(val *querypb.BindVariable) (EvalResult, error) {
 			fval = 0
 		}
 		return EvalResult{typ: sqltypes.Float64, fval: fval}, nil
+	case sqltypes.Decimal:
+		dval, err := parseDecimal(val.Value)
+		if err != nil {
+			return EvalResult{}, err
+		}
+		return decimalResult(dval), nil
 	case sqltypes.VarChar, sqltypes.Text, sqltypes.VarBinary:
 		return EvalResult{typ: sqltypes.VarBinary, bytes: val.Value}, nil
 	case sqltypes.Null:
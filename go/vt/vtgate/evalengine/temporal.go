@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+type (
+	// DateAddExpr adds Interval, expressed in Unit, to Date.
+	// If Subtract is set, the interval is subtracted instead.
+	// It implements DATE_ADD, DATE_SUB, ADDDATE, SUBDATE, TIMESTAMPADD
+	// and the `+ INTERVAL` and `- INTERVAL` operators.
+	DateAddExpr struct {
+		Date     Expr
+		Interval Expr
+		Unit     string
+		Subtract bool
+	}
+
+	// TimestampDiffExpr returns Right - Left, expressed in Unit.
+	TimestampDiffExpr struct {
+		Unit        string
+		Left, Right Expr
+	}
+)
+
+var _ Expr = (*DateAddExpr)(nil)
+var _ Expr = (*TimestampDiffExpr)(nil)
+
+const (
+	dateFormat     = "2006-01-02"
+	datetimeFormat = "2006-01-02 15:04:05"
+	timeFormat     = "15:04:05"
+)
+
+// intervalUnits maps the supported INTERVAL units to their duration.
+// Units without a fixed duration are mapped to 0.
+var intervalUnits = map[string]time.Duration{
+	"microsecond": time.Microsecond,
+	"second":      time.Second,
+	"minute":      time.Minute,
+	"hour":        time.Hour,
+	"day":         24 * time.Hour,
+	"week":        7 * 24 * time.Hour,
+	"month":       0,
+	"quarter":     0,
+	"year":        0,
+}
+
+// IsIntervalUnitSupported returns true if unit can be used in the temporal
+// expressions of the package.
+func IsIntervalUnitSupported(unit string) bool {
+	_, ok := intervalUnits[strings.ToLower(unit)]
+	return ok
+}
+
+// monthsInUnit returns the number of months in a unit that has no fixed duration.
+func monthsInUnit(unit string) int64 {
+	switch unit {
+	case "quarter":
+		return 3
+	case "year":
+		return 12
+	}
+	return 1
+}
+
+// parseTemporal parses a DATE or DATETIME value. dateOnly is true if the
+// value does not have a time part.
+func parseTemporal(v EvalResult) (t time.Time, dateOnly bool, ok bool) {
+	s := strings.TrimSpace(string(v.bytes))
+	if v.typ == sqltypes.Date || len(s) == len(dateFormat) {
+		t, err := time.ParseInLocation(dateFormat, s, time.UTC)
+		return t, true, err == nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05.999999", s, time.UTC)
+	return t, false, err == nil
+}
+
+func formatDatetime(t time.Time) string {
+	if t.Nanosecond() != 0 {
+		return t.Format("2006-01-02 15:04:05.000000")
+	}
+	return t.Format(datetimeFormat)
+}
+
+// addMonths adds months to t. Like MySQL, if the day does not exist in the
+// resulting month, the last day of that month is used.
+func addMonths(t time.Time, months int64) time.Time {
+	total := int64(t.Year())*12 + int64(t.Month()) - 1 + months
+	year, month := int(total/12), time.Month(total%12+1)
+	day := t.Day()
+	if last := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day(); day > last {
+		day = last
+	}
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+}
+
+// monthsBetween returns the number of complete months between from and to.
+func monthsBetween(from, to time.Time) int64 {
+	sign := int64(1)
+	if to.Before(from) {
+		from, to = to, from
+		sign = -1
+	}
+	months := int64(to.Year()-from.Year())*12 + int64(to.Month()-from.Month())
+	sinceMonthStart := func(t time.Time) time.Duration {
+		return t.Sub(time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC))
+	}
+	if sinceMonthStart(to) < sinceMonthStart(from) {
+		months--
+	}
+	return sign * months
+}
+
+// Evaluate implements the Expr interface
+func (d *DateAddExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	date, err := d.Date.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	interval, err := d.Interval.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if date.typ == sqltypes.Null || interval.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	t, dateOnly, ok := parseTemporal(date)
+	if !ok {
+		return resultNull, nil
+	}
+
+	unit := strings.ToLower(d.Unit)
+	duration, ok := intervalUnits[unit]
+	if !ok {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported interval unit: %s", d.Unit)
+	}
+	amount, err := ToFloat64(makeNumeric(interval).Value())
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if d.Subtract {
+		amount = -amount
+	}
+	if unit != "second" {
+		amount = math.Round(amount)
+	}
+
+	if duration == 0 {
+		t = addMonths(t, int64(amount)*monthsInUnit(unit))
+	} else {
+		t = t.Add(time.Duration(amount * float64(duration)))
+	}
+	if t.Year() < 0 || t.Year() > 9999 {
+		return resultNull, nil
+	}
+	if dateOnly && duration%(24*time.Hour) == 0 {
+		return EvalResult{typ: sqltypes.Date, bytes: []byte(t.Format(dateFormat))}, nil
+	}
+	return EvalResult{typ: sqltypes.Datetime, bytes: []byte(formatDatetime(t))}, nil
+}
+
+// Evaluate implements the Expr interface
+func (d *TimestampDiffExpr) Evaluate(env ExpressionEnv) (EvalResult, error) {
+	left, err := d.Left.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	right, err := d.Right.Evaluate(env)
+	if err != nil {
+		return EvalResult{}, err
+	}
+	if left.typ == sqltypes.Null || right.typ == sqltypes.Null {
+		return resultNull, nil
+	}
+	from, _, ok := parseTemporal(left)
+	if !ok {
+		return resultNull, nil
+	}
+	to, _, ok := parseTemporal(right)
+	if !ok {
+		return resultNull, nil
+	}
+
+	unit := strings.ToLower(d.Unit)
+	duration, ok := intervalUnits[unit]
+	if !ok {
+		return EvalResult{}, vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "unsupported interval unit: %s", d.Unit)
+	}
+	if duration == 0 {
+		return EvalResult{typ: sqltypes.Int64, ival: monthsBetween(from, to) / monthsInUnit(unit)}, nil
+	}
+	return EvalResult{typ: sqltypes.Int64, ival: int64(to.Sub(from) / duration)}, nil
+}
+
+// Type implements the Expr interface
+func (d *DateAddExpr) Type(env ExpressionEnv) (querypb.Type, error) {
+	typ, err := d.Date.Type(env)
+	if err != nil {
+		return 0, err
+	}
+	if duration := intervalUnits[strings.ToLower(d.Unit)]; typ == sqltypes.Date && duration%(24*time.Hour) == 0 {
+		return sqltypes.Date, nil
+	}
+	return sqltypes.Datetime, nil
+}
+
+// Type implements the Expr interface
+func (d *TimestampDiffExpr) Type(ExpressionEnv) (querypb.Type, error) {
+	return sqltypes.Int64, nil
+}
+
+// String implements the Expr interface
+func (d *DateAddExpr) String() string {
+	name := "date_add"
+	if d.Subtract {
+		name = "date_sub"
+	}
+	return fmt.Sprintf("%s(%s, interval %s %s)", name, d.Date.String(), d.Interval.String(), d.Unit)
+}
+
+// String implements the Expr interface
+func (d *TimestampDiffExpr) String() string {
+	return fmt.Sprintf("timestampdiff(%s, %s, %s)", d.Unit, d.Left.String(), d.Right.String())
+}
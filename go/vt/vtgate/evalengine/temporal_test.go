@@ -0,0 +1,42 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package evalengine
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+)
+
+// more tests in go/sqlparser/expressions_test.go
+
+func TestTemporalNulls(t *testing.T) {
+	exprs := []Expr{
+		&DateAddExpr{Date: &Literal{resultNull}, Interval: NewLiteralInt(1), Unit: "day"},
+		&DateAddExpr{Date: NewLiteralString([]byte("not a date")), Interval: NewLiteralInt(1), Unit: "day"},
+		&DateAddExpr{Date: NewLiteralString([]byte("9999-12-31")), Interval: NewLiteralInt(1), Unit: "year"},
+		&TimestampDiffExpr{Unit: "second", Left: NewLiteralString([]byte("2021-01-01")), Right: &Literal{resultNull}},
+	}
+	for _, expr := range exprs {
+		result, err := expr.Evaluate(ExpressionEnv{})
+		require.NoError(t, err)
+		assert.Equal(t, sqltypes.NULL, result.Value(), expr.String())
+	}
+}
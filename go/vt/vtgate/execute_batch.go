@@ -0,0 +1,286 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/trace"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+var errNotBatchable = vterrors.Errorf(vtrpcpb.Code_UNIMPLEMENTED, "statement cannot be batched by shard")
+
+// batchedStatement is a statement of a batch, along with the single
+// shard query its plan sends.
+type batchedStatement struct {
+	plan     *engine.Plan
+	logStats *LogStats
+	rs       *srvtopo.ResolvedShard
+	query    *querypb.BoundQuery
+
+	// result is what the plan returned when the shard query was recorded.
+	// The statements that generate sequence values return them in it.
+	result *sqltypes.Result
+}
+
+// batchTarget identifies the shard the statements of a batch are grouped by.
+type batchTarget struct {
+	keyspace   string
+	shard      string
+	tabletType topodatapb.TabletType
+}
+
+// ExecuteBatchByShard executes a batch of DML statements, sending the
+// statements that target the same shard to it in a single round trip.
+// The statements of a shard execute in their order within the batch, and
+// as part of the session transaction if there is one, which is rolled back
+// if any of them fails. Otherwise, each shard executes its statements
+// atomically in a transaction of its own.
+//
+// Only the statements that are planned to a single query on a single shard
+// can be batched. If one of the statements cannot, nothing is executed and
+// batchable is false: the caller should then execute the statements one
+// by one.
+func (e *Executor) ExecuteBatchByShard(ctx context.Context, method string, safeSession *SafeSession, sqlList []string, bindVarsList []map[string]*querypb.BindVariable) (qrl []sqltypes.QueryResponse, batchable bool) {
+	span, ctx := trace.NewSpan(ctx, "executor.ExecuteBatchByShard")
+	span.Annotate("method", method)
+	defer span.Finish()
+
+	if len(sqlList) == 0 || safeSession.InReservedConn() || safeSession.InLockSession() || len(safeSession.Savepoints) != 0 {
+		return nil, false
+	}
+
+	stmts := make([]*batchedStatement, len(sqlList))
+	for i, sql := range sqlList {
+		bindVars := make(map[string]*querypb.BindVariable)
+		if len(bindVarsList) != 0 {
+			for k, v := range bindVarsList[i] {
+				bindVars[k] = v
+			}
+		}
+		stmt, err := e.recordBatchedStatement(ctx, method, safeSession, sql, bindVars)
+		if err != nil {
+			return nil, false
+		}
+		stmts[i] = stmt
+	}
+
+	// Group the statements by shard, keeping their order.
+	var (
+		rss      []*srvtopo.ResolvedShard
+		queries  [][]*querypb.BoundQuery
+		indexes  [][]int
+		shardIdx = make(map[batchTarget]int)
+	)
+	for i, stmt := range stmts {
+		target := batchTarget{
+			keyspace:   stmt.rs.Target.Keyspace,
+			shard:      stmt.rs.Target.Shard,
+			tabletType: stmt.rs.Target.TabletType,
+		}
+		idx, ok := shardIdx[target]
+		if !ok {
+			idx = len(rss)
+			shardIdx[target] = idx
+			rss = append(rss, stmt.rs)
+			queries = append(queries, nil)
+			indexes = append(indexes, nil)
+		}
+		queries[idx] = append(queries[idx], stmt.query)
+		indexes[idx] = append(indexes[idx], i)
+	}
+
+	// Start an implicit transaction if necessary.
+	if err := e.startTxIfNecessary(ctx, safeSession); err != nil {
+		qrl = make([]sqltypes.QueryResponse, len(sqlList))
+		for i := range qrl {
+			qrl[i].QueryError = err
+		}
+		return qrl, true
+	}
+	safeSession.ClearWarnings()
+
+	execStart := time.Now()
+	results, errs := e.scatterConn.ExecuteBatch(ctx, rss, queries, safeSession)
+
+	// Inside a session transaction, the tablet does not tell which statement
+	// of a failed shard batch failed, and the ones before it stay applied in
+	// the shard transaction. So, like for a partial DML execution, the session
+	// transaction is rolled back and none of the statements of the batch are
+	// reported as successful.
+	var rollbackErr error
+	if safeSession.InTransaction() {
+		for _, err := range errs {
+			if err != nil {
+				_ = e.txConn.Rollback(ctx, safeSession)
+				rollbackErr = vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction rolled back due to partial batch execution: %v", err)
+				break
+			}
+		}
+	}
+
+	qrl = make([]sqltypes.QueryResponse, len(sqlList))
+	for idx := range rss {
+		for j, i := range indexes[idx] {
+			if rollbackErr != nil {
+				qrl[i].QueryError = rollbackErr
+				continue
+			}
+			if errs[idx] != nil {
+				qrl[i].QueryError = errs[idx]
+				continue
+			}
+			qr := &results[idx][j]
+			if stmts[i].result != nil && stmts[i].result.InsertID != 0 {
+				qr.InsertID = stmts[i].result.InsertID
+			}
+			qrl[i].QueryResult = qr
+		}
+	}
+
+	for i, stmt := range stmts {
+		logStats := stmt.logStats
+		logStats.Keyspace = stmt.plan.Instructions.GetKeyspaceName()
+		logStats.Table = stmt.plan.Instructions.GetTableName()
+		logStats.TabletType = stmt.rs.Target.TabletType.String()
+		logStats.ShardQueries = 1
+		errCount := e.logExecutionEnd(logStats, execStart, stmt.plan, qrl[i].QueryError, qrl[i].QueryResult)
+		stmt.plan.AddStats(1, time.Since(logStats.StartTime), logStats.ShardQueries, logStats.RowsAffected, logStats.RowsReturned, errCount)
+		saveSessionStats(safeSession, stmt.plan.Type, qrl[i].QueryResult, qrl[i].QueryError)
+		logStats.Send()
+	}
+	return qrl, true
+}
+
+// recordBatchedStatement plans a statement of a batch, and executes the
+// plan against a batchVCursor to find out the shard query it would send.
+func (e *Executor) recordBatchedStatement(ctx context.Context, method string, safeSession *SafeSession, sql string, bindVars map[string]*querypb.BindVariable) (*batchedStatement, error) {
+	logStats := NewLogStats(ctx, method, sql, bindVars)
+	query, comments := sqlparser.SplitMarginComments(sql)
+	vcursor, err := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := e.getPlan(vcursor, query, comments, bindVars, skipQueryPlanCache(safeSession), logStats)
+	if err != nil {
+		return nil, err
+	}
+	if !isBatchablePlan(plan) {
+		return nil, errNotBatchable
+	}
+	e.logPlanningFinished(logStats, plan)
+	if err := e.addNeededBindVars(plan.BindVarNeeds, bindVars, safeSession); err != nil {
+		return nil, err
+	}
+
+	recorder := &batchVCursor{vcursorImpl: vcursor}
+	qr, err := plan.Instructions.Execute(recorder, bindVars, true)
+	if err != nil {
+		return nil, err
+	}
+	if recorder.query == nil {
+		return nil, errNotBatchable
+	}
+	return &batchedStatement{
+		plan:     plan,
+		logStats: logStats,
+		rs:       recorder.rs,
+		query:    recorder.query,
+		result:   qr,
+	}, nil
+}
+
+// isBatchablePlan returns true for the DML plans that send their statement
+// as is, without first reading or changing the rows of lookup vindexes.
+func isBatchablePlan(plan *engine.Plan) bool {
+	if len(plan.Warnings) != 0 {
+		return false
+	}
+	switch primitive := plan.Instructions.(type) {
+	case *engine.Insert:
+		return true
+	case *engine.Update:
+		return primitive.OwnedVindexQuery == ""
+	case *engine.Delete:
+		return primitive.OwnedVindexQuery == ""
+	}
+	return false
+}
+
+// batchVCursor is the VCursor a batched statement is executed with. It
+// records the query the statement sends to its shard instead of executing
+// it, and refuses everything else that would reach the tablets, except the
+// standalone queries that reserve sequence values.
+type batchVCursor struct {
+	*vcursorImpl
+
+	rs    *srvtopo.ResolvedShard
+	query *querypb.BoundQuery
+}
+
+var _ engine.VCursor = (*batchVCursor)(nil)
+
+// ExecuteMultiShard records the query of a statement that targets a single shard.
+func (vc *batchVCursor) ExecuteMultiShard(rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, rollbackOnError, autocommit bool) (*sqltypes.Result, []error) {
+	if vc.query != nil || len(rss) != 1 || len(queries) != 1 {
+		return nil, []error{errNotBatchable}
+	}
+	vc.rs = rss[0]
+	vc.query = commentedShardQueries(queries, vc.marginComments)[0]
+	return &sqltypes.Result{}, nil
+}
+
+// Execute is part of the engine.VCursor interface.
+func (vc *batchVCursor) Execute(method string, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError bool, co vtgatepb.CommitOrder) (*sqltypes.Result, error) {
+	return nil, errNotBatchable
+}
+
+// StreamExecuteMulti is part of the engine.VCursor interface.
+func (vc *batchVCursor) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
+	return errNotBatchable
+}
+
+// ExecuteKeyspaceID is part of the engine.VCursor interface.
+func (vc *batchVCursor) ExecuteKeyspaceID(keyspace string, ksid []byte, query string, bindVars map[string]*querypb.BindVariable, rollbackOnError, autocommit bool) (*sqltypes.Result, error) {
+	return nil, errNotBatchable
+}
+
+// ExecuteVSchema is part of the engine.VCursor interface.
+func (vc *batchVCursor) ExecuteVSchema(keyspace string, vschemaDDL *sqlparser.AlterVschema) error {
+	return errNotBatchable
+}
+
+// SubmitOnlineDDL is part of the engine.VCursor interface.
+func (vc *batchVCursor) SubmitOnlineDDL(onlineDDL *schema.OnlineDDL) error {
+	return errNotBatchable
+}
+
+// ExecuteLock is part of the engine.VCursor interface.
+func (vc *batchVCursor) ExecuteLock(rs *srvtopo.ResolvedShard, query *querypb.BoundQuery) (*sqltypes.Result, error) {
+	return nil, errNotBatchable
+}
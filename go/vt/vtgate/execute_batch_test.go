@@ -0,0 +1,169 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var batchByShardQueries = []string{
+	"update user_extra set extra = 1 where user_id = 1",
+	"update user_extra set extra = 2 where user_id = 3",
+	"delete from user_extra where user_id = 1",
+}
+
+func sqlOf(queries []*querypb.BoundQuery) []string {
+	var sqls []string
+	for _, query := range queries {
+		sqls = append(sqls, query.Sql)
+	}
+	return sqls
+}
+
+func TestExecuteBatchByShardAutocommit(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	qrl, ok := executor.ExecuteBatchByShard(context.Background(), "TestExecute", session, batchByShardQueries, nil)
+	require.True(t, ok)
+	require.Len(t, qrl, 3)
+	for _, qr := range qrl {
+		require.NoError(t, qr.QueryError)
+		require.NotNil(t, qr.QueryResult)
+	}
+
+	// Each shard gets its statements in order, in a single transactional batch.
+	require.Len(t, sbc1.BatchQueries, 1)
+	assert.Equal(t, []string{
+		"update user_extra set extra = 1 where user_id = 1",
+		"delete from user_extra where user_id = 1",
+	}, sqlOf(sbc1.BatchQueries[0]))
+	require.Len(t, sbc2.BatchQueries, 1)
+	assert.Equal(t, []string{
+		"update user_extra set extra = 2 where user_id = 3",
+	}, sqlOf(sbc2.BatchQueries[0]))
+	assert.EqualValues(t, 1, sbc1.AsTransactionCount.Get())
+	assert.EqualValues(t, 1, sbc2.AsTransactionCount.Get())
+	assert.EqualValues(t, 1, sbc1.ExecCount.Get())
+	assert.Empty(t, sbc1.Queries)
+	assert.False(t, session.InTransaction())
+}
+
+func TestExecuteBatchByShardInTransaction(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", InTransaction: true})
+	qrl, ok := executor.ExecuteBatchByShard(context.Background(), "TestExecute", session, batchByShardQueries, nil)
+	require.True(t, ok)
+	for _, qr := range qrl {
+		require.NoError(t, qr.QueryError)
+	}
+
+	// The batches begin the shard transactions, which stay open.
+	assert.EqualValues(t, 1, sbc1.BeginCount.Get())
+	assert.EqualValues(t, 1, sbc2.BeginCount.Get())
+	assert.EqualValues(t, 0, sbc1.AsTransactionCount.Get())
+	assert.EqualValues(t, 0, sbc1.CommitCount.Get())
+	assert.Len(t, session.ShardSessions, 2)
+
+	// A second batch joins the existing shard transaction.
+	_, ok = executor.ExecuteBatchByShard(context.Background(), "TestExecute", session, batchByShardQueries[:1], nil)
+	require.True(t, ok)
+	assert.EqualValues(t, 1, sbc1.BeginCount.Get())
+	assert.Len(t, sbc1.BatchQueries, 2)
+	assert.Len(t, session.ShardSessions, 2)
+}
+
+func TestExecuteBatchByShardShardError(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	sbc2.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+	qrl, ok := executor.ExecuteBatchByShard(context.Background(), "TestExecute", session, batchByShardQueries, nil)
+	require.True(t, ok)
+	assert.NoError(t, qrl[0].QueryError)
+	assert.Error(t, qrl[1].QueryError)
+	assert.Nil(t, qrl[1].QueryResult)
+	assert.NoError(t, qrl[2].QueryError)
+	assert.Len(t, sbc1.BatchQueries, 1)
+}
+
+func TestExecuteBatchByShardShardErrorInTransaction(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", InTransaction: true})
+	_, ok := executor.ExecuteBatchByShard(context.Background(), "TestExecute", session, batchByShardQueries, nil)
+	require.True(t, ok)
+	require.Len(t, session.ShardSessions, 2)
+
+	// A failed shard batch rolls back the session transaction, so none of
+	// the statements of the batch are reported as successful.
+	sbc2.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	qrl, ok := executor.ExecuteBatchByShard(context.Background(), "TestExecute", session, batchByShardQueries, nil)
+	require.True(t, ok)
+	for _, qr := range qrl {
+		require.Error(t, qr.QueryError)
+		assert.Equal(t, vtrpcpb.Code_ABORTED, vterrors.Code(qr.QueryError))
+		assert.Contains(t, qr.QueryError.Error(), "transaction rolled back due to partial batch execution")
+		assert.Nil(t, qr.QueryResult)
+	}
+	assert.EqualValues(t, 1, sbc1.RollbackCount.Get())
+	assert.EqualValues(t, 1, sbc2.RollbackCount.Get())
+	assert.Empty(t, session.ShardSessions)
+}
+
+func TestExecuteBatchByShardNotBatchable(t *testing.T) {
+	executor, sbc1, sbc2, sbclookup := createLegacyExecutorEnv()
+
+	tcases := []struct {
+		name string
+		sql  string
+	}{{
+		name: "select",
+		sql:  "select id from user where id = 1",
+	}, {
+		name: "owned lookup vindex",
+		sql:  "insert into user(id, name) values (1, 'myname')",
+	}, {
+		name: "multiple shards",
+		sql:  "update user_extra set extra = 1",
+	}, {
+		name: "syntax error",
+		sql:  "update user_extra set",
+	}}
+	for _, tcase := range tcases {
+		t.Run(tcase.name, func(t *testing.T) {
+			session := NewSafeSession(&vtgatepb.Session{TargetString: "@master", Autocommit: true})
+			sqls := append([]string{batchByShardQueries[0]}, tcase.sql)
+			_, ok := executor.ExecuteBatchByShard(context.Background(), "TestExecute", session, sqls, nil)
+			assert.False(t, ok)
+			assert.Empty(t, sbc1.BatchQueries)
+			assert.Empty(t, sbc2.BatchQueries)
+			assert.Empty(t, sbc1.Queries)
+			assert.Empty(t, sbclookup.Queries)
+		})
+	}
+}
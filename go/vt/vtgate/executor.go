@@ -18,6 +18,8 @@ package vtgate
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -52,6 +54,7 @@ import (
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
+	"vitess.io/vitess/go/vt/vtgate/targetacl"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
@@ -74,6 +77,9 @@ var (
 
 	queriesProcessedByTable = stats.NewCountersWithMultiLabels("QueriesProcessedByTable", "Queries processed at vtgate by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
 	queriesRoutedByTable    = stats.NewCountersWithMultiLabels("QueriesRoutedByTable", "Queries routed from vtgate to vttablet by plan type, keyspace and table", []string{"Plan", "Keyspace", "Table"})
+
+	preparedCacheHits   = stats.NewCounter("PreparedStatementCacheHits", "Prepared statements found in the prepared statement cache")
+	preparedCacheMisses = stats.NewCounter("PreparedStatementCacheMisses", "Prepared statements not found in the prepared statement cache")
 )
 
 const (
@@ -102,6 +108,9 @@ type Executor struct {
 	streamSize   int
 	plans        cache.Cache
 	vschemaStats *VSchemaStats
+	// prepared caches the plans of the prepared statements of all the
+	// sessions. See getPreparedPlan.
+	prepared cache.Cache
 
 	normalize       bool
 	warnShardedOnly bool
@@ -124,12 +133,14 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		scatterConn:     resolver.scatterConn,
 		txConn:          resolver.scatterConn.txConn,
 		plans:           cache.NewDefaultCacheImpl(cacheCfg),
+		prepared:        cache.NewDefaultCacheImpl(&cache.Config{MaxEntries: *preparedCacheSize}),
 		normalize:       normalize,
 		warnShardedOnly: warnOnShardedOnly,
 		streamSize:      streamSize,
 	}
 
 	vschemaacl.Init()
+	targetacl.Init()
 	e.vm = &VSchemaManager{e: e}
 	e.vm.watchSrvVSchema(ctx, cell)
 
@@ -140,6 +151,13 @@ func NewExecutor(ctx context.Context, serv srvtopo.Server, cell string, resolver
 		stats.NewGaugeFunc("QueryPlanCacheSize", "Query plan cache size", e.plans.UsedCapacity)
 		stats.NewGaugeFunc("QueryPlanCacheCapacity", "Query plan cache capacity", e.plans.MaxCapacity)
 		stats.NewCounterFunc("QueryPlanCacheEvictions", "Query plan cache evictions", e.plans.Evictions)
+		stats.NewCounterFunc("QueryPlanCacheHits", "Query plan cache hits", e.plans.Hits)
+		stats.NewCounterFunc("QueryPlanCacheMisses", "Query plan cache misses", e.plans.Misses)
+		stats.NewGaugeFunc("PreparedStatementCacheLength", "Prepared statement cache length", func() int64 {
+			return int64(e.prepared.Len())
+		})
+		stats.NewGaugeFunc("PreparedStatementCacheCapacity", "Prepared statement cache capacity", e.prepared.MaxCapacity)
+		stats.NewCounterFunc("PreparedStatementCacheEvictions", "Prepared statement cache evictions", e.prepared.Evictions)
 		http.Handle(pathQueryPlans, e)
 		http.Handle(pathScatterStats, e)
 		http.Handle(pathVSchema, e)
@@ -317,6 +335,8 @@ func (e *Executor) addNeededBindVars(bindVarNeeds *sqlparser.BindVarNeeds, bindV
 				v = raw.ReadAfterWriteTimeout
 			})
 			bindVars[key] = sqltypes.Float64BindVariable(v)
+		case sysvars.MaxReplicationLag.Name:
+			bindVars[key] = sqltypes.Float64BindVariable(session.MaxReplicationLag)
 		case sysvars.SessionTrackGTIDs.Name:
 			v := "off"
 			ifReadAfterWriteExist(session, func(raw *vtgatepb.ReadAfterWrite) {
@@ -844,6 +864,25 @@ func (e *Executor) handleShow(ctx context.Context, safeSession *SafeSession, sql
 			Fields: buildVarCharFields("Keyspace", "Name", "Type", "Params", "Owner"),
 			Rows:   rows,
 		}, nil
+	case sqlparser.KeywordString(sqlparser.PROCESSLIST):
+		// The processlist of vtgate is made of its own MySQL connections,
+		// rather than of the connections of a random tablet.
+		// Like in MySQL, users only see their own connections unless
+		// they are allowed to see every connection.
+		user := callerid.EffectiveCallerIDFromContext(ctx).GetPrincipal()
+		return &sqltypes.Result{
+			Fields: []*querypb.Field{
+				{Name: "Id", Type: sqltypes.Uint64},
+				{Name: "User", Type: sqltypes.VarChar},
+				{Name: "Host", Type: sqltypes.VarChar},
+				{Name: "db", Type: sqltypes.VarChar},
+				{Name: "Command", Type: sqltypes.VarChar},
+				{Name: "Time", Type: sqltypes.Int64},
+				{Name: "State", Type: sqltypes.VarChar},
+				{Name: "Info", Type: sqltypes.VarChar},
+			},
+			Rows: mysqlProcesslist(time.Now(), user, show.Full),
+		}, nil
 	case sqlparser.KeywordString(sqlparser.WARNINGS):
 		fields := []*querypb.Field{
 			{Name: "Level", Type: sqltypes.VarChar},
@@ -1206,6 +1245,7 @@ func (e *Executor) SaveVSchema(vschema *vindexes.VSchema, stats *VSchemaStats) {
 	e.vschema = vschema
 	e.vschemaStats = stats
 	e.plans.Clear()
+	e.prepared.Clear()
 
 	if vschemaCounters != nil {
 		vschemaCounters.Add("Reload", 1)
@@ -1228,24 +1268,31 @@ func (e *Executor) ParseDestinationTarget(targetString string) (string, topodata
 // getPlan computes the plan for the given query. If one is in
 // the cache, it reuses it.
 func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable, skipQueryPlanCache bool, logStats *LogStats) (*engine.Plan, error) {
+	plan, _, err := e.getPlanAndCacheable(vcursor, sql, comments, bindVars, skipQueryPlanCache, logStats)
+	return plan, err
+}
+
+// getPlanAndCacheable is the same as getPlan, but also returns
+// whether the plan can be cached.
+func (e *Executor) getPlanAndCacheable(vcursor *vcursorImpl, sql string, comments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable, skipQueryPlanCache bool, logStats *LogStats) (*engine.Plan, bool, error) {
 	if logStats != nil {
 		logStats.SQL = comments.Leading + sql + comments.Trailing
 		logStats.BindVariables = bindVars
 	}
 
 	if e.VSchema() == nil {
-		return nil, errors.New("vschema not initialized")
+		return nil, false, errors.New("vschema not initialized")
 	}
 
 	stmt, reservedVars, err := sqlparser.Parse2(sql)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	query := sql
 	statement := stmt
 	bindVarNeeds := &sqlparser.BindVarNeeds{}
 	if !sqlparser.IgnoreMaxPayloadSizeDirective(statement) && !isValidPayloadSize(query) {
-		return nil, vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "query payload size above threshold")
+		return nil, false, vterrors.NewErrorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.NetPacketTooLarge, "query payload size above threshold")
 	}
 	ignoreMaxMemoryRows := sqlparser.IgnoreMaxMaxMemoryRowsDirective(stmt)
 	vcursor.SetIgnoreMaxMemoryRows(ignoreMaxMemoryRows)
@@ -1255,7 +1302,7 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		parameterize := e.normalize // the public flag is called normalize
 		result, err := sqlparser.PrepareAST(stmt, reservedVars, bindVars, "vtg", parameterize, vcursor.keyspace)
 		if err != nil {
-			return nil, err
+			return nil, false, err
 		}
 		statement = result.AST
 		bindVarNeeds = result.BindVarNeeds
@@ -1267,22 +1314,84 @@ func (e *Executor) getPlan(vcursor *vcursorImpl, sql string, comments sqlparser.
 		logStats.BindVariables = bindVars
 	}
 
+	// The concurrent executions of a query which is not cached yet wait for
+	// the first one to build the plan, instead of all building it.
 	planKey := vcursor.planPrefixKey() + ":" + query
-	if plan, ok := e.plans.Get(planKey); ok {
-		return plan.(*engine.Plan), nil
-	}
+	cacheable := true
+	plan, err := e.plans.GetOrCompute(vcursor.ctx, planKey, func() (interface{}, bool, error) {
+		plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds)
+		if err != nil {
+			return nil, false, err
+		}
+
+		plan.Warnings = vcursor.warnings
+		vcursor.warnings = nil
 
-	plan, err := planbuilder.BuildFromStmt(query, statement, reservedVars, vcursor, bindVarNeeds)
+		cacheable = !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) && sqlparser.CachePlan(statement) && !vcursor.explicitDestination
+		return plan, cacheable, nil
+	})
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
+	return plan.(*engine.Plan), cacheable, nil
+}
+
+// preparedPlan is an entry of the prepared statement cache.
+type preparedPlan struct {
+	plan *engine.Plan
+	// bindVars are the bind variables extracted from the literals of the
+	// statement by the normalization.
+	bindVars            map[string]*querypb.BindVariable
+	ignoreMaxMemoryRows bool
+}
 
-	plan.Warnings = vcursor.warnings
-	vcursor.warnings = nil
+// getPreparedPlan is the same as getPlan, but for prepared statements.
+// The plans of the prepared statements are cached by the digest of their
+// text and by keyspace, so a statement prepared again, by any session,
+// is neither parsed nor planned.
+func (e *Executor) getPreparedPlan(vcursor *vcursorImpl, sql string, comments sqlparser.MarginComments, bindVars map[string]*querypb.BindVariable, skipQueryPlanCache bool, logStats *LogStats) (*engine.Plan, error) {
+	if skipQueryPlanCache {
+		return e.getPlan(vcursor, sql, comments, bindVars, skipQueryPlanCache, logStats)
+	}
+
+	digest := sha256.Sum256([]byte(sql))
+	preparedKey := vcursor.planPrefixKey() + ":" + hex.EncodeToString(digest[:])
+	if cached, ok := e.prepared.Get(preparedKey); ok {
+		preparedCacheHits.Add(1)
+		prepared := cached.(*preparedPlan)
+		for k, v := range prepared.bindVars {
+			bindVars[k] = v
+		}
+		vcursor.SetIgnoreMaxMemoryRows(prepared.ignoreMaxMemoryRows)
+		if logStats != nil {
+			logStats.SQL = comments.Leading + prepared.plan.Original + comments.Trailing
+			logStats.BindVariables = bindVars
+		}
+		return prepared.plan, nil
+	}
+	preparedCacheMisses.Add(1)
 
-	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) && sqlparser.CachePlan(statement) {
-		e.plans.Set(planKey, plan)
+	// Remember the bind variables of the caller to find the ones added by
+	// the normalization.
+	callerBindVars := make(map[string]bool, len(bindVars))
+	for k := range bindVars {
+		callerBindVars[k] = true
+	}
+	plan, cacheable, err := e.getPlanAndCacheable(vcursor, sql, comments, bindVars, skipQueryPlanCache, logStats)
+	if err != nil || !cacheable {
+		return plan, err
+	}
+	prepared := &preparedPlan{
+		plan:                plan,
+		bindVars:            make(map[string]*querypb.BindVariable),
+		ignoreMaxMemoryRows: vcursor.ignoreMaxMemoryRows,
 	}
+	for k, v := range bindVars {
+		if !callerBindVars[k] {
+			prepared.bindVars[k] = v
+		}
+	}
+	e.prepared.Set(preparedKey, prepared)
 	return plan, nil
 }
 
@@ -1565,7 +1674,7 @@ func (e *Executor) handlePrepare(ctx context.Context, safeSession *SafeSession,
 	// V3 mode.
 	query, comments := sqlparser.SplitMarginComments(sql)
 	vcursor, _ := newVCursorImpl(ctx, safeSession, comments, e, logStats, e.vm, e.VSchema(), e.resolver.resolver, e.serv, e.warnShardedOnly)
-	plan, err := e.getPlan(
+	plan, err := e.getPreparedPlan(
 		vcursor,
 		query,
 		comments,
@@ -1608,8 +1717,8 @@ func (e *Executor) ExecuteMultiShard(ctx context.Context, rss []*srvtopo.Resolve
 }
 
 // StreamExecuteMulti implements the IExecutor interface
-func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error {
-	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, options, callback)
+func (e *Executor) StreamExecuteMulti(ctx context.Context, query string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, callback func(reply *sqltypes.Result) error) error {
+	return e.scatterConn.StreamExecuteMulti(ctx, query, rss, vars, session, callback)
 }
 
 //ExecuteLock implments the IExecutor interface
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/cache"
 	"vitess.io/vitess/go/test/utils"
@@ -316,6 +317,8 @@ func TestSelectSystemVariables(t *testing.T) {
 		ReadAfterWriteTimeout: 13,
 		SessionTrackGtids:     true,
 	}
+	masterSession.MaxReplicationLag = 2.5
+	defer func() { masterSession.MaxReplicationLag = 0 }()
 	executor, _, _, _ := createLegacyExecutorEnv()
 	executor.normalize = true
 	logChan := QueryLogger.Subscribe("Test")
@@ -323,7 +326,7 @@ func TestSelectSystemVariables(t *testing.T) {
 
 	sql := "select @@autocommit, @@client_found_rows, @@skip_query_plan_cache, @@enable_system_settings, " +
 		"@@sql_select_limit, @@transaction_mode, @@workload, @@read_after_write_gtid, " +
-		"@@read_after_write_timeout, @@session_track_gtids, @@ddl_strategy, @@socket"
+		"@@read_after_write_timeout, @@session_track_gtids, @@ddl_strategy, @@socket, @@max_replication_lag"
 
 	result, err := executorExec(executor, sql, map[string]*querypb.BindVariable{})
 	wantResult := &sqltypes.Result{
@@ -340,6 +343,7 @@ func TestSelectSystemVariables(t *testing.T) {
 			{Name: "@@session_track_gtids", Type: sqltypes.VarBinary},
 			{Name: "@@ddl_strategy", Type: sqltypes.VarBinary},
 			{Name: "@@socket", Type: sqltypes.VarBinary},
+			{Name: "@@max_replication_lag", Type: sqltypes.Float64},
 		},
 		Rows: [][]sqltypes.Value{{
 			// the following are the uninitialised session values
@@ -356,6 +360,7 @@ func TestSelectSystemVariables(t *testing.T) {
 			sqltypes.NewVarBinary("own_gtid"),
 			sqltypes.NewVarBinary(""),
 			sqltypes.NewVarBinary(""),
+			sqltypes.NewFloat64(2.5),
 		}},
 	}
 	require.NoError(t, err)
@@ -1110,6 +1115,41 @@ func TestSelectScatterPartial(t *testing.T) {
 	testQueryLog(t, logChan, "TestExecute", "SELECT", "select /*vt+ SCATTER_ERRORS_AS_WARNINGS=1 */ id from user", 8)
 }
 
+func TestSelectScatterLimited(t *testing.T) {
+	// Special setup: Don't use createLegacyExecutorEnv.
+	cell := "aa"
+	hc := discovery.NewFakeLegacyHealthCheck()
+	s := createSandbox("TestExecutor")
+	s.VSchema = executorVSchema
+	getSandbox(KsTestUnsharded).VSchema = unshardedVSchema
+	serv := new(sandboxTopo)
+	resolver := newTestLegacyResolver(hc, serv, cell)
+	shards := []string{"-20", "20-40", "40-60", "60-80", "80-a0", "a0-c0", "c0-e0", "e0-"}
+	for _, shard := range shards {
+		_ = hc.AddTestTablet(cell, shard, 1, "TestExecutor", shard, topodatapb.TabletType_MASTER, true, 1, nil)
+	}
+	executor := NewExecutor(context.Background(), serv, cell, resolver, false, false, testBufferSize, cache.DefaultConfig)
+
+	// Another session uses the only slot.
+	limiter := newScatterLimiter("", 1, 0, 10*time.Millisecond)
+	resolver.scatterConn.limiter = limiter
+	release, err := limiter.acquire(context.Background(), "other")
+	require.NoError(t, err)
+	defer release()
+
+	_, err = executorExec(executor, "select id from user", nil)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "scatter query waited 10ms for its turn to execute on a shard")
+
+	// With the directive, the shards which did not get their turn are warnings.
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	result, err := executor.Execute(context.Background(), "TestExecute", session, "select /*vt+ SCATTER_ERRORS_AS_WARNINGS */ id from user", nil)
+	require.NoError(t, err)
+	assert.Empty(t, result.Rows)
+	assert.Len(t, session.Warnings, len(shards))
+}
+
 func TestStreamSelectScatter(t *testing.T) {
 	// Special setup: Don't use createLegacyExecutorEnv.
 	cell := "aa"
@@ -2209,6 +2249,37 @@ func TestSelectDatabasePrepare(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestSelectPrepareCache(t *testing.T) {
+	executor, sbc1, _, _ := createLegacyExecutorEnv()
+	executor.normalize = true
+	hits, misses := preparedCacheHits.Get(), preparedCacheMisses.Get()
+
+	sql := "select id from user where id = 1 and name = :name"
+	wantQueries := []*querypb.BoundQuery{{
+		Sql: "select id from `user` where 1 != 1",
+		BindVariables: map[string]*querypb.BindVariable{
+			"name": sqltypes.StringBindVariable("foo"),
+			"vtg1": sqltypes.Int64BindVariable(1),
+		},
+	}}
+	for i := 0; i < 2; i++ {
+		sbc1.Queries = nil
+		_, err := executorPrepare(executor, sql, map[string]*querypb.BindVariable{
+			"name": sqltypes.StringBindVariable("foo"),
+		})
+		require.NoError(t, err)
+		// The literals extracted by the normalization are bound on a cache hit as well.
+		utils.MustMatch(t, wantQueries, sbc1.Queries)
+	}
+	assert.EqualValues(t, 1, executor.prepared.Len())
+	assert.EqualValues(t, 1, preparedCacheHits.Get()-hits)
+	assert.EqualValues(t, 1, preparedCacheMisses.Get()-misses)
+
+	// The cache is cleared when the vschema changes.
+	executor.SaveVSchema(executor.VSchema(), nil)
+	assert.EqualValues(t, 0, executor.prepared.Len())
+}
+
 func TestSelectWithUnionAll(t *testing.T) {
 	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
 	executor.normalize = true
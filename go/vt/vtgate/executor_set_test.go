@@ -153,6 +153,12 @@ func TestExecutorSet(t *testing.T) {
 	}, {
 		in:  "set workload = 1",
 		err: "Incorrect argument type to variable 'workload': INT64",
+	}, {
+		in:  "set max_replication_lag = 2.5",
+		out: &vtgatepb.Session{Autocommit: true, MaxReplicationLag: 2.5},
+	}, {
+		in:  "set max_replication_lag = -1",
+		err: "variable 'max_replication_lag' can't be set to the value: -1",
 	}, {
 		in:  "set transaction_mode = 'twopc', autocommit=1",
 		out: &vtgatepb.Session{Autocommit: true, TransactionMode: vtgatepb.TransactionMode_TWOPC},
@@ -179,19 +185,34 @@ func TestExecutorSet(t *testing.T) {
 		err: "Unknown system variable 'session foo = 1'",
 	}, {
 		in:  "set names utf8",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, CharacterSetClient: "utf8", CharacterSetResults: "utf8"},
 	}, {
-		in:  "set names ascii",
-		err: "unexpected value for charset/names: ascii",
+		in:  "set names cp1251",
+		out: &vtgatepb.Session{Autocommit: true, CharacterSetClient: "cp1251", CharacterSetResults: "cp1251"},
 	}, {
-		in:  "set charset utf8",
+		in:  "set names 'default'",
 		out: &vtgatepb.Session{Autocommit: true},
+	}, {
+		in:  "set names gbk",
+		err: "unexpected value for charset/names: gbk",
+	}, {
+		in:  "set charset latin1",
+		out: &vtgatepb.Session{Autocommit: true, CharacterSetClient: "latin1", CharacterSetResults: "latin1"},
 	}, {
 		in:  "set character set default",
-		out: &vtgatepb.Session{Autocommit: true},
+		out: &vtgatepb.Session{Autocommit: true, CharacterSetClient: "utf8", CharacterSetResults: "utf8"},
+	}, {
+		in:  "set character set gbk",
+		err: "unexpected value for charset/names: gbk",
+	}, {
+		in:  "set character_set_results = latin1",
+		out: &vtgatepb.Session{Autocommit: true, CharacterSetResults: "latin1"},
 	}, {
-		in:  "set character set ascii",
-		err: "unexpected value for charset/names: ascii",
+		in:  "set character_set_results = 'binary'",
+		out: &vtgatepb.Session{Autocommit: true, CharacterSetResults: "binary"},
+	}, {
+		in:  "set character_set_results = 'abcd'",
+		err: "unexpected value for character_set_results: abcd",
 	}, {
 		in:  "set skip_query_plan_cache = 1",
 		out: &vtgatepb.Session{Autocommit: true, Options: &querypb.ExecuteOptions{SkipQueryPlanCache: true}},
@@ -322,24 +343,6 @@ func TestExecutorSetOp(t *testing.T) {
 	}, {
 		in:     "set character_set_client = utf8",
 		result: returnResult("character_set_client", "varchar", "utf8"),
-	}, {
-		in:     "set character_set_results=null",
-		result: returnNoResult("character_set_results", "varchar"),
-	}, {
-		in:     "set character_set_results='binary'",
-		result: returnNoResult("character_set_results", "varchar"),
-	}, {
-		in:     "set character_set_results='utf8'",
-		result: returnNoResult("character_set_results", "varchar"),
-	}, {
-		in:     "set character_set_results=utf8mb4",
-		result: returnNoResult("character_set_results", "varchar"),
-	}, {
-		in:     "set character_set_results='latin1'",
-		result: returnNoResult("character_set_results", "varchar"),
-	}, {
-		in:     "set character_set_results='abcd'",
-		result: returnNoResult("character_set_results", "varchar"),
 	}, {
 		in:     "set @@global.client_found_rows = 1",
 		result: returnNoResult("client_found_rows", "int64"),
@@ -25,6 +25,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -42,6 +43,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/targetacl"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
@@ -915,6 +917,62 @@ func TestExecutorShow(t *testing.T) {
 	assert.EqualError(t, err, want, query)
 }
 
+func TestExecutorShowSharded(t *testing.T) {
+	executor, sbc1, sbc2, _ := createLegacyExecutorEnv()
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "TestExecutor", Autocommit: true})
+
+	// SHOW TABLES is answered from the vschema of the sharded keyspace.
+	qr, err := executor.Execute(ctx, "TestExecute", session, "show full tables like 'user%'", nil)
+	require.NoError(t, err)
+	assert.Empty(t, sbc1.Queries)
+	assert.Empty(t, sbc2.Queries)
+	require.Len(t, qr.Fields, 2)
+	assert.Equal(t, "Tables_in_TestExecutor", qr.Fields[0].Name)
+	assert.Equal(t, "Table_type", qr.Fields[1].Name)
+	var names []string
+	for _, row := range qr.Rows {
+		names = append(names, row[0].ToString())
+		assert.Equal(t, "BASE TABLE", row[1].ToString())
+	}
+	assert.Contains(t, names, "user")
+	assert.Contains(t, names, "user_extra")
+	assert.NotContains(t, names, "music")
+	assert.True(t, sort.StringsAreSorted(names), names)
+
+	// SHOW CREATE TABLE and SHOW INDEX are sent to a single shard of the
+	// keyspace of the table.
+	_, err = executor.Execute(ctx, "TestExecute", session, "show create table user", nil)
+	require.NoError(t, err)
+	_, err = executor.Execute(ctx, "TestExecute", session, "show index from user_extra", nil)
+	require.NoError(t, err)
+	queries := append(sbc1.Queries, sbc2.Queries...)
+	require.Len(t, queries, 2)
+	var sqls []string
+	for _, query := range queries {
+		sqls = append(sqls, query.Sql)
+	}
+	assert.ElementsMatch(t, []string{"show create table `user`", "show indexes from user_extra"}, sqls)
+
+	// SHOW VARIABLES reports the values of the session for the variables
+	// handled by vtgate.
+	sbc1.Queries = nil
+	sbc2.Queries = nil
+	variables := &sqltypes.Result{
+		Fields: []*querypb.Field{{Name: "Variable_name", Type: sqltypes.VarChar}, {Name: "Value", Type: sqltypes.VarChar}},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("autocommit"), sqltypes.NewVarChar("0")},
+			{sqltypes.NewVarChar("max_connections"), sqltypes.NewVarChar("151")},
+		},
+	}
+	sbc1.SetResults([]*sqltypes.Result{variables})
+	sbc2.SetResults([]*sqltypes.Result{variables})
+	qr, err = executor.Execute(ctx, "TestExecute", session, "show variables", nil)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 2)
+	assert.Equal(t, "1", qr.Rows[0][1].ToString())
+	assert.Equal(t, "151", qr.Rows[1][1].ToString())
+}
+
 func TestExecutorUse(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 	session := NewSafeSession(&vtgatepb.Session{Autocommit: true, TargetString: "@master"})
@@ -922,10 +980,12 @@ func TestExecutorUse(t *testing.T) {
 	stmts := []string{
 		"use TestExecutor",
 		"use `TestExecutor:-80@master`",
+		"use TestExecutor:80-@replica",
 	}
 	want := []string{
 		"TestExecutor",
 		"TestExecutor:-80@master",
+		"TestExecutor:80-@replica",
 	}
 	for i, stmt := range stmts {
 		_, err := executor.Execute(ctx, "TestExecute", session, stmt, nil)
@@ -1052,7 +1112,12 @@ func TestExecutorOther(t *testing.T) {
 					assert.NoError(t, err)
 				}
 
-				utils.MustMatch(t, tc.wantCnts, cnts{
+				wantCnts := tc.wantCnts
+				if stmt == "show tables" && tc.targetStr == "TestExecutor" {
+					// The tables of a sharded keyspace come from the vschema.
+					wantCnts = cnts{}
+				}
+				utils.MustMatch(t, wantCnts, cnts{
 					Sbc1Cnt:      sbc1.ExecCount.Get(),
 					Sbc2Cnt:      sbc2.ExecCount.Get(),
 					SbcLookupCnt: sbclookup.ExecCount.Get(),
@@ -1379,6 +1444,40 @@ func TestExecutorVindexDDLACL(t *testing.T) {
 	*vschemaacl.AuthorizedDDLUsers = ""
 }
 
+func TestExecutorExplicitTargetingACL(t *testing.T) {
+	executor, _, _, _ := createLegacyExecutorEnv()
+	*targetacl.AuthorizedUsers = "blueUser"
+	targetacl.Init()
+	defer func() {
+		*targetacl.AuthorizedUsers = "%"
+		targetacl.Init()
+	}()
+
+	ctxRedUser := callerid.NewContext(ctx, &vtrpcpb.CallerID{}, &querypb.VTGateCallerID{Username: "redUser"})
+	ctxBlueUser := callerid.NewContext(ctx, &vtrpcpb.CallerID{}, &querypb.VTGateCallerID{Username: "blueUser"})
+
+	stmts := []string{
+		"use TestExecutor:-80@master",
+		"select id from TestExecutor[-80].music",
+	}
+	for _, stmt := range stmts {
+		session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+		_, err := executor.Execute(ctxRedUser, "TestExecute", session, stmt, nil)
+		require.EqualError(t, err, `User 'redUser' is not allowed to target shards explicitly`, stmt)
+
+		// the plan must not be cached, or the second user would bypass the check
+		_, err = executor.Execute(ctxBlueUser, "TestExecute", session, stmt, nil)
+		require.NoError(t, err, stmt)
+		_, err = executor.Execute(ctxRedUser, "TestExecute", NewSafeSession(&vtgatepb.Session{TargetString: "@master"}), stmt, nil)
+		require.EqualError(t, err, `User 'redUser' is not allowed to target shards explicitly`, stmt)
+	}
+
+	// plain keyspace targeting is always allowed
+	session := NewSafeSession(&vtgatepb.Session{TargetString: "@master"})
+	_, err := executor.Execute(ctxRedUser, "TestExecute", session, "use TestExecutor", nil)
+	require.NoError(t, err)
+}
+
 func TestExecutorUnrecognized(t *testing.T) {
 	executor, _, _, _ := createLegacyExecutorEnv()
 	_, err := executor.Execute(ctx, "TestExecute", NewSafeSession(&vtgatepb.Session{}), "invalid statement", nil)
@@ -1997,19 +2096,23 @@ func TestExecutorExplain(t *testing.T) {
 	require.NoError(t, err)
 
 	require.Equal(t,
-		`[[VARCHAR("Route") VARCHAR("SelectScatter") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select * from `+"`user`"+`")]]`,
+		`[[VARCHAR("Route") VARCHAR("SelectScatter") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select * from `+"`user`"+`") `+
+			`VARCHAR("") VARCHAR("") VARCHAR("-20,20-40,40-60,60-80,80-a0,a0-c0,c0-e0,e0-")]]`,
+		fmt.Sprintf("%v", result.Rows))
+
+	result, err = executorExec(executor, "explain format = vitess select * from user where id = 1", bindVars)
+	require.NoError(t, err)
+	require.Equal(t,
+		`[[VARCHAR("Route") VARCHAR("SelectEqualUnique") VARCHAR("TestExecutor") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("select * from `+"`user`"+` where id = 1") `+
+			`VARCHAR("hash_index") VARCHAR("1") VARCHAR("-20")]]`,
 		fmt.Sprintf("%v", result.Rows))
 
 	result, err = executorExec(executor, "explain format = vitess select 42", bindVars)
 	require.NoError(t, err)
-	expected :=
-		`[[VARCHAR("Projection") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")] ` +
-			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")]]`
 	require.Equal(t,
-		`[[VARCHAR("Projection") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")] `+
-			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("")]]`,
-		expected,
-		fmt.Sprintf("%v", result.Rows), fmt.Sprintf("%v", result.Rows))
+		`[[VARCHAR("Projection") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("")] `+
+			`[VARCHAR("└─ SingleRow") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("UNKNOWN") VARCHAR("") VARCHAR("") VARCHAR("") VARCHAR("")]]`,
+		fmt.Sprintf("%v", result.Rows))
 }
 
 func TestExecutorOtherAdmin(t *testing.T) {
@@ -23,8 +23,10 @@ import (
 
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vtgate/buffer"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
@@ -118,3 +120,30 @@ func WaitForTablets(gw Gateway, tabletTypesToWait []topodatapb.TabletType) error
 	}
 	return err
 }
+
+// canBuffer returns true if the requests of the target can be buffered:
+// MASTER requests, and REPLICA or RDONLY requests for which every healthy
+// tablet was tried already. healthyTablets returns the keys of the healthy
+// tablets of a target, as they are recorded in invalidTablets.
+func canBuffer(target *querypb.Target, invalidTablets map[string]bool, healthyTablets func(*querypb.Target) []string) bool {
+	switch target.TabletType {
+	case topodatapb.TabletType_MASTER:
+		return true
+	case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY:
+		for _, key := range healthyTablets(target) {
+			if !invalidTablets[key] {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// waitForFailoverEnd blocks while the requests of the target are buffered.
+func waitForFailoverEnd(ctx context.Context, buf *buffer.Buffer, target *querypb.Target, err error) (buffer.RetryDoneFunc, error) {
+	if target.TabletType == topodatapb.TabletType_MASTER {
+		return buf.WaitForFailoverEnd(ctx, target.Keyspace, target.Shard, err)
+	}
+	return buf.WaitForReadFailoverEnd(ctx, target.Keyspace, target.Shard, target.TabletType, err)
+}
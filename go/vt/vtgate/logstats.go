@@ -25,6 +25,8 @@ import (
 
 	"context"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/tb"
@@ -33,6 +35,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
 )
 
 // LogStats records the stats for a single vtgate query
@@ -185,3 +188,37 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	)
 	return err
 }
+
+// LogProto returns the record of the query in the binary log format, or
+// nil if it mustn't be logged.
+func (stats *LogStats) LogProto() proto.Message {
+	if !streamlog.ShouldEmitLog(stats.SQL, stats.RowsAffected, stats.RowsReturned) {
+		return nil
+	}
+	remoteAddr, username := stats.RemoteAddrUsername()
+	record := &querylogpb.GateQuery{
+		Method:          stats.Method,
+		RemoteAddr:      remoteAddr,
+		Username:        username,
+		ImmediateCaller: stats.ImmediateCaller(),
+		EffectiveCaller: stats.EffectiveCaller(),
+		StartTime:       stats.StartTime.UnixNano(),
+		EndTime:         stats.EndTime.UnixNano(),
+		PlanTime:        int64(stats.PlanTime),
+		ExecuteTime:     int64(stats.ExecuteTime),
+		CommitTime:      int64(stats.CommitTime),
+		StmtType:        stats.StmtType,
+		Sql:             stats.SQL,
+		ShardQueries:    stats.ShardQueries,
+		RowsAffected:    stats.RowsAffected,
+		RowsReturned:    stats.RowsReturned,
+		Error:           stats.ErrorStr(),
+		Keyspace:        stats.Keyspace,
+		Table:           stats.Table,
+		TabletType:      stats.TabletType,
+	}
+	if !*streamlog.RedactDebugUIQueries {
+		record.BindVariables = stats.BindVariables
+	}
+	return record
+}
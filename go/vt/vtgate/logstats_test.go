@@ -27,11 +27,14 @@ import (
 
 	"context"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/callinfo/fakecallinfo"
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
 )
 
 func testFormat(stats *LogStats, params url.Values) string {
@@ -129,6 +132,43 @@ func TestLogStatsFormat(t *testing.T) {
 	*streamlog.QueryLogFormat = "text"
 }
 
+func TestLogStatsLogProto(t *testing.T) {
+	defer func() { *streamlog.RedactDebugUIQueries = false }()
+	bindVars := map[string]*querypb.BindVariable{"intVal": sqltypes.Int64BindVariable(1)}
+	logStats := NewLogStats(context.Background(), "test", "sql1", bindVars)
+	logStats.StartTime = time.Unix(0, 1000)
+	logStats.EndTime = time.Unix(0, 3000)
+	logStats.PlanTime = 500
+	logStats.ShardQueries = 2
+	logStats.RowsReturned = 3
+	logStats.Keyspace = "ks"
+	logStats.Error = errors.New("boom")
+
+	want := &querylogpb.GateQuery{
+		Method:        "test",
+		StartTime:     1000,
+		EndTime:       3000,
+		PlanTime:      500,
+		Sql:           "sql1",
+		BindVariables: bindVars,
+		ShardQueries:  2,
+		RowsReturned:  3,
+		Error:         "boom",
+		Keyspace:      "ks",
+	}
+	got := logStats.LogProto()
+	if !proto.Equal(got, want) {
+		t.Errorf("logstats proto: got %v, want %v", got, want)
+	}
+
+	*streamlog.RedactDebugUIQueries = true
+	want.BindVariables = nil
+	got = logStats.LogProto()
+	if !proto.Equal(got, want) {
+		t.Errorf("logstats proto: got %v, want %v", got, want)
+	}
+}
+
 func TestLogStatsFilter(t *testing.T) {
 	defer func() { *streamlog.QueryLogFilterTag = "" }()
 
@@ -0,0 +1,366 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"flag"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/flagutil"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+)
+
+const (
+	// connLimitPolicyReject refuses the new connections of a user
+	// that has reached its maximum number of connections.
+	connLimitPolicyReject = "reject"
+	// connLimitPolicyKillIdle closes the connection of the user that
+	// has been idle the longest to make room for the new connection.
+	// The new connection is refused if no connection of the user is idle.
+	connLimitPolicyKillIdle = "kill_idle"
+
+	// mysqlConnectionsHandler is the admin endpoint that shows the
+	// connections of each user.
+	mysqlConnectionsHandler = "/debug/mysql_connections"
+)
+
+var (
+	mysqlMaxConnectionsPerUser = flag.Int("mysql_server_max_connections_per_user", 0, "Maximum number of MySQL protocol connections a user can have open at the same time. 0 means unlimited.")
+	mysqlConnLimitPolicy       = flag.String("mysql_server_connection_limit_policy", connLimitPolicyReject, "What to do with a new connection of a user that has reached mysql_server_max_connections_per_user: reject, to refuse it, or kill_idle, to close the connection of the user that has been idle the longest to make room for it.")
+	mysqlMaxIdleTime           = flag.Duration("mysql_server_max_idle_time", 0, "MySQL protocol connections that have not executed a query for longer than this duration are closed. 0 means idle connections are never closed.")
+
+	connRejectedPerUser   = stats.NewCountersWithSingleLabel("MysqlServerConnRejectedPerUser", "MySQL server connections refused because the user reached its maximum number of connections", "User")
+	idleConnKilledPerUser = stats.NewCountersWithSingleLabel("MysqlServerIdleConnKilledPerUser", "Idle MySQL server connections closed by vtgate", "User")
+
+	// mysqlProcessUsers are the users that see the connections of every
+	// user in SHOW PROCESSLIST, like the users with the PROCESS privilege
+	// in MySQL.
+	mysqlProcessUsers []string
+)
+
+func init() {
+	flagutil.StringListVar(&mysqlProcessUsers, "mysql_server_process_users", nil, "Comma-separated list of users that see the MySQL protocol connections of every user in SHOW PROCESSLIST. The other users only see their own connections.")
+}
+
+// connLimiter keeps track of the MySQL protocol connections of each user,
+// limits their number, and closes the connections that stay idle for too long.
+type connLimiter struct {
+	idleTimer *timer.Timer
+
+	// mu protects the fields below.
+	mu              sync.Mutex
+	maxConnsPerUser int
+	killIdle        bool
+	maxIdleTime     time.Duration
+	users           map[string]map[*mysql.Conn]*connActivity
+}
+
+// connActivity is the activity of a connection.
+type connActivity struct {
+	lastActive time.Time
+	// busy is true while the connection is executing a query.
+	busy bool
+	// target and query are the target of the session and the query of
+	// the last query executed by the connection.
+	target string
+	query  string
+}
+
+func newConnLimiter(maxConnsPerUser int, killIdle bool, maxIdleTime time.Duration) *connLimiter {
+	return &connLimiter{
+		maxConnsPerUser: maxConnsPerUser,
+		killIdle:        killIdle,
+		maxIdleTime:     maxIdleTime,
+		users:           make(map[string]map[*mysql.Conn]*connActivity),
+	}
+}
+
+// Open starts closing the idle connections, if a maximum idle time is set.
+func (cl *connLimiter) Open() {
+	if cl.maxIdleTime <= 0 {
+		return
+	}
+	// Idle connections are closed at most 10% past their maximum idle time.
+	cl.idleTimer = timer.NewTimer(cl.maxIdleTime / 10)
+	cl.idleTimer.Start(func() {
+		cl.closeIdle(time.Now())
+	})
+}
+
+// Close stops closing the idle connections.
+func (cl *connLimiter) Close() {
+	if cl.idleTimer != nil {
+		cl.idleTimer.Stop()
+		cl.idleTimer = nil
+	}
+}
+
+// add registers the authenticated connection. It returns an error if
+// the user of the connection has reached its maximum number of connections.
+func (cl *connLimiter) add(c *mysql.Conn) error {
+	var victim *mysql.Conn
+	err := func() error {
+		cl.mu.Lock()
+		defer cl.mu.Unlock()
+
+		conns := cl.users[c.User]
+		if conns == nil {
+			conns = make(map[*mysql.Conn]*connActivity)
+			cl.users[c.User] = conns
+		}
+		if cl.maxConnsPerUser > 0 && len(conns) >= cl.maxConnsPerUser {
+			if cl.killIdle {
+				victim = idlest(conns)
+			}
+			if victim == nil {
+				connRejectedPerUser.Add(c.User, 1)
+				return mysql.NewSQLError(mysql.ERTooManyUserConnections, mysql.SSClientError, "User '%s' has exceeded the 'max_user_connections' resource (current value: %d)", c.User, cl.maxConnsPerUser)
+			}
+			delete(conns, victim)
+			idleConnKilledPerUser.Add(c.User, 1)
+		}
+		conns[c] = &connActivity{lastActive: time.Now()}
+		return nil
+	}()
+	if victim != nil {
+		log.Infof("Closing idle connection %v of user %v to make room for connection %v", victim.ConnectionID, c.User, c.ConnectionID)
+		victim.Close()
+	}
+	return err
+}
+
+// idlest returns the connection that has been idle the longest,
+// or nil if all the connections are busy.
+func idlest(conns map[*mysql.Conn]*connActivity) *mysql.Conn {
+	var found *mysql.Conn
+	var lastActive time.Time
+	for c, activity := range conns {
+		if activity.busy {
+			continue
+		}
+		if found == nil || activity.lastActive.Before(lastActive) {
+			found, lastActive = c, activity.lastActive
+		}
+	}
+	return found
+}
+
+// remove unregisters the closed connection.
+func (cl *connLimiter) remove(c *mysql.Conn) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	conns := cl.users[c.User]
+	if _, ok := conns[c]; !ok {
+		return
+	}
+	delete(conns, c)
+	if len(conns) == 0 {
+		delete(cl.users, c.User)
+	}
+}
+
+// startQuery marks the connection as busy executing query against target.
+func (cl *connLimiter) startQuery(c *mysql.Conn, target, query string) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	if activity, ok := cl.users[c.User][c]; ok {
+		activity.busy = true
+		activity.lastActive = time.Now()
+		activity.target = target
+		activity.query = query
+	}
+}
+
+// endQuery marks the connection as idle.
+func (cl *connLimiter) endQuery(c *mysql.Conn) {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	if activity, ok := cl.users[c.User][c]; ok {
+		activity.busy = false
+		activity.lastActive = time.Now()
+	}
+}
+
+// closeIdle closes the connections that have been idle for longer
+// than the maximum idle time.
+func (cl *connLimiter) closeIdle(now time.Time) {
+	var idle []*mysql.Conn
+	var maxIdleTime time.Duration
+	func() {
+		cl.mu.Lock()
+		defer cl.mu.Unlock()
+		maxIdleTime = cl.maxIdleTime
+		for user, conns := range cl.users {
+			for c, activity := range conns {
+				if activity.busy || now.Sub(activity.lastActive) <= maxIdleTime {
+					continue
+				}
+				delete(conns, c)
+				idleConnKilledPerUser.Add(user, 1)
+				idle = append(idle, c)
+			}
+			if len(conns) == 0 {
+				delete(cl.users, user)
+			}
+		}
+	}()
+	for _, c := range idle {
+		log.Infof("Closing connection %v of user %v, idle for more than %v", c.ConnectionID, c.User, maxIdleTime)
+		c.Close()
+	}
+}
+
+// userConnections is the status of the connections of a user.
+type userConnections struct {
+	User        string
+	Connections int
+	Busy        int
+	// MaxIdleTime is the longest time one of the idle connections
+	// of the user has been idle.
+	MaxIdleTime time.Duration
+}
+
+// status returns the status of the connections of each user, sorted by user.
+func (cl *connLimiter) status(now time.Time) []userConnections {
+	cl.mu.Lock()
+	defer cl.mu.Unlock()
+	status := make([]userConnections, 0, len(cl.users))
+	for user, conns := range cl.users {
+		uc := userConnections{
+			User:        user,
+			Connections: len(conns),
+		}
+		for _, activity := range conns {
+			if activity.busy {
+				uc.Busy++
+				continue
+			}
+			if idle := now.Sub(activity.lastActive); idle > uc.MaxIdleTime {
+				uc.MaxIdleTime = idle
+			}
+		}
+		status = append(status, uc)
+	}
+	sort.Slice(status, func(i, j int) bool {
+		return status[i].User < status[j].User
+	})
+	return status
+}
+
+// processlist returns the rows of SHOW PROCESSLIST for the connections
+// of user, or of every user if allUsers is set, sorted by connection id.
+// Unless full is set, the queries are truncated to their first 100
+// characters, like MySQL does.
+func (cl *connLimiter) processlist(now time.Time, user string, allUsers, full bool) [][]sqltypes.Value {
+	type process struct {
+		c        *mysql.Conn
+		activity connActivity
+	}
+	var processes []process
+	func() {
+		cl.mu.Lock()
+		defer cl.mu.Unlock()
+		for connUser, conns := range cl.users {
+			if !allUsers && connUser != user {
+				continue
+			}
+			for c, activity := range conns {
+				processes = append(processes, process{c: c, activity: *activity})
+			}
+		}
+	}()
+	sort.Slice(processes, func(i, j int) bool {
+		return processes[i].c.ConnectionID < processes[j].c.ConnectionID
+	})
+
+	rows := make([][]sqltypes.Value, 0, len(processes))
+	for _, p := range processes {
+		host := ""
+		if addr := p.c.RemoteAddr(); addr != nil {
+			host = addr.String()
+		}
+		db := sqltypes.NULL
+		if p.activity.target != "" {
+			db = sqltypes.NewVarChar(p.activity.target)
+		}
+		command, state, info := "Sleep", "", sqltypes.NULL
+		if p.activity.busy {
+			command, state = "Query", "executing"
+			query := p.activity.query
+			if !full {
+				query = truncateChars(query, 100)
+			}
+			info = sqltypes.NewVarChar(query)
+		}
+		rows = append(rows, []sqltypes.Value{
+			sqltypes.NewUint64(uint64(p.c.ConnectionID)),
+			sqltypes.NewVarChar(p.c.User),
+			sqltypes.NewVarChar(host),
+			db,
+			sqltypes.NewVarChar(command),
+			sqltypes.NewInt64(int64(now.Sub(p.activity.lastActive) / time.Second)),
+			sqltypes.NewVarChar(state),
+			info,
+		})
+	}
+	return rows
+}
+
+// truncateChars returns the first n characters of s.
+func truncateChars(s string, n int) string {
+	for i := range s {
+		if n == 0 {
+			return s[:i]
+		}
+		n--
+	}
+	return s
+}
+
+// mysqlProcesslist returns the rows of SHOW PROCESSLIST for the connections
+// of the MySQL server of vtgate that user can see. It has no rows if the
+// server is not running.
+func mysqlProcesslist(now time.Time, user string, full bool) [][]sqltypes.Value {
+	if vtgateHandle == nil {
+		return [][]sqltypes.Value{}
+	}
+	allUsers := false
+	for _, processUser := range mysqlProcessUsers {
+		if processUser == user {
+			allUsers = true
+			break
+		}
+	}
+	return vtgateHandle.limiter.processlist(now, user, allUsers, full)
+}
+
+// ServeHTTP shows the connections of each user.
+func (cl *connLimiter) ServeHTTP(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	returnAsJSON(response, cl.status(time.Now()))
+}
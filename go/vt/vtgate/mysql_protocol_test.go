@@ -20,7 +20,9 @@ import (
 	"net"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -30,6 +32,7 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -169,6 +172,124 @@ func TestMySQLProtocolClientFoundRows(t *testing.T) {
 	}
 }
 
+func TestMySQLProtocolConnectionLimits(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	limiter := vtgateHandle.limiter
+	setLimits := func(maxConnsPerUser int, killIdle bool, maxIdleTime time.Duration) {
+		limiter.mu.Lock()
+		defer limiter.mu.Unlock()
+		limiter.maxConnsPerUser, limiter.killIdle, limiter.maxIdleTime = maxConnsPerUser, killIdle, maxIdleTime
+	}
+	defer setLimits(0, false, 0)
+	userStatus := func() []userConnections {
+		var status []userConnections
+		for _, uc := range limiter.status(time.Now()) {
+			if uc.User == "limited_user" {
+				status = append(status, uc)
+			}
+		}
+		return status
+	}
+
+	// The user can only have one connection.
+	setLimits(1, false, 0)
+	c1, err := mysqlConnect(&mysql.ConnParams{Uname: "limited_user"})
+	require.NoError(t, err)
+	_, err = mysqlConnect(&mysql.ConnParams{Uname: "limited_user"})
+	require.Error(t, err)
+	assert.Equal(t, mysql.ERTooManyUserConnections, err.(*mysql.SQLError).Number())
+	assert.Contains(t, err.Error(), "User 'limited_user' has exceeded the 'max_user_connections' resource (current value: 1)")
+
+	// The idle connection of the user makes room for the new one.
+	setLimits(1, true, time.Minute)
+	c2, err := mysqlConnect(&mysql.ConnParams{Uname: "limited_user"})
+	require.NoError(t, err)
+	defer c2.Close()
+	_, err = c1.ExecuteFetch("select id from t1", 10, false)
+	require.Error(t, err)
+	_, err = c2.ExecuteFetch("select id from t1", 10, false)
+	require.NoError(t, err)
+
+	status := userStatus()
+	require.Len(t, status, 1)
+	assert.Equal(t, 1, status[0].Connections)
+	assert.Equal(t, 0, status[0].Busy)
+
+	// Connections idle for longer than the maximum idle time are closed.
+	limiter.closeIdle(time.Now().Add(time.Hour))
+	_, err = c2.ExecuteFetch("select id from t1", 10, false)
+	require.Error(t, err)
+	assert.Empty(t, userStatus())
+}
+
+func TestMySQLProtocolShowProcesslist(t *testing.T) {
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	c, err := mysqlConnect(&mysql.ConnParams{Uname: "processlist_user"})
+	require.NoError(t, err)
+	defer c.Close()
+	idle, err := mysqlConnect(&mysql.ConnParams{Uname: "processlist_user"})
+	require.NoError(t, err)
+	defer idle.Close()
+	other, err := mysqlConnect(&mysql.ConnParams{Uname: "processlist_other"})
+	require.NoError(t, err)
+	defer other.Close()
+
+	query := "show full processlist /* " + strings.Repeat("x", 100) + " */"
+	qr, err := c.ExecuteFetch(query, 100, true /* wantfields */)
+	require.NoError(t, err)
+	require.Len(t, qr.Fields, 8)
+	assert.Equal(t, "Info", qr.Fields[7].Name)
+
+	// The user only sees its own connections.
+	rows := make(map[uint64][]sqltypes.Value)
+	for _, row := range qr.Rows {
+		assert.Equal(t, "processlist_user", row[1].ToString())
+		id, err := row[0].ToUint64()
+		require.NoError(t, err)
+		rows[id] = row
+	}
+	require.Len(t, rows, 2)
+	// The connection running the query is busy with it.
+	row := rows[uint64(c.ConnectionID)]
+	assert.Equal(t, "Query", row[4].ToString())
+	assert.Equal(t, query, row[7].ToString())
+	// The other connection is sleeping.
+	row = rows[uint64(idle.ConnectionID)]
+	assert.Equal(t, "Sleep", row[4].ToString())
+	assert.True(t, row[7].IsNull())
+
+	// Without FULL, the queries are truncated to 100 characters.
+	query = "show processlist /* " + strings.Repeat("é", 100) + " */"
+	qr, err = c.ExecuteFetch(query, 100, false /* wantfields */)
+	require.NoError(t, err)
+	require.Len(t, qr.Rows, 2)
+	for _, row := range qr.Rows {
+		id, err := row[0].ToUint64()
+		require.NoError(t, err)
+		if id == uint64(c.ConnectionID) {
+			assert.Equal(t, "show processlist /* "+strings.Repeat("é", 80), row[7].ToString())
+		}
+	}
+
+	// The process users see the connections of every user.
+	defer func(users []string) { mysqlProcessUsers = users }(mysqlProcessUsers)
+	mysqlProcessUsers = []string{"processlist_user"}
+	qr, err = c.ExecuteFetch("show processlist", 100, false /* wantfields */)
+	require.NoError(t, err)
+	users := make(map[string]int)
+	for _, row := range qr.Rows {
+		users[row[1].ToString()]++
+	}
+	assert.Equal(t, 2, users["processlist_user"])
+	assert.Equal(t, 1, users["processlist_other"])
+}
+
 // mysqlConnect fills the host & port into params and connects
 // to the mysql protocol port.
 func mysqlConnect(params *mysql.ConnParams) (*mysql.Conn, error) {
@@ -50,6 +50,8 @@ type ContextVSchema interface {
 	SysVarSetEnabled() bool
 	KeyspaceExists(keyspace string) bool
 	AllKeyspace() ([]*vindexes.Keyspace, error)
+	// KeyspaceTables returns the tables of the keyspace in the vschema.
+	KeyspaceTables(keyspace string) map[string]*vindexes.Table
 	GetSemTable() *semantics.SemTable
 	Planner() PlannerVersion
 
@@ -169,7 +171,7 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars s
 		return buildUsePlan(stmt, vschema)
 	case sqlparser.Explain:
 		return buildExplainPlan(stmt, reservedVars, vschema)
-	case *sqlparser.OtherRead, *sqlparser.OtherAdmin:
+	case *sqlparser.OtherRead, *sqlparser.OtherAdmin, *sqlparser.AnalyzeTable:
 		return buildOtherReadAndAdmin(query, vschema)
 	case *sqlparser.Set:
 		return buildSetPlan(stmt, vschema)
@@ -192,6 +194,10 @@ func createInstructionFor(query string, stmt sqlparser.Statement, reservedVars s
 		return buildFlushPlan(stmt, vschema)
 	case *sqlparser.CallProc:
 		return buildCallProcPlan(stmt, vschema)
+	case *sqlparser.Kill:
+		// KILL is handled by the mysql server plugin, which knows the
+		// connections and their running queries.
+		return nil, vterrors.New(vtrpcpb.Code_UNIMPLEMENTED, "KILL is only supported over the MySQL protocol")
 	}
 
 	return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "BUG: unexpected statement type: %T", stmt)
@@ -75,7 +75,13 @@ func planFilter(pb *primitiveBuilder, input logicalPlan, filter sqlparser.Expr,
 	case *subquery:
 		return nil, errors.New("unsupported: filtering on results of cross-shard subquery")
 	case *orderedAggregate:
-		return nil, errors.New("unsupported: filtering on results of aggregates")
+		if whereType != sqlparser.HavingStr {
+			return nil, errors.New("unsupported: filtering on results of aggregates")
+		}
+		if err := node.pushHaving(filter); err != nil {
+			return nil, err
+		}
+		return node, nil
 	}
 
 	return nil, vterrors.Errorf(vtrpc.Code_INTERNAL, "[BUG] unreachable %T.filtering", input)
@@ -33,6 +33,7 @@ import (
 
 // processDMLTable analyzes the FROM clause for DMLs and returns a route.
 func (pb *primitiveBuilder) processDMLTable(tableExprs sqlparser.TableExprs, reservedVars sqlparser.BindVars, where sqlparser.Expr) (*route, error) {
+	pb.writes = true
 	if err := pb.processTableExprs(tableExprs, reservedVars, where); err != nil {
 		return nil, err
 	}
@@ -57,6 +58,7 @@ func (pb *primitiveBuilder) processTableExprs(tableExprs sqlparser.TableExprs, r
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.writes = pb.writes
 	if err := rpb.processTableExprs(tableExprs[1:], reservedVars, where); err != nil {
 		return err
 	}
@@ -132,6 +134,7 @@ func (pb *primitiveBuilder) processAliasedTable(tableExpr *sqlparser.AliasedTabl
 		rb, st := newRoute(&sqlparser.Select{From: []sqlparser.TableExpr{tableExpr}})
 		rb.substitutions = subroute.substitutions
 		rb.condition = subroute.condition
+		rb.multiColCondition = subroute.multiColCondition
 		rb.eroute = subroute.eroute
 		subroute.Redirect = rb
 
@@ -198,6 +201,9 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 	if err != nil {
 		return err
 	}
+	if pb.writes && vschemaTable != nil && vschemaTable.Source != nil {
+		vschemaTable = vschemaTable.Source
+	}
 	if vindex != nil {
 		single, ok := vindex.(vindexes.SingleColumn)
 		if !ok {
@@ -259,6 +265,9 @@ func (pb *primitiveBuilder) buildTablePrimitive(tableExpr *sqlparser.AliasedTabl
 		eroute.Vindex, _ = vindex.(vindexes.SingleColumn)
 		eroute.Values = []sqltypes.PlanValue{{Value: sqltypes.MakeTrusted(sqltypes.VarBinary, vschemaTable.Pinned)}}
 	}
+	if destTarget == nil && eroute.Opcode != engine.SelectNext {
+		eroute.ReadTabletType = vschemaTable.ReadTabletType
+	}
 	eroute.TableName = sqlparser.String(vschemaTable.Name)
 	rb.eroute = eroute
 
@@ -280,6 +289,7 @@ func (pb *primitiveBuilder) processJoin(ajoin *sqlparser.JoinTableExpr, reserved
 		return err
 	}
 	rpb := newPrimitiveBuilder(pb.vschema, pb.jt)
+	rpb.writes = pb.writes
 	if err := rpb.processTableExpr(ajoin.RightExpr, reservedVars, where); err != nil {
 		return err
 	}
@@ -324,9 +334,11 @@ func (pb *primitiveBuilder) join(rpb *primitiveBuilder, ajoin *sqlparser.JoinTab
 	if lRoute.eroute.Opcode == engine.SelectReference {
 		// Swap the conditions & eroutes, and then merge.
 		lRoute.condition, rRoute.condition = rRoute.condition, lRoute.condition
+		lRoute.multiColCondition, rRoute.multiColCondition = rRoute.multiColCondition, lRoute.multiColCondition
 		lRoute.eroute, rRoute.eroute = rRoute.eroute, lRoute.eroute
 	}
 	lRoute.substitutions = append(lRoute.substitutions, rRoute.substitutions...)
+	lRoute.mergeReadTabletType(rRoute)
 	rRoute.Redirect = lRoute
 
 	// Merge the AST.
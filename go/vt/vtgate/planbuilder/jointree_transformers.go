@@ -20,11 +20,11 @@ import (
 	"sort"
 	"strings"
 
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
-	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -63,7 +63,16 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 	tableNameMap := map[string]interface{}{}
 
 	sort.Sort(n._tables)
-	for _, t := range n._tables {
+	readTabletType := topodatapb.TabletType_UNKNOWN
+	for i, t := range n._tables {
+		// The tables are read from a tablet type other than the one of
+		// the session only if they all agree on it.
+		if i == 0 || t.vtable.ReadTabletType == readTabletType {
+			readTabletType = t.vtable.ReadTabletType
+		} else {
+			readTabletType = topodatapb.TabletType_UNKNOWN
+		}
+
 		alias := sqlparser.AliasedTableExpr{
 			Expr: sqlparser.TableName{
 				Name: t.vtable.Name,
@@ -82,11 +91,6 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 		where = &sqlparser.Where{Expr: predicates, Type: sqlparser.WhereClause}
 	}
 
-	var singleColumn vindexes.SingleColumn
-	if n.vindex != nil {
-		singleColumn = n.vindex.(vindexes.SingleColumn)
-	}
-
 	var expressions sqlparser.SelectExprs
 	for _, col := range n.columns {
 		expressions = append(expressions, &sqlparser.AliasedExpr{Expr: col})
@@ -103,8 +107,10 @@ func transformRoutePlan(n *routePlan) (*route, error) {
 			Opcode:    n.routeOpCode,
 			TableName: strings.Join(tableNames, ", "),
 			Keyspace:  n.keyspace,
-			Vindex:    singleColumn,
+			Vindex:    n.vindex,
 			Values:    n.vindexValues,
+
+			ReadTabletType: readTabletType,
 		},
 		Select: &sqlparser.Select{
 			SelectExprs: expressions,
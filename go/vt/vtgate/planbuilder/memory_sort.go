@@ -22,6 +22,7 @@ import (
 
 	"vitess.io/vitess/go/vt/vtgate/semantics"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
@@ -47,8 +48,12 @@ func newMemorySort(plan logicalPlan, orderBy sqlparser.OrderBy) (*memorySort, er
 		eMemorySort:    eMemorySort,
 	}
 	for _, order := range orderBy {
+		orderExpr, collationID, err := orderCollation(order.Expr)
+		if err != nil {
+			return nil, err
+		}
 		colNumber := -1
-		switch expr := order.Expr.(type) {
+		switch expr := orderExpr.(type) {
 		case *sqlparser.Literal:
 			var err error
 			if colNumber, err = ResultFromNumber(ms.ResultColumns(), expr); err != nil {
@@ -86,6 +91,7 @@ func newMemorySort(plan logicalPlan, orderBy sqlparser.OrderBy) (*memorySort, er
 			Col:             colNumber,
 			WeightStringCol: -1,
 			Desc:            order.Direction == sqlparser.DescOrder,
+			CollationID:     collationID,
 		}
 		ms.eMemorySort.OrderBy = append(ms.eMemorySort.OrderBy, ob)
 	}
@@ -106,10 +112,24 @@ func (ms *memorySort) SetLimit(limit *sqlparser.Limit) error {
 // Wireup implements the logicalPlan interface
 // If text columns are detected in the keys, then the function modifies
 // the primitive to pull a corresponding weight_string from mysql and
-// compare those instead. This is because we currently don't have the
-// ability to mimic mysql's collation behavior.
+// compare those instead. If the ordering specifies a collation, the
+// weight_string is computed under that collation when the input is a route,
+// and vtgate compares the values with that collation otherwise.
 func (ms *memorySort) Wireup(plan logicalPlan, jt *jointab) error {
 	for i, orderby := range ms.eMemorySort.OrderBy {
+		if orderby.CollationID != collations.Unknown {
+			rb, ok := ms.input.(*route)
+			if !ok {
+				continue
+			}
+			weightcolNumber, err := rb.SupplyCollatedWeightString(orderby.Col, orderby.CollationID)
+			if err != nil {
+				return err
+			}
+			ms.eMemorySort.OrderBy[i].WeightStringCol = weightcolNumber
+			ms.eMemorySort.TruncateColumnCount = len(ms.resultColumns)
+			continue
+		}
 		rc := ms.resultColumns[orderby.Col]
 		// Add a weight_string column if we know that the column is a textual column or if its type is unknown
 		if sqltypes.IsText(rc.column.typ) || rc.column.typ == sqltypes.Null {
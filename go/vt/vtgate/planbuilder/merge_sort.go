@@ -17,6 +17,7 @@ limitations under the License.
 package planbuilder
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/vtgate/engine"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
@@ -61,10 +62,19 @@ func (ms *mergeSort) Primitive() engine.Primitive {
 func (ms *mergeSort) Wireup(plan logicalPlan, jt *jointab) error {
 	// If the route has to do the ordering, and if any columns are Text,
 	// we have to request the corresponding weight_string from mysql
-	// and use that value instead. This is because we cannot mimic
-	// mysql's collation behavior yet.
+	// and use that value instead. If the ordering specifies a collation,
+	// the weight_string is computed under that collation.
 	rb := ms.input.(*route)
 	for i, orderby := range rb.eroute.OrderBy {
+		if orderby.CollationID != collations.Unknown {
+			var err error
+			rb.eroute.OrderBy[i].WeightStringCol, err = rb.SupplyCollatedWeightString(orderby.Col, orderby.CollationID)
+			if err != nil {
+				return err
+			}
+			ms.truncateColumnCount = len(ms.resultColumns)
+			continue
+		}
 		rc := ms.resultColumns[orderby.Col]
 		// Add a weight_string column if we know that the column is a textual column or if its type is unknown
 		if sqltypes.IsText(rc.column.typ) || rc.column.typ == sqltypes.Null {
@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vtgate/engine"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 )
 
 var _ logicalPlan = (*orderedAggregate)(nil)
@@ -59,6 +60,25 @@ type orderedAggregate struct {
 	resultsBuilder
 	extraDistinct *sqlparser.ColName
 	eaggr         *engine.OrderedAggregate
+
+	// aggregates are the aggregate expressions of the select list, which
+	// the HAVING clause can reference.
+	aggregates []aggregateColumn
+	// avgCounts are the counts to push down for the averages. They are
+	// pushed down last, once all the other columns are pushed.
+	avgCounts []avgCount
+}
+
+type aggregateColumn struct {
+	expr      sqlparser.Expr
+	colNumber int
+}
+
+// avgCount is the count that the sum of an average, pushed down as the
+// aggregate at index aggr, is divided by.
+type avgCount struct {
+	aggr int
+	expr *sqlparser.AliasedExpr
 }
 
 // checkAggregates analyzes the select expression for aggregates. If it determines
@@ -242,6 +262,9 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 	if len(funcExpr.Exprs) != 1 {
 		return nil, 0, fmt.Errorf("unsupported: only one expression allowed inside aggregates: %s", sqlparser.String(funcExpr))
 	}
+	if opcode == engine.AggregateAvg {
+		return oa.pushAvg(pb, expr, funcExpr, origin)
+	}
 	handleDistinct, innerAliased, err := oa.needDistinctHandling(pb, funcExpr, opcode)
 	if err != nil {
 		return nil, 0, err
@@ -292,11 +315,162 @@ func (oa *orderedAggregate) pushAggr(pb *primitiveBuilder, expr *sqlparser.Alias
 		})
 	}
 
+	return oa.addAggregateColumn(expr)
+}
+
+// pushAvg pushes down the sum and the count of the values of an average.
+// The sum is pushed down now, under the name of the average, and the
+// count is pushed down in Wireup, after all the columns of the select
+// list.
+func (oa *orderedAggregate) pushAvg(pb *primitiveBuilder, expr *sqlparser.AliasedExpr, funcExpr *sqlparser.FuncExpr, origin logicalPlan) (rc *resultColumn, colNumber int, err error) {
+	if funcExpr.Distinct {
+		innerAliased, ok := funcExpr.Exprs[0].(*sqlparser.AliasedExpr)
+		if !ok {
+			return nil, 0, fmt.Errorf("syntax error: %s", sqlparser.String(funcExpr))
+		}
+		rb, ok := oa.input.(*route)
+		if !ok {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+		}
+		// A distinct average can be pushed down only if each value is
+		// in a single shard.
+		vindex := pb.st.Vindex(innerAliased.Expr, rb)
+		if vindex == nil || !vindex.IsUnique() {
+			return nil, 0, fmt.Errorf("unsupported: in scatter query: %s", sqlparser.String(funcExpr))
+		}
+	}
+	as := expr.As
+	if as.IsEmpty() {
+		as = sqlparser.NewColIdent(sqlparser.String(expr.Expr))
+	}
+	sumExpr := &sqlparser.AliasedExpr{
+		Expr: &sqlparser.FuncExpr{
+			Name:     sqlparser.NewColIdent("sum"),
+			Distinct: funcExpr.Distinct,
+			Exprs:    funcExpr.Exprs,
+		},
+		As: as,
+	}
+	newBuilder, _, innerCol, err := planProjection(pb, oa.input, sumExpr, origin)
+	if err != nil {
+		return nil, 0, err
+	}
+	pb.plan = newBuilder
+	oa.avgCounts = append(oa.avgCounts, avgCount{
+		aggr: len(oa.eaggr.Aggregates),
+		expr: &sqlparser.AliasedExpr{
+			Expr: &sqlparser.FuncExpr{
+				Name:     sqlparser.NewColIdent("count"),
+				Distinct: funcExpr.Distinct,
+				Exprs:    funcExpr.Exprs,
+			},
+		},
+	})
+	oa.eaggr.Aggregates = append(oa.eaggr.Aggregates, engine.AggregateParams{
+		Opcode: engine.AggregateAvg,
+		Col:    innerCol,
+	})
+	return oa.addAggregateColumn(expr)
+}
+
+func (oa *orderedAggregate) addAggregateColumn(expr *sqlparser.AliasedExpr) (rc *resultColumn, colNumber int, err error) {
 	// Build a new rc with oa as origin because it's semantically different
 	// from the expression we pushed down.
 	rc = newResultColumn(expr, oa)
 	oa.resultColumns = append(oa.resultColumns, rc)
-	return rc, len(oa.resultColumns) - 1, nil
+	colNumber = len(oa.resultColumns) - 1
+	oa.aggregates = append(oa.aggregates, aggregateColumn{
+		expr:      expr.Expr,
+		colNumber: colNumber,
+	})
+	return rc, colNumber, nil
+}
+
+// pushHaving adds a predicate of the HAVING clause, which is evaluated
+// on the aggregated rows.
+func (oa *orderedAggregate) pushHaving(filter sqlparser.Expr) error {
+	predicate, err := oa.havingExpr(filter)
+	if err != nil {
+		return err
+	}
+	if oa.eaggr.Having != nil {
+		predicate = &evalengine.BinaryOp{
+			Expr:  &evalengine.AndOp{},
+			Left:  oa.eaggr.Having,
+			Right: predicate,
+		}
+	}
+	oa.eaggr.Having = predicate
+	return nil
+}
+
+var (
+	havingComparisons = map[sqlparser.ComparisonExprOperator]func() evalengine.BinaryExpr{
+		sqlparser.EqualOp:        func() evalengine.BinaryExpr { return &evalengine.EqualOp{} },
+		sqlparser.NotEqualOp:     func() evalengine.BinaryExpr { return &evalengine.NotEqualOp{} },
+		sqlparser.LessThanOp:     func() evalengine.BinaryExpr { return &evalengine.LessThanOp{} },
+		sqlparser.LessEqualOp:    func() evalengine.BinaryExpr { return &evalengine.LessEqualOp{} },
+		sqlparser.GreaterThanOp:  func() evalengine.BinaryExpr { return &evalengine.GreaterThanOp{} },
+		sqlparser.GreaterEqualOp: func() evalengine.BinaryExpr { return &evalengine.GreaterEqualOp{} },
+	}
+	havingArithmetic = map[sqlparser.BinaryExprOperator]func() evalengine.BinaryExpr{
+		sqlparser.PlusOp:  func() evalengine.BinaryExpr { return &evalengine.Addition{} },
+		sqlparser.MinusOp: func() evalengine.BinaryExpr { return &evalengine.Subtraction{} },
+		sqlparser.MultOp:  func() evalengine.BinaryExpr { return &evalengine.Multiplication{} },
+		sqlparser.DivOp:   func() evalengine.BinaryExpr { return &evalengine.Division{} },
+	}
+)
+
+// havingExpr converts a HAVING predicate to an expression evaluated on the
+// aggregated rows. The predicate can reference the columns and the
+// aggregates of the select list.
+func (oa *orderedAggregate) havingExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	binaryOp := func(op evalengine.BinaryExpr, left, right sqlparser.Expr) (evalengine.Expr, error) {
+		l, err := oa.havingExpr(left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := oa.havingExpr(right)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.BinaryOp{Expr: op, Left: l, Right: r}, nil
+	}
+	switch node := expr.(type) {
+	case *sqlparser.AndExpr:
+		return binaryOp(&evalengine.AndOp{}, node.Left, node.Right)
+	case *sqlparser.OrExpr:
+		return binaryOp(&evalengine.OrOp{}, node.Left, node.Right)
+	case *sqlparser.ComparisonExpr:
+		if op, ok := havingComparisons[node.Operator]; ok {
+			return binaryOp(op(), node.Left, node.Right)
+		}
+	case *sqlparser.BinaryExpr:
+		if op, ok := havingArithmetic[node.Operator]; ok {
+			return binaryOp(op(), node.Left, node.Right)
+		}
+	case *sqlparser.ColName:
+		for i, rc := range oa.resultColumns {
+			if rc.column == node.Metadata {
+				return evalengine.NewColumn(i), nil
+			}
+		}
+		return nil, fmt.Errorf("unsupported: in scatter query: having clause must reference columns of the select list: %s", sqlparser.String(node))
+	case *sqlparser.FuncExpr:
+		if node.IsAggregate() {
+			for _, aggr := range oa.aggregates {
+				if sqlparser.EqualsExpr(node, aggr.expr) {
+					return evalengine.NewColumn(aggr.colNumber), nil
+				}
+			}
+			return nil, fmt.Errorf("unsupported: in scatter query: having clause must reference aggregates of the select list: %s", sqlparser.String(node))
+		}
+	default:
+		if evalExpr, err := sqlparser.Convert(expr); err == nil {
+			return evalExpr, nil
+		}
+	}
+	return nil, fmt.Errorf("unsupported: in scatter query: complex having expression: %s", sqlparser.String(expr))
 }
 
 // needDistinctHandling returns true if oa needs to handle the distinct clause.
@@ -331,6 +505,16 @@ func (oa *orderedAggregate) needDistinctHandling(pb *primitiveBuilder, funcExpr
 // compare those instead. This is because we currently don't have the
 // ability to mimic mysql's collation behavior.
 func (oa *orderedAggregate) Wireup(plan logicalPlan, jt *jointab) error {
+	for _, count := range oa.avgCounts {
+		// It's ok to pass nil for pb and logicalPlan because the input is a route.
+		_, _, colNumber, err := planProjection(nil, oa.input, count.expr, nil)
+		if err != nil {
+			return err
+		}
+		oa.eaggr.Aggregates[count.aggr].CountCol = colNumber
+		oa.eaggr.TruncateColumnCount = len(oa.resultColumns)
+	}
+	oa.avgCounts = nil
 	for i, colNumber := range oa.eaggr.Keys {
 		rc := oa.resultColumns[colNumber]
 		if sqltypes.IsText(rc.column.typ) {
@@ -16,6 +16,7 @@ package planbuilder
 import (
 	"fmt"
 
+	"vitess.io/vitess/go/mysql/collations"
 	"vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -226,6 +227,24 @@ func planJoinOrdering(pb *primitiveBuilder, orderBy sqlparser.OrderBy, node *joi
 	return node, nil
 }
 
+// orderCollation returns the column of an ORDER BY without its COLLATE
+// clause, and the collation the clause specifies, which is used to compare
+// the rows returned by different shards.
+func orderCollation(expr sqlparser.Expr) (sqlparser.Expr, collations.ID, error) {
+	collate, ok := expr.(*sqlparser.CollateExpr)
+	if !ok {
+		return expr, collations.Unknown, nil
+	}
+	if _, ok := collate.Expr.(*sqlparser.ColName); !ok {
+		return expr, collations.Unknown, nil
+	}
+	collation := collations.LookupByName(collate.Charset)
+	if collation == nil {
+		return nil, collations.Unknown, fmt.Errorf("unsupported: order by with unknown collation: %s", collate.Charset)
+	}
+	return collate.Expr, collation.ID(), nil
+}
+
 func planRouteOrdering(orderBy sqlparser.OrderBy, node *route) (logicalPlan, error) {
 	switch len(orderBy) {
 	case 0:
@@ -254,8 +273,12 @@ func planRouteOrdering(orderBy sqlparser.OrderBy, node *route) (logicalPlan, err
 
 	// If it's a scatter, we have to populate the OrderBy field.
 	for _, order := range orderBy {
+		orderExpr, collationID, err := orderCollation(order.Expr)
+		if err != nil {
+			return nil, err
+		}
 		colNumber := -1
-		switch expr := order.Expr.(type) {
+		switch expr := orderExpr.(type) {
 		case *sqlparser.Literal:
 			var err error
 			if colNumber, err = ResultFromNumber(node.resultColumns, expr); err != nil {
@@ -293,6 +316,7 @@ func planRouteOrdering(orderBy sqlparser.OrderBy, node *route) (logicalPlan, err
 			Col:             colNumber,
 			WeightStringCol: -1,
 			Desc:            order.Direction == sqlparser.DescOrder,
+			CollationID:     collationID,
 		}
 		node.eroute.OrderBy = append(node.eroute.OrderBy, ob)
 
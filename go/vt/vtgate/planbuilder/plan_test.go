@@ -319,6 +319,14 @@ func (vw *vschemaWrapper) AllKeyspace() ([]*vindexes.Keyspace, error) {
 	return []*vindexes.Keyspace{vw.keyspace}, nil
 }
 
+func (vw *vschemaWrapper) KeyspaceTables(keyspace string) map[string]*vindexes.Table {
+	ks, ok := vw.v.Keyspaces[keyspace]
+	if !ok {
+		return nil
+	}
+	return ks.Tables
+}
+
 func (vw *vschemaWrapper) Planner() PlannerVersion {
 	return vw.version
 }
@@ -25,6 +25,9 @@ type primitiveBuilder struct {
 	jt      *jointab
 	plan    logicalPlan
 	st      *symtab
+	// writes is set if the tables are the targets of a DML. The writes
+	// of the materialized tables are routed to their sources.
+	writes bool
 }
 
 func newPrimitiveBuilder(vschema ContextVSchema, jt *jointab) *primitiveBuilder {
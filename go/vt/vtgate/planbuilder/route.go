@@ -17,6 +17,8 @@ limitations under the License.
 package planbuilder
 
 import (
+	"vitess.io/vitess/go/mysql/collations"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/semantics"
@@ -62,6 +64,17 @@ type route struct {
 	// to resolve the ERoute Values field.
 	condition sqlparser.Expr
 
+	// multiColCondition stores the values of the columns of the
+	// multi-column vindex used by the route, in the order of the
+	// vindex columns. A value is a tuple for an IN constraint. If the
+	// vindex is a partial vindex, only its first columns may be set.
+	// condition is nil if it is set.
+	multiColCondition []sqlparser.Expr
+
+	// multiColMatches accumulates the equality and IN constraints found
+	// on the columns of the multi-column vindexes of the route.
+	multiColMatches map[*vindexes.ColumnVindex][]sqlparser.Expr
+
 	// eroute is the primitive being built.
 	eroute *engine.Route
 
@@ -163,7 +176,14 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 			rb.eroute.Values = []sqltypes.PlanValue{pv}
 			vals.Right = sqlparser.ListArg("::" + engine.ListVarName)
 		case nil:
-			// no-op.
+			// A multi-column vindex has one value per column.
+			for _, expr := range rb.multiColCondition {
+				pv, err := rb.procureValues(plan, jt, expr)
+				if err != nil {
+					return err
+				}
+				rb.eroute.Values = append(rb.eroute.Values, pv)
+			}
 		default:
 			pv, err := rb.procureValues(plan, jt, vals)
 			if err != nil {
@@ -174,9 +194,13 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 	}
 
 	// Fix up the AST.
+	locking := false
 	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
 		switch node := node.(type) {
+		case *sqlparser.Union:
+			locking = locking || node.Lock != sqlparser.NoLock
 		case *sqlparser.Select:
+			locking = locking || node.Lock != sqlparser.NoLock
 			if len(node.SelectExprs) == 0 {
 				node.SelectExprs = sqlparser.SelectExprs([]sqlparser.SelectExpr{
 					&sqlparser.AliasedExpr{
@@ -194,6 +218,11 @@ func (rb *route) Wireup(plan logicalPlan, jt *jointab) error {
 		return true, nil
 	}, rb.Select)
 
+	// Locking reads must go to the tablets of the session.
+	if locking {
+		rb.eroute.ReadTabletType = topodatapb.TabletType_UNKNOWN
+	}
+
 	// Substitute table names
 	for _, sub := range rb.substitutions {
 		*sub.oldExpr = *sub.newExpr
@@ -338,6 +367,24 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if weightcolNumber, ok := rb.weightStrings[rc]; ok {
 		return weightcolNumber, nil
 	}
+	weightcolNumber, err = rb.supplyWeightString(colNumber, collations.Unknown)
+	if err != nil {
+		return 0, err
+	}
+	rb.weightStrings[rc] = weightcolNumber
+	return weightcolNumber, nil
+}
+
+// SupplyCollatedWeightString requests the weight_string of a column under
+// the collation of an ORDER BY ... COLLATE, so that the rows of different
+// shards are merged by the weights MySQL computes for that collation.
+// Unlike SupplyWeightString, the column is not shared with the weight_string
+// of the column under its own collation.
+func (rb *route) SupplyCollatedWeightString(colNumber int, collation collations.ID) (weightcolNumber int, err error) {
+	return rb.supplyWeightString(colNumber, collation)
+}
+
+func (rb *route) supplyWeightString(colNumber int, collation collations.ID) (weightcolNumber int, err error) {
 	s, ok := rb.Select.(*sqlparser.Select)
 	if !ok {
 		return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected AST struct for query")
@@ -347,12 +394,16 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if !ok {
 		return 0, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "unexpected AST struct for query %T", s.SelectExprs[colNumber])
 	}
+	arg := aliasExpr.Expr
+	if collation != collations.Unknown {
+		arg = &sqlparser.CollateExpr{Expr: arg, Charset: collation.String()}
+	}
 	expr := &sqlparser.AliasedExpr{
 		Expr: &sqlparser.FuncExpr{
 			Name: sqlparser.NewColIdent("weight_string"),
 			Exprs: []sqlparser.SelectExpr{
 				&sqlparser.AliasedExpr{
-					Expr: aliasExpr.Expr,
+					Expr: arg,
 				},
 			},
 		},
@@ -363,7 +414,6 @@ func (rb *route) SupplyWeightString(colNumber int) (weightcolNumber int, err err
 	if err != nil {
 		return 0, err
 	}
-	rb.weightStrings[rc] = weightcolNumber
 	return weightcolNumber, nil
 }
 
@@ -385,6 +435,7 @@ func (rb *route) Inputs() []logicalPlan {
 func (rb *route) MergeSubquery(pb *primitiveBuilder, inner *route) bool {
 	if rb.SubqueryCanMerge(pb, inner) {
 		rb.substitutions = append(rb.substitutions, inner.substitutions...)
+		rb.mergeReadTabletType(inner)
 		inner.Redirect = rb
 		return true
 	}
@@ -396,12 +447,22 @@ func (rb *route) MergeSubquery(pb *primitiveBuilder, inner *route) bool {
 func (rb *route) MergeUnion(right *route, isDistinct bool) bool {
 	if rb.unionCanMerge(right, isDistinct) {
 		rb.substitutions = append(rb.substitutions, right.substitutions...)
+		rb.mergeReadTabletType(right)
 		right.Redirect = rb
 		return true
 	}
 	return false
 }
 
+// mergeReadTabletType sets the read tablet type of a route merged with
+// other. The tables of the merged route are read from a tablet type
+// other than the one of the session only if they all agree on it.
+func (rb *route) mergeReadTabletType(other *route) {
+	if rb.eroute.ReadTabletType != other.eroute.ReadTabletType {
+		rb.eroute.ReadTabletType = topodatapb.TabletType_UNKNOWN
+	}
+}
+
 func (rb *route) isSingleShard() bool {
 	switch rb.eroute.Opcode {
 	case engine.SelectUnsharded, engine.SelectDBA, engine.SelectNext, engine.SelectEqualUnique, engine.SelectReference:
@@ -556,59 +617,170 @@ func (rb *route) UpdatePlan(pb *primitiveBuilder, filter sqlparser.Expr) {
 	case engine.SelectUnsharded, engine.SelectNext, engine.SelectDBA, engine.SelectReference, engine.SelectNone:
 		return
 	}
+	rb.updateMultiColumnPlan(pb, filter)
 	opcode, vindex, values := rb.computePlan(pb, filter)
 	if opcode == engine.SelectScatter {
 		return
 	}
 	// If we get SelectNone in next filters, override the previous route plan.
-	if opcode == engine.SelectNone {
+	if opcode == engine.SelectNone || rb.isBetterPlan(opcode, vindex) {
 		rb.updateRoute(opcode, vindex, values)
-		return
 	}
+}
+
+// isBetterPlan returns true if routing with the opcode and vindex
+// is an improvement over the current plan of the route.
+func (rb *route) isBetterPlan(opcode engine.RouteOpcode, vindex vindexes.Vindex) bool {
 	switch rb.eroute.Opcode {
 	case engine.SelectEqualUnique:
-		if opcode == engine.SelectEqualUnique && vindex.Cost() < rb.eroute.Vindex.Cost() {
-			rb.updateRoute(opcode, vindex, values)
-		}
+		return opcode == engine.SelectEqualUnique && vindex.Cost() < rb.eroute.Vindex.Cost()
 	case engine.SelectEqual:
 		switch opcode {
 		case engine.SelectEqualUnique:
-			rb.updateRoute(opcode, vindex, values)
+			return true
 		case engine.SelectEqual:
-			if vindex.Cost() < rb.eroute.Vindex.Cost() {
-				rb.updateRoute(opcode, vindex, values)
-			}
+			return vindex.Cost() < rb.eroute.Vindex.Cost()
 		}
 	case engine.SelectIN:
 		switch opcode {
 		case engine.SelectEqualUnique, engine.SelectEqual:
-			rb.updateRoute(opcode, vindex, values)
+			return true
 		case engine.SelectIN:
-			if vindex.Cost() < rb.eroute.Vindex.Cost() {
-				rb.updateRoute(opcode, vindex, values)
-			}
+			return vindex.Cost() < rb.eroute.Vindex.Cost()
 		}
 	case engine.SelectMultiEqual:
 		switch opcode {
 		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN:
-			rb.updateRoute(opcode, vindex, values)
+			return true
 		case engine.SelectMultiEqual:
-			if vindex.Cost() < rb.eroute.Vindex.Cost() {
-				rb.updateRoute(opcode, vindex, values)
-			}
+			return vindex.Cost() < rb.eroute.Vindex.Cost()
 		}
 	case engine.SelectScatter:
 		switch opcode {
 		case engine.SelectEqualUnique, engine.SelectEqual, engine.SelectIN, engine.SelectMultiEqual, engine.SelectNone:
-			rb.updateRoute(opcode, vindex, values)
+			return true
 		}
 	}
+	return false
+}
+
+// updateMultiColumnPlan records the equality or IN constraint of the
+// filter on a column of a multi-column vindex. Once all the columns of
+// the vindex are constrained, or its first columns if it is a partial
+// vindex, the vindex is considered for the route. An IN constraint on
+// any of the columns makes it a SelectIN route.
+func (rb *route) updateMultiColumnPlan(pb *primitiveBuilder, filter sqlparser.Expr) {
+	comparison, ok := filter.(*sqlparser.ComparisonExpr)
+	if !ok {
+		return
+	}
+	var left, right sqlparser.Expr
+	var multiColVindexes []multiColumnVindex
+	switch comparison.Operator {
+	case sqlparser.EqualOp:
+		left, right = comparison.Left, comparison.Right
+		multiColVindexes = pb.st.MultiColumnVindexes(left, rb)
+		if multiColVindexes == nil {
+			left, right = right, left
+			multiColVindexes = pb.st.MultiColumnVindexes(left, rb)
+		}
+		if !rb.exprIsValue(right) || sqlparser.IsNull(right) {
+			return
+		}
+	case sqlparser.InOp:
+		left, right = comparison.Left, comparison.Right
+		multiColVindexes = pb.st.MultiColumnVindexes(left, rb)
+		if !rb.isINValues(right) {
+			return
+		}
+	}
+	if multiColVindexes == nil {
+		return
+	}
+	for _, mcv := range multiColVindexes {
+		if rb.multiColMatches == nil {
+			rb.multiColMatches = make(map[*vindexes.ColumnVindex][]sqlparser.Expr)
+		}
+		values := rb.multiColMatches[mcv.columnVindex]
+		if values == nil {
+			values = make([]sqlparser.Expr, len(mcv.columnVindex.Columns))
+			rb.multiColMatches[mcv.columnVindex] = values
+		}
+		values[mcv.position] = right
+
+		vindex := mcv.columnVindex.Vindex
+		set := setPrefix(values)
+		if set == 0 {
+			continue
+		}
+		if set < len(values) {
+			if partial, ok := vindex.(vindexes.MultiColumn); !ok || !partial.PartialVindex() {
+				continue
+			}
+		}
+		condition := values[:set]
+		opcode := engine.SelectEqual
+		switch {
+		case hasINValues(condition):
+			opcode = engine.SelectIN
+		case set == len(values) && vindex.IsUnique():
+			opcode = engine.SelectEqualUnique
+		}
+		// With the same vindex, more columns narrow the route down.
+		if rb.isBetterPlan(opcode, vindex) || (rb.eroute.Vindex == vindex && rb.eroute.Opcode == opcode && len(condition) > len(rb.multiColCondition)) {
+			rb.updateRoute(opcode, vindex, nil)
+			rb.multiColCondition = condition
+		}
+	}
+}
+
+// isINValues returns true if the right side of an IN constraint can be
+// used to route the query.
+func (rb *route) isINValues(right sqlparser.Expr) bool {
+	switch node := right.(type) {
+	case sqlparser.ValTuple:
+		if len(node) == 1 && sqlparser.IsNull(node[0]) {
+			return false
+		}
+		for _, n := range node {
+			if !rb.exprIsValue(n) {
+				return false
+			}
+		}
+		return true
+	case sqlparser.ListArg:
+		return true
+	}
+	return false
+}
+
+// setPrefix returns the number of first expressions that are set.
+func setPrefix(exprs []sqlparser.Expr) int {
+	for i, expr := range exprs {
+		if expr == nil {
+			return i
+		}
+	}
+	return len(exprs)
+}
+
+// hasINValues returns true if one of the values is the list of an IN
+// constraint.
+func hasINValues(exprs []sqlparser.Expr) bool {
+	for _, expr := range exprs {
+		switch expr.(type) {
+		case sqlparser.ValTuple, sqlparser.ListArg:
+			return true
+		}
+	}
+	return false
 }
 
-func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.SingleColumn, condition sqlparser.Expr) {
+func (rb *route) updateRoute(opcode engine.RouteOpcode, vindex vindexes.Vindex, condition sqlparser.Expr) {
 	rb.eroute.Opcode = opcode
 	rb.eroute.Vindex = vindex
 	rb.condition = condition
+	rb.multiColCondition = nil
 }
 
 // computePlan computes the plan for the specified filter.
@@ -253,6 +253,25 @@ type vindexPlusPredicates struct {
 	covered bool
 }
 
+// addValue sets the value of the vindex column at the given position.
+// The values are kept in the order of the vindex columns, which is the
+// order in which a multi-column vindex expects them.
+func (v *vindexPlusPredicates) addValue(pos int, value sqltypes.PlanValue) {
+	// values may be shared with a clone of the routePlan, so it is copied.
+	values := make([]sqltypes.PlanValue, len(v.vindex.Columns))
+	copy(values, v.values)
+	values[pos] = value
+	v.values = values
+
+	// Vindex is covered if all the columns in the vindex have a associated predicate
+	v.covered = true
+	for _, pv := range v.values {
+		if pv.IsNull() {
+			v.covered = false
+		}
+	}
+}
+
 // addPredicate clones this routePlan and returns a new one with these predicates added to it. if the predicates can help,
 // they will improve the routeOpCode
 func (rp *routePlan) addPredicate(predicates ...sqlparser.Expr) error {
@@ -309,12 +328,10 @@ func (rp *routePlan) searchForNewVindexes(predicates []sqlparser.Expr) (bool, er
 						return false, err
 					}
 					if ok {
-						for _, col := range v.vindex.Columns {
+						for i, col := range v.vindex.Columns {
 							// If the column for the predicate matches any column in the vindex add it to the list
 							if column.Name.Equal(col) {
-								v.values = append(v.values, value)
-								// Vindex is covered if all the columns in the vindex have a associated predicate
-								v.covered = len(v.values) == len(v.vindex.Columns)
+								v.addValue(i, value)
 								newVindexFound = newVindexFound || v.covered
 							}
 						}
@@ -283,8 +283,8 @@ func (pb *primitiveBuilder) processSelect(sel *sqlparser.Select, reservedVars sq
 		// TODO(sougou): this can probably be improved.
 		directives := sqlparser.ExtractCommentDirectives(sel.Comments)
 		rb.eroute.QueryTimeout = queryTimeout(directives)
-		if rb.eroute.TargetDestination != nil {
-			return errors.New("unsupported: SELECT with a target destination")
+		if rb.eroute.TargetDestination != nil && !isSingleTable(sel) {
+			return errors.New("unsupported: SELECT with a target destination and multiple tables")
 		}
 
 		if directives.IsSet(sqlparser.DirectiveScatterErrorsAsWarnings) {
@@ -679,3 +679,17 @@ func (pb *primitiveBuilder) expandStar(inrcs []*resultColumn, expr *sqlparser.St
 	}
 	return inrcs, true, nil
 }
+
+// isSingleTable returns true if the select references exactly one table,
+// including the tables of its subqueries. A route with an explicit target
+// destination must not be merged with the routes of other tables.
+func isSingleTable(sel *sqlparser.Select) bool {
+	count := 0
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if _, ok := node.(*sqlparser.AliasedTableExpr); ok {
+			count++
+		}
+		return true, nil
+	}, sel)
+	return count == 1
+}
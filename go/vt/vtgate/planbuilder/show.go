@@ -19,6 +19,7 @@ package planbuilder
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
 
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -242,14 +243,18 @@ func buildPlanWithDB(show *sqlparser.ShowBasic, vschema ContextVSchema) (engine.
 	if err != nil {
 		return nil, err
 	}
-	if destination == nil {
-		destination = key.DestinationAnyShard{}
-	}
 
 	if dbName.IsEmpty() {
 		dbName = sqlparser.NewTableIdent(keyspace.Name)
 	}
 
+	if show.Command == sqlparser.Table && destination == nil && keyspace.Sharded && (show.Filter == nil || show.Filter.Filter == nil) {
+		return buildVSchemaTablesPlan(show, keyspace, dbName, vschema), nil
+	}
+	if destination == nil {
+		destination = key.DestinationAnyShard{}
+	}
+
 	query := sqlparser.String(show)
 	var plan engine.Primitive
 	plan = &engine.Send{
@@ -269,6 +274,39 @@ func buildPlanWithDB(show *sqlparser.ShowBasic, vschema ContextVSchema) (engine.
 
 }
 
+// buildVSchemaTablesPlan answers SHOW [FULL] TABLES from the tables of a
+// sharded keyspace in the vschema, which has to list all of them to route
+// the queries, instead of asking one of its shards.
+func buildVSchemaTablesPlan(show *sqlparser.ShowBasic, keyspace *vindexes.Keyspace, dbName sqlparser.TableIdent, vschema ContextVSchema) engine.Primitive {
+	filter := regexp.MustCompile(".*")
+	if show.Filter != nil {
+		filter = sqlparser.LikeToRegexp(show.Filter.Like)
+	}
+
+	var names []string
+	for name, table := range vschema.KeyspaceTables(keyspace.Name) {
+		if name == "dual" || !filter.MatchString(name) {
+			continue
+		}
+		names = append(names, table.Name.String())
+	}
+	sort.Strings(names)
+
+	fields := buildVarCharFields("Tables_in_" + dbName.String())
+	if show.Full {
+		fields = append(fields, buildVarCharFields("Table_type")...)
+	}
+	rows := make([][]sqltypes.Value, 0, len(names))
+	for _, name := range names {
+		if show.Full {
+			rows = append(rows, buildVarCharRow(name, "BASE TABLE"))
+			continue
+		}
+		rows = append(rows, buildVarCharRow(name))
+	}
+	return engine.NewRowsPrimitive(rows, fields)
+}
+
 func buildVarCharFields(names ...string) []*querypb.Field {
 	fields := make([]*querypb.Field, len(names))
 	for i, v := range names {
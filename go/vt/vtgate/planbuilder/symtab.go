@@ -110,10 +110,7 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 	}
 
 	for _, cv := range vschemaTable.ColumnVindexes {
-		single, ok := cv.Vindex.(vindexes.SingleColumn)
-		if !ok {
-			continue
-		}
+		single, isSingle := cv.Vindex.(vindexes.SingleColumn)
 		for i, cvcol := range cv.Columns {
 			col, err := t.mergeColumn(cvcol, &column{
 				origin: rb,
@@ -122,6 +119,10 @@ func (st *symtab) AddVSchemaTable(alias sqlparser.TableName, vschemaTable *vinde
 			if err != nil {
 				return err
 			}
+			if !isSingle {
+				col.multiColVindexes = append(col.multiColVindexes, multiColumnVindex{columnVindex: cv, position: i})
+				continue
+			}
 			if i == 0 {
 				if col.vindex == nil || col.vindex.Cost() > single.Cost() {
 					col.vindex = single
@@ -413,6 +414,26 @@ func (st *symtab) Vindex(expr sqlparser.Expr, scope *route) vindexes.SingleColum
 	return c.vindex
 }
 
+// MultiColumnVindexes returns the multi-column vindexes the expression
+// is a column of, if the column originates from the specified route.
+func (st *symtab) MultiColumnVindexes(expr sqlparser.Expr, scope *route) []multiColumnVindex {
+	col, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return nil
+	}
+	if col.Metadata == nil {
+		// Find will set the Metadata.
+		if _, _, err := st.Find(col); err != nil {
+			return nil
+		}
+	}
+	c := col.Metadata.(*column)
+	if c.Origin() != scope {
+		return nil
+	}
+	return c.multiColVindexes
+}
+
 // BuildColName builds a *sqlparser.ColName for the resultColumn specified
 // by the index. The built ColName will correctly reference the resultColumn
 // it was built from.
@@ -524,6 +545,16 @@ type column struct {
 	vindex    vindexes.SingleColumn
 	typ       querypb.Type
 	colNumber int
+
+	// multiColVindexes are the multi-column vindexes the column is part of.
+	multiColVindexes []multiColumnVindex
+}
+
+// multiColumnVindex is a multi-column vindex along with the position
+// of a column in it.
+type multiColumnVindex struct {
+	columnVindex *vindexes.ColumnVindex
+	position     int
 }
 
 // Origin returns the route that originates the column.
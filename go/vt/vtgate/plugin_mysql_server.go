@@ -19,7 +19,9 @@ package vtgate
 import (
 	"flag"
 	"fmt"
+	"math"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"regexp"
@@ -36,15 +38,18 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vtgate/audit"
 	"vitess.io/vitess/go/vt/vttls"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 
 	"github.com/google/uuid"
 )
@@ -57,6 +62,7 @@ var (
 	mysqlAuthServerImpl           = flag.String("mysql_auth_server_impl", "static", "Which auth server implementation to use. Options: none, ldap, clientcert, static, vault.")
 	mysqlAllowClearTextWithoutTLS = flag.Bool("mysql_allow_clear_text_without_tls", false, "If set, the server will allow the use of a clear text password over non-SSL connections.")
 	mysqlProxyProtocol            = flag.Bool("proxy_protocol", false, "Enable HAProxy PROXY protocol on MySQL listener socket")
+	mysqlConvertCharset           = flag.Bool("mysql_server_convert_charset", false, "If set, the queries and the bind variables are converted to utf8mb4 from the character set the clients request in their handshake or with SET NAMES, like latin1 or utf8, and the results and the errors back to it, or to the one set with SET character_set_results. The characters that do not exist in the character set of a client are replaced with '?'.")
 
 	mysqlServerRequireSecureTransport = flag.Bool("mysql_server_require_secure_transport", false, "Reject insecure connections but only if mysql_server_ssl_cert and mysql_server_ssl_key are provided")
 
@@ -76,6 +82,8 @@ var (
 	mysqlDefaultWorkload     int32
 
 	busyConnections int32
+
+	mysqlServerKills = stats.NewCountersWithSingleLabel("MysqlServerKills", "KILL statements executed by the MySQL server, by kill type", "Type")
 )
 
 // vtgateHandler implements the Listener interface.
@@ -85,12 +93,23 @@ type vtgateHandler struct {
 
 	vtg         *VTGate
 	connections map[*mysql.Conn]bool
+	// queries cancels the query running on each connection, by
+	// connection id. It is used by KILL.
+	queries map[uint32]context.CancelFunc
+
+	// limiter accounts for the connections of each user.
+	limiter *connLimiter
+
+	// audit records the audit events, if enabled.
+	audit *audit.Logger
 }
 
 func newVtgateHandler(vtg *VTGate) *vtgateHandler {
 	return &vtgateHandler{
 		vtg:         vtg,
 		connections: make(map[*mysql.Conn]bool),
+		queries:     make(map[uint32]context.CancelFunc),
+		limiter:     newConnLimiter(0, false, 0),
 	}
 }
 
@@ -100,6 +119,109 @@ func (vh *vtgateHandler) NewConnection(c *mysql.Conn) {
 	vh.connections[c] = true
 }
 
+// AuthorizeConnection is part of the mysql.ConnectionAuthorizer interface.
+// It refuses the connection if its user has too many connections.
+func (vh *vtgateHandler) AuthorizeConnection(c *mysql.Conn) error {
+	err := vh.limiter.add(c)
+	ev := auditEvent(c, audit.Connect)
+	if err != nil {
+		ev.Error = err.Error()
+	}
+	vh.audit.Record(ev)
+	return err
+}
+
+// AuthFailed is part of the mysql.AuthFailureObserver interface.
+func (vh *vtgateHandler) AuthFailed(c *mysql.Conn, user string, err error) {
+	ev := auditEvent(c, audit.AuthFailure)
+	ev.User = user
+	ev.Error = err.Error()
+	vh.audit.Record(ev)
+}
+
+// auditEvent returns an audit event describing the connection.
+func auditEvent(c *mysql.Conn, typ audit.EventType) *audit.Event {
+	ev := &audit.Event{
+		Type:         typ,
+		ConnectionID: c.ConnectionID,
+		User:         c.User,
+	}
+	if addr := c.RemoteAddr(); addr != nil {
+		ev.RemoteAddr = addr.String()
+	}
+	return ev
+}
+
+// auditStatement records the execution of query if it matches the
+// audit criteria.
+func (vh *vtgateHandler) auditStatement(c *mysql.Conn, session *vtgatepb.Session, query string, err error) {
+	if vh.audit == nil {
+		return
+	}
+	ev := auditEvent(c, audit.Statement)
+	ev.Target = session.TargetString
+	vh.audit.RecordStatement(ev, query, err)
+}
+
+// startQuery returns a context that is canceled when the query running on
+// c is killed. The returned function must be called when the query is done.
+func (vh *vtgateHandler) startQuery(ctx context.Context, c *mysql.Conn) (context.Context, func()) {
+	ctx, cancel := context.WithCancel(ctx)
+	vh.mu.Lock()
+	vh.queries[c.ConnectionID] = cancel
+	vh.mu.Unlock()
+	return ctx, func() {
+		vh.mu.Lock()
+		delete(vh.queries, c.ConnectionID)
+		vh.mu.Unlock()
+		cancel()
+	}
+}
+
+// kill executes a KILL statement received on c. KILL QUERY cancels the
+// query running on the target connection, which makes vttablet kill it in
+// MySQL. KILL CONNECTION also closes the connection, which rolls back its
+// transaction. Only the connections of this vtgate can be killed, and only
+// by their own user.
+func (vh *vtgateHandler) kill(c *mysql.Conn, query string) error {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return mysql.NewSQLErrorFromError(err)
+	}
+	kill, ok := stmt.(*sqlparser.Kill)
+	if !ok {
+		return mysql.NewSQLErrorFromError(vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected statement type: %T", stmt))
+	}
+	var target *mysql.Conn
+	var cancel context.CancelFunc
+	if kill.ProcesslistID <= math.MaxUint32 {
+		id := uint32(kill.ProcesslistID)
+		vh.mu.Lock()
+		for conn := range vh.connections {
+			if conn.ConnectionID == id {
+				target = conn
+				break
+			}
+		}
+		cancel = vh.queries[id]
+		vh.mu.Unlock()
+	}
+	if target == nil {
+		return mysql.NewSQLError(mysql.ERNoSuchThread, mysql.SSUnknownSQLState, "Unknown thread id: %d", kill.ProcesslistID)
+	}
+	if target.User != c.User {
+		return mysql.NewSQLError(mysql.ERKillDenied, mysql.SSUnknownSQLState, "You are not owner of thread %d", kill.ProcesslistID)
+	}
+	mysqlServerKills.Add(kill.Type.ToString(), 1)
+	if cancel != nil {
+		cancel()
+	}
+	if kill.Type == sqlparser.ConnectionKill {
+		target.Close()
+	}
+	return nil
+}
+
 func (vh *vtgateHandler) numConnections() int {
 	vh.mu.Lock()
 	defer vh.mu.Unlock()
@@ -116,6 +238,9 @@ func (vh *vtgateHandler) ComResetConnection(c *mysql.Conn) {
 	if err != nil {
 		log.Errorf("Error happened in transaction rollback: %v", err)
 	}
+	// Like in MySQL, the character sets are the ones of the handshake again.
+	session.CharacterSetClient, session.CharacterSetResults = "", ""
+	setCharacterSets(c, session)
 }
 
 func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
@@ -125,6 +250,10 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 		defer vh.mu.Unlock()
 		delete(vh.connections, c)
 	}()
+	vh.limiter.remove(c)
+	if c.User != "" {
+		vh.audit.Record(auditEvent(c, audit.Disconnect))
+	}
 
 	var ctx context.Context
 	var cancel context.CancelFunc
@@ -144,12 +273,19 @@ func (vh *vtgateHandler) ConnectionClosed(c *mysql.Conn) {
 // Regexp to extract parent span id over the sql query
 var r = regexp.MustCompile(`/\*VT_SPAN_CONTEXT=(.*)\*/`)
 
+// Regexp to extract a W3C traceparent from the sql comments, in the
+// format of sqlcommenter: /*traceparent='00-<trace id>-<parent id>-<flags>'*/
+var traceparentRegexp = regexp.MustCompile(`traceparent='?([0-9a-f]{2}-[0-9a-f]{32}-[0-9a-f]{16}-[0-9a-f]{2})`)
+
 // this function is here to make this logic easy to test by decoupling the logic from the `trace.NewSpan` and `trace.NewFromString` functions
 func startSpanTestable(ctx context.Context, query, label string,
 	newSpan func(context.Context, string) (trace.Span, context.Context),
 	newSpanFromString func(context.Context, string, string) (trace.Span, context.Context, error)) (trace.Span, context.Context, error) {
 	_, comments := sqlparser.SplitMarginComments(query)
 	match := r.FindStringSubmatch(comments.Leading)
+	if len(match) == 0 {
+		match = traceparentRegexp.FindStringSubmatch(comments.Leading + comments.Trailing)
+	}
 	span, ctx := getSpan(ctx, match, newSpan, label, newSpanFromString)
 
 	trace.AnnotateSQL(span, query)
@@ -165,7 +301,7 @@ func getSpan(ctx context.Context, match []string, newSpan func(context.Context,
 		if err == nil {
 			return span, ctx
 		}
-		log.Warningf("Unable to parse VT_SPAN_CONTEXT: %s", err.Error())
+		log.Warningf("Unable to parse the span context %q: %s", match[1], err.Error())
 	}
 	span, ctx = newSpan(ctx, label)
 	return span, ctx
@@ -204,6 +340,21 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 	ctx = callerid.NewContext(ctx, ef, im)
 
 	session := vh.session(c)
+	if sqlparser.Preview(query) == sqlparser.StmtKill {
+		err := vh.kill(c, query)
+		vh.auditStatement(c, session, query, err)
+		if err != nil {
+			return err
+		}
+		return callback(&sqltypes.Result{})
+	}
+
+	vh.limiter.startQuery(c, session.TargetString, query)
+	defer vh.limiter.endQuery(c)
+
+	ctx, done := vh.startQuery(ctx, c)
+	defer done()
+
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -215,17 +366,28 @@ func (vh *vtgateHandler) ComQuery(c *mysql.Conn, query string, callback func(*sq
 
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
 		err := vh.vtg.StreamExecute(ctx, session, query, make(map[string]*querypb.BindVariable), callback)
+		vh.auditStatement(c, session, query, err)
+		setCharacterSets(c, session)
 		return mysql.NewSQLErrorFromError(err)
 	}
 	session, result, err := vh.vtg.Execute(ctx, session, query, make(map[string]*querypb.BindVariable))
+	vh.auditStatement(c, session, query, err)
 	err = mysql.NewSQLErrorFromError(err)
 	if err != nil {
 		return err
 	}
 	fillInTxStatusFlags(c, session)
+	setCharacterSets(c, session)
 	return callback(result)
 }
 
+// setCharacterSets makes the connection convert the queries and the
+// results from and to the character sets set with SET NAMES and SET
+// character_set_results.
+func setCharacterSets(c *mysql.Conn, session *vtgatepb.Session) {
+	c.SetCharacterSets(session.CharacterSetClient, session.CharacterSetResults)
+}
+
 func fillInTxStatusFlags(c *mysql.Conn, session *vtgatepb.Session) {
 	if session.InTransaction {
 		c.StatusFlags |= mysql.ServerStatusInTrans
@@ -265,6 +427,9 @@ func (vh *vtgateHandler) ComPrepare(c *mysql.Conn, query string, bindVars map[st
 	ctx = callerid.NewContext(ctx, ef, im)
 
 	session := vh.session(c)
+	vh.limiter.startQuery(c, session.TargetString, query)
+	defer vh.limiter.endQuery(c)
+
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -307,6 +472,12 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 	ctx = callerid.NewContext(ctx, ef, im)
 
 	session := vh.session(c)
+	vh.limiter.startQuery(c, session.TargetString, prepare.PrepareStmt)
+	defer vh.limiter.endQuery(c)
+
+	ctx, done := vh.startQuery(ctx, c)
+	defer done()
+
 	if !session.InTransaction {
 		atomic.AddInt32(&busyConnections, 1)
 	}
@@ -318,14 +489,18 @@ func (vh *vtgateHandler) ComStmtExecute(c *mysql.Conn, prepare *mysql.PrepareDat
 
 	if session.Options.Workload == querypb.ExecuteOptions_OLAP {
 		err := vh.vtg.StreamExecute(ctx, session, prepare.PrepareStmt, prepare.BindVars, callback)
+		vh.auditStatement(c, session, prepare.PrepareStmt, err)
+		setCharacterSets(c, session)
 		return mysql.NewSQLErrorFromError(err)
 	}
 	_, qr, err := vh.vtg.Execute(ctx, session, prepare.PrepareStmt, prepare.BindVars)
+	vh.auditStatement(c, session, prepare.PrepareStmt, err)
 	if err != nil {
 		err = mysql.NewSQLErrorFromError(err)
 		return err
 	}
 	fillInTxStatusFlags(c, session)
+	setCharacterSets(c, session)
 
 	return callback(qr)
 }
@@ -418,9 +593,25 @@ func initMySQLProtocol() {
 		log.Exitf("-mysql_tcp_version must be one of [tcp, tcp4, tcp6]")
 	}
 
+	var killIdle bool
+	switch *mysqlConnLimitPolicy {
+	case connLimitPolicyReject:
+	case connLimitPolicyKillIdle:
+		killIdle = true
+	default:
+		log.Exitf("-mysql_server_connection_limit_policy must be one of [%s, %s]", connLimitPolicyReject, connLimitPolicyKillIdle)
+	}
+
 	// Create a Listener.
 	var err error
 	vtgateHandle = newVtgateHandler(rpcVTGate)
+	vtgateHandle.limiter = newConnLimiter(*mysqlMaxConnectionsPerUser, killIdle, *mysqlMaxIdleTime)
+	vtgateHandle.limiter.Open()
+	http.Handle(mysqlConnectionsHandler, vtgateHandle.limiter)
+	vtgateHandle.audit, err = audit.NewLoggerFromFlags()
+	if err != nil {
+		log.Exitf("Cannot start the mysql server audit log: %v", err)
+	}
 	if *mysqlServerPort >= 0 {
 		mysqlListener, err = mysql.NewListener(*mysqlTCPVersion, net.JoinHostPort(*mysqlServerBindAddress, fmt.Sprintf("%v", *mysqlServerPort)), authServer, vtgateHandle, *mysqlConnReadTimeout, *mysqlConnWriteTimeout, *mysqlProxyProtocol)
 		if err != nil {
@@ -433,6 +624,7 @@ func initMySQLProtocol() {
 			initTLSConfig(mysqlListener, *mysqlSslCert, *mysqlSslKey, *mysqlSslCa, *mysqlSslServerCA, *mysqlServerRequireSecureTransport)
 		}
 		mysqlListener.AllowClearTextWithoutTLS.Set(*mysqlAllowClearTextWithoutTLS)
+		mysqlListener.ConvertCharset = *mysqlConvertCharset
 		// Check for the connection threshold
 		if *mysqlSlowConnectWarnThreshold != 0 {
 			log.Infof("setting mysql slow connection threshold to %v", mysqlSlowConnectWarnThreshold)
@@ -452,6 +644,7 @@ func initMySQLProtocol() {
 			log.Exitf("mysql.NewListener failed: %v", err)
 			return
 		}
+		mysqlUnixListener.ConvertCharset = *mysqlConvertCharset
 		// Listen for unix socket
 		go mysqlUnixListener.Accept()
 	}
@@ -500,6 +693,9 @@ func shutdownMysqlProtocolAndDrain() {
 	if sigChan != nil {
 		signal.Stop(sigChan)
 	}
+	if vtgateHandle != nil {
+		vtgateHandle.limiter.Close()
+	}
 
 	if atomic.LoadInt32(&busyConnections) > 0 {
 		log.Infof("Waiting for all client connections to be idle (%d active)...", atomic.LoadInt32(&busyConnections))
@@ -518,6 +714,12 @@ func shutdownMysqlProtocolAndDrain() {
 
 func rollbackAtShutdown() {
 	defer log.Flush()
+	// Closing the audit log last records the disconnections below.
+	defer func() {
+		if err := vtgateHandle.audit.Close(); err != nil {
+			log.Errorf("Error closing the mysql server audit log: %v", err)
+		}
+	}()
 
 	// Close all open connections. If they're waiting for reads, this will cause
 	// them to error out, which will automatically rollback open transactions.
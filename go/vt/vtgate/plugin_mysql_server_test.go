@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/trace"
 
@@ -226,6 +227,24 @@ func TestSpanContextNotParsable(t *testing.T) {
 	assert.True(t, hasRun, "Should have continued execution despite failure to parse VT_SPAN_CONTEXT")
 }
 
+func TestTraceparentPassedIn(t *testing.T) {
+	traceparent := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"
+	_, _, err := startSpanTestable(context.Background(), "SELECT col1 FROM TABLE /*action='list',traceparent='"+traceparent+"'*/", "someLabel",
+		newSpanFail(t),
+		newFromStringExpect(t, traceparent))
+	assert.NoError(t, err)
+
+	_, _, err = startSpanTestable(context.Background(), "/*traceparent='"+traceparent+"'*/ SELECT col1 FROM TABLE", "someLabel",
+		newSpanFail(t),
+		newFromStringExpect(t, traceparent))
+	assert.NoError(t, err)
+}
+
+func TestTraceparentNotInComments(t *testing.T) {
+	_, _, err := startSpanTestable(context.Background(), "SELECT * FROM SOMETABLE WHERE COL = 'traceparent=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01'", "someLabel", newSpanOK, newFromStringFail(t))
+	assert.NoError(t, err)
+}
+
 func newTestAuthServerStatic() *mysql.AuthServerStatic {
 	jsonConfig := "{\"user1\":{\"Password\":\"password1\", \"UserData\":\"userData1\", \"SourceHost\":\"localhost\"}}"
 	return mysql.NewAuthServerStatic("", jsonConfig, 0)
@@ -248,6 +267,64 @@ func TestDefaultWorkloadOLAP(t *testing.T) {
 	}
 }
 
+func TestKillQuery(t *testing.T) {
+	vh := newVtgateHandler(nil)
+	alice := &mysql.Conn{ConnectionID: 1, User: "alice"}
+	bob := &mysql.Conn{ConnectionID: 2, User: "bob"}
+	vh.NewConnection(alice)
+	vh.NewConnection(bob)
+
+	ctx, done := vh.startQuery(context.Background(), alice)
+	defer done()
+
+	err := vh.kill(bob, "kill query 1")
+	require.EqualError(t, err, "You are not owner of thread 1 (errno 1095) (sqlstate HY000)")
+	err = vh.kill(alice, "kill query 3")
+	require.EqualError(t, err, "Unknown thread id: 3 (errno 1094) (sqlstate HY000)")
+	err = vh.kill(alice, "kill query 4294967297")
+	require.EqualError(t, err, "Unknown thread id: 4294967297 (errno 1094) (sqlstate HY000)")
+	require.NoError(t, ctx.Err())
+
+	before := mysqlServerKills.Counts()["query"]
+	require.NoError(t, vh.kill(alice, "kill query 1"))
+	assert.Equal(t, context.Canceled, ctx.Err())
+	assert.Equal(t, before+1, mysqlServerKills.Counts()["query"])
+	assert.False(t, alice.IsClosed())
+
+	// Killing a connection without a running query is not an error.
+	require.NoError(t, vh.kill(bob, "kill query 2"))
+}
+
+func TestKillConnection(t *testing.T) {
+	th := &testHandler{}
+	unixSocket, err := ioutil.TempFile("", "mysql_vitess_test.sock")
+	require.NoError(t, err)
+	os.Remove(unixSocket.Name())
+	l, err := newMysqlUnixSocket(unixSocket.Name(), newTestAuthServerStatic(), th)
+	require.NoError(t, err)
+	defer l.Close()
+	go l.Accept()
+
+	params := &mysql.ConnParams{
+		UnixSocket: unixSocket.Name(),
+		Uname:      "user1",
+		Pass:       "password1",
+	}
+	c, err := mysql.Connect(context.Background(), params)
+	require.NoError(t, err)
+	defer c.Close()
+
+	vh := newVtgateHandler(nil)
+	vh.NewConnection(th.lastConn)
+	killer := &mysql.Conn{ConnectionID: th.lastConn.ConnectionID + 1, User: "user1"}
+	vh.NewConnection(killer)
+
+	require.NoError(t, vh.kill(killer, fmt.Sprintf("kill %d", th.lastConn.ConnectionID)))
+	assert.True(t, th.lastConn.IsClosed())
+	_, err = c.ExecuteFetch("select 1", 1, false)
+	assert.Error(t, err)
+}
+
 func TestInitTLSConfigWithoutServerCA(t *testing.T) {
 	testInitTLSConfig(t, false)
 }
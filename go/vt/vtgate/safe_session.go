@@ -173,6 +173,17 @@ func (session *SafeSession) InTransaction() bool {
 	return session.Session.InTransaction
 }
 
+// ShardSessionKeyspaces returns the keyspaces of the shard sessions.
+func (session *SafeSession) ShardSessionKeyspaces() map[string]bool {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	keyspaces := make(map[string]bool)
+	for _, shardSession := range session.ShardSessions {
+		keyspaces[shardSession.Target.GetKeyspace()] = true
+	}
+	return keyspaces
+}
+
 // Find returns the transactionId and tabletAlias, if any, for a session
 func (session *SafeSession) Find(keyspace, shard string, tabletType topodatapb.TabletType) (transactionID int64, reservedID int64, alias *topodatapb.TabletAlias) {
 	session.mu.Lock()
@@ -512,6 +523,37 @@ func (session *SafeSession) SetReadAfterWriteTimeout(timeout float64) {
 	session.ReadAfterWrite.ReadAfterWriteTimeout = timeout
 }
 
+// SetMaxReplicationLag set the MaxReplicationLag setting.
+func (session *SafeSession) SetMaxReplicationLag(lag float64) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.MaxReplicationLag = lag
+}
+
+// GetMaxReplicationLag returns the MaxReplicationLag setting.
+func (session *SafeSession) GetMaxReplicationLag() time.Duration {
+	if session == nil {
+		return 0
+	}
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	return time.Duration(session.MaxReplicationLag * float64(time.Second))
+}
+
+// SetCharacterSetClient set the CharacterSetClient setting.
+func (session *SafeSession) SetCharacterSetClient(charset string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.CharacterSetClient = charset
+}
+
+// SetCharacterSetResults set the CharacterSetResults setting.
+func (session *SafeSession) SetCharacterSetResults(charset string) {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	session.CharacterSetResults = charset
+}
+
 // SetSessionTrackGtids set the SessionTrackGtids setting.
 func (session *SafeSession) SetSessionTrackGtids(enable bool) {
 	session.mu.Lock()
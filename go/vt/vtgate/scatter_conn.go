@@ -59,6 +59,11 @@ type ScatterConn struct {
 	txConn               *TxConn
 	gateway              Gateway
 	legacyHealthCheck    discovery.LegacyHealthCheck
+
+	// limiter and maxConcurrencyPerQuery limit the concurrency of
+	// the scatter queries.
+	limiter                *scatterLimiter
+	maxConcurrencyPerQuery int
 }
 
 // shardActionFunc defines the contract for a shard action
@@ -67,7 +72,7 @@ type ScatterConn struct {
 // return an error if any.  multiGo is capable of executing
 // multiple shardActionFunc actions in parallel and
 // consolidating the results and errors for the caller.
-type shardActionFunc func(rs *srvtopo.ResolvedShard, i int) error
+type shardActionFunc func(rs *srvtopo.ResolvedShard, i int, turn *scatterTurn) error
 
 // shardActionTransactionFunc defines the contract for a shard action
 // that may be in a transaction. Every such function executes the
@@ -93,9 +98,11 @@ func NewLegacyScatterConn(statsName string, txConn *TxConn, gw Gateway, hc disco
 			tabletCallErrorCountStatsName,
 			"Error count from tablet calls in scatter conns",
 			[]string{"Operation", "Keyspace", "ShardName", "DbType"}),
-		txConn:            txConn,
-		gateway:           gw,
-		legacyHealthCheck: hc,
+		txConn:                 txConn,
+		gateway:                gw,
+		legacyHealthCheck:      hc,
+		limiter:                newScatterLimiter(statsName, *scatterMaxConcurrency, *scatterMaxConcurrencyPerSession, *scatterQueueTimeout),
+		maxConcurrencyPerQuery: *scatterMaxConcurrencyPerQuery,
 	}
 }
 
@@ -118,7 +125,9 @@ func NewScatterConn(statsName string, txConn *TxConn, gw *TabletGateway) *Scatte
 		txConn:  txConn,
 		gateway: gw,
 		// gateway has a reference to healthCheck so we don't need this any more
-		legacyHealthCheck: nil,
+		legacyHealthCheck:      nil,
+		limiter:                newScatterLimiter(statsName, *scatterMaxConcurrency, *scatterMaxConcurrencyPerSession, *scatterQueueTimeout),
+		maxConcurrencyPerQuery: *scatterMaxConcurrencyPerQuery,
 	}
 }
 
@@ -151,6 +160,9 @@ func (stc *ScatterConn) endAction(startTime time.Time, allErrors *concurrency.Al
 // It always returns a non-nil query result and an array of
 // shard errors which may be nil so that callers can optionally
 // process a partially-successful operation.
+//
+// With -transaction_replay, the single-shard transactions lost
+// during a failover are replayed.
 func (stc *ScatterConn) ExecuteMultiShard(
 	ctx context.Context,
 	rss []*srvtopo.ResolvedShard,
@@ -159,6 +171,21 @@ func (stc *ScatterConn) ExecuteMultiShard(
 	autocommit bool,
 	ignoreMaxMemoryRows bool,
 ) (qr *sqltypes.Result, errs []error) {
+	qr, errs = stc.executeMultiShard(ctx, rss, queries, session, autocommit, ignoreMaxMemoryRows)
+	if autocommit || !session.InTransaction() {
+		return qr, errs
+	}
+	return stc.recordOrReplay(ctx, rss, queries, session, ignoreMaxMemoryRows, qr, errs)
+}
+
+func (stc *ScatterConn) executeMultiShard(
+	ctx context.Context,
+	rss []*srvtopo.ResolvedShard,
+	queries []*querypb.BoundQuery,
+	session *SafeSession,
+	autocommit bool,
+	ignoreMaxMemoryRows bool,
+) (qr *sqltypes.Result, errs []error) {
 
 	if len(rss) != len(queries) {
 		return nil, []error{vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] got mismatched number of queries and shards")}
@@ -267,6 +294,7 @@ func (stc *ScatterConn) ExecuteMultiShard(
 			if ignoreMaxMemoryRows || len(qr.Rows) <= *maxMemoryRows {
 				qr.AppendResult(innerqr)
 			}
+			recordWarnings(session, innerqr)
 			return info.updateTransactionAndReservedID(transactionID, reservedID, alias), nil
 		},
 	)
@@ -280,6 +308,87 @@ func (stc *ScatterConn) ExecuteMultiShard(
 
 var errRegx = regexp.MustCompile("transaction ([a-z0-9:]+) (?:ended|not found)")
 
+// ExecuteBatch sends each shard its list of queries in a single round trip.
+// Inside a transaction, the queries join the shard transaction, which is
+// begun along with the batch if the shard is not part of it yet. Otherwise,
+// each shard executes its queries in a transaction of their own.
+//
+// It returns the results and the error of every shard, indexed like rss.
+func (stc *ScatterConn) ExecuteBatch(
+	ctx context.Context,
+	rss []*srvtopo.ResolvedShard,
+	queries [][]*querypb.BoundQuery,
+	session *SafeSession,
+) ([][]sqltypes.Result, []error) {
+	results := make([][]sqltypes.Result, len(rss))
+	errs := make([]error, len(rss))
+	if len(rss) != len(queries) {
+		for i := range errs {
+			errs[i] = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] got mismatched number of queries and shards")
+		}
+		return results, errs
+	}
+	if session.InTransaction() {
+		// The batches are not recorded for replays.
+		stc.txConn.replays.invalidate(session)
+	}
+
+	allErrors := stc.multiGoTransaction(
+		ctx,
+		"ExecuteBatch",
+		rss,
+		session,
+		false,
+		func(rs *srvtopo.ResolvedShard, i int, info *shardActionInfo) (*shardActionInfo, error) {
+			var (
+				opts  *querypb.ExecuteOptions
+				alias *topodatapb.TabletAlias
+				err   error
+			)
+			transactionID := info.transactionID
+			if session != nil && session.Session != nil {
+				opts = session.Session.Options
+			}
+
+			qs, err := getQueryService(rs, info)
+			if err != nil {
+				errs[i] = err
+				return nil, err
+			}
+
+			switch info.actionNeeded {
+			case nothing:
+				// Without a shard transaction, the tablet wraps the batch in one.
+				results[i], err = qs.ExecuteBatch(ctx, rs.Target, queries[i], transactionID == 0, transactionID, opts)
+			case begin:
+				results[i], transactionID, alias, err = qs.BeginExecuteBatch(ctx, rs.Target, queries[i], false, opts)
+			default:
+				err = vterrors.Errorf(vtrpcpb.Code_INTERNAL, "[BUG] unexpected actionNeeded on batch execution: %v", info.actionNeeded)
+			}
+			errs[i] = err
+			for j := range results[i] {
+				recordWarnings(session, &results[i][j])
+			}
+			return info.updateTransactionID(transactionID, alias), err
+		},
+	)
+
+	// A shard that could not even be sent its batch has no error of its own.
+	for i := range rss {
+		if results[i] == nil && errs[i] == nil {
+			errs[i] = allErrors.Error()
+		}
+	}
+	return results, errs
+}
+
+// recordWarnings adds the warnings of a shard result to the session.
+func recordWarnings(session *SafeSession, qr *sqltypes.Result) {
+	for _, warning := range qr.Warnings {
+		session.RecordWarning(warning)
+	}
+}
+
 func checkAndResetShardSession(info *shardActionInfo, err error, session *SafeSession) bool {
 	if info.reservedID != 0 && info.transactionID == 0 && wasConnectionClosed(err) {
 		session.ResetShard(info.alias)
@@ -337,9 +446,12 @@ func (stc *ScatterConn) StreamExecute(
 	var mu sync.Mutex
 	fieldSent := false
 
-	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+	slots := stc.newScatterSlots(ctx, nil, len(rss))
+	allErrors := stc.multiGo("StreamExecute", rss, slots, func(rs *srvtopo.ResolvedShard, i int, turn *scatterTurn) error {
 		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars, 0, options, func(qr *sqltypes.Result) error {
-			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+			return turn.yield(func() error {
+				return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+			})
 		})
 	})
 	return allErrors.AggrError(vterrors.Aggregate)
@@ -355,16 +467,23 @@ func (stc *ScatterConn) StreamExecuteMulti(
 	query string,
 	rss []*srvtopo.ResolvedShard,
 	bindVars []map[string]*querypb.BindVariable,
-	options *querypb.ExecuteOptions,
+	session *SafeSession,
 	callback func(reply *sqltypes.Result) error,
 ) error {
 	// mu protects fieldSent, callback and replyErr
 	var mu sync.Mutex
 	fieldSent := false
 
-	allErrors := stc.multiGo("StreamExecute", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+	var options *querypb.ExecuteOptions
+	if session != nil && session.Session != nil {
+		options = session.Options
+	}
+	slots := stc.newScatterSlots(ctx, session, len(rss))
+	allErrors := stc.multiGo("StreamExecute", rss, slots, func(rs *srvtopo.ResolvedShard, i int, turn *scatterTurn) error {
 		return rs.Gateway.StreamExecute(ctx, rs.Target, query, bindVars[i], 0, options, func(qr *sqltypes.Result) error {
-			return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+			return turn.yield(func() error {
+				return stc.processOneStreamingResult(&mu, &fieldSent, qr, callback)
+			})
 		})
 	})
 	return allErrors.AggrError(vterrors.Aggregate)
@@ -416,7 +535,8 @@ func (stc *ScatterConn) MessageStream(ctx context.Context, rss []*srvtopo.Resolv
 	var mu sync.Mutex
 	fieldSent := false
 	lastErrors := newTimeTracker()
-	allErrors := stc.multiGo("MessageStream", rss, func(rs *srvtopo.ResolvedShard, i int) error {
+	// Message streams do not end: they are not limited like scatter queries.
+	allErrors := stc.multiGo("MessageStream", rss, nil, func(rs *srvtopo.ResolvedShard, i int, turn *scatterTurn) error {
 		// This loop handles the case where a reparent happens, which can cause
 		// an individual stream to end. If we don't succeed on the retries for
 		// messageStreamGracePeriod, we abort and return an error.
@@ -491,6 +611,7 @@ func (stc *ScatterConn) GetHealthCheckCacheStatus() discovery.TabletsCacheStatus
 func (stc *ScatterConn) multiGo(
 	name string,
 	rss []*srvtopo.ResolvedShard,
+	slots *scatterSlots,
 	action shardActionFunc,
 ) (allErrors *concurrency.AllErrorRecorder) {
 	allErrors = new(concurrency.AllErrorRecorder)
@@ -504,7 +625,13 @@ func (stc *ScatterConn) multiGo(
 		// Send a dummy session.
 		// TODO(sougou): plumb a real session through this call.
 		defer stc.endAction(startTime, allErrors, statsKey, &err, NewSafeSession(nil))
-		err = action(rs, i)
+		var turn *scatterTurn
+		if turn, err = slots.acquire(); err != nil {
+			err = NewShardError(err, rs.Target)
+			return
+		}
+		defer turn.done()
+		err = action(rs, i, turn)
 	}
 
 	if len(rss) == 1 {
@@ -550,10 +677,17 @@ func (stc *ScatterConn) multiGoTransaction(
 	if numShards == 0 {
 		return allErrors
 	}
+	slots := stc.newScatterSlots(ctx, session, numShards)
 	oneShard := func(rs *srvtopo.ResolvedShard, i int) {
 		var err error
 		startTime, statsKey := stc.startAction(name, rs.Target)
 		defer stc.endAction(startTime, allErrors, statsKey, &err, session)
+		turn, err := slots.acquire()
+		if err != nil {
+			err = NewShardError(err, rs.Target)
+			return
+		}
+		defer turn.done()
 
 		shardActionInfo := actionInfo(rs.Target, session, autocommit)
 		updated, err := action(rs, i, shardActionInfo)
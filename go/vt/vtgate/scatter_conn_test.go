@@ -17,6 +17,7 @@ limitations under the License.
 package vtgate
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -33,6 +34,7 @@ import (
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
 )
@@ -102,6 +104,49 @@ func TestExecuteFailOnAutocommit(t *testing.T) {
 	utils.MustMatch(t, []*querypb.BoundQuery{queries[1]}, sbc1.Queries, "")
 }
 
+func TestScatterConnErrorParts(t *testing.T) {
+	keyspace := "TestScatterConnErrorParts"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, keyspace, "1", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbc0.MustFailCodes[vtrpcpb.Code_INVALID_ARGUMENT] = 1
+	sbc1.MustFailCodes[vtrpcpb.Code_RESOURCE_EXHAUSTED] = 1
+
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	destinations := []key.Destination{key.DestinationShard("0"), key.DestinationShard("1")}
+	err := executeOnShardsReturnsErr(t, res, keyspace, sc, NewSafeSession(nil), destinations)
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+
+	parts := vterrors.Parts(err)
+	require.Len(t, parts, 2)
+	for i, want := range []vtrpcpb.Code{vtrpcpb.Code_INVALID_ARGUMENT, vtrpcpb.Code_RESOURCE_EXHAUSTED} {
+		assert.Equal(t, keyspace, parts[i].Keyspace)
+		assert.Equal(t, fmt.Sprint(i), parts[i].Shard)
+		assert.Equal(t, "replica", parts[i].TabletType)
+		assert.Equal(t, want, parts[i].Code)
+	}
+}
+
+func TestScatterConnRecordsWarnings(t *testing.T) {
+	keyspace := "TestScatterConnRecordsWarnings"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "0", 1, keyspace, "0", topodatapb.TabletType_REPLICA, true, 1, nil)
+	sbc1 := hc.AddTestTablet("aa", "1", 1, keyspace, "1", topodatapb.TabletType_REPLICA, true, 1, nil)
+	warning := &querypb.QueryWarning{Code: mysql.ERUnknownError, Message: "result truncated"}
+	sbc0.SetResults([]*sqltypes.Result{{Warnings: []*querypb.QueryWarning{warning}}})
+	sbc1.SetResults([]*sqltypes.Result{{}})
+
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	session := NewSafeSession(nil)
+	executeOnShards(t, res, keyspace, sc, session, []key.Destination{key.DestinationShard("0"), key.DestinationShard("1")})
+	utils.MustMatch(t, []*querypb.QueryWarning{warning}, session.GetWarnings(), "")
+}
+
 func TestReservedOnMultiReplica(t *testing.T) {
 	keyspace := "keyspace"
 	createSandbox(keyspace)
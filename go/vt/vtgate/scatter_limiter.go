@@ -0,0 +1,350 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	scatterMaxConcurrency           = flag.Int("scatter_max_concurrency", 0, "Maximum number of shards that the scatter queries of this vtgate execute on concurrently. When it is reached, the sessions take turns to execute their queries. 0 means unlimited.")
+	scatterMaxConcurrencyPerSession = flag.Int("scatter_max_concurrency_per_session", 0, "Maximum number of shards that the scatter queries of a session execute on concurrently. 0 means unlimited.")
+	scatterMaxConcurrencyPerQuery   = flag.Int("scatter_max_concurrency_per_query", 0, "Maximum number of shards that a single scatter query executes on concurrently. 0 means unlimited.")
+	scatterQueueTimeout             = flag.Duration("scatter_queue_timeout", 0, "Maximum time that a scatter query waits for its turn to execute on a shard, when scatter_max_concurrency or scatter_max_concurrency_per_session is reached. 0 means that it waits as long as its context allows.")
+)
+
+// scatterLimiter limits how many shards the scatter queries execute on
+// concurrently, for all the sessions and for each session. When a limit
+// is reached, the queries wait for their turn, and the sessions take
+// turns: a session with many waiting queries does not delay the queries
+// of the other sessions.
+type scatterLimiter struct {
+	maxConcurrency int
+	maxPerSession  int
+	queueTimeout   time.Duration
+	waits          *stats.Timings
+
+	mu       sync.Mutex
+	inUse    int
+	queued   int
+	sessions map[string]*scatterSession
+	// turns are the sessions with waiting queries, in the order in which
+	// they get their next slot.
+	turns []*scatterSession
+}
+
+type scatterSession struct {
+	key     string
+	inUse   int
+	waiters []*scatterWaiter
+}
+
+type scatterWaiter struct {
+	ready chan struct{}
+	// granted is protected by scatterLimiter.mu.
+	granted bool
+}
+
+// newScatterLimiter returns nil if the scatter queries are not limited.
+func newScatterLimiter(statsName string, maxConcurrency, maxPerSession int, queueTimeout time.Duration) *scatterLimiter {
+	if maxConcurrency <= 0 && maxPerSession <= 0 {
+		return nil
+	}
+	waitsName, queuedName := "", ""
+	if statsName != "" {
+		waitsName = statsName + "QueueWaits"
+		queuedName = statsName + "Queued"
+	}
+	sl := &scatterLimiter{
+		maxConcurrency: maxConcurrency,
+		maxPerSession:  maxPerSession,
+		queueTimeout:   queueTimeout,
+		waits:          stats.NewTimings(waitsName, "Time scatter queries waited for their turn to execute on a shard", "Result"),
+		sessions:       make(map[string]*scatterSession),
+	}
+	stats.NewGaugeFunc(queuedName, "Number of scatter queries waiting for their turn to execute on a shard", sl.Queued)
+	return sl
+}
+
+// Queued returns the number of waiting queries.
+func (sl *scatterLimiter) Queued() int64 {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	return int64(sl.queued)
+}
+
+// acquire waits for a slot for the session, and returns the function
+// that releases it. It fails if ctx is done, or if the queue timeout
+// expires, first.
+func (sl *scatterLimiter) acquire(ctx context.Context, sessionKey string) (func(), error) {
+	sl.mu.Lock()
+	session := sl.sessions[sessionKey]
+	if session == nil {
+		session = &scatterSession{key: sessionKey}
+		sl.sessions[sessionKey] = session
+	}
+	if len(session.waiters) == 0 && sl.hasSlotLocked(session) {
+		sl.grantLocked(session)
+		sl.mu.Unlock()
+		return func() { sl.release(session) }, nil
+	}
+	w := &scatterWaiter{ready: make(chan struct{})}
+	if len(session.waiters) == 0 {
+		sl.turns = append(sl.turns, session)
+	}
+	session.waiters = append(session.waiters, w)
+	sl.queued++
+	sl.mu.Unlock()
+
+	start := time.Now()
+	var timeout <-chan time.Time
+	if sl.queueTimeout > 0 {
+		timer := time.NewTimer(sl.queueTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	var err error
+	select {
+	case <-w.ready:
+		sl.waits.Record("Acquired", start)
+		return func() { sl.release(session) }, nil
+	case <-ctx.Done():
+		sl.waits.Record("Canceled", start)
+		err = vterrors.Errorf(vterrors.Code(ctx.Err()), "scatter query waiting for its turn to execute on a shard: %v", ctx.Err())
+	case <-timeout:
+		sl.waits.Record("Timeout", start)
+		err = vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "scatter query waited %v for its turn to execute on a shard", sl.queueTimeout)
+	}
+
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	if w.granted {
+		// The slot was granted while giving up: hand it to the next waiter.
+		sl.releaseLocked(session)
+	} else {
+		sl.removeWaiterLocked(session, w)
+	}
+	return nil, vterrors.WithApplied(err, vterrors.NotApplied)
+}
+
+func (sl *scatterLimiter) release(session *scatterSession) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	sl.releaseLocked(session)
+}
+
+func (sl *scatterLimiter) releaseLocked(session *scatterSession) {
+	sl.inUse--
+	session.inUse--
+	sl.dispatchLocked()
+	sl.forgetLocked(session)
+}
+
+func (sl *scatterLimiter) hasSlotLocked(session *scatterSession) bool {
+	if sl.maxConcurrency > 0 && sl.inUse >= sl.maxConcurrency {
+		return false
+	}
+	return sl.maxPerSession <= 0 || session.inUse < sl.maxPerSession
+}
+
+func (sl *scatterLimiter) grantLocked(session *scatterSession) {
+	sl.inUse++
+	session.inUse++
+}
+
+// dispatchLocked grants the free slots to the waiting queries, one session
+// at a time. A session that gets a slot goes to the back of the turns.
+func (sl *scatterLimiter) dispatchLocked() {
+	for {
+		next := -1
+		for i, session := range sl.turns {
+			if sl.hasSlotLocked(session) {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return
+		}
+		session := sl.turns[next]
+		w := session.waiters[0]
+		session.waiters = session.waiters[1:]
+		sl.queued--
+		sl.grantLocked(session)
+		w.granted = true
+		close(w.ready)
+
+		sl.turns = append(sl.turns[:next], sl.turns[next+1:]...)
+		if len(session.waiters) != 0 {
+			sl.turns = append(sl.turns, session)
+		}
+	}
+}
+
+func (sl *scatterLimiter) removeWaiterLocked(session *scatterSession, w *scatterWaiter) {
+	for i, waiter := range session.waiters {
+		if waiter == w {
+			session.waiters = append(session.waiters[:i], session.waiters[i+1:]...)
+			sl.queued--
+			break
+		}
+	}
+	if len(session.waiters) == 0 {
+		for i, s := range sl.turns {
+			if s == session {
+				sl.turns = append(sl.turns[:i], sl.turns[i+1:]...)
+				break
+			}
+		}
+	}
+	sl.forgetLocked(session)
+}
+
+func (sl *scatterLimiter) forgetLocked(session *scatterSession) {
+	if session.inUse == 0 && len(session.waiters) == 0 {
+		delete(sl.sessions, session.key)
+	}
+}
+
+// scatterSlots limits the concurrency of a single scatter query: how many
+// shards it executes on concurrently, and its turns with the other
+// sessions in the scatterLimiter.
+type scatterSlots struct {
+	ctx        context.Context
+	limiter    *scatterLimiter
+	sessionKey string
+	// query holds a token for each shard the query executes on, if the
+	// concurrency of the query is limited.
+	query chan struct{}
+}
+
+// newScatterSlots returns nil if the query is not limited.
+func (stc *ScatterConn) newScatterSlots(ctx context.Context, session *SafeSession, numShards int) *scatterSlots {
+	if numShards <= 1 {
+		return nil
+	}
+	var query chan struct{}
+	if stc.maxConcurrencyPerQuery > 0 && numShards > stc.maxConcurrencyPerQuery {
+		query = make(chan struct{}, stc.maxConcurrencyPerQuery)
+	}
+	if query == nil && stc.limiter == nil {
+		return nil
+	}
+	return &scatterSlots{
+		ctx:        ctx,
+		limiter:    stc.limiter,
+		sessionKey: scatterSessionKey(ctx, session),
+		query:      query,
+	}
+}
+
+// scatterTurn is the turn of a scatter query to execute on a shard.
+type scatterTurn struct {
+	slots   *scatterSlots
+	release func()
+}
+
+// acquire waits for the turn of the query to execute on a shard. The turn
+// must be released with done. It returns a nil turn if the query is not
+// limited.
+func (ss *scatterSlots) acquire() (*scatterTurn, error) {
+	if ss == nil {
+		return nil, nil
+	}
+	if ss.query != nil {
+		select {
+		case ss.query <- struct{}{}:
+		case <-ss.ctx.Done():
+			err := vterrors.Errorf(vterrors.Code(ss.ctx.Err()), "scatter query waiting for its turn to execute on a shard: %v", ss.ctx.Err())
+			return nil, vterrors.WithApplied(err, vterrors.NotApplied)
+		}
+	}
+	turn := &scatterTurn{slots: ss}
+	if ss.limiter != nil {
+		var err error
+		if turn.release, err = ss.limiter.acquire(ss.ctx, ss.sessionKey); err != nil {
+			if ss.query != nil {
+				<-ss.query
+			}
+			return nil, err
+		}
+	}
+	return turn, nil
+}
+
+// done releases the turn.
+func (t *scatterTurn) done() {
+	if t == nil {
+		return
+	}
+	if t.release != nil {
+		t.release()
+		t.release = nil
+	}
+	if t.slots.query != nil {
+		<-t.slots.query
+	}
+}
+
+// yield gives the slot of the turn in the scatterLimiter back while fn
+// runs, and waits for a new one afterwards. The streaming queries yield
+// while they call back: the callback can execute other scatter queries
+// of the same session, such as the right side of a streaming join, which
+// would otherwise wait for the slot that the query holds.
+func (t *scatterTurn) yield(fn func() error) error {
+	if t == nil || t.release == nil {
+		return fn()
+	}
+	t.release()
+	t.release = nil
+	err := fn()
+	release, acquireErr := t.slots.limiter.acquire(t.slots.ctx, t.slots.sessionKey)
+	if acquireErr != nil {
+		if err != nil {
+			return err
+		}
+		return acquireErr
+	}
+	t.release = release
+	return err
+}
+
+// scatterSessionKey identifies the session of a scatter query: by its
+// UUID, or else by its caller.
+func scatterSessionKey(ctx context.Context, session *SafeSession) string {
+	if session != nil && session.Session != nil {
+		if uuid := session.GetSessionUUID(); uuid != "" {
+			return uuid
+		}
+	}
+	if ef := callerid.EffectiveCallerIDFromContext(ctx); ef != nil {
+		return "effective:" + ef.Principal
+	}
+	if im := callerid.ImmediateCallerIDFromContext(ctx); im != nil {
+		return "immediate:" + im.Username
+	}
+	return ""
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func waitForQueued(t *testing.T, sl *scatterLimiter, want int64) {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		if sl.Queued() == want {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("Queued: %d, want %d", sl.Queued(), want)
+}
+
+func TestScatterLimiterFairness(t *testing.T) {
+	assert.Nil(t, newScatterLimiter("", 0, 0, 0))
+
+	sl := newScatterLimiter("", 1, 0, 0)
+	release, err := sl.acquire(ctx, "a")
+	require.NoError(t, err)
+
+	// Session a queues three queries, then session b queues one.
+	order := make(chan string, 4)
+	queue := func(key string) {
+		go func() {
+			release, err := sl.acquire(ctx, key)
+			if !assert.NoError(t, err) {
+				order <- ""
+				return
+			}
+			order <- key
+			release()
+		}()
+	}
+	for i := 0; i < 3; i++ {
+		queue("a")
+		waitForQueued(t, sl, int64(i+1))
+	}
+	queue("b")
+	waitForQueued(t, sl, 4)
+
+	// The sessions take turns.
+	release()
+	var got []string
+	for i := 0; i < 4; i++ {
+		got = append(got, <-order)
+	}
+	assert.Equal(t, []string{"a", "b", "a", "a"}, got)
+	assert.EqualValues(t, 0, sl.Queued())
+	assert.Empty(t, sl.sessions)
+	assert.Zero(t, sl.inUse)
+}
+
+func TestScatterLimiterPerSession(t *testing.T) {
+	sl := newScatterLimiter("", 0, 1, 10*time.Millisecond)
+	release, err := sl.acquire(ctx, "a")
+	require.NoError(t, err)
+
+	// Other sessions are not limited by session a.
+	releaseB, err := sl.acquire(ctx, "b")
+	require.NoError(t, err)
+	releaseB()
+
+	_, err = sl.acquire(ctx, "a")
+	assert.EqualError(t, err, "scatter query waited 10ms for its turn to execute on a shard")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.True(t, vterrors.IsSafeToRetryWrites(err))
+	assert.EqualValues(t, 0, sl.Queued())
+
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	sl.queueTimeout = 0
+	_, err = sl.acquire(cancelCtx, "a")
+	assert.Equal(t, vtrpcpb.Code_CANCELED, vterrors.Code(err))
+	assert.EqualValues(t, 0, sl.Queued())
+
+	release()
+	assert.Empty(t, sl.sessions)
+}
+
+func TestScatterConnLimits(t *testing.T) {
+	keyspace := "TestScatterConnLimits"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	var destinations []key.Destination
+	for i := 0; i < 4; i++ {
+		shard := fmt.Sprint(i)
+		hc.AddTestTablet("aa", shard, 1, keyspace, shard, topodatapb.TabletType_REPLICA, true, 1, nil)
+		destinations = append(destinations, key.DestinationShard(shard))
+	}
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	session := NewSafeSession(&vtgatepb.Session{SessionUUID: "uuid"})
+
+	// A query limited to one shard at a time executes on all its shards.
+	sc.maxConcurrencyPerQuery = 1
+	sc.limiter = newScatterLimiter("", 2, 2, 10*time.Millisecond)
+	err := executeOnShardsReturnsErr(t, res, keyspace, sc, session, destinations)
+	require.NoError(t, err)
+
+	// The query does not get its turn: all its shards fail.
+	release, err := sc.limiter.acquire(ctx, "uuid")
+	require.NoError(t, err)
+	release2, err := sc.limiter.acquire(ctx, "other")
+	require.NoError(t, err)
+	err = executeOnShardsReturnsErr(t, res, keyspace, sc, session, destinations)
+	require.Error(t, err)
+	parts := vterrors.Parts(err)
+	require.Len(t, parts, 4)
+	for i, part := range parts {
+		assert.Equal(t, fmt.Sprint(i), part.Shard)
+		assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, part.Code)
+	}
+	release()
+	release2()
+
+	// Single shard queries are not limited.
+	release, err = sc.limiter.acquire(ctx, "uuid")
+	require.NoError(t, err)
+	release2, err = sc.limiter.acquire(ctx, "uuid")
+	require.NoError(t, err)
+	err = executeOnShardsReturnsErr(t, res, keyspace, sc, session, destinations[:1])
+	require.NoError(t, err)
+	release()
+	release2()
+}
+
+func TestScatterConnLimitsNestedStream(t *testing.T) {
+	keyspace := "TestScatterConnLimitsNestedStream"
+	createSandbox(keyspace)
+	hc := discovery.NewFakeHealthCheck()
+	sc := newTestScatterConn(hc, new(sandboxTopo), "aa")
+	var destinations []key.Destination
+	for i := 0; i < 2; i++ {
+		shard := fmt.Sprint(i)
+		hc.AddTestTablet("aa", shard, 1, keyspace, shard, topodatapb.TabletType_REPLICA, true, 1, nil)
+		destinations = append(destinations, key.DestinationShard(shard))
+	}
+	res := srvtopo.NewResolver(&sandboxTopo{}, sc.gateway, "aa")
+	session := NewSafeSession(&vtgatepb.Session{SessionUUID: "uuid"})
+	sc.limiter = newScatterLimiter("", 0, 2, 100*time.Millisecond)
+
+	// The callback of a streaming scatter executes another scatter of the
+	// same session, as the right side of a streaming join does: the slots
+	// of the streaming query are given back while it calls back.
+	rss, _, err := res.ResolveDestinations(ctx, keyspace, topodatapb.TabletType_REPLICA, nil, destinations)
+	require.NoError(t, err)
+	bvs := []map[string]*querypb.BindVariable{{}, {}}
+	calls := 0
+	err = sc.StreamExecuteMulti(ctx, "query", rss, bvs, session, func(*sqltypes.Result) error {
+		calls++
+		return executeOnShardsReturnsErr(t, res, keyspace, sc, session, destinations)
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, calls)
+	assert.Empty(t, sc.limiter.sessions)
+	assert.Zero(t, sc.limiter.inUse)
+}
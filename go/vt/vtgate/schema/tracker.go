@@ -0,0 +1,270 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+const (
+	// mysqlSchemaQuery loads the columns of all the tables of the database.
+	mysqlSchemaQuery = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() order by table_name, ordinal_position"
+
+	// mysqlTablesQuery loads the columns of the given tables.
+	mysqlTablesQuery = "select table_name, column_name, data_type, column_type from information_schema.columns where table_schema = database() and table_name in ::tableNames order by table_name, ordinal_position"
+)
+
+// Tracker keeps the authoritative column list of the tables of every
+// keyspace. The full schema of a keyspace is loaded from its first serving
+// master tablet, and the tables listed in the schema change signals of
+// the master health messages are reloaded.
+type Tracker struct {
+	ch     chan *discovery.TabletHealth
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu sync.Mutex
+	// tables maps a keyspace to the columns of its tables.
+	tables map[string]map[string][]vindexes.Column
+	// pending contains the updates that are not loaded yet, by keyspace.
+	pending map[string]*pendingUpdate
+	work    chan struct{}
+	signal  func()
+}
+
+// pendingUpdate is a schema load to perform for a keyspace.
+type pendingUpdate struct {
+	th *discovery.TabletHealth
+	// full is true if the whole schema must be loaded.
+	full   bool
+	tables map[string]bool
+}
+
+// NewTracker creates a tracker that consumes the health messages of ch.
+func NewTracker(ch chan *discovery.TabletHealth) *Tracker {
+	return &Tracker{
+		ch:      ch,
+		tables:  make(map[string]map[string][]vindexes.Column),
+		pending: make(map[string]*pendingUpdate),
+		work:    make(chan struct{}, 1),
+	}
+}
+
+// RegisterSignalReceiver sets the function called after every schema
+// change. It must be called before Start.
+func (t *Tracker) RegisterSignalReceiver(f func()) {
+	t.signal = f
+}
+
+// Start starts consuming the health messages.
+func (t *Tracker) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	t.cancel = cancel
+	t.wg.Add(2)
+	go t.consume(ctx)
+	go t.load(ctx)
+}
+
+// Stop stops the tracker.
+func (t *Tracker) Stop() {
+	if t.cancel == nil {
+		return
+	}
+	t.cancel()
+	t.wg.Wait()
+	t.cancel = nil
+}
+
+// Tables returns the columns of the tables of the keyspace. The result
+// must not be modified.
+func (t *Tracker) Tables(ks string) map[string][]vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[ks]
+}
+
+// GetColumns returns the columns of the table, or nil if the table is
+// unknown.
+func (t *Tracker) GetColumns(ks, tbl string) []vindexes.Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.tables[ks][tbl]
+}
+
+// consume reads the health messages and records the updates to perform.
+// The loads are done by a separate goroutine so that the health check
+// never drops messages because the tracker is busy querying a tablet.
+func (t *Tracker) consume(ctx context.Context) {
+	defer t.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case th := <-t.ch:
+			if t.addPending(th) {
+				select {
+				case t.work <- struct{}{}:
+				default:
+				}
+			}
+		}
+	}
+}
+
+// addPending records the update required by the health message, and
+// returns true if there is one.
+func (t *Tracker) addPending(th *discovery.TabletHealth) bool {
+	if th.Target == nil || th.Target.TabletType != topodatapb.TabletType_MASTER || !th.Serving {
+		return false
+	}
+	ks := th.Target.Keyspace
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	_, loaded := t.tables[ks]
+	changed := th.Stats.GetTableSchemaChanged()
+	if loaded && len(changed) == 0 {
+		return false
+	}
+	pu := t.pending[ks]
+	if pu == nil {
+		pu = &pendingUpdate{tables: make(map[string]bool)}
+		t.pending[ks] = pu
+	}
+	pu.th = th
+	if !loaded {
+		pu.full = true
+	}
+	for _, tbl := range changed {
+		pu.tables[tbl] = true
+	}
+	return true
+}
+
+// load performs the pending updates.
+func (t *Tracker) load(ctx context.Context) {
+	defer t.wg.Done()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.work:
+		}
+
+		t.mu.Lock()
+		pending := t.pending
+		t.pending = make(map[string]*pendingUpdate)
+		t.mu.Unlock()
+
+		updated := false
+		for ks, pu := range pending {
+			if err := t.loadKeyspace(ctx, ks, pu); err != nil {
+				// The keyspace stays unloaded, or the tables are
+				// reloaded with the next change signal.
+				log.Warningf("Unable to load the schema of keyspace %v from %v: %v", ks, pu.th.Target.Shard, err)
+				continue
+			}
+			updated = true
+		}
+		if updated && t.signal != nil {
+			t.signal()
+		}
+	}
+}
+
+// loadKeyspace loads the schema of the keyspace, or of the updated tables.
+func (t *Tracker) loadKeyspace(ctx context.Context, ks string, pu *pendingUpdate) error {
+	query := mysqlSchemaQuery
+	var bindVars map[string]*querypb.BindVariable
+	if !pu.full {
+		names := make([]string, 0, len(pu.tables))
+		for tbl := range pu.tables {
+			names = append(names, tbl)
+		}
+		tableNames, err := sqltypes.BuildBindVariable(names)
+		if err != nil {
+			return err
+		}
+		query = mysqlTablesQuery
+		bindVars = map[string]*querypb.BindVariable{"tableNames": tableNames}
+	}
+	qr, err := pu.th.Conn.Execute(ctx, pu.th.Target, query, bindVars, 0, 0, nil)
+	if err != nil {
+		return err
+	}
+	loaded := columnsFromResult(qr)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	tables := make(map[string][]vindexes.Column)
+	if !pu.full {
+		for tbl, columns := range t.tables[ks] {
+			if !pu.tables[tbl] {
+				tables[tbl] = columns
+			}
+		}
+	}
+	for tbl, columns := range loaded {
+		tables[tbl] = columns
+	}
+	// The map is replaced rather than updated because Tables returns it
+	// to callers that read it without the lock.
+	t.tables[ks] = tables
+	return nil
+}
+
+// columnsFromResult converts the rows of the schema queries to the
+// columns of every table.
+func columnsFromResult(qr *sqltypes.Result) map[string][]vindexes.Column {
+	tables := make(map[string][]vindexes.Column)
+	for _, row := range qr.Rows {
+		tbl := row[0].ToString()
+		tables[tbl] = append(tables[tbl], vindexes.Column{
+			Name: sqlparser.NewColIdent(row[1].ToString()),
+			Type: sqlType(row[2].ToString(), row[3].ToString()),
+		})
+	}
+	return tables
+}
+
+// sqlType returns the type of a column from its information_schema data
+// type and column type. Types unknown to the parser are returned as
+// NULL_TYPE, which the planner treats as an unknown type.
+func sqlType(dataType, columnType string) (typ querypb.Type) {
+	defer func() {
+		if recover() != nil {
+			typ = sqltypes.Null
+		}
+	}()
+	ct := &sqlparser.ColumnType{
+		Type:     dataType,
+		Unsigned: strings.Contains(strings.ToLower(columnType), "unsigned"),
+	}
+	return ct.SQLType()
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestTracker(t *testing.T) {
+	fields := sqltypes.MakeTestFields("table_name|column_name|data_type|column_type", "varchar|varchar|varchar|varchar")
+	sbc := sandboxconn.NewSandboxConn(&topodatapb.Tablet{Keyspace: "ks", Shard: "-80"})
+	sbc.SetResults([]*sqltypes.Result{
+		sqltypes.MakeTestResult(fields,
+			"t1|id|int|int(11)",
+			"t1|name|varchar|varchar(255)",
+			"t3|x|bigint|bigint(20) unsigned",
+		),
+		sqltypes.MakeTestResult(fields,
+			"t2|id|bigint|bigint(20)",
+		),
+	})
+
+	ch := make(chan *discovery.TabletHealth)
+	signal := make(chan struct{}, 1)
+	tracker := NewTracker(ch)
+	tracker.RegisterSignalReceiver(func() { signal <- struct{}{} })
+	tracker.Start()
+	defer tracker.Stop()
+
+	master := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_MASTER}
+	replica := &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_REPLICA}
+
+	// Replicas and non-serving masters are ignored.
+	ch <- &discovery.TabletHealth{Conn: sbc, Target: replica, Serving: true, Stats: &querypb.RealtimeStats{}}
+	ch <- &discovery.TabletHealth{Conn: sbc, Target: master, Serving: false, Stats: &querypb.RealtimeStats{}}
+
+	// The first serving master loads the full schema.
+	ch <- &discovery.TabletHealth{Conn: sbc, Target: master, Serving: true, Stats: &querypb.RealtimeStats{}}
+	waitForSignal(t, signal)
+	require.Len(t, sbc.Queries, 1)
+	assert.Equal(t, mysqlSchemaQuery, sbc.Queries[0].Sql)
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int32},
+		{Name: sqlparser.NewColIdent("name"), Type: sqltypes.VarChar},
+	}, tracker.GetColumns("ks", "t1"))
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("x"), Type: sqltypes.Uint64},
+	}, tracker.GetColumns("ks", "t3"))
+
+	// Messages without schema changes do not reload anything.
+	ch <- &discovery.TabletHealth{Conn: sbc, Target: master, Serving: true, Stats: &querypb.RealtimeStats{}}
+
+	// Changed tables are reloaded, and dropped tables are removed.
+	ch <- &discovery.TabletHealth{Conn: sbc, Target: master, Serving: true, Stats: &querypb.RealtimeStats{
+		TableSchemaChanged: []string{"t2", "t3"},
+	}}
+	waitForSignal(t, signal)
+	require.Len(t, sbc.Queries, 2)
+	assert.Equal(t, mysqlTablesQuery, sbc.Queries[1].Sql)
+	assert.Contains(t, sbc.Queries[1].BindVariables, "tableNames")
+	tables := tracker.Tables("ks")
+	assert.Len(t, tables, 2)
+	assert.Len(t, tables["t1"], 2)
+	assert.Equal(t, []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64},
+	}, tables["t2"])
+	assert.Nil(t, tracker.GetColumns("ks", "t3"))
+	assert.Nil(t, tracker.Tables("other"))
+}
+
+func TestSQLType(t *testing.T) {
+	assert.Equal(t, sqltypes.Int32, sqlType("int", "int(11)"))
+	assert.Equal(t, sqltypes.Uint32, sqlType("INT", "INT(10) UNSIGNED"))
+	assert.Equal(t, sqltypes.Null, sqlType("unknowntype", "unknowntype"))
+}
+
+func waitForSignal(t *testing.T, signal chan struct{}) {
+	t.Helper()
+	select {
+	case <-signal:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the schema change signal")
+	}
+}
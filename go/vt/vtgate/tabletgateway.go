@@ -42,6 +42,12 @@ import (
 
 const (
 	tabletGatewayImplementation = "tabletgateway"
+
+	// randomRoutingPolicy picks a random healthy tablet, preferring the local cell.
+	randomRoutingPolicy = "random"
+	// lagAwareRoutingPolicy picks the healthy tablet with the lowest replication
+	// lag and the fewest outstanding queries, preferring the local cell.
+	lagAwareRoutingPolicy = "lag_aware"
 )
 
 func init() {
@@ -52,8 +58,30 @@ var (
 	_ discovery.HealthCheck = (*discovery.HealthCheckImpl)(nil)
 	// CellsToWatch is the list of cells the healthcheck operates over. If it is empty, only the local cell is watched
 	CellsToWatch = flag.String("cells_to_watch", "", "comma-separated list of cells for watching tablets")
+
+	tabletRoutingPolicy               = flag.String("tablet_routing_policy", randomRoutingPolicy, "how to pick a replica or rdonly tablet among the healthy ones: random, or lag_aware to prefer the tablets with the lowest replication lag and the fewest outstanding queries")
+	lagAwareRoutingQueryCost          = flag.Duration("lag_aware_routing_query_cost", 10*time.Millisecond, "with -tablet_routing_policy=lag_aware, how much replication lag one outstanding query on a tablet is worth when comparing tablets")
+	maxReplicationLagFallbackToMaster = flag.Bool("max_replication_lag_fallback_to_master", false, "if true, replica reads of a session with max_replication_lag set go to the master when no replica is within that lag. Otherwise they fail")
 )
 
+// maxReplicationLagKey is the context key for the max_replication_lag of the session.
+type maxReplicationLagKey struct{}
+
+// withMaxReplicationLag returns a context carrying the max_replication_lag of
+// the session, which the TabletGateway enforces on replica reads.
+func withMaxReplicationLag(ctx context.Context, lag time.Duration) context.Context {
+	if lag <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, maxReplicationLagKey{}, lag)
+}
+
+// maxReplicationLagFromContext returns the max_replication_lag stored in the context, or 0.
+func maxReplicationLagFromContext(ctx context.Context) time.Duration {
+	lag, _ := ctx.Value(maxReplicationLagKey{}).(time.Duration)
+	return lag
+}
+
 // TabletGateway implements the Gateway interface.
 // This implementation uses the new healthcheck module.
 type TabletGateway struct {
@@ -62,6 +90,12 @@ type TabletGateway struct {
 	srvTopoServer srvtopo.Server
 	localCell     string
 	retryCount    int
+	routingPolicy string
+
+	// outstandingMu protects outstanding.
+	outstandingMu sync.Mutex
+	// outstanding is the number of queries in flight per tablet alias.
+	outstanding map[string]int64
 
 	// mu protects the fields of this group.
 	mu sync.Mutex
@@ -98,11 +132,18 @@ func NewTabletGateway(ctx context.Context, hc discovery.HealthCheck, serv srvtop
 
 	}
 	vtgateHealthCheck = hc
+	routingPolicy := *tabletRoutingPolicy
+	if routingPolicy != randomRoutingPolicy && routingPolicy != lagAwareRoutingPolicy {
+		log.Warningf("Unknown -tablet_routing_policy %q, using %q", routingPolicy, randomRoutingPolicy)
+		routingPolicy = randomRoutingPolicy
+	}
 	gw := &TabletGateway{
 		hc:                hc,
 		srvTopoServer:     serv,
 		localCell:         localCell,
 		retryCount:        *RetryCount,
+		routingPolicy:     routingPolicy,
+		outstanding:       make(map[string]int64),
 		statusAggregators: make(map[string]*TabletStatusAggregator),
 		buffer:            buffer.New(),
 	}
@@ -212,9 +253,10 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		// Note: We only buffer once and only "!inTransaction" queries i.e.
 		// a) no transaction is necessary (e.g. critical reads) or
 		// b) no transaction was created yet.
-		if !bufferedOnce && !inTransaction && target.TabletType == topodatapb.TabletType_MASTER {
+		// Reads are only buffered if no other tablet is left to serve them.
+		if !bufferedOnce && !inTransaction && canBuffer(target, invalidTablets, gw.healthyTablets) {
 			// The next call blocks if we should buffer during a failover.
-			retryDone, bufferErr := gw.buffer.WaitForFailoverEnd(ctx, target.Keyspace, target.Shard, err)
+			retryDone, bufferErr := waitForFailoverEnd(ctx, gw.buffer, target, err)
 			if bufferErr != nil {
 				// Buffering failed e.g. buffer is already full. Do not retry.
 				err = vterrors.Errorf(vterrors.Code(bufferErr),
@@ -233,12 +275,31 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		}
 
 		tablets := gw.hc.GetHealthyTabletStats(target)
+		if maxLag := maxReplicationLagFromContext(ctx); maxLag > 0 && isReplicaTarget(target) && len(tablets) > 0 {
+			tablets = filterByMaxReplicationLag(tablets, maxLag)
+			if len(tablets) == 0 {
+				if !*maxReplicationLagFallbackToMaster {
+					err = vterrors.WithApplied(vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no tablet within max_replication_lag %v available for '%s'", maxLag, target.String()), vterrors.NotApplied)
+					break
+				}
+				target = &querypb.Target{
+					Keyspace:   target.Keyspace,
+					Shard:      target.Shard,
+					TabletType: topodatapb.TabletType_MASTER,
+					Cell:       target.Cell,
+				}
+				tablets = gw.hc.GetHealthyTabletStats(target)
+			}
+		}
 		if len(tablets) == 0 {
 			// fail fast if there is no tablet
-			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", target.String())
+			err = vterrors.WithApplied(vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no healthy tablet available for '%s'", target.String()), vterrors.NotApplied)
 			break
 		}
 		gw.shuffleTablets(gw.localCell, tablets)
+		if gw.routingPolicy == lagAwareRoutingPolicy && isReplicaTarget(target) {
+			gw.sortByLagAndLoad(gw.localCell, tablets)
+		}
 
 		var th *discovery.TabletHealth
 		// skip tablets we tried before
@@ -251,7 +312,7 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		if th == nil {
 			// do not override error from last attempt.
 			if err == nil {
-				err = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no available connection")
+				err = vterrors.WithApplied(vterrors.New(vtrpcpb.Code_UNAVAILABLE, "no available connection"), vterrors.NotApplied)
 			}
 			break
 		}
@@ -259,14 +320,17 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 		tabletLastUsed = th.Tablet
 		// execute
 		if th.Conn == nil {
-			err = vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no connection for tablet %v", tabletLastUsed)
+			err = vterrors.WithApplied(vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "no connection for tablet %v", tabletLastUsed), vterrors.NotApplied)
 			invalidTablets[topoproto.TabletAliasString(tabletLastUsed.Alias)] = true
 			continue
 		}
 
 		startTime := time.Now()
 		var canRetry bool
+		aliasKey := topoproto.TabletAliasString(tabletLastUsed.Alias)
+		gw.addOutstanding(aliasKey, 1)
 		canRetry, err = inner(ctx, target, th.Conn)
+		gw.addOutstanding(aliasKey, -1)
 		gw.updateStats(target, startTime, err)
 		if canRetry {
 			invalidTablets[topoproto.TabletAliasString(tabletLastUsed.Alias)] = true
@@ -277,6 +341,16 @@ func (gw *TabletGateway) withRetry(ctx context.Context, target *querypb.Target,
 	return NewShardError(err, target)
 }
 
+// healthyTablets returns the aliases of the healthy tablets of the target,
+// as they are recorded in the invalid tablets of a request.
+func (gw *TabletGateway) healthyTablets(target *querypb.Target) []string {
+	var aliases []string
+	for _, t := range gw.hc.GetHealthyTabletStats(target) {
+		aliases = append(aliases, topoproto.TabletAliasString(t.Tablet.Alias))
+	}
+	return aliases
+}
+
 func (gw *TabletGateway) updateStats(target *querypb.Target, startTime time.Time, err error) {
 	elapsed := time.Since(startTime)
 	aggr := gw.getStatsAggregator(target)
@@ -337,6 +411,68 @@ func (gw *TabletGateway) shuffleTablets(cell string, tablets []*discovery.Tablet
 	}
 }
 
+// isReplicaTarget returns true for the tablet types lag-aware routing applies to.
+func isReplicaTarget(target *querypb.Target) bool {
+	return target.TabletType == topodatapb.TabletType_REPLICA || target.TabletType == topodatapb.TabletType_RDONLY
+}
+
+// filterByMaxReplicationLag returns the tablets whose replication lag is at most maxLag.
+func filterByMaxReplicationLag(tablets []*discovery.TabletHealth, maxLag time.Duration) []*discovery.TabletHealth {
+	res := make([]*discovery.TabletHealth, 0, len(tablets))
+	for _, th := range tablets {
+		if th.Stats != nil && discovery.ReplicationLag(th) <= maxLag {
+			res = append(res, th)
+		}
+	}
+	return res
+}
+
+// addOutstanding changes the number of queries in flight on a tablet.
+func (gw *TabletGateway) addOutstanding(alias string, delta int64) {
+	gw.outstandingMu.Lock()
+	defer gw.outstandingMu.Unlock()
+	if n := gw.outstanding[alias] + delta; n > 0 {
+		gw.outstanding[alias] = n
+	} else {
+		delete(gw.outstanding, alias)
+	}
+}
+
+// sortByLagAndLoad orders the tablets of the given cell first, then by their
+// replication lag plus -lag_aware_routing_query_cost per outstanding query.
+// The sort is stable, so tablets with the same score keep their shuffled order.
+func (gw *TabletGateway) sortByLagAndLoad(cell string, tablets []*discovery.TabletHealth) {
+	type scoredTablet struct {
+		th       *discovery.TabletHealth
+		sameCell bool
+		score    time.Duration
+	}
+	scored := make([]scoredTablet, len(tablets))
+	gw.outstandingMu.Lock()
+	for i, th := range tablets {
+		var lag time.Duration
+		if th.Stats != nil {
+			lag = discovery.ReplicationLag(th)
+		}
+		outstanding := gw.outstanding[topoproto.TabletAliasString(th.Tablet.Alias)]
+		scored[i] = scoredTablet{
+			th:       th,
+			sameCell: th.Tablet.Alias.Cell == cell,
+			score:    lag + time.Duration(outstanding)*(*lagAwareRoutingQueryCost),
+		}
+	}
+	gw.outstandingMu.Unlock()
+	sort.SliceStable(scored, func(i, j int) bool {
+		if scored[i].sameCell != scored[j].sameCell {
+			return scored[i].sameCell
+		}
+		return scored[i].score < scored[j].score
+	})
+	for i := range scored {
+		tablets[i] = scored[i].th
+	}
+}
+
 func (gw *TabletGateway) nextTablet(cell string, tablets []*discovery.TabletHealth, offset, length int, sameCell bool) int {
 	for ; offset < length; offset++ {
 		if (tablets[offset].Tablet.Alias.Cell == cell) == sameCell {
@@ -357,7 +493,7 @@ func NewShardError(in error, target *querypb.Target) error {
 		return nil
 	}
 	if target != nil {
-		return vterrors.Wrapf(in, "target: %s.%s.%s", target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType))
+		return vterrors.WrapShard(in, target.Keyspace, target.Shard, topoproto.TabletTypeLString(target.TabletType))
 	}
 	return in
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -35,6 +36,7 @@ import (
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 )
 
 func TestTabletGatewayExecute(t *testing.T) {
@@ -48,6 +50,34 @@ func TestTabletGatewayExecute(t *testing.T) {
 	})
 }
 
+func TestTabletGatewayExecuteWriteRetry(t *testing.T) {
+	target := &querypb.Target{
+		Keyspace:   "ks",
+		Shard:      "0",
+		TabletType: topodatapb.TabletType_MASTER,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	sc1 := hc.AddTestTablet("cell", "1.1.1.1", 1001, "ks", "0", topodatapb.TabletType_MASTER, true, 10, nil)
+	sc2 := hc.AddTestTablet("cell", "1.1.1.1", 1002, "ks", "0", topodatapb.TabletType_MASTER, true, 10, nil)
+
+	// The write could have been applied: it is not retried.
+	sc1.EphemeralShardErr = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "Server shutdown in progress (errno 1053) (sqlstate 08S01)")
+	sc2.EphemeralShardErr = sc1.EphemeralShardErr
+	_, err := tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "Server shutdown in progress", vtrpcpb.Code_UNAVAILABLE)
+	assert.EqualValues(t, 1, sc1.ExecCount.Get()+sc2.ExecCount.Get())
+
+	// The write was not applied: it is retried on the other tablet.
+	sc1.ExecCount.Set(0)
+	sc2.ExecCount.Set(0)
+	sc1.EphemeralShardErr = vterrors.New(vtrpcpb.Code_UNAVAILABLE, "MySQL server has gone away (errno 2006) (sqlstate HY000)")
+	sc2.EphemeralShardErr = sc1.EphemeralShardErr
+	_, err = tg.Execute(context.Background(), target, "query", nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.EqualValues(t, 2, sc1.ExecCount.Get()+sc2.ExecCount.Get())
+}
+
 func TestTabletGatewayExecuteBatch(t *testing.T) {
 	testTabletGatewayGeneric(t, func(tg *TabletGateway, target *querypb.Target) error {
 		queries := []*querypb.BoundQuery{{Sql: "query", BindVariables: nil}}
@@ -163,6 +193,74 @@ func TestTabletGatewayShuffleTablets(t *testing.T) {
 	}
 }
 
+func TestTabletGatewaySortByLagAndLoad(t *testing.T) {
+	tg := NewTabletGateway(context.Background(), nil, nil, "local")
+
+	newTablet := func(uid uint32, cell string, lagMs int64) *discovery.TabletHealth {
+		return &discovery.TabletHealth{
+			Tablet:  topo.NewTablet(uid, cell, fmt.Sprintf("host%d", uid)),
+			Target:  &querypb.Target{Keyspace: "k", Shard: "s", TabletType: topodatapb.TabletType_REPLICA},
+			Serving: true,
+			Stats:   &querypb.RealtimeStats{ReplicationLagMs: lagMs},
+		}
+	}
+	ts1 := newTablet(1, "cell1", 500)
+	ts2 := newTablet(2, "cell1", 5)
+	ts3 := newTablet(3, "cell2", 0)
+
+	tablets := []*discovery.TabletHealth{ts1, ts2, ts3}
+	tg.sortByLagAndLoad("cell1", tablets)
+	assert.Equal(t, []*discovery.TabletHealth{ts2, ts1, ts3}, tablets)
+
+	// Outstanding queries on the least lagged tablet outweigh its lower lag.
+	for i := 0; i < 50; i++ {
+		tg.addOutstanding(topoproto.TabletAliasString(ts2.Tablet.Alias), 1)
+	}
+	tg.sortByLagAndLoad("cell1", tablets)
+	assert.Equal(t, []*discovery.TabletHealth{ts1, ts2, ts3}, tablets)
+}
+
+func TestTabletGatewayMaxReplicationLag(t *testing.T) {
+	keyspace := "ks"
+	shard := "0"
+	target := &querypb.Target{
+		Keyspace:   keyspace,
+		Shard:      shard,
+		TabletType: topodatapb.TabletType_REPLICA,
+	}
+	hc := discovery.NewFakeHealthCheck()
+	tg := NewTabletGateway(context.Background(), hc, nil, "cell")
+	sbcMaster := hc.AddTestTablet("cell", "1.1.1.1", 1001, keyspace, shard, topodatapb.TabletType_MASTER, true, 10, nil)
+	sbcFresh := hc.AddTestTablet("cell", "1.1.1.1", 1002, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	sbcLagged := hc.AddTestTablet("cell", "1.1.1.1", 1003, keyspace, shard, topodatapb.TabletType_REPLICA, true, 10, nil)
+	for _, th := range hc.GetHealthyTabletStats(target) {
+		if topoproto.TabletAliasEqual(th.Tablet.Alias, sbcLagged.Tablet().Alias) {
+			th.Stats.ReplicationLagMs = 5000
+		} else {
+			th.Stats.ReplicationLagMs = 100
+		}
+	}
+
+	ctx := withMaxReplicationLag(context.Background(), time.Second)
+	for i := 0; i < 10; i++ {
+		_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	assert.EqualValues(t, 10, sbcFresh.ExecCount.Get())
+	assert.EqualValues(t, 0, sbcLagged.ExecCount.Get())
+
+	// No replica is within 50ms.
+	ctx = withMaxReplicationLag(context.Background(), 50*time.Millisecond)
+	_, err := tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	verifyContainsError(t, err, "no tablet within max_replication_lag 50ms", vtrpcpb.Code_UNAVAILABLE)
+
+	*maxReplicationLagFallbackToMaster = true
+	defer func() { *maxReplicationLagFallbackToMaster = false }()
+	_, err = tg.Execute(ctx, target, "query", nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, sbcMaster.ExecCount.Get())
+}
+
 func TestTabletGatewayReplicaTransactionError(t *testing.T) {
 	keyspace := "ks"
 	shard := "0"
@@ -203,6 +301,8 @@ func testTabletGatewayGeneric(t *testing.T, f func(tg *TabletGateway, target *qu
 	want := []string{"target: ks.0.replica", `no healthy tablet available for 'keyspace:"ks" shard:"0" tablet_type:REPLICA`}
 	err := f(tg, target)
 	verifyShardErrors(t, err, want, vtrpcpb.Code_UNAVAILABLE)
+	assert.Equal(t, vterrors.NotApplied, vterrors.WritesApplied(err))
+	assert.True(t, vterrors.IsSafeToRetryWrites(err))
 
 	// tablet with error
 	hc.Reset()
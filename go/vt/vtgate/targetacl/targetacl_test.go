@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package targetacl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+func TestTargetAcl(t *testing.T) {
+	redUser := querypb.VTGateCallerID{Username: "redUser"}
+	yellowUser := querypb.VTGateCallerID{Username: "yellowUser"}
+
+	// By default all users are allowed in
+	Init()
+	assert.True(t, Authorized(&redUser))
+	assert.True(t, Authorized(&yellowUser))
+
+	// Test user list
+	*AuthorizedUsers = "oneUser, twoUser, redUser, blueUser"
+	Init()
+	assert.True(t, Authorized(&redUser))
+	assert.False(t, Authorized(&yellowUser))
+
+	// Test empty list
+	*AuthorizedUsers = ""
+	Init()
+	assert.False(t, Authorized(&redUser))
+	assert.False(t, Authorized(&yellowUser))
+
+	// Revert to baseline state for other tests
+	*AuthorizedUsers = "%"
+	Init()
+	assert.True(t, Authorized(&redUser))
+}
@@ -26,6 +26,7 @@ import (
 
 	"context"
 
+	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/concurrency"
 	"vitess.io/vitess/go/vt/dtids"
 	"vitess.io/vitess/go/vt/log"
@@ -39,6 +40,7 @@ import (
 type TxConn struct {
 	gateway Gateway
 	mode    vtgatepb.TransactionMode
+	replays *txReplayer
 }
 
 // NewTxConn builds a new TxConn.
@@ -46,6 +48,7 @@ func NewTxConn(gw Gateway, txMode vtgatepb.TransactionMode) *TxConn {
 	return &TxConn{
 		gateway: gw,
 		mode:    txMode,
+		replays: newTxReplayer(),
 	}
 }
 
@@ -65,6 +68,7 @@ func (txc *TxConn) Begin(ctx context.Context, session *SafeSession) error {
 // best effort or 2pc depending on the session setting.
 func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	defer session.ResetTx()
+	defer txc.replays.forget(session)
 	if !session.InTransaction() {
 		return nil
 	}
@@ -76,6 +80,10 @@ func (txc *TxConn) Commit(ctx context.Context, session *SafeSession) error {
 	case vtgatepb.TransactionMode_UNSPECIFIED:
 		twopc = txc.mode == vtgatepb.TransactionMode_TWOPC
 	}
+	span, ctx := trace.NewSpan(ctx, "TxConn.Commit")
+	defer span.Finish()
+	span.Annotate("twopc", twopc)
+	span.Annotate("shards", len(session.ShardSessions))
 	if twopc {
 		return txc.commit2PC(ctx, session)
 	}
@@ -193,6 +201,7 @@ func (txc *TxConn) Rollback(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.ResetTx()
+	defer txc.replays.forget(session)
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -228,6 +237,7 @@ func (txc *TxConn) Release(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.Reset()
+	defer txc.replays.forget(session)
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -280,6 +290,7 @@ func (txc *TxConn) ReleaseAll(ctx context.Context, session *SafeSession) error {
 		return nil
 	}
 	defer session.ResetAll()
+	defer txc.replays.forget(session)
 
 	allsessions := append(session.PreSessions, session.ShardSessions...)
 	allsessions = append(allsessions, session.PostSessions...)
@@ -0,0 +1,276 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"context"
+	"flag"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/srvtopo"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+var (
+	transactionReplay              = flag.Bool("transaction_replay", false, "If set, the single-shard transactions of the MySQL protocol sessions that fail because of a failover or a lost connection are replayed on a new transaction, if the replayed statements return the same results as before.")
+	transactionReplayMaxStatements = flag.Int("transaction_replay_max_statements", 100, "Transactions with more statements than this are not replayed.")
+	transactionReplayMaxAttempts   = flag.Int("transaction_replay_max_attempts", 1, "Maximum number of times a transaction is replayed.")
+
+	txReplays = stats.NewCountersWithSingleLabel("TransactionReplays", "Transactions replayed after a failure, by result", "Result")
+)
+
+// txReplayer records the statements of the single-shard transactions, so
+// that they can be replayed on a new transaction if theirs is lost during
+// a failover. The statements are recorded with the checksums of their
+// results: a replay fails if the results of the statements change, since
+// the client may have acted on them.
+//
+// The records are kept by session uuid. Only the MySQL protocol sessions
+// have one, and they do not leave vtgate.
+type txReplayer struct {
+	mu      sync.Mutex
+	records map[string]*txRecord
+}
+
+// txRecord is the record of the transaction of a session.
+type txRecord struct {
+	transactionID int64
+	statements    []txStatement
+	// broken is set if the transaction can not be replayed anymore.
+	broken   bool
+	attempts int
+}
+
+// txStatement is a statement of a recorded transaction.
+type txStatement struct {
+	query    *querypb.BoundQuery
+	checksum uint64
+}
+
+func newTxReplayer() *txReplayer {
+	return &txReplayer{records: make(map[string]*txRecord)}
+}
+
+// record returns the record of the transaction of session, or nil if its
+// transaction can not be recorded.
+func (r *txReplayer) record(session *SafeSession) *txRecord {
+	if r == nil || !*transactionReplay || session.Session == nil || session.SessionUUID == "" {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rec := r.records[session.SessionUUID]
+	if rec == nil {
+		rec = &txRecord{}
+		r.records[session.SessionUUID] = rec
+	}
+	return rec
+}
+
+// forget drops the record of the transaction of session, once the
+// transaction is over.
+func (r *txReplayer) forget(session *SafeSession) {
+	if r == nil || session.Session == nil || session.SessionUUID == "" {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.records, session.SessionUUID)
+}
+
+// invalidate prevents the replay of the transaction of session, if one of
+// its statements was not recorded.
+func (r *txReplayer) invalidate(session *SafeSession) {
+	if rec := r.record(session); rec != nil {
+		rec.broken = true
+		rec.statements = nil
+	}
+}
+
+// replayableShardSession returns the shard session of the transaction of
+// session, if it can be replayed: it involves a single shard, without any
+// state besides the transaction.
+func replayableShardSession(session *SafeSession) *vtgatepb.Session_ShardSession {
+	session.mu.Lock()
+	defer session.mu.Unlock()
+	if !session.Session.InTransaction || session.Session.InReservedConn || session.LockSession != nil ||
+		len(session.Savepoints) != 0 || len(session.PreSessions) != 0 || len(session.PostSessions) != 0 ||
+		len(session.ShardSessions) != 1 || session.commitOrder != vtgatepb.CommitOrder_NORMAL {
+		return nil
+	}
+	return session.ShardSessions[0]
+}
+
+// add records a statement executed in the transaction of session.
+func (rec *txRecord) add(session *SafeSession, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, qr *sqltypes.Result) {
+	ss := replayableShardSession(session)
+	if ss == nil || len(rss) != 1 {
+		rec.broken = true
+		rec.statements = nil
+		return
+	}
+	if ss.TransactionId != rec.transactionID {
+		// This is the first statement of a new transaction.
+		*rec = txRecord{transactionID: ss.TransactionId}
+	}
+	if rec.broken {
+		return
+	}
+	if len(rec.statements) >= *transactionReplayMaxStatements {
+		rec.broken = true
+		rec.statements = nil
+		return
+	}
+	rec.statements = append(rec.statements, txStatement{query: queries[0], checksum: resultChecksum(qr)})
+}
+
+// isReplayableError returns true if err means that the transaction was
+// lost because of a failover or of a lost connection.
+func isReplayableError(err error) bool {
+	switch vterrors.Code(err) {
+	case vtrpcpb.Code_UNAVAILABLE:
+		return true
+	case vtrpcpb.Code_FAILED_PRECONDITION:
+		return strings.Contains(err.Error(), "operation not allowed in state NOT_SERVING") ||
+			strings.Contains(err.Error(), "operation not allowed in state SHUTTING_DOWN")
+	}
+	return wasConnectionClosed(err)
+}
+
+// recordOrReplay records the statement executed by ExecuteMultiShard in a
+// transaction, or replays the transaction if the statement failed with a
+// replayable error. The results are returned unchanged if the transaction
+// is not replayed, or if its replay fails.
+func (stc *ScatterConn) recordOrReplay(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, session *SafeSession, ignoreMaxMemoryRows bool, qr *sqltypes.Result, errs []error) (*sqltypes.Result, []error) {
+	rec := stc.txConn.replays.record(session)
+	if rec == nil {
+		return qr, errs
+	}
+	err := vterrors.Aggregate(errs)
+	if err == nil {
+		rec.add(session, rss, queries, qr)
+		return qr, errs
+	}
+	ss := replayableShardSession(session)
+	if !isReplayableError(err) || rec.broken || len(rec.statements) == 0 || len(rss) != 1 || ss == nil ||
+		ss.TransactionId != rec.transactionID || !proto.Equal(ss.Target, rss[0].Target) ||
+		rec.attempts >= *transactionReplayMaxAttempts {
+		rec.broken = true
+		rec.statements = nil
+		return qr, errs
+	}
+	rec.attempts++
+	replayed, rerr := stc.replay(ctx, rec, rss[0], queries[0], session, ss, ignoreMaxMemoryRows)
+	if rerr != nil {
+		log.Warningf("Could not replay transaction %d after error %v: %v", rec.transactionID, err, rerr)
+		rec.broken = true
+		rec.statements = nil
+		return qr, errs
+	}
+	return replayed, nil
+}
+
+// replay replays the recorded statements of the transaction of ss on a new
+// transaction, then executes query. The session is restored if the replay
+// fails.
+func (stc *ScatterConn) replay(ctx context.Context, rec *txRecord, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession, ss *vtgatepb.Session_ShardSession, ignoreMaxMemoryRows bool) (*sqltypes.Result, error) {
+	// The transaction may still be open if only the connection to the
+	// tablet was lost. It must not hold its locks during the replay.
+	if qs, err := stc.txConn.queryService(ss.TabletAlias); err == nil {
+		_, _ = qs.Rollback(ctx, ss.Target, ss.TransactionId)
+	}
+	// The transaction is the only state of the session, see
+	// replayableShardSession.
+	session.mu.Lock()
+	session.ShardSessions = nil
+	session.mu.Unlock()
+
+	fail := func(reason string, err error) (*sqltypes.Result, error) {
+		txReplays.Add(reason, 1)
+		if newSS := replayableShardSession(session); newSS != nil && newSS.TransactionId != 0 {
+			if qs, qerr := stc.txConn.queryService(newSS.TabletAlias); qerr == nil {
+				_, _ = qs.Rollback(ctx, newSS.Target, newSS.TransactionId)
+			}
+		}
+		// Keep the lost transaction in the session, so that the next
+		// statements and the commit fail like without the replay.
+		session.mu.Lock()
+		session.ShardSessions = []*vtgatepb.Session_ShardSession{ss}
+		session.mu.Unlock()
+		return nil, err
+	}
+
+	rss := []*srvtopo.ResolvedShard{rs}
+	for i, stmt := range rec.statements {
+		qr, errs := stc.executeMultiShard(ctx, rss, []*querypb.BoundQuery{stmt.query}, session, false, ignoreMaxMemoryRows)
+		if err := vterrors.Aggregate(errs); err != nil {
+			return fail("Error", err)
+		}
+		if resultChecksum(qr) != stmt.checksum {
+			return fail("Mismatch", vterrors.Errorf(vtrpcpb.Code_ABORTED, "statement %d returned a different result", i+1))
+		}
+	}
+	qr, errs := stc.executeMultiShard(ctx, rss, []*querypb.BoundQuery{query}, session, false, ignoreMaxMemoryRows)
+	if err := vterrors.Aggregate(errs); err != nil {
+		return fail("Error", err)
+	}
+	txReplays.Add("Success", 1)
+
+	newSS := replayableShardSession(session)
+	if newSS == nil {
+		rec.broken = true
+		rec.statements = nil
+		return qr, nil
+	}
+	rec.transactionID = newSS.TransactionId
+	rec.statements = append(rec.statements, txStatement{query: query, checksum: resultChecksum(qr)})
+	return qr, nil
+}
+
+// resultChecksum returns the checksum of the rows and of the counters of qr.
+func resultChecksum(qr *sqltypes.Result) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(strconv.FormatUint(qr.RowsAffected, 10)))
+	h.Write([]byte{':'})
+	h.Write([]byte(strconv.FormatUint(qr.InsertID, 10)))
+	for _, row := range qr.Rows {
+		h.Write([]byte{'\n'})
+		for _, v := range row {
+			if v.IsNull() {
+				h.Write([]byte{0})
+				continue
+			}
+			h.Write([]byte{1})
+			h.Write([]byte(strconv.Itoa(v.Len())))
+			h.Write([]byte{':'})
+			h.Write(v.Raw())
+		}
+	}
+	return h.Sum64()
+}
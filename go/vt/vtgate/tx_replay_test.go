@@ -0,0 +1,134 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func enableTransactionReplay(t *testing.T) {
+	t.Helper()
+	old := *transactionReplay
+	*transactionReplay = true
+	t.Cleanup(func() { *transactionReplay = old })
+}
+
+func TestTransactionReplay(t *testing.T) {
+	enableTransactionReplay(t)
+	sc, sbc0, _, rss0, _, _ := newTestTxConnEnv(t, "TestTransactionReplay")
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, SessionUUID: "uuid"})
+	success := txReplays.Counts()["Success"]
+
+	_, errs := sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select 1"}}, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	require.EqualValues(t, 1, session.ShardSessions[0].TransactionId)
+
+	sbc0.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	qr, errs := sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select 2"}}, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	assert.Equal(t, sandboxconn.SingleRowResult.Rows, qr.Rows)
+	assert.EqualValues(t, 2, session.ShardSessions[0].TransactionId)
+	assert.EqualValues(t, 1, sbc0.RollbackCount.Get())
+	assert.Equal(t, []string{"select 1", "select 2", "select 1", "select 2"}, sandboxQueries(sbc0.Queries))
+	assert.EqualValues(t, 1, txReplays.Counts()["Success"]-success)
+
+	// The replayed transaction is recorded like the original one.
+	sbc0.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select 3"}}, session, false, false)
+	require.Error(t, vterrors.Aggregate(errs), "the default -transaction_replay_max_attempts is 1")
+
+	require.NoError(t, sc.txConn.Commit(ctx, session))
+	assert.Empty(t, sc.txConn.replays.records)
+}
+
+func TestTransactionReplayMismatch(t *testing.T) {
+	enableTransactionReplay(t)
+	sc, sbc0, _, rss0, _, _ := newTestTxConnEnv(t, "TestTransactionReplayMismatch")
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, SessionUUID: "uuid"})
+	mismatch := txReplays.Counts()["Mismatch"]
+
+	sbc0.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "int64"), "1")})
+	_, errs := sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select a from t"}}, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+
+	// The replayed statement returns another row.
+	sbc0.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	sbc0.SetResults([]*sqltypes.Result{sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "int64"), "2")})
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "update t set a = 3"}}, session, false, false)
+	require.EqualError(t, vterrors.Aggregate(errs), "UNAVAILABLE error")
+	assert.EqualValues(t, 1, txReplays.Counts()["Mismatch"]-mismatch)
+	// The new transaction is rolled back, and the session keeps the lost one.
+	assert.EqualValues(t, 2, sbc0.RollbackCount.Get())
+	assert.EqualValues(t, 1, session.ShardSessions[0].TransactionId)
+}
+
+func TestTransactionReplayDisabled(t *testing.T) {
+	sc, sbc0, _, rss0, _, _ := newTestTxConnEnv(t, "TestTransactionReplayDisabled")
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, SessionUUID: "uuid"})
+
+	_, errs := sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select 1"}}, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	sbc0.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select 2"}}, session, false, false)
+	require.Error(t, vterrors.Aggregate(errs))
+	assert.EqualValues(t, 0, sbc0.RollbackCount.Get())
+	assert.Empty(t, sc.txConn.replays.records)
+}
+
+func TestTransactionReplayMultiShard(t *testing.T) {
+	enableTransactionReplay(t)
+	sc, sbc0, _, rss0, rss1, _ := newTestTxConnEnv(t, "TestTransactionReplayMultiShard")
+	session := NewSafeSession(&vtgatepb.Session{InTransaction: true, SessionUUID: "uuid"})
+
+	_, errs := sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select 1"}}, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	_, errs = sc.ExecuteMultiShard(ctx, rss1, []*querypb.BoundQuery{{Sql: "select 1"}}, session, false, false)
+	require.NoError(t, vterrors.Aggregate(errs))
+	sbc0.MustFailCodes[vtrpcpb.Code_UNAVAILABLE] = 1
+	_, errs = sc.ExecuteMultiShard(ctx, rss0, []*querypb.BoundQuery{{Sql: "select 2"}}, session, false, false)
+	require.Error(t, vterrors.Aggregate(errs))
+	assert.EqualValues(t, 0, sbc0.RollbackCount.Get())
+}
+
+func TestResultChecksum(t *testing.T) {
+	fields := sqltypes.MakeTestFields("a|b", "int64|varchar")
+	r1 := sqltypes.MakeTestResult(fields, "1|a", "2|b")
+	assert.Equal(t, resultChecksum(r1), resultChecksum(sqltypes.MakeTestResult(fields, "1|a", "2|b")))
+	assert.NotEqual(t, resultChecksum(r1), resultChecksum(sqltypes.MakeTestResult(fields, "1|a", "2|c")))
+	assert.NotEqual(t, resultChecksum(r1), resultChecksum(sqltypes.MakeTestResult(fields, "1|a")))
+	assert.NotEqual(t, resultChecksum(sqltypes.MakeTestResult(fields, "1|ab")), resultChecksum(sqltypes.MakeTestResult(fields, "1a|b")))
+	assert.NotEqual(t, resultChecksum(&sqltypes.Result{RowsAffected: 1}), resultChecksum(&sqltypes.Result{RowsAffected: 2}))
+}
+
+func sandboxQueries(queries []*querypb.BoundQuery) []string {
+	var sqls []string
+	for _, q := range queries {
+		sqls = append(sqls, q.Sql)
+	}
+	return sqls
+}
@@ -35,6 +35,7 @@ import (
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
 	"vitess.io/vitess/go/vt/schema"
 	"vitess.io/vitess/go/vt/topotools"
+	"vitess.io/vitess/go/vt/vtgate/targetacl"
 	"vitess.io/vitess/go/vt/vtgate/vschemaacl"
 
 	"vitess.io/vitess/go/vt/vtgate/planbuilder"
@@ -66,7 +67,7 @@ var _ vindexes.VCursor = (*vcursorImpl)(nil)
 type iExecute interface {
 	Execute(ctx context.Context, method string, session *SafeSession, s string, vars map[string]*querypb.BindVariable) (*sqltypes.Result, error)
 	ExecuteMultiShard(ctx context.Context, rss []*srvtopo.ResolvedShard, queries []*querypb.BoundQuery, session *SafeSession, autocommit bool, ignoreMaxMemoryRows bool) (qr *sqltypes.Result, errs []error)
-	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, options *querypb.ExecuteOptions, callback func(reply *sqltypes.Result) error) error
+	StreamExecuteMulti(ctx context.Context, s string, rss []*srvtopo.ResolvedShard, vars []map[string]*querypb.BindVariable, session *SafeSession, callback func(reply *sqltypes.Result) error) error
 	ExecuteLock(ctx context.Context, rs *srvtopo.ResolvedShard, query *querypb.BoundQuery, session *SafeSession) (*sqltypes.Result, error)
 	Commit(ctx context.Context, safeSession *SafeSession) error
 
@@ -104,6 +105,10 @@ type vcursorImpl struct {
 	vm                    VSchemaOperator
 	semTable              *semantics.SemTable
 	warnShardedOnly       bool // when using sharded only features, a warning will be warnings field
+	// explicitDestination is set to true if the query targeted a shard
+	// explicitly in a table name. Such plans are not cached so that the
+	// targeting ACL is checked on every execution.
+	explicitDestination bool
 
 	warnings []*querypb.QueryWarning // any warnings that are accumulated during the planning phase are stored here
 }
@@ -182,7 +187,7 @@ func newVCursorImpl(
 	}
 
 	return &vcursorImpl{
-		ctx:             ctx,
+		ctx:             withMaxReplicationLag(ctx, safeSession.GetMaxReplicationLag()),
 		safeSession:     safeSession,
 		keyspace:        keyspace,
 		tabletType:      tabletType,
@@ -248,6 +253,9 @@ func (vc *vcursorImpl) FindTable(name sqlparser.TableName) (*vindexes.Table, str
 	if err != nil {
 		return nil, "", destTabletType, nil, err
 	}
+	if err := vc.authorizeDestination(dest); err != nil {
+		return nil, "", destTabletType, nil, err
+	}
 	if destKeyspace == "" {
 		destKeyspace = vc.keyspace
 	}
@@ -267,7 +275,7 @@ func (vc *vcursorImpl) FindRoutedTable(name sqlparser.TableName) (*vindexes.Tabl
 		destKeyspace = vc.keyspace
 	}
 
-	table, err := vc.vschema.FindRoutedTable(destKeyspace, name.Name.String(), destTabletType)
+	table, err := vc.vschema.FindShiftedTable(destKeyspace, name.Name.String(), destTabletType, vc.shiftFunc())
 	if err != nil {
 		return nil, err
 	}
@@ -281,16 +289,33 @@ func (vc *vcursorImpl) FindTableOrVindex(name sqlparser.TableName) (*vindexes.Ta
 	if err != nil {
 		return nil, nil, "", destTabletType, nil, err
 	}
+	if err := vc.authorizeDestination(dest); err != nil {
+		return nil, nil, "", destTabletType, nil, err
+	}
 	if destKeyspace == "" {
 		destKeyspace = vc.getActualKeyspace()
 	}
-	table, vindex, err := vc.vschema.FindTableOrVindex(destKeyspace, name.Name.String(), vc.tabletType)
+	table, vindex, err := vc.vschema.FindShiftedTableOrVindex(destKeyspace, name.Name.String(), vc.tabletType, vc.shiftFunc())
 	if err != nil {
 		return nil, nil, "", destTabletType, nil, err
 	}
 	return table, vindex, destKeyspace, destTabletType, dest, nil
 }
 
+// authorizeDestination checks that the caller may target the given
+// destination explicitly. A nil destination is always allowed.
+func (vc *vcursorImpl) authorizeDestination(dest key.Destination) error {
+	if dest == nil {
+		return nil
+	}
+	vc.explicitDestination = true
+	user := callerid.ImmediateCallerIDFromContext(vc.ctx)
+	if !targetacl.Authorized(user) {
+		return vterrors.NewErrorf(vtrpcpb.Code_PERMISSION_DENIED, vterrors.AccessDeniedError, "User '%s' is not allowed to target shards explicitly", user.GetUsername())
+	}
+	return nil
+}
+
 func (vc *vcursorImpl) getActualKeyspace() string {
 	if !sqlparser.SystemSchema(vc.keyspace) {
 		return vc.keyspace
@@ -377,6 +402,15 @@ func (vc *vcursorImpl) AllKeyspace() ([]*vindexes.Keyspace, error) {
 	return kss, nil
 }
 
+// KeyspaceTables implements the ContextVSchema interface
+func (vc *vcursorImpl) KeyspaceTables(keyspace string) map[string]*vindexes.Table {
+	ks, ok := vc.vschema.Keyspaces[keyspace]
+	if !ok {
+		return nil
+	}
+	return ks.Tables
+}
+
 // Planner implements the ContextVSchema interface
 func (vc *vcursorImpl) Planner() planbuilder.PlannerVersion {
 	if vc.safeSession.Options != nil &&
@@ -486,7 +520,7 @@ func (vc *vcursorImpl) ExecuteStandalone(query string, bindVars map[string]*quer
 // StreamExeculteMulti is the streaming version of ExecuteMultiShard.
 func (vc *vcursorImpl) StreamExecuteMulti(query string, rss []*srvtopo.ResolvedShard, bindVars []map[string]*querypb.BindVariable, callback func(reply *sqltypes.Result) error) error {
 	atomic.AddUint64(&vc.logStats.ShardQueries, uint64(len(rss)))
-	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession.Options, callback)
+	return vc.executor.StreamExecuteMulti(vc.ctx, vc.marginComments.Leading+query+vc.marginComments.Trailing, rss, bindVars, vc.safeSession, callback)
 }
 
 // ExecuteKeyspaceID is part of the engine.VCursor interface.
@@ -515,15 +549,34 @@ func (vc *vcursorImpl) ResolveDestinations(keyspace string, ids []*querypb.Value
 	return vc.resolver.ResolveDestinations(vc.ctx, keyspace, vc.tabletType, ids, destinations)
 }
 
+// ResolveReadDestinations is part of the engine.VCursor interface.
+func (vc *vcursorImpl) ResolveReadDestinations(keyspace string, tabletType topodatapb.TabletType, ids []*querypb.Value, destinations []key.Destination) ([]*srvtopo.ResolvedShard, [][]*querypb.Value, error) {
+	if !vc.usesReadTabletTypes() {
+		tabletType = vc.tabletType
+	}
+	return vc.resolver.ResolveDestinations(vc.ctx, keyspace, tabletType, ids, destinations)
+}
+
+// usesReadTabletTypes returns true if the tables that have a read tablet
+// type are read from it: the target of the session does not name a
+// tablet type, and the session is neither in a transaction nor using
+// reserved connections, which are bound to the tablets of the session.
+func (vc *vcursorImpl) usesReadTabletTypes() bool {
+	return !strings.Contains(vc.safeSession.TargetString, "@") && !vc.safeSession.InTransaction() && !vc.safeSession.InReservedConn()
+}
+
 func (vc *vcursorImpl) Session() engine.SessionActions {
 	return vc
 }
 
 func (vc *vcursorImpl) SetTarget(target string) error {
-	keyspace, tabletType, _, err := topoprotopb.ParseDestination(target, defaultTabletType)
+	keyspace, tabletType, dest, err := topoprotopb.ParseDestination(target, defaultTabletType)
 	if err != nil {
 		return err
 	}
+	if err := vc.authorizeDestination(dest); err != nil {
+		return err
+	}
 	if _, ok := vc.vschema.Keyspaces[keyspace]; !ignoreKeyspace(keyspace) && !ok {
 		return vterrors.NewErrorf(vtrpcpb.Code_NOT_FOUND, vterrors.BadDb, "Unknown database '%s'", keyspace)
 	}
@@ -712,6 +765,21 @@ func (vc *vcursorImpl) SetReadAfterWriteTimeout(timeout float64) {
 	vc.safeSession.SetReadAfterWriteTimeout(timeout)
 }
 
+// SetMaxReplicationLag implements the SessionActions interface
+func (vc *vcursorImpl) SetMaxReplicationLag(lag float64) {
+	vc.safeSession.SetMaxReplicationLag(lag)
+}
+
+// SetCharacterSetClient implements the SessionActions interface
+func (vc *vcursorImpl) SetCharacterSetClient(charset string) {
+	vc.safeSession.SetCharacterSetClient(charset)
+}
+
+// SetCharacterSetResults implements the SessionActions interface
+func (vc *vcursorImpl) SetCharacterSetResults(charset string) {
+	vc.safeSession.SetCharacterSetResults(charset)
+}
+
 // SetSessionTrackGTIDs implements the SessionActions interface
 func (vc *vcursorImpl) SetSessionTrackGTIDs(enable bool) {
 	vc.safeSession.SetSessionTrackGtids(enable)
@@ -765,7 +833,40 @@ func parseDestinationTarget(targetString string, vschema *vindexes.VSchema) (str
 	return destKeyspace, destTabletType, dest, err
 }
 
+// shiftFunc tells whether the session is shifted by the routing rules
+// shifting traffic gradually. The session is picked by its bucket, but a
+// transaction keeps using the keyspace it already used, even if the rule
+// changed since.
+func (vc *vcursorImpl) shiftFunc() vindexes.ShiftFunc {
+	if !vc.vschema.HasShiftRules() {
+		return vindexes.ShiftByBucket(vindexes.NoShiftBucket)
+	}
+	byBucket := vindexes.ShiftByBucket(vindexes.ShiftBucket(vc.safeSession.GetSessionUUID()))
+	keyspaces := vc.safeSession.ShardSessionKeyspaces()
+	if len(keyspaces) == 0 {
+		return byBucket
+	}
+	return func(rr *vindexes.RoutingRule) bool {
+		from, to := keyspaces[rr.Tables[0].Keyspace.Name], keyspaces[rr.ShiftTable.Keyspace.Name]
+		if from != to {
+			return to
+		}
+		return byBucket(rr)
+	}
+}
+
 func (vc *vcursorImpl) planPrefixKey() string {
+	if !vc.vschema.HasShiftRules() {
+		return vc.basePlanPrefixKey()
+	}
+	// The sessions shifted by different routing rules get different plans.
+	if shiftKey := vc.vschema.ShiftKey(vc.shiftFunc()); strings.Contains(shiftKey, "1") {
+		return fmt.Sprintf("%s:shift%s", vc.basePlanPrefixKey(), shiftKey)
+	}
+	return vc.basePlanPrefixKey()
+}
+
+func (vc *vcursorImpl) basePlanPrefixKey() string {
 	if vc.destination != nil {
 		switch vc.destination.(type) {
 		case key.DestinationKeyspaceID, key.DestinationKeyspaceIDs:
@@ -17,6 +17,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -256,6 +257,39 @@ func TestSetTarget(t *testing.T) {
 	}
 }
 
+func TestUsesReadTabletTypes(t *testing.T) {
+	tests := []struct {
+		session *vtgatepb.Session
+		want    bool
+	}{{
+		session: &vtgatepb.Session{},
+		want:    true,
+	}, {
+		session: &vtgatepb.Session{TargetString: "ks1"},
+		want:    true,
+	}, {
+		session: &vtgatepb.Session{TargetString: "ks1@master"},
+		want:    false,
+	}, {
+		session: &vtgatepb.Session{TargetString: "@rdonly"},
+		want:    false,
+	}, {
+		session: &vtgatepb.Session{TargetString: "ks1", InTransaction: true},
+		want:    false,
+	}, {
+		session: &vtgatepb.Session{TargetString: "ks1", InReservedConn: true},
+		want:    false,
+	}}
+
+	for i, tc := range tests {
+		t.Run(fmt.Sprintf("%d#%s", i, tc.session.TargetString), func(t *testing.T) {
+			vc, err := newVCursorImpl(context.Background(), NewSafeSession(tc.session), sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: vschemaWith2KS}, vschemaWith2KS, nil, nil, false)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, vc.usesReadTabletTypes())
+		})
+	}
+}
+
 func TestPlanPrefixKey(t *testing.T) {
 	type testCase struct {
 		vschema               *vindexes.VSchema
@@ -293,6 +327,159 @@ func TestPlanPrefixKey(t *testing.T) {
 	}
 }
 
+func TestShiftRoutingRules(t *testing.T) {
+	vs, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable:    "t1@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 100,
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+			"ks2": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+		},
+	})
+	require.NoError(t, err)
+
+	// The transactions on replicas need the tablet gateway.
+	defer func(gateway string) {
+		*GatewayImplementation = gateway
+	}(*GatewayImplementation)
+	*GatewayImplementation = tabletGatewayImplementation
+
+	shardSession := func(keyspace string) *vtgatepb.Session_ShardSession {
+		return &vtgatepb.Session_ShardSession{
+			Target:        &querypb.Target{Keyspace: keyspace, Shard: "0", TabletType: topodatapb.TabletType_REPLICA},
+			TransactionId: 1,
+		}
+	}
+	tests := []struct {
+		name          string
+		sessionUUID   string
+		shardSessions []*vtgatepb.Session_ShardSession
+		wantKeyspace  string
+		wantPrefixKey string
+	}{{
+		name:          "no uuid",
+		wantKeyspace:  "ks1",
+		wantPrefixKey: "@replica",
+	}, {
+		name:          "uuid",
+		sessionUUID:   "4c9a1f3e-2b7d-4e8a-9f61-0d5c3b8e7a21",
+		wantKeyspace:  "ks2",
+		wantPrefixKey: "@replica:shift1",
+	}, {
+		name:          "transaction read from the source",
+		sessionUUID:   "4c9a1f3e-2b7d-4e8a-9f61-0d5c3b8e7a21",
+		shardSessions: []*vtgatepb.Session_ShardSession{shardSession("ks1")},
+		wantKeyspace:  "ks1",
+		wantPrefixKey: "@replica",
+	}, {
+		name:          "transaction read from the shift target",
+		shardSessions: []*vtgatepb.Session_ShardSession{shardSession("ks2")},
+		wantKeyspace:  "ks2",
+		wantPrefixKey: "@replica:shift1",
+	}, {
+		name:          "transaction read from both",
+		sessionUUID:   "4c9a1f3e-2b7d-4e8a-9f61-0d5c3b8e7a21",
+		shardSessions: []*vtgatepb.Session_ShardSession{shardSession("ks1"), shardSession("ks2")},
+		wantKeyspace:  "ks2",
+		wantPrefixKey: "@replica:shift1",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			session := &vtgatepb.Session{
+				TargetString:  "@replica",
+				SessionUUID:   tc.sessionUUID,
+				InTransaction: len(tc.shardSessions) > 0,
+				ShardSessions: tc.shardSessions,
+			}
+			vc, err := newVCursorImpl(context.Background(), NewSafeSession(session), sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: vs}, vs, nil, nil, false)
+			require.NoError(t, err)
+			vc.executor = &Executor{vschema: vs}
+
+			table, _, _, _, _, err := vc.FindTableOrVindex(sqlparser.TableName{Name: sqlparser.NewTableIdent("t1")})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantKeyspace, table.Keyspace.Name)
+
+			require.Equal(t, tc.wantPrefixKey, vc.planPrefixKey())
+		})
+	}
+}
+
+func TestShiftPrimaryKeyspaceRoute(t *testing.T) {
+	vs, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable:    "ks1.*",
+				ToTables:     []string{"ks1.*"},
+				ShiftToTable: "ks2.*",
+				ShiftPercent: 100,
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+			"ks2": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+		},
+	})
+	require.NoError(t, err)
+	noShift, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {Tables: map[string]*vschemapb.Table{"t1": {}}},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		vschema       *vindexes.VSchema
+		shardSessions []*vtgatepb.Session_ShardSession
+		wantKeyspace  string
+		wantPrefixKey string
+	}{{
+		name:          "shifted",
+		vschema:       vs,
+		wantKeyspace:  "ks2",
+		wantPrefixKey: "ks1@master:shift1",
+	}, {
+		name:    "transaction wrote to the source",
+		vschema: vs,
+		shardSessions: []*vtgatepb.Session_ShardSession{{
+			Target:        &querypb.Target{Keyspace: "ks1", Shard: "0", TabletType: topodatapb.TabletType_MASTER},
+			TransactionId: 1,
+		}},
+		wantKeyspace:  "ks1",
+		wantPrefixKey: "ks1@master",
+	}, {
+		name:          "no shift rules",
+		vschema:       noShift,
+		wantKeyspace:  "ks1",
+		wantPrefixKey: "ks1@master",
+	}}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			session := &vtgatepb.Session{
+				TargetString:  "ks1",
+				SessionUUID:   "4c9a1f3e-2b7d-4e8a-9f61-0d5c3b8e7a21",
+				InTransaction: len(tc.shardSessions) > 0,
+				ShardSessions: tc.shardSessions,
+			}
+			vc, err := newVCursorImpl(context.Background(), NewSafeSession(session), sqlparser.MarginComments{}, nil, nil, &fakeVSchemaOperator{vschema: tc.vschema}, tc.vschema, nil, nil, false)
+			require.NoError(t, err)
+			vc.executor = &Executor{vschema: tc.vschema}
+
+			table, _, _, _, _, err := vc.FindTableOrVindex(sqlparser.TableName{Name: sqlparser.NewTableIdent("t1")})
+			require.NoError(t, err)
+			require.Equal(t, tc.wantKeyspace, table.Keyspace.Name)
+
+			require.Equal(t, tc.wantPrefixKey, vc.planPrefixKey())
+		})
+	}
+}
+
 func TestFirstSortedKeyspace(t *testing.T) {
 	ks1Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "xks1"}}
 	ks2Schema := &vindexes.KeyspaceSchema{Keyspace: &vindexes.Keyspace{Name: "aks2"}}
@@ -126,6 +126,20 @@ func (cached *ConsistentLookupUnique) CachedSize(alloc bool) int64 {
 	size += cached.clCommon.CachedSize(true)
 	return size
 }
+func (cached *GRPC) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(72)
+	}
+	// field name string
+	size += int64(len(cached.name))
+	// field address string
+	size += int64(len(cached.address))
+	return size
+}
 func (cached *Hash) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -341,7 +355,7 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(169)
+		size += int64(184)
 	}
 	// field Type string
 	size += int64(len(cached.Type))
@@ -381,6 +395,8 @@ func (cached *Table) CachedSize(alloc bool) int64 {
 	}
 	// field Pinned []byte
 	size += int64(cap(cached.Pinned))
+	// field Source *vitess.io/vitess/go/vt/vtgate/vindexes.Table
+	size += cached.Source.CachedSize(true)
 	return size
 }
 func (cached *UnicodeLooseMD5) CachedSize(alloc bool) int64 {
@@ -17,6 +17,7 @@ limitations under the License.
 package vindexes
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"reflect"
@@ -504,6 +505,10 @@ type loggingVCursor struct {
 	log     []string
 }
 
+func (vc *loggingVCursor) Context() context.Context {
+	return context.Background()
+}
+
 func (vc *loggingVCursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	return vtgatepb.CommitOrder_PRE
 }
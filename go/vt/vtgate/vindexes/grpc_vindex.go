@@ -0,0 +1,420 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/encoding"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/grpcclient"
+	"vitess.io/vitess/go/vt/key"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+var (
+	_ SingleColumn = (*GRPC)(nil)
+)
+
+const (
+	grpcVindexService      = "vitess.vindex.ExternalVindex"
+	grpcVindexMapMethod    = "/" + grpcVindexService + "/Map"
+	grpcVindexVerifyMethod = "/" + grpcVindexService + "/Verify"
+
+	// grpcVindexCodec is the content subtype of the messages exchanged
+	// with the external service. They're encoded as JSON so that the
+	// service can be implemented without the vitess protos.
+	grpcVindexCodec = "json"
+)
+
+func init() {
+	Register("grpc", NewGRPC)
+	encoding.RegisterCodec(grpcJSONCodec{})
+}
+
+// GRPCValue is an id sent to the external vindex service.
+type GRPCValue struct {
+	Type  string `json:"type"`
+	Value []byte `json:"value"`
+}
+
+// GRPCMapRequest is the request sent to the Map method of the
+// external vindex service.
+type GRPCMapRequest struct {
+	Vindex string      `json:"vindex"`
+	IDs    []GRPCValue `json:"ids"`
+}
+
+// GRPCMapResponse is the response of the Map method of the external
+// vindex service. KeyspaceIDs has one entry per requested id, which lists
+// the keyspace ids the id maps to. An empty list means that the id
+// maps to no keyspace id.
+type GRPCMapResponse struct {
+	KeyspaceIDs [][][]byte `json:"keyspace_ids"`
+}
+
+// GRPCVerifyRequest is the request sent to the Verify method of the
+// external vindex service.
+type GRPCVerifyRequest struct {
+	Vindex      string      `json:"vindex"`
+	IDs         []GRPCValue `json:"ids"`
+	KeyspaceIDs [][]byte    `json:"keyspace_ids"`
+}
+
+// GRPCVerifyResponse is the response of the Verify method of the
+// external vindex service. It has one entry per requested id.
+type GRPCVerifyResponse struct {
+	Verified []bool `json:"verified"`
+}
+
+// GRPCVindexServer is the interface implemented by the external service
+// a grpc vindex delegates to.
+type GRPCVindexServer interface {
+	Map(context.Context, *GRPCMapRequest) (*GRPCMapResponse, error)
+	Verify(context.Context, *GRPCVerifyRequest) (*GRPCVerifyResponse, error)
+}
+
+// RegisterGRPCVindexServer registers srv in the grpc server s.
+func RegisterGRPCVindexServer(s *grpc.Server, srv GRPCVindexServer) {
+	s.RegisterService(&grpcVindexServiceDesc, srv)
+}
+
+var grpcVindexServiceDesc = grpc.ServiceDesc{
+	ServiceName: grpcVindexService,
+	HandlerType: (*GRPCVindexServer)(nil),
+	Methods: []grpc.MethodDesc{{
+		MethodName: "Map",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+			request := &GRPCMapRequest{}
+			if err := dec(request); err != nil {
+				return nil, err
+			}
+			return srv.(GRPCVindexServer).Map(ctx, request)
+		},
+	}, {
+		MethodName: "Verify",
+		Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+			request := &GRPCVerifyRequest{}
+			if err := dec(request); err != nil {
+				return nil, err
+			}
+			return srv.(GRPCVindexServer).Verify(ctx, request)
+		},
+	}},
+}
+
+// grpcJSONCodec encodes the grpc vindex messages as JSON.
+type grpcJSONCodec struct{}
+
+func (grpcJSONCodec) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (grpcJSONCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+func (grpcJSONCodec) Name() string {
+	return grpcVindexCodec
+}
+
+// grpcDialConfig is the configuration a connection to an external
+// service is dialed with.
+type grpcDialConfig struct {
+	address    string
+	cert       string
+	key        string
+	ca         string
+	serverName string
+}
+
+var (
+	// grpcVindexConns caches the connections to the external services,
+	// so that they are shared by the vindexes and survive vschema reloads.
+	// The vindexes that connect to the same address with different TLS
+	// settings get their own connection.
+	grpcVindexConnsMu sync.Mutex
+	grpcVindexConns   = make(map[grpcDialConfig]*grpc.ClientConn)
+)
+
+func grpcVindexConn(address string, params map[string]string) (*grpc.ClientConn, error) {
+	config := grpcDialConfig{
+		address:    address,
+		cert:       params["cert"],
+		key:        params["key"],
+		ca:         params["ca"],
+		serverName: params["server_name"],
+	}
+
+	grpcVindexConnsMu.Lock()
+	defer grpcVindexConnsMu.Unlock()
+
+	if conn, ok := grpcVindexConns[config]; ok {
+		return conn, nil
+	}
+	opt, err := grpcclient.SecureDialOption(config.cert, config.key, config.ca, config.serverName)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := grpcclient.Dial(address, grpcclient.FailFast(false), opt)
+	if err != nil {
+		return nil, err
+	}
+	grpcVindexConns[config] = conn
+	return conn, nil
+}
+
+// grpcCacheEntry is a mapping returned by the external service.
+type grpcCacheEntry struct {
+	ksids   [][]byte
+	expires time.Time
+}
+
+// GRPC defines a vindex that delegates Map and Verify to an external
+// gRPC service. The following params are supported:
+// address: the address of the service (required).
+// unique: whether the vindex is unique. Defaults to true.
+// timeout: the timeout of each call to the service. Defaults to 1s.
+// cache_size: the number of ids whose mapping is cached. Defaults to 0 (no caching).
+// cache_ttl: how long a mapping stays in the cache. Defaults to 1m.
+// cert, key, ca, server_name: the TLS settings to connect to the service.
+type GRPC struct {
+	name     string
+	address  string
+	unique   bool
+	timeout  time.Duration
+	cacheTTL time.Duration
+	cache    *cache.LRUCache
+	conn     *grpc.ClientConn
+}
+
+// NewGRPC creates a GRPC vindex.
+func NewGRPC(name string, params map[string]string) (Vindex, error) {
+	address := params["address"]
+	if address == "" {
+		return nil, fmt.Errorf("grpc vindex %s: address must be specified", name)
+	}
+	unique := true
+	if _, ok := params["unique"]; ok {
+		var err error
+		if unique, err = boolFromMap(params, "unique"); err != nil {
+			return nil, err
+		}
+	}
+	timeout, err := grpcDurationParam(params, "timeout", time.Second)
+	if err != nil {
+		return nil, err
+	}
+	cacheTTL, err := grpcDurationParam(params, "cache_ttl", time.Minute)
+	if err != nil {
+		return nil, err
+	}
+	vind := &GRPC{
+		name:     name,
+		address:  address,
+		unique:   unique,
+		timeout:  timeout,
+		cacheTTL: cacheTTL,
+	}
+	if val, ok := params["cache_size"]; ok {
+		cacheSize, err := strconv.ParseInt(val, 10, 64)
+		if err != nil || cacheSize < 0 {
+			return nil, fmt.Errorf("cache_size value must be a non-negative integer: '%s'", val)
+		}
+		if cacheSize > 0 {
+			vind.cache = cache.NewLRUCache(cacheSize, func(interface{}) int64 { return 1 })
+		}
+	}
+	if vind.conn, err = grpcVindexConn(address, params); err != nil {
+		return nil, err
+	}
+	return vind, nil
+}
+
+func grpcDurationParam(params map[string]string, name string, defaultValue time.Duration) (time.Duration, error) {
+	val, ok := params[name]
+	if !ok {
+		return defaultValue, nil
+	}
+	d, err := time.ParseDuration(val)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("%s value must be a positive duration: '%s'", name, val)
+	}
+	return d, nil
+}
+
+// String returns the name of the vindex.
+func (vind *GRPC) String() string {
+	return vind.name
+}
+
+// Cost returns the cost of this vindex as 2.
+func (vind *GRPC) Cost() int {
+	return 2
+}
+
+// IsUnique returns true if the vindex is configured as unique.
+func (vind *GRPC) IsUnique() bool {
+	return vind.unique
+}
+
+// NeedsVCursor satisfies the Vindex interface.
+func (vind *GRPC) NeedsVCursor() bool {
+	return false
+}
+
+// Map can map ids to key.Destination objects.
+func (vind *GRPC) Map(vcursor VCursor, ids []sqltypes.Value) ([]key.Destination, error) {
+	results := make([][][]byte, len(ids))
+	var missing []int
+	for i, id := range ids {
+		if ksids, ok := vind.cached(id); ok {
+			results[i] = ksids
+			continue
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) != 0 {
+		request := &GRPCMapRequest{Vindex: vind.name}
+		for _, i := range missing {
+			request.IDs = append(request.IDs, grpcValue(ids[i]))
+		}
+		response := &GRPCMapResponse{}
+		if err := vind.invoke(vcursor, grpcVindexMapMethod, request, response); err != nil {
+			return nil, vterrors.Wrap(err, "grpc.Map")
+		}
+		if len(response.KeyspaceIDs) != len(missing) {
+			return nil, fmt.Errorf("grpc.Map: unexpected number of results from vindex %s: %d, want %d", vind.name, len(response.KeyspaceIDs), len(missing))
+		}
+		for j, i := range missing {
+			results[i] = response.KeyspaceIDs[j]
+			vind.store(ids[i], response.KeyspaceIDs[j])
+		}
+	}
+
+	out := make([]key.Destination, 0, len(ids))
+	for i, ksids := range results {
+		switch {
+		case len(ksids) == 0:
+			out = append(out, key.DestinationNone{})
+		case vind.unique && len(ksids) > 1:
+			return nil, fmt.Errorf("grpc.Map: unexpected multiple results from vindex %s: %v", vind.name, ids[i])
+		case vind.unique:
+			out = append(out, key.DestinationKeyspaceID(ksids[0]))
+		default:
+			out = append(out, key.DestinationKeyspaceIDs(ksids))
+		}
+	}
+	return out, nil
+}
+
+// Verify returns true if ids map to ksids. The ids whose mapping is
+// cached are verified locally, the others are sent to the service.
+func (vind *GRPC) Verify(vcursor VCursor, ids []sqltypes.Value, ksids [][]byte) ([]bool, error) {
+	out := make([]bool, len(ids))
+	var missing []int
+	for i, id := range ids {
+		if mapped, ok := vind.cached(id); ok {
+			for _, ksid := range mapped {
+				if string(ksid) == string(ksids[i]) {
+					out[i] = true
+					break
+				}
+			}
+			continue
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) == 0 {
+		return out, nil
+	}
+
+	request := &GRPCVerifyRequest{Vindex: vind.name}
+	for _, i := range missing {
+		request.IDs = append(request.IDs, grpcValue(ids[i]))
+		request.KeyspaceIDs = append(request.KeyspaceIDs, ksids[i])
+	}
+	response := &GRPCVerifyResponse{}
+	if err := vind.invoke(vcursor, grpcVindexVerifyMethod, request, response); err != nil {
+		return nil, vterrors.Wrap(err, "grpc.Verify")
+	}
+	if len(response.Verified) != len(missing) {
+		return nil, fmt.Errorf("grpc.Verify: unexpected number of results from vindex %s: %d, want %d", vind.name, len(response.Verified), len(missing))
+	}
+	for j, i := range missing {
+		out[i] = response.Verified[j]
+	}
+	return out, nil
+}
+
+// invoke calls the service with the context of the request, so that the
+// call is canceled with the request. The vindexes that are used outside
+// of a request get no vcursor.
+func (vind *GRPC) invoke(vcursor VCursor, method string, request, response interface{}) error {
+	ctx := context.Background()
+	if vcursor != nil {
+		ctx = vcursor.Context()
+	}
+	ctx, cancel := context.WithTimeout(ctx, vind.timeout)
+	defer cancel()
+	return vind.conn.Invoke(ctx, method, request, response, grpc.CallContentSubtype(grpcVindexCodec))
+}
+
+func (vind *GRPC) cached(id sqltypes.Value) ([][]byte, bool) {
+	if vind.cache == nil {
+		return nil, false
+	}
+	v, ok := vind.cache.Get(grpcCacheKey(id))
+	if !ok {
+		return nil, false
+	}
+	entry := v.(*grpcCacheEntry)
+	if time.Now().After(entry.expires) {
+		vind.cache.Delete(grpcCacheKey(id))
+		return nil, false
+	}
+	return entry.ksids, true
+}
+
+func (vind *GRPC) store(id sqltypes.Value, ksids [][]byte) {
+	if vind.cache == nil {
+		return
+	}
+	vind.cache.Set(grpcCacheKey(id), &grpcCacheEntry{
+		ksids:   ksids,
+		expires: time.Now().Add(vind.cacheTTL),
+	})
+}
+
+func grpcCacheKey(id sqltypes.Value) string {
+	return id.Type().String() + ":" + id.ToString()
+}
+
+func grpcValue(id sqltypes.Value) GRPCValue {
+	return GRPCValue{
+		Type:  id.Type().String(),
+		Value: id.Raw(),
+	}
+}
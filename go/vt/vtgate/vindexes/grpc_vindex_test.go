@@ -0,0 +1,242 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vindexes
+
+import (
+	"context"
+	"net"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/key"
+)
+
+// fakeGRPCVindexServer maps an id to "ks<id>", except for the ids
+// "multi", which maps to two keyspace ids, "none", which maps to
+// nothing, and "slow", which doesn't answer in time.
+type fakeGRPCVindexServer struct {
+	mapCalls    int64
+	verifyCalls int64
+}
+
+func (s *fakeGRPCVindexServer) mapID(id GRPCValue) [][]byte {
+	switch string(id.Value) {
+	case "multi":
+		return [][]byte{[]byte("ks1"), []byte("ks2")}
+	case "none":
+		return nil
+	case "slow":
+		time.Sleep(100 * time.Millisecond)
+	}
+	return [][]byte{[]byte("ks" + string(id.Value))}
+}
+
+func (s *fakeGRPCVindexServer) Map(ctx context.Context, request *GRPCMapRequest) (*GRPCMapResponse, error) {
+	atomic.AddInt64(&s.mapCalls, 1)
+	response := &GRPCMapResponse{}
+	for _, id := range request.IDs {
+		response.KeyspaceIDs = append(response.KeyspaceIDs, s.mapID(id))
+	}
+	return response, nil
+}
+
+func (s *fakeGRPCVindexServer) Verify(ctx context.Context, request *GRPCVerifyRequest) (*GRPCVerifyResponse, error) {
+	atomic.AddInt64(&s.verifyCalls, 1)
+	response := &GRPCVerifyResponse{}
+	for i, id := range request.IDs {
+		verified := false
+		for _, ksid := range s.mapID(id) {
+			verified = verified || string(ksid) == string(request.KeyspaceIDs[i])
+		}
+		response.Verified = append(response.Verified, verified)
+	}
+	return response, nil
+}
+
+func startFakeGRPCVindexServer(t *testing.T) (*fakeGRPCVindexServer, string) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	fake := &fakeGRPCVindexServer{}
+	RegisterGRPCVindexServer(server, fake)
+	go server.Serve(listener)
+	t.Cleanup(server.Stop)
+	return fake, listener.Addr().String()
+}
+
+func createGRPCVindex(t *testing.T, params map[string]string) SingleColumn {
+	vindex, err := CreateVindex("grpc", "grpc", params)
+	require.NoError(t, err)
+	return vindex.(SingleColumn)
+}
+
+func TestGRPCInfo(t *testing.T) {
+	_, address := startFakeGRPCVindexServer(t)
+	vindex := createGRPCVindex(t, map[string]string{"address": address})
+	assert.Equal(t, 2, vindex.Cost())
+	assert.Equal(t, "grpc", vindex.String())
+	assert.True(t, vindex.IsUnique())
+	assert.False(t, vindex.NeedsVCursor())
+
+	vindex = createGRPCVindex(t, map[string]string{"address": address, "unique": "false"})
+	assert.False(t, vindex.IsUnique())
+}
+
+func TestGRPCMap(t *testing.T) {
+	fake, address := startFakeGRPCVindexServer(t)
+	vindex := createGRPCVindex(t, map[string]string{"address": address})
+
+	got, err := vindex.Map(nil, []sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewVarChar("none"),
+	})
+	require.NoError(t, err)
+	want := []key.Destination{
+		key.DestinationKeyspaceID("ks1"),
+		key.DestinationNone{},
+	}
+	assert.Equal(t, want, got)
+
+	_, err = vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("multi")})
+	assert.EqualError(t, err, "grpc.Map: unexpected multiple results from vindex grpc: VARCHAR(\"multi\")")
+	assert.EqualValues(t, 2, atomic.LoadInt64(&fake.mapCalls))
+
+	vindex = createGRPCVindex(t, map[string]string{"address": address, "unique": "false"})
+	got, err = vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("multi")})
+	require.NoError(t, err)
+	want = []key.Destination{
+		key.DestinationKeyspaceIDs([][]byte{[]byte("ks1"), []byte("ks2")}),
+	}
+	assert.Equal(t, want, got)
+}
+
+func TestGRPCVerify(t *testing.T) {
+	fake, address := startFakeGRPCVindexServer(t)
+	vindex := createGRPCVindex(t, map[string]string{"address": address})
+
+	got, err := vindex.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)},
+		[][]byte{[]byte("ks1"), []byte("ks1")},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, got)
+	assert.EqualValues(t, 1, atomic.LoadInt64(&fake.verifyCalls))
+}
+
+func TestGRPCCache(t *testing.T) {
+	fake, address := startFakeGRPCVindexServer(t)
+	vindex := createGRPCVindex(t, map[string]string{"address": address, "cache_size": "10", "cache_ttl": "1h"})
+
+	ids := []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2)}
+	for i := 0; i < 2; i++ {
+		got, err := vindex.Map(nil, ids)
+		require.NoError(t, err)
+		want := []key.Destination{
+			key.DestinationKeyspaceID("ks1"),
+			key.DestinationKeyspaceID("ks2"),
+		}
+		assert.Equal(t, want, got)
+	}
+	assert.EqualValues(t, 1, atomic.LoadInt64(&fake.mapCalls))
+
+	// Only the ids that are not cached are sent to the service.
+	got, err := vindex.Verify(nil,
+		[]sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(2), sqltypes.NewInt64(3)},
+		[][]byte{[]byte("ks1"), []byte("ks1"), []byte("ks3")},
+	)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false, true}, got)
+	assert.EqualValues(t, 1, atomic.LoadInt64(&fake.verifyCalls))
+
+	_, err = vindex.Map(nil, []sqltypes.Value{sqltypes.NewInt64(1), sqltypes.NewInt64(3)})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, atomic.LoadInt64(&fake.mapCalls))
+}
+
+func TestGRPCTimeout(t *testing.T) {
+	_, address := startFakeGRPCVindexServer(t)
+	vindex := createGRPCVindex(t, map[string]string{"address": address, "timeout": "10ms"})
+
+	_, err := vindex.Map(nil, []sqltypes.Value{sqltypes.NewVarChar("slow")})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "grpc.Map")
+	assert.Contains(t, err.Error(), "DeadlineExceeded")
+}
+
+// contextVCursor is a VCursor that only provides the context of the
+// request.
+type contextVCursor struct {
+	VCursor
+	ctx context.Context
+}
+
+func (vc contextVCursor) Context() context.Context {
+	return vc.ctx
+}
+
+func TestGRPCCallerContext(t *testing.T) {
+	_, address := startFakeGRPCVindexServer(t)
+	vindex := createGRPCVindex(t, map[string]string{"address": address})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := vindex.Map(contextVCursor{ctx: ctx}, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Canceled")
+
+	_, err = vindex.Map(contextVCursor{ctx: context.Background()}, []sqltypes.Value{sqltypes.NewInt64(1)})
+	require.NoError(t, err)
+}
+
+func TestGRPCConnCache(t *testing.T) {
+	_, address := startFakeGRPCVindexServer(t)
+	conn := createGRPCVindex(t, map[string]string{"address": address}).(*GRPC).conn
+	assert.Equal(t, conn, createGRPCVindex(t, map[string]string{"address": address}).(*GRPC).conn)
+
+	// Different TLS settings get their own connection.
+	other := createGRPCVindex(t, map[string]string{"address": address, "server_name": "other"}).(*GRPC).conn
+	assert.NotEqual(t, conn, other)
+}
+
+func TestGRPCParams(t *testing.T) {
+	testcases := []struct {
+		params map[string]string
+		err    string
+	}{{
+		params: map[string]string{},
+		err:    "grpc vindex grpc: address must be specified",
+	}, {
+		params: map[string]string{"address": "localhost:1", "unique": "yes"},
+		err:    "unique value must be 'true' or 'false': 'yes'",
+	}, {
+		params: map[string]string{"address": "localhost:1", "timeout": "0s"},
+		err:    "timeout value must be a positive duration: '0s'",
+	}, {
+		params: map[string]string{"address": "localhost:1", "cache_size": "-1"},
+		err:    "cache_size value must be a non-negative integer: '-1'",
+	}}
+	for _, tcase := range testcases {
+		_, err := CreateVindex("grpc", "grpc", tcase.params)
+		assert.EqualError(t, err, tcase.err)
+	}
+}
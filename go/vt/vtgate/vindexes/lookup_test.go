@@ -17,6 +17,7 @@ limitations under the License.
 package vindexes
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -51,6 +52,10 @@ type vcursor struct {
 	keys        []sqltypes.Value
 }
 
+func (vc *vcursor) Context() context.Context {
+	return context.Background()
+}
+
 func (vc *vcursor) LookupRowLockShardSession() vtgatepb.CommitOrder {
 	panic("implement me")
 }
@@ -25,6 +25,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var (
@@ -87,11 +89,17 @@ func (ge *RegionExperimental) NeedsVCursor() bool {
 	return false
 }
 
+// PartialVindex returns true: the region alone maps to the key range
+// of the region.
+func (ge *RegionExperimental) PartialVindex() bool {
+	return true
+}
+
 // Map satisfies MultiColumn.
 func (ge *RegionExperimental) Map(vcursor VCursor, rowsColValues [][]sqltypes.Value) ([]key.Destination, error) {
 	destinations := make([]key.Destination, 0, len(rowsColValues))
 	for _, row := range rowsColValues {
-		if len(row) != 2 {
+		if len(row) != 1 && len(row) != 2 {
 			destinations = append(destinations, key.DestinationNone{})
 			continue
 		}
@@ -103,6 +111,14 @@ func (ge *RegionExperimental) Map(vcursor VCursor, rowsColValues [][]sqltypes.Va
 		}
 		r := make([]byte, 2, 2+8)
 		binary.BigEndian.PutUint16(r, uint16(rn))
+		if len(row) == 1 {
+			// Only the region is known.
+			if ge.regionBytes == 1 {
+				r = r[1:]
+			}
+			destinations = append(destinations, key.DestinationKeyRange{KeyRange: regionKeyRange(r)})
+			continue
+		}
 
 		// Compute hash.
 		hn, err := evalengine.ToUint64(row[1])
@@ -135,3 +151,18 @@ func (ge *RegionExperimental) Verify(vcursor VCursor, rowsColValues [][]sqltypes
 	}
 	return result, nil
 }
+
+// regionKeyRange returns the key range of the keyspace ids that start
+// with the region prefix.
+func regionKeyRange(region []byte) *topodatapb.KeyRange {
+	end := make([]byte, len(region))
+	copy(end, region)
+	for i := len(end) - 1; i >= 0; i-- {
+		end[i]++
+		if end[i] != 0 {
+			return &topodatapb.KeyRange{Start: region, End: end}
+		}
+	}
+	// The last region ends at the end of the keyspace.
+	return &topodatapb.KeyRange{Start: region}
+}
@@ -25,6 +25,8 @@ import (
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/key"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func TestRegionExperimentalMisc(t *testing.T) {
@@ -47,8 +49,13 @@ func TestRegionExperimentalMap(t *testing.T) {
 	}, {
 		sqltypes.NewInt64(256), sqltypes.NewInt64(1),
 	}, {
-		// Invalid length.
+		// Only the region.
 		sqltypes.NewInt64(1),
+	}, {
+		sqltypes.NewInt64(255),
+	}, {
+		// Invalid length.
+		sqltypes.NewInt64(1), sqltypes.NewInt64(1), sqltypes.NewInt64(1),
 	}, {
 		// Invalid region.
 		sqltypes.NewVarBinary("abcd"), sqltypes.NewInt64(256),
@@ -62,11 +69,14 @@ func TestRegionExperimentalMap(t *testing.T) {
 		key.DestinationKeyspaceID([]byte("\x01\x16k@\xb4J\xbaK\xd6")),
 		key.DestinationKeyspaceID([]byte("\xff\x16k@\xb4J\xbaK\xd6")),
 		key.DestinationKeyspaceID([]byte("\x00\x16k@\xb4J\xbaK\xd6")),
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\x01"), End: []byte("\x02")}},
+		key.DestinationKeyRange{KeyRange: &topodatapb.KeyRange{Start: []byte("\xff")}},
 		key.DestinationNone{},
 		key.DestinationNone{},
 		key.DestinationNone{},
 	}
 	assert.Equal(t, want, got)
+	assert.True(t, ge.PartialVindex())
 }
 
 func TestRegionExperimentalMapMulti2(t *testing.T) {
@@ -153,3 +153,8 @@ func (rv *RegionJSON) Verify(vcursor VCursor, rowsColValues [][]sqltypes.Value,
 func (rv *RegionJSON) NeedsVCursor() bool {
 	return false
 }
+
+// PartialVindex returns false: the keyspace id depends on both columns.
+func (rv *RegionJSON) PartialVindex() bool {
+	return false
+}
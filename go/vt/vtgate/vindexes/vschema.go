@@ -20,12 +20,15 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"io/ioutil"
 	"sort"
+	"strings"
 
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
@@ -58,12 +61,22 @@ type VSchema struct {
 	uniqueTables   map[string]*Table
 	uniqueVindexes map[string]Vindex
 	Keyspaces      map[string]*KeyspaceSchema `json:"keyspaces"`
+
+	// shiftRules are the names of the routing rules shifting sessions, in
+	// increasing order.
+	shiftRules []string
 }
 
 // RoutingRule represents one routing rule.
 type RoutingRule struct {
 	Tables []*Table
 	Error  error
+
+	// ShiftTable receives the sessions of the shift buckets below
+	// ShiftPercent instead of Tables. The targets of the keyspace routes
+	// are the tables named * of their keyspaces.
+	ShiftTable   *Table
+	ShiftPercent uint32
 }
 
 // MarshalJSON returns a JSON representation of Column.
@@ -75,7 +88,70 @@ func (rr *RoutingRule) MarshalJSON() ([]byte, error) {
 	for _, t := range rr.Tables {
 		tables = append(tables, t.Keyspace.Name+"."+t.Name.String())
 	}
-	return json.Marshal(tables)
+	if rr.ShiftTable == nil {
+		return json.Marshal(tables)
+	}
+	return json.Marshal(struct {
+		Tables       []string `json:"tables"`
+		ShiftTable   string   `json:"shift_table"`
+		ShiftPercent uint32   `json:"shift_percent"`
+	}{
+		Tables:       tables,
+		ShiftTable:   rr.ShiftTable.Keyspace.Name + "." + rr.ShiftTable.Name.String(),
+		ShiftPercent: rr.ShiftPercent,
+	})
+}
+
+// NoShiftBucket is the shift bucket of the sessions which are never shifted
+// by the routing rules.
+const NoShiftBucket = 100
+
+// ShiftBucket returns the shift bucket, between 0 and 99, of a session. A
+// routing rule shifting n percents of the sessions shifts those of the
+// buckets below n. The bucket only depends on the session, so that the
+// session keeps reading from the same target. Sessions without a UUID,
+// like those of the gRPC clients, are never shifted: they have nothing
+// else lasting longer than a transaction to pick them by.
+func ShiftBucket(sessionUUID string) uint32 {
+	if sessionUUID == "" {
+		return NoShiftBucket
+	}
+	h := fnv.New32a()
+	h.Write([]byte(sessionUUID))
+	return h.Sum32() % NoShiftBucket
+}
+
+// ShiftFunc tells whether a session is shifted by a routing rule.
+type ShiftFunc func(rr *RoutingRule) bool
+
+// ShiftByBucket returns the ShiftFunc of the sessions of a shift bucket.
+func ShiftByBucket(bucket uint32) ShiftFunc {
+	return func(rr *RoutingRule) bool {
+		return bucket < rr.ShiftPercent
+	}
+}
+
+// HasShiftRules returns true if some routing rules shift sessions.
+func (vschema *VSchema) HasShiftRules() bool {
+	return len(vschema.shiftRules) != 0
+}
+
+// ShiftKey returns the routing rules shifting a session, as a string of
+// one digit per rule, so that sessions shifted by the same rules can
+// share their plans. It is empty if no rule shifts sessions.
+func (vschema *VSchema) ShiftKey(shifted ShiftFunc) string {
+	if len(vschema.shiftRules) == 0 {
+		return ""
+	}
+	key := make([]byte, 0, len(vschema.shiftRules))
+	for _, name := range vschema.shiftRules {
+		if shifted(vschema.RoutingRules[name]) {
+			key = append(key, '1')
+		} else {
+			key = append(key, '0')
+		}
+	}
+	return string(key)
 }
 
 // Table represents a table in VSchema.
@@ -90,6 +166,13 @@ type Table struct {
 	Columns                 []Column             `json:"columns,omitempty"`
 	Pinned                  []byte               `json:"pinned,omitempty"`
 	ColumnListAuthoritative bool                 `json:"column_list_authoritative,omitempty"`
+	// ReadTabletType is the tablet type the reads of the table go to
+	// by default. It is UNKNOWN if they go to the tablet type of the
+	// session.
+	ReadTabletType topodatapb.TabletType `json:"read_tablet_type,omitempty"`
+	// Source is the table this table is materialized from. The writes
+	// of the table are routed to it.
+	Source *Table `json:"source,omitempty"`
 }
 
 // Keyspace contains the keyspcae info for each Table.
@@ -168,14 +251,15 @@ func BuildVSchema(source *vschemapb.SrvVSchema) (vschema *VSchema, err error) {
 	}
 	buildKeyspaces(source, vschema)
 	resolveAutoIncrement(source, vschema)
+	resolveSources(source, vschema)
 	addDual(vschema)
 	buildRoutingRule(source, vschema)
 	return vschema, nil
 }
 
 // BuildKeyspaceSchema builds the vschema portion for one keyspace.
-// The build ignores sequence references because those dependencies can
-// go cross-keyspace.
+// The build ignores sequence and source references because those
+// dependencies can go cross-keyspace.
 func BuildKeyspaceSchema(input *vschemapb.Keyspace, keyspace string) (*KeyspaceSchema, error) {
 	if input == nil {
 		input = &vschemapb.Keyspace{}
@@ -260,6 +344,20 @@ func buildTables(ks *vschemapb.Keyspace, vschema *VSchema, ksvschema *KeyspaceSc
 			t.Pinned = decoded
 		}
 
+		if table.ReadTabletType != "" {
+			tabletType, err := topoproto.ParseTabletType(table.ReadTabletType)
+			if err != nil {
+				return err
+			}
+			if tabletType != topodatapb.TabletType_REPLICA && tabletType != topodatapb.TabletType_RDONLY {
+				return fmt.Errorf("read tablet type must be replica or rdonly, not %s, for table: %s", table.ReadTabletType, tname)
+			}
+			if t.Type == TypeSequence {
+				return fmt.Errorf("sequence table cannot have a read tablet type: %s", tname)
+			}
+			t.ReadTabletType = tabletType
+		}
+
 		// If keyspace is sharded, then any table that's not a reference or pinned must have vindexes.
 		if keyspace.Sharded && t.Type != TypeReference && table.Pinned == "" && len(table.ColumnVindexes) == 0 {
 			return fmt.Errorf("missing primary col vindex for table: %s", tname)
@@ -371,6 +469,40 @@ func resolveAutoIncrement(source *vschemapb.SrvVSchema, vschema *VSchema) {
 	}
 }
 
+// resolveSources resolves the source tables of the materialized tables.
+// A source must be in another keyspace, and must not be materialized
+// itself.
+func resolveSources(source *vschemapb.SrvVSchema, vschema *VSchema) {
+	for ksname, ks := range source.Keyspaces {
+		ksvschema := vschema.Keyspaces[ksname]
+		for tname, table := range ks.Tables {
+			t := ksvschema.Tables[tname]
+			if t == nil || table.Source == "" {
+				continue
+			}
+			srcks, srctab, err := sqlparser.ParseTable(table.Source)
+			var src *Table
+			switch {
+			case err != nil:
+			case srcks == "" || srcks == ksname:
+				err = fmt.Errorf("source must be qualified by another keyspace")
+			case source.Keyspaces[srcks].GetTables()[srctab].GetSource() != "":
+				err = fmt.Errorf("source is materialized from %s", source.Keyspaces[srcks].Tables[srctab].Source)
+			default:
+				src, err = vschema.FindTable(srcks, srctab)
+			}
+			if err != nil {
+				// Better to remove the table than to route its writes to itself.
+				delete(ksvschema.Tables, tname)
+				delete(vschema.uniqueTables, tname)
+				ksvschema.Error = fmt.Errorf("cannot resolve source %s: %v", table.Source, err)
+				continue
+			}
+			t.Source = src
+		}
+	}
+}
+
 // addDual adds dual as a valid table to all keyspaces.
 // For sharded keyspaces, it gets pinned against keyspace id '0x00'.
 func addDual(vschema *VSchema) {
@@ -413,30 +545,98 @@ outer:
 				}
 				continue outer
 			}
-			toks, totabname, err := sqlparser.ParseTable(toTable)
+			t, err := vschema.findRoutingTarget(rule.FromTable, toTable)
 			if err != nil {
 				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
 					Error: err,
 				}
 				continue outer
 			}
-			if toks == "" {
-				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
-					Error: fmt.Errorf("table %s must be qualified", toTable),
-				}
-				continue outer
-			}
-			t, err := vschema.FindTable(toks, totabname)
-			if err != nil {
+			rr.Tables = append(rr.Tables, t)
+		}
+		if rule.ShiftToTable != "" || rule.ShiftPercent != 0 {
+			if err := buildShift(rule, rr, vschema); err != nil {
 				vschema.RoutingRules[rule.FromTable] = &RoutingRule{
 					Error: err,
 				}
-				continue outer
+				continue
 			}
-			rr.Tables = append(rr.Tables, t)
 		}
 		vschema.RoutingRules[rule.FromTable] = rr
 	}
+
+	for name, rr := range vschema.RoutingRules {
+		if rr.ShiftTable != nil {
+			vschema.shiftRules = append(vschema.shiftRules, name)
+		}
+	}
+	sort.Strings(vschema.shiftRules)
+}
+
+// keyspaceRouteSuffix ends the names of the routing rules routing all the
+// tables of a keyspace, like ks.* or ks.*@replica, and of their targets.
+const keyspaceRouteSuffix = ".*"
+
+// isKeyspaceRoute returns true if name is the name of a keyspace route,
+// with or without a tablet type suffix.
+func isKeyspaceRoute(name string) bool {
+	if i := strings.LastIndexByte(name, '@'); i >= 0 {
+		name = name[:i]
+	}
+	return strings.HasSuffix(name, keyspaceRouteSuffix)
+}
+
+// findRoutingTarget returns the target toTable of the routing rule
+// fromTable. The target of a keyspace route is a keyspace, returned as its
+// table named *.
+func (vschema *VSchema) findRoutingTarget(fromTable, toTable string) (*Table, error) {
+	if isKeyspaceRoute(fromTable) != strings.HasSuffix(toTable, keyspaceRouteSuffix) {
+		return nil, fmt.Errorf("table %s cannot route to %s: the keyspaces route to keyspaces, and the tables to tables", fromTable, toTable)
+	}
+	if isKeyspaceRoute(fromTable) {
+		keyspace := strings.TrimSuffix(toTable, keyspaceRouteSuffix)
+		ks, ok := vschema.Keyspaces[keyspace]
+		if !ok {
+			return nil, fmt.Errorf("keyspace %s not found in vschema", keyspace)
+		}
+		return &Table{Name: sqlparser.NewTableIdent("*"), Keyspace: ks.Keyspace}, nil
+	}
+	toks, totabname, err := sqlparser.ParseTable(toTable)
+	if err != nil {
+		return nil, err
+	}
+	if toks == "" {
+		return nil, fmt.Errorf("table %s must be qualified", toTable)
+	}
+	return vschema.FindTable(toks, totabname)
+}
+
+// buildShift sets the table, or the keyspace for a keyspace route,
+// receiving the shifted sessions of a routing rule. The sessions are
+// pinned to their target, so that all the writes of a session go to the
+// same primary.
+func buildShift(rule *vschemapb.RoutingRule, rr *RoutingRule, vschema *VSchema) error {
+	if rule.ShiftToTable == "" {
+		return fmt.Errorf("table %s shifts %d percents of the sessions but has no shift target", rule.FromTable, rule.ShiftPercent)
+	}
+	if rule.ShiftPercent > 100 {
+		return fmt.Errorf("table %s shifts %d percents of the sessions, more than 100", rule.FromTable, rule.ShiftPercent)
+	}
+	if len(rr.Tables) != 1 {
+		return fmt.Errorf("table %s shifts sessions to %s but has no target to shift them from", rule.FromTable, rule.ShiftToTable)
+	}
+	t, err := vschema.findRoutingTarget(rule.FromTable, rule.ShiftToTable)
+	if err != nil {
+		return err
+	}
+	// The transactions keep using the keyspace they already used, which
+	// tells the targets apart only in different keyspaces.
+	if t.Keyspace.Name == rr.Tables[0].Keyspace.Name {
+		return fmt.Errorf("table %s shifts sessions to %s in the same keyspace", rule.FromTable, rule.ShiftToTable)
+	}
+	rr.ShiftTable = t
+	rr.ShiftPercent = rule.ShiftPercent
+	return nil
 }
 
 // FindTable returns a pointer to the Table. If a keyspace is specified, only tables
@@ -495,6 +695,12 @@ func (vschema *VSchema) findTable(keyspace, tablename string) (*Table, error) {
 
 // FindRoutedTable finds a table checking the routing rules.
 func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType topodatapb.TabletType) (*Table, error) {
+	return vschema.FindShiftedTable(keyspace, tablename, tabletType, ShiftByBucket(NoShiftBucket))
+}
+
+// FindShiftedTable finds a table checking the routing rules, for a session
+// shifted by the rules of shifted.
+func (vschema *VSchema) FindShiftedTable(keyspace, tablename string, tabletType topodatapb.TabletType, shifted ShiftFunc) (*Table, error) {
 	qualified := tablename
 	if keyspace != "" {
 		qualified = keyspace + "." + tablename
@@ -502,7 +708,12 @@ func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType t
 	fqtn := qualified + TabletTypeSuffix[tabletType]
 	// First look for a fully qualified table name: ks.t@master.
 	// Then look for one without tablet type: ks.t.
-	for _, name := range []string{fqtn, qualified} {
+	// Then look for the routes of the keyspace: ks.*@master, then ks.*.
+	names := []string{fqtn, qualified}
+	if keyspace != "" {
+		names = append(names, keyspace+keyspaceRouteSuffix+TabletTypeSuffix[tabletType], keyspace+keyspaceRouteSuffix)
+	}
+	for _, name := range names {
 		rr, ok := vschema.RoutingRules[name]
 		if ok {
 			if rr.Error != nil {
@@ -511,7 +722,14 @@ func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType t
 			if len(rr.Tables) == 0 {
 				return nil, fmt.Errorf("table %s has been disabled", tablename)
 			}
-			return rr.Tables[0], nil
+			target := rr.Tables[0]
+			if rr.ShiftTable != nil && shifted(rr) {
+				target = rr.ShiftTable
+			}
+			if isKeyspaceRoute(name) {
+				return vschema.findTable(target.Keyspace.Name, tablename)
+			}
+			return target, nil
 		}
 	}
 	return vschema.findTable(keyspace, tablename)
@@ -519,7 +737,13 @@ func (vschema *VSchema) FindRoutedTable(keyspace, tablename string, tabletType t
 
 // FindTableOrVindex finds a table or a Vindex by name using Find and FindVindex.
 func (vschema *VSchema) FindTableOrVindex(keyspace, name string, tabletType topodatapb.TabletType) (*Table, Vindex, error) {
-	tables, err := vschema.FindRoutedTable(keyspace, name, tabletType)
+	return vschema.FindShiftedTableOrVindex(keyspace, name, tabletType, ShiftByBucket(NoShiftBucket))
+}
+
+// FindShiftedTableOrVindex is like FindTableOrVindex, for a session
+// shifted by the rules of shifted.
+func (vschema *VSchema) FindShiftedTableOrVindex(keyspace, name string, tabletType topodatapb.TabletType, shifted ShiftFunc) (*Table, Vindex, error) {
+	tables, err := vschema.FindShiftedTable(keyspace, name, tabletType, shifted)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -812,6 +812,248 @@ func TestVSchemaRoutingRules(t *testing.T) {
 	assert.Equal(t, string(wantb), string(gotb), string(gotb))
 }
 
+func TestVSchemaShiftRoutingRules(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable:    "t1@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 30,
+			}, {
+				FromTable:    "t1@rdonly",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 60,
+			}, {
+				FromTable:    "staged@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+			}, {
+				FromTable:    "all@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 100,
+			}, {
+				FromTable:    "primary",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 10,
+			}, {
+				FromTable:    "primary@master",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 10,
+			}, {
+				FromTable:    "notarget@replica",
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 10,
+			}, {
+				FromTable:    "noshift@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftPercent: 10,
+			}, {
+				FromTable:    "toomany@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 101,
+			}, {
+				FromTable:    "unqualified@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "t1",
+				ShiftPercent: 10,
+			}, {
+				FromTable:    "notfound@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks3.t1",
+				ShiftPercent: 10,
+			}, {
+				FromTable:    "samekeyspace@replica",
+				ToTables:     []string{"ks1.t1"},
+				ShiftToTable: "ks1.t2",
+				ShiftPercent: 10,
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {},
+				},
+			},
+			"ks2": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	t1 := vschema.Keyspaces["ks1"].Tables["t1"]
+	shifted := vschema.Keyspaces["ks2"].Tables["t1"]
+
+	errorTests := []struct {
+		name       string
+		tabletType topodatapb.TabletType
+		wantErr    string
+	}{
+		{name: "notarget", tabletType: topodatapb.TabletType_REPLICA, wantErr: "table notarget@replica shifts sessions to ks2.t1 but has no target to shift them from"},
+		{name: "noshift", tabletType: topodatapb.TabletType_REPLICA, wantErr: "table noshift@replica shifts 10 percents of the sessions but has no shift target"},
+		{name: "toomany", tabletType: topodatapb.TabletType_REPLICA, wantErr: "table toomany@replica shifts 101 percents of the sessions, more than 100"},
+		{name: "unqualified", tabletType: topodatapb.TabletType_REPLICA, wantErr: "table t1 must be qualified"},
+		{name: "notfound", tabletType: topodatapb.TabletType_REPLICA, wantErr: "keyspace ks3 not found in vschema"},
+		{name: "samekeyspace", tabletType: topodatapb.TabletType_REPLICA, wantErr: "table samekeyspace@replica shifts sessions to ks1.t2 in the same keyspace"},
+	}
+	for _, tcase := range errorTests {
+		_, err := vschema.FindShiftedTable("", tcase.name, tcase.tabletType, ShiftByBucket(0))
+		assert.EqualError(t, err, tcase.wantErr, tcase.name)
+	}
+
+	tests := []struct {
+		name       string
+		tabletType topodatapb.TabletType
+		bucket     uint32
+		want       *Table
+		key        string
+	}{
+		{name: "t1", tabletType: topodatapb.TabletType_REPLICA, bucket: 0, want: shifted, key: "111011"},
+		{name: "t1", tabletType: topodatapb.TabletType_REPLICA, bucket: 29, want: shifted, key: "100011"},
+		{name: "t1", tabletType: topodatapb.TabletType_REPLICA, bucket: 30, want: t1, key: "100010"},
+		{name: "t1", tabletType: topodatapb.TabletType_RDONLY, bucket: 30, want: shifted, key: "100010"},
+		{name: "t1", tabletType: topodatapb.TabletType_RDONLY, bucket: 60, want: t1, key: "100000"},
+		{name: "staged", tabletType: topodatapb.TabletType_REPLICA, bucket: 0, want: t1, key: "111011"},
+		{name: "all", tabletType: topodatapb.TabletType_REPLICA, bucket: 99, want: shifted, key: "100000"},
+		{name: "all", tabletType: topodatapb.TabletType_REPLICA, bucket: NoShiftBucket, want: t1, key: "000000"},
+		// The primary routes shift sessions too: all the writes of a session
+		// go to its target.
+		{name: "primary", tabletType: topodatapb.TabletType_MASTER, bucket: 9, want: shifted, key: "111011"},
+		{name: "primary", tabletType: topodatapb.TabletType_MASTER, bucket: 10, want: t1, key: "100011"},
+		{name: "primary", tabletType: topodatapb.TabletType_REPLICA, bucket: 9, want: shifted, key: "111011"},
+	}
+	for _, tcase := range tests {
+		got, err := vschema.FindShiftedTable("", tcase.name, tcase.tabletType, ShiftByBucket(tcase.bucket))
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, "%s%s in bucket %d", tcase.name, TabletTypeSuffix[tcase.tabletType], tcase.bucket)
+		assert.Equal(t, tcase.key, vschema.ShiftKey(ShiftByBucket(tcase.bucket)), "key of bucket %d", tcase.bucket)
+	}
+
+	got, err := vschema.FindRoutedTable("", "all", topodatapb.TabletType_REPLICA)
+	require.NoError(t, err)
+	assert.Equal(t, t1, got)
+
+	data, err := json.Marshal(vschema.RoutingRules["t1@replica"])
+	require.NoError(t, err)
+	assert.Equal(t, `{"tables":["ks1.t1"],"shift_table":"ks2.t1","shift_percent":30}`, string(data))
+}
+
+func TestVSchemaShiftKeyspaceRoutes(t *testing.T) {
+	input := vschemapb.SrvVSchema{
+		RoutingRules: &vschemapb.RoutingRules{
+			Rules: []*vschemapb.RoutingRule{{
+				FromTable:    "ks1.*",
+				ToTables:     []string{"ks1.*"},
+				ShiftToTable: "ks2.*",
+				ShiftPercent: 20,
+			}, {
+				FromTable: "ks1.*@replica",
+				ToTables:  []string{"ks2.*"},
+			}, {
+				FromTable: "ks1.t2",
+				ToTables:  []string{"ks1.t2"},
+			}, {
+				FromTable: "bad.*",
+				ToTables:  []string{"ks1.t1"},
+			}, {
+				FromTable: "t3",
+				ToTables:  []string{"ks1.*"},
+			}, {
+				FromTable: "ks4.*",
+				ToTables:  []string{"ks9.*"},
+			}, {
+				FromTable:    "ks5.*",
+				ToTables:     []string{"ks1.*"},
+				ShiftToTable: "ks1.*",
+				ShiftPercent: 10,
+			}, {
+				FromTable:    "ks6.*",
+				ToTables:     []string{"ks1.*"},
+				ShiftToTable: "ks2.t1",
+				ShiftPercent: 10,
+			}},
+		},
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks1": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {},
+				},
+			},
+			"ks2": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {},
+				},
+			},
+		},
+	}
+	vschema, err := BuildVSchema(&input)
+	require.NoError(t, err)
+	ks1t1 := vschema.Keyspaces["ks1"].Tables["t1"]
+	ks1t2 := vschema.Keyspaces["ks1"].Tables["t2"]
+	ks2t1 := vschema.Keyspaces["ks2"].Tables["t1"]
+
+	errorTests := []struct {
+		keyspace, name string
+		wantErr        string
+	}{
+		{keyspace: "bad", name: "t1", wantErr: "table bad.* cannot route to ks1.t1: the keyspaces route to keyspaces, and the tables to tables"},
+		{name: "t3", wantErr: "table t3 cannot route to ks1.*: the keyspaces route to keyspaces, and the tables to tables"},
+		{keyspace: "ks4", name: "t1", wantErr: "keyspace ks9 not found in vschema"},
+		{keyspace: "ks5", name: "t1", wantErr: "table ks5.* shifts sessions to ks1.* in the same keyspace"},
+		{keyspace: "ks6", name: "t1", wantErr: "table ks6.* cannot route to ks2.t1: the keyspaces route to keyspaces, and the tables to tables"},
+	}
+	for _, tcase := range errorTests {
+		_, err := vschema.FindShiftedTable(tcase.keyspace, tcase.name, topodatapb.TabletType_MASTER, ShiftByBucket(0))
+		assert.EqualError(t, err, tcase.wantErr, tcase.name)
+	}
+
+	tests := []struct {
+		name       string
+		tabletType topodatapb.TabletType
+		bucket     uint32
+		want       *Table
+	}{
+		{name: "t1", tabletType: topodatapb.TabletType_MASTER, bucket: 19, want: ks2t1},
+		{name: "t1", tabletType: topodatapb.TabletType_MASTER, bucket: 20, want: ks1t1},
+		{name: "t1", tabletType: topodatapb.TabletType_REPLICA, bucket: NoShiftBucket, want: ks2t1},
+		// The rules of the tables come first.
+		{name: "t2", tabletType: topodatapb.TabletType_MASTER, bucket: 0, want: ks1t2},
+	}
+	for _, tcase := range tests {
+		got, err := vschema.FindShiftedTable("ks1", tcase.name, tcase.tabletType, ShiftByBucket(tcase.bucket))
+		require.NoError(t, err)
+		assert.Equal(t, tcase.want, got, "ks1.%s%s in bucket %d", tcase.name, TabletTypeSuffix[tcase.tabletType], tcase.bucket)
+	}
+
+	// The tables of the target keyspace are found like without the route.
+	got, err := vschema.FindShiftedTable("ks1", "t3", topodatapb.TabletType_MASTER, ShiftByBucket(0))
+	require.NoError(t, err)
+	assert.Equal(t, "ks2", got.Keyspace.Name)
+	assert.Equal(t, "t3", got.Name.String())
+
+	data, err := json.Marshal(vschema.RoutingRules["ks1.*"])
+	require.NoError(t, err)
+	assert.Equal(t, `{"tables":["ks1.*"],"shift_table":"ks2.*","shift_percent":20}`, string(data))
+}
+
+func TestShiftBucket(t *testing.T) {
+	assert.EqualValues(t, NoShiftBucket, ShiftBucket(""))
+	bucket := ShiftBucket("a9d9c0b6-0d58-4d8d-9c1e-6c4e5a1b2f3d")
+	assert.Less(t, bucket, uint32(NoShiftBucket))
+	assert.Equal(t, bucket, ShiftBucket("a9d9c0b6-0d58-4d8d-9c1e-6c4e5a1b2f3d"))
+}
+
 func TestChooseVindexForType(t *testing.T) {
 	testcases := []struct {
 		in  querypb.Type
@@ -2114,6 +2356,109 @@ func TestBadShardedSequence(t *testing.T) {
 	}
 }
 
+func TestVSchemaReadTabletType(t *testing.T) {
+	good := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"unsharded": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {
+						ReadTabletType: "replica",
+					},
+					"t2": {},
+				},
+			},
+		},
+	}
+	got, err := BuildVSchema(&good)
+	require.NoError(t, err)
+	ks := got.Keyspaces["unsharded"]
+	require.NoError(t, ks.Error)
+	assert.Equal(t, topodatapb.TabletType_REPLICA, ks.Tables["t1"].ReadTabletType)
+	assert.Equal(t, topodatapb.TabletType_UNKNOWN, ks.Tables["t2"].ReadTabletType)
+
+	testcases := []struct {
+		table *vschemapb.Table
+		err   string
+	}{{
+		table: &vschemapb.Table{ReadTabletType: "master"},
+		err:   "read tablet type must be replica or rdonly, not master, for table: t1",
+	}, {
+		table: &vschemapb.Table{ReadTabletType: "nope"},
+		err:   "unknown TabletType nope",
+	}, {
+		table: &vschemapb.Table{Type: "sequence", ReadTabletType: "rdonly"},
+		err:   "sequence table cannot have a read tablet type: t1",
+	}}
+	for _, tcase := range testcases {
+		bad := &vschemapb.Keyspace{
+			Tables: map[string]*vschemapb.Table{
+				"t1": tcase.table,
+			},
+		}
+		_, err := BuildKeyspaceSchema(bad, "unsharded")
+		assert.EqualError(t, err, tcase.err)
+	}
+}
+
+func TestVSchemaSource(t *testing.T) {
+	source := vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"global": {
+				Tables: map[string]*vschemapb.Table{
+					"countries": {},
+				},
+			},
+			"sharded": {
+				Sharded: true,
+				Tables: map[string]*vschemapb.Table{
+					"countries": {
+						Type:   "reference",
+						Source: "global.countries",
+					},
+					"self": {
+						Type:   "reference",
+						Source: "sharded.countries",
+					},
+					"unqualified": {
+						Type:   "reference",
+						Source: "countries",
+					},
+					"missing": {
+						Type:   "reference",
+						Source: "nokeyspace.countries",
+					},
+				},
+			},
+			"chained": {
+				Tables: map[string]*vschemapb.Table{
+					"countries": {
+						Source: "sharded.countries",
+					},
+				},
+			},
+		},
+	}
+	got, err := BuildVSchema(&source)
+	require.NoError(t, err)
+
+	countries := got.Keyspaces["sharded"].Tables["countries"]
+	require.NotNil(t, countries)
+	assert.Equal(t, got.Keyspaces["global"].Tables["countries"], countries.Source)
+	assert.Nil(t, got.Keyspaces["global"].Tables["countries"].Source)
+
+	// The tables that failed to resolve are removed.
+	for _, name := range []string{"self", "unqualified", "missing"} {
+		assert.Nil(t, got.Keyspaces["sharded"].Tables[name], name)
+	}
+	assert.Error(t, got.Keyspaces["sharded"].Error)
+	assert.EqualError(t, got.Keyspaces["chained"].Error, "cannot resolve source sharded.countries: source is materialized from global.countries")
+	assert.Nil(t, got.Keyspaces["chained"].Tables["countries"])
+
+	// BuildKeyspaceSchema ignores the sources.
+	_, err = BuildKeyspaceSchema(source.Keyspaces["chained"], "chained")
+	assert.NoError(t, err)
+}
+
 func TestFindTable(t *testing.T) {
 	input := vschemapb.SrvVSchema{
 		Keyspaces: map[string]*vschemapb.Keyspace{
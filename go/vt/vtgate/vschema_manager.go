@@ -25,6 +25,7 @@ import (
 
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
@@ -38,6 +39,13 @@ type VSchemaManager struct {
 	e                 *Executor
 	mu                sync.Mutex
 	currentSrvVschema *vschemapb.SrvVSchema
+	schema            SchemaInfo
+}
+
+// SchemaInfo is the interface to the schema tracked from the tablets.
+type SchemaInfo interface {
+	// Tables returns the columns of the tables of the keyspace.
+	Tables(ks string) map[string][]vindexes.Column
 }
 
 //GetCurrentVschema return the denormalized VSchema from SrvVSchema
@@ -87,10 +95,11 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 			}
 		}
 
-		// keep a copy of the latest SrvVschema
+		// keep a copy of the latest SrvVschema. The lock is held until
+		// the vschema is saved so that Rebuild cannot save an older one.
 		vm.mu.Lock()
+		defer vm.mu.Unlock()
 		vm.currentSrvVschema = v
-		vm.mu.Unlock()
 
 		// Transform the provided SrvVSchema into a VSchema.
 		var vschema *vindexes.VSchema
@@ -102,6 +111,8 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 				if vschemaCounters != nil {
 					vschemaCounters.Add("Parsing", 1)
 				}
+			} else {
+				vm.updateFromSchema(vschema)
 			}
 		}
 		if v == nil {
@@ -131,6 +142,54 @@ func (vm *VSchemaManager) watchSrvVSchema(ctx context.Context, cell string) {
 	})
 }
 
+// startSchemaTracking makes the vschema use the columns of the tracked
+// schema, and rebuilds it whenever the tracked schema changes.
+func (vm *VSchemaManager) startSchemaTracking(st *vtschema.Tracker) {
+	vm.mu.Lock()
+	vm.schema = st
+	vm.mu.Unlock()
+	st.RegisterSignalReceiver(vm.Rebuild)
+	st.Start()
+}
+
+// Rebuild rebuilds the vschema from the latest SrvVSchema and the tracked
+// schema. It is called when the tracked schema changes.
+func (vm *VSchemaManager) Rebuild() {
+	vm.mu.Lock()
+	defer vm.mu.Unlock()
+	v := vm.currentSrvVschema
+	if v == nil {
+		return
+	}
+	vschema, err := vindexes.BuildVSchema(v)
+	if err != nil {
+		log.Warningf("Error creating VSchema from the tracked schema: %v", err)
+		return
+	}
+	vm.updateFromSchema(vschema)
+	vm.e.SaveVSchema(vschema, NewVSchemaStats(vschema, ""))
+}
+
+// updateFromSchema sets the columns of the vschema tables from the tracked
+// schema. Tables with an authoritative column list in the vschema are left
+// untouched.
+func (vm *VSchemaManager) updateFromSchema(vschema *vindexes.VSchema) {
+	if vm.schema == nil {
+		return
+	}
+	for ksName, ks := range vschema.Keyspaces {
+		tables := vm.schema.Tables(ksName)
+		for name, table := range ks.Tables {
+			columns, ok := tables[name]
+			if !ok || table.ColumnListAuthoritative {
+				continue
+			}
+			table.Columns = columns
+			table.ColumnListAuthoritative = true
+		}
+	}
+}
+
 // UpdateVSchema propagates the updated vschema to the topo. The entry for
 // the given keyspace is updated in the global topo, and the full SrvVSchema
 // is updated in all known cells.
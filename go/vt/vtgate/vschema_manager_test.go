@@ -0,0 +1,77 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vtgate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vtgate/vindexes"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+type fakeSchemaInfo map[string]map[string][]vindexes.Column
+
+func (f fakeSchemaInfo) Tables(ks string) map[string][]vindexes.Column {
+	return f[ks]
+}
+
+func TestVSchemaUpdateFromSchema(t *testing.T) {
+	trackedCols := []vindexes.Column{
+		{Name: sqlparser.NewColIdent("id"), Type: sqltypes.Int64},
+		{Name: sqlparser.NewColIdent("name"), Type: sqltypes.VarChar},
+	}
+	vm := &VSchemaManager{schema: fakeSchemaInfo{
+		"ks": {
+			"t1": trackedCols,
+			"t2": trackedCols,
+		},
+	}}
+
+	vschema, err := vindexes.BuildVSchema(&vschemapb.SrvVSchema{
+		Keyspaces: map[string]*vschemapb.Keyspace{
+			"ks": {
+				Tables: map[string]*vschemapb.Table{
+					"t1": {},
+					"t2": {
+						Columns:                 []*vschemapb.Column{{Name: "c"}},
+						ColumnListAuthoritative: true,
+					},
+					"t3": {},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	vm.updateFromSchema(vschema)
+
+	tables := vschema.Keyspaces["ks"].Tables
+	// tracked tables get the tracked columns
+	assert.True(t, tables["t1"].ColumnListAuthoritative)
+	assert.Equal(t, trackedCols, tables["t1"].Columns)
+	// authoritative vschema columns are kept
+	require.Len(t, tables["t2"].Columns, 1)
+	assert.Equal(t, "c", tables["t2"].Columns[0].Name.String())
+	// untracked tables are unchanged
+	assert.False(t, tables["t3"].ColumnListAuthoritative)
+	assert.Empty(t, tables["t3"].Columns)
+}
@@ -34,6 +34,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vstreamformat"
 )
 
 // vstreamManager manages vstream requests.
@@ -41,6 +42,7 @@ type vstreamManager struct {
 	resolver *srvtopo.Resolver
 	toposerv srvtopo.Server
 	cell     string
+	registry *vstreamformat.Registry
 }
 
 // vstream contains the metadata for one VStream request.
@@ -86,6 +88,10 @@ type vstream struct {
 	// the timestamp of the most recent event, keyed by streamId. streamId is of the form <keyspace>.<shard>
 	timestamps map[string]int64
 
+	// encoder encodes the row changes in the format requested by the client.
+	// It's nil if the client didn't request a format.
+	encoder *vstreamformat.Encoder
+
 	vsm *vstreamManager
 }
 
@@ -96,11 +102,15 @@ type journalEvent struct {
 }
 
 func newVStreamManager(resolver *srvtopo.Resolver, serv srvtopo.Server, cell string) *vstreamManager {
-	return &vstreamManager{
+	vsm := &vstreamManager{
 		resolver: resolver,
 		toposerv: serv,
 		cell:     cell,
 	}
+	if *vstreamRegistryURL != "" {
+		vsm.registry = vstreamformat.NewRegistry(*vstreamRegistryURL)
+	}
+	return vsm
 }
 
 func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.TabletType, vgtid *binlogdatapb.VGtid,
@@ -109,6 +119,10 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 	if err != nil {
 		return err
 	}
+	encoder, err := vstreamformat.NewEncoder(flags.Format, vsm.registry)
+	if err != nil {
+		return err
+	}
 	vs := &vstream{
 		vgtid:      vgtid,
 		tabletType: tabletType,
@@ -120,6 +134,7 @@ func (vsm *vstreamManager) VStream(ctx context.Context, tabletType topodatapb.Ta
 		minimizeSkew:       flags.MinimizeSkew,
 		skewTimeoutSeconds: 10 * 60,
 		timestamps:         make(map[string]int64),
+		encoder:            encoder,
 		vsm:                vsm,
 	}
 	return vs.stream(ctx)
@@ -323,6 +338,8 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	// journalDone is assigned a channel when a journal event is encountered.
 	// It will be closed when all journal events converge.
 	var journalDone chan struct{}
+	// tables contains the encoders of the tables, if the client requested a format.
+	tables := make(map[string]*vstreamformat.Table)
 
 	errCount := 0
 	for {
@@ -371,11 +388,17 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 					// duplicate table names.
 					ev := proto.Clone(event).(*binlogdatapb.VEvent)
 					ev.FieldEvent.TableName = sgtid.Keyspace + "." + ev.FieldEvent.TableName
+					if err := vs.encodeFields(ctx, tables, ev.FieldEvent); err != nil {
+						return err
+					}
 					sendevents = append(sendevents, ev)
 				case binlogdatapb.VEventType_ROW:
 					// Update table names and send.
 					ev := proto.Clone(event).(*binlogdatapb.VEvent)
 					ev.RowEvent.TableName = sgtid.Keyspace + "." + ev.RowEvent.TableName
+					if err := vs.encodeRows(tables, ev.RowEvent); err != nil {
+						return err
+					}
 					sendevents = append(sendevents, ev)
 				case binlogdatapb.VEventType_COMMIT, binlogdatapb.VEventType_DDL, binlogdatapb.VEventType_OTHER:
 					sendevents = append(sendevents, event)
@@ -447,6 +470,40 @@ func (vs *vstream) streamFromTablet(ctx context.Context, sgtid *binlogdatapb.Sha
 	}
 }
 
+// encodeFields builds the encoder of the table of a field event,
+// and adds its schema to the event.
+func (vs *vstream) encodeFields(ctx context.Context, tables map[string]*vstreamformat.Table, fieldEvent *binlogdatapb.FieldEvent) error {
+	if vs.encoder == nil {
+		return nil
+	}
+	table, err := vs.encoder.NewTable(ctx, fieldEvent.TableName, fieldEvent.Fields)
+	if err != nil {
+		return err
+	}
+	tables[fieldEvent.TableName] = table
+	fieldEvent.EncodedSchema = table.Schema
+	return nil
+}
+
+// encodeRows encodes the row changes of a row event.
+func (vs *vstream) encodeRows(tables map[string]*vstreamformat.Table, rowEvent *binlogdatapb.RowEvent) error {
+	if vs.encoder == nil {
+		return nil
+	}
+	table, ok := tables[rowEvent.TableName]
+	if !ok {
+		return vterrors.Errorf(vtrpcpb.Code_INTERNAL, "no field event received for table %s", rowEvent.TableName)
+	}
+	for _, change := range rowEvent.RowChanges {
+		encoded, err := table.Encode(change)
+		if err != nil {
+			return err
+		}
+		change.Encoded = encoded
+	}
+	return nil
+}
+
 // sendAll sends a group of events together while holding the lock.
 func (vs *vstream) sendAll(sgtid *binlogdatapb.ShardGtid, eventss [][]*binlogdatapb.VEvent) error {
 	vs.mu.Lock()
@@ -25,6 +25,7 @@ import (
 
 	vtgatepb "vitess.io/vitess/go/vt/proto/vtgate"
 
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/vttablet/sandboxconn"
 
@@ -37,10 +38,12 @@ import (
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/proto/binlogdata"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/vstreamformat"
 )
 
 var mu sync.Mutex
@@ -211,6 +214,78 @@ func TestVStreamEvents(t *testing.T) {
 	<-ch
 }
 
+func TestVStreamFormat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	name := "TestVStream"
+	_ = createSandbox(name)
+	hc := discovery.NewFakeHealthCheck()
+	vsm := newTestVStreamManager(hc, new(sandboxTopo), "aa")
+	sbc0 := hc.AddTestTablet("aa", "1.1.1.1", 1001, name, "-20", topodatapb.TabletType_MASTER, true, 1, nil)
+
+	fields := []*querypb.Field{{Name: "id", Type: sqltypes.Int64}}
+	row := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(1)})
+	send := []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_GTID, Gtid: "gtid01"},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "t0", Fields: fields}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t0", RowChanges: []*binlogdatapb.RowChange{{After: row}}}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "t1"}},
+	}
+	sbc0.AddVStreamEvents(send, nil)
+
+	vgtid := &binlogdatapb.VGtid{
+		ShardGtids: []*binlogdatapb.ShardGtid{{
+			Keyspace: name,
+			Shard:    "-20",
+			Gtid:     "pos",
+		}},
+	}
+	err := vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{Format: "xml"}, func(events []*binlogdatapb.VEvent) error {
+		return nil
+	})
+	assert.EqualError(t, err, "unsupported vstream format: xml")
+
+	var got []*binlogdatapb.VEvent
+	err = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{Format: vstreamformat.FormatJSON}, func(events []*binlogdatapb.VEvent) error {
+		got = append(got, events...)
+		return nil
+	})
+	// Row events for a table without field event can't be encoded.
+	assert.EqualError(t, err, "target: TestVStream.-20.master: no field event received for table TestVStream.t1")
+	assert.Empty(t, got)
+
+	enc, err := vstreamformat.NewEncoder(vstreamformat.FormatJSON, nil)
+	require.NoError(t, err)
+	table, err := enc.NewTable(ctx, "TestVStream.t0", fields)
+	require.NoError(t, err)
+	encoded, err := table.Encode(&binlogdatapb.RowChange{After: row})
+	require.NoError(t, err)
+
+	send[3] = &binlogdatapb.VEvent{Type: binlogdatapb.VEventType_COMMIT}
+	sbc0.AddVStreamEvents(send, nil)
+	ch := make(chan *binlogdatapb.VStreamResponse)
+	go func() {
+		_ = vsm.VStream(ctx, topodatapb.TabletType_MASTER, vgtid, nil, &vtgatepb.VStreamFlags{Format: vstreamformat.FormatJSON}, func(events []*binlogdatapb.VEvent) error {
+			ch <- &binlogdatapb.VStreamResponse{Events: events}
+			return nil
+		})
+	}()
+	want := &binlogdatapb.VStreamResponse{Events: []*binlogdatapb.VEvent{
+		{Type: binlogdatapb.VEventType_VGTID, Vgtid: &binlogdatapb.VGtid{
+			ShardGtids: []*binlogdatapb.ShardGtid{{
+				Keyspace: name,
+				Shard:    "-20",
+				Gtid:     "gtid01",
+			}},
+		}},
+		{Type: binlogdatapb.VEventType_FIELD, FieldEvent: &binlogdatapb.FieldEvent{TableName: "TestVStream.t0", Fields: fields, EncodedSchema: table.Schema}},
+		{Type: binlogdatapb.VEventType_ROW, RowEvent: &binlogdatapb.RowEvent{TableName: "TestVStream.t0", RowChanges: []*binlogdatapb.RowChange{{After: row, Encoded: encoded}}}},
+		{Type: binlogdatapb.VEventType_COMMIT},
+	}}
+	verifyEvents(t, ch, want)
+}
+
 // TestVStreamChunks ensures that a transaction that's broken
 // into chunks is sent together.
 func TestVStreamChunks(t *testing.T) {
@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamformat
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/sqltypes"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// Avro primitive types used for the columns.
+const (
+	avroLong   = "long"
+	avroFloat  = "float"
+	avroDouble = "double"
+	avroBytes  = "bytes"
+	avroString = "string"
+)
+
+// avroType returns the Avro type of a column. Values that don't fit in
+// a long, like unsigned bigints and decimals, are sent as strings.
+func avroType(typ querypb.Type) string {
+	switch {
+	case typ == sqltypes.Uint64:
+		return avroString
+	case sqltypes.IsIntegral(typ):
+		return avroLong
+	case typ == sqltypes.Float32:
+		return avroFloat
+	case typ == sqltypes.Float64:
+		return avroDouble
+	case sqltypes.IsBinary(typ), typ == sqltypes.Bit, typ == sqltypes.Geometry:
+		return avroBytes
+	}
+	return avroString
+}
+
+// avroSchema returns the schema of the row changes of a table, in
+// parsing canonical form: the row change is a record with a nullable
+// before and after image of the row, and all columns are nullable.
+func avroSchema(table string, fields []*querypb.Field, types []string) string {
+	name := avroFullName(table)
+	buf := &strings.Builder{}
+	buf.WriteString(`{"name":"` + name + `.RowChange","type":"record","fields":[`)
+	buf.WriteString(`{"name":"before","type":["null",{"name":"` + name + `.Row","type":"record","fields":[`)
+	for i, field := range fields {
+		if i != 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString(`{"name":"` + avroName(field.Name) + `","type":["null","` + types[i] + `"]}`)
+	}
+	buf.WriteString(`]}]},`)
+	buf.WriteString(`{"name":"after","type":["null","` + name + `.Row"]}]}`)
+	return buf.String()
+}
+
+// avroFullName converts a qualified table name to an Avro full name.
+func avroFullName(table string) string {
+	parts := strings.Split(table, ".")
+	for i, part := range parts {
+		parts[i] = avroName(part)
+	}
+	return strings.Join(parts, ".")
+}
+
+// avroName replaces the characters that are not allowed in Avro names.
+func avroName(name string) string {
+	b := []byte(name)
+	for i, c := range b {
+		switch {
+		case c == '_', c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z':
+		case c >= '0' && c <= '9' && i != 0:
+		default:
+			b[i] = '_'
+		}
+	}
+	if len(b) == 0 {
+		return "_"
+	}
+	return string(b)
+}
+
+// avroMagic starts the single object encoding of a datum.
+var avroMagic = []byte{0xc3, 0x01}
+
+func (t *Table) encodeAvro(before, after []sqltypes.Value) ([]byte, error) {
+	var buf []byte
+	if t.SchemaID >= 0 {
+		// Confluent wire format: a zero byte and the big
+		// endian id of the schema in the registry.
+		buf = append(buf, 0)
+		buf = append(buf, byte(t.SchemaID>>24), byte(t.SchemaID>>16), byte(t.SchemaID>>8), byte(t.SchemaID))
+	} else {
+		buf = append(buf, avroMagic...)
+		var fp [8]byte
+		binary.LittleEndian.PutUint64(fp[:], t.Fingerprint)
+		buf = append(buf, fp[:]...)
+	}
+	var err error
+	for _, row := range [][]sqltypes.Value{before, after} {
+		if row == nil {
+			buf = appendLong(buf, 0)
+			continue
+		}
+		buf = appendLong(buf, 1)
+		for i, value := range row {
+			if buf, err = appendValue(buf, t.types[i], value); err != nil {
+				return nil, vterrors.Wrapf(err, "cannot encode column %s", t.fields[i].Name)
+			}
+		}
+	}
+	return buf, nil
+}
+
+// appendValue appends a nullable value: the index of
+// the branch of the union, and the value itself.
+func appendValue(buf []byte, typ string, value sqltypes.Value) ([]byte, error) {
+	if value.IsNull() {
+		return appendLong(buf, 0), nil
+	}
+	buf = appendLong(buf, 1)
+	switch typ {
+	case avroLong:
+		// Unsigned values that fit in a long are parsed as signed.
+		v, err := strconv.ParseInt(value.ToString(), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		return appendLong(buf, v), nil
+	case avroFloat:
+		v, err := strconv.ParseFloat(value.ToString(), 32)
+		if err != nil {
+			return nil, err
+		}
+		var b [4]byte
+		binary.LittleEndian.PutUint32(b[:], math.Float32bits(float32(v)))
+		return append(buf, b[:]...), nil
+	case avroDouble:
+		v, err := strconv.ParseFloat(value.ToString(), 64)
+		if err != nil {
+			return nil, err
+		}
+		var b [8]byte
+		binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+		return append(buf, b[:]...), nil
+	}
+	raw := value.Raw()
+	buf = appendLong(buf, int64(len(raw)))
+	return append(buf, raw...), nil
+}
+
+// appendLong appends the zig-zag varint encoding of v.
+func appendLong(buf []byte, v int64) []byte {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	return append(buf, b[:n]...)
+}
+
+// emptyFingerprint is the CRC-64-AVRO fingerprint of an empty input.
+const emptyFingerprint = 0xc15d213aa4d7a795
+
+var fingerprintTable = func() (table [256]uint64) {
+	for i := range table {
+		fp := uint64(i)
+		for j := 0; j < 8; j++ {
+			fp = (fp >> 1) ^ (emptyFingerprint & -(fp & 1))
+		}
+		table[i] = fp
+	}
+	return table
+}()
+
+// fingerprint returns the CRC-64-AVRO (Rabin) fingerprint of a schema.
+func fingerprint(schema []byte) uint64 {
+	fp := uint64(emptyFingerprint)
+	for _, b := range schema {
+		fp = (fp >> 8) ^ fingerprintTable[byte(fp)^b]
+	}
+	return fp
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package vstreamformat serializes the row changes of a VStream as Avro
+// or canonical JSON, so that they can be written to a message queue
+// without a translation layer.
+//
+// Every table gets an Avro schema: a record with a nullable before and
+// after image of the row. The schema is sent in the field event of the
+// table, and every encoded row change refers to it:
+//   - json: the row change is a JSON object with sorted keys, and the
+//     schema_fingerprint key holds the CRC-64-AVRO fingerprint of the schema.
+//   - avro: the row change uses the Avro single object encoding, which
+//     starts with the fingerprint of the schema. If a schema registry is
+//     configured, the schema is registered under the "<table>-value" subject,
+//     and the row change uses the Confluent wire format instead, which starts
+//     with the id of the schema in the registry.
+package vstreamformat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+const (
+	// FormatJSON encodes the row changes as canonical JSON.
+	FormatJSON = "json"
+	// FormatAvro encodes the row changes as Avro.
+	FormatAvro = "avro"
+)
+
+// Encoder encodes the row changes of a VStream in one format.
+type Encoder struct {
+	format   string
+	registry *Registry
+}
+
+// NewEncoder returns an Encoder for the format. It returns nil
+// if the format is empty, because the row changes are not encoded.
+// The registry can be nil.
+func NewEncoder(format string, registry *Registry) (*Encoder, error) {
+	switch format {
+	case "":
+		return nil, nil
+	case FormatJSON, FormatAvro:
+		return &Encoder{format: format, registry: registry}, nil
+	}
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsupported vstream format: %s", format)
+}
+
+// Table encodes the row changes of one table.
+type Table struct {
+	format string
+	fields []*querypb.Field
+	types  []string
+
+	// Schema is the Avro schema of the row changes, in parsing canonical form.
+	Schema string
+	// Fingerprint is the CRC-64-AVRO fingerprint of the schema.
+	Fingerprint uint64
+	// SchemaID is the id of the schema in the registry, or -1
+	// if there is no registry.
+	SchemaID int32
+}
+
+// NewTable builds the schema of the table from its fields. The name
+// is the qualified name of the table. The schema of an avro table is
+// registered if the Encoder has a registry.
+func (enc *Encoder) NewTable(ctx context.Context, name string, fields []*querypb.Field) (*Table, error) {
+	t := &Table{
+		format:   enc.format,
+		fields:   fields,
+		types:    make([]string, len(fields)),
+		SchemaID: -1,
+	}
+	for i, field := range fields {
+		t.types[i] = avroType(field.Type)
+	}
+	t.Schema = avroSchema(name, fields, t.types)
+	t.Fingerprint = fingerprint([]byte(t.Schema))
+	if enc.format == FormatAvro && enc.registry != nil {
+		id, err := enc.registry.Register(ctx, name+"-value", t.Schema)
+		if err != nil {
+			return nil, err
+		}
+		t.SchemaID = id
+	}
+	return t, nil
+}
+
+// Encode encodes the row change.
+func (t *Table) Encode(change *binlogdatapb.RowChange) ([]byte, error) {
+	before, err := t.makeRow(change.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := t.makeRow(change.After)
+	if err != nil {
+		return nil, err
+	}
+	if t.format == FormatJSON {
+		return t.encodeJSON(before, after)
+	}
+	return t.encodeAvro(before, after)
+}
+
+func (t *Table) makeRow(row *querypb.Row) ([]sqltypes.Value, error) {
+	if row == nil {
+		return nil, nil
+	}
+	if len(row.Lengths) != len(t.fields) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "row has %d columns, want %d", len(row.Lengths), len(t.fields))
+	}
+	return sqltypes.MakeRowTrusted(t.fields, row), nil
+}
+
+func (t *Table) encodeJSON(before, after []sqltypes.Value) ([]byte, error) {
+	change := map[string]interface{}{
+		"before":             t.jsonRow(before),
+		"after":              t.jsonRow(after),
+		"schema_fingerprint": fmt.Sprintf("%016x", t.Fingerprint),
+	}
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(change); err != nil {
+		return nil, vterrors.Wrap(err, "cannot encode row change")
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+func (t *Table) jsonRow(row []sqltypes.Value) interface{} {
+	if row == nil {
+		return nil
+	}
+	// The keys of a map are sorted by the encoder.
+	values := make(map[string]interface{}, len(row))
+	for i, value := range row {
+		values[t.fields[i].Name] = jsonValue(t.types[i], value)
+	}
+	return values
+}
+
+func jsonValue(typ string, value sqltypes.Value) interface{} {
+	switch {
+	case value.IsNull():
+		return nil
+	case value.IsIntegral() || value.IsFloat():
+		return json.Number(value.ToString())
+	case typ == avroBytes:
+		return value.Raw()
+	}
+	return value.ToString()
+}
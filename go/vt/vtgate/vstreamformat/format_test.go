@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamformat
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var testFields = []*querypb.Field{{
+	Name: "id",
+	Type: sqltypes.Int64,
+}, {
+	Name: "val",
+	Type: sqltypes.VarChar,
+}, {
+	Name: "data",
+	Type: sqltypes.VarBinary,
+}, {
+	Name: "price",
+	Type: sqltypes.Float64,
+}}
+
+func testRow(values ...sqltypes.Value) *querypb.Row {
+	return sqltypes.RowToProto3(values)
+}
+
+func TestNewEncoder(t *testing.T) {
+	enc, err := NewEncoder("", nil)
+	require.NoError(t, err)
+	assert.Nil(t, enc)
+
+	_, err = NewEncoder("xml", nil)
+	assert.EqualError(t, err, "unsupported vstream format: xml")
+}
+
+func TestFingerprint(t *testing.T) {
+	// Test vectors from the Avro specification.
+	assert.Equal(t, uint64(7195948357588979594), fingerprint([]byte(`"null"`)))
+	assert.Equal(t, uint64(8247732601305521295), fingerprint([]byte(`"int"`)))
+}
+
+func TestAvroSchema(t *testing.T) {
+	enc, err := NewEncoder(FormatAvro, nil)
+	require.NoError(t, err)
+	table, err := enc.NewTable(context.Background(), "ks.t-1", testFields)
+	require.NoError(t, err)
+	want := `{"name":"ks.t_1.RowChange","type":"record","fields":[` +
+		`{"name":"before","type":["null",{"name":"ks.t_1.Row","type":"record","fields":[` +
+		`{"name":"id","type":["null","long"]},` +
+		`{"name":"val","type":["null","string"]},` +
+		`{"name":"data","type":["null","bytes"]},` +
+		`{"name":"price","type":["null","double"]}]}]},` +
+		`{"name":"after","type":["null","ks.t_1.Row"]}]}`
+	assert.Equal(t, want, table.Schema)
+	assert.Equal(t, fingerprint([]byte(want)), table.Fingerprint)
+	assert.Equal(t, int32(-1), table.SchemaID)
+}
+
+func TestEncodeJSON(t *testing.T) {
+	enc, err := NewEncoder(FormatJSON, nil)
+	require.NoError(t, err)
+	table, err := enc.NewTable(context.Background(), "ks.t", testFields)
+	require.NoError(t, err)
+
+	got, err := table.Encode(&binlogdatapb.RowChange{
+		After: testRow(sqltypes.NewInt64(1), sqltypes.NewVarChar("<a>"), sqltypes.NewVarBinary("\x00"), sqltypes.NULL),
+	})
+	require.NoError(t, err)
+	want := `{"after":{"data":"AA==","id":1,"price":null,"val":"<a>"},"before":null,"schema_fingerprint":"` +
+		fmt.Sprintf("%016x", table.Fingerprint) + `"}`
+	assert.Equal(t, want, string(got))
+
+	_, err = table.Encode(&binlogdatapb.RowChange{
+		After: testRow(sqltypes.NewInt64(1)),
+	})
+	assert.EqualError(t, err, "row has 1 columns, want 4")
+}
+
+func TestEncodeAvro(t *testing.T) {
+	enc, err := NewEncoder(FormatAvro, nil)
+	require.NoError(t, err)
+	table, err := enc.NewTable(context.Background(), "ks.t", testFields)
+	require.NoError(t, err)
+
+	got, err := table.Encode(&binlogdatapb.RowChange{
+		Before: testRow(sqltypes.NewInt64(-2), sqltypes.NewVarChar("ab"), sqltypes.NULL, sqltypes.NewFloat64(1.5)),
+	})
+	require.NoError(t, err)
+	want := []byte{0xc3, 0x01}
+	fp := table.Fingerprint
+	for i := 0; i < 8; i++ {
+		want = append(want, byte(fp>>(8*i)))
+	}
+	want = append(want,
+		// before: the record branch of the union.
+		0x02,
+		// id: -2 as a zig-zag long.
+		0x02, 0x03,
+		// val: a string of length 2.
+		0x02, 0x04, 'a', 'b',
+		// data: null.
+		0x00,
+		// price: 1.5 as a little endian double.
+		0x02, 0, 0, 0, 0, 0, 0, 0xf8, 0x3f,
+		// after: null.
+		0x00,
+	)
+	assert.Equal(t, want, got)
+}
+
+func TestEncodeAvroRegistry(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		assert.Equal(t, "/subjects/ks.t-value/versions", r.URL.Path)
+		body, _ := ioutil.ReadAll(r.Body)
+		var req map[string]string
+		require.NoError(t, json.Unmarshal(body, &req))
+		assert.Contains(t, req["schema"], `"name":"ks.t.RowChange"`)
+		w.Write([]byte(`{"id":258}`))
+	}))
+	defer server.Close()
+
+	enc, err := NewEncoder(FormatAvro, NewRegistry(server.URL+"/"))
+	require.NoError(t, err)
+	table, err := enc.NewTable(context.Background(), "ks.t", testFields)
+	require.NoError(t, err)
+	assert.Equal(t, int32(258), table.SchemaID)
+
+	// The id of the schema is cached.
+	_, err = enc.NewTable(context.Background(), "ks.t", testFields)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	got, err := table.Encode(&binlogdatapb.RowChange{})
+	require.NoError(t, err)
+	assert.Equal(t, []byte{0, 0, 0, 1, 2, 0, 0}, got)
+}
+
+func TestRegistryError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		w.Write([]byte(`{"error_code":409}`))
+	}))
+	defer server.Close()
+
+	_, err := NewRegistry(server.URL).Register(context.Background(), "ks.t-value", `"int"`)
+	assert.EqualError(t, err, `cannot register schema for ks.t-value: 409 Conflict: {"error_code":409}`)
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vstreamformat
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+	"vitess.io/vitess/go/vt/vterrors"
+)
+
+// Registry registers Avro schemas in a Confluent schema registry.
+// The ids of the registered schemas are cached.
+type Registry struct {
+	url    string
+	client *http.Client
+
+	mu  sync.Mutex
+	ids map[string]int32
+}
+
+// NewRegistry returns a Registry for the schema registry at registryURL.
+func NewRegistry(registryURL string) *Registry {
+	return &Registry{
+		url:    strings.TrimSuffix(registryURL, "/"),
+		client: &http.Client{},
+		ids:    make(map[string]int32),
+	}
+}
+
+// Register registers the schema under the subject, and returns its id.
+// Registering a schema that's already registered returns the existing id.
+func (r *Registry) Register(ctx context.Context, subject, schema string) (int32, error) {
+	key := subject + "\n" + schema
+	r.mu.Lock()
+	id, ok := r.ids[key]
+	r.mu.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	body, err := json.Marshal(map[string]string{"schema": schema})
+	if err != nil {
+		return 0, err
+	}
+	req, err := http.NewRequest("POST", r.url+"/subjects/"+url.PathEscape(subject)+"/versions", bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/vnd.schemaregistry.v1+json")
+	resp, err := r.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, vterrors.Wrapf(err, "cannot register schema for %s", subject)
+	}
+	defer resp.Body.Close()
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return 0, vterrors.Wrapf(err, "cannot register schema for %s", subject)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return 0, vterrors.Errorf(vtrpcpb.Code_UNAVAILABLE, "cannot register schema for %s: %s: %s", subject, resp.Status, respBody)
+	}
+	var result struct {
+		ID int32 `json:"id"`
+	}
+	if err := json.Unmarshal(respBody, &result); err != nil {
+		return 0, vterrors.Wrapf(err, "cannot parse the response of the schema registry for %s", subject)
+	}
+
+	r.mu.Lock()
+	r.ids[key] = result.ID
+	r.mu.Unlock()
+	return result.ID, nil
+}
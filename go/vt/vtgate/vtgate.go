@@ -43,6 +43,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 
+	vtschema "vitess.io/vitess/go/vt/vtgate/schema"
 	"vitess.io/vitess/go/vt/vtgate/vtgateservice"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -60,11 +61,16 @@ var (
 	queryPlanCacheSize   = flag.Int64("gate_query_cache_size", cache.DefaultConfig.MaxEntries, "gate server query cache size, maximum number of queries to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a cache. This config controls the expected amount of unique entries in the cache.")
 	queryPlanCacheMemory = flag.Int64("gate_query_cache_memory", cache.DefaultConfig.MaxMemoryUsage, "gate server query cache size in bytes, maximum amount of memory to be cached. vtgate analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	queryPlanCacheLFU    = flag.Bool("gate_query_cache_lfu", cache.DefaultConfig.LFU, "gate server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
+	queryPlanCacheLRUMem = flag.Bool("gate_query_cache_lru_memory_based", false, "when the lru cache algorithm is used, limit the gate query cache by gate_query_cache_memory instead of gate_query_cache_size")
+	queryPlanCacheTTL    = flag.Duration("gate_query_cache_ttl", 0, "when the lru cache algorithm is used, the maximum time a plan stays in the gate query cache. 0 keeps the plans until they are evicted.")
+	preparedCacheSize    = flag.Int64("gate_prepared_statement_cache_size", 1000, "gate server prepared statement cache size, maximum number of prepared statements to be cached. The parsed and planned prepared statements are shared by all the sessions, so the connections preparing the same statements do not each parse and plan them. 0 disables the cache.")
 	_                    = flag.Bool("disable_local_gateway", false, "deprecated: if specified, this process will not route any queries to local tablets in the local cell")
 	maxMemoryRows        = flag.Int("max_memory_rows", 300000, "Maximum number of rows that will be held in memory for intermediate results as well as the final result.")
 	warnMemoryRows       = flag.Int("warn_memory_rows", 30000, "Warning threshold for in-memory results. A row count higher than this amount will cause the VtGateWarnings.ResultsExceeded counter to be incremented.")
 	defaultDDLStrategy   = flag.String("ddl_strategy", string(schema.DDLStrategyDirect), "Set default strategy for DDL statements. Override with @@ddl_strategy session variable")
 	dbDDLPlugin          = flag.String("dbddl_plugin", "fail", "controls how to handle CREATE/DROP DATABASE. use it if you are using your own database provisioning service")
+	schemaChangeSignal   = flag.Bool("schema_change_signal", false, "Enable the schema tracker. The columns of the tables are loaded from the master tablets and reloaded when they signal a schema change, and used as authoritative column lists by the planner. The tablets need -queryserver-config-schema-change-signal for the changes to be seen.")
+	vstreamRegistryURL   = flag.String("vstream_schema_registry_url", "", "URL of a Confluent schema registry. If set, the schemas of the VStreams that request the avro format are registered there, and their row changes refer to the ids of the schemas.")
 
 	// TODO(deepthi): change these two vars to unexported and move to healthcheck.go when LegacyHealthcheck is removed
 
@@ -82,6 +88,8 @@ var (
 	// lockHeartbeatTime is used to set the next heartbeat time.
 	lockHeartbeatTime = flag.Duration("lock_heartbeat_time", 5*time.Second, "If there is lock function used. This will keep the lock connection active by using this heartbeat")
 	warnShardedOnly   = flag.Bool("warn_sharded_only", false, "If any features that are only available in unsharded mode are used, query execution warnings will be added to the session")
+
+	executeBatchByShard = flag.Bool("execute_batch_by_shard", false, "If set, ExecuteBatch sends the DML statements of a batch that target the same shard to it in a single round trip, in one transaction. The statements are still executed in order within a shard, but not across shards. Batches that contain other statements are executed one statement at a time.")
 )
 
 func getTxMode() vtgatepb.TransactionMode {
@@ -192,6 +200,8 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		MaxEntries:     *queryPlanCacheSize,
 		MaxMemoryUsage: *queryPlanCacheMemory,
 		LFU:            *queryPlanCacheLFU,
+		LRUMemoryBased: *queryPlanCacheLRUMem,
+		TTL:            *queryPlanCacheTTL,
 	}
 
 	rpcVTGate = &VTGate{
@@ -241,6 +251,12 @@ func Init(ctx context.Context, serv srvtopo.Server, cell string, tabletTypesToWa
 		log.Fatalf("error initializing query logger: %v", err)
 	}
 
+	if *schemaChangeSignal {
+		st := vtschema.NewTracker(gw.hc.Subscribe())
+		rpcVTGate.executor.vm.startSchemaTracking(st)
+		servenv.OnTerm(st.Stop)
+	}
+
 	initAPI(gw.hc)
 
 	return rpcVTGate
@@ -314,6 +330,29 @@ func (vtg *VTGate) ExecuteBatch(ctx context.Context, session *vtgatepb.Session,
 		}
 	}
 
+	if *executeBatchByShard {
+		safeSession := NewSafeSession(session)
+		if qrl, ok := vtg.executor.ExecuteBatchByShard(ctx, "ExecuteBatch", safeSession, sqlList, bindVariablesList); ok {
+			for i := range qrl {
+				if qr := qrl[i].QueryResult; qr != nil {
+					vtg.rowsReturned.Add(statsKey, int64(len(qr.Rows)))
+					vtg.rowsAffected.Add(statsKey, int64(qr.RowsAffected))
+				}
+				if qrl[i].QueryError != nil {
+					query := map[string]interface{}{
+						"Sql":     sqlList[i],
+						"Session": session,
+					}
+					if len(bindVariablesList) != 0 {
+						query["BindVariables"] = bindVariablesList[i]
+					}
+					qrl[i].QueryError = recordAndAnnotateError(qrl[i].QueryError, statsKey, query, vtg.logExecute)
+				}
+			}
+			return safeSession.Session, qrl, nil
+		}
+	}
+
 	qrl := make([]sqltypes.QueryResponse, len(sqlList))
 	for i, sql := range sqlList {
 		var bv map[string]*querypb.BindVariable
@@ -522,6 +561,8 @@ func LegacyInit(ctx context.Context, hc discovery.LegacyHealthCheck, serv srvtop
 		MaxEntries:     *queryPlanCacheSize,
 		MaxMemoryUsage: *queryPlanCacheMemory,
 		LFU:            *queryPlanCacheLFU,
+		LRUMemoryBased: *queryPlanCacheLRUMem,
+		TTL:            *queryPlanCacheTTL,
 	}
 
 	rpcVTGate = &VTGate{
@@ -117,6 +117,49 @@ func TestVTGateExecute(t *testing.T) {
 	}
 }
 
+func TestVTGateExecuteBatchByShard(t *testing.T) {
+	*executeBatchByShard = true
+	defer func() { *executeBatchByShard = false }()
+
+	createSandbox(KsTestUnsharded)
+	hcVTGateTest.Reset()
+	sbc := hcVTGateTest.AddTestTablet("aa", "1.1.1.1", 1001, KsTestUnsharded, "0", topodatapb.TabletType_MASTER, true, 1, nil)
+	_, qrl, err := rpcVTGate.ExecuteBatch(
+		context.Background(),
+		&vtgatepb.Session{
+			Autocommit:   true,
+			TargetString: KsTestUnsharded + "@master",
+		},
+		[]string{"insert into t1(id) values (1)", "update t1 set v = 2 where id = 1"},
+		nil,
+	)
+	require.NoError(t, err)
+	require.Len(t, qrl, 2)
+	for _, qr := range qrl {
+		require.NoError(t, qr.QueryError)
+	}
+	require.Len(t, sbc.BatchQueries, 1)
+	assert.Len(t, sbc.BatchQueries[0], 2)
+	assert.EqualValues(t, 1, sbc.ExecCount.Get())
+
+	// A batch with a statement that cannot be batched is executed one statement at a time.
+	_, qrl, err = rpcVTGate.ExecuteBatch(
+		context.Background(),
+		&vtgatepb.Session{
+			Autocommit:   true,
+			TargetString: KsTestUnsharded + "@master",
+		},
+		[]string{"insert into t1(id) values (2)", "select id from t1"},
+		nil,
+	)
+	require.NoError(t, err)
+	for _, qr := range qrl {
+		require.NoError(t, qr.QueryError)
+	}
+	assert.Len(t, sbc.BatchQueries, 1)
+	assert.EqualValues(t, 3, sbc.ExecCount.Get())
+}
+
 func TestVTGateExecuteWithKeyspaceShard(t *testing.T) {
 	createSandbox(KsTestUnsharded)
 	hcVTGateTest.Reset()
@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package aclgroups resolves the groups of the users checked by the table ACLs.
+The groups come from a static file, a command such as an LDAP lookup script,
+or a file in the topo. The file and topo sources are reloaded when they
+change, so group membership changes don't require a restart of vttablet.
+
+The file and topo sources contain a JSON object mapping each group to its
+members:
+
+	{
+	  "readers": ["user1", "user2"],
+	  "writers": ["user1"]
+	}
+
+The exec source runs the command with "--" and the username as its
+arguments, and expects the groups of the user separated by white space on
+its output.
+*/
+package aclgroups
+
+import (
+	"flag"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/tableacl"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
+)
+
+var (
+	source       = flag.String("table-acl-groups-source", "", "source of the groups of the users checked by the table acls: file, exec or topo. Disabled if empty")
+	groupsFile   = flag.String("table-acl-groups-file", "", "file mapping each group to its members, for the file source. Reloaded when it changes")
+	groupsExec   = flag.String("table-acl-groups-exec", "", "command printing the groups of the user given as its argument, for the exec source")
+	execTimeout  = flag.Duration("table-acl-groups-exec-timeout", 5*time.Second, "timeout of the command of the exec source")
+	cacheTTL     = flag.Duration("table-acl-groups-cache-ttl", time.Minute, "how long the exec source caches the groups of a user. The groups older than this are still used while they are fetched again")
+	cacheSize    = flag.Int64("table-acl-groups-cache-size", 10000, "maximum number of users whose groups are cached by the exec source")
+	topoCell     = flag.String("table-acl-groups-topo-cell", "global", "topo cell of the groups file, for the topo source")
+	topoFilePath = flag.String("table-acl-groups-topo-path", "", "path of the file mapping each group to its members in the topo, for the topo source")
+
+	// loads counts the loads of the group memberships by their result.
+	loads = stats.NewCountersWithSingleLabel("TableACLGroupsLoads", "Loads of the table acl group memberships", "Result", "Success", "Failure")
+)
+
+// memberships holds the groups of each user, as loaded by the file and
+// topo sources.
+type memberships struct {
+	mu         sync.RWMutex
+	userGroups map[string][]string
+}
+
+// Groups is part of the tableacl.GroupSource interface.
+func (m *memberships) Groups(username string) []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.userGroups[username]
+}
+
+// set parses the memberships in data and replaces the current ones. The
+// current memberships are kept if data cannot be parsed.
+func (m *memberships) set(data []byte) error {
+	groups := tableacl.Memberships{}
+	if err := json2.Unmarshal(data, &groups); err != nil {
+		loads.Add("Failure", 1)
+		return err
+	}
+	userGroups := groups.UserGroups()
+	m.mu.Lock()
+	m.userGroups = userGroups
+	m.mu.Unlock()
+	loads.Add("Success", 1)
+	return nil
+}
+
+// activateGroupSource creates the source selected by the flags and
+// sets it as the group source of the table ACLs.
+func activateGroupSource(qsc tabletserver.Controller) {
+	switch *source {
+	case "":
+		return
+	case "file":
+		fs, err := newFileSource(*groupsFile)
+		if err != nil {
+			log.Fatalf("cannot load table acl groups file: %v", err)
+		}
+		if err := fs.watch(); err != nil {
+			log.Fatalf("cannot watch table acl groups file: %v", err)
+		}
+		servenv.OnTerm(fs.close)
+		tableacl.SetGroupSource(fs)
+	case "exec":
+		if *groupsExec == "" {
+			log.Fatalf("table-acl-groups-exec is required by the exec table acl groups source")
+		}
+		tableacl.SetGroupSource(newExecSource(*groupsExec, *execTimeout, *cacheTTL, *cacheSize))
+	case "topo":
+		ts, err := newTopoSource(qsc, *topoCell, *topoFilePath)
+		if err != nil {
+			log.Fatalf("cannot start table acl groups topo source: %v", err)
+		}
+		ts.start()
+		servenv.OnTerm(ts.stop)
+		tableacl.SetGroupSource(ts)
+	default:
+		log.Fatalf("unknown table acl groups source: %q", *source)
+	}
+	log.Infof("Resolving table acl groups from the %s source", *source)
+}
+
+func init() {
+	tabletserver.RegisterFunctions = append(tabletserver.RegisterFunctions, activateGroupSource)
+}
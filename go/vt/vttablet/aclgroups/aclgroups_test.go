@@ -0,0 +1,158 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aclgroups
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
+)
+
+// waitForGroups waits until source returns want as the groups of username.
+func waitForGroups(t *testing.T, source interface{ Groups(string) []string }, username string, want []string) {
+	t.Helper()
+	start := time.Now()
+	for {
+		got := source.Groups(username)
+		if assert.ObjectsAreEqual(want, got) {
+			return
+		}
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("timeout: groups of %s are %v, want %v", username, got, want)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestFileSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aclgroups")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "groups.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"readers": ["u1"]}`), 0644))
+
+	fs, err := newFileSource(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"readers"}, fs.Groups("u1"))
+	assert.Empty(t, fs.Groups("u2"))
+
+	require.NoError(t, fs.watch())
+	defer fs.close()
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"readers": ["u1", "u2"]}`), 0644))
+	waitForGroups(t, fs, "u2", []string{"readers"})
+
+	// Invalid content keeps the previous memberships.
+	assert.Error(t, fs.set([]byte(`{"readers": `)))
+	assert.Equal(t, []string{"readers"}, fs.Groups("u2"))
+
+	_, err = newFileSource(filepath.Join(dir, "missing.json"))
+	assert.Error(t, err)
+}
+
+func TestExecSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "aclgroups")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "groups.sh")
+	writeScript := func(body string) {
+		require.NoError(t, ioutil.WriteFile(script, []byte("#!/bin/sh\n"+body+"\n"), 0755))
+	}
+	writeScript(`[ "$1" = "--" ] && echo "readers $2"`)
+
+	var mu sync.Mutex
+	now := time.Now()
+	es := newExecSource(script, 5*time.Second, time.Minute, 2)
+	es.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+	assert.Equal(t, []string{"readers", "u1"}, es.Groups("u1"))
+	assert.Equal(t, []string{"readers", "-u"}, es.Groups("-u"))
+
+	// The groups are cached until the ttl expires, and then returned
+	// while they are fetched again.
+	writeScript(`echo "writers"`)
+	assert.Equal(t, []string{"readers", "u1"}, es.Groups("u1"))
+	advance(2 * time.Minute)
+	assert.Equal(t, []string{"readers", "u1"}, es.Groups("u1"))
+	waitForGroups(t, es, "u1", []string{"writers"})
+
+	// A failing command keeps the previous groups.
+	writeScript(`exit 1`)
+	failures := loads.Counts()["Failure"]
+	advance(2 * time.Minute)
+	assert.Equal(t, []string{"writers"}, es.Groups("u1"))
+	for loads.Counts()["Failure"] == failures {
+		time.Sleep(time.Millisecond)
+	}
+	assert.Equal(t, []string{"writers"}, es.Groups("u1"))
+	assert.Empty(t, es.Groups("u2"))
+
+	// The least recently used users are evicted.
+	writeScript(`echo "readers"`)
+	es = newExecSource(script, 5*time.Second, time.Minute, 2)
+	es.Groups("u1")
+	es.Groups("u2")
+	es.Groups("u3")
+	assert.Equal(t, 2, es.cache.Len())
+	_, ok := es.cache.Get("u1")
+	assert.False(t, ok)
+}
+
+func TestTopoSource(t *testing.T) {
+	cell := "cell1"
+	filePath := "/keyspaces/ks1/configs/TableACLGroups"
+	ts := memorytopo.NewServer(cell)
+	qsc := tabletservermock.NewController()
+	qsc.TS = ts
+	sleepDuringTopoFailure = time.Millisecond
+	ctx := context.Background()
+
+	source, err := newTopoSource(qsc, cell, filePath)
+	require.NoError(t, err)
+	source.start()
+	defer source.stop()
+
+	conn, err := ts.ConnForCell(ctx, cell)
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, filePath, []byte(`{"readers": ["u1"]}`))
+	require.NoError(t, err)
+	waitForGroups(t, source, "u1", []string{"readers"})
+
+	_, err = conn.Update(ctx, filePath, []byte(`{"writers": ["u1"]}`), nil)
+	require.NoError(t, err)
+	waitForGroups(t, source, "u1", []string{"writers"})
+
+	_, err = newTopoSource(qsc, cell, "")
+	assert.Error(t, err)
+}
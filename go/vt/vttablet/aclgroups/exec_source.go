@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aclgroups
+
+import (
+	"context"
+	"os/exec"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/vt/log"
+)
+
+// execSource runs a command to get the groups of a user, typically a
+// script querying an LDAP directory. The groups are cached for ttl, in a
+// LRU cache holding the groups of up to cacheSize users.
+type execSource struct {
+	command string
+	timeout time.Duration
+	ttl     time.Duration
+
+	// now is time.Now, except in tests.
+	now func() time.Time
+
+	cache *cache.LRUCache
+	// flights makes the concurrent lookups of a user run the command once.
+	flights singleflight.Group
+}
+
+type execEntry struct {
+	groups  []string
+	fetched time.Time
+}
+
+func newExecSource(command string, timeout, ttl time.Duration, cacheSize int64) *execSource {
+	return &execSource{
+		command: command,
+		timeout: timeout,
+		ttl:     ttl,
+		now:     time.Now,
+		cache: cache.NewLRUCache(cacheSize, func(_ interface{}) int64 {
+			return 1
+		}),
+	}
+}
+
+// Groups is part of the tableacl.GroupSource interface. The groups of a
+// user which are older than the ttl are returned while they are fetched
+// again in the background, so that only the first lookup of a user waits
+// for the command. If the command fails, the groups last fetched for the
+// user are kept.
+func (es *execSource) Groups(username string) []string {
+	if value, ok := es.cache.Get(username); ok {
+		entry := value.(*execEntry)
+		if es.now().Sub(entry.fetched) >= es.ttl {
+			go es.load(username, entry)
+		}
+		return entry.groups
+	}
+	return es.load(username, nil)
+}
+
+// load runs the command for username, unless it's already running, and
+// caches its groups. It returns the groups of previous if the command
+// fails.
+func (es *execSource) load(username string, previous *execEntry) []string {
+	value, _, _ := es.flights.Do(username, func() (interface{}, error) {
+		groups, err := es.run(username)
+		if err != nil {
+			loads.Add("Failure", 1)
+			log.Warningf("Cannot get the table acl groups of %q: %v", username, err)
+			if previous == nil {
+				return []string(nil), nil
+			}
+			return previous.groups, nil
+		}
+		loads.Add("Success", 1)
+		es.cache.Set(username, &execEntry{groups: groups, fetched: es.now()})
+		return groups, nil
+	})
+	return value.([]string)
+}
+
+func (es *execSource) run(username string) ([]string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), es.timeout)
+	defer cancel()
+	// The username is given after "--", so that a username starting with
+	// "-" is not taken as an option of the command.
+	out, err := exec.CommandContext(ctx, es.command, "--", username).Output()
+	if err != nil {
+		return nil, err
+	}
+	return strings.Fields(string(out)), nil
+}
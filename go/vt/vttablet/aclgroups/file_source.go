@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aclgroups
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"sync"
+
+	"github.com/fsnotify/fsnotify"
+
+	"vitess.io/vitess/go/vt/log"
+)
+
+// fileSource reads the group memberships from a local file.
+type fileSource struct {
+	memberships
+
+	path string
+
+	// mu protects watcher.
+	mu      sync.Mutex
+	watcher *fsnotify.Watcher
+}
+
+func newFileSource(path string) (*fileSource, error) {
+	fs := &fileSource{path: path}
+	if err := fs.load(); err != nil {
+		return nil, err
+	}
+	return fs, nil
+}
+
+func (fs *fileSource) load() error {
+	data, err := ioutil.ReadFile(fs.path)
+	if err != nil {
+		loads.Add("Failure", 1)
+		return err
+	}
+	return fs.set(data)
+}
+
+// watch reloads the memberships every time the file changes, until close
+// is called. The directory of the file is watched rather than the file
+// itself, so that a file replaced by a rename is still tracked.
+func (fs *fileSource) watch() error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(fs.path)); err != nil {
+		watcher.Close()
+		return err
+	}
+	fs.mu.Lock()
+	fs.watcher = watcher
+	fs.mu.Unlock()
+
+	go func() {
+		path := filepath.Clean(fs.path)
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+					continue
+				}
+				if err := fs.load(); err != nil {
+					log.Warningf("Keeping previous table acl groups, cannot reload %s: %v", fs.path, err)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warningf("Error watching table acl groups file %s: %v", fs.path, err)
+			}
+		}
+	}()
+	return nil
+}
+
+// close stops watching the file.
+func (fs *fileSource) close() {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+	if fs.watcher != nil {
+		fs.watcher.Close()
+		fs.watcher = nil
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aclgroups
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
+)
+
+// sleepDuringTopoFailure is how long to sleep before retrying in case of error.
+// (it's a var not a const so the test can change the value).
+var sleepDuringTopoFailure = 30 * time.Second
+
+// topoSource watches the group memberships in a topo file.
+type topoSource struct {
+	memberships
+
+	// conn is the topo connection. Set at construction time.
+	conn topo.Conn
+
+	// filePath is the file to read from.
+	filePath string
+
+	// version is the version of the file last read. The watch resumes
+	// from it when it is restarted.
+	version topo.Version
+
+	// mu protects the following variables.
+	mu sync.Mutex
+
+	// cancel is the function to call to cancel the current watch, if any.
+	cancel func()
+
+	// stopped is set when stop() is called.
+	stopped bool
+}
+
+func newTopoSource(qsc tabletserver.Controller, cell, filePath string) (*topoSource, error) {
+	if filePath == "" {
+		return nil, fmt.Errorf("table-acl-groups-topo-path is required by the topo table acl groups source")
+	}
+	conn, err := qsc.TopoServer().ConnForCell(context.Background(), cell)
+	if err != nil {
+		return nil, err
+	}
+	return &topoSource{
+		conn:     conn,
+		filePath: filePath,
+	}, nil
+}
+
+func (ts *topoSource) start() {
+	go func() {
+		for {
+			if err := ts.oneWatch(); err != nil {
+				log.Warningf("Background watch of table acl groups failed: %v", err)
+			}
+
+			ts.mu.Lock()
+			stopped := ts.stopped
+			ts.mu.Unlock()
+
+			if stopped {
+				return
+			}
+
+			log.Warningf("Sleeping for %v before trying again", sleepDuringTopoFailure)
+			time.Sleep(sleepDuringTopoFailure)
+		}
+	}()
+}
+
+func (ts *topoSource) stop() {
+	ts.mu.Lock()
+	if ts.cancel != nil {
+		ts.cancel()
+	}
+	ts.stopped = true
+	ts.mu.Unlock()
+}
+
+func (ts *topoSource) apply(wd *topo.WatchData) error {
+	if err := ts.set(wd.Contents); err != nil {
+		return fmt.Errorf("error unmarshaling table acl groups: %v, version %v", err, wd.Version)
+	}
+	ts.version = wd.Version
+	log.Infof("Table acl groups version %v fetched from topo", wd.Version)
+	return nil
+}
+
+func (ts *topoSource) oneWatch() error {
+	defer func() {
+		ts.mu.Lock()
+		ts.cancel = nil
+		ts.mu.Unlock()
+	}()
+
+	current, wdChannel, cancel := ts.conn.WatchFrom(context.Background(), ts.filePath, ts.version)
+	if current.Err != nil {
+		return current.Err
+	}
+
+	ts.mu.Lock()
+	if ts.stopped {
+		ts.mu.Unlock()
+		cancel()
+		for range wdChannel {
+		}
+		return topo.NewError(topo.Interrupted, "watch")
+	}
+	ts.cancel = cancel
+	ts.mu.Unlock()
+
+	if err := ts.apply(current); err != nil {
+		cancel()
+		for range wdChannel {
+		}
+		return err
+	}
+
+	for wd := range wdChannel {
+		if wd.Err != nil {
+			return wd.Err
+		}
+		if err := ts.apply(wd); err != nil {
+			cancel()
+			for range wdChannel {
+			}
+			return err
+		}
+	}
+
+	return fmt.Errorf("watch terminated with no error")
+}
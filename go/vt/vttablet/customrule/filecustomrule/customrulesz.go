@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package filecustomrule
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"net/http"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var customRulesTemplate = template.Must(template.New("customrules").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Custom Rules</title></head>
+<body>
+<h1>Custom Rules</h1>
+{{if .Message}}<p>{{.Message}}</p>{{end}}
+<p>Path: {{.Path}}<br>Loaded: {{.Loaded}}</p>
+<form method="POST">
+<input type="hidden" name="Action" value="Reload">
+<input type="submit" value="Reload">
+</form>
+<h2>Test</h2>
+<form method="POST">
+<input type="hidden" name="Action" value="Test">
+SQL:<br><textarea name="sql" rows="4" cols="80">{{.SQL}}</textarea><br>
+Bind variables (JSON):<br><textarea name="bindvars" rows="2" cols="80">{{.BindVars}}</textarea><br>
+User: <input type="text" name="user" value="{{.User}}">
+IP: <input type="text" name="ip" value="{{.IP}}">
+<input type="submit" value="Test">
+</form>
+{{if .Tested}}
+<h3>Matching rules</h3>
+{{if .Matches}}<ul>{{range .Matches}}<li>{{.Name}} ({{.Action}}{{if .Monitor}}, monitor mode{{end}}): {{.Description}}</li>{{end}}</ul>{{else}}<p>None</p>{{end}}
+{{end}}
+<h2>Rules</h2>
+{{range .Files}}<h3>{{.Path}}{{if .Signer}} (signed by {{.Signer}}){{end}}</h3>
+<pre>{{.JSON}}</pre>
+{{end}}
+</body>
+</html>
+`))
+
+// ruleMatch is a rule matching a tested query.
+type ruleMatch struct {
+	Name, Description string
+	Action            string
+	Monitor           bool
+}
+
+// customRulesHandler serves /debug/customrules. It shows the loaded rules,
+// reloads them on a POST with Action=Reload, and reports which rules match a
+// query on a POST with Action=Test. Reloading the rules requires the ADMIN
+// role.
+func customRulesHandler(fcr *FileCustomRule, qsc tabletserver.Controller, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data := struct {
+		Path, Loaded, Message   string
+		SQL, BindVars, User, IP string
+		Tested                  bool
+		Matches                 []ruleMatch
+		Files                   []struct{ Path, Signer, JSON string }
+	}{
+		Path:     fcr.path,
+		SQL:      r.FormValue("sql"),
+		BindVars: r.FormValue("bindvars"),
+		User:     r.FormValue("user"),
+		IP:       r.FormValue("ip"),
+	}
+	action := r.FormValue("Action")
+	if action != "" && r.Method != http.MethodPost {
+		http.Error(w, "actions require a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	switch action {
+	case "":
+	case "Reload":
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		if err := fcr.load(qsc); err != nil {
+			data.Message = fmt.Sprintf("Reload failed, keeping previous rules: %v", err)
+		} else {
+			data.Message = "Reload completed."
+		}
+	case "Test":
+		matches, err := testRules(fcr, qsc, data.SQL, data.BindVars, data.IP, data.User)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		data.Tested = true
+		data.Matches = matches
+	default:
+		http.Error(w, fmt.Sprintf("unknown action %s", action), http.StatusBadRequest)
+		return
+	}
+
+	_, version, _ := fcr.GetRules()
+	files := fcr.GetRuleFiles()
+	if r.FormValue("format") == "json" {
+		js, err := json.MarshalIndent(struct {
+			Path    string
+			Version int64
+			Message string `json:",omitempty"`
+			Matches []ruleMatch
+			Files   []RuleFile
+		}{
+			Path:    data.Path,
+			Version: version,
+			Message: data.Message,
+			Matches: data.Matches,
+			Files:   files,
+		}, "", "  ")
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Write(js)
+		return
+	}
+
+	if version != 0 {
+		data.Loaded = time.Unix(version, 0).Format(time.RFC3339)
+	}
+	for _, f := range files {
+		js, err := json.MarshalIndent(f.Rules, "", "  ")
+		if err != nil {
+			js = []byte(err.Error())
+		}
+		data.Files = append(data.Files, struct{ Path, Signer, JSON string }{f.Path, f.Signer, string(js)})
+	}
+	if err := customRulesTemplate.Execute(w, data); err != nil {
+		log.Errorf("customrules: couldn't execute template: %v", err)
+	}
+}
+
+// testRules returns the loaded rules which match the query. bindVars is a
+// JSON object of bind variable values.
+func testRules(fcr *FileCustomRule, qsc tabletserver.Controller, sql, bindVars, ip, user string) ([]ruleMatch, error) {
+	bvs, err := parseBindVars(bindVars)
+	if err != nil {
+		return nil, err
+	}
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	tables := map[string]*schema.Table{}
+	if se := qsc.SchemaEngine(); se != nil {
+		tables = se.GetSchema()
+	}
+	plan, err := planbuilder.Build(stmt, tables, false, "")
+	if err != nil {
+		return nil, err
+	}
+	qrs, _, _ := fcr.GetRules()
+	filtered := qrs.FilterByPlan(sql, plan.PlanID, plan.TableName().String(), plan.EstimatedRows)
+	var matches []ruleMatch
+	for _, qr := range filtered.CopyUnderlying() {
+		act := qr.GetAction(ip, user, bvs)
+		if act == rules.QRContinue {
+			continue
+		}
+		actName, _ := json.Marshal(act)
+		matches = append(matches, ruleMatch{
+			Name:        qr.Name,
+			Description: qr.Description,
+			Action:      strings.Trim(string(actName), `"`),
+			Monitor:     qr.Mode() == rules.ModeMonitor,
+		})
+	}
+	return matches, nil
+}
+
+// parseBindVars converts a JSON object to bind variables. Numbers are
+// converted to integers when possible, and lists to tuples.
+func parseBindVars(data string) (map[string]*querypb.BindVariable, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, nil
+	}
+	dec := json.NewDecoder(strings.NewReader(data))
+	dec.UseNumber()
+	var values map[string]interface{}
+	if err := dec.Decode(&values); err != nil {
+		return nil, fmt.Errorf("want json object for bind variables: %v", err)
+	}
+	for k, v := range values {
+		values[k] = convertJSONValue(v)
+	}
+	return sqltypes.BuildBindVariables(values)
+}
+
+func convertJSONValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []interface{}:
+		for i := range v {
+			v[i] = convertJSONValue(v[i])
+		}
+	}
+	return v
+}
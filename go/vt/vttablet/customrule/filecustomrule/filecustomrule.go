@@ -14,15 +14,26 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package filecustomrule implements static custom rule from a config file
+// Package filecustomrule implements custom rules from a config file
 package filecustomrule
 
 import (
 	"flag"
+	"fmt"
 	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/fsnotify/fsnotify"
+
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vttablet/customrule/rulesig"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 )
@@ -31,15 +42,40 @@ var (
 	// Actual FileCustomRule object in charge of rule updates
 	fileCustomRule = NewFileCustomRule()
 	// Commandline flag to specify rule path
-	fileRulePath = flag.String("filecustomrules", "", "file based custom rule path")
+	fileRulePath = flag.String("filecustomrules", "", "file based custom rule path, or directory of *.json rule files")
+	// Commandline flag to reload the rules when the file changes
+	fileRuleWatch = flag.Bool("filecustomrules_watch", true, "reload the file based custom rules when the file changes")
+
+	// loadTimestamps exports the Unix timestamps of the last successful
+	// and failed loads of the rule file.
+	loadTimestamps = stats.NewGaugesWithSingleLabel("FileCustomRuleLoadTimestamp", "Unix timestamp of the last load of the file based custom rules", "Result")
 )
 
 // FileCustomRule is an implementation of CustomRuleManager, it reads custom query
-// rules from local file for once and push it to vttablet
+// rules from local file and push them to vttablet. The path can also be a
+// directory, in which case the rules of all its *.json files are merged in the
+// order of the file names. If watched, the rules are reloaded every time the
+// files change. Rule files which cannot be parsed are ignored, and the previous
+// rules are kept. If a keyring is set, every rule file must have a valid
+// signature, see package rulesig.
 type FileCustomRule struct {
-	path                    string       // Path to the file containing custom query rules
-	currentRuleSet          *rules.Rules // Query rules built from local file
-	currentRuleSetTimestamp int64        // Unix timestamp when currentRuleSet is built from local file
+	path    string          // Path to the file or directory containing custom query rules
+	keyring rulesig.Keyring // Signers trusted to sign the rule files, nil if signatures are not required
+
+	// mu protects the following variables.
+	mu                      sync.Mutex
+	currentRuleSet          *rules.Rules // Query rules built from local files
+	currentRuleSetTimestamp int64        // Unix timestamp when currentRuleSet is built from local files
+	currentRuleFiles        []RuleFile   // Files currentRuleSet is built from
+	watcher                 *fsnotify.Watcher
+}
+
+// RuleFile is a rule file and the rules it defines. Signer is the signer
+// of the file, if signatures are required.
+type RuleFile struct {
+	Path   string
+	Signer string `json:",omitempty"`
+	Rules  *rules.Rules
 }
 
 // FileCustomRuleSource is the name of the file based custom rule source
@@ -72,6 +108,78 @@ func ParseRules(path string) (*rules.Rules, error) {
 	return qrs, nil
 }
 
+// parseRuleFile parses the rule file at path, after verifying its signature
+// if keyring is not nil.
+func parseRuleFile(path string, keyring rulesig.Keyring) (RuleFile, error) {
+	if keyring == nil {
+		qrs, err := ParseRules(path)
+		return RuleFile{Path: path, Rules: qrs}, err
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return RuleFile{}, err
+	}
+	sig, err := ioutil.ReadFile(path + rulesig.Suffix)
+	if err != nil {
+		return RuleFile{}, err
+	}
+	signer, err := keyring.Verify(data, sig)
+	if err != nil {
+		log.Warningf("Refusing to load custom rules %v: %v", path, err)
+		return RuleFile{}, err
+	}
+	qrs := rules.New()
+	if err := qrs.UnmarshalJSON(data); err != nil {
+		log.Warningf("Error unmarshaling query rules %v", err)
+		return RuleFile{}, err
+	}
+	return RuleFile{Path: path, Signer: signer, Rules: qrs}, nil
+}
+
+// parseRulePath parses the rule file at path, or all the *.json files if path
+// is a directory. The names of the named rules must be unique across files.
+func parseRulePath(path string, keyring rulesig.Keyring) ([]RuleFile, *rules.Rules, error) {
+	paths := []string{path}
+	fi, err := os.Stat(path)
+	if err != nil {
+		log.Warningf("Error reading file %v: %v", path, err)
+		return nil, nil, err
+	}
+	if fi.IsDir() {
+		// Glob returns the files sorted by name.
+		if paths, err = filepath.Glob(filepath.Join(path, "*.json")); err != nil {
+			return nil, nil, err
+		}
+	}
+	var files []RuleFile
+	merged := rules.New()
+	definedIn := make(map[string]string)
+	for _, p := range paths {
+		file, err := parseRuleFile(p, keyring)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %v", p, err)
+		}
+		for _, qr := range file.Rules.CopyUnderlying() {
+			if qr.Name == "" {
+				continue
+			}
+			if other, ok := definedIn[qr.Name]; ok {
+				return nil, nil, fmt.Errorf("rule %s in %s is already defined in %s", qr.Name, p, other)
+			}
+			definedIn[qr.Name] = p
+		}
+		files = append(files, file)
+		merged.Append(file.Rules)
+	}
+	return files, merged, nil
+}
+
+// SetKeyring requires the rule files to be signed by one of the signers
+// of keyring. It must be called before Open.
+func (fcr *FileCustomRule) SetKeyring(keyring rulesig.Keyring) {
+	fcr.keyring = keyring
+}
+
 // Open try to build query rules from local file and push the rules to vttablet
 func (fcr *FileCustomRule) Open(qsc tabletserver.Controller, rulePath string) error {
 	fcr.path = rulePath
@@ -79,29 +187,167 @@ func (fcr *FileCustomRule) Open(qsc tabletserver.Controller, rulePath string) er
 		// Don't go further if path is empty
 		return nil
 	}
+	return fcr.load(qsc)
+}
 
-	qrs, err := ParseRules(rulePath)
+// load parses the rule file and pushes the rules to vttablet. Parsing
+// compiles all the regular expressions of the rules, so the current rules
+// are only replaced by a valid rule set.
+func (fcr *FileCustomRule) load(qsc tabletserver.Controller) error {
+	files, qrs, err := parseRulePath(fcr.path, fcr.keyring)
 	if err != nil {
+		loadTimestamps.Set("Failure", time.Now().Unix())
 		return err
 	}
-	fcr.currentRuleSetTimestamp = time.Now().Unix()
+	now := time.Now().Unix()
+	fcr.mu.Lock()
+	fcr.currentRuleSetTimestamp = now
 	fcr.currentRuleSet = qrs.Copy()
-	// Push query rules to vttablet
+	fcr.currentRuleFiles = files
+	fcr.mu.Unlock()
+	// Push query rules to vttablet, with their signers
 	qsc.SetQueryRules(FileCustomRuleSource, qrs.Copy())
-	log.Infof("Custom rule loaded from file: %s", fcr.path)
+	signers := ruleFileSigners(files)
+	if err := qsc.SetQueryRulesSigner(FileCustomRuleSource, signers); err != nil {
+		log.Warningf("Cannot record the signers of custom rule file %s: %v", fcr.path, err)
+	}
+	loadTimestamps.Set("Success", now)
+	if signers != "" {
+		log.Infof("Custom rule loaded from file: %s, signed by %s", fcr.path, signers)
+	} else {
+		log.Infof("Custom rule loaded from file: %s", fcr.path)
+	}
 	return nil
 }
 
-// GetRules returns query rules built from local file
+// ruleFileSigners returns the distinct signers of the rule files, in the
+// order the files are merged, or "" if the files are not signed.
+func ruleFileSigners(files []RuleFile) string {
+	var signers []string
+	seen := make(map[string]bool)
+	for _, f := range files {
+		if f.Signer == "" || seen[f.Signer] {
+			continue
+		}
+		seen[f.Signer] = true
+		signers = append(signers, f.Signer)
+	}
+	return strings.Join(signers, ", ")
+}
+
+// Watch reloads the rules every time the rule files change, until Close
+// is called. The directory of a rule file is watched rather than the file
+// itself, so that files replaced by a rename are still tracked.
+func (fcr *FileCustomRule) Watch(qsc tabletserver.Controller) error {
+	if fcr.path == "" {
+		return nil
+	}
+	fi, err := os.Stat(fcr.path)
+	if err != nil {
+		return err
+	}
+	isDir := fi.IsDir()
+	dir := filepath.Dir(fcr.path)
+	if isDir {
+		dir = fcr.path
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return err
+	}
+	fcr.mu.Lock()
+	fcr.watcher = watcher
+	fcr.mu.Unlock()
+
+	go func() {
+		path := filepath.Clean(fcr.path)
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if !isRuleFileEvent(event, path, isDir) {
+					continue
+				}
+				if err := fcr.load(qsc); err != nil {
+					log.Warningf("Keeping previous custom rules, cannot reload %s: %v", fcr.path, err)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warningf("Error watching custom rule file %s: %v", fcr.path, err)
+			}
+		}
+	}()
+	return nil
+}
+
+// isRuleFileEvent returns true if event may change the rules.
+func isRuleFileEvent(event fsnotify.Event, path string, isDir bool) bool {
+	name := strings.TrimSuffix(filepath.Clean(event.Name), rulesig.Suffix)
+	if isDir {
+		// Removing a file of the directory removes its rules.
+		return filepath.Ext(name) == ".json" && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename|fsnotify.Remove) != 0
+	}
+	return name == path && event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) != 0
+}
+
+// Close stops watching the rule file.
+func (fcr *FileCustomRule) Close() {
+	fcr.mu.Lock()
+	defer fcr.mu.Unlock()
+	if fcr.watcher != nil {
+		fcr.watcher.Close()
+		fcr.watcher = nil
+	}
+}
+
+// GetRules returns query rules built from local file. The rules of a
+// directory are merged, see GetRuleFiles for the rules and the signer of
+// each file.
 func (fcr *FileCustomRule) GetRules() (qrs *rules.Rules, version int64, err error) {
+	fcr.mu.Lock()
+	defer fcr.mu.Unlock()
 	return fcr.currentRuleSet.Copy(), fcr.currentRuleSetTimestamp, nil
 }
 
+// GetRuleFiles returns the rule files the query rules are built from,
+// in the order their rules are merged.
+func (fcr *FileCustomRule) GetRuleFiles() []RuleFile {
+	fcr.mu.Lock()
+	defer fcr.mu.Unlock()
+	files := make([]RuleFile, 0, len(fcr.currentRuleFiles))
+	for _, f := range fcr.currentRuleFiles {
+		files = append(files, RuleFile{Path: f.Path, Signer: f.Signer, Rules: f.Rules.Copy()})
+	}
+	return files
+}
+
 // ActivateFileCustomRules activates this static file based custom rule mechanism
 func ActivateFileCustomRules(qsc tabletserver.Controller) {
 	if *fileRulePath != "" {
+		keyring, err := rulesig.KeyringFromFlags()
+		if err != nil {
+			log.Fatalf("cannot load custom rule signing keys: %v", err)
+		}
 		qsc.RegisterQueryRuleSource(FileCustomRuleSource)
+		fileCustomRule.SetKeyring(keyring)
 		fileCustomRule.Open(qsc, *fileRulePath)
+		if *fileRuleWatch {
+			if err := fileCustomRule.Watch(qsc); err != nil {
+				log.Warningf("Cannot watch custom rule file %s: %v", *fileRulePath, err)
+			}
+			servenv.OnTerm(fileCustomRule.Close)
+		}
+		http.HandleFunc("/debug/customrules", func(w http.ResponseWriter, r *http.Request) {
+			customRulesHandler(fileCustomRule, qsc, w, r)
+		})
 	}
 }
 
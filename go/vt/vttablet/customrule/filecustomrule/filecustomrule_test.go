@@ -17,11 +17,21 @@ limitations under the License.
 package filecustomrule
 
 import (
+	"crypto/ed25519"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
 	"path"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/customrule/rulesig"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
 )
@@ -65,3 +75,239 @@ func TestFileCustomRule(t *testing.T) {
 		t.Fatalf("Expect custom rule r1 to be found, but got nothing, qrs=%v", qrs)
 	}
 }
+
+var customRule2 = `[{"Name": "r2", "Description": "disallow user u2", "User": "u2"}]`
+
+func TestFileCustomRuleWatch(t *testing.T) {
+	tqsc := tabletservermock.NewController()
+
+	dir, err := ioutil.TempDir("", "filecustomrule")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rulepath := path.Join(dir, "customrule.json")
+	require.NoError(t, ioutil.WriteFile(rulepath, []byte(customRule1), os.FileMode(0644)))
+
+	fcr := NewFileCustomRule()
+	require.NoError(t, fcr.Open(tqsc, rulepath))
+	require.NoError(t, fcr.Watch(tqsc))
+	defer fcr.Close()
+
+	waitForRule := func(name string) {
+		t.Helper()
+		for i := 0; i < 100; i++ {
+			if qrs := tqsc.GetQueryRules(FileCustomRuleSource); qrs != nil && qrs.Find(name) != nil {
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		t.Fatalf("rule %s was not loaded", name)
+	}
+	waitForRule("r1")
+
+	// Changes to the file are loaded.
+	require.NoError(t, ioutil.WriteFile(rulepath, []byte(customRule2), os.FileMode(0644)))
+	waitForRule("r2")
+	_, successTime, err := fcr.GetRules()
+	require.NoError(t, err)
+	assert.Equal(t, successTime, loadTimestamps.Counts()["Success"])
+
+	// An invalid file is ignored, and the previous rules are kept.
+	before := loadTimestamps.Counts()["Failure"]
+	require.NoError(t, ioutil.WriteFile(rulepath, []byte(`[{"Query": "["}]`), os.FileMode(0644)))
+	for i := 0; i < 100 && loadTimestamps.Counts()["Failure"] == before; i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.NotZero(t, loadTimestamps.Counts()["Failure"])
+	qrs, _, err := fcr.GetRules()
+	require.NoError(t, err)
+	assert.NotNil(t, qrs.Find("r2"))
+	assert.NotNil(t, tqsc.GetQueryRules(FileCustomRuleSource).Find("r2"))
+
+	// Files replaced by a rename are tracked.
+	tmpPath := path.Join(dir, "customrule.json.tmp")
+	require.NoError(t, ioutil.WriteFile(tmpPath, []byte(customRule1), os.FileMode(0644)))
+	require.NoError(t, os.Rename(tmpPath, rulepath))
+	waitForRule("r1")
+}
+
+func TestFileCustomRuleDirectory(t *testing.T) {
+	tqsc := tabletservermock.NewController()
+
+	dir, err := ioutil.TempDir("", "filecustomrule")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "20-team2.json"), []byte(customRule2), os.FileMode(0644)))
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "10-team1.json"), []byte(customRule1), os.FileMode(0644)))
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "README"), []byte("not rules"), os.FileMode(0644)))
+
+	fcr := NewFileCustomRule()
+	require.NoError(t, fcr.Open(tqsc, dir))
+
+	// Rules are merged in the order of the file names.
+	qrs, _, err := fcr.GetRules()
+	require.NoError(t, err)
+	var names []string
+	for _, qr := range qrs.CopyUnderlying() {
+		names = append(names, qr.Name)
+	}
+	assert.Equal(t, []string{"r1", "r2"}, names)
+
+	files := fcr.GetRuleFiles()
+	require.Len(t, files, 2)
+	assert.Equal(t, path.Join(dir, "10-team1.json"), files[0].Path)
+	assert.NotNil(t, files[0].Rules.Find("r1"))
+	assert.Equal(t, path.Join(dir, "20-team2.json"), files[1].Path)
+	assert.NotNil(t, files[1].Rules.Find("r2"))
+
+	// Rule names must be unique across files.
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "30-team3.json"), []byte(customRule1), os.FileMode(0644)))
+	err = NewFileCustomRule().Open(tabletservermock.NewController(), dir)
+	assert.EqualError(t, err, "rule r1 in "+path.Join(dir, "30-team3.json")+" is already defined in "+path.Join(dir, "10-team1.json"))
+
+	// Unnamed rules can be defined in several files.
+	unnamed := `[{"Description": "disallow bindvar 'asdfg'", "BindVarConds": [{"Name": "asdfg", "OnAbsent": false, "Operator": ""}]}]`
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "30-team3.json"), []byte(unnamed), os.FileMode(0644)))
+	require.NoError(t, ioutil.WriteFile(path.Join(dir, "40-team4.json"), []byte(unnamed), os.FileMode(0644)))
+	fcr2 := NewFileCustomRule()
+	require.NoError(t, fcr2.Open(tabletservermock.NewController(), dir))
+	qrs, _, err = fcr2.GetRules()
+	require.NoError(t, err)
+	assert.Len(t, qrs.CopyUnderlying(), 4)
+	require.NoError(t, os.Remove(path.Join(dir, "40-team4.json")))
+
+	// Removing a file of a watched directory removes its rules.
+	require.NoError(t, os.Remove(path.Join(dir, "30-team3.json")))
+	require.NoError(t, fcr.Watch(tqsc))
+	defer fcr.Close()
+	require.NoError(t, os.Remove(path.Join(dir, "20-team2.json")))
+	for i := 0; i < 100 && tqsc.GetQueryRules(FileCustomRuleSource).Find("r2") != nil; i++ {
+		time.Sleep(20 * time.Millisecond)
+	}
+	assert.Nil(t, tqsc.GetQueryRules(FileCustomRuleSource).Find("r2"))
+	assert.NotNil(t, tqsc.GetQueryRules(FileCustomRuleSource).Find("r1"))
+	assert.Len(t, fcr.GetRuleFiles(), 1)
+}
+
+func TestCustomRulesHandler(t *testing.T) {
+	tqsc := tabletservermock.NewController()
+
+	dir, err := ioutil.TempDir("", "filecustomrule")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rulepath := path.Join(dir, "rules.json")
+	require.NoError(t, ioutil.WriteFile(rulepath, []byte(customRule1), 0644))
+
+	fcr := NewFileCustomRule()
+	require.NoError(t, fcr.Open(tqsc, rulepath))
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		customRulesHandler(fcr, tqsc, w, r)
+	}
+
+	req := httptest.NewRequest("GET", "/debug/customrules", nil)
+	w := httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), rulepath)
+	assert.Contains(t, w.Body.String(), "disallow bindvar")
+
+	// Actions need a POST.
+	req = httptest.NewRequest("GET", "/debug/customrules?Action=Reload", nil)
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+
+	require.NoError(t, ioutil.WriteFile(rulepath, []byte(customRule2), 0644))
+	form := url.Values{"Action": {"Reload"}, "format": {"json"}}
+	req = httptest.NewRequest("POST", "/debug/customrules", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "Reload completed")
+	qrs, _, _ := fcr.GetRules()
+	assert.NotNil(t, qrs.Find("r2"))
+
+	testCases := []struct {
+		user, bindVars string
+		want           string
+	}{{
+		user: "u1",
+		want: `"Matches": null`,
+	}, {
+		user: "u2",
+		want: `"Name": "r2"`,
+	}, {
+		user:     "u1",
+		bindVars: `{"id": 1, "ids": [1, 2.5, "a"]}`,
+		want:     `"Matches": null`,
+	}}
+	for _, tc := range testCases {
+		form := url.Values{
+			"Action":   {"Test"},
+			"format":   {"json"},
+			"sql":      {"select * from t where id = :id"},
+			"bindvars": {tc.bindVars},
+			"user":     {tc.user},
+		}
+		req = httptest.NewRequest("POST", "/debug/customrules", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w = httptest.NewRecorder()
+		handler(w, req)
+		assert.Equal(t, http.StatusOK, w.Code, w.Body.String())
+		assert.Contains(t, w.Body.String(), tc.want, tc.user)
+	}
+
+	form = url.Values{"Action": {"Test"}, "sql": {"select * from t"}, "bindvars": {"[1]"}}
+	req = httptest.NewRequest("POST", "/debug/customrules", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	w = httptest.NewRecorder()
+	handler(w, req)
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
+
+func TestFileCustomRuleSigned(t *testing.T) {
+	tqsc := tabletservermock.NewController()
+
+	dir, err := ioutil.TempDir("", "filecustomrule")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	rulepath := path.Join(dir, "rules.json")
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	keyring := rulesig.Keyring{"policy": pub}
+	writeSigned := func(rules string) {
+		require.NoError(t, ioutil.WriteFile(rulepath, []byte(rules), 0644))
+		sig, err := rulesig.Sign("policy", priv, []byte(rules))
+		require.NoError(t, err)
+		require.NoError(t, ioutil.WriteFile(rulepath+rulesig.Suffix, sig, 0644))
+	}
+
+	// Unsigned rules are refused.
+	require.NoError(t, ioutil.WriteFile(rulepath, []byte(customRule1), 0644))
+	fcr := NewFileCustomRule()
+	fcr.SetKeyring(keyring)
+	assert.Error(t, fcr.Open(tqsc, rulepath))
+
+	writeSigned(customRule1)
+	require.NoError(t, fcr.load(tqsc))
+	qrs, _, err := fcr.GetRules()
+	require.NoError(t, err)
+	assert.NotNil(t, qrs.Find("r1"))
+	files := fcr.GetRuleFiles()
+	require.Len(t, files, 1)
+	assert.Equal(t, "policy", files[0].Signer)
+	assert.Equal(t, "policy", tqsc.GetQueryRulesSigner(FileCustomRuleSource))
+
+	// Tampered rules are refused, and the previous rules are kept.
+	require.NoError(t, ioutil.WriteFile(rulepath, []byte(customRule2), 0644))
+	assert.EqualError(t, fcr.load(tqsc), rulepath+": signature of policy does not match the rules")
+	qrs, _, err = fcr.GetRules()
+	require.NoError(t, err)
+	assert.NotNil(t, qrs.Find("r1"))
+	assert.Nil(t, qrs.Find("r2"))
+
+	writeSigned(customRule2)
+	require.NoError(t, fcr.load(tqsc))
+	assert.NotNil(t, tqsc.GetQueryRules(FileCustomRuleSource).Find("r2"))
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package httpcustomrule implements an HTTP backed source of query rules.
+The rules are polled from a URL, so that a central policy service can
+push query rules to all the tablets.
+*/
+package httpcustomrule
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttls"
+)
+
+var (
+	// Commandline flags to specify the rule URL and how to fetch it.
+	ruleURL      = flag.String("httpcustomrule_url", "", "URL of the custom rules JSON document. Disabled if empty.")
+	ruleInterval = flag.Duration("httpcustomrule_interval", time.Minute, "how often the custom rules are fetched from httpcustomrule_url.")
+	ruleTimeout  = flag.Duration("httpcustomrule_timeout", 10*time.Second, "timeout of a fetch of the custom rules.")
+	ruleCA       = flag.String("httpcustomrule_ca", "", "server CA to use to verify httpcustomrule_url.")
+	ruleCert     = flag.String("httpcustomrule_cert", "", "client cert to use to fetch httpcustomrule_url.")
+	ruleKey      = flag.String("httpcustomrule_key", "", "client key to use to fetch httpcustomrule_url.")
+	ruleName     = flag.String("httpcustomrule_server_name", "", "server name of the certificate of httpcustomrule_url.")
+)
+
+// httpCustomRuleSource is the HTTP based custom rule source name.
+const httpCustomRuleSource string = "HTTP_CUSTOM_RULE"
+
+// httpCustomRule polls the rules from a URL. The ETag and Last-Modified
+// headers of the response are sent back on the next request, so that an
+// unchanged document is not transferred again.
+type httpCustomRule struct {
+	// qsc, url, client and interval are set at construction time.
+	qsc      tabletserver.Controller
+	url      string
+	client   *http.Client
+	interval time.Duration
+
+	// The following variables are only accessed by fetch.
+	etag         string
+	lastModified string
+	qrs          *rules.Rules
+
+	// mu protects done.
+	mu   sync.Mutex
+	done chan struct{}
+}
+
+func newHTTPCustomRule(qsc tabletserver.Controller, url string, interval time.Duration, client *http.Client) *httpCustomRule {
+	return &httpCustomRule{
+		qsc:      qsc,
+		url:      url,
+		client:   client,
+		interval: interval,
+	}
+}
+
+func (cr *httpCustomRule) start() {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	cr.done = make(chan struct{})
+	done := cr.done
+	go func() {
+		ticker := time.NewTicker(cr.interval)
+		defer ticker.Stop()
+		for {
+			if err := cr.fetch(); err != nil {
+				log.Warningf("Keeping previous custom rules, cannot fetch %s: %v", cr.url, err)
+			}
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (cr *httpCustomRule) stop() {
+	cr.mu.Lock()
+	defer cr.mu.Unlock()
+	if cr.done != nil {
+		close(cr.done)
+		cr.done = nil
+	}
+}
+
+// fetch gets the rules and applies them if they changed. Rules which cannot
+// be fetched or parsed leave the current rules in place.
+func (cr *httpCustomRule) fetch() error {
+	req, err := http.NewRequest(http.MethodGet, cr.url, nil)
+	if err != nil {
+		return err
+	}
+	if cr.etag != "" {
+		req.Header.Set("If-None-Match", cr.etag)
+	}
+	if cr.lastModified != "" {
+		req.Header.Set("If-Modified-Since", cr.lastModified)
+	}
+	resp, err := cr.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotModified:
+		return nil
+	default:
+		return fmt.Errorf("unexpected status %s", resp.Status)
+	}
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	qrs := rules.New()
+	if err := qrs.UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("error unmarshaling query rules: %v", err)
+	}
+	cr.etag = resp.Header.Get("ETag")
+	cr.lastModified = resp.Header.Get("Last-Modified")
+	if cr.qrs == nil || !cr.qrs.Equal(qrs) {
+		cr.qrs = qrs.Copy()
+		cr.qsc.SetQueryRules(httpCustomRuleSource, qrs)
+		log.Infof("Custom rule fetched from %s and applied to vttablet", cr.url)
+	}
+	return nil
+}
+
+// newClient returns the HTTP client to fetch the rules with. TLS is
+// configured from the command line flags.
+func newClient() (*http.Client, error) {
+	client := &http.Client{Timeout: *ruleTimeout}
+	if *ruleCA == "" && *ruleCert == "" && *ruleName == "" {
+		return client, nil
+	}
+	config, err := vttls.ClientConfig(*ruleCert, *ruleKey, *ruleCA, *ruleName)
+	if err != nil {
+		return nil, err
+	}
+	client.Transport = &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		TLSClientConfig: config,
+	}
+	return client, nil
+}
+
+// activateHTTPCustomRules activates the HTTP based custom rule mechanism.
+func activateHTTPCustomRules(qsc tabletserver.Controller) {
+	if *ruleURL != "" {
+		qsc.RegisterQueryRuleSource(httpCustomRuleSource)
+
+		client, err := newClient()
+		if err != nil {
+			log.Fatalf("cannot start HTTPCustomRule: %v", err)
+		}
+		cr := newHTTPCustomRule(qsc, *ruleURL, *ruleInterval, client)
+		cr.start()
+
+		servenv.OnTerm(cr.stop)
+	}
+}
+
+func init() {
+	tabletserver.RegisterFunctions = append(tabletserver.RegisterFunctions, activateHTTPCustomRules)
+}
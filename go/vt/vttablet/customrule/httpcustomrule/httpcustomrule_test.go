@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httpcustomrule
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
+)
+
+var customRule1 = `[{"Name": "r1", "Description": "disallow user u1", "User": "u1"}]`
+
+var customRule2 = `[{"Name": "r2", "Description": "disallow user u2", "User": "u2"}]`
+
+// ruleServer serves a rule document with an ETag.
+type ruleServer struct {
+	mu     sync.Mutex
+	status int
+	body   string
+	etag   string
+	notMod int
+}
+
+func (rs *ruleServer) set(status int, body, etag string) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	rs.status, rs.body, rs.etag = status, body, etag
+}
+
+func (rs *ruleServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	if rs.etag != "" && r.Header.Get("If-None-Match") == rs.etag {
+		rs.notMod++
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("ETag", rs.etag)
+	w.WriteHeader(rs.status)
+	w.Write([]byte(rs.body))
+}
+
+func parseRules(t *testing.T, data string) *rules.Rules {
+	t.Helper()
+	qrs := rules.New()
+	require.NoError(t, qrs.UnmarshalJSON([]byte(data)))
+	return qrs
+}
+
+func TestHTTPCustomRuleFetch(t *testing.T) {
+	rs := &ruleServer{}
+	rs.set(http.StatusOK, customRule1, `"v1"`)
+	server := httptest.NewServer(rs)
+	defer server.Close()
+
+	qsc := tabletservermock.NewController()
+	qsc.RegisterQueryRuleSource(httpCustomRuleSource)
+	cr := newHTTPCustomRule(qsc, server.URL, time.Minute, server.Client())
+
+	require.NoError(t, cr.fetch())
+	assert.True(t, qsc.GetQueryRules(httpCustomRuleSource).Equal(parseRules(t, customRule1)))
+
+	// The ETag is sent back, and the unchanged rules are not transferred.
+	require.NoError(t, cr.fetch())
+	assert.Equal(t, 1, rs.notMod)
+
+	rs.set(http.StatusOK, customRule2, `"v2"`)
+	require.NoError(t, cr.fetch())
+	assert.True(t, qsc.GetQueryRules(httpCustomRuleSource).Equal(parseRules(t, customRule2)))
+
+	// Errors keep the previous rules.
+	rs.set(http.StatusInternalServerError, "", "")
+	assert.Error(t, cr.fetch())
+	rs.set(http.StatusOK, "[{", `"v3"`)
+	assert.Error(t, cr.fetch())
+	assert.True(t, qsc.GetQueryRules(httpCustomRuleSource).Equal(parseRules(t, customRule2)))
+}
+
+func TestHTTPCustomRuleTLS(t *testing.T) {
+	rs := &ruleServer{}
+	rs.set(http.StatusOK, customRule1, "")
+	server := httptest.NewTLSServer(rs)
+	defer server.Close()
+
+	qsc := tabletservermock.NewController()
+	qsc.RegisterQueryRuleSource(httpCustomRuleSource)
+
+	// The server certificate is not trusted by a default client.
+	cr := newHTTPCustomRule(qsc, server.URL, time.Minute, &http.Client{})
+	assert.Error(t, cr.fetch())
+
+	cr = newHTTPCustomRule(qsc, server.URL, time.Minute, server.Client())
+	require.NoError(t, cr.fetch())
+	assert.True(t, qsc.GetQueryRules(httpCustomRuleSource).Equal(parseRules(t, customRule1)))
+}
+
+func TestHTTPCustomRuleStartStop(t *testing.T) {
+	rs := &ruleServer{}
+	rs.set(http.StatusOK, customRule1, "")
+	server := httptest.NewServer(rs)
+	defer server.Close()
+
+	qsc := tabletservermock.NewController()
+	qsc.RegisterQueryRuleSource(httpCustomRuleSource)
+	cr := newHTTPCustomRule(qsc, server.URL, 10*time.Millisecond, server.Client())
+	cr.start()
+	defer cr.stop()
+
+	want := parseRules(t, customRule2)
+	rs.set(http.StatusOK, customRule2, "")
+	for start := time.Now(); ; {
+		if qrs := qsc.GetQueryRules(httpCustomRuleSource); qrs != nil && qrs.Equal(want) {
+			break
+		}
+		if time.Since(start) > 10*time.Second {
+			t.Fatalf("rules were not refreshed: %v", qsc.GetQueryRules(httpCustomRuleSource))
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+/*
+Package rulesig verifies the detached signatures of custom rule sets.
+
+The signature of a rule set is stored next to it, with a .sig suffix, as a
+JSON object with the name of the signer and the base64 encoded ed25519
+signature of the rule set:
+
+	{"Signer": "policy-service", "Signature": "..."}
+
+The public keys of the trusted signers are read from a JSON object mapping
+the signer names to their base64 encoded keys.
+*/
+package rulesig
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+)
+
+var keysFile = flag.String("customrules_signing_keys", "", "JSON file mapping signer names to base64 ed25519 public keys. If set, file and topo custom rules are only loaded if signed by one of the keys.")
+
+// Suffix is appended to the path of a rule set to get the path of
+// its signature.
+const Suffix = ".sig"
+
+// Signature is the content of a signature file.
+type Signature struct {
+	Signer    string
+	Signature []byte
+}
+
+// Keyring maps the signer names to their public keys.
+type Keyring map[string]ed25519.PublicKey
+
+// ParseKeyring parses a JSON object of signer names and base64 public keys.
+func ParseKeyring(data []byte) (Keyring, error) {
+	var keys map[string]string
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, fmt.Errorf("want json object of signing keys: %v", err)
+	}
+	kr := make(Keyring, len(keys))
+	for signer, key := range keys {
+		pub, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key for signer %s: %v", signer, err)
+		}
+		if len(pub) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("invalid key for signer %s: want %d bytes, got %d", signer, ed25519.PublicKeySize, len(pub))
+		}
+		kr[signer] = pub
+	}
+	return kr, nil
+}
+
+// KeyringFromFlags returns the keyring of the -customrules_signing_keys
+// flag, or nil if signatures are not required.
+func KeyringFromFlags() (Keyring, error) {
+	if *keysFile == "" {
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(*keysFile)
+	if err != nil {
+		return nil, err
+	}
+	return ParseKeyring(data)
+}
+
+// Verify checks that sig is a valid signature of data by one of the
+// signers of the keyring, and returns the name of the signer.
+func (kr Keyring) Verify(data, sig []byte) (signer string, err error) {
+	var s Signature
+	if err := json.Unmarshal(sig, &s); err != nil {
+		return "", fmt.Errorf("invalid signature: %v", err)
+	}
+	pub, ok := kr[s.Signer]
+	if !ok {
+		return "", fmt.Errorf("unknown signer %q", s.Signer)
+	}
+	if !ed25519.Verify(pub, data, s.Signature) {
+		return "", fmt.Errorf("signature of %s does not match the rules", s.Signer)
+	}
+	return s.Signer, nil
+}
+
+// Sign returns the content of the signature file of data.
+func Sign(signer string, key ed25519.PrivateKey, data []byte) ([]byte, error) {
+	return json.Marshal(Signature{
+		Signer:    signer,
+		Signature: ed25519.Sign(key, data),
+	})
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rulesig
+
+import (
+	"crypto/ed25519"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerify(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	_, otherPriv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	kr, err := ParseKeyring([]byte(fmt.Sprintf(`{"policy": %q}`, base64.StdEncoding.EncodeToString(pub))))
+	require.NoError(t, err)
+
+	data := []byte(`[{"Name": "r1"}]`)
+	sig, err := Sign("policy", priv, data)
+	require.NoError(t, err)
+	signer, err := kr.Verify(data, sig)
+	require.NoError(t, err)
+	assert.Equal(t, "policy", signer)
+
+	_, err = kr.Verify([]byte(`[{"Name": "r2"}]`), sig)
+	assert.EqualError(t, err, "signature of policy does not match the rules")
+
+	sig, err = Sign("policy", otherPriv, data)
+	require.NoError(t, err)
+	_, err = kr.Verify(data, sig)
+	assert.EqualError(t, err, "signature of policy does not match the rules")
+
+	sig, err = Sign("other", otherPriv, data)
+	require.NoError(t, err)
+	_, err = kr.Verify(data, sig)
+	assert.EqualError(t, err, `unknown signer "other"`)
+
+	_, err = kr.Verify(data, []byte("{"))
+	assert.Error(t, err)
+}
+
+func TestParseKeyring(t *testing.T) {
+	_, err := ParseKeyring([]byte(`[]`))
+	assert.Error(t, err)
+	_, err = ParseKeyring([]byte(`{"a": "!"}`))
+	assert.Error(t, err)
+	_, err = ParseKeyring([]byte(`{"a": "YWJj"}`))
+	assert.EqualError(t, err, "invalid key for signer a: want 32 bytes, got 3")
+}
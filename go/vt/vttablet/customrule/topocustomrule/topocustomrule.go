@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/customrule/rulesig"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 )
@@ -38,7 +39,7 @@ import (
 var (
 	// Commandline flag to specify rule cell and path.
 	ruleCell = flag.String("topocustomrule_cell", "global", "topo cell for customrules file.")
-	rulePath = flag.String("topocustomrule_path", "", "path for customrules file. Disabled if empty.")
+	rulePath = flag.String("topocustomrule_path", "", "path for customrules file. Disabled if empty. The rules of a keyspace managed by vtctld are at keyspaces/<keyspace>/QueryRules in the global cell.")
 )
 
 // topoCustomRuleSource is topo based custom rule source name
@@ -59,9 +60,20 @@ type topoCustomRule struct {
 	// filePath is the file to read from.
 	filePath string
 
+	// keyring holds the signers trusted to sign the rules. If set, the
+	// signature of the rules is read from filePath + rulesig.Suffix.
+	keyring rulesig.Keyring
+
 	// qrs is the current rule set that we read.
 	qrs *rules.Rules
 
+	// signer is the verified signer of qrs, if signatures are required.
+	signer string
+
+	// version is the version of the file qrs was read from. The
+	// watch resumes from it when it is restarted.
+	version topo.Version
+
 	// mu protects the following variables.
 	mu sync.Mutex
 
@@ -72,7 +84,7 @@ type topoCustomRule struct {
 	stopped bool
 }
 
-func newTopoCustomRule(qsc tabletserver.Controller, cell, filePath string) (*topoCustomRule, error) {
+func newTopoCustomRule(qsc tabletserver.Controller, cell, filePath string, keyring rulesig.Keyring) (*topoCustomRule, error) {
 	conn, err := qsc.TopoServer().ConnForCell(context.Background(), cell)
 	if err != nil {
 		return nil, err
@@ -81,6 +93,7 @@ func newTopoCustomRule(qsc tabletserver.Controller, cell, filePath string) (*top
 		qsc:      qsc,
 		conn:     conn,
 		filePath: filePath,
+		keyring:  keyring,
 	}, nil
 }
 
@@ -116,15 +129,37 @@ func (cr *topoCustomRule) stop() {
 }
 
 func (cr *topoCustomRule) apply(wd *topo.WatchData) error {
+	signer := ""
+	if cr.keyring != nil {
+		// If the signature is not updated yet, the error restarts
+		// the watch, which reads the rules again.
+		sig, _, err := cr.conn.Get(context.Background(), cr.filePath+rulesig.Suffix)
+		if err != nil {
+			return fmt.Errorf("cannot read signature of query rules version %v: %v", wd.Version, err)
+		}
+		if signer, err = cr.keyring.Verify(wd.Contents, sig); err != nil {
+			return fmt.Errorf("refusing query rules version %v: %v", wd.Version, err)
+		}
+	}
+
 	qrs := rules.New()
 	if err := qrs.UnmarshalJSON(wd.Contents); err != nil {
 		return fmt.Errorf("error unmarshaling query rules: %v, original data '%s' version %v", err, wd.Contents, wd.Version)
 	}
 
-	if !reflect.DeepEqual(cr.qrs, qrs) {
+	cr.version = wd.Version
+	if !reflect.DeepEqual(cr.qrs, qrs) || cr.signer != signer {
 		cr.qrs = qrs.Copy()
+		cr.signer = signer
 		cr.qsc.SetQueryRules(topoCustomRuleSource, qrs)
-		log.Infof("Custom rule version %v fetched from topo and applied to vttablet", wd.Version)
+		if err := cr.qsc.SetQueryRulesSigner(topoCustomRuleSource, signer); err != nil {
+			log.Warningf("Cannot record the signer of query rules version %v: %v", wd.Version, err)
+		}
+		if signer != "" {
+			log.Infof("Custom rule version %v signed by %s fetched from topo and applied to vttablet", wd.Version, signer)
+		} else {
+			log.Infof("Custom rule version %v fetched from topo and applied to vttablet", wd.Version)
+		}
 	}
 
 	return nil
@@ -139,7 +174,7 @@ func (cr *topoCustomRule) oneWatch() error {
 	}()
 
 	ctx := context.Background()
-	current, wdChannel, cancel := cr.conn.Watch(ctx, cr.filePath)
+	current, wdChannel, cancel := cr.conn.WatchFrom(ctx, cr.filePath, cr.version)
 	if current.Err != nil {
 		return current.Err
 	}
@@ -188,9 +223,13 @@ func (cr *topoCustomRule) oneWatch() error {
 // activateTopoCustomRules activates topo dynamic custom rule mechanism.
 func activateTopoCustomRules(qsc tabletserver.Controller) {
 	if *rulePath != "" {
+		keyring, err := rulesig.KeyringFromFlags()
+		if err != nil {
+			log.Fatalf("cannot load custom rule signing keys: %v", err)
+		}
 		qsc.RegisterQueryRuleSource(topoCustomRuleSource)
 
-		cr, err := newTopoCustomRule(qsc, *ruleCell, *rulePath)
+		cr, err := newTopoCustomRule(qsc, *ruleCell, *rulePath, keyring)
 		if err != nil {
 			log.Fatalf("cannot start TopoCustomRule: %v", err)
 		}
@@ -18,10 +18,15 @@ package topocustomrule
 
 import (
 	"context"
+	"crypto/ed25519"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vttablet/customrule/rulesig"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletservermock"
 )
@@ -84,7 +89,7 @@ func TestUpdate(t *testing.T) {
 	sleepDuringTopoFailure = time.Millisecond
 	ctx := context.Background()
 
-	cr, err := newTopoCustomRule(qsc, cell, filePath)
+	cr, err := newTopoCustomRule(qsc, cell, filePath, nil)
 	if err != nil {
 		t.Fatalf("newTopoCustomRule failed: %v", err)
 	}
@@ -107,3 +112,51 @@ func TestUpdate(t *testing.T) {
 	}
 	waitForValue(t, qsc, custom2)
 }
+
+func TestUpdateSigned(t *testing.T) {
+	custom1 := rules.New()
+	require.NoError(t, custom1.UnmarshalJSON([]byte(customRule1)))
+	custom2 := rules.New()
+	require.NoError(t, custom2.UnmarshalJSON([]byte(customRule2)))
+
+	pub, priv, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	sign := func(data string) []byte {
+		sig, err := rulesig.Sign("policy", priv, []byte(data))
+		require.NoError(t, err)
+		return sig
+	}
+
+	cell := "cell1"
+	filePath := "/keyspaces/ks1/configs/CustomRules"
+	ts := memorytopo.NewServer(cell)
+	qsc := tabletservermock.NewController()
+	qsc.TS = ts
+	sleepDuringTopoFailure = time.Millisecond
+	ctx := context.Background()
+
+	cr, err := newTopoCustomRule(qsc, cell, filePath, rulesig.Keyring{"policy": pub})
+	require.NoError(t, err)
+	cr.start()
+	defer cr.stop()
+
+	conn, err := ts.ConnForCell(ctx, cell)
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, filePath+rulesig.Suffix, sign(customRule1))
+	require.NoError(t, err)
+	_, err = conn.Create(ctx, filePath, []byte(customRule1))
+	require.NoError(t, err)
+	waitForValue(t, qsc, custom1)
+	assert.Equal(t, "policy", qsc.GetQueryRulesSigner(topoCustomRuleSource))
+
+	// Tampered rules are not applied.
+	_, err = conn.Update(ctx, filePath, []byte(customRule2), nil)
+	require.NoError(t, err)
+	time.Sleep(50 * time.Millisecond)
+	assert.True(t, qsc.GetQueryRules(topoCustomRuleSource).Equal(custom1))
+
+	// They are once the signature is updated.
+	_, err = conn.Update(ctx, filePath+rulesig.Suffix, sign(customRule2), nil)
+	require.NoError(t, err)
+	waitForValue(t, qsc, custom2)
+}
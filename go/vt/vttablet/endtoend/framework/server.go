@@ -17,29 +17,20 @@ limitations under the License.
 package framework
 
 import (
-	"fmt"
-	"net"
-	"net/http"
-	"time"
-
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/yaml2"
 
-	"vitess.io/vitess/go/vt/topo/memorytopo"
-	"vitess.io/vitess/go/vt/vterrors"
-
 	"context"
 
 	"vitess.io/vitess/go/mysql"
-	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/vtgate/fakerpcvtgateconn"
 	"vitess.io/vitess/go/vt/vtgate/vtgateconn"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletservertest"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
-	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 var (
@@ -53,6 +44,8 @@ var (
 	ResolveChan = make(chan string, 1)
 	// TopoServer is the topology for the server
 	TopoServer *topo.Server
+
+	harness *tabletservertest.Harness
 )
 
 // StartCustomServer starts the server and initializes
@@ -68,37 +61,15 @@ func StartCustomServer(connParams, connAppDebugParams mysql.ConnParams, dbName s
 		}, nil
 	})
 
-	dbcfgs := dbconfigs.NewTestDBConfigs(connParams, connAppDebugParams, dbName)
-
-	Target = querypb.Target{
-		Keyspace:   "vttest",
-		Shard:      "0",
-		TabletType: topodatapb.TabletType_MASTER,
-	}
-	TopoServer = memorytopo.NewServer("")
-
-	Server = tabletserver.NewTabletServer("", config, TopoServer, topodatapb.TabletAlias{})
-	Server.Register()
-	err := Server.StartService(Target, dbcfgs, nil /* mysqld */)
+	var err error
+	harness, err = tabletservertest.Start(connParams, connAppDebugParams, dbName, config)
 	if err != nil {
-		return vterrors.Wrap(err, "could not start service")
-	}
-
-	// Start http service.
-	ln, err := net.Listen("tcp", ":0")
-	if err != nil {
-		return vterrors.Wrap(err, "could not start listener")
-	}
-	ServerAddress = fmt.Sprintf("http://%s", ln.Addr().String())
-	go http.Serve(ln, nil)
-	for {
-		time.Sleep(10 * time.Millisecond)
-		response, err := http.Get(fmt.Sprintf("%s/debug/vars", ServerAddress))
-		if err == nil {
-			response.Body.Close()
-			break
-		}
+		return err
 	}
+	Target = harness.Target
+	Server = harness.Server
+	ServerAddress = harness.ServerAddress
+	TopoServer = harness.TopoServer
 	return nil
 }
 
@@ -121,7 +92,7 @@ func StartServer(connParams, connAppDebugParams mysql.ConnParams, dbName string)
 
 // StopServer must be called once all the tests are done.
 func StopServer() {
-	Server.StopService()
+	harness.Stop()
 }
 
 // txReolver transmits dtids to be resolved through ResolveChan.
@@ -241,6 +241,26 @@ func (client *FakeTabletManagerClient) VExec(ctx context.Context, tablet *topoda
 	return sqltypes.ResultToProto3(result), nil
 }
 
+// ShowTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) ShowTransactions(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.TransactionInfo, error) {
+	return nil, nil
+}
+
+// KillTransaction is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) KillTransaction(ctx context.Context, tablet *topodatapb.Tablet, transactionID int64) error {
+	return nil
+}
+
+// LiveQueries is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) LiveQueries(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	return nil, nil
+}
+
+// KillQuery is part of the tmclient.TabletManagerClient interface.
+func (client *FakeTabletManagerClient) KillQuery(ctx context.Context, tablet *topodatapb.Tablet, connID int64) error {
+	return nil
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *FakeTabletManagerClient) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	// This result satisfies 'select pos from _vt.vreplication...' called from split clone unit tests in go/vt/worker.
@@ -316,7 +336,7 @@ func (client *FakeTabletManagerClient) Backup(ctx context.Context, tablet *topod
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *FakeTabletManagerClient) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
 	return &eofEventStream{}, nil
 }
 
@@ -26,13 +26,25 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
-// logQueriesToFile is the vttablet startup flag that must be set for this plugin to be active.
-var logQueriesToFile = flag.String("log_queries_to_file", "", "Enable query logging to the specified file")
+var (
+	// logQueriesToFile is the vttablet startup flag that must be set for this plugin to be active.
+	logQueriesToFile = flag.String("log_queries_to_file", "", "Enable query logging to the specified file")
+
+	// logTransactionsToFile is the vttablet startup flag to also log the transactions to a file.
+	logTransactionsToFile = flag.String("log_transactions_to_file", "", "Enable transaction logging to the specified file")
+)
 
 func init() {
 	servenv.OnRun(func() {
 		if *logQueriesToFile != "" {
-			Init(*logQueriesToFile)
+			if _, err := Init(*logQueriesToFile); err != nil {
+				log.Errorf("Error logging queries to file %s: %v", *logQueriesToFile, err)
+			}
+		}
+		if *logTransactionsToFile != "" {
+			if _, err := InitTransactions(*logTransactionsToFile); err != nil {
+				log.Errorf("Error logging transactions to file %s: %v", *logTransactionsToFile, err)
+			}
 		}
 	})
 }
@@ -44,21 +56,33 @@ type FileLogger interface {
 }
 
 type fileLogger struct {
+	logger  *streamlog.StreamLogger
 	logChan chan interface{}
 }
 
 func (l *fileLogger) Stop() {
-	tabletenv.StatsLogger.Unsubscribe(l.logChan)
+	l.logger.Unsubscribe(l.logChan)
 }
 
 // Init starts logging to the given file path.
 func Init(path string) (FileLogger, error) {
 	log.Infof("Logging queries to file %s", path)
-	logChan, err := tabletenv.StatsLogger.LogToFile(path, streamlog.GetFormatter(tabletenv.StatsLogger))
+	return logToFile(tabletenv.StatsLogger, path)
+}
+
+// InitTransactions starts logging the transactions to the given file path.
+func InitTransactions(path string) (FileLogger, error) {
+	log.Infof("Logging transactions to file %s", path)
+	return logToFile(tabletenv.TxLogger, path)
+}
+
+func logToFile(logger *streamlog.StreamLogger, path string) (FileLogger, error) {
+	logChan, err := logger.LogToFile(path, streamlog.GetFormatter(logger))
 	if err != nil {
 		return nil, err
 	}
 	return &fileLogger{
+		logger:  logger,
 		logChan: logChan,
 	}, nil
 }
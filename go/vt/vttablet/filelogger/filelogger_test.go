@@ -18,14 +18,19 @@ package filelogger
 
 import (
 	"context"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
 )
 
 // TestFileLog sends a stream of five query records to the plugin, and verifies that they are logged.
@@ -65,7 +70,7 @@ func TestFileLog(t *testing.T) {
 	for i := 0; i < 10; i++ {
 		time.Sleep(10 * time.Millisecond)
 
-		want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 1\"\tmap[]\t1\t\"test 1 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 2\"\tmap[]\t1\t\"test 2 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t\n"
+		want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 1\"\tmap[]\t1\t\"test 1 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t0.000000\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 2\"\tmap[]\t1\t\"test 2 PII\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t0.000000\t\n"
 		contents, _ := ioutil.ReadFile(logPath)
 		got := string(contents)
 		if want == got {
@@ -119,10 +124,71 @@ func TestFileLogRedacted(t *testing.T) {
 	// Allow time for propagation
 	time.Sleep(10 * time.Millisecond)
 
-	want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 1\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 2\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t\n"
+	want := "\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 1\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t0.000000\t\n\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\t\t\"test 2\"\t\"[REDACTED]\"\t1\t\"[REDACTED]\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t0.000000\t\n"
 	contents, _ := ioutil.ReadFile(logPath)
 	got := string(contents)
 	if want != string(got) {
 		t.Errorf("streamlog file: want %q got %q", want, got)
 	}
 }
+
+// TestFileLogBinary sends query records to the plugin, and verifies that they are logged in the binary format.
+func TestFileLogBinary(t *testing.T) {
+	*streamlog.QueryLogFileFormat = streamlog.QueryLogFormatBinary
+	defer func() {
+		*streamlog.QueryLogFileFormat = ""
+	}()
+
+	dir, err := ioutil.TempDir("", "filelogger_test")
+	if err != nil {
+		t.Fatalf("error getting tempdir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	logPath := path.Join(dir, "test.log")
+	logger, err := Init(logPath)
+	if err != nil {
+		t.Fatalf("error setting up file logger: %v", err)
+	}
+	defer logger.Stop()
+
+	log1 := &tabletenv.LogStats{
+		Ctx:         context.Background(),
+		OriginalSQL: "test 1",
+		StartTime:   time.Unix(0, 1),
+		EndTime:     time.Unix(0, 2),
+	}
+	log1.AddRewrittenSQL("test 1 PII", time.Time{})
+	log1.MysqlResponseTime = 0
+	tabletenv.StatsLogger.Send(log1)
+
+	want := &querylogpb.TabletQuery{
+		StartTime:       1,
+		EndTime:         2,
+		OriginalSql:     "test 1",
+		NumberOfQueries: 1,
+		RewrittenSql:    "test 1 PII",
+		QuerySources:    "mysql",
+	}
+	for i := 0; i < 10; i++ {
+		time.Sleep(10 * time.Millisecond)
+
+		reader, err := streamlog.OpenBinaryLog(logPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := &querylogpb.TabletQuery{}
+		err = reader.Next(got)
+		reader.Close()
+		if err == io.EOF && i < 9 {
+			continue
+		}
+		if err != nil {
+			t.Fatalf("reading the binary log: %v", err)
+		}
+		if !proto.Equal(got, want) {
+			t.Errorf("streamlog file: want %v got %v", want, got)
+		}
+		return
+	}
+}
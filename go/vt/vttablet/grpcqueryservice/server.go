@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -43,6 +44,10 @@ var _ queryservicepb.QueryServer = (*query)(nil)
 // Execute is part of the queryservice.QueryServer interface
 func (q *query) Execute(ctx context.Context, request *querypb.ExecuteRequest) (response *querypb.ExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
+	// The memory of the results is held until gRPC sends the response,
+	// which it does as soon as the handler returns.
+	ctx, resp := tabletenv.ResponseContext(ctx)
+	defer resp.Sent()
 	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
@@ -59,6 +64,8 @@ func (q *query) Execute(ctx context.Context, request *querypb.ExecuteRequest) (r
 // ExecuteBatch is part of the queryservice.QueryServer interface
 func (q *query) ExecuteBatch(ctx context.Context, request *querypb.ExecuteBatchRequest) (response *querypb.ExecuteBatchResponse, err error) {
 	defer q.server.HandlePanic(&err)
+	ctx, resp := tabletenv.ResponseContext(ctx)
+	defer resp.Sent()
 	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
@@ -250,6 +257,8 @@ func (q *query) ReadTransaction(ctx context.Context, request *querypb.ReadTransa
 // BeginExecute is part of the queryservice.QueryServer interface
 func (q *query) BeginExecute(ctx context.Context, request *querypb.BeginExecuteRequest) (response *querypb.BeginExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
+	ctx, resp := tabletenv.ResponseContext(ctx)
+	defer resp.Sent()
 	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
@@ -276,6 +285,8 @@ func (q *query) BeginExecute(ctx context.Context, request *querypb.BeginExecuteR
 // BeginExecuteBatch is part of the queryservice.QueryServer interface
 func (q *query) BeginExecuteBatch(ctx context.Context, request *querypb.BeginExecuteBatchRequest) (response *querypb.BeginExecuteBatchResponse, err error) {
 	defer q.server.HandlePanic(&err)
+	ctx, resp := tabletenv.ResponseContext(ctx)
+	defer resp.Sent()
 	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
@@ -379,6 +390,8 @@ func (q *query) VStreamResults(request *binlogdatapb.VStreamResultsRequest, stre
 //ReserveExecute implements the QueryServer interface
 func (q *query) ReserveExecute(ctx context.Context, request *querypb.ReserveExecuteRequest) (response *querypb.ReserveExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
+	ctx, resp := tabletenv.ResponseContext(ctx)
+	defer resp.Sent()
 	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
@@ -405,6 +418,8 @@ func (q *query) ReserveExecute(ctx context.Context, request *querypb.ReserveExec
 //ReserveBeginExecute implements the QueryServer interface
 func (q *query) ReserveBeginExecute(ctx context.Context, request *querypb.ReserveBeginExecuteRequest) (response *querypb.ReserveBeginExecuteResponse, err error) {
 	defer q.server.HandlePanic(&err)
+	ctx, resp := tabletenv.ResponseContext(ctx)
+	defer resp.Sent()
 	ctx = callerid.NewContext(callinfo.GRPCCallInfo(ctx),
 		request.EffectiveCallerId,
 		request.ImmediateCallerId,
@@ -589,6 +589,56 @@ func (client *Client) VExec(ctx context.Context, tablet *topodatapb.Tablet, quer
 	return response.Result, nil
 }
 
+// ShowTransactions is part of the tmclient.TabletManagerClient interface.
+func (client *Client) ShowTransactions(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.TransactionInfo, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.ShowTransactions(ctx, &tabletmanagerdatapb.ShowTransactionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Transactions, nil
+}
+
+// KillTransaction is part of the tmclient.TabletManagerClient interface.
+func (client *Client) KillTransaction(ctx context.Context, tablet *topodatapb.Tablet, transactionID int64) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.KillTransaction(ctx, &tabletmanagerdatapb.KillTransactionRequest{TransactionId: transactionID})
+	return err
+}
+
+// LiveQueries is part of the tmclient.TabletManagerClient interface.
+func (client *Client) LiveQueries(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return nil, err
+	}
+	defer cc.Close()
+	response, err := c.LiveQueries(ctx, &tabletmanagerdatapb.LiveQueriesRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return response.Queries, nil
+}
+
+// KillQuery is part of the tmclient.TabletManagerClient interface.
+func (client *Client) KillQuery(ctx context.Context, tablet *topodatapb.Tablet, connID int64) error {
+	cc, c, err := client.dial(tablet)
+	if err != nil {
+		return err
+	}
+	defer cc.Close()
+	_, err = c.KillQuery(ctx, &tabletmanagerdatapb.KillQueryRequest{ConnectionId: connID})
+	return err
+}
+
 // VReplicationExec is part of the tmclient.TabletManagerClient interface.
 func (client *Client) VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error) {
 	cc, c, err := client.dial(tablet)
@@ -835,13 +885,13 @@ func (e *restoreFromBackupStreamAdapter) Recv() (*logutilpb.Event, error) {
 }
 
 // RestoreFromBackup is part of the tmclient.TabletManagerClient interface.
-func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error) {
+func (client *Client) RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error) {
 	cc, c, err := client.dial(tablet)
 	if err != nil {
 		return nil, err
 	}
 
-	stream, err := c.RestoreFromBackup(ctx, &tabletmanagerdatapb.RestoreFromBackupRequest{})
+	stream, err := c.RestoreFromBackup(ctx, req)
 	if err != nil {
 		cc.Close()
 		return nil, err
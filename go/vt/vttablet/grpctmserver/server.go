@@ -326,6 +326,38 @@ func (s *server) VExec(ctx context.Context, request *tabletmanagerdatapb.VExecRe
 	return response, err
 }
 
+func (s *server) ShowTransactions(ctx context.Context, request *tabletmanagerdatapb.ShowTransactionsRequest) (response *tabletmanagerdatapb.ShowTransactionsResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "ShowTransactions", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.ShowTransactionsResponse{}
+	response.Transactions, err = s.tm.ShowTransactions(ctx)
+	return response, err
+}
+
+func (s *server) KillTransaction(ctx context.Context, request *tabletmanagerdatapb.KillTransactionRequest) (response *tabletmanagerdatapb.KillTransactionResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "KillTransaction", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.KillTransactionResponse{}
+	err = s.tm.KillTransaction(ctx, request.TransactionId)
+	return response, err
+}
+
+func (s *server) LiveQueries(ctx context.Context, request *tabletmanagerdatapb.LiveQueriesRequest) (response *tabletmanagerdatapb.LiveQueriesResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "LiveQueries", request, response, false /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.LiveQueriesResponse{}
+	response.Queries, err = s.tm.LiveQueries(ctx)
+	return response, err
+}
+
+func (s *server) KillQuery(ctx context.Context, request *tabletmanagerdatapb.KillQueryRequest) (response *tabletmanagerdatapb.KillQueryResponse, err error) {
+	defer s.tm.HandleRPCPanic(ctx, "KillQuery", request, response, true /*verbose*/, &err)
+	ctx = callinfo.GRPCCallInfo(ctx)
+	response = &tabletmanagerdatapb.KillQueryResponse{}
+	err = s.tm.KillQuery(ctx, request.ConnectionId)
+	return response, err
+}
+
 func (s *server) VReplicationExec(ctx context.Context, request *tabletmanagerdatapb.VReplicationExecRequest) (response *tabletmanagerdatapb.VReplicationExecResponse, err error) {
 	defer s.tm.HandleRPCPanic(ctx, "VReplicationExec", request, response, true /*verbose*/, &err)
 	ctx = callinfo.GRPCCallInfo(ctx)
@@ -475,7 +507,7 @@ func (s *server) RestoreFromBackup(request *tabletmanagerdatapb.RestoreFromBacku
 		})
 	})
 
-	return s.tm.RestoreFromBackup(ctx, logger)
+	return s.tm.RestoreFromBackup(ctx, logger, request)
 }
 
 // registration glue
@@ -45,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
 	"vitess.io/vitess/go/vt/schema"
+	"vitess.io/vitess/go/vt/schemadiff"
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
@@ -103,7 +104,7 @@ var acceptableDropTableIfExistsErrorCodes = []int{mysql.ERCantFindFile, mysql.ER
 var ghostOverridePath = flag.String("gh-ost-path", "", "override default gh-ost binary full path")
 var ptOSCOverridePath = flag.String("pt-osc-path", "", "override default pt-online-schema-change binary full path")
 var migrationCheckInterval = flag.Duration("migration_check_interval", 1*time.Minute, "Interval between migration checks")
-var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long should vttablet keep an old migrated table before purging it")
+var retainOnlineDDLTables = flag.Duration("retain_online_ddl_tables", 24*time.Hour, "How long should vttablet keep an old migrated table before purging it. Completed migrations may only be reverted within this window")
 var migrationNextCheckInterval = 5 * time.Second
 
 const (
@@ -390,6 +391,25 @@ func (e *Executor) tableExists(ctx context.Context, tableName string) (bool, err
 	return (row != nil), nil
 }
 
+// showCreateTable returns the CREATE TABLE statement of the given table.
+func (e *Executor) showCreateTable(ctx context.Context, tableName string) (string, error) {
+	conn, err := e.pool.Get(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Recycle()
+
+	parsed := sqlparser.BuildParsedQuery(sqlShowCreateTable, tableName)
+	rs, err := conn.Exec(ctx, parsed.Query, 1, false)
+	if err != nil {
+		return "", err
+	}
+	if len(rs.Rows) != 1 || len(rs.Rows[0]) < 2 {
+		return "", fmt.Errorf("unexpected result for %s: %v", parsed.Query, rs.Rows)
+	}
+	return rs.Rows[0][1].ToString(), nil
+}
+
 func (e *Executor) parseAlterOptions(ctx context.Context, onlineDDL *schema.OnlineDDL) string {
 	// Temporary hack (2020-08-11)
 	// Because sqlparser does not do full blown ALTER TABLE parsing,
@@ -849,7 +869,7 @@ curl -s 'http://localhost:%d/schema-migration/report-status?uuid=%s&status=%s&dr
 			fmt.Sprintf(`--panic-flag-file=%s`, e.ghostPanicFlagFileName(onlineDDL.UUID)),
 			fmt.Sprintf(`--execute=%t`, execute),
 		}
-		opts, _ := shlex.Split(onlineDDL.Options)
+		opts, _ := shlex.Split(onlineDDL.RuntimeOptions())
 		args = append(args, opts...)
 		_, err := execCmd("bash", args, os.Environ(), "/tmp", nil, nil)
 		return err
@@ -1072,7 +1092,7 @@ export MYSQL_PWD
 				`--no-drop-old-table`,
 			)
 		}
-		opts, _ := shlex.Split(onlineDDL.Options)
+		opts, _ := shlex.Split(onlineDDL.RuntimeOptions())
 		args = append(args, opts...)
 		_, err = execCmd("bash", args, os.Environ(), "/tmp", nil, nil)
 		return err
@@ -1329,6 +1349,30 @@ func (e *Executor) validateMigrationRevertible(ctx context.Context, revertMigrat
 	if revertMigration.Status != schema.OnlineDDLStatusComplete {
 		return fmt.Errorf("can only revert a migration in a '%s' state. Migration %s is in '%s' state", schema.OnlineDDLStatusComplete, revertMigration.UUID, revertMigration.Status)
 	}
+	{
+		// Validation: migration artifacts (old tables, vreplication stream) are still retained
+		query, err := sqlparser.ParseAndBind(sqlSelectMigrationRevertibility,
+			sqltypes.Int64BindVariable(int64((*retainOnlineDDLTables).Seconds())),
+			sqltypes.StringBindVariable(revertMigration.UUID),
+		)
+		if err != nil {
+			return err
+		}
+		r, err := e.execQuery(ctx, query)
+		if err != nil {
+			return err
+		}
+		row := r.Named().Row()
+		if row == nil {
+			return ErrMigrationNotFound
+		}
+		if row.AsBool("is_cleaned_up", false) {
+			return fmt.Errorf("can not revert migration %s: its artifacts have already been cleaned up", revertMigration.UUID)
+		}
+		if row.AsBool("is_expired", false) {
+			return fmt.Errorf("can not revert migration %s: it completed more than %v ago, which is beyond the retention window", revertMigration.UUID, *retainOnlineDDLTables)
+		}
+	}
 	{
 		// Validation: see if there's a pending migration on this table:
 		r, err := e.execQuery(ctx, sqlSelectPendingMigrations)
@@ -1388,6 +1432,9 @@ func (e *Executor) executeRevert(ctx context.Context, onlineDDL *schema.OnlineDD
 	if err := e.validateMigrationRevertible(ctx, revertMigration); err != nil {
 		return err
 	}
+	if err := e.updateRevertedUUID(ctx, onlineDDL.UUID, revertUUID); err != nil {
+		return err
+	}
 	revertActionStr := row["ddl_action"].ToString()
 	switch revertActionStr {
 	case sqlparser.CreateStr:
@@ -1487,6 +1534,19 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 	if err != nil {
 		return failMigration(err)
 	}
+	if onlineDDL.IsDeclarative() && ddlAction == sqlparser.CreateDDLAction {
+		noop, err := e.evaluateDeclarativeMigration(ctx, onlineDDL)
+		if err != nil {
+			return failMigration(err)
+		}
+		if noop {
+			e.triggerNextCheckInterval()
+			return nil
+		}
+		if ddlAction, err = onlineDDL.GetAction(); err != nil {
+			return failMigration(err)
+		}
+	}
 	switch ddlAction {
 	case sqlparser.DropDDLAction:
 		go func() error {
@@ -1521,7 +1581,9 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 			}
 
 			acceptableErrorCodes := []int{}
-			if ddlStmt.GetIfExists() {
+			// The desired state of a declarative DROP is reached if
+			// the table doesn't exist.
+			if ddlStmt.GetIfExists() || onlineDDL.IsDeclarative() {
 				acceptableErrorCodes = acceptableDropTableIfExistsErrorCodes
 			}
 			acceptableErrCodeFound, err := e.executeDirectly(ctx, onlineDDL, acceptableErrorCodes...)
@@ -1623,6 +1685,46 @@ func (e *Executor) executeMigration(ctx context.Context, onlineDDL *schema.Onlin
 	return nil
 }
 
+// evaluateDeclarativeMigration compares the table of a declarative migration
+// with its desired CREATE TABLE statement. If the table doesn't exist, the
+// migration creates it. If the table is already as desired, the migration
+// completes right away and noop is true. Otherwise the statement of the
+// migration becomes the ALTER TABLE statement turning the table into the
+// desired one, which then runs with the strategy of the migration.
+func (e *Executor) evaluateDeclarativeMigration(ctx context.Context, onlineDDL *schema.OnlineDDL) (noop bool, err error) {
+	exists, err := e.tableExists(ctx, onlineDDL.Table)
+	if err != nil {
+		return false, err
+	}
+	if !exists {
+		return false, nil
+	}
+	current, err := e.showCreateTable(ctx, onlineDDL.Table)
+	if err != nil {
+		return false, err
+	}
+	alter, err := schemadiff.DiffCreateTableQueries(current, onlineDDL.SQL)
+	if err != nil {
+		return false, err
+	}
+	if alter == nil {
+		_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusRunning, false, progressPctStarted, etaSecondsUnknown)
+		_ = e.updateMigrationMessage(ctx, onlineDDL.UUID, "declarative: table is already as desired")
+		_ = e.onSchemaMigrationStatus(ctx, onlineDDL.UUID, schema.OnlineDDLStatusComplete, false, progressPctFull, etaSecondsNow)
+		return true, nil
+	}
+
+	onlineDDL.SQL = sqlparser.String(alter)
+	log.Infof("declarative migration %s: %s", onlineDDL.UUID, onlineDDL.SQL)
+	if err := e.updateMigrationStatement(ctx, onlineDDL.UUID, onlineDDL.SQL); err != nil {
+		return false, err
+	}
+	if err := e.updateDDLAction(ctx, onlineDDL.UUID, sqlparser.AlterStr); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
 func (e *Executor) runNextMigration(ctx context.Context) error {
 	e.migrationMutex.Lock()
 	defer e.migrationMutex.Unlock()
@@ -2006,8 +2108,18 @@ func (e *Executor) gcArtifacts(ctx context.Context) error {
 	}
 	for _, row := range r.Named().Rows {
 		uuid := row["migration_uuid"].ToString()
+		strategy := schema.DDLStrategy(row["strategy"].ToString())
+		ddlAction := row["ddl_action"].ToString()
 		artifacts := row["artifacts"].ToString()
 
+		if strategy == schema.DDLStrategyOnline && ddlAction == sqlparser.AlterStr {
+			// The stopped vreplication stream is what makes a vreplication migration revertible.
+			// Beyond the retention window we have no further use for it.
+			if err := e.terminateVReplMigration(ctx, uuid); err != nil {
+				return err
+			}
+		}
+
 		artifactTables := textutil.SplitDelimitedList(artifacts)
 		for _, artifactTable := range artifactTables {
 			if err := e.gcArtifactTable(ctx, artifactTable, uuid); err != nil {
@@ -2182,6 +2294,30 @@ func (e *Executor) updateDDLAction(ctx context.Context, uuid string, actionStr s
 	return err
 }
 
+func (e *Executor) updateRevertedUUID(ctx context.Context, uuid string, revertedUUID string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateRevertedUUID,
+		sqltypes.StringBindVariable(revertedUUID),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
+func (e *Executor) updateMigrationStatement(ctx context.Context, uuid string, statement string) error {
+	query, err := sqlparser.ParseAndBind(sqlUpdateMigrationStatement,
+		sqltypes.StringBindVariable(statement),
+		sqltypes.StringBindVariable(uuid),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = e.execQuery(ctx, query)
+	return err
+}
+
 func (e *Executor) updateMigrationMessage(ctx context.Context, uuid string, message string) error {
 	query, err := sqlparser.ParseAndBind(sqlUpdateMessage,
 		sqltypes.StringBindVariable(message),
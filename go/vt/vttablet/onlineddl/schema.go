@@ -56,6 +56,7 @@ const (
 	alterSchemaMigrationsTableMessage            = "ALTER TABLE _vt.schema_migrations add column message TEXT NOT NULL"
 	alterSchemaMigrationsTableTableCompleteIndex = "ALTER TABLE _vt.schema_migrations add KEY table_complete_idx (migration_status, keyspace(64), mysql_table(64), completed_timestamp)"
 	alterSchemaMigrationsTableETASeconds         = "ALTER TABLE _vt.schema_migrations add column eta_seconds bigint NOT NULL DEFAULT -1"
+	alterSchemaMigrationsTableRevertedUUID       = "ALTER TABLE _vt.schema_migrations add column reverted_uuid varchar(64) NOT NULL DEFAULT ''"
 
 	sqlScheduleSingleMigration = `UPDATE _vt.schema_migrations
 		SET
@@ -122,6 +123,16 @@ const (
 		WHERE
 			migration_uuid=%a
 	`
+	sqlUpdateRevertedUUID = `UPDATE _vt.schema_migrations
+			SET reverted_uuid=%a
+		WHERE
+			migration_uuid=%a
+	`
+	sqlUpdateMigrationStatement = `UPDATE _vt.schema_migrations
+			SET migration_statement=%a
+		WHERE
+			migration_uuid=%a
+	`
 	sqlUpdateMessage = `UPDATE _vt.schema_migrations
 			SET message=%a
 		WHERE
@@ -203,6 +214,8 @@ const (
 	`
 	sqlSelectUncollectedArtifacts = `SELECT
 			migration_uuid,
+			strategy,
+			ddl_action,
 			artifacts
 		FROM _vt.schema_migrations
 		WHERE
@@ -210,6 +223,14 @@ const (
 			AND cleanup_timestamp IS NULL
 			AND completed_timestamp <= NOW() - INTERVAL %a SECOND
 	`
+	sqlSelectMigrationRevertibility = `SELECT
+			migration_uuid,
+			cleanup_timestamp IS NOT NULL AS is_cleaned_up,
+			completed_timestamp <= NOW() - INTERVAL %a SECOND AS is_expired
+		FROM _vt.schema_migrations
+		WHERE
+			migration_uuid=%a
+	`
 	sqlSelectMigration = `SELECT
 			id,
 			migration_uuid,
@@ -273,6 +294,7 @@ const (
 		`
 	sqlDropTrigger       = "DROP TRIGGER IF EXISTS `%a`.`%a`"
 	sqlShowTablesLike    = "SHOW TABLES LIKE '%a'"
+	sqlShowCreateTable   = "SHOW CREATE TABLE `%a`"
 	sqlCreateTableLike   = "CREATE TABLE `%a` LIKE `%a`"
 	sqlAlterTableOptions = "ALTER TABLE `%a` %s"
 	sqlShowColumnsFrom   = "SHOW COLUMNS FROM `%a`"
@@ -335,4 +357,5 @@ var applyDDL = []string{
 	alterSchemaMigrationsTableMessage,
 	alterSchemaMigrationsTableTableCompleteIndex,
 	alterSchemaMigrationsTableETASeconds,
+	alterSchemaMigrationsTableRevertedUUID,
 }
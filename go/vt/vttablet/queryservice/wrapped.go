@@ -185,6 +185,11 @@ func (ws *wrappedService) Execute(ctx context.Context, target *querypb.Target, q
 		qr, innerErr = conn.Execute(ctx, target, query, bindVars, transactionID, reservedID, options)
 		// You cannot retry if you're in a transaction.
 		retryable := canRetry(ctx, innerErr) && (!inDedicatedConn)
+		// Writes go to the master: do not retry them if they could have
+		// been applied.
+		if retryable && target.GetTabletType() == topodatapb.TabletType_MASTER {
+			retryable = vterrors.IsSafeToRetryWrites(innerErr)
+		}
 		return retryable, innerErr
 	})
 	return qr, err
@@ -557,7 +557,8 @@ func (sbc *SandboxConn) getNextResult(stmt sqlparser.Statement) *sqltypes.Result
 		*sqlparser.Union,
 		*sqlparser.Show,
 		sqlparser.Explain,
-		*sqlparser.OtherRead:
+		*sqlparser.OtherRead,
+		*sqlparser.AnalyzeTable:
 		return getSingleRowResult()
 	case *sqlparser.Set,
 		sqlparser.DDLStatement,
@@ -86,14 +86,14 @@ func (fw *failingFakeWriter) Close() error          { return nil }
 // expectedLogStatsText returns the results expected from the plugin processing a dummy message generated by mockLogStats(...).
 func expectedLogStatsText(originalSQL string) string {
 	return fmt.Sprintf("Execute\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\tPASS_SELECT\t"+
-		"\"%s\"\t%s\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"", originalSQL, "map[]", originalSQL)
+		"\"%s\"\t%s\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t0.000000", originalSQL, "map[]", originalSQL)
 }
 
 // expectedRedactedLogStatsText returns the results expected from the plugin processing a dummy message generated by mockLogStats(...)
 // when redaction is enabled.
 func expectedRedactedLogStatsText(originalSQL string) string {
 	return fmt.Sprintf("Execute\t\t\t''\t''\t0001-01-01 00:00:00.000000\t0001-01-01 00:00:00.000000\t0.000000\tPASS_SELECT\t"+
-		"\"%s\"\t%q\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"", originalSQL, "[REDACTED]", "[REDACTED]")
+		"\"%s\"\t%q\t1\t\"%s\"\tmysql\t0.000000\t0.000000\t0\t0\t\"\"\t0.000000", originalSQL, "[REDACTED]", "[REDACTED]")
 }
 
 // TestSyslog sends a stream of five query records to the plugin, and verifies that they are logged.
@@ -38,7 +38,18 @@ func ErrorFromGRPC(err error) error {
 	if s, ok := status.FromError(err); ok {
 		code = s.Code()
 	}
-	return vterrors.Errorf(vtrpcpb.Code(code), "vttablet: %v", err)
+	vterr := vterrors.Errorf(vtrpcpb.Code(code), "vttablet: %v", err)
+	// Keep the details of the error, e.g. the MySQL error number, and
+	// whether the writes of the request could have been applied, so that
+	// vtgate does not retry writes that tabletserver applied.
+	fromGRPC := vterrors.FromGRPC(err)
+	if details := vterrors.Details(fromGRPC); len(details) != 0 {
+		vterr = vterrors.WithDetails(vterr, details)
+	}
+	if applied := vterrors.WritesApplied(fromGRPC); applied != vterrors.AppliedUnknown {
+		vterr = vterrors.WithApplied(vterr, applied)
+	}
+	return vterr
 }
 
 // ErrorFromVTRPC converts a *vtrpcpb.RPCError to vtError for
@@ -51,5 +62,12 @@ func ErrorFromVTRPC(err *vtrpcpb.RPCError) error {
 	if code == vtrpcpb.Code_OK {
 		code = vterrors.LegacyErrorCodeToCode(err.LegacyCode)
 	}
-	return vterrors.Errorf(code, "vttablet: %s", err.Message)
+	vterr := vterrors.Errorf(code, "vttablet: %s", err.Message)
+	if len(err.Details) != 0 {
+		vterr = vterrors.WithDetails(vterr, err.Details)
+	}
+	if err.Applied != vtrpcpb.Applied_APPLIED_UNKNOWN {
+		vterr = vterrors.WithApplied(vterr, vterrors.Applied(err.Applied))
+	}
+	return vterr
 }
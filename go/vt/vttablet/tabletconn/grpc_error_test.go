@@ -54,3 +54,40 @@ func TestTabletErrorFromRPCError(t *testing.T) {
 		}
 	}
 }
+
+func TestTabletErrorKeepsApplied(t *testing.T) {
+	err := vterrors.WithApplied(vterrors.New(vtrpcpb.Code_DEADLINE_EXCEEDED, "context deadline exceeded"), vterrors.NotApplied)
+
+	got := ErrorFromGRPC(vterrors.ToGRPC(err))
+	if code := vterrors.Code(got); code != vtrpcpb.Code_DEADLINE_EXCEEDED {
+		t.Errorf("ErrorFromGRPC: code %v, want %v", code, vtrpcpb.Code_DEADLINE_EXCEEDED)
+	}
+	if applied := vterrors.WritesApplied(got); applied != vterrors.NotApplied {
+		t.Errorf("ErrorFromGRPC: applied %v, want %v", applied, vterrors.NotApplied)
+	}
+
+	got = ErrorFromVTRPC(vterrors.ToVTRPC(err))
+	if applied := vterrors.WritesApplied(got); applied != vterrors.NotApplied {
+		t.Errorf("ErrorFromVTRPC: applied %v, want %v", applied, vterrors.NotApplied)
+	}
+}
+
+func TestTabletErrorKeepsDetails(t *testing.T) {
+	details := map[string]string{
+		vterrors.DetailErrno:    "1062",
+		vterrors.DetailSQLState: "23000",
+	}
+	err := vterrors.WithDetails(vterrors.New(vtrpcpb.Code_ALREADY_EXISTS, "Duplicate entry '1' for key 'PRIMARY'"), details)
+
+	for name, got := range map[string]error{
+		"ErrorFromGRPC":  ErrorFromGRPC(vterrors.ToGRPC(err)),
+		"ErrorFromVTRPC": ErrorFromVTRPC(vterrors.ToVTRPC(err)),
+	} {
+		if code := vterrors.Code(got); code != vtrpcpb.Code_ALREADY_EXISTS {
+			t.Errorf("%s: code %v, want %v", name, code, vtrpcpb.Code_ALREADY_EXISTS)
+		}
+		if errno, sqlState, ok := vterrors.SQLErrorDetails(got); !ok || errno != 1062 || sqlState != "23000" {
+			t.Errorf("%s: SQLErrorDetails: %v %v %v, want 1062 23000 true", name, errno, sqlState, ok)
+		}
+	}
+}
@@ -64,6 +64,13 @@ func testErrorHelper(t *testing.T, f *FakeQueryService, name string, ef func(con
 
 		// Transaction expired or was unknown
 		vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction 12"),
+
+		// MySQL error, with its structured details
+		vterrors.WithDetails(vterrors.Errorf(vtrpcpb.Code_ALREADY_EXISTS, "Duplicate entry '1' for key 'PRIMARY' (errno 1062) (sqlstate 23000)"), map[string]string{
+			vterrors.DetailErrno:       "1062",
+			vterrors.DetailSQLState:    "23000",
+			vterrors.DetailQueryDigest: "0123456789abcdef",
+		}),
 	}
 	for _, e := range errors {
 		f.TabletError = e
@@ -84,6 +91,14 @@ func testErrorHelper(t *testing.T, f *FakeQueryService, name string, ef func(con
 		if !strings.Contains(err.Error(), e.Error()) {
 			t.Errorf("client error message '%v' for %v doesn't contain expected server text message '%v'", err.Error(), name, e)
 		}
+
+		// The structured details are kept too.
+		details := vterrors.Details(err)
+		for key, want := range vterrors.Details(e) {
+			if got := details[key]; got != want {
+				t.Errorf("unexpected detail %v of the error from %v: got %v, wanted %v", key, name, got, want)
+			}
+		}
 	}
 	f.TabletError = nil
 }
@@ -0,0 +1,250 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"context"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/mysqlctl"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/topo"
+)
+
+var (
+	mysqlConfigReloadInterval = flag.Duration("mysql_config_reload_interval", 0, "if set, the MySQL config overrides of the tablet are periodically read from the topo: my.cnf is regenerated with them if mysqld is managed by the tablet, and the dynamic global variables which differ from the config are changed with SET GLOBAL. The changes are listed on /debug/mysql_config")
+
+	mysqlConfigChanges         = stats.NewCountersWithSingleLabel("MysqlConfigChanges", "Number of MySQL global variables changed to match the config", "Result")
+	mysqlConfigRestartRequired = stats.NewGauge("MysqlConfigRestartRequired", "Number of MySQL global variables which differ from the config but can only be changed by a restart")
+
+	mysqlVariableName = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// tabletManagedVariables are the global variables which vttablet changes
+// at runtime, and which must not be reset to their config value.
+var tabletManagedVariables = map[string]bool{
+	"read_only":                    true,
+	"super_read_only":              true,
+	"rpl_semi_sync_master_enabled": true,
+	"rpl_semi_sync_slave_enabled":  true,
+	"gtid_purged":                  true,
+	"gtid_slave_pos":               true,
+	"gtid_binlog_state":            true,
+	"gtid_mode":                    true,
+	"enforce_gtid_consistency":     true,
+	"server_id":                    true,
+}
+
+// maxMysqlConfigAuditEntries is the number of changes kept in the audit
+// trail.
+const maxMysqlConfigAuditEntries = 100
+
+// MysqlConfigAuditEntry is a change of a MySQL global variable made to
+// match the config.
+type MysqlConfigAuditEntry struct {
+	Time     time.Time
+	Variable string
+	Old      string
+	New      string
+	Error    string `json:",omitempty"`
+}
+
+// mysqlConfigStatus is the content of /debug/mysql_config.
+type mysqlConfigStatus struct {
+	Overrides       map[string]string
+	LastReload      time.Time
+	LastError       string `json:",omitempty"`
+	RestartRequired []mysqlctl.ConfigVariableChange
+	Audit           []MysqlConfigAuditEntry
+}
+
+// mysqlConfigManager periodically reconciles the MySQL config with the
+// overrides stored in the topo for the tablet.
+type mysqlConfigManager struct {
+	tm    *TabletManager
+	ticks *timer.Timer
+
+	// mu protects status.
+	mu     sync.Mutex
+	status mysqlConfigStatus
+
+	// failed has the desired values of the variables whose last change
+	// failed, so that retries aren't added to the audit trail.
+	failed map[string]string
+}
+
+func newMysqlConfigManager(tm *TabletManager, interval time.Duration) *mysqlConfigManager {
+	return &mysqlConfigManager{
+		tm:     tm,
+		ticks:  timer.NewTimer(interval),
+		failed: make(map[string]string),
+	}
+}
+
+// Open starts the reloads. It's a no-op if no interval is set.
+func (mcm *mysqlConfigManager) Open() {
+	if *mysqlConfigReloadInterval == 0 {
+		return
+	}
+	servenv.OnRun(func() {
+		http.HandleFunc("/debug/mysql_config", mcm.handleHTTP)
+	})
+	mcm.ticks.Start(mcm.reload)
+}
+
+// Close stops the reloads.
+func (mcm *mysqlConfigManager) Close() {
+	mcm.ticks.Stop()
+}
+
+func (mcm *mysqlConfigManager) reload() {
+	// Don't change the config while an action, like a restore or a
+	// reparent, is running. It will be done at the next reload.
+	if !mcm.tm.tryLock() {
+		return
+	}
+	defer mcm.tm.unlock()
+
+	ctx, cancel := context.WithTimeout(mcm.tm.BatchCtx, *topo.RemoteOperationTimeout)
+	defer cancel()
+	if err := mcm.reconcile(ctx); err != nil {
+		log.Warningf("Failed to reload the MySQL config: %v", err)
+		mcm.mu.Lock()
+		mcm.status.LastError = err.Error()
+		mcm.mu.Unlock()
+	}
+}
+
+// reconcile regenerates my.cnf with the overrides of the tablet, and
+// changes the global variables whose running value differs from the
+// config.
+func (mcm *mysqlConfigManager) reconcile(ctx context.Context) error {
+	tm := mcm.tm
+	overrides, err := tm.TopoServer.GetMysqlConfigOverrides(ctx, tm.tabletAlias)
+	if err != nil {
+		return err
+	}
+
+	// If mysqld isn't managed by the tablet, we don't know the rest of
+	// the config, so only the overrides are enforced.
+	settings := mysqlctl.MycnfSettings(mysqlctl.MergeMycnfOverrides("", overrides))
+	if tm.Cnf != nil {
+		tm.MysqlDaemon.SetConfigOverrides(overrides)
+		if err := tm.MysqlDaemon.RefreshConfig(ctx, tm.Cnf); err != nil {
+			return err
+		}
+		if settings, err = mysqlctl.ReadMycnfSettings(tm.Cnf); err != nil {
+			return err
+		}
+	}
+
+	qr, err := tm.MysqlDaemon.FetchSuperQuery(ctx, "SHOW GLOBAL VARIABLES")
+	if err != nil {
+		return err
+	}
+	variables := make(map[string]string, len(qr.Rows))
+	for _, row := range qr.Rows {
+		variables[row[0].ToString()] = row[1].ToString()
+	}
+
+	var restartRequired []mysqlctl.ConfigVariableChange
+	var audit []MysqlConfigAuditEntry
+	for _, change := range mysqlctl.DiffConfigVariables(settings, variables) {
+		if tabletManagedVariables[change.Name] || !mysqlVariableName.MatchString(change.Name) {
+			continue
+		}
+		err := tm.MysqlDaemon.ExecuteSuperQueryList(ctx, []string{
+			fmt.Sprintf("SET GLOBAL %s = %s", change.Name, mysqlVariableValue(change.Desired)),
+		})
+		if sqlErr, ok := err.(*mysql.SQLError); ok && sqlErr.Number() == mysql.ERIncorrectGlobalLocalVar {
+			// The variable is read-only.
+			restartRequired = append(restartRequired, change)
+			continue
+		}
+		if err != nil && mcm.failed[change.Name] == change.Desired {
+			continue
+		}
+		entry := MysqlConfigAuditEntry{
+			Time:     time.Now(),
+			Variable: change.Name,
+			Old:      change.Running,
+			New:      change.Desired,
+		}
+		if err != nil {
+			entry.Error = err.Error()
+			mcm.failed[change.Name] = change.Desired
+			mysqlConfigChanges.Add("Failed", 1)
+			log.Warningf("Failed to change MySQL global variable %s from %v to %v: %v", change.Name, change.Running, change.Desired, err)
+		} else {
+			delete(mcm.failed, change.Name)
+			mysqlConfigChanges.Add("Applied", 1)
+			log.Infof("Changed MySQL global variable %s from %v to %v", change.Name, change.Running, change.Desired)
+		}
+		audit = append(audit, entry)
+	}
+	mysqlConfigRestartRequired.Set(int64(len(restartRequired)))
+
+	mcm.mu.Lock()
+	defer mcm.mu.Unlock()
+	mcm.status.Overrides = overrides
+	mcm.status.LastReload = time.Now()
+	mcm.status.LastError = ""
+	mcm.status.RestartRequired = restartRequired
+	mcm.status.Audit = append(mcm.status.Audit, audit...)
+	if n := len(mcm.status.Audit) - maxMysqlConfigAuditEntries; n > 0 {
+		mcm.status.Audit = append([]MysqlConfigAuditEntry(nil), mcm.status.Audit[n:]...)
+	}
+	return nil
+}
+
+// mysqlVariableValue returns the SQL literal of a global variable value.
+func mysqlVariableValue(value string) string {
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		return value
+	}
+	return sqltypes.EncodeStringSQL(value)
+}
+
+func (mcm *mysqlConfigManager) handleHTTP(w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.DEBUGGING); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	mcm.mu.Lock()
+	data, err := json.MarshalIndent(mcm.status, "", "  ")
+	mcm.mu.Unlock()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Write(data)
+}
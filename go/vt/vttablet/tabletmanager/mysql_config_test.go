@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"testing"
+
+	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestMysqlConfigReconcile(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("cell1")
+	fmd := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	tm := &TabletManager{
+		BatchCtx:    ctx,
+		TopoServer:  ts,
+		MysqlDaemon: fmd,
+		tabletAlias: &topodatapb.TabletAlias{Cell: "cell1", Uid: 1},
+	}
+	tm.mysqlConfig = newMysqlConfigManager(tm, 0)
+
+	err := ts.SaveMysqlConfigOverrides(ctx, tm.tabletAlias, map[string]string{
+		"max-connections": "1000",
+		"long_query_time": "2",
+		"read_only":       "OFF",
+		"unknown_option":  "1",
+	})
+	require.NoError(t, err)
+
+	fmd.FetchSuperQueryMap = map[string]*sqltypes.Result{
+		"SHOW GLOBAL VARIABLES": sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+			"long_query_time|2.000000",
+			"max_connections|500",
+			"read_only|ON",
+		),
+	}
+	fmd.ExpectedExecuteSuperQueryList = []string{
+		"SET GLOBAL max_connections = 1000",
+	}
+
+	err = tm.mysqlConfig.reconcile(ctx)
+	require.NoError(t, err)
+	status := tm.mysqlConfig.status
+	require.Len(t, status.Audit, 1)
+	assert.Equal(t, "max_connections", status.Audit[0].Variable)
+	assert.Equal(t, "500", status.Audit[0].Old)
+	assert.Equal(t, "1000", status.Audit[0].New)
+	assert.Empty(t, status.Audit[0].Error)
+
+	// The change is not in the fake variables, so it's retried and
+	// fails. Only the first failure is added to the audit trail.
+	err = tm.mysqlConfig.reconcile(ctx)
+	require.NoError(t, err)
+	err = tm.mysqlConfig.reconcile(ctx)
+	require.NoError(t, err)
+	status = tm.mysqlConfig.status
+	require.Len(t, status.Audit, 2)
+	assert.Contains(t, status.Audit[1].Error, "unexpected extra query")
+}
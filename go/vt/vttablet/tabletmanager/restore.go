@@ -26,8 +26,6 @@ import (
 
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
 
-	"vitess.io/vitess/go/vt/dbconfigs"
-
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tmclient"
@@ -42,6 +40,7 @@ import (
 	"vitess.io/vitess/go/vt/topo/topoproto"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -60,6 +59,7 @@ var (
 	binlogUser           = flag.String("binlog_user", "", "PITR restore parameter: username of binlog server.")
 	binlogPwd            = flag.String("binlog_password", "", "PITR restore parameter: password of binlog server.")
 	timeoutForGTIDLookup = flag.Duration("pitr_gtid_lookup_timeout", 60*time.Second, "PITR restore parameter: timeout for fetching gtid from timestamp.")
+	timeoutForReplay     = flag.Duration("pitr_replay_timeout", time.Hour, "PITR restore parameter: timeout for applying the binlogs up to the restore position or time.")
 	binlogSslCa          = flag.String("binlog_ssl_ca", "", "PITR restore parameter: Filename containing TLS CA certificate to verify binlog server TLS certificate against.")
 	binlogSslCert        = flag.String("binlog_ssl_cert", "", "PITR restore parameter: Filename containing mTLS client certificate to present to binlog server as authentication.")
 	binlogSslKey         = flag.String("binlog_ssl_key", "", "PITR restore parameter: Filename containing mTLS client private key for use in binlog server authentication.")
@@ -88,7 +88,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 			log.Warningf("Orchestrator BeginMaintenance failed: %v", err)
 		}
 	}()
-	err := tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore)
+	err := tm.restoreDataLocked(ctx, logger, waitForBackupInterval, deleteBeforeRestore, nil /* request */)
 	if err != nil {
 		return err
 	}
@@ -105,7 +105,7 @@ func (tm *TabletManager) RestoreData(ctx context.Context, logger logutil.Logger,
 	return nil
 }
 
-func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool) error {
+func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.Logger, waitForBackupInterval time.Duration, deleteBeforeRestore bool, request *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 
 	tablet := tm.Tablet()
 	originalType := tablet.Type
@@ -130,6 +130,26 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		log.Infof("Using base_keyspace %v to restore keyspace %v", keyspace, tablet.Keyspace)
 	}
 
+	// A point-in-time restore requested through RestoreFromBackup takes
+	// precedence over the snapshot time of the keyspace.
+	restoreTime := keyspaceInfo.SnapshotTime
+	var restoreToPos mysql.Position
+	pitr := request != nil && (request.RestoreToPos != "" || request.RestoreToTimestamp != nil)
+	if pitr {
+		if request.RestoreToPos != "" && request.RestoreToTimestamp != nil {
+			return vterrors.New(vtrpcpb.Code_INVALID_ARGUMENT, "only one of restore_to_pos and restore_to_timestamp can be specified")
+		}
+		if request.RestoreToPos != "" {
+			restoreToPos, err = mysql.DecodePosition(request.RestoreToPos)
+			if err != nil {
+				return vterrors.Wrapf(err, "invalid restore_to_pos %v", request.RestoreToPos)
+			}
+			restoreTime = nil
+		} else {
+			restoreTime = request.RestoreToTimestamp
+		}
+	}
+
 	params := mysqlctl.RestoreParams{
 		Cnf:                 tm.Cnf,
 		Mysqld:              tm.MysqlDaemon,
@@ -141,7 +161,21 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 		DbName:              topoproto.TabletDbName(tablet),
 		Keyspace:            keyspace,
 		Shard:               tablet.Shard,
-		StartTime:           logutil.ProtoToTime(keyspaceInfo.SnapshotTime),
+		StartTime:           logutil.ProtoToTime(restoreTime),
+		RestoreToPos:        restoreToPos,
+	}
+
+	// Resolve the binlog source before touching any data, so a bad request
+	// fails without wiping the tablet.
+	var binlogSource *mysql.ConnParams
+	if pitr {
+		binlogSource, err = tm.binlogSourceParams(ctx, request.BinlogSourceTablet)
+		if err != nil {
+			return err
+		}
+		if binlogSource == nil {
+			return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "point-in-time restore requires either binlog_source_tablet or the -binlog_host, -binlog_port and -binlog_user flags")
+		}
 	}
 
 	// Check whether we're going to restore before changing to RESTORE type,
@@ -186,16 +220,37 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	if backupManifest != nil {
 		pos = backupManifest.Position
 	}
-	// If SnapshotTime is set , then apply the incremental change
-	if keyspaceInfo.SnapshotTime != nil {
-		err = tm.restoreToTimeFromBinlog(ctx, pos, keyspaceInfo.SnapshotTime)
-		if err != nil {
-			log.Errorf("unable to restore to the specified time %s, error : %v", keyspaceInfo.SnapshotTime.String(), err)
-			return nil
+	replayFailed := false
+	if err == nil {
+		switch {
+		case pitr && !restoreToPos.IsZero():
+			params.Logger.Infof("Restore: applying binlogs from %v:%v up to position %v", binlogSource.Host, binlogSource.Port, restoreToPos)
+			err = tm.restoreToPosFromBinlog(ctx, binlogSource, pos, restoreToPos)
+		case pitr:
+			params.Logger.Infof("Restore: applying binlogs from %v:%v up to time %v", binlogSource.Host, binlogSource.Port, logutil.ProtoToTime(restoreTime))
+			err = tm.restoreToTimeFromBinlog(ctx, binlogSource, pos, restoreTime)
+		case keyspaceInfo.SnapshotTime != nil:
+			// If SnapshotTime is set , then apply the incremental change
+			if source := binlogParamsFromFlags(); source != nil {
+				if err := tm.restoreToTimeFromBinlog(ctx, source, pos, keyspaceInfo.SnapshotTime); err != nil {
+					log.Errorf("unable to restore to the specified time %s, error : %v", keyspaceInfo.SnapshotTime.String(), err)
+					return nil
+				}
+			} else {
+				log.Warning("invalid binlog server setting, restoring to last available backup.")
+			}
 		}
+		replayFailed = err != nil
 	}
 	switch err {
 	case nil:
+		if pitr {
+			// A tablet restored to a point in time is behind its shard on purpose,
+			// so we don't reconnect it to the master. It is left DRAINED for the
+			// operator to inspect or extract data from.
+			originalType = topodatapb.TabletType_DRAINED
+			break
+		}
 		// Starting from here we won't be able to recover if we get stopped by a cancelled
 		// context. Thus we use the background context to get through to the finish.
 		if keyspaceInfo.KeyspaceType == topodatapb.KeyspaceType_NORMAL {
@@ -207,7 +262,13 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	case mysqlctl.ErrNoBackup:
 		// No-op, starting with empty database.
 	default:
-		// If anything failed, we should reset the original tablet type
+		// If anything failed, we should reset the original tablet type,
+		// unless the backup was restored but the binlogs were not applied.
+		// The data is then at no meaningful position, so the tablet is left
+		// DRAINED instead of serving it.
+		if replayFailed {
+			originalType = topodatapb.TabletType_DRAINED
+		}
 		if err := tm.tmState.ChangeTabletType(ctx, originalType, DBActionNone); err != nil {
 			log.Errorf("Could not change back to original tablet type %v: %v", originalType, err)
 		}
@@ -227,19 +288,65 @@ func (tm *TabletManager) restoreDataLocked(ctx context.Context, logger logutil.L
 	return tm.tmState.ChangeTabletType(ctx, originalType, DBActionNone)
 }
 
-// restoreToTimeFromBinlog restores to the snapshot time of the keyspace
-// currently this works with mysql based database only (as it uses mysql specific queries for restoring)
-func (tm *TabletManager) restoreToTimeFromBinlog(ctx context.Context, pos mysql.Position, restoreTime *vttime.Time) error {
-	// validate the minimal settings necessary for connecting to binlog server
+// binlogParamsFromFlags returns the connection parameters of the binlog
+// server configured through the -binlog_* flags, or nil if the minimal
+// settings necessary for connecting to it are missing.
+func binlogParamsFromFlags() *mysql.ConnParams {
 	if *binlogHost == "" || *binlogPort <= 0 || *binlogUser == "" {
-		log.Warning("invalid binlog server setting, restoring to last available backup.")
 		return nil
 	}
+	connParams := &mysql.ConnParams{
+		Host:       *binlogHost,
+		Port:       *binlogPort,
+		Uname:      *binlogUser,
+		Pass:       *binlogPwd,
+		SslCa:      *binlogSslCa,
+		SslCert:    *binlogSslCert,
+		SslKey:     *binlogSslKey,
+		ServerName: *binlogSslServerName,
+	}
+	if *binlogSslCa != "" || *binlogSslCert != "" {
+		connParams.EnableSSL()
+	}
+	return connParams
+}
 
-	timeoutCtx, cancelFnc := context.WithTimeout(ctx, *timeoutForGTIDLookup)
-	defer cancelFnc()
+// binlogSourceParams returns the connection parameters of the server binlogs
+// are read from during a point-in-time restore. If sourceAlias is set, the
+// mysqld of that tablet is used with this tablet's replication credentials.
+// Otherwise the binlog server from the -binlog_* flags is used.
+func (tm *TabletManager) binlogSourceParams(ctx context.Context, sourceAlias *topodatapb.TabletAlias) (*mysql.ConnParams, error) {
+	if sourceAlias == nil {
+		return binlogParamsFromFlags(), nil
+	}
+	if topoproto.TabletAliasEqual(sourceAlias, tm.tabletAlias) {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "tablet %v cannot be its own binlog source", topoproto.TabletAliasString(sourceAlias))
+	}
+	ti, err := tm.TopoServer.GetTablet(ctx, sourceAlias)
+	if err != nil {
+		return nil, vterrors.Wrapf(err, "cannot read binlog source tablet %v", topoproto.TabletAliasString(sourceAlias))
+	}
+	if ti.MysqlHostname == "" || ti.MysqlPort == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "binlog source tablet %v has no mysql address in the topology", topoproto.TabletAliasString(sourceAlias))
+	}
+	replParams, err := tm.DBConfigs.ReplConnector().MysqlParams()
+	if err != nil {
+		return nil, err
+	}
+	connParams := *replParams
+	connParams.Host = ti.MysqlHostname
+	connParams.Port = int(ti.MysqlPort)
+	connParams.UnixSocket = ""
+	return &connParams, nil
+}
+
+// restoreToTimeFromBinlog restores to the snapshot time of the keyspace
+// currently this works with mysql based database only (as it uses mysql specific queries for restoring)
+func (tm *TabletManager) restoreToTimeFromBinlog(ctx context.Context, source *mysql.ConnParams, pos mysql.Position, restoreTime *vttime.Time) error {
+	lookupCtx, cancelLookup := context.WithTimeout(ctx, *timeoutForGTIDLookup)
+	defer cancelLookup()
 
-	afterGTIDPos, beforeGTIDPos, err := tm.getGTIDFromTimestamp(timeoutCtx, pos, restoreTime.Seconds)
+	afterGTIDPos, beforeGTIDPos, err := tm.getGTIDFromTimestamp(lookupCtx, source, pos, restoreTime.Seconds)
 	if err != nil {
 		return err
 	}
@@ -256,7 +363,22 @@ func (tm *TabletManager) restoreToTimeFromBinlog(ctx context.Context, pos mysql.
 	if beforeGTIDPos == "" {
 		beforeGTIDPos = pos.GTIDSet.Last()
 	}
-	err = tm.catchupToGTID(timeoutCtx, afterGTIDPos, beforeGTIDPos)
+	beforeGTIDPosParsed, err := mysql.DecodePosition(beforeGTIDPos)
+	if err != nil {
+		return err
+	}
+	// when the there is no afterPos, that means need to replicate completely
+	var until string
+	if afterGTIDPos != "" {
+		afterGTIDParsed, err := mysql.DecodePosition(afterGTIDPos)
+		if err != nil {
+			return err
+		}
+		until = fmt.Sprintf("SQL_BEFORE_GTIDS = '%s'", afterGTIDParsed.GTIDSet.Last())
+	}
+	replayCtx, cancelReplay := context.WithTimeout(ctx, *timeoutForReplay)
+	defer cancelReplay()
+	err = tm.catchupToGTID(replayCtx, source, until, beforeGTIDPosParsed)
 	if err != nil {
 		return vterrors.Wrapf(err, "unable to replicate upto desired GTID : %s", afterGTIDPos)
 	}
@@ -264,32 +386,29 @@ func (tm *TabletManager) restoreToTimeFromBinlog(ctx context.Context, pos mysql.
 	return nil
 }
 
+// restoreToPosFromBinlog applies binlogs from the source on top of a restored
+// backup at pos, until restoreToPos is reached.
+func (tm *TabletManager) restoreToPosFromBinlog(ctx context.Context, source *mysql.ConnParams, pos, restoreToPos mysql.Position) error {
+	if pos.AtLeast(restoreToPos) {
+		log.Infof("backup position %v already contains %v, no binlogs to apply", pos, restoreToPos)
+		return nil
+	}
+	replayCtx, cancelReplay := context.WithTimeout(ctx, *timeoutForReplay)
+	defer cancelReplay()
+
+	until := fmt.Sprintf("SQL_AFTER_GTIDS = '%s'", restoreToPos.GTIDSet.String())
+	if err := tm.catchupToGTID(replayCtx, source, until, restoreToPos); err != nil {
+		return vterrors.Wrapf(err, "unable to replicate upto desired position : %v", restoreToPos)
+	}
+	return nil
+}
+
 // getGTIDFromTimestamp computes 2 GTIDs based on restoreTime
 // afterPos is the GTID of the first event at or after restoreTime.
 // beforePos is the GTID of the last event before restoreTime. This is the GTID upto which replication will be applied
 // afterPos can be used directly in the query `START SLAVE UNTIL SQL_BEFORE_GTIDS = ''`
 // beforePos will be used to check if replication was able to catch up from the binlog server
-func (tm *TabletManager) getGTIDFromTimestamp(ctx context.Context, pos mysql.Position, restoreTime int64) (afterPos string, beforePos string, err error) {
-	connParams := &mysql.ConnParams{
-		Host:       *binlogHost,
-		Port:       *binlogPort,
-		Uname:      *binlogUser,
-		SslCa:      *binlogSslCa,
-		SslCert:    *binlogSslCert,
-		SslKey:     *binlogSslKey,
-		ServerName: *binlogSslServerName,
-	}
-	if *binlogPwd != "" {
-		connParams.Pass = *binlogPwd
-	}
-	if *binlogSslCa != "" || *binlogSslCert != "" {
-		connParams.EnableSSL()
-	}
-	dbCfgs := &dbconfigs.DBConfigs{
-		Host: connParams.Host,
-		Port: connParams.Port,
-	}
-	dbCfgs.SetDbParams(*connParams, *connParams)
+func (tm *TabletManager) getGTIDFromTimestamp(ctx context.Context, connParams *mysql.ConnParams, pos mysql.Position, restoreTime int64) (afterPos string, beforePos string, err error) {
 	vsClient := vreplication.NewReplicaConnector(connParams)
 
 	filter := &binlogdatapb.Filter{
@@ -351,25 +470,23 @@ func (tm *TabletManager) getGTIDFromTimestamp(ctx context.Context, pos mysql.Pos
 	}
 }
 
-// catchupToGTID replicates upto specified GTID from binlog server
+// catchupToGTID replicates from the binlog source until the given UNTIL clause
+// is satisfied, e.g. SQL_BEFORE_GTIDS or SQL_AFTER_GTIDS. An empty clause
+// replicates everything the source has.
 //
 // copies the data from binlog server by pointing to as replica
-// waits till all events to GTID replicated
+// waits till all events up to waitPos are replicated
 // once done, it will reset the replication
-func (tm *TabletManager) catchupToGTID(ctx context.Context, afterGTIDPos string, beforeGTIDPos string) error {
-	var afterGTIDStr string
-	if afterGTIDPos != "" {
-		afterGTIDParsed, err := mysql.DecodePosition(afterGTIDPos)
+//
+// If it fails, the replication from the binlog source is stopped and reset
+// too, so that the tablet doesn't keep applying binlogs past the requested
+// point, or stay connected to the binlog source.
+func (tm *TabletManager) catchupToGTID(ctx context.Context, source *mysql.ConnParams, until string, waitPos mysql.Position) (err error) {
+	defer func() {
 		if err != nil {
-			return err
+			tm.resetBinlogReplication()
 		}
-		afterGTIDStr = afterGTIDParsed.GTIDSet.Last()
-	}
-
-	beforeGTIDPosParsed, err := mysql.DecodePosition(beforeGTIDPos)
-	if err != nil {
-		return err
-	}
+	}()
 
 	// it uses mysql specific queries here
 	cmds := []string{
@@ -377,73 +494,72 @@ func (tm *TabletManager) catchupToGTID(ctx context.Context, afterGTIDPos string,
 		"STOP SLAVE IO_THREAD FOR CHANNEL ''",
 	}
 
-	if *binlogSslCa != "" || *binlogSslCert != "" {
+	changeMasterCmd := fmt.Sprintf("CHANGE MASTER TO MASTER_HOST='%s', MASTER_PORT=%d, MASTER_USER='%s', MASTER_PASSWORD='%s', MASTER_AUTO_POSITION=1", source.Host, source.Port, source.Uname, source.Pass)
+	if source.SslEnabled() {
 		// We need to use TLS
-		changeMasterCmd := fmt.Sprintf("CHANGE MASTER TO MASTER_HOST='%s', MASTER_PORT=%d, MASTER_USER='%s', MASTER_PASSWORD='%s', MASTER_AUTO_POSITION=1, MASTER_SSL=1", *binlogHost, *binlogPort, *binlogUser, *binlogPwd)
-		if *binlogSslCa != "" {
-			changeMasterCmd += fmt.Sprintf(", MASTER_SSL_CA='%s'", *binlogSslCa)
+		changeMasterCmd += ", MASTER_SSL=1"
+		if source.SslCa != "" {
+			changeMasterCmd += fmt.Sprintf(", MASTER_SSL_CA='%s'", source.SslCa)
 		}
-		if *binlogSslCert != "" {
-			changeMasterCmd += fmt.Sprintf(", MASTER_SSL_CERT='%s'", *binlogSslCert)
+		if source.SslCert != "" {
+			changeMasterCmd += fmt.Sprintf(", MASTER_SSL_CERT='%s'", source.SslCert)
 		}
-		if *binlogSslKey != "" {
-			changeMasterCmd += fmt.Sprintf(", MASTER_SSL_KEY='%s'", *binlogSslKey)
+		if source.SslKey != "" {
+			changeMasterCmd += fmt.Sprintf(", MASTER_SSL_KEY='%s'", source.SslKey)
 		}
-		cmds = append(cmds, changeMasterCmd+";")
-	} else {
-		// No TLS
-		cmds = append(cmds, fmt.Sprintf("CHANGE MASTER TO MASTER_HOST='%s', MASTER_PORT=%d, MASTER_USER='%s', MASTER_PASSWORD='%s', MASTER_AUTO_POSITION=1;", *binlogHost, *binlogPort, *binlogUser, *binlogPwd))
 	}
+	cmds = append(cmds, changeMasterCmd+";")
 
-	if afterGTIDPos == "" { // when the there is no afterPos, that means need to replicate completely
+	if until == "" {
 		cmds = append(cmds, "START SLAVE")
 	} else {
-		cmds = append(cmds, fmt.Sprintf("START SLAVE UNTIL SQL_BEFORE_GTIDS = '%s'", afterGTIDStr))
+		cmds = append(cmds, fmt.Sprintf("START SLAVE UNTIL %s", until))
 	}
 
 	if err := tm.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
-		return vterrors.Wrap(err, fmt.Sprintf("failed to restart the replication until %s GTID", afterGTIDStr))
+		return vterrors.Wrap(err, fmt.Sprintf("failed to restart the replication until %s", until))
 	}
-	log.Infof("Waiting for position to reach", beforeGTIDPosParsed.GTIDSet.Last())
+	log.Infof("Waiting for position to reach %v", waitPos)
 	// Could not use `agent.MysqlDaemon.WaitMasterPos` as replication is stopped with `START SLAVE UNTIL SQL_BEFORE_GTIDS`
 	// this is as per https://dev.mysql.com/doc/refman/5.6/en/start-slave.html
-	// We need to wait until replication catches upto the specified afterGTIDPos
-	chGTIDCaughtup := make(chan bool)
-	go func() {
-		timeToWait := time.Now().Add(*timeoutForGTIDLookup)
-		for time.Now().Before(timeToWait) {
-			pos, err := tm.MysqlDaemon.MasterPosition()
-			if err != nil {
-				chGTIDCaughtup <- false
-			}
-
-			if pos.AtLeast(beforeGTIDPosParsed) {
-				chGTIDCaughtup <- true
-			}
-			select {
-			case <-ctx.Done():
-				chGTIDCaughtup <- false
-			default:
-				time.Sleep(300 * time.Millisecond)
-			}
+	// We need to wait until replication catches upto the specified position
+	ticker := time.NewTicker(300 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		pos, err := tm.MysqlDaemon.MasterPosition()
+		if err != nil {
+			return vterrors.Wrap(err, "error while fetching the current GTID position")
 		}
-	}()
-	select {
-	case resp := <-chGTIDCaughtup:
-		if resp {
-			cmds := []string{
-				"STOP SLAVE",
-				"RESET SLAVE ALL",
-			}
-			if err := tm.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
-				return vterrors.Wrap(err, "failed to stop replication")
-			}
-			return nil
+		if pos.AtLeast(waitPos) {
+			break
 		}
-		return vterrors.Wrap(err, "error while fetching the current GTID position")
-	case <-ctx.Done():
-		log.Warningf("Could not copy up to GTID.")
-		return vterrors.Wrapf(err, "context timeout while restoring up to specified GTID - %s", beforeGTIDPos)
+		select {
+		case <-ctx.Done():
+			log.Warningf("Could not copy up to GTID.")
+			return vterrors.Wrapf(ctx.Err(), "context timeout while restoring up to specified position - %v", waitPos)
+		case <-ticker.C:
+		}
+	}
+	cmds = []string{
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+	}
+	if err := tm.MysqlDaemon.ExecuteSuperQueryList(ctx, cmds); err != nil {
+		return vterrors.Wrap(err, "failed to stop replication")
+	}
+	return nil
+}
+
+// resetBinlogReplication stops and resets the replication from the binlog
+// source after catchupToGTID failed. It uses a background context, since
+// the failure may be the expiry of the restore's context.
+func (tm *TabletManager) resetBinlogReplication() {
+	cmds := []string{
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+	}
+	if err := tm.MysqlDaemon.ExecuteSuperQueryList(context.Background(), cmds); err != nil {
+		log.Errorf("Cannot reset the replication from the binlog source: %v", err)
 	}
 }
 
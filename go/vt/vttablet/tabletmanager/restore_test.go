@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/vt/mysqlctl/fakemysqldaemon"
+)
+
+func TestCatchupToGTIDResetsReplicationOnFailure(t *testing.T) {
+	fmd := fakemysqldaemon.NewFakeMysqlDaemon(nil)
+	fmd.ExpectedExecuteSuperQueryList = []string{
+		"STOP SLAVE FOR CHANNEL '' ",
+		"STOP SLAVE IO_THREAD FOR CHANNEL ''",
+		"SUBCHANGE MASTER TO",
+		"START SLAVE UNTIL SQL_AFTER_GTIDS = '00010203-0405-0607-0809-0a0b0c0d0e0f:1-10'",
+		"STOP SLAVE",
+		"RESET SLAVE ALL",
+	}
+	tm := &TabletManager{MysqlDaemon: fmd}
+
+	waitPos, err := mysql.DecodePosition("MySQL56/00010203-0405-0607-0809-0a0b0c0d0e0f:1-10")
+	require.NoError(t, err)
+	source := &mysql.ConnParams{Host: "binlogs", Port: 3306, Uname: "repl"}
+
+	// The replication never reaches waitPos.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	err = tm.catchupToGTID(ctx, source, "SQL_AFTER_GTIDS = '00010203-0405-0607-0809-0a0b0c0d0e0f:1-10'", waitPos)
+	assert.Error(t, err)
+	assert.NoError(t, fmd.CheckSuperQueryList())
+}
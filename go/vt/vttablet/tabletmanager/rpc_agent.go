@@ -98,6 +98,14 @@ type RPCTM interface {
 	// VExec generic API
 	VExec(ctx context.Context, query, workflow, keyspace string) (*querypb.QueryResult, error)
 
+	// Transaction introspection API
+	ShowTransactions(ctx context.Context) ([]*tabletmanagerdatapb.TransactionInfo, error)
+	KillTransaction(ctx context.Context, transactionID int64) error
+
+	// Live query API
+	LiveQueries(ctx context.Context) ([]*tabletmanagerdatapb.LiveQuery, error)
+	KillQuery(ctx context.Context, connID int64) error
+
 	// VReplication API
 	VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, id int, pos string) error
@@ -130,7 +138,7 @@ type RPCTM interface {
 
 	Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error
 
-	RestoreFromBackup(ctx context.Context, logger logutil.Logger) error
+	RestoreFromBackup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.RestoreFromBackupRequest) error
 
 	// HandleRPCPanic is to be called in a defer statement in each
 	// RPC input point.
@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// LiveQueries returns the queries executing on the tablet.
+func (tm *TabletManager) LiveQueries(ctx context.Context) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	var result []*tabletmanagerdatapb.LiveQuery
+	for _, row := range tm.QueryServiceControl.LiveQueries() {
+		result = append(result, &tabletmanagerdatapb.LiveQuery{
+			Type:            row.Type,
+			Query:           row.Query,
+			StartTime:       row.Start.UnixNano(),
+			Duration:        row.Duration.Nanoseconds(),
+			ConnectionId:    row.ConnID,
+			TransactionId:   row.TransactionID,
+			ImmediateCaller: row.ImmediateCaller,
+			EffectiveCaller: row.EffectiveCaller,
+		})
+	}
+	return result, nil
+}
+
+// KillQuery kills the query executing on the MySQL connection connID of the
+// tablet.
+func (tm *TabletManager) KillQuery(ctx context.Context, connID int64) error {
+	if !tm.QueryServiceControl.KillQuery(connID) {
+		return vterrors.Errorf(vtrpcpb.Code_NOT_FOUND, "no query running on connection %d", connID)
+	}
+	return nil
+}
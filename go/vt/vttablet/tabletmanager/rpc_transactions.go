@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletmanager
+
+import (
+	"context"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+// ShowTransactions returns the transactions open on the tablet.
+func (tm *TabletManager) ShowTransactions(ctx context.Context) ([]*tabletmanagerdatapb.TransactionInfo, error) {
+	var result []*tabletmanagerdatapb.TransactionInfo
+	for _, tx := range tm.QueryServiceControl.Transactions() {
+		result = append(result, &tabletmanagerdatapb.TransactionInfo{
+			TransactionId:   tx.ID,
+			StartTime:       tx.StartTime.UnixNano(),
+			Queries:         tx.Queries,
+			EffectiveCaller: tx.EffectiveCaller,
+			ImmediateCaller: tx.ImmediateCaller,
+		})
+	}
+	return result, nil
+}
+
+// KillTransaction terminates a transaction open on the tablet.
+func (tm *TabletManager) KillTransaction(ctx context.Context, transactionID int64) error {
+	return tm.QueryServiceControl.KillTransaction(transactionID)
+}
@@ -135,6 +135,9 @@ type TabletManager struct {
 	// replManager manages replication.
 	replManager *replManager
 
+	// mysqlConfig reconciles the MySQL config with its overrides.
+	mysqlConfig *mysqlConfigManager
+
 	// tabletAlias is saved away from tablet for read-only access
 	tabletAlias *topodatapb.TabletAlias
 
@@ -232,6 +235,7 @@ func BuildTabletFromInput(alias *topodatapb.TabletAlias, port, grpcPort int32) (
 func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval time.Duration) error {
 	tm.DBConfigs.DBName = topoproto.TabletDbName(tablet)
 	tm.replManager = newReplManager(tm.BatchCtx, tm, healthCheckInterval)
+	tm.mysqlConfig = newMysqlConfigManager(tm, *mysqlConfigReloadInterval)
 	tm.tabletAlias = tablet.Alias
 	tm.tmState = newTMState(tm, tablet)
 	tm.actionSema = sync2.NewSemaphore(1, 0)
@@ -295,6 +299,7 @@ func (tm *TabletManager) Start(tablet *topodatapb.Tablet, healthCheckInterval ti
 		go tm.orc.DiscoverLoop(tm)
 	}
 	servenv.OnRun(tm.registerTabletManager)
+	tm.mysqlConfig.Open()
 
 	restoring, err := tm.handleRestore(tm.BatchCtx)
 	if err != nil {
@@ -318,6 +323,7 @@ func (tm *TabletManager) Close() {
 	// rather than registering it as an OnTerm hook so the shard sync loop keeps
 	// running during lame duck.
 	tm.stopShardSync()
+	tm.mysqlConfig.Close()
 
 	// cleanup initialized fields in the tablet entry
 	f := func(tablet *topodatapb.Tablet) error {
@@ -348,6 +354,9 @@ func (tm *TabletManager) Stop() {
 	// Stop the shard sync loop and wait for it to exit. This needs to be done
 	// here in addition to in Close() because tests do not call Close().
 	tm.stopShardSync()
+	if tm.mysqlConfig != nil {
+		tm.mysqlConfig.Close()
+	}
 
 	if tm.UpdateStream != nil {
 		tm.UpdateStream.Disable()
@@ -640,6 +649,12 @@ func (tm *TabletManager) exportStats() {
 		statsKeyRangeEnd.Set(hex.EncodeToString(tablet.KeyRange.End))
 	}
 	statsAlias.Set(topoproto.TabletAliasString(tablet.Alias))
+
+	stats.SetResourceAttribute("cell", tablet.Alias.GetCell())
+	stats.SetResourceAttribute("keyspace", tablet.Keyspace)
+	stats.SetResourceAttribute("shard", tablet.Shard)
+	stats.SetResourceAttribute("tablet_type", topoproto.TabletTypeLString(tablet.Type))
+	stats.SetResourceAttribute("tablet_alias", topoproto.TabletAliasString(tablet.Alias))
 }
 
 // withRetry will exponentially back off and retry a function upon
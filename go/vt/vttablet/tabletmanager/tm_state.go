@@ -30,6 +30,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/key"
 	"vitess.io/vitess/go/vt/log"
@@ -186,6 +187,7 @@ func (ts *tmState) ChangeTabletType(ctx context.Context, tabletType topodatapb.T
 	s := topoproto.TabletTypeLString(tabletType)
 	statsTabletType.Set(s)
 	statsTabletTypeCount.Add(s, 1)
+	stats.SetResourceAttribute("tablet_type", s)
 
 	ts.updateLocked(ctx)
 	ts.publishStateLocked(ctx)
@@ -59,7 +59,10 @@ const (
   vrepl_id int,
   table_name varbinary(128),
   lastpk varbinary(2000),
+  rows_copied bigint not null default 0,
   primary key (vrepl_id, table_name))`
+
+	alterCopyStateRowsCopied = "alter table _vt.copy_state add column rows_copied bigint not null default 0"
 )
 
 var withDDL *withddl.WithDDL
@@ -71,7 +74,7 @@ const (
 func init() {
 	allddls := append([]string{}, binlogplayer.CreateVReplicationTable()...)
 	allddls = append(allddls, binlogplayer.AlterVReplicationTable...)
-	allddls = append(allddls, createReshardingJournalTable, createCopyState)
+	allddls = append(allddls, createReshardingJournalTable, createCopyState, alterCopyStateRowsCopied)
 	withDDL = withddl.New(allddls)
 }
 
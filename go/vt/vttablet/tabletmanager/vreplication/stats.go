@@ -278,6 +278,36 @@ func (st *vrStats) register() {
 			}
 			return result
 		})
+	stats.NewGaugesFuncWithMultiLabels(
+		"VReplicationThrottledTime",
+		"Time spent waiting for the throttler per phase per stream",
+		[]string{"source_keyspace", "source_shard", "workflow", "counts", "phase"},
+		func() map[string]int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := make(map[string]int64, len(st.controllers))
+			for _, ct := range st.controllers {
+				for phase, t := range ct.blpStats.ThrottledTimings.Histograms() {
+					result[ct.source.Keyspace+"."+ct.source.Shard+"."+ct.workflow+"."+fmt.Sprintf("%v", ct.id)+"."+phase] = t.Total()
+				}
+			}
+			return result
+		})
+
+	stats.NewCounterFunc(
+		"VReplicationThrottledTimeTotal",
+		"Time spent waiting for the throttler aggregated across all phases and streams",
+		func() int64 {
+			st.mu.Lock()
+			defer st.mu.Unlock()
+			result := int64(0)
+			for _, ct := range st.controllers {
+				for _, t := range ct.blpStats.ThrottledTimings.Histograms() {
+					result += t.Total()
+				}
+			}
+			return result
+		})
 	stats.NewCountersFuncWithMultiLabels(
 		"VReplicationErrors",
 		"Errors during vreplication",
@@ -0,0 +1,117 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+)
+
+var (
+	maxThrottleSleep = flag.Duration("vreplication_max_throttle_sleep", 5*time.Second, "Maximum time vreplication sleeps between two checks of the throttler while the replicas of the target are lagging.")
+)
+
+const (
+	// minThrottleSleep is the first sleep after the throttler
+	// rejected a check. It doubles on every rejected check.
+	minThrottleSleep = 250 * time.Millisecond
+	// minCopyBatchSize is the smallest number of rows the copier
+	// applies per transaction while it's being throttled.
+	minCopyBatchSize = 10
+)
+
+// throttleChecker is the part of the throttler client used by vreplication.
+type throttleChecker interface {
+	ThrottleCheckOK(ctx context.Context) bool
+}
+
+// adaptiveThrottler slows down a stream while the throttler reports that
+// the replicas of the target are lagging. It sleeps between checks for
+// exponentially longer durations, and it reduces the number of rows the
+// copier applies per transaction. The batch size grows back once the
+// throttler is satisfied.
+// It's not thread safe: every stream has its own adaptiveThrottler.
+type adaptiveThrottler struct {
+	checker throttleChecker
+	stats   *binlogplayer.Stats
+
+	sleep     time.Duration
+	batchSize int
+}
+
+func newAdaptiveThrottler(checker throttleChecker, stats *binlogplayer.Stats) *adaptiveThrottler {
+	return &adaptiveThrottler{
+		checker:   checker,
+		stats:     stats,
+		batchSize: *relayLogMaxItems,
+	}
+}
+
+// wait returns once the throttler is satisfied, or false if ctx is done first.
+// The time spent throttled is recorded against the phase.
+func (at *adaptiveThrottler) wait(ctx context.Context, phase string) bool {
+	if at.checkOK(ctx) {
+		at.sleep = 0
+		if at.batchSize *= 2; at.batchSize > *relayLogMaxItems {
+			at.batchSize = *relayLogMaxItems
+		}
+		return true
+	}
+	start := time.Now()
+	defer at.stats.ThrottledTimings.Record(phase, start)
+	for {
+		at.backoff()
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(at.sleep):
+		}
+		if at.checkOK(ctx) {
+			return true
+		}
+	}
+}
+
+// copyBatchSize returns the maximum number of rows the copier
+// should apply in one transaction.
+func (at *adaptiveThrottler) copyBatchSize() int {
+	return at.batchSize
+}
+
+func (at *adaptiveThrottler) checkOK(ctx context.Context) bool {
+	if at.checker == nil {
+		return true
+	}
+	return at.checker.ThrottleCheckOK(ctx)
+}
+
+func (at *adaptiveThrottler) backoff() {
+	switch {
+	case at.sleep == 0:
+		at.sleep = minThrottleSleep
+	case at.sleep*2 > *maxThrottleSleep:
+		at.sleep = *maxThrottleSleep
+	default:
+		at.sleep *= 2
+	}
+	if at.batchSize /= 2; at.batchSize < minCopyBatchSize {
+		at.batchSize = minCopyBatchSize
+	}
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vreplication
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/binlog/binlogplayer"
+	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+type fakeThrottleChecker struct {
+	results []bool
+	checks  int
+}
+
+func (ftc *fakeThrottleChecker) ThrottleCheckOK(ctx context.Context) bool {
+	ftc.checks++
+	if len(ftc.results) == 0 {
+		return true
+	}
+	ok := ftc.results[0]
+	ftc.results = ftc.results[1:]
+	return ok
+}
+
+func TestAdaptiveThrottler(t *testing.T) {
+	defer func(saved time.Duration) { *maxThrottleSleep = saved }(*maxThrottleSleep)
+	*maxThrottleSleep = 400 * time.Millisecond
+
+	checker := &fakeThrottleChecker{}
+	stats := binlogplayer.NewStats()
+	at := newAdaptiveThrottler(checker, stats)
+	assert.True(t, at.wait(context.Background(), "copy"))
+	assert.Equal(t, *relayLogMaxItems, at.copyBatchSize())
+
+	// Two rejected checks: the sleep goes from 250ms to the 400ms
+	// maximum, and the batch size is divided by 4.
+	checker.results = []bool{false, false, true}
+	start := time.Now()
+	assert.True(t, at.wait(context.Background(), "copy"))
+	assert.GreaterOrEqual(t, int64(time.Since(start)), int64(650*time.Millisecond))
+	assert.Equal(t, 400*time.Millisecond, at.sleep)
+	assert.Equal(t, *relayLogMaxItems/4, at.copyBatchSize())
+	assert.Equal(t, int64(1), stats.ThrottledTimings.Counts()["copy"])
+
+	// The batch size grows back when the throttler is satisfied.
+	assert.True(t, at.wait(context.Background(), "copy"))
+	assert.Equal(t, time.Duration(0), at.sleep)
+	assert.Equal(t, *relayLogMaxItems/2, at.copyBatchSize())
+
+	// The wait stops when the context is done.
+	checker.results = []bool{false, false}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.False(t, at.wait(ctx, "replicate"))
+	assert.Equal(t, int64(1), stats.ThrottledTimings.Counts()["replicate"])
+
+	// A nil checker never throttles.
+	assert.True(t, newAdaptiveThrottler(nil, stats).wait(context.Background(), "copy"))
+}
+
+func TestSplitRows(t *testing.T) {
+	fields := []*querypb.Field{{
+		Name: "id",
+		Type: sqltypes.Int64,
+	}, {
+		Name: "val",
+		Type: sqltypes.VarBinary,
+	}}
+	row := func(id int64, val string) *querypb.Row {
+		return sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewVarBinary(val)})
+	}
+	lastpk := sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(5)})
+	rows := &binlogdatapb.VStreamRowsResponse{
+		Rows:   []*querypb.Row{row(1, "a"), row(2, "b"), row(3, "c"), row(5, "e")},
+		Lastpk: lastpk,
+	}
+
+	batches := splitRows(rows, fields, fields[:1], 4)
+	assert.Equal(t, []*binlogdatapb.VStreamRowsResponse{rows}, batches)
+
+	batches = splitRows(rows, fields, fields[:1], 3)
+	want := []*binlogdatapb.VStreamRowsResponse{{
+		Rows:   rows.Rows[:3],
+		Lastpk: sqltypes.RowToProto3([]sqltypes.Value{sqltypes.NewInt64(3)}),
+	}, {
+		Rows:   rows.Rows[3:],
+		Lastpk: lastpk,
+	}}
+	assert.Equal(t, want, batches)
+
+	// The primary key is not part of the fields.
+	pkfields := []*querypb.Field{{
+		Name: "pk",
+		Type: sqltypes.Int64,
+	}}
+	batches = splitRows(rows, fields, pkfields, 3)
+	assert.Equal(t, []*binlogdatapb.VStreamRowsResponse{rows}, batches)
+}
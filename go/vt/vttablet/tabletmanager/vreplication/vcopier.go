@@ -104,7 +104,9 @@ func (vc *vcopier) initTablesForCopy(ctx context.Context) error {
 // primary key that was copied. A nil Result means that nothing has been copied.
 // A table that was fully copied is removed from copyState.
 func (vc *vcopier) copyNext(ctx context.Context, settings binlogplayer.VRSettings) error {
-	qr, err := vc.vr.dbClient.Execute(fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id=%d", vc.vr.id))
+	// The copy_state table of older versions has no rows_copied column.
+	// Reading it through withDDL adds the column if it's missing.
+	qr, err := withDDL.Exec(ctx, fmt.Sprintf("select table_name, lastpk, rows_copied from _vt.copy_state where vrepl_id=%d", vc.vr.id), vc.vr.dbClient.ExecuteFetch)
 	if err != nil {
 		return err
 	}
@@ -228,7 +230,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			default:
 			}
 			// verify throttler is happy, otherwise keep looping
-			if vc.vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
+			if vc.vr.throttler.wait(ctx, "copy") {
 				break
 			}
 		}
@@ -250,7 +252,7 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 			}
 			pkfields = rows.Pkfields
 			buf := sqlparser.NewTrackedBuffer(nil)
-			buf.Myprintf("update _vt.copy_state set lastpk=%a where vrepl_id=%s and table_name=%s", ":lastpk", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
+			buf.Myprintf("update _vt.copy_state set lastpk=%a, rows_copied=rows_copied+%a where vrepl_id=%s and table_name=%s", ":lastpk", ":rows_copied", strconv.Itoa(int(vc.vr.id)), encodeString(tableName))
 			updateCopyState = buf.ParsedQuery()
 		}
 		if len(rows.Rows) == 0 {
@@ -262,50 +264,58 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 		// to data size, this should map to a uniform amount of pages affected
 		// per statement. A packet size of 30K will roughly translate to 8
 		// mysql pages of 4K each.
-		if err := vc.vr.dbClient.Begin(); err != nil {
-			return err
-		}
-		_, err = vc.tablePlan.applyBulkInsert(rows, func(sql string) (*sqltypes.Result, error) {
-			start := time.Now()
-			qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
+		// If the throttler reports that the replicas are lagging, the rows
+		// are applied in smaller transactions.
+		for i, batch := range splitRows(rows, vc.tablePlan.Fields, pkfields, vc.vr.throttler.copyBatchSize()) {
+			if i > 0 && !vc.vr.throttler.wait(ctx, "copy") {
+				return io.EOF
+			}
+			if err := vc.vr.dbClient.Begin(); err != nil {
+				return err
+			}
+			_, err = vc.tablePlan.applyBulkInsert(batch, func(sql string) (*sqltypes.Result, error) {
+				start := time.Now()
+				qr, err := vc.vr.dbClient.ExecuteWithRetry(ctx, sql)
+				if err != nil {
+					return nil, err
+				}
+				vc.vr.stats.QueryTimings.Record("copy", start)
+
+				vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
+				vc.vr.stats.QueryCount.Add("copy", 1)
+
+				return qr, err
+			})
 			if err != nil {
-				return nil, err
+				return err
 			}
-			vc.vr.stats.QueryTimings.Record("copy", start)
-
-			vc.vr.stats.CopyRowCount.Add(int64(qr.RowsAffected))
-			vc.vr.stats.QueryCount.Add("copy", 1)
-
-			return qr, err
-		})
-		if err != nil {
-			return err
-		}
 
-		var buf bytes.Buffer
-		err = proto.CompactText(&buf, &querypb.QueryResult{
-			Fields: pkfields,
-			Rows:   []*querypb.Row{rows.Lastpk},
-		})
-		if err != nil {
-			return err
-		}
-		bv = map[string]*querypb.BindVariable{
-			"lastpk": {
-				Type:  sqltypes.VarBinary,
-				Value: buf.Bytes(),
-			},
-		}
-		updateState, err := updateCopyState.GenerateQuery(bv, nil)
-		if err != nil {
-			return err
-		}
-		if _, err := vc.vr.dbClient.Execute(updateState); err != nil {
-			return err
-		}
+			var buf bytes.Buffer
+			err = proto.CompactText(&buf, &querypb.QueryResult{
+				Fields: pkfields,
+				Rows:   []*querypb.Row{batch.Lastpk},
+			})
+			if err != nil {
+				return err
+			}
+			bv = map[string]*querypb.BindVariable{
+				"lastpk": {
+					Type:  sqltypes.VarBinary,
+					Value: buf.Bytes(),
+				},
+				"rows_copied": sqltypes.Int64BindVariable(int64(len(batch.Rows))),
+			}
+			updateState, err := updateCopyState.GenerateQuery(bv, nil)
+			if err != nil {
+				return err
+			}
+			if _, err := vc.vr.dbClient.Execute(updateState); err != nil {
+				return err
+			}
 
-		if err := vc.vr.dbClient.Commit(); err != nil {
-			return err
+			if err := vc.vr.dbClient.Commit(); err != nil {
+				return err
+			}
 		}
 		return nil
 	})
@@ -328,6 +338,50 @@ func (vc *vcopier) copyTable(ctx context.Context, tableName string, copyState ma
 	return nil
 }
 
+// splitRows splits the rows into batches of at most batchSize rows.
+// The Lastpk of every batch is the primary key of its last row.
+// The rows are not split if some primary key columns are not
+// part of the fields.
+func splitRows(rows *binlogdatapb.VStreamRowsResponse, fields, pkfields []*querypb.Field, batchSize int) []*binlogdatapb.VStreamRowsResponse {
+	if batchSize <= 0 || len(rows.Rows) <= batchSize {
+		return []*binlogdatapb.VStreamRowsResponse{rows}
+	}
+	pkIndexes := make([]int, len(pkfields))
+	for i, pkfield := range pkfields {
+		pkIndexes[i] = -1
+		for j, field := range fields {
+			if field.Name == pkfield.Name {
+				pkIndexes[i] = j
+				break
+			}
+		}
+		if pkIndexes[i] == -1 {
+			return []*binlogdatapb.VStreamRowsResponse{rows}
+		}
+	}
+	var batches []*binlogdatapb.VStreamRowsResponse
+	for start := 0; start < len(rows.Rows); start += batchSize {
+		end := start + batchSize
+		if end >= len(rows.Rows) {
+			batches = append(batches, &binlogdatapb.VStreamRowsResponse{
+				Rows:   rows.Rows[start:],
+				Lastpk: rows.Lastpk,
+			})
+			break
+		}
+		values := sqltypes.MakeRowTrusted(fields, rows.Rows[end-1])
+		lastpk := make([]sqltypes.Value, len(pkIndexes))
+		for i, index := range pkIndexes {
+			lastpk[i] = values[index]
+		}
+		batches = append(batches, &binlogdatapb.VStreamRowsResponse{
+			Rows:   rows.Rows[start:end],
+			Lastpk: sqltypes.RowToProto3(lastpk),
+		})
+	}
+	return batches
+}
+
 func (vc *vcopier) fastForward(ctx context.Context, copyState map[string]*sqltypes.Result, gtid string) error {
 	defer func() {
 		vc.vr.stats.PhaseTimings.Record("fastforward", time.Now())
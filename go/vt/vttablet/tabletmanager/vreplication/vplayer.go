@@ -61,6 +61,13 @@ type vplayer struct {
 	// numAccumulatedHeartbeats keeps track of how many heartbeats have been received since we updated the time_updated column of _vt.vreplication
 	numAccumulatedHeartbeats int
 
+	// batchRows and batchSize are the number of rows and the size of the rows
+	// applied in the current target transaction. batchStart is the time the
+	// first row of the transaction was applied.
+	batchRows  int
+	batchSize  int
+	batchStart time.Time
+
 	// canAcceptStmtEvents is set to true if the current player can accept events in statement mode. Only true for filters that are match all.
 	canAcceptStmtEvents bool
 
@@ -334,8 +341,8 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 	var sbm int64 = -1
 	for {
 		// check throttler.
-		if !vp.vr.vre.throttlerClient.ThrottleCheckOKOrWait(ctx) {
-			continue
+		if !vp.vr.throttler.wait(ctx, "replicate") {
+			return io.EOF
 		}
 
 		items, err := relay.Fetch()
@@ -386,7 +393,10 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 					// applying the next set of events as part of the current transaction. This approach
 					// also handles the case where the last transaction is partial. In that case,
 					// we only group the transactions with commits we've seen so far.
-					if hasAnotherCommit(items, i, j+1) {
+					// The grouping stops once the transaction reaches one of the batch caps.
+					// Since the position is updated on every GTID, committing at any commit
+					// event saves the exact position of the last source transaction applied.
+					if hasAnotherCommit(items, i, j+1) && !vp.batchFull() {
 						continue
 					}
 				}
@@ -406,6 +416,35 @@ func (vp *vplayer) applyEvents(ctx context.Context, relay *relayLog) error {
 	}
 }
 
+// addToBatch accounts for the rows of a row event in the current transaction.
+func (vp *vplayer) addToBatch(event *binlogdatapb.VEvent) {
+	if vp.batchStart.IsZero() {
+		vp.batchStart = time.Now()
+	}
+	vp.batchRows += len(event.RowEvent.RowChanges)
+	vp.batchSize += eventsSize([]*binlogdatapb.VEvent{event})
+}
+
+// batchFull returns true if the current transaction has reached one of
+// the caps, and must be committed instead of being grouped with the next one.
+func (vp *vplayer) batchFull() bool {
+	switch {
+	case *maxBatchRows > 0 && vp.batchRows >= *maxBatchRows:
+		return true
+	case *maxBatchSize > 0 && vp.batchSize >= *maxBatchSize:
+		return true
+	case *maxBatchTime > 0 && !vp.batchStart.IsZero() && time.Since(vp.batchStart) >= *maxBatchTime:
+		return true
+	}
+	return false
+}
+
+func (vp *vplayer) resetBatch() {
+	vp.batchRows = 0
+	vp.batchSize = 0
+	vp.batchStart = time.Time{}
+}
+
 func hasAnotherCommit(items [][]*binlogdatapb.VEvent, i, j int) bool {
 	for i < len(items) {
 		for j < len(items[i]) {
@@ -459,6 +498,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err := vp.vr.dbClient.Commit(); err != nil {
 			return err
 		}
+		vp.resetBatch()
 		if posReached {
 			return io.EOF
 		}
@@ -499,6 +539,7 @@ func (vp *vplayer) applyEvent(ctx context.Context, event *binlogdatapb.VEvent, m
 		if err := vp.applyRowEvent(ctx, event.RowEvent); err != nil {
 			return err
 		}
+		vp.addToBatch(event)
 		//Row event is logged AFTER RowChanges are applied so as to calculate the total elapsed time for the Row event
 		stats.Send(fmt.Sprintf("%v", event.RowEvent))
 	case binlogdatapb.VEventType_OTHER:
@@ -2155,6 +2155,94 @@ func TestPlayerBatching(t *testing.T) {
 	})
 }
 
+func TestPlayerBatchingCaps(t *testing.T) {
+	defer deleteTablet(addTablet(100))
+	defer func(saved int) { *maxBatchRows = saved }(*maxBatchRows)
+	*maxBatchRows = 2
+
+	execStatements(t, []string{
+		"create table t1(id int, val varbinary(128), primary key(id))",
+		fmt.Sprintf("create table %s.t1(id int, val varbinary(128), primary key(id))", vrepldb),
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+		fmt.Sprintf("drop table %s.t1", vrepldb),
+	})
+	env.SchemaEngine.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match: "/.*",
+		}},
+	}
+	bls := &binlogdatapb.BinlogSource{
+		Keyspace: env.KeyspaceName,
+		Shard:    env.ShardName,
+		Filter:   filter,
+		OnDdl:    binlogdatapb.OnDDLAction_IGNORE,
+	}
+	cancel, _ := startVReplication(t, bls, "")
+	defer cancel()
+
+	execStatements(t, []string{
+		"insert into t1 values(1, 'aaa')",
+	})
+	expectDBClientQueries(t, []string{
+		"begin",
+		"insert into t1(id,val) values (1,'aaa')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+
+	vconn := &realDBClient{nolog: true}
+	if err := vconn.Connect(); err != nil {
+		t.Error(err)
+	}
+	defer vconn.Close()
+
+	// Start a transaction and lock the row.
+	if _, err := vconn.ExecuteFetch("begin", 1); err != nil {
+		t.Error(err)
+	}
+	if _, err := vconn.ExecuteFetch("update t1 set val='bbb' where id=1", 1); err != nil {
+		t.Error(err)
+	}
+
+	execStatements(t, []string{
+		"update t1 set val='ccc' where id=1",
+	})
+	// Wait for the begin. The update will be blocked.
+	expectDBClientQueries(t, []string{
+		"begin",
+	})
+
+	// Create three more transactions. They will go and wait in the relayLog.
+	execStatements(t, []string{
+		"insert into t1 values(2, 'aaa')",
+		"insert into t1 values(3, 'aaa')",
+		"insert into t1 values(4, 'aaa')",
+	})
+
+	// Release the lock.
+	_, _ = vconn.ExecuteFetch("rollback", 1)
+	// First transaction will complete. The next ones are
+	// grouped until the transaction reaches two rows.
+	expectDBClientQueries(t, []string{
+		"update t1 set val='ccc' where id=1",
+		"/update _vt.vreplication set pos=",
+		"commit",
+		"begin",
+		"insert into t1(id,val) values (2,'aaa')",
+		"insert into t1(id,val) values (3,'aaa')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+		"begin",
+		"insert into t1(id,val) values (4,'aaa')",
+		"/update _vt.vreplication set pos=",
+		"commit",
+	})
+}
+
 func TestPlayerRelayLogMaxSize(t *testing.T) {
 	defer deleteTablet(addTablet(100))
 
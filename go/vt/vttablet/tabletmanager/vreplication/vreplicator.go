@@ -43,9 +43,14 @@ var (
 	// between the two timeouts.
 	idleTimeout = 1100 * time.Millisecond
 
-	dbLockRetryDelay    = 1 * time.Second
-	relayLogMaxSize     = flag.Int("relay_log_max_size", 250000, "Maximum buffer size (in bytes) for VReplication target buffering. If single rows are larger than this, a single row is buffered at a time.")
-	relayLogMaxItems    = flag.Int("relay_log_max_items", 5000, "Maximum number of rows for VReplication target buffering.")
+	dbLockRetryDelay = 1 * time.Second
+	relayLogMaxSize  = flag.Int("relay_log_max_size", 250000, "Maximum buffer size (in bytes) for VReplication target buffering. If single rows are larger than this, a single row is buffered at a time.")
+	relayLogMaxItems = flag.Int("relay_log_max_items", 5000, "Maximum number of rows for VReplication target buffering.")
+	// The vplayer groups the source transactions found in the relay log into a single
+	// target transaction. These flags cap the size and duration of such a group.
+	maxBatchRows        = flag.Int("vreplication_max_batch_rows", 0, "Maximum number of rows applied in a single target transaction when grouping source transactions. 0 means no limit.")
+	maxBatchSize        = flag.Int("vreplication_max_batch_size", 0, "Maximum size (in bytes) of the rows applied in a single target transaction when grouping source transactions. 0 means no limit.")
+	maxBatchTime        = flag.Duration("vreplication_max_batch_time", 0, "Maximum time a target transaction stays open when grouping source transactions. 0 means no limit.")
 	copyTimeout         = 1 * time.Hour
 	replicaLagTolerance = 10 * time.Second
 
@@ -73,6 +78,8 @@ type vreplicator struct {
 	// mysqld is used to fetch the local schema.
 	mysqld    mysqlctl.MysqlDaemon
 	pkInfoMap map[string][]*PrimaryKeyInfo
+	// throttler slows down the stream if the replicas of the target lag.
+	throttler *adaptiveThrottler
 
 	originalFKCheckSetting int64
 }
@@ -90,7 +97,8 @@ type vreplicator struct {
 //   "select col1, col2 from t where...",
 //   "select col1, keyspace_id() as ksid from t where...",
 //   "select id, count(*), sum(price) from t group by id".
-//   Only "in_keyrange" expressions are supported in the where clause.
+//   The where clause can contain "in_keyrange" and the row filtering
+//   expressions supported by the vstreamer, like "tenant_id in (1, 2)".
 //   The select expressions can be any valid non-aggregate expressions,
 //   or count(*), or sum(col).
 //   If the target column name does not match the source expression, an
@@ -102,6 +110,10 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 		log.Warningf("the supplied value for vreplication_heartbeat_update_interval:%d seconds is larger than the maximum allowed:%d seconds, vreplication will fallback to %d",
 			*vreplicationHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval, vreplicationMinimumHeartbeatUpdateInterval)
 	}
+	var checker throttleChecker
+	if vre != nil {
+		checker = vre.throttlerClient
+	}
 	return &vreplicator{
 		vre:             vre,
 		id:              id,
@@ -110,6 +122,7 @@ func newVReplicator(id uint32, source *binlogdatapb.BinlogSource, sourceVStreame
 		stats:           stats,
 		dbClient:        newVDBClient(dbClient, stats),
 		mysqld:          mysqld,
+		throttler:       newAdaptiveThrottler(checker, stats),
 	}
 }
 
@@ -0,0 +1,282 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// blockedDigestsSource is the query rule source of the blocked digests.
+const blockedDigestsSource = "BLOCKED_DIGESTS"
+
+// BlockedDigest is a query shape blocked until Expires.
+// See sqlparser.Digest for how queries are digested.
+type BlockedDigest struct {
+	Digest  string
+	Reason  string
+	Expires time.Time
+}
+
+// digestBlocker blocks queries by digest. The blocked digests are
+// enforced through query rules, and saved in the topo of the cell of
+// the tablet so that they survive restarts.
+type digestBlocker struct {
+	tsv *TabletServer
+
+	// mu protects digests and serializes the topo updates.
+	mu      sync.Mutex
+	digests map[string]BlockedDigest
+}
+
+func newDigestBlocker(tsv *TabletServer) *digestBlocker {
+	db := &digestBlocker{
+		tsv:     tsv,
+		digests: make(map[string]BlockedDigest),
+	}
+	tsv.qe.queryRuleSources.RegisterSource(blockedDigestsSource)
+	tsv.exporter.HandleFunc("/debug/blocked_digests", db.handleHTTP)
+	return db
+}
+
+// topoPath returns the path of the blocked digests of the tablet,
+// relative to the root of its cell.
+func (db *digestBlocker) topoPath() string {
+	return path.Join("blocked_digests", topoproto.TabletAliasString(&db.tsv.alias))
+}
+
+// load reads the blocked digests saved in the topo, and enforces them.
+func (db *digestBlocker) load(ctx context.Context) error {
+	if db.tsv.topoServer == nil {
+		return nil
+	}
+	conn, err := db.tsv.topoServer.ConnForCell(ctx, db.tsv.alias.Cell)
+	if err != nil {
+		return err
+	}
+	data, _, err := conn.Get(ctx, db.topoPath())
+	if topo.IsErrType(err, topo.NoNode) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	var saved []BlockedDigest
+	if err := json.Unmarshal(data, &saved); err != nil {
+		return fmt.Errorf("cannot parse blocked digests: %v", err)
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	digests := make(map[string]BlockedDigest, len(saved))
+	for _, bd := range saved {
+		digests[bd.Digest] = bd
+	}
+	pruneExpiredDigests(digests, time.Now())
+	db.digests = digests
+	return db.enforce()
+}
+
+// block blocks digest until now + ttl. Blocking a blocked digest again
+// replaces its reason and expiry.
+func (db *digestBlocker) block(ctx context.Context, digest, reason string, ttl time.Duration) error {
+	if b, err := hex.DecodeString(digest); err != nil || len(b) != 32 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid digest: %s", digest)
+	}
+	if ttl <= 0 {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "ttl must be positive: %v", ttl)
+	}
+
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	now := time.Now()
+	digests := db.copyDigests(now)
+	digests[digest] = BlockedDigest{
+		Digest:  digest,
+		Reason:  reason,
+		Expires: now.Add(ttl),
+	}
+	if err := db.update(ctx, digests); err != nil {
+		return err
+	}
+	log.Infof("Blocked query digest %s for %v: %s", digest, ttl, reason)
+	return nil
+}
+
+// unblock unblocks digest. It returns false if the digest was not blocked.
+func (db *digestBlocker) unblock(ctx context.Context, digest string) (bool, error) {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	digests := db.copyDigests(time.Now())
+	if _, ok := digests[digest]; !ok {
+		return false, nil
+	}
+	delete(digests, digest)
+	if err := db.update(ctx, digests); err != nil {
+		return false, err
+	}
+	log.Infof("Unblocked query digest %s", digest)
+	return true, nil
+}
+
+// list returns the blocked digests, sorted by digest.
+func (db *digestBlocker) list() []BlockedDigest {
+	db.mu.Lock()
+	defer db.mu.Unlock()
+	digests := db.copyDigests(time.Now())
+	list := make([]BlockedDigest, 0, len(digests))
+	for _, bd := range digests {
+		list = append(list, bd)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Digest < list[j].Digest })
+	return list
+}
+
+// copyDigests returns a copy of the digests which have not expired
+// at now. It must be called while holding db.mu.
+func (db *digestBlocker) copyDigests(now time.Time) map[string]BlockedDigest {
+	digests := make(map[string]BlockedDigest, len(db.digests))
+	for digest, bd := range db.digests {
+		digests[digest] = bd
+	}
+	pruneExpiredDigests(digests, now)
+	return digests
+}
+
+// update saves digests in the topo, and enforces them.
+// It must be called while holding db.mu.
+func (db *digestBlocker) update(ctx context.Context, digests map[string]BlockedDigest) error {
+	if db.tsv.topoServer != nil {
+		saved := make([]BlockedDigest, 0, len(digests))
+		for _, bd := range digests {
+			saved = append(saved, bd)
+		}
+		sort.Slice(saved, func(i, j int) bool { return saved[i].Digest < saved[j].Digest })
+		data, err := json.MarshalIndent(saved, "", "  ")
+		if err != nil {
+			return err
+		}
+		conn, err := db.tsv.topoServer.ConnForCell(ctx, db.tsv.alias.Cell)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Update(ctx, db.topoPath(), data, nil); err != nil {
+			return vterrors.Wrap(err, "cannot save blocked digests")
+		}
+	}
+	db.digests = digests
+	return db.enforce()
+}
+
+// enforce sets the query rules of the blocked digests.
+// It must be called while holding db.mu.
+func (db *digestBlocker) enforce() error {
+	qrs := rules.New()
+	for _, bd := range db.digests {
+		qr := rules.NewQueryRule(fmt.Sprintf("query digest blocked until %s: %s", bd.Expires.UTC().Format(time.RFC3339), bd.Reason), bd.Digest, rules.QRFail)
+		qr.AddDigestCond(bd.Digest)
+		qr.SetActivePeriod(time.Time{}, bd.Expires)
+		qrs.Add(qr)
+	}
+	return db.tsv.SetQueryRules(blockedDigestsSource, qrs)
+}
+
+// pruneExpiredDigests removes the digests which have expired at now.
+func pruneExpiredDigests(digests map[string]BlockedDigest, now time.Time) {
+	for digest, bd := range digests {
+		if !now.Before(bd.Expires) {
+			delete(digests, digest)
+		}
+	}
+}
+
+// handleHTTP lists the blocked digests. A POST blocks the digest, or the
+// digest of the query, for the ttl duration (e.g. 30m), with an optional
+// reason. A POST with unblock=true unblocks it.
+func (db *digestBlocker) handleHTTP(w http.ResponseWriter, r *http.Request) {
+	level := acl.DEBUGGING
+	if r.Method == http.MethodPost {
+		level = acl.ADMIN
+	}
+	if err := acl.CheckAccessHTTP(r, level); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if r.Method == http.MethodPost {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusBadRequest)
+			return
+		}
+		digest := r.FormValue("digest")
+		if query := r.FormValue("query"); query != "" {
+			digest = sqlparser.Digest(query)
+		}
+		if digest == "" {
+			http.Error(w, "digest or query is required", http.StatusBadRequest)
+			return
+		}
+		if r.FormValue("unblock") == "true" {
+			ok, err := db.unblock(r.Context(), digest)
+			if err != nil {
+				http.Error(w, err.Error(), http.StatusInternalServerError)
+				return
+			}
+			if !ok {
+				http.Error(w, fmt.Sprintf("digest %s is not blocked", digest), http.StatusNotFound)
+				return
+			}
+		} else {
+			ttl, err := time.ParseDuration(r.FormValue("ttl"))
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid ttl: %v", err), http.StatusBadRequest)
+				return
+			}
+			if err := db.block(r.Context(), digest, r.FormValue("reason"), ttl); err != nil {
+				code := http.StatusInternalServerError
+				if vterrors.Code(err) == vtrpcpb.Code_INVALID_ARGUMENT {
+					code = http.StatusBadRequest
+				}
+				http.Error(w, err.Error(), code)
+				return
+			}
+		}
+	}
+	js, err := json.MarshalIndent(db.list(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
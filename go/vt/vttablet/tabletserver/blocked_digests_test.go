@@ -0,0 +1,154 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestBlockDigest(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("select * from test_table where `name` = 1 limit 10001", &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{Fields: getTestTableFields()})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	execute := func() error {
+		_, err := newTestQueryExecutor(ctx, tsv, "select * from test_table where name = 1", 0).Execute()
+		return err
+	}
+	require.NoError(t, execute())
+
+	digest := sqlparser.Digest("select * from test_table where name = 2")
+	require.NoError(t, tsv.BlockDigest(ctx, digest, "full scan", time.Hour))
+	err := execute()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "full scan")
+
+	blocked := tsv.BlockedDigests()
+	require.Len(t, blocked, 1)
+	assert.Equal(t, digest, blocked[0].Digest)
+	assert.Equal(t, "full scan", blocked[0].Reason)
+
+	// The blocked digests are reloaded from the topo.
+	tsv.digests.mu.Lock()
+	tsv.digests.digests = make(map[string]BlockedDigest)
+	require.NoError(t, tsv.digests.enforce())
+	tsv.digests.mu.Unlock()
+	require.NoError(t, execute())
+	require.NoError(t, tsv.digests.load(ctx))
+	assert.Error(t, execute())
+
+	ok, err := tsv.UnblockDigest(ctx, digest)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = tsv.UnblockDigest(ctx, digest)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	require.NoError(t, execute())
+	require.NoError(t, tsv.digests.load(ctx))
+	assert.Empty(t, tsv.BlockedDigests())
+
+	err = tsv.BlockDigest(ctx, "not a digest", "", time.Hour)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	err = tsv.BlockDigest(ctx, digest, "", 0)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+}
+
+func TestBlockDigestExpiry(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	digest := sqlparser.Digest("select 1 from dual")
+	require.NoError(t, tsv.BlockDigest(ctx, digest, "", time.Hour))
+	tsv.digests.mu.Lock()
+	bd := tsv.digests.digests[digest]
+	bd.Expires = time.Now().Add(-time.Second)
+	require.NoError(t, tsv.digests.update(ctx, map[string]BlockedDigest{digest: bd}))
+	tsv.digests.mu.Unlock()
+
+	assert.Empty(t, tsv.BlockedDigests())
+	require.NoError(t, tsv.digests.load(ctx))
+	assert.Empty(t, tsv.BlockedDigests())
+}
+
+func TestBlockedDigestsHandler(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	send := func(method string, form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/debug/blocked_digests", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		tsv.digests.handleHTTP(w, req)
+		return w
+	}
+
+	query := "select * from test_table where name = 1"
+	w := send(http.MethodPost, url.Values{"query": {query}, "ttl": {"30m"}, "reason": {"bad plan"}})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	var blocked []BlockedDigest
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &blocked))
+	require.Len(t, blocked, 1)
+	assert.Equal(t, sqlparser.Digest(query), blocked[0].Digest)
+	assert.Equal(t, "bad plan", blocked[0].Reason)
+
+	w = send(http.MethodGet, nil)
+	require.Equal(t, http.StatusOK, w.Code)
+	blocked = nil
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &blocked))
+	assert.Len(t, blocked, 1)
+
+	w = send(http.MethodPost, url.Values{"query": {query}, "ttl": {"soon"}})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = send(http.MethodPost, url.Values{"digest": {"abc"}, "ttl": {"1h"}})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+	w = send(http.MethodPost, url.Values{"ttl": {"1h"}})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = send(http.MethodPost, url.Values{"digest": {sqlparser.Digest(query)}, "unblock": {"true"}})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, "[]", w.Body.String())
+	w = send(http.MethodPost, url.Values{"digest": {sqlparser.Digest(query)}, "unblock": {"true"}})
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
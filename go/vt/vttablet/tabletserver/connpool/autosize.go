@@ -0,0 +1,189 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"vitess.io/vitess/go/pools"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/timer"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+const threadsRunningQuery = "show global status like 'Threads_running'"
+
+// autosizer resizes a Pool between its min and max sizes, based on
+// how many queries waited for a connection and on the MySQL
+// threads_running. See tabletenv.PoolAutosizeConfig.
+type autosizer struct {
+	pool              *Pool
+	minSize           int
+	maxSize           int
+	maxThreadsRunning int
+	idleIntervals     int
+	ticks             *timer.Timer
+	resizes           *stats.CountersWithMultiLabels
+
+	// The fields below are only accessed by resize.
+	lastWaitCount int64
+	lastWaitTime  time.Duration
+	idle          int
+}
+
+// newAutosizer returns nil if the pool is not auto-sized.
+func newAutosizer(cp *Pool, cfg tabletenv.ConnPoolConfig) *autosizer {
+	if cfg.MaxSize == 0 {
+		return nil
+	}
+	config := cp.env.Config()
+	if config == nil {
+		config = tabletenv.NewDefaultConfig()
+	}
+	az := &autosizer{
+		pool:              cp,
+		minSize:           cfg.MinSize,
+		maxSize:           cfg.MaxSize,
+		maxThreadsRunning: config.PoolAutosize.MaxThreadsRunning,
+		idleIntervals:     config.PoolAutosize.IdleIntervals,
+		ticks:             timer.NewTimer(config.PoolAutosize.IntervalSeconds.Get()),
+	}
+	if az.minSize < 1 {
+		az.minSize = 1
+	}
+	if cp.name != "" {
+		az.resizes = cp.env.Exporter().NewCountersWithMultiLabels(cp.name+"Resizes", "Tablet server conn pool resizes", []string{"Direction", "Reason"})
+	}
+	return az
+}
+
+func (az *autosizer) open() {
+	// The wait counters start from zero with every new resource pool.
+	az.lastWaitCount = 0
+	az.lastWaitTime = 0
+	az.idle = 0
+	az.ticks.Start(az.resize)
+}
+
+func (az *autosizer) close() {
+	az.ticks.Stop()
+}
+
+// resize grows the pool if queries waited for a connection since the last
+// call, unless MySQL has too many threads running, in which case it shrinks
+// the pool. It also shrinks the pool after idleIntervals calls in a row
+// without waits.
+func (az *autosizer) resize() {
+	p := az.pool.pool()
+	if p == nil {
+		return
+	}
+	waitCount, waitTime := p.WaitCount(), p.WaitTime()
+	waits, waited := waitCount-az.lastWaitCount, waitTime-az.lastWaitTime
+	az.lastWaitCount, az.lastWaitTime = waitCount, waitTime
+
+	threadsRunning := 0
+	if az.maxThreadsRunning > 0 {
+		var err error
+		if threadsRunning, err = az.pool.threadsRunning(); err != nil {
+			log.Warningf("Not resizing pool %s: %v", az.pool.name, err)
+			return
+		}
+	}
+
+	capacity := int(p.Capacity())
+	switch {
+	case az.maxThreadsRunning > 0 && threadsRunning > az.maxThreadsRunning:
+		az.idle = 0
+		az.shrink(p, capacity, fmt.Sprintf("%d threads running", threadsRunning), "ThreadsRunning")
+	case waits > 0:
+		az.idle = 0
+		az.grow(capacity, fmt.Sprintf("%d waits for %v", waits, waited), "Waits")
+	default:
+		az.idle++
+		if az.idle >= az.idleIntervals {
+			az.idle = 0
+			az.shrink(p, capacity, fmt.Sprintf("no waits for %d intervals", az.idleIntervals), "Idle")
+		}
+	}
+}
+
+// grow adds a quarter of the capacity, at least one connection.
+func (az *autosizer) grow(capacity int, why, reason string) {
+	newCapacity := capacity + max(1, capacity/4)
+	if newCapacity > az.maxSize {
+		newCapacity = az.maxSize
+	}
+	az.setCapacity(capacity, newCapacity, why, "Grow", reason)
+}
+
+// shrink removes an eighth of the capacity, at least one connection.
+// It only removes available connections, so that it does not wait for
+// the connections in use to be returned.
+func (az *autosizer) shrink(p *pools.ResourcePool, capacity int, why, reason string) {
+	step := max(1, capacity/8)
+	if available := int(p.Available()); step > available {
+		step = available
+	}
+	newCapacity := capacity - step
+	if newCapacity < az.minSize {
+		newCapacity = az.minSize
+	}
+	az.setCapacity(capacity, newCapacity, why, "Shrink", reason)
+}
+
+func (az *autosizer) setCapacity(capacity, newCapacity int, why, direction, reason string) {
+	if newCapacity == capacity {
+		return
+	}
+	if err := az.pool.SetCapacity(newCapacity); err != nil {
+		log.Warningf("Could not resize pool %s from %d to %d: %v", az.pool.name, capacity, newCapacity, err)
+		return
+	}
+	log.Infof("Resized pool %s from %d to %d: %s", az.pool.name, capacity, newCapacity, why)
+	if az.resizes != nil {
+		az.resizes.Add([]string{direction, reason}, 1)
+	}
+}
+
+// threadsRunning returns the number of threads running in MySQL.
+func (cp *Pool) threadsRunning() (int, error) {
+	conn, err := cp.dbaPool.Get(context.TODO())
+	if err != nil {
+		return 0, err
+	}
+	defer conn.Recycle()
+	qr, err := conn.ExecuteFetch(threadsRunningQuery, 1, false)
+	if err != nil {
+		return 0, err
+	}
+	if len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		return 0, fmt.Errorf("unexpected result for %s: %v", threadsRunningQuery, qr.Rows)
+	}
+	return strconv.Atoi(qr.Rows[0][1].ToString())
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func threadsRunningResult(n string) *sqltypes.Result {
+	return sqltypes.MakeTestResult(
+		sqltypes.MakeTestFields("Variable_name|Value", "varchar|varchar"),
+		"Threads_running|"+n,
+	)
+}
+
+func TestConnPoolAutosize(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery(threadsRunningQuery, threadsRunningResult("5"))
+
+	config := tabletenv.NewDefaultConfig()
+	config.PoolAutosize.MaxThreadsRunning = 10
+	config.PoolAutosize.IdleIntervals = 2
+	connPool := NewPool(tabletenv.NewEnv(config, "PoolTest"), "AutosizePool", tabletenv.ConnPoolConfig{
+		Size:               2,
+		MaxSize:            4,
+		IdleTimeoutSeconds: 10,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	az := connPool.autosizer
+	assert.EqualValues(t, 4, connPool.MaxCap())
+
+	// No waits for a single interval: the pool keeps its size.
+	az.resize()
+	assert.EqualValues(t, 2, connPool.Capacity())
+
+	// A query waits for a connection: the pool grows.
+	conn1, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	conn2, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		conn3, err := connPool.Get(context.Background())
+		if assert.NoError(t, err) {
+			conn3.Recycle()
+		}
+	}()
+	time.Sleep(10 * time.Millisecond)
+	conn1.Recycle()
+	<-done
+	conn2.Recycle()
+	az.resize()
+	assert.EqualValues(t, 3, connPool.Capacity())
+	assert.EqualValues(t, 1, az.resizes.Counts()["Grow.Waits"])
+
+	// MySQL is overloaded: the pool shrinks.
+	db.AddQuery(threadsRunningQuery, threadsRunningResult("20"))
+	az.resize()
+	assert.EqualValues(t, 2, connPool.Capacity())
+	assert.EqualValues(t, 1, az.resizes.Counts()["Shrink.ThreadsRunning"])
+
+	// No waits for two intervals: the pool shrinks, but not below one
+	// connection.
+	db.AddQuery(threadsRunningQuery, threadsRunningResult("5"))
+	az.resize()
+	assert.EqualValues(t, 2, connPool.Capacity())
+	az.resize()
+	assert.EqualValues(t, 1, connPool.Capacity())
+	az.resize()
+	az.resize()
+	assert.EqualValues(t, 1, connPool.Capacity())
+	assert.EqualValues(t, 1, az.resizes.Counts()["Shrink.Idle"])
+
+	// The pool does not grow beyond its max size.
+	for i := 0; i < 5; i++ {
+		az.lastWaitCount--
+		az.resize()
+	}
+	assert.EqualValues(t, 4, connPool.Capacity())
+}
+
+func TestConnPoolNotAutosized(t *testing.T) {
+	connPool := newPool()
+	assert.Nil(t, connPool.autosizer)
+}
@@ -53,10 +53,15 @@ type DBConn struct {
 	dbaPool *dbconnpool.ConnectionPool
 	stats   *tabletenv.Stats
 	current sync2.AtomicString
+	// workload is the workload of the current query, if any.
+	workload sync2.AtomicString
 
 	// err will be set if a query is killed through a Kill.
 	errmu sync.Mutex
 	err   error
+
+	// lastUsed is when the connection was last returned to the pool.
+	lastUsed time.Time
 }
 
 // NewDBConn creates a new DBConn. It triggers a CheckMySQL if creation fails.
@@ -107,6 +112,7 @@ func (dbc *DBConn) Err() error {
 func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
 	span, ctx := trace.NewSpan(ctx, "DBConn.Exec")
 	defer span.Finish()
+	trace.AnnotateSQL(span, query)
 
 	for attempt := 1; attempt <= 2; attempt++ {
 		r, err := dbc.execOnce(ctx, query, maxrows, wantfields)
@@ -143,6 +149,8 @@ func (dbc *DBConn) Exec(ctx context.Context, query string, maxrows int, wantfiel
 func (dbc *DBConn) execOnce(ctx context.Context, query string, maxrows int, wantfields bool) (*sqltypes.Result, error) {
 	dbc.current.Set(query)
 	defer dbc.current.Set("")
+	dbc.workload.Set(tabletenv.WorkloadFromContext(ctx))
+	defer dbc.workload.Set("")
 
 	// Check if the context is already past its deadline before
 	// trying to execute the query.
@@ -239,11 +247,35 @@ func (dbc *DBConn) Stream(ctx context.Context, query string, callback func(*sqlt
 	panic("unreachable")
 }
 
+// StreamOnce streams the results of the query, but does not retry on
+// connection errors.
+func (dbc *DBConn) StreamOnce(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int, includedFields querypb.ExecuteOptions_IncludedFields) error {
+	span, ctx := trace.NewSpan(ctx, "DBConn.StreamOnce")
+	trace.AnnotateSQL(span, query)
+	defer span.Finish()
+
+	resultSent := false
+	return dbc.streamOnce(
+		ctx,
+		query,
+		func(r *sqltypes.Result) error {
+			if !resultSent {
+				resultSent = true
+				r = r.StripMetadata(includedFields)
+			}
+			return callback(r)
+		},
+		streamBufferSize,
+	)
+}
+
 func (dbc *DBConn) streamOnce(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int) error {
 	defer dbc.stats.MySQLTimings.Record("ExecStream", time.Now())
 
 	dbc.current.Set(query)
 	defer dbc.current.Set("")
+	dbc.workload.Set(tabletenv.WorkloadFromContext(ctx))
+	defer dbc.workload.Set("")
 
 	done, wg := dbc.setDeadline(ctx)
 	err := dbc.conn.ExecuteStreamFetch(query, callback, streamBufferSize)
@@ -313,6 +345,11 @@ func (dbc *DBConn) IsClosed() bool {
 	return dbc.conn.IsClosed()
 }
 
+// CreateTime returns the time the connection was created at.
+func (dbc *DBConn) CreateTime() time.Time {
+	return dbc.conn.CreateTime()
+}
+
 // Recycle returns the DBConn to the pool.
 func (dbc *DBConn) Recycle() {
 	switch {
@@ -321,10 +358,45 @@ func (dbc *DBConn) Recycle() {
 	case dbc.conn.IsClosed():
 		dbc.pool.Put(nil)
 	default:
+		dbc.lastUsed = time.Now()
 		dbc.pool.Put(dbc)
 	}
 }
 
+// idleTime returns how long the connection stayed in the pool before it
+// was taken out, or 0 if it was never used.
+func (dbc *DBConn) idleTime() time.Duration {
+	if dbc.lastUsed.IsZero() {
+		return 0
+	}
+	return time.Since(dbc.lastUsed)
+}
+
+// ping checks that the connection is still alive. It closes the connection
+// if the server does not answer before ctx is done.
+func (dbc *DBConn) ping(ctx context.Context) error {
+	defer dbc.stats.MySQLTimings.Record("Ping", time.Now())
+
+	done := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		select {
+		case <-ctx.Done():
+			dbc.conn.Close()
+		case <-done:
+		}
+	}()
+	err := dbc.conn.Ping()
+	close(done)
+	wg.Wait()
+	if err == nil && dbc.conn.IsClosed() {
+		err = ctx.Err()
+	}
+	return err
+}
+
 // Taint unregister connection from original pool and taints the connection.
 func (dbc *DBConn) Taint() {
 	if dbc.pool == nil {
@@ -339,6 +411,9 @@ func (dbc *DBConn) Taint() {
 // Kill will also not kill a query more than once.
 func (dbc *DBConn) Kill(reason string, elapsed time.Duration) error {
 	dbc.stats.KillCounters.Add("Queries", 1)
+	if workload := dbc.workload.Get(); workload != "" {
+		dbc.stats.WorkloadKills.Add([]string{workload, "Queries"}, 1)
+	}
 	log.Infof("Due to %s, elapsed time: %v, killing query ID %v %s", reason, elapsed, dbc.conn.ID(), dbc.Current())
 
 	// Client side action. Set error and close connection.
@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/mysql/fakesqldb"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -362,6 +363,39 @@ func TestDBConnStream(t *testing.T) {
 	}
 }
 
+func TestDBConnStreamCallbackError(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select * from test_table limit 1000"
+	db.AddQuery(sql, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarChar("123")},
+		},
+	})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := NewDBConn(context.Background(), connPool, db.ConnParams())
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	// The error returned by the callback for the fields or the rows is
+	// returned unchanged.
+	for _, failFields := range []bool{true, false} {
+		want := mysql.NewSQLError(mysql.ERVitessMaxRowsExceeded, mysql.SSUnknownSQLState, "Row count exceeded 0")
+		err = dbConn.Stream(context.Background(), sql, func(r *sqltypes.Result) error {
+			if (r.Fields != nil) == failFields {
+				return want
+			}
+			return nil
+		}, 10, querypb.ExecuteOptions_ALL)
+		assert.Equal(t, want, err, "failFields: %v", failFields)
+	}
+}
+
 func TestDBConnStreamKill(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -391,3 +425,35 @@ func TestDBConnStreamKill(t *testing.T) {
 
 	assert.Contains(t, err.Error(), "(errno 2013) due to")
 }
+
+func TestDBConnWorkloadKill(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	sql := "select * from test_table limit 1000"
+	db.AddQuery(sql, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+		},
+	})
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	dbConn, err := NewDBConn(context.Background(), connPool, db.ConnParams())
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		dbConn.Kill("test kill", 0)
+	}()
+
+	ctx := tabletenv.NewContextWithWorkload(context.Background(), "billing")
+	err = dbConn.Stream(ctx, sql, func(r *sqltypes.Result) error {
+		time.Sleep(100 * time.Millisecond)
+		return nil
+	}, 10, querypb.ExecuteOptions_ALL)
+	require.Error(t, err)
+
+	assert.Equal(t, int64(1), connPool.env.Stats().WorkloadKills.Counts()["billing.Queries"])
+	assert.Equal(t, "", dbConn.workload.Get())
+}
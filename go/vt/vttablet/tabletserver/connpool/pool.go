@@ -53,10 +53,17 @@ type Pool struct {
 	prefillParallelism int
 	timeout            time.Duration
 	idleTimeout        time.Duration
+	shedTimeout        time.Duration
+	maxLifetime        time.Duration
+	pingIdle           time.Duration
 	waiterCap          int64
 	waiterCount        sync2.AtomicInt64
+	idlePinged         sync2.AtomicInt64
+	idleReplaced       sync2.AtomicInt64
 	dbaPool            *dbconnpool.ConnectionPool
 	appDebugParams     dbconfigs.Connector
+	// autosizer is nil if the pool is not auto-sized.
+	autosizer *autosizer
 }
 
 // NewPool creates a new Pool. The name is used
@@ -70,9 +77,13 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 		prefillParallelism: cfg.PrefillParallelism,
 		timeout:            cfg.TimeoutSeconds.Get(),
 		idleTimeout:        idleTimeout,
+		shedTimeout:        cfg.ShedTimeoutSeconds.Get(),
+		maxLifetime:        cfg.MaxLifetimeSeconds.Get(),
+		pingIdle:           cfg.PingIdleSeconds.Get(),
 		waiterCap:          int64(cfg.MaxWaiters),
 		dbaPool:            dbconnpool.NewConnectionPool("", 1, idleTimeout, 0),
 	}
+	cp.autosizer = newAutosizer(cp, cfg)
 	if name == "" {
 		return cp
 	}
@@ -83,8 +94,15 @@ func NewPool(env tabletenv.Env, name string, cfg tabletenv.ConnPoolConfig) *Pool
 	env.Exporter().NewGaugeFunc(name+"MaxCap", "Tablet server conn pool max cap", cp.MaxCap)
 	env.Exporter().NewCounterFunc(name+"WaitCount", "Tablet server conn pool wait count", cp.WaitCount)
 	env.Exporter().NewCounterDurationFunc(name+"WaitTime", "Tablet server wait time", cp.WaitTime)
+	env.Exporter().NewCountersFuncWithMultiLabels(name+"WaitCountByPriority", "Tablet server conn pool wait count by priority", []string{"Priority"}, cp.WaitCountByPriority)
+	env.Exporter().NewCountersFuncWithMultiLabels(name+"WaitTimeByPriority", "Tablet server conn pool wait time by priority, in nanoseconds", []string{"Priority"}, cp.WaitTimeByPriority)
+	env.Exporter().NewCountersFuncWithMultiLabels(name+"Shed", "Number of lower priority waits shed by the conn pool", []string{"Priority"}, cp.ShedByPriority)
 	env.Exporter().NewGaugeDurationFunc(name+"IdleTimeout", "Tablet server idle timeout", cp.IdleTimeout)
 	env.Exporter().NewCounterFunc(name+"IdleClosed", "Tablet server conn pool idle closed", cp.IdleClosed)
+	env.Exporter().NewGaugeDurationFunc(name+"MaxLifetime", "Tablet server conn pool max lifetime", cp.MaxLifetime)
+	env.Exporter().NewCounterFunc(name+"LifetimeClosed", "Tablet server conn pool connections closed because they outlived the max lifetime or were drained", cp.LifetimeClosed)
+	env.Exporter().NewCounterFunc(name+"IdlePinged", "Tablet server conn pool connections pinged because they were idle", cp.IdlePinged)
+	env.Exporter().NewCounterFunc(name+"IdleReplaced", "Tablet server conn pool idle connections replaced because they failed their ping", cp.IdleReplaced)
 	env.Exporter().NewCounterFunc(name+"Exhausted", "Number of times pool had zero available slots", cp.Exhausted)
 	return cp
 }
@@ -109,10 +127,19 @@ func (cp *Pool) Open(appParams, dbaParams, appDebugParams dbconfigs.Connector) {
 	f := func(ctx context.Context) (pools.Resource, error) {
 		return NewDBConn(ctx, cp, appParams)
 	}
-	cp.connections = pools.NewResourcePool(f, cp.capacity, cp.capacity, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
+	maxCap := cp.capacity
+	if cp.autosizer != nil {
+		maxCap = cp.autosizer.maxSize
+	}
+	cp.connections = pools.NewResourcePool(f, cp.capacity, maxCap, cp.idleTimeout, cp.prefillParallelism, cp.getLogWaitCallback())
+	cp.connections.SetShedTimeout(cp.shedTimeout)
+	cp.connections.SetMaxLifetime(cp.maxLifetime)
 	cp.appDebugParams = appDebugParams
 
 	cp.dbaPool.Open(dbaParams)
+	if cp.autosizer != nil {
+		cp.autosizer.open()
+	}
 }
 
 func (cp *Pool) getLogWaitCallback() func(time.Time) {
@@ -131,6 +158,9 @@ func (cp *Pool) Close() {
 	if p == nil {
 		return
 	}
+	if cp.autosizer != nil {
+		cp.autosizer.close()
+	}
 	// We should not hold the lock while calling Close
 	// because it waits for connections to be returned.
 	p.Close()
@@ -175,7 +205,40 @@ func (cp *Pool) Get(ctx context.Context) (*DBConn, error) {
 	if err != nil {
 		return nil, err
 	}
-	return r.(*DBConn), nil
+	conn := r.(*DBConn)
+	if err := cp.checkIdle(ctx, conn); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+// idlePingTimeout bounds how long an idle connection is pinged for.
+const idlePingTimeout = 2 * time.Second
+
+// checkIdle pings conn if it stayed idle in the pool for longer than
+// pingIdle, and reconnects it if the ping fails, so that the connections
+// which were dropped while idle, e.g. by a load balancer, don't fail the
+// next query. If conn cannot be reconnected, it is returned to the pool
+// and the error is returned.
+func (cp *Pool) checkIdle(ctx context.Context, conn *DBConn) error {
+	if cp.pingIdle == 0 || conn.idleTime() < cp.pingIdle {
+		return nil
+	}
+	cp.idlePinged.Add(1)
+	pingCtx, cancel := context.WithTimeout(ctx, idlePingTimeout)
+	err := conn.ping(pingCtx)
+	cancel()
+	if err == nil {
+		return nil
+	}
+	cp.idleReplaced.Add(1)
+	log.Infof("Reconnecting idle connection of pool '%s' which failed its ping: %v", cp.name, err)
+	if err := conn.reconnect(ctx); err != nil {
+		cp.env.CheckMySQL()
+		conn.Recycle()
+		return err
+	}
+	return nil
 }
 
 // Put puts a connection into the pool.
@@ -288,6 +351,74 @@ func (cp *Pool) WaitTime() time.Duration {
 	return p.WaitTime()
 }
 
+// WaitCountByPriority returns the pool wait count of each priority.
+func (cp *Pool) WaitCountByPriority() map[string]int64 {
+	p := cp.pool()
+	if p == nil {
+		return nil
+	}
+	return p.WaitCountByPriority()
+}
+
+// WaitTimeByPriority returns the pool wait time of each priority, in nanoseconds.
+func (cp *Pool) WaitTimeByPriority() map[string]int64 {
+	p := cp.pool()
+	if p == nil {
+		return nil
+	}
+	return p.WaitTimeByPriority()
+}
+
+// ShedByPriority returns the number of shed waits of each priority.
+func (cp *Pool) ShedByPriority() map[string]int64 {
+	p := cp.pool()
+	if p == nil {
+		return nil
+	}
+	return p.ShedByPriority()
+}
+
+// IdlePinged returns the number of idle connections pinged before use.
+func (cp *Pool) IdlePinged() int64 {
+	return cp.idlePinged.Get()
+}
+
+// IdleReplaced returns the number of idle connections replaced because
+// they failed their ping.
+func (cp *Pool) IdleReplaced() int64 {
+	return cp.idleReplaced.Get()
+}
+
+// MaxLifetime returns the max lifetime of the pool connections.
+func (cp *Pool) MaxLifetime() time.Duration {
+	p := cp.pool()
+	if p == nil {
+		return 0
+	}
+	return p.MaxLifetime()
+}
+
+// LifetimeClosed returns the number of connections closed because they
+// outlived the max lifetime or were drained.
+func (cp *Pool) LifetimeClosed() int64 {
+	p := cp.pool()
+	if p == nil {
+		return 0
+	}
+	return p.LifetimeClosed()
+}
+
+// Drain replaces all the connections of the pool, e.g. after the MySQL
+// credentials changed. The idle connections are reopened now, the ones in
+// use when they're recycled.
+func (cp *Pool) Drain() {
+	p := cp.pool()
+	if p == nil {
+		return
+	}
+	p.Drain()
+}
+
 // IdleTimeout returns the idle timeout for the pool.
 func (cp *Pool) IdleTimeout() time.Duration {
 	p := cp.pool()
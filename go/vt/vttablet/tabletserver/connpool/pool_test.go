@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
@@ -191,6 +192,96 @@ func TestConnPoolSetCapacity(t *testing.T) {
 	}
 }
 
+func TestConnPoolDrain(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := newPool()
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	ctx := context.Background()
+
+	idle, err := connPool.Get(ctx)
+	require.NoError(t, err)
+	inUse, err := connPool.Get(ctx)
+	require.NoError(t, err)
+	idle.Recycle()
+
+	time.Sleep(time.Millisecond)
+	connPool.Drain()
+	assert.True(t, idle.IsClosed())
+	assert.False(t, inUse.IsClosed())
+	assert.EqualValues(t, 1, connPool.LifetimeClosed())
+
+	inUse.Recycle()
+	assert.True(t, inUse.IsClosed())
+	assert.EqualValues(t, 2, connPool.LifetimeClosed())
+
+	dbConn, err := connPool.Get(ctx)
+	require.NoError(t, err)
+	assert.False(t, dbConn.IsClosed())
+	dbConn.Recycle()
+}
+
+func TestConnPoolMaxLifetime(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	connPool := NewPool(tabletenv.NewEnv(nil, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size:               1,
+		MaxLifetimeSeconds: 0.01,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+	assert.Equal(t, 10*time.Millisecond, connPool.MaxLifetime())
+
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	dbConn.Recycle()
+	assert.True(t, dbConn.IsClosed())
+	assert.EqualValues(t, 1, connPool.LifetimeClosed())
+}
+
+func TestConnPoolPingIdle(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	db.AddQuery("select 1", &sqltypes.Result{})
+	connPool := NewPool(tabletenv.NewEnv(nil, "PoolTest"), "TestPool", tabletenv.ConnPoolConfig{
+		Size:            1,
+		PingIdleSeconds: 0.01,
+	})
+	connPool.Open(db.ConnParams(), db.ConnParams(), db.ConnParams())
+	defer connPool.Close()
+
+	// Connections which were not idle for long are not pinged.
+	dbConn, err := connPool.Get(context.Background())
+	require.NoError(t, err)
+	dbConn.Recycle()
+	dbConn, err = connPool.Get(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, connPool.IdlePinged())
+	dbConn.Recycle()
+
+	// Idle connections are.
+	time.Sleep(20 * time.Millisecond)
+	dbConn, err = connPool.Get(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, connPool.IdlePinged())
+	assert.EqualValues(t, 0, connPool.IdleReplaced())
+	dbConn.Recycle()
+
+	// And replaced if they were dropped while idle.
+	db.CloseAllConnections()
+	db.WaitForClose(time.Second)
+	time.Sleep(20 * time.Millisecond)
+	dbConn, err = connPool.Get(context.Background())
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, connPool.IdlePinged())
+	assert.EqualValues(t, 1, connPool.IdleReplaced())
+	_, err = dbConn.Exec(context.Background(), "select 1", 1, false)
+	dbConn.Recycle()
+	require.NoError(t, err)
+}
+
 func TestConnPoolStatJSON(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
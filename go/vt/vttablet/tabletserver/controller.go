@@ -26,6 +26,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	"time"
@@ -79,6 +80,10 @@ type Controller interface {
 	// SetQueryRules sets the query rules for this QueryService
 	SetQueryRules(ruleSource string, qrs *rules.Rules) error
 
+	// SetQueryRulesSigner records the verified signer of the query rules
+	// of ruleSource, or clears it if signer is empty
+	SetQueryRulesSigner(ruleSource, signer string) error
+
 	// QueryService returns the QueryService object used by this Controller
 	QueryService() queryservice.QueryService
 
@@ -93,6 +98,18 @@ type Controller interface {
 
 	// TopoServer returns the topo server.
 	TopoServer() *topo.Server
+
+	// Transactions returns the open transactions
+	Transactions() []tx.Info
+
+	// KillTransaction terminates an open transaction
+	KillTransaction(transactionID int64) error
+
+	// LiveQueries returns the queries which are currently executing
+	LiveQueries() []QueryDetailzRow
+
+	// KillQuery kills the query executing on a MySQL connection
+	KillQuery(connID int64) bool
 }
 
 // Ensure TabletServer satisfies Controller interface.
@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"regexp"
+	"strings"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// quotedValueRegexp matches the values quoted in the MySQL error
+	// messages, e.g. Duplicate entry 'a' for key 'PRIMARY'.
+	quotedValueRegexp = regexp.MustCompile(`'(?:[^'\\]|\\.)*'`)
+	// addressRegexp matches the ipv4 and ipv6 addresses, with or without
+	// a port, and the host:port pairs. Host names need a letter, so that
+	// times are not taken for addresses.
+	addressRegexp = regexp.MustCompile(`\b(?:\d{1,3}\.){3}\d{1,3}(?::\d+)?\b|\[[0-9a-fA-F:.]+\](?::\d+)?|\b[a-zA-Z0-9.-]*[a-zA-Z][a-zA-Z0-9.-]*:\d{2,5}\b`)
+)
+
+// errorSanitizer rewrites the errors returned to the clients, so that they
+// do not leak the sql text, the bind variables or the addresses of the
+// servers to untrusted applications. The errors are logged, and kept in
+// the query logs, before they are sanitized. The code of an error is never
+// changed, so that the clients can still act on it.
+type errorSanitizer struct {
+	enabled bool
+	// policies overrides the policy of the error codes. The errors of
+	// the other codes are redacted, except FAILED_PRECONDITION which are
+	// kept.
+	policies  map[vtrpcpb.Code]string
+	sanitized *stats.CountersWithSingleLabel
+}
+
+func newErrorSanitizer(exporter *servenv.Exporter, config tabletenv.ErrorSanitizationConfig) *errorSanitizer {
+	es := &errorSanitizer{
+		enabled:   config.Enable,
+		policies:  make(map[vtrpcpb.Code]string),
+		sanitized: exporter.NewCountersWithSingleLabel("SanitizedErrors", "Errors sanitized before being returned to the clients, by error code", "code"),
+	}
+	for name, policy := range config.Policies {
+		if code, ok := vtrpcpb.Code_value[name]; ok {
+			es.policies[vtrpcpb.Code(code)] = policy
+		}
+	}
+	return es
+}
+
+// sanitize returns the error to send to the client in place of err, the
+// error of sql. sqlErr is the MySQL error err was built from, if any.
+func (es *errorSanitizer) sanitize(err error, sql string, bindVariables map[string]*querypb.BindVariable, sqlErr *mysql.SQLError) error {
+	if !es.enabled {
+		return err
+	}
+	code := vterrors.Code(err)
+	policy, ok := es.policies[code]
+	switch {
+	case ok:
+	case code == vtrpcpb.Code_FAILED_PRECONDITION:
+		// Like with TerseErrors, vtgate needs the messages of these
+		// errors to detect failovers and buffer the queries.
+		policy = tabletenv.SanitizeKeep
+	default:
+		policy = tabletenv.SanitizeRedact
+	}
+	switch policy {
+	case tabletenv.SanitizeKeep:
+		return err
+	case tabletenv.SanitizeGeneric:
+		es.sanitized.Add(code.String(), 1)
+		if sqlErr != nil {
+			return vterrors.Errorf(code, "%v error (errno %d) (sqlstate %s)", code, sqlErr.Number(), sqlErr.SQLState())
+		}
+		return vterrors.Errorf(code, "%v error", code)
+	}
+	es.sanitized.Add(code.String(), 1)
+	return vterrors.New(code, redactMessage(err.Error(), sql, bindVariables))
+}
+
+// redactMessage strips the query, the quoted values and the network
+// addresses from msg.
+func redactMessage(msg, sql string, bindVariables map[string]*querypb.BindVariable) string {
+	for _, query := range []string{queryAsString(sql, bindVariables), queryAsString(sql, nil)} {
+		msg = strings.Replace(msg, ": "+query, "", 1)
+	}
+	if sql != "" {
+		msg = strings.ReplaceAll(msg, sql, redacted)
+	}
+	msg = quotedValueRegexp.ReplaceAllString(msg, "'"+redacted+"'")
+	return addressRegexp.ReplaceAllString(msg, redacted)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/servenv"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func TestRedactMessage(t *testing.T) {
+	sql := "insert into t(id, name) values (:id, :name)"
+	bv := map[string]*querypb.BindVariable{
+		"id":   sqltypes.Int64BindVariable(1),
+		"name": sqltypes.StringBindVariable("secret"),
+	}
+	testcases := []struct {
+		in, want string
+	}{{
+		in:   "Duplicate entry 'secret' for key 'name' (errno 1062) (sqlstate 23000) (CallerID: user): " + queryAsString(sql, bv),
+		want: "Duplicate entry '[REDACTED]' for key '[REDACTED]' (errno 1062) (sqlstate 23000) (CallerID: user)",
+	}, {
+		in:   "(errno 1062) (sqlstate 23000): " + queryAsString(sql, nil),
+		want: "(errno 1062) (sqlstate 23000)",
+	}, {
+		in:   "could not execute " + sql + " on 10.0.0.1:3306",
+		want: "could not execute [REDACTED] on [REDACTED]",
+	}, {
+		in:   "dial tcp [::1]:15991: connection refused, also tried db-1.example.com:3306",
+		want: "dial tcp [REDACTED]: connection refused, also tried [REDACTED]",
+	}, {
+		in:   "vttablet: rpc error: code = Aborted desc = transaction 1234: ended at 2021-01-01 00:00:00",
+		want: "vttablet: rpc error: code = Aborted desc = transaction 1234: ended at 2021-01-01 00:00:00",
+	}}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, redactMessage(tcase.in, sql, bv), tcase.in)
+	}
+}
+
+func TestErrorSanitizer(t *testing.T) {
+	exporter := servenv.NewExporter("ErrorSanitizerTest", "Tablet")
+	sql := "select * from t where name = :name"
+	bv := map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("secret")}
+	sqlErr := mysql.NewSQLError(1105, "HY000", "unknown column 'secret'")
+	errFor := func(code vtrpcpb.Code) error {
+		return vterrors.Errorf(code, "%s (errno 1105) (sqlstate HY000): %s", sqlErr.Message, queryAsString(sql, bv))
+	}
+
+	es := newErrorSanitizer(exporter, tabletenv.ErrorSanitizationConfig{})
+	err := errFor(vtrpcpb.Code_UNKNOWN)
+	assert.Equal(t, err, es.sanitize(err, sql, bv, sqlErr))
+
+	es = newErrorSanitizer(exporter, tabletenv.ErrorSanitizationConfig{
+		Enable: true,
+		Policies: map[string]string{
+			"INVALID_ARGUMENT": tabletenv.SanitizeKeep,
+			"INTERNAL":         tabletenv.SanitizeGeneric,
+			"ABORTED":          tabletenv.SanitizeGeneric,
+		},
+	})
+	before := es.sanitized.Counts()
+	testcases := []struct {
+		code   vtrpcpb.Code
+		sqlErr *mysql.SQLError
+		want   string
+	}{{
+		code:   vtrpcpb.Code_UNKNOWN,
+		sqlErr: sqlErr,
+		want:   "unknown column '[REDACTED]' (errno 1105) (sqlstate HY000)",
+	}, {
+		code:   vtrpcpb.Code_INVALID_ARGUMENT,
+		sqlErr: sqlErr,
+		want:   errFor(vtrpcpb.Code_INVALID_ARGUMENT).Error(),
+	}, {
+		code:   vtrpcpb.Code_FAILED_PRECONDITION,
+		sqlErr: sqlErr,
+		want:   errFor(vtrpcpb.Code_FAILED_PRECONDITION).Error(),
+	}, {
+		code:   vtrpcpb.Code_INTERNAL,
+		sqlErr: sqlErr,
+		want:   "INTERNAL error (errno 1105) (sqlstate HY000)",
+	}, {
+		code: vtrpcpb.Code_ABORTED,
+		want: "ABORTED error",
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.code.String(), func(t *testing.T) {
+			got := es.sanitize(errFor(tcase.code), sql, bv, tcase.sqlErr)
+			assert.EqualError(t, got, tcase.want)
+			assert.Equal(t, tcase.code, vterrors.Code(got))
+		})
+	}
+	after := es.sanitized.Counts()
+	assert.EqualValues(t, 1, after["UNKNOWN"]-before["UNKNOWN"])
+	assert.EqualValues(t, 1, after["INTERNAL"]-before["INTERNAL"])
+	assert.EqualValues(t, 0, after["INVALID_ARGUMENT"]-before["INVALID_ARGUMENT"])
+
+	// Errors without a code are sanitized as UNKNOWN errors.
+	got := es.sanitize(errors.New("dial tcp 10.0.0.1:3306: i/o timeout"), "", nil, nil)
+	assert.EqualError(t, got, "dial tcp [REDACTED]: i/o timeout")
+}
@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"io"
+	"sort"
 	"sync"
 	"time"
 
@@ -34,6 +35,7 @@ import (
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletmanager/vreplication"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -60,14 +62,21 @@ type healthStreamer struct {
 	state   *querypb.StreamHealthResponse
 
 	history *history.History
+
+	// se and signalWhenSchemaChange are used to notify the clients
+	// of the tables changed by the schema engine.
+	se                     *schema.Engine
+	signalWhenSchemaChange bool
 }
 
-func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias) *healthStreamer {
+func newHealthStreamer(env tabletenv.Env, alias topodatapb.TabletAlias, se *schema.Engine) *healthStreamer {
 	return &healthStreamer{
-		stats:              env.Stats(),
-		degradedThreshold:  env.Config().Healthcheck.DegradedThresholdSeconds.Get(),
-		unhealthyThreshold: env.Config().Healthcheck.UnhealthyThresholdSeconds.Get(),
-		clients:            make(map[chan *querypb.StreamHealthResponse]struct{}),
+		stats:                  env.Stats(),
+		degradedThreshold:      env.Config().Healthcheck.DegradedThresholdSeconds.Get(),
+		unhealthyThreshold:     env.Config().Healthcheck.UnhealthyThresholdSeconds.Get(),
+		clients:                make(map[chan *querypb.StreamHealthResponse]struct{}),
+		se:                     se,
+		signalWhenSchemaChange: env.Config().SignalWhenSchemaChange,
 
 		state: &querypb.StreamHealthResponse{
 			Target:      &querypb.Target{},
@@ -174,13 +183,25 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 		hs.state.RealtimeStats.HealthError = ""
 	}
 	hs.state.RealtimeStats.SecondsBehindMaster = uint32(lag.Seconds())
+	hs.state.RealtimeStats.ReplicationLagMs = lag.Milliseconds()
 	hs.state.Serving = serving
 
 	hs.state.RealtimeStats.SecondsBehindMasterFilteredReplication, hs.state.RealtimeStats.BinlogPlayersCount = blpFunc()
 	hs.state.RealtimeStats.Qps = hs.stats.QPSRates.TotalRate()
 
 	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.broadcastLocked(shr)
+	hs.history.Add(&historyRecord{
+		Time:       time.Now(),
+		serving:    shr.Serving,
+		tabletType: shr.Target.TabletType,
+		lag:        lag,
+		err:        err,
+	})
+}
 
+// broadcastLocked sends shr to all clients. hs.mu must be held.
+func (hs *healthStreamer) broadcastLocked(shr *querypb.StreamHealthResponse) {
 	for ch := range hs.clients {
 		select {
 		case ch <- shr:
@@ -202,15 +223,50 @@ func (hs *healthStreamer) ChangeState(tabletType topodatapb.TabletType, terTimes
 			delete(hs.clients, ch)
 		}
 	}
-	hs.history.Add(&historyRecord{
-		Time:       time.Now(),
-		serving:    shr.Serving,
-		tabletType: shr.Target.TabletType,
-		lag:        lag,
-		err:        err,
+}
+
+// RegisterSchemaNotifier registers the health streamer with the schema
+// engine if schema change signalling is enabled. It must be called every
+// time the schema engine is opened, because closing the engine drops its
+// notifiers.
+func (hs *healthStreamer) RegisterSchemaNotifier() {
+	if !hs.signalWhenSchemaChange || hs.se == nil {
+		return
+	}
+	// The notifier is called once at registration with all the tables
+	// as created. The clients load the full schema when they connect,
+	// so that first call is not broadcast.
+	registering := true
+	hs.se.RegisterNotifier("healthStreamer", func(_ map[string]*schema.Table, created, altered, dropped []string) {
+		if registering {
+			registering = false
+			return
+		}
+		hs.tablesChanged(created, altered, dropped)
 	})
 }
 
+// tablesChanged broadcasts a health message listing the changed tables.
+// The list is cleared afterwards so that it is only sent once.
+func (hs *healthStreamer) tablesChanged(created, altered, dropped []string) {
+	var tables []string
+	tables = append(tables, created...)
+	tables = append(tables, altered...)
+	tables = append(tables, dropped...)
+	if len(tables) == 0 {
+		return
+	}
+	sort.Strings(tables)
+
+	hs.mu.Lock()
+	defer hs.mu.Unlock()
+
+	hs.state.RealtimeStats.TableSchemaChanged = tables
+	shr := proto.Clone(hs.state).(*querypb.StreamHealthResponse)
+	hs.state.RealtimeStats.TableSchemaChanged = nil
+	hs.broadcastLocked(shr)
+}
+
 func (hs *healthStreamer) AppendDetails(details []*kv) []*kv {
 	hs.mu.Lock()
 	defer hs.mu.Unlock()
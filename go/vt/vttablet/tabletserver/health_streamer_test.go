@@ -37,7 +37,7 @@ func TestHealthStreamerClosed(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	err := hs.Stream(context.Background(), func(shr *querypb.StreamHealthResponse) error {
 		return nil
 	})
@@ -52,7 +52,7 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 		Uid:  1,
 	}
 	blpFunc = testBlpFunc
-	hs := newHealthStreamer(env, alias)
+	hs := newHealthStreamer(env, alias, nil)
 	hs.Open()
 	defer hs.Close()
 	target := querypb.Target{}
@@ -115,6 +115,7 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 			SecondsBehindMaster:                    1,
 			SecondsBehindMasterFilteredReplication: 1,
 			BinlogPlayersCount:                     2,
+			ReplicationLagMs:                       1000,
 		},
 	}
 	assert.Equal(t, want, shr)
@@ -136,6 +137,37 @@ func TestHealthStreamerBroadcast(t *testing.T) {
 	assert.Equal(t, want, shr)
 }
 
+func TestHealthStreamerTablesChanged(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	env := tabletenv.NewEnv(config, "ReplTrackerTest")
+	alias := topodatapb.TabletAlias{
+		Cell: "cell",
+		Uid:  1,
+	}
+	blpFunc = testBlpFunc
+	hs := newHealthStreamer(env, alias, nil)
+	hs.Open()
+	defer hs.Close()
+	target := querypb.Target{}
+	hs.InitDBConfig(target)
+
+	ch, cancel := testStream(hs)
+	defer cancel()
+	<-ch
+
+	hs.tablesChanged([]string{"t3"}, []string{"t1"}, []string{"t2"})
+	shr := <-ch
+	assert.Equal(t, []string{"t1", "t2", "t3"}, shr.RealtimeStats.TableSchemaChanged)
+
+	// Nothing is sent if no table changed.
+	hs.tablesChanged(nil, nil, nil)
+
+	// The changed tables are only sent once.
+	hs.ChangeState(topodatapb.TabletType_MASTER, time.Time{}, 0, nil, true)
+	shr = <-ch
+	assert.Empty(t, shr.RealtimeStats.TableSchemaChanged)
+}
+
 func testStream(hs *healthStreamer) (<-chan *querypb.StreamHealthResponse, context.CancelFunc) {
 	ctx, cancel := context.WithCancel(context.Background())
 	ch := make(chan *querypb.StreamHealthResponse)
@@ -37,6 +37,8 @@ var (
 			<th>Duration</th>
 			<th>Start</th>
 			<th>ConnectionID</th>
+			<th>TransactionID</th>
+			<th>Caller</th>
 			<th>Terminate</th>
 		</tr>
         </thead>
@@ -49,6 +51,8 @@ var (
 			<td>{{.Duration}}</td>
 			<td>{{.Start}}</td>
 			<td>{{.ConnID}}</td>
+			<td>{{if .TransactionID}}{{.TransactionID}}{{end}}</td>
+			<td>{{.EffectiveCaller}}</td>
 			<td><a href='/livequeryz/terminate?connID={{.ConnID}}'>Terminate</a></td>
 		</tr>
 	`))
@@ -110,3 +114,56 @@ func livequeryzTerminateHandler(queryLists []*QueryList, w http.ResponseWriter,
 	}
 	livequeryzHandler(queryLists, w, r)
 }
+
+// livequeryzKillHandler kills a query given its connID, or a transaction
+// given its transactionID. Unlike livequeryzTerminateHandler, it requires
+// a POST and reports the outcome as JSON.
+func livequeryzKillHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "kill requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusBadRequest)
+		return
+	}
+	var killed string
+	switch {
+	case r.FormValue("connID") != "":
+		connID, err := strconv.ParseInt(r.FormValue("connID"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid connID", http.StatusBadRequest)
+			return
+		}
+		if !tsv.KillQuery(connID) {
+			http.Error(w, fmt.Sprintf("no query running on connection %d", connID), http.StatusNotFound)
+			return
+		}
+		killed = fmt.Sprintf("query on connection %d", connID)
+	case r.FormValue("transactionID") != "":
+		transactionID, err := strconv.ParseInt(r.FormValue("transactionID"), 10, 64)
+		if err != nil {
+			http.Error(w, "invalid transactionID", http.StatusBadRequest)
+			return
+		}
+		if err := tsv.KillTransaction(transactionID); err != nil {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		killed = fmt.Sprintf("transaction %d", transactionID)
+	default:
+		http.Error(w, "connID or transactionID is required", http.StatusBadRequest)
+		return
+	}
+	js, err := json.Marshal(struct{ Killed string }{killed})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
@@ -19,9 +19,18 @@ package tabletserver
 import (
 	"net/http"
 	"net/http/httptest"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"context"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 )
 
 func TestLiveQueryzHandlerJSON(t *testing.T) {
@@ -94,3 +103,78 @@ func TestLiveQueryzHandlerTerminateFailedInvalidForm(t *testing.T) {
 			http.StatusInternalServerError, resp.Code)
 	}
 }
+
+func TestLiveQueriesOrder(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	start := time.Now()
+	add := func(ql *QueryList, connID int64, startedAgo time.Duration) {
+		qd := NewQueryDetail(context.Background(), &testConn{id: connID})
+		qd.start = start.Add(-startedAgo)
+		ql.Add(qd)
+		t.Cleanup(func() { ql.Remove(qd) })
+	}
+	add(tsv.olapql, 1, 3*time.Second)
+	add(tsv.statelessql, 2, time.Second)
+	add(tsv.statefulql, 3, 4*time.Second)
+	add(tsv.statelessql, 4, 2*time.Second)
+	add(tsv.olapql, 6, time.Second)
+	add(tsv.statefulql, 5, time.Second)
+
+	var got []int64
+	for _, row := range tsv.LiveQueries() {
+		got = append(got, row.ConnID)
+	}
+	assert.Equal(t, []int64{3, 1, 4, 2, 5, 6}, got)
+}
+
+func TestLiveQueryzKillHandler(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+	// The stats are shared by the tests.
+	defer tsv.stats.KillCounters.ResetAll()
+
+	kill := func(method, params string) *httptest.ResponseRecorder {
+		resp := httptest.NewRecorder()
+		req, _ := http.NewRequest(method, "/livequeryz/kill", strings.NewReader(params))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		livequeryzKillHandler(tsv, resp, req)
+		return resp
+	}
+
+	conn := &testConn{id: 5, query: "select sleep(100)"}
+	qd := NewQueryDetail(context.Background(), conn)
+	tsv.statelessql.Add(qd)
+	defer tsv.statelessql.Remove(qd)
+	assert.Len(t, tsv.LiveQueries(), 1)
+
+	resp := kill("GET", "connID=5")
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.Code)
+	assert.False(t, conn.IsKilled())
+
+	resp = kill("POST", "connID=6")
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	resp = kill("POST", "connID=5")
+	assert.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, `{"Killed":"query on connection 5"}`, resp.Body.String())
+	assert.True(t, conn.IsKilled())
+
+	// An idle transaction is rolled back.
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	transactionID, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	resp = kill("POST", "transactionID="+strconv.FormatInt(transactionID, 10))
+	assert.Equal(t, http.StatusOK, resp.Code)
+	_, err = tsv.Commit(ctx, &target, transactionID)
+	assert.Error(t, err)
+
+	resp = kill("POST", "transactionID="+strconv.FormatInt(transactionID, 10))
+	assert.Equal(t, http.StatusNotFound, resp.Code)
+
+	resp = kill("POST", "")
+	assert.Equal(t, http.StatusBadRequest, resp.Code)
+}
@@ -32,7 +32,10 @@ type MessageRow struct {
 	TimeNext  int64
 	Epoch     int64
 	TimeAcked int64
-	Row       []sqltypes.Value
+	// TimeScheduled is the time before which the message must
+	// not be sent. It is 0 if the table has no time_scheduled.
+	TimeScheduled int64
+	Row           []sqltypes.Value
 
 	// defunct is set if the row was asked to be removed
 	// from cache.
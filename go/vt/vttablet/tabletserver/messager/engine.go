@@ -39,6 +39,7 @@ type TabletService interface {
 	tabletenv.Env
 	PostponeMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 	PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error)
+	DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error)
 }
 
 // VStreamer defines  the functions of VStreamer
@@ -146,6 +147,31 @@ func (me *Engine) GeneratePostponeQuery(name string, ids []string) (string, map[
 	return query, bv, nil
 }
 
+// GenerateArchiveQuery returns the query and bind vars for archiving messages.
+// The query is empty if the table does not archive its messages.
+func (me *Engine) GenerateArchiveQuery(name string, timeCutoff int64) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	query, bv := mm.GenerateArchiveQuery(timeCutoff)
+	return query, bv, nil
+}
+
+// GenerateDeadLetterQuery returns the query and bind vars for dead-lettering messages.
+func (me *Engine) GenerateDeadLetterQuery(name string, ids []string) (string, map[string]*querypb.BindVariable, error) {
+	me.mu.Lock()
+	defer me.mu.Unlock()
+	mm := me.managers[name]
+	if mm == nil {
+		return "", nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "message table %s not found in schema", name)
+	}
+	query, bv := mm.GenerateDeadLetterQuery(ids)
+	return query, bv, nil
+}
+
 // GeneratePurgeQuery returns the query and bind vars for purging messages.
 func (me *Engine) GeneratePurgeQuery(name string, timeCutoff int64) (string, map[string]*querypb.BindVariable, error) {
 	me.mu.Lock()
@@ -29,6 +29,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/pools"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
@@ -185,6 +186,11 @@ type messageManager struct {
 	receivers       []*receiverWithStatus
 	curReceiver     int
 	messagesPending bool
+	// scheduledPoll triggers the poller when the earliest
+	// scheduled message seen by the vstream is due.
+	// scheduledPollAt is the time it fires at, in nanoseconds.
+	scheduledPoll   *time.Timer
+	scheduledPollAt int64
 
 	// streamMu keeps the cache and database consistent with each other.
 	// Specifically:
@@ -209,11 +215,21 @@ type messageManager struct {
 	// The goroutine must in turn defer on Done.
 	wg sync.WaitGroup
 
+	// hasTimeScheduled is set if the table has a time_scheduled
+	// column. Its value is then selected after time_acked.
+	hasTimeScheduled bool
+
+	// maxAttempts is the number of times a message is sent
+	// before it's dead-lettered. 0 means no limit.
+	maxAttempts int64
+
 	vsFilter                  *binlogdatapb.Filter
 	readByPriorityAndTimeNext *sqlparser.ParsedQuery
 	ackQuery                  *sqlparser.ParsedQuery
 	postponeQuery             *sqlparser.ParsedQuery
+	deadLetterQuery           *sqlparser.ParsedQuery
 	purgeQuery                *sqlparser.ParsedQuery
+	archiveQuery              *sqlparser.ParsedQuery
 }
 
 // newMessageManager creates a new message manager.
@@ -227,21 +243,29 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		fieldResult: &sqltypes.Result{
 			Fields: table.MessageInfo.Fields,
 		},
-		ackWaitTime:     table.MessageInfo.AckWaitDuration,
-		purgeAfter:      table.MessageInfo.PurgeAfterDuration,
-		minBackoff:      table.MessageInfo.MinBackoff,
-		maxBackoff:      table.MessageInfo.MaxBackoff,
-		batchSize:       table.MessageInfo.BatchSize,
-		cache:           newCache(table.MessageInfo.CacheSize),
-		pollerTicks:     timer.NewTimer(table.MessageInfo.PollInterval),
-		purgeTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
-		postponeSema:    postponeSema,
-		messagesPending: true,
+		ackWaitTime:      table.MessageInfo.AckWaitDuration,
+		purgeAfter:       table.MessageInfo.PurgeAfterDuration,
+		minBackoff:       table.MessageInfo.MinBackoff,
+		maxBackoff:       table.MessageInfo.MaxBackoff,
+		batchSize:        table.MessageInfo.BatchSize,
+		cache:            newCache(table.MessageInfo.CacheSize),
+		pollerTicks:      timer.NewTimer(table.MessageInfo.PollInterval),
+		purgeTicks:       timer.NewTimer(table.MessageInfo.PollInterval),
+		postponeSema:     postponeSema,
+		messagesPending:  true,
+		hasTimeScheduled: table.MessageInfo.HasTimeScheduled,
+		maxAttempts:      int64(table.MessageInfo.MaxAttempts),
 	}
 	mm.cond.L = &mm.mu
 
+	hiddenColumns := "priority, time_next, epoch, time_acked"
+	scheduledFilter := ""
+	if mm.hasTimeScheduled {
+		hiddenColumns += ", time_scheduled"
+		scheduledFilter = " and (time_scheduled is null or time_scheduled < :time_next)"
+	}
 	columnList := buildSelectColumnList(table)
-	vsQuery := fmt.Sprintf("select priority, time_next, epoch, time_acked, %s from %v", columnList, mm.name)
+	vsQuery := fmt.Sprintf("select %s, %s from %v", hiddenColumns, columnList, mm.name)
 	mm.vsFilter = &binlogdatapb.Filter{
 		Rules: []*binlogdatapb.Rule{{
 			Match:  table.Name.String(),
@@ -249,13 +273,37 @@ func newMessageManager(tsv TabletService, vs VStreamer, table *schema.Table, pos
 		}},
 	}
 	mm.readByPriorityAndTimeNext = sqlparser.BuildParsedQuery(
-		"select priority, time_next, epoch, time_acked, %s from %v where time_next < %a order by priority, time_next desc limit %a",
-		columnList, mm.name, ":time_next", ":max")
-	mm.ackQuery = sqlparser.BuildParsedQuery(
+		"select %s, %s from %v where time_next < %a%s order by priority, time_next desc limit %a",
+		hiddenColumns, columnList, mm.name, ":time_next", scheduledFilter, ":max")
+	switch table.MessageInfo.PurgePolicy {
+	case schema.PurgeDelete:
+		// The :time_acked bind var is still supplied, but unused.
+		mm.ackQuery = sqlparser.BuildParsedQuery(
+			"delete from %v where id in %a and time_acked is null",
+			mm.name, "::ids")
+	default:
+		mm.ackQuery = sqlparser.BuildParsedQuery(
+			"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
+			mm.name, ":time_acked", "::ids")
+	}
+	switch table.MessageInfo.PurgePolicy {
+	case schema.PurgeArchive:
+		// The rows are ordered by id so that the delete removes
+		// the same rows as the ones that were archived.
+		mm.archiveQuery = sqlparser.BuildParsedQuery(
+			"insert into %v select * from %v where time_acked < %a order by id limit 500",
+			sqlparser.NewTableIdent(table.MessageInfo.ArchiveTable), mm.name, ":time_acked")
+		mm.purgeQuery = sqlparser.BuildParsedQuery(
+			"delete from %v where time_acked < %a order by id limit 500", mm.name, ":time_acked")
+	default:
+		mm.purgeQuery = sqlparser.BuildParsedQuery(
+			"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
+	}
+	// A dead-lettered message is acked at the time it's dead-lettered
+	// so that it's purged or archived with the acked messages.
+	mm.deadLetterQuery = sqlparser.BuildParsedQuery(
 		"update %v set time_acked = %a, time_next = null where id in %a and time_acked is null",
 		mm.name, ":time_acked", "::ids")
-	mm.purgeQuery = sqlparser.BuildParsedQuery(
-		"delete from %v where time_acked < %a limit 500", mm.name, ":time_acked")
 
 	mm.postponeQuery = buildPostponeQuery(mm.name, mm.minBackoff, mm.maxBackoff)
 
@@ -357,6 +405,7 @@ func (mm *messageManager) Close() {
 	mm.receivers = nil
 	MessageStats.Set([]string{mm.name.String(), "ClientCount"}, 0)
 	mm.cache.Clear()
+	mm.stopScheduledPoll()
 	// This broadcast will cause runSend to exit.
 	mm.cond.Broadcast()
 	mm.mu.Unlock()
@@ -491,6 +540,7 @@ func (mm *messageManager) runSend() {
 		mm.mu.Lock()
 
 		var rows [][]sqltypes.Value
+		var deadIDs []string
 		for {
 			if !mm.isOpen {
 				return
@@ -521,6 +571,10 @@ func (mm *messageManager) runSend() {
 				if mr == nil {
 					break
 				}
+				if mm.maxAttempts > 0 && mr.Epoch >= mm.maxAttempts {
+					deadIDs = append(deadIDs, mr.Row[0].ToString())
+					continue
+				}
 				if mr.Epoch >= 1 {
 					lateCount++
 				}
@@ -528,6 +582,14 @@ func (mm *messageManager) runSend() {
 			}
 			MessageStats.Add([]string{mm.name.String(), "Delayed"}, lateCount)
 
+			// Messages that ran out of attempts are dead-lettered
+			// asynchronously.
+			if deadIDs != nil {
+				mm.wg.Add(1)
+				go mm.deadLetter(deadIDs)
+				deadIDs = nil
+			}
+
 			// If we have rows to send, break out of this loop.
 			if rows != nil {
 				break
@@ -603,6 +665,32 @@ func (mm *messageManager) postpone(tsv TabletService, name string, ackWaitTime t
 	}
 }
 
+// deadLetter marks the messages as dead so that they're not sent again.
+func (mm *messageManager) deadLetter(ids []string) {
+	defer func() {
+		mm.tsv.LogError()
+		mm.wg.Done()
+	}()
+
+	defer func() {
+		// Same as in send: the ids must be discarded after the
+		// update to prevent the poller from requeuing the messages.
+		mm.streamMu.Lock()
+		defer mm.streamMu.Unlock()
+		mm.cache.Discard(ids)
+	}()
+
+	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), mm.ackWaitTime)
+	defer cancel()
+	count, err := mm.tsv.DeadLetterMessages(ctx, nil, mm.name.String(), ids)
+	if err != nil {
+		log.Errorf("Error dead-lettering messages %v: %v", ids, err)
+		MessageStats.Add([]string{mm.name.String(), "DeadLetterFailed"}, 1)
+		return
+	}
+	MessageStats.Add([]string{mm.name.String(), "DeadLettered"}, count)
+}
+
 func (mm *messageManager) startVStream() {
 	mm.streamMu.Lock()
 	defer mm.streamMu.Unlock()
@@ -721,18 +809,54 @@ func (mm *messageManager) processRowEvent(fields []*querypb.Field, rowEvent *bin
 			continue
 		}
 		row := sqltypes.MakeRowTrusted(fields, rc.After)
-		mr, err := BuildMessageRow(row)
+		mr, err := mm.buildMessageRow(row)
 		if err != nil {
 			return err
 		}
 		if mr.TimeAcked != 0 || mr.TimeNext > now {
 			continue
 		}
+		if mr.TimeScheduled > now {
+			mm.schedulePoll(mr.TimeScheduled)
+			continue
+		}
 		mm.Add(mr)
 	}
 	return nil
 }
 
+// schedulePoll makes the poller run when the message scheduled at
+// the given time is due, unless an earlier poll is already scheduled.
+// A random jitter of up to a tenth of the poll interval is added so
+// that the tablets do not all poll at the same time.
+func (mm *messageManager) schedulePoll(at int64) {
+	mm.mu.Lock()
+	defer mm.mu.Unlock()
+	if !mm.isOpen || (mm.scheduledPoll != nil && mm.scheduledPollAt <= at) {
+		return
+	}
+	mm.stopScheduledPoll()
+	delay := time.Duration(at - time.Now().UnixNano())
+	delay += time.Duration(rand.Int63n(int64(mm.pollerTicks.Interval()/10) + 1))
+	mm.scheduledPollAt = at
+	mm.scheduledPoll = time.AfterFunc(delay, func() {
+		mm.mu.Lock()
+		mm.scheduledPoll = nil
+		mm.scheduledPollAt = 0
+		mm.mu.Unlock()
+		mm.pollerTicks.Trigger()
+	})
+}
+
+// stopScheduledPoll cancels the scheduled poll. mm.mu must be held.
+func (mm *messageManager) stopScheduledPoll() {
+	if mm.scheduledPoll != nil {
+		mm.scheduledPoll.Stop()
+		mm.scheduledPoll = nil
+		mm.scheduledPollAt = 0
+	}
+}
+
 func (mm *messageManager) runPoller() {
 	// Fast-path. Skip all the work.
 	if mm.receiverCount() == 0 {
@@ -776,7 +900,7 @@ func (mm *messageManager) runPoller() {
 		defer mm.cond.Broadcast()
 	}
 	for _, row := range qr.Rows {
-		mr, err := BuildMessageRow(row)
+		mr, err := mm.buildMessageRow(row)
 		if err != nil {
 			mm.tsv.Stats().InternalErrors.Add("Messages", 1)
 			log.Errorf("Error reading message row: %v", err)
@@ -796,7 +920,10 @@ func (mm *messageManager) runPurge() {
 // purge is a non-member because it should be called asynchronously and should
 // not rely on members of messageManager.
 func purge(tsv TabletService, name string, purgeAfter, purgeInterval time.Duration) {
-	ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), purgeInterval)
+	// The purge is maintenance work: it gets a connection after the
+	// queries waiting for one.
+	ctx := pools.WithPriority(tabletenv.LocalContext(), pools.PriorityBackground)
+	ctx, cancel := context.WithTimeout(ctx, purgeInterval)
 	defer func() {
 		tsv.LogError()
 		cancel()
@@ -862,6 +989,35 @@ func (mm *messageManager) GeneratePostponeQuery(ids []string) (string, map[strin
 	return mm.postponeQuery.Query, bvs
 }
 
+// GenerateArchiveQuery returns the query and bind vars for archiving messages
+// before they're purged. The query is empty if the messages are not archived.
+func (mm *messageManager) GenerateArchiveQuery(timeCutoff int64) (string, map[string]*querypb.BindVariable) {
+	if mm.archiveQuery == nil {
+		return "", nil
+	}
+	return mm.archiveQuery.Query, map[string]*querypb.BindVariable{
+		"time_acked": sqltypes.Int64BindVariable(timeCutoff),
+	}
+}
+
+// GenerateDeadLetterQuery returns the query and bind vars for dead-lettering messages.
+func (mm *messageManager) GenerateDeadLetterQuery(ids []string) (string, map[string]*querypb.BindVariable) {
+	idbvs := &querypb.BindVariable{
+		Type:   querypb.Type_TUPLE,
+		Values: make([]*querypb.Value, 0, len(ids)),
+	}
+	for _, id := range ids {
+		idbvs.Values = append(idbvs.Values, &querypb.Value{
+			Type:  querypb.Type_VARBINARY,
+			Value: []byte(id),
+		})
+	}
+	return mm.deadLetterQuery.Query, map[string]*querypb.BindVariable{
+		"time_acked": sqltypes.Int64BindVariable(time.Now().UnixNano()),
+		"ids":        idbvs,
+	}
+}
+
 // GeneratePurgeQuery returns the query and bind vars for purging messages.
 func (mm *messageManager) GeneratePurgeQuery(timeCutoff int64) (string, map[string]*querypb.BindVariable) {
 	return mm.purgeQuery.Query, map[string]*querypb.BindVariable{
@@ -903,6 +1059,28 @@ func BuildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
 	return mr, nil
 }
 
+// buildMessageRow builds a MessageRow for a row selected by the
+// message manager, which has the time_scheduled column after
+// time_acked if the table has one.
+func (mm *messageManager) buildMessageRow(row []sqltypes.Value) (*MessageRow, error) {
+	if !mm.hasTimeScheduled {
+		return BuildMessageRow(row)
+	}
+	timeScheduled := row[4]
+	mr, err := BuildMessageRow(append(row[:4:4], row[5:]...))
+	if err != nil {
+		return nil, err
+	}
+	if !timeScheduled.IsNull() {
+		v, err := evalengine.ToInt64(timeScheduled)
+		if err != nil {
+			return nil, err
+		}
+		mr.TimeScheduled = v
+	}
+	return mr, nil
+}
+
 func (mm *messageManager) receiverCount() int {
 	mm.mu.Lock()
 	defer mm.mu.Unlock()
@@ -33,7 +33,9 @@ import (
 	"context"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
+	"vitess.io/vitess/go/pools"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -347,6 +349,50 @@ func TestMessageManagerPostponeThrottle(t *testing.T) {
 	<-ch
 }
 
+func TestMessageManagerDeadLetter(t *testing.T) {
+	tsv := newFakeTabletServer()
+	ti := newMMTable()
+	ti.MessageInfo.MaxAttempts = 2
+	mm := newMessageManager(tsv, newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	mm.Open()
+	defer mm.Close()
+
+	ch := make(chan string)
+	tsv.SetChannel(ch)
+
+	r1 := newTestReceiver(1)
+	mm.Subscribe(context.Background(), r1.rcv)
+	<-r1.ch
+
+	// Message 1 is out of attempts and must be dead-lettered.
+	mm.Add(&MessageRow{Epoch: 2, Row: []sqltypes.Value{sqltypes.NewVarBinary("1"), sqltypes.NULL}})
+	assert.Equal(t, "deadletter", <-ch)
+	assert.Equal(t, int64(1), tsv.deadCount.Get())
+
+	// Message 2 has one attempt left and must be sent.
+	mm.Add(&MessageRow{Epoch: 1, Row: []sqltypes.Value{sqltypes.NewVarBinary("2"), sqltypes.NULL}})
+	want := &sqltypes.Result{
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("2"),
+			sqltypes.NULL,
+		}},
+	}
+	assert.Equal(t, want, <-r1.ch)
+	assert.Equal(t, "postpone", <-ch)
+	assert.Equal(t, int64(1), tsv.deadCount.Get())
+
+	// Dead-lettered messages are acked now, so that they're purged
+	// after vt_purge_after like the other acked messages.
+	query, bv := mm.GenerateDeadLetterQuery([]string{"1", "2"})
+	assert.Equal(t, "update foo set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null", query)
+	bvv, _ := sqltypes.BindVariableToValue(bv["time_acked"])
+	gotAcked, _ := evalengine.ToInt64(bvv)
+	assert.InDelta(t, time.Now().UnixNano(), gotAcked, 10e9)
+	utils.MustMatch(t, sqltypes.TestBindVariable([]interface{}{"1", "2"}), bv["ids"], "did not match")
+	query, _ = mm.GeneratePurgeQuery(time.Now().UnixNano())
+	assert.Equal(t, "delete from foo where time_acked < :time_acked limit 500", query)
+}
+
 func TestMessageManagerSendError(t *testing.T) {
 	tsv := newFakeTabletServer()
 	mm := newMessageManager(tsv, newFakeVStreamer(), newMMTable(), sync2.NewSemaphore(1, 0))
@@ -732,6 +778,52 @@ func TestMessageManagerPurge(t *testing.T) {
 	if got, want := <-ch, "purge"; got != want {
 		t.Errorf("Purge: %s, want %v", got, want)
 	}
+	// It waits for connections after the queries.
+	tsv.mu.Lock()
+	defer tsv.mu.Unlock()
+	assert.Equal(t, pools.PriorityBackground, tsv.purgePriority)
+}
+
+func TestMessageManagerTimeScheduled(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.HasTimeScheduled = true
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+
+	wantFilter := "select priority, time_next, epoch, time_acked, time_scheduled, id, message from foo"
+	assert.Equal(t, wantFilter, mm.vsFilter.Rules[0].Filter)
+	wantQuery := "select priority, time_next, epoch, time_acked, time_scheduled, id, message from foo where time_next < :time_next and (time_scheduled is null or time_scheduled < :time_next) order by priority, time_next desc limit :max"
+	assert.Equal(t, wantQuery, mm.readByPriorityAndTimeNext.Query)
+
+	mr, err := mm.buildMessageRow([]sqltypes.Value{
+		sqltypes.NewInt64(1),
+		sqltypes.NewInt64(2),
+		sqltypes.NewInt64(0),
+		sqltypes.NULL,
+		sqltypes.NewInt64(5),
+		sqltypes.NewInt64(10),
+		sqltypes.NewVarBinary("10"),
+	})
+	require.NoError(t, err)
+	want := &MessageRow{
+		Priority:      1,
+		TimeNext:      2,
+		TimeScheduled: 5,
+		Row:           []sqltypes.Value{sqltypes.NewInt64(10), sqltypes.NewVarBinary("10")},
+	}
+	assert.Equal(t, want, mr)
+
+	mm.Open()
+	defer mm.Close()
+	at := time.Now().Add(time.Hour).UnixNano()
+	mm.schedulePoll(at)
+	mm.schedulePoll(at + 1)
+	mm.mu.Lock()
+	assert.Equal(t, at, mm.scheduledPollAt)
+	mm.mu.Unlock()
+	mm.schedulePoll(at - 1)
+	mm.mu.Lock()
+	assert.Equal(t, at-1, mm.scheduledPollAt)
+	mm.mu.Unlock()
 }
 
 func TestMMGenerate(t *testing.T) {
@@ -790,6 +882,32 @@ func TestMMGenerate(t *testing.T) {
 	}
 }
 
+func TestMMGeneratePurgePolicies(t *testing.T) {
+	ti := newMMTable()
+	ti.MessageInfo.PurgePolicy = schema.PurgeDelete
+	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	query, _ := mm.GenerateAckQuery([]string{"1", "2"})
+	assert.Equal(t, "delete from foo where id in ::ids and time_acked is null", query)
+	query, _ = mm.GenerateArchiveQuery(3)
+	assert.Equal(t, "", query)
+
+	ti = newMMTable()
+	ti.MessageInfo.PurgePolicy = schema.PurgeArchive
+	ti.MessageInfo.ArchiveTable = "foo_archive"
+	mm = newMessageManager(newFakeTabletServer(), newFakeVStreamer(), ti, sync2.NewSemaphore(1, 0))
+	query, _ = mm.GenerateAckQuery([]string{"1", "2"})
+	assert.Equal(t, "update foo set time_acked = :time_acked, time_next = null where id in ::ids and time_acked is null", query)
+	wantbv := map[string]*querypb.BindVariable{
+		"time_acked": sqltypes.Int64BindVariable(3),
+	}
+	query, bv := mm.GenerateArchiveQuery(3)
+	assert.Equal(t, "insert into foo_archive select * from foo where time_acked < :time_acked order by id limit 500", query)
+	assert.Equal(t, wantbv, bv)
+	query, bv = mm.GeneratePurgeQuery(3)
+	assert.Equal(t, "delete from foo where time_acked < :time_acked order by id limit 500", query)
+	assert.Equal(t, wantbv, bv)
+}
+
 func TestMMGenerateWithBackoff(t *testing.T) {
 	mm := newMessageManager(newFakeTabletServer(), newFakeVStreamer(), newMMTableWithBackoff(), sync2.NewSemaphore(1, 0))
 	mm.Open()
@@ -829,9 +947,11 @@ type fakeTabletServer struct {
 	tabletenv.Env
 	postponeCount sync2.AtomicInt64
 	purgeCount    sync2.AtomicInt64
+	deadCount     sync2.AtomicInt64
 
-	mu sync.Mutex
-	ch chan string
+	mu            sync.Mutex
+	ch            chan string
+	purgePriority pools.Priority
 }
 
 func newFakeTabletServer() *fakeTabletServer {
@@ -864,6 +984,7 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	fts.purgeCount.Add(1)
 	fts.mu.Lock()
 	ch := fts.ch
+	fts.purgePriority = pools.PriorityFromContext(ctx)
 	fts.mu.Unlock()
 	if ch != nil {
 		ch <- "purge"
@@ -871,6 +992,17 @@ func (fts *fakeTabletServer) PurgeMessages(ctx context.Context, target *querypb.
 	return 0, nil
 }
 
+func (fts *fakeTabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	fts.deadCount.Add(int64(len(ids)))
+	fts.mu.Lock()
+	ch := fts.ch
+	fts.mu.Unlock()
+	if ch != nil {
+		ch <- "deadletter"
+	}
+	return int64(len(ids)), nil
+}
+
 type fakeVStreamer struct {
 	streamInvocations sync2.AtomicInt64
 	mu                sync.Mutex
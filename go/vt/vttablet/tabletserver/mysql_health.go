@@ -0,0 +1,130 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"fmt"
+	"syscall"
+	"time"
+
+	"vitess.io/vitess/go/vt/dbconnpool"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// The reasons why a tablet is unhealthy, as exported by the
+// TabletUnhealthyReason stat.
+const (
+	unhealthyReplicationError = "ReplicationError"
+	unhealthyReplicationLag   = "ReplicationLag"
+	unhealthyReadOnly         = "ReadOnly"
+	unhealthyWritable         = "Writable"
+	unhealthyDiskSpace        = "DiskSpace"
+)
+
+const mysqlHealthQuery = "select @@global.read_only, @@global.datadir"
+
+// mysqlHealthChecker checks that MySQL can serve the tablet type: that its
+// read_only matches the type, and that it does not run out of disk space.
+type mysqlHealthChecker struct {
+	env                tabletenv.Env
+	readOnlyCheck      bool
+	minFreeDiskPercent float64
+	diskPath           string
+
+	// freeDiskPercent is replaced in tests.
+	freeDiskPercent func(path string) (float64, error)
+}
+
+func newMySQLHealthChecker(env tabletenv.Env) *mysqlHealthChecker {
+	config := env.Config().Healthcheck
+	return &mysqlHealthChecker{
+		env:                env,
+		readOnlyCheck:      config.UnhealthyOnReadOnlyMismatch,
+		minFreeDiskPercent: config.UnhealthyMinFreeDiskPercent,
+		diskPath:           config.DiskPath,
+		freeDiskPercent:    freeDiskPercent,
+	}
+}
+
+// Check returns the reason why MySQL cannot serve the tablet type, along
+// with an error describing it, or an empty reason. Failures to reach MySQL
+// are only logged: they are handled by CheckMySQL.
+func (mh *mysqlHealthChecker) Check(tabletType topodatapb.TabletType) (string, error) {
+	if !mh.readOnlyCheck && mh.minFreeDiskPercent == 0 {
+		return "", nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	conn, err := dbconnpool.NewDBConnection(ctx, mh.env.Config().DB.DbaWithDB())
+	if err != nil {
+		log.Warningf("Could not check the MySQL health: %v", err)
+		return "", nil
+	}
+	defer conn.Close()
+	qr, err := conn.ExecuteFetch(mysqlHealthQuery, 1, false)
+	if err != nil || len(qr.Rows) != 1 || len(qr.Rows[0]) != 2 {
+		log.Warningf("Could not check the MySQL health: %v", err)
+		return "", nil
+	}
+	readOnly, datadir := qr.Rows[0][0].ToString() == "1", qr.Rows[0][1].ToString()
+
+	if mh.readOnlyCheck {
+		switch tabletType {
+		case topodatapb.TabletType_MASTER:
+			if readOnly {
+				return unhealthyReadOnly, fmt.Errorf("MySQL read_only is on for a %v tablet", tabletType)
+			}
+		case topodatapb.TabletType_REPLICA, topodatapb.TabletType_RDONLY:
+			if !readOnly {
+				return unhealthyWritable, fmt.Errorf("MySQL read_only is off for a %v tablet", tabletType)
+			}
+		}
+	}
+
+	if mh.minFreeDiskPercent > 0 {
+		path := mh.diskPath
+		if path == "" {
+			path = datadir
+		}
+		free, err := mh.freeDiskPercent(path)
+		if err != nil {
+			log.Warningf("Could not check the free disk space of %s: %v", path, err)
+			return "", nil
+		}
+		if free < mh.minFreeDiskPercent {
+			return unhealthyDiskSpace, fmt.Errorf("%.1f%% free disk space on %s, below %.1f%%", free, path, mh.minFreeDiskPercent)
+		}
+	}
+	return "", nil
+}
+
+// freeDiskPercent returns the percentage of the file system of path
+// that is available to MySQL.
+func freeDiskPercent(path string) (float64, error) {
+	var st syscall.Statfs_t
+	if err := syscall.Statfs(path, &st); err != nil {
+		return 0, err
+	}
+	if st.Blocks == 0 {
+		return 100, nil
+	}
+	return float64(st.Bavail) * 100 / float64(st.Blocks), nil
+}
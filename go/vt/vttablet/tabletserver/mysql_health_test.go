@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestMySQLHealthChecker(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	setReadOnly := func(readOnly string) {
+		db.AddQuery(mysqlHealthQuery, sqltypes.MakeTestResult(
+			sqltypes.MakeTestFields("@@global.read_only|@@global.datadir", "int64|varchar"),
+			readOnly+"|/vt/data",
+		))
+	}
+	setReadOnly("0")
+
+	config := tabletenv.NewDefaultConfig()
+	config.DB = newDBConfigs(db)
+	mh := newMySQLHealthChecker(tabletenv.NewEnv(config, "MySQLHealthTest"))
+
+	// Nothing is checked by default.
+	reason, err := mh.Check(topodatapb.TabletType_REPLICA)
+	assert.Equal(t, "", reason)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, db.GetQueryCalledNum(mysqlHealthQuery))
+
+	mh.readOnlyCheck = true
+	reason, err = mh.Check(topodatapb.TabletType_MASTER)
+	assert.Equal(t, "", reason)
+	assert.NoError(t, err)
+	reason, err = mh.Check(topodatapb.TabletType_REPLICA)
+	assert.Equal(t, unhealthyWritable, reason)
+	assert.EqualError(t, err, "MySQL read_only is off for a REPLICA tablet")
+
+	setReadOnly("1")
+	reason, err = mh.Check(topodatapb.TabletType_MASTER)
+	assert.Equal(t, unhealthyReadOnly, reason)
+	assert.EqualError(t, err, "MySQL read_only is on for a MASTER tablet")
+	reason, err = mh.Check(topodatapb.TabletType_RDONLY)
+	assert.Equal(t, "", reason)
+	assert.NoError(t, err)
+	// Other tablet types are not checked.
+	reason, err = mh.Check(topodatapb.TabletType_SPARE)
+	assert.Equal(t, "", reason)
+	assert.NoError(t, err)
+
+	var gotPath string
+	mh.minFreeDiskPercent = 10
+	mh.freeDiskPercent = func(path string) (float64, error) {
+		gotPath = path
+		return 5, nil
+	}
+	reason, err = mh.Check(topodatapb.TabletType_REPLICA)
+	assert.Equal(t, unhealthyDiskSpace, reason)
+	assert.EqualError(t, err, "5.0% free disk space on /vt/data, below 10.0%")
+	assert.Equal(t, "/vt/data", gotPath)
+
+	mh.diskPath = "/vt/binlogs"
+	mh.minFreeDiskPercent = 5
+	reason, err = mh.Check(topodatapb.TabletType_REPLICA)
+	assert.Equal(t, "", reason)
+	assert.NoError(t, err)
+	assert.Equal(t, "/vt/binlogs", gotPath)
+}
+
+func TestFreeDiskPercent(t *testing.T) {
+	free, err := freeDiskPercent(t.TempDir())
+	assert.NoError(t, err)
+	assert.True(t, free >= 0 && free <= 100, free)
+
+	_, err = freeDiskPercent("/nonexistent/path")
+	assert.Error(t, err)
+}
@@ -18,6 +18,7 @@ package planbuilder
 
 import (
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
@@ -55,15 +56,96 @@ func analyzeSelect(sel *sqlparser.Select, tables map[string]*schema.Table) (plan
 		plan.NextCount = v
 		plan.FieldQuery = nil
 		plan.FullQuery = nil
+		return plan, nil
+	}
+	if hasDerivedTable(sel.From) {
+		plan.PlanID = PlanSelectDerived
 	}
+	plan.CacheTTL = cacheTTL(sel)
+	plan.AllowTruncatedResult = sqlparser.ExtractCommentDirectives(sel.Comments).IsSet(sqlparser.DirectiveAllowTruncatedResult)
 	return plan, nil
 }
 
+// analyzeUnion builds the plan of a UNION. The comments of a UNION are
+// parsed into its first select, so its directives are read from there.
+func analyzeUnion(union *sqlparser.Union) *Plan {
+	plan := &Plan{
+		PlanID:     PlanUnion,
+		FieldQuery: GenerateFieldQuery(union),
+		FullQuery:  GenerateLimitQuery(union),
+	}
+	sel := firstSelect(union)
+	if sel == nil {
+		return plan
+	}
+	if union.Lock == sqlparser.NoLock {
+		plan.CacheTTL = cacheTTL(sel)
+	}
+	plan.AllowTruncatedResult = sqlparser.ExtractCommentDirectives(sel.Comments).IsSet(sqlparser.DirectiveAllowTruncatedResult)
+	return plan
+}
+
+// firstSelect returns the leftmost select of stmt.
+func firstSelect(stmt sqlparser.SelectStatement) *sqlparser.Select {
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		return stmt
+	case *sqlparser.Union:
+		return firstSelect(stmt.FirstStatement)
+	case *sqlparser.ParenSelect:
+		return firstSelect(stmt.Select)
+	}
+	return nil
+}
+
+// hasDerivedTable returns true if one of the tables of a FROM clause,
+// including the ones of its joins, is a derived table.
+func hasDerivedTable(exprs sqlparser.TableExprs) bool {
+	for _, expr := range exprs {
+		switch expr := expr.(type) {
+		case *sqlparser.AliasedTableExpr:
+			if _, ok := expr.Expr.(*sqlparser.DerivedTable); ok {
+				return true
+			}
+		case *sqlparser.ParenTableExpr:
+			if hasDerivedTable(expr.Exprs) {
+				return true
+			}
+		case *sqlparser.JoinTableExpr:
+			if hasDerivedTable(sqlparser.TableExprs{expr.LeftExpr, expr.RightExpr}) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// cacheTTL returns the duration of the CACHEABLE_FOR directive of sel, or
+// 0 if the result must not be cached. The duration is either a number of
+// seconds or a duration string like 5s.
+func cacheTTL(sel *sqlparser.Select) time.Duration {
+	if sel.Lock != sqlparser.NoLock || sel.Into != nil {
+		return 0
+	}
+	var ttl time.Duration
+	switch v := sqlparser.ExtractCommentDirectives(sel.Comments)[sqlparser.DirectiveCacheableFor].(type) {
+	case int:
+		ttl = time.Duration(v) * time.Second
+	case string:
+		ttl, _ = time.ParseDuration(v)
+	}
+	if ttl < 0 {
+		return 0
+	}
+	return ttl
+}
+
 // analyzeUpdate code is almost identical to analyzeDelete.
 func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
-		PlanID: PlanUpdate,
-		Table:  lookupTable(upd.TableExprs, tables),
+		PlanID:         PlanUpdate,
+		Table:          lookupTable(upd.TableExprs, tables),
+		AllowUnsafeDML: sqlparser.ExtractCommentDirectives(upd.Comments).IsSet(sqlparser.DirectiveAllowUnsafeDML),
 	}
 
 	// Store the WHERE clause as string for the hot row protection (txserializer).
@@ -92,8 +174,9 @@ func analyzeUpdate(upd *sqlparser.Update, tables map[string]*schema.Table) (plan
 // analyzeDelete code is almost identical to analyzeUpdate.
 func analyzeDelete(del *sqlparser.Delete, tables map[string]*schema.Table) (plan *Plan, err error) {
 	plan = &Plan{
-		PlanID: PlanDelete,
-		Table:  lookupTable(del.TableExprs, tables),
+		PlanID:         PlanDelete,
+		Table:          lookupTable(del.TableExprs, tables),
+		AllowUnsafeDML: sqlparser.ExtractCommentDirectives(del.Comments).IsSet(sqlparser.DirectiveAllowUnsafeDML),
 	}
 
 	if del.Where != nil {
@@ -118,6 +201,9 @@ func analyzeInsert(ins *sqlparser.Insert, tables map[string]*schema.Table) (plan
 		PlanID:    PlanInsert,
 		FullQuery: GenerateFullQuery(ins),
 	}
+	if _, ok := ins.Rows.(sqlparser.SelectStatement); ok {
+		plan.PlanID = PlanInsertSelect
+	}
 
 	tableName := sqlparser.GetTableName(ins.Table)
 	plan.Table = tables[tableName.String()]
@@ -39,7 +39,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(168)
+		size += int64(216)
 	}
 	// field Table *vitess.io/vitess/go/vt/vttablet/tabletserver/schema.Table
 	size += cached.Table.CachedSize(true)
@@ -62,5 +62,7 @@ func (cached *Plan) CachedSize(alloc bool) int64 {
 	if cc, ok := cached.FullStmt.(cachedObject); ok {
 		size += cc.CachedSize(true)
 	}
+	// field Workload string
+	size += int64(len(cached.Workload))
 	return size
 }
@@ -18,7 +18,9 @@ package planbuilder
 
 import (
 	"encoding/json"
+	"strconv"
 	"strings"
+	"time"
 
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/vt/sqlparser"
@@ -73,6 +75,12 @@ const (
 	PlanUnlockTables
 	PlanCallProc
 	PlanAlterMigration
+	// PlanUnion is for UNION statements.
+	PlanUnion
+	// PlanSelectDerived is for selects from derived tables.
+	PlanSelectDerived
+	// PlanInsertSelect is for INSERT ... SELECT statements.
+	PlanInsertSelect
 	NumPlans
 )
 
@@ -103,6 +111,9 @@ var planName = []string{
 	"UnlockTables",
 	"CallProcedure",
 	"AlterMigration",
+	"Union",
+	"SelectDerived",
+	"InsertSelect",
 }
 
 func (pt PlanType) String() string {
@@ -134,7 +145,11 @@ func PlanByNameIC(s string) (pt PlanType, ok bool) {
 
 // IsSelect returns true if PlanType is about a select query.
 func (pt PlanType) IsSelect() bool {
-	return pt == PlanSelect || pt == PlanSelectImpossible
+	switch pt {
+	case PlanSelect, PlanSelectImpossible, PlanUnion, PlanSelectDerived:
+		return true
+	}
+	return false
 }
 
 // MarshalJSON returns a json string for PlanType.
@@ -167,8 +182,36 @@ type Plan struct {
 
 	// FullStmt can be used when the query does not operate on tables
 	FullStmt sqlparser.Statement
+
+	// EstimatedRows is an upper bound of the number of rows returned
+	// or affected by the query. It is UnboundedRows if there is no bound.
+	EstimatedRows int64
+
+	// CacheTTL is how long the result of a select may be cached,
+	// as set by the CACHEABLE_FOR directive. 0 means no caching.
+	CacheTTL time.Duration
+
+	// AllowTruncatedResult is set by the ALLOW_TRUNCATED_RESULT directive.
+	// The select then returns its first rows if it exceeds the max result
+	// size instead of failing.
+	AllowTruncatedResult bool
+
+	// AllowUnsafeDML is set by the ALLOW_UNSAFE_DML directive. The update
+	// or delete then runs even if vttablet rejects unsafe DMLs.
+	AllowUnsafeDML bool
+
+	// Workload is the workload of the query in the per-workload stats,
+	// as set by the WORKLOAD directive.
+	Workload string
+
+	// MaxExecutionTimeHint is set if the select has its own
+	// MAX_EXECUTION_TIME optimizer hint, which vttablet then keeps.
+	MaxExecutionTimeHint bool
 }
 
+// UnboundedRows is the EstimatedRows of queries without a row limit.
+const UnboundedRows = int64(-1)
+
 // TableName returns the table name for the plan.
 func (plan *Plan) TableName() sqlparser.TableIdent {
 	var tableName sqlparser.TableIdent
@@ -187,13 +230,13 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		}
 	}
 
+	// The estimate must be computed before the analyzers modify the
+	// statement.
+	estimatedRows := estimateRows(statement)
+
 	switch stmt := statement.(type) {
 	case *sqlparser.Union:
-		plan, err = &Plan{
-			PlanID:     PlanSelect,
-			FieldQuery: GenerateFieldQuery(stmt),
-			FullQuery:  GenerateLimitQuery(stmt),
-		}, nil
+		plan, err = analyzeUnion(stmt), nil
 	case *sqlparser.Select:
 		plan, err = analyzeSelect(stmt, tables)
 	case *sqlparser.Insert:
@@ -218,7 +261,7 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		plan, err = &Plan{PlanID: PlanAlterMigration, FullStmt: stmt}, nil
 	case *sqlparser.Show:
 		plan, err = analyzeShow(stmt, dbName)
-	case *sqlparser.OtherRead, sqlparser.Explain:
+	case *sqlparser.OtherRead, *sqlparser.AnalyzeTable, sqlparser.Explain:
 		plan, err = &Plan{PlanID: PlanOtherRead}, nil
 	case *sqlparser.OtherAdmin:
 		plan, err = &Plan{PlanID: PlanOtherAdmin}, nil
@@ -241,9 +284,53 @@ func Build(statement sqlparser.Statement, tables map[string]*schema.Table, isRes
 		return nil, err
 	}
 	plan.Permissions = BuildPermissions(statement)
+	plan.EstimatedRows = estimatedRows
+	plan.Workload = sqlparser.WorkloadDirective(statement)
+	plan.MaxExecutionTimeHint = sqlparser.MaxExecutionTimeHint(statement)
 	return plan, nil
 }
 
+// estimateRows returns the max number of rows returned or affected by a
+// statement. SELECT, UPDATE and DELETE statements are only bounded by a
+// literal LIMIT. Statements which do not return or affect rows have an
+// estimate of 0.
+func estimateRows(statement sqlparser.Statement) int64 {
+	switch stmt := statement.(type) {
+	case *sqlparser.Select:
+		return limitRows(stmt.Limit)
+	case *sqlparser.Union:
+		return limitRows(stmt.Limit)
+	case *sqlparser.Update:
+		return limitRows(stmt.Limit)
+	case *sqlparser.Delete:
+		return limitRows(stmt.Limit)
+	case *sqlparser.Insert:
+		switch rows := stmt.Rows.(type) {
+		case sqlparser.Values:
+			return int64(len(rows))
+		case sqlparser.SelectStatement:
+			return estimateRows(rows)
+		}
+		return UnboundedRows
+	}
+	return 0
+}
+
+func limitRows(limit *sqlparser.Limit) int64 {
+	if limit == nil {
+		return UnboundedRows
+	}
+	lit, ok := limit.Rowcount.(*sqlparser.Literal)
+	if !ok || lit.Type != sqlparser.IntVal {
+		return UnboundedRows
+	}
+	n, err := strconv.ParseInt(lit.Val, 10, 64)
+	if err != nil {
+		return UnboundedRows
+	}
+	return n
+}
+
 // BuildStreaming builds a streaming plan based on the schema.
 func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn bool) (*Plan, error) {
 	statement, err := sqlparser.Parse(sql)
@@ -259,9 +346,13 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 	}
 
 	plan := &Plan{
-		PlanID:      PlanSelectStream,
-		FullQuery:   GenerateFullQuery(statement),
-		Permissions: BuildPermissions(statement),
+		PlanID:        PlanSelectStream,
+		FullQuery:     GenerateFullQuery(statement),
+		Permissions:   BuildPermissions(statement),
+		EstimatedRows: estimateRows(statement),
+		Workload:      sqlparser.WorkloadDirective(statement),
+
+		MaxExecutionTimeHint: sqlparser.MaxExecutionTimeHint(statement),
 	}
 
 	switch stmt := statement.(type) {
@@ -282,8 +373,9 @@ func BuildStreaming(sql string, tables map[string]*schema.Table, isReservedConn
 // BuildMessageStreaming builds a plan for message streaming.
 func BuildMessageStreaming(name string, tables map[string]*schema.Table) (*Plan, error) {
 	plan := &Plan{
-		PlanID: PlanMessageStream,
-		Table:  tables[name],
+		PlanID:        PlanMessageStream,
+		Table:         tables[name],
+		EstimatedRows: UnboundedRows,
 	}
 	if plan.Table == nil {
 		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "table %s not found in schema", name)
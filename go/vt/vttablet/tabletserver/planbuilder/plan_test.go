@@ -28,6 +28,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -268,6 +269,68 @@ func TestMessageStreamingPlan(t *testing.T) {
 	}
 }
 
+func TestEstimatedRows(t *testing.T) {
+	testSchema := loadSchema("schema_test.json")
+	testcases := []struct {
+		query string
+		want  int64
+	}{
+		{"select * from a", UnboundedRows},
+		{"select * from a limit 10", 10},
+		{"select * from a limit 5, 10", 10},
+		{"select * from a limit :n", UnboundedRows},
+		{"select * from a union select * from b limit 20", 20},
+		{"update a set name = 1 where eid = 1", UnboundedRows},
+		{"update a set name = 1 where eid = 1 limit 2", 2},
+		{"delete from a limit 3", 3},
+		{"insert into a(eid, id) values (1, 2), (3, 4)", 2},
+		{"insert into a(eid, id) select eid, id from b limit 7", 7},
+		{"create table b (id int)", 0},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			statement, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			plan, err := Build(statement, testSchema, false, "dbName")
+			require.NoError(t, err)
+			require.Equal(t, tc.want, plan.EstimatedRows)
+		})
+	}
+
+	plan, err := BuildStreaming("select * from a limit 10", testSchema, false)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), plan.EstimatedRows)
+}
+
+func TestCacheTTL(t *testing.T) {
+	testSchema := loadSchema("schema_test.json")
+	testcases := []struct {
+		query string
+		want  time.Duration
+	}{
+		{"select * from a", 0},
+		{"select /*vt+ CACHEABLE_FOR=5s */ * from a", 5 * time.Second},
+		{"select /*vt+ CACHEABLE_FOR=2 */ * from a", 2 * time.Second},
+		{"select /*vt+ CACHEABLE_FOR=soon */ * from a", 0},
+		{"select /*vt+ CACHEABLE_FOR=-1s */ * from a", 0},
+		{"select /*vt+ CACHEABLE_FOR=5s */ * from a for update", 0},
+		{"select /*vt+ CACHEABLE_FOR=5s */ * from a lock in share mode", 0},
+		{"update /*vt+ CACHEABLE_FOR=5s */ a set name = 1", 0},
+		{"select /*vt+ CACHEABLE_FOR=5s */ * from a union select * from b", 5 * time.Second},
+		{"select /*vt+ CACHEABLE_FOR=5s */ * from a union select * from b for update", 0},
+		{"select /*vt+ CACHEABLE_FOR=5s */ * from (select * from a) as t", 5 * time.Second},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			statement, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			plan, err := Build(statement, testSchema, false, "dbName")
+			require.NoError(t, err)
+			require.Equal(t, tc.want, plan.CacheTTL)
+		})
+	}
+}
+
 func loadSchema(name string) map[string]*schema.Table {
 	b, err := ioutil.ReadFile(locateFile(name))
 	if err != nil {
@@ -364,3 +427,63 @@ func iterateExecFile(name string) (testCaseIterator chan testCase) {
 func locateFile(name string) string {
 	return "testdata/" + name
 }
+
+func TestAllowTruncatedResult(t *testing.T) {
+	testSchema := loadSchema("schema_test.json")
+	testcases := []struct {
+		query string
+		want  bool
+	}{
+		{"select * from a", false},
+		{"select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from a", true},
+		{"select /*vt+ ALLOW_TRUNCATED_RESULT=0 */ * from a", false},
+		{"update /*vt+ ALLOW_TRUNCATED_RESULT=1 */ a set name = 1", false},
+		{"select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from a union select * from b", true},
+		{"select * from a union select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from b", false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			statement, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			plan, err := Build(statement, testSchema, false, "dbName")
+			require.NoError(t, err)
+			require.Equal(t, tc.want, plan.AllowTruncatedResult)
+		})
+	}
+}
+
+func TestAllowUnsafeDML(t *testing.T) {
+	testSchema := loadSchema("schema_test.json")
+	testcases := []struct {
+		query string
+		want  bool
+	}{
+		{"update a set name = 1", false},
+		{"update /*vt+ ALLOW_UNSAFE_DML */ a set name = 1", true},
+		{"update /*vt+ ALLOW_UNSAFE_DML=0 */ a set name = 1", false},
+		{"delete /*vt+ ALLOW_UNSAFE_DML */ from a", true},
+		{"select /*vt+ ALLOW_UNSAFE_DML */ * from a", false},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			statement, err := sqlparser.Parse(tc.query)
+			require.NoError(t, err)
+			plan, err := Build(statement, testSchema, false, "dbName")
+			require.NoError(t, err)
+			require.Equal(t, tc.want, plan.AllowUnsafeDML)
+		})
+	}
+}
+
+func TestWorkload(t *testing.T) {
+	testSchema := loadSchema("schema_test.json")
+	statement, err := sqlparser.Parse("select /*vt+ WORKLOAD=billing */ * from a")
+	require.NoError(t, err)
+	plan, err := Build(statement, testSchema, false, "dbName")
+	require.NoError(t, err)
+	require.Equal(t, "billing", plan.Workload)
+
+	plan, err = BuildStreaming("select /*vt+ WORKLOAD=billing */ * from a", testSchema, false)
+	require.NoError(t, err)
+	require.Equal(t, "billing", plan.Workload)
+}
@@ -67,6 +67,9 @@ type TabletPlan struct {
 	RowsAffected uint64
 	RowsReturned uint64
 	ErrorCount   uint64
+	// ResultBytes is the sum of the peak memory held by the results of the
+	// queries, when the memory admission is enabled.
+	ResultBytes uint64
 }
 
 // AddStats updates the stats for the current TabletPlan.
@@ -90,6 +93,28 @@ func (ep *TabletPlan) Stats() (queryCount uint64, duration, mysqlTime time.Durat
 	return
 }
 
+// readTables returns the tables read by the plan.
+func (ep *TabletPlan) readTables() []string {
+	var tables []string
+	for _, perm := range ep.Permissions {
+		if perm.Role == tableacl.READER {
+			tables = append(tables, perm.TableName)
+		}
+	}
+	return tables
+}
+
+// writtenTables returns the tables written or altered by the plan.
+func (ep *TabletPlan) writtenTables() []string {
+	var tables []string
+	for _, perm := range ep.Permissions {
+		if perm.Role != tableacl.READER {
+			tables = append(tables, perm.TableName)
+		}
+	}
+	return tables
+}
+
 // buildAuthorized builds 'Authorized', which is the runtime part for 'Permissions'.
 func (ep *TabletPlan) buildAuthorized() {
 	ep.Authorized = make([]*tableacl.ACLResult, len(ep.Permissions))
@@ -118,12 +143,19 @@ type QueryEngine struct {
 	plans            cache.Cache
 	queryRuleSources *rules.Map
 
+	// schemaGen is incremented by schemaChanged when it clears the
+	// plans, so that GetPlan doesn't keep a plan of the old schema.
+	schemaGen sync2.AtomicInt64
+
 	// Pools
 	conns       *connpool.Pool
 	streamConns *connpool.Pool
 
 	// Services
 	consolidator *sync2.Consolidator
+	// resultCache is nil if the result cache is disabled.
+	resultCache *resultCache
+	queryMemory *queryMemory
 	// txSerializer protects vttablet from applications which try to concurrently
 	// UPDATE (or DELETE) a "hot" row (or range of rows).
 	// Such queries would be serialized by MySQL anyway. This serializer prevents
@@ -145,14 +177,23 @@ type QueryEngine struct {
 
 	strictTransTables bool
 
+	// maxExecutionTimeHint makes the SELECTs with a deadline carry a
+	// MAX_EXECUTION_TIME hint.
+	maxExecutionTimeHint bool
+
 	consolidatorMode            sync2.AtomicString
 	enableQueryPlanFieldCaching bool
 
 	// stats
 	queryCounts, queryTimes, queryRowCounts, queryErrorCounts *stats.CountersWithMultiLabels
+	queryRuleThrottled, queryRuleMonitorMatches               *stats.CountersWithSingleLabel
+	// planInvalidations counts the plans invalidated through the API, by
+	// scope of the invalidation.
+	planInvalidations *stats.CountersWithSingleLabel
 
 	// Loggers
 	accessCheckerLogger *logutil.ThrottledLogger
+	ruleMonitorLogger   *logutil.ThrottledLogger
 }
 
 // NewQueryEngine creates a new QueryEngine.
@@ -164,6 +205,8 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 		MaxEntries:     int64(config.QueryCacheSize),
 		MaxMemoryUsage: config.QueryCacheMemory,
 		LFU:            config.QueryCacheLFU,
+		LRUMemoryBased: config.QueryCacheLRUMemoryBased,
+		TTL:            config.QueryCacheTTLSeconds.Get(),
 	}
 
 	qe := &QueryEngine{
@@ -179,12 +222,15 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	qe.consolidatorMode.Set(config.Consolidator)
 	qe.enableQueryPlanFieldCaching = config.CacheResultFields
 	qe.consolidator = sync2.NewConsolidator()
+	qe.resultCache = newResultCache(env, config.ResultCacheSize)
+	qe.queryMemory = newQueryMemory(env)
 	qe.txSerializer = txserializer.New(env)
 
 	qe.strictTableACL = config.StrictTableACL
 	qe.enableTableACLDryRun = config.EnableTableACLDryRun
 
 	qe.strictTransTables = config.EnforceStrictTransTables
+	qe.maxExecutionTimeHint = config.EnableMaxExecutionTimeHint
 
 	if config.TableACLExemptACL != "" {
 		if f, err := tableacl.GetCurrentACLFactory(); err == nil {
@@ -206,6 +252,7 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	planbuilder.PassthroughDMLs = config.PassthroughDML
 
 	qe.accessCheckerLogger = logutil.NewThrottledLogger("accessChecker", 1*time.Second)
+	qe.ruleMonitorLogger = logutil.NewThrottledLogger("ruleMonitor", 1*time.Second)
 
 	env.Exporter().NewGaugeFunc("MaxResultSize", "Query engine max result size", qe.maxResultSize.Get)
 	env.Exporter().NewGaugeFunc("WarnResultSize", "Query engine warn result size", qe.warnResultSize.Get)
@@ -218,15 +265,22 @@ func NewQueryEngine(env tabletenv.Env, se *schema.Engine) *QueryEngine {
 	env.Exporter().NewGaugeFunc("QueryCacheSize", "Query engine query cache size", qe.plans.UsedCapacity)
 	env.Exporter().NewGaugeFunc("QueryCacheCapacity", "Query engine query cache capacity", qe.plans.MaxCapacity)
 	env.Exporter().NewCounterFunc("QueryCacheEvictions", "Query engine query cache evictions", qe.plans.Evictions)
+	env.Exporter().NewCounterFunc("QueryCacheHits", "Query engine query cache hits", qe.plans.Hits)
+	env.Exporter().NewCounterFunc("QueryCacheMisses", "Query engine query cache misses", qe.plans.Misses)
 	qe.queryCounts = env.Exporter().NewCountersWithMultiLabels("QueryCounts", "query counts", []string{"Table", "Plan"})
 	qe.queryTimes = env.Exporter().NewCountersWithMultiLabels("QueryTimesNs", "query times in ns", []string{"Table", "Plan"})
 	qe.queryRowCounts = env.Exporter().NewCountersWithMultiLabels("QueryRowCounts", "query row counts", []string{"Table", "Plan"})
 	qe.queryErrorCounts = env.Exporter().NewCountersWithMultiLabels("QueryErrorCounts", "query error counts", []string{"Table", "Plan"})
+	qe.queryRuleThrottled = env.Exporter().NewCountersWithSingleLabel("QueryRuleThrottled", "queries throttled by rate limiting query rules", "Rule")
+	qe.queryRuleMonitorMatches = env.Exporter().NewCountersWithSingleLabel("QueryRuleMonitorMatches", "queries matched by query rules in monitor mode", "Rule")
+	qe.planInvalidations = env.Exporter().NewCountersWithSingleLabel("QueryCacheInvalidations", "Query engine query cache entries invalidated through the API", "Scope")
 
 	env.Exporter().HandleFunc("/debug/hotrows", qe.txSerializer.ServeHTTP)
 	env.Exporter().HandleFunc("/debug/tablet_plans", qe.handleHTTPQueryPlans)
 	env.Exporter().HandleFunc("/debug/query_stats", qe.handleHTTPQueryStats)
+	env.Exporter().HandleFunc("/debug/query_plans/invalidate", qe.handleHTTPInvalidatePlans)
 	env.Exporter().HandleFunc("/debug/query_rules", qe.handleHTTPQueryRules)
+	env.Exporter().HandleFunc("/debug/query_rule_sources", qe.handleHTTPQueryRuleSources)
 	env.Exporter().HandleFunc("/debug/consolidations", qe.handleHTTPConsolidations)
 	env.Exporter().HandleFunc("/debug/acl", qe.handleHTTPAclJSON)
 
@@ -285,35 +339,49 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 	span, ctx := trace.NewSpan(ctx, "QueryEngine.GetPlan")
 	defer span.Finish()
 
-	if plan := qe.getQuery(sql); plan != nil {
-		logStats.CachedPlan = true
-		return plan, nil
+	// The cached plans are returned without locking. The concurrent
+	// executions of a query which is not cached yet wait for the first one
+	// to build the plan, instead of all building it.
+	logStats.CachedPlan = true
+	var gen int64
+	plan, err := qe.plans.GetOrCompute(ctx, sql, func() (interface{}, bool, error) {
+		logStats.CachedPlan = false
+		// Obtain read lock to prevent schema from changing while we build a plan.
+		qe.mu.RLock()
+		defer qe.mu.RUnlock()
+		gen = qe.schemaGen.Get()
+		return qe.buildPlan(ctx, logStats, sql, skipQueryPlanCache, isReservedConn)
+	})
+	if err != nil {
+		return nil, err
 	}
+	// If the schema changed since the plan was built, the plans may have
+	// been cleared before it was cached: it must not outlive them.
+	if !logStats.CachedPlan && qe.schemaGen.Get() != gen {
+		qe.plans.Delete(sql)
+	}
+	return plan.(*TabletPlan), nil
+}
 
-	// Obtain read lock to prevent schema from changing while
-	// we build a plan. The read lock allows multiple identical
-	// queries to build the same plan. One of them will win by
-	// updating the query cache and prevent future races. Due to
-	// this, query stats reporting may not be accurate, but it's
-	// acceptable because those numbers are best effort.
-	qe.mu.RLock()
-	defer qe.mu.RUnlock()
+// buildPlan builds the plan of sql for GetPlan, and returns whether it
+// can be cached. The caller must hold qe.mu.
+func (qe *QueryEngine) buildPlan(ctx context.Context, logStats *tabletenv.LogStats, sql string, skipQueryPlanCache bool, isReservedConn bool) (*TabletPlan, bool, error) {
 	statement, err := sqlparser.Parse(sql)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	splan, err := planbuilder.Build(statement, qe.tables, isReservedConn, qe.env.Config().DB.DBName)
 	if err != nil {
-		return nil, err
+		return nil, false, err
 	}
 	plan := &TabletPlan{Plan: splan, Original: sql}
-	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
+	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String(), plan.EstimatedRows)
 	plan.buildAuthorized()
 	if plan.PlanID.IsSelect() {
 		if !skipQueryPlanCache && qe.enableQueryPlanFieldCaching && plan.FieldQuery != nil {
 			conn, err := qe.conns.Get(ctx)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 			defer conn.Recycle()
 
@@ -322,17 +390,14 @@ func (qe *QueryEngine) GetPlan(ctx context.Context, logStats *tabletenv.LogStats
 			r, err := conn.Exec(ctx, sql, 1, true)
 			logStats.AddRewrittenSQL(sql, start)
 			if err != nil {
-				return nil, err
+				return nil, false, err
 			}
 			plan.Fields = r.Fields
 		}
 	} else if plan.PlanID == planbuilder.PlanDDL || plan.PlanID == planbuilder.PlanSet {
-		return plan, nil
+		return plan, false, nil
 	}
-	if !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement) {
-		qe.plans.Set(sql, plan)
-	}
-	return plan, nil
+	return plan, !skipQueryPlanCache && !sqlparser.SkipQueryPlanCacheDirective(statement), nil
 }
 
 // GetStreamPlan is similar to GetPlan, but doesn't use the cache
@@ -345,7 +410,7 @@ func (qe *QueryEngine) GetStreamPlan(sql string, isReservedConn bool) (*TabletPl
 		return nil, err
 	}
 	plan := &TabletPlan{Plan: splan, Original: sql}
-	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String())
+	plan.Rules = qe.queryRuleSources.FilterByPlan(sql, plan.PlanID, plan.TableName().String(), plan.EstimatedRows)
 	plan.buildAuthorized()
 	return plan, nil
 }
@@ -359,7 +424,7 @@ func (qe *QueryEngine) GetMessageStreamPlan(name string) (*TabletPlan, error) {
 		return nil, err
 	}
 	plan := &TabletPlan{Plan: splan}
-	plan.Rules = qe.queryRuleSources.FilterByPlan("stream from "+name, plan.PlanID, plan.TableName().String())
+	plan.Rules = qe.queryRuleSources.FilterByPlan("stream from "+name, plan.PlanID, plan.TableName().String(), plan.EstimatedRows)
 	plan.buildAuthorized()
 	return plan, nil
 }
@@ -369,6 +434,50 @@ func (qe *QueryEngine) ClearQueryPlanCache() {
 	qe.plans.Clear()
 }
 
+// InvalidatePlan removes the plan of sql from the query plan cache. It
+// returns false if the plan was not cached.
+func (qe *QueryEngine) InvalidatePlan(sql string) bool {
+	qe.plans.Wait()
+	if _, ok := qe.plans.Get(sql); !ok {
+		return false
+	}
+	qe.plans.Delete(sql)
+	qe.planInvalidations.Add("Query", 1)
+	return true
+}
+
+// InvalidateTablePlans removes the plans of the queries using table from
+// the query plan cache. It returns the number of removed plans.
+func (qe *QueryEngine) InvalidateTablePlans(table string) int {
+	qe.plans.Wait()
+	var keys []string
+	qe.plans.ForEach(func(value interface{}) bool {
+		plan := value.(*TabletPlan)
+		for _, perm := range plan.Permissions {
+			if perm.TableName == table {
+				keys = append(keys, plan.Original)
+				break
+			}
+		}
+		return true
+	})
+	// The cache cannot be modified while iterating over it.
+	for _, key := range keys {
+		qe.plans.Delete(key)
+	}
+	qe.planInvalidations.Add("Table", int64(len(keys)))
+	return len(keys)
+}
+
+// InvalidateAllPlans clears the query plan cache. It returns the number of
+// removed plans.
+func (qe *QueryEngine) InvalidateAllPlans() int {
+	n := qe.QueryPlanCacheLen()
+	qe.plans.Clear()
+	qe.planInvalidations.Add("All", int64(n))
+	return n
+}
+
 // IsMySQLReachable returns an error if it cannot connect to MySQL.
 // This can be called before opening the QueryEngine.
 func (qe *QueryEngine) IsMySQLReachable() error {
@@ -388,18 +497,14 @@ func (qe *QueryEngine) schemaChanged(tables map[string]*schema.Table, created, a
 	defer qe.mu.Unlock()
 	qe.tables = tables
 	if len(altered) != 0 || len(dropped) != 0 {
+		qe.schemaGen.Add(1)
 		qe.plans.Clear()
+		if qe.resultCache != nil {
+			qe.resultCache.InvalidateSchema()
+		}
 	}
 }
 
-// getQuery fetches the plan and makes it the most recent.
-func (qe *QueryEngine) getQuery(sql string) *TabletPlan {
-	if cacheResult, ok := qe.plans.Get(sql); ok {
-		return cacheResult.(*TabletPlan)
-	}
-	return nil
-}
-
 // SetQueryPlanCacheCap sets the query plan cache capacity.
 func (qe *QueryEngine) SetQueryPlanCacheCap(size int) {
 	if size <= 0 {
@@ -439,6 +544,9 @@ type perQueryStats struct {
 	RowsAffected uint64
 	RowsReturned uint64
 	ErrorCount   uint64
+	// AvgTime and AvgMysqlTime are per query.
+	AvgTime      time.Duration
+	AvgMysqlTime time.Duration
 }
 
 func (qe *QueryEngine) handleHTTPQueryPlans(response http.ResponseWriter, request *http.Request) {
@@ -476,6 +584,10 @@ func (qe *QueryEngine) handleHTTPQueryStats(response http.ResponseWriter, reques
 		pqstats.Table = plan.TableName().String()
 		pqstats.Plan = plan.PlanID
 		pqstats.QueryCount, pqstats.Time, pqstats.MysqlTime, pqstats.RowsAffected, pqstats.RowsReturned, pqstats.ErrorCount = plan.Stats()
+		if pqstats.QueryCount != 0 {
+			pqstats.AvgTime = pqstats.Time / time.Duration(pqstats.QueryCount)
+			pqstats.AvgMysqlTime = pqstats.MysqlTime / time.Duration(pqstats.QueryCount)
+		}
 
 		qstats = append(qstats, pqstats)
 		return true
@@ -487,6 +599,45 @@ func (qe *QueryEngine) handleHTTPQueryStats(response http.ResponseWriter, reques
 	}
 }
 
+// handleHTTPInvalidatePlans removes plans from the query plan cache: the plan
+// of a query given the query parameter, the plans using a table given the
+// table parameter, or all the plans given all=true.
+func (qe *QueryEngine) handleHTTPInvalidatePlans(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.ADMIN); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	if request.Method != http.MethodPost {
+		http.Error(response, "invalidation requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := request.ParseForm(); err != nil {
+		http.Error(response, fmt.Sprintf("cannot parse form: %s", err), http.StatusBadRequest)
+		return
+	}
+	var invalidated int
+	switch {
+	case request.FormValue("query") != "":
+		if qe.InvalidatePlan(request.FormValue("query")) {
+			invalidated = 1
+		}
+	case request.FormValue("table") != "":
+		invalidated = qe.InvalidateTablePlans(request.FormValue("table"))
+	case request.FormValue("all") == "true":
+		invalidated = qe.InvalidateAllPlans()
+	default:
+		http.Error(response, "query, table or all=true is required", http.StatusBadRequest)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.Marshal(struct{ Invalidated int }{invalidated})
+	if err != nil {
+		http.Error(response, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	response.Write(b)
+}
+
 func (qe *QueryEngine) handleHTTPQueryRules(response http.ResponseWriter, request *http.Request) {
 	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
 		acl.SendError(response, err)
@@ -503,6 +654,24 @@ func (qe *QueryEngine) handleHTTPQueryRules(response http.ResponseWriter, reques
 	response.Write(buf.Bytes())
 }
 
+// handleHTTPQueryRuleSources serves the rules of every source, with the
+// verified signer of the rules if they are signed.
+func (qe *QueryEngine) handleHTTPQueryRuleSources(response http.ResponseWriter, request *http.Request) {
+	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
+		acl.SendError(response, err)
+		return
+	}
+	response.Header().Set("Content-Type", "application/json; charset=utf-8")
+	b, err := json.MarshalIndent(qe.queryRuleSources.Sources(), "", " ")
+	if err != nil {
+		response.Write([]byte(err.Error()))
+		return
+	}
+	buf := bytes.NewBuffer(nil)
+	json.HTMLEscape(buf, b)
+	response.Write(buf.Bytes())
+}
+
 func (qe *QueryEngine) handleHTTPAclJSON(response http.ResponseWriter, request *http.Request) {
 	if err := acl.CheckAccessHTTP(request, acl.DEBUGGING); err != nil {
 		acl.SendError(response, err)
@@ -18,6 +18,7 @@ package tabletserver
 
 import (
 	"context"
+	"encoding/json"
 	"expvar"
 	"fmt"
 	"math/rand"
@@ -34,6 +35,7 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/cache"
@@ -43,6 +45,7 @@ import (
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema/schematest"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -154,6 +157,7 @@ func TestGetMessageStreamPlan(t *testing.T) {
 			TableName: "msg",
 			Role:      tableacl.WRITER,
 		}},
+		EstimatedRows: planbuilder.UnboundedRows,
 	}
 	if !reflect.DeepEqual(plan.Plan, wantPlan) {
 		t.Errorf("GetMessageStreamPlan(msg): %v, want %v", plan.Plan, wantPlan)
@@ -219,6 +223,77 @@ func TestQueryPlanCache(t *testing.T) {
 	qe.ClearQueryPlanCache()
 }
 
+func TestQueryPlanCacheWithoutLock(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	query := "select * from test_table_01"
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{})
+
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	firstPlan, err := qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "GetPlanStats"), query, false, false /* inReservedConn */)
+	require.NoError(t, err)
+	qe.plans.Wait()
+
+	// A cached plan is returned while the schema is being changed.
+	qe.mu.Lock()
+	done := make(chan *TabletPlan)
+	go func() {
+		plan, err := qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "GetPlanStats"), query, false, false /* inReservedConn */)
+		assert.NoError(t, err)
+		done <- plan
+	}()
+	select {
+	case plan := <-done:
+		assert.True(t, plan == firstPlan, "cached plan was not returned")
+	case <-time.After(10 * time.Second):
+		t.Fatal("GetPlan of a cached plan waited for the schema lock")
+	}
+	qe.mu.Unlock()
+}
+
+func TestQueryPlanCacheSchemaChanged(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	query := "select * from test_table_01"
+	db.AddQuery("select * from test_table_01 where 1 != 1", &sqltypes.Result{})
+
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	// The schema changes while the plan is built: it's returned, but
+	// not cached.
+	db.SetBeforeFunc("select * from test_table_01 where 1 != 1", func() {
+		qe.schemaGen.Add(1)
+	})
+	ctx := context.Background()
+	plan, err := qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "GetPlanStats"), query, false, false /* inReservedConn */)
+	require.NoError(t, err)
+	require.NotNil(t, plan)
+	qe.plans.Wait()
+	_, ok := qe.plans.Get(query)
+	assert.False(t, ok, "plan of the old schema was cached")
+
+	db.SetBeforeFunc("select * from test_table_01 where 1 != 1", nil)
+	_, err = qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "GetPlanStats"), query, false, false /* inReservedConn */)
+	require.NoError(t, err)
+	qe.plans.Wait()
+	_, ok = qe.plans.Get(query)
+	assert.True(t, ok, "plan was not cached")
+}
+
 func TestNoQueryPlanCache(t *testing.T) {
 	db := fakesqldb.New(t)
 	defer db.Close()
@@ -309,6 +384,124 @@ func TestStatsURL(t *testing.T) {
 	qe.handleHTTPQueryRules(response, request)
 }
 
+func TestQueryRuleSourcesURL(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	qe := newTestQueryEngine(1*time.Second, true, newDBConfigs(db))
+
+	qrs := rules.New()
+	qrs.Add(rules.NewQueryRule("", "r1", rules.QRFail))
+	qe.queryRuleSources.RegisterSource("signed")
+	require.NoError(t, qe.queryRuleSources.SetRules("signed", qrs))
+	require.NoError(t, qe.queryRuleSources.SetSigner("signed", "policy"))
+
+	request, _ := http.NewRequest("GET", "/debug/query_rule_sources", nil)
+	response := httptest.NewRecorder()
+	qe.handleHTTPQueryRuleSources(response, request)
+	var got []struct {
+		Name, Signer string
+		Rules        []map[string]interface{}
+	}
+	require.NoError(t, json.Unmarshal(response.Body.Bytes(), &got))
+	var signed []string
+	for _, source := range got {
+		if source.Name == "signed" {
+			signed = append(signed, source.Signer)
+			require.Len(t, source.Rules, 1)
+			assert.Equal(t, "r1", source.Rules[0]["Name"])
+		}
+	}
+	assert.Equal(t, []string{"policy"}, signed)
+}
+
+func TestInvalidatePlans(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	queries := []string{
+		"select * from test_table_01",
+		"select * from test_table_01 where pk = 1",
+		"select * from test_table_02",
+		"select * from test_table_03",
+	}
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	warmUp := func() {
+		for _, query := range queries {
+			_, err := qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "GetPlanStats"), query, false, false /* inReservedConn */)
+			require.NoError(t, err)
+		}
+		qe.plans.Wait()
+		assertPlanCacheSize(t, qe, len(queries))
+	}
+	warmUp()
+
+	require.True(t, qe.InvalidatePlan("select * from test_table_02"))
+	require.False(t, qe.InvalidatePlan("select * from test_table_02"))
+	qe.plans.Wait()
+	assertPlanCacheSize(t, qe, 3)
+
+	require.Equal(t, 2, qe.InvalidateTablePlans("test_table_01"))
+	require.Equal(t, 0, qe.InvalidateTablePlans("test_table_01"))
+	qe.plans.Wait()
+	assertPlanCacheSize(t, qe, 1)
+
+	warmUp()
+	require.Equal(t, len(queries), qe.InvalidateAllPlans())
+	assertPlanCacheSize(t, qe, 0)
+
+	require.Equal(t, map[string]int64{"Query": 1, "Table": 2, "All": 4}, qe.planInvalidations.Counts())
+}
+
+func TestInvalidatePlansURL(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	for query, result := range schematest.Queries() {
+		db.AddQuery(query, result)
+	}
+	qe := newTestQueryEngine(10*time.Second, true, newDBConfigs(db))
+	qe.se.Open()
+	qe.Open()
+	defer qe.Close()
+
+	ctx := context.Background()
+	for _, query := range []string{"select * from test_table_01", "select * from test_table_02"} {
+		_, err := qe.GetPlan(ctx, tabletenv.NewLogStats(ctx, "GetPlanStats"), query, false, false /* inReservedConn */)
+		require.NoError(t, err)
+	}
+
+	invalidate := func(method, form string) *httptest.ResponseRecorder {
+		request, _ := http.NewRequest(method, "/debug/query_plans/invalidate", strings.NewReader(form))
+		request.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		response := httptest.NewRecorder()
+		qe.handleHTTPInvalidatePlans(response, request)
+		return response
+	}
+
+	response := invalidate("GET", "all=true")
+	require.Equal(t, http.StatusMethodNotAllowed, response.Code)
+
+	response = invalidate("POST", "")
+	require.Equal(t, http.StatusBadRequest, response.Code)
+
+	response = invalidate("POST", "table=test_table_01")
+	require.Equal(t, http.StatusOK, response.Code)
+	require.Equal(t, `{"Invalidated":1}`, response.Body.String())
+
+	response = invalidate("POST", "query=select+*+from+test_table_01")
+	require.Equal(t, `{"Invalidated":0}`, response.Body.String())
+
+	response = invalidate("POST", "all=true")
+	require.Equal(t, `{"Invalidated":1}`, response.Body.String())
+	assertPlanCacheSize(t, qe, 0)
+}
+
 func newTestQueryEngine(idleTimeout time.Duration, strict bool, dbcfgs *dbconfigs.DBConfigs) *QueryEngine {
 	config := tabletenv.NewDefaultConfig()
 	config.DB = dbcfgs
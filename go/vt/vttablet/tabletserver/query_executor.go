@@ -57,6 +57,12 @@ type QueryExecutor struct {
 	logStats       *tabletenv.LogStats
 	tsv            *TabletServer
 	tabletType     topodatapb.TabletType
+
+	// rewrite is set by a matching QRRewrite rule.
+	rewrite *rules.Rewrite
+
+	// memTracker accounts for the memory held by the results of the query.
+	memTracker *queryMemoryTracker
 }
 
 var sequenceFields = []*querypb.Field{
@@ -70,10 +76,14 @@ var sequenceFields = []*querypb.Field{
 func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	planName := qre.plan.PlanID.String()
 	qre.logStats.PlanType = planName
+	qre.setWorkload()
 	defer func(start time.Time) {
 		duration := time.Since(start)
-		qre.tsv.stats.QueryTimings.Add(planName, duration)
+		qre.tsv.stats.QueryTimings.AddWithExemplar(planName, duration, func() string {
+			return sqlparser.Digest(qre.query)
+		})
 		qre.recordUserQuery("Execute", int64(duration))
+		qre.recordWorkloadQuery(planName, duration)
 
 		mysqlTime := qre.logStats.MysqlResponseTime
 		tableName := qre.plan.TableName().String()
@@ -81,6 +91,13 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 			tableName = "Join"
 		}
 
+		// Failed writes may have written some rows too.
+		if qre.tsv.qe.resultCache != nil {
+			if tables := qre.plan.writtenTables(); len(tables) != 0 {
+				qre.tsv.qe.resultCache.InvalidateTables(tables)
+			}
+		}
+
 		if reply == nil {
 			qre.tsv.qe.AddStats(planName, tableName, 1, duration, mysqlTime, 0, 1)
 			qre.plan.AddStats(1, duration, mysqlTime, 0, 0, 1)
@@ -93,9 +110,17 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		qre.tsv.Stats().ResultHistogram.Add(int64(len(reply.Rows)))
 	}(time.Now())
 
-	if err := qre.checkPermissions(); err != nil {
+	release, err := qre.checkPermissions()
+	if err != nil {
 		return nil, err
 	}
+	defer release()
+	reserved, err := qre.admitMemory(false)
+	if err != nil {
+		return nil, err
+	}
+	qre.memTracker = qre.tsv.qe.queryMemory.newTracker(qre.plan, reserved)
+	defer qre.closeMemTracker()
 
 	switch qre.plan.PlanID {
 	case p.PlanNextval:
@@ -121,7 +146,7 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 	}
 
 	switch qre.plan.PlanID {
-	case p.PlanSelect, p.PlanSelectImpossible, p.PlanShow:
+	case p.PlanSelect, p.PlanSelectImpossible, p.PlanUnion, p.PlanSelectDerived, p.PlanShow:
 		maxrows := qre.getSelectLimit()
 		qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
 		if qre.bindVars[sqltypes.BvReplaceSchemaName] != nil {
@@ -131,6 +156,8 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		if err != nil {
 			return nil, err
 		}
+		qre.memTracker.addUnread(qr)
+		qr = qre.truncateRows(qr, maxrows)
 		if err := qre.verifyRowCount(int64(len(qr.Rows)), maxrows); err != nil {
 			return nil, err
 		}
@@ -139,7 +166,14 @@ func (qre *QueryExecutor) Execute() (reply *sqltypes.Result, err error) {
 		return qre.execOther()
 	case p.PlanSavepoint, p.PlanRelease, p.PlanSRollback:
 		return qre.execOther()
-	case p.PlanInsert, p.PlanUpdate, p.PlanDelete, p.PlanInsertMessage, p.PlanDDL, p.PlanLoad:
+	case p.PlanUpdate, p.PlanDelete:
+		// A DML affecting too many rows can only be rolled back
+		// within a transaction.
+		if qre.maxDMLRows() > 0 {
+			return qre.execAsTransaction(qre.txConnExec)
+		}
+		return qre.execAutocommit(qre.txConnExec)
+	case p.PlanInsert, p.PlanInsertSelect, p.PlanInsertMessage, p.PlanDDL, p.PlanLoad:
 		return qre.execAutocommit(qre.txConnExec)
 	case p.PlanUpdateLimit, p.PlanDeleteLimit:
 		return qre.execAsTransaction(qre.txConnExec)
@@ -196,19 +230,28 @@ func (qre *QueryExecutor) execAsTransaction(f func(conn *StatefulConnection) (*s
 }
 
 func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result, error) {
+	qre.tsv.te.txPool.startWrites(conn, qre.plan.writtenTables())
 	switch qre.plan.PlanID {
-	case p.PlanInsert, p.PlanUpdate, p.PlanDelete, p.PlanSet:
+	case p.PlanInsert, p.PlanInsertSelect, p.PlanSet:
 		return qre.txFetch(conn, true)
 	case p.PlanInsertMessage:
 		qre.bindVars["#time_now"] = sqltypes.Int64BindVariable(time.Now().UnixNano())
 		return qre.txFetch(conn, true)
+	case p.PlanUpdate, p.PlanDelete:
+		if err := qre.checkUnsafeDML(); err != nil {
+			return nil, err
+		}
+		return qre.execDML(conn)
 	case p.PlanUpdateLimit, p.PlanDeleteLimit:
+		if err := qre.checkUnsafeDML(); err != nil {
+			return nil, err
+		}
 		return qre.execDMLLimit(conn)
 	case p.PlanOtherRead, p.PlanOtherAdmin, p.PlanFlush:
 		return qre.execStatefulConn(conn, qre.query, true)
 	case p.PlanSavepoint, p.PlanRelease, p.PlanSRollback:
 		return qre.execStatefulConn(conn, qre.query, true)
-	case p.PlanSelect, p.PlanSelectImpossible, p.PlanShow:
+	case p.PlanSelect, p.PlanSelectImpossible, p.PlanUnion, p.PlanSelectDerived, p.PlanShow:
 		maxrows := qre.getSelectLimit()
 		qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
 		if qre.bindVars[sqltypes.BvReplaceSchemaName] != nil {
@@ -218,6 +261,8 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 		if err != nil {
 			return nil, err
 		}
+		qre.memTracker.addUnread(qr)
+		qr = qre.truncateRows(qr, maxrows)
 		if err := qre.verifyRowCount(int64(len(qr.Rows)), maxrows); err != nil {
 			return nil, err
 		}
@@ -235,15 +280,33 @@ func (qre *QueryExecutor) txConnExec(conn *StatefulConnection) (*sqltypes.Result
 // Stream performs a streaming query execution.
 func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 	qre.logStats.PlanType = qre.plan.PlanID.String()
+	qre.setWorkload()
 
 	defer func(start time.Time) {
 		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
 		qre.recordUserQuery("Stream", int64(time.Since(start)))
+		qre.recordWorkloadQuery(qre.plan.PlanID.String(), time.Since(start))
 	}(time.Now())
 
-	if err := qre.checkPermissions(); err != nil {
+	release, err := qre.checkPermissions()
+	if err != nil {
 		return err
 	}
+	defer release()
+	reserved, err := qre.admitMemory(true)
+	if err != nil {
+		return err
+	}
+	qre.memTracker = qre.tsv.qe.queryMemory.newTracker(qre.plan, reserved)
+	defer qre.memTracker.close()
+	if qre.memTracker != nil {
+		send := callback
+		callback = func(result *sqltypes.Result) error {
+			n := qre.memTracker.add(result)
+			defer qre.memTracker.release(n)
+			return send(result)
+		}
+	}
 
 	// if we have a transaction id, let's use the txPool for this query
 	var conn *connpool.DBConn
@@ -274,15 +337,19 @@ func (qre *QueryExecutor) Stream(callback func(*sqltypes.Result) error) error {
 func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) error {
 	qre.logStats.OriginalSQL = qre.query
 	qre.logStats.PlanType = qre.plan.PlanID.String()
+	qre.setWorkload()
 
 	defer func(start time.Time) {
 		qre.tsv.stats.QueryTimings.Record(qre.plan.PlanID.String(), start)
 		qre.recordUserQuery("MessageStream", int64(time.Since(start)))
+		qre.recordWorkloadQuery(qre.plan.PlanID.String(), time.Since(start))
 	}(time.Now())
 
-	if err := qre.checkPermissions(); err != nil {
+	release, err := qre.checkPermissions()
+	if err != nil {
 		return err
 	}
+	defer release()
 
 	done, err := qre.tsv.messager.Subscribe(qre.ctx, qre.plan.TableName().String(), func(r *sqltypes.Result) error {
 		select {
@@ -299,15 +366,91 @@ func (qre *QueryExecutor) MessageStream(callback func(*sqltypes.Result) error) e
 	return nil
 }
 
+// admitMemory queues or rejects an expensive query while the results in
+// flight hold more memory than the admission threshold. It returns the
+// memory reserved for the results of an admitted query.
+func (qre *QueryExecutor) admitMemory(streaming bool) (int64, error) {
+	qm := qre.tsv.qe.queryMemory
+	if !qm.enabled() || tabletenv.IsLocalContext(qre.ctx) || !qm.expensive(qre.plan, streaming) {
+		return 0, nil
+	}
+	reserve := qm.estimate(qre.plan, streaming, qre.tsv.qe.streamBufferSize.Get())
+	if err := qm.admit(qre.ctx, reserve); err != nil {
+		return 0, err
+	}
+	return reserve, nil
+}
+
+// closeMemTracker releases the memory held by the result of the query once
+// its response is sent, if the caller of the tablet server sends it.
+// Otherwise, the memory is released right away.
+func (qre *QueryExecutor) closeMemTracker() {
+	if qre.memTracker == nil {
+		return
+	}
+	if resp := tabletenv.ResponseFromContext(qre.ctx); resp != nil {
+		resp.OnSent(qre.memTracker.close)
+		return
+	}
+	qre.memTracker.close()
+}
+
+// tracksRead returns true if the result of the query is read in streaming
+// mode, to account for its memory as it is read from MySQL.
+func (qre *QueryExecutor) tracksRead() bool {
+	return qre.memTracker != nil && (qre.plan.PlanID.IsSelect() || qre.plan.PlanID == p.PlanShow)
+}
+
+// readTracked returns the result that stream reads, like Exec would with
+// the same maxrows and wantfields, while accounting for the memory of its
+// rows as they are read.
+func (qre *QueryExecutor) readTracked(stream func(callback func(*sqltypes.Result) error) error, wantfields bool) (*sqltypes.Result, error) {
+	maxrows := qre.execMaxRows()
+	result := &sqltypes.Result{}
+	err := stream(func(qr *sqltypes.Result) error {
+		if qr.Fields != nil {
+			result.Fields = qr.Fields
+		}
+		qre.memTracker.addRead(qr)
+		if len(result.Rows)+len(qr.Rows) > maxrows {
+			return mysql.NewSQLError(mysql.ERVitessMaxRowsExceeded, mysql.SSUnknownSQLState, "Row count exceeded %d", maxrows)
+		}
+		result.Rows = append(result.Rows, qr.Rows...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !wantfields {
+		result.Fields = nil
+	}
+	return result, nil
+}
+
 // checkPermissions returns an error if the query does not pass all checks
-// (query blacklisting, table ACL).
-func (qre *QueryExecutor) checkPermissions() error {
+// (query blacklisting, rate limiting, table ACL). Otherwise, the returned
+// release function must be called once the query is done.
+func (qre *QueryExecutor) checkPermissions() (release func(), err error) {
 	// Skip permissions check if the context is local.
 	if tabletenv.IsLocalContext(qre.ctx) {
-		return nil
+		return func() {}, nil
 	}
 
-	// Check if the query is blacklisted.
+	release, err = qre.checkRules()
+	if err != nil {
+		return nil, err
+	}
+	if err := qre.checkACL(); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// checkRules runs the query rules. If the query is admitted, the returned
+// release function must be called once the query is done.
+func (qre *QueryExecutor) checkRules() (release func(), err error) {
+	// Check if the query is blacklisted or rate limited.
 	remoteAddr := ""
 	username := ""
 	ci, ok := callinfo.FromContext(qre.ctx)
@@ -315,12 +458,31 @@ func (qre *QueryExecutor) checkPermissions() error {
 		remoteAddr = ci.RemoteAddr()
 		username = ci.Username()
 	}
-	action, desc := qre.plan.Rules.GetAction(remoteAddr, username, qre.bindVars)
+	for _, rule := range qre.plan.Rules.Monitor(remoteAddr, username, qre.bindVars) {
+		qre.tsv.qe.queryRuleMonitorMatches.Add(rule.Name, 1)
+		qre.tsv.qe.ruleMonitorLogger.Infof("query rule %s in monitor mode matched query: %s", rule.Name, sqlparser.TruncateForLog(qre.query))
+	}
+	action, rule, release := qre.plan.Rules.Admit(remoteAddr, username, qre.bindVars)
 	switch action {
 	case rules.QRFail:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "disallowed due to rule: %s", rule.Description)
 	case rules.QRFailRetry:
-		return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", desc)
+		return nil, vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "disallowed due to rule: %s", rule.Description)
+	case rules.QRRateLimit:
+		qre.tsv.qe.queryRuleThrottled.Add(rule.Name, 1)
+		return nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "rate limited due to rule: %s", rule.Description)
+	case rules.QRRewrite:
+		qre.rewrite = rule.Rewrite()
+	}
+	return release, nil
+}
+
+// checkACL returns an error if the caller is not allowed to access the
+// tables of the query.
+func (qre *QueryExecutor) checkACL() error {
+	username := ""
+	if ci, ok := callinfo.FromContext(qre.ctx); ok {
+		username = ci.Username()
 	}
 
 	// Skip ACL check for queries against the dummy dual table
@@ -342,6 +504,8 @@ func (qre *QueryExecutor) checkPermissions() error {
 		}
 		return nil
 	}
+	// Add the groups of the caller known to the group source, if any.
+	callerID = tableacl.ResolveGroups(callerID)
 
 	// Skip the ACL check if the caller id is an exempted superuser.
 	if qre.tsv.qe.exemptACL != nil && qre.tsv.qe.exemptACL.IsMember(callerID) {
@@ -350,7 +514,7 @@ func (qre *QueryExecutor) checkPermissions() error {
 	}
 
 	for i, auth := range qre.plan.Authorized {
-		if err := qre.checkAccess(auth, qre.plan.Permissions[i].TableName, callerID); err != nil {
+		if err := qre.checkAccess(auth, qre.plan.Permissions[i], callerID); err != nil {
 			return err
 		}
 	}
@@ -358,11 +522,15 @@ func (qre *QueryExecutor) checkPermissions() error {
 	return nil
 }
 
-func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName string, callerID *querypb.VTGateCallerID) error {
+func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, permission p.Permission, callerID *querypb.VTGateCallerID) error {
+	tableName := permission.TableName
 	statsKey := []string{tableName, authorized.GroupName, qre.plan.PlanID.String(), callerID.Username}
+	decisionKey := []string{authorized.GroupName, permission.Role.Name(), ""}
 	if !authorized.IsMember(callerID) {
 		if qre.tsv.qe.enableTableACLDryRun {
 			qre.tsv.Stats().TableaclPseudoDenied.Add(statsKey, 1)
+			decisionKey[2] = "PseudoDenied"
+			qre.tsv.Stats().TableaclDecisions.Add(decisionKey, 1)
 			return nil
 		}
 
@@ -374,12 +542,16 @@ func (qre *QueryExecutor) checkAccess(authorized *tableacl.ACLResult, tableName
 		if qre.tsv.qe.strictTableACL {
 			errStr := fmt.Sprintf("table acl error: %q %v cannot run %v on table %q", callerID.Username, callerID.Groups, qre.plan.PlanID, tableName)
 			qre.tsv.Stats().TableaclDenied.Add(statsKey, 1)
+			decisionKey[2] = "Denied"
+			qre.tsv.Stats().TableaclDecisions.Add(decisionKey, 1)
 			qre.tsv.qe.accessCheckerLogger.Infof("%s", errStr)
 			return vterrors.Errorf(vtrpcpb.Code_PERMISSION_DENIED, "%s", errStr)
 		}
 		return nil
 	}
 	qre.tsv.Stats().TableaclAllowed.Add(statsKey, 1)
+	decisionKey[2] = "Allowed"
+	qre.tsv.Stats().TableaclDecisions.Add(decisionKey, 1)
 	return nil
 }
 
@@ -451,19 +623,9 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 	t.SequenceInfo.Lock()
 	defer t.SequenceInfo.Unlock()
 	if t.SequenceInfo.NextVal == 0 || t.SequenceInfo.NextVal+inc > t.SequenceInfo.LastVal {
-		_, err := qre.execAsTransaction(func(conn *StatefulConnection) (*sqltypes.Result, error) {
-			query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
-			qr, err := qre.execStatefulConn(conn, query, false)
-			if err != nil {
-				return nil, err
-			}
-			if len(qr.Rows) != 1 {
-				return nil, fmt.Errorf("unexpected rows from reading sequence %s (possible mis-route): %d", tableName, len(qr.Rows))
-			}
-			nextID, err := evalengine.ToInt64(qr.Rows[0][0])
-			if err != nil {
-				return nil, vterrors.Wrapf(err, "error loading sequence %s", tableName)
-			}
+		nextVal := t.SequenceInfo.NextVal
+		var newLast int64
+		err := qre.updateSequence(func(nextID, cache int64) (int64, error) {
 			// If LastVal does not match next ID, then either:
 			// VTTablet just started, and we're initializing the cache, or
 			// Someone reset the id underneath us.
@@ -472,32 +634,24 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 					log.Warningf("Sequence next ID value %v is below the currently cached max %v, updating it to max", nextID, t.SequenceInfo.LastVal)
 					nextID = t.SequenceInfo.LastVal
 				}
-				t.SequenceInfo.NextVal = nextID
-				t.SequenceInfo.LastVal = nextID
-			}
-			cache, err := evalengine.ToInt64(qr.Rows[0][1])
-			if err != nil {
-				return nil, vterrors.Wrapf(err, "error loading sequence %s", tableName)
+				nextVal = nextID
 			}
 			if cache < 1 {
-				return nil, fmt.Errorf("invalid cache value for sequence %s: %d", tableName, cache)
+				return 0, fmt.Errorf("invalid cache value for sequence %s: %d", tableName, cache)
 			}
-			newLast := nextID + cache
-			for newLast < t.SequenceInfo.NextVal+inc {
+			newLast = nextID + cache
+			for newLast < nextVal+inc {
 				newLast += cache
 			}
-			query = fmt.Sprintf("update %s set next_id = %d where id = 0", sqlparser.String(tableName), newLast)
-			conn.TxProperties().RecordQuery(query)
-			_, err = qre.execStatefulConn(conn, query, false)
-			if err != nil {
-				return nil, err
-			}
-			t.SequenceInfo.LastVal = newLast
-			return nil, nil
+			return newLast, nil
 		})
 		if err != nil {
 			return nil, err
 		}
+		// The cache is only updated once the new next ID is committed,
+		// so that a failed commit cannot hand out the IDs twice.
+		t.SequenceInfo.NextVal = nextVal
+		t.SequenceInfo.LastVal = newLast
 	}
 	ret := t.SequenceInfo.NextVal
 	t.SequenceInfo.NextVal += inc
@@ -512,6 +666,36 @@ func (qre *QueryExecutor) execNextval() (*sqltypes.Result, error) {
 // execSelect sends a query to mysql only if another identical query is not running. Otherwise, it waits and
 // reuses the result. If the plan is missing field info, it sends the query to mysql requesting full info.
 func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
+	if rc := qre.tsv.qe.resultCache; rc != nil && qre.plan.CacheTTL > 0 {
+		return qre.execCachedSelect(rc)
+	}
+	return qre.execUncachedSelect()
+}
+
+// execCachedSelect returns the result of the select from the result cache,
+// or fetches it and adds it to the cache.
+func (qre *QueryExecutor) execCachedSelect(rc *resultCache) (*sqltypes.Result, error) {
+	// The key does not depend on the deadline of the query, which only
+	// changes its MAX_EXECUTION_TIME hint.
+	query, err := qre.generateQuery(qre.plan.FullQuery, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	key := rc.key(query)
+	result, generation := rc.Get(key)
+	if result != nil {
+		qre.logStats.QuerySources |= tabletenv.QuerySourceResultCache
+		return result, nil
+	}
+	result, err = qre.execUncachedSelect()
+	if err != nil {
+		return nil, err
+	}
+	rc.Set(key, result, qre.plan.readTables(), generation, qre.plan.CacheTTL)
+	return result, nil
+}
+
+func (qre *QueryExecutor) execUncachedSelect() (*sqltypes.Result, error) {
 	if qre.tsv.qe.enableQueryPlanFieldCaching && qre.plan.Fields != nil {
 		result, err := qre.qFetch(qre.logStats, qre.plan.FullQuery, qre.bindVars)
 		if err != nil {
@@ -535,10 +719,18 @@ func (qre *QueryExecutor) execSelect() (*sqltypes.Result, error) {
 	return qre.execDBConn(conn, sql, true)
 }
 
+func (qre *QueryExecutor) execDML(conn *StatefulConnection) (*sqltypes.Result, error) {
+	return qre.execRowLimitedDML(conn)
+}
+
 func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Result, error) {
-	maxrows := qre.tsv.qe.maxResultSize.Get()
-	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(maxrows + 1)
-	result, err := qre.txFetch(conn, true)
+	maxrows := qre.maxResultSize()
+	limit := maxrows
+	if maxDMLRows := qre.maxDMLRows(); maxDMLRows > 0 && maxDMLRows < limit {
+		limit = maxDMLRows
+	}
+	qre.bindVars["#maxLimit"] = sqltypes.Int64BindVariable(limit + 1)
+	result, err := qre.execRowLimitedDML(conn)
 	if err != nil {
 		return nil, err
 	}
@@ -550,6 +742,125 @@ func (qre *QueryExecutor) execDMLLimit(conn *StatefulConnection) (*sqltypes.Resu
 	return result, nil
 }
 
+// dmlRowLimitSavepoint is the savepoint an update or delete of a transaction
+// of the caller is undone to if it affects too many rows.
+const dmlRowLimitSavepoint = "vt_dml_row_limit"
+
+// execRowLimitedDML executes the update or delete, and undoes it if it
+// affects more than the max rows of the unsafe DMLs. If the statement runs
+// in a transaction begun for it, that transaction is rolled back. Otherwise,
+// only the statement is undone: within a transaction of the caller, by
+// rolling back to a savepoint taken before it, and on a reserved connection
+// outside of a transaction, by running it in a transaction of its own.
+func (qre *QueryExecutor) execRowLimitedDML(conn *StatefulConnection) (*sqltypes.Result, error) {
+	sql, _, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	if err != nil {
+		return nil, err
+	}
+	if qre.connID == 0 || qre.maxDMLRows() == 0 {
+		result, err := qre.execStatefulConn(conn, sql, true)
+		if err != nil {
+			return nil, err
+		}
+		if err := qre.verifyDMLRowCount(int64(result.RowsAffected)); err != nil {
+			defer qre.logStats.AddRewrittenSQL("rollback", time.Now())
+			_ = qre.tsv.te.txPool.Rollback(qre.ctx, conn)
+			return nil, err
+		}
+		conn.TxProperties().RecordQuery(sql)
+		return result, nil
+	}
+
+	start, undo, done := "begin", "rollback", "commit"
+	if conn.IsInTransaction() {
+		start = "savepoint " + dmlRowLimitSavepoint
+		undo = "rollback to savepoint " + dmlRowLimitSavepoint
+		done = "release savepoint " + dmlRowLimitSavepoint
+	}
+	if _, err := qre.execStatefulConn(conn, start, false); err != nil {
+		return nil, err
+	}
+	result, err := qre.execStatefulConn(conn, sql, true)
+	if err == nil {
+		err = qre.verifyDMLRowCount(int64(result.RowsAffected))
+	}
+	if err != nil {
+		if _, undoErr := qre.execStatefulConn(conn, undo, false); undoErr != nil {
+			// A deadlock rolls back the whole transaction, savepoint
+			// included: the statement error is the one to report.
+			log.Warningf("could not undo %q after %v: %v", sqlparser.TruncateForLog(sql), err, undoErr)
+			return nil, err
+		}
+		if conn.IsInTransaction() {
+			if _, doneErr := qre.execStatefulConn(conn, done, false); doneErr != nil {
+				return nil, doneErr
+			}
+		}
+		return nil, err
+	}
+	if _, err := qre.execStatefulConn(conn, done, false); err != nil {
+		return nil, err
+	}
+	// Only record the statements that were not undone.
+	conn.TxProperties().RecordQuery(sql)
+	return result, nil
+}
+
+// checkUnsafeDML returns an error if unsafe DMLs are rejected and the
+// update or delete has no WHERE clause.
+func (qre *QueryExecutor) checkUnsafeDML() error {
+	if !qre.tsv.config.Oltp.RejectUnsafeDML || qre.plan.AllowUnsafeDML || qre.plan.WhereClause != nil {
+		return nil
+	}
+	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsafe DML rejected: no WHERE clause, use the %s directive to allow it", sqlparser.DirectiveAllowUnsafeDML)
+}
+
+// maxDMLRows returns the max number of rows the update or delete may
+// affect, or 0 if it is not limited.
+func (qre *QueryExecutor) maxDMLRows() int64 {
+	if !qre.tsv.config.Oltp.RejectUnsafeDML || qre.plan.AllowUnsafeDML {
+		return 0
+	}
+	return int64(qre.tsv.config.Oltp.MaxDMLRows)
+}
+
+func (qre *QueryExecutor) verifyDMLRowCount(count int64) error {
+	if maxrows := qre.maxDMLRows(); maxrows > 0 && count > maxrows {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unsafe DML rejected: more than %d rows affected, use the %s directive to allow it", maxrows, sqlparser.DirectiveAllowUnsafeDML)
+	}
+	return nil
+}
+
+// truncateRows returns the first maxrows rows of qr if the query allows
+// truncated results, or qr.
+func (qre *QueryExecutor) truncateRows(qr *sqltypes.Result, maxrows int64) *sqltypes.Result {
+	if !qre.allowTruncatedResult() || int64(len(qr.Rows)) <= maxrows {
+		return qr
+	}
+	callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
+	qre.tsv.Stats().Warnings.Add("ResultsTruncated", 1)
+	log.Warningf("caller id: %s row count exceeded %d, result truncated: %q", callerid.GetUsername(callerID), maxrows, queryAsString(qre.plan.FullQuery.Query, qre.bindVars))
+	// qr is read-only: it can be shared through the consolidator
+	// or the result cache.
+	truncated := *qr
+	truncated.Rows = qr.Rows[:maxrows]
+	truncated.Warnings = append(qr.Warnings[:len(qr.Warnings):len(qr.Warnings)], &querypb.QueryWarning{
+		Code:    mysql.ERUnknownError,
+		Message: fmt.Sprintf("result truncated to its first %d rows, the max result size", maxrows),
+	})
+	return &truncated
+}
+
+// allowTruncatedResult returns true if the query returns its first rows
+// instead of failing when it exceeds the max result size.
+func (qre *QueryExecutor) allowTruncatedResult() bool {
+	switch qre.plan.PlanID {
+	case p.PlanSelect, p.PlanUnion, p.PlanSelectDerived, p.PlanShow:
+		return qre.tsv.config.Oltp.TruncateResults || qre.plan.AllowTruncatedResult
+	}
+	return false
+}
+
 func (qre *QueryExecutor) verifyRowCount(count, maxrows int64) error {
 	if count > maxrows {
 		callerID := callerid.ImmediateCallerIDFromContext(qre.ctx)
@@ -586,7 +897,8 @@ func (qre *QueryExecutor) getConn() (*connpool.DBConn, error) {
 	case connpool.ErrConnPoolClosed:
 		return nil, err
 	}
-	return nil, err
+	// The query was not sent: no connection was available.
+	return nil, vterrors.WithApplied(err, vterrors.NotApplied)
 }
 
 func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
@@ -602,7 +914,8 @@ func (qre *QueryExecutor) getStreamConn() (*connpool.DBConn, error) {
 	case connpool.ErrConnPoolClosed:
 		return nil, err
 	}
-	return nil, err
+	// The query was not sent: no connection was available.
+	return nil, vterrors.WithApplied(err, vterrors.NotApplied)
 }
 
 func (qre *QueryExecutor) qFetch(logStats *tabletenv.LogStats, parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (*sqltypes.Result, error) {
@@ -663,19 +976,67 @@ func (qre *QueryExecutor) txFetch(conn *StatefulConnection, record bool) (*sqlty
 	return qr, nil
 }
 
+// generateFinalSQL returns the query to send to MySQL, and the same
+// query without the margin comments and the MAX_EXECUTION_TIME hint, which
+// the consolidator uses as key: the hint depends on the deadline of each
+// execution.
 func (qre *QueryExecutor) generateFinalSQL(parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (string, string, error) {
 	var buf strings.Builder
 	buf.WriteString(qre.marginComments.Leading)
 
-	query, err := parsedQuery.GenerateQuery(bindVars, nil)
+	query, err := qre.generateQuery(parsedQuery, bindVars)
 	if err != nil {
-		return "", "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
+		return "", "", err
 	}
-	withoutComments := query
-	buf.WriteString(query)
+	hintedQuery := query
+	if qre.tsv.qe.maxExecutionTimeHint && !qre.plan.MaxExecutionTimeHint && (qre.plan.PlanID.IsSelect() || qre.plan.PlanID == p.PlanSelectStream) {
+		if deadline, ok := qre.ctx.Deadline(); ok {
+			hintedQuery = addMaxExecutionTime(query, time.Until(deadline))
+		}
+	}
+	buf.WriteString(hintedQuery)
 	buf.WriteString(qre.marginComments.Trailing)
 	fullSQL := buf.String()
-	return fullSQL, withoutComments, nil
+	return fullSQL, query, nil
+}
+
+// generateQuery generates the query of parsedQuery, with the rewrite rule
+// of the plan applied.
+func (qre *QueryExecutor) generateQuery(parsedQuery *sqlparser.ParsedQuery, bindVars map[string]*querypb.BindVariable) (string, error) {
+	query, err := parsedQuery.GenerateQuery(bindVars, nil)
+	if err != nil {
+		return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s", err)
+	}
+	if qre.rewrite != nil {
+		query, err = qre.rewrite.Apply(query)
+		if err != nil {
+			return "", vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "could not apply rewrite rule: %v", err)
+		}
+	}
+	return query, nil
+}
+
+// addMaxExecutionTime adds a MAX_EXECUTION_TIME optimizer hint to a
+// SELECT, so that MySQL stops it after timeout. The query is unchanged if
+// it's not a SELECT. The caller checks that the query doesn't set its own
+// MAX_EXECUTION_TIME.
+func addMaxExecutionTime(query string, timeout time.Duration) string {
+	const selectPrefix = "select "
+	if len(query) < len(selectPrefix) || !strings.EqualFold(query[:len(selectPrefix)], selectPrefix) {
+		return query
+	}
+	ms := timeout.Milliseconds()
+	if ms < 1 {
+		ms = 1
+	}
+	hint := fmt.Sprintf("MAX_EXECUTION_TIME(%d)", ms)
+	rest := query[len(selectPrefix):]
+	// MySQL only reads the first hint comment of a statement, so the
+	// hint is merged into an existing one.
+	if strings.HasPrefix(rest, "/*+") {
+		return query[:len(selectPrefix)] + "/*+ " + hint + " " + strings.TrimLeft(rest[len("/*+"):], " ")
+	}
+	return query[:len(selectPrefix)] + "/*+ " + hint + " */ " + rest
 }
 
 func rewriteOUTParamError(err error) error {
@@ -774,7 +1135,7 @@ func (qre *QueryExecutor) drainResultSetOnConn(conn *connpool.DBConn) error {
 }
 
 func (qre *QueryExecutor) getSelectLimit() int64 {
-	maxRows := qre.tsv.qe.maxResultSize.Get()
+	maxRows := qre.maxResultSize()
 	sqlLimit := qre.options.GetSqlSelectLimit()
 	if sqlLimit > 0 && sqlLimit < maxRows {
 		return sqlLimit
@@ -782,6 +1143,32 @@ func (qre *QueryExecutor) getSelectLimit() int64 {
 	return maxRows
 }
 
+// maxResultSize returns the smallest max result size override of the
+// tables used by the query, or the max result size if there is none.
+func (qre *QueryExecutor) maxResultSize() int64 {
+	maxRows := int64(0)
+	for _, perm := range qre.plan.Permissions {
+		v, ok := qre.tsv.config.Oltp.MaxRowsPerTable[perm.TableName]
+		if ok && (maxRows == 0 || int64(v) < maxRows) {
+			maxRows = int64(v)
+		}
+	}
+	if maxRows == 0 {
+		return qre.tsv.qe.maxResultSize.Get()
+	}
+	return maxRows
+}
+
+// execMaxRows returns the max number of rows the query may fetch from
+// MySQL. Queries allowing truncated results fetch one more row than
+// they return, to tell whether their result was truncated.
+func (qre *QueryExecutor) execMaxRows() int {
+	if qre.allowTruncatedResult() {
+		return int(qre.getSelectLimit() + 1)
+	}
+	return int(qre.maxResultSize())
+}
+
 func (qre *QueryExecutor) execDBConn(conn *connpool.DBConn, sql string, wantfields bool) (*sqltypes.Result, error) {
 	span, ctx := trace.NewSpan(qre.ctx, "QueryExecutor.execDBConn")
 	defer span.Finish()
@@ -792,7 +1179,12 @@ func (qre *QueryExecutor) execDBConn(conn *connpool.DBConn, sql string, wantfiel
 	qre.tsv.statelessql.Add(qd)
 	defer qre.tsv.statelessql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	if qre.tracksRead() {
+		return qre.readTracked(func(callback func(*sqltypes.Result) error) error {
+			return conn.Stream(ctx, sql, callback, int(qre.tsv.qe.streamBufferSize.Get()), querypb.ExecuteOptions_ALL)
+		}, wantfields)
+	}
+	return conn.Exec(ctx, sql, qre.execMaxRows(), wantfields)
 }
 
 func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string, wantfields bool) (*sqltypes.Result, error) {
@@ -802,10 +1194,18 @@ func (qre *QueryExecutor) execStatefulConn(conn *StatefulConnection, sql string,
 	defer qre.logStats.AddRewrittenSQL(sql, time.Now())
 
 	qd := NewQueryDetail(qre.logStats.Ctx, conn)
+	if conn.IsInTransaction() {
+		qd.transactionID = int64(conn.ConnID)
+	}
 	qre.tsv.statefulql.Add(qd)
 	defer qre.tsv.statefulql.Remove(qd)
 
-	return conn.Exec(ctx, sql, int(qre.tsv.qe.maxResultSize.Get()), wantfields)
+	if qre.tracksRead() {
+		return qre.readTracked(func(callback func(*sqltypes.Result) error) error {
+			return conn.Stream(ctx, sql, callback, int(qre.tsv.qe.streamBufferSize.Get()), querypb.ExecuteOptions_ALL)
+		}, wantfields)
+	}
+	return conn.Exec(ctx, sql, qre.execMaxRows(), wantfields)
 }
 
 func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callback func(*sqltypes.Result) error) error {
@@ -831,13 +1231,49 @@ func (qre *QueryExecutor) execStreamSQL(conn *connpool.DBConn, sql string, callb
 }
 
 func (qre *QueryExecutor) recordUserQuery(queryType string, duration int64) {
+	username := qre.callerName()
+	tableName := qre.plan.TableName().String()
+	qre.tsv.Stats().UserTableQueryCount.Add([]string{tableName, username, queryType}, 1)
+	qre.tsv.Stats().UserTableQueryTimesNs.Add([]string{tableName, username, queryType}, duration)
+}
+
+// callerName returns the effective caller principal, or the immediate
+// caller username if there is none.
+func (qre *QueryExecutor) callerName() string {
 	username := callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(qre.ctx))
 	if username == "" {
 		username = callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qre.ctx))
 	}
-	tableName := qre.plan.TableName().String()
-	qre.tsv.Stats().UserTableQueryCount.Add([]string{tableName, username, queryType}, 1)
-	qre.tsv.Stats().UserTableQueryTimesNs.Add([]string{tableName, username, queryType}, duration)
+	return username
+}
+
+// setWorkload attributes the query to its workload if the per-workload
+// stats are enabled. The workload is named by the WORKLOAD directive,
+// or else by the caller, and folded into the other workload past the
+// maximum number of workloads. It is carried by the context so that the
+// query kills can be attributed to it too.
+func (qre *QueryExecutor) setWorkload() {
+	if !qre.tsv.config.EnableWorkloadStats {
+		return
+	}
+	workload := qre.plan.Workload
+	if workload == "" {
+		workload = qre.callerName()
+	}
+	if workload == "" {
+		return
+	}
+	workload = qre.tsv.workloads.Label(workload)
+	qre.logStats.Workload = workload
+	qre.ctx = tabletenv.NewContextWithWorkload(qre.ctx, workload)
+}
+
+func (qre *QueryExecutor) recordWorkloadQuery(planName string, duration time.Duration) {
+	if qre.logStats.Workload == "" {
+		return
+	}
+	qre.tsv.Stats().WorkloadQueryCount.Add([]string{qre.logStats.Workload, planName}, 1)
+	qre.tsv.Stats().WorkloadQueryTimesNs.Add([]string{qre.logStats.Workload, planName}, int64(duration))
 }
 
 // resolveNumber extracts a number from a bind variable or sql value.
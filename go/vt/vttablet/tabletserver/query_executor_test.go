@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strings"
 	"testing"
+	"time"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
@@ -112,6 +113,32 @@ func TestQueryExecutorPlans(t *testing.T) {
 		// Because the fields would have been cached before, the field query will
 		// not get re-executed.
 		inTxWant: "select * from t limit 1",
+	}, {
+		input: "select * from t union select * from t",
+		dbResponses: []dbResponse{{
+			query:  "select * from t where 1 != 1 union select * from t where 1 != 1",
+			result: fieldResult,
+		}, {
+			query:  "select * from t union select * from t limit 10001",
+			result: selectResult,
+		}},
+		resultWant: selectResult,
+		planWant:   "Union",
+		logWant:    "select * from t where 1 != 1 union select * from t where 1 != 1; select * from t union select * from t limit 10001",
+		inTxWant:   "select * from t union select * from t limit 10001",
+	}, {
+		input: "select * from (select * from t) as d",
+		dbResponses: []dbResponse{{
+			query:  "select * from (select * from t where 1 != 1) as d where 1 != 1",
+			result: fieldResult,
+		}, {
+			query:  "select * from (select * from t) as d limit 10001",
+			result: selectResult,
+		}},
+		resultWant: selectResult,
+		planWant:   "SelectDerived",
+		logWant:    "select * from (select * from t where 1 != 1) as d where 1 != 1; select * from (select * from t) as d limit 10001",
+		inTxWant:   "select * from (select * from t) as d limit 10001",
 	}, {
 		input: "show engines",
 		dbResponses: []dbResponse{{
@@ -139,6 +166,15 @@ func TestQueryExecutorPlans(t *testing.T) {
 		resultWant: dmlResult,
 		planWant:   "Insert",
 		logWant:    "insert into test_table(a) values (1)",
+	}, {
+		input: "insert into test_table(a) select a from t",
+		dbResponses: []dbResponse{{
+			query:  "insert into test_table(a) select a from t",
+			result: dmlResult,
+		}},
+		resultWant: dmlResult,
+		planWant:   "InsertSelect",
+		logWant:    "insert into test_table(a) select a from t",
 	}, {
 		input: "replace into test_table(a) values(1)",
 		dbResponses: []dbResponse{{
@@ -511,6 +547,163 @@ func TestQueryExecutorLimitFailure(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorRejectUnsafeDML(t *testing.T) {
+	dmlResult := &sqltypes.Result{
+		RowsAffected: 3,
+	}
+
+	// The queries are run both in and outside a transaction. Within the
+	// transaction of the caller, only the statement is undone.
+	testcases := []struct {
+		input       string
+		passthrough bool
+		dbQuery     string
+		err         string
+		logWant     string
+		inTxWant    string
+	}{{
+		input:    "update test_table set a=1",
+		err:      "unsafe DML rejected: no WHERE clause",
+		logWant:  "begin; rollback",
+		inTxWant: "",
+	}, {
+		input:       "delete from test_table",
+		passthrough: true,
+		err:         "unsafe DML rejected: no WHERE clause",
+		logWant:     "begin; rollback",
+	}, {
+		input:    "update test_table set a=1 where pk > 1",
+		dbQuery:  "update test_table set a = 1 where pk > 1 limit 3",
+		err:      "unsafe DML rejected: more than 2 rows affected",
+		logWant:  "begin; update test_table set a = 1 where pk > 1 limit 3; rollback",
+		inTxWant: "savepoint vt_dml_row_limit; update test_table set a = 1 where pk > 1 limit 3; rollback to savepoint vt_dml_row_limit; release savepoint vt_dml_row_limit",
+	}, {
+		input:       "delete from test_table where pk > 1",
+		passthrough: true,
+		dbQuery:     "delete from test_table where pk > 1",
+		err:         "unsafe DML rejected: more than 2 rows affected",
+		logWant:     "begin; delete from test_table where pk > 1; rollback",
+		inTxWant:    "savepoint vt_dml_row_limit; delete from test_table where pk > 1; rollback to savepoint vt_dml_row_limit; release savepoint vt_dml_row_limit",
+	}}
+	for i, tcase := range testcases {
+		t.Run(fmt.Sprintf("%d - %s", i, tcase.input), func(t *testing.T) {
+			db := setUpQueryExecutorTest(t)
+			defer db.Close()
+			if tcase.dbQuery != "" {
+				db.AddQuery(tcase.dbQuery, dmlResult)
+			}
+			addDMLRowLimitQueries(db)
+			ctx := context.Background()
+			tsv := newTestTabletServer(ctx, rejectUnsafeDML, db)
+			defer tsv.StopService()
+			tsv.SetPassthroughDMLs(tcase.passthrough)
+
+			// Test outside a transaction.
+			qre := newTestQueryExecutor(ctx, tsv, tcase.input, 0)
+			_, err := qre.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tcase.err)
+			assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+			assert.Equal(t, tcase.logWant, qre.logStats.RewrittenSQL())
+
+			// Test inside a transaction.
+			txid := newTransaction(tsv, nil)
+			target := tsv.sm.Target()
+			defer tsv.Commit(ctx, &target, txid)
+
+			qre = newTestQueryExecutor(ctx, tsv, tcase.input, txid)
+			_, err = qre.Execute()
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tcase.err)
+			assert.Equal(t, tcase.inTxWant, qre.logStats.RewrittenSQL())
+
+			// Ensure the transaction goes on.
+			conn, err := tsv.te.txPool.GetAndLock(txid, "")
+			require.NoError(t, err)
+			defer conn.Unlock()
+			require.True(t, conn.IsInTransaction(), "transaction was rolled back")
+			assert.Empty(t, conn.TxProperties().Queries)
+		})
+	}
+}
+
+func TestQueryExecutorRowLimitedDMLInTransaction(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("delete from test_table where pk > 1", &sqltypes.Result{RowsAffected: 2})
+	addDMLRowLimitQueries(db)
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, rejectUnsafeDML, db)
+	defer tsv.StopService()
+	tsv.SetPassthroughDMLs(true)
+
+	txid := newTransaction(tsv, nil)
+	target := tsv.sm.Target()
+	defer tsv.Commit(ctx, &target, txid)
+	qre := newTestQueryExecutor(ctx, tsv, "delete from test_table where pk > 1", txid)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, got.RowsAffected)
+	assert.Equal(t, "savepoint vt_dml_row_limit; delete from test_table where pk > 1; release savepoint vt_dml_row_limit", qre.logStats.RewrittenSQL())
+
+	// On a reserved connection, the statement runs in a transaction of its own.
+	db.AddQuery("select 42 from dual where 1 != 1", &sqltypes.Result{})
+	db.AddQuery("select 42 from dual limit 10001", &sqltypes.Result{})
+	_, reservedID, _, err := tsv.ReserveExecute(ctx, &target, nil, "select 42", nil, 0, &querypb.ExecuteOptions{})
+	require.NoError(t, err)
+	defer tsv.Release(ctx, &target, 0, reservedID)
+	db.AddQuery("delete from test_table where pk > 2", &sqltypes.Result{RowsAffected: 3})
+	qre = newTestQueryExecutor(ctx, tsv, "delete from test_table where pk > 2", reservedID)
+	_, err = qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsafe DML rejected: more than 2 rows affected")
+	assert.Equal(t, "begin; delete from test_table where pk > 2; rollback", qre.logStats.RewrittenSQL())
+}
+
+func TestQueryExecutorRowLimitedDMLDeadlock(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	addDMLRowLimitQueries(db)
+	deadlock := mysql.NewSQLError(mysql.ERLockDeadlock, mysql.SSLockDeadlock, "Deadlock found when trying to get lock; try restarting transaction")
+	db.AddRejectedQuery("delete from test_table where pk > 1", deadlock)
+	// The deadlock rolled back the transaction and its savepoint.
+	db.AddRejectedQuery("rollback to savepoint vt_dml_row_limit", mysql.NewSQLError(mysql.ERSPDoesNotExist, mysql.SSUnknownSQLState, "SAVEPOINT vt_dml_row_limit does not exist"))
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, rejectUnsafeDML, db)
+	defer tsv.StopService()
+	tsv.SetPassthroughDMLs(true)
+
+	txid := newTransaction(tsv, nil)
+	target := tsv.sm.Target()
+	defer tsv.Rollback(ctx, &target, txid)
+	qre := newTestQueryExecutor(ctx, tsv, "delete from test_table where pk > 1", txid)
+	_, err := qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "(errno 1213)")
+	assert.NotContains(t, err.Error(), "does not exist")
+}
+
+func addDMLRowLimitQueries(db *fakesqldb.DB) {
+	db.AddQuery("savepoint vt_dml_row_limit", &sqltypes.Result{})
+	db.AddQuery("rollback to savepoint vt_dml_row_limit", &sqltypes.Result{})
+	db.AddQuery("release savepoint vt_dml_row_limit", &sqltypes.Result{})
+}
+
+func TestQueryExecutorAllowUnsafeDML(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	db.AddQuery("update /*vt+ ALLOW_UNSAFE_DML */ test_table set a = 1 limit 10001", &sqltypes.Result{RowsAffected: 3})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, rejectUnsafeDML, db)
+	defer tsv.StopService()
+	tsv.SetPassthroughDMLs(false)
+
+	qre := newTestQueryExecutor(ctx, tsv, "update /*vt+ ALLOW_UNSAFE_DML */ test_table set a=1", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, got.RowsAffected)
+}
+
 func TestQueryExecutorPlanPassSelectWithLockOutsideATransaction(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -822,6 +1015,56 @@ func TestQueryExecutorTableAclNoPermission(t *testing.T) {
 	}
 }
 
+type fakeGroupSource map[string][]string
+
+func (f fakeGroupSource) Groups(username string) []string {
+	return f[username]
+}
+
+func TestQueryExecutorTableAclGroupSource(t *testing.T) {
+	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
+	tableacl.Register(aclName, &simpleacl.Factory{})
+	tableacl.SetDefaultACL(aclName)
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	callerID := &querypb.VTGateCallerID{
+		Username: "u2",
+	}
+	ctx := callerid.NewContext(context.Background(), nil, callerID)
+	config := &tableaclpb.Config{
+		TableGroups: []*tableaclpb.TableGroupSpec{{
+			Name:                 "group03",
+			TableNamesOrPrefixes: []string{"test_table"},
+			Readers:              []string{"readers"},
+		}},
+	}
+	require.NoError(t, tableacl.InitFromProto(config))
+
+	tsv := newTestTabletServer(ctx, enableStrictTableACL, db)
+	defer tsv.StopService()
+	decisions := tsv.Stats().TableaclDecisions
+	_, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	assert.Equal(t, vtrpcpb.Code_PERMISSION_DENIED, vterrors.Code(err))
+	assert.EqualValues(t, 1, decisions.Counts()["group03.READER.Denied"])
+
+	// The group source grants u2 the readers group.
+	tableacl.SetGroupSource(fakeGroupSource{"u2": {"readers"}})
+	defer tableacl.SetGroupSource(nil)
+	got, err := newTestQueryExecutor(ctx, tsv, query, 0).Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.EqualValues(t, 1, decisions.Counts()["group03.READER.Allowed"])
+}
+
 func TestQueryExecutorTableAclDualTableExempt(t *testing.T) {
 	aclName := fmt.Sprintf("simpleacl-test-%d", rand.Int63())
 	tableacl.Register(aclName, &simpleacl.Factory{})
@@ -1055,6 +1298,256 @@ func TestQueryExecutorBlacklistQRFail(t *testing.T) {
 	}
 }
 
+func TestQueryExecutorTruncatedResult(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	rows := [][]sqltypes.Value{
+		{sqltypes.NewInt32(1), sqltypes.NewInt32(1), sqltypes.NewInt32(1)},
+		{sqltypes.NewInt32(2), sqltypes.NewInt32(2), sqltypes.NewInt32(2)},
+		{sqltypes.NewInt32(3), sqltypes.NewInt32(3), sqltypes.NewInt32(3)},
+	}
+	for _, query := range []string{
+		"select * from test_table limit 3",
+		"select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from test_table limit 3",
+	} {
+		db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields(), Rows: rows})
+	}
+	db.AddQuery("select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from test_table limit 2", &sqltypes.Result{Fields: getTestTableFields(), Rows: rows[:2]})
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from test_table where 1 != 1", &sqltypes.Result{Fields: getTestTableFields()})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, smallResultSize, db)
+	defer tsv.StopService()
+	defer tsv.stats.Warnings.ResetAll()
+
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	_, err := qre.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Row count exceeded 2")
+
+	tsv.stats.Warnings.ResetAll()
+	qre = newTestQueryExecutor(ctx, tsv, "select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from test_table", 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, rows[:2], got.Rows)
+	assert.Equal(t, []*querypb.QueryWarning{{
+		Code:    mysql.ERUnknownError,
+		Message: "result truncated to its first 2 rows, the max result size",
+	}}, got.Warnings)
+	assert.EqualValues(t, 1, tsv.stats.Warnings.Counts()["ResultsTruncated"])
+
+	txid := newTransaction(tsv, nil)
+	qre = newTestQueryExecutor(ctx, tsv, "select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from test_table", txid)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, rows[:2], got.Rows)
+	_, err = tsv.Rollback(ctx, &querypb.Target{TabletType: topodatapb.TabletType_MASTER}, txid)
+	require.NoError(t, err)
+
+	// The max result size of the table overrides the default one.
+	tsv.config.Oltp.MaxRowsPerTable = map[string]int{"test_table": 1}
+	defer func() { tsv.config.Oltp.MaxRowsPerTable = nil }()
+	qre = newTestQueryExecutor(ctx, tsv, "select /*vt+ ALLOW_TRUNCATED_RESULT=1 */ * from test_table", 0)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, rows[:1], got.Rows)
+
+	tsv.config.Oltp.MaxRowsPerTable = nil
+	tsv.config.Oltp.TruncateResults = true
+	defer func() { tsv.config.Oltp.TruncateResults = false }()
+	qre = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	got, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, rows[:2], got.Rows)
+}
+
+func TestQueryExecutorRateLimitRule(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	expandedQuery := "select * from test_table where `name` = 1 limit 1000"
+	expected := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, expected)
+	db.AddQuery(expandedQuery, expected)
+
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	limitRule := rules.NewQueryRule("throttle selects", "throttle_selects", rules.QRRateLimit)
+	limitRule.SetQueryCond("select.*")
+	limitRule.SetRateLimit(1, 1)
+
+	rulesName := "rateLimitRules"
+	rules := rules.New()
+	rules.Add(limitRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	defer tsv.StopService()
+
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, rules))
+	before := tsv.qe.queryRuleThrottled.Counts()["throttle_selects"]
+
+	// The first query uses the budget for this second.
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err := qre.Execute()
+	require.NoError(t, err)
+
+	qre = newTestQueryExecutor(ctx, tsv, query, 0)
+	_, err = qre.Execute()
+	require.EqualError(t, err, "rate limited due to rule: throttle selects")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Equal(t, before+1, tsv.qe.queryRuleThrottled.Counts()["throttle_selects"])
+}
+
+func TestQueryExecutorRewriteRule(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table where name = 1 limit 1000"
+	rewrittenQuery := "select /* capped */ * from test_table where `name` = 1 limit 10"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(rewrittenQuery, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	rewriteRule := rules.NewQueryRule("cap selects", "cap_selects", rules.QRRewrite)
+	rewriteRule.SetQueryCond("select.*")
+	rewriteRule.SetRewrite(&rules.Rewrite{Limit: 10, Comment: "capped"})
+
+	rulesName := "rewriteRules"
+	rules := rules.New()
+	rules.Add(rewriteRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	defer tsv.StopService()
+
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, rules))
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, 1, db.GetQueryCalledNum(rewrittenQuery))
+}
+
+func TestQueryExecutorResultCache(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	fields := sqltypes.MakeTestFields("a|b", "int64|varchar")
+	query := "select /*vt+ CACHEABLE_FOR=5s */ * from t where a = 1"
+	dbQuery := "select /*vt+ CACHEABLE_FOR=5s */ * from t where a = 1 limit 10001"
+	db.AddQuery("select * from t where 1 != 1", sqltypes.MakeTestResult(fields))
+	db.AddQuery(dbQuery, sqltypes.MakeTestResult(fields, "1|aaa"))
+	db.AddQuery("update t set b = 'bbb' where a = 1", &sqltypes.Result{RowsAffected: 1})
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, enableResultCache, db)
+	defer tsv.StopService()
+
+	execute := func(sql string) (*sqltypes.Result, *QueryExecutor) {
+		t.Helper()
+		qre := newTestQueryExecutor(ctx, tsv, sql, 0)
+		got, err := qre.Execute()
+		require.NoError(t, err)
+		return got, qre
+	}
+
+	want, _ := execute(query)
+	got, qre := execute(query)
+	assert.Equal(t, want, got)
+	assert.Equal(t, 1, db.GetQueryCalledNum(dbQuery))
+	assert.Equal(t, "resultcache", qre.logStats.FmtQuerySources())
+
+	// Writes to the table invalidate the result. AddQuery resets
+	// the query count.
+	db.AddQuery(dbQuery, sqltypes.MakeTestResult(fields, "1|bbb"))
+	execute("update t set b = 'bbb' where a = 1")
+	got, _ = execute(query)
+	assert.Equal(t, sqltypes.MakeTestResult(fields, "1|bbb"), got)
+	assert.Equal(t, 1, db.GetQueryCalledNum(dbQuery))
+	execute(query)
+	assert.Equal(t, 1, db.GetQueryCalledNum(dbQuery))
+
+	// So do schema changes.
+	tsv.qe.schemaChanged(tsv.qe.tables, nil, []string{"t"}, nil)
+	execute(query)
+	assert.Equal(t, 2, db.GetQueryCalledNum(dbQuery))
+
+	// The results read while a transaction writes the table are not
+	// cached until it commits.
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	txID, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	_, err = newTestQueryExecutor(ctx, tsv, "update t set b = 'bbb' where a = 1", txID).Execute()
+	require.NoError(t, err)
+	db.AddQuery(dbQuery, sqltypes.MakeTestResult(fields, "1|bbb"))
+	execute(query)
+	execute(query)
+	assert.Equal(t, 2, db.GetQueryCalledNum(dbQuery))
+	_, err = tsv.Commit(ctx, &target, txID)
+	require.NoError(t, err)
+	execute(query)
+	execute(query)
+	assert.Equal(t, 3, db.GetQueryCalledNum(dbQuery))
+
+	// Queries without the directive are not cached.
+	db.AddQuery("select * from t where a = 1 limit 10001", sqltypes.MakeTestResult(fields, "1|bbb"))
+	execute("select * from t where a = 1")
+	execute("select * from t where a = 1")
+	assert.Equal(t, 2, db.GetQueryCalledNum("select * from t where a = 1 limit 10001"))
+}
+
+func TestQueryExecutorMonitorRule(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	query := "select * from test_table limit 1000"
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQuery(query, want)
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{
+		Fields: getTestTableFields(),
+	})
+
+	monitorRule := rules.NewQueryRule("fail selects", "monitor_selects", rules.QRFail)
+	monitorRule.SetQueryCond("select.*")
+	monitorRule.SetMode(rules.ModeMonitor)
+
+	rulesName := "monitorRules"
+	rules := rules.New()
+	rules.Add(monitorRule)
+
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	tsv.qe.queryRuleSources.RegisterSource(rulesName)
+	defer tsv.qe.queryRuleSources.UnRegisterSource(rulesName)
+	defer tsv.StopService()
+
+	require.NoError(t, tsv.qe.queryRuleSources.SetRules(rulesName, rules))
+	before := tsv.qe.queryRuleMonitorMatches.Counts()["monitor_selects"]
+
+	qre := newTestQueryExecutor(ctx, tsv, query, 0)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+	assert.Equal(t, before+1, tsv.qe.queryRuleMonitorMatches.Counts()["monitor_selects"])
+}
+
 func TestQueryExecutorBlacklistQRRetry(t *testing.T) {
 	db := setUpQueryExecutorTest(t)
 	defer db.Close()
@@ -1117,6 +1610,8 @@ const (
 	noTwopc
 	shortTwopcAge
 	smallResultSize
+	enableResultCache
+	rejectUnsafeDML
 )
 
 // newTestQueryExecutor uses a package level variable testTabletServer defined in tabletserver_test.go
@@ -1147,6 +1642,13 @@ func newTestTabletServer(ctx context.Context, flags executorFlags, db *fakesqldb
 	if flags&smallResultSize > 0 {
 		config.Oltp.MaxRows = 2
 	}
+	if flags&enableResultCache > 0 {
+		config.ResultCacheSize = 1024 * 1024
+	}
+	if flags&rejectUnsafeDML > 0 {
+		config.Oltp.RejectUnsafeDML = true
+		config.Oltp.MaxDMLRows = 2
+	}
 	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
 	dbconfigs := newDBConfigs(db)
 	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
@@ -1166,6 +1668,56 @@ func newTransaction(tsv *TabletServer, options *querypb.ExecuteOptions) int64 {
 	return transactionID
 }
 
+func TestQueryExecutorWorkloadStats(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	for _, query := range []string{
+		"select * from test_table limit 10001",
+		"select /*vt+ WORKLOAD=billing */ * from test_table limit 10001",
+	} {
+		db.AddQuery(query, &sqltypes.Result{Fields: getTestTableFields()})
+	}
+	db.AddQuery("select * from test_table where 1 != 1", &sqltypes.Result{Fields: getTestTableFields()})
+	db.AddQuery("select /*vt+ WORKLOAD=billing */ * from test_table where 1 != 1", &sqltypes.Result{Fields: getTestTableFields()})
+
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("reports", "", ""), nil)
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	stats := tsv.Stats()
+	defer stats.WorkloadQueryCount.ResetAll()
+	defer stats.WorkloadQueryTimesNs.ResetAll()
+	defer stats.WorkloadErrors.ResetAll()
+
+	// The per-workload stats are disabled by default.
+	_, err := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0).Execute()
+	require.NoError(t, err)
+	assert.Empty(t, stats.WorkloadQueryCount.Counts())
+
+	tsv.config.EnableWorkloadStats = true
+	defer func() { tsv.config.EnableWorkloadStats = false }()
+	_, err = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0).Execute()
+	require.NoError(t, err)
+	qre := newTestQueryExecutor(ctx, tsv, "select /*vt+ WORKLOAD=billing */ * from test_table", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, map[string]int64{"reports.Select": 1, "billing.Select": 1}, stats.WorkloadQueryCount.Counts())
+	assert.Equal(t, "billing", tabletenv.WorkloadFromContext(qre.ctx))
+
+	err = tsv.convertAndLogError(ctx, qre.query, nil, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "pool full"), qre.logStats)
+	require.Error(t, err)
+	assert.Equal(t, map[string]int64{"billing.RESOURCE_EXHAUSTED": 1}, stats.WorkloadErrors.Counts())
+
+	// The workloads beyond the maximum are counted as the other workload,
+	// while the ones seen before keep their own label.
+	tsv.workloads = tabletenv.NewWorkloadLabels(2)
+	for _, caller := range []string{"reports", "billing", "adhoc1", "adhoc2", "reports"} {
+		ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID(caller, "", ""), nil)
+		_, err = newTestQueryExecutor(ctx, tsv, "select * from test_table", 0).Execute()
+		require.NoError(t, err)
+	}
+	assert.Equal(t, map[string]int64{"reports.Select": 3, "billing.Select": 2, "other.Select": 2}, stats.WorkloadQueryCount.Counts())
+}
+
 func newTestQueryExecutor(ctx context.Context, tsv *TabletServer, sql string, txID int64) *QueryExecutor {
 	logStats := tabletenv.NewLogStats(ctx, "TestQueryExecutor")
 	plan, err := tsv.qe.GetPlan(ctx, logStats, sql, false, false /* inReservedConn */)
@@ -1350,3 +1902,74 @@ func getQueryExecutorSupportedQueries() map[string]*sqltypes.Result {
 		fmt.Sprintf(sqlReadAllRedo, "_vt", "_vt"): {},
 	}
 }
+
+func TestAddMaxExecutionTime(t *testing.T) {
+	testcases := []struct {
+		query   string
+		timeout time.Duration
+		want    string
+	}{{
+		query:   "select * from test_table",
+		timeout: 2500 * time.Millisecond,
+		want:    "select /*+ MAX_EXECUTION_TIME(2500) */ * from test_table",
+	}, {
+		query:   "SELECT * from test_table",
+		timeout: time.Microsecond,
+		want:    "SELECT /*+ MAX_EXECUTION_TIME(1) */ * from test_table",
+	}, {
+		query:   "select /*+ SET_VAR(sort_buffer_size = 16M) */ * from test_table",
+		timeout: time.Second,
+		want:    "select /*+ MAX_EXECUTION_TIME(1000) SET_VAR(sort_buffer_size = 16M) */ * from test_table",
+	}, {
+		query:   "update test_table set name = 'a'",
+		timeout: time.Second,
+		want:    "update test_table set name = 'a'",
+	}}
+	for _, tc := range testcases {
+		t.Run(tc.query, func(t *testing.T) {
+			assert.Equal(t, tc.want, addMaxExecutionTime(tc.query, tc.timeout))
+		})
+	}
+}
+
+func TestQueryExecutorMaxExecutionTimeHint(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	want := &sqltypes.Result{
+		Fields: getTestTableFields(),
+	}
+	db.AddQueryPattern(`select /\*\+ MAX_EXECUTION_TIME\(\d+\) \*/ \* from test_table limit 10001`, want)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+	tsv.qe.maxExecutionTimeHint = true
+	qre := newTestQueryExecutor(ctx, tsv, "select * from test_table", 0)
+	assert.Equal(t, planbuilder.PlanSelect, qre.plan.PlanID)
+	got, err := qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, want.Fields, got.Fields)
+
+	// The queries are consolidated without the hint, which depends on the
+	// deadline of each execution.
+	sql, sqlWithoutComments, err := qre.generateFinalSQL(qre.plan.FullQuery, qre.bindVars)
+	require.NoError(t, err)
+	assert.Contains(t, sql, "MAX_EXECUTION_TIME(")
+	assert.Equal(t, "select * from test_table limit 10001", sqlWithoutComments)
+
+	// The hint of the query is kept.
+	db.AddQuery("select /*+ MAX_EXECUTION_TIME(10) */ * from test_table limit 10001", want)
+	qre = newTestQueryExecutor(ctx, tsv, "select /*+ MAX_EXECUTION_TIME(10) */ * from test_table", 0)
+	assert.True(t, qre.plan.MaxExecutionTimeHint)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.GetQueryCalledNum("select /*+ MAX_EXECUTION_TIME(10) */ * from test_table limit 10001"))
+
+	// Without a deadline, the query is unchanged.
+	db.AddQuery("select * from test_table limit 10001", want)
+	qre = newTestQueryExecutor(context.Background(), tsv, "select * from test_table", 0)
+	_, err = qre.Execute()
+	require.NoError(t, err)
+	assert.Equal(t, 1, db.GetQueryCalledNum("select * from test_table limit 10001"))
+}
@@ -25,6 +25,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/sqlparser"
 )
@@ -35,6 +36,8 @@ type QueryDetail struct {
 	conn   killable
 	connID int64
 	start  time.Time
+	// transactionID is the transaction the query is part of, 0 if none.
+	transactionID int64
 }
 
 type killable interface {
@@ -90,6 +93,20 @@ func (ql *QueryList) Terminate(connID int64) bool {
 	return true
 }
 
+// TerminateTransaction kills the connection of the query running in the
+// transaction. It returns false if no query of the transaction is running.
+func (ql *QueryList) TerminateTransaction(transactionID int64) bool {
+	ql.mu.Lock()
+	defer ql.mu.Unlock()
+	for _, qd := range ql.queryDetails {
+		if qd.transactionID == transactionID {
+			qd.conn.Kill("QueryList.TerminateTransaction()", time.Since(qd.start))
+			return true
+		}
+	}
+	return false
+}
+
 // TerminateAll terminates all queries and kills the MySQL connections
 func (ql *QueryList) TerminateAll() {
 	ql.mu.Lock()
@@ -107,6 +124,9 @@ type QueryDetailzRow struct {
 	Start             time.Time
 	Duration          time.Duration
 	ConnID            int64
+	TransactionID     int64
+	ImmediateCaller   string
+	EffectiveCaller   string
 	State             string
 	ShowTerminateLink bool
 }
@@ -126,12 +146,15 @@ func (ql *QueryList) AppendQueryzRows(rows []QueryDetailzRow) []QueryDetailzRow
 			query, _ = sqlparser.RedactSQLQuery(query)
 		}
 		row := QueryDetailzRow{
-			Type:        ql.name,
-			Query:       query,
-			ContextHTML: callinfo.HTMLFromContext(qd.ctx),
-			Start:       qd.start,
-			Duration:    time.Since(qd.start),
-			ConnID:      qd.connID,
+			Type:            ql.name,
+			Query:           sqlparser.TruncateForUI(query),
+			ContextHTML:     callinfo.HTMLFromContext(qd.ctx),
+			Start:           qd.start,
+			Duration:        time.Since(qd.start),
+			ConnID:          qd.connID,
+			TransactionID:   qd.transactionID,
+			ImmediateCaller: callerid.GetUsername(callerid.ImmediateCallerIDFromContext(qd.ctx)),
+			EffectiveCaller: callerid.GetPrincipal(callerid.EffectiveCallerIDFromContext(qd.ctx)),
 		}
 		rows = append(rows, row)
 	}
@@ -21,6 +21,10 @@ import (
 	"time"
 
 	"context"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/vt/callerid"
 )
 
 type testConn struct {
@@ -66,3 +70,25 @@ func TestQueryList(t *testing.T) {
 		t.Errorf("failed to remove from QueryList")
 	}
 }
+
+func TestQueryListTerminateTransaction(t *testing.T) {
+	ql := NewQueryList("test")
+	conn1 := &testConn{id: 1, query: "select 1"}
+	conn2 := &testConn{id: 2, query: "update t set a = 1"}
+	ctx := callerid.NewContext(context.Background(), callerid.NewEffectiveCallerID("alice", "", ""), callerid.NewImmediateCallerID("app"))
+	ql.Add(NewQueryDetail(ctx, conn1))
+	qd2 := NewQueryDetail(ctx, conn2)
+	qd2.transactionID = 10
+	ql.Add(qd2)
+
+	rows := ql.AppendQueryzRows(nil)
+	assert.Len(t, rows, 2)
+	assert.Equal(t, int64(10), rows[1].TransactionID)
+	assert.Equal(t, "alice", rows[1].EffectiveCaller)
+	assert.Equal(t, "app", rows[1].ImmediateCaller)
+
+	assert.False(t, ql.TerminateTransaction(11))
+	assert.True(t, ql.TerminateTransaction(10))
+	assert.False(t, conn1.IsKilled())
+	assert.True(t, conn2.IsKilled())
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// queryMemory accounts for the approximate memory held by the results of
+// the queries in flight: the rows a select reads from MySQL, until its
+// response is sent, and the packets a streaming query has not sent yet.
+// While this memory is above the threshold, the expensive queries are
+// queued until it drops below, for at most maxWait, and rejected otherwise.
+//
+// The expensive queries are the streaming queries, and the selects whose
+// plan returned at least expensiveRows rows per query on average so far.
+// An expensive query is admitted with a reservation of the memory it is
+// expected to hold, which its results then draw from.
+type queryMemory struct {
+	threshold     int64
+	expensiveRows uint64
+	maxWait       time.Duration
+
+	inFlight sync2.AtomicInt64
+	// waiting is the number of queued queries.
+	waiting sync2.AtomicInt64
+
+	// mu protects released, which is closed and replaced whenever memory
+	// is released while queries are queued.
+	mu       sync.Mutex
+	released chan struct{}
+
+	admissions *stats.CountersWithSingleLabel
+	peaks      *stats.Histogram
+}
+
+func newQueryMemory(env tabletenv.Env) *queryMemory {
+	config := env.Config().MemoryAdmission
+	qm := &queryMemory{
+		threshold:     config.ThresholdBytes,
+		expensiveRows: uint64(config.ExpensiveRows),
+		maxWait:       config.MaxWaitSeconds.Get(),
+		released:      make(chan struct{}),
+		admissions:    env.Exporter().NewCountersWithSingleLabel("QueryMemoryAdmissions", "Admission decisions for expensive queries on the memory held by the results in flight", "decision", "Admitted", "Queued", "Rejected"),
+		peaks:         env.Exporter().NewHistogram("QueryMemoryPeakBytes", "Distribution of the peak memory held by the results of a query", []int64{1 << 10, 1 << 14, 1 << 17, 1 << 20, 1 << 23, 1 << 26, 1 << 30}),
+	}
+	env.Exporter().NewGaugeFunc("QueryMemoryInFlight", "Memory in bytes held by the results of the queries in flight", qm.inFlight.Get)
+	return qm
+}
+
+// enabled returns true if the memory held by the results is accounted for
+// and admission controlled.
+func (qm *queryMemory) enabled() bool {
+	return qm.threshold > 0
+}
+
+// expensive returns true if the queries of plan are admission controlled.
+func (qm *queryMemory) expensive(plan *TabletPlan, streaming bool) bool {
+	if streaming {
+		return true
+	}
+	switch plan.PlanID {
+	case planbuilder.PlanSelect, planbuilder.PlanUnion, planbuilder.PlanSelectDerived, planbuilder.PlanShow:
+	default:
+		return false
+	}
+	count := atomic.LoadUint64(&plan.QueryCount)
+	if count == 0 {
+		return false
+	}
+	return atomic.LoadUint64(&plan.RowsReturned)/count >= qm.expensiveRows
+}
+
+// estimate returns the memory the results of a query of plan are expected
+// to hold: the size of a stream packet for a streaming query, and the
+// average peak of the previous queries of the plan for a select.
+func (qm *queryMemory) estimate(plan *TabletPlan, streaming bool, streamBufferSize int64) int64 {
+	if streaming {
+		return streamBufferSize
+	}
+	count := atomic.LoadUint64(&plan.QueryCount)
+	if count == 0 {
+		return 0
+	}
+	return int64(atomic.LoadUint64(&plan.ResultBytes) / count)
+}
+
+// admit returns nil once an expensive query can start, and an error if it
+// is rejected because the memory did not drop below the threshold in time.
+// An admitted query holds reserve bytes, reserved in the same atomic step
+// as the memory is checked, so that the queries admitted concurrently see
+// each other. The reservation must be handed to the tracker of the query.
+func (qm *queryMemory) admit(ctx context.Context, reserve int64) error {
+	if qm.tryReserve(reserve) {
+		qm.admissions.Add("Admitted", 1)
+		return nil
+	}
+	if qm.maxWait == 0 {
+		return qm.reject()
+	}
+
+	qm.admissions.Add("Queued", 1)
+	qm.waiting.Add(1)
+	defer qm.waiting.Add(-1)
+	timer := time.NewTimer(qm.maxWait)
+	defer timer.Stop()
+	for {
+		// Get the channel before checking the memory, so that a release
+		// in between is not missed.
+		qm.mu.Lock()
+		released := qm.released
+		qm.mu.Unlock()
+		if qm.tryReserve(reserve) {
+			qm.admissions.Add("Admitted", 1)
+			return nil
+		}
+		select {
+		case <-released:
+		case <-timer.C:
+			return qm.reject()
+		case <-ctx.Done():
+			qm.admissions.Add("Rejected", 1)
+			return vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "query timed out while queued for memory admission: %v", ctx.Err())
+		}
+	}
+}
+
+// tryReserve adds n bytes to the memory in flight if it is below the
+// threshold, and returns false otherwise.
+func (qm *queryMemory) tryReserve(n int64) bool {
+	for {
+		inFlight := qm.inFlight.Get()
+		if inFlight >= qm.threshold {
+			return false
+		}
+		if qm.inFlight.CompareAndSwap(inFlight, inFlight+n) {
+			return true
+		}
+	}
+}
+
+func (qm *queryMemory) reject() error {
+	qm.admissions.Add("Rejected", 1)
+	return vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "query rejected: results in flight hold %d bytes, above the memory admission threshold of %d bytes", qm.inFlight.Get(), qm.threshold)
+}
+
+func (qm *queryMemory) add(n int64) {
+	qm.inFlight.Add(n)
+}
+
+func (qm *queryMemory) release(n int64) {
+	qm.inFlight.Add(-n)
+	if qm.waiting.Get() == 0 {
+		return
+	}
+	qm.mu.Lock()
+	close(qm.released)
+	qm.released = make(chan struct{})
+	qm.mu.Unlock()
+}
+
+// queryMemoryTracker accounts for the memory held by the results of a
+// single query. Its methods must not be called concurrently. A nil
+// tracker accounts for nothing.
+type queryMemoryTracker struct {
+	qm   *queryMemory
+	plan *TabletPlan
+	// reserved is what is left of the reservation of the admission, which
+	// the results are charged against before the memory in flight.
+	reserved int64
+	held     int64
+	peak     int64
+	// read is set once the query accounted for the results it read.
+	read bool
+}
+
+// newTracker returns the tracker of a query of plan, which takes over the
+// reserved bytes of its admission, or nil if the accounting is disabled.
+// The tracker must be closed once the response of the query is sent.
+func (qm *queryMemory) newTracker(plan *TabletPlan, reserved int64) *queryMemoryTracker {
+	if !qm.enabled() {
+		return nil
+	}
+	return &queryMemoryTracker{qm: qm, plan: plan, reserved: reserved}
+}
+
+// add accounts for the memory of result and returns its size.
+func (t *queryMemoryTracker) add(result *sqltypes.Result) int64 {
+	if t == nil || result == nil {
+		return 0
+	}
+	n := resultSize(result)
+	t.held += n
+	if t.held > t.peak {
+		t.peak = t.held
+	}
+	charged := n
+	if t.reserved > 0 {
+		if t.reserved >= n {
+			charged = 0
+			t.reserved -= n
+		} else {
+			charged -= t.reserved
+			t.reserved = 0
+		}
+	}
+	t.qm.add(charged)
+	return n
+}
+
+// addRead accounts for the memory of a part of the result the query is
+// reading from MySQL.
+func (t *queryMemoryTracker) addRead(result *sqltypes.Result) {
+	if t == nil {
+		return
+	}
+	t.read = true
+	t.add(result)
+}
+
+// addUnread accounts for the memory of the result of the query, unless it
+// was already accounted for as it was read from MySQL. That is the case of
+// the results shared by the consolidator or by the result cache.
+func (t *queryMemoryTracker) addUnread(result *sqltypes.Result) {
+	if t == nil || t.read {
+		return
+	}
+	t.add(result)
+}
+
+// release releases n bytes previously returned by add.
+func (t *queryMemoryTracker) release(n int64) {
+	if t == nil || n == 0 {
+		return
+	}
+	t.held -= n
+	t.qm.release(n)
+}
+
+// close releases the memory still held or reserved, and records the peak
+// of the query.
+func (t *queryMemoryTracker) close() {
+	if t == nil {
+		return
+	}
+	if t.reserved > 0 {
+		t.held += t.reserved
+		t.reserved = 0
+	}
+	t.release(t.held)
+	if t.peak > 0 {
+		t.qm.peaks.Add(t.peak)
+		if t.plan != nil {
+			atomic.AddUint64(&t.plan.ResultBytes, uint64(t.peak))
+		}
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+func newTestQueryMemory(name string, threshold int64, maxWait time.Duration) *queryMemory {
+	config := tabletenv.NewDefaultConfig()
+	config.MemoryAdmission.ThresholdBytes = threshold
+	config.MemoryAdmission.MaxWaitSeconds.Set(maxWait)
+	return newQueryMemory(tabletenv.NewEnv(config, name))
+}
+
+func TestQueryMemoryTracker(t *testing.T) {
+	assert.Nil(t, newTestQueryMemory("QueryMemoryDisabledTest", 0, 0).newTracker(nil, 0))
+
+	qm := newTestQueryMemory("QueryMemoryTrackerTest", 1<<20, 0)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "varchar"), "abcd", "efgh")
+	size := resultSize(result)
+
+	t1 := qm.newTracker(nil, 0)
+	t2 := qm.newTracker(nil, 0)
+	n := t1.add(result)
+	assert.Equal(t, size, n)
+	t1.add(result)
+	t2.add(result)
+	assert.EqualValues(t, 3*size, qm.inFlight.Get())
+
+	t1.release(n)
+	assert.EqualValues(t, 2*size, qm.inFlight.Get())
+	t1.close()
+	t2.close()
+	assert.EqualValues(t, 0, qm.inFlight.Get())
+	assert.EqualValues(t, 2, qm.peaks.Count())
+	assert.EqualValues(t, 3*size, qm.peaks.Total())
+}
+
+func TestQueryMemoryTrackerReservation(t *testing.T) {
+	qm := newTestQueryMemory("QueryMemoryReservationTest", 1<<20, 0)
+	plan := &TabletPlan{Plan: &planbuilder.Plan{PlanID: planbuilder.PlanSelect}}
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "varchar"), "abcd", "efgh")
+	size := resultSize(result)
+
+	// The results are charged against the reservation of the admission first.
+	require.NoError(t, qm.admit(context.Background(), 3*size/2))
+	tracker := qm.newTracker(plan, 3*size/2)
+	tracker.add(result)
+	assert.EqualValues(t, 3*size/2, qm.inFlight.Get())
+	tracker.add(result)
+	assert.EqualValues(t, 2*size, qm.inFlight.Get())
+	tracker.close()
+	assert.EqualValues(t, 0, qm.inFlight.Get())
+
+	// The peak of the query is the estimate of the next ones.
+	plan.AddStats(1, 0, 0, 0, 2, 0)
+	assert.EqualValues(t, 2*size, qm.estimate(plan, false, 0))
+	assert.EqualValues(t, 100, qm.estimate(plan, true, 100))
+
+	// A reservation that is not used is released once the query is done.
+	require.NoError(t, qm.admit(context.Background(), size))
+	qm.newTracker(plan, size).close()
+	assert.EqualValues(t, 0, qm.inFlight.Get())
+}
+
+func TestQueryMemoryExpensive(t *testing.T) {
+	qm := newTestQueryMemory("QueryMemoryExpensiveTest", 1<<20, 0)
+	plan := &TabletPlan{Plan: &planbuilder.Plan{PlanID: planbuilder.PlanSelect}}
+
+	assert.True(t, qm.expensive(plan, true))
+	// The plan has no stats yet.
+	assert.False(t, qm.expensive(plan, false))
+	plan.AddStats(2, 0, 0, 0, 1000, 0)
+	assert.False(t, qm.expensive(plan, false))
+	plan.AddStats(1, 0, 0, 0, 2000, 0)
+	assert.True(t, qm.expensive(plan, false))
+
+	dml := &TabletPlan{Plan: &planbuilder.Plan{PlanID: planbuilder.PlanUpdate}}
+	dml.AddStats(1, 0, 0, 0, 5000, 0)
+	assert.False(t, qm.expensive(dml, false))
+}
+
+func TestQueryMemoryAdmit(t *testing.T) {
+	ctx := context.Background()
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "varchar"), "abcdefgh")
+
+	// Without a wait, queries are rejected as long as the threshold is reached.
+	qm := newTestQueryMemory("QueryMemoryRejectTest", resultSize(result), 0)
+	require.NoError(t, qm.admit(ctx, 0))
+	tracker := qm.newTracker(nil, 0)
+	tracker.add(result)
+	err := qm.admit(ctx, 0)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	tracker.close()
+	require.NoError(t, qm.admit(ctx, 0))
+	assert.Equal(t, map[string]int64{"Admitted": 2, "Rejected": 1}, qm.admissions.Counts())
+
+	// The memory is reserved along with the admission, so that the next
+	// query sees it.
+	require.NoError(t, qm.admit(ctx, resultSize(result)))
+	err = qm.admit(ctx, resultSize(result))
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	qm.newTracker(nil, resultSize(result)).close()
+	assert.EqualValues(t, 0, qm.inFlight.Get())
+
+	// Queued queries are admitted once memory is released.
+	qm = newTestQueryMemory("QueryMemoryQueueTest", resultSize(result), time.Minute)
+	tracker = qm.newTracker(nil, 0)
+	tracker.add(result)
+	admitted := make(chan error)
+	go func() {
+		admitted <- qm.admit(ctx, 0)
+	}()
+	for qm.waiting.Get() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	tracker.close()
+	require.NoError(t, <-admitted)
+	assert.Equal(t, map[string]int64{"Admitted": 1, "Queued": 1}, qm.admissions.Counts())
+
+	// And rejected once they waited for too long.
+	qm = newTestQueryMemory("QueryMemoryTimeoutTest", resultSize(result), 10*time.Millisecond)
+	tracker = qm.newTracker(nil, 0)
+	tracker.add(result)
+	err = qm.admit(ctx, 0)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+
+	// Or once their context is done.
+	qm.maxWait = time.Minute
+	cancelCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = qm.admit(cancelCtx, 0)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Equal(t, map[string]int64{"Queued": 2, "Rejected": 2}, qm.admissions.Counts())
+}
@@ -159,6 +159,7 @@ func (r *heartbeatReader) readHeartbeat() {
 	cumulativeLagNs.Add(lag.Nanoseconds())
 	currentLagNs.Set(lag.Nanoseconds())
 	heartbeatLagNsHistogram.Add(lag.Nanoseconds())
+	heartbeatLagByShard.Add(r.keyspaceShard, lag)
 	reads.Add(1)
 
 	r.lagMu.Lock()
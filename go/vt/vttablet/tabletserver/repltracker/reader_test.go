@@ -77,6 +77,7 @@ func TestReaderReadHeartbeat(t *testing.T) {
 		">1000s": int64(0),
 	}
 	utils.MustMatch(t, expectedHisto, heartbeatLagNsHistogram.Counts(), "wrong counts in histogram")
+	assert.Equal(t, int64(1), heartbeatLagByShard.Counts()[tr.keyspaceShard], "wrong count in per-shard histogram")
 }
 
 // TestReaderReadHeartbeatError tests that we properly account for errors
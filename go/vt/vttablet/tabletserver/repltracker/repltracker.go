@@ -46,6 +46,9 @@ var (
 	heartbeatLagNsHistogram = stats.NewGenericHistogram("HeartbeatLagNsHistogram",
 		"Histogram of lag values in nanoseconds", []int64{0, 1e6, 1e7, 1e8, 1e9, 1e10, 1e11, 1e12},
 		[]string{"0", "1ms", "10ms", "100ms", "1s", "10s", "100s", "1000s", ">1000s"}, "Count", "Total")
+	// HeartbeatLagByShard is a histogram of the lag values per keyspace/shard, for processes
+	// serving more than one shard.
+	heartbeatLagByShard = stats.NewTimings("HeartbeatLagByShard", "Histogram of heartbeat lag values per keyspace/shard", "KeyspaceShard")
 )
 
 // ReplTracker tracks replication lag.
@@ -137,6 +140,13 @@ func (rt *ReplTracker) Status() (time.Duration, error) {
 	return rt.poller.Status()
 }
 
+// RequestHeartbeats asks the heartbeat writer to keep writing heartbeats, when
+// running with heartbeat_on_demand_duration. Consumers of replication lag call
+// it each time they check lag.
+func (rt *ReplTracker) RequestHeartbeats() {
+	rt.hw.RequestHeartbeats()
+}
+
 // EnableHeartbeat enables or disables writes of heartbeat. This functionality
 // is only used by tests.
 func (rt *ReplTracker) EnableHeartbeat(enable bool) {
@@ -26,6 +26,7 @@ import (
 	"context"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/timer"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
@@ -54,11 +55,21 @@ var withDDL = withddl.New([]string{
 
 // heartbeatWriter runs on master tablets and writes heartbeats to the _vt.heartbeat
 // table at a regular interval, defined by heartbeat_interval.
+// If heartbeat_on_demand_duration is set, heartbeats are only written for that
+// long after the last call to RequestHeartbeats, and every
+// heartbeat_on_demand_idle_interval otherwise, so that the lag the replicas
+// report in their health stream stays bounded.
 type heartbeatWriter struct {
 	env tabletenv.Env
 
 	enabled       bool
 	interval      time.Duration
+	onDemand      time.Duration
+	onDemandUntil sync2.AtomicInt64
+	idleInterval  time.Duration
+	// lastWrite is the time of the last heartbeat written. It's only used by
+	// the ticks goroutine.
+	lastWrite     time.Time
 	tabletAlias   topodatapb.TabletAlias
 	keyspaceShard string
 	now           func() time.Time
@@ -80,13 +91,15 @@ func newHeartbeatWriter(env tabletenv.Env, alias topodatapb.TabletAlias) *heartb
 	}
 	heartbeatInterval := config.ReplicationTracker.HeartbeatIntervalSeconds.Get()
 	return &heartbeatWriter{
-		env:         env,
-		enabled:     true,
-		tabletAlias: alias,
-		now:         time.Now,
-		interval:    heartbeatInterval,
-		ticks:       timer.NewTimer(heartbeatInterval),
-		errorLog:    logutil.NewThrottledLogger("HeartbeatWriter", 60*time.Second),
+		env:          env,
+		enabled:      true,
+		tabletAlias:  alias,
+		now:          time.Now,
+		interval:     heartbeatInterval,
+		onDemand:     config.ReplicationTracker.HeartbeatOnDemandSeconds.Get(),
+		idleInterval: config.ReplicationTracker.HeartbeatIdleIntervalSeconds.Get(),
+		ticks:        timer.NewTimer(heartbeatInterval),
+		errorLog:     logutil.NewThrottledLogger("HeartbeatWriter", 60*time.Second),
 		pool: connpool.NewPool(env, "HeartbeatWritePool", tabletenv.ConnPoolConfig{
 			Size:               1,
 			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
@@ -151,12 +164,42 @@ func (w *heartbeatWriter) bindHeartbeatVars(query string) (string, error) {
 	return bound, nil
 }
 
+// RequestHeartbeats keeps on-demand heartbeats running for another
+// heartbeat_on_demand_duration. It is a no-op if heartbeats are always on.
+func (w *heartbeatWriter) RequestHeartbeats() {
+	if !w.enabled || w.onDemand == 0 {
+		return
+	}
+	wasIdle := !w.onDemandActive()
+	w.onDemandUntil.Set(w.now().Add(w.onDemand).UnixNano())
+	if wasIdle {
+		// Don't make the requester wait a full interval for the first heartbeat.
+		w.ticks.Trigger()
+	}
+}
+
+// onDemandActive returns true if heartbeats were requested recently enough
+// to be written.
+func (w *heartbeatWriter) onDemandActive() bool {
+	return w.now().UnixNano() < w.onDemandUntil.Get()
+}
+
+// idleWriteDue returns true if a heartbeat must be written while on-demand
+// heartbeats are not requested.
+func (w *heartbeatWriter) idleWriteDue() bool {
+	return w.idleInterval > 0 && w.now().Sub(w.lastWrite) >= w.idleInterval
+}
+
 // writeHeartbeat updates the heartbeat row for this tablet with the current time in nanoseconds.
 func (w *heartbeatWriter) writeHeartbeat() {
+	if w.onDemand > 0 && !w.onDemandActive() && !w.idleWriteDue() {
+		return
+	}
 	if err := w.write(); err != nil {
 		w.recordError(err)
 		return
 	}
+	w.lastWrite = w.now()
 	writes.Add(1)
 }
 
@@ -93,6 +93,46 @@ func TestWriteHeartbeatError(t *testing.T) {
 	assert.Equal(t, int64(1), writeErrors.Get())
 }
 
+func TestWriteHeartbeatOnDemand(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+
+	tw := newTestWriter(db, mockNowFunc)
+	tw.onDemand = 10 * time.Second
+	upsert := fmt.Sprintf("INSERT INTO %s.heartbeat (ts, tabletUid, keyspaceShard) VALUES (%d, %d, '%s') ON DUPLICATE KEY UPDATE ts=VALUES(ts), tabletUid=VALUES(tabletUid)",
+		"_vt", now.UnixNano(), tw.tabletAlias.Uid, tw.keyspaceShard)
+	db.AddQuery(upsert, &sqltypes.Result{})
+
+	writes.Reset()
+	writeErrors.Reset()
+
+	// No heartbeats were requested yet.
+	tw.writeHeartbeat()
+	assert.Equal(t, int64(0), writes.Get())
+
+	tw.RequestHeartbeats()
+	tw.writeHeartbeat()
+	assert.Equal(t, int64(1), writes.Get())
+
+	// The request expires after the on-demand duration.
+	tw.now = func() time.Time { return now.Add(11 * time.Second) }
+	tw.writeHeartbeat()
+	assert.Equal(t, int64(1), writes.Get())
+	assert.Equal(t, int64(0), writeErrors.Get())
+
+	// While idle, heartbeats are still written every idle interval.
+	tw.idleInterval = 30 * time.Second
+	tw.writeHeartbeat()
+	assert.Equal(t, int64(1), writes.Get())
+	idleNow := now.Add(30 * time.Second)
+	db.AddQuery(fmt.Sprintf("INSERT INTO %s.heartbeat (ts, tabletUid, keyspaceShard) VALUES (%d, %d, '%s') ON DUPLICATE KEY UPDATE ts=VALUES(ts), tabletUid=VALUES(tabletUid)",
+		"_vt", idleNow.UnixNano(), tw.tabletAlias.Uid, tw.keyspaceShard), &sqltypes.Result{})
+	tw.now = func() time.Time { return idleNow }
+	tw.writeHeartbeat()
+	assert.Equal(t, int64(2), writes.Get())
+	assert.Equal(t, int64(0), writeErrors.Get())
+}
+
 func newTestWriter(db *fakesqldb.DB, nowFunc func() time.Time) *heartbeatWriter {
 	config := tabletenv.NewDefaultConfig()
 	config.ReplicationTracker.Mode = tabletenv.Heartbeat
@@ -0,0 +1,212 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/cache"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+// resultCache caches the results of selects annotated with the
+// CACHEABLE_FOR directive, for the duration of the directive.
+//
+// Entries are invalidated by generations: every write to a table and
+// every schema change increments the generation, and records it for the
+// table or the schema. An entry is only valid if it was fetched after the
+// last invalidation of the schema and of all the tables it reads. Writes
+// are detected when vttablet executes them, so writes which don't go
+// through vttablet, like replicated ones, are only visible once the
+// entries expire.
+//
+// The writes of a transaction are only visible to the other connections
+// once it is committed, so the results of the tables it writes are not
+// cached until it ends.
+type resultCache struct {
+	results *cache.LRUCache
+
+	// mu protects the following fields.
+	mu               sync.Mutex
+	generation       int64
+	schemaGeneration int64
+	tableGenerations map[string]int64
+	// writers counts the open transactions writing each table.
+	writers map[string]int
+
+	hits, misses, invalidations *stats.Counter
+}
+
+type cachedResult struct {
+	result     *sqltypes.Result
+	tables     []string
+	generation int64
+	expires    time.Time
+}
+
+// newResultCache returns a cache of at most size bytes of results.
+// It returns nil if size is 0.
+func newResultCache(env tabletenv.Env, size int64) *resultCache {
+	if size <= 0 {
+		return nil
+	}
+	rc := &resultCache{
+		results: cache.NewLRUCache(size, func(v interface{}) int64 {
+			return resultSize(v.(*cachedResult).result)
+		}),
+		tableGenerations: make(map[string]int64),
+		writers:          make(map[string]int),
+		hits:             env.Exporter().NewCounter("ResultCacheHits", "Selects served from the result cache"),
+		misses:           env.Exporter().NewCounter("ResultCacheMisses", "Cacheable selects not found in the result cache"),
+		invalidations:    env.Exporter().NewCounter("ResultCacheInvalidations", "Writes and schema changes which invalidated the result cache"),
+	}
+	env.Exporter().NewGaugeFunc("ResultCacheSize", "Result cache size in bytes", rc.results.UsedCapacity)
+	env.Exporter().NewGaugeFunc("ResultCacheLength", "Number of results in the result cache", func() int64 {
+		return int64(rc.results.Len())
+	})
+	return rc
+}
+
+// key returns the cache key of a query. sql must be the final query, with
+// the bind variables substituted and the comments removed.
+func (rc *resultCache) key(sql string) string {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return strconv.FormatInt(rc.schemaGeneration, 10) + ":" + sql
+}
+
+// Get returns the cached result of key, or nil. It also returns the
+// generation to pass to Set if the result is fetched.
+func (rc *resultCache) Get(key string) (*sqltypes.Result, int64) {
+	rc.mu.Lock()
+	generation := rc.generation
+	rc.mu.Unlock()
+
+	v, ok := rc.results.Get(key)
+	if ok {
+		entry := v.(*cachedResult)
+		if rc.valid(entry) && time.Now().Before(entry.expires) {
+			rc.hits.Add(1)
+			return entry.result, generation
+		}
+		rc.results.Delete(key)
+	}
+	rc.misses.Add(1)
+	return nil, generation
+}
+
+// Set caches the result of key for ttl. generation is the generation
+// returned by Get before the result was fetched: the result is dropped
+// if the tables were written in the meantime.
+func (rc *resultCache) Set(key string, result *sqltypes.Result, tables []string, generation int64, ttl time.Duration) {
+	entry := &cachedResult{
+		result:     result,
+		tables:     tables,
+		generation: generation,
+		expires:    time.Now().Add(ttl),
+	}
+	if !rc.valid(entry) {
+		return
+	}
+	rc.results.Set(key, entry)
+}
+
+func (rc *resultCache) valid(entry *cachedResult) bool {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if entry.generation < rc.schemaGeneration {
+		return false
+	}
+	for _, table := range entry.tables {
+		if entry.generation < rc.tableGenerations[table] || rc.writers[table] > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// InvalidateTables invalidates the results which read the tables.
+func (rc *resultCache) InvalidateTables(tables []string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	for _, table := range tables {
+		rc.tableGenerations[table] = rc.generation
+	}
+	rc.invalidations.Add(1)
+}
+
+// StartWrites invalidates the results which read the tables, and stops
+// caching them until EndWrites is called with the same tables, when the
+// transaction writing them is committed or rolled back.
+func (rc *resultCache) StartWrites(tables []string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	for _, table := range tables {
+		rc.tableGenerations[table] = rc.generation
+		rc.writers[table]++
+	}
+	rc.invalidations.Add(1)
+}
+
+// EndWrites ends the writes started by StartWrites, and invalidates the
+// results read while they were in progress.
+func (rc *resultCache) EndWrites(tables []string) {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.generation++
+	for _, table := range tables {
+		rc.tableGenerations[table] = rc.generation
+		if rc.writers[table] <= 1 {
+			delete(rc.writers, table)
+		} else {
+			rc.writers[table]--
+		}
+	}
+	rc.invalidations.Add(1)
+}
+
+// InvalidateSchema invalidates all the results.
+func (rc *resultCache) InvalidateSchema() {
+	rc.mu.Lock()
+	rc.generation++
+	rc.schemaGeneration = rc.generation
+	// Entries of the previous schema can't be valid any more.
+	rc.tableGenerations = make(map[string]int64)
+	rc.mu.Unlock()
+	rc.results.Clear()
+	rc.invalidations.Add(1)
+}
+
+// resultSize returns the approximate size of a result in bytes.
+func resultSize(result *sqltypes.Result) int64 {
+	size := int64(1)
+	for _, field := range result.Fields {
+		size += int64(len(field.Name) + len(field.Table) + len(field.Database))
+	}
+	for _, row := range result.Rows {
+		for _, value := range row {
+			size += int64(value.Len())
+		}
+	}
+	return size
+}
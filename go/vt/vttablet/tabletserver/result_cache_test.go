@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func TestResultCache(t *testing.T) {
+	env := tabletenv.NewEnv(tabletenv.NewDefaultConfig(), "ResultCacheTest")
+	assert.Nil(t, newResultCache(env, 0))
+	rc := newResultCache(env, 1024)
+	result := sqltypes.MakeTestResult(sqltypes.MakeTestFields("a", "int64"), "1")
+
+	key := rc.key("select a from t")
+	got, gen := rc.Get(key)
+	assert.Nil(t, got)
+	rc.Set(key, result, []string{"t"}, gen, time.Hour)
+	got, _ = rc.Get(key)
+	assert.Equal(t, result, got)
+
+	// Writes to other tables don't invalidate the result.
+	rc.InvalidateTables([]string{"t2"})
+	got, _ = rc.Get(key)
+	assert.Equal(t, result, got)
+
+	// A result fetched while the table is written is not cached.
+	rc.InvalidateTables([]string{"t"})
+	got, gen = rc.Get(key)
+	assert.Nil(t, got)
+	rc.InvalidateTables([]string{"t"})
+	rc.Set(key, result, []string{"t"}, gen, time.Hour)
+	got, gen = rc.Get(key)
+	assert.Nil(t, got)
+
+	// Expired results are dropped.
+	rc.Set(key, result, []string{"t"}, gen, -time.Second)
+	got, gen = rc.Get(key)
+	assert.Nil(t, got)
+
+	// Results are not cached while a transaction writes the table, and
+	// the results read meanwhile are dropped when it ends.
+	rc.StartWrites([]string{"t"})
+	rc.StartWrites([]string{"t"})
+	got, gen = rc.Get(key)
+	assert.Nil(t, got)
+	rc.Set(key, result, []string{"t"}, gen, time.Hour)
+	got, gen = rc.Get(key)
+	assert.Nil(t, got)
+	rc.EndWrites([]string{"t"})
+	rc.Set(key, result, []string{"t"}, gen, time.Hour)
+	got, gen = rc.Get(key)
+	assert.Nil(t, got)
+	rc.EndWrites([]string{"t"})
+	_, gen = rc.Get(key)
+	rc.Set(key, result, []string{"t"}, gen, time.Hour)
+	got, gen = rc.Get(key)
+	assert.Equal(t, result, got)
+
+	// Schema changes change the keys.
+	rc.Set(key, result, []string{"t"}, gen, time.Hour)
+	rc.InvalidateSchema()
+	assert.NotEqual(t, key, rc.key("select a from t"))
+	assert.Equal(t, 0, rc.results.Len())
+}
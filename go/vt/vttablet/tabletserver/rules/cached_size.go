@@ -37,13 +37,31 @@ func (cached *BindVarCond) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *RateLimit) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field limiter *golang.org/x/time/rate.Limiter
+	if cached.limiter != nil {
+		size += int64(80)
+	}
+	// field sem *vitess.io/vitess/go/sync2.Semaphore
+	if cached.sem != nil {
+		size += int64(16)
+	}
+	return size
+}
 func (cached *Rule) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(184)
+		size += int64(424)
 	}
 	// field Description string
 	size += int64(len(cached.Description))
@@ -66,6 +84,35 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 			size += int64(len(elem))
 		}
 	}
+	// field digests []string
+	{
+		size += int64(cap(cached.digests)) * int64(16)
+		for _, elem := range cached.digests {
+			size += int64(len(elem))
+		}
+	}
+	// field injections []vitess.io/vitess/go/vt/sqlparser.InjectionKind
+	{
+		size += int64(cap(cached.injections)) * int64(8)
+	}
+	// field keyspaces []string
+	{
+		size += int64(cap(cached.keyspaces)) * int64(16)
+		for _, elem := range cached.keyspaces {
+			size += int64(len(elem))
+		}
+	}
+	// field shards []string
+	{
+		size += int64(cap(cached.shards)) * int64(16)
+		for _, elem := range cached.shards {
+			size += int64(len(elem))
+		}
+	}
+	// field tabletTypes []vitess.io/vitess/go/vt/proto/topodata.TabletType
+	{
+		size += int64(cap(cached.tabletTypes)) * int64(4)
+	}
 	// field bindVarConds []vitess.io/vitess/go/vt/vttablet/tabletserver/rules.BindVarCond
 	{
 		size += int64(cap(cached.bindVarConds)) * int64(48)
@@ -73,6 +120,31 @@ func (cached *Rule) CachedSize(alloc bool) int64 {
 			size += elem.CachedSize(false)
 		}
 	}
+	// field schedule []vitess.io/vitess/go/vt/vttablet/tabletserver/rules.TimeWindow
+	{
+		size += int64(cap(cached.schedule)) * int64(48)
+		for _, elem := range cached.schedule {
+			size += elem.CachedSize(false)
+		}
+	}
+	// field rateLimit *vitess.io/vitess/go/vt/vttablet/tabletserver/rules.RateLimit
+	size += cached.rateLimit.CachedSize(true)
+	// field rewrite *vitess.io/vitess/go/vt/vttablet/tabletserver/rules.Rewrite
+	size += cached.rewrite.CachedSize(true)
+	return size
+}
+func (cached *Rewrite) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(32)
+	}
+	// field IndexHint *vitess.io/vitess/go/vt/sqlparser.IndexHints
+	size += cached.IndexHint.CachedSize(true)
+	// field Comment string
+	size += int64(len(cached.Comment))
 	return size
 }
 func (cached *Rules) CachedSize(alloc bool) int64 {
@@ -92,6 +164,48 @@ func (cached *Rules) CachedSize(alloc bool) int64 {
 	}
 	return size
 }
+func (cached *TimeWindow) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(48)
+	}
+	// field Days []time.Weekday
+	{
+		size += int64(cap(cached.Days)) * int64(8)
+	}
+	// field Location *time.Location
+	if cached.Location != nil {
+		size += int64(104)
+	}
+	return size
+}
+func (cached *bvclen) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	// field value vitess.io/vitess/go/vt/vttablet/tabletserver/rules.bvcValue
+	if cc, ok := cached.value.(cachedObject); ok {
+		size += cc.CachedSize(true)
+	}
+	return size
+}
+func (cached *bvcrange) CachedSize(alloc bool) int64 {
+	if cached == nil {
+		return int64(0)
+	}
+	size := int64(0)
+	if alloc {
+		size += int64(16)
+	}
+	return size
+}
 func (cached *bvcre) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
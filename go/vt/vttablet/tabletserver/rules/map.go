@@ -19,6 +19,7 @@ package rules
 import (
 	"encoding/json"
 	"errors"
+	"sort"
 	"sync"
 
 	"vitess.io/vitess/go/vt/log"
@@ -31,12 +32,24 @@ type Map struct {
 	mu sync.Mutex
 	// queryRulesMap maps the names of different query rule sources to the actual Rules structure
 	queryRulesMap map[string]*Rules
+	// signers maps the names of the query rule sources to the verified
+	// signers of their current rules, if the rules are signed.
+	signers map[string]string
+}
+
+// Source is a query rule source, with its current rules and the
+// verified signer of these rules, if they are signed.
+type Source struct {
+	Name   string
+	Signer string `json:",omitempty"`
+	Rules  *Rules
 }
 
 // NewMap returns an empty Map object.
 func NewMap() *Map {
 	qri := &Map{
 		queryRulesMap: map[string]*Rules{},
+		signers:       map[string]string{},
 	}
 	return qri
 }
@@ -57,6 +70,7 @@ func (qri *Map) UnRegisterSource(ruleSource string) {
 	qri.mu.Lock()
 	defer qri.mu.Unlock()
 	delete(qri.queryRulesMap, ruleSource)
+	delete(qri.signers, ruleSource)
 }
 
 // SetRules takes an external Rules structure and overwrite one of the
@@ -74,6 +88,34 @@ func (qri *Map) SetRules(ruleSource string, newRules *Rules) error {
 	return errors.New("Rule source identifier " + ruleSource + " is not valid")
 }
 
+// SetSigner records the verified signer of the current rules of
+// ruleSource. An empty signer means that the rules are not signed.
+func (qri *Map) SetSigner(ruleSource, signer string) error {
+	qri.mu.Lock()
+	defer qri.mu.Unlock()
+	if _, ok := qri.queryRulesMap[ruleSource]; !ok {
+		return errors.New("Rule source identifier " + ruleSource + " is not valid")
+	}
+	if signer == "" {
+		delete(qri.signers, ruleSource)
+	} else {
+		qri.signers[ruleSource] = signer
+	}
+	return nil
+}
+
+// Sources returns the registered query rule sources, sorted by name.
+func (qri *Map) Sources() []Source {
+	qri.mu.Lock()
+	defer qri.mu.Unlock()
+	sources := make([]Source, 0, len(qri.queryRulesMap))
+	for name, qrs := range qri.queryRulesMap {
+		sources = append(sources, Source{Name: name, Signer: qri.signers[name], Rules: qrs.Copy()})
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Name < sources[j].Name })
+	return sources
+}
+
 // Get returns the corresponding Rules as designated by ruleSource parameter.
 func (qri *Map) Get(ruleSource string) (*Rules, error) {
 	qri.mu.Lock()
@@ -86,12 +128,13 @@ func (qri *Map) Get(ruleSource string) (*Rules, error) {
 
 // FilterByPlan creates a new Rules by prefiltering on all query rules that are contained in internal
 // Rules structures, in other words, query rules from all predefined sources will be applied.
-func (qri *Map) FilterByPlan(query string, planid planbuilder.PlanType, tableName string) (newqrs *Rules) {
+func (qri *Map) FilterByPlan(query string, planid planbuilder.PlanType, tableName string, estimatedRows int64) (newqrs *Rules) {
 	qri.mu.Lock()
 	defer qri.mu.Unlock()
 	newqrs = New()
+	digest := &queryDigest{query: query}
 	for _, rules := range qri.queryRulesMap {
-		newqrs.Append(rules.FilterByPlan(query, planid, tableName))
+		newqrs.Append(rules.filterByPlan(digest, planid, tableName, estimatedRows))
 	}
 	return newqrs
 }
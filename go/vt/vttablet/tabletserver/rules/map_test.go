@@ -21,6 +21,9 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 )
 
@@ -174,7 +177,7 @@ func TestMapFilterByPlan(t *testing.T) {
 	qri.SetRules(customQueryRules, otherRules)
 
 	// Test filter by blacklist rule
-	qrs = qri.FilterByPlan("select * from bannedtable2", planbuilder.PlanSelect, "bannedtable2")
+	qrs = qri.FilterByPlan("select * from bannedtable2", planbuilder.PlanSelect, "bannedtable2", planbuilder.UnboundedRows)
 	if l := len(qrs.rules); l != 1 {
 		t.Errorf("Select from bannedtable matches %d rules, but we expect %d", l, 1)
 	}
@@ -183,7 +186,7 @@ func TestMapFilterByPlan(t *testing.T) {
 	}
 
 	// Test filter by custom rule
-	qrs = qri.FilterByPlan("select cid from t_customer limit 10", planbuilder.PlanSelect, "t_customer")
+	qrs = qri.FilterByPlan("select cid from t_customer limit 10", planbuilder.PlanSelect, "t_customer", 10)
 	if l := len(qrs.rules); l != 1 {
 		t.Errorf("Select from t_customer matches %d rules, but we expect %d", l, 1)
 	}
@@ -197,7 +200,7 @@ func TestMapFilterByPlan(t *testing.T) {
 	qr.AddBindVarCond("bindvar1", true, false, QRNoOp, nil)
 	otherRules.Add(qr)
 	qri.SetRules(customQueryRules, otherRules)
-	qrs = qri.FilterByPlan("select * from bannedtable2", planbuilder.PlanSelect, "bannedtable2")
+	qrs = qri.FilterByPlan("select * from bannedtable2", planbuilder.PlanSelect, "bannedtable2", planbuilder.UnboundedRows)
 	if l := len(qrs.rules); l != 2 {
 		t.Errorf("Insert into bannedtable2 matches %d rules: %v, but we expect %d rules to be matched", l, qrs.rules, 2)
 	}
@@ -230,3 +233,27 @@ func TestMapJSON(t *testing.T) {
 		t.Errorf("MapJSON:\n%v, want\n%v", got, want)
 	}
 }
+
+func TestMapSources(t *testing.T) {
+	setupRules()
+	qri := NewMap()
+	qri.RegisterSource(customQueryRules)
+	_ = qri.SetRules(customQueryRules, otherRules)
+	qri.RegisterSource(blacklistQueryRules)
+	_ = qri.SetRules(blacklistQueryRules, blacklistRules)
+
+	require.NoError(t, qri.SetSigner(customQueryRules, "ops"))
+	require.Error(t, qri.SetSigner("UNKNOWN", "ops"))
+	got := qri.Sources()
+	require.Len(t, got, 2)
+	assert.Equal(t, Source{Name: blacklistQueryRules, Rules: blacklistRules}, got[0])
+	assert.Equal(t, Source{Name: customQueryRules, Signer: "ops", Rules: otherRules}, got[1])
+
+	// Unsigned rules clear the signer.
+	require.NoError(t, qri.SetSigner(customQueryRules, ""))
+	assert.Equal(t, "", qri.Sources()[1].Signer)
+
+	qri.UnRegisterSource(customQueryRules)
+	qri.RegisterSource(customQueryRules)
+	assert.Equal(t, "", qri.Sources()[1].Signer)
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"golang.org/x/time/rate"
+
+	"vitess.io/vitess/go/sync2"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// RateLimit is the budget of a QRRateLimit rule. The budget is shared by
+// all copies of the Rule, which means that all the queries matching the
+// rule draw from it, whatever their plan.
+type RateLimit struct {
+	// QPS is the max number of queries per second. 0 means unlimited.
+	// It is enforced by a token bucket holding up to one second worth of
+	// queries, so the queries can't burst over QPS in any second.
+	QPS int
+	// MaxConcurrency is the max number of queries executing at the
+	// same time. 0 means unlimited.
+	MaxConcurrency int
+
+	limiter *rate.Limiter
+	sem     *sync2.Semaphore
+}
+
+// NewRateLimit creates a RateLimit.
+func NewRateLimit(qps, maxConcurrency int) *RateLimit {
+	rl := &RateLimit{
+		QPS:            qps,
+		MaxConcurrency: maxConcurrency,
+	}
+	if qps > 0 {
+		rl.limiter = rate.NewLimiter(rate.Limit(qps), qps)
+	}
+	if maxConcurrency > 0 {
+		rl.sem = sync2.NewSemaphore(maxConcurrency, 0)
+	}
+	return rl
+}
+
+// acquire tries to take a query from the budget. If it succeeds, the
+// returned release function must be called when the query is done.
+func (rl *RateLimit) acquire() (release func(), ok bool) {
+	if rl.sem != nil && !rl.sem.TryAcquire() {
+		return nil, false
+	}
+	if rl.limiter != nil && !rl.limiter.AllowN(timeNow(), 1) {
+		if rl.sem != nil {
+			rl.sem.Release()
+		}
+		return nil, false
+	}
+	if rl.sem != nil {
+		return rl.sem.Release, true
+	}
+	return func() {}, true
+}
+
+// Equal returns true if other has the same budget.
+func (rl *RateLimit) Equal(other *RateLimit) bool {
+	if rl == nil || other == nil {
+		return rl == nil && other == nil
+	}
+	return rl.QPS == other.QPS && rl.MaxConcurrency == other.MaxConcurrency
+}
+
+// MarshalJSON marshals to JSON.
+func (rl *RateLimit) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBuffer(nil)
+	safeEncode(b, `{"QPS":`, rl.QPS)
+	safeEncode(b, `,"MaxConcurrency":`, rl.MaxConcurrency)
+	_, _ = b.WriteString("}")
+	return b.Bytes(), nil
+}
+
+// SetRateLimit sets the budget used when the action of the rule is
+// QRRateLimit.
+func (qr *Rule) SetRateLimit(qps, maxConcurrency int) {
+	qr.rateLimit = NewRateLimit(qps, maxConcurrency)
+}
+
+// Admit runs the input against the rules engine like GetAction, but also
+// enforces the budgets of QRRateLimit rules: a matching rule with some budget
+// left lets the evaluation continue, while a rule without budget stops it and
+// QRRateLimit is returned. The matching rule is returned for any action other
+// than QRContinue. A matching QRRewrite rule also lets the evaluation
+// continue, and is returned with QRRewrite if no other rule stops it; only
+// the first one applies. The release function must be called once the
+// query is done. It is never nil. Rules in monitor mode are skipped.
+func (qrs *Rules) Admit(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, rule *Rule, release func()) {
+	var releases []func()
+	release = func() {
+		for _, f := range releases {
+			f()
+		}
+	}
+	var rewriteRule *Rule
+	for _, qr := range qrs.rules {
+		if qr.mode == ModeMonitor {
+			continue
+		}
+		act := qr.GetAction(ip, user, bindVars)
+		switch act {
+		case QRContinue:
+			continue
+		case QRRateLimit:
+			if qr.rateLimit == nil {
+				continue
+			}
+			if f, ok := qr.rateLimit.acquire(); ok {
+				releases = append(releases, f)
+				continue
+			}
+		case QRRewrite:
+			if rewriteRule == nil && qr.rewrite != nil {
+				rewriteRule = qr
+			}
+			continue
+		}
+		release()
+		return act, qr, func() {}
+	}
+	if rewriteRule != nil {
+		return QRRewrite, rewriteRule, release
+	}
+	return QRContinue, nil, release
+}
+
+func buildRateLimit(v interface{}) (*RateLimit, error) {
+	info, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want json object for RateLimit")
+	}
+	var qps, maxConcurrency int64
+	for k, v := range info {
+		num, ok := v.(json.Number)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s in RateLimit", k)
+		}
+		n, err := num.Int64()
+		if err != nil || n < 0 {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want non-negative integer for %s in RateLimit: %v", k, num)
+		}
+		switch k {
+		case "QPS":
+			qps = n
+		case "MaxConcurrency":
+			maxConcurrency = n
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s in RateLimit", k)
+		}
+	}
+	return NewRateLimit(int(qps), int(maxConcurrency)), nil
+}
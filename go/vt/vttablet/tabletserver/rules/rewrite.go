@@ -0,0 +1,246 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// Rewrite is the transformation applied by a QRRewrite rule to the
+// queries it matches.
+type Rewrite struct {
+	// Limit, if > 0, caps the number of rows of SELECT, UPDATE and DELETE
+	// statements by adding a LIMIT clause or lowering the existing one.
+	Limit int
+	// IndexHint, if not nil, replaces the index hint of the first table
+	// of SELECT statements, which is the leftmost table of a join.
+	IndexHint *sqlparser.IndexHints
+	// Comment, if not empty, is added as a comment to the statement.
+	Comment string
+}
+
+var indexHintTypes = map[string]sqlparser.IndexHintsType{
+	"USE":    sqlparser.UseOp,
+	"IGNORE": sqlparser.IgnoreOp,
+	"FORCE":  sqlparser.ForceOp,
+}
+
+// Apply parses sql, applies the rewrite, and returns the new query.
+// Statements which are not SELECT, INSERT, UPDATE or DELETE are returned
+// unchanged.
+func (rw *Rewrite) Apply(sql string) (string, error) {
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return "", err
+	}
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		stmt.Limit = rw.capLimit(stmt.Limit)
+		stmt.Comments = rw.addComment(stmt.Comments)
+		if rw.IndexHint != nil && len(stmt.From) > 0 {
+			if ate := firstTable(stmt.From[0]); ate != nil {
+				ate.Hints = rw.IndexHint
+			}
+		}
+	case *sqlparser.Update:
+		stmt.Limit = rw.capLimit(stmt.Limit)
+		stmt.Comments = rw.addComment(stmt.Comments)
+	case *sqlparser.Delete:
+		stmt.Limit = rw.capLimit(stmt.Limit)
+		stmt.Comments = rw.addComment(stmt.Comments)
+	case *sqlparser.Insert:
+		stmt.Comments = rw.addComment(stmt.Comments)
+	default:
+		return sql, nil
+	}
+	return sqlparser.String(stmt), nil
+}
+
+// firstTable returns the leftmost table of a table expression, or nil if
+// it is a derived table.
+func firstTable(expr sqlparser.TableExpr) *sqlparser.AliasedTableExpr {
+	switch expr := expr.(type) {
+	case *sqlparser.AliasedTableExpr:
+		if _, ok := expr.Expr.(sqlparser.TableName); ok {
+			return expr
+		}
+	case *sqlparser.JoinTableExpr:
+		return firstTable(expr.LeftExpr)
+	case *sqlparser.ParenTableExpr:
+		if len(expr.Exprs) > 0 {
+			return firstTable(expr.Exprs[0])
+		}
+	}
+	return nil
+}
+
+// capLimit returns a limit that returns at most rw.Limit rows. Offsets
+// and limits which are not integer literals are preserved.
+func (rw *Rewrite) capLimit(limit *sqlparser.Limit) *sqlparser.Limit {
+	if rw.Limit <= 0 {
+		return limit
+	}
+	newLimit := sqlparser.NewIntLiteral(strconv.Itoa(rw.Limit))
+	if limit == nil {
+		return &sqlparser.Limit{Rowcount: newLimit}
+	}
+	if lit, ok := limit.Rowcount.(*sqlparser.Literal); ok && lit.Type == sqlparser.IntVal {
+		if n, err := strconv.Atoi(lit.Val); err == nil && n <= rw.Limit {
+			return limit
+		}
+	}
+	return &sqlparser.Limit{Offset: limit.Offset, Rowcount: newLimit}
+}
+
+func (rw *Rewrite) addComment(comments sqlparser.Comments) sqlparser.Comments {
+	if rw.Comment == "" {
+		return comments
+	}
+	// Prevent the comment from terminating early.
+	text := strings.ReplaceAll(rw.Comment, "*/", "* /")
+	return append(comments, "/* "+text+" */")
+}
+
+// Equal returns true if other is the same rewrite.
+func (rw *Rewrite) Equal(other *Rewrite) bool {
+	if rw == nil || other == nil {
+		return rw == nil && other == nil
+	}
+	if rw.Limit != other.Limit || rw.Comment != other.Comment {
+		return false
+	}
+	if rw.IndexHint == nil || other.IndexHint == nil {
+		return rw.IndexHint == nil && other.IndexHint == nil
+	}
+	return sqlparser.EqualsRefOfIndexHints(rw.IndexHint, other.IndexHint)
+}
+
+// MarshalJSON marshals to JSON.
+func (rw *Rewrite) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBuffer(nil)
+	_, _ = b.WriteString("{")
+	sep := ""
+	if rw.Limit > 0 {
+		safeEncode(b, `"Limit":`, rw.Limit)
+		sep = ","
+	}
+	if rw.IndexHint != nil {
+		var typ string
+		for name, t := range indexHintTypes {
+			if t == rw.IndexHint.Type {
+				typ = name
+			}
+		}
+		indexes := make([]string, 0, len(rw.IndexHint.Indexes))
+		for _, idx := range rw.IndexHint.Indexes {
+			indexes = append(indexes, idx.String())
+		}
+		safeEncode(b, sep+`"IndexHint":{"Type":`, typ)
+		safeEncode(b, `,"Indexes":`, indexes)
+		_, _ = b.WriteString("}")
+		sep = ","
+	}
+	if rw.Comment != "" {
+		safeEncode(b, sep+`"Comment":`, rw.Comment)
+	}
+	_, _ = b.WriteString("}")
+	return b.Bytes(), nil
+}
+
+// SetRewrite sets the transformation used when the action of the rule is
+// QRRewrite.
+func (qr *Rule) SetRewrite(rw *Rewrite) {
+	qr.rewrite = rw
+}
+
+// Rewrite returns the transformation of a QRRewrite rule.
+func (qr *Rule) Rewrite() *Rewrite {
+	return qr.rewrite
+}
+
+func buildRewrite(v interface{}) (*Rewrite, error) {
+	info, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want json object for Rewrite")
+	}
+	rw := &Rewrite{}
+	for k, v := range info {
+		switch k {
+		case "Limit":
+			num, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for Limit in Rewrite")
+			}
+			n, err := num.Int64()
+			if err != nil || n <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive integer for Limit in Rewrite: %v", num)
+			}
+			rw.Limit = int(n)
+		case "Comment":
+			sv, ok := v.(string)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Comment in Rewrite")
+			}
+			rw.Comment = sv
+		case "IndexHint":
+			hint, err := buildIndexHint(v)
+			if err != nil {
+				return nil, err
+			}
+			rw.IndexHint = hint
+		default:
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s in Rewrite", k)
+		}
+	}
+	return rw, nil
+}
+
+func buildIndexHint(v interface{}) (*sqlparser.IndexHints, error) {
+	info, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want json object for IndexHint")
+	}
+	typ, ok := info["Type"].(string)
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Type in IndexHint")
+	}
+	hintType, ok := indexHintTypes[strings.ToUpper(typ)]
+	if !ok {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Type in IndexHint: %s", typ)
+	}
+	lv, ok := info["Indexes"].([]interface{})
+	if !ok || len(lv) == 0 {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want non-empty list for Indexes in IndexHint")
+	}
+	hint := &sqlparser.IndexHints{Type: hintType}
+	for _, idx := range lv {
+		name, ok := idx.(string)
+		if !ok {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Indexes in IndexHint")
+		}
+		hint.Indexes = append(hint.Indexes, sqlparser.NewColIdent(name))
+	}
+	return hint, nil
+}
@@ -23,14 +23,18 @@ import (
 	"reflect"
 	"regexp"
 	"strconv"
+	"time"
 
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -154,11 +158,30 @@ func (qrs *Rules) MarshalJSON() ([]byte, error) {
 
 // FilterByPlan creates a new Rules by prefiltering on the query and planId. This allows
 // us to create query plan specific Rules out of the original Rules. In the new rules,
-// query, plans and tableNames predicates are empty.
-func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableName string) (newqrs *Rules) {
+// query, plans, tableNames and estimated rows predicates are empty.
+func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableName string, estimatedRows int64) (newqrs *Rules) {
+	return qrs.filterByPlan(&queryDigest{query: query}, planid, tableName, estimatedRows)
+}
+
+// filterByPlan is FilterByPlan with a digest shared by the rules, as it
+// is expensive to compute.
+func (qrs *Rules) filterByPlan(digest *queryDigest, planid planbuilder.PlanType, tableName string, estimatedRows int64) (newqrs *Rules) {
+	var newrules []*Rule
+	for _, qr := range qrs.rules {
+		if newrule := qr.filterByPlan(digest, planid, tableName, estimatedRows); newrule != nil {
+			newrules = append(newrules, newrule)
+		}
+	}
+	return &Rules{newrules}
+}
+
+// FilterByTarget creates a new Rules with the rules scoped to the keyspace,
+// shard and tablet type. In the new rules, the keyspace, shard and tablet
+// type predicates are empty.
+func (qrs *Rules) FilterByTarget(keyspace, shard string, tabletType topodatapb.TabletType) (newqrs *Rules) {
 	var newrules []*Rule
 	for _, qr := range qrs.rules {
-		if newrule := qr.FilterByPlan(query, planid, tableName); newrule != nil {
+		if newrule := qr.FilterByTarget(keyspace, shard, tabletType); newrule != nil {
 			newrules = append(newrules, newrule)
 		}
 	}
@@ -166,8 +189,12 @@ func (qrs *Rules) FilterByPlan(query string, planid planbuilder.PlanType, tableN
 }
 
 // GetAction runs the input against the rules engine and returns the action to be performed.
+// Rules in monitor mode are skipped.
 func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) (action Action, desc string) {
 	for _, qr := range qrs.rules {
+		if qr.mode == ModeMonitor {
+			continue
+		}
 		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
 			return act, qr.Description
 		}
@@ -175,6 +202,35 @@ func (qrs *Rules) GetAction(ip, user string, bindVars map[string]*querypb.BindVa
 	return QRContinue, ""
 }
 
+// QueryTimeout returns the smallest query timeout of the rules which match
+// the input, or 0 if none of them sets a timeout. Rules in monitor mode
+// are skipped.
+func (qrs *Rules) QueryTimeout(ip, user string, bindVars map[string]*querypb.BindVariable) time.Duration {
+	var timeout time.Duration
+	for _, qr := range qrs.rules {
+		if qr.mode == ModeMonitor || qr.timeout == 0 {
+			continue
+		}
+		if (timeout == 0 || qr.timeout < timeout) && qr.matches(ip, user, bindVars) {
+			timeout = qr.timeout
+		}
+	}
+	return timeout
+}
+
+// Monitor returns the rules in monitor mode which match the input.
+func (qrs *Rules) Monitor(ip, user string, bindVars map[string]*querypb.BindVariable) (matched []*Rule) {
+	for _, qr := range qrs.rules {
+		if qr.mode != ModeMonitor {
+			continue
+		}
+		if act := qr.GetAction(ip, user, bindVars); act != QRContinue {
+			matched = append(matched, qr)
+		}
+	}
+	return matched
+}
+
 //-----------------------------------------------
 
 // Rule represents one rule (conditions-action).
@@ -200,11 +256,51 @@ type Rule struct {
 	// Any matched tableNames will make this condition true (OR)
 	tableNames []string
 
+	// Any matched digest of the query will make this condition true (OR)
+	digests []string
+
+	// Any kind of SQL injection found in the query will make this
+	// condition true (OR). See sqlparser.FindInjections.
+	injections []sqlparser.InjectionKind
+
+	// Any matched keyspace, shard or tablet type of the tablet will make
+	// the respective condition true (OR). They are checked by
+	// FilterByTarget.
+	keyspaces, shards []string
+	tabletTypes       []topodatapb.TabletType
+
+	// The estimated rows of the plan must be at least minRows,
+	// or unbounded. 0 means no condition.
+	minRows int64
+
+	// If set, the plan must have unbounded estimated rows.
+	unbounded bool
+
 	// All BindVar conditions have to be fulfilled to make this true (AND)
 	bindVarConds []BindVarCond
 
+	// The rule is only active in [activeFrom, activeUntil).
+	// Zero values are unbounded.
+	activeFrom, activeUntil time.Time
+
+	// Any matched window will make this condition true (OR)
+	schedule []TimeWindow
+
 	// Action to be performed on trigger
 	act Action
+
+	// In monitor mode, the action is not performed.
+	mode Mode
+
+	// Query timeout of the matched queries. 0 means the configured
+	// timeout applies.
+	timeout time.Duration
+
+	// Budget for the QRRateLimit action. It is shared by all copies.
+	rateLimit *RateLimit
+
+	// Transformation for the QRRewrite action.
+	rewrite *Rewrite
 }
 
 type namedRegexp struct {
@@ -243,8 +339,22 @@ func (qr *Rule) Equal(other *Rule) bool {
 		qr.query.Equal(other.query) &&
 		reflect.DeepEqual(qr.plans, other.plans) &&
 		reflect.DeepEqual(qr.tableNames, other.tableNames) &&
+		reflect.DeepEqual(qr.digests, other.digests) &&
+		reflect.DeepEqual(qr.injections, other.injections) &&
+		reflect.DeepEqual(qr.keyspaces, other.keyspaces) &&
+		reflect.DeepEqual(qr.shards, other.shards) &&
+		reflect.DeepEqual(qr.tabletTypes, other.tabletTypes) &&
+		qr.minRows == other.minRows &&
+		qr.unbounded == other.unbounded &&
 		reflect.DeepEqual(qr.bindVarConds, other.bindVarConds) &&
-		qr.act == other.act)
+		qr.activeFrom.Equal(other.activeFrom) &&
+		qr.activeUntil.Equal(other.activeUntil) &&
+		scheduleEqual(qr.schedule, other.schedule) &&
+		qr.act == other.act &&
+		qr.mode == other.mode &&
+		qr.timeout == other.timeout &&
+		qr.rateLimit.Equal(other.rateLimit) &&
+		qr.rewrite.Equal(other.rewrite))
 }
 
 // Copy performs a deep copy of a Rule.
@@ -255,7 +365,15 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		requestIP:   qr.requestIP,
 		user:        qr.user,
 		query:       qr.query,
+		minRows:     qr.minRows,
+		unbounded:   qr.unbounded,
+		activeFrom:  qr.activeFrom,
+		activeUntil: qr.activeUntil,
 		act:         qr.act,
+		mode:        qr.mode,
+		timeout:     qr.timeout,
+		rateLimit:   qr.rateLimit,
+		rewrite:     qr.rewrite,
 	}
 	if qr.plans != nil {
 		newqr.plans = make([]planbuilder.PlanType, len(qr.plans))
@@ -265,10 +383,34 @@ func (qr *Rule) Copy() (newqr *Rule) {
 		newqr.tableNames = make([]string, len(qr.tableNames))
 		copy(newqr.tableNames, qr.tableNames)
 	}
+	if qr.digests != nil {
+		newqr.digests = make([]string, len(qr.digests))
+		copy(newqr.digests, qr.digests)
+	}
+	if qr.injections != nil {
+		newqr.injections = make([]sqlparser.InjectionKind, len(qr.injections))
+		copy(newqr.injections, qr.injections)
+	}
+	if qr.keyspaces != nil {
+		newqr.keyspaces = make([]string, len(qr.keyspaces))
+		copy(newqr.keyspaces, qr.keyspaces)
+	}
+	if qr.shards != nil {
+		newqr.shards = make([]string, len(qr.shards))
+		copy(newqr.shards, qr.shards)
+	}
+	if qr.tabletTypes != nil {
+		newqr.tabletTypes = make([]topodatapb.TabletType, len(qr.tabletTypes))
+		copy(newqr.tabletTypes, qr.tabletTypes)
+	}
 	if qr.bindVarConds != nil {
 		newqr.bindVarConds = make([]BindVarCond, len(qr.bindVarConds))
 		copy(newqr.bindVarConds, qr.bindVarConds)
 	}
+	if qr.schedule != nil {
+		newqr.schedule = make([]TimeWindow, len(qr.schedule))
+		copy(newqr.schedule, qr.schedule)
+	}
 	return newqr
 }
 
@@ -292,12 +434,62 @@ func (qr *Rule) MarshalJSON() ([]byte, error) {
 	if qr.tableNames != nil {
 		safeEncode(b, `,"TableNames":`, qr.tableNames)
 	}
+	if qr.digests != nil {
+		safeEncode(b, `,"Digests":`, qr.digests)
+	}
+	if qr.injections != nil {
+		injections := make([]string, 0, len(qr.injections))
+		for _, kind := range qr.injections {
+			injections = append(injections, kind.String())
+		}
+		safeEncode(b, `,"Injections":`, injections)
+	}
+	if qr.keyspaces != nil {
+		safeEncode(b, `,"Keyspaces":`, qr.keyspaces)
+	}
+	if qr.shards != nil {
+		safeEncode(b, `,"Shards":`, qr.shards)
+	}
+	if qr.tabletTypes != nil {
+		tabletTypes := make([]string, 0, len(qr.tabletTypes))
+		for _, tabletType := range qr.tabletTypes {
+			tabletTypes = append(tabletTypes, tabletType.String())
+		}
+		safeEncode(b, `,"TabletTypes":`, tabletTypes)
+	}
+	if qr.minRows != 0 {
+		safeEncode(b, `,"MinEstimatedRows":`, qr.minRows)
+	}
+	if qr.unbounded {
+		safeEncode(b, `,"Unbounded":`, qr.unbounded)
+	}
 	if qr.bindVarConds != nil {
 		safeEncode(b, `,"BindVarConds":`, qr.bindVarConds)
 	}
+	if !qr.activeFrom.IsZero() {
+		safeEncode(b, `,"ActiveFrom":`, qr.activeFrom.Format(time.RFC3339))
+	}
+	if !qr.activeUntil.IsZero() {
+		safeEncode(b, `,"ActiveUntil":`, qr.activeUntil.Format(time.RFC3339))
+	}
+	if qr.schedule != nil {
+		safeEncode(b, `,"Schedule":`, qr.schedule)
+	}
 	if qr.act != QRContinue {
 		safeEncode(b, `,"Action":`, qr.act)
 	}
+	if qr.mode != ModeEnforce {
+		safeEncode(b, `,"Mode":`, qr.mode)
+	}
+	if qr.timeout != 0 {
+		safeEncode(b, `,"QueryTimeout":`, qr.timeout.Seconds())
+	}
+	if qr.rateLimit != nil {
+		safeEncode(b, `,"RateLimit":`, qr.rateLimit)
+	}
+	if qr.rewrite != nil {
+		safeEncode(b, `,"Rewrite":`, qr.rewrite)
+	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
 }
@@ -332,6 +524,58 @@ func (qr *Rule) AddTableCond(tableName string) {
 	qr.tableNames = append(qr.tableNames, tableName)
 }
 
+// AddDigestCond adds to the list of query digests that can be matched for
+// the rule to fire. See Digest.
+// This function acts as an OR: Any digest match is considered a match.
+func (qr *Rule) AddDigestCond(digest string) {
+	qr.digests = append(qr.digests, digest)
+}
+
+// AddInjectionCond adds to the list of kinds of SQL injections that can be
+// found in the query for the rule to fire. See sqlparser.FindInjections.
+// This function acts as an OR: Any kind found is considered a match.
+func (qr *Rule) AddInjectionCond(kind sqlparser.InjectionKind) {
+	qr.injections = append(qr.injections, kind)
+}
+
+// AddKeyspaceCond adds to the list of keyspaces the rule is scoped to.
+// This function acts as an OR: Any keyspace match is considered a match.
+func (qr *Rule) AddKeyspaceCond(keyspace string) {
+	qr.keyspaces = append(qr.keyspaces, keyspace)
+}
+
+// AddShardCond adds to the list of shards the rule is scoped to.
+// This function acts as an OR: Any shard match is considered a match.
+func (qr *Rule) AddShardCond(shard string) {
+	qr.shards = append(qr.shards, shard)
+}
+
+// AddTabletTypeCond adds to the list of tablet types the rule is scoped to.
+// This function acts as an OR: Any tablet type match is considered a match.
+func (qr *Rule) AddTabletTypeCond(tabletType topodatapb.TabletType) {
+	qr.tabletTypes = append(qr.tabletTypes, tabletType)
+}
+
+// SetMinRowsCond restricts the rule to plans which may return or affect at
+// least minRows rows according to their estimate. Plans with unbounded
+// estimates always match.
+func (qr *Rule) SetMinRowsCond(minRows int64) {
+	qr.minRows = minRows
+}
+
+// SetUnboundedCond restricts the rule to plans with unbounded estimated rows,
+// e.g. SELECT statements without a LIMIT.
+func (qr *Rule) SetUnboundedCond(unbounded bool) {
+	qr.unbounded = unbounded
+}
+
+// SetActivePeriod restricts the rule to [from, until).
+// Zero values are unbounded.
+func (qr *Rule) SetActivePeriod(from, until time.Time) {
+	qr.activeFrom = from
+	qr.activeUntil = until
+}
+
 // SetQueryCond adds a regular expression condition for the query.
 func (qr *Rule) SetQueryCond(pattern string) (err error) {
 	qr.query.name = pattern
@@ -360,12 +604,42 @@ func makeExact(pattern string) string {
 // uint64   ==, !=, <, >=, >, <=                   whole numbers
 // int64    ==, !=, <, >=, >, <=                   whole numbers
 // string   ==, !=, <, >=, >, <=, MATCH, NOMATCH   []byte, string
+// []int64  BETWEEN                                whole numbers
 // whole numbers can be: int, int8, int16, int32, int64, uint64
+// The []int64 value of BETWEEN is the inclusive range [low, high].
 func (qr *Rule) AddBindVarCond(name string, onAbsent, onMismatch bool, op Operator, value interface{}) error {
+	converted, err := convertBindVarCondValue(op, value)
+	if err != nil {
+		return err
+	}
+	qr.bindVarConds = append(qr.bindVarConds, BindVarCond{name, onAbsent, onMismatch, op, converted})
+	return nil
+}
+
+// AddBindVarLengthCond adds a restriction on the number of values of a
+// list bind variable, like the ones used for IN clauses. The arguments
+// are the same as for AddBindVarCond, except that value can only be a
+// whole number or a range. Bind variables which are not lists are a
+// type mismatch.
+func (qr *Rule) AddBindVarLengthCond(name string, onAbsent, onMismatch bool, op Operator, value interface{}) error {
+	if op == QRNoOp || op == QRMatch || op == QRNoMatch {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid operator %v for length condition", opnames[op])
+	}
+	if _, ok := value.(string); ok {
+		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "type %T not allowed as length condition operand (%v)", value, value)
+	}
+	converted, err := convertBindVarCondValue(op, value)
+	if err != nil {
+		return err
+	}
+	qr.bindVarConds = append(qr.bindVarConds, BindVarCond{name, onAbsent, onMismatch, op, bvclen{converted}})
+	return nil
+}
+
+func convertBindVarCondValue(op Operator, value interface{}) (bvcValue, error) {
 	var converted bvcValue
 	if op == QRNoOp {
-		qr.bindVarConds = append(qr.bindVarConds, BindVarCond{name, onAbsent, onMismatch, op, nil})
-		return nil
+		return nil, nil
 	}
 	switch v := value.(type) {
 	case uint64:
@@ -386,87 +660,212 @@ func (qr *Rule) AddBindVarCond(name string, onAbsent, onMismatch bool, op Operat
 			// Change the value to compiled regexp
 			re, err := regexp.Compile(makeExact(v))
 			if err != nil {
-				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "processing %s: %v", v, err)
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "processing %s: %v", v, err)
 			}
 			converted = bvcre{re}
 		} else {
 			goto Error
 		}
+	case []int64:
+		if op != QRBetween {
+			goto Error
+		}
+		if len(v) != 2 || v[0] > v[1] {
+			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want [low, high] range for BETWEEN: %v", v)
+		}
+		converted = bvcrange{v[0], v[1]}
 	default:
-		return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "type %T not allowed as condition operand (%v)", value, value)
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "type %T not allowed as condition operand (%v)", value, value)
 	}
-	qr.bindVarConds = append(qr.bindVarConds, BindVarCond{name, onAbsent, onMismatch, op, converted})
-	return nil
+	return converted, nil
 
 Error:
-	return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid operator %v for type %T (%v)", op, value, value)
+	return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid operator %v for type %T (%v)", op, value, value)
 }
 
 // FilterByPlan returns a new Rule if the query and planid match.
 // The new Rule will contain all the original constraints other
 // than the plan and query. If the plan and query don't match the Rule,
 // then it returns nil.
-func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableName string) (newqr *Rule) {
-	if !reMatch(qr.query.Regexp, query) {
+// estimatedRows is the EstimatedRows of the plan.
+func (qr *Rule) FilterByPlan(query string, planid planbuilder.PlanType, tableName string, estimatedRows int64) (newqr *Rule) {
+	return qr.filterByPlan(&queryDigest{query: query}, planid, tableName, estimatedRows)
+}
+
+func (qr *Rule) filterByPlan(query *queryDigest, planid planbuilder.PlanType, tableName string, estimatedRows int64) (newqr *Rule) {
+	if !reMatch(qr.query.Regexp, query.query) {
 		return nil
 	}
 	if !planMatch(qr.plans, planid) {
 		return nil
 	}
-	if !tableMatch(qr.tableNames, tableName) {
+	if !stringMatch(qr.tableNames, tableName) {
+		return nil
+	}
+	if qr.digests != nil && !stringMatch(qr.digests, query.get()) {
+		return nil
+	}
+	if qr.injections != nil && !injectionMatch(qr.injections, query.findInjections()) {
+		return nil
+	}
+	if !rowsMatch(qr.minRows, qr.unbounded, estimatedRows) {
 		return nil
 	}
 	newqr = qr.Copy()
 	newqr.query = namedRegexp{}
 	newqr.plans = nil
 	newqr.tableNames = nil
+	newqr.digests = nil
+	newqr.injections = nil
+	newqr.minRows = 0
+	newqr.unbounded = false
+	return newqr
+}
+
+// queryDigest is a query with its digest and SQL injection findings, which
+// are computed on first use.
+type queryDigest struct {
+	query      string
+	digest     string
+	injections []sqlparser.InjectionFinding
+	found      bool
+}
+
+func (qd *queryDigest) get() string {
+	if qd.digest == "" {
+		qd.digest = sqlparser.Digest(qd.query)
+	}
+	return qd.digest
+}
+
+func (qd *queryDigest) findInjections() []sqlparser.InjectionFinding {
+	if !qd.found {
+		qd.injections = sqlparser.FindInjections(qd.query)
+		qd.found = true
+	}
+	return qd.injections
+}
+
+// FilterByTarget returns a new Rule without the keyspace, shard and tablet
+// type conditions if the rule is scoped to the keyspace, shard and tablet
+// type, and nil otherwise.
+func (qr *Rule) FilterByTarget(keyspace, shard string, tabletType topodatapb.TabletType) (newqr *Rule) {
+	if !stringMatch(qr.keyspaces, keyspace) || !stringMatch(qr.shards, shard) || !tabletTypeMatch(qr.tabletTypes, tabletType) {
+		return nil
+	}
+	newqr = qr.Copy()
+	newqr.keyspaces = nil
+	newqr.shards = nil
+	newqr.tabletTypes = nil
 	return newqr
 }
 
 // GetAction returns the action for a single rule.
 func (qr *Rule) GetAction(ip, user string, bindVars map[string]*querypb.BindVariable) Action {
-	if !reMatch(qr.requestIP.Regexp, ip) {
+	if !qr.matches(ip, user, bindVars) {
 		return QRContinue
 	}
+	return qr.act
+}
+
+// matches returns true if the request matches the conditions of the rule
+// which are not checked by FilterByPlan.
+func (qr *Rule) matches(ip, user string, bindVars map[string]*querypb.BindVariable) bool {
+	if !qr.isActive(timeNow()) {
+		return false
+	}
+	if !reMatch(qr.requestIP.Regexp, ip) {
+		return false
+	}
 	if !reMatch(qr.user.Regexp, user) {
-		return QRContinue
+		return false
 	}
 	for _, bvcond := range qr.bindVarConds {
 		if !bvMatch(bvcond, bindVars) {
-			return QRContinue
+			return false
 		}
 	}
-	return qr.act
+	return true
+}
+
+// SetQueryTimeout sets the query timeout of the queries matching the rule.
+func (qr *Rule) SetQueryTimeout(timeout time.Duration) {
+	qr.timeout = timeout
+}
+
+// QueryTimeout returns the query timeout set by the rule, or 0.
+func (qr *Rule) QueryTimeout() time.Duration {
+	return qr.timeout
 }
 
 func reMatch(re *regexp.Regexp, val string) bool {
 	return re == nil || re.MatchString(val)
 }
 
+// basePlans maps the plans that refine another plan to it, so that the
+// rules on the selects keep applying to the unions and the selects from
+// derived tables, and the rules on the inserts to the insert selects.
+var basePlans = map[planbuilder.PlanType]planbuilder.PlanType{
+	planbuilder.PlanUnion:         planbuilder.PlanSelect,
+	planbuilder.PlanSelectDerived: planbuilder.PlanSelect,
+	planbuilder.PlanInsertSelect:  planbuilder.PlanInsert,
+}
+
 func planMatch(plans []planbuilder.PlanType, plan planbuilder.PlanType) bool {
 	if plans == nil {
 		return true
 	}
+	base, hasBase := basePlans[plan]
 	for _, p := range plans {
-		if p == plan {
+		if p == plan || (hasBase && p == base) {
 			return true
 		}
 	}
 	return false
 }
 
-func tableMatch(tableNames []string, tableName string) bool {
-	if tableNames == nil {
+func injectionMatch(kinds []sqlparser.InjectionKind, findings []sqlparser.InjectionFinding) bool {
+	for _, finding := range findings {
+		for _, kind := range kinds {
+			if finding.Kind == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func tabletTypeMatch(tabletTypes []topodatapb.TabletType, tabletType topodatapb.TabletType) bool {
+	if tabletTypes == nil {
 		return true
 	}
-	for _, t := range tableNames {
-		if t == tableName {
+	for _, t := range tabletTypes {
+		if t == tabletType {
 			return true
 		}
 	}
 	return false
 }
 
+func stringMatch(names []string, name string) bool {
+	if names == nil {
+		return true
+	}
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+func rowsMatch(minRows int64, unbounded bool, estimatedRows int64) bool {
+	if estimatedRows == planbuilder.UnboundedRows {
+		return true
+	}
+	return !unbounded && estimatedRows >= minRows
+}
+
 func bvMatch(bvcond BindVarCond, bindVars map[string]*querypb.BindVariable) bool {
 	bv, ok := bindVars[bvcond.name]
 	if !ok {
@@ -490,6 +889,8 @@ const (
 	QRContinue = Action(iota)
 	QRFail
 	QRFailRetry
+	QRRateLimit
+	QRRewrite
 )
 
 // MarshalJSON marshals to JSON.
@@ -501,12 +902,48 @@ func (act Action) MarshalJSON() ([]byte, error) {
 		str = "FAIL"
 	case QRFailRetry:
 		str = "FAIL_RETRY"
+	case QRRateLimit:
+		str = "RATE_LIMIT"
+	case QRRewrite:
+		str = "REWRITE"
 	default:
 		str = "INVALID"
 	}
 	return json.Marshal(str)
 }
 
+// Mode specifies whether the action of a Rule is performed.
+type Mode int
+
+// These are modes.
+const (
+	// ModeEnforce performs the action of matching rules.
+	ModeEnforce = Mode(iota)
+	// ModeMonitor only reports matches, so that the rule can be
+	// validated before it is enforced.
+	ModeMonitor
+)
+
+var modeNames = map[Mode]string{
+	ModeEnforce: "enforce",
+	ModeMonitor: "monitor",
+}
+
+// MarshalJSON marshals to JSON.
+func (mode Mode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(modeNames[mode])
+}
+
+// SetMode sets the mode of the rule.
+func (qr *Rule) SetMode(mode Mode) {
+	qr.mode = mode
+}
+
+// Mode returns the mode of the rule.
+func (qr *Rule) Mode() Mode {
+	return qr.mode
+}
+
 // BindVarCond represents a bind var condition.
 type BindVarCond struct {
 	name       string
@@ -526,7 +963,12 @@ func (bvc BindVarCond) MarshalJSON() ([]byte, error) {
 	}
 	safeEncode(b, `,"Operator":`, bvc.op)
 	if bvc.op != QRNoOp {
-		safeEncode(b, `,"Value":`, bvc.value)
+		value := bvc.value
+		if lv, ok := value.(bvclen); ok {
+			safeEncode(b, `,"Length":`, true)
+			value = lv.value
+		}
+		safeEncode(b, `,"Value":`, value)
 	}
 	_, _ = b.WriteString("}")
 	return b.Bytes(), nil
@@ -546,6 +988,7 @@ const (
 	QRLessEqual
 	QRMatch
 	QRNoMatch
+	QRBetween
 	QRNumOp
 )
 
@@ -559,6 +1002,7 @@ var opmap = map[string]Operator{
 	"<=":      QRLessEqual,
 	"MATCH":   QRMatch,
 	"NOMATCH": QRNoMatch,
+	"BETWEEN": QRBetween,
 }
 
 var opnames []string
@@ -738,6 +1182,41 @@ func (reval bvcre) eval(bv *querypb.BindVariable, op Operator, onMismatch bool)
 	panic("unreachable")
 }
 
+// bvcrange is the inclusive range of BETWEEN conditions.
+type bvcrange struct {
+	low, high int64
+}
+
+// MarshalJSON marshals to JSON.
+func (rval bvcrange) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]int64{rval.low, rval.high})
+}
+
+func (rval bvcrange) eval(bv *querypb.BindVariable, op Operator, onMismatch bool) bool {
+	if !sqltypes.IsIntegral(bv.Type) {
+		return onMismatch
+	}
+	num, status := getint64(bv)
+	if status != QROK {
+		// Out of range values are above high.
+		return false
+	}
+	return num >= rval.low && num <= rval.high
+}
+
+// bvclen applies a condition to the number of values of a list
+// bind variable.
+type bvclen struct {
+	value bvcValue
+}
+
+func (lval bvclen) eval(bv *querypb.BindVariable, op Operator, onMismatch bool) bool {
+	if bv.Type != querypb.Type_TUPLE {
+		return onMismatch
+	}
+	return lval.value.eval(sqltypes.Int64BindVariable(int64(len(bv.Values))), op, onMismatch)
+}
+
 // getuint64 returns QROutOfRange for negative values
 func getuint64(val *querypb.BindVariable) (uv uint64, status int) {
 	bv, err := sqltypes.BindVariableToValue(val)
@@ -786,21 +1265,54 @@ func MapStrOperator(strop string) (op Operator, err error) {
 // BuildQueryRule builds a query rule from a ruleInfo.
 func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 	qr = NewQueryRule("", "", QRFail)
+	_, hasAction := ruleInfo["Action"]
 	for k, v := range ruleInfo {
 		var sv string
 		var lv []interface{}
+		var mv interface{}
 		var ok bool
 		switch k {
-		case "Name", "Description", "RequestIP", "User", "Query", "Action":
+		case "Name", "Description", "RequestIP", "User", "Query", "Action", "Mode", "ActiveFrom", "ActiveUntil":
 			sv, ok = v.(string)
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
 			}
-		case "Plans", "BindVarConds", "TableNames":
+		case "Unbounded":
+			bv, ok := v.(bool)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want bool for %s", k)
+			}
+			qr.SetUnboundedCond(bv)
+			continue
+		case "MinEstimatedRows":
+			num, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
+			n, err := num.Int64()
+			if err != nil || n < 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want non-negative integer for %s: %v", k, num)
+			}
+			qr.SetMinRowsCond(n)
+			continue
+		case "QueryTimeout":
+			num, ok := v.(json.Number)
+			if !ok {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want number for %s", k)
+			}
+			seconds, err := num.Float64()
+			if err != nil || seconds <= 0 {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want positive number of seconds for %s: %v", k, num)
+			}
+			qr.SetQueryTimeout(time.Duration(seconds * float64(time.Second)))
+			continue
+		case "Plans", "BindVarConds", "TableNames", "Digests", "Injections", "Keyspaces", "Shards", "TabletTypes", "Schedule":
 			lv, ok = v.([]interface{})
 			if !ok {
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for %s", k)
 			}
+		case "RateLimit", "Rewrite":
+			mv = v
 		default:
 			return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s", k)
 		}
@@ -844,32 +1356,135 @@ func BuildQueryRule(ruleInfo map[string]interface{}) (qr *Rule, err error) {
 				}
 				qr.AddTableCond(tableName)
 			}
+		case "Digests":
+			for _, d := range lv {
+				digest, ok := d.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Digests")
+				}
+				qr.AddDigestCond(digest)
+			}
+		case "Injections":
+			for _, i := range lv {
+				name, ok := i.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Injections")
+				}
+				kind, ok := sqlparser.InjectionKindByName(name)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid injection kind: %s", name)
+				}
+				qr.AddInjectionCond(kind)
+			}
+		case "Keyspaces":
+			for _, ks := range lv {
+				keyspace, ok := ks.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Keyspaces")
+				}
+				qr.AddKeyspaceCond(keyspace)
+			}
+		case "Shards":
+			for _, sh := range lv {
+				shard, ok := sh.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Shards")
+				}
+				qr.AddShardCond(shard)
+			}
+		case "TabletTypes":
+			for _, tt := range lv {
+				name, ok := tt.(string)
+				if !ok {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for TabletTypes")
+				}
+				tabletType, err := topoproto.ParseTabletType(name)
+				if err != nil {
+					return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid tablet type %s", name)
+				}
+				qr.AddTabletTypeCond(tabletType)
+			}
 		case "BindVarConds":
 			for _, bvc := range lv {
-				name, onAbsent, onMismatch, op, value, err := buildBindVarCondition(bvc)
+				name, onAbsent, onMismatch, op, value, length, err := buildBindVarCondition(bvc)
 				if err != nil {
 					return nil, err
 				}
-				err = qr.AddBindVarCond(name, onAbsent, onMismatch, op, value)
+				if length {
+					err = qr.AddBindVarLengthCond(name, onAbsent, onMismatch, op, value)
+				} else {
+					err = qr.AddBindVarCond(name, onAbsent, onMismatch, op, value)
+				}
 				if err != nil {
 					return nil, err
 				}
 			}
+		case "ActiveFrom":
+			qr.activeFrom, err = time.Parse(time.RFC3339, sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want RFC3339 time for ActiveFrom: %s", sv)
+			}
+		case "ActiveUntil":
+			qr.activeUntil, err = time.Parse(time.RFC3339, sv)
+			if err != nil {
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want RFC3339 time for ActiveUntil: %s", sv)
+			}
+		case "Schedule":
+			for _, w := range lv {
+				tw, err := buildTimeWindow(w)
+				if err != nil {
+					return nil, err
+				}
+				qr.AddTimeWindow(tw)
+			}
+		case "RateLimit":
+			qr.rateLimit, err = buildRateLimit(mv)
+			if err != nil {
+				return nil, err
+			}
+		case "Rewrite":
+			qr.rewrite, err = buildRewrite(mv)
+			if err != nil {
+				return nil, err
+			}
+		case "Mode":
+			switch sv {
+			case "enforce":
+				qr.mode = ModeEnforce
+			case "monitor":
+				qr.mode = ModeMonitor
+			default:
+				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Mode %s", sv)
+			}
 		case "Action":
 			switch sv {
 			case "FAIL":
 				qr.act = QRFail
 			case "FAIL_RETRY":
 				qr.act = QRFailRetry
+			case "RATE_LIMIT":
+				qr.act = QRRateLimit
+			case "REWRITE":
+				qr.act = QRRewrite
 			default:
 				return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid Action %s", sv)
 			}
 		}
 	}
+	// A rule which only sets a query timeout doesn't fail the queries.
+	if qr.timeout != 0 && !hasAction {
+		qr.act = QRContinue
+	}
+	if qr.act == QRRateLimit && qr.rateLimit == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "RateLimit is required for Action RATE_LIMIT")
+	}
+	if qr.act == QRRewrite && qr.rewrite == nil {
+		return nil, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Rewrite is required for Action REWRITE")
+	}
 	return qr, nil
 }
 
-func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, err error) {
+func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch bool, op Operator, value interface{}, length bool, err error) {
 	bvcinfo, ok := bvc.(map[string]interface{})
 	if !ok {
 		err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want json object for bind var conditions")
@@ -946,6 +1561,35 @@ func buildBindVarCondition(bvc interface{}) (name string, onAbsent, onMismatch b
 			return
 		}
 		value = strvalue
+	} else if op == QRBetween {
+		lv, ok := v.([]interface{})
+		if !ok || len(lv) != 2 {
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want [low, high] range: %v", v)
+			return
+		}
+		bounds := make([]int64, 0, 2)
+		for _, bound := range lv {
+			num, ok := bound.(json.Number)
+			if !ok {
+				err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64 bound: %v", bound)
+				return
+			}
+			n, perr := num.Int64()
+			if perr != nil {
+				err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want int64 bound: %v", num)
+				return
+			}
+			bounds = append(bounds, n)
+		}
+		value = bounds
+	}
+
+	if v, ok = bvcinfo["Length"]; ok {
+		length, ok = v.(bool)
+		if !ok {
+			err = vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want bool for Length")
+			return
+		}
 	}
 
 	v, ok = bvcinfo["OnMismatch"]
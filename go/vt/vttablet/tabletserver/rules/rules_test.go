@@ -23,12 +23,18 @@ import (
 	"regexp"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
@@ -128,7 +134,7 @@ func TestFilterByPlan(t *testing.T) {
 	qrs.Add(qr3)
 	qrs.Add(qr4)
 
-	qrs1 := qrs.FilterByPlan("select", planbuilder.PlanSelect, "a")
+	qrs1 := qrs.FilterByPlan("select", planbuilder.PlanSelect, "a", planbuilder.UnboundedRows)
 	want := compacted(`[{
 		"Description":"rule 1",
 		"Name":"r1",
@@ -163,7 +169,7 @@ func TestFilterByPlan(t *testing.T) {
 		t.Errorf("qrs1:\n%s, want\n%s", got, want)
 	}
 
-	qrs1 = qrs.FilterByPlan("insert", planbuilder.PlanSelect, "a")
+	qrs1 = qrs.FilterByPlan("insert", planbuilder.PlanSelect, "a", planbuilder.UnboundedRows)
 	want = compacted(`[{
 		"Description":"rule 2",
 		"Name":"r2",
@@ -179,13 +185,13 @@ func TestFilterByPlan(t *testing.T) {
 		t.Errorf("qrs1:\n%s, want\n%s", got, want)
 	}
 
-	qrs1 = qrs.FilterByPlan("insert", planbuilder.PlanSelect, "a")
+	qrs1 = qrs.FilterByPlan("insert", planbuilder.PlanSelect, "a", planbuilder.UnboundedRows)
 	got = marshalled(qrs1)
 	if got != want {
 		t.Errorf("qrs1:\n%s, want\n%s", got, want)
 	}
 
-	qrs1 = qrs.FilterByPlan("select", planbuilder.PlanInsert, "a")
+	qrs1 = qrs.FilterByPlan("select", planbuilder.PlanInsert, "a", planbuilder.UnboundedRows)
 	want = compacted(`[{
 		"Description":"rule 3",
 		"Name":"r3",
@@ -201,12 +207,12 @@ func TestFilterByPlan(t *testing.T) {
 		t.Errorf("qrs1:\n%s, want\n%s", got, want)
 	}
 
-	qrs1 = qrs.FilterByPlan("sel", planbuilder.PlanInsert, "a")
+	qrs1 = qrs.FilterByPlan("sel", planbuilder.PlanInsert, "a", planbuilder.UnboundedRows)
 	if qrs1.rules != nil {
 		t.Errorf("want nil, got non-nil")
 	}
 
-	qrs1 = qrs.FilterByPlan("table", planbuilder.PlanInsert, "b")
+	qrs1 = qrs.FilterByPlan("table", planbuilder.PlanInsert, "b", planbuilder.UnboundedRows)
 	want = compacted(`[{
 		"Description":"rule 4",
 		"Name":"r4",
@@ -220,7 +226,7 @@ func TestFilterByPlan(t *testing.T) {
 	qr5 := NewQueryRule("rule 5", "r5", QRFail)
 	qrs.Add(qr5)
 
-	qrs1 = qrs.FilterByPlan("sel", planbuilder.PlanInsert, "a")
+	qrs1 = qrs.FilterByPlan("sel", planbuilder.PlanInsert, "a", planbuilder.UnboundedRows)
 	want = compacted(`[{
 		"Description":"rule 5",
 		"Name":"r5",
@@ -232,11 +238,42 @@ func TestFilterByPlan(t *testing.T) {
 	}
 
 	qrsnil1 := New()
-	if qrsnil2 := qrsnil1.FilterByPlan("", planbuilder.PlanSelect, "a"); qrsnil2.rules != nil {
+	if qrsnil2 := qrsnil1.FilterByPlan("", planbuilder.PlanSelect, "a", planbuilder.UnboundedRows); qrsnil2.rules != nil {
 		t.Errorf("want nil, got non-nil")
 	}
 }
 
+func TestFilterByRefinedPlan(t *testing.T) {
+	selects := NewQueryRule("selects", "selects", QRFail)
+	selects.AddPlanCond(planbuilder.PlanSelect)
+	inserts := NewQueryRule("inserts", "inserts", QRFail)
+	inserts.AddPlanCond(planbuilder.PlanInsert)
+	unions := NewQueryRule("unions", "unions", QRFail)
+	unions.AddPlanCond(planbuilder.PlanUnion)
+	qrs := New()
+	qrs.Add(selects)
+	qrs.Add(inserts)
+	qrs.Add(unions)
+
+	for _, tcase := range []struct {
+		plan planbuilder.PlanType
+		want []string
+	}{
+		{planbuilder.PlanSelect, []string{"selects"}},
+		{planbuilder.PlanUnion, []string{"selects", "unions"}},
+		{planbuilder.PlanSelectDerived, []string{"selects"}},
+		{planbuilder.PlanInsert, []string{"inserts"}},
+		{planbuilder.PlanInsertSelect, []string{"inserts"}},
+		{planbuilder.PlanUpdate, nil},
+	} {
+		var got []string
+		for _, qr := range qrs.FilterByPlan("", tcase.plan, "a", planbuilder.UnboundedRows).rules {
+			got = append(got, qr.Name)
+		}
+		assert.Equal(t, tcase.want, got, tcase.plan.String())
+	}
+}
+
 func TestQueryRule(t *testing.T) {
 	qr := NewQueryRule("rule 1", "r1", QRFail)
 	err := qr.SetIPCond("123")
@@ -338,6 +375,12 @@ var creationCases = []BVCreation{
 	{"a", true, true, QRNoMatch, int64(1), true},
 	{"a", true, true, QRMatch, "[", true},
 	{"a", true, true, QRNoMatch, "[", true},
+
+	{"a", true, true, QRBetween, []int64{1, 10}, false},
+	{"a", true, true, QRBetween, []int64{10, 1}, true},
+	{"a", true, true, QRBetween, []int64{1}, true},
+	{"a", true, true, QREqual, []int64{1, 10}, true},
+	{"a", true, true, QRBetween, int64(1), true},
 }
 
 func TestBVCreation(t *testing.T) {
@@ -461,6 +504,21 @@ var bvtestcases = []BindVarTestCase{
 	{BindVarCond{"a", true, true, QRNoMatch, makere("a.*")}, sqltypes.StringBindVariable("c"), true},
 	{BindVarCond{"a", true, true, QRNoMatch, makere("a.*")}, sqltypes.StringBindVariable("a"), false},
 	{BindVarCond{"a", true, true, QRNoMatch, makere("a.*")}, sqltypes.Int64BindVariable(1), true},
+
+	{BindVarCond{"a", true, true, QRBetween, bvcrange{-1, 10}}, sqltypes.Int64BindVariable(-2), false},
+	{BindVarCond{"a", true, true, QRBetween, bvcrange{-1, 10}}, sqltypes.Int64BindVariable(-1), true},
+	{BindVarCond{"a", true, true, QRBetween, bvcrange{-1, 10}}, sqltypes.Int64BindVariable(10), true},
+	{BindVarCond{"a", true, true, QRBetween, bvcrange{-1, 10}}, sqltypes.Int64BindVariable(11), false},
+	{BindVarCond{"a", true, true, QRBetween, bvcrange{-1, 10}}, sqltypes.Uint64BindVariable(0xFFFFFFFFFFFFFFFF), false},
+	{BindVarCond{"a", true, true, QRBetween, bvcrange{-1, 10}}, sqltypes.StringBindVariable("a"), true},
+	{BindVarCond{"a", true, false, QRBetween, bvcrange{-1, 10}}, sqltypes.StringBindVariable("a"), false},
+
+	{BindVarCond{"a", true, true, QRGreaterThan, bvclen{bvcint64(2)}}, sqltypes.TestBindVariable([]interface{}{1, 2}), false},
+	{BindVarCond{"a", true, true, QRGreaterThan, bvclen{bvcint64(2)}}, sqltypes.TestBindVariable([]interface{}{1, 2, 3}), true},
+	{BindVarCond{"a", true, true, QRGreaterThan, bvclen{bvcint64(2)}}, sqltypes.Int64BindVariable(3), true},
+	{BindVarCond{"a", true, false, QRGreaterThan, bvclen{bvcint64(2)}}, sqltypes.Int64BindVariable(3), false},
+	{BindVarCond{"a", true, true, QRBetween, bvclen{bvcrange{1, 2}}}, sqltypes.TestBindVariable([]interface{}{"a"}), true},
+	{BindVarCond{"a", true, true, QRBetween, bvclen{bvcrange{1, 2}}}, sqltypes.TestBindVariable([]interface{}{}), false},
 }
 
 func makere(s string) bvcre {
@@ -672,6 +730,40 @@ var invalidjsons = []InvalidJSONCase{
 	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "NOMATCH", "Value": "["}]}]`, "processing [: error parsing regexp: missing closing ]: `[$`"},
 	{`[{"Action": 1 }]`, "want string for Action"},
 	{`[{"Action": "foo" }]`, "invalid Action foo"},
+	{`[{"ActiveFrom": "tomorrow" }]`, "want RFC3339 time for ActiveFrom: tomorrow"},
+	{`[{"ActiveUntil": 1 }]`, "want string for ActiveUntil"},
+	{`[{"Schedule": 1 }]`, "want list for Schedule"},
+	{`[{"Schedule": [1] }]`, "want json object for Schedule"},
+	{`[{"Schedule": [{"Start": "09:00"}] }]`, "Start and End are required in Schedule"},
+	{`[{"Schedule": [{"Start": "09:00", "End": "10:00", "Days": ["Xyz"]}] }]`, "invalid day: Xyz"},
+	{`[{"Action": "RATE_LIMIT" }]`, "RateLimit is required for Action RATE_LIMIT"},
+	{`[{"Action": "RATE_LIMIT", "RateLimit": 1 }]`, "want json object for RateLimit"},
+	{`[{"Action": "RATE_LIMIT", "RateLimit": {"QPS": "1"} }]`, "want number for QPS in RateLimit"},
+	{`[{"Action": "RATE_LIMIT", "RateLimit": {"QPS": -1} }]`, "want non-negative integer for QPS in RateLimit: -1"},
+	{`[{"Action": "RATE_LIMIT", "RateLimit": {"Burst": 1} }]`, "unrecognized tag Burst in RateLimit"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "BETWEEN", "Value": 1}]}]`, "want [low, high] range: 1"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "BETWEEN", "Value": [1, "2"]}]}]`, "want int64 bound: 2"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "BETWEEN", "Value": [2, 1]}]}]`, "want [low, high] range for BETWEEN: [2 1]"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": ">", "Value": 1, "Length": 1}]}]`, "want bool for Length"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": "MATCH", "Value": "1", "Length": true}]}]`, "invalid operator MATCH for length condition"},
+	{`[{"BindVarConds": [{"Name": "a", "OnAbsent": true, "OnMismatch": true, "Operator": ">", "Value": "1", "Length": true}]}]`, "type string not allowed as length condition operand (1)"},
+	{`[{"Unbounded": 1 }]`, "want bool for Unbounded"},
+	{`[{"MinEstimatedRows": "1" }]`, "want number for MinEstimatedRows"},
+	{`[{"MinEstimatedRows": -1 }]`, "want non-negative integer for MinEstimatedRows: -1"},
+	{`[{"Injections": [1] }]`, "want string for Injections"},
+	{`[{"Injections": ["union"] }]`, "invalid injection kind: union"},
+	{`[{"Keyspaces": "ks" }]`, "want list for Keyspaces"},
+	{`[{"Keyspaces": [1] }]`, "want string for Keyspaces"},
+	{`[{"Shards": [1] }]`, "want string for Shards"},
+	{`[{"QueryTimeout": "5s" }]`, "want number for QueryTimeout"},
+	{`[{"QueryTimeout": 0 }]`, "want positive number of seconds for QueryTimeout: 0"},
+	{`[{"Mode": 1 }]`, "want string for Mode"},
+	{`[{"Mode": "audit" }]`, "invalid Mode audit"},
+	{`[{"Action": "REWRITE" }]`, "Rewrite is required for Action REWRITE"},
+	{`[{"Action": "REWRITE", "Rewrite": {"Limit": 0} }]`, "want positive integer for Limit in Rewrite: 0"},
+	{`[{"Action": "REWRITE", "Rewrite": {"IndexHint": {"Type": "PREFER", "Indexes": ["a"]}} }]`, "invalid Type in IndexHint: PREFER"},
+	{`[{"Action": "REWRITE", "Rewrite": {"IndexHint": {"Type": "USE"}} }]`, "want non-empty list for Indexes in IndexHint"},
+	{`[{"Action": "REWRITE", "Rewrite": {"Order": 1} }]`, "unrecognized tag Order in Rewrite"},
 }
 
 func TestInvalidJSON(t *testing.T) {
@@ -728,6 +820,7 @@ func TestOpNames(t *testing.T) {
 		"<=",
 		"MATCH",
 		"NOMATCH",
+		"BETWEEN",
 	}
 	if !reflect.DeepEqual(opnames, want) {
 		t.Errorf("opnames: \n%v, want \n%v", opnames, want)
@@ -750,3 +843,495 @@ func marshalled(in interface{}) string {
 	}
 	return string(b)
 }
+
+func TestTimeWindow(t *testing.T) {
+	// 2021-03-01 is a Monday.
+	monday := func(hour, min int) time.Time {
+		return time.Date(2021, 3, 1, hour, min, 0, 0, time.UTC)
+	}
+	tw, err := NewTimeWindow([]string{"Mon", "tue"}, "09:00", "17:30", "")
+	require.NoError(t, err)
+	assert.False(t, tw.Contains(monday(8, 59)))
+	assert.True(t, tw.Contains(monday(9, 0)))
+	assert.True(t, tw.Contains(monday(17, 29)))
+	assert.False(t, tw.Contains(monday(17, 30)))
+	assert.False(t, tw.Contains(monday(12, 0).AddDate(0, 0, -1)))
+
+	// The window spans midnight and belongs to Monday.
+	tw, err = NewTimeWindow([]string{"Mon"}, "22:00", "02:00", "")
+	require.NoError(t, err)
+	assert.True(t, tw.Contains(monday(23, 0)))
+	assert.True(t, tw.Contains(monday(1, 0).AddDate(0, 0, 1)))
+	assert.False(t, tw.Contains(monday(1, 0)))
+	assert.False(t, tw.Contains(monday(3, 0)))
+
+	tw, err = NewTimeWindow(nil, "09:00", "17:00", "America/New_York")
+	require.NoError(t, err)
+	assert.False(t, tw.Contains(monday(10, 0)))
+	assert.True(t, tw.Contains(monday(15, 0)))
+
+	// The window follows the wall clock on the days of the daylight saving
+	// time transitions.
+	newYork, err := time.LoadLocation("America/New_York")
+	require.NoError(t, err)
+	assert.True(t, tw.Contains(time.Date(2021, 3, 14, 9, 30, 0, 0, newYork)))
+	assert.False(t, tw.Contains(time.Date(2021, 3, 14, 8, 30, 0, 0, newYork)))
+	assert.False(t, tw.Contains(time.Date(2021, 11, 7, 8, 30, 0, 0, newYork)))
+	assert.True(t, tw.Contains(time.Date(2021, 11, 7, 16, 30, 0, 0, newYork)))
+
+	_, err = NewTimeWindow([]string{"Funday"}, "09:00", "17:00", "")
+	assert.EqualError(t, err, "invalid day: Funday")
+	_, err = NewTimeWindow(nil, "9am", "17:00", "")
+	assert.EqualError(t, err, "invalid time of day 9am, want HH:MM")
+}
+
+func TestRuleSchedule(t *testing.T) {
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "block analytics queries during business hours",
+		"User": "analytics",
+		"ActiveFrom": "2021-01-01T00:00:00Z",
+		"ActiveUntil": "2022-01-01T00:00:00Z",
+		"Schedule": [{"Days": ["Mon", "Tue", "Wed", "Thu", "Fri"], "Start": "09:00", "End": "17:00"}]
+	}]`))
+	require.NoError(t, err)
+
+	cases := []struct {
+		now  time.Time
+		want Action
+	}{
+		{time.Date(2021, 3, 1, 10, 0, 0, 0, time.UTC), QRFail},
+		{time.Date(2021, 3, 1, 18, 0, 0, 0, time.UTC), QRContinue},
+		{time.Date(2021, 3, 6, 10, 0, 0, 0, time.UTC), QRContinue},
+		{time.Date(2020, 3, 2, 10, 0, 0, 0, time.UTC), QRContinue},
+		{time.Date(2022, 3, 7, 10, 0, 0, 0, time.UTC), QRContinue},
+	}
+	for _, tcase := range cases {
+		timeNow = func() time.Time { return tcase.now }
+		action, _ := qrs.GetAction("", "analytics", nil)
+		assert.Equal(t, tcase.want, action, "%v", tcase.now)
+	}
+
+	// The schedule survives a JSON round trip and a copy.
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	qrs2 := New()
+	require.NoError(t, qrs2.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs2), "%s", data)
+	assert.True(t, qrs.Equal(qrs.Copy()))
+}
+
+func TestRateLimit(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "throttle t1",
+		"Action": "RATE_LIMIT",
+		"RateLimit": {"MaxConcurrency": 2}
+	}, {
+		"Name": "r2",
+		"Description": "fail u2",
+		"User": "u2"
+	}]`))
+	require.NoError(t, err)
+
+	// The budget is shared by the copies made for each plan.
+	qrs1 := qrs.FilterByPlan("select * from t1", planbuilder.PlanSelect, "t1", planbuilder.UnboundedRows)
+	qrs2 := qrs.FilterByPlan("update t1 set a = 1", planbuilder.PlanUpdate, "t1", planbuilder.UnboundedRows)
+
+	action, _, release1 := qrs1.Admit("", "u1", nil)
+	assert.Equal(t, QRContinue, action)
+	action, _, release2 := qrs2.Admit("", "u1", nil)
+	assert.Equal(t, QRContinue, action)
+	action, rule, _ := qrs1.Admit("", "u1", nil)
+	assert.Equal(t, QRRateLimit, action)
+	assert.Equal(t, "r1", rule.Name)
+
+	release1()
+	action, _, release3 := qrs2.Admit("", "u1", nil)
+	assert.Equal(t, QRContinue, action)
+
+	// Evaluation continues after an admitted rate limit rule, and the
+	// budget is given back when a later rule fails the query.
+	release2()
+	action, rule, _ = qrs1.Admit("", "u2", nil)
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "r2", rule.Name)
+	action, _, release4 := qrs1.Admit("", "u1", nil)
+	assert.Equal(t, QRContinue, action)
+	release3()
+	release4()
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	qrs3 := New()
+	require.NoError(t, qrs3.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs3), "%s", data)
+}
+
+func TestRateLimitQPS(t *testing.T) {
+	defer func(f func() time.Time) { timeNow = f }(timeNow)
+	now := time.Date(2021, 3, 1, 12, 0, 0, 0, time.UTC)
+	timeNow = func() time.Time { return now }
+
+	rl := NewRateLimit(10, 0)
+	admitted := func() int {
+		n := 0
+		for i := 0; i < 100; i++ {
+			if _, ok := rl.acquire(); ok {
+				n++
+			}
+		}
+		return n
+	}
+	assert.Equal(t, 10, admitted())
+
+	// The budget is given back at QPS: a fixed window would admit 10 more
+	// queries right after a second boundary, a token bucket only refills
+	// what elapsed since the last query.
+	now = now.Add(100 * time.Millisecond)
+	assert.Equal(t, 1, admitted())
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, 5, admitted())
+	now = now.Add(time.Hour)
+	assert.Equal(t, 10, admitted())
+}
+
+func TestRewrite(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "cap t1",
+		"Action": "REWRITE",
+		"Rewrite": {"Limit": 100, "IndexHint": {"Type": "force", "Indexes": ["idx_a"]}, "Comment": "r1"}
+	}, {
+		"Name": "r2",
+		"Description": "comment t1",
+		"Action": "REWRITE",
+		"Rewrite": {"Comment": "r2"}
+	}, {
+		"Name": "r3",
+		"Description": "fail u3",
+		"User": "u3"
+	}]`))
+	require.NoError(t, err)
+
+	// Only the first rewrite rule applies, and later rules are still
+	// evaluated.
+	action, rule, release := qrs.Admit("", "u1", nil)
+	release()
+	assert.Equal(t, QRRewrite, action)
+	assert.Equal(t, "r1", rule.Name)
+	action, failRule, _ := qrs.Admit("", "u3", nil)
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "r3", failRule.Name)
+
+	testcases := []struct {
+		in, out string
+	}{{
+		in:  "select a from t1 where b = 1",
+		out: "select /* r1 */ a from t1 force index (idx_a) where b = 1 limit 100",
+	}, {
+		in:  "select a from t1 use index (idx_b) limit 5, 1000",
+		out: "select /* r1 */ a from t1 force index (idx_a) limit 5, 100",
+	}, {
+		in:  "select a from t1 limit 10",
+		out: "select /* r1 */ a from t1 force index (idx_a) limit 10",
+	}, {
+		in:  "delete from t1 where a = 1",
+		out: "delete /* r1 */ from t1 where a = 1 limit 100",
+	}, {
+		in:  "update t1 set a = 1 limit 1000",
+		out: "update /* r1 */ t1 set a = 1 limit 100",
+	}, {
+		in:  "select t1.a from t1 use index (idx_b) join t2 on t1.a = t2.a",
+		out: "select /* r1 */ t1.a from t1 force index (idx_a) join t2 on t1.a = t2.a limit 100",
+	}, {
+		in:  "select t1.a from (t1 join t2 on t1.a = t2.a) left join t3 on t1.a = t3.a",
+		out: "select /* r1 */ t1.a from (t1 force index (idx_a) join t2 on t1.a = t2.a) left join t3 on t1.a = t3.a limit 100",
+	}, {
+		in:  "select a from (select a from t1) as t",
+		out: "select /* r1 */ a from (select a from t1) as t limit 100",
+	}, {
+		in:  "create table t1 (a int)",
+		out: "create table t1 (a int)",
+	}, {
+		in:  "set @a = 1",
+		out: "set @a = 1",
+	}}
+	for _, tc := range testcases {
+		got, err := rule.Rewrite().Apply(tc.in)
+		require.NoError(t, err, tc.in)
+		assert.Equal(t, tc.out, got)
+	}
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	qrs2 := New()
+	require.NoError(t, qrs2.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs2), "%s", data)
+}
+
+func TestEstimatedRowsCond(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "fail unbounded selects on t1",
+		"Plans": ["Select"],
+		"TableNames": ["t1"],
+		"Unbounded": true
+	}, {
+		"Name": "r2",
+		"Description": "fail large updates",
+		"Plans": ["Update"],
+		"MinEstimatedRows": 1000
+	}, {
+		"Name": "r3",
+		"Description": "fail long IN lists",
+		"BindVarConds": [{
+			"Name": "ids",
+			"OnAbsent": false,
+			"OnMismatch": false,
+			"Operator": ">",
+			"Value": 2,
+			"Length": true
+		}]
+	}]`))
+	require.NoError(t, err)
+
+	names := func(qrs *Rules) (names []string) {
+		for _, qr := range qrs.rules {
+			names = append(names, qr.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"r1", "r3"}, names(qrs.FilterByPlan("select * from t1", planbuilder.PlanSelect, "t1", planbuilder.UnboundedRows)))
+	assert.Equal(t, []string{"r3"}, names(qrs.FilterByPlan("select * from t1 limit 10", planbuilder.PlanSelect, "t1", 10)))
+	assert.Equal(t, []string{"r3"}, names(qrs.FilterByPlan("select * from t2", planbuilder.PlanSelect, "t2", planbuilder.UnboundedRows)))
+	assert.Equal(t, []string{"r2", "r3"}, names(qrs.FilterByPlan("update t1 set a = 1", planbuilder.PlanUpdate, "t1", planbuilder.UnboundedRows)))
+	assert.Equal(t, []string{"r2", "r3"}, names(qrs.FilterByPlan("update t1 set a = 1 limit 1000", planbuilder.PlanUpdate, "t1", 1000)))
+	assert.Equal(t, []string{"r3"}, names(qrs.FilterByPlan("update t1 set a = 1 limit 999", planbuilder.PlanUpdate, "t1", 999)))
+
+	qrs1 := qrs.FilterByPlan("select * from t2 where id in ::ids", planbuilder.PlanSelect, "t2", planbuilder.UnboundedRows)
+	action, _ := qrs1.GetAction("", "", map[string]*querypb.BindVariable{
+		"ids": sqltypes.TestBindVariable([]interface{}{1, 2}),
+	})
+	assert.Equal(t, QRContinue, action)
+	action, desc := qrs1.GetAction("", "", map[string]*querypb.BindVariable{
+		"ids": sqltypes.TestBindVariable([]interface{}{1, 2, 3}),
+	})
+	assert.Equal(t, QRFail, action)
+	assert.Equal(t, "fail long IN lists", desc)
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	qrs2 := New()
+	require.NoError(t, qrs2.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs2), "%s", data)
+}
+
+func TestMonitorMode(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "new rule for u1",
+		"User": "u1",
+		"Mode": "monitor"
+	}, {
+		"Name": "r2",
+		"Description": "fail u2",
+		"User": "u2",
+		"Mode": "enforce"
+	}]`))
+	require.NoError(t, err)
+	assert.Equal(t, ModeMonitor, qrs.Find("r1").Mode())
+	assert.Equal(t, ModeEnforce, qrs.Find("r2").Mode())
+
+	// Rules in monitor mode are reported, but their action is not taken.
+	action, desc := qrs.GetAction("", "u1", nil)
+	assert.Equal(t, QRContinue, action)
+	assert.Equal(t, "", desc)
+	action, _, release := qrs.Admit("", "u1", nil)
+	release()
+	assert.Equal(t, QRContinue, action)
+	matched := qrs.Monitor("", "u1", nil)
+	require.Len(t, matched, 1)
+	assert.Equal(t, "r1", matched[0].Name)
+
+	assert.Empty(t, qrs.Monitor("", "u2", nil))
+	action, _ = qrs.GetAction("", "u2", nil)
+	assert.Equal(t, QRFail, action)
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Mode":"monitor"`)
+	assert.NotContains(t, string(data), `"Mode":"enforce"`)
+	qrs2 := New()
+	require.NoError(t, qrs2.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs2), "%s", data)
+}
+
+func TestFilterByTarget(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "fail everywhere"
+	}, {
+		"Name": "r2",
+		"Description": "fail on ks1",
+		"Keyspaces": ["ks1"]
+	}, {
+		"Name": "r3",
+		"Description": "fail on ks1 and ks2 shard -80",
+		"Keyspaces": ["ks1", "ks2"],
+		"Shards": ["-80"]
+	}, {
+		"Name": "r4",
+		"Description": "fail on the primary of ks1",
+		"Keyspaces": ["ks1"],
+		"TabletTypes": ["master"]
+	}]`))
+	require.NoError(t, err)
+
+	names := func(qrs *Rules) (names []string) {
+		for _, qr := range qrs.rules {
+			names = append(names, qr.Name)
+			assert.Nil(t, qr.keyspaces)
+			assert.Nil(t, qr.shards)
+			assert.Nil(t, qr.tabletTypes)
+		}
+		return names
+	}
+	replica := topodatapb.TabletType_REPLICA
+	assert.Equal(t, []string{"r1", "r2"}, names(qrs.FilterByTarget("ks1", "0", replica)))
+	assert.Equal(t, []string{"r1", "r2", "r4"}, names(qrs.FilterByTarget("ks1", "0", topodatapb.TabletType_MASTER)))
+	assert.Equal(t, []string{"r1", "r2", "r3"}, names(qrs.FilterByTarget("ks1", "-80", replica)))
+	assert.Equal(t, []string{"r1", "r3"}, names(qrs.FilterByTarget("ks2", "-80", replica)))
+	assert.Equal(t, []string{"r1"}, names(qrs.FilterByTarget("ks2", "80-", replica)))
+	assert.Equal(t, []string{"r1"}, names(qrs.FilterByTarget("", "", topodatapb.TabletType_UNKNOWN)))
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	qrs2 := New()
+	require.NoError(t, qrs2.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs2), "%s", data)
+}
+
+func TestQueryTimeout(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "olap selects",
+		"Plans": ["Select"],
+		"QueryTimeout": 30
+	}, {
+		"Name": "r2",
+		"Description": "batch user",
+		"User": "batch",
+		"QueryTimeout": 0.5
+	}, {
+		"Name": "r3",
+		"Description": "monitored",
+		"QueryTimeout": 0.1,
+		"Mode": "monitor"
+	}, {
+		"Name": "r4",
+		"Description": "fail with timeout",
+		"Plans": ["Delete"],
+		"QueryTimeout": 1,
+		"Action": "FAIL"
+	}]`))
+	require.NoError(t, err)
+	assert.Equal(t, QRContinue, qrs.Find("r1").act)
+	assert.Equal(t, QRFail, qrs.Find("r4").act)
+
+	sel := qrs.FilterByPlan("select * from t", planbuilder.PlanSelect, "t", planbuilder.UnboundedRows)
+	assert.Equal(t, 30*time.Second, sel.QueryTimeout("", "app", nil))
+	assert.Equal(t, 500*time.Millisecond, sel.QueryTimeout("", "batch", nil))
+	action, _ := sel.GetAction("", "batch", nil)
+	assert.Equal(t, QRContinue, action)
+
+	upd := qrs.FilterByPlan("update t set a = 1", planbuilder.PlanUpdate, "t", planbuilder.UnboundedRows)
+	assert.Equal(t, time.Duration(0), upd.QueryTimeout("", "app", nil))
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	qrs2 := New()
+	require.NoError(t, qrs2.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs2), "%s", data)
+}
+
+func TestDigestCond(t *testing.T) {
+	digest := sqlparser.Digest("select * from t where a = 1")
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "block a digest",
+		"Digests": ["` + digest + `"],
+		"Action": "FAIL"
+	}]`))
+	require.NoError(t, err)
+
+	qrs1 := qrs.FilterByPlan("select * from t where a = 42", planbuilder.PlanSelect, "t", planbuilder.UnboundedRows)
+	require.Len(t, qrs1.rules, 1)
+	assert.Nil(t, qrs1.rules[0].digests)
+	action, _ := qrs1.GetAction("", "", nil)
+	assert.Equal(t, QRFail, action)
+
+	qrs2 := qrs.FilterByPlan("select * from t where b = 42", planbuilder.PlanSelect, "t", planbuilder.UnboundedRows)
+	assert.Empty(t, qrs2.rules)
+
+	// The rules share the digest of the query.
+	r2 := NewQueryRule("block another digest", "r2", QRFailRetry)
+	r2.AddDigestCond(sqlparser.Digest("select * from t where b = 1"))
+	qrs.Add(r2)
+	qrs2 = qrs.FilterByPlan("select * from t where b = 42", planbuilder.PlanSelect, "t", planbuilder.UnboundedRows)
+	require.Len(t, qrs2.rules, 1)
+	assert.Equal(t, "r2", qrs2.rules[0].Name)
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	qrs3 := New()
+	require.NoError(t, qrs3.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs3), "%s", data)
+	assert.True(t, reflect.DeepEqual(qrs, qrs.Copy()))
+}
+
+func TestInjectionCond(t *testing.T) {
+	qrs := New()
+	err := qrs.UnmarshalJSON([]byte(`[{
+		"Name": "r1",
+		"Description": "block tautologies",
+		"Injections": ["tautology", "commented string"],
+		"Action": "FAIL"
+	}]`))
+	require.NoError(t, err)
+
+	qrs1 := qrs.FilterByPlan("select * from t where name = 'x' or 1 = 1", planbuilder.PlanSelect, "t", planbuilder.UnboundedRows)
+	require.Len(t, qrs1.rules, 1)
+	assert.Nil(t, qrs1.rules[0].injections)
+	action, _ := qrs1.GetAction("", "", nil)
+	assert.Equal(t, QRFail, action)
+
+	qrs2 := qrs.FilterByPlan("select * from t where name = 'x' or id = 1", planbuilder.PlanSelect, "t", planbuilder.UnboundedRows)
+	assert.Empty(t, qrs2.rules)
+
+	// The kinds of the other rules are found in the same query.
+	r2 := NewQueryRule("block stacked queries", "r2", QRFailRetry)
+	r2.AddInjectionCond(sqlparser.InjectionStackedQueries)
+	qrs.Add(r2)
+	qrs2 = qrs.FilterByPlan("select * from t where id = 1; drop table t", planbuilder.PlanSelect, "t", planbuilder.UnboundedRows)
+	require.Len(t, qrs2.rules, 1)
+	assert.Equal(t, "r2", qrs2.rules[0].Name)
+
+	data, err := json.Marshal(qrs)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"Injections":["tautology","commented string"]`)
+	qrs3 := New()
+	require.NoError(t, qrs3.UnmarshalJSON(data))
+	assert.True(t, qrs.Equal(qrs3), "%s", data)
+	assert.True(t, reflect.DeepEqual(qrs, qrs.Copy()))
+}
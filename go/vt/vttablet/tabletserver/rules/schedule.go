@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rules
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/vterrors"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// timeNow is stubbed by tests.
+var timeNow = time.Now
+
+// TimeWindow is a recurring window of time during which a Rule is active.
+// If End is before Start, the window spans midnight and belongs to the
+// day on which it starts.
+type TimeWindow struct {
+	// Days restricts the window to some days of the week.
+	// An empty list means every day.
+	Days []time.Weekday
+	// Start and End are wall clock times of day, as offsets from midnight.
+	Start, End time.Duration
+	// Location is the time zone of the window. nil means UTC.
+	Location *time.Location
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"SUN": time.Sunday,
+	"MON": time.Monday,
+	"TUE": time.Tuesday,
+	"WED": time.Wednesday,
+	"THU": time.Thursday,
+	"FRI": time.Friday,
+	"SAT": time.Saturday,
+}
+
+// NewTimeWindow creates a TimeWindow. days are three letter day names
+// (e.g. "Mon"), start and end are in the "15:04" format, and timeZone is
+// an IANA time zone name. An empty timeZone means UTC.
+func NewTimeWindow(days []string, start, end, timeZone string) (TimeWindow, error) {
+	var tw TimeWindow
+	for _, day := range days {
+		wd, ok := weekdayNames[strings.ToUpper(day)]
+		if !ok {
+			return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid day: %s", day)
+		}
+		tw.Days = append(tw.Days, wd)
+	}
+	var err error
+	if tw.Start, err = parseTimeOfDay(start); err != nil {
+		return TimeWindow{}, err
+	}
+	if tw.End, err = parseTimeOfDay(end); err != nil {
+		return TimeWindow{}, err
+	}
+	if timeZone != "" {
+		if tw.Location, err = time.LoadLocation(timeZone); err != nil {
+			return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid time zone %s: %v", timeZone, err)
+		}
+	}
+	return tw, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid time of day %s, want HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// Contains returns true if t falls within the window.
+func (tw TimeWindow) Contains(t time.Time) bool {
+	loc := tw.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+	// The wall clock time of day, which is not the time elapsed since
+	// midnight on the days of daylight saving time transitions.
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute + time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+	if tw.Start <= tw.End {
+		return offset >= tw.Start && offset < tw.End && tw.dayMatch(t.Weekday())
+	}
+	// The window spans midnight.
+	if offset >= tw.Start {
+		return tw.dayMatch(t.Weekday())
+	}
+	if offset < tw.End {
+		return tw.dayMatch((t.Weekday() + 6) % 7)
+	}
+	return false
+}
+
+func (tw TimeWindow) dayMatch(wd time.Weekday) bool {
+	if len(tw.Days) == 0 {
+		return true
+	}
+	for _, day := range tw.Days {
+		if day == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// Equal returns true if other is equal to this TimeWindow.
+func (tw TimeWindow) Equal(other TimeWindow) bool {
+	if tw.Start != other.Start || tw.End != other.End || len(tw.Days) != len(other.Days) {
+		return false
+	}
+	for i := range tw.Days {
+		if tw.Days[i] != other.Days[i] {
+			return false
+		}
+	}
+	return tw.timeZone() == other.timeZone()
+}
+
+func (tw TimeWindow) timeZone() string {
+	if tw.Location == nil {
+		return ""
+	}
+	return tw.Location.String()
+}
+
+// MarshalJSON marshals to JSON.
+func (tw TimeWindow) MarshalJSON() ([]byte, error) {
+	b := bytes.NewBuffer(nil)
+	days := make([]string, 0, len(tw.Days))
+	for _, day := range tw.Days {
+		days = append(days, day.String()[:3])
+	}
+	safeEncode(b, `{"Days":`, days)
+	safeEncode(b, `,"Start":`, formatTimeOfDay(tw.Start))
+	safeEncode(b, `,"End":`, formatTimeOfDay(tw.End))
+	if tz := tw.timeZone(); tz != "" {
+		safeEncode(b, `,"TimeZone":`, tz)
+	}
+	_, _ = b.WriteString("}")
+	return b.Bytes(), nil
+}
+
+func formatTimeOfDay(d time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(d/time.Hour), int(d%time.Hour/time.Minute))
+}
+
+// SetActiveRange restricts the Rule to the time range [from, until).
+// A zero time leaves that side of the range unbounded.
+func (qr *Rule) SetActiveRange(from, until time.Time) {
+	qr.activeFrom = from
+	qr.activeUntil = until
+}
+
+// AddTimeWindow adds to the list of windows during which the rule is
+// active. This function acts as an OR: the rule is active if the current
+// time falls in any of the windows. A rule without windows is always active.
+func (qr *Rule) AddTimeWindow(tw TimeWindow) {
+	qr.schedule = append(qr.schedule, tw)
+}
+
+// isActive returns true if the rule is active at time t.
+func (qr *Rule) isActive(t time.Time) bool {
+	if !qr.activeFrom.IsZero() && t.Before(qr.activeFrom) {
+		return false
+	}
+	if !qr.activeUntil.IsZero() && !t.Before(qr.activeUntil) {
+		return false
+	}
+	if len(qr.schedule) == 0 {
+		return true
+	}
+	for _, tw := range qr.schedule {
+		if tw.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func scheduleEqual(a, b []TimeWindow) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func buildTimeWindow(v interface{}) (TimeWindow, error) {
+	info, ok := v.(map[string]interface{})
+	if !ok {
+		return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want json object for Schedule")
+	}
+	var days []string
+	var start, end, timeZone string
+	for k, v := range info {
+		switch k {
+		case "Days":
+			lv, ok := v.([]interface{})
+			if !ok {
+				return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want list for Days")
+			}
+			for _, d := range lv {
+				day, ok := d.(string)
+				if !ok {
+					return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for Days")
+				}
+				days = append(days, day)
+			}
+		case "Start", "End", "TimeZone":
+			sv, ok := v.(string)
+			if !ok {
+				return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "want string for %s", k)
+			}
+			switch k {
+			case "Start":
+				start = sv
+			case "End":
+				end = sv
+			case "TimeZone":
+				timeZone = sv
+			}
+		default:
+			return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "unrecognized tag %s in Schedule", k)
+		}
+	}
+	if start == "" || end == "" {
+		return TimeWindow{}, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "Start and End are required in Schedule")
+	}
+	return NewTimeWindow(days, start, end, timeZone)
+}
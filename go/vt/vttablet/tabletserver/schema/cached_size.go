@@ -17,6 +17,10 @@ limitations under the License.
 
 package schema
 
+type cachedObject interface {
+	CachedSize(alloc bool) int64
+}
+
 func (cached *MessageInfo) CachedSize(alloc bool) int64 {
 	if cached == nil {
 		return int64(0)
@@ -40,7 +44,11 @@ func (cached *SequenceInfo) CachedSize(alloc bool) int64 {
 	}
 	size := int64(0)
 	if alloc {
-		size += int64(24)
+		size += int64(48)
+	}
+	// field HighWaterMarkVersion vitess.io/vitess/go/vt/topo.Version
+	if cc, ok := cached.HighWaterMarkVersion.(cachedObject); ok {
+		size += cc.CachedSize(true)
 	}
 	return size
 }
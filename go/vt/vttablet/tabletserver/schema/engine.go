@@ -66,6 +66,7 @@ type Engine struct {
 	reloadAtPos mysql.Position
 	notifierMu  sync.Mutex
 	notifiers   map[string]notifier
+	subscribers map[string]chan ChangeEvent
 
 	// SkipMetaCheck skips the metadata about the database and table information
 	SkipMetaCheck bool
@@ -193,6 +194,7 @@ func (se *Engine) Open() error {
 		"dual": NewTable("dual"),
 	}
 	se.notifiers = make(map[string]notifier)
+	se.subscribers = make(map[string]chan ChangeEvent)
 
 	if err := se.reload(ctx); err != nil {
 		return err
@@ -236,6 +238,7 @@ func (se *Engine) Close() {
 	se.tables = make(map[string]*Table)
 	se.lastChange = 0
 	se.notifiers = make(map[string]notifier)
+	se.closeSubscribers()
 	se.isOpen = false
 	log.Info("Schema Engine: closed")
 }
@@ -251,6 +254,8 @@ func (se *Engine) MakeNonMaster() {
 			t.SequenceInfo.Lock()
 			t.SequenceInfo.NextVal = 0
 			t.SequenceInfo.LastVal = 0
+			t.SequenceInfo.HighWaterMark = 0
+			t.SequenceInfo.HighWaterMarkVersion = nil
 			t.SequenceInfo.Unlock()
 		}
 	}
@@ -328,6 +333,8 @@ func (se *Engine) reload(ctx context.Context) error {
 	changedTables := make(map[string]*Table)
 	// created and altered contain the names of created and altered tables for broadcast.
 	var created, altered []string
+	// before contains the previous definitions of altered and dropped tables.
+	before := make(map[string]*Table)
 	for _, row := range tableData.Rows {
 		tableName := row[0].ToString()
 		curTables[tableName] = true
@@ -359,6 +366,7 @@ func (se *Engine) reload(ctx context.Context) error {
 		changedTables[tableName] = table
 		if isInTablesMap {
 			altered = append(altered, tableName)
+			before[tableName] = tbl
 		} else {
 			created = append(created, tableName)
 		}
@@ -372,6 +380,7 @@ func (se *Engine) reload(ctx context.Context) error {
 	for tableName := range se.tables {
 		if !curTables[tableName] {
 			dropped = append(dropped, tableName)
+			before[tableName] = se.tables[tableName]
 			delete(se.tables, tableName)
 		}
 	}
@@ -389,7 +398,7 @@ func (se *Engine) reload(ctx context.Context) error {
 	if len(created) > 0 || len(altered) > 0 || len(dropped) > 0 {
 		log.Infof("schema engine created %v, altered %v, dropped %v", created, altered, dropped)
 	}
-	se.broadcast(created, altered, dropped)
+	se.broadcast(created, altered, dropped, before)
 	return nil
 }
 
@@ -509,7 +518,7 @@ func (se *Engine) UnregisterNotifier(name string) {
 }
 
 // broadcast must be called while holding a lock on se.mu.
-func (se *Engine) broadcast(created, altered, dropped []string) {
+func (se *Engine) broadcast(created, altered, dropped []string, before map[string]*Table) {
 	if !se.isOpen {
 		return
 	}
@@ -523,6 +532,7 @@ func (se *Engine) broadcast(created, altered, dropped []string) {
 	for _, f := range se.notifiers {
 		f(s, created, altered, dropped)
 	}
+	se.publish(changeEvents(s, created, altered, dropped, before))
 }
 
 // GetTable returns the info for a table.
@@ -167,9 +167,37 @@ func TestOpenAndReload(t *testing.T) {
 		}
 	}
 	se.RegisterNotifier("test", notifier)
+	oldTable03 := se.GetTable(sqlparser.NewTableIdent("test_table_03"))
+	oldMsg := se.GetTable(sqlparser.NewTableIdent("msg"))
+	events := se.Subscribe("test")
+	var initial []string
+	for range want {
+		event := <-events
+		assert.Equal(t, TableAdded, event.Type)
+		assert.Equal(t, want[event.Name], event.After)
+		initial = append(initial, event.Name)
+	}
+	sort.Strings(initial)
+	assert.Equal(t, []string{"dual", "msg", "seq", "test_table_01", "test_table_02", "test_table_03"}, initial)
 	err := se.Reload(context.Background())
 	require.NoError(t, err)
 
+	event := <-events
+	assert.Equal(t, TableAdded, event.Type)
+	assert.Equal(t, "test_table_04", event.Name)
+	assert.Nil(t, event.Before)
+	assert.Equal(t, se.GetTable(sqlparser.NewTableIdent("test_table_04")), event.After)
+	event = <-events
+	assert.Equal(t, TableAltered, event.Type)
+	assert.Equal(t, "test_table_03", event.Name)
+	assert.Equal(t, oldTable03, event.Before)
+	assert.Equal(t, se.GetTable(sqlparser.NewTableIdent("test_table_03")), event.After)
+	event = <-events
+	assert.Equal(t, ChangeEvent{Type: TableDropped, Name: "msg", Before: oldMsg}, event)
+	se.Unsubscribe("test")
+	_, ok := <-events
+	assert.False(t, ok, "channel should be closed")
+
 	assert.EqualValues(t, secondReadRowsValue, se.innoDbReadRowsGauge.Get())
 
 	want["test_table_03"] = &Table{
@@ -384,6 +412,27 @@ func TestStatsURL(t *testing.T) {
 	se.handleDebugSchema(response, request)
 }
 
+func TestSubscribeClosedEngine(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	se := newEngine(10, 10*time.Second, 10*time.Second, db)
+	_, ok := <-se.Subscribe("test")
+	assert.False(t, ok, "channel should be closed")
+}
+
+func TestPublishSlowSubscriber(t *testing.T) {
+	slow := make(chan ChangeEvent, 1)
+	fast := make(chan ChangeEvent, 2)
+	se := &Engine{subscribers: map[string]chan ChangeEvent{"slow": slow, "fast": fast}}
+	se.publish([]ChangeEvent{{Type: TableAdded, Name: "t1"}, {Type: TableDropped, Name: "t2"}})
+
+	_, ok := <-slow
+	assert.False(t, ok, "slow subscriber should be unsubscribed")
+	assert.Equal(t, map[string]chan ChangeEvent{"fast": fast}, se.subscribers)
+	assert.Equal(t, ChangeEvent{Type: TableAdded, Name: "t1"}, <-fast)
+	assert.Equal(t, ChangeEvent{Type: TableDropped, Name: "t2"}, <-fast)
+}
+
 func newEngine(queryCacheSize int, reloadTime time.Duration, idleTimeout time.Duration, db *fakesqldb.DB) *Engine {
 	config := tabletenv.NewDefaultConfig()
 	config.QueryCacheSize = queryCacheSize
@@ -497,6 +546,7 @@ func initialSchema() map[string]*Table {
 				BatchSize:          1,
 				CacheSize:          10,
 				PollInterval:       30 * time.Second,
+				PurgePolicy:        PurgeRetain,
 			},
 		},
 	}
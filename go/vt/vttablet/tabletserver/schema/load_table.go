@@ -62,6 +62,8 @@ func loadMessageInfo(ta *Table, comment string) error {
 		"time_next":  {},
 		"epoch":      {},
 		"time_acked": {},
+		// time_scheduled is optional.
+		"time_scheduled": {},
 	}
 
 	requiredCols := []string{
@@ -110,6 +112,22 @@ func loadMessageInfo(ta *Table, comment string) error {
 
 	ta.MessageInfo.MaxBackoff, _ = getDuration(keyvals, "vt_max_backoff")
 
+	ta.MessageInfo.MaxAttempts, _ = getNum(keyvals, "vt_max_attempts")
+
+	switch policy := keyvals["vt_purge_policy"]; policy {
+	case "", PurgeRetain:
+		ta.MessageInfo.PurgePolicy = PurgeRetain
+	case PurgeDelete:
+		ta.MessageInfo.PurgePolicy = PurgeDelete
+	case PurgeArchive:
+		ta.MessageInfo.PurgePolicy = PurgeArchive
+		if ta.MessageInfo.ArchiveTable = keyvals["vt_archive_table"]; ta.MessageInfo.ArchiveTable == "" {
+			return fmt.Errorf("attribute vt_archive_table not specified for message table with archive purge policy")
+		}
+	default:
+		return fmt.Errorf("invalid vt_purge_policy %s for message table: %s", policy, ta.Name.String())
+	}
+
 	for _, col := range requiredCols {
 		num := ta.FindColumn(sqlparser.NewColIdent(col))
 		if num == -1 {
@@ -117,6 +135,8 @@ func loadMessageInfo(ta *Table, comment string) error {
 		}
 	}
 
+	ta.MessageInfo.HasTimeScheduled = ta.FindColumn(sqlparser.NewColIdent("time_scheduled")) != -1
+
 	// Load user-defined columns. Any "unrecognized" column is user-defined.
 	for _, field := range ta.Fields {
 		if _, ok := hiddenCols[strings.ToLower(field.Name)]; ok {
@@ -130,6 +130,7 @@ func TestLoadTableMessage(t *testing.T) {
 			BatchSize:          1,
 			CacheSize:          10,
 			PollInterval:       30 * time.Second,
+			PurgePolicy:        PurgeRetain,
 		},
 	}
 	assert.Equal(t, want, table)
@@ -141,6 +142,27 @@ func TestLoadTableMessage(t *testing.T) {
 	want.MessageInfo.MaxBackoff = 100 * time.Second
 	assert.Equal(t, want, table)
 
+	// Test loading max attempts
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5", db)
+	require.NoError(t, err)
+	want.MessageInfo.MaxAttempts = 5
+	assert.Equal(t, want, table)
+
+	// Test loading purge policies
+	table, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_min_backoff=10,vt_max_backoff=100,vt_max_attempts=5,vt_purge_policy=archive,vt_archive_table=test_archive", db)
+	require.NoError(t, err)
+	want.MessageInfo.PurgePolicy = PurgeArchive
+	want.MessageInfo.ArchiveTable = "test_archive"
+	assert.Equal(t, want, table)
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_purge_policy=archive", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "attribute vt_archive_table not specified")
+
+	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30,vt_purge_after=120,vt_batch_size=1,vt_cache_size=10,vt_poller_interval=30,vt_purge_policy=drop", db)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid vt_purge_policy drop for message table: test_table")
+
 	// Missing property
 	_, err = newTestLoadTable("USER_TABLE", "vitess_message,vt_ack_wait=30", db)
 	wanterr := "not specified for message table"
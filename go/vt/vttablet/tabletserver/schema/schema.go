@@ -21,6 +21,7 @@ import (
 	"time"
 
 	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
 )
@@ -40,6 +41,18 @@ var TypeNames = []string{
 	"message",
 }
 
+// Purge policies for message tables.
+const (
+	// PurgeRetain keeps acked messages for PurgeAfterDuration
+	// before deleting them. This is the default.
+	PurgeRetain = "retain"
+	// PurgeDelete deletes messages as soon as they're acked.
+	PurgeDelete = "delete"
+	// PurgeArchive moves acked messages to ArchiveTable
+	// after PurgeAfterDuration.
+	PurgeArchive = "archive"
+)
+
 // Table contains info about a table.
 type Table struct {
 	Name      sqlparser.TableIdent
@@ -66,6 +79,12 @@ type SequenceInfo struct {
 	sync.Mutex
 	NextVal int64
 	LastVal int64
+	// HighWaterMark is the high-water mark last saved in the topo by
+	// the tablet, and HighWaterMarkVersion its topo version. The IDs
+	// below it are reserved for the tablet, so that the cache can be
+	// refreshed up to it without going to the topo.
+	HighWaterMark        int64
+	HighWaterMarkVersion topo.Version
 }
 
 // MessageInfo contains info specific to message tables.
@@ -103,6 +122,27 @@ type MessageInfo struct {
 	// MaxBackoff specifies the longest duration message manager
 	// should wait before rescheduling a message
 	MaxBackoff time.Duration
+
+	// MaxAttempts specifies the number of times a message is sent
+	// before it is dead-lettered. Dead-lettered messages are not
+	// sent again, and are acked when they're dead-lettered so that
+	// they're purged according to PurgePolicy. 0 means that there
+	// is no limit.
+	MaxAttempts int
+
+	// PurgePolicy specifies what happens to acked messages.
+	// It's one of PurgeRetain, PurgeDelete or PurgeArchive.
+	PurgePolicy string
+
+	// ArchiveTable is the table acked messages are moved
+	// to if PurgePolicy is PurgeArchive. It must have the
+	// same columns as the message table.
+	ArchiveTable string
+
+	// HasTimeScheduled is true if the table has the optional
+	// time_scheduled column. Messages are not sent before
+	// their time_scheduled.
+	HasTimeScheduled bool
 }
 
 // NewTable creates a new Table.
@@ -0,0 +1,143 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"vitess.io/vitess/go/vt/log"
+)
+
+// subscriberBufferSize is the number of events a subscriber can lag
+// behind before it is unsubscribed.
+const subscriberBufferSize = 1000
+
+// ChangeType is the type of a schema change.
+type ChangeType int
+
+// The types of schema changes.
+const (
+	TableAdded ChangeType = iota
+	TableAltered
+	TableDropped
+)
+
+func (t ChangeType) String() string {
+	switch t {
+	case TableAdded:
+		return "TableAdded"
+	case TableAltered:
+		return "TableAltered"
+	case TableDropped:
+		return "TableDropped"
+	}
+	return "Unknown"
+}
+
+// ChangeEvent describes the change of a table. Before is nil for added
+// tables, and After is nil for dropped tables. The tables must not be
+// modified.
+type ChangeEvent struct {
+	Type   ChangeType
+	Name   string
+	Before *Table
+	After  *Table
+}
+
+// Subscribe returns a channel receiving the schema changes, starting with
+// a TableAdded event for every table of the current schema. The channel
+// is closed when the subscription is cancelled by Unsubscribe, when the
+// engine is closed, or when the subscriber falls too far behind. The
+// subscriber must then subscribe again to resync. A closed channel is
+// returned if the engine is not open. Subscribe must not be called from
+// a notifier.
+func (se *Engine) Subscribe(name string) <-chan ChangeEvent {
+	se.mu.Lock()
+	defer se.mu.Unlock()
+	se.notifierMu.Lock()
+	defer se.notifierMu.Unlock()
+
+	if !se.isOpen {
+		ch := make(chan ChangeEvent)
+		close(ch)
+		return ch
+	}
+	if ch, ok := se.subscribers[name]; ok {
+		close(ch)
+	}
+	ch := make(chan ChangeEvent, len(se.tables)+subscriberBufferSize)
+	for tableName, table := range se.tables {
+		ch <- ChangeEvent{Type: TableAdded, Name: tableName, After: table}
+	}
+	se.subscribers[name] = ch
+	return ch
+}
+
+// Unsubscribe cancels the subscription and closes its channel.
+func (se *Engine) Unsubscribe(name string) {
+	se.notifierMu.Lock()
+	defer se.notifierMu.Unlock()
+
+	if ch, ok := se.subscribers[name]; ok {
+		close(ch)
+		delete(se.subscribers, name)
+	}
+}
+
+// publish sends the events to the subscribers. It must be called while
+// holding a lock on se.notifierMu. Subscribers too far behind to receive
+// the events are unsubscribed.
+func (se *Engine) publish(events []ChangeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	for name, ch := range se.subscribers {
+		if cap(ch)-len(ch) < len(events) {
+			log.Warningf("schema engine: subscriber %s is too far behind, unsubscribing it", name)
+			close(ch)
+			delete(se.subscribers, name)
+			continue
+		}
+		for _, event := range events {
+			ch <- event
+		}
+	}
+}
+
+// closeSubscribers closes the channels of all the subscribers.
+func (se *Engine) closeSubscribers() {
+	se.notifierMu.Lock()
+	defer se.notifierMu.Unlock()
+
+	for _, ch := range se.subscribers {
+		close(ch)
+	}
+	se.subscribers = make(map[string]chan ChangeEvent)
+}
+
+// changeEvents returns the events of a schema reload.
+func changeEvents(tables map[string]*Table, created, altered, dropped []string, before map[string]*Table) []ChangeEvent {
+	var events []ChangeEvent
+	for _, name := range created {
+		events = append(events, ChangeEvent{Type: TableAdded, Name: name, After: tables[name]})
+	}
+	for _, name := range altered {
+		events = append(events, ChangeEvent{Type: TableAltered, Name: name, Before: before[name], After: tables[name]})
+	}
+	for _, name := range dropped {
+		events = append(events, ChangeEvent{Type: TableDropped, Name: name, Before: before[name]})
+	}
+	return events
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+
+	"vitess.io/vitess/go/acl"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+// sequenceHighWaterMark is the next ID of a sequence table, as saved in
+// the global topo. It survives reparents to a replica which did not
+// receive the last updates of the sequence table.
+type sequenceHighWaterMark struct {
+	NextID int64
+}
+
+// sequenceHighWaterMarkRefreshes is the number of cache refreshes of a
+// sequence table covered by a high-water mark saved in the topo. The
+// high-water mark is saved ahead of the next ID, so that the refreshes
+// below it don't go to the topo.
+const sequenceHighWaterMarkRefreshes = 10
+
+// sequenceTopoPath returns the path of the high-water mark of the sequence
+// table in the global topo.
+func (tsv *TabletServer) sequenceTopoPath(tableName sqlparser.TableIdent) string {
+	target := tsv.sm.Target()
+	return path.Join("sequences", target.Keyspace, target.Shard, tableName.String())
+}
+
+// readSequenceHighWaterMark returns the high-water mark of the sequence
+// table, or 0 if there is none.
+func (tsv *TabletServer) readSequenceHighWaterMark(ctx context.Context, tableName sqlparser.TableIdent) (int64, topo.Version, error) {
+	if tsv.topoServer == nil {
+		return 0, nil, nil
+	}
+	conn, err := tsv.topoServer.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return 0, nil, err
+	}
+	data, version, err := conn.Get(ctx, tsv.sequenceTopoPath(tableName))
+	if topo.IsErrType(err, topo.NoNode) {
+		return 0, nil, nil
+	}
+	if err != nil {
+		return 0, nil, err
+	}
+	var hwm sequenceHighWaterMark
+	if err := json.Unmarshal(data, &hwm); err != nil {
+		return 0, nil, fmt.Errorf("cannot parse high-water mark of sequence %s: %v", tableName, err)
+	}
+	return hwm.NextID, version, nil
+}
+
+// writeSequenceHighWaterMark saves the high-water mark of the sequence
+// table, and returns its new version. version is the version read by
+// readSequenceHighWaterMark or returned by the last write, so that
+// concurrent writers cannot move the high-water mark backwards.
+func (tsv *TabletServer) writeSequenceHighWaterMark(ctx context.Context, tableName sqlparser.TableIdent, nextID int64, version topo.Version) (topo.Version, error) {
+	if tsv.topoServer == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(&sequenceHighWaterMark{NextID: nextID})
+	if err != nil {
+		return nil, err
+	}
+	conn, err := tsv.topoServer.ConnForCell(ctx, topo.GlobalCell)
+	if err != nil {
+		return nil, err
+	}
+	if version == nil {
+		return conn.Create(ctx, tsv.sequenceTopoPath(tableName), data)
+	}
+	return conn.Update(ctx, tsv.sequenceTopoPath(tableName), data, version)
+}
+
+// updateSequence moves the next ID of the sequence table of the plan
+// forward, in a transaction. advance is called with the current next ID
+// and cache size, and returns the new next ID. The first time, the current
+// next ID is raised to the high-water mark saved in the topo. A new
+// high-water mark, some cache refreshes ahead of the new next ID, is saved
+// in the topo before the transaction commits whenever the new next ID goes
+// past the high-water mark of the tablet. This way an ID can never be
+// handed out twice, even after a reparent to a replica which missed the
+// last updates of the sequence table.
+// It must be called while holding the lock of the SequenceInfo.
+func (qre *QueryExecutor) updateSequence(advance func(nextID, cache int64) (int64, error)) error {
+	tableName := qre.plan.TableName()
+	seq := qre.plan.Table.SequenceInfo
+	_, err := qre.execAsTransaction(func(conn *StatefulConnection) (*sqltypes.Result, error) {
+		query := fmt.Sprintf("select next_id, cache from %s where id = 0 for update", sqlparser.String(tableName))
+		qr, err := qre.execStatefulConn(conn, query, false)
+		if err != nil {
+			return nil, err
+		}
+		if len(qr.Rows) != 1 {
+			return nil, fmt.Errorf("unexpected rows from reading sequence %s (possible mis-route): %d", tableName, len(qr.Rows))
+		}
+		nextID, err := evalengine.ToInt64(qr.Rows[0][0])
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "error loading sequence %s", tableName)
+		}
+		cache, err := evalengine.ToInt64(qr.Rows[0][1])
+		if err != nil {
+			return nil, vterrors.Wrapf(err, "error loading sequence %s", tableName)
+		}
+		if seq.HighWaterMark == 0 {
+			// The high-water mark in the topo may have been saved by
+			// another tablet: the IDs below it may have been handed out.
+			hwm, version, err := qre.tsv.readSequenceHighWaterMark(qre.ctx, tableName)
+			if err != nil {
+				return nil, vterrors.Wrapf(err, "error loading high-water mark of sequence %s", tableName)
+			}
+			if nextID < hwm {
+				log.Warningf("Sequence %s next ID value %v is below its high-water mark %v, updating it to the high-water mark", tableName, nextID, hwm)
+				nextID = hwm
+			}
+			seq.HighWaterMarkVersion = version
+		}
+		newNextID, err := advance(nextID, cache)
+		if err != nil {
+			return nil, err
+		}
+		query = fmt.Sprintf("update %s set next_id = %d where id = 0", sqlparser.String(tableName), newNextID)
+		conn.TxProperties().RecordQuery(query)
+		if _, err := qre.execStatefulConn(conn, query, false); err != nil {
+			return nil, err
+		}
+		if newNextID > seq.HighWaterMark {
+			hwm := newNextID + sequenceHighWaterMarkRefreshes*cache
+			version, err := qre.tsv.writeSequenceHighWaterMark(qre.ctx, tableName, hwm, seq.HighWaterMarkVersion)
+			if err != nil {
+				// Another tablet may have saved a high-water mark:
+				// the next update reads it again.
+				seq.HighWaterMark = 0
+				seq.HighWaterMarkVersion = nil
+				return nil, vterrors.Wrapf(err, "error saving high-water mark of sequence %s", tableName)
+			}
+			seq.HighWaterMark = hwm
+			seq.HighWaterMarkVersion = version
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// reserveSequence reserves count IDs of the sequence table of the plan,
+// and returns the first one. The IDs are taken from the sequence table,
+// after the IDs cached by the tablet.
+func (qre *QueryExecutor) reserveSequence(count int64) (int64, error) {
+	t := qre.plan.Table
+	t.SequenceInfo.Lock()
+	defer t.SequenceInfo.Unlock()
+	var first int64
+	err := qre.updateSequence(func(nextID, _ int64) (int64, error) {
+		if nextID < t.SequenceInfo.LastVal {
+			nextID = t.SequenceInfo.LastVal
+		}
+		first = nextID
+		return nextID + count, nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return first, nil
+}
+
+// ReserveSequence reserves count IDs of a sequence table, bypassing the
+// cache of the tablet. It returns the first reserved ID. The tablet must
+// be a master.
+func (tsv *TabletServer) ReserveSequence(ctx context.Context, table string, count int64) (first int64, err error) {
+	target := tsv.sm.Target()
+	err = tsv.execRequest(
+		ctx, tsv.QueryTimeout.Get(),
+		"ReserveSequence", fmt.Sprintf("reserve %d from %s", count, table), nil,
+		&target, nil, false, /* allowOnShutdown */
+		func(ctx context.Context, logStats *tabletenv.LogStats) error {
+			if target.TabletType != topodatapb.TabletType_MASTER {
+				return vterrors.Errorf(vtrpcpb.Code_FAILED_PRECONDITION, "sequences can only be reserved on a master, not a %v", target.TabletType)
+			}
+			if count < 1 {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "invalid count for sequence %s: %d", table, count)
+			}
+			t := tsv.se.GetTable(sqlparser.NewTableIdent(table))
+			if t == nil || t.SequenceInfo == nil {
+				return vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%s is not a sequence table", table)
+			}
+			qre := &QueryExecutor{
+				query:    logStats.OriginalSQL,
+				bindVars: make(map[string]*querypb.BindVariable),
+				options:  &querypb.ExecuteOptions{},
+				plan:     &TabletPlan{Plan: &planbuilder.Plan{PlanID: planbuilder.PlanNextval, Table: t}},
+				ctx:      ctx,
+				logStats: logStats,
+				tsv:      tsv,
+			}
+			first, err = qre.reserveSequence(count)
+			return err
+		},
+	)
+	return first, err
+}
+
+// sequenceReserveHandler reserves count IDs of a sequence table, see
+// ReserveSequence.
+func sequenceReserveHandler(tsv *TabletServer, w http.ResponseWriter, r *http.Request) {
+	if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+		acl.SendError(w, err)
+		return
+	}
+	if r.Method != http.MethodPost {
+		http.Error(w, "reserve requires a POST", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		http.Error(w, fmt.Sprintf("cannot parse form: %s", err), http.StatusBadRequest)
+		return
+	}
+	count, err := strconv.ParseInt(r.FormValue("count"), 10, 64)
+	if err != nil {
+		http.Error(w, "invalid count", http.StatusBadRequest)
+		return
+	}
+	first, err := tsv.ReserveSequence(r.Context(), r.FormValue("table"), count)
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch vterrors.Code(err) {
+		case vtrpcpb.Code_INVALID_ARGUMENT:
+			code = http.StatusBadRequest
+		case vtrpcpb.Code_FAILED_PRECONDITION:
+			code = http.StatusPreconditionFailed
+		}
+		http.Error(w, err.Error(), code)
+		return
+	}
+	js, err := json.Marshal(struct{ First, Count int64 }{first, count})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(js)
+}
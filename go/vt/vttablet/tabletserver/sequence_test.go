@@ -0,0 +1,183 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletserver
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
+)
+
+const selectSequenceQuery = "select next_id, cache from seq where id = 0 for update"
+
+func addSequenceQuery(db *fakesqldb.DB, nextID, cache int64) {
+	db.AddQuery(selectSequenceQuery, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewInt64(nextID),
+			sqltypes.NewInt64(cache),
+		}},
+	})
+}
+
+func nextSequenceValue(t *testing.T, tsv *TabletServer) int64 {
+	t.Helper()
+	got, err := newTestQueryExecutor(context.Background(), tsv, "select next value from seq", 0).Execute()
+	require.NoError(t, err)
+	v, err := evalengine.ToInt64(got.Rows[0][0])
+	require.NoError(t, err)
+	return v
+}
+
+func TestSequenceHighWaterMark(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	addSequenceQuery(db, 1, 3)
+	db.AddQuery("update seq set next_id = 4 where id = 0", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// The high-water mark covers several refreshes of the cache.
+	assert.EqualValues(t, 1, nextSequenceValue(t, tsv))
+	hwm, version, err := tsv.readSequenceHighWaterMark(ctx, sqlparser.NewTableIdent("seq"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 34, hwm)
+
+	// The refreshes below the high-water mark don't update it.
+	assert.EqualValues(t, 2, nextSequenceValue(t, tsv))
+	assert.EqualValues(t, 3, nextSequenceValue(t, tsv))
+	addSequenceQuery(db, 4, 3)
+	db.AddQuery("update seq set next_id = 7 where id = 0", &sqltypes.Result{})
+	assert.EqualValues(t, 4, nextSequenceValue(t, tsv))
+	hwm, version2, err := tsv.readSequenceHighWaterMark(ctx, sqlparser.NewTableIdent("seq"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 34, hwm)
+	assert.Equal(t, version, version2)
+
+	// After a reparent to a replica which missed the last update of the
+	// sequence table, the IDs continue from the high-water mark.
+	tsv.se.MakeNonMaster()
+	addSequenceQuery(db, 1, 3)
+	db.AddQuery("update seq set next_id = 37 where id = 0", &sqltypes.Result{})
+	assert.EqualValues(t, 34, nextSequenceValue(t, tsv))
+	hwm, version, err = tsv.readSequenceHighWaterMark(ctx, sqlparser.NewTableIdent("seq"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 67, hwm)
+
+	// A high-water mark saved by another tablet fails the update going
+	// past the high-water mark of the tablet, and is used by the next one.
+	_, err = tsv.writeSequenceHighWaterMark(ctx, sqlparser.NewTableIdent("seq"), 100, version)
+	require.NoError(t, err)
+	addSequenceQuery(db, 37, 3)
+	db.AddQuery("update seq set next_id = 77 where id = 0", &sqltypes.Result{})
+	_, err = tsv.ReserveSequence(ctx, "seq", 40)
+	require.Error(t, err)
+	db.AddQuery("update seq set next_id = 140 where id = 0", &sqltypes.Result{})
+	first, err := tsv.ReserveSequence(ctx, "seq", 40)
+	require.NoError(t, err)
+	assert.EqualValues(t, 100, first)
+}
+
+func TestSequenceCommitFailure(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	addSequenceQuery(db, 1, 3)
+	db.AddQuery("update seq set next_id = 4 where id = 0", &sqltypes.Result{})
+	db.AddRejectedQuery("commit", errors.New("commit failed"))
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	_, err := newTestQueryExecutor(ctx, tsv, "select next value from seq", 0).Execute()
+	require.Error(t, err)
+
+	// The IDs of the failed transaction were not cached.
+	db.DeleteRejectedQuery("commit")
+	addSequenceQuery(db, 4, 3)
+	db.AddQuery("update seq set next_id = 7 where id = 0", &sqltypes.Result{})
+	assert.EqualValues(t, 4, nextSequenceValue(t, tsv))
+}
+
+func TestReserveSequence(t *testing.T) {
+	db := setUpQueryExecutorTest(t)
+	defer db.Close()
+	addSequenceQuery(db, 1, 3)
+	db.AddQuery("update seq set next_id = 4 where id = 0", &sqltypes.Result{})
+	ctx := context.Background()
+	tsv := newTestTabletServer(ctx, noFlags, db)
+	defer tsv.StopService()
+
+	// The reserved IDs come after the cached ones.
+	assert.EqualValues(t, 1, nextSequenceValue(t, tsv))
+	db.AddQuery("update seq set next_id = 14 where id = 0", &sqltypes.Result{})
+	first, err := tsv.ReserveSequence(ctx, "seq", 10)
+	require.NoError(t, err)
+	assert.EqualValues(t, 4, first)
+	hwm, _, err := tsv.readSequenceHighWaterMark(ctx, sqlparser.NewTableIdent("seq"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 34, hwm)
+	db.AddQuery("update seq set next_id = 44 where id = 0", &sqltypes.Result{})
+	addSequenceQuery(db, 14, 3)
+	first, err = tsv.ReserveSequence(ctx, "seq", 30)
+	require.NoError(t, err)
+	assert.EqualValues(t, 14, first)
+	hwm, _, err = tsv.readSequenceHighWaterMark(ctx, sqlparser.NewTableIdent("seq"))
+	require.NoError(t, err)
+	assert.EqualValues(t, 74, hwm)
+
+	_, err = tsv.ReserveSequence(ctx, "seq", 0)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+	_, err = tsv.ReserveSequence(ctx, "test_table", 10)
+	assert.Equal(t, vtrpcpb.Code_INVALID_ARGUMENT, vterrors.Code(err))
+
+	send := func(method string, form url.Values) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, "/debug/sequences/reserve", strings.NewReader(form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		w := httptest.NewRecorder()
+		sequenceReserveHandler(tsv, w, req)
+		return w
+	}
+	addSequenceQuery(db, 44, 3)
+	db.AddQuery("update seq set next_id = 49 where id = 0", &sqltypes.Result{})
+	w := send(http.MethodPost, url.Values{"table": {"seq"}, "count": {"5"}})
+	require.Equal(t, http.StatusOK, w.Code, w.Body.String())
+	assert.Equal(t, `{"First":44,"Count":5}`, w.Body.String())
+	w = send(http.MethodGet, nil)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	w = send(http.MethodPost, url.Values{"table": {"test_table"}, "count": {"5"}})
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
@@ -90,6 +90,13 @@ type stateManager struct {
 	reason         string
 	transitionErr  error
 
+	// replReason is the reason why replication is not healthy.
+	replReason string
+	// mysqlReason and mysqlErr are set while MySQL cannot
+	// serve the tablet type, see mysqlHealth.
+	mysqlReason string
+	mysqlErr    error
+
 	requests sync.WaitGroup
 
 	// QueryList does not have an Open or Close.
@@ -113,6 +120,7 @@ type stateManager struct {
 	ddle        onlineDDLExecutor
 	throttler   lagThrottler
 	tableGC     tableGarbageCollector
+	mh          mysqlHealth
 
 	// hcticks starts on initialiazation and runs forever.
 	hcticks *timer.Timer
@@ -178,6 +186,10 @@ type (
 		Open() error
 		Close()
 	}
+
+	mysqlHealth interface {
+		Check(tabletType topodatapb.TabletType) (reason string, err error)
+	}
 )
 
 // Init performs the second phase of initialization.
@@ -345,8 +357,10 @@ func (sm *stateManager) StopService() {
 func (sm *stateManager) StartRequest(ctx context.Context, target *querypb.Target, allowOnShutdown bool) (err error) {
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
+	// A refused request never reached MySQL.
+	defer func() { err = vterrors.WithApplied(err, vterrors.NotApplied) }()
 
-	if sm.state != StateServing || !sm.replHealthy {
+	if sm.state != StateServing || !sm.replHealthy || sm.mysqlErr != nil {
 		// This specific error string needs to be returned for vtgate buffering to work.
 		return vterrors.New(vtrpcpb.Code_FAILED_PRECONDITION, "operation not allowed in state NOT_SERVING")
 	}
@@ -496,6 +510,7 @@ func (sm *stateManager) connect(tabletType topodatapb.TabletType) error {
 	if err := sm.se.Open(); err != nil {
 		return err
 	}
+	sm.hs.RegisterSchemaNotifier()
 	sm.vstreamer.Open()
 	if err := sm.qe.Open(); err != nil {
 		return err
@@ -621,16 +636,38 @@ func (sm *stateManager) handleGracePeriod(tabletType topodatapb.TabletType) {
 // Broadcast fetches the replication status and broadcasts
 // the state to all subscribed.
 func (sm *stateManager) Broadcast() {
+	// MySQL is checked without holding the lock, so that
+	// requests are not blocked by a slow MySQL.
+	sm.mu.Lock()
+	tabletType := sm.target.TabletType
+	sm.mu.Unlock()
+	mysqlReason, mysqlErr := sm.mh.Check(tabletType)
+
 	sm.mu.Lock()
 	defer sm.mu.Unlock()
 
 	lag, err := sm.refreshReplHealthLocked()
+	sm.refreshMySQLHealthLocked(mysqlReason, mysqlErr)
+	if err == nil {
+		err = sm.mysqlErr
+	}
 	sm.hs.ChangeState(sm.target.TabletType, sm.terTimestamp, lag, err, sm.isServingLocked())
 }
 
+func (sm *stateManager) refreshMySQLHealthLocked(reason string, err error) {
+	if err != nil && sm.mysqlErr == nil {
+		log.Infof("Going unhealthy: %v", err)
+	}
+	if err == nil && sm.mysqlErr != nil {
+		log.Infof("MySQL is healthy")
+	}
+	sm.mysqlReason, sm.mysqlErr = reason, err
+}
+
 func (sm *stateManager) refreshReplHealthLocked() (time.Duration, error) {
 	if sm.target.TabletType == topodatapb.TabletType_MASTER {
 		sm.replHealthy = true
+		sm.replReason = ""
 		return 0, nil
 	}
 	lag, err := sm.rt.Status()
@@ -639,17 +676,20 @@ func (sm *stateManager) refreshReplHealthLocked() (time.Duration, error) {
 			log.Infof("Going unhealthy due to replication error: %v", err)
 		}
 		sm.replHealthy = false
+		sm.replReason = unhealthyReplicationError
 	} else {
 		if lag > sm.unhealthyThreshold {
 			if sm.replHealthy {
 				log.Infof("Going unhealthy due to high replication lag: %v", lag)
 			}
 			sm.replHealthy = false
+			sm.replReason = unhealthyReplicationLag
 		} else {
 			if !sm.replHealthy {
 				log.Infof("Replication is healthy")
 			}
 			sm.replHealthy = true
+			sm.replReason = ""
 		}
 	}
 	return lag, err
@@ -682,7 +722,21 @@ func (sm *stateManager) IsServing() bool {
 }
 
 func (sm *stateManager) isServingLocked() bool {
-	return sm.state == StateServing && sm.wantState == StateServing && sm.replHealthy && !sm.lameduck
+	return sm.state == StateServing && sm.wantState == StateServing && sm.replHealthy && sm.mysqlErr == nil && !sm.lameduck
+}
+
+// UnhealthyReason returns the reason why the tablet is not healthy,
+// or an empty string.
+func (sm *stateManager) UnhealthyReason() string {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+	if sm.mysqlErr != nil {
+		return sm.mysqlReason
+	}
+	if !sm.replHealthy {
+		return sm.replReason
+	}
+	return ""
 }
 
 func (sm *stateManager) AppendDetails(details []*kv) []*kv {
@@ -725,6 +779,13 @@ func (sm *stateManager) AppendDetails(details []*kv) []*kv {
 			Value: sm.transitionErr.Error(),
 		})
 	}
+	if sm.mysqlErr != nil {
+		details = append(details, &kv{
+			Key:   "Unhealthy",
+			Class: unhealthyClass,
+			Value: fmt.Sprintf("%s: %v", sm.mysqlReason, sm.mysqlErr),
+		})
+	}
 	if sm.lameduck {
 		details = append(details, &kv{
 			Key:   "Lameduck",
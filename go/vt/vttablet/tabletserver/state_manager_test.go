@@ -31,6 +31,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	querypb "vitess.io/vitess/go/vt/proto/query"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 )
 
@@ -498,6 +499,7 @@ func TestStateManagerValidations(t *testing.T) {
 
 	err := sm.StartRequest(ctx, target, false)
 	assert.Contains(t, err.Error(), "operation not allowed")
+	assert.Equal(t, vterrors.NotApplied, vterrors.WritesApplied(err))
 
 	sm.replHealthy = false
 	sm.state = StateServing
@@ -665,6 +667,35 @@ func TestRefreshReplHealthLocked(t *testing.T) {
 	assert.Equal(t, 3*time.Hour, lag)
 	assert.NoError(t, err)
 	assert.False(t, sm.replHealthy)
+	assert.Equal(t, unhealthyReplicationLag, sm.replReason)
+}
+
+func TestStateManagerMySQLHealth(t *testing.T) {
+	sm := newTestStateManager(t)
+	defer sm.StopService()
+	mh := sm.mh.(*testMySQLHealth)
+	err := sm.SetServingType(topodatapb.TabletType_MASTER, testNow, StateServing, "")
+	require.NoError(t, err)
+	sm.hcticks.Stop()
+
+	healthError := func() string {
+		sm.hs.mu.Lock()
+		defer sm.hs.mu.Unlock()
+		return sm.hs.state.RealtimeStats.HealthError
+	}
+
+	mh.reason, mh.err = unhealthyReadOnly, errors.New("MySQL read_only is on for a MASTER tablet")
+	sm.Broadcast()
+	assert.False(t, sm.IsServing())
+	assert.Equal(t, unhealthyReadOnly, sm.UnhealthyReason())
+	assert.Equal(t, "MySQL read_only is on for a MASTER tablet", healthError())
+	assert.EqualError(t, sm.StartRequest(context.Background(), &sm.target, false), "operation not allowed in state NOT_SERVING")
+
+	mh.reason, mh.err = "", nil
+	sm.Broadcast()
+	assert.True(t, sm.IsServing())
+	assert.Equal(t, "", sm.UnhealthyReason())
+	assert.Equal(t, "", healthError())
 }
 
 func verifySubcomponent(t *testing.T, order int64, component interface{}, state testState) {
@@ -681,7 +712,7 @@ func newTestStateManager(t *testing.T) *stateManager {
 		statelessql: NewQueryList("stateless"),
 		statefulql:  NewQueryList("stateful"),
 		olapql:      NewQueryList("olap"),
-		hs:          newHealthStreamer(env, topodatapb.TabletAlias{}),
+		hs:          newHealthStreamer(env, topodatapb.TabletAlias{}, nil),
 		se:          &testSchemaEngine{},
 		rt:          &testReplTracker{lag: 1 * time.Second},
 		vstreamer:   &testSubcomponent{},
@@ -694,6 +725,7 @@ func newTestStateManager(t *testing.T) *stateManager {
 		ddle:        &testOnlineDDLExecutor{},
 		throttler:   &testLagThrottler{},
 		tableGC:     &testTableGC{},
+		mh:          &testMySQLHealth{},
 	}
 	sm.Init(env, querypb.Target{})
 	sm.hs.InitDBConfig(querypb.Target{})
@@ -796,6 +828,15 @@ func (te *testReplTracker) Status() (time.Duration, error) {
 	return te.lag, te.err
 }
 
+type testMySQLHealth struct {
+	reason string
+	err    error
+}
+
+func (te *testMySQLHealth) Check(topodatapb.TabletType) (string, error) {
+	return te.reason, te.err
+}
+
 type testQueryEngine struct {
 	testOrderState
 
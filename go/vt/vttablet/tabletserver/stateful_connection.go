@@ -18,8 +18,12 @@ package tabletserver
 
 import (
 	"fmt"
+	"io"
+	"net/url"
 	"time"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/vt/log"
 
 	"vitess.io/vitess/go/vt/callerid"
@@ -28,12 +32,15 @@ import (
 
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/streamlog"
+	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/connpool"
 
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
 	"context"
 
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
@@ -86,6 +93,10 @@ func (sc *StatefulConnection) Exec(ctx context.Context, query string, maxrows in
 		}
 		return nil, vterrors.New(vtrpcpb.Code_ABORTED, "connection was aborted")
 	}
+	span, ctx := trace.NewSpan(ctx, "StatefulConnection.Exec")
+	defer span.Finish()
+	span.Annotate("connection_id", sc.ID())
+	trace.AnnotateSQL(span, query)
 	r, err := sc.dbConn.ExecOnce(ctx, query, maxrows, wantfields)
 	if err != nil {
 		if mysql.IsConnErr(err) {
@@ -103,6 +114,32 @@ func (sc *StatefulConnection) Exec(ctx context.Context, query string, maxrows in
 	return r, nil
 }
 
+// Stream streams the results of the statement from the dedicated connection.
+// Like Exec, it does not retry on connection errors.
+func (sc *StatefulConnection) Stream(ctx context.Context, query string, callback func(*sqltypes.Result) error, streamBufferSize int, includedFields querypb.ExecuteOptions_IncludedFields) error {
+	if sc.IsClosed() {
+		if sc.IsInTransaction() {
+			return vterrors.Errorf(vtrpcpb.Code_ABORTED, "transaction was aborted: %v", sc.txProps.Conclusion)
+		}
+		return vterrors.New(vtrpcpb.Code_ABORTED, "connection was aborted")
+	}
+	span, ctx := trace.NewSpan(ctx, "StatefulConnection.Stream")
+	defer span.Finish()
+	span.Annotate("connection_id", sc.ID())
+	trace.AnnotateSQL(span, query)
+	err := sc.dbConn.StreamOnce(ctx, query, callback, streamBufferSize, includedFields)
+	if err != nil && mysql.IsConnErr(err) {
+		select {
+		case <-ctx.Done():
+			// If the context is done, the query was killed.
+			// So, don't trigger a mysql check.
+		default:
+			sc.env.CheckMySQL()
+		}
+	}
+	return err
+}
+
 func (sc *StatefulConnection) execWithRetry(ctx context.Context, query string, maxrows int, wantfields bool) error {
 	if sc.IsClosed() {
 		return vterrors.New(vtrpcpb.Code_CANCELED, "connection is closed")
@@ -183,6 +220,55 @@ func (sc *StatefulConnection) String() string {
 	)
 }
 
+// Logf formats the transaction log record of the connection, as filtered
+// and redacted by the params. See tabletenv.LogFilter.
+func (sc *StatefulConnection) Logf(w io.Writer, params url.Values) error {
+	props := sc.txProps
+	if props == nil {
+		return nil
+	}
+	filter, err := tabletenv.NewLogFilter(params)
+	if err != nil {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		return err
+	}
+	if !filter.Match(props.Queries.Statements(), props.Conclusion != tx.TxCommit.Name(), props.EndTime.Sub(props.StartTime)) {
+		return nil
+	}
+	if filter.Redact {
+		redacted := *props
+		redacted.Queries = props.Queries.Map(tabletenv.RedactQuery)
+		props = &redacted
+	}
+	_, err = fmt.Fprintf(w, "%v\t%s", sc.ConnID, props.String())
+	return err
+}
+
+// LogProto returns the record of the transaction in the binary log format,
+// or nil if there is no transaction.
+func (sc *StatefulConnection) LogProto() proto.Message {
+	props := sc.txProps
+	if props == nil {
+		return nil
+	}
+	record := &querylogpb.Transaction{
+		ConnId:          sc.ConnID,
+		EffectiveCaller: callerid.GetPrincipal(props.EffectiveCaller),
+		ImmediateCaller: callerid.GetUsername(props.ImmediateCaller),
+		StartTime:       props.StartTime.UnixNano(),
+		EndTime:         props.EndTime.UnixNano(),
+		Conclusion:      props.Conclusion,
+		Queries:         props.Queries.Statements(),
+		DroppedQueries:  int64(props.Queries.Dropped()),
+	}
+	if *streamlog.RedactDebugUIQueries {
+		for i, query := range record.Queries {
+			record.Queries[i] = tabletenv.RedactQuery(query)
+		}
+	}
+	return record
+}
+
 // Current returns the currently executing query
 func (sc *StatefulConnection) Current() string {
 	return sc.dbConn.Current()
@@ -270,7 +356,23 @@ func (sc *StatefulConnection) LogTransaction(reason tx.ReleaseReason) {
 	if sc.txProps.LogToFile {
 		log.Infof("Logged transaction: %s", sc.String())
 	}
-	tabletenv.TxLogger.Send(sc)
+	tabletenv.TxLogger.Send(sc.txLogSnapshot())
+}
+
+// txLogSnapshot returns a copy of the connection and of its transaction
+// properties for the transaction logs, which format it asynchronously: the
+// connection itself goes on with other transactions once this one is logged.
+func (sc *StatefulConnection) txLogSnapshot() *StatefulConnection {
+	props := *sc.txProps
+	props.Queries = sc.txProps.Queries.Map(func(query string) string { return query })
+	if sc.txProps.WrittenTables != nil {
+		props.WrittenTables = append([]string(nil), sc.txProps.WrittenTables...)
+	}
+	return &StatefulConnection{
+		ConnID:  sc.ConnID,
+		env:     sc.env,
+		txProps: &props,
+	}
 }
 
 // logReservedConn logs reserved connection related stats.
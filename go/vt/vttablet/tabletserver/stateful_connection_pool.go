@@ -214,6 +214,15 @@ func (sf *StatefulConnectionPool) ForAllTxProperties(f func(*tx.Properties)) {
 	}
 }
 
+// ForAllTx executes a function on every connection that is in a transaction
+func (sf *StatefulConnectionPool) ForAllTx(f func(*StatefulConnection)) {
+	for _, connection := range mapToTxConn(sf.active.GetAll()) {
+		if connection.IsInTransaction() {
+			f(connection)
+		}
+	}
+}
+
 // Unregister forgets the specified connection.  If the connection is not present, it's ignored.
 func (sf *StatefulConnectionPool) unregister(id tx.ConnID, reason string) {
 	sf.active.Unregister(id, reason)
@@ -237,6 +246,13 @@ func (sf *StatefulConnectionPool) markAsNotInUse(sc *StatefulConnection, updateT
 	sf.active.Put(sc.ConnID, updateTime)
 }
 
+// Drain replaces all the connections of the pools. The idle connections
+// are reopened now, the ones in use when they're released.
+func (sf *StatefulConnectionPool) Drain() {
+	sf.conns.Drain()
+	sf.foundRowsPool.Drain()
+}
+
 // Capacity returns the pool capacity.
 func (sf *StatefulConnectionPool) Capacity() int {
 	return int(sf.conns.Capacity())
@@ -20,6 +20,9 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -30,6 +33,8 @@ import (
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
+
+	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
 
 // These constants represent values for various config parameters.
@@ -52,7 +57,7 @@ var (
 
 	// TxLogger can be used to enable logging of transactions.
 	// Call TxLogger.ServeLogs in your main program to enable logging.
-	// The log format can be inferred by looking at TxConnection.Format.
+	// The log format can be inferred by looking at StatefulConnection.Logf.
 	TxLogger = streamlog.New("TxLog", 10)
 
 	// StatsLogger is the main stream logger object
@@ -65,7 +70,6 @@ var (
 	deprecateAllowUnsafeDMLs                bool
 	deprecatedMessagePoolSize               int
 	deprecatedPoolNamePrefix                string
-	deprecatedMaxDMLRows                    int
 	deprecatedFoundRowsPoolSize             int
 
 	// The following vars are used for custom initialization of Tabletconfig.
@@ -75,6 +79,8 @@ var (
 	enableConsolidatorReplicas   bool
 	enableHeartbeat              bool
 	heartbeatInterval            time.Duration
+	heartbeatOnDemandDuration    time.Duration
+	heartbeatIdleInterval        time.Duration
 	healthCheckInterval          time.Duration
 	degradedThreshold            time.Duration
 	unhealthyThreshold           time.Duration
@@ -84,12 +90,19 @@ var (
 
 func init() {
 	flag.IntVar(&currentConfig.OltpReadPool.Size, "queryserver-config-pool-size", defaultConfig.OltpReadPool.Size, "query server read pool size, connection pool is used by regular queries (non streaming, not in a transaction)")
+	flag.IntVar(&currentConfig.OltpReadPool.MinSize, "queryserver-config-pool-min-size", defaultConfig.OltpReadPool.MinSize, "query server read pool minimum size when the pool is auto-sized, see -queryserver-config-pool-max-size")
+	flag.IntVar(&currentConfig.OltpReadPool.MaxSize, "queryserver-config-pool-max-size", defaultConfig.OltpReadPool.MaxSize, "query server read pool maximum size. If set, the read pool is auto-sized between -queryserver-config-pool-min-size and this value based on the pool wait times and the MySQL threads_running, starting from -queryserver-config-pool-size")
 	flag.IntVar(&currentConfig.OltpReadPool.PrefillParallelism, "queryserver-config-pool-prefill-parallelism", defaultConfig.OltpReadPool.PrefillParallelism, "query server read pool prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
 	flag.IntVar(&currentConfig.OlapReadPool.Size, "queryserver-config-stream-pool-size", defaultConfig.OlapReadPool.Size, "query server stream connection pool size, stream pool is used by stream queries: queries that return results to client in a streaming fashion")
 	flag.IntVar(&currentConfig.OlapReadPool.PrefillParallelism, "queryserver-config-stream-pool-prefill-parallelism", defaultConfig.OlapReadPool.PrefillParallelism, "query server stream pool prefill parallelism, a non-zero value will prefill the pool using the specified parallelism")
 	flag.IntVar(&deprecatedMessagePoolSize, "queryserver-config-message-conn-pool-size", 0, "DEPRECATED")
 	flag.IntVar(&deprecatedMessagePoolPrefillParallelism, "queryserver-config-message-conn-pool-prefill-parallelism", 0, "DEPRECATED: Unused.")
 	flag.IntVar(&currentConfig.TxPool.Size, "queryserver-config-transaction-cap", defaultConfig.TxPool.Size, "query server transaction cap is the maximum number of transactions allowed to happen at any given point of a time for a single vttablet. E.g. by setting transaction cap to 100, there are at most 100 transactions will be processed by a vttablet and the 101th transaction will be blocked (and fail if it cannot get connection within specified timeout)")
+	flag.IntVar(&currentConfig.TxPool.MinSize, "queryserver-config-transaction-cap-min", defaultConfig.TxPool.MinSize, "query server minimum transaction cap when the transaction pool is auto-sized, see -queryserver-config-transaction-cap-max")
+	flag.IntVar(&currentConfig.TxPool.MaxSize, "queryserver-config-transaction-cap-max", defaultConfig.TxPool.MaxSize, "query server maximum transaction cap. If set, the transaction pool is auto-sized between -queryserver-config-transaction-cap-min and this value based on the pool wait times and the MySQL threads_running, starting from -queryserver-config-transaction-cap")
+	SecondsVar(&currentConfig.PoolAutosize.IntervalSeconds, "queryserver-config-pool-autosize-interval", defaultConfig.PoolAutosize.IntervalSeconds, "how often (in seconds) the auto-sized connection pools are resized")
+	flag.IntVar(&currentConfig.PoolAutosize.MaxThreadsRunning, "queryserver-config-pool-autosize-max-threads-running", defaultConfig.PoolAutosize.MaxThreadsRunning, "the auto-sized connection pools shrink, and do not grow, while the MySQL threads_running is above this value. 0 means threads_running is not checked")
+	flag.IntVar(&currentConfig.PoolAutosize.IdleIntervals, "queryserver-config-pool-autosize-idle-intervals", defaultConfig.PoolAutosize.IdleIntervals, "number of consecutive resize intervals without waits for a connection before an auto-sized connection pool shrinks")
 	flag.IntVar(&currentConfig.TxPool.PrefillParallelism, "queryserver-config-transaction-prefill-parallelism", defaultConfig.TxPool.PrefillParallelism, "query server transaction prefill parallelism, a non-zero value will prefill the pool using the specified parallism.")
 	flag.IntVar(&currentConfig.MessagePostponeParallelism, "queryserver-config-message-postpone-cap", defaultConfig.MessagePostponeParallelism, "query server message postpone cap is the maximum number of messages that can be postponed at any given time. Set this number to substantially lower than transaction cap, so that the transaction pool isn't exhausted by the message subsystem.")
 	flag.IntVar(&deprecatedFoundRowsPoolSize, "client-found-rows-pool-size", 0, "DEPRECATED: queryserver-config-transaction-cap will be used instead.")
@@ -97,37 +110,57 @@ func init() {
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "how long to wait (in seconds) for queries and transactions to complete during graceful shutdown.")
 	SecondsVar(&currentConfig.GracePeriods.ShutdownSeconds, "transaction_shutdown_grace_period", defaultConfig.GracePeriods.ShutdownSeconds, "DEPRECATED: use shutdown_grace_period instead.")
 	flag.IntVar(&currentConfig.Oltp.MaxRows, "queryserver-config-max-result-size", defaultConfig.Oltp.MaxRows, "query server max result size, maximum number of rows allowed to return from vttablet for non-streaming queries.")
+	flag.Var((*intMap)(&currentConfig.Oltp.MaxRowsPerTable), "queryserver-config-max-result-size-per-table", "comma separated list of table:size pairs overriding -queryserver-config-max-result-size for the queries reading these tables, e.g. reports:100000. The smallest override applies to queries reading several of them.")
+	flag.BoolVar(&currentConfig.Oltp.TruncateResults, "queryserver-config-truncate-results", defaultConfig.Oltp.TruncateResults, "query server returns the first max result size rows of the selects exceeding the max result size instead of an error. Selects can also opt in with the ALLOW_TRUNCATED_RESULT comment directive.")
 	flag.IntVar(&currentConfig.Oltp.WarnRows, "queryserver-config-warn-result-size", defaultConfig.Oltp.WarnRows, "query server result size warning threshold, warn if number of rows returned from vttablet for non-streaming queries exceeds this")
-	flag.IntVar(&deprecatedMaxDMLRows, "queryserver-config-max-dml-rows", 0, "query server max dml rows per statement, maximum number of rows allowed to return at a time for an update or delete with either 1) an equality where clauses on primary keys, or 2) a subselect statement. For update and delete statements in above two categories, vttablet will split the original query into multiple small queries based on this configuration value. ")
+	flag.IntVar(&currentConfig.Oltp.MaxDMLRows, "queryserver-config-max-dml-rows", defaultConfig.Oltp.MaxDMLRows, "query server max dml rows per statement. If -queryserver-config-reject-unsafe-dmls is set, the updates and deletes affecting more rows than this are undone with an error, without aborting the transaction they are part of. 0 means no limit.")
+	flag.BoolVar(&currentConfig.Oltp.RejectUnsafeDML, "queryserver-config-reject-unsafe-dmls", defaultConfig.Oltp.RejectUnsafeDML, "query server rejects the updates and deletes without a WHERE clause, or affecting more than -queryserver-config-max-dml-rows rows, as a guardrail against accidental full table writes. Statements can opt out with the ALLOW_UNSAFE_DML comment directive.")
+	flag.IntVar(&currentConfig.Oltp.TxLogMaxStatements, "queryserver-config-transaction-log-max-statements", defaultConfig.Oltp.TxLogMaxStatements, "query server maximum number of statements recorded per transaction for the transaction logs and the transaction killer logs. Only the last statements are kept. 0 means no limit. Ignored if -twopc_enable is set.")
+	flag.IntVar(&currentConfig.Oltp.TxLogMaxBytes, "queryserver-config-transaction-log-max-bytes", defaultConfig.Oltp.TxLogMaxBytes, "query server maximum size in bytes of the statements recorded per transaction for the transaction logs and the transaction killer logs. Only the last statements are kept. 0 means no limit. Ignored if -twopc_enable is set.")
 	flag.BoolVar(&currentConfig.PassthroughDML, "queryserver-config-passthrough-dmls", defaultConfig.PassthroughDML, "query server pass through all dml statements without rewriting")
 	flag.BoolVar(&deprecateAllowUnsafeDMLs, "queryserver-config-allowunsafe-dmls", false, "deprecated")
 
 	flag.IntVar(&currentConfig.StreamBufferSize, "queryserver-config-stream-buffer-size", defaultConfig.StreamBufferSize, "query server stream buffer size, the maximum number of bytes sent from vttablet for each stream call. It's recommended to keep this value in sync with vtgate's stream_buffer_size.")
 	flag.IntVar(&currentConfig.QueryCacheSize, "queryserver-config-query-cache-size", defaultConfig.QueryCacheSize, "query server query cache size, maximum number of queries to be cached. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
 	flag.Int64Var(&currentConfig.QueryCacheMemory, "queryserver-config-query-cache-memory", defaultConfig.QueryCacheMemory, "query server query cache size in bytes, maximum amount of memory to be used for caching. vttablet analyzes every incoming query and generate a query plan, these plans are being cached in a lru cache. This config controls the capacity of the lru cache.")
+	flag.Int64Var(&currentConfig.ResultCacheSize, "queryserver-config-result-cache-size", defaultConfig.ResultCacheSize, "query server result cache size in bytes. The results of selects with a CACHEABLE_FOR directive are cached in a lru cache of this size. 0 disables the cache.")
 	flag.BoolVar(&currentConfig.QueryCacheLFU, "queryserver-config-query-cache-lfu", defaultConfig.QueryCacheLFU, "query server cache algorithm. when set to true, a new cache algorithm based on a TinyLFU admission policy will be used to improve cache behavior and prevent pollution from sparse queries")
+	flag.BoolVar(&currentConfig.QueryCacheLRUMemoryBased, "queryserver-config-query-cache-lru-memory-based", defaultConfig.QueryCacheLRUMemoryBased, "when the lru cache algorithm is used, limit the query cache by queryserver-config-query-cache-memory instead of queryserver-config-query-cache-size")
+	SecondsVar(&currentConfig.QueryCacheTTLSeconds, "queryserver-config-query-cache-ttl", defaultConfig.QueryCacheTTLSeconds, "when the lru cache algorithm is used, the maximum time in seconds a plan stays in the query cache. 0 keeps the plans until they are evicted.")
 	SecondsVar(&currentConfig.SchemaReloadIntervalSeconds, "queryserver-config-schema-reload-time", defaultConfig.SchemaReloadIntervalSeconds, "query server schema reload time, how often vttablet reloads schemas from underlying MySQL instance in seconds. vttablet keeps table schemas in its own memory and periodically refreshes it from MySQL. This config controls the reload time.")
 	SecondsVar(&currentConfig.Oltp.QueryTimeoutSeconds, "queryserver-config-query-timeout", defaultConfig.Oltp.QueryTimeoutSeconds, "query server query timeout (in seconds), this is the query timeout in vttablet side. If a query takes more than this timeout, it will be killed.")
+	SecondsMapVar(&currentConfig.QueryTimeouts.Tables, "queryserver-config-query-timeout-per-table", "comma separated list of table:seconds pairs overriding the query timeout for the queries on these tables, e.g. orders:5,events:30")
+	SecondsMapVar(&currentConfig.QueryTimeouts.Plans, "queryserver-config-query-timeout-per-plan", "comma separated list of plan:seconds pairs overriding the query timeout for the queries of these plan types, e.g. Select:30,Update:5. The timeouts of -queryserver-config-query-timeout-per-table take precedence.")
 	SecondsVar(&currentConfig.OltpReadPool.TimeoutSeconds, "queryserver-config-query-pool-timeout", defaultConfig.OltpReadPool.TimeoutSeconds, "query server query pool timeout (in seconds), it is how long vttablet waits for a connection from the query pool. If set to 0 (default) then the overall query timeout is used instead.")
 	SecondsVar(&currentConfig.OlapReadPool.TimeoutSeconds, "queryserver-config-stream-pool-timeout", defaultConfig.OlapReadPool.TimeoutSeconds, "query server stream pool timeout (in seconds), it is how long vttablet waits for a connection from the stream pool. If set to 0 (default) then there is no timeout.")
 	SecondsVar(&currentConfig.TxPool.TimeoutSeconds, "queryserver-config-txpool-timeout", defaultConfig.TxPool.TimeoutSeconds, "query server transaction pool timeout, it is how long vttablet waits if tx pool is full")
 	SecondsVar(&currentConfig.OltpReadPool.IdleTimeoutSeconds, "queryserver-config-idle-timeout", defaultConfig.OltpReadPool.IdleTimeoutSeconds, "query server idle timeout (in seconds), vttablet manages various mysql connection pools. This config means if a connection has not been used in given idle timeout, this connection will be removed from pool. This effectively manages number of connection objects and optimize the pool performance.")
+	SecondsVar(&currentConfig.OltpReadPool.MaxLifetimeSeconds, "queryserver-config-pool-conn-max-lifetime", defaultConfig.OltpReadPool.MaxLifetimeSeconds, "query server connection max lifetime (in seconds), vttablet closes and reopens the connections of its pools which are older than this, with a random jitter of up to a tenth of it. If set to 0 (default) then connections are kept as long as they're not idle.")
+	SecondsVar(&currentConfig.OltpReadPool.PingIdleSeconds, "queryserver-config-pool-conn-ping-idle", defaultConfig.OltpReadPool.PingIdleSeconds, "query server connection ping idle time (in seconds), vttablet pings the connections of its pools which were idle for longer than this before using them, and transparently replaces the dead ones, e.g. after a failover behind a load balancer. If set to 0 (default) then connections are not pinged.")
 	flag.IntVar(&currentConfig.OltpReadPool.MaxWaiters, "queryserver-config-query-pool-waiter-cap", defaultConfig.OltpReadPool.MaxWaiters, "query server query pool waiter limit, this is the maximum number of queries that can be queued waiting to get a connection")
 	flag.IntVar(&currentConfig.TxPool.MaxWaiters, "queryserver-config-txpool-waiter-cap", defaultConfig.TxPool.MaxWaiters, "query server transaction pool waiter limit, this is the maximum number of transactions that can be queued waiting to get a connection")
+	SecondsVar(&currentConfig.OltpReadPool.ShedTimeoutSeconds, "queryserver-config-query-pool-shed-timeout", defaultConfig.OltpReadPool.ShedTimeoutSeconds, "query server query pool shed timeout (in seconds), it is how long a lower priority query, e.g. an OLAP one, waits for the higher priority queries waiting for a connection before failing. If set to 0 (default) then it waits until its timeout.")
+	SecondsVar(&currentConfig.TxPool.ShedTimeoutSeconds, "queryserver-config-txpool-shed-timeout", defaultConfig.TxPool.ShedTimeoutSeconds, "query server transaction pool shed timeout (in seconds), it is how long a lower priority transaction waits for the higher priority transactions waiting for a connection before failing. If set to 0 (default) then it waits until its timeout.")
 	// tableacl related configurations.
 	flag.BoolVar(&currentConfig.StrictTableACL, "queryserver-config-strict-table-acl", defaultConfig.StrictTableACL, "only allow queries that pass table acl checks")
 	flag.BoolVar(&currentConfig.EnableTableACLDryRun, "queryserver-config-enable-table-acl-dry-run", defaultConfig.EnableTableACLDryRun, "If this flag is enabled, tabletserver will emit monitoring metrics and let the request pass regardless of table acl check results")
 	flag.StringVar(&currentConfig.TableACLExemptACL, "queryserver-config-acl-exempt-acl", defaultConfig.TableACLExemptACL, "an acl that exempt from table acl checking (this acl is free to access any vitess tables).")
 	flag.BoolVar(&currentConfig.TerseErrors, "queryserver-config-terse-errors", defaultConfig.TerseErrors, "prevent bind vars from escaping in returned errors")
+	flag.BoolVar(&currentConfig.ErrorSanitization.Enable, "queryserver-config-sanitize-errors", defaultConfig.ErrorSanitization.Enable, "strip the sql text, bind variables, quoted values and network addresses from the errors returned to the clients. The full errors are still logged.")
+	flag.Var((*flagutil.StringMapValue)(&currentConfig.ErrorSanitization.Policies), "queryserver-config-sanitize-errors-policies", "comma-separated list of error_code:policy pairs overriding how the errors of a code are returned to the clients when -queryserver-config-sanitize-errors is set. The policies are redact (default), keep, which returns the error as is (default for FAILED_PRECONDITION, so that vtgate can detect failovers), and generic, which only returns the error code and MySQL error number. E.g. INVALID_ARGUMENT:keep,UNKNOWN:generic")
+	flag.BoolVar(&currentConfig.EnableWorkloadStats, "queryserver-config-enable-workload-stats", defaultConfig.EnableWorkloadStats, "break down query counts, times, errors and kills by workload, as named by the WORKLOAD query directive or else the caller id")
+	flag.IntVar(&currentConfig.MaxWorkloadStats, "queryserver-config-workload-stats-max-workloads", defaultConfig.MaxWorkloadStats, "maximum number of distinct workloads in the per-workload stats, the queries of the workloads seen after that are counted as the 'other' workload")
 	flag.StringVar(&deprecatedPoolNamePrefix, "pool-name-prefix", "", "Deprecated")
 	flag.BoolVar(&currentConfig.WatchReplication, "watch_replication_stream", false, "When enabled, vttablet will stream the MySQL replication stream from the local server, and use it to update schema when it sees a DDL.")
 	flag.BoolVar(&currentConfig.TrackSchemaVersions, "track_schema_versions", false, "When enabled, vttablet will store versions of schemas at each position that a DDL is applied and allow retrieval of the schema corresponding to a position")
+	flag.BoolVar(&currentConfig.SignalWhenSchemaChange, "queryserver-config-schema-change-signal", defaultConfig.SignalWhenSchemaChange, "query server schema signal, will signal connected vtgates that schema has changed whenever this is detected. VTGates will need to have -schema_change_signal enabled for this to work")
 	flag.BoolVar(&deprecatedAutocommit, "enable-autocommit", true, "This flag is deprecated. Autocommit is always allowed.")
 	flag.BoolVar(&currentConfig.TwoPCEnable, "twopc_enable", defaultConfig.TwoPCEnable, "if the flag is on, 2pc is enabled. Other 2pc flags must be supplied.")
 	flag.StringVar(&currentConfig.TwoPCCoordinatorAddress, "twopc_coordinator_address", defaultConfig.TwoPCCoordinatorAddress, "address of the (VTGate) process(es) that will be used to notify of abandoned transactions.")
 	SecondsVar(&currentConfig.TwoPCAbandonAge, "twopc_abandon_age", defaultConfig.TwoPCAbandonAge, "time in seconds. Any unresolved transaction older than this time will be sent to the coordinator to be resolved.")
 	flagutil.DualFormatBoolVar(&currentConfig.EnableTxThrottler, "enable_tx_throttler", defaultConfig.EnableTxThrottler, "If true replication-lag-based throttling on transactions will be enabled.")
 	flagutil.DualFormatStringVar(&currentConfig.TxThrottlerConfig, "tx_throttler_config", defaultConfig.TxThrottlerConfig, "The configuration of the transaction throttler as a text formatted throttlerdata.Configuration protocol buffer message")
+	flagutil.DualFormatStringVar(&currentConfig.TxThrottlerAlgorithm, "tx_throttler_algorithm", defaultConfig.TxThrottlerAlgorithm, "The algorithm the transaction throttler adapts the transaction rate with: max_replication_lag uses the max replication lag module of go/vt/throttler, adaptive increases and decreases the rate in a feedback loop on the replication lag of the replicas.")
 	flagutil.DualFormatStringListVar(&currentConfig.TxThrottlerHealthCheckCells, "tx_throttler_healthcheck_cells", defaultConfig.TxThrottlerHealthCheckCells, "A comma-separated list of cells. Only tabletservers running in these cells will be monitored for replication lag by the transaction throttler.")
 
 	flag.BoolVar(&enableHotRowProtection, "enable_hot_row_protection", false, "If true, incoming transactions for the same row (range) will be queued and cannot consume all txpool slots.")
@@ -135,6 +168,12 @@ func init() {
 	flag.IntVar(&currentConfig.HotRowProtection.MaxQueueSize, "hot_row_protection_max_queue_size", defaultConfig.HotRowProtection.MaxQueueSize, "Maximum number of BeginExecute RPCs which will be queued for the same row (range).")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxGlobalQueueSize, "hot_row_protection_max_global_queue_size", defaultConfig.HotRowProtection.MaxGlobalQueueSize, "Global queue limit across all row (ranges). Useful to prevent that the queue can grow unbounded.")
 	flag.IntVar(&currentConfig.HotRowProtection.MaxConcurrency, "hot_row_protection_concurrent_transactions", defaultConfig.HotRowProtection.MaxConcurrency, "Number of concurrent transactions let through to the txpool/MySQL for the same hot row. Should be > 1 to have enough 'ready' transactions in MySQL and benefit from a pipelining effect.")
+	SecondsVar(&currentConfig.HotRowProtection.MaxWaitSeconds, "hot_row_protection_max_wait", defaultConfig.HotRowProtection.MaxWaitSeconds, "Maximum time (in seconds) a transaction is queued for the same row (range) before it is rejected. 0 means it is queued until its query timeout.")
+	flag.Var((*intMap)(&currentConfig.HotRowProtection.MaxQueueSizePerTable), "hot_row_protection_max_queue_size_per_table", "comma separated list of table:size pairs overriding -hot_row_protection_max_queue_size for the rows of these tables, e.g. orders:50")
+	SecondsMapVar(&currentConfig.HotRowProtection.MaxWaitSecondsPerTable, "hot_row_protection_max_wait_per_table", "comma separated list of table:seconds pairs overriding -hot_row_protection_max_wait for the rows of these tables, e.g. orders:2")
+	flag.Int64Var(&currentConfig.MemoryAdmission.ThresholdBytes, "memory_admission_threshold_bytes", defaultConfig.MemoryAdmission.ThresholdBytes, "Memory held by the results of the queries in flight above which expensive queries are queued or rejected. 0 disables the memory admission control.")
+	flag.IntVar(&currentConfig.MemoryAdmission.ExpensiveRows, "memory_admission_expensive_rows", defaultConfig.MemoryAdmission.ExpensiveRows, "Average number of rows the queries of a select must return to be subject to the memory admission control. Streaming queries always are.")
+	SecondsVar(&currentConfig.MemoryAdmission.MaxWaitSeconds, "memory_admission_max_wait", defaultConfig.MemoryAdmission.MaxWaitSeconds, "Maximum time (in seconds) an expensive query is queued for the memory held by the results in flight to drop below -memory_admission_threshold_bytes before it is rejected. 0 rejects it right away.")
 
 	flag.BoolVar(&currentConfig.EnableTransactionLimit, "enable_transaction_limit", defaultConfig.EnableTransactionLimit, "If true, limit on number of transactions open at the same time will be enforced for all users. User trying to open a new transaction after exhausting their limit will receive an error immediately, regardless of whether there are available slots or not.")
 	flag.BoolVar(&currentConfig.EnableTransactionLimitDryRun, "enable_transaction_limit_dry_run", defaultConfig.EnableTransactionLimitDryRun, "If true, limit on number of transactions open at the same time will be tracked for all users, but not enforced.")
@@ -146,9 +185,12 @@ func init() {
 
 	flag.BoolVar(&enableHeartbeat, "heartbeat_enable", false, "If true, vttablet records (if master) or checks (if replica) the current time of a replication heartbeat in the table _vt.heartbeat. The result is used to inform the serving state of the vttablet via healthchecks.")
 	flag.DurationVar(&heartbeatInterval, "heartbeat_interval", 1*time.Second, "How frequently to read and write replication heartbeat.")
+	flag.DurationVar(&heartbeatOnDemandDuration, "heartbeat_on_demand_duration", 0, "If non-zero, the master only writes heartbeats on demand: a lag check (e.g. by the lag throttler) keeps heartbeats running for this long. While no heartbeats are requested, they are written every heartbeat_on_demand_idle_interval.")
+	flag.DurationVar(&heartbeatIdleInterval, "heartbeat_on_demand_idle_interval", 10*time.Second, "With heartbeat_on_demand_duration, how often the master still writes heartbeats while none are requested. This bounds the extra replication lag the replicas report in their health stream, which vtgate uses to route queries, so keep it below discovery_low_replication_lag. 0 disables these heartbeats.")
 	flagutil.DualFormatBoolVar(&currentConfig.EnableLagThrottler, "enable_lag_throttler", defaultConfig.EnableLagThrottler, "If true, vttablet will run a throttler service, and will implicitly enable heartbeats")
 
 	flag.BoolVar(&currentConfig.EnforceStrictTransTables, "enforce_strict_trans_tables", defaultConfig.EnforceStrictTransTables, "If true, vttablet requires MySQL to run with STRICT_TRANS_TABLES or STRICT_ALL_TABLES on. It is recommended to not turn this flag off. Otherwise MySQL may alter your supplied values before saving them to the database.")
+	flag.BoolVar(&currentConfig.EnableMaxExecutionTimeHint, "enable_max_execution_time_hint", defaultConfig.EnableMaxExecutionTimeHint, "If true, the SELECTs executed with a deadline get a MAX_EXECUTION_TIME optimizer hint of the remaining time, so MySQL stops them when the deadline expires instead of relying on the connection killer.")
 	flagutil.DualFormatBoolVar(&enableConsolidator, "enable_consolidator", true, "This option enables the query consolidator.")
 	flagutil.DualFormatBoolVar(&enableConsolidatorReplicas, "enable_consolidator_replicas", false, "This option enables the query consolidator only on replicas.")
 	flagutil.DualFormatBoolVar(&currentConfig.CacheResultFields, "enable_query_plan_field_caching", defaultConfig.CacheResultFields, "This option fetches & caches fields (columns) when storing query plans")
@@ -156,6 +198,9 @@ func init() {
 	flag.DurationVar(&healthCheckInterval, "health_check_interval", 20*time.Second, "Interval between health checks")
 	flag.DurationVar(&degradedThreshold, "degraded_threshold", 30*time.Second, "replication lag after which a replica is considered degraded")
 	flag.DurationVar(&unhealthyThreshold, "unhealthy_threshold", 2*time.Hour, "replication lag after which a replica is considered unhealthy")
+	flag.BoolVar(&currentConfig.Healthcheck.UnhealthyOnReadOnlyMismatch, "unhealthy_on_read_only_mismatch", defaultConfig.Healthcheck.UnhealthyOnReadOnlyMismatch, "if true, the tablet is unhealthy while the MySQL read_only is on for a master, or off for a replica or rdonly tablet")
+	flag.Float64Var(&currentConfig.Healthcheck.UnhealthyMinFreeDiskPercent, "unhealthy_min_free_disk_percent", defaultConfig.Healthcheck.UnhealthyMinFreeDiskPercent, "percentage of free disk space below which the tablet is unhealthy. 0 disables the check")
+	flag.StringVar(&currentConfig.Healthcheck.DiskPath, "unhealthy_disk_path", defaultConfig.Healthcheck.DiskPath, "path of the file system checked by -unhealthy_min_free_disk_percent. Defaults to the MySQL datadir")
 	flag.DurationVar(&transitionGracePeriod, "serving_state_grace_period", 0, "how long to pause after broadcasting health to vtgate, before enforcing a new serving state")
 
 	flag.BoolVar(&enableReplicationReporter, "enable_replication_reporter", false, "Use polling to track replication lag.")
@@ -167,6 +212,10 @@ func Init() {
 	// TODO(sougou): Make a decision on whether this should be global or per-pool.
 	currentConfig.OlapReadPool.IdleTimeoutSeconds = currentConfig.OltpReadPool.IdleTimeoutSeconds
 	currentConfig.TxPool.IdleTimeoutSeconds = currentConfig.OltpReadPool.IdleTimeoutSeconds
+	currentConfig.OlapReadPool.MaxLifetimeSeconds = currentConfig.OltpReadPool.MaxLifetimeSeconds
+	currentConfig.TxPool.MaxLifetimeSeconds = currentConfig.OltpReadPool.MaxLifetimeSeconds
+	currentConfig.OlapReadPool.PingIdleSeconds = currentConfig.OltpReadPool.PingIdleSeconds
+	currentConfig.TxPool.PingIdleSeconds = currentConfig.OltpReadPool.PingIdleSeconds
 
 	if enableHotRowProtection {
 		if enableHotRowProtectionDryRun {
@@ -194,6 +243,8 @@ func Init() {
 		heartbeatInterval = time.Second
 	}
 	currentConfig.ReplicationTracker.HeartbeatIntervalSeconds.Set(heartbeatInterval)
+	currentConfig.ReplicationTracker.HeartbeatOnDemandSeconds.Set(heartbeatOnDemandDuration)
+	currentConfig.ReplicationTracker.HeartbeatIdleIntervalSeconds.Set(heartbeatIdleInterval)
 
 	switch {
 	case enableHeartbeat:
@@ -232,9 +283,15 @@ type TabletConfig struct {
 	OltpReadPool ConnPoolConfig `json:"oltpReadPool,omitempty"`
 	OlapReadPool ConnPoolConfig `json:"olapReadPool,omitempty"`
 	TxPool       ConnPoolConfig `json:"txPool,omitempty"`
+	// PoolAutosize configures the resizing of the pools that have a MaxSize.
+	PoolAutosize PoolAutosizeConfig `json:"poolAutosize,omitempty"`
 
 	Oltp             OltpConfig             `json:"oltp,omitempty"`
+	QueryTimeouts    QueryTimeoutsConfig    `json:"queryTimeouts,omitempty"`
 	HotRowProtection HotRowProtectionConfig `json:"hotRowProtection,omitempty"`
+	MemoryAdmission  MemoryAdmissionConfig  `json:"memoryAdmission,omitempty"`
+
+	ErrorSanitization ErrorSanitizationConfig `json:"errorSanitization,omitempty"`
 
 	Healthcheck  HealthcheckConfig  `json:"healthcheck,omitempty"`
 	GracePeriods GracePeriodsConfig `json:"gracePeriods,omitempty"`
@@ -248,12 +305,18 @@ type TabletConfig struct {
 	QueryCacheSize              int     `json:"queryCacheSize,omitempty"`
 	QueryCacheMemory            int64   `json:"queryCacheMemory,omitempty"`
 	QueryCacheLFU               bool    `json:"queryCacheLFU,omitempty"`
+	QueryCacheLRUMemoryBased    bool    `json:"queryCacheLRUMemoryBased,omitempty"`
+	QueryCacheTTLSeconds        Seconds `json:"queryCacheTTLSeconds,omitempty"`
+	ResultCacheSize             int64   `json:"resultCacheSize,omitempty"`
 	SchemaReloadIntervalSeconds Seconds `json:"schemaReloadIntervalSeconds,omitempty"`
 	WatchReplication            bool    `json:"watchReplication,omitempty"`
 	TrackSchemaVersions         bool    `json:"trackSchemaVersions,omitempty"`
+	SignalWhenSchemaChange      bool    `json:"signalWhenSchemaChange,omitempty"`
 	TerseErrors                 bool    `json:"terseErrors,omitempty"`
 	MessagePostponeParallelism  int     `json:"messagePostponeParallelism,omitempty"`
 	CacheResultFields           bool    `json:"cacheResultFields,omitempty"`
+	EnableWorkloadStats         bool    `json:"enableWorkloadStats,omitempty"`
+	MaxWorkloadStats            int     `json:"maxWorkloadStats,omitempty"`
 
 	ExternalConnections map[string]*dbconfigs.DBConfigs `json:"externalConnections,omitempty"`
 
@@ -266,6 +329,7 @@ type TabletConfig struct {
 
 	EnableTxThrottler           bool     `json:"-"`
 	TxThrottlerConfig           string   `json:"-"`
+	TxThrottlerAlgorithm        string   `json:"-"`
 	TxThrottlerHealthCheckCells []string `json:"-"`
 
 	EnableLagThrottler bool `json:"-"`
@@ -273,6 +337,8 @@ type TabletConfig struct {
 	TransactionLimitConfig `json:"-"`
 
 	EnforceStrictTransTables bool `json:"-"`
+
+	EnableMaxExecutionTimeHint bool `json:"-"`
 }
 
 // ConnPoolConfig contains the config for a conn pool.
@@ -282,6 +348,30 @@ type ConnPoolConfig struct {
 	IdleTimeoutSeconds Seconds `json:"idleTimeoutSeconds,omitempty"`
 	PrefillParallelism int     `json:"prefillParallelism,omitempty"`
 	MaxWaiters         int     `json:"maxWaiters,omitempty"`
+	// ShedTimeoutSeconds is how long the lower priority waiters wait for
+	// the higher priority ones before failing. 0 means no shedding.
+	ShedTimeoutSeconds Seconds `json:"shedTimeoutSeconds,omitempty"`
+	// MaxLifetimeSeconds is how long connections are used before they're
+	// closed and reopened. 0 means no max lifetime.
+	MaxLifetimeSeconds Seconds `json:"maxLifetimeSeconds,omitempty"`
+	// PingIdleSeconds is how long connections stay idle before they're
+	// pinged, and replaced if dead, when taken from the pool. 0 means no ping.
+	PingIdleSeconds Seconds `json:"pingIdleSeconds,omitempty"`
+	// MinSize and MaxSize bound the pool size when it is auto-sized.
+	// The pool is auto-sized if MaxSize is set, starting from Size.
+	// It never shrinks below one connection.
+	MinSize int `json:"minSize,omitempty"`
+	MaxSize int `json:"maxSize,omitempty"`
+}
+
+// PoolAutosizeConfig contains the config for auto-sizing the conn pools.
+// A pool grows when queries waited for a connection during the last
+// interval, and shrinks when MySQL has more than MaxThreadsRunning threads
+// running, or when no query waited for IdleIntervals intervals in a row.
+type PoolAutosizeConfig struct {
+	IntervalSeconds   Seconds `json:"intervalSeconds,omitempty"`
+	MaxThreadsRunning int     `json:"maxThreadsRunning,omitempty"`
+	IdleIntervals     int     `json:"idleIntervals,omitempty"`
 }
 
 // OltpConfig contains the config for oltp settings.
@@ -290,6 +380,28 @@ type OltpConfig struct {
 	TxTimeoutSeconds    Seconds `json:"txTimeoutSeconds,omitempty"`
 	MaxRows             int     `json:"maxRpws,omitempty"`
 	WarnRows            int     `json:"warnRows,omitempty"`
+	// MaxRowsPerTable overrides MaxRows for the queries reading these tables.
+	MaxRowsPerTable map[string]int `json:"maxRowsPerTable,omitempty"`
+	// TruncateResults makes the selects exceeding the max rows return
+	// their first max rows rows instead of an error.
+	TruncateResults bool `json:"truncateResults,omitempty"`
+	// RejectUnsafeDML rejects the updates and deletes without a WHERE
+	// clause, and undoes those affecting more than MaxDMLRows rows.
+	RejectUnsafeDML bool `json:"rejectUnsafeDML,omitempty"`
+	MaxDMLRows      int  `json:"maxDMLRows,omitempty"`
+	// TxLogMaxStatements and TxLogMaxBytes bound the statements recorded
+	// per transaction for the transaction logs: only the last ones are
+	// kept. 0 means no limit. They are not applied with 2PC, whose redo
+	// logs need all the statements.
+	TxLogMaxStatements int `json:"txLogMaxStatements,omitempty"`
+	TxLogMaxBytes      int `json:"txLogMaxBytes,omitempty"`
+}
+
+// QueryTimeoutsConfig overrides Oltp.QueryTimeoutSeconds for the queries
+// on some tables, or of some plan types. Table timeouts take precedence.
+type QueryTimeoutsConfig struct {
+	Tables map[string]Seconds `json:"tables,omitempty"`
+	Plans  map[string]Seconds `json:"plans,omitempty"`
 }
 
 // HotRowProtectionConfig contains the config for hot row protection.
@@ -299,6 +411,45 @@ type HotRowProtectionConfig struct {
 	MaxQueueSize       int    `json:"maxQueueSize,omitempty"`
 	MaxGlobalQueueSize int    `json:"maxGlobalQueueSize,omitempty"`
 	MaxConcurrency     int    `json:"maxConcurrency,omitempty"`
+	// MaxWaitSeconds limits how long a transaction is queued. 0 means no limit
+	// other than the query timeout.
+	MaxWaitSeconds Seconds `json:"maxWaitSeconds,omitempty"`
+	// MaxQueueSizePerTable and MaxWaitSecondsPerTable override MaxQueueSize
+	// and MaxWaitSeconds for the rows of some tables.
+	MaxQueueSizePerTable   map[string]int     `json:"maxQueueSizePerTable,omitempty"`
+	MaxWaitSecondsPerTable map[string]Seconds `json:"maxWaitSecondsPerTable,omitempty"`
+}
+
+// MemoryAdmissionConfig contains the config for the admission control of
+// expensive queries on the memory held by the results in flight.
+type MemoryAdmissionConfig struct {
+	// ThresholdBytes is the memory held by the results in flight above which
+	// expensive queries are not admitted. 0 disables the admission control.
+	ThresholdBytes int64 `json:"thresholdBytes,omitempty"`
+	// ExpensiveRows is the average number of rows the queries of a select
+	// plan must return for them to be expensive. Streaming queries are
+	// always expensive.
+	ExpensiveRows int `json:"expensiveRows,omitempty"`
+	// MaxWaitSeconds limits how long an expensive query is queued for the
+	// memory to drop below the threshold. 0 means it is rejected right away.
+	MaxWaitSeconds Seconds `json:"maxWaitSeconds,omitempty"`
+}
+
+// Error sanitization policies.
+const (
+	SanitizeRedact  = "redact"
+	SanitizeKeep    = "keep"
+	SanitizeGeneric = "generic"
+)
+
+// ErrorSanitizationConfig contains the config for the sanitization of the
+// errors returned to the clients.
+type ErrorSanitizationConfig struct {
+	Enable bool `json:"enable,omitempty"`
+	// Policies maps the name of an error code to the policy applied to
+	// its errors. The codes without a policy are redacted, except
+	// FAILED_PRECONDITION which is kept.
+	Policies map[string]string `json:"policies,omitempty"`
 }
 
 // HealthcheckConfig contains the config for healthcheck.
@@ -306,6 +457,14 @@ type HealthcheckConfig struct {
 	IntervalSeconds           Seconds `json:"intervalSeconds,omitempty"`
 	DegradedThresholdSeconds  Seconds `json:"degradedThresholdSeconds,omitempty"`
 	UnhealthyThresholdSeconds Seconds `json:"unhealthyThresholdSeconds,omitempty"`
+	// UnhealthyOnReadOnlyMismatch makes the tablet unhealthy while the
+	// MySQL read_only is on for a master, or off for a replica.
+	UnhealthyOnReadOnlyMismatch bool `json:"unhealthyOnReadOnlyMismatch,omitempty"`
+	// UnhealthyMinFreeDiskPercent makes the tablet unhealthy while the file
+	// system of DiskPath has less free space. 0 disables the check.
+	UnhealthyMinFreeDiskPercent float64 `json:"unhealthyMinFreeDiskPercent,omitempty"`
+	// DiskPath defaults to the MySQL datadir.
+	DiskPath string `json:"diskPath,omitempty"`
 }
 
 // GracePeriodsConfig contains various grace periods.
@@ -320,6 +479,12 @@ type ReplicationTrackerConfig struct {
 	// Mode can be disable, polling or heartbeat. Default is disable.
 	Mode                     string  `json:"mode,omitempty"`
 	HeartbeatIntervalSeconds Seconds `json:"heartbeatIntervalSeconds,omitempty"`
+	// HeartbeatOnDemandSeconds, if non-zero, makes the heartbeat writer only
+	// write for this long after heartbeats were last requested.
+	HeartbeatOnDemandSeconds Seconds `json:"heartbeatOnDemandSeconds,omitempty"`
+	// HeartbeatIdleIntervalSeconds is how often the heartbeat writer still
+	// writes while on-demand heartbeats are not requested.
+	HeartbeatIdleIntervalSeconds Seconds `json:"heartbeatIdleIntervalSeconds,omitempty"`
 }
 
 // TransactionLimitConfig captures configuration of transaction pool slots
@@ -370,6 +535,82 @@ func (c *TabletConfig) Verify() error {
 	if v := c.HotRowProtection.MaxConcurrency; v <= 0 {
 		return fmt.Errorf("-hot_row_protection_concurrent_transactions must be > 0 (specified value: %v)", v)
 	}
+	if v := c.HotRowProtection.MaxWaitSeconds; v < 0 {
+		return fmt.Errorf("-hot_row_protection_max_wait must be >= 0 (specified value: %v)", v)
+	}
+	for table, size := range c.HotRowProtection.MaxQueueSizePerTable {
+		if size <= 0 {
+			return fmt.Errorf("-hot_row_protection_max_queue_size_per_table must be > 0 (specified value for %s: %v)", table, size)
+		}
+		if globalSize := c.HotRowProtection.MaxGlobalQueueSize; globalSize < size {
+			return fmt.Errorf("global queue size must be >= per row (range) queue size: -hot_row_protection_max_global_queue_size < hot_row_protection_max_queue_size_per_table (%v < %v for %s)", globalSize, size, table)
+		}
+	}
+	for table, v := range c.HotRowProtection.MaxWaitSecondsPerTable {
+		if v < 0 {
+			return fmt.Errorf("-hot_row_protection_max_wait_per_table must be >= 0 (specified value for %s: %v)", table, v)
+		}
+	}
+	for table, v := range c.Oltp.MaxRowsPerTable {
+		if v <= 0 {
+			return fmt.Errorf("-queryserver-config-max-result-size-per-table must be > 0 (specified value for %s: %v)", table, v)
+		}
+	}
+	for table, v := range c.QueryTimeouts.Tables {
+		if v < 0 {
+			return fmt.Errorf("query timeout of table %s must be >= 0 (specified value: %v)", table, v)
+		}
+	}
+	for plan, v := range c.QueryTimeouts.Plans {
+		if v < 0 {
+			return fmt.Errorf("query timeout of plan %s must be >= 0 (specified value: %v)", plan, v)
+		}
+	}
+	if v := c.MemoryAdmission.ThresholdBytes; v < 0 {
+		return fmt.Errorf("-memory_admission_threshold_bytes must be >= 0 (specified value: %v)", v)
+	}
+	if v := c.MemoryAdmission.MaxWaitSeconds; v < 0 {
+		return fmt.Errorf("-memory_admission_max_wait must be >= 0 (specified value: %v)", v)
+	}
+	for code, policy := range c.ErrorSanitization.Policies {
+		if _, ok := vtrpcpb.Code_value[code]; !ok {
+			return fmt.Errorf("-queryserver-config-sanitize-errors-policies has an invalid error code: %s", code)
+		}
+		switch policy {
+		case SanitizeRedact, SanitizeKeep, SanitizeGeneric:
+		default:
+			return fmt.Errorf("-queryserver-config-sanitize-errors-policies has an invalid policy for %s: %s", code, policy)
+		}
+	}
+	if v := c.Healthcheck.UnhealthyMinFreeDiskPercent; v < 0 || v >= 100 {
+		return fmt.Errorf("-unhealthy_min_free_disk_percent must be >= 0 and < 100 (specified value: %v)", v)
+	}
+	if err := c.OltpReadPool.verifyAutosize("-queryserver-config-pool"); err != nil {
+		return err
+	}
+	if err := c.TxPool.verifyAutosize("-queryserver-config-transaction-cap"); err != nil {
+		return err
+	}
+	if c.OltpReadPool.MaxSize > 0 || c.TxPool.MaxSize > 0 {
+		if v := c.PoolAutosize.IntervalSeconds; v <= 0 {
+			return fmt.Errorf("-queryserver-config-pool-autosize-interval must be > 0 (specified value: %v)", v)
+		}
+		if v := c.PoolAutosize.IdleIntervals; v <= 0 {
+			return fmt.Errorf("-queryserver-config-pool-autosize-idle-intervals must be > 0 (specified value: %v)", v)
+		}
+	}
+	return nil
+}
+
+// verifyAutosize checks that the size of an auto-sized pool is within
+// its bounds.
+func (c *ConnPoolConfig) verifyAutosize(flagName string) error {
+	if c.MaxSize == 0 {
+		return nil
+	}
+	if c.MinSize < 0 || c.MinSize > c.Size || c.Size > c.MaxSize {
+		return fmt.Errorf("auto-sized pool must satisfy 0 <= min size <= size <= max size: %s (%v <= %v <= %v)", flagName, c.MinSize, c.Size, c.MaxSize)
+	}
 	return nil
 }
 
@@ -421,10 +662,16 @@ var defaultConfig = TabletConfig{
 		IdleTimeoutSeconds: 30 * 60,
 		MaxWaiters:         5000,
 	},
+	PoolAutosize: PoolAutosizeConfig{
+		IntervalSeconds: 10,
+		IdleIntervals:   6,
+	},
 	Oltp: OltpConfig{
 		QueryTimeoutSeconds: 30,
 		TxTimeoutSeconds:    30,
 		MaxRows:             10000,
+		TxLogMaxStatements:  1000,
+		TxLogMaxBytes:       1024 * 1024,
 	},
 	Healthcheck: HealthcheckConfig{
 		IntervalSeconds:           20,
@@ -435,6 +682,9 @@ var defaultConfig = TabletConfig{
 		Mode:                     Disable,
 		HeartbeatIntervalSeconds: 0.25,
 	},
+	MemoryAdmission: MemoryAdmissionConfig{
+		ExpensiveRows: 1000,
+	},
 	HotRowProtection: HotRowProtectionConfig{
 		Mode: Disable,
 		// Default value is the same as TxPool.Size.
@@ -458,9 +708,11 @@ var defaultConfig = TabletConfig{
 	SchemaReloadIntervalSeconds: 30 * 60,
 	MessagePostponeParallelism:  4,
 	CacheResultFields:           true,
+	MaxWorkloadStats:            100,
 
 	EnableTxThrottler:           false,
 	TxThrottlerConfig:           defaultTxThrottlerConfig(),
+	TxThrottlerAlgorithm:        "max_replication_lag",
 	TxThrottlerHealthCheckCells: []string{},
 
 	EnableLagThrottler: false, // Feature flag; to switch to 'true' at some stage in the future
@@ -498,3 +750,41 @@ func defaultTransactionLimitConfig() TransactionLimitConfig {
 		TransactionLimitBySubcomponent: false,
 	}
 }
+
+// intMap is a flag.Value for a map of ints. It accepts a comma-separated
+// list of key:value pairs.
+type intMap map[string]int
+
+// Set is part of the flag.Value interface.
+func (m *intMap) Set(v string) error {
+	values := make(map[string]int)
+	for _, pair := range strings.Split(v, ",") {
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("want key:value, got %q", pair)
+		}
+		n, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return fmt.Errorf("want key:value, got %q", pair)
+		}
+		values[parts[0]] = n
+	}
+	*m = values
+	return nil
+}
+
+// String is part of the flag.Value interface.
+func (m *intMap) String() string {
+	if m == nil {
+		return ""
+	}
+	var pairs []string
+	for k, v := range *m {
+		pairs = append(pairs, fmt.Sprintf("%s:%d", k, v))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
@@ -65,9 +65,11 @@ func TestConfigParse(t *testing.T) {
   repl:
     password: '****'
   socket: a
+errorSanitization: {}
 gracePeriods: {}
 healthcheck: {}
 hotRowProtection: {}
+memoryAdmission: {}
 olapReadPool: {}
 oltp: {}
 oltpReadPool:
@@ -76,6 +78,8 @@ oltpReadPool:
   prefillParallelism: 30
   size: 16
   timeoutSeconds: 10
+poolAutosize: {}
+queryTimeouts: {}
 replicationTracker: {}
 txPool: {}
 `
@@ -109,6 +113,7 @@ func TestDefaultConfig(t *testing.T) {
 	require.NoError(t, err)
 	want := `cacheResultFields: true
 consolidator: enable
+errorSanitization: {}
 gracePeriods: {}
 healthcheck:
   degradedThresholdSeconds: 30
@@ -119,6 +124,9 @@ hotRowProtection:
   maxGlobalQueueSize: 1000
   maxQueueSize: 20
   mode: disable
+maxWorkloadStats: 100
+memoryAdmission:
+  expensiveRows: 1000
 messagePostponeParallelism: 4
 olapReadPool:
   idleTimeoutSeconds: 1800
@@ -126,14 +134,20 @@ olapReadPool:
 oltp:
   maxRpws: 10000
   queryTimeoutSeconds: 30
+  txLogMaxBytes: 1048576
+  txLogMaxStatements: 1000
   txTimeoutSeconds: 30
 oltpReadPool:
   idleTimeoutSeconds: 1800
   maxWaiters: 5000
   size: 16
+poolAutosize:
+  idleIntervals: 6
+  intervalSeconds: 10
 queryCacheLFU: true
 queryCacheMemory: 33554432
 queryCacheSize: 5000
+queryTimeouts: {}
 replicationTracker:
   heartbeatIntervalSeconds: 0.25
   mode: disable
@@ -182,16 +196,25 @@ func TestFlags(t *testing.T) {
 			TimeoutSeconds: 1,
 			MaxWaiters:     5000,
 		},
+		PoolAutosize: PoolAutosizeConfig{
+			IntervalSeconds: 10,
+			IdleIntervals:   6,
+		},
 		Oltp: OltpConfig{
 			QueryTimeoutSeconds: 30,
 			TxTimeoutSeconds:    30,
 			MaxRows:             10000,
+			TxLogMaxStatements:  1000,
+			TxLogMaxBytes:       1024 * 1024,
 		},
 		HotRowProtection: HotRowProtectionConfig{
 			MaxQueueSize:       20,
 			MaxGlobalQueueSize: 1000,
 			MaxConcurrency:     5,
 		},
+		MemoryAdmission: MemoryAdmissionConfig{
+			ExpensiveRows: 1000,
+		},
 		StreamBufferSize:            32768,
 		QueryCacheSize:              int(cache.DefaultConfig.MaxEntries),
 		QueryCacheMemory:            cache.DefaultConfig.MaxMemoryUsage,
@@ -200,7 +223,9 @@ func TestFlags(t *testing.T) {
 		TrackSchemaVersions:         false,
 		MessagePostponeParallelism:  4,
 		CacheResultFields:           true,
+		MaxWorkloadStats:            100,
 		TxThrottlerConfig:           "target_replication_lag_sec: 2\nmax_replication_lag_sec: 10\ninitial_rate: 100\nmax_increase: 1\nemergency_decrease: 0.5\nmin_duration_between_increases_sec: 40\nmax_duration_between_increases_sec: 62\nmin_duration_between_decreases_sec: 20\nspread_backlog_across_sec: 20\nage_bad_rate_after_sec: 180\nbad_rate_increase: 0.1\nmax_rate_approach_threshold: 0.9\n",
+		TxThrottlerAlgorithm:        "max_replication_lag",
 		TxThrottlerHealthCheckCells: []string{},
 		TransactionLimitConfig: TransactionLimitConfig{
 			TransactionLimitPerUser:     0.4,
@@ -223,6 +248,7 @@ func TestFlags(t *testing.T) {
 	want.Healthcheck.DegradedThresholdSeconds = 30
 	want.Healthcheck.UnhealthyThresholdSeconds = 7200
 	want.ReplicationTracker.HeartbeatIntervalSeconds = 1
+	want.ReplicationTracker.HeartbeatIdleIntervalSeconds = 10
 	want.ReplicationTracker.Mode = Disable
 	assert.Equal(t, want.DB, currentConfig.DB)
 	assert.Equal(t, want, currentConfig)
@@ -326,3 +352,98 @@ func TestFlags(t *testing.T) {
 	want.GracePeriods.TransitionSeconds = 4
 	assert.Equal(t, want, currentConfig)
 }
+
+func TestVerifyHotRowProtection(t *testing.T) {
+	testcases := []struct {
+		modify func(c *HotRowProtectionConfig)
+		want   string
+	}{{
+		modify: func(c *HotRowProtectionConfig) {},
+	}, {
+		modify: func(c *HotRowProtectionConfig) {
+			c.MaxWaitSeconds = 1
+			c.MaxQueueSizePerTable = map[string]int{"t1": 50}
+			c.MaxWaitSecondsPerTable = map[string]Seconds{"t1": 2, "t2": 0}
+		},
+	}, {
+		modify: func(c *HotRowProtectionConfig) { c.MaxWaitSeconds = -1 },
+		want:   "-hot_row_protection_max_wait must be >= 0 (specified value: -1)",
+	}, {
+		modify: func(c *HotRowProtectionConfig) { c.MaxQueueSizePerTable = map[string]int{"t1": 0} },
+		want:   "-hot_row_protection_max_queue_size_per_table must be > 0 (specified value for t1: 0)",
+	}, {
+		modify: func(c *HotRowProtectionConfig) { c.MaxQueueSizePerTable = map[string]int{"t1": 2000} },
+		want:   "global queue size must be >= per row (range) queue size: -hot_row_protection_max_global_queue_size < hot_row_protection_max_queue_size_per_table (1000 < 2000 for t1)",
+	}, {
+		modify: func(c *HotRowProtectionConfig) { c.MaxWaitSecondsPerTable = map[string]Seconds{"t1": -1} },
+		want:   "-hot_row_protection_max_wait_per_table must be >= 0 (specified value for t1: -1)",
+	}}
+	for _, tcase := range testcases {
+		config := NewDefaultConfig()
+		tcase.modify(&config.HotRowProtection)
+		err := config.Verify()
+		if tcase.want == "" {
+			assert.NoError(t, err)
+			continue
+		}
+		assert.EqualError(t, err, tcase.want)
+	}
+}
+
+func TestVerifyMaxRowsPerTable(t *testing.T) {
+	config := NewDefaultConfig()
+	config.Oltp.MaxRowsPerTable = map[string]int{"t1": 100000}
+	assert.NoError(t, config.Verify())
+
+	config.Oltp.MaxRowsPerTable = map[string]int{"t1": 0}
+	assert.EqualError(t, config.Verify(), "-queryserver-config-max-result-size-per-table must be > 0 (specified value for t1: 0)")
+}
+
+func TestVerifyPoolAutosize(t *testing.T) {
+	config := NewDefaultConfig()
+	config.OltpReadPool.MaxSize = 32
+	assert.NoError(t, config.Verify())
+
+	config.OltpReadPool.MinSize = 20
+	assert.EqualError(t, config.Verify(), "auto-sized pool must satisfy 0 <= min size <= size <= max size: -queryserver-config-pool (20 <= 16 <= 32)")
+
+	config = NewDefaultConfig()
+	config.TxPool.MaxSize = 10
+	assert.EqualError(t, config.Verify(), "auto-sized pool must satisfy 0 <= min size <= size <= max size: -queryserver-config-transaction-cap (0 <= 20 <= 10)")
+
+	config.TxPool.MaxSize = 40
+	config.PoolAutosize.IdleIntervals = 0
+	assert.EqualError(t, config.Verify(), "-queryserver-config-pool-autosize-idle-intervals must be > 0 (specified value: 0)")
+}
+
+func TestVerifyHealthcheck(t *testing.T) {
+	config := NewDefaultConfig()
+	config.Healthcheck.UnhealthyMinFreeDiskPercent = 5
+	assert.NoError(t, config.Verify())
+
+	config.Healthcheck.UnhealthyMinFreeDiskPercent = 100
+	assert.EqualError(t, config.Verify(), "-unhealthy_min_free_disk_percent must be >= 0 and < 100 (specified value: 100)")
+}
+
+func TestVerifyErrorSanitization(t *testing.T) {
+	config := NewDefaultConfig()
+	config.ErrorSanitization.Policies = map[string]string{"INVALID_ARGUMENT": SanitizeKeep, "UNKNOWN": SanitizeGeneric}
+	assert.NoError(t, config.Verify())
+
+	config.ErrorSanitization.Policies = map[string]string{"BAD_CODE": SanitizeKeep}
+	assert.EqualError(t, config.Verify(), "-queryserver-config-sanitize-errors-policies has an invalid error code: BAD_CODE")
+
+	config.ErrorSanitization.Policies = map[string]string{"UNKNOWN": "drop"}
+	assert.EqualError(t, config.Verify(), "-queryserver-config-sanitize-errors-policies has an invalid policy for UNKNOWN: drop")
+}
+
+func TestIntMap(t *testing.T) {
+	var m map[string]int
+	val := (*intMap)(&m)
+	require.NoError(t, val.Set("t1:50,t2:10"))
+	assert.Equal(t, map[string]int{"t1": 50, "t2": 10}, m)
+	assert.Equal(t, "t1:50,t2:10", val.String())
+
+	assert.Error(t, val.Set("t1"))
+	assert.Error(t, val.Set("t1:1.5"))
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"flag"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+)
+
+var (
+	logFilterTables      = flag.String("querylog-filter-tables", "", "comma separated list of tables: only the queries and transactions using one of them are logged. Can be overridden by the tables parameter of the log streams.")
+	logFilterErrorsOnly  = flag.Bool("querylog-errors-only", false, "only log the queries which failed and the transactions which were not committed. Can be overridden by the errors_only parameter of the log streams.")
+	logFilterMinDuration = flag.Duration("querylog-min-duration", 0, "only log the queries and transactions which lasted at least this long. Can be overridden by the min_duration parameter of the log streams.")
+	logFilterRedact      = flag.Bool("querylog-redact", false, "strip the literal values of the logged queries and transactions, drop their bind variables and error messages, and log the digests of the queries. Can be overridden by the redact parameter of the log streams.")
+)
+
+// redactedText replaces the texts which cannot be redacted.
+const redactedText = "[REDACTED]"
+
+// LogFilter selects the records of the query and transaction logs, and
+// redacts them so that they can be shipped without leaking PII.
+type LogFilter struct {
+	// Tables keeps the records using one of the tables, if not empty.
+	Tables map[string]bool
+	// ErrorsOnly keeps the queries which failed, and the transactions
+	// which were not committed.
+	ErrorsOnly bool
+	// MinDuration keeps the records which lasted at least MinDuration.
+	MinDuration time.Duration
+	// Redact strips the literal values of the queries, and drops the
+	// bind variables and error messages.
+	Redact bool
+}
+
+// NewLogFilter returns the filter of a log stream. The parameters of the
+// stream override the querylog flags.
+func NewLogFilter(params url.Values) (*LogFilter, error) {
+	filter := &LogFilter{
+		ErrorsOnly:  *logFilterErrorsOnly,
+		MinDuration: *logFilterMinDuration,
+		Redact:      *logFilterRedact,
+	}
+	tables := *logFilterTables
+	if _, ok := params["tables"]; ok {
+		tables = params.Get("tables")
+	}
+	for _, table := range strings.Split(tables, ",") {
+		if table = strings.TrimSpace(table); table != "" {
+			if filter.Tables == nil {
+				filter.Tables = make(map[string]bool)
+			}
+			filter.Tables[table] = true
+		}
+	}
+	var err error
+	if v := params.Get("errors_only"); v != "" {
+		if filter.ErrorsOnly, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid errors_only: %v", v)
+		}
+	}
+	if v := params.Get("min_duration"); v != "" {
+		if filter.MinDuration, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("invalid min_duration: %v", v)
+		}
+	}
+	if v := params.Get("redact"); v != "" {
+		if filter.Redact, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("invalid redact: %v", v)
+		}
+	}
+	return filter, nil
+}
+
+// Match returns true if the record of the queries should be logged.
+func (filter *LogFilter) Match(queries []string, failed bool, duration time.Duration) bool {
+	if filter.ErrorsOnly && !failed {
+		return false
+	}
+	if duration < filter.MinDuration {
+		return false
+	}
+	if len(filter.Tables) == 0 {
+		return true
+	}
+	for _, query := range queries {
+		if filter.usesTables(query) {
+			return true
+		}
+	}
+	return false
+}
+
+// usesTables returns true if query uses one of the tables of the filter.
+func (filter *LogFilter) usesTables(query string) bool {
+	stmt, err := sqlparser.Parse(query)
+	if err != nil {
+		return false
+	}
+	found := false
+	_ = sqlparser.Walk(func(node sqlparser.SQLNode) (bool, error) {
+		if tableName, ok := node.(sqlparser.TableName); ok && filter.Tables[tableName.Name.String()] {
+			found = true
+		}
+		return !found, nil
+	}, stmt)
+	return found
+}
+
+// RedactQuery strips the literal values and comments of query, see
+// sqlparser.DigestText. Queries which cannot be parsed are fully redacted.
+func RedactQuery(query string) string {
+	text, err := sqlparser.DigestText(query)
+	if err != nil {
+		return redactedText
+	}
+	return text
+}
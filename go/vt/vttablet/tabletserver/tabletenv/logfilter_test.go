@@ -0,0 +1,80 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLogFilter(t *testing.T) {
+	*logFilterTables = "t1, t2"
+	*logFilterMinDuration = time.Second
+	defer func() {
+		*logFilterTables = ""
+		*logFilterMinDuration = 0
+	}()
+
+	filter, err := NewLogFilter(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &LogFilter{
+		Tables:      map[string]bool{"t1": true, "t2": true},
+		MinDuration: time.Second,
+	}, filter)
+
+	// The params override the flags.
+	filter, err = NewLogFilter(url.Values{"tables": {""}, "min_duration": {"10ms"}, "errors_only": {"1"}, "redact": {"true"}})
+	require.NoError(t, err)
+	assert.Equal(t, &LogFilter{
+		ErrorsOnly:  true,
+		MinDuration: 10 * time.Millisecond,
+		Redact:      true,
+	}, filter)
+
+	for _, params := range []url.Values{
+		{"errors_only": {"maybe"}},
+		{"min_duration": {"1"}},
+		{"redact": {"please"}},
+	} {
+		_, err := NewLogFilter(params)
+		assert.Error(t, err, "%v", params)
+	}
+}
+
+func TestLogFilterMatch(t *testing.T) {
+	filter := &LogFilter{Tables: map[string]bool{"t": true}}
+	assert.True(t, filter.Match([]string{"select * from t where id = 1"}, false, 0))
+	assert.True(t, filter.Match([]string{"insert into u values (1)", "update t set a = 1"}, false, 0))
+	assert.True(t, filter.Match([]string{"select * from u join t on u.id = t.id"}, false, 0))
+	assert.False(t, filter.Match([]string{"select t from u"}, false, 0))
+	assert.False(t, filter.Match([]string{"not a query"}, false, 0))
+	assert.False(t, filter.Match(nil, false, 0))
+
+	filter = &LogFilter{ErrorsOnly: true, MinDuration: time.Second}
+	assert.True(t, filter.Match(nil, true, time.Second))
+	assert.False(t, filter.Match(nil, false, time.Second))
+	assert.False(t, filter.Match(nil, true, time.Millisecond))
+}
+
+func TestRedactQuery(t *testing.T) {
+	assert.Equal(t, "select * from t where a = ? and b in ?", RedactQuery("select /* pii */ * from t where a = 'pii' and b in (1, 2)"))
+	assert.Equal(t, "[REDACTED]", RedactQuery("select 'pii"))
+}
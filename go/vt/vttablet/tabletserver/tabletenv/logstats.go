@@ -26,12 +26,17 @@ import (
 
 	"context"
 
+	"github.com/golang/protobuf/proto"
+
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/callerid"
 	"vitess.io/vitess/go/vt/callinfo"
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/vterrors"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
 )
 
 const (
@@ -39,6 +44,8 @@ const (
 	QuerySourceConsolidator = 1 << iota
 	// QuerySourceMySQL means query result is returned from MySQL.
 	QuerySourceMySQL
+	// QuerySourceResultCache means query result is found in the result cache.
+	QuerySourceResultCache
 )
 
 // LogStats records the stats for a single query
@@ -62,6 +69,11 @@ type LogStats struct {
 	ReservedID           int64
 	Error                error
 	CachedPlan           bool
+	// QueryTimeout is the effective timeout of the query, zero if none.
+	QueryTimeout time.Duration
+	// Workload is the workload the query is attributed to in the
+	// per-workload stats, empty if they are disabled.
+	Workload string
 }
 
 // NewLogStats constructs a new LogStats with supplied Method and ctx
@@ -142,7 +154,7 @@ func (stats *LogStats) FmtQuerySources() string {
 	if stats.QuerySources == 0 {
 		return "none"
 	}
-	sources := make([]string, 2)
+	sources := make([]string, 3)
 	n := 0
 	if stats.QuerySources&QuerySourceMySQL != 0 {
 		sources[n] = "mysql"
@@ -152,6 +164,10 @@ func (stats *LogStats) FmtQuerySources() string {
 		sources[n] = "consolidator"
 		n++
 	}
+	if stats.QuerySources&QuerySourceResultCache != 0 {
+		sources[n] = "resultcache"
+		n++
+	}
 	return strings.Join(sources[:n], ",")
 }
 
@@ -185,11 +201,32 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	if !streamlog.ShouldEmitLog(stats.OriginalSQL, uint64(stats.RowsAffected), uint64(len(stats.Rows))) {
 		return nil
 	}
+	filter, err := NewLogFilter(params)
+	if err != nil {
+		fmt.Fprintf(w, "Error: %v\n", err)
+		return err
+	}
+	if !filter.Match([]string{stats.OriginalSQL}, stats.Error != nil, stats.TotalTime()) {
+		return nil
+	}
 
+	originalSQL := stats.OriginalSQL
 	rewrittenSQL := "[REDACTED]"
 	formattedBindVars := "\"[REDACTED]\""
+	errorStr := stats.ErrorStr()
 
-	if !*streamlog.RedactDebugUIQueries {
+	switch {
+	case filter.Redact:
+		originalSQL = RedactQuery(stats.OriginalSQL)
+		rewrittenSqls := make([]string, 0, len(stats.rewrittenSqls))
+		for _, sql := range stats.rewrittenSqls {
+			rewrittenSqls = append(rewrittenSqls, RedactQuery(sql))
+		}
+		rewrittenSQL = strings.Join(rewrittenSqls, "; ")
+		if stats.Error != nil {
+			errorStr = vterrors.Code(stats.Error).String()
+		}
+	case !*streamlog.RedactDebugUIQueries:
 		rewrittenSQL = stats.RewrittenSQL()
 
 		_, fullBindParams := params["full"]
@@ -204,17 +241,19 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 	callInfo, username := stats.CallInfo()
 
 	// Valid options for the QueryLogFormat are text or json
-	var fmtString string
+	var fmtString, digestFmtString, endString string
 	switch *streamlog.QueryLogFormat {
 	case streamlog.QueryLogFormatText:
-		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%q\t\n"
+		fmtString = "%v\t%v\t%v\t'%v'\t'%v'\t%v\t%v\t%.6f\t%v\t%q\t%v\t%v\t%q\t%v\t%.6f\t%.6f\t%v\t%v\t%q\t%.6f\t"
+		digestFmtString = "%v\t"
+		endString = "\n"
 	case streamlog.QueryLogFormatJSON:
-		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v, \"ResponseSize\": %v, \"Error\": %q}\n"
+		fmtString = "{\"Method\": %q, \"CallInfo\": %q, \"Username\": %q, \"ImmediateCaller\": %q, \"Effective Caller\": %q, \"Start\": \"%v\", \"End\": \"%v\", \"TotalTime\": %.6f, \"PlanType\": %q, \"OriginalSQL\": %q, \"BindVars\": %v, \"Queries\": %v, \"RewrittenSQL\": %q, \"QuerySources\": %q, \"MysqlTime\": %.6f, \"ConnWaitTime\": %.6f, \"RowsAffected\": %v, \"ResponseSize\": %v, \"Error\": %q, \"QueryTimeout\": %.6f"
+		digestFmtString = ", \"Digest\": %q"
+		endString = "}\n"
 	}
 
-	_, err := fmt.Fprintf(
-		w,
-		fmtString,
+	args := []interface{}{
 		stats.Method,
 		callInfo,
 		username,
@@ -224,7 +263,7 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.EndTime.Format("2006-01-02 15:04:05.000000"),
 		stats.TotalTime().Seconds(),
 		stats.PlanType,
-		stats.OriginalSQL,
+		originalSQL,
 		formattedBindVars,
 		stats.NumberOfQueries,
 		rewrittenSQL,
@@ -233,7 +272,48 @@ func (stats *LogStats) Logf(w io.Writer, params url.Values) error {
 		stats.WaitingForConnection.Seconds(),
 		stats.RowsAffected,
 		stats.SizeOfResponse(),
-		stats.ErrorStr(),
-	)
+		errorStr,
+		stats.QueryTimeout.Seconds(),
+	}
+	// Redacted records keep the digest of the query, so that they can
+	// still be grouped by query.
+	if filter.Redact {
+		fmtString += digestFmtString
+		args = append(args, sqlparser.Digest(stats.OriginalSQL))
+	}
+	_, err = fmt.Fprintf(w, fmtString+endString, args...)
 	return err
 }
+
+// LogProto returns the record of the query in the binary log format, or
+// nil if it mustn't be logged.
+func (stats *LogStats) LogProto() proto.Message {
+	if !streamlog.ShouldEmitLog(stats.OriginalSQL, uint64(stats.RowsAffected), uint64(len(stats.Rows))) {
+		return nil
+	}
+	callInfo, username := stats.CallInfo()
+	record := &querylogpb.TabletQuery{
+		Method:          stats.Method,
+		CallInfo:        callInfo,
+		Username:        username,
+		ImmediateCaller: stats.ImmediateCaller(),
+		EffectiveCaller: stats.EffectiveCaller(),
+		StartTime:       stats.StartTime.UnixNano(),
+		EndTime:         stats.EndTime.UnixNano(),
+		PlanType:        stats.PlanType,
+		OriginalSql:     stats.OriginalSQL,
+		NumberOfQueries: int64(stats.NumberOfQueries),
+		QuerySources:    stats.FmtQuerySources(),
+		MysqlTime:       int64(stats.MysqlResponseTime),
+		ConnWaitTime:    int64(stats.WaitingForConnection),
+		RowsAffected:    int64(stats.RowsAffected),
+		ResponseSize:    int64(stats.SizeOfResponse()),
+		Error:           stats.ErrorStr(),
+		QueryTimeout:    int64(stats.QueryTimeout),
+	}
+	if !*streamlog.RedactDebugUIQueries {
+		record.RewrittenSql = stats.RewrittenSQL()
+		record.BindVariables = stats.BindVariables
+	}
+	return record
+}
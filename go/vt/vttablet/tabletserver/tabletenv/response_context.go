@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"context"
+	"sync"
+)
+
+type responseContextKey int
+
+// Response collects what must be done once the response of a request has
+// been sent, like releasing the memory accounted for the results it holds.
+type Response struct {
+	mu    sync.Mutex
+	dones []func()
+}
+
+// ResponseContext returns a context for a request whose response is sent
+// by the caller, who must call Sent on the returned Response once it is.
+func ResponseContext(ctx context.Context) (context.Context, *Response) {
+	resp := &Response{}
+	return context.WithValue(ctx, responseContextKey(0), resp), resp
+}
+
+// ResponseFromContext returns the Response of the request of ctx, or nil
+// if the request was not made with a ResponseContext.
+func ResponseFromContext(ctx context.Context) *Response {
+	resp, _ := ctx.Value(responseContextKey(0)).(*Response)
+	return resp
+}
+
+// OnSent registers done to be called once the response is sent.
+func (resp *Response) OnSent(done func()) {
+	resp.mu.Lock()
+	defer resp.mu.Unlock()
+	resp.dones = append(resp.dones, done)
+}
+
+// Sent calls the functions registered with OnSent.
+func (resp *Response) Sent() {
+	resp.mu.Lock()
+	dones := resp.dones
+	resp.dones = nil
+	resp.mu.Unlock()
+	for _, done := range dones {
+		done()
+	}
+}
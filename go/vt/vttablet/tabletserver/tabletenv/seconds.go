@@ -18,6 +18,10 @@ package tabletenv
 
 import (
 	"flag"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -39,3 +43,45 @@ func (s Seconds) Get() time.Duration {
 func (s *Seconds) Set(d time.Duration) {
 	*s = Seconds(d) / Seconds(1*time.Second)
 }
+
+// SecondsMapVar defines a flag for a map of Seconds. It accepts a
+// comma-separated list of key:seconds pairs.
+func SecondsMapVar(p *map[string]Seconds, name string, usage string) {
+	flag.Var((*secondsMap)(p), name, usage)
+}
+
+type secondsMap map[string]Seconds
+
+// Set is part of the flag.Value interface.
+func (m *secondsMap) Set(v string) error {
+	values := make(map[string]Seconds)
+	for _, pair := range strings.Split(v, ",") {
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, ":", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("want key:seconds, got %q", pair)
+		}
+		seconds, err := strconv.ParseFloat(parts[1], 64)
+		if err != nil {
+			return fmt.Errorf("want key:seconds, got %q", pair)
+		}
+		values[parts[0]] = Seconds(seconds)
+	}
+	*m = values
+	return nil
+}
+
+// String is part of the flag.Value interface.
+func (m *secondsMap) String() string {
+	if m == nil {
+		return ""
+	}
+	var pairs []string
+	for k, v := range *m {
+		pairs = append(pairs, fmt.Sprintf("%s:%v", k, float64(v)))
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
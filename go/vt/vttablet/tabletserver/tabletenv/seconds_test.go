@@ -51,3 +51,17 @@ func TestSecondsGetSet(t *testing.T) {
 	assert.Equal(t, Seconds(2), val)
 	assert.Equal(t, 2*time.Second, val.Get())
 }
+
+func TestSecondsMap(t *testing.T) {
+	var m map[string]Seconds
+	val := (*secondsMap)(&m)
+	require.NoError(t, val.Set("orders:5,events:0.5"))
+	assert.Equal(t, map[string]Seconds{"orders": 5, "events": 0.5}, m)
+	assert.Equal(t, "events:0.5,orders:5", val.String())
+
+	require.NoError(t, val.Set(""))
+	assert.Empty(t, m)
+
+	assert.Error(t, val.Set("orders"))
+	assert.Error(t, val.Set("orders:abc"))
+}
@@ -34,7 +34,8 @@ type Stats struct {
 	ErrorCounters          *stats.CountersWithSingleLabel
 	InternalErrors         *stats.CountersWithSingleLabel
 	Warnings               *stats.CountersWithSingleLabel
-	Unresolved             *stats.GaugesWithSingleLabel   // For now, only Prepares are tracked
+	Unresolved             *stats.GaugesWithSingleLabel   // Unresolved prepares and distributed transactions
+	UnresolvedMaxAge       *stats.GaugesWithSingleLabel   // Age in seconds of the oldest unresolved items
 	UserTableQueryCount    *stats.CountersWithMultiLabels // Per CallerID/table counts
 	UserTableQueryTimesNs  *stats.CountersWithMultiLabels // Per CallerID/table latencies
 	UserTransactionCount   *stats.CountersWithMultiLabels // Per CallerID transaction counts
@@ -43,10 +44,16 @@ type Stats struct {
 	TableaclAllowed        *stats.CountersWithMultiLabels // Number of allows
 	TableaclDenied         *stats.CountersWithMultiLabels // Number of denials
 	TableaclPseudoDenied   *stats.CountersWithMultiLabels // Number of pseudo denials
+	TableaclDecisions      *stats.CountersWithMultiLabels // Number of decisions per table group and role
 
 	UserActiveReservedCount *stats.CountersWithSingleLabel // Per CallerID active reserved connection counts
 	UserReservedCount       *stats.CountersWithSingleLabel // Per CallerID reserved connection counts
 	UserReservedTimesNs     *stats.CountersWithSingleLabel // Per CallerID reserved connection duration
+
+	WorkloadQueryCount   *stats.CountersWithMultiLabels // Per workload/plan type counts
+	WorkloadQueryTimesNs *stats.CountersWithMultiLabels // Per workload/plan type latencies
+	WorkloadErrors       *stats.CountersWithMultiLabels // Per workload/error code counts
+	WorkloadKills        *stats.CountersWithMultiLabels // Per workload query kills
 }
 
 // NewStats instantiates a new set of stats scoped by exporter.
@@ -80,7 +87,8 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		),
 		InternalErrors:         exporter.NewCountersWithSingleLabel("InternalErrors", "Internal component errors", "type", "Task", "StrayTransactions", "Panic", "HungQuery", "Schema", "TwopcCommit", "TwopcResurrection", "WatchdogFail", "Messages"),
 		Warnings:               exporter.NewCountersWithSingleLabel("Warnings", "Warnings", "type", "ResultsExceeded"),
-		Unresolved:             exporter.NewGaugesWithSingleLabel("Unresolved", "Unresolved items", "item_type", "Prepares"),
+		Unresolved:             exporter.NewGaugesWithSingleLabel("Unresolved", "Unresolved items", "item_type", "Prepares", "FailedPrepares", "Transactions"),
+		UnresolvedMaxAge:       exporter.NewGaugesWithSingleLabel("UnresolvedMaxAge", "Age in seconds of the oldest unresolved items", "item_type", "Prepares", "Transactions"),
 		UserTableQueryCount:    exporter.NewCountersWithMultiLabels("UserTableQueryCount", "Queries received for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
 		UserTableQueryTimesNs:  exporter.NewCountersWithMultiLabels("UserTableQueryTimesNs", "Total latency for each CallerID/table combination", []string{"TableName", "CallerID", "Type"}),
 		UserTransactionCount:   exporter.NewCountersWithMultiLabels("UserTransactionCount", "transactions received for each CallerID", []string{"CallerID", "Conclusion"}),
@@ -89,10 +97,16 @@ func NewStats(exporter *servenv.Exporter) *Stats {
 		TableaclAllowed:        exporter.NewCountersWithMultiLabels("TableACLAllowed", "ACL acceptances", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclDenied:         exporter.NewCountersWithMultiLabels("TableACLDenied", "ACL denials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
 		TableaclPseudoDenied:   exporter.NewCountersWithMultiLabels("TableACLPseudoDenied", "ACL pseudodenials", []string{"TableName", "TableGroup", "PlanID", "Username"}),
+		TableaclDecisions:      exporter.NewCountersWithMultiLabels("TableACLDecisions", "ACL decisions per table group and role", []string{"TableGroup", "Role", "Decision"}),
 
 		UserActiveReservedCount: exporter.NewCountersWithSingleLabel("UserActiveReservedCount", "active reserved connection for each CallerID", "CallerID"),
 		UserReservedCount:       exporter.NewCountersWithSingleLabel("UserReservedCount", "reserved connection received for each CallerID", "CallerID"),
 		UserReservedTimesNs:     exporter.NewCountersWithSingleLabel("UserReservedTimesNs", "Total reserved connection latency for each CallerID", "CallerID"),
+
+		WorkloadQueryCount:   exporter.NewCountersWithMultiLabels("WorkloadQueryCount", "Queries received for each workload/plan type combination", []string{"Workload", "PlanType"}),
+		WorkloadQueryTimesNs: exporter.NewCountersWithMultiLabels("WorkloadQueryTimesNs", "Total latency for each workload/plan type combination", []string{"Workload", "PlanType"}),
+		WorkloadErrors:       exporter.NewCountersWithMultiLabels("WorkloadErrors", "Errors for each workload/error code combination", []string{"Workload", "ErrorCode"}),
+		WorkloadKills:        exporter.NewCountersWithMultiLabels("WorkloadKills", "Number of queries being killed for each workload", []string{"Workload", "QueryType"}),
 	}
 	stats.QPSRates = exporter.NewRates("QPS", stats.QueryTimings, 15*60/5, 5*time.Second)
 	return stats
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletenv
+
+import (
+	"context"
+	"sync"
+)
+
+// OtherWorkload is the workload the queries are attributed to in the
+// per-workload stats once the maximum number of workloads is reached.
+const OtherWorkload = "other"
+
+type workloadContextKey int
+
+// NewContextWithWorkload returns a context carrying the workload of the
+// query, so that the lower layers can attribute their stats to it.
+func NewContextWithWorkload(ctx context.Context, workload string) context.Context {
+	return context.WithValue(ctx, workloadContextKey(0), workload)
+}
+
+// WorkloadFromContext returns the workload carried by ctx, or "" if
+// there is none.
+func WorkloadFromContext(ctx context.Context) string {
+	workload, _ := ctx.Value(workloadContextKey(0)).(string)
+	return workload
+}
+
+// WorkloadLabels bounds the number of distinct workloads in the
+// per-workload stats, since the workload names come from the clients.
+type WorkloadLabels struct {
+	max int
+
+	mu     sync.Mutex
+	labels map[string]bool
+}
+
+// NewWorkloadLabels returns a WorkloadLabels which allows up to max
+// distinct workloads.
+func NewWorkloadLabels(max int) *WorkloadLabels {
+	return &WorkloadLabels{
+		max:    max,
+		labels: make(map[string]bool),
+	}
+}
+
+// Label returns the label of workload in the stats: the workload itself
+// if it was seen before or the maximum is not reached yet, and else
+// OtherWorkload.
+func (wl *WorkloadLabels) Label(workload string) string {
+	wl.mu.Lock()
+	defer wl.mu.Unlock()
+	if wl.labels[workload] {
+		return workload
+	}
+	if len(wl.labels) >= wl.max {
+		return OtherWorkload
+	}
+	wl.labels[workload] = true
+	return workload
+}
@@ -23,7 +23,9 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"syscall"
@@ -31,14 +33,18 @@ import (
 
 	"context"
 
+	"github.com/fsnotify/fsnotify"
+
 	"vitess.io/vitess/go/acl"
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/pools"
 	"vitess.io/vitess/go/sqltypes"
 	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/sync2"
 	"vitess.io/vitess/go/tb"
 	"vitess.io/vitess/go/trace"
 	"vitess.io/vitess/go/vt/callerid"
+	"vitess.io/vitess/go/vt/callinfo"
 	"vitess.io/vitess/go/vt/dbconfigs"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/logutil"
@@ -52,6 +58,7 @@ import (
 	"vitess.io/vitess/go/vt/srvtopo"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/topoproto"
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vttablet/onlineddl"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
@@ -63,6 +70,7 @@ import (
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txserializer"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/txthrottler"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/vstreamer"
@@ -95,8 +103,10 @@ type TabletServer struct {
 	QueryTimeout           sync2.AtomicDuration
 	txTimeout              sync2.AtomicDuration
 	TerseErrors            bool
+	errorSanitizer         *errorSanitizer
 	enableHotRowProtection bool
 	topoServer             *topo.Server
+	workloads              *tabletenv.WorkloadLabels
 
 	// These are sub-components of TabletServer.
 	statelessql  *QueryList
@@ -114,6 +124,7 @@ type TabletServer struct {
 	hs           *healthStreamer
 	lagThrottler *throttle.Throttler
 	tableGC      *gc.TableGC
+	digests      *digestBlocker
 
 	// sm manages state transitions.
 	sm                *stateManager
@@ -121,6 +132,13 @@ type TabletServer struct {
 
 	// alias is used for identifying this tabletserver in healthcheck responses.
 	alias topodatapb.TabletAlias
+
+	// queryRules keeps the unfiltered rules of every source, so that
+	// they can be scoped again when the target of the tablet changes.
+	// queryRulesTarget is the target they are currently scoped to.
+	queryRulesMu     sync.Mutex
+	queryRules       map[string]*rules.Rules
+	queryRulesTarget querypb.Target
 }
 
 var _ queryservice.QueryService = (*TabletServer)(nil)
@@ -151,9 +169,12 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 		QueryTimeout:           sync2.NewAtomicDuration(config.Oltp.QueryTimeoutSeconds.Get()),
 		txTimeout:              sync2.NewAtomicDuration(config.Oltp.TxTimeoutSeconds.Get()),
 		TerseErrors:            config.TerseErrors,
+		errorSanitizer:         newErrorSanitizer(exporter, config.ErrorSanitization),
 		enableHotRowProtection: config.HotRowProtection.Mode != tabletenv.Disable,
 		topoServer:             topoServer,
+		workloads:              tabletenv.NewWorkloadLabels(config.MaxWorkloadStats),
 		alias:                  alias,
+		queryRules:             make(map[string]*rules.Rules),
 	}
 
 	tsOnce.Do(func() { srvTopoServer = srvtopo.NewResilientServer(topoServer, "TabletSrvTopo") })
@@ -168,20 +189,22 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.statelessql = NewQueryList("oltp-stateless")
 	tsv.statefulql = NewQueryList("oltp-stateful")
 	tsv.olapql = NewQueryList("olap")
-	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tabletTypeFunc)
-	tsv.hs = newHealthStreamer(tsv, alias)
-	tsv.se = schema.NewEngine(tsv)
 	tsv.rt = repltracker.NewReplTracker(tsv, alias)
+	tsv.lagThrottler = throttle.NewThrottler(tsv, topoServer, tsv.rt, tabletTypeFunc)
+	tsv.se = schema.NewEngine(tsv)
+	tsv.hs = newHealthStreamer(tsv, alias, tsv.se)
 	tsv.vstreamer = vstreamer.NewEngine(tsv, srvTopoServer, tsv.se, tsv.lagThrottler, alias.Cell)
 	tsv.tracker = schema.NewTracker(tsv, tsv.vstreamer, tsv.se)
 	tsv.watcher = NewBinlogWatcher(tsv, tsv.vstreamer, tsv.config)
 	tsv.qe = NewQueryEngine(tsv, tsv.se)
 	tsv.txThrottler = txthrottler.NewTxThrottler(tsv.config, topoServer)
 	tsv.te = NewTxEngine(tsv)
+	tsv.te.txPool.resultCache = tsv.qe.resultCache
 	tsv.messager = messager.NewEngine(tsv, tsv.se, tsv.vstreamer)
 
 	tsv.onlineDDLExecutor = onlineddl.NewExecutor(tsv, alias, topoServer, tabletTypeFunc)
 	tsv.tableGC = gc.NewTableGC(tsv, topoServer, tabletTypeFunc, tsv.lagThrottler)
+	tsv.digests = newDigestBlocker(tsv)
 
 	tsv.sm = &stateManager{
 		statelessql: tsv.statelessql,
@@ -200,6 +223,7 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 		ddle:        tsv.onlineDDLExecutor,
 		throttler:   tsv.lagThrottler,
 		tableGC:     tsv.tableGC,
+		mh:          newMySQLHealthChecker(tsv),
 	}
 
 	tsv.exporter.NewGaugeFunc("TabletState", "Tablet server state", func() int64 { return int64(tsv.sm.State()) })
@@ -210,16 +234,29 @@ func NewTabletServer(name string, config *tabletenv.TabletConfig, topoServer *to
 	tsv.exporter.NewGaugesFuncWithMultiLabels("TabletServerState", "Tablet server state labeled by state name", []string{"name"}, func() map[string]int64 {
 		return map[string]int64{tsv.sm.IsServingString(): 1}
 	})
+	tsv.exporter.NewGaugesFuncWithMultiLabels("TabletUnhealthyReason", "Reason why the tablet is not healthy", []string{"Reason"}, func() map[string]int64 {
+		if reason := tsv.sm.UnhealthyReason(); reason != "" {
+			return map[string]int64{reason: 1}
+		}
+		return nil
+	})
 	tsv.exporter.NewGaugeDurationFunc("QueryTimeout", "Tablet server query timeout", tsv.QueryTimeout.Get)
+	for name := range config.QueryTimeouts.Plans {
+		if _, ok := planbuilder.PlanByName(name); !ok {
+			log.Warningf("Ignoring the query timeout of unknown plan type %s", name)
+		}
+	}
 
 	tsv.registerHealthzHealthHandler()
 	tsv.registerDebugHealthHandler()
 	tsv.registerQueryzHandler()
 	tsv.registerQueryListHandlers([]*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql})
+	tsv.registerSequenceHandlers()
 	tsv.registerTwopczHandler()
 	tsv.registerMigrationStatusHandler()
 	tsv.registerThrottlerHandlers()
 	tsv.registerDebugEnvHandler()
+	tsv.registerDrainConnPoolsHandler()
 
 	return tsv
 }
@@ -242,6 +279,13 @@ func (tsv *TabletServer) InitDBConfig(target querypb.Target, dbcfgs *dbconfigs.D
 	tsv.onlineDDLExecutor.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
 	tsv.lagThrottler.InitDBConfig(target.Keyspace, target.Shard)
 	tsv.tableGC.InitDBConfig(target.Keyspace, target.Shard, dbcfgs.DBName)
+	tsv.rescopeQueryRules()
+
+	ctx, cancel := context.WithTimeout(context.Background(), *topo.RemoteOperationTimeout)
+	defer cancel()
+	if err := tsv.digests.load(ctx); err != nil {
+		log.Errorf("Cannot load the blocked query digests: %v", err)
+	}
 	return nil
 }
 
@@ -283,19 +327,74 @@ func (tsv *TabletServer) RegisterQueryRuleSource(ruleSource string) {
 
 // UnRegisterQueryRuleSource unregisters ruleSource from query rules.
 func (tsv *TabletServer) UnRegisterQueryRuleSource(ruleSource string) {
+	tsv.queryRulesMu.Lock()
+	delete(tsv.queryRules, ruleSource)
+	tsv.queryRulesMu.Unlock()
 	tsv.qe.queryRuleSources.UnRegisterSource(ruleSource)
 }
 
 // SetQueryRules sets the query rules for a registered ruleSource.
+// Rules scoped to other keyspaces, shards or tablet types are dropped,
+// so that they don't need to be checked for every query. The unfiltered
+// rules are kept and scoped again whenever the target changes.
 func (tsv *TabletServer) SetQueryRules(ruleSource string, qrs *rules.Rules) error {
-	err := tsv.qe.queryRuleSources.SetRules(ruleSource, qrs)
-	if err != nil {
+	tsv.queryRulesMu.Lock()
+	defer tsv.queryRulesMu.Unlock()
+
+	target := tsv.sm.Target()
+	filtered := qrs
+	if qrs != nil {
+		filtered = qrs.FilterByTarget(target.Keyspace, target.Shard, target.TabletType)
+	}
+	if err := tsv.qe.queryRuleSources.SetRules(ruleSource, filtered); err != nil {
 		return err
 	}
+	if qrs != nil {
+		tsv.queryRules[ruleSource] = qrs
+	} else {
+		delete(tsv.queryRules, ruleSource)
+	}
+	if !sameRulesTarget(&tsv.queryRulesTarget, &target) {
+		tsv.rescopeQueryRulesLocked(target)
+	}
 	tsv.qe.ClearQueryPlanCache()
 	return nil
 }
 
+// SetQueryRulesSigner records the verified signer of the query rules of
+// ruleSource, to be shown in /debug/query_rule_sources.
+func (tsv *TabletServer) SetQueryRulesSigner(ruleSource, signer string) error {
+	return tsv.qe.queryRuleSources.SetSigner(ruleSource, signer)
+}
+
+// rescopeQueryRules filters the query rules of every source again if the
+// keyspace, shard or tablet type of the tablet changed since they were set.
+func (tsv *TabletServer) rescopeQueryRules() {
+	tsv.queryRulesMu.Lock()
+	defer tsv.queryRulesMu.Unlock()
+
+	target := tsv.sm.Target()
+	if sameRulesTarget(&tsv.queryRulesTarget, &target) {
+		return
+	}
+	tsv.rescopeQueryRulesLocked(target)
+	tsv.qe.ClearQueryPlanCache()
+}
+
+func (tsv *TabletServer) rescopeQueryRulesLocked(target querypb.Target) {
+	tsv.queryRulesTarget = querypb.Target{Keyspace: target.Keyspace, Shard: target.Shard, TabletType: target.TabletType}
+	for ruleSource, qrs := range tsv.queryRules {
+		filtered := qrs.FilterByTarget(target.Keyspace, target.Shard, target.TabletType)
+		if err := tsv.qe.queryRuleSources.SetRules(ruleSource, filtered); err != nil {
+			log.Errorf("Cannot scope the query rules of %s to %v: %v", ruleSource, target, err)
+		}
+	}
+}
+
+func sameRulesTarget(a, b *querypb.Target) bool {
+	return a.Keyspace == b.Keyspace && a.Shard == b.Shard && a.TabletType == b.TabletType
+}
+
 func (tsv *TabletServer) initACL(tableACLConfigFile string, enforceTableACLConfig bool) {
 	// tabletacl.Init loads ACL from file if *tableACLConfig is not empty
 	err := tableacl.Init(
@@ -334,6 +433,47 @@ func (tsv *TabletServer) InitACL(tableACLConfigFile string, enforceTableACLConfi
 	}
 }
 
+// WatchACL reloads the table ACL every time its config file changes. The
+// directory of the file is watched, so that a file replaced by a rename is
+// still tracked. A config which cannot be loaded leaves the current ACL in
+// place.
+func (tsv *TabletServer) WatchACL(tableACLConfigFile string) error {
+	if tableACLConfigFile == "" {
+		return nil
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return err
+	}
+	if err := watcher.Add(filepath.Dir(tableACLConfigFile)); err != nil {
+		watcher.Close()
+		return err
+	}
+	servenv.OnClose(func() { watcher.Close() })
+
+	go func() {
+		path := filepath.Clean(tableACLConfigFile)
+		for {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(event.Name) != path || event.Op&(fsnotify.Write|fsnotify.Create|fsnotify.Rename) == 0 {
+					continue
+				}
+				tsv.initACL(tableACLConfigFile, false)
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				log.Warningf("Error watching table ACL config file %s: %v", tableACLConfigFile, err)
+			}
+		}
+	}()
+	return nil
+}
+
 // SetServingType changes the serving type of the tabletserver. It starts or
 // stops internal services as deemed necessary.
 // Returns true if the state of QueryService or the tablet type changed.
@@ -342,7 +482,9 @@ func (tsv *TabletServer) SetServingType(tabletType topodatapb.TabletType, terTim
 	if serving {
 		state = StateServing
 	}
-	return tsv.sm.SetServingType(tabletType, terTimestamp, state, reason)
+	err := tsv.sm.SetServingType(tabletType, terTimestamp, state, reason)
+	tsv.rescopeQueryRules()
+	return err
 }
 
 // StartService is a convenience function for InitDBConfig->SetServingType
@@ -352,7 +494,9 @@ func (tsv *TabletServer) StartService(target querypb.Target, dbcfgs *dbconfigs.D
 		return err
 	}
 	// StartService is only used for testing. So, we cheat by aggressively setting replication to healthy.
-	return tsv.sm.SetServingType(target.TabletType, time.Time{}, StateServing, "")
+	err := tsv.sm.SetServingType(target.TabletType, time.Time{}, StateServing, "")
+	tsv.rescopeQueryRules()
+	return err
 }
 
 // StopService shuts down the tabletserver to the uninitialized state.
@@ -696,16 +840,10 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 		return nil, vterrors.New(vtrpcpb.Code_INTERNAL, "transactionID and reserveID must match if both are non-zero")
 	}
 
-	allowOnShutdown := false
-	timeout := tsv.QueryTimeout.Get()
-	if transactionID != 0 {
-		allowOnShutdown = true
-		// Use the smaller of the two values (0 means infinity).
-		// TODO(sougou): Assign deadlines to each transaction and set query timeout accordingly.
-		timeout = smallerTimeout(timeout, tsv.txTimeout.Get())
-	}
+	allowOnShutdown := transactionID != 0
+	// The timeout depends on the plan, it is applied once the query is planned.
 	err = tsv.execRequest(
-		ctx, timeout,
+		ctx, 0,
 		"Execute", sql, bindVariables,
 		target, options, allowOnShutdown,
 		func(ctx context.Context, logStats *tabletenv.LogStats) error {
@@ -713,10 +851,23 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 				bindVariables = make(map[string]*querypb.BindVariable)
 			}
 			query, comments := sqlparser.SplitMarginComments(sql)
-			plan, err := tsv.qe.GetPlan(ctx, logStats, query, skipQueryPlanCache(options), reservedID != 0)
+			planCtx, cancel := withTimeout(ctx, tsv.QueryTimeout.Get(), options)
+			plan, err := tsv.qe.GetPlan(planCtx, logStats, query, skipQueryPlanCache(options), reservedID != 0)
+			cancel()
 			if err != nil {
 				return err
 			}
+			timeout := tsv.queryTimeout(ctx, plan, bindVariables)
+			if transactionID != 0 {
+				// Use the smaller of the two values (0 means infinity).
+				// TODO(sougou): Assign deadlines to each transaction and set query timeout accordingly.
+				timeout = smallerTimeout(timeout, tsv.txTimeout.Get())
+			}
+			if options.GetWorkload() != querypb.ExecuteOptions_DBA && !tabletenv.IsLocalContext(ctx) {
+				logStats.QueryTimeout = timeout
+			}
+			ctx, cancel = withTimeout(ctx, timeout, options)
+			defer cancel()
 			// If both the values are non-zero then by design they are same value. So, it is safe to overwrite.
 			connID := reservedID
 			if transactionID != 0 {
@@ -745,7 +896,7 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 			// Change database name in mysql output to the keyspace name
 			if tsv.sm.target.Keyspace != tsv.config.DB.DBName && sqltypes.IncludeFieldsOrDefault(options) == querypb.ExecuteOptions_ALL {
 				switch qre.plan.PlanID {
-				case planbuilder.PlanSelect, planbuilder.PlanSelectImpossible:
+				case planbuilder.PlanSelect, planbuilder.PlanSelectImpossible, planbuilder.PlanUnion, planbuilder.PlanSelectDerived:
 					dbName := tsv.config.DB.DBName
 					ksName := tsv.sm.target.Keyspace
 					for _, f := range result.Fields {
@@ -761,6 +912,53 @@ func (tsv *TabletServer) Execute(ctx context.Context, target *querypb.Target, sq
 	return result, err
 }
 
+// queryTimeout returns the timeout of a query. In order of precedence, it is
+// the smallest timeout of the query rules matching the query, the smallest
+// timeout configured for the tables of the query, the timeout configured for
+// its plan type, and finally the query timeout of the tablet server.
+func (tsv *TabletServer) queryTimeout(ctx context.Context, plan *TabletPlan, bindVariables map[string]*querypb.BindVariable) time.Duration {
+	if timeout, ok := tsv.configuredTimeout(ctx, plan, bindVariables); ok {
+		return timeout
+	}
+	return tsv.QueryTimeout.Get()
+}
+
+// configuredTimeout returns the timeout of a query set by the query rules,
+// or configured for its tables or its plan type, like queryTimeout. It
+// returns false if there is none.
+func (tsv *TabletServer) configuredTimeout(ctx context.Context, plan *TabletPlan, bindVariables map[string]*querypb.BindVariable) (time.Duration, bool) {
+	remoteAddr := ""
+	username := ""
+	if ci, ok := callinfo.FromContext(ctx); ok {
+		remoteAddr = ci.RemoteAddr()
+		username = ci.Username()
+	}
+	if timeout := plan.Rules.QueryTimeout(remoteAddr, username, bindVariables); timeout != 0 {
+		return timeout, true
+	}
+	var timeout time.Duration
+	found := false
+	for _, perm := range plan.Permissions {
+		t, ok := tsv.config.QueryTimeouts.Tables[perm.TableName]
+		if !ok {
+			continue
+		}
+		if !found {
+			timeout = t.Get()
+			found = true
+			continue
+		}
+		timeout = smallerTimeout(timeout, t.Get())
+	}
+	if found {
+		return timeout, true
+	}
+	if t, ok := tsv.config.QueryTimeouts.Plans[plan.PlanID.String()]; ok {
+		return t.Get(), true
+	}
+	return 0, false
+}
+
 // smallerTimeout returns the smaller of the two timeouts.
 // 0 is treated as infinity.
 func smallerTimeout(t1, t2 time.Duration) time.Duration {
@@ -794,6 +992,16 @@ func (tsv *TabletServer) StreamExecute(ctx context.Context, target *querypb.Targ
 			if err != nil {
 				return err
 			}
+			// Streams are only bounded by the timeouts configured for
+			// their tables or plan type, not by the query timeout.
+			if timeout, ok := tsv.configuredTimeout(ctx, plan, bindVariables); ok {
+				if options.GetWorkload() != querypb.ExecuteOptions_DBA && !tabletenv.IsLocalContext(ctx) {
+					logStats.QueryTimeout = timeout
+				}
+				var cancel context.CancelFunc
+				ctx, cancel = withTimeout(ctx, timeout, options)
+				defer cancel()
+			}
 			qre := &QueryExecutor{
 				query:          query,
 				marginComments: comments,
@@ -1074,24 +1282,50 @@ func (tsv *TabletServer) PostponeMessages(ctx context.Context, target *querypb.T
 	})
 }
 
+// DeadLetterMessages marks the list of messages for a given message table
+// as dead, so that they're not sent again.
+// It returns the number of messages successfully dead-lettered.
+func (tsv *TabletServer) DeadLetterMessages(ctx context.Context, target *querypb.Target, name string, ids []string) (count int64, err error) {
+	return tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
+		return tsv.messager.GenerateDeadLetterQuery(name, ids)
+	})
+}
+
 // PurgeMessages purges messages older than specified time in Unix Nanoseconds.
-// It purges at most 500 messages. It returns the number of messages successfully purged.
+// If the table archives its messages, they're copied to the archive table
+// in the same transaction. It purges at most 500 messages.
+// It returns the number of messages successfully purged.
 func (tsv *TabletServer) PurgeMessages(ctx context.Context, target *querypb.Target, name string, timeCutoff int64) (count int64, err error) {
 	return tsv.execDML(ctx, target, func() (string, map[string]*querypb.BindVariable, error) {
+		return tsv.messager.GenerateArchiveQuery(name, timeCutoff)
+	}, func() (string, map[string]*querypb.BindVariable, error) {
 		return tsv.messager.GeneratePurgeQuery(name, timeCutoff)
 	})
 }
 
-func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerator func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
+// execDML executes the generated queries in a single transaction,
+// skipping the empty ones. It returns the rows affected by the last query.
+func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, queryGenerators ...func() (string, map[string]*querypb.BindVariable, error)) (count int64, err error) {
 	if err = tsv.sm.StartRequest(ctx, target, false /* allowOnShutdown */); err != nil {
 		return 0, err
 	}
 	defer tsv.sm.EndRequest()
 	defer tsv.handlePanicAndSendLogStats("ack", nil, nil)
 
-	query, bv, err := queryGenerator()
-	if err != nil {
-		return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+	type queryWithBindVars struct {
+		query string
+		bv    map[string]*querypb.BindVariable
+	}
+	var queries []queryWithBindVars
+	for _, queryGenerator := range queryGenerators {
+		query, bv, err := queryGenerator()
+		if err != nil {
+			return 0, vterrors.Errorf(vtrpcpb.Code_INVALID_ARGUMENT, "%v", err)
+		}
+		if query == "" {
+			continue
+		}
+		queries = append(queries, queryWithBindVars{query: query, bv: bv})
 	}
 
 	transactionID, _, err := tsv.Begin(ctx, target, nil)
@@ -1105,9 +1339,12 @@ func (tsv *TabletServer) execDML(ctx context.Context, target *querypb.Target, qu
 			tsv.Rollback(ctx, target, transactionID)
 		}
 	}()
-	qr, err := tsv.Execute(ctx, target, query, bv, transactionID, 0, nil)
-	if err != nil {
-		return 0, err
+	var qr *sqltypes.Result
+	for _, q := range queries {
+		qr, err = tsv.Execute(ctx, target, q.query, q.bv, transactionID, 0, nil)
+		if err != nil {
+			return 0, err
+		}
 	}
 	if _, err = tsv.Commit(ctx, target, transactionID); err != nil {
 		transactionID = 0
@@ -1266,6 +1503,7 @@ func (tsv *TabletServer) execRequest(
 		cancel()
 		tsv.sm.EndRequest()
 	}()
+	ctx = withPriority(ctx, options)
 
 	err = exec(ctx, logStats)
 	if err != nil {
@@ -1306,8 +1544,14 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 		return nil
 	}
 
+	// The error is rebuilt below: keep whether its writes were applied,
+	// for vterrors.IsSafeToRetryWrites.
+	applied := vterrors.WritesApplied(err)
 	errCode := convertErrorCode(err)
 	tsv.stats.ErrorCounters.Add(errCode.String(), 1)
+	if logStats != nil && logStats.Workload != "" {
+		tsv.stats.WorkloadErrors.Add([]string{logStats.Workload, errCode.String()}, 1)
+	}
 
 	callerID := ""
 	cid := callerid.ImmediateCallerIDFromContext(ctx)
@@ -1372,11 +1616,43 @@ func (tsv *TabletServer) convertAndLogError(ctx context.Context, sql string, bin
 		logMethod(message)
 	}
 
+	// The query logs keep the full error, only the error returned to
+	// the client is sanitized.
+	clientErr := tsv.errorSanitizer.sanitize(err, sql, bindVariables, sqlErr)
+	details := tsv.errorDetails(sql, sqlErr, logStats)
+	decorate := func(err error) error {
+		err = vterrors.WithDetails(err, details)
+		if applied != vterrors.AppliedUnknown {
+			err = vterrors.WithApplied(err, applied)
+		}
+		return err
+	}
 	if logStats != nil {
-		logStats.Error = err
+		logStats.Error = decorate(err)
 	}
 
-	return err
+	return decorate(clientErr)
+}
+
+// errorDetails returns the details attached to the errors returned to
+// the clients, so that they do not have to parse them from the message.
+func (tsv *TabletServer) errorDetails(sql string, sqlErr *mysql.SQLError, logStats *tabletenv.LogStats) map[string]string {
+	details := map[string]string{
+		vterrors.DetailTabletAlias: topoproto.TabletAliasString(&tsv.alias),
+	}
+	if sql != "" {
+		details[vterrors.DetailQueryDigest] = sqlparser.Digest(sql)
+	}
+	if logStats != nil && logStats.Target != nil {
+		details[vterrors.DetailKeyspace] = logStats.Target.Keyspace
+		details[vterrors.DetailShard] = logStats.Target.Shard
+		details[vterrors.DetailTabletType] = topoproto.TabletTypeLString(logStats.Target.TabletType)
+	}
+	if sqlErr != nil {
+		details[vterrors.DetailErrno] = strconv.Itoa(sqlErr.Number())
+		details[vterrors.DetailSQLState] = sqlErr.SQLState()
+	}
+	return details
 }
 
 // truncateSQLAndBindVars calls TruncateForLog which:
@@ -1421,7 +1697,7 @@ func convertErrorCode(err error) vtrpcpb.Code {
 	case mysql.ERDiskFull, mysql.EROutOfMemory, mysql.EROutOfSortMemory, mysql.ERConCount, mysql.EROutOfResources, mysql.ERRecordFileFull, mysql.ERHostIsBlocked,
 		mysql.ERCantCreateThread, mysql.ERTooManyDelayedThreads, mysql.ERNetPacketTooLarge, mysql.ERTooManyUserConnections, mysql.ERLockTableFull, mysql.ERUserLimitReached, mysql.ERVitessMaxRowsExceeded:
 		errCode = vtrpcpb.Code_RESOURCE_EXHAUSTED
-	case mysql.ERLockWaitTimeout:
+	case mysql.ERLockWaitTimeout, mysql.ERQueryTimeout:
 		errCode = vtrpcpb.Code_DEADLINE_EXCEEDED
 	case mysql.CRServerGone, mysql.ERServerShutdown:
 		errCode = vtrpcpb.Code_UNAVAILABLE
@@ -1583,6 +1859,92 @@ func (tsv *TabletServer) registerQueryListHandlers(queryLists []*QueryList) {
 	tsv.exporter.HandleFunc("/livequeryz/terminate", func(w http.ResponseWriter, r *http.Request) {
 		livequeryzTerminateHandler(queryLists, w, r)
 	})
+	tsv.exporter.HandleFunc("/livequeryz/kill", func(w http.ResponseWriter, r *http.Request) {
+		livequeryzKillHandler(tsv, w, r)
+	})
+}
+
+func (tsv *TabletServer) registerSequenceHandlers() {
+	tsv.exporter.HandleFunc("/debug/sequences/reserve", func(w http.ResponseWriter, r *http.Request) {
+		sequenceReserveHandler(tsv, w, r)
+	})
+}
+
+// LiveQueries returns the queries which are currently executing, sorted by
+// start time. Queries which started at the same time are sorted by
+// connection ID.
+func (tsv *TabletServer) LiveQueries() []QueryDetailzRow {
+	var rows []QueryDetailzRow
+	for _, ql := range []*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql} {
+		rows = ql.AppendQueryzRows(rows)
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].Start.Equal(rows[j].Start) {
+			return rows[i].Start.Before(rows[j].Start)
+		}
+		return rows[i].ConnID < rows[j].ConnID
+	})
+	return rows
+}
+
+// KillQuery kills the query executing on the MySQL connection connID.
+// It returns false if there is no such query.
+func (tsv *TabletServer) KillQuery(connID int64) bool {
+	for _, ql := range []*QueryList{tsv.statelessql, tsv.statefulql, tsv.olapql} {
+		if ql.Terminate(connID) {
+			return true
+		}
+	}
+	return false
+}
+
+// DrainConnPools replaces all the MySQL connections of the query and
+// transaction pools, e.g. after the MySQL credentials were rotated. The
+// idle connections are reopened now, the ones in use when they're
+// returned to their pool.
+func (tsv *TabletServer) DrainConnPools() {
+	tsv.qe.conns.Drain()
+	tsv.qe.streamConns.Drain()
+	tsv.te.txPool.scp.Drain()
+}
+
+// Transactions returns the open transactions, sorted by id.
+func (tsv *TabletServer) Transactions() []tx.Info {
+	return tsv.te.txPool.Transactions()
+}
+
+// KillTransaction terminates a transaction. If one of its queries is
+// executing, its MySQL connection is killed, which fails the transaction.
+// Otherwise the transaction is rolled back.
+func (tsv *TabletServer) KillTransaction(transactionID int64) error {
+	if tsv.statefulql.TerminateTransaction(transactionID) {
+		return nil
+	}
+	_, err := tsv.te.txFinish(transactionID, tx.TxKill, func(conn *StatefulConnection) error {
+		return tsv.te.txPool.Rollback(tabletenv.LocalContext(), conn)
+	})
+	if err != nil {
+		return err
+	}
+	tsv.stats.KillCounters.Add("Transactions", 1)
+	return nil
+}
+
+// BlockDigest fails the queries with the digest for ttl. See sqlparser.Digest.
+// Blocking a blocked digest again replaces its reason and expiry.
+func (tsv *TabletServer) BlockDigest(ctx context.Context, digest, reason string, ttl time.Duration) error {
+	return tsv.digests.block(ctx, digest, reason, ttl)
+}
+
+// UnblockDigest unblocks the queries with the digest. It returns false if
+// the digest was not blocked.
+func (tsv *TabletServer) UnblockDigest(ctx context.Context, digest string) (bool, error) {
+	return tsv.digests.unblock(ctx, digest)
+}
+
+// BlockedDigests returns the blocked query digests.
+func (tsv *TabletServer) BlockedDigests() []BlockedDigest {
+	return tsv.digests.list()
 }
 
 func (tsv *TabletServer) registerTwopczHandler() {
@@ -1625,6 +1987,7 @@ func (tsv *TabletServer) registerThrottlerCheckHandlers() {
 			}
 			flags := &throttle.CheckFlags{
 				LowPriority: (r.URL.Query().Get("p") == "low"),
+				Table:       r.URL.Query().Get("table"),
 			}
 			checkResult := tsv.lagThrottler.CheckByType(ctx, appName, remoteAddr, flags, checkType)
 			if checkResult.StatusCode == http.StatusNotFound && flags.OKIfNotExists {
@@ -1677,11 +2040,49 @@ func (tsv *TabletServer) registerThrottlerThrottleAppHandler() {
 	})
 }
 
+// registerThrottlerGrantBudgetHandler registers a throttler "grant-budget" request
+func (tsv *TabletServer) registerThrottlerGrantBudgetHandler() {
+	tsv.exporter.HandleFunc("/throttler/grant-budget", func(w http.ResponseWriter, r *http.Request) {
+		appName := r.URL.Query().Get("app")
+		d, err := time.ParseDuration(r.URL.Query().Get("duration"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+			return
+		}
+		threshold, err := throttle.ParseThrottleThreshold(r.URL.Query().Get("threshold"))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+			return
+		}
+		var qps float64
+		if qpsParam := r.URL.Query().Get("qps"); qpsParam != "" {
+			if qps, err = strconv.ParseFloat(qpsParam, 64); err != nil {
+				http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+				return
+			}
+		}
+		budget, err := tsv.lagThrottler.GrantAppBudget(appName, time.Now().Add(d), threshold, qps)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("not ok: %v", err), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(budget)
+	})
+	tsv.exporter.HandleFunc("/throttler/revoke-budget", func(w http.ResponseWriter, r *http.Request) {
+		appName := r.URL.Query().Get("app")
+		tsv.lagThrottler.RevokeAppBudget(appName)
+		w.Write([]byte("ok"))
+	})
+}
+
 // registerThrottlerHandlers registers all throttler handlers
 func (tsv *TabletServer) registerThrottlerHandlers() {
 	tsv.registerThrottlerCheckHandlers()
 	tsv.registerThrottlerStatusHandler()
 	tsv.registerThrottlerThrottleAppHandler()
+	tsv.registerThrottlerGrantBudgetHandler()
 }
 
 func (tsv *TabletServer) registerDebugEnvHandler() {
@@ -1690,6 +2091,21 @@ func (tsv *TabletServer) registerDebugEnvHandler() {
 	})
 }
 
+func (tsv *TabletServer) registerDrainConnPoolsHandler() {
+	tsv.exporter.HandleFunc("/debug/drain_conn_pools", func(w http.ResponseWriter, r *http.Request) {
+		if err := acl.CheckAccessHTTP(r, acl.ADMIN); err != nil {
+			acl.SendError(w, err)
+			return
+		}
+		if r.Method != http.MethodPost {
+			http.Error(w, "drain_conn_pools requires a POST", http.StatusMethodNotAllowed)
+			return
+		}
+		tsv.DrainConnPools()
+		w.Write([]byte("ok"))
+	})
+}
+
 // EnableHeartbeat forces heartbeat to be on or off.
 // Only to be used for testing.
 func (tsv *TabletServer) EnableHeartbeat(enabled bool) {
@@ -1847,6 +2263,18 @@ func withTimeout(ctx context.Context, timeout time.Duration, options *querypb.Ex
 	return context.WithTimeout(ctx, timeout)
 }
 
+// withPriority returns a context with the conn pool priority of the
+// workload: OLAP queries and transactions wait for connections of the
+// query and transaction pools after the OLTP ones. The other requests
+// keep the priority of their context, like the background one of the
+// messager purge.
+func withPriority(ctx context.Context, options *querypb.ExecuteOptions) context.Context {
+	if options.GetWorkload() == querypb.ExecuteOptions_OLAP {
+		return pools.WithPriority(ctx, pools.PriorityOLAP)
+	}
+	return ctx
+}
+
 // skipQueryPlanCache returns true if the query plan should be cached
 func skipQueryPlanCache(options *querypb.ExecuteOptions) bool {
 	if options == nil {
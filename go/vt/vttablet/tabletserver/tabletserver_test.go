@@ -23,6 +23,7 @@ import (
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"path"
 	"reflect"
 	"strings"
 	"sync"
@@ -42,16 +43,20 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/pools"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/streamlog"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/tableacl"
 	"vitess.io/vitess/go/vt/tableacl/simpleacl"
 	"vitess.io/vitess/go/vt/topo/memorytopo"
 	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
+	querylogpb "vitess.io/vitess/go/vt/proto/querylog"
 	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
 	vtrpcpb "vitess.io/vitess/go/vt/proto/vtrpc"
 )
@@ -159,7 +164,7 @@ func TestTabletServerRedoLogIsKeptBetweenRestarts(t *testing.T) {
 	})
 	turnOnTxEngine()
 	assert.EqualValues(t, 1, len(tsv.te.preparedPool.conns), "len(tsv.te.preparedPool.conns)")
-	got := tsv.te.preparedPool.conns["dtid0"].TxProperties().Queries
+	got := tsv.te.preparedPool.conns["dtid0"].TxProperties().Queries.Statements()
 	want := []string{"update test_table set `name` = 2 where pk = 1 limit 10001"}
 	utils.MustMatch(t, want, got, "Prepared queries")
 	turnOffTxEngine()
@@ -193,7 +198,7 @@ func TestTabletServerRedoLogIsKeptBetweenRestarts(t *testing.T) {
 	})
 	turnOnTxEngine()
 	assert.EqualValues(t, 1, len(tsv.te.preparedPool.conns), "len(tsv.te.preparedPool.conns)")
-	got = tsv.te.preparedPool.conns["a:b:10"].TxProperties().Queries
+	got = tsv.te.preparedPool.conns["a:b:10"].TxProperties().Queries.Statements()
 	want = []string{"update test_table set `name` = 2 where pk = 1 limit 10001"}
 	utils.MustMatch(t, want, got, "Prepared queries")
 	wantFailed := map[string]error{"a:b:20": errPrepFailed}
@@ -402,6 +407,72 @@ func TestTabletServerCommitTransaction(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTabletServerCommitTransactionBinaryLog(t *testing.T) {
+	*streamlog.QueryLogFileFormat = streamlog.QueryLogFormatBinary
+	defer func() {
+		*streamlog.QueryLogFileFormat = ""
+	}()
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	dir, err := ioutil.TempDir("", "tabletserver_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	logPath := path.Join(dir, "tx.log")
+	logChan, err := tabletenv.TxLogger.LogToFile(logPath, streamlog.GetFormatter(tabletenv.TxLogger))
+	require.NoError(t, err)
+	defer tabletenv.TxLogger.Unsubscribe(logChan)
+
+	executeSQL := "update test_table set name_string = 'a' where pk = 1"
+	db.AddQuery(executeSQL, &sqltypes.Result{})
+	tsv.SetPassthroughDMLs(true)
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	transactionID, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, transactionID, 0, nil)
+	require.NoError(t, err)
+	_, err = tsv.Commit(ctx, &target, transactionID)
+	require.NoError(t, err)
+	// The connection goes on with another transaction while the first one
+	// is being logged.
+	transactionID2, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	_, err = tsv.Rollback(ctx, &target, transactionID2)
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		time.Sleep(10 * time.Millisecond)
+
+		reader, err := streamlog.OpenBinaryLog(logPath)
+		require.NoError(t, err)
+		var got []*querylogpb.Transaction
+		for {
+			record := &querylogpb.Transaction{}
+			if err = reader.Next(record); err != nil {
+				break
+			}
+			got = append(got, record)
+		}
+		reader.Close()
+		require.Equal(t, io.EOF, err)
+		if len(got) < 2 && i < 9 {
+			continue
+		}
+		require.Len(t, got, 2)
+		assert.EqualValues(t, transactionID, got[0].ConnId)
+		assert.Equal(t, "commit", got[0].Conclusion)
+		assert.Equal(t, []string{executeSQL}, got[0].Queries)
+		assert.NotZero(t, got[0].StartTime)
+		assert.GreaterOrEqual(t, got[0].EndTime, got[0].StartTime)
+		assert.EqualValues(t, transactionID2, got[1].ConnId)
+		assert.Equal(t, "rollback", got[1].Conclusion)
+		assert.Empty(t, got[1].Queries)
+		return
+	}
+}
+
 func TestTabletServerCommiRollbacktFail(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -637,6 +708,100 @@ func TestTabletServerRollbackPrepared(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestTabletServerSetQueryRulesScope(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "ks1")
+	defer tsv.StopService()
+	defer db.Close()
+
+	qrs := rules.New()
+	require.NoError(t, qrs.UnmarshalJSON([]byte(`[
+		{"Name": "r1", "Keyspaces": ["ks1"]},
+		{"Name": "r2", "Keyspaces": ["ks2"]},
+		{"Name": "r3", "Keyspaces": ["ks1"], "Shards": ["-80"]},
+		{"Name": "r4", "Shards": [""]},
+		{"Name": "r5", "TabletTypes": ["master"]},
+		{"Name": "r6", "Keyspaces": ["ks1"], "TabletTypes": ["replica", "rdonly"]}
+	]`)))
+	tsv.RegisterQueryRuleSource("test")
+	defer tsv.UnRegisterQueryRuleSource("test")
+	require.NoError(t, tsv.SetQueryRules("test", qrs))
+
+	ruleNames := func() []string {
+		got, err := tsv.qe.queryRuleSources.Get("test")
+		require.NoError(t, err)
+		var names []string
+		for _, qr := range got.CopyUnderlying() {
+			names = append(names, qr.Name)
+		}
+		return names
+	}
+	assert.Equal(t, []string{"r1", "r4", "r5"}, ruleNames())
+
+	// The rules are scoped again when the tablet type changes.
+	require.NoError(t, tsv.SetServingType(topodatapb.TabletType_REPLICA, time.Time{}, true, ""))
+	assert.Equal(t, []string{"r1", "r4", "r6"}, ruleNames())
+
+	require.NoError(t, tsv.SetServingType(topodatapb.TabletType_MASTER, time.Time{}, true, ""))
+	assert.Equal(t, []string{"r1", "r4", "r5"}, ruleNames())
+}
+
+func TestTabletServerQueryTimeout(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	tsv.QueryTimeout.Set(10 * time.Second)
+	tsv.config.QueryTimeouts = tabletenv.QueryTimeoutsConfig{
+		Tables: map[string]tabletenv.Seconds{"test_table": 5, "other_table": 0},
+		Plans:  map[string]tabletenv.Seconds{"Select": 30, "Delete": 2},
+	}
+	qrs := rules.New()
+	require.NoError(t, qrs.UnmarshalJSON([]byte(`[
+		{"Name": "r1", "Query": ".*slow.*", "QueryTimeout": 60},
+		{"Name": "r2", "Query": ".*slow.*", "QueryTimeout": 1.5, "BindVarConds": [{"Name": "a", "OnAbsent": false, "Operator": ""}]}
+	]`)))
+	tsv.RegisterQueryRuleSource("test")
+	defer tsv.UnRegisterQueryRuleSource("test")
+	require.NoError(t, tsv.SetQueryRules("test", qrs))
+
+	testcases := []struct {
+		sql      string
+		bindVars map[string]*querypb.BindVariable
+		want     time.Duration
+	}{{
+		sql:  "select * from test_table",
+		want: 5 * time.Second,
+	}, {
+		sql:  "select * from test_table join other_table",
+		want: 5 * time.Second,
+	}, {
+		sql:  "select * from other_table",
+		want: 0,
+	}, {
+		sql:  "select * from t",
+		want: 30 * time.Second,
+	}, {
+		sql:  "delete from t",
+		want: 2 * time.Second,
+	}, {
+		sql:  "update t set a = 1",
+		want: 10 * time.Second,
+	}, {
+		sql:  "select * from test_table where slow = 1",
+		want: 60 * time.Second,
+	}, {
+		sql:      "select * from test_table where slow = :a",
+		bindVars: map[string]*querypb.BindVariable{"a": sqltypes.Int64BindVariable(1)},
+		want:     1500 * time.Millisecond,
+	}}
+	for _, tcase := range testcases {
+		logStats := tabletenv.NewLogStats(ctx, "TestQueryTimeout")
+		plan, err := tsv.qe.GetPlan(ctx, logStats, tcase.sql, true, false)
+		require.NoError(t, err, tcase.sql)
+		assert.Equal(t, tcase.want, tsv.queryTimeout(ctx, plan, tcase.bindVars), tcase.sql)
+	}
+}
+
 func TestTabletServerStreamExecute(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -661,6 +826,120 @@ func TestTabletServerStreamExecute(t *testing.T) {
 	}
 }
 
+func TestTabletServerStreamExecuteTimeout(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000"
+	db.AddQuery(executeSQL, &sqltypes.Result{
+		Fields: []*querypb.Field{{Type: sqltypes.VarBinary}},
+		Rows:   [][]sqltypes.Value{{sqltypes.NewVarBinary("row01")}},
+	})
+	db.SetQueryDelay(executeSQL, 200*time.Millisecond)
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	callback := func(*sqltypes.Result) error { return nil }
+
+	// Streams are not bounded by the query timeout.
+	tsv.QueryTimeout.Set(10 * time.Millisecond)
+	err := tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, nil, callback)
+	require.NoError(t, err)
+
+	// They are bounded by the timeouts configured for their tables.
+	tsv.config.QueryTimeouts = tabletenv.QueryTimeoutsConfig{
+		Tables: map[string]tabletenv.Seconds{"test_table": 0.05},
+	}
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, nil, callback)
+	require.Error(t, err)
+}
+
+func TestTabletServerStreamExecuteMemoryAdmission(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000"
+	executeSQLResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarBinary},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarBinary("row01")},
+		},
+	}
+	db.AddQuery(executeSQL, executeSQLResult)
+	qm := tsv.qe.queryMemory
+	qm.threshold = 1
+	admissions := qm.admissions.Counts()
+
+	// The packets are accounted for while they are sent.
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	var held int64
+	err := tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, nil, func(*sqltypes.Result) error {
+		if v := qm.inFlight.Get(); v > held {
+			held = v
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.NotZero(t, held)
+	assert.Zero(t, qm.inFlight.Get())
+
+	// Streaming queries are rejected while the memory is above the threshold.
+	tracker := qm.newTracker(nil, 0)
+	tracker.add(executeSQLResult)
+	err = tsv.StreamExecute(ctx, &target, executeSQL, nil, 0, nil, func(*sqltypes.Result) error { return nil })
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	tracker.close()
+	assert.EqualValues(t, 1, qm.admissions.Counts()["Admitted"]-admissions["Admitted"])
+	assert.EqualValues(t, 1, qm.admissions.Counts()["Rejected"]-admissions["Rejected"])
+}
+
+func TestTabletServerExecuteMemoryHeldUntilSent(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	executeSQL := "select * from test_table limit 1000"
+	executeSQLResult := &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarBinary},
+		},
+		Rows: [][]sqltypes.Value{
+			{sqltypes.NewVarBinary("row01")},
+			{sqltypes.NewVarBinary("row02")},
+		},
+	}
+	db.AddQuery(executeSQL, executeSQLResult)
+	qm := tsv.qe.queryMemory
+	qm.threshold = 1 << 20
+
+	// The result read from MySQL stays accounted for until its response is sent.
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	respCtx, resp := tabletenv.ResponseContext(ctx)
+	qr, err := tsv.Execute(respCtx, &target, executeSQL, nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Len(t, qr.Rows, 2)
+	assert.NotZero(t, qm.inFlight.Get())
+	resp.Sent()
+	assert.Zero(t, qm.inFlight.Get())
+
+	// Without a response to wait for, it is released once the query is done.
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+	require.NoError(t, err)
+	assert.Zero(t, qm.inFlight.Get())
+
+	// The row limit still applies to the result read in streaming mode.
+	tsv.SetMaxResultSize(1)
+	_, err = tsv.Execute(ctx, &target, executeSQL, nil, 0, 0, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Row count exceeded 1")
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), fmt.Sprintf("(errno %d)", mysql.ERVitessMaxRowsExceeded))
+	assert.Zero(t, qm.inFlight.Get())
+
+}
+
 func TestTabletServerStreamExecuteComments(t *testing.T) {
 	db, tsv := setupTabletServerTest(t, "")
 	defer tsv.StopService()
@@ -991,6 +1270,96 @@ func TestSerializeTransactionsSameRow(t *testing.T) {
 	}
 }
 
+func TestComputeTxSerializerKey(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	bv := map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1)}
+	testcases := []struct {
+		sql       string
+		wantKey   string
+		wantTable string
+	}{{
+		sql:       "update test_table set name_string = 'a' where pk = :pk",
+		wantKey:   "test_table where pk = 1",
+		wantTable: "test_table",
+	}, {
+		sql:       "update test_table set name_string = 'a' where pk = :pk limit 1",
+		wantKey:   "test_table where pk = 1",
+		wantTable: "test_table",
+	}, {
+		sql:       "delete from test_table where pk = :pk",
+		wantKey:   "test_table where pk = 1",
+		wantTable: "test_table",
+	}, {
+		sql:       "delete from test_table where pk = :pk limit 1",
+		wantKey:   "test_table where pk = 1",
+		wantTable: "test_table",
+	}, {
+		sql: "delete from test_table",
+	}, {
+		sql: "select * from test_table where pk = :pk",
+	}}
+	for _, tcase := range testcases {
+		logStats := tabletenv.NewLogStats(ctx, "TestComputeTxSerializerKey")
+		key, table := tsv.computeTxSerializerKey(ctx, logStats, tcase.sql, bv)
+		assert.Equal(t, tcase.wantKey, key, tcase.sql)
+		assert.Equal(t, tcase.wantTable, table, tcase.sql)
+	}
+}
+
+// TestSerializeTransactionsSameRowMaxWait verifies that DELETE and
+// UPDATE ... LIMIT queries for the same row are serialized, and that a
+// transaction which is queued for longer than the max wait of its table is
+// rejected.
+func TestSerializeTransactionsSameRowMaxWait(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.Mode = tabletenv.Enable
+	config.HotRowProtection.MaxConcurrency = 1
+	config.HotRowProtection.MaxWaitSecondsPerTable = map[string]tabletenv.Seconds{"test_table": 0.01}
+	config.TxPool.Size = 2
+	db, tsv := setupTabletServerTestCustom(t, config, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	q1 := "delete from test_table where pk = :pk"
+	q2 := "update test_table set name_string = 'tx2' where pk = :pk limit 1"
+
+	// tx1 stays in its BeginExecute() until tx2 was rejected.
+	tx1Started := make(chan struct{})
+	tx2Done := make(chan struct{})
+	db.AddQuery("delete from test_table where pk = 1 limit 10001", &sqltypes.Result{})
+	db.SetBeforeFunc("delete from test_table where pk = 1 limit 10001",
+		func() {
+			close(tx1Started)
+			<-tx2Done
+		})
+
+	wg := sync.WaitGroup{}
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		_, tx1, _, err := tsv.BeginExecute(ctx, &target, nil, q1, map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1)}, 0, nil)
+		if err != nil {
+			t.Errorf("failed to execute query: %s: %s", q1, err)
+		}
+		if _, err := tsv.Commit(ctx, &target, tx1); err != nil {
+			t.Errorf("call TabletServer.Commit failed: %v", err)
+		}
+	}()
+
+	<-tx1Started
+	_, _, _, err := tsv.BeginExecute(ctx, &target, nil, q2, map[string]*querypb.BindVariable{"pk": sqltypes.Int64BindVariable(1)}, 0, nil)
+	close(tx2Done)
+	wg.Wait()
+	require.Error(t, err)
+	assert.Equal(t, vtrpcpb.Code_RESOURCE_EXHAUSTED, vterrors.Code(err))
+	assert.Contains(t, err.Error(), "hot row protection: queued for longer than 10ms for the same row (table + WHERE clause: 'test_table where pk = 1')")
+}
+
 func TestDMLQueryWithoutWhereClause(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.Mode = tabletenv.Enable
@@ -1636,6 +2005,23 @@ func TestRescheduleMessages(t *testing.T) {
 	}
 }
 
+func TestDeadLetterMessages(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+
+	_, err := tsv.DeadLetterMessages(ctx, &target, "nonmsg", []string{"1", "2"})
+	want := "message table nonmsg not found in schema"
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), want)
+
+	db.AddQueryPattern("update msg set time_acked = [0-9]+, time_next = null where id in \\('1', '2'\\) and time_acked is null limit 10001", &sqltypes.Result{RowsAffected: 2})
+	count, err := tsv.DeadLetterMessages(ctx, &target, "msg", []string{"1", "2"})
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+}
+
 func TestPurgeMessages(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -1864,6 +2250,79 @@ func TestTerseErrorsIgnoreFailoverInProgress(t *testing.T) {
 	require.Empty(t, tl.logs, "unexpected error log during failover")
 }
 
+func TestSanitizeErrors(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.ErrorSanitization.Enable = true
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	tl := newTestLogger()
+	defer tl.Close()
+
+	logStats := tabletenv.NewLogStats(ctx, "TestSanitizeErrors")
+	err := tsv.convertAndLogError(ctx, "insert into test_table(name) values (:name)",
+		map[string]*querypb.BindVariable{"name": sqltypes.StringBindVariable("secret")},
+		mysql.NewSQLError(mysql.ERDupEntry, mysql.SSConstraintViolation, "Duplicate entry 'secret' for key 'name'"),
+		logStats,
+	)
+	assert.EqualError(t, err, "Duplicate entry '[REDACTED]' for key '[REDACTED]' (errno 1062) (sqlstate 23000)")
+	assert.Equal(t, vtrpcpb.Code_ALREADY_EXISTS, vterrors.Code(err))
+	assert.Contains(t, logStats.Error.Error(), "Duplicate entry 'secret' for key 'name'")
+	assert.Contains(t, logStats.Error.Error(), "BindVars: {name: ")
+}
+
+func TestConvertErrorKeepsApplied(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{})
+	err := tsv.convertAndLogError(ctx, "select * from test_table", nil,
+		vterrors.WithApplied(vterrors.Errorf(vtrpcpb.Code_DEADLINE_EXCEEDED, "resource pool timed out"), vterrors.NotApplied),
+		nil,
+	)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+	assert.Equal(t, vterrors.NotApplied, vterrors.WritesApplied(err))
+	assert.True(t, vterrors.IsSafeToRetryWrites(err))
+
+	err = tsv.convertAndLogError(ctx, "update test_table set name = 2", nil,
+		mysql.NewSQLError(mysql.CRServerLost, mysql.SSUnknownSQLState, "Lost connection to MySQL server during query"),
+		nil,
+	)
+	assert.Equal(t, vterrors.AppliedUnknown, vterrors.WritesApplied(err))
+	assert.True(t, vterrors.IsRetryable(err))
+	assert.False(t, vterrors.IsSafeToRetryWrites(err))
+
+	// A query stopped by its MAX_EXECUTION_TIME ran out of time.
+	err = tsv.convertAndLogError(ctx, "select * from test_table", nil,
+		mysql.NewSQLError(mysql.ERQueryTimeout, mysql.SSUnknownSQLState, "Query execution was interrupted, maximum statement execution time exceeded"),
+		nil,
+	)
+	assert.Equal(t, vtrpcpb.Code_DEADLINE_EXCEEDED, vterrors.Code(err))
+}
+
+func TestConvertErrorDetails(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	tsv := NewTabletServer("TabletServerTest", config, memorytopo.NewServer(""), topodatapb.TabletAlias{Cell: "cell1", Uid: 100})
+	logStats := tabletenv.NewLogStats(ctx, "Execute")
+	logStats.Target = &querypb.Target{Keyspace: "ks", Shard: "-80", TabletType: topodatapb.TabletType_MASTER}
+	sql := "insert into test_table values (1)"
+	err := tsv.convertAndLogError(ctx, sql, nil,
+		mysql.NewSQLError(mysql.ERDupEntry, mysql.SSConstraintViolation, "Duplicate entry '1' for key 'PRIMARY'"),
+		logStats,
+	)
+	assert.Equal(t, map[string]string{
+		vterrors.DetailTabletAlias: "cell1-0000000100",
+		vterrors.DetailQueryDigest: sqlparser.Digest(sql),
+		vterrors.DetailKeyspace:    "ks",
+		vterrors.DetailShard:       "-80",
+		vterrors.DetailTabletType:  "master",
+		vterrors.DetailErrno:       "1062",
+		vterrors.DetailSQLState:    "23000",
+	}, vterrors.Details(err))
+	assert.Equal(t, vterrors.Details(err), vterrors.Details(vterrors.FromGRPC(vterrors.ToGRPC(err))))
+
+	err = tsv.convertAndLogError(ctx, "", nil, vterrors.Errorf(vtrpcpb.Code_INTERNAL, "tablet error"), nil)
+	assert.Equal(t, map[string]string{
+		vterrors.DetailTabletAlias: "cell1-0000000100",
+	}, vterrors.Details(err))
+}
+
 var aclJSON1 = `{
   "table_groups": [
     {
@@ -2607,6 +3066,41 @@ func getSupportedQueries() map[string]*sqltypes.Result {
 	}
 }
 
+func TestDrainConnPools(t *testing.T) {
+	db, tsv := setupTabletServerTest(t, "")
+	defer tsv.StopService()
+	defer db.Close()
+
+	conn, err := tsv.qe.conns.Get(ctx)
+	require.NoError(t, err)
+	conn.Recycle()
+	target := querypb.Target{TabletType: topodatapb.TabletType_MASTER}
+	transactionID, _, err := tsv.Begin(ctx, &target, nil)
+	require.NoError(t, err)
+	_, err = tsv.Commit(ctx, &target, transactionID)
+	require.NoError(t, err)
+	conns, txConns := tsv.qe.conns.Active(), tsv.te.txPool.scp.conns.Active()
+	require.NotZero(t, conns)
+	require.NotZero(t, txConns)
+
+	// All the connections are idle, they are all replaced.
+	tsv.DrainConnPools()
+	assert.Equal(t, conns, tsv.qe.conns.LifetimeClosed())
+	assert.Equal(t, txConns, tsv.te.txPool.scp.conns.LifetimeClosed())
+	assert.Equal(t, conns, tsv.qe.conns.Active())
+}
+
+func TestWithPriority(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, pools.PriorityOLTP, pools.PriorityFromContext(withPriority(ctx, nil)))
+	olap := &querypb.ExecuteOptions{Workload: querypb.ExecuteOptions_OLAP}
+	assert.Equal(t, pools.PriorityOLAP, pools.PriorityFromContext(withPriority(ctx, olap)))
+
+	// The priority of the context is kept.
+	ctx = pools.WithPriority(ctx, pools.PriorityBackground)
+	assert.Equal(t, pools.PriorityBackground, pools.PriorityFromContext(withPriority(ctx, nil)))
+}
+
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
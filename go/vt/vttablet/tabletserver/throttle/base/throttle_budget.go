@@ -0,0 +1,43 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"time"
+)
+
+// ThrottleBudget is the definition of the throttle budget of an app or a table
+// - Threshold: replaces the metric threshold, 0 == default threshold
+// - QPS: max rate of successful checks, 0 == no limit
+// - ExpireAt: end of a temporarily granted budget, zero for configured budgets
+type ThrottleBudget struct {
+	Name      string
+	Threshold float64
+	QPS       float64
+	ExpireAt  time.Time
+}
+
+// NewThrottleBudget creates a ThrottleBudget struct
+func NewThrottleBudget(name string, threshold float64, qps float64, expireAt time.Time) *ThrottleBudget {
+	result := &ThrottleBudget{
+		Name:      name,
+		Threshold: threshold,
+		QPS:       qps,
+		ExpireAt:  expireAt,
+	}
+	return result
+}
@@ -24,6 +24,9 @@ type MetricResultFunc func() (metricResult MetricResult, threshold float64)
 var ErrThresholdExceeded = errors.New("Threshold exceeded")
 var errNoResultYet = errors.New("Metric not collected yet")
 
+// ErrBudgetExceeded is the error one gets when an app or a table exceeds its throttle budget rate
+var ErrBudgetExceeded = errors.New("Budget exceeded")
+
 // ErrNoSuchMetric is for when a user requests a metric by an unknown metric name
 var ErrNoSuchMetric = errors.New("No such metric")
 
@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/ratelimiter"
+	"vitess.io/vitess/go/textutil"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/throttle/base"
+)
+
+const (
+	// maxBudgetGrantDuration is the longest time a budget can be granted for
+	maxBudgetGrantDuration = 24 * time.Hour
+)
+
+var (
+	throttleAppBudgets   = flag.String("throttle_app_budgets", "", "Comma separated list of <app>:<threshold>:<qps> throttle budgets. The threshold replaces the default threshold for the checks of the app, the qps limits the rate of its successful checks. Either can be empty. example: 'online-ddl:2s:,vreplication::100'")
+	throttleTableBudgets = flag.String("throttle_table_budgets", "", "Comma separated list of <table>:<threshold>:<qps> throttle budgets, for the checks which indicate a table. The lower of the app and table thresholds applies.")
+)
+
+// budgetLimiter limits the rate of successful checks for a budget
+type budgetLimiter struct {
+	qps     float64
+	limiter *ratelimiter.RateLimiter
+}
+
+func newBudgetLimiter(qps float64) *budgetLimiter {
+	if qps >= 1 {
+		return &budgetLimiter{qps: qps, limiter: ratelimiter.NewRateLimiter(int(qps), time.Second)}
+	}
+	return &budgetLimiter{qps: qps, limiter: ratelimiter.NewRateLimiter(1, time.Duration(float64(time.Second)/qps))}
+}
+
+// throttleBudgets holds the configured budgets of apps and tables, and the
+// budgets temporarily granted to apps
+type throttleBudgets struct {
+	mu       sync.Mutex
+	apps     map[string]*base.ThrottleBudget
+	tables   map[string]*base.ThrottleBudget
+	grants   map[string]*base.ThrottleBudget
+	limiters map[string]*budgetLimiter
+}
+
+func newThrottleBudgets(appBudgets, tableBudgets string) (*throttleBudgets, error) {
+	apps, err := parseThrottleBudgets(appBudgets)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := parseThrottleBudgets(tableBudgets)
+	if err != nil {
+		return nil, err
+	}
+	return &throttleBudgets{
+		apps:     apps,
+		tables:   tables,
+		grants:   make(map[string]*base.ThrottleBudget),
+		limiters: make(map[string]*budgetLimiter),
+	}, nil
+}
+
+// parseThrottleBudgets parses a comma separated list of <name>:<threshold>:<qps>.
+// The name may itself contain colons.
+func parseThrottleBudgets(s string) (map[string]*base.ThrottleBudget, error) {
+	budgets := make(map[string]*base.ThrottleBudget)
+	for _, token := range textutil.SplitDelimitedList(s) {
+		parts := strings.Split(token, ":")
+		if len(parts) < 3 {
+			return nil, fmt.Errorf("invalid throttle budget %q, expected <name>:<threshold>:<qps>", token)
+		}
+		name := strings.Join(parts[:len(parts)-2], ":")
+		threshold, err := ParseThrottleThreshold(parts[len(parts)-2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid threshold in throttle budget %q: %v", token, err)
+		}
+		var qps float64
+		if qpsToken := parts[len(parts)-1]; qpsToken != "" {
+			if qps, err = strconv.ParseFloat(qpsToken, 64); err != nil || qps < 0 {
+				return nil, fmt.Errorf("invalid qps in throttle budget %q", token)
+			}
+		}
+		if name == "" {
+			return nil, fmt.Errorf("missing name in throttle budget %q", token)
+		}
+		budgets[name] = base.NewThrottleBudget(name, threshold, qps, time.Time{})
+	}
+	return budgets, nil
+}
+
+// ParseThrottleThreshold parses a threshold, either as a duration for lag metrics, e.g. "2s",
+// or as a number. An empty string is 0, i.e. the default threshold.
+func ParseThrottleThreshold(s string) (float64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return d.Seconds(), nil
+	}
+	threshold, err := strconv.ParseFloat(s, 64)
+	if err != nil || threshold < 0 {
+		return 0, fmt.Errorf("invalid threshold %q", s)
+	}
+	return threshold, nil
+}
+
+// appBudget returns the budget of an app: a granted budget if there's one, or else the configured one.
+// Like throttled apps, a composite "a:b" app name also matches the budgets of its components.
+// The mutex must be held.
+func (b *throttleBudgets) appBudget(appName string) *base.ThrottleBudget {
+	singleAppBudget := func(singleAppName string) *base.ThrottleBudget {
+		if grant, ok := b.grants[singleAppName]; ok {
+			if grant.ExpireAt.After(time.Now()) {
+				return grant
+			}
+			delete(b.grants, singleAppName)
+		}
+		return b.apps[singleAppName]
+	}
+	if budget := singleAppBudget(appName); budget != nil {
+		return budget
+	}
+	for _, singleAppName := range strings.Split(appName, ":") {
+		if singleAppName == "" {
+			continue
+		}
+		if budget := singleAppBudget(singleAppName); budget != nil {
+			return budget
+		}
+	}
+	return nil
+}
+
+// threshold returns the threshold for a check of an app on a table, given the default threshold
+func (b *throttleBudgets) threshold(appName, table string, threshold float64) float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if budget := b.appBudget(appName); budget != nil && budget.Threshold > 0 {
+		threshold = budget.Threshold
+	}
+	if budget, ok := b.tables[table]; ok && budget.Threshold > 0 && budget.Threshold < threshold {
+		threshold = budget.Threshold
+	}
+	return threshold
+}
+
+// allow consumes a check from the rate budgets of an app and a table, and returns false if
+// any of them is exceeded
+func (b *throttleBudgets) allow(appName, table string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	allowBudget := func(kind string, budget *base.ThrottleBudget) bool {
+		if budget == nil || budget.QPS <= 0 {
+			return true
+		}
+		key := kind + "/" + budget.Name
+		limiter, ok := b.limiters[key]
+		if !ok || limiter.qps != budget.QPS {
+			limiter = newBudgetLimiter(budget.QPS)
+			b.limiters[key] = limiter
+		}
+		return limiter.limiter.Allow()
+	}
+	if !allowBudget("app", b.appBudget(appName)) {
+		return false
+	}
+	return allowBudget("table", b.tables[table])
+}
+
+// grant temporarily gives an app a budget, which replaces its configured budget
+func (b *throttleBudgets) grant(appName string, expireAt time.Time, threshold float64, qps float64) (*base.ThrottleBudget, error) {
+	if appName == "" {
+		return nil, fmt.Errorf("no app indicated")
+	}
+	now := time.Now()
+	if !expireAt.After(now) {
+		return nil, fmt.Errorf("grant of a budget to %s must expire in the future", appName)
+	}
+	if expireAt.After(now.Add(maxBudgetGrantDuration)) {
+		return nil, fmt.Errorf("grant of a budget to %s can't last more than %v", appName, maxBudgetGrantDuration)
+	}
+	if threshold < 0 || qps < 0 {
+		return nil, fmt.Errorf("invalid budget for %s: threshold and qps must not be negative", appName)
+	}
+	budget := base.NewThrottleBudget(appName, threshold, qps, expireAt)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.grants[appName] = budget
+	return budget, nil
+}
+
+// revoke cancels the budget granted to an app, if any
+func (b *throttleBudgets) revoke(appName string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.grants, appName)
+}
+
+// snapshot returns copies of the configured and granted budgets
+func (b *throttleBudgets) snapshot() (apps, tables, grants map[string]base.ThrottleBudget) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	copyBudgets := func(budgets map[string]*base.ThrottleBudget) map[string]base.ThrottleBudget {
+		result := make(map[string]base.ThrottleBudget, len(budgets))
+		for name, budget := range budgets {
+			if !budget.ExpireAt.IsZero() && budget.ExpireAt.Before(time.Now()) {
+				continue
+			}
+			result[name] = *budget
+		}
+		return result
+	}
+	return copyBudgets(b.apps), copyBudgets(b.tables), copyBudgets(b.grants)
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseThrottleBudgets(t *testing.T) {
+	budgets, err := parseThrottleBudgets("online-ddl:2s:, vreplication::100,a:b:500ms:10, t1:0.5:")
+	require.NoError(t, err)
+	require.Len(t, budgets, 4)
+	assert.Equal(t, 2.0, budgets["online-ddl"].Threshold)
+	assert.Equal(t, 0.0, budgets["online-ddl"].QPS)
+	assert.Equal(t, 0.0, budgets["vreplication"].Threshold)
+	assert.Equal(t, 100.0, budgets["vreplication"].QPS)
+	assert.Equal(t, 0.5, budgets["a:b"].Threshold)
+	assert.Equal(t, 10.0, budgets["a:b"].QPS)
+	assert.Equal(t, 0.5, budgets["t1"].Threshold)
+
+	for _, invalid := range []string{"app", "app:1s", "app:x:", "app::x", "app::-1", ":1s:1"} {
+		_, err := parseThrottleBudgets(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestThrottleBudgetsThreshold(t *testing.T) {
+	budgets, err := newThrottleBudgets("app1:2s:,app2::10", "t1:500ms:,t2:5s:")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1.0, budgets.threshold("other", "", 1))
+	assert.Equal(t, 2.0, budgets.threshold("app1", "", 1))
+	assert.Equal(t, 2.0, budgets.threshold("vreplication:app1", "", 1))
+	assert.Equal(t, 1.0, budgets.threshold("app2", "", 1))
+	// The lower of the app and table thresholds applies.
+	assert.Equal(t, 0.5, budgets.threshold("app1", "t1", 1))
+	assert.Equal(t, 2.0, budgets.threshold("app1", "t2", 1))
+
+	_, err = budgets.grant("app1", time.Now().Add(time.Hour), 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 10.0, budgets.threshold("app1", "", 1))
+	budgets.revoke("app1")
+	assert.Equal(t, 2.0, budgets.threshold("app1", "", 1))
+
+	// Expired grants don't apply.
+	_, err = budgets.grant("app1", time.Now().Add(10*time.Millisecond), 10, 0)
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, 2.0, budgets.threshold("app1", "", 1))
+	_, _, grants := budgets.snapshot()
+	assert.Empty(t, grants)
+}
+
+func TestThrottleBudgetsAllow(t *testing.T) {
+	budgets, err := newThrottleBudgets("app1::2", "t1::3")
+	require.NoError(t, err)
+
+	for i := 0; i < 10; i++ {
+		assert.True(t, budgets.allow("other", ""))
+	}
+	assert.True(t, budgets.allow("app1", ""))
+	assert.True(t, budgets.allow("app1", ""))
+	assert.False(t, budgets.allow("app1", ""))
+
+	assert.True(t, budgets.allow("other", "t1"))
+	assert.True(t, budgets.allow("other", "t1"))
+	assert.True(t, budgets.allow("other", "t1"))
+	assert.False(t, budgets.allow("other", "t1"))
+
+	// A granted budget has its own rate.
+	_, err = budgets.grant("app1", time.Now().Add(time.Hour), 0, 100)
+	require.NoError(t, err)
+	for i := 0; i < 10; i++ {
+		assert.True(t, budgets.allow("app1", ""))
+	}
+}
+
+func TestThrottleBudgetsGrant(t *testing.T) {
+	budgets, err := newThrottleBudgets("", "")
+	require.NoError(t, err)
+
+	_, err = budgets.grant("", time.Now().Add(time.Hour), 1, 1)
+	assert.EqualError(t, err, "no app indicated")
+	_, err = budgets.grant("app1", time.Now().Add(-time.Second), 1, 1)
+	assert.EqualError(t, err, "grant of a budget to app1 must expire in the future")
+	_, err = budgets.grant("app1", time.Now().Add(25*time.Hour), 1, 1)
+	assert.EqualError(t, err, "grant of a budget to app1 can't last more than 24h0m0s")
+	_, err = budgets.grant("app1", time.Now().Add(time.Hour), -1, 1)
+	assert.Error(t, err)
+
+	budget, err := budgets.grant("app1", time.Now().Add(time.Hour), 5, 50)
+	require.NoError(t, err)
+	assert.Equal(t, "app1", budget.Name)
+	_, _, grants := budgets.snapshot()
+	assert.Equal(t, *budget, grants["app1"])
+}
@@ -33,6 +33,8 @@ type CheckFlags struct {
 	OverrideThreshold float64
 	LowPriority       bool
 	OKIfNotExists     bool
+	// Table is the table the app is about to work on, if any, so that table budgets apply
+	Table string
 }
 
 // StandardCheckFlags have no special hints
@@ -64,6 +66,10 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 	}
 	//
 	metricResult, threshold := check.throttler.AppRequestMetricResult(ctx, appName, metricResultFunc, denyApp)
+	checkBudgets := appName != "" && appName != frenoAppName
+	if checkBudgets {
+		threshold = check.throttler.budgets.threshold(appName, flags.Table, threshold)
+	}
 	if flags.OverrideThreshold > 0 {
 		threshold = flags.OverrideThreshold
 	}
@@ -92,6 +98,10 @@ func (check *ThrottlerCheck) checkAppMetricResult(ctx context.Context, appName s
 			// low priority requests will henceforth be denied
 			go check.throttler.nonLowPriorityAppRequestsThrottled.SetDefault(metricName, true)
 		}
+	} else if checkBudgets && !check.throttler.budgets.allow(appName, flags.Table) {
+		// app or table checks too often
+		statusCode = http.StatusTooManyRequests // 429
+		err = base.ErrBudgetExceeded
 	} else {
 		// all good!
 		statusCode = http.StatusOK // 200
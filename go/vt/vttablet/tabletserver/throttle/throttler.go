@@ -95,6 +95,12 @@ func init() {
 	rand.Seed(time.Now().UnixNano())
 }
 
+// HeartbeatWriter is the subset of the replication tracker the throttler uses to
+// keep on-demand heartbeats running while it checks lag.
+type HeartbeatWriter interface {
+	RequestHeartbeats()
+}
+
 // Throttler is the main entity in the throttling mechanism. This service runs, probes, collects data,
 // aggregates, reads inventory, provides information, etc.
 type Throttler struct {
@@ -105,10 +111,11 @@ type Throttler struct {
 	isLeader int64
 	isOpen   int64
 
-	env            tabletenv.Env
-	pool           *connpool.Pool
-	tabletTypeFunc func() topodatapb.TabletType
-	ts             *topo.Server
+	env             tabletenv.Env
+	pool            *connpool.Pool
+	tabletTypeFunc  func() topodatapb.TabletType
+	ts              *topo.Server
+	heartbeatWriter HeartbeatWriter
 
 	throttleTabletTypesMap map[topodatapb.TabletType]bool
 
@@ -136,6 +143,8 @@ type Throttler struct {
 
 	nonLowPriorityAppRequestsThrottled *cache.Cache
 	httpClient                         *http.Client
+
+	budgets *throttleBudgets
 }
 
 // ThrottlerStatus published some status values from the throttler
@@ -149,17 +158,22 @@ type ThrottlerStatus struct {
 
 	AggregatedMetrics map[string]base.MetricResult
 	MetricsHealth     base.MetricHealthMap
+
+	AppBudgets        map[string]base.ThrottleBudget
+	TableBudgets      map[string]base.ThrottleBudget
+	GrantedAppBudgets map[string]base.ThrottleBudget
 }
 
 // NewThrottler creates a Throttler
-func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
+func NewThrottler(env tabletenv.Env, ts *topo.Server, heartbeatWriter HeartbeatWriter, tabletTypeFunc func() topodatapb.TabletType) *Throttler {
 	throttler := &Throttler{
 		isLeader: 0,
 		isOpen:   0,
 
-		env:            env,
-		tabletTypeFunc: tabletTypeFunc,
-		ts:             ts,
+		env:             env,
+		tabletTypeFunc:  tabletTypeFunc,
+		ts:              ts,
+		heartbeatWriter: heartbeatWriter,
 		pool: connpool.NewPool(env, "ThrottlerPool", tabletenv.ConnPoolConfig{
 			Size:               2,
 			IdleTimeoutSeconds: env.Config().OltpReadPool.IdleTimeoutSeconds,
@@ -187,6 +201,7 @@ func NewThrottler(env tabletenv.Env, ts *topo.Server, tabletTypeFunc func() topo
 		httpClient: base.SetupHTTPClient(0),
 	}
 	throttler.initThrottleTabletTypes()
+	throttler.initBudgets()
 	throttler.ThrottleApp("abusing-app", time.Now().Add(time.Hour*24*365*10), defaultThrottleRatio)
 	throttler.check = NewThrottlerCheck(throttler)
 	throttler.initConfig("")
@@ -211,6 +226,16 @@ func (throttler *Throttler) initThrottleTabletTypes() {
 	throttler.throttleTabletTypesMap[topodatapb.TabletType_REPLICA] = true
 }
 
+// initBudgets reads the user supplied throttle_app_budgets and throttle_table_budgets
+func (throttler *Throttler) initBudgets() {
+	budgets, err := newThrottleBudgets(*throttleAppBudgets, *throttleTableBudgets)
+	if err != nil {
+		log.Errorf("Throttler: ignoring throttle budgets: %v", err)
+		budgets, _ = newThrottleBudgets("", "")
+	}
+	throttler.budgets = budgets
+}
+
 // InitDBConfig initializes keyspace and shard
 func (throttler *Throttler) InitDBConfig(keyspace, shard string) {
 	throttler.keyspace = keyspace
@@ -753,6 +778,17 @@ func (throttler *Throttler) IsAppThrottled(appName string) bool {
 	return false
 }
 
+// GrantAppBudget temporarily gives an app a throttle budget, until expireAt, which is at most 24 hours
+// away. A zero threshold or qps means the default threshold or no rate limit.
+func (throttler *Throttler) GrantAppBudget(appName string, expireAt time.Time, threshold float64, qps float64) (*base.ThrottleBudget, error) {
+	return throttler.budgets.grant(appName, expireAt, threshold, qps)
+}
+
+// RevokeAppBudget cancels the budget granted to an app, if any. The app gets its configured budget back.
+func (throttler *Throttler) RevokeAppBudget(appName string) {
+	throttler.budgets.revoke(appName)
+}
+
 // ThrottledAppsMap returns a (copy) map of currently throttled apps
 func (throttler *Throttler) ThrottledAppsMap() (result map[string](*base.AppThrottle)) {
 	result = make(map[string](*base.AppThrottle))
@@ -834,6 +870,10 @@ func (throttler *Throttler) checkSelf(ctx context.Context, appName string, remot
 
 // CheckByType runs a check by requested check type
 func (throttler *Throttler) CheckByType(ctx context.Context, appName string, remoteAddr string, flags *CheckFlags, checkType ThrottleCheckType) (checkResult *CheckResult) {
+	if throttler.heartbeatWriter != nil {
+		// Lag is only meaningful while heartbeats are being written.
+		throttler.heartbeatWriter.RequestHeartbeats()
+	}
 	switch checkType {
 	case ThrottleCheckSelf:
 		return throttler.checkSelf(ctx, appName, remoteAddr, flags)
@@ -849,6 +889,7 @@ func (throttler *Throttler) CheckByType(ctx context.Context, appName string, rem
 
 // Status exports a status breakdown
 func (throttler *Throttler) Status() *ThrottlerStatus {
+	appBudgets, tableBudgets, grantedAppBudgets := throttler.budgets.snapshot()
 	return &ThrottlerStatus{
 		Keyspace: throttler.keyspace,
 		Shard:    throttler.shard,
@@ -859,5 +900,9 @@ func (throttler *Throttler) Status() *ThrottlerStatus {
 
 		AggregatedMetrics: throttler.aggregatedMetricsSnapshot(),
 		MetricsHealth:     throttler.metricsHealthSnapshot(),
+
+		AppBudgets:        appBudgets,
+		TableBudgets:      tableBudgets,
+		GrantedAppBudgets: grantedAppBudgets,
 	}
 }
@@ -101,13 +101,13 @@ const (
 type TwoPC struct {
 	readPool *connpool.Pool
 
-	insertRedoTx        *sqlparser.ParsedQuery
-	insertRedoStmt      *sqlparser.ParsedQuery
-	updateRedoTx        *sqlparser.ParsedQuery
-	deleteRedoTx        *sqlparser.ParsedQuery
-	deleteRedoStmt      *sqlparser.ParsedQuery
-	readAllRedo         string
-	countUnresolvedRedo *sqlparser.ParsedQuery
+	insertRedoTx       *sqlparser.ParsedQuery
+	insertRedoStmt     *sqlparser.ParsedQuery
+	updateRedoTx       *sqlparser.ParsedQuery
+	deleteRedoTx       *sqlparser.ParsedQuery
+	deleteRedoStmt     *sqlparser.ParsedQuery
+	readAllRedo        string
+	readUnresolvedRedo *sqlparser.ParsedQuery
 
 	insertTransaction   *sqlparser.ParsedQuery
 	insertParticipants  *sqlparser.ParsedQuery
@@ -140,8 +140,8 @@ func NewTwoPC(readPool *connpool.Pool) *TwoPC {
 		"delete from %s.redo_statement where dtid = %a",
 		dbname, ":dtid")
 	tpc.readAllRedo = fmt.Sprintf(sqlReadAllRedo, dbname, dbname)
-	tpc.countUnresolvedRedo = sqlparser.BuildParsedQuery(
-		"select count(*) from %s.redo_state where time_created < %a",
+	tpc.readUnresolvedRedo = sqlparser.BuildParsedQuery(
+		"select dtid, state, time_created from %s.redo_state where time_created < %a",
 		dbname, ":time_created")
 
 	tpc.insertTransaction = sqlparser.BuildParsedQuery(
@@ -303,26 +303,41 @@ func (tpc *TwoPC) ReadAllRedo(ctx context.Context) (prepared, failed []*tx.Prepa
 	return prepared, failed, nil
 }
 
-// CountUnresolvedRedo returns the number of prepared transactions that are still unresolved.
-func (tpc *TwoPC) CountUnresolvedRedo(ctx context.Context, unresolvedTime time.Time) (int64, error) {
+// ReadUnresolvedRedo returns the prepared and the failed transactions
+// of the redo logs created before unresolvedTime, and their start time.
+func (tpc *TwoPC) ReadUnresolvedRedo(ctx context.Context, unresolvedTime time.Time) (prepared, failed map[string]time.Time, err error) {
 	conn, err := tpc.readPool.Get(ctx)
 	if err != nil {
-		return 0, err
+		return nil, nil, err
 	}
 	defer conn.Recycle()
 
 	bindVars := map[string]*querypb.BindVariable{
 		"time_created": sqltypes.Int64BindVariable(unresolvedTime.UnixNano()),
 	}
-	qr, err := tpc.read(ctx, conn, tpc.countUnresolvedRedo, bindVars)
+	qr, err := tpc.read(ctx, conn, tpc.readUnresolvedRedo, bindVars)
 	if err != nil {
-		return 0, err
+		return nil, nil, err
 	}
-	if len(qr.Rows) < 1 {
-		return 0, nil
+	prepared = make(map[string]time.Time)
+	failed = make(map[string]time.Time)
+	for _, row := range qr.Rows {
+		dtid := row[0].ToString()
+		st, err := evalengine.ToInt64(row[1])
+		if err != nil {
+			return nil, nil, err
+		}
+		t, err := evalengine.ToInt64(row[2])
+		if err != nil {
+			return nil, nil, err
+		}
+		if st == RedoStatePrepared {
+			prepared[dtid] = time.Unix(0, t)
+		} else {
+			failed[dtid] = time.Unix(0, t)
+		}
 	}
-	v, _ := evalengine.ToInt64(qr.Rows[0][0])
-	return v, nil
+	return prepared, failed, nil
 }
 
 // CreateTransaction saves the metadata of a 2pc transaction as Prepared.
@@ -236,6 +236,62 @@ func TestReadAllRedo(t *testing.T) {
 	}
 }
 
+func TestReadUnresolvedRedo(t *testing.T) {
+	_, tsv, db := newTestTxExecutor(t)
+	defer db.Close()
+	defer tsv.StopService()
+	tpc := tsv.te.twoPC
+	ctx := context.Background()
+
+	query := "select dtid, state, time_created from _vt.redo_state where time_created < 10"
+	db.AddQuery(query, &sqltypes.Result{})
+	prepared, failed, err := tpc.ReadUnresolvedRedo(ctx, time.Unix(0, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prepared) != 0 || len(failed) != 0 {
+		t.Errorf("ReadUnresolvedRedo: %v, %v, must be empty", prepared, failed)
+	}
+
+	db.AddQuery(query, &sqltypes.Result{
+		Fields: []*querypb.Field{
+			{Type: sqltypes.VarChar},
+			{Type: sqltypes.Int64},
+			{Type: sqltypes.Int64},
+		},
+		Rows: [][]sqltypes.Value{{
+			sqltypes.NewVarBinary("dtid0"),
+			sqltypes.NewInt64(RedoStatePrepared),
+			sqltypes.NewVarBinary("1"),
+		}, {
+			sqltypes.NewVarBinary("dtid1"),
+			sqltypes.NewInt64(RedoStateFailed),
+			sqltypes.NewVarBinary("2"),
+		}, {
+			sqltypes.NewVarBinary("dtid2"),
+			sqltypes.NewInt64(RedoStatePrepared),
+			sqltypes.NewVarBinary("3"),
+		}},
+	})
+	prepared, failed, err = tpc.ReadUnresolvedRedo(ctx, time.Unix(0, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantPrepared := map[string]time.Time{
+		"dtid0": time.Unix(0, 1),
+		"dtid2": time.Unix(0, 3),
+	}
+	if !reflect.DeepEqual(prepared, wantPrepared) {
+		t.Errorf("ReadUnresolvedRedo: %v, want %v", prepared, wantPrepared)
+	}
+	wantFailed := map[string]time.Time{
+		"dtid1": time.Unix(0, 2),
+	}
+	if !reflect.DeepEqual(failed, wantFailed) {
+		t.Errorf("ReadUnresolvedRedo (failed): %v, want %v", failed, wantFailed)
+	}
+}
+
 func TestReadAllTransactions(t *testing.T) {
 	_, tsv, db := newTestTxExecutor(t)
 	defer db.Close()
@@ -52,13 +52,26 @@ type (
 		ImmediateCaller *querypb.VTGateCallerID
 		StartTime       time.Time
 		EndTime         time.Time
-		Queries         []string
+		Queries         QueryLog
 		Autocommit      bool
 		Conclusion      string
 		LogToFile       bool
+		// WrittenTables are the tables written by the transaction, whose
+		// results are not cached until it ends.
+		WrittenTables []string
 
 		Stats *servenv.TimingsWrapper
 	}
+
+	// Info is a snapshot of an open transaction, as returned by
+	// TxPool.Transactions.
+	Info struct {
+		ID              ConnID
+		StartTime       time.Time
+		Queries         []string
+		EffectiveCaller string
+		ImmediateCaller string
+	}
 )
 
 const (
@@ -118,7 +131,7 @@ func (p *Properties) RecordQuery(query string) {
 	if p == nil {
 		return
 	}
-	p.Queries = append(p.Queries, query)
+	p.Queries.Record(query)
 }
 
 // InTransaction returns true as soon as this struct is not nil
@@ -138,6 +151,16 @@ func (p *Properties) String() string {
 		p.EndTime.Format(time.StampMicro),
 		p.EndTime.Sub(p.StartTime).Seconds(),
 		p.Conclusion,
-		strings.Join(p.Queries, ";"),
+		p.queriesString(),
 	)
 }
+
+// queriesString returns the recorded statements, with the number of
+// statements that were dropped before them.
+func (p *Properties) queriesString() string {
+	queries := strings.Join(p.Queries.Statements(), ";")
+	if dropped := p.Queries.Dropped(); dropped > 0 {
+		return fmt.Sprintf("/* %d earlier statements not recorded */ %s", dropped, queries)
+	}
+	return queries
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tx
+
+// QueryLog records the statements of a transaction in a ring buffer: once
+// it holds MaxStatements statements, or MaxBytes bytes of statements, the
+// oldest ones are dropped. A zero limit means no limit.
+type QueryLog struct {
+	MaxStatements int
+	MaxBytes      int
+
+	ring    []string
+	start   int
+	count   int
+	bytes   int
+	dropped int
+}
+
+// Record records a statement. A statement larger than MaxBytes is
+// truncated.
+func (l *QueryLog) Record(query string) {
+	if l.MaxBytes > 0 && len(query) > l.MaxBytes {
+		query = query[:l.MaxBytes]
+	}
+	for l.count > 0 && ((l.MaxStatements > 0 && l.count >= l.MaxStatements) || (l.MaxBytes > 0 && l.bytes+len(query) > l.MaxBytes)) {
+		l.bytes -= len(l.ring[l.start])
+		l.ring[l.start] = ""
+		l.start = (l.start + 1) % len(l.ring)
+		l.count--
+		l.dropped++
+	}
+	if l.count == len(l.ring) {
+		l.grow()
+	}
+	l.ring[(l.start+l.count)%len(l.ring)] = query
+	l.count++
+	l.bytes += len(query)
+}
+
+// grow doubles the size of the ring, up to MaxStatements.
+func (l *QueryLog) grow() {
+	size := 2 * len(l.ring)
+	if size == 0 {
+		size = 4
+	}
+	if l.MaxStatements > 0 && size > l.MaxStatements {
+		size = l.MaxStatements
+	}
+	ring := make([]string, size)
+	l.copyTo(ring)
+	l.ring = ring
+	l.start = 0
+}
+
+// copyTo copies the recorded statements to dst, oldest first.
+func (l *QueryLog) copyTo(dst []string) {
+	end := l.start + l.count
+	if end > len(l.ring) {
+		end = len(l.ring)
+	}
+	n := copy(dst, l.ring[l.start:end])
+	copy(dst[n:l.count], l.ring)
+}
+
+// Statements returns the recorded statements, oldest first.
+func (l *QueryLog) Statements() []string {
+	if l.count == 0 {
+		return nil
+	}
+	statements := make([]string, l.count)
+	l.copyTo(statements)
+	return statements
+}
+
+// Len returns the number of recorded statements.
+func (l *QueryLog) Len() int {
+	return l.count
+}
+
+// Dropped returns the number of statements dropped to respect the limits.
+func (l *QueryLog) Dropped() int {
+	return l.dropped
+}
+
+// Map returns a copy of the log, with f applied to its statements.
+func (l *QueryLog) Map(f func(string) string) QueryLog {
+	mapped := *l
+	mapped.ring = l.Statements()
+	mapped.start = 0
+	mapped.bytes = 0
+	for i, query := range mapped.ring {
+		mapped.ring[i] = f(query)
+		mapped.bytes += len(mapped.ring[i])
+	}
+	return mapped
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tx
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryLogUnlimited(t *testing.T) {
+	var l QueryLog
+	assert.Nil(t, l.Statements())
+	var want []string
+	for i := 0; i < 10; i++ {
+		query := fmt.Sprintf("stmt%d", i)
+		l.Record(query)
+		want = append(want, query)
+	}
+	assert.Equal(t, want, l.Statements())
+	assert.Equal(t, 10, l.Len())
+	assert.Equal(t, 0, l.Dropped())
+}
+
+func TestQueryLogMaxStatements(t *testing.T) {
+	l := QueryLog{MaxStatements: 3}
+	for i := 0; i < 10; i++ {
+		l.Record(fmt.Sprintf("stmt%d", i))
+	}
+	assert.Equal(t, []string{"stmt7", "stmt8", "stmt9"}, l.Statements())
+	assert.Equal(t, 7, l.Dropped())
+	assert.Len(t, l.ring, 3)
+}
+
+func TestQueryLogMaxBytes(t *testing.T) {
+	l := QueryLog{MaxBytes: 10}
+	l.Record("aaaa")
+	l.Record("bbbb")
+	assert.Equal(t, []string{"aaaa", "bbbb"}, l.Statements())
+	l.Record("cccc")
+	assert.Equal(t, []string{"bbbb", "cccc"}, l.Statements())
+	assert.Equal(t, 1, l.Dropped())
+
+	// The statements larger than the limit are truncated.
+	l.Record(strings.Repeat("d", 20))
+	assert.Equal(t, []string{strings.Repeat("d", 10)}, l.Statements())
+	assert.Equal(t, 3, l.Dropped())
+}
+
+func TestQueryLogMap(t *testing.T) {
+	l := QueryLog{MaxStatements: 2}
+	l.Record("a")
+	l.Record("b")
+	l.Record("c")
+	mapped := l.Map(strings.ToUpper)
+	assert.Equal(t, []string{"B", "C"}, mapped.Statements())
+	assert.Equal(t, 1, mapped.Dropped())
+	assert.Equal(t, []string{"b", "c"}, l.Statements())
+
+	mapped.Record("d")
+	assert.Equal(t, []string{"C", "d"}, mapped.Statements())
+	assert.Equal(t, []string{"b", "c"}, l.Statements())
+}
+
+func TestPropertiesStringDropped(t *testing.T) {
+	p := &Properties{Queries: QueryLog{MaxStatements: 1}}
+	p.RecordQuery("stmt1")
+	p.RecordQuery("stmt2")
+	assert.Contains(t, p.String(), "\t/* 1 earlier statements not recorded */ stmt2\t")
+}
@@ -425,29 +425,41 @@ func (te *TxEngine) rollbackPrepared() {
 }
 
 // startWatchdog starts the watchdog goroutine, which looks for abandoned
-// transactions and calls the notifier on them.
+// transactions and asks the coordinator to resolve them.
 func (te *TxEngine) startWatchdog() {
 	te.ticks.Start(func() {
 		ctx, cancel := context.WithTimeout(tabletenv.LocalContext(), te.abandonAge/4)
 		defer cancel()
 
-		// Raise alerts on prepares that have been unresolved for too long.
-		// Use 5x abandonAge to give opportunity for watchdog to resolve these.
-		count, err := te.twoPC.CountUnresolvedRedo(ctx, time.Now().Add(-te.abandonAge*5))
+		now := time.Now()
+		dtids := make(map[string]bool)
+
+		// Prepares that outlived the abandon age are resolved using the
+		// metadata of their coordinator. Failed prepares can only be
+		// resolved by hand, so they're only tracked.
+		prepared, failed, err := te.twoPC.ReadUnresolvedRedo(ctx, now.Add(-te.abandonAge))
 		if err != nil {
 			te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
-			log.Errorf("Error reading unresolved prepares: '%v': %v", te.coordinatorAddress, err)
+			log.Errorf("Error reading unresolved prepares: %v", err)
+		} else {
+			te.updateUnresolvedPrepares(now, prepared, failed)
+			for dtid := range prepared {
+				dtids[dtid] = true
+			}
 		}
-		te.env.Stats().Unresolved.Set("Prepares", count)
 
 		// Resolve lingering distributed transactions.
-		txs, err := te.twoPC.ReadAbandoned(ctx, time.Now().Add(-te.abandonAge))
+		txs, err := te.twoPC.ReadAbandoned(ctx, now.Add(-te.abandonAge))
 		if err != nil {
 			te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
 			log.Errorf("Error reading transactions for 2pc watchdog: %v", err)
-			return
+		} else {
+			te.updateUnresolvedTransactions(now, txs)
+			for dtid := range txs {
+				dtids[dtid] = true
+			}
 		}
-		if len(txs) == 0 {
+		if len(dtids) == 0 {
 			return
 		}
 
@@ -460,7 +472,7 @@ func (te *TxEngine) startWatchdog() {
 		defer coordConn.Close()
 
 		var wg sync.WaitGroup
-		for tx := range txs {
+		for dtid := range dtids {
 			wg.Add(1)
 			go func(dtid string) {
 				defer wg.Done()
@@ -468,12 +480,55 @@ func (te *TxEngine) startWatchdog() {
 					te.env.Stats().InternalErrors.Add("WatchdogFail", 1)
 					log.Errorf("Error notifying for dtid %s: %v", dtid, err)
 				}
-			}(tx)
+			}(dtid)
 		}
 		wg.Wait()
 	})
 }
 
+// updateUnresolvedPrepares exports the number of prepares unresolved for
+// too long, and the age of the oldest one. Alerts are raised at 5x the
+// abandon age, to give the watchdog the opportunity to resolve them. The
+// failed prepares, which the watchdog can't resolve, are counted apart.
+func (te *TxEngine) updateUnresolvedPrepares(now time.Time, prepared, failed map[string]time.Time) {
+	count, oldest := unresolvedSince(now.Add(-te.abandonAge*5), prepared)
+	te.env.Stats().Unresolved.Set("Prepares", count)
+	te.env.Stats().Unresolved.Set("FailedPrepares", int64(len(failed)))
+	te.env.Stats().UnresolvedMaxAge.Set("Prepares", unresolvedAge(now, oldest))
+}
+
+// updateUnresolvedTransactions exports the number of distributed
+// transactions this tablet coordinates that are unresolved for too long,
+// and the age of the oldest one.
+func (te *TxEngine) updateUnresolvedTransactions(now time.Time, txs map[string]time.Time) {
+	count, oldest := unresolvedSince(now.Add(-te.abandonAge*5), txs)
+	te.env.Stats().Unresolved.Set("Transactions", count)
+	te.env.Stats().UnresolvedMaxAge.Set("Transactions", unresolvedAge(now, oldest))
+}
+
+// unresolvedSince returns the number of transactions started before
+// alertTime, and the start time of the oldest transaction.
+func unresolvedSince(alertTime time.Time, txs map[string]time.Time) (count int64, oldest time.Time) {
+	for _, started := range txs {
+		if started.Before(alertTime) {
+			count++
+		}
+		if oldest.IsZero() || started.Before(oldest) {
+			oldest = started
+		}
+	}
+	return count, oldest
+}
+
+// unresolvedAge returns the age in seconds of a transaction started at
+// oldest, or 0 if there is none.
+func unresolvedAge(now, oldest time.Time) int64 {
+	if oldest.IsZero() {
+		return 0
+	}
+	return int64(now.Sub(oldest).Seconds())
+}
+
 // stopWatchdog stops the watchdog goroutine.
 func (te *TxEngine) stopWatchdog() {
 	te.ticks.Stop()
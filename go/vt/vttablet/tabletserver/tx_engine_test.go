@@ -576,3 +576,36 @@ func TestTxEngineFailReserve(t *testing.T) {
 	require.Error(t, err)
 	assert.Zero(t, connID)
 }
+
+func TestTxEngineUnresolvedStats(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.TwoPCAbandonAge = 10
+	te := NewTxEngine(tabletenv.NewEnv(config, "TabletServerTest"))
+	stats := te.env.Stats()
+	now := time.Now()
+
+	te.updateUnresolvedPrepares(now, map[string]time.Time{
+		"recent": now.Add(-20 * time.Second),
+		"stuck":  now.Add(-60 * time.Second),
+	}, map[string]time.Time{
+		"failed": now.Add(-90 * time.Second),
+	})
+	// The failed prepares are only counted as failed.
+	assert.EqualValues(t, 1, stats.Unresolved.Counts()["Prepares"])
+	assert.EqualValues(t, 1, stats.Unresolved.Counts()["FailedPrepares"])
+	assert.EqualValues(t, 60, stats.UnresolvedMaxAge.Counts()["Prepares"])
+
+	te.updateUnresolvedTransactions(now, map[string]time.Time{
+		"recent": now.Add(-30 * time.Second),
+	})
+	assert.EqualValues(t, 0, stats.Unresolved.Counts()["Transactions"])
+	assert.EqualValues(t, 30, stats.UnresolvedMaxAge.Counts()["Transactions"])
+
+	// Resolved transactions reset the stats.
+	te.updateUnresolvedPrepares(now, nil, nil)
+	te.updateUnresolvedTransactions(now, nil)
+	assert.EqualValues(t, 0, stats.Unresolved.Counts()["Prepares"])
+	assert.EqualValues(t, 0, stats.Unresolved.Counts()["FailedPrepares"])
+	assert.EqualValues(t, 0, stats.UnresolvedMaxAge.Counts()["Prepares"])
+	assert.EqualValues(t, 0, stats.UnresolvedMaxAge.Counts()["Transactions"])
+}
@@ -58,7 +58,7 @@ func (txe *TxExecutor) Prepare(transactionID int64, dtid string) error {
 	}
 
 	// If no queries were executed, we just rollback.
-	if len(conn.TxProperties().Queries) == 0 {
+	if conn.TxProperties().Queries.Len() == 0 {
 		conn.Release(tx.TxRollback)
 		return nil
 	}
@@ -70,7 +70,7 @@ func (txe *TxExecutor) Prepare(transactionID int64, dtid string) error {
 	}
 
 	return txe.inTransaction(func(localConn *StatefulConnection) error {
-		return txe.te.twoPC.SaveRedo(txe.ctx, localConn, dtid, conn.TxProperties().Queries)
+		return txe.te.twoPC.SaveRedo(txe.ctx, localConn, dtid, conn.TxProperties().Queries.Statements())
 	})
 
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package tabletserver
 
 import (
+	"sort"
 	"sync"
 	"time"
 
@@ -67,6 +68,9 @@ type (
 		logMu   sync.Mutex
 		lastLog time.Time
 		txStats *servenv.TimingsWrapper
+
+		// resultCache is nil if the result cache is disabled.
+		resultCache *resultCache
 	}
 	queries struct {
 		setIsolationLevel string
@@ -153,13 +157,19 @@ func (tp *TxPool) WaitForEmpty() {
 
 //NewTxProps creates a new TxProperties struct
 func (tp *TxPool) NewTxProps(immediateCaller *querypb.VTGateCallerID, effectiveCaller *vtrpcpb.CallerID, autocommit bool) *tx.Properties {
-	return &tx.Properties{
+	props := &tx.Properties{
 		StartTime:       time.Now(),
 		EffectiveCaller: effectiveCaller,
 		ImmediateCaller: immediateCaller,
 		Autocommit:      autocommit,
 		Stats:           tp.txStats,
 	}
+	// The redo logs of 2PC need all the statements of the transactions.
+	if config := tp.env.Config(); !config.TwoPCEnable {
+		props.Queries.MaxStatements = config.Oltp.TxLogMaxStatements
+		props.Queries.MaxBytes = config.Oltp.TxLogMaxBytes
+	}
+	return props
 }
 
 // GetAndLock fetches the connection associated to the connID and blocks it from concurrent use
@@ -179,6 +189,7 @@ func (tp *TxPool) Commit(ctx context.Context, txConn *StatefulConnection) (strin
 	}
 	span, ctx := trace.NewSpan(ctx, "TxPool.Commit")
 	defer span.Finish()
+	span.Annotate("transaction_id", txConn.ID())
 	defer tp.txComplete(txConn, tx.TxCommit)
 	if txConn.TxProperties().Autocommit {
 		return "", nil
@@ -204,7 +215,12 @@ func (tp *TxPool) RollbackAndRelease(ctx context.Context, txConn *StatefulConnec
 func (tp *TxPool) Rollback(ctx context.Context, txConn *StatefulConnection) error {
 	span, ctx := trace.NewSpan(ctx, "TxPool.Rollback")
 	defer span.Finish()
+	span.Annotate("transaction_id", txConn.ID())
 	if txConn.IsClosed() || !txConn.IsInTransaction() {
+		// The transaction of a closed connection was rolled back by MySQL.
+		if txConn.IsInTransaction() {
+			tp.endWrites(txConn)
+		}
 		return nil
 	}
 	if txConn.TxProperties().Autocommit {
@@ -252,6 +268,7 @@ func (tp *TxPool) Begin(ctx context.Context, options *querypb.ExecuteOptions, re
 	if err != nil {
 		return nil, "", err
 	}
+	span.Annotate("transaction_id", conn.ID())
 	sql, err := tp.begin(ctx, options, readOnly, conn, preQueries)
 	if err != nil {
 		conn.Close()
@@ -285,7 +302,7 @@ func (tp *TxPool) createConn(ctx context.Context, options *querypb.ExecuteOption
 			tp.LogActive()
 			err = vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED, "transaction pool connection limit exceeded")
 		}
-		return nil, err
+		return nil, vterrors.WithApplied(err, vterrors.NotApplied)
 	}
 	return conn, nil
 }
@@ -339,6 +356,23 @@ func (tp *TxPool) LogActive() {
 	})
 }
 
+// Transactions returns a snapshot of the open transactions, sorted by id.
+func (tp *TxPool) Transactions() []tx.Info {
+	var txs []tx.Info
+	tp.scp.ForAllTx(func(conn *StatefulConnection) {
+		props := conn.TxProperties()
+		txs = append(txs, tx.Info{
+			ID:              conn.ConnID,
+			StartTime:       props.StartTime,
+			Queries:         props.Queries.Statements(),
+			EffectiveCaller: callerid.GetPrincipal(props.EffectiveCaller),
+			ImmediateCaller: callerid.GetUsername(props.ImmediateCaller),
+		})
+	})
+	sort.Slice(txs, func(i, j int) bool { return txs[i].ID < txs[j].ID })
+	return txs
+}
+
 // Timeout returns the transaction timeout.
 func (tp *TxPool) Timeout() time.Duration {
 	return tp.transactionTimeout.Get()
@@ -351,7 +385,47 @@ func (tp *TxPool) SetTimeout(timeout time.Duration) {
 }
 
 func (tp *TxPool) txComplete(conn *StatefulConnection, reason tx.ReleaseReason) {
+	tp.endWrites(conn)
 	conn.LogTransaction(reason)
 	tp.limiter.Release(conn.TxProperties().ImmediateCaller, conn.TxProperties().EffectiveCaller)
 	conn.CleanTxState()
 }
+
+// startWrites records the tables written by a statement of the transaction
+// of conn, so that their results are not cached until it ends.
+func (tp *TxPool) startWrites(conn *StatefulConnection, tables []string) {
+	if tp.resultCache == nil || !conn.IsInTransaction() {
+		return
+	}
+	props := conn.TxProperties()
+	var started []string
+	for _, table := range tables {
+		if !containsString(props.WrittenTables, table) {
+			props.WrittenTables = append(props.WrittenTables, table)
+			started = append(started, table)
+		}
+	}
+	if len(started) != 0 {
+		tp.resultCache.StartWrites(started)
+	}
+}
+
+// endWrites ends the writes recorded by startWrites for the transaction
+// of conn.
+func (tp *TxPool) endWrites(conn *StatefulConnection) {
+	props := conn.TxProperties()
+	if tp.resultCache == nil || props == nil || len(props.WrittenTables) == 0 {
+		return
+	}
+	tp.resultCache.EndWrites(props.WrittenTables)
+	props.WrittenTables = nil
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
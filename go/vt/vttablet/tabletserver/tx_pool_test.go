@@ -135,6 +135,65 @@ func TestTxPoolRollbackNonBusy(t *testing.T) {
 	assert.Equal(t, "begin;begin;rollback;commit", db.QueryLog())
 }
 
+func TestTxPoolQueryLogLimits(t *testing.T) {
+	env := newEnv("TxPoolTest")
+	env.Config().Oltp.TxLogMaxStatements = 2
+	_, txPool, _, closer := setupWithEnv(t, env)
+	defer closer()
+
+	conn, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	defer conn.Release(tx.TxRollback)
+	for _, query := range []string{"insert into t1 values(1)", "insert into t1 values(2)", "insert into t1 values(3)"} {
+		conn.TxProperties().RecordQuery(query)
+	}
+	assert.DeepEqual(t, []string{"insert into t1 values(2)", "insert into t1 values(3)"}, conn.TxProperties().Queries.Statements())
+	require.Contains(t, conn.String(), "/* 1 earlier statements not recorded */ insert into t1 values(2);insert into t1 values(3)")
+
+	// The limits are not applied with 2PC.
+	env.Config().TwoPCEnable = true
+	conn2, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	defer conn2.Release(tx.TxRollback)
+	for i := 0; i < 3; i++ {
+		conn2.TxProperties().RecordQuery("insert into t1 values(1)")
+	}
+	assert.Equal(t, 3, conn2.TxProperties().Queries.Len())
+}
+
+func TestTxPoolTransactions(t *testing.T) {
+	_, txPool, _, closer := setup(t)
+	defer closer()
+
+	callerCtx := callerid.NewContext(ctx, callerid.NewEffectiveCallerID("effective", "", ""), callerid.NewImmediateCallerID("immediate"))
+	conn1, _, err := txPool.Begin(callerCtx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	conn1.TxProperties().RecordQuery("insert into t1 values(1)")
+	conn1.Unlock()
+	conn2, _, err := txPool.Begin(ctx, &querypb.ExecuteOptions{}, false, 0, nil)
+	require.NoError(t, err)
+	conn2.Unlock()
+
+	txs := txPool.Transactions()
+	require.Len(t, txs, 2)
+	assert.Equal(t, conn1.ReservedID(), txs[0].ID)
+	assert.DeepEqual(t, []string{"insert into t1 values(1)"}, txs[0].Queries)
+	assert.Equal(t, "effective", txs[0].EffectiveCaller)
+	assert.Equal(t, "immediate", txs[0].ImmediateCaller)
+	assert.Equal(t, conn2.ReservedID(), txs[1].ID)
+
+	conn2, err = txPool.GetAndLock(conn2.ReservedID(), "")
+	require.NoError(t, err)
+	txPool.RollbackAndRelease(ctx, conn2)
+	conn1, err = txPool.GetAndLock(conn1.ReservedID(), "")
+	require.NoError(t, err)
+	defer txPool.RollbackAndRelease(ctx, conn1)
+
+	txs = txPool.Transactions()
+	require.Len(t, txs, 1)
+	assert.Equal(t, conn1.ReservedID(), txs[0].ID)
+}
+
 func TestTxPoolTransactionIsolation(t *testing.T) {
 	db, txPool, _, closer := setup(t)
 	defer closer()
@@ -364,7 +423,7 @@ func TestTxPoolGetConnRecentlyRemovedTransaction(t *testing.T) {
 }
 
 func TestTxPoolCloseKillsStrayTransactions(t *testing.T) {
-	_, txPool, _, closer := setup(t)
+	db, txPool, _, closer := setup(t)
 	defer closer()
 
 	startingStray := txPool.env.Stats().InternalErrors.Counts()["StrayTransactions"]
@@ -373,11 +432,13 @@ func TestTxPoolCloseKillsStrayTransactions(t *testing.T) {
 	conn, _, err := txPool.Begin(context.Background(), &querypb.ExecuteOptions{}, false, 0, nil)
 	require.NoError(t, err)
 	conn.Unlock()
+	require.Equal(t, 1, db.OpenTransactionCount())
 
 	// Close kills stray transaction.
 	txPool.Close()
 	require.Equal(t, int64(1), txPool.env.Stats().InternalErrors.Counts()["StrayTransactions"]-startingStray)
 	require.Equal(t, 0, txPool.scp.Capacity())
+	require.Equal(t, 0, db.OpenTransactionCount())
 }
 
 func TestTxTimeoutKillsTransactions(t *testing.T) {
@@ -17,12 +17,17 @@ limitations under the License.
 package tabletserver
 
 import (
+	"bytes"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 
 	"vitess.io/vitess/go/vt/callerid"
@@ -60,9 +65,9 @@ func testHandler(req *http.Request, t *testing.T) {
 			ImmediateCaller: callerid.NewImmediateCallerID("immediate-caller"),
 			StartTime:       time.Now(),
 			Conclusion:      "unknown",
-			Queries:         []string{"select * from test"},
 		},
 	}
+	txConn.txProps.RecordQuery("select * from test")
 	txConn.txProps.EndTime = txConn.txProps.StartTime
 	response = httptest.NewRecorder()
 	tabletenv.TxLogger.Send(txConn)
@@ -102,3 +107,34 @@ func TestTxlogzHandlerWithLargeLimit(t *testing.T) {
 	req, _ := http.NewRequest("GET", "/txlogz?timeout=0&limit=10000000", nil)
 	testHandler(req, t)
 }
+
+func TestStatefulConnectionLogf(t *testing.T) {
+	start := time.Date(2017, time.January, 1, 1, 2, 3, 0, time.UTC)
+	txConn := &StatefulConnection{
+		ConnID: 123456,
+		txProps: &tx.Properties{
+			StartTime:  start,
+			EndTime:    start.Add(time.Second),
+			Conclusion: tx.TxCommit.Name(),
+		},
+	}
+	txConn.txProps.RecordQuery("update t set name = 'pii' where id = 1")
+	logf := func(params url.Values) string {
+		var buf bytes.Buffer
+		require.NoError(t, txConn.Logf(&buf, params))
+		return buf.String()
+	}
+
+	assert.Equal(t, "123456\t'<nil>'\t'<nil>'\tJan  1 01:02:03.000000\tJan  1 01:02:04.000000\t1.000000\tcommit\tupdate t set name = 'pii' where id = 1\t\n", logf(nil))
+	assert.Equal(t, "123456\t'<nil>'\t'<nil>'\tJan  1 01:02:03.000000\tJan  1 01:02:04.000000\t1.000000\tcommit\tupdate t set `name` = ? where id = ?\t\n", logf(url.Values{"redact": {"true"}}))
+	assert.Contains(t, txConn.txProps.Queries.Statements()[0], "pii")
+	assert.Empty(t, logf(url.Values{"errors_only": {"true"}}))
+	assert.Empty(t, logf(url.Values{"tables": {"u"}}))
+	assert.Empty(t, logf(url.Values{"min_duration": {"2s"}}))
+
+	txConn.txProps.Conclusion = tx.TxRollback.Name()
+	assert.NotEmpty(t, logf(url.Values{"errors_only": {"true"}, "tables": {"t"}}))
+
+	txConn.txProps = nil
+	assert.Empty(t, logf(nil))
+}
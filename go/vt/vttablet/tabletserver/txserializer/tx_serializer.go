@@ -61,6 +61,11 @@ type TxSerializer struct {
 	maxQueueSize           int
 	maxGlobalQueueSize     int
 	concurrentTransactions int
+	maxWait                time.Duration
+	// maxQueueSizePerTable and maxWaitPerTable override maxQueueSize and
+	// maxWait for the rows of some tables.
+	maxQueueSizePerTable map[string]int
+	maxWaitPerTable      map[string]time.Duration
 
 	// waits stores how many times a transaction was queued because another
 	// transaction was already in flight for the same row (range).
@@ -77,8 +82,11 @@ type TxSerializer struct {
 	// been rejected due to exceeding the max queue size per row (range).
 	//
 	// globalQueueExceeded is the same as queueExceeded but for the global queue.
-	waits, waitsDryRun, queueExceeded, queueExceededDryRun *stats.CountersWithSingleLabel
-	globalQueueExceeded, globalQueueExceededDryRun         *stats.Counter
+	//
+	// waitTimeouts counts per table how many transactions were rejected because
+	// they were queued for longer than the max wait.
+	waits, waitsDryRun, queueExceeded, queueExceededDryRun, waitTimeouts *stats.CountersWithSingleLabel
+	globalQueueExceeded, globalQueueExceededDryRun                       *stats.Counter
 
 	log                          *logutil.ThrottledLogger
 	logDryRun                    *logutil.ThrottledLogger
@@ -94,6 +102,10 @@ type TxSerializer struct {
 // New returns a TxSerializer object.
 func New(env tabletenv.Env) *TxSerializer {
 	config := env.Config()
+	maxWaitPerTable := make(map[string]time.Duration, len(config.HotRowProtection.MaxWaitSecondsPerTable))
+	for table, maxWait := range config.HotRowProtection.MaxWaitSecondsPerTable {
+		maxWaitPerTable[table] = maxWait.Get()
+	}
 	return &TxSerializer{
 		env:                    env,
 		ConsolidatorCache:      sync2.NewConsolidatorCache(1000),
@@ -101,6 +113,9 @@ func New(env tabletenv.Env) *TxSerializer {
 		maxQueueSize:           config.HotRowProtection.MaxQueueSize,
 		maxGlobalQueueSize:     config.HotRowProtection.MaxGlobalQueueSize,
 		concurrentTransactions: config.HotRowProtection.MaxConcurrency,
+		maxWait:                config.HotRowProtection.MaxWaitSeconds.Get(),
+		maxQueueSizePerTable:   config.HotRowProtection.MaxQueueSizePerTable,
+		maxWaitPerTable:        maxWaitPerTable,
 		waits: env.Exporter().NewCountersWithSingleLabel(
 			"TxSerializerWaits",
 			"Number of times a transaction was queued because another transaction was already in flight for the same row range",
@@ -117,6 +132,10 @@ func New(env tabletenv.Env) *TxSerializer {
 			"TxSerializerQueueExceededDryRun",
 			"Dry-run Number of transactions that were rejected because the max queue size was exceeded",
 			"table_name"),
+		waitTimeouts: env.Exporter().NewCountersWithSingleLabel(
+			"TxSerializerWaitTimeouts",
+			"Number of transactions that were rejected because they were queued for longer than the max wait",
+			"table_name"),
 		globalQueueExceeded: env.Exporter().NewCounter(
 			"TxSerializerGlobalQueueExceeded",
 			"Number of transactions that were rejected on the global queue because of exceeding the max queue size per row range"),
@@ -181,14 +200,14 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 		}
 	}
 
-	if q.size >= txs.maxQueueSize {
+	if maxQueueSize := txs.queueSize(table); q.size >= maxQueueSize {
 		if txs.dryRun {
 			txs.queueExceededDryRun.Add(table, 1)
-			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+			txs.logQueueExceededDryRun.Warningf("Would have rejected BeginExecute RPC because there are too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, maxQueueSize, key)
 		} else {
 			txs.queueExceeded.Add(table, 1)
 			return false, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
-				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, txs.maxQueueSize, key)
+				"hot row protection: too many queued transactions (%d >= %d) for the same row (table + WHERE clause: '%v')", q.size, maxQueueSize, key)
 		}
 	}
 
@@ -235,12 +254,40 @@ func (txs *TxSerializer) lockLocked(ctx context.Context, key, table string) (boo
 
 	// Blocking wait for the next available slot.
 	txs.waits.Add(table, 1)
+	maxWait := txs.wait(table)
+	var timeout <-chan time.Time
+	if maxWait > 0 {
+		timer := time.NewTimer(maxWait)
+		defer timer.Stop()
+		timeout = timer.C
+	}
 	select {
 	case q.availableSlots <- struct{}{}:
 		return true, nil
 	case <-ctx.Done():
 		return true, ctx.Err()
+	case <-timeout:
+		txs.waitTimeouts.Add(table, 1)
+		return true, vterrors.Errorf(vtrpcpb.Code_RESOURCE_EXHAUSTED,
+			"hot row protection: queued for longer than %v for the same row (table + WHERE clause: '%v')", maxWait, key)
+	}
+}
+
+// queueSize returns the max queue size for the rows of table.
+func (txs *TxSerializer) queueSize(table string) int {
+	if size, ok := txs.maxQueueSizePerTable[table]; ok {
+		return size
+	}
+	return txs.maxQueueSize
+}
+
+// wait returns how long a transaction may be queued for the rows of table,
+// 0 if there is no limit.
+func (txs *TxSerializer) wait(table string) time.Duration {
+	if maxWait, ok := txs.maxWaitPerTable[table]; ok {
+		return maxWait
 	}
+	return txs.maxWait
 }
 
 func (txs *TxSerializer) unlock(key string) {
@@ -39,6 +39,7 @@ func resetVariables(txs *TxSerializer) {
 	txs.waitsDryRun.ResetAll()
 	txs.queueExceeded.ResetAll()
 	txs.queueExceededDryRun.ResetAll()
+	txs.waitTimeouts.ResetAll()
 	txs.globalQueueExceeded.Reset()
 	txs.globalQueueExceededDryRun.Reset()
 }
@@ -510,6 +511,89 @@ func TestTxSerializerGlobalQueueOverflow(t *testing.T) {
 	done2()
 }
 
+// TestTxSerializerPerTableQueueSize verifies that the max queue size of a
+// table overrides the default one.
+func TestTxSerializerPerTableQueueSize(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 2
+	config.HotRowProtection.MaxGlobalQueueSize = 4
+	config.HotRowProtection.MaxConcurrency = 2
+	config.HotRowProtection.MaxQueueSizePerTable = map[string]int{"t2": 1}
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	// t1 uses the default queue size of 2.
+	done1, _, err1 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err1 != nil {
+		t.Error(err1)
+	}
+	done2, _, err2 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err2 != nil {
+		t.Error(err2)
+	}
+
+	// t2 has a queue size of 1.
+	done3, _, err3 := txs.Wait(context.Background(), "t2 where1", "t2")
+	if err3 != nil {
+		t.Error(err3)
+	}
+	_, _, err4 := txs.Wait(context.Background(), "t2 where1", "t2")
+	if got, want := vterrors.Code(err4), vtrpcpb.Code_RESOURCE_EXHAUSTED; got != want {
+		t.Errorf("wrong error code: got = %v, want = %v", got, want)
+	}
+	if got, want := err4.Error(), "hot row protection: too many queued transactions (1 >= 1) for the same row (table + WHERE clause: 't2 where1')"; got != want {
+		t.Errorf("transaction rejected with wrong error: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.queueExceeded.Counts()["t2"], int64(1); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+
+	done1()
+	done2()
+	done3()
+}
+
+// TestTxSerializerMaxWait verifies that a transaction is rejected if it is
+// queued for longer than the max wait of its table.
+func TestTxSerializerMaxWait(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.HotRowProtection.MaxQueueSize = 2
+	config.HotRowProtection.MaxGlobalQueueSize = 2
+	config.HotRowProtection.MaxConcurrency = 1
+	config.HotRowProtection.MaxWaitSecondsPerTable = map[string]tabletenv.Seconds{"t1": 0.01}
+	txs := New(tabletenv.NewEnv(config, "TxSerializerTest"))
+	resetVariables(txs)
+
+	// tx1.
+	done1, waited1, err1 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if err1 != nil {
+		t.Error(err1)
+	}
+	if waited1 {
+		t.Errorf("tx1 must never wait: %v", waited1)
+	}
+
+	// tx2 (same row range as tx1) gives up after the max wait.
+	_, waited2, err2 := txs.Wait(context.Background(), "t1 where1", "t1")
+	if !waited2 {
+		t.Errorf("tx2 must have waited: %v", waited2)
+	}
+	if got, want := vterrors.Code(err2), vtrpcpb.Code_RESOURCE_EXHAUSTED; got != want {
+		t.Errorf("wrong error code: got = %v, want = %v", got, want)
+	}
+	if got, want := err2.Error(), "hot row protection: queued for longer than 10ms for the same row (table + WHERE clause: 't1 where1')"; got != want {
+		t.Errorf("transaction rejected with wrong error: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.waitTimeouts.Counts()["t1"], int64(1); got != want {
+		t.Errorf("variable not incremented: got = %v, want = %v", got, want)
+	}
+	if got, want := txs.Pending("t1 where1"), 1; got != want {
+		t.Errorf("tx2 must have left the queue: got = %v, want = %v", got, want)
+	}
+
+	done1()
+}
+
 func TestTxSerializerPending(t *testing.T) {
 	config := tabletenv.NewDefaultConfig()
 	config.HotRowProtection.MaxQueueSize = 1
@@ -0,0 +1,186 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/stats"
+	"vitess.io/vitess/go/vt/discovery"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	throttlerdatapb "vitess.io/vitess/go/vt/proto/throttlerdata"
+)
+
+var (
+	adaptiveMaxRate        = stats.NewGauge("TransactionThrottlerMaxRate", "Transactions per second the adaptive transaction throttler currently allows")
+	adaptiveReplicationLag = stats.NewGauge("TransactionThrottlerReplicationLagMs", "Replication lag, in milliseconds, the adaptive transaction throttler last adapted the rate to")
+)
+
+// adaptiveController is a Controller that adapts the rate in a feedback
+// loop on the replication lag:
+//   - While the lag is at most target_replication_lag_sec and the
+//     transactions use most of the allowed rate, the rate is increased by
+//     max_increase at most every min_duration_between_increases_sec.
+//   - While the lag is above the target, the rate is decreased in proportion
+//     to the excess lag at most every min_duration_between_decreases_sec,
+//     by emergency_decrease at most.
+//   - Once the lag reaches max_replication_lag_sec, the rate is decreased
+//     by emergency_decrease.
+//
+// The rate starts at initial_rate and never drops below 1 transaction per
+// second, so that the effect of the writes on the lag can still be measured.
+// Transactions are let through by a token bucket which holds up to one
+// second worth of transactions.
+type adaptiveController struct {
+	config *throttlerdatapb.Configuration
+
+	mu sync.Mutex
+	// lags holds the last replication lag of each replica in
+	// milliseconds, by tablet key.
+	lags map[string]int64
+	rate float64
+
+	tokens     float64
+	lastRefill time.Time
+
+	// admitted counts the transactions let through since intervalStart,
+	// to tell whether they use most of the allowed rate.
+	admitted      int64
+	intervalStart time.Time
+
+	lastIncrease time.Time
+	lastDecrease time.Time
+}
+
+func newAdaptiveController(config *throttlerdatapb.Configuration) (Controller, error) {
+	c := &adaptiveController{
+		config: config,
+		lags:   make(map[string]int64),
+		rate:   float64(config.InitialRate),
+		tokens: float64(config.InitialRate),
+	}
+	adaptiveMaxRate.Set(config.InitialRate)
+	return c, nil
+}
+
+// RecordReplicationLag is part of the Controller interface.
+func (c *adaptiveController) RecordReplicationLag(now time.Time, ts *discovery.LegacyTabletStats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !ts.Up || ts.Stats == nil {
+		delete(c.lags, ts.Key)
+	} else {
+		c.lags[ts.Key] = replicationLagMs(ts.Stats)
+	}
+	c.adapt(now)
+}
+
+// replicationLagMs returns the replication lag reported by a replica, in
+// milliseconds. The lag measured by the heartbeats is sub-second precise:
+// seconds_behind_master is only used if the replica doesn't report it.
+func replicationLagMs(stats *querypb.RealtimeStats) int64 {
+	if stats.ReplicationLagMs > 0 {
+		return stats.ReplicationLagMs
+	}
+	return int64(stats.SecondsBehindMaster) * 1000
+}
+
+func (c *adaptiveController) adapt(now time.Time) {
+	lags := make([]int64, 0, len(c.lags))
+	for _, lag := range c.lags {
+		lags = append(lags, lag)
+	}
+	lag := replicationLag(lags, int(c.config.IgnoreNSlowestReplicas))
+	adaptiveReplicationLag.Set(lag)
+
+	sinceDecrease := now.Sub(c.lastDecrease)
+	switch {
+	case lag >= c.config.MaxReplicationLagSec*1000:
+		if sinceDecrease < time.Duration(c.config.MinDurationBetweenDecreasesSec)*time.Second {
+			return
+		}
+		c.setRate(now, c.rate*(1-c.config.EmergencyDecrease))
+		c.lastDecrease = now
+	case lag > c.config.TargetReplicationLagSec*1000:
+		if sinceDecrease < time.Duration(c.config.MinDurationBetweenDecreasesSec)*time.Second {
+			return
+		}
+		factor := math.Max(float64(c.config.TargetReplicationLagSec*1000)/float64(lag), 1-c.config.EmergencyDecrease)
+		c.setRate(now, c.rate*factor)
+		c.lastDecrease = now
+	default:
+		minInterval := time.Duration(c.config.MinDurationBetweenIncreasesSec) * time.Second
+		if now.Sub(c.lastIncrease) < minInterval || sinceDecrease < minInterval {
+			return
+		}
+		if c.intervalStart.IsZero() {
+			c.intervalStart = now
+			return
+		}
+		// Do not raise a rate the transactions do not reach.
+		elapsed := now.Sub(c.intervalStart).Seconds()
+		if elapsed <= 0 || float64(c.admitted)/elapsed < c.rate*c.config.MaxRateApproachThreshold {
+			c.admitted = 0
+			c.intervalStart = now
+			return
+		}
+		c.setRate(now, c.rate*(1+c.config.MaxIncrease))
+		c.lastIncrease = now
+	}
+}
+
+func (c *adaptiveController) setRate(now time.Time, rate float64) {
+	c.rate = math.Max(rate, 1)
+	c.tokens = math.Min(c.tokens, c.rate)
+	c.admitted = 0
+	c.intervalStart = now
+	adaptiveMaxRate.Set(int64(c.rate))
+}
+
+// Throttle is part of the Controller interface.
+func (c *adaptiveController) Throttle(now time.Time) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.lastRefill.IsZero() {
+		c.lastRefill = now
+	}
+	if elapsed := now.Sub(c.lastRefill).Seconds(); elapsed > 0 {
+		c.tokens = math.Min(c.tokens+elapsed*c.rate, math.Max(c.rate, 1))
+		c.lastRefill = now
+	}
+	if c.tokens < 1 {
+		return true
+	}
+	c.tokens--
+	c.admitted++
+	return false
+}
+
+// MaxRate is part of the Controller interface.
+func (c *adaptiveController) MaxRate() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return int64(c.rate)
+}
+
+// Close is part of the Controller interface.
+func (c *adaptiveController) Close() {}
@@ -0,0 +1,191 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	throttlerdatapb "vitess.io/vitess/go/vt/proto/throttlerdata"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+var t0 = time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+
+func adaptiveTestConfig() *throttlerdatapb.Configuration {
+	config := throttler.DefaultMaxReplicationLagModuleConfig().Configuration
+	config.MaxReplicationLagSec = 10
+	config.InitialRate = 10
+	config.MinDurationBetweenIncreasesSec = 10
+	config.MinDurationBetweenDecreasesSec = 5
+	return &config
+}
+
+// replicaStats returns the stats of a replica which reports lag, in
+// seconds, without a heartbeat lag.
+func replicaStats(key string, lag uint32) *discovery.LegacyTabletStats {
+	return &discovery.LegacyTabletStats{
+		Key:    key,
+		Target: &querypb.Target{TabletType: topodatapb.TabletType_REPLICA},
+		Up:     true,
+		Stats:  &querypb.RealtimeStats{SecondsBehindMaster: lag},
+	}
+}
+
+func newTestAdaptiveController(t *testing.T, config *throttlerdatapb.Configuration) *adaptiveController {
+	c, err := newAdaptiveController(config)
+	require.NoError(t, err)
+	return c.(*adaptiveController)
+}
+
+// admit returns how many transactions the controller lets through at now.
+func admit(c *adaptiveController, now time.Time, attempts int) int {
+	admitted := 0
+	for i := 0; i < attempts; i++ {
+		if !c.Throttle(now) {
+			admitted++
+		}
+	}
+	return admitted
+}
+
+func TestAdaptiveControllerThrottle(t *testing.T) {
+	c := newTestAdaptiveController(t, adaptiveTestConfig())
+
+	// The bucket holds one second worth of transactions.
+	assert.Equal(t, 10, admit(c, t0, 20))
+	assert.Equal(t, 1, admit(c, t0.Add(100*time.Millisecond), 20))
+	assert.Equal(t, 10, admit(c, t0.Add(10*time.Second), 20))
+	assert.EqualValues(t, 10, c.MaxRate())
+}
+
+func TestAdaptiveControllerDecrease(t *testing.T) {
+	c := newTestAdaptiveController(t, adaptiveTestConfig())
+	c.rate = 100
+
+	// Above the target, the rate decreases in proportion to the excess lag.
+	c.RecordReplicationLag(t0, replicaStats("r1", 4))
+	assert.EqualValues(t, 50, c.MaxRate())
+	assert.EqualValues(t, 4000, adaptiveReplicationLag.Get())
+
+	// Decreases are spaced by min_duration_between_decreases_sec.
+	c.RecordReplicationLag(t0.Add(time.Second), replicaStats("r1", 20))
+	assert.EqualValues(t, 50, c.MaxRate())
+
+	// Beyond the max lag, the rate is decreased by emergency_decrease.
+	c.RecordReplicationLag(t0.Add(5*time.Second), replicaStats("r1", 20))
+	assert.EqualValues(t, 25, c.MaxRate())
+	assert.EqualValues(t, 25, adaptiveMaxRate.Get())
+
+	// The rate never drops below 1.
+	for i := 2; i < 10; i++ {
+		c.RecordReplicationLag(t0.Add(time.Duration(i)*5*time.Second), replicaStats("r1", 20))
+	}
+	assert.EqualValues(t, 1, c.MaxRate())
+}
+
+func TestAdaptiveControllerIncrease(t *testing.T) {
+	c := newTestAdaptiveController(t, adaptiveTestConfig())
+
+	// The first update starts the interval over which the rate is measured.
+	c.RecordReplicationLag(t0, replicaStats("r1", 0))
+	assert.EqualValues(t, 10, c.MaxRate())
+
+	// The rate is not raised while the transactions do not reach it.
+	admit(c, t0.Add(5*time.Second), 1)
+	c.RecordReplicationLag(t0.Add(10*time.Second), replicaStats("r1", 0))
+	assert.EqualValues(t, 10, c.MaxRate())
+
+	// It is raised by max_increase once they do.
+	for i := 11; i <= 20; i++ {
+		admit(c, t0.Add(time.Duration(i)*time.Second), 20)
+	}
+	c.RecordReplicationLag(t0.Add(20*time.Second), replicaStats("r1", 0))
+	assert.EqualValues(t, 20, c.MaxRate())
+
+	// Increases are spaced by min_duration_between_increases_sec.
+	for i := 21; i <= 25; i++ {
+		admit(c, t0.Add(time.Duration(i)*time.Second), 40)
+	}
+	c.RecordReplicationLag(t0.Add(25*time.Second), replicaStats("r1", 0))
+	assert.EqualValues(t, 20, c.MaxRate())
+}
+
+func TestAdaptiveControllerReplicas(t *testing.T) {
+	config := adaptiveTestConfig()
+	config.IgnoreNSlowestReplicas = 1
+	c := newTestAdaptiveController(t, config)
+	c.rate = 100
+
+	// The slowest replica is ignored.
+	c.RecordReplicationLag(t0, replicaStats("r1", 0))
+	c.RecordReplicationLag(t0, replicaStats("r2", 30))
+	assert.EqualValues(t, 100, c.MaxRate())
+
+	// Unless it is the only one left.
+	down := replicaStats("r1", 0)
+	down.Up = false
+	c.RecordReplicationLag(t0, down)
+	assert.EqualValues(t, 50, c.MaxRate())
+}
+
+func TestAdaptiveControllerHeartbeatLag(t *testing.T) {
+	c := newTestAdaptiveController(t, adaptiveTestConfig())
+	c.rate = 100
+
+	// The heartbeat lag is preferred: 2.5s is above the 2s target, while
+	// seconds_behind_master rounds it down to the target.
+	ts := replicaStats("r1", 2)
+	ts.Stats.ReplicationLagMs = 2500
+	c.RecordReplicationLag(t0, ts)
+	assert.EqualValues(t, 80, c.MaxRate())
+	assert.EqualValues(t, 2500, adaptiveReplicationLag.Get())
+
+	// seconds_behind_master is used without a heartbeat lag.
+	c.RecordReplicationLag(t0.Add(5*time.Second), replicaStats("r1", 2))
+	assert.EqualValues(t, 80, c.MaxRate())
+	assert.EqualValues(t, 2000, adaptiveReplicationLag.Get())
+}
+
+func TestReplicationLag(t *testing.T) {
+	assert.EqualValues(t, 0, replicationLag(nil, 0))
+	assert.EqualValues(t, 7, replicationLag([]int64{3, 7, 5}, 0))
+	assert.EqualValues(t, 5, replicationLag([]int64{3, 7, 5}, 1))
+	assert.EqualValues(t, 3, replicationLag([]int64{3, 7, 5}, 5))
+}
+
+func TestTxThrottlerAlgorithm(t *testing.T) {
+	config := tabletenv.NewDefaultConfig()
+	config.EnableTxThrottler = true
+	config.TxThrottlerHealthCheckCells = []string{"cell1"}
+
+	config.TxThrottlerAlgorithm = "adaptive"
+	_, err := tryCreateTxThrottler(config, nil)
+	require.NoError(t, err)
+
+	config.TxThrottlerAlgorithm = "unknown"
+	_, err = tryCreateTxThrottler(config, nil)
+	assert.EqualError(t, err, `unknown transaction throttler algorithm: "unknown"`)
+}
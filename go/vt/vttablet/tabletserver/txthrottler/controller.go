@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package txthrottler
+
+import (
+	"sort"
+	"time"
+
+	"vitess.io/vitess/go/vt/discovery"
+	"vitess.io/vitess/go/vt/throttler"
+
+	throttlerdatapb "vitess.io/vitess/go/vt/proto/throttlerdata"
+)
+
+// Controller decides how many transactions per second the TxThrottler lets
+// through. It adapts the rate to the replication lag the replicas report
+// in their health updates. When heartbeats are enabled, this lag is the
+// time the replicas take to apply the writes of the master.
+type Controller interface {
+	// RecordReplicationLag feeds the health update of a replica to the controller.
+	RecordReplicationLag(now time.Time, ts *discovery.LegacyTabletStats)
+
+	// Throttle returns true if a transaction starting at now should back off.
+	// Calls are serialized by the TxThrottler.
+	Throttle(now time.Time) bool
+
+	// MaxRate returns the number of transactions per second currently allowed.
+	MaxRate() int64
+
+	// Close releases the resources of the controller.
+	Close()
+}
+
+// ControllerFactory creates a Controller from the configuration of the TxThrottler.
+type ControllerFactory func(config *throttlerdatapb.Configuration) (Controller, error)
+
+var controllerFactories = make(map[string]ControllerFactory)
+
+// RegisterController registers the factory of a controller, which can then
+// be selected with -tx_throttler_algorithm. It must be called at init time.
+func RegisterController(name string, factory ControllerFactory) {
+	if _, ok := controllerFactories[name]; ok {
+		panic("txthrottler: controller " + name + " is already registered")
+	}
+	controllerFactories[name] = factory
+}
+
+func init() {
+	RegisterController("max_replication_lag", newThrottlerController)
+	RegisterController("adaptive", newAdaptiveController)
+}
+
+// throttlerController is the Controller that wraps the throttler found in
+// vitess/go/vt/throttler, which adapts the rate in its MaxReplicationLagModule.
+type throttlerController struct {
+	throttler ThrottlerInterface
+}
+
+func newThrottlerController(config *throttlerdatapb.Configuration) (Controller, error) {
+	t, err := throttlerFactory(
+		TxThrottlerName,
+		"TPS",                           /* unit */
+		1,                               /* threadCount */
+		throttler.MaxRateModuleDisabled, /* maxRate */
+		config.MaxReplicationLagSec /* maxReplicationLag */)
+	if err != nil {
+		return nil, err
+	}
+	if err := t.UpdateConfiguration(config, true /* copyZeroValues */); err != nil {
+		t.Close()
+		return nil, err
+	}
+	return &throttlerController{throttler: t}, nil
+}
+
+// RecordReplicationLag is part of the Controller interface.
+func (c *throttlerController) RecordReplicationLag(now time.Time, ts *discovery.LegacyTabletStats) {
+	c.throttler.RecordReplicationLag(now, ts)
+}
+
+// Throttle is part of the Controller interface.
+func (c *throttlerController) Throttle(now time.Time) bool {
+	return c.throttler.Throttle(0 /* threadId */) > 0
+}
+
+// MaxRate is part of the Controller interface.
+func (c *throttlerController) MaxRate() int64 {
+	return c.throttler.MaxRate()
+}
+
+// Close is part of the Controller interface.
+func (c *throttlerController) Close() {
+	c.throttler.Close()
+}
+
+// replicationLag returns the highest lag among lags, after ignoring the
+// ignoreSlowest highest ones. At least one lag is always kept.
+func replicationLag(lags []int64, ignoreSlowest int) int64 {
+	if len(lags) == 0 {
+		return 0
+	}
+	sorted := append([]int64(nil), lags...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] > sorted[j] })
+	if ignoreSlowest >= len(sorted) {
+		ignoreSlowest = len(sorted) - 1
+	}
+	return sorted[ignoreSlowest]
+}
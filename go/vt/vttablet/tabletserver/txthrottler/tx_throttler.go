@@ -25,6 +25,7 @@ import (
 
 	"github.com/golang/protobuf/proto"
 
+	"vitess.io/vitess/go/stats"
 	"vitess.io/vitess/go/vt/discovery"
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/throttler"
@@ -37,8 +38,9 @@ import (
 )
 
 // TxThrottler throttles transactions based on replication lag.
-// It's a thin wrapper around the throttler found in vitess/go/vt/throttler.
-// It uses a discovery.LegacyHealthCheck to send replication-lag updates to the wrapped throttler.
+// It's a thin wrapper around a Controller, by default the one that wraps the throttler
+// found in vitess/go/vt/throttler.
+// It uses a discovery.LegacyHealthCheck to send replication-lag updates to the controller.
 //
 // Intended Usage:
 //   // Assuming topoServer is a topo.Server variable pointing to a Vitess topology server.
@@ -119,6 +121,7 @@ func tryCreateTxThrottler(config *tabletenv.TabletConfig, topoServer *topo.Serve
 		enabled:          true,
 		topoServer:       topoServer,
 		throttlerConfig:  &throttlerConfig,
+		algorithm:        config.TxThrottlerAlgorithm,
 		healthCheckCells: healthCheckCells,
 	})
 }
@@ -133,6 +136,9 @@ type txThrottlerConfig struct {
 
 	topoServer      *topo.Server
 	throttlerConfig *throttlerdatapb.Configuration
+	// algorithm is the name of the registered Controller that adapts the
+	// transaction rate.
+	algorithm string
 	// healthCheckCells stores the cell names in which running vttablets will be monitored for
 	// replication lag.
 	healthCheckCells []string
@@ -162,10 +168,11 @@ type TopologyWatcherInterface interface {
 
 // txThrottlerState holds the state of an open TxThrottler object.
 type txThrottlerState struct {
-	// throttleMu serializes calls to throttler.Throttler.Throttle(threadId).
-	// That method is required to be called in serial for each threadId.
+	// throttleMu serializes calls to Controller.Throttle().
+	// throttler.Throttler.Throttle(threadId) is required to be called in serial
+	// for each threadId.
 	throttleMu sync.Mutex
-	throttler  ThrottlerInterface
+	controller Controller
 
 	healthCheck      discovery.LegacyHealthCheck
 	topologyWatchers []TopologyWatcherInterface
@@ -198,6 +205,9 @@ func resetTxThrottlerFactories() {
 	}
 }
 
+// throttledTransactions counts the transactions the TxThrottler rejected.
+var throttledTransactions = stats.NewCounter("TransactionThrottlerThrottled", "Count of transactions throttled by the transaction throttler")
+
 // TxThrottlerName is the name the wrapped go/vt/throttler object will be registered with
 // go/vt/throttler.GlobalManager.
 const TxThrottlerName = "TransactionThrottler"
@@ -212,6 +222,9 @@ func newTxThrottler(config *txThrottlerConfig) (*TxThrottler, error) {
 		if len(config.healthCheckCells) == 0 {
 			return nil, fmt.Errorf("empty healthCheckCells given. %+v", config)
 		}
+		if _, ok := controllerFactories[config.algorithm]; !ok {
+			return nil, fmt.Errorf("unknown transaction throttler algorithm: %q", config.algorithm)
+		}
 	}
 	return &TxThrottler{
 		config: config,
@@ -258,26 +271,21 @@ func (t *TxThrottler) Throttle() (result bool) {
 	if t.state == nil {
 		panic("BUG: Throttle() called on a closed TxThrottler")
 	}
-	return t.state.throttle()
+	result = t.state.throttle()
+	if result {
+		throttledTransactions.Add(1)
+	}
+	return result
 }
 
 func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
 ) (*txThrottlerState, error) {
-	t, err := throttlerFactory(
-		TxThrottlerName,
-		"TPS",                           /* unit */
-		1,                               /* threadCount */
-		throttler.MaxRateModuleDisabled, /* maxRate */
-		config.throttlerConfig.MaxReplicationLagSec /* maxReplicationLag */)
+	controller, err := controllerFactories[config.algorithm](config.throttlerConfig)
 	if err != nil {
 		return nil, err
 	}
-	if err := t.UpdateConfiguration(config.throttlerConfig, true /* copyZeroValues */); err != nil {
-		t.Close()
-		return nil, err
-	}
 	result := &txThrottlerState{
-		throttler: t,
+		controller: controller,
 	}
 	result.healthCheck = healthCheckFactory()
 	result.healthCheck.SetListener(result, false /* sendDownEvents */)
@@ -299,13 +307,13 @@ func newTxThrottlerState(config *txThrottlerConfig, keyspace, shard string,
 }
 
 func (ts *txThrottlerState) throttle() bool {
-	if ts.throttler == nil {
+	if ts.controller == nil {
 		panic("BUG: throttle called after deallocateResources was called.")
 	}
-	// Serialize calls to ts.throttle.Throttle()
+	// Serialize calls to ts.controller.Throttle()
 	ts.throttleMu.Lock()
 	defer ts.throttleMu.Unlock()
-	return ts.throttler.Throttle(0 /* threadId */) > 0
+	return ts.controller.Throttle(time.Now())
 }
 
 func (ts *txThrottlerState) deallocateResources() {
@@ -321,9 +329,9 @@ func (ts *txThrottlerState) deallocateResources() {
 	ts.healthCheck = nil
 
 	// After ts.healthCheck is closed txThrottlerState.StatsUpdate() is guaranteed not
-	// to be executing, so we can safely close the throttler.
-	ts.throttler.Close()
-	ts.throttler = nil
+	// to be executing, so we can safely close the controller.
+	ts.controller.Close()
+	ts.controller = nil
 }
 
 // StatsUpdate is part of the LegacyHealthCheckStatsListener interface.
@@ -337,5 +345,5 @@ func (ts *txThrottlerState) StatsUpdate(tabletStats *discovery.LegacyTabletStats
 	if tabletStats.Target.TabletType != topodatapb.TabletType_REPLICA {
 		return
 	}
-	ts.throttler.RecordReplicationLag(time.Now(), tabletStats)
+	ts.controller.RecordReplicationLag(time.Now(), tabletStats)
 }
@@ -48,6 +48,10 @@ type Plan struct {
 	// Filters is the list of filters to be applied to the columns
 	// of the table.
 	Filters []Filter
+
+	// DMLTypes is the list of statement types for which row events
+	// are sent. If empty, the row events of all statements are sent.
+	DMLTypes []binlogdatapb.VEventType
 }
 
 // Opcode enumerates the operators supported in a where clause
@@ -58,6 +62,9 @@ const (
 	Equal = Opcode(iota)
 	// VindexMatch is used for an in_keyrange() construct
 	VindexMatch
+	// ExprMatch is used to filter the row on an arbitrary
+	// expression, which is evaluated by the evalengine
+	ExprMatch
 )
 
 // Filter contains opcodes for filtering.
@@ -74,6 +81,10 @@ type Filter struct {
 	Vindex        vindexes.Vindex
 	VindexColumns []int
 	KeyRange      *topodatapb.KeyRange
+
+	// Expr is the expression for ExprMatch. Its columns
+	// refer to the columns of the table.
+	Expr evalengine.Expr
 }
 
 // ColExpr represents a column expression.
@@ -108,6 +119,19 @@ func (plan *Plan) fields() []*querypb.Field {
 	return fields
 }
 
+// sendsDML returns true if the row events of the statement type must be sent.
+func (plan *Plan) sendsDML(dmlType binlogdatapb.VEventType) bool {
+	if len(plan.DMLTypes) == 0 {
+		return true
+	}
+	for _, typ := range plan.DMLTypes {
+		if typ == dmlType {
+			return true
+		}
+	}
+	return false
+}
+
 // filter filters the row against the plan. It returns false if the row did not match.
 // If the row matched, it returns the columns to be sent.
 func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error) {
@@ -129,6 +153,14 @@ func (plan *Plan) filter(values []sqltypes.Value) (bool, []sqltypes.Value, error
 			if !key.KeyRangeContains(filter.KeyRange, ksid) {
 				return false, nil, nil
 			}
+		case ExprMatch:
+			result, err := filter.Expr.Evaluate(evalengine.ExpressionEnv{Row: values})
+			if err != nil {
+				return false, nil, err
+			}
+			if !result.IsTrue() {
+				return false, nil, nil
+			}
 		}
 	}
 
@@ -242,24 +274,49 @@ func tableMatches(table sqlparser.TableName, dbname string, filter *binlogdatapb
 
 func buildPlan(ti *Table, vschema *localVSchema, filter *binlogdatapb.Filter) (*Plan, error) {
 	for _, rule := range filter.Rules {
+		var plan *Plan
+		var err error
 		switch {
 		case strings.HasPrefix(rule.Match, "/"):
 			expr := strings.Trim(rule.Match, "/")
-			result, err := regexp.MatchString(expr, ti.Name)
+			var result bool
+			result, err = regexp.MatchString(expr, ti.Name)
 			if err != nil {
 				return nil, err
 			}
 			if !result {
 				continue
 			}
-			return buildREPlan(ti, vschema, rule.Filter)
+			plan, err = buildREPlan(ti, vschema, rule.Filter)
 		case rule.Match == ti.Name:
-			return buildTablePlan(ti, vschema, rule.Filter)
+			plan, err = buildTablePlan(ti, vschema, rule.Filter)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, err
 		}
+		if err := plan.analyzeDMLTypes(rule.DmlTypes); err != nil {
+			return nil, err
+		}
+		return plan, nil
 	}
 	return nil, nil
 }
 
+// analyzeDMLTypes sets the statement types for which row events are sent.
+func (plan *Plan) analyzeDMLTypes(dmlTypes []binlogdatapb.VEventType) error {
+	for _, typ := range dmlTypes {
+		switch typ {
+		case binlogdatapb.VEventType_INSERT, binlogdatapb.VEventType_UPDATE, binlogdatapb.VEventType_DELETE:
+		default:
+			return fmt.Errorf("unsupported dml type: %v", typ)
+		}
+	}
+	plan.DMLTypes = dmlTypes
+	return nil
+}
+
 // buildREPlan handles cases where Match has a regular expression.
 // If so, the Filter can be an empty string or a keyrange, like "-80".
 func buildREPlan(ti *Table, vschema *localVSchema, filter string) (*Plan, error) {
@@ -366,38 +423,16 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 	for _, expr := range exprs {
 		switch expr := expr.(type) {
 		case *sqlparser.ComparisonExpr:
-			qualifiedName, ok := expr.Left.(*sqlparser.ColName)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			if !qualifiedName.Qualifier.IsEmpty() {
-				return fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
-			}
-			colnum, err := findColumn(plan.Table, qualifiedName.Name)
-			if err != nil {
-				return err
-			}
-			val, ok := expr.Right.(*sqlparser.Literal)
-			if !ok {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
-			}
-			//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
-			if val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal {
-				return fmt.Errorf("unexpected: %v", sqlparser.String(expr))
+			if filter, ok, err := plan.equalFilter(expr); ok || err != nil {
+				if err != nil {
+					return err
+				}
+				plan.Filters = append(plan.Filters, filter)
+				continue
 			}
-			pv, err := sqlparser.NewPlanValue(val)
-			if err != nil {
+			if err := plan.analyzeWhereExpr(expr); err != nil {
 				return err
 			}
-			resolved, err := pv.ResolveValue(nil)
-			if err != nil {
-				return err
-			}
-			plan.Filters = append(plan.Filters, Filter{
-				Opcode: Equal,
-				ColNum: colnum,
-				Value:  resolved,
-			})
 		case *sqlparser.FuncExpr:
 			if !expr.Name.EqualString("in_keyrange") {
 				return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
@@ -406,12 +441,156 @@ func (plan *Plan) analyzeWhere(vschema *localVSchema, where *sqlparser.Where) er
 				return err
 			}
 		default:
-			return fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+			if err := plan.analyzeWhereExpr(expr); err != nil {
+				return err
+			}
 		}
 	}
 	return nil
 }
 
+// equalFilter returns an Equal filter if expr compares a column with an
+// int or varbinary value. It returns false if expr is any other expression.
+func (plan *Plan) equalFilter(expr *sqlparser.ComparisonExpr) (Filter, bool, error) {
+	qualifiedName, ok := expr.Left.(*sqlparser.ColName)
+	if !ok || expr.Operator != sqlparser.EqualOp {
+		return Filter{}, false, nil
+	}
+	val, ok := expr.Right.(*sqlparser.Literal)
+	//StrVal is varbinary, we do not support varchar since we would have to implement all collation types
+	if !ok || (val.Type != sqlparser.IntVal && val.Type != sqlparser.StrVal) {
+		return Filter{}, false, nil
+	}
+	if !qualifiedName.Qualifier.IsEmpty() {
+		return Filter{}, false, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(qualifiedName))
+	}
+	colnum, err := findColumn(plan.Table, qualifiedName.Name)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	pv, err := sqlparser.NewPlanValue(val)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	resolved, err := pv.ResolveValue(nil)
+	if err != nil {
+		return Filter{}, false, err
+	}
+	return Filter{
+		Opcode: Equal,
+		ColNum: colnum,
+		Value:  resolved,
+	}, true, nil
+}
+
+// analyzeWhereExpr adds an ExprMatch filter for a condition that
+// is not handled by the other filters.
+func (plan *Plan) analyzeWhereExpr(expr sqlparser.Expr) error {
+	evalExpr, err := plan.whereExpr(expr)
+	if err != nil {
+		return err
+	}
+	plan.Filters = append(plan.Filters, Filter{
+		Opcode: ExprMatch,
+		Expr:   evalExpr,
+	})
+	return nil
+}
+
+var (
+	whereComparisons = map[sqlparser.ComparisonExprOperator]func() evalengine.BinaryExpr{
+		sqlparser.EqualOp:        func() evalengine.BinaryExpr { return &evalengine.EqualOp{} },
+		sqlparser.NotEqualOp:     func() evalengine.BinaryExpr { return &evalengine.NotEqualOp{} },
+		sqlparser.LessThanOp:     func() evalengine.BinaryExpr { return &evalengine.LessThanOp{} },
+		sqlparser.LessEqualOp:    func() evalengine.BinaryExpr { return &evalengine.LessEqualOp{} },
+		sqlparser.GreaterThanOp:  func() evalengine.BinaryExpr { return &evalengine.GreaterThanOp{} },
+		sqlparser.GreaterEqualOp: func() evalengine.BinaryExpr { return &evalengine.GreaterEqualOp{} },
+	}
+	whereArithmetic = map[sqlparser.BinaryExprOperator]func() evalengine.BinaryExpr{
+		sqlparser.PlusOp:  func() evalengine.BinaryExpr { return &evalengine.Addition{} },
+		sqlparser.MinusOp: func() evalengine.BinaryExpr { return &evalengine.Subtraction{} },
+		sqlparser.MultOp:  func() evalengine.BinaryExpr { return &evalengine.Multiplication{} },
+		sqlparser.DivOp:   func() evalengine.BinaryExpr { return &evalengine.Division{} },
+	}
+)
+
+// whereExpr converts a condition of the where clause to an expression
+// that is evaluated on the rows of the table.
+func (plan *Plan) whereExpr(expr sqlparser.Expr) (evalengine.Expr, error) {
+	binaryOp := func(op evalengine.BinaryExpr, left, right sqlparser.Expr) (evalengine.Expr, error) {
+		l, err := plan.whereExpr(left)
+		if err != nil {
+			return nil, err
+		}
+		r, err := plan.whereExpr(right)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.BinaryOp{Expr: op, Left: l, Right: r}, nil
+	}
+	switch node := expr.(type) {
+	case *sqlparser.AndExpr:
+		return binaryOp(&evalengine.AndOp{}, node.Left, node.Right)
+	case *sqlparser.OrExpr:
+		return binaryOp(&evalengine.OrOp{}, node.Left, node.Right)
+	case *sqlparser.NotExpr:
+		inner, err := plan.whereExpr(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.NotExpr{Expr: inner}, nil
+	case *sqlparser.IsExpr:
+		if node.Operator != sqlparser.IsNullOp && node.Operator != sqlparser.IsNotNullOp {
+			break
+		}
+		inner, err := plan.whereExpr(node.Expr)
+		if err != nil {
+			return nil, err
+		}
+		return &evalengine.IsNullExpr{Expr: inner, Negate: node.Operator == sqlparser.IsNotNullOp}, nil
+	case *sqlparser.ComparisonExpr:
+		if op, ok := whereComparisons[node.Operator]; ok {
+			return binaryOp(op(), node.Left, node.Right)
+		}
+		if node.Operator != sqlparser.InOp && node.Operator != sqlparser.NotInOp {
+			break
+		}
+		tuple, ok := node.Right.(sqlparser.ValTuple)
+		if !ok {
+			break
+		}
+		left, err := plan.whereExpr(node.Left)
+		if err != nil {
+			return nil, err
+		}
+		in := &evalengine.InExpr{Left: left, Negate: node.Operator == sqlparser.NotInOp}
+		for _, val := range tuple {
+			right, err := plan.whereExpr(val)
+			if err != nil {
+				return nil, err
+			}
+			in.Right = append(in.Right, right)
+		}
+		return in, nil
+	case *sqlparser.BinaryExpr:
+		if op, ok := whereArithmetic[node.Operator]; ok {
+			return binaryOp(op(), node.Left, node.Right)
+		}
+	case *sqlparser.ColName:
+		if !node.Qualifier.IsEmpty() {
+			return nil, fmt.Errorf("unsupported qualifier for column: %v", sqlparser.String(node))
+		}
+		colnum, err := findColumn(plan.Table, node.Name)
+		if err != nil {
+			return nil, err
+		}
+		return evalengine.NewColumn(colnum), nil
+	case *sqlparser.Literal, sqlparser.BoolVal:
+		return sqlparser.Convert(node)
+	}
+	return nil, fmt.Errorf("unsupported constraint: %v", sqlparser.String(expr))
+}
+
 // splitAndExpression breaks up the Expr into AND-separated conditions
 // and appends them to filters, which can be shuffled and recombined
 // as needed.
@@ -21,9 +21,12 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+
 	"vitess.io/vitess/go/json2"
 	"vitess.io/vitess/go/mysql"
 	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vtgate/evalengine"
 	"vitess.io/vitess/go/vt/vtgate/vindexes"
 
 	binlogdatapb "vitess.io/vitess/go/vt/proto/binlogdata"
@@ -547,6 +550,35 @@ func TestPlanbuilder(t *testing.T) {
 		inTable: t1,
 		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where in_keyrange(id, 1+1, '-80')"},
 		outErr:  `unsupported: 1 + 1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id in (1, :a)"},
+		outErr:  `unsupported constraint: :a`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where t1.id > 1"},
+		outErr:  `unsupported qualifier for column: t1.id`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id, val from t1 where id > 1 or notcol = 2"},
+		outErr:  `column notcol not found in table t1`,
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "t1", Filter: "select id from t1", DmlTypes: []binlogdatapb.VEventType{binlogdatapb.VEventType_INSERT, binlogdatapb.VEventType_DELETE}},
+		outPlan: &Plan{
+			ColExprs: []ColExpr{{
+				ColNum: 0,
+				Field: &querypb.Field{
+					Name: "id",
+					Type: sqltypes.Int64,
+				},
+			}},
+			DMLTypes: []binlogdatapb.VEventType{binlogdatapb.VEventType_INSERT, binlogdatapb.VEventType_DELETE},
+		},
+	}, {
+		inTable: t1,
+		inRule:  &binlogdatapb.Rule{Match: "/.*/", DmlTypes: []binlogdatapb.VEventType{binlogdatapb.VEventType_DDL}},
+		outErr:  `unsupported dml type: DDL`,
 	}}
 	for _, tcase := range testcases {
 		plan, err := buildPlan(tcase.inTable, testLocalVSchema, &binlogdatapb.Filter{
@@ -577,3 +609,95 @@ func TestPlanbuilder(t *testing.T) {
 		}
 	}
 }
+
+func TestPlanSendsDML(t *testing.T) {
+	plan := &Plan{}
+	assert.True(t, plan.sendsDML(binlogdatapb.VEventType_INSERT))
+	assert.True(t, plan.sendsDML(binlogdatapb.VEventType_DELETE))
+
+	plan.DMLTypes = []binlogdatapb.VEventType{binlogdatapb.VEventType_UPDATE}
+	assert.False(t, plan.sendsDML(binlogdatapb.VEventType_INSERT))
+	assert.True(t, plan.sendsDML(binlogdatapb.VEventType_UPDATE))
+	assert.False(t, plan.sendsDML(binlogdatapb.VEventType_DELETE))
+}
+
+func TestPlanFilterExpressions(t *testing.T) {
+	t1 := &Table{
+		Name: "t1",
+		Fields: []*querypb.Field{{
+			Name: "id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "tenant_id",
+			Type: sqltypes.Int64,
+		}, {
+			Name: "val",
+			Type: sqltypes.VarBinary,
+		}},
+	}
+	row := func(id, tenantID int64, val string) []sqltypes.Value {
+		v := sqltypes.NULL
+		if val != "" {
+			v = sqltypes.NewVarBinary(val)
+		}
+		return []sqltypes.Value{sqltypes.NewInt64(id), sqltypes.NewInt64(tenantID), v}
+	}
+	rows := [][]sqltypes.Value{
+		row(1, 10, "a"),
+		row(2, 20, "b"),
+		row(3, 30, ""),
+		row(4, 10, "d"),
+	}
+	testcases := []struct {
+		where string
+		ids   []int64
+	}{{
+		where: "tenant_id in (10, 30)",
+		ids:   []int64{1, 3, 4},
+	}, {
+		where: "tenant_id not in (10, 30)",
+		ids:   []int64{2},
+	}, {
+		where: "tenant_id = 10 and id > 1",
+		ids:   []int64{4},
+	}, {
+		where: "id < 2 or tenant_id >= 30",
+		ids:   []int64{1, 3},
+	}, {
+		where: "val is null",
+		ids:   []int64{3},
+	}, {
+		where: "not (val is null or id = 4)",
+		ids:   []int64{1, 2},
+	}, {
+		where: "tenant_id != id * 10",
+		ids:   []int64{4},
+	}, {
+		where: "val = 'b'",
+		ids:   []int64{2},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.where, func(t *testing.T) {
+			plan, err := buildPlan(t1, testLocalVSchema, &binlogdatapb.Filter{
+				Rules: []*binlogdatapb.Rule{{Match: "t1", Filter: "select id, val from t1 where " + tcase.where}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []int64
+			for _, r := range rows {
+				ok, values, err := plan.filter(r)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if ok {
+					id, _ := evalengine.ToInt64(values[0])
+					ids = append(ids, id)
+				}
+			}
+			if !reflect.DeepEqual(ids, tcase.ids) {
+				t.Errorf("filter(%s): %v, want %v", tcase.where, ids, tcase.ids)
+			}
+		})
+	}
+}
@@ -108,8 +108,10 @@ type streamerPlan struct {
 //   "select * from t where in_keyrange('-80')", same as "-80",
 //   "select * from t where in_keyrange(col1, 'hash', '-80')",
 //   "select col1, col2 from t where...",
-//   "select col1, keyspace_id() from t where...".
-//   Only "in_keyrange" expressions are supported in the where clause.
+//   "select col1, keyspace_id() from t where...",
+//   "select * from t where tenant_id in (1, 2) and deleted_at is null".
+//   Besides "in_keyrange", the where clause can contain comparisons, "in", "is null",
+//   "and", "or", "not" and arithmetic on the columns of the table and on literals.
 //   Other constructs like joins, group by, etc. are not supported.
 // vschema: the current vschema. This value can later be changed through the SetVSchema method.
 // send: callback function to send events.
@@ -545,7 +547,7 @@ func (vs *vstreamer) parseEvent(ev mysql.BinlogEvent) ([]*binlogdatapb.VEvent, e
 		// and insert on the other.
 		id := ev.TableID(vs.format)
 		plan := vs.plans[id]
-		if plan == nil {
+		if plan == nil || !plan.sendsDML(dmlType(ev)) {
 			return nil, nil
 		}
 		rows, err := ev.Rows(vs.format, plan.TableMap)
@@ -801,6 +803,17 @@ nextrow:
 	return vevents, nil
 }
 
+// dmlType returns the statement type of a rows event.
+func dmlType(ev mysql.BinlogEvent) binlogdatapb.VEventType {
+	switch {
+	case ev.IsUpdateRows():
+		return binlogdatapb.VEventType_UPDATE
+	case ev.IsDeleteRows():
+		return binlogdatapb.VEventType_DELETE
+	}
+	return binlogdatapb.VEventType_INSERT
+}
+
 func (vs *vstreamer) processRowEvent(vevents []*binlogdatapb.VEvent, plan *streamerPlan, rows mysql.Rows) ([]*binlogdatapb.VEvent, error) {
 	rowChanges := make([]*binlogdatapb.RowChange, 0, len(rows.Rows))
 	for _, row := range rows.Rows {
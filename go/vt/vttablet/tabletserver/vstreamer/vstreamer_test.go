@@ -609,6 +609,48 @@ func TestFilteredVarBinary(t *testing.T) {
 	runCases(t, filter, testcases, "", nil)
 }
 
+func TestFilteredDMLTypes(t *testing.T) {
+	if testing.Short() {
+		t.Skip()
+	}
+
+	execStatements(t, []string{
+		"create table t1(id1 int, val varbinary(128), primary key(id1))",
+	})
+	defer execStatements(t, []string{
+		"drop table t1",
+	})
+	engine.se.Reload(context.Background())
+
+	filter := &binlogdatapb.Filter{
+		Rules: []*binlogdatapb.Rule{{
+			Match:    "t1",
+			Filter:   "select id1 from t1",
+			DmlTypes: []binlogdatapb.VEventType{binlogdatapb.VEventType_UPDATE, binlogdatapb.VEventType_DELETE},
+		}},
+	}
+
+	testcases := []testcase{{
+		input: []string{
+			"begin",
+			"insert into t1 values (1, 'kepler')",
+			"insert into t1 values (2, 'newton')",
+			"update t1 set val = 'newton' where id1 = 1",
+			"delete from t1 where id1 = 2",
+			"commit",
+		},
+		output: [][]string{{
+			`begin`,
+			`type:FIELD field_event:<table_name:"t1" fields:<name:"id1" type:INT32 table:"t1" org_table:"t1" database:"vttest" org_name:"id1" column_length:11 charset:63 > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<before:<lengths:1 values:"1" > after:<lengths:1 values:"1" > > > `,
+			`type:ROW row_event:<table_name:"t1" row_changes:<before:<lengths:1 values:"2" > > > `,
+			`gtid`,
+			`commit`,
+		}},
+	}}
+	runCases(t, filter, testcases, "", nil)
+}
+
 func TestFilteredInt(t *testing.T) {
 	if testing.Short() {
 		t.Skip()
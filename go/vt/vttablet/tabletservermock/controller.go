@@ -29,9 +29,11 @@ import (
 	"vitess.io/vitess/go/vt/servenv"
 	"vitess.io/vitess/go/vt/topo"
 	"vitess.io/vitess/go/vt/vttablet/queryservice"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/schema"
 	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tx"
 	"vitess.io/vitess/go/vt/vttablet/vexec"
 
 	querypb "vitess.io/vitess/go/vt/proto/query"
@@ -93,6 +95,9 @@ type Controller struct {
 
 	// queryRulesMap has the latest query rules.
 	queryRulesMap map[string]*rules.Rules
+
+	// queryRulesSigners has the latest signers of the query rules.
+	queryRulesSigners map[string]string
 }
 
 // NewController returns a mock of tabletserver.Controller
@@ -103,6 +108,7 @@ func NewController() *Controller {
 		BroadcastData:       make(chan *BroadcastData, 10),
 		StateChanges:        make(chan *StateChange, 10),
 		queryRulesMap:       make(map[string]*rules.Rules),
+		queryRulesSigners:   make(map[string]string),
 	}
 }
 
@@ -200,6 +206,14 @@ func (tqsc *Controller) SetQueryRules(ruleSource string, qrs *rules.Rules) error
 	return nil
 }
 
+// SetQueryRulesSigner is part of the tabletserver.Controller interface
+func (tqsc *Controller) SetQueryRulesSigner(ruleSource, signer string) error {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+	tqsc.queryRulesSigners[ruleSource] = signer
+	return nil
+}
+
 // QueryService is part of the tabletserver.Controller interface
 func (tqsc *Controller) QueryService() queryservice.QueryService {
 	return nil
@@ -225,6 +239,26 @@ func (tqsc *Controller) TopoServer() *topo.Server {
 	return tqsc.TS
 }
 
+// Transactions is part of the tabletserver.Controller interface.
+func (tqsc *Controller) Transactions() []tx.Info {
+	return nil
+}
+
+// KillTransaction is part of the tabletserver.Controller interface.
+func (tqsc *Controller) KillTransaction(transactionID int64) error {
+	return nil
+}
+
+// LiveQueries is part of the tabletserver.Controller interface.
+func (tqsc *Controller) LiveQueries() []tabletserver.QueryDetailzRow {
+	return nil
+}
+
+// KillQuery is part of the tabletserver.Controller interface.
+func (tqsc *Controller) KillQuery(connID int64) bool {
+	return false
+}
+
 // EnterLameduck implements tabletserver.Controller.
 func (tqsc *Controller) EnterLameduck() {
 	tqsc.mu.Lock()
@@ -247,3 +281,10 @@ func (tqsc *Controller) GetQueryRules(ruleSource string) *rules.Rules {
 	defer tqsc.mu.Unlock()
 	return tqsc.queryRulesMap[ruleSource]
 }
+
+// GetQueryRulesSigner allows a test to check what signer was set.
+func (tqsc *Controller) GetQueryRulesSigner(ruleSource string) string {
+	tqsc.mu.Lock()
+	defer tqsc.mu.Unlock()
+	return tqsc.queryRulesSigners[ruleSource]
+}
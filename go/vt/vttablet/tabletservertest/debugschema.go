@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package framework
+package tabletservertest
 
 import (
 	"encoding/json"
@@ -41,17 +41,13 @@ type TableColumn struct {
 
 // DebugSchema parses /debug/schema and returns
 // a map of the tables keyed by the table name.
-func DebugSchema() map[string]Table {
+func (h *Harness) DebugSchema() map[string]Table {
 	out := make(map[string]Table)
-	var list []Table
-	response, err := http.Get(fmt.Sprintf("%s/debug/schema", ServerAddress))
+	response, err := http.Get(fmt.Sprintf("%s/debug/schema", h.ServerAddress))
 	if err != nil {
 		return out
 	}
 	defer response.Body.Close()
-	_ = json.NewDecoder(response.Body).Decode(&list)
-	for _, table := range list {
-		out[table.Name] = table
-	}
+	_ = json.NewDecoder(response.Body).Decode(&out)
 	return out
 }
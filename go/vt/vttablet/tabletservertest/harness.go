@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tabletservertest runs a TabletServer for integration tests,
+// against a test mysqld or a fakesqldb, and provides a QueryClient and
+// helpers to read the stats and the debug pages of the server.
+//
+// It lets the authors of plugins, like custom query rules or ACLs,
+// exercise them through the real query service. A test binary can only
+// start one Harness, because TabletServer registers its stats and http
+// handlers globally.
+package tabletservertest
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"vitess.io/vitess/go/mysql"
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/dbconfigs"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+// Harness is a TabletServer started for a test.
+type Harness struct {
+	// Target is the target info for the server.
+	Target querypb.Target
+	// Server is the TabletServer of the harness.
+	Server *tabletserver.TabletServer
+	// ServerAddress is the http URL for the server.
+	ServerAddress string
+	// TopoServer is the topology for the server.
+	TopoServer *topo.Server
+}
+
+// Start starts a TabletServer for the vttest/0 master, connected to the
+// dbName database of a test mysqld, and serves its debug pages. Stop must
+// be called once all the tests are done.
+func Start(connParams, connAppDebugParams mysql.ConnParams, dbName string, config *tabletenv.TabletConfig) (*Harness, error) {
+	return start(dbconfigs.NewTestDBConfigs(connParams, connAppDebugParams, dbName), config)
+}
+
+// StartWithFakeDB is like Start, but the server is connected to db. The
+// queries the server runs to load the schema of tables are added to db.
+// The other queries of the tests must be added to db by the caller.
+func StartWithFakeDB(db *fakesqldb.DB, config *tabletenv.TabletConfig, tables ...*FakeTable) (*Harness, error) {
+	AddFakeSchema(db, tables...)
+	params, err := db.ConnParams().MysqlParams()
+	if err != nil {
+		return nil, err
+	}
+	return start(dbconfigs.NewTestDBConfigs(*params, *params, ""), config)
+}
+
+func start(dbcfgs *dbconfigs.DBConfigs, config *tabletenv.TabletConfig) (*Harness, error) {
+	h := &Harness{
+		Target: querypb.Target{
+			Keyspace:   "vttest",
+			Shard:      "0",
+			TabletType: topodatapb.TabletType_MASTER,
+		},
+		TopoServer: memorytopo.NewServer(""),
+	}
+	h.Server = tabletserver.NewTabletServer("", config, h.TopoServer, topodatapb.TabletAlias{})
+	h.Server.Register()
+	if err := h.Server.StartService(h.Target, dbcfgs, nil /* mysqld */); err != nil {
+		return nil, vterrors.Wrap(err, "could not start service")
+	}
+
+	// Start http service.
+	ln, err := net.Listen("tcp", ":0")
+	if err != nil {
+		h.Server.StopService()
+		return nil, vterrors.Wrap(err, "could not start listener")
+	}
+	h.ServerAddress = fmt.Sprintf("http://%s", ln.Addr().String())
+	go http.Serve(ln, nil)
+	for {
+		time.Sleep(10 * time.Millisecond)
+		response, err := http.Get(fmt.Sprintf("%s/debug/vars", h.ServerAddress))
+		if err == nil {
+			response.Body.Close()
+			break
+		}
+	}
+	return h, nil
+}
+
+// Stop stops the server.
+func (h *Harness) Stop() {
+	h.Server.StopService()
+}
+
+// FakeTable describes a table of a fakesqldb.
+type FakeTable struct {
+	Name   string
+	Fields []*querypb.Field
+	// PKColumns are the names of the primary key columns.
+	PKColumns []string
+}
+
+// AddFakeSchema adds to db the queries TabletServer runs to load its
+// schema, for the given tables.
+func AddFakeSchema(db *fakesqldb.DB, tables ...*FakeTable) {
+	showTables := &sqltypes.Result{Fields: mysql.BaseShowTablesFields}
+	showPrimary := &sqltypes.Result{Fields: mysql.ShowPrimaryFields}
+	for _, table := range tables {
+		showTables.Rows = append(showTables.Rows, mysql.BaseShowTablesRow(table.Name, false, ""))
+		for _, col := range table.PKColumns {
+			showPrimary.Rows = append(showPrimary.Rows, mysql.ShowPrimaryRow(table.Name, col))
+		}
+		db.AddQuery(fmt.Sprintf("select * from %s where 1 != 1", table.Name), &sqltypes.Result{Fields: table.Fields})
+	}
+	db.AddQueryPattern(`SELECT t\.table_name.*`, showTables)
+	db.AddQuery(mysql.BaseShowPrimary, showPrimary)
+	db.AddQuery("select unix_timestamp()", sqltypes.MakeTestResult(sqltypes.MakeTestFields("unix_timestamp()", "int64"), "1427325875"))
+	db.AddQuery("select @@global.sql_mode", sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@global.sql_mode", "varchar"), "STRICT_TRANS_TABLES"))
+	db.AddQuery("select @@autocommit", sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@autocommit", "int64"), "1"))
+	db.AddQuery("select @@sql_auto_is_null", sqltypes.MakeTestResult(sqltypes.MakeTestFields("@@sql_auto_is_null", "int64"), "0"))
+	db.AddQuery("show status like 'Innodb_rows_read'", sqltypes.MakeTestResult(sqltypes.MakeTestFields("Variable_name|Value", "varchar|int64"), "Innodb_rows_read|0"))
+}
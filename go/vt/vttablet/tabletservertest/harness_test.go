@@ -0,0 +1,60 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tabletservertest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/mysql/fakesqldb"
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/tabletenv"
+)
+
+func TestStartWithFakeDB(t *testing.T) {
+	db := fakesqldb.New(t)
+	defer db.Close()
+	fields := sqltypes.MakeTestFields("id|name", "int64|varchar")
+	db.AddQuery("select id, `name` from t1 where 1 != 1", &sqltypes.Result{Fields: fields})
+	db.AddQuery("select id, `name` from t1 where id = 1 limit 10001", sqltypes.MakeTestResult(fields, "1|a"))
+
+	h, err := StartWithFakeDB(db, tabletenv.NewDefaultConfig(), &FakeTable{
+		Name:      "t1",
+		Fields:    fields,
+		PKColumns: []string{"id"},
+	})
+	require.NoError(t, err)
+	defer h.Stop()
+
+	assert.Contains(t, h.DebugSchema(), "t1")
+
+	client := h.NewClient()
+	qr, err := client.Execute("select id, name from t1 where id = 1", nil)
+	require.NoError(t, err)
+	assert.Equal(t, [][]string{{"1", "a"}}, RowsToStrings(qr))
+
+	err = (&TestCase{
+		Query:  "select id, name from t1 where id = 1",
+		Result: [][]string{{"1", "a"}},
+	}).Test("", client)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, FetchInt(h.DebugVars(), "QueryCounts/t1.Select"))
+	assert.Equal(t, 2, h.QueryStats()["select id, name from t1 where id = 1"].QueryCount)
+}
@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package framework
+package tabletservertest
 
 import (
 	"encoding/json"
@@ -35,11 +35,11 @@ type LiveQuery struct {
 	ShowTerminateLink bool
 }
 
-// OLAPQueryz returns the contents of /livequeryz?format=json.
+// LiveQueryz returns the contents of /livequeryz?format=json
 // as a []LiveQuery. The function returns an empty list on error.
-func LiveQueryz() []LiveQuery {
+func (h *Harness) LiveQueryz() []LiveQuery {
 	var out []LiveQuery
-	response, err := http.Get(fmt.Sprintf("%s/livequeryz?format=json", ServerAddress))
+	response, err := http.Get(fmt.Sprintf("%s/livequeryz?format=json", h.ServerAddress))
 	if err != nil {
 		return out
 	}
@@ -49,8 +49,8 @@ func LiveQueryz() []LiveQuery {
 }
 
 // StreamTerminate terminates the specified streaming query.
-func StreamTerminate(connID int) error {
-	response, err := http.Get(fmt.Sprintf("%s/livequeryz/terminate?format=json&connID=%d", ServerAddress, connID))
+func (h *Harness) StreamTerminate(connID int) error {
+	response, err := http.Get(fmt.Sprintf("%s/livequeryz/terminate?format=json&connID=%d", h.ServerAddress, connID))
 	if err != nil {
 		return err
 	}
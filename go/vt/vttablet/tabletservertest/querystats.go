@@ -14,7 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-package framework
+package tabletservertest
 
 import (
 	"encoding/json"
@@ -30,10 +30,10 @@ type QueryStat struct {
 
 // QueryStats parses /debug/query_stats and returns
 // a map of the query stats keyed by the query.
-func QueryStats() map[string]QueryStat {
+func (h *Harness) QueryStats() map[string]QueryStat {
 	out := make(map[string]QueryStat)
 	var list []QueryStat
-	response, err := http.Get(fmt.Sprintf("%s/debug/query_stats", ServerAddress))
+	response, err := http.Get(fmt.Sprintf("%s/debug/query_stats", h.ServerAddress))
 	if err != nil {
 		return out
 	}
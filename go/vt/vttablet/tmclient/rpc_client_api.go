@@ -141,6 +141,18 @@ type TabletManagerClient interface {
 	// VExec executes a generic VExec command
 	VExec(ctx context.Context, tablet *topodatapb.Tablet, query, workflow, keyspace string) (*querypb.QueryResult, error)
 
+	// ShowTransactions returns the transactions open on the tablet
+	ShowTransactions(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.TransactionInfo, error)
+
+	// KillTransaction terminates a transaction open on the tablet
+	KillTransaction(ctx context.Context, tablet *topodatapb.Tablet, transactionID int64) error
+
+	// LiveQueries returns the queries executing on the tablet
+	LiveQueries(ctx context.Context, tablet *topodatapb.Tablet) ([]*tabletmanagerdatapb.LiveQuery, error)
+
+	// KillQuery kills the query executing on a MySQL connection of the tablet
+	KillQuery(ctx context.Context, tablet *topodatapb.Tablet, connID int64) error
+
 	// VReplicationExec executes a VReplication command
 	VReplicationExec(ctx context.Context, tablet *topodatapb.Tablet, query string) (*querypb.QueryResult, error)
 	VReplicationWaitForPos(ctx context.Context, tablet *topodatapb.Tablet, id int, pos string) error
@@ -201,8 +213,9 @@ type TabletManagerClient interface {
 	// Backup creates a database backup
 	Backup(ctx context.Context, tablet *topodatapb.Tablet, concurrency int, allowMaster bool) (logutil.EventStream, error)
 
-	// RestoreFromBackup deletes local data and restores database from backup
-	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet) (logutil.EventStream, error)
+	// RestoreFromBackup deletes local data and restores database from backup.
+	// The request may ask for a point-in-time restore.
+	RestoreFromBackup(ctx context.Context, tablet *topodatapb.Tablet, req *tabletmanagerdatapb.RestoreFromBackupRequest) (logutil.EventStream, error)
 
 	//
 	// Management methods
@@ -855,6 +855,103 @@ func (fra *fakeRPCTM) VExec(ctx context.Context, query, workflow, keyspace strin
 	return testExecuteFetchResult, nil
 }
 
+var testShowTransactionsReply = []*tabletmanagerdatapb.TransactionInfo{{
+	TransactionId:   1234,
+	StartTime:       5678,
+	Queries:         []string{"insert into t1 values(1)"},
+	EffectiveCaller: "effective",
+	ImmediateCaller: "immediate",
+}}
+
+func (fra *fakeRPCTM) ShowTransactions(ctx context.Context) ([]*tabletmanagerdatapb.TransactionInfo, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testShowTransactionsReply, nil
+}
+
+func tmRPCTestShowTransactions(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	txs, err := client.ShowTransactions(ctx, tablet)
+	compareError(t, "ShowTransactions", err, txs, testShowTransactionsReply)
+}
+
+func tmRPCTestShowTransactionsPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.ShowTransactions(ctx, tablet)
+	expectHandleRPCPanic(t, "ShowTransactions", false /*verbose*/, err)
+}
+
+var testKillTransactionID int64 = 1234
+
+func (fra *fakeRPCTM) KillTransaction(ctx context.Context, transactionID int64) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "KillTransaction transactionID", transactionID, testKillTransactionID)
+	return nil
+}
+
+func tmRPCTestKillTransaction(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.KillTransaction(ctx, tablet, testKillTransactionID)
+	if err != nil {
+		t.Errorf("KillTransaction failed: %v", err)
+	}
+}
+
+func tmRPCTestKillTransactionPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.KillTransaction(ctx, tablet, testKillTransactionID)
+	expectHandleRPCPanic(t, "KillTransaction", true /*verbose*/, err)
+}
+
+var testLiveQueriesReply = []*tabletmanagerdatapb.LiveQuery{{
+	Type:            "oltp-stateful",
+	Query:           "update t1 set a = 1",
+	StartTime:       5678,
+	Duration:        1000,
+	ConnectionId:    7,
+	TransactionId:   1234,
+	ImmediateCaller: "immediate",
+	EffectiveCaller: "effective",
+}}
+
+func (fra *fakeRPCTM) LiveQueries(ctx context.Context) ([]*tabletmanagerdatapb.LiveQuery, error) {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	return testLiveQueriesReply, nil
+}
+
+func tmRPCTestLiveQueries(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	queries, err := client.LiveQueries(ctx, tablet)
+	compareError(t, "LiveQueries", err, queries, testLiveQueriesReply)
+}
+
+func tmRPCTestLiveQueriesPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	_, err := client.LiveQueries(ctx, tablet)
+	expectHandleRPCPanic(t, "LiveQueries", false /*verbose*/, err)
+}
+
+var testKillQueryConnID int64 = 7
+
+func (fra *fakeRPCTM) KillQuery(ctx context.Context, connID int64) error {
+	if fra.panics {
+		panic(fmt.Errorf("test-triggered panic"))
+	}
+	compare(fra.t, "KillQuery connID", connID, testKillQueryConnID)
+	return nil
+}
+
+func tmRPCTestKillQuery(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.KillQuery(ctx, tablet, testKillQueryConnID)
+	if err != nil {
+		t.Errorf("KillQuery failed: %v", err)
+	}
+}
+
+func tmRPCTestKillQueryPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
+	err := client.KillQuery(ctx, tablet, testKillQueryConnID)
+	expectHandleRPCPanic(t, "KillQuery", true /*verbose*/, err)
+}
+
 var testVRQuery = "query"
 
 func (fra *fakeRPCTM) VReplicationExec(ctx context.Context, query string) (*querypb.QueryResult, error) {
@@ -1153,6 +1250,13 @@ var testBackupConcurrency = 24
 var testBackupAllowMaster = false
 var testBackupCalled = false
 var testRestoreFromBackupCalled = false
+var testRestoreFromBackupRequest = &tabletmanagerdatapb.RestoreFromBackupRequest{
+	RestoreToPos: "MySQL56/8bc65c84-3fe4-11ed-a912-257f0fcdd6c9:1-1000",
+	BinlogSourceTablet: &topodatapb.TabletAlias{
+		Cell: "cell1",
+		Uid:  100,
+	},
+}
 
 func (fra *fakeRPCTM) Backup(ctx context.Context, concurrency int, logger logutil.Logger, allowMaster bool) error {
 	if fra.panics {
@@ -1186,17 +1290,18 @@ func tmRPCTestBackupPanic(ctx context.Context, t *testing.T, client tmclient.Tab
 	expectHandleRPCPanic(t, "Backup", true /*verbose*/, err)
 }
 
-func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger) error {
+func (fra *fakeRPCTM) RestoreFromBackup(ctx context.Context, logger logutil.Logger, request *tabletmanagerdatapb.RestoreFromBackupRequest) error {
 	if fra.panics {
 		panic(fmt.Errorf("test-triggered panic"))
 	}
+	compare(fra.t, "RestoreFromBackup request", request, testRestoreFromBackupRequest)
 	logStuff(logger, 10)
 	testRestoreFromBackupCalled = true
 	return nil
 }
 
 func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupRequest)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1205,7 +1310,7 @@ func tmRPCTestRestoreFromBackup(ctx context.Context, t *testing.T, client tmclie
 }
 
 func tmRPCTestRestoreFromBackupPanic(ctx context.Context, t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.Tablet) {
-	stream, err := client.RestoreFromBackup(ctx, tablet)
+	stream, err := client.RestoreFromBackup(ctx, tablet, testRestoreFromBackupRequest)
 	if err != nil {
 		t.Fatalf("RestoreFromBackup failed: %v", err)
 	}
@@ -1270,6 +1375,14 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestStartReplicationUntilAfter(ctx, t, client, tablet)
 	tmRPCTestGetReplicas(ctx, t, client, tablet)
 
+	// Transaction introspection methods
+	tmRPCTestShowTransactions(ctx, t, client, tablet)
+	tmRPCTestKillTransaction(ctx, t, client, tablet)
+
+	// Live query methods
+	tmRPCTestLiveQueries(ctx, t, client, tablet)
+	tmRPCTestKillQuery(ctx, t, client, tablet)
+
 	// VReplication methods
 	tmRPCTestVReplicationExec(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPos(ctx, t, client, tablet)
@@ -1322,6 +1435,14 @@ func Run(t *testing.T, client tmclient.TabletManagerClient, tablet *topodatapb.T
 	tmRPCTestStopReplicationMinimumPanic(ctx, t, client, tablet)
 	tmRPCTestStartReplicationPanic(ctx, t, client, tablet)
 	tmRPCTestGetReplicasPanic(ctx, t, client, tablet)
+	// Transaction introspection methods
+	tmRPCTestShowTransactionsPanic(ctx, t, client, tablet)
+	tmRPCTestKillTransactionPanic(ctx, t, client, tablet)
+
+	// Live query methods
+	tmRPCTestLiveQueriesPanic(ctx, t, client, tablet)
+	tmRPCTestKillQueryPanic(ctx, t, client, tablet)
+
 	// VReplication methods
 	tmRPCTestVReplicationExecPanic(ctx, t, client, tablet)
 	tmRPCTestVReplicationWaitForPosPanic(ctx, t, client, tablet)
@@ -64,6 +64,22 @@ func (c *CheckpointWriter) UpdateTask(taskID string, status workflowpb.TaskState
 	return c.saveLocked()
 }
 
+// UpdateTaskAttributes sets the given attributes of the task in the
+// checkpointing copy and saves the full checkpoint to the topology server.
+func (c *CheckpointWriter) UpdateTaskAttributes(taskID string, attributes map[string]string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	t := c.checkpoint.Tasks[taskID]
+	if t.Attributes == nil {
+		t.Attributes = make(map[string]string)
+	}
+	for k, v := range attributes {
+		t.Attributes[k] = v
+	}
+	return c.saveLocked()
+}
+
 func (c *CheckpointWriter) saveLocked() error {
 	var err error
 	c.wi.Data, err = proto.Marshal(c.checkpoint)
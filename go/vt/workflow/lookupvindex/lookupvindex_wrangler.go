@@ -0,0 +1,33 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookupvindex
+
+import (
+	"context"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+)
+
+// Wrangler is the subset of the methods of go/vt/wrangler used by the
+// lookup vindex workflow. It can be replaced by a fake in unit tests.
+type Wrangler interface {
+	CreateLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string) error
+
+	LookupVindexBackfillPending(ctx context.Context, qualifiedVindexName string) ([]string, error)
+
+	ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lookupvindex contains a workflow that creates a lookup vindex,
+// backfills its lookup table from the existing rows using vreplication,
+// and activates the vindex once the backfill has caught up.
+package lookupvindex
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+	"time"
+
+	"context"
+
+	"github.com/golang/protobuf/proto"
+
+	"vitess.io/vitess/go/json2"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tmclient"
+	"vitess.io/vitess/go/vt/workflow"
+	"vitess.io/vitess/go/vt/wrangler"
+
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+const (
+	codeVersion             = 1
+	lookupVindexFactoryName = "lookup_vindex"
+	taskCreate              = "create"
+	taskBackfill            = "backfill"
+	taskExternalize         = "externalize"
+)
+
+// tasks lists the tasks of the workflow in the order they run.
+var tasks = []string{taskCreate, taskBackfill, taskExternalize}
+
+// Register registers the lookup vindex Factory in the workflow framework.
+func Register() {
+	workflow.Register(lookupVindexFactoryName, &Factory{})
+}
+
+// Factory is the factory to create a lookup vindex workflow.
+type Factory struct{}
+
+// Init is part of the workflow.Factory interface.
+func (*Factory) Init(m *workflow.Manager, w *workflowpb.Workflow, args []string) error {
+	subFlags := flag.NewFlagSet(lookupVindexFactoryName, flag.ContinueOnError)
+	keyspace := subFlags.String("keyspace", "", "Name of the keyspace the lookup vindex is created in")
+	spec := subFlags.String("spec", "", "JSON vschema spec of the lookup vindex, as accepted by CreateLookupVindex")
+	cells := subFlags.String("cells", "", "Source cells to replicate from")
+	tabletTypes := subFlags.String("tablet_types", "", "Source tablet types to replicate from")
+	pollInterval := subFlags.Duration("poll_interval", 10*time.Second, "How often the progress of the backfill is checked")
+	if err := subFlags.Parse(args); err != nil {
+		return err
+	}
+	if *keyspace == "" || *spec == "" {
+		return fmt.Errorf("keyspace and spec must be provided for the lookup vindex workflow")
+	}
+	if *pollInterval <= 0 {
+		return fmt.Errorf("poll_interval must be positive: %v", *pollInterval)
+	}
+	specs := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(*spec), specs); err != nil {
+		return err
+	}
+	if len(specs.Vindexes) != 1 {
+		return fmt.Errorf("only one vindex must be specified in the specs: %v", specs.Vindexes)
+	}
+	var vindexName string
+	for name := range specs.Vindexes {
+		vindexName = name
+	}
+	if _, err := m.TopoServer().GetKeyspace(context.TODO(), *keyspace); err != nil {
+		return err
+	}
+
+	w.Name = fmt.Sprintf("Create and backfill lookup vindex %v.%v", *keyspace, vindexName)
+	checkpoint := &workflowpb.WorkflowCheckpoint{
+		CodeVersion: codeVersion,
+		Tasks:       make(map[string]*workflowpb.Task),
+		Settings: map[string]string{
+			"keyspace":      *keyspace,
+			"vindex":        vindexName,
+			"spec":          *spec,
+			"cells":         *cells,
+			"tablet_types":  *tabletTypes,
+			"poll_interval": pollInterval.String(),
+		},
+	}
+	for _, taskID := range tasks {
+		checkpoint.Tasks[taskID] = &workflowpb.Task{
+			Id:         taskID,
+			State:      workflowpb.TaskState_TaskNotStarted,
+			Attributes: map[string]string{},
+		}
+	}
+	var err error
+	w.Data, err = proto.Marshal(checkpoint)
+	return err
+}
+
+// Instantiate is part the workflow.Factory interface.
+func (*Factory) Instantiate(m *workflow.Manager, w *workflowpb.Workflow, rootNode *workflow.Node) (workflow.Workflow, error) {
+	rootNode.Message = "This is a workflow to create a lookup vindex and backfill it automatically."
+
+	checkpoint := &workflowpb.WorkflowCheckpoint{}
+	if err := proto.Unmarshal(w.Data, checkpoint); err != nil {
+		return nil, err
+	}
+	pollInterval, err := time.ParseDuration(checkpoint.Settings["poll_interval"])
+	if err != nil {
+		return nil, err
+	}
+
+	lw := &lookupVindexWorkflow{
+		checkpoint:   checkpoint,
+		rootUINode:   rootNode,
+		logger:       logutil.NewMemoryLogger(),
+		wr:           wrangler.New(logutil.NewConsoleLogger(), m.TopoServer(), tmclient.NewTabletManagerClient()),
+		topoServer:   m.TopoServer(),
+		pollInterval: pollInterval,
+		taskUINodes:  make(map[string]*workflow.Node),
+	}
+	for _, taskID := range tasks {
+		node := &workflow.Node{
+			Name:     taskID,
+			PathName: taskID,
+		}
+		lw.taskUINodes[taskID] = node
+		lw.rootUINode.Children = append(lw.rootUINode.Children, node)
+	}
+	return lw, nil
+}
+
+// lookupVindexWorkflow contains meta-information and methods to
+// control the lookup vindex workflow.
+type lookupVindexWorkflow struct {
+	ctx        context.Context
+	wr         Wrangler
+	topoServer *topo.Server
+	wi         *topo.WorkflowInfo
+	// logger is the logger we export UI logs from.
+	logger *logutil.MemoryLogger
+
+	// rootUINode is the root node representing the workflow in the UI.
+	rootUINode  *workflow.Node
+	taskUINodes map[string]*workflow.Node
+
+	checkpoint       *workflowpb.WorkflowCheckpoint
+	checkpointWriter *workflow.CheckpointWriter
+
+	// pollInterval is how often the backfill progress is checked.
+	pollInterval time.Duration
+}
+
+// Run executes the lookup vindex workflow.
+// It implements the workflow.Workflow interface.
+func (lw *lookupVindexWorkflow) Run(ctx context.Context, manager *workflow.Manager, wi *topo.WorkflowInfo) error {
+	lw.ctx = ctx
+	lw.wi = wi
+	lw.checkpointWriter = workflow.NewCheckpointWriter(lw.topoServer, lw.checkpoint, lw.wi)
+	lw.rootUINode.Display = workflow.NodeDisplayDeterminate
+	lw.rootUINode.BroadcastChanges(true /* updateChildren */)
+
+	runners := map[string]func() error{
+		taskCreate:      lw.runCreate,
+		taskBackfill:    lw.runBackfill,
+		taskExternalize: lw.runExternalize,
+	}
+	for i, taskID := range tasks {
+		// Tasks that are already done were run before the workflow was restarted.
+		if lw.checkpoint.Tasks[taskID].State != workflowpb.TaskState_TaskDone {
+			if err := lw.runTask(taskID, runners[taskID]); err != nil {
+				lw.setUIMessage(fmt.Sprintf("Task %v failed: %v", taskID, err))
+				return err
+			}
+		}
+		lw.rootUINode.Progress = 100 * (i + 1) / len(tasks)
+		lw.rootUINode.BroadcastChanges(false /* updateChildren */)
+	}
+	lw.setUIMessage(fmt.Sprintf("Lookup vindex %v is backfilled and active.", lw.qualifiedVindexName()))
+	return nil
+}
+
+// runTask runs a task and checkpoints its state before and after it runs.
+func (lw *lookupVindexWorkflow) runTask(taskID string, run func() error) error {
+	node := lw.taskUINodes[taskID]
+	node.Display = workflow.NodeDisplayIndeterminate
+	node.BroadcastChanges(false /* updateChildren */)
+	if err := lw.checkpointWriter.UpdateTask(taskID, workflowpb.TaskState_TaskRunning, nil); err != nil {
+		return err
+	}
+
+	err := run()
+	if err != nil {
+		node.Message = err.Error()
+		node.BroadcastChanges(false /* updateChildren */)
+		if cerr := lw.checkpointWriter.UpdateTask(taskID, workflowpb.TaskState_TaskRunning, err); cerr != nil {
+			log.Errorf("failed to checkpoint the failure of task %v: %v", taskID, cerr)
+		}
+		return err
+	}
+
+	node.Display = workflow.NodeDisplayDeterminate
+	node.Progress = 100
+	node.BroadcastChanges(false /* updateChildren */)
+	return lw.checkpointWriter.UpdateTask(taskID, workflowpb.TaskState_TaskDone, nil)
+}
+
+func (lw *lookupVindexWorkflow) runCreate() error {
+	settings := lw.checkpoint.Settings
+	specs := &vschemapb.Keyspace{}
+	if err := json2.Unmarshal([]byte(settings["spec"]), specs); err != nil {
+		return err
+	}
+	lw.logger.Infof("Creating lookup vindex %v", lw.qualifiedVindexName())
+	return lw.wr.CreateLookupVindex(lw.ctx, settings["keyspace"], specs, settings["cells"], settings["tablet_types"])
+}
+
+// runBackfill waits until all the streams backfilling the lookup table
+// have caught up. The streams that are still pending are recorded in
+// the task attributes so that the progress can be followed in the topo.
+func (lw *lookupVindexWorkflow) runBackfill() error {
+	node := lw.taskUINodes[taskBackfill]
+	for {
+		pending, err := lw.wr.LookupVindexBackfillPending(lw.ctx, lw.qualifiedVindexName())
+		if err != nil {
+			return err
+		}
+		attributes := map[string]string{
+			"pending_streams": strings.Join(pending, "\n"),
+			"last_checked":    time.Now().UTC().Format(time.RFC3339),
+		}
+		if err := lw.checkpointWriter.UpdateTaskAttributes(taskBackfill, attributes); err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			node.Message = "Backfill has caught up."
+			return nil
+		}
+		node.Message = fmt.Sprintf("Waiting for %d stream(s): %v", len(pending), strings.Join(pending, ", "))
+		node.BroadcastChanges(false /* updateChildren */)
+
+		select {
+		case <-lw.ctx.Done():
+			return lw.ctx.Err()
+		case <-time.After(lw.pollInterval):
+		}
+	}
+}
+
+func (lw *lookupVindexWorkflow) runExternalize() error {
+	lw.logger.Infof("Externalizing lookup vindex %v", lw.qualifiedVindexName())
+	return lw.wr.ExternalizeVindex(lw.ctx, lw.qualifiedVindexName())
+}
+
+func (lw *lookupVindexWorkflow) qualifiedVindexName() string {
+	return lw.checkpoint.Settings["keyspace"] + "." + lw.checkpoint.Settings["vindex"]
+}
+
+func (lw *lookupVindexWorkflow) setUIMessage(message string) {
+	log.Infof("Lookup vindex workflow: %v.", message)
+	lw.logger.Infof(message)
+	lw.rootUINode.Log = lw.logger.String()
+	lw.rootUINode.Message = message
+	lw.rootUINode.BroadcastChanges(false /* updateChildren */)
+}
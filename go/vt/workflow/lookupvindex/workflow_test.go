@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lookupvindex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+	"vitess.io/vitess/go/vt/workflow"
+
+	// import the gRPC client implementation for tablet manager
+	_ "vitess.io/vitess/go/vt/vttablet/grpctmclient"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+	vschemapb "vitess.io/vitess/go/vt/proto/vschema"
+	workflowpb "vitess.io/vitess/go/vt/proto/workflow"
+)
+
+const (
+	testKeyspace = "test_keyspace"
+	testSpec     = `{"vindexes":{"v":{"type":"lookup_unique","params":{"table":"lkpks.lkp","from":"c1","to":"keyspace_id"},"owner":"t1"}},"tables":{"t1":{"column_vindexes":[{"column":"c1","name":"v"}]}}}`
+)
+
+func init() {
+	Register()
+}
+
+// fakeWrangler records the calls made by the workflow. Its
+// LookupVindexBackfillPending returns the next entry of pending.
+type fakeWrangler struct {
+	calls   []string
+	pending [][]string
+}
+
+func (fw *fakeWrangler) CreateLookupVindex(ctx context.Context, keyspace string, specs *vschemapb.Keyspace, cell, tabletTypes string) error {
+	fw.calls = append(fw.calls, "create "+keyspace+" "+cell+" "+tabletTypes)
+	return nil
+}
+
+func (fw *fakeWrangler) LookupVindexBackfillPending(ctx context.Context, qualifiedVindexName string) ([]string, error) {
+	fw.calls = append(fw.calls, "pending "+qualifiedVindexName)
+	pending := fw.pending[0]
+	fw.pending = fw.pending[1:]
+	return pending, nil
+}
+
+func (fw *fakeWrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error {
+	fw.calls = append(fw.calls, "externalize "+qualifiedVindexName)
+	return nil
+}
+
+func TestLookupVindexWorkflow(t *testing.T) {
+	ctx := context.Background()
+	ts := setupTopology(ctx, t)
+	m := workflow.NewManager(ts)
+	wg, _, cancel := workflow.StartManager(m)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	uuid, err := m.Create(ctx, lookupVindexFactoryName, []string{"-keyspace=" + testKeyspace, "-spec=" + testSpec, "-cells=cell", "-tablet_types=replica", "-poll_interval=1ms"})
+	require.NoError(t, err)
+
+	// Inject the fake wrangler into the workflow.
+	w, err := m.WorkflowForTesting(uuid)
+	require.NoError(t, err)
+	fw := &fakeWrangler{
+		pending: [][]string{{"stream 1 for lkpks.-80 is copying"}, nil},
+	}
+	w.(*lookupVindexWorkflow).wr = fw
+
+	require.NoError(t, m.Start(ctx, uuid))
+	m.Wait(ctx, uuid)
+	require.NoError(t, workflow.VerifyAllTasksDone(ctx, ts, uuid))
+	require.NoError(t, m.Stop(ctx, uuid))
+
+	want := []string{
+		"create test_keyspace cell replica",
+		"pending test_keyspace.v",
+		"pending test_keyspace.v",
+		"externalize test_keyspace.v",
+	}
+	assert.Equal(t, want, fw.calls)
+
+	// The progress of the backfill is checkpointed in the topo.
+	wi, err := ts.GetWorkflow(ctx, uuid)
+	require.NoError(t, err)
+	checkpoint := &workflowpb.WorkflowCheckpoint{}
+	require.NoError(t, proto.Unmarshal(wi.Data, checkpoint))
+	assert.Equal(t, "", checkpoint.Tasks[taskBackfill].Attributes["pending_streams"])
+	assert.NotEqual(t, "", checkpoint.Tasks[taskBackfill].Attributes["last_checked"])
+}
+
+func TestLookupVindexWorkflowInit(t *testing.T) {
+	ctx := context.Background()
+	ts := setupTopology(ctx, t)
+	m := workflow.NewManager(ts)
+
+	testcases := []struct {
+		args []string
+		err  string
+	}{{
+		args: []string{"-spec=" + testSpec},
+		err:  "keyspace and spec must be provided for the lookup vindex workflow",
+	}, {
+		args: []string{"-keyspace=" + testKeyspace, "-spec=" + testSpec, "-poll_interval=0s"},
+		err:  "poll_interval must be positive: 0s",
+	}, {
+		args: []string{"-keyspace=" + testKeyspace, "-spec={\"vindexes\":{}}"},
+		err:  "only one vindex must be specified in the specs",
+	}, {
+		args: []string{"-keyspace=absent", "-spec=" + testSpec},
+		err:  "node doesn't exist",
+	}}
+	for _, tcase := range testcases {
+		_, err := m.Create(ctx, lookupVindexFactoryName, tcase.args)
+		require.Error(t, err, tcase.args)
+		assert.Contains(t, err.Error(), tcase.err, tcase.args)
+	}
+}
+
+func setupTopology(ctx context.Context, t *testing.T) *topo.Server {
+	ts := memorytopo.NewServer("cell")
+	err := ts.CreateKeyspace(ctx, testKeyspace, &topodatapb.Keyspace{})
+	require.NoError(t, err)
+	return ts
+}
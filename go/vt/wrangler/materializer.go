@@ -21,6 +21,7 @@ import (
 	"hash/fnv"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"text/template"
@@ -640,77 +641,122 @@ func generateColDef(lines []string, sourceVindexCol, vindexFromCol string) (stri
 	return "", fmt.Errorf("column %s not found in schema %v", sourceVindexCol, lines)
 }
 
-// ExternalizeVindex externalizes a lookup vindex that's finished backfilling or has caught up.
-func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error {
+// lookupVindexWorkflow contains the information needed to manage the
+// vreplication streams that backfill a lookup vindex.
+type lookupVindexWorkflow struct {
+	sourceKeyspace string
+	sourceVSchema  *vschemapb.Keyspace
+	vindex         *vschemapb.Vindex
+	workflow       string
+	targetShards   []*topo.ShardInfo
+}
+
+func (wr *Wrangler) buildLookupVindexWorkflow(ctx context.Context, qualifiedVindexName string) (*lookupVindexWorkflow, error) {
 	splits := strings.Split(qualifiedVindexName, ".")
 	if len(splits) != 2 {
-		return fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
+		return nil, fmt.Errorf("vindex name should be of the form keyspace.vindex: %s", qualifiedVindexName)
 	}
 	sourceKeyspace, vindexName := splits[0], splits[1]
 	sourceVSchema, err := wr.ts.GetVSchema(ctx, sourceKeyspace)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	sourceVindex := sourceVSchema.Vindexes[vindexName]
 	if sourceVindex == nil {
-		return fmt.Errorf("vindex %s not found in vschema", qualifiedVindexName)
+		return nil, fmt.Errorf("vindex %s not found in vschema", qualifiedVindexName)
 	}
 	qualifiedTableName := sourceVindex.Params["table"]
 	splits = strings.Split(qualifiedTableName, ".")
 	if len(splits) != 2 {
-		return fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", qualifiedTableName)
+		return nil, fmt.Errorf("table name in vindex should be of the form keyspace.table: %s", qualifiedTableName)
 	}
 	targetKeyspace, targetTableName := splits[0], splits[1]
-	workflow := targetTableName + "_vdx"
 	targetShards, err := wr.ts.GetServingShards(ctx, targetKeyspace)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return &lookupVindexWorkflow{
+		sourceKeyspace: sourceKeyspace,
+		sourceVSchema:  sourceVSchema,
+		vindex:         sourceVindex,
+		workflow:       targetTableName + "_vdx",
+		targetShards:   targetShards,
+	}, nil
+}
 
-	// Create a parallelizer function.
-	forAllTargets := func(f func(*topo.ShardInfo) error) error {
-		var wg sync.WaitGroup
-		allErrors := &concurrency.AllErrorRecorder{}
-		for _, targetShard := range targetShards {
-			wg.Add(1)
-			go func(targetShard *topo.ShardInfo) {
-				defer wg.Done()
+// forAllTargets runs f in parallel for all the target shards of the lookup vindex.
+func (lw *lookupVindexWorkflow) forAllTargets(f func(*topo.ShardInfo) error) error {
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	for _, targetShard := range lw.targetShards {
+		wg.Add(1)
+		go func(targetShard *topo.ShardInfo) {
+			defer wg.Done()
 
-				if err := f(targetShard); err != nil {
-					allErrors.RecordError(err)
-				}
-			}(targetShard)
+			if err := f(targetShard); err != nil {
+				allErrors.RecordError(err)
+			}
+		}(targetShard)
+	}
+	wg.Wait()
+	return allErrors.AggrError(vterrors.Aggregate)
+}
+
+// lookupVindexStream is the state of one of the streams backfilling a lookup vindex.
+type lookupVindexStream struct {
+	id      int64
+	state   string
+	message string
+}
+
+// readStreams returns the streams of the lookup vindex on the master of targetShard.
+func (lw *lookupVindexWorkflow) readStreams(ctx context.Context, wr *Wrangler, targetMaster *topo.TabletInfo) ([]*lookupVindexStream, error) {
+	p3qr, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select id, state, message from _vt.vreplication where workflow=%s and db_name=%s", encodeString(lw.workflow), encodeString(targetMaster.DbName())))
+	if err != nil {
+		return nil, err
+	}
+	qr := sqltypes.Proto3ToResult(p3qr)
+	streams := make([]*lookupVindexStream, 0, len(qr.Rows))
+	for _, row := range qr.Rows {
+		id, err := evalengine.ToInt64(row[0])
+		if err != nil {
+			return nil, err
 		}
-		wg.Wait()
-		return allErrors.AggrError(vterrors.Aggregate)
+		streams = append(streams, &lookupVindexStream{
+			id:      id,
+			state:   row[1].ToString(),
+			message: row[2].ToString(),
+		})
+	}
+	return streams, nil
+}
+
+// ExternalizeVindex externalizes a lookup vindex that's finished backfilling or has caught up.
+func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName string) error {
+	lw, err := wr.buildLookupVindexWorkflow(ctx, qualifiedVindexName)
+	if err != nil {
+		return err
 	}
 
-	err = forAllTargets(func(targetShard *topo.ShardInfo) error {
+	err = lw.forAllTargets(func(targetShard *topo.ShardInfo) error {
 		targetMaster, err := wr.ts.GetTablet(ctx, targetShard.MasterAlias)
 		if err != nil {
 			return err
 		}
-		p3qr, err := wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, fmt.Sprintf("select id, state, message from _vt.vreplication where workflow=%s and db_name=%s", encodeString(workflow), encodeString(targetMaster.DbName())))
+		streams, err := lw.readStreams(ctx, wr, targetMaster)
 		if err != nil {
 			return err
 		}
-		qr := sqltypes.Proto3ToResult(p3qr)
-		for _, row := range qr.Rows {
-			id, err := evalengine.ToInt64(row[0])
-			if err != nil {
-				return err
-			}
-			state := row[1].ToString()
-			message := row[2].ToString()
-			if sourceVindex.Owner == "" {
+		for _, stream := range streams {
+			if lw.vindex.Owner == "" {
 				// If there's no owner, all streams need to be running.
-				if state != binlogplayer.BlpRunning {
-					return fmt.Errorf("stream %d for %v.%v is not in Running state: %v", id, targetShard.Keyspace(), targetShard.ShardName(), state)
+				if stream.state != binlogplayer.BlpRunning {
+					return fmt.Errorf("stream %d for %v.%v is not in Running state: %v", stream.id, targetShard.Keyspace(), targetShard.ShardName(), stream.state)
 				}
 			} else {
 				// If there is an owner, all streams need to be stopped after copy.
-				if state != binlogplayer.BlpStopped || !strings.Contains(message, "Stopped after copy") {
-					return fmt.Errorf("stream %d for %v.%v is not in Stopped after copy state: %v, %v", id, targetShard.Keyspace(), targetShard.ShardName(), state, message)
+				if stream.state != binlogplayer.BlpStopped || !strings.Contains(stream.message, "Stopped after copy") {
+					return fmt.Errorf("stream %d for %v.%v is not in Stopped after copy state: %v, %v", stream.id, targetShard.Keyspace(), targetShard.ShardName(), stream.state, stream.message)
 				}
 			}
 		}
@@ -720,14 +766,14 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 		return err
 	}
 
-	if sourceVindex.Owner != "" {
+	if lw.vindex.Owner != "" {
 		// If there is an owner, we have to delete the streams.
-		err := forAllTargets(func(targetShard *topo.ShardInfo) error {
+		err := lw.forAllTargets(func(targetShard *topo.ShardInfo) error {
 			targetMaster, err := wr.ts.GetTablet(ctx, targetShard.MasterAlias)
 			if err != nil {
 				return err
 			}
-			query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(targetMaster.DbName()), encodeString(workflow))
+			query := fmt.Sprintf("delete from _vt.vreplication where db_name=%s and workflow=%s", encodeString(targetMaster.DbName()), encodeString(lw.workflow))
 			_, err = wr.tmc.VReplicationExec(ctx, targetMaster.Tablet, query)
 			if err != nil {
 				return err
@@ -740,8 +786,73 @@ func (wr *Wrangler) ExternalizeVindex(ctx context.Context, qualifiedVindexName s
 	}
 
 	// Remove the write_only param and save the source vschema.
-	delete(sourceVindex.Params, "write_only")
-	return wr.ts.SaveVSchema(ctx, sourceKeyspace, sourceVSchema)
+	delete(lw.vindex.Params, "write_only")
+	return wr.ts.SaveVSchema(ctx, lw.sourceKeyspace, lw.sourceVSchema)
+}
+
+// LookupVindexBackfillPending returns a description of every stream
+// backfilling the lookup vindex that has not caught up yet. If the
+// returned list is empty, the vindex can be externalized.
+// A stream of an owned vindex is done once it has stopped after the copy
+// phase. A stream of an unowned vindex is done once it has finished copying
+// and is running.
+func (wr *Wrangler) LookupVindexBackfillPending(ctx context.Context, qualifiedVindexName string) ([]string, error) {
+	lw, err := wr.buildLookupVindexWorkflow(ctx, qualifiedVindexName)
+	if err != nil {
+		return nil, err
+	}
+
+	var mu sync.Mutex
+	var pending []string
+	err = lw.forAllTargets(func(targetShard *topo.ShardInfo) error {
+		targetMaster, err := wr.ts.GetTablet(ctx, targetShard.MasterAlias)
+		if err != nil {
+			return err
+		}
+		streams, err := lw.readStreams(ctx, wr, targetMaster)
+		if err != nil {
+			return err
+		}
+		if len(streams) == 0 {
+			return fmt.Errorf("no streams found for workflow %v on %v.%v", lw.workflow, targetShard.Keyspace(), targetShard.ShardName())
+		}
+		var shardPending []string
+		var running []string
+		for _, stream := range streams {
+			switch {
+			case lw.vindex.Owner != "" && stream.state == binlogplayer.BlpStopped && strings.Contains(stream.message, "Stopped after copy"):
+			case lw.vindex.Owner == "" && stream.state == binlogplayer.BlpRunning:
+				running = append(running, strconv.FormatInt(stream.id, 10))
+			default:
+				// Streams in Error state are retried, so they're only reported as pending.
+				desc := fmt.Sprintf("stream %d for %v.%v is in %v state", stream.id, targetShard.Keyspace(), targetShard.ShardName(), stream.state)
+				if stream.message != "" {
+					desc += ": " + stream.message
+				}
+				shardPending = append(shardPending, desc)
+			}
+		}
+		if len(running) != 0 {
+			// Running streams may still be in the copy phase.
+			query := fmt.Sprintf("select distinct vrepl_id from _vt.copy_state where vrepl_id in (%s)", strings.Join(running, ", "))
+			p3qr, err := wr.tmc.ExecuteFetchAsDba(ctx, targetMaster.Tablet, true, []byte(query), len(running), false, false)
+			if err != nil {
+				return err
+			}
+			for _, row := range sqltypes.Proto3ToResult(p3qr).Rows {
+				shardPending = append(shardPending, fmt.Sprintf("stream %s for %v.%v is copying", row[0].ToString(), targetShard.Keyspace(), targetShard.ShardName()))
+			}
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		pending = append(pending, shardPending...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(pending)
+	return pending, nil
 }
 
 //
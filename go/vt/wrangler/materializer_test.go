@@ -1552,6 +1552,108 @@ func TestExternalizeVindex(t *testing.T) {
 	}
 }
 
+func TestLookupVindexBackfillPending(t *testing.T) {
+	ms := &vtctldatapb.MaterializeSettings{
+		SourceKeyspace: "sourceks",
+		TargetKeyspace: "targetks",
+	}
+	env := newTestMaterializerEnv(t, ms, []string{"0"}, []string{"-80", "80-"})
+	defer env.close()
+
+	sourceVSchema := &vschemapb.Keyspace{
+		Sharded: true,
+		Vindexes: map[string]*vschemapb.Vindex{
+			"owned": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table":      "targetks.lkp",
+					"from":       "c1",
+					"to":         "c2",
+					"write_only": "true",
+				},
+				Owner: "t1",
+			},
+			"unowned": {
+				Type: "lookup_unique",
+				Params: map[string]string{
+					"table":      "targetks.lkp",
+					"from":       "c1",
+					"to":         "c2",
+					"write_only": "true",
+				},
+			},
+		},
+	}
+	err := env.topoServ.SaveVSchema(context.Background(), ms.SourceKeyspace, sourceVSchema)
+	require.NoError(t, err)
+
+	fields := sqltypes.MakeTestFields(
+		"id|state|message",
+		"int64|varbinary|varbinary",
+	)
+	copyFields := sqltypes.MakeTestFields(
+		"vrepl_id",
+		"int64",
+	)
+	running := sqltypes.MakeTestResult(fields, "1|Running|")
+	copying := sqltypes.MakeTestResult(fields, "1|Copying|")
+	stopped := sqltypes.MakeTestResult(fields, "1|Stopped|Stopped after copy")
+	validationQuery := "select id, state, message from _vt.vreplication where workflow='lkp_vdx' and db_name='vt_targetks'"
+	copyStateQuery := "select distinct vrepl_id from _vt.copy_state where vrepl_id in (1)"
+	testcases := []struct {
+		input       string
+		vrResponses map[int]*sqltypes.Result
+		copyState   map[int]*sqltypes.Result
+		want        []string
+		err         string
+	}{{
+		input:       "sourceks.owned",
+		vrResponses: map[int]*sqltypes.Result{200: stopped, 210: stopped},
+	}, {
+		input:       "sourceks.owned",
+		vrResponses: map[int]*sqltypes.Result{200: stopped, 210: copying},
+		want:        []string{"stream 1 for targetks.80- is in Copying state"},
+	}, {
+		input:       "sourceks.unowned",
+		vrResponses: map[int]*sqltypes.Result{200: running, 210: running},
+		copyState: map[int]*sqltypes.Result{
+			200: sqltypes.MakeTestResult(copyFields),
+			210: sqltypes.MakeTestResult(copyFields, "1"),
+		},
+		want: []string{"stream 1 for targetks.80- is copying"},
+	}, {
+		input:       "sourceks.unowned",
+		vrResponses: map[int]*sqltypes.Result{200: running, 210: sqltypes.MakeTestResult(fields, "1|Error|duplicate key")},
+		copyState:   map[int]*sqltypes.Result{200: sqltypes.MakeTestResult(copyFields)},
+		want:        []string{"stream 1 for targetks.80- is in Error state: duplicate key"},
+	}, {
+		input:       "sourceks.unowned",
+		vrResponses: map[int]*sqltypes.Result{200: running, 210: sqltypes.MakeTestResult(fields)},
+		copyState:   map[int]*sqltypes.Result{200: sqltypes.MakeTestResult(copyFields)},
+		err:         "no streams found for workflow lkp_vdx on targetks.80-",
+	}, {
+		input: "sourceks.absent",
+		err:   "vindex sourceks.absent not found in vschema",
+	}}
+	for _, tcase := range testcases {
+		for id, result := range tcase.vrResponses {
+			env.tmc.expectVRQuery(id, validationQuery, result)
+		}
+		for id, result := range tcase.copyState {
+			env.tmc.expectVRQuery(id, copyStateQuery, result)
+		}
+
+		pending, err := env.wr.LookupVindexBackfillPending(context.Background(), tcase.input)
+		if tcase.err != "" {
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tcase.err)
+			continue
+		}
+		require.NoError(t, err)
+		require.Equal(t, tcase.want, pending, tcase.input)
+	}
+}
+
 func TestMaterializerOneToOne(t *testing.T) {
 	ms := &vtctldatapb.MaterializeSettings{
 		Workflow:       "workflow",
@@ -0,0 +1,290 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"strconv"
+
+	"context"
+
+	"vitess.io/vitess/go/vt/sqlparser"
+	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/planbuilder"
+	"vitess.io/vitess/go/vt/vttablet/tabletserver/rules"
+
+	querypb "vitess.io/vitess/go/vt/proto/query"
+)
+
+var queryRulesHistorySize = flag.Int("query_rules_history_size", 10, "number of versions of the custom query rules of a keyspace kept for RollbackQueryRules, 0 keeps all of them")
+
+// QueryRuleChange is a difference between two sets of query rules.
+// Rules are identified by their name.
+type QueryRuleChange struct {
+	Name string
+	// Change is one of "added", "removed" or "changed".
+	Change string
+	Old    *rules.Rule `json:",omitempty"`
+	New    *rules.Rule `json:",omitempty"`
+}
+
+// QueryRuleMatches is the number of queries a rule matched.
+type QueryRuleMatches struct {
+	Name    string
+	Matches int
+}
+
+// QueryLogMatches is the result of running query rules against a sample
+// of a query log.
+type QueryLogMatches struct {
+	// Queries is the number of queries in the sample.
+	Queries int
+	// Skipped is the number of records which couldn't be parsed.
+	Skipped int
+	Rules   []QueryRuleMatches
+}
+
+// ValidateQueryRules parses query rules. The rules must have distinct
+// non-empty names, so that they can be compared by DiffQueryRules.
+func ValidateQueryRules(data []byte) (*rules.Rules, error) {
+	qrs := rules.New()
+	if err := qrs.UnmarshalJSON(data); err != nil {
+		return nil, err
+	}
+	names := make(map[string]bool)
+	for i, qr := range qrs.CopyUnderlying() {
+		if qr.Name == "" {
+			return nil, fmt.Errorf("query rule %d has no name", i)
+		}
+		if names[qr.Name] {
+			return nil, fmt.Errorf("duplicate query rule name %s", qr.Name)
+		}
+		names[qr.Name] = true
+	}
+	return qrs, nil
+}
+
+// GetQueryRules returns the query rules of a keyspace. A keyspace
+// without rules has an empty rule set.
+func (wr *Wrangler) GetQueryRules(ctx context.Context, keyspace string) (*rules.Rules, error) {
+	data, err := wr.ts.GetQueryRules(ctx, keyspace)
+	if err != nil {
+		if topo.IsErrType(err, topo.NoNode) {
+			return rules.New(), nil
+		}
+		return nil, err
+	}
+	qrs := rules.New()
+	if err := qrs.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("bad query rules for keyspace %s: %v", keyspace, err)
+	}
+	return qrs, nil
+}
+
+// DiffQueryRules returns the changes the query rules in data would make
+// to the query rules of a keyspace.
+func (wr *Wrangler) DiffQueryRules(ctx context.Context, keyspace string, data []byte) ([]QueryRuleChange, error) {
+	qrs, err := ValidateQueryRules(data)
+	if err != nil {
+		return nil, err
+	}
+	current, err := wr.GetQueryRules(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	return diffQueryRules(current, qrs), nil
+}
+
+// ApplyQueryRules validates the query rules in data and saves them as the
+// query rules of a keyspace, where the topocustomrule source of its
+// tablets reads them. The rules are recorded as a new version by author
+// in the history of the keyspace. It returns the changes made to the
+// rules. If dryRun is set, the rules are not saved.
+func (wr *Wrangler) ApplyQueryRules(ctx context.Context, keyspace string, data []byte, author string, dryRun bool) ([]QueryRuleChange, error) {
+	if _, err := wr.ts.GetKeyspace(ctx, keyspace); err != nil {
+		return nil, err
+	}
+	changes, err := wr.DiffQueryRules(ctx, keyspace, data)
+	if err != nil {
+		return nil, err
+	}
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+	qrv, err := wr.ts.SaveQueryRulesVersion(ctx, keyspace, data, author, *queryRulesHistorySize)
+	if err != nil {
+		return nil, err
+	}
+	wr.Logger().Infof("Applied %d query rule changes to keyspace %s as version %d", len(changes), keyspace, qrv.Version)
+	return changes, nil
+}
+
+// GetQueryRulesHistory returns the last versions of the query rules of a
+// keyspace, oldest first.
+func (wr *Wrangler) GetQueryRulesHistory(ctx context.Context, keyspace string) ([]*topo.QueryRulesVersion, error) {
+	return wr.ts.GetQueryRulesHistory(ctx, keyspace)
+}
+
+// RollbackQueryRules applies a previous version of the query rules of a
+// keyspace, which is recorded as a new version by author. It returns the
+// changes made to the rules. If dryRun is set, the rules are not saved.
+func (wr *Wrangler) RollbackQueryRules(ctx context.Context, keyspace string, version int64, author string, dryRun bool) ([]QueryRuleChange, error) {
+	history, err := wr.ts.GetQueryRulesHistory(ctx, keyspace)
+	if err != nil {
+		return nil, err
+	}
+	for _, qrv := range history {
+		if qrv.Version == version {
+			return wr.ApplyQueryRules(ctx, keyspace, qrv.Rules, author, dryRun)
+		}
+	}
+	return nil, fmt.Errorf("version %d of the query rules of keyspace %s not found in their history", version, keyspace)
+}
+
+func diffQueryRules(current, next *rules.Rules) []QueryRuleChange {
+	var changes []QueryRuleChange
+	for _, qr := range next.CopyUnderlying() {
+		old := current.Find(qr.Name)
+		switch {
+		case old == nil:
+			changes = append(changes, QueryRuleChange{Name: qr.Name, Change: "added", New: qr})
+		case !old.Equal(qr):
+			changes = append(changes, QueryRuleChange{Name: qr.Name, Change: "changed", Old: old, New: qr})
+		}
+	}
+	for _, qr := range current.CopyUnderlying() {
+		if next.Find(qr.Name) == nil {
+			changes = append(changes, QueryRuleChange{Name: qr.Name, Change: "removed", Old: qr})
+		}
+	}
+	return changes
+}
+
+// queryLogRecord is the part of a JSON query log record of a tablet
+// which is used to match query rules.
+type queryLogRecord struct {
+	Username    string
+	PlanType    string
+	OriginalSQL string
+	BindVars    json.RawMessage
+}
+
+// MatchQueryLog counts how many queries of a sample of a tablet query
+// log, in the JSON format, each query rule matches. The records don't
+// have the client address nor the estimated rows of the queries, so the
+// rules with such conditions don't match.
+func MatchQueryLog(qrs *rules.Rules, log io.Reader) (*QueryLogMatches, error) {
+	result := &QueryLogMatches{}
+	underlying := qrs.CopyUnderlying()
+	counts := make([]int, len(underlying))
+	scanner := bufio.NewScanner(log)
+	scanner.Buffer(nil, 1024*1024)
+	for scanner.Scan() {
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+		var record queryLogRecord
+		if err := json.Unmarshal(line, &record); err != nil {
+			result.Skipped++
+			continue
+		}
+		planID, ok := planbuilder.PlanByName(record.PlanType)
+		if !ok {
+			result.Skipped++
+			continue
+		}
+		stmt, err := sqlparser.Parse(record.OriginalSQL)
+		if err != nil {
+			result.Skipped++
+			continue
+		}
+		result.Queries++
+		tableName := queryTableName(stmt)
+		bindVars := queryLogBindVars(record.BindVars)
+		for i, qr := range underlying {
+			filtered := qr.FilterByPlan(record.OriginalSQL, planID, tableName, 0)
+			if filtered != nil && filtered.GetAction("", record.Username, bindVars) != rules.QRContinue {
+				counts[i]++
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	for i, qr := range underlying {
+		result.Rules = append(result.Rules, QueryRuleMatches{Name: qr.Name, Matches: counts[i]})
+	}
+	return result, nil
+}
+
+// queryTableName returns the table of a single table query, as the
+// tablet planner does.
+func queryTableName(stmt sqlparser.Statement) string {
+	var exprs sqlparser.TableExprs
+	switch stmt := stmt.(type) {
+	case *sqlparser.Select:
+		exprs = stmt.From
+	case *sqlparser.Update:
+		exprs = stmt.TableExprs
+	case *sqlparser.Delete:
+		exprs = stmt.TableExprs
+	case *sqlparser.Insert:
+		return stmt.Table.Name.String()
+	}
+	if len(exprs) != 1 {
+		return ""
+	}
+	aliased, ok := exprs[0].(*sqlparser.AliasedTableExpr)
+	if !ok {
+		return ""
+	}
+	return sqlparser.GetTableName(aliased.Expr).String()
+}
+
+// queryLogBindVars converts the bind variables of a JSON query log
+// record. The values which were redacted or truncated are left out.
+func queryLogBindVars(data json.RawMessage) map[string]*querypb.BindVariable {
+	var values map[string]struct {
+		Type  string          `json:"type"`
+		Value json.RawMessage `json:"value"`
+	}
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil
+	}
+	bindVars := make(map[string]*querypb.BindVariable, len(values))
+	for name, v := range values {
+		typ, ok := querypb.Type_value[v.Type]
+		if !ok || len(v.Value) == 0 {
+			continue
+		}
+		value := string(v.Value)
+		if v.Value[0] == '"' {
+			var err error
+			if value, err = strconv.Unquote(value); err != nil {
+				continue
+			}
+		}
+		bindVars[name] = &querypb.BindVariable{Type: querypb.Type(typ), Value: []byte(value)}
+	}
+	return bindVars
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/vt/logutil"
+	"vitess.io/vitess/go/vt/topo/memorytopo"
+
+	topodatapb "vitess.io/vitess/go/vt/proto/topodata"
+)
+
+func TestValidateQueryRules(t *testing.T) {
+	_, err := ValidateQueryRules([]byte(`[{"Name": "r1"}, {"Name": "r1"}]`))
+	assert.EqualError(t, err, "duplicate query rule name r1")
+	_, err = ValidateQueryRules([]byte(`[{"Name": "r1", "Action": "BAD"}]`))
+	assert.Error(t, err)
+	qrs, err := ValidateQueryRules([]byte(`[{"Name": "r1"}, {"Name": "r2"}]`))
+	require.NoError(t, err)
+	assert.Len(t, qrs.CopyUnderlying(), 2)
+}
+
+func TestMatchQueryLog(t *testing.T) {
+	qrs, err := ValidateQueryRules([]byte(`[
+		{"Name": "t1", "TableNames": ["t1"], "Action": "FAIL"},
+		{"Name": "big_id", "BindVarConds": [{"Name": "id", "OnAbsent": false, "OnMismatch": false, "Operator": ">", "Value": 10}], "Action": "FAIL"},
+		{"Name": "user", "User": "app", "Plans": ["Insert"], "Action": "FAIL"}
+	]`))
+	require.NoError(t, err)
+
+	log := `{"Username": "app", "PlanType": "Select", "OriginalSQL": "select * from t1 where id = :id", "BindVars": {"id": {"type": "INT64", "value": 12}}}
+{"Username": "app", "PlanType": "Select", "OriginalSQL": "select * from t2 where id = :id", "BindVars": {"id": {"type": "INT64", "value": 2}}}
+{"Username": "app", "PlanType": "Insert", "OriginalSQL": "insert into t2 values (1)", "BindVars": "[REDACTED]"}
+
+not json
+{"Username": "app", "PlanType": "Select", "OriginalSQL": "not sql"}
+`
+	matches, err := MatchQueryLog(qrs, strings.NewReader(log))
+	require.NoError(t, err)
+	assert.Equal(t, &QueryLogMatches{
+		Queries: 3,
+		Skipped: 2,
+		Rules: []QueryRuleMatches{
+			{Name: "t1", Matches: 1},
+			{Name: "big_id", Matches: 1},
+			{Name: "user", Matches: 1},
+		},
+	}, matches)
+}
+
+func TestQueryRulesHistory(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	wr := New(logutil.NewMemoryLogger(), ts, nil)
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+
+	defer func(size int) { *queryRulesHistorySize = size }(*queryRulesHistorySize)
+	*queryRulesHistorySize = 2
+
+	history, err := wr.GetQueryRulesHistory(ctx, "ks")
+	require.NoError(t, err)
+	assert.Empty(t, history)
+
+	for _, data := range []string{
+		`[{"Name": "r1"}]`,
+		`[{"Name": "r1"}, {"Name": "r2"}]`,
+		// Without changes, no version is added.
+		`[{"Name": "r1"}, {"Name": "r2"}]`,
+		`[]`,
+	} {
+		_, err := wr.ApplyQueryRules(ctx, "ks", []byte(data), "alice", false)
+		require.NoError(t, err)
+	}
+	history, err = wr.GetQueryRulesHistory(ctx, "ks")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.EqualValues(t, 2, history[0].Version)
+	assert.EqualValues(t, 3, history[1].Version)
+	assert.Equal(t, "alice", history[1].Author)
+	assert.False(t, history[1].Time.IsZero())
+
+	// Version 1 is not kept anymore.
+	_, err = wr.RollbackQueryRules(ctx, "ks", 1, "bob", false)
+	assert.EqualError(t, err, "version 1 of the query rules of keyspace ks not found in their history")
+
+	changes, err := wr.RollbackQueryRules(ctx, "ks", 2, "bob", true)
+	require.NoError(t, err)
+	assert.Len(t, changes, 2)
+	qrs, err := wr.GetQueryRules(ctx, "ks")
+	require.NoError(t, err)
+	assert.Empty(t, qrs.CopyUnderlying())
+
+	_, err = wr.RollbackQueryRules(ctx, "ks", 2, "bob", false)
+	require.NoError(t, err)
+	qrs, err = wr.GetQueryRules(ctx, "ks")
+	require.NoError(t, err)
+	assert.Len(t, qrs.CopyUnderlying(), 2)
+	history, err = wr.GetQueryRulesHistory(ctx, "ks")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.EqualValues(t, 4, history[1].Version)
+	assert.Equal(t, "bob", history[1].Author)
+
+	require.NoError(t, ts.DeleteKeyspace(ctx, "ks"))
+	history, err = wr.GetQueryRulesHistory(ctx, "ks")
+	require.NoError(t, err)
+	assert.Empty(t, history)
+}
+
+func TestQueryRulesHistorySeeded(t *testing.T) {
+	ctx := context.Background()
+	ts := memorytopo.NewServer("zone1")
+	wr := New(logutil.NewMemoryLogger(), ts, nil)
+	require.NoError(t, ts.CreateKeyspace(ctx, "ks", &topodatapb.Keyspace{}))
+
+	// Rules saved before there was a history are recorded as version 1.
+	require.NoError(t, ts.SaveQueryRules(ctx, "ks", []byte(`[{"Name": "r1"}]`)))
+	_, err := wr.ApplyQueryRules(ctx, "ks", []byte(`[]`), "alice", false)
+	require.NoError(t, err)
+	history, err := wr.GetQueryRulesHistory(ctx, "ks")
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.EqualValues(t, 1, history[0].Version)
+	assert.JSONEq(t, `[{"Name": "r1"}]`, string(history[0].Rules))
+	assert.EqualValues(t, 2, history[1].Version)
+
+	_, err = wr.RollbackQueryRules(ctx, "ks", 1, "bob", false)
+	require.NoError(t, err)
+	qrs, err := wr.GetQueryRules(ctx, "ks")
+	require.NoError(t, err)
+	assert.Len(t, qrs.CopyUnderlying(), 1)
+}
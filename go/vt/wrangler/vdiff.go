@@ -122,6 +122,58 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 	filteredReplicationWaitTime time.Duration, format string, maxRows int64, tables string) (map[string]*DiffReport, error) {
 	log.Infof("Starting VDiff for %s.%s, sourceCell %s, targetCell %s, tabletTypes %s, timeout %s",
 		targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr, filteredReplicationWaitTime.String())
+	df, err := wr.newVDiff(ctx, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr, tables)
+	if err != nil {
+		return nil, err
+	}
+	defer func(ctx context.Context) {
+		if err := df.restartTargets(ctx); err != nil {
+			wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", workflow, err)
+		}
+	}(ctx)
+
+	// Perform the diffs.
+	// We need a cancelable context to abort all running streams
+	// if one stream returns an error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	// TODO(sougou): parallelize
+	rowsToCompare := maxRows
+	diffReports := make(map[string]*DiffReport)
+	jsonOutput := ""
+	for table, td := range df.differs {
+		if err := df.diffTable(ctx, wr, table, td, filteredReplicationWaitTime); err != nil {
+			return nil, err
+		}
+		// Perform the diff of source and target streams.
+		dr, err := td.diff(ctx, df.ts.wr, &rowsToCompare)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "diff")
+		}
+		if format == "json" {
+			json, err := json.MarshalIndent(*dr, "", "")
+			if err != nil {
+				wr.Logger().Printf("Error converting report to json: %v", err.Error())
+			}
+			if jsonOutput != "" {
+				jsonOutput += ","
+			}
+			jsonOutput += fmt.Sprintf("%s", json)
+		} else {
+			wr.Logger().Printf("Summary for %v: %+v\n", td.targetTable, *dr)
+		}
+		diffReports[table] = dr
+	}
+	if format == "json" && jsonOutput != "" {
+		wr.logger.Printf(`[ %s ]`, jsonOutput)
+	}
+	return diffReports, nil
+}
+
+// newVDiff builds the plan of a diff between the sources and targets of a
+// vreplication workflow, and selects the tablets to stream from.
+func (wr *Wrangler) newVDiff(ctx context.Context, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr, tables string) (*vdiff, error) {
 	// Assign defaults to sourceCell and targetCell if not specified.
 	if sourceCell == "" && targetCell == "" {
 		cells, err := wr.ts.GetCellInfoNames(ctx)
@@ -197,49 +249,7 @@ func (wr *Wrangler) VDiff(ctx context.Context, targetKeyspace, workflow, sourceC
 	if err := df.selectTablets(ctx, ts); err != nil {
 		return nil, vterrors.Wrap(err, "selectTablets")
 	}
-	defer func(ctx context.Context) {
-		if err := df.restartTargets(ctx); err != nil {
-			wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", workflow, err)
-		}
-	}(ctx)
-
-	// Perform the diffs.
-	// We need a cancelable context to abort all running streams
-	// if one stream returns an error.
-	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
-
-	// TODO(sougou): parallelize
-	rowsToCompare := maxRows
-	diffReports := make(map[string]*DiffReport)
-	jsonOutput := ""
-	for table, td := range df.differs {
-		if err := df.diffTable(ctx, wr, table, td, filteredReplicationWaitTime); err != nil {
-			return nil, err
-		}
-		// Perform the diff of source and target streams.
-		dr, err := td.diff(ctx, df.ts.wr, &rowsToCompare)
-		if err != nil {
-			return nil, vterrors.Wrap(err, "diff")
-		}
-		if format == "json" {
-			json, err := json.MarshalIndent(*dr, "", "")
-			if err != nil {
-				wr.Logger().Printf("Error converting report to json: %v", err.Error())
-			}
-			if jsonOutput != "" {
-				jsonOutput += ","
-			}
-			jsonOutput += fmt.Sprintf("%s", json)
-		} else {
-			wr.Logger().Printf("Summary for %v: %+v\n", td.targetTable, *dr)
-		}
-		diffReports[table] = dr
-	}
-	if format == "json" && jsonOutput != "" {
-		wr.logger.Printf(`[ %s ]`, jsonOutput)
-	}
-	return diffReports, nil
+	return df, nil
 }
 
 func (df *vdiff) diffTable(ctx context.Context, wr *Wrangler, table string, td *tableDiffer, filteredReplicationWaitTime time.Duration) error {
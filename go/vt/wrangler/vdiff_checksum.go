@@ -0,0 +1,309 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"vitess.io/vitess/go/sqltypes"
+	"vitess.io/vitess/go/vt/concurrency"
+	"vitess.io/vitess/go/vt/log"
+	"vitess.io/vitess/go/vt/throttler"
+	"vitess.io/vitess/go/vt/vterrors"
+	"vitess.io/vitess/go/vt/vtgate/engine"
+)
+
+// ChecksumReport is the summary of a checksum diff for one table.
+type ChecksumReport struct {
+	SourceRows int64
+	TargetRows int64
+	// Chunks is the number of primary key ranges compared.
+	Chunks           int
+	MismatchedChunks []*ChecksumChunk `json:",omitempty"`
+}
+
+// ChecksumChunk is a primary key range whose rows differ between the
+// source and the target. The range is [Start, End) over the first primary
+// key column. Start and End are empty for the chunk holding the rows whose
+// first primary key column is not an integer.
+type ChecksumChunk struct {
+	Start      string
+	End        string
+	SourceRows int64
+	TargetRows int64
+}
+
+// chunkKey identifies the chunk of a row.
+type chunkKey struct {
+	// whole is set for the rows whose first pk column is not an
+	// integer. They all fall in the same chunk.
+	whole bool
+	start int64
+}
+
+// chunkSum is the row count and checksum of one chunk.
+type chunkSum struct {
+	rows     int64
+	checksum uint64
+}
+
+// VDiffChecksum reports the primary key ranges whose row counts or checksums
+// differ between the sources and targets of a vreplication workflow.
+// Unlike VDiff, the rows of each shard are checksummed as they are streamed,
+// in parallel across shards, instead of being compared row by row. The rows
+// are split in chunks of chunkSize values of the first primary key column.
+// If maxRowsPerSecond is not 0, the rows checksummed per second are throttled
+// to that rate.
+func (wr *Wrangler) VDiffChecksum(ctx context.Context, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr string,
+	filteredReplicationWaitTime time.Duration, format string, chunkSize, maxRowsPerSecond int64, tables string) (map[string]*ChecksumReport, error) {
+	log.Infof("Starting VDiffChecksum for %s.%s, sourceCell %s, targetCell %s, tabletTypes %s, timeout %s",
+		targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr, filteredReplicationWaitTime.String())
+	if chunkSize <= 0 {
+		return nil, fmt.Errorf("chunk size needs to be greater than 0")
+	}
+	df, err := wr.newVDiff(ctx, targetKeyspace, workflow, sourceCell, targetCell, tabletTypesStr, tables)
+	if err != nil {
+		return nil, err
+	}
+	for table, td := range df.differs {
+		if _, ok := td.sourcePrimitive.(*engine.OrderedAggregate); ok {
+			return nil, fmt.Errorf("table %s: checksums are not supported for aggregated tables, use VDiff instead", table)
+		}
+	}
+	defer func(ctx context.Context) {
+		if err := df.restartTargets(ctx); err != nil {
+			wr.Logger().Errorf("Could not restart workflow %s: %v, please restart it manually", workflow, err)
+		}
+	}(ctx)
+
+	// We need a cancelable context to abort all running streams
+	// if one stream returns an error.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	reports := make(map[string]*ChecksumReport)
+	var jsonReports []*ChecksumReport
+	for table, td := range df.differs {
+		if err := df.diffTable(ctx, wr, table, td, filteredReplicationWaitTime); err != nil {
+			return nil, err
+		}
+		cr, err := df.checksumTable(ctx, td, chunkSize, maxRowsPerSecond)
+		if err != nil {
+			return nil, vterrors.Wrap(err, "checksum")
+		}
+		if format == "json" {
+			jsonReports = append(jsonReports, cr)
+		} else {
+			wr.Logger().Printf("Summary for %v: %v source rows, %v target rows, %v chunks, %v mismatched\n", td.targetTable, cr.SourceRows, cr.TargetRows, cr.Chunks, len(cr.MismatchedChunks))
+			for _, chunk := range cr.MismatchedChunks {
+				wr.Logger().Printf("Mismatched range [%s, %s) of %v: %v source rows, %v target rows\n", chunk.Start, chunk.End, td.targetTable, chunk.SourceRows, chunk.TargetRows)
+			}
+		}
+		reports[table] = cr
+	}
+	if format == "json" && len(jsonReports) != 0 {
+		output, err := json.MarshalIndent(jsonReports, "", "  ")
+		if err != nil {
+			wr.Logger().Printf("Error converting report to json: %v", err.Error())
+		}
+		wr.Logger().Printf("%s\n", output)
+	}
+	return reports, nil
+}
+
+// checksumTable consumes the query streams started by diffTable, and
+// compares the chunk checksums of the sources and the targets.
+func (df *vdiff) checksumTable(ctx context.Context, td *tableDiffer, chunkSize, maxRowsPerSecond int64) (*ChecksumReport, error) {
+	var t *throttler.Throttler
+	if maxRowsPerSecond > 0 {
+		var err error
+		name := fmt.Sprintf("VDiffChecksum-%s.%s.%s", df.targetKeyspace, df.workflow, td.targetTable)
+		t, err = throttler.NewThrottler(name, "rows", len(df.sources)+len(df.targets), maxRowsPerSecond, throttler.ReplicationLagModuleDisabled)
+		if err != nil {
+			return nil, err
+		}
+		defer t.Close()
+	}
+
+	var mu sync.Mutex
+	sourceSums := make(map[chunkKey]*chunkSum)
+	targetSums := make(map[chunkKey]*chunkSum)
+	var wg sync.WaitGroup
+	allErrors := &concurrency.AllErrorRecorder{}
+	threadID := 0
+	start := func(participants map[string]*shardStreamer, sums map[chunkKey]*chunkSum) {
+		for _, participant := range participants {
+			wg.Add(1)
+			go func(participant *shardStreamer, threadID int) {
+				defer wg.Done()
+				shardSums, err := td.checksumStream(ctx, participant, chunkSize, t, threadID)
+				if err != nil {
+					allErrors.RecordError(err)
+					return
+				}
+				mu.Lock()
+				defer mu.Unlock()
+				mergeChunkSums(sums, shardSums)
+			}(participant, threadID)
+			threadID++
+		}
+	}
+	start(df.sources, sourceSums)
+	start(df.targets, targetSums)
+	wg.Wait()
+	if allErrors.HasErrors() {
+		return nil, allErrors.AggrError(vterrors.Aggregate)
+	}
+	return compareChunkSums(sourceSums, targetSums, chunkSize), nil
+}
+
+// checksumStream returns the chunk checksums of the rows streamed
+// by participant.
+func (td *tableDiffer) checksumStream(ctx context.Context, participant *shardStreamer, chunkSize int64, t *throttler.Throttler, threadID int) (map[chunkKey]*chunkSum, error) {
+	if t != nil {
+		defer t.ThreadFinished(threadID)
+	}
+	sums := make(map[chunkKey]*chunkSum)
+	for result := range participant.result {
+		for _, row := range result.Rows {
+			if err := throttle(ctx, t, threadID); err != nil {
+				return nil, err
+			}
+			key := chunkOf(row[td.comparePKs[0]], chunkSize)
+			sum, ok := sums[key]
+			if !ok {
+				sum = &chunkSum{}
+				sums[key] = sum
+			}
+			sum.rows++
+			sum.checksum ^= rowChecksum(row[:len(td.compareCols)])
+		}
+	}
+	return sums, participant.err
+}
+
+// throttle waits until t lets threadID process one more row.
+func throttle(ctx context.Context, t *throttler.Throttler, threadID int) error {
+	if t == nil {
+		return nil
+	}
+	for {
+		backoff := t.Throttle(threadID)
+		if backoff == throttler.NotThrottled {
+			return nil
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+			return vterrors.Wrap(ctx.Err(), "throttle")
+		}
+	}
+}
+
+func chunkOf(pk sqltypes.Value, chunkSize int64) chunkKey {
+	if !pk.IsIntegral() {
+		return chunkKey{whole: true}
+	}
+	n, err := pk.ToInt64()
+	if err != nil {
+		// Unsigned values above the max int64.
+		return chunkKey{whole: true}
+	}
+	start := n - n%chunkSize
+	if n < 0 && n%chunkSize != 0 {
+		start -= chunkSize
+	}
+	return chunkKey{start: start}
+}
+
+// rowChecksum returns the checksum of the values of a row. The checksums
+// of the rows are xor-ed, so that they can be combined in any order.
+func rowChecksum(row []sqltypes.Value) uint64 {
+	h := fnv.New64a()
+	for _, v := range row {
+		if v.IsNull() {
+			h.Write([]byte{0})
+			continue
+		}
+		h.Write([]byte{1})
+		h.Write([]byte(strconv.Itoa(v.Len())))
+		h.Write([]byte{':'})
+		h.Write(v.Raw())
+	}
+	return h.Sum64()
+}
+
+func mergeChunkSums(to, from map[chunkKey]*chunkSum) {
+	for key, sum := range from {
+		existing, ok := to[key]
+		if !ok {
+			to[key] = sum
+			continue
+		}
+		existing.rows += sum.rows
+		existing.checksum ^= sum.checksum
+	}
+}
+
+func compareChunkSums(source, target map[chunkKey]*chunkSum, chunkSize int64) *ChecksumReport {
+	cr := &ChecksumReport{}
+	keys := make(map[chunkKey]bool)
+	for key, sum := range source {
+		keys[key] = true
+		cr.SourceRows += sum.rows
+	}
+	for key, sum := range target {
+		keys[key] = true
+		cr.TargetRows += sum.rows
+	}
+	cr.Chunks = len(keys)
+	for key := range keys {
+		s, t := source[key], target[key]
+		if s == nil {
+			s = &chunkSum{}
+		}
+		if t == nil {
+			t = &chunkSum{}
+		}
+		if *s == *t {
+			continue
+		}
+		chunk := &ChecksumChunk{SourceRows: s.rows, TargetRows: t.rows}
+		if !key.whole {
+			chunk.Start = strconv.FormatInt(key.start, 10)
+			chunk.End = strconv.FormatInt(key.start+chunkSize, 10)
+		}
+		cr.MismatchedChunks = append(cr.MismatchedChunks, chunk)
+	}
+	sort.Slice(cr.MismatchedChunks, func(i, j int) bool {
+		ci, cj := cr.MismatchedChunks[i], cr.MismatchedChunks[j]
+		if ci.Start == "" || cj.Start == "" {
+			return cj.Start == "" && ci.Start != ""
+		}
+		si, _ := strconv.ParseInt(ci.Start, 10, 64)
+		sj, _ := strconv.ParseInt(cj.Start, 10, 64)
+		return si < sj
+	})
+	return cr
+}
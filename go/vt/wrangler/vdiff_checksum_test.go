@@ -0,0 +1,135 @@
+/*
+Copyright 2021 The Vitess Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wrangler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"vitess.io/vitess/go/sqltypes"
+
+	tabletmanagerdatapb "vitess.io/vitess/go/vt/proto/tabletmanagerdata"
+)
+
+func TestVDiffChecksumSharded(t *testing.T) {
+	env := newTestVDiffEnv([]string{"-40", "40-"}, []string{"-80", "80-"}, "", nil)
+	defer env.close()
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|varchar"),
+		}},
+	}
+
+	query := "select c1, c2, weight_string(c2) from t1 order by c1 asc"
+	fields := sqltypes.MakeTestFields("c1|c2|weight_string(c2)", "int64|varchar|varbinary")
+	testcases := []struct {
+		id     string
+		source [][]string
+		target [][]string
+		cr     *ChecksumReport
+	}{{
+		id:     "match",
+		source: [][]string{{"1|a|A", "2|b|B"}, {"3|c|C", "7|d|D"}},
+		target: [][]string{{"1|a|A", "---", "7|d|D"}, {"2|b|B", "3|c|C"}},
+		cr: &ChecksumReport{
+			SourceRows: 4,
+			TargetRows: 4,
+			Chunks:     3,
+		},
+	}, {
+		id:     "mismatch",
+		source: [][]string{{"1|a|A", "2|b|B"}, {"3|c|C", "7|d|D"}},
+		target: [][]string{{"1|a|A"}, {"2|x|X", "3|c|C", "7|d|D", "8|e|E"}},
+		cr: &ChecksumReport{
+			SourceRows: 4,
+			TargetRows: 5,
+			Chunks:     4,
+			MismatchedChunks: []*ChecksumChunk{{
+				Start:      "2",
+				End:        "4",
+				SourceRows: 2,
+				TargetRows: 2,
+			}, {
+				Start:      "8",
+				End:        "10",
+				TargetRows: 1,
+			}},
+		},
+	}}
+	for _, tcase := range testcases {
+		t.Run(tcase.id, func(t *testing.T) {
+			env.tablets[101].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, tcase.source[0]...))
+			env.tablets[111].setResults(query, vdiffSourceGtid, sqltypes.MakeTestStreamingResults(fields, tcase.source[1]...))
+			env.tablets[201].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, tcase.target[0]...))
+			env.tablets[211].setResults(query, vdiffTargetMasterPosition, sqltypes.MakeTestStreamingResults(fields, tcase.target[1]...))
+
+			crs, err := env.wr.VDiffChecksum(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 2, 1000, "")
+			require.NoError(t, err)
+			assert.Equal(t, tcase.cr, crs["t1"])
+		})
+	}
+}
+
+func TestVDiffChecksumAggregates(t *testing.T) {
+	env := newTestVDiffEnv([]string{"0"}, []string{"0"}, "select c1, count(*) c2 from t group by c1", nil)
+	defer env.close()
+
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:              "t1",
+			Columns:           []string{"c1", "c2"},
+			PrimaryKeyColumns: []string{"c1"},
+			Fields:            sqltypes.MakeTestFields("c1|c2", "int64|int64"),
+		}},
+	}
+	_, err := env.wr.VDiffChecksum(context.Background(), "target", env.workflow, env.cell, env.cell, "replica", 30*time.Second, "", 2, 0, "")
+	require.EqualError(t, err, "table t1: checksums are not supported for aggregated tables, use VDiff instead")
+}
+
+func TestChunkOf(t *testing.T) {
+	testcases := []struct {
+		in   sqltypes.Value
+		want chunkKey
+	}{
+		{sqltypes.NewInt64(0), chunkKey{start: 0}},
+		{sqltypes.NewInt64(9), chunkKey{start: 0}},
+		{sqltypes.NewInt64(10), chunkKey{start: 10}},
+		{sqltypes.NewInt64(-1), chunkKey{start: -10}},
+		{sqltypes.NewInt64(-10), chunkKey{start: -10}},
+		{sqltypes.NewUint64(25), chunkKey{start: 20}},
+		{sqltypes.NewUint64(1 << 63), chunkKey{whole: true}},
+		{sqltypes.NewVarBinary("abc"), chunkKey{whole: true}},
+	}
+	for _, tcase := range testcases {
+		assert.Equal(t, tcase.want, chunkOf(tcase.in, 10), tcase.in.String())
+	}
+}
+
+func TestRowChecksum(t *testing.T) {
+	row := func(values ...sqltypes.Value) []sqltypes.Value { return values }
+	assert.Equal(t, rowChecksum(row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"))), rowChecksum(row(sqltypes.NewInt64(1), sqltypes.NewVarChar("a"))))
+	assert.NotEqual(t, rowChecksum(row(sqltypes.NewVarChar("ab"), sqltypes.NewVarChar("c"))), rowChecksum(row(sqltypes.NewVarChar("a"), sqltypes.NewVarChar("bc"))))
+	assert.NotEqual(t, rowChecksum(row(sqltypes.NULL)), rowChecksum(row(sqltypes.NewVarChar(""))))
+}
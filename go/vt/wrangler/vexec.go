@@ -32,6 +32,7 @@ import (
 	"vitess.io/vitess/go/vt/log"
 	"vitess.io/vitess/go/vt/sqlparser"
 	"vitess.io/vitess/go/vt/topo"
+	"vitess.io/vitess/go/vt/vtctl/workflow"
 	vtctldvexec "vitess.io/vitess/go/vt/vtctl/workflow/vexec" // renamed to avoid a collision with the vexec struct in this package
 	"vitess.io/vitess/go/vt/vterrors"
 	"vitess.io/vitess/go/vt/vtgate/evalengine"
@@ -357,8 +358,11 @@ type ShardReplicationStatus struct {
 }
 
 type copyState struct {
-	Table  string
-	LastPK string
+	Table      string
+	LastPK     string
+	RowsCopied int64
+	// RowsRemaining is estimated from the table statistics of the source.
+	RowsRemaining int64
 }
 
 // ReplicationStatus includes data from the _vt.vreplication table, along with other useful relevant data.
@@ -428,7 +432,7 @@ func (wr *Wrangler) getReplicationStatusFromRow(ctx context.Context, row []sqlty
 		TimeUpdated:          timeUpdated,
 		Message:              message,
 	}
-	status.CopyState, err = wr.getCopyState(ctx, master, id)
+	status.CopyState, err = wr.getCopyState(ctx, master, id, &bls)
 	if err != nil {
 		return nil, "", err
 	}
@@ -574,9 +578,9 @@ func (wr *Wrangler) printWorkflowList(keyspace string, workflows []string) {
 	wr.Logger().Printf("Following workflow(s) found in keyspace %s: %v\n", keyspace, list)
 }
 
-func (wr *Wrangler) getCopyState(ctx context.Context, tablet *topo.TabletInfo, id int64) ([]copyState, error) {
+func (wr *Wrangler) getCopyState(ctx context.Context, tablet *topo.TabletInfo, id int64, bls *binlogdatapb.BinlogSource) ([]copyState, error) {
 	var cs []copyState
-	query := fmt.Sprintf("select table_name, lastpk from _vt.copy_state where vrepl_id = %d", id)
+	query := fmt.Sprintf("select table_name, lastpk, rows_copied from _vt.copy_state where vrepl_id = %d", id)
 	qr, err := wr.VReplicationExec(ctx, tablet.Alias, query)
 	if err != nil {
 		return nil, err
@@ -588,13 +592,37 @@ func (wr *Wrangler) getCopyState(ctx context.Context, tablet *topo.TabletInfo, i
 			// These fields are varbinary, but close enough
 			table := row[0].ToString()
 			lastPK := row[1].ToString()
+			rowsCopied, err := evalengine.ToInt64(row[2])
+			if err != nil {
+				return nil, err
+			}
 			copyState := copyState{
-				Table:  table,
-				LastPK: lastPK,
+				Table:      table,
+				LastPK:     lastPK,
+				RowsCopied: rowsCopied,
 			}
 			cs = append(cs, copyState)
 		}
 	}
+	if len(cs) == 0 {
+		return cs, nil
+	}
+
+	// The remaining rows are only an estimate, so failing to get them is not an error.
+	tables := make([]string, len(cs))
+	for i := range cs {
+		tables[i] = cs[i].Table
+	}
+	rowCounts, err := workflow.GetSourceRowCounts(ctx, wr.ts, wr.tmc, bls, tables)
+	if err != nil {
+		log.Warningf("Cannot estimate the rows remaining to copy for stream %d on %s: %v", id, tablet.AliasString(), err)
+		return cs, nil
+	}
+	for i := range cs {
+		if rowCount, ok := rowCounts[cs[i].Table]; ok && int64(rowCount) > cs[i].RowsCopied {
+			cs[i].RowsRemaining = int64(rowCount) - cs[i].RowsCopied
+		}
+	}
 
 	return cs, nil
 }
@@ -238,7 +238,9 @@ func TestWorkflowListStreams(t *testing.T) {
 					"CopyState": [
 						{
 							"Table": "t1",
-							"LastPK": "pk1"
+							"LastPK": "pk1",
+							"RowsCopied": 4,
+							"RowsRemaining": 6
 						}
 					]
 				}
@@ -273,7 +275,9 @@ func TestWorkflowListStreams(t *testing.T) {
 					"CopyState": [
 						{
 							"Table": "t1",
-							"LastPK": "pk1"
+							"LastPK": "pk1",
+							"RowsCopied": 4,
+							"RowsRemaining": 6
 						}
 					]
 				}
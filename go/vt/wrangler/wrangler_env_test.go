@@ -86,6 +86,12 @@ func newWranglerTestEnv(sourceShards, targetShards []string, query string, posit
 		tmc:        newTestWranglerTMClient(),
 	}
 	env.wr = New(logutil.NewConsoleLogger(), env.topoServ, env.tmc)
+	env.tmc.schema = &tabletmanagerdatapb.SchemaDefinition{
+		TableDefinitions: []*tabletmanagerdatapb.TableDefinition{{
+			Name:     "t1",
+			RowCount: 10,
+		}},
+	}
 
 	tabletID := 100
 	for _, shard := range sourceShards {
@@ -166,12 +172,12 @@ func newWranglerTestEnv(sourceShards, targetShards []string, query string, posit
 		env.tmc.setVRResults(master.tablet, "select distinct workflow from _vt.vreplication where state != 'Stopped' and db_name = 'vt_target'", result)
 
 		result = sqltypes.MakeTestResult(sqltypes.MakeTestFields(
-			"table|lastpk",
-			"varchar|varchar"),
-			"t1|pk1",
+			"table|lastpk|rows_copied",
+			"varchar|varchar|int64"),
+			"t1|pk1|4",
 		)
 
-		env.tmc.setVRResults(master.tablet, "select table_name, lastpk from _vt.copy_state where vrepl_id = 1", result)
+		env.tmc.setVRResults(master.tablet, "select table_name, lastpk, rows_copied from _vt.copy_state where vrepl_id = 1", result)
 
 		env.tmc.setVRResults(master.tablet, "select id, source, pos, stop_pos, max_replication_lag, state, db_name, time_updated, transaction_timestamp, message from _vt.vreplication where db_name = 'vt_target' and workflow = 'bad'", result)
 